@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/adjustmentreason"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// AdjustmentReason is the model entity for the AdjustmentReason schema.
+type AdjustmentReason struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// Código del motivo (damaged, theft, expired, internal_use, ...)
+	Code string `json:"code,omitempty"`
+	// Nombre visible del motivo
+	Name string `json:"name,omitempty"`
+	// Indica si el motivo es una baja que cuenta como merma
+	WriteOff bool `json:"write_off,omitempty"`
+	// Los motivos inactivos no se pueden usar en nuevos ajustes
+	Active bool `json:"active,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AdjustmentReason) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case adjustmentreason.FieldWriteOff, adjustmentreason.FieldActive:
+			values[i] = new(sql.NullBool)
+		case adjustmentreason.FieldID, adjustmentreason.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case adjustmentreason.FieldCode, adjustmentreason.FieldName:
+			values[i] = new(sql.NullString)
+		case adjustmentreason.FieldCreatedAt, adjustmentreason.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type AdjustmentReason", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AdjustmentReason fields.
+func (ar *AdjustmentReason) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case adjustmentreason.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ar.ID = int(value.Int64)
+		case adjustmentreason.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ar.TenantID = int(value.Int64)
+			}
+		case adjustmentreason.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				ar.Code = value.String
+			}
+		case adjustmentreason.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ar.Name = value.String
+			}
+		case adjustmentreason.FieldWriteOff:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field write_off", values[i])
+			} else if value.Valid {
+				ar.WriteOff = value.Bool
+			}
+		case adjustmentreason.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				ar.Active = value.Bool
+			}
+		case adjustmentreason.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ar.CreatedAt = value.Time
+			}
+		case adjustmentreason.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ar.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this AdjustmentReason.
+// Note that you need to call AdjustmentReason.Unwrap() before calling this method if this AdjustmentReason
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ar *AdjustmentReason) Update() *AdjustmentReasonUpdateOne {
+	return (&AdjustmentReasonClient{config: ar.config}).UpdateOne(ar)
+}
+
+// Unwrap unwraps the AdjustmentReason entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ar *AdjustmentReason) Unwrap() *AdjustmentReason {
+	_tx, ok := ar.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AdjustmentReason is not a transactional entity")
+	}
+	ar.config.driver = _tx.drv
+	return ar
+}
+
+// String implements the fmt.Stringer.
+func (ar *AdjustmentReason) String() string {
+	var builder strings.Builder
+	builder.WriteString("AdjustmentReason(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ar.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", ar.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(ar.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ar.Name)
+	builder.WriteString(", ")
+	builder.WriteString("write_off=")
+	builder.WriteString(fmt.Sprintf("%v", ar.WriteOff))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Active))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ar.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ar.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AdjustmentReasons is a parsable slice of AdjustmentReason.
+type AdjustmentReasons []*AdjustmentReason
+
+func (ar AdjustmentReasons) config(cfg config) {
+	for _i := range ar {
+		ar[_i].config = cfg
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by ent, DO NOT EDIT.
+
+package adjustmentreason
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the adjustmentreason type in the database.
+	Label = "adjustment_reason"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldWriteOff holds the string denoting the write_off field in the database.
+	FieldWriteOff = "write_off"
+	// FieldActive holds the string denoting the active field in the database.
+	FieldActive = "active"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the adjustmentreason in the database.
+	Table = "adjustment_reasons"
+)
+
+// Columns holds all SQL columns for adjustmentreason fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCode,
+	FieldName,
+	FieldWriteOff,
+	FieldActive,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultWriteOff holds the default value on creation for the "write_off" field.
+	DefaultWriteOff bool
+	// DefaultActive holds the default value on creation for the "active" field.
+	DefaultActive bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
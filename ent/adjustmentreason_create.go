@@ -0,0 +1,393 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/adjustmentreason"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdjustmentReasonCreate is the builder for creating a AdjustmentReason entity.
+type AdjustmentReasonCreate struct {
+	config
+	mutation *AdjustmentReasonMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (arc *AdjustmentReasonCreate) SetTenantID(i int) *AdjustmentReasonCreate {
+	arc.mutation.SetTenantID(i)
+	return arc
+}
+
+// SetCode sets the "code" field.
+func (arc *AdjustmentReasonCreate) SetCode(s string) *AdjustmentReasonCreate {
+	arc.mutation.SetCode(s)
+	return arc
+}
+
+// SetName sets the "name" field.
+func (arc *AdjustmentReasonCreate) SetName(s string) *AdjustmentReasonCreate {
+	arc.mutation.SetName(s)
+	return arc
+}
+
+// SetWriteOff sets the "write_off" field.
+func (arc *AdjustmentReasonCreate) SetWriteOff(b bool) *AdjustmentReasonCreate {
+	arc.mutation.SetWriteOff(b)
+	return arc
+}
+
+// SetNillableWriteOff sets the "write_off" field if the given value is not nil.
+func (arc *AdjustmentReasonCreate) SetNillableWriteOff(b *bool) *AdjustmentReasonCreate {
+	if b != nil {
+		arc.SetWriteOff(*b)
+	}
+	return arc
+}
+
+// SetActive sets the "active" field.
+func (arc *AdjustmentReasonCreate) SetActive(b bool) *AdjustmentReasonCreate {
+	arc.mutation.SetActive(b)
+	return arc
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (arc *AdjustmentReasonCreate) SetNillableActive(b *bool) *AdjustmentReasonCreate {
+	if b != nil {
+		arc.SetActive(*b)
+	}
+	return arc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (arc *AdjustmentReasonCreate) SetCreatedAt(t time.Time) *AdjustmentReasonCreate {
+	arc.mutation.SetCreatedAt(t)
+	return arc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (arc *AdjustmentReasonCreate) SetNillableCreatedAt(t *time.Time) *AdjustmentReasonCreate {
+	if t != nil {
+		arc.SetCreatedAt(*t)
+	}
+	return arc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (arc *AdjustmentReasonCreate) SetUpdatedAt(t time.Time) *AdjustmentReasonCreate {
+	arc.mutation.SetUpdatedAt(t)
+	return arc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (arc *AdjustmentReasonCreate) SetNillableUpdatedAt(t *time.Time) *AdjustmentReasonCreate {
+	if t != nil {
+		arc.SetUpdatedAt(*t)
+	}
+	return arc
+}
+
+// Mutation returns the AdjustmentReasonMutation object of the builder.
+func (arc *AdjustmentReasonCreate) Mutation() *AdjustmentReasonMutation {
+	return arc.mutation
+}
+
+// Save creates the AdjustmentReason in the database.
+func (arc *AdjustmentReasonCreate) Save(ctx context.Context) (*AdjustmentReason, error) {
+	var (
+		err  error
+		node *AdjustmentReason
+	)
+	arc.defaults()
+	if len(arc.hooks) == 0 {
+		if err = arc.check(); err != nil {
+			return nil, err
+		}
+		node, err = arc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AdjustmentReasonMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = arc.check(); err != nil {
+				return nil, err
+			}
+			arc.mutation = mutation
+			if node, err = arc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(arc.hooks) - 1; i >= 0; i-- {
+			if arc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = arc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, arc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*AdjustmentReason)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from AdjustmentReasonMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (arc *AdjustmentReasonCreate) SaveX(ctx context.Context) *AdjustmentReason {
+	v, err := arc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arc *AdjustmentReasonCreate) Exec(ctx context.Context) error {
+	_, err := arc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arc *AdjustmentReasonCreate) ExecX(ctx context.Context) {
+	if err := arc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (arc *AdjustmentReasonCreate) defaults() {
+	if _, ok := arc.mutation.WriteOff(); !ok {
+		v := adjustmentreason.DefaultWriteOff
+		arc.mutation.SetWriteOff(v)
+	}
+	if _, ok := arc.mutation.Active(); !ok {
+		v := adjustmentreason.DefaultActive
+		arc.mutation.SetActive(v)
+	}
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		v := adjustmentreason.DefaultCreatedAt()
+		arc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := arc.mutation.UpdatedAt(); !ok {
+		v := adjustmentreason.DefaultUpdatedAt()
+		arc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (arc *AdjustmentReasonCreate) check() error {
+	if _, ok := arc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "AdjustmentReason.tenant_id"`)}
+	}
+	if _, ok := arc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "AdjustmentReason.code"`)}
+	}
+	if v, ok := arc.mutation.Code(); ok {
+		if err := adjustmentreason.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "AdjustmentReason.code": %w`, err)}
+		}
+	}
+	if _, ok := arc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AdjustmentReason.name"`)}
+	}
+	if v, ok := arc.mutation.Name(); ok {
+		if err := adjustmentreason.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AdjustmentReason.name": %w`, err)}
+		}
+	}
+	if _, ok := arc.mutation.WriteOff(); !ok {
+		return &ValidationError{Name: "write_off", err: errors.New(`ent: missing required field "AdjustmentReason.write_off"`)}
+	}
+	if _, ok := arc.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "AdjustmentReason.active"`)}
+	}
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdjustmentReason.created_at"`)}
+	}
+	if _, ok := arc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AdjustmentReason.updated_at"`)}
+	}
+	return nil
+}
+
+func (arc *AdjustmentReasonCreate) sqlSave(ctx context.Context) (*AdjustmentReason, error) {
+	_node, _spec := arc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, arc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (arc *AdjustmentReasonCreate) createSpec() (*AdjustmentReason, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdjustmentReason{config: arc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: adjustmentreason.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: adjustmentreason.FieldID,
+			},
+		}
+	)
+	if value, ok := arc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: adjustmentreason.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := arc.mutation.Code(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: adjustmentreason.FieldCode,
+		})
+		_node.Code = value
+	}
+	if value, ok := arc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: adjustmentreason.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := arc.mutation.WriteOff(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: adjustmentreason.FieldWriteOff,
+		})
+		_node.WriteOff = value
+	}
+	if value, ok := arc.mutation.Active(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: adjustmentreason.FieldActive,
+		})
+		_node.Active = value
+	}
+	if value, ok := arc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: adjustmentreason.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := arc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: adjustmentreason.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// AdjustmentReasonCreateBulk is the builder for creating many AdjustmentReason entities in bulk.
+type AdjustmentReasonCreateBulk struct {
+	config
+	builders []*AdjustmentReasonCreate
+}
+
+// Save creates the AdjustmentReason entities in the database.
+func (arcb *AdjustmentReasonCreateBulk) Save(ctx context.Context) ([]*AdjustmentReason, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(arcb.builders))
+	nodes := make([]*AdjustmentReason, len(arcb.builders))
+	mutators := make([]Mutator, len(arcb.builders))
+	for i := range arcb.builders {
+		func(i int, root context.Context) {
+			builder := arcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdjustmentReasonMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, arcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, arcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, arcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (arcb *AdjustmentReasonCreateBulk) SaveX(ctx context.Context) []*AdjustmentReason {
+	v, err := arcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arcb *AdjustmentReasonCreateBulk) Exec(ctx context.Context) error {
+	_, err := arcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arcb *AdjustmentReasonCreateBulk) ExecX(ctx context.Context) {
+	if err := arcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/adjustmentreason"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdjustmentReasonUpdate is the builder for updating AdjustmentReason entities.
+type AdjustmentReasonUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AdjustmentReasonMutation
+}
+
+// Where appends a list predicates to the AdjustmentReasonUpdate builder.
+func (aru *AdjustmentReasonUpdate) Where(ps ...predicate.AdjustmentReason) *AdjustmentReasonUpdate {
+	aru.mutation.Where(ps...)
+	return aru
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (aru *AdjustmentReasonUpdate) SetTenantID(i int) *AdjustmentReasonUpdate {
+	aru.mutation.ResetTenantID()
+	aru.mutation.SetTenantID(i)
+	return aru
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (aru *AdjustmentReasonUpdate) AddTenantID(i int) *AdjustmentReasonUpdate {
+	aru.mutation.AddTenantID(i)
+	return aru
+}
+
+// SetCode sets the "code" field.
+func (aru *AdjustmentReasonUpdate) SetCode(s string) *AdjustmentReasonUpdate {
+	aru.mutation.SetCode(s)
+	return aru
+}
+
+// SetName sets the "name" field.
+func (aru *AdjustmentReasonUpdate) SetName(s string) *AdjustmentReasonUpdate {
+	aru.mutation.SetName(s)
+	return aru
+}
+
+// SetWriteOff sets the "write_off" field.
+func (aru *AdjustmentReasonUpdate) SetWriteOff(b bool) *AdjustmentReasonUpdate {
+	aru.mutation.SetWriteOff(b)
+	return aru
+}
+
+// SetNillableWriteOff sets the "write_off" field if the given value is not nil.
+func (aru *AdjustmentReasonUpdate) SetNillableWriteOff(b *bool) *AdjustmentReasonUpdate {
+	if b != nil {
+		aru.SetWriteOff(*b)
+	}
+	return aru
+}
+
+// SetActive sets the "active" field.
+func (aru *AdjustmentReasonUpdate) SetActive(b bool) *AdjustmentReasonUpdate {
+	aru.mutation.SetActive(b)
+	return aru
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (aru *AdjustmentReasonUpdate) SetNillableActive(b *bool) *AdjustmentReasonUpdate {
+	if b != nil {
+		aru.SetActive(*b)
+	}
+	return aru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aru *AdjustmentReasonUpdate) SetUpdatedAt(t time.Time) *AdjustmentReasonUpdate {
+	aru.mutation.SetUpdatedAt(t)
+	return aru
+}
+
+// Mutation returns the AdjustmentReasonMutation object of the builder.
+func (aru *AdjustmentReasonUpdate) Mutation() *AdjustmentReasonMutation {
+	return aru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aru *AdjustmentReasonUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	aru.defaults()
+	if len(aru.hooks) == 0 {
+		if err = aru.check(); err != nil {
+			return 0, err
+		}
+		affected, err = aru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AdjustmentReasonMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = aru.check(); err != nil {
+				return 0, err
+			}
+			aru.mutation = mutation
+			affected, err = aru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(aru.hooks) - 1; i >= 0; i-- {
+			if aru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, aru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aru *AdjustmentReasonUpdate) SaveX(ctx context.Context) int {
+	affected, err := aru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aru *AdjustmentReasonUpdate) Exec(ctx context.Context) error {
+	_, err := aru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aru *AdjustmentReasonUpdate) ExecX(ctx context.Context) {
+	if err := aru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aru *AdjustmentReasonUpdate) defaults() {
+	if _, ok := aru.mutation.UpdatedAt(); !ok {
+		v := adjustmentreason.UpdateDefaultUpdatedAt()
+		aru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aru *AdjustmentReasonUpdate) check() error {
+	if v, ok := aru.mutation.Code(); ok {
+		if err := adjustmentreason.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "AdjustmentReason.code": %w`, err)}
+		}
+	}
+	if v, ok := aru.mutation.Name(); ok {
+		if err := adjustmentreason.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AdjustmentReason.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aru *AdjustmentReasonUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   adjustmentreason.Table,
+			Columns: adjustmentreason.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: adjustmentreason.FieldID,
+			},
+		},
+	}
+	if ps := aru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aru.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: adjustmentreason.FieldTenantID,
+		})
+	}
+	if value, ok := aru.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: adjustmentreason.FieldTenantID,
+		})
+	}
+	if value, ok := aru.mutation.Code(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: adjustmentreason.FieldCode,
+		})
+	}
+	if value, ok := aru.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: adjustmentreason.FieldName,
+		})
+	}
+	if value, ok := aru.mutation.WriteOff(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: adjustmentreason.FieldWriteOff,
+		})
+	}
+	if value, ok := aru.mutation.Active(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: adjustmentreason.FieldActive,
+		})
+	}
+	if value, ok := aru.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: adjustmentreason.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adjustmentreason.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AdjustmentReasonUpdateOne is the builder for updating a single AdjustmentReason entity.
+type AdjustmentReasonUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AdjustmentReasonMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (aruo *AdjustmentReasonUpdateOne) SetTenantID(i int) *AdjustmentReasonUpdateOne {
+	aruo.mutation.ResetTenantID()
+	aruo.mutation.SetTenantID(i)
+	return aruo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (aruo *AdjustmentReasonUpdateOne) AddTenantID(i int) *AdjustmentReasonUpdateOne {
+	aruo.mutation.AddTenantID(i)
+	return aruo
+}
+
+// SetCode sets the "code" field.
+func (aruo *AdjustmentReasonUpdateOne) SetCode(s string) *AdjustmentReasonUpdateOne {
+	aruo.mutation.SetCode(s)
+	return aruo
+}
+
+// SetName sets the "name" field.
+func (aruo *AdjustmentReasonUpdateOne) SetName(s string) *AdjustmentReasonUpdateOne {
+	aruo.mutation.SetName(s)
+	return aruo
+}
+
+// SetWriteOff sets the "write_off" field.
+func (aruo *AdjustmentReasonUpdateOne) SetWriteOff(b bool) *AdjustmentReasonUpdateOne {
+	aruo.mutation.SetWriteOff(b)
+	return aruo
+}
+
+// SetNillableWriteOff sets the "write_off" field if the given value is not nil.
+func (aruo *AdjustmentReasonUpdateOne) SetNillableWriteOff(b *bool) *AdjustmentReasonUpdateOne {
+	if b != nil {
+		aruo.SetWriteOff(*b)
+	}
+	return aruo
+}
+
+// SetActive sets the "active" field.
+func (aruo *AdjustmentReasonUpdateOne) SetActive(b bool) *AdjustmentReasonUpdateOne {
+	aruo.mutation.SetActive(b)
+	return aruo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (aruo *AdjustmentReasonUpdateOne) SetNillableActive(b *bool) *AdjustmentReasonUpdateOne {
+	if b != nil {
+		aruo.SetActive(*b)
+	}
+	return aruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aruo *AdjustmentReasonUpdateOne) SetUpdatedAt(t time.Time) *AdjustmentReasonUpdateOne {
+	aruo.mutation.SetUpdatedAt(t)
+	return aruo
+}
+
+// Mutation returns the AdjustmentReasonMutation object of the builder.
+func (aruo *AdjustmentReasonUpdateOne) Mutation() *AdjustmentReasonMutation {
+	return aruo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aruo *AdjustmentReasonUpdateOne) Select(field string, fields ...string) *AdjustmentReasonUpdateOne {
+	aruo.fields = append([]string{field}, fields...)
+	return aruo
+}
+
+// Save executes the query and returns the updated AdjustmentReason entity.
+func (aruo *AdjustmentReasonUpdateOne) Save(ctx context.Context) (*AdjustmentReason, error) {
+	var (
+		err  error
+		node *AdjustmentReason
+	)
+	aruo.defaults()
+	if len(aruo.hooks) == 0 {
+		if err = aruo.check(); err != nil {
+			return nil, err
+		}
+		node, err = aruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AdjustmentReasonMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = aruo.check(); err != nil {
+				return nil, err
+			}
+			aruo.mutation = mutation
+			node, err = aruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(aruo.hooks) - 1; i >= 0; i-- {
+			if aruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aruo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, aruo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*AdjustmentReason)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from AdjustmentReasonMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aruo *AdjustmentReasonUpdateOne) SaveX(ctx context.Context) *AdjustmentReason {
+	node, err := aruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aruo *AdjustmentReasonUpdateOne) Exec(ctx context.Context) error {
+	_, err := aruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aruo *AdjustmentReasonUpdateOne) ExecX(ctx context.Context) {
+	if err := aruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aruo *AdjustmentReasonUpdateOne) defaults() {
+	if _, ok := aruo.mutation.UpdatedAt(); !ok {
+		v := adjustmentreason.UpdateDefaultUpdatedAt()
+		aruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aruo *AdjustmentReasonUpdateOne) check() error {
+	if v, ok := aruo.mutation.Code(); ok {
+		if err := adjustmentreason.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "AdjustmentReason.code": %w`, err)}
+		}
+	}
+	if v, ok := aruo.mutation.Name(); ok {
+		if err := adjustmentreason.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AdjustmentReason.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aruo *AdjustmentReasonUpdateOne) sqlSave(ctx context.Context) (_node *AdjustmentReason, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   adjustmentreason.Table,
+			Columns: adjustmentreason.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: adjustmentreason.FieldID,
+			},
+		},
+	}
+	id, ok := aruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AdjustmentReason.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, adjustmentreason.FieldID)
+		for _, f := range fields {
+			if !adjustmentreason.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != adjustmentreason.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aruo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: adjustmentreason.FieldTenantID,
+		})
+	}
+	if value, ok := aruo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: adjustmentreason.FieldTenantID,
+		})
+	}
+	if value, ok := aruo.mutation.Code(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: adjustmentreason.FieldCode,
+		})
+	}
+	if value, ok := aruo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: adjustmentreason.FieldName,
+		})
+	}
+	if value, ok := aruo.mutation.WriteOff(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: adjustmentreason.FieldWriteOff,
+		})
+	}
+	if value, ok := aruo.mutation.Active(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: adjustmentreason.FieldActive,
+		})
+	}
+	if value, ok := aruo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: adjustmentreason.FieldUpdatedAt,
+		})
+	}
+	_node = &AdjustmentReason{config: aruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adjustmentreason.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
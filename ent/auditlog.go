@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/auditlog"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// AuditLog is the model entity for the AuditLog schema.
+type AuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// ID del usuario que hizo el cambio
+	UserID int `json:"user_id,omitempty"`
+	// Acción registrada, como product.bulk_update
+	Action string `json:"action,omitempty"`
+	// Tipo de registro afectado (product, invoice...)
+	Entity string `json:"entity,omitempty"`
+	// ID del registro afectado; vacío si el cambio abarca varios
+	EntityID int `json:"entity_id,omitempty"`
+	// Descripción legible del cambio
+	Summary string `json:"summary,omitempty"`
+	// Detalle del cambio en JSON (operación y valores antes y después)
+	Details string `json:"details,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLog) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID, auditlog.FieldTenantID, auditlog.FieldUserID, auditlog.FieldEntityID:
+			values[i] = new(sql.NullInt64)
+		case auditlog.FieldAction, auditlog.FieldEntity, auditlog.FieldSummary, auditlog.FieldDetails:
+			values[i] = new(sql.NullString)
+		case auditlog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type AuditLog", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLog fields.
+func (al *AuditLog) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			al.ID = int(value.Int64)
+		case auditlog.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				al.TenantID = int(value.Int64)
+			}
+		case auditlog.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				al.UserID = int(value.Int64)
+			}
+		case auditlog.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				al.Action = value.String
+			}
+		case auditlog.FieldEntity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity", values[i])
+			} else if value.Valid {
+				al.Entity = value.String
+			}
+		case auditlog.FieldEntityID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				al.EntityID = int(value.Int64)
+			}
+		case auditlog.FieldSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value.Valid {
+				al.Summary = value.String
+			}
+		case auditlog.FieldDetails:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field details", values[i])
+			} else if value.Valid {
+				al.Details = value.String
+			}
+		case auditlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				al.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this AuditLog.
+// Note that you need to call AuditLog.Unwrap() before calling this method if this AuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (al *AuditLog) Update() *AuditLogUpdateOne {
+	return (&AuditLogClient{config: al.config}).UpdateOne(al)
+}
+
+// Unwrap unwraps the AuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (al *AuditLog) Unwrap() *AuditLog {
+	_tx, ok := al.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLog is not a transactional entity")
+	}
+	al.config.driver = _tx.drv
+	return al
+}
+
+// String implements the fmt.Stringer.
+func (al *AuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", al.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(al.Action)
+	builder.WriteString(", ")
+	builder.WriteString("entity=")
+	builder.WriteString(al.Entity)
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.EntityID))
+	builder.WriteString(", ")
+	builder.WriteString("summary=")
+	builder.WriteString(al.Summary)
+	builder.WriteString(", ")
+	builder.WriteString("details=")
+	builder.WriteString(al.Details)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(al.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogs is a parsable slice of AuditLog.
+type AuditLogs []*AuditLog
+
+func (al AuditLogs) config(cfg config) {
+	for _i := range al {
+		al[_i].config = cfg
+	}
+}
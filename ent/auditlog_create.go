@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/auditlog"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (alc *AuditLogCreate) SetTenantID(i int) *AuditLogCreate {
+	alc.mutation.SetTenantID(i)
+	return alc
+}
+
+// SetUserID sets the "user_id" field.
+func (alc *AuditLogCreate) SetUserID(i int) *AuditLogCreate {
+	alc.mutation.SetUserID(i)
+	return alc
+}
+
+// SetAction sets the "action" field.
+func (alc *AuditLogCreate) SetAction(s string) *AuditLogCreate {
+	alc.mutation.SetAction(s)
+	return alc
+}
+
+// SetEntity sets the "entity" field.
+func (alc *AuditLogCreate) SetEntity(s string) *AuditLogCreate {
+	alc.mutation.SetEntity(s)
+	return alc
+}
+
+// SetEntityID sets the "entity_id" field.
+func (alc *AuditLogCreate) SetEntityID(i int) *AuditLogCreate {
+	alc.mutation.SetEntityID(i)
+	return alc
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableEntityID(i *int) *AuditLogCreate {
+	if i != nil {
+		alc.SetEntityID(*i)
+	}
+	return alc
+}
+
+// SetSummary sets the "summary" field.
+func (alc *AuditLogCreate) SetSummary(s string) *AuditLogCreate {
+	alc.mutation.SetSummary(s)
+	return alc
+}
+
+// SetDetails sets the "details" field.
+func (alc *AuditLogCreate) SetDetails(s string) *AuditLogCreate {
+	alc.mutation.SetDetails(s)
+	return alc
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableDetails(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetDetails(*s)
+	}
+	return alc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (alc *AuditLogCreate) SetCreatedAt(t time.Time) *AuditLogCreate {
+	alc.mutation.SetCreatedAt(t)
+	return alc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCreatedAt(t *time.Time) *AuditLogCreate {
+	if t != nil {
+		alc.SetCreatedAt(*t)
+	}
+	return alc
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alc *AuditLogCreate) Mutation() *AuditLogMutation {
+	return alc.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (alc *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	var (
+		err  error
+		node *AuditLog
+	)
+	alc.defaults()
+	if len(alc.hooks) == 0 {
+		if err = alc.check(); err != nil {
+			return nil, err
+		}
+		node, err = alc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditLogMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = alc.check(); err != nil {
+				return nil, err
+			}
+			alc.mutation = mutation
+			if node, err = alc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(alc.hooks) - 1; i >= 0; i-- {
+			if alc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = alc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, alc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*AuditLog)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from AuditLogMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *AuditLogCreate) defaults() {
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		v := auditlog.DefaultCreatedAt()
+		alc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *AuditLogCreate) check() error {
+	if _, ok := alc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "AuditLog.tenant_id"`)}
+	}
+	if _, ok := alc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AuditLog.user_id"`)}
+	}
+	if _, ok := alc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AuditLog.action"`)}
+	}
+	if v, ok := alc.mutation.Action(); ok {
+		if err := auditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditLog.action": %w`, err)}
+		}
+	}
+	if _, ok := alc.mutation.Entity(); !ok {
+		return &ValidationError{Name: "entity", err: errors.New(`ent: missing required field "AuditLog.entity"`)}
+	}
+	if v, ok := alc.mutation.Entity(); ok {
+		if err := auditlog.EntityValidator(v); err != nil {
+			return &ValidationError{Name: "entity", err: fmt.Errorf(`ent: validator failed for field "AuditLog.entity": %w`, err)}
+		}
+	}
+	if _, ok := alc.mutation.Summary(); !ok {
+		return &ValidationError{Name: "summary", err: errors.New(`ent: missing required field "AuditLog.summary"`)}
+	}
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditLog.created_at"`)}
+	}
+	return nil
+}
+
+func (alc *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (alc *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: alc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: auditlog.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditlog.FieldID,
+			},
+		}
+	)
+	if value, ok := alc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := alc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := alc.mutation.Action(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldAction,
+		})
+		_node.Action = value
+	}
+	if value, ok := alc.mutation.Entity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldEntity,
+		})
+		_node.Entity = value
+	}
+	if value, ok := alc.mutation.EntityID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldEntityID,
+		})
+		_node.EntityID = value
+	}
+	if value, ok := alc.mutation.Summary(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldSummary,
+		})
+		_node.Summary = value
+	}
+	if value, ok := alc.mutation.Details(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldDetails,
+		})
+		_node.Details = value
+	}
+	if value, ok := alc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: auditlog.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	builders []*AuditLogCreate
+}
+
+// Save creates the AuditLog entities in the database.
+func (alcb *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*AuditLog, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
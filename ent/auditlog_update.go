@@ -0,0 +1,633 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/auditlog"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (alu *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	alu.mutation.Where(ps...)
+	return alu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (alu *AuditLogUpdate) SetTenantID(i int) *AuditLogUpdate {
+	alu.mutation.ResetTenantID()
+	alu.mutation.SetTenantID(i)
+	return alu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (alu *AuditLogUpdate) AddTenantID(i int) *AuditLogUpdate {
+	alu.mutation.AddTenantID(i)
+	return alu
+}
+
+// SetUserID sets the "user_id" field.
+func (alu *AuditLogUpdate) SetUserID(i int) *AuditLogUpdate {
+	alu.mutation.ResetUserID()
+	alu.mutation.SetUserID(i)
+	return alu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (alu *AuditLogUpdate) AddUserID(i int) *AuditLogUpdate {
+	alu.mutation.AddUserID(i)
+	return alu
+}
+
+// SetAction sets the "action" field.
+func (alu *AuditLogUpdate) SetAction(s string) *AuditLogUpdate {
+	alu.mutation.SetAction(s)
+	return alu
+}
+
+// SetEntity sets the "entity" field.
+func (alu *AuditLogUpdate) SetEntity(s string) *AuditLogUpdate {
+	alu.mutation.SetEntity(s)
+	return alu
+}
+
+// SetEntityID sets the "entity_id" field.
+func (alu *AuditLogUpdate) SetEntityID(i int) *AuditLogUpdate {
+	alu.mutation.ResetEntityID()
+	alu.mutation.SetEntityID(i)
+	return alu
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableEntityID(i *int) *AuditLogUpdate {
+	if i != nil {
+		alu.SetEntityID(*i)
+	}
+	return alu
+}
+
+// AddEntityID adds i to the "entity_id" field.
+func (alu *AuditLogUpdate) AddEntityID(i int) *AuditLogUpdate {
+	alu.mutation.AddEntityID(i)
+	return alu
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (alu *AuditLogUpdate) ClearEntityID() *AuditLogUpdate {
+	alu.mutation.ClearEntityID()
+	return alu
+}
+
+// SetSummary sets the "summary" field.
+func (alu *AuditLogUpdate) SetSummary(s string) *AuditLogUpdate {
+	alu.mutation.SetSummary(s)
+	return alu
+}
+
+// SetDetails sets the "details" field.
+func (alu *AuditLogUpdate) SetDetails(s string) *AuditLogUpdate {
+	alu.mutation.SetDetails(s)
+	return alu
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableDetails(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetDetails(*s)
+	}
+	return alu
+}
+
+// ClearDetails clears the value of the "details" field.
+func (alu *AuditLogUpdate) ClearDetails() *AuditLogUpdate {
+	alu.mutation.ClearDetails()
+	return alu
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alu *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return alu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (alu *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(alu.hooks) == 0 {
+		if err = alu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = alu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditLogMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = alu.check(); err != nil {
+				return 0, err
+			}
+			alu.mutation = mutation
+			affected, err = alu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(alu.hooks) - 1; i >= 0; i-- {
+			if alu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = alu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, alu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alu *AuditLogUpdate) check() error {
+	if v, ok := alu.mutation.Action(); ok {
+		if err := auditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditLog.action": %w`, err)}
+		}
+	}
+	if v, ok := alu.mutation.Entity(); ok {
+		if err := auditlog.EntityValidator(v); err != nil {
+			return &ValidationError{Name: "entity", err: fmt.Errorf(`ent: validator failed for field "AuditLog.entity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (alu *AuditLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   auditlog.Table,
+			Columns: auditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditlog.FieldID,
+			},
+		},
+	}
+	if ps := alu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := alu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldTenantID,
+		})
+	}
+	if value, ok := alu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldTenantID,
+		})
+	}
+	if value, ok := alu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldUserID,
+		})
+	}
+	if value, ok := alu.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldUserID,
+		})
+	}
+	if value, ok := alu.mutation.Action(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldAction,
+		})
+	}
+	if value, ok := alu.mutation.Entity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldEntity,
+		})
+	}
+	if value, ok := alu.mutation.EntityID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldEntityID,
+		})
+	}
+	if value, ok := alu.mutation.AddedEntityID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldEntityID,
+		})
+	}
+	if alu.mutation.EntityIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: auditlog.FieldEntityID,
+		})
+	}
+	if value, ok := alu.mutation.Summary(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldSummary,
+		})
+	}
+	if value, ok := alu.mutation.Details(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldDetails,
+		})
+	}
+	if alu.mutation.DetailsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldDetails,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (aluo *AuditLogUpdateOne) SetTenantID(i int) *AuditLogUpdateOne {
+	aluo.mutation.ResetTenantID()
+	aluo.mutation.SetTenantID(i)
+	return aluo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (aluo *AuditLogUpdateOne) AddTenantID(i int) *AuditLogUpdateOne {
+	aluo.mutation.AddTenantID(i)
+	return aluo
+}
+
+// SetUserID sets the "user_id" field.
+func (aluo *AuditLogUpdateOne) SetUserID(i int) *AuditLogUpdateOne {
+	aluo.mutation.ResetUserID()
+	aluo.mutation.SetUserID(i)
+	return aluo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (aluo *AuditLogUpdateOne) AddUserID(i int) *AuditLogUpdateOne {
+	aluo.mutation.AddUserID(i)
+	return aluo
+}
+
+// SetAction sets the "action" field.
+func (aluo *AuditLogUpdateOne) SetAction(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetAction(s)
+	return aluo
+}
+
+// SetEntity sets the "entity" field.
+func (aluo *AuditLogUpdateOne) SetEntity(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetEntity(s)
+	return aluo
+}
+
+// SetEntityID sets the "entity_id" field.
+func (aluo *AuditLogUpdateOne) SetEntityID(i int) *AuditLogUpdateOne {
+	aluo.mutation.ResetEntityID()
+	aluo.mutation.SetEntityID(i)
+	return aluo
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableEntityID(i *int) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetEntityID(*i)
+	}
+	return aluo
+}
+
+// AddEntityID adds i to the "entity_id" field.
+func (aluo *AuditLogUpdateOne) AddEntityID(i int) *AuditLogUpdateOne {
+	aluo.mutation.AddEntityID(i)
+	return aluo
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (aluo *AuditLogUpdateOne) ClearEntityID() *AuditLogUpdateOne {
+	aluo.mutation.ClearEntityID()
+	return aluo
+}
+
+// SetSummary sets the "summary" field.
+func (aluo *AuditLogUpdateOne) SetSummary(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetSummary(s)
+	return aluo
+}
+
+// SetDetails sets the "details" field.
+func (aluo *AuditLogUpdateOne) SetDetails(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetDetails(s)
+	return aluo
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableDetails(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetDetails(*s)
+	}
+	return aluo
+}
+
+// ClearDetails clears the value of the "details" field.
+func (aluo *AuditLogUpdateOne) ClearDetails() *AuditLogUpdateOne {
+	aluo.mutation.ClearDetails()
+	return aluo
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (aluo *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return aluo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aluo *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	aluo.fields = append([]string{field}, fields...)
+	return aluo
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (aluo *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	var (
+		err  error
+		node *AuditLog
+	)
+	if len(aluo.hooks) == 0 {
+		if err = aluo.check(); err != nil {
+			return nil, err
+		}
+		node, err = aluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditLogMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = aluo.check(); err != nil {
+				return nil, err
+			}
+			aluo.mutation = mutation
+			node, err = aluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(aluo.hooks) - 1; i >= 0; i-- {
+			if aluo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aluo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, aluo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*AuditLog)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from AuditLogMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aluo *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aluo *AuditLogUpdateOne) check() error {
+	if v, ok := aluo.mutation.Action(); ok {
+		if err := auditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditLog.action": %w`, err)}
+		}
+	}
+	if v, ok := aluo.mutation.Entity(); ok {
+		if err := auditlog.EntityValidator(v); err != nil {
+			return &ValidationError{Name: "entity", err: fmt.Errorf(`ent: validator failed for field "AuditLog.entity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aluo *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   auditlog.Table,
+			Columns: auditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditlog.FieldID,
+			},
+		},
+	}
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aluo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldTenantID,
+		})
+	}
+	if value, ok := aluo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldTenantID,
+		})
+	}
+	if value, ok := aluo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldUserID,
+		})
+	}
+	if value, ok := aluo.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldUserID,
+		})
+	}
+	if value, ok := aluo.mutation.Action(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldAction,
+		})
+	}
+	if value, ok := aluo.mutation.Entity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldEntity,
+		})
+	}
+	if value, ok := aluo.mutation.EntityID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldEntityID,
+		})
+	}
+	if value, ok := aluo.mutation.AddedEntityID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldEntityID,
+		})
+	}
+	if aluo.mutation.EntityIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: auditlog.FieldEntityID,
+		})
+	}
+	if value, ok := aluo.mutation.Summary(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldSummary,
+		})
+	}
+	if value, ok := aluo.mutation.Details(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldDetails,
+		})
+	}
+	if aluo.mutation.DetailsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldDetails,
+		})
+	}
+	_node = &AuditLog{config: aluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
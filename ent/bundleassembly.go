@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/bundleassembly"
+	"Veritasbackend/pkg/money"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// BundleAssembly is the model entity for the BundleAssembly schema.
+type BundleAssembly struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// ID del producto combo armado
+	BundleID int `json:"bundle_id,omitempty"`
+	// Unidades de combo armadas
+	Quantity float64 `json:"quantity,omitempty"`
+	// Costo de una unidad armada (suma del costo de sus componentes)
+	UnitCost money.Amount `json:"unit_cost,omitempty"`
+	// Observaciones del armado
+	Notes string `json:"notes,omitempty"`
+	// ID del usuario que registró el armado
+	UserID int `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BundleAssembly) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bundleassembly.FieldUnitCost:
+			values[i] = new(money.Amount)
+		case bundleassembly.FieldQuantity:
+			values[i] = new(sql.NullFloat64)
+		case bundleassembly.FieldID, bundleassembly.FieldTenantID, bundleassembly.FieldBundleID, bundleassembly.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case bundleassembly.FieldNotes:
+			values[i] = new(sql.NullString)
+		case bundleassembly.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type BundleAssembly", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BundleAssembly fields.
+func (ba *BundleAssembly) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bundleassembly.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ba.ID = int(value.Int64)
+		case bundleassembly.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ba.TenantID = int(value.Int64)
+			}
+		case bundleassembly.FieldBundleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bundle_id", values[i])
+			} else if value.Valid {
+				ba.BundleID = int(value.Int64)
+			}
+		case bundleassembly.FieldQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				ba.Quantity = value.Float64
+			}
+		case bundleassembly.FieldUnitCost:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_cost", values[i])
+			} else if value != nil {
+				ba.UnitCost = *value
+			}
+		case bundleassembly.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				ba.Notes = value.String
+			}
+		case bundleassembly.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ba.UserID = int(value.Int64)
+			}
+		case bundleassembly.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ba.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this BundleAssembly.
+// Note that you need to call BundleAssembly.Unwrap() before calling this method if this BundleAssembly
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ba *BundleAssembly) Update() *BundleAssemblyUpdateOne {
+	return (&BundleAssemblyClient{config: ba.config}).UpdateOne(ba)
+}
+
+// Unwrap unwraps the BundleAssembly entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ba *BundleAssembly) Unwrap() *BundleAssembly {
+	_tx, ok := ba.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BundleAssembly is not a transactional entity")
+	}
+	ba.config.driver = _tx.drv
+	return ba
+}
+
+// String implements the fmt.Stringer.
+func (ba *BundleAssembly) String() string {
+	var builder strings.Builder
+	builder.WriteString("BundleAssembly(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ba.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", ba.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("bundle_id=")
+	builder.WriteString(fmt.Sprintf("%v", ba.BundleID))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", ba.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("unit_cost=")
+	builder.WriteString(fmt.Sprintf("%v", ba.UnitCost))
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(ba.Notes)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ba.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ba.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BundleAssemblies is a parsable slice of BundleAssembly.
+type BundleAssemblies []*BundleAssembly
+
+func (ba BundleAssemblies) config(cfg config) {
+	for _i := range ba {
+		ba[_i].config = cfg
+	}
+}
@@ -0,0 +1,64 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bundleassembly
+
+import (
+	"Veritasbackend/pkg/money"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the bundleassembly type in the database.
+	Label = "bundle_assembly"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldBundleID holds the string denoting the bundle_id field in the database.
+	FieldBundleID = "bundle_id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldUnitCost holds the string denoting the unit_cost field in the database.
+	FieldUnitCost = "unit_cost"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the bundleassembly in the database.
+	Table = "bundle_assemblies"
+)
+
+// Columns holds all SQL columns for bundleassembly fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldBundleID,
+	FieldQuantity,
+	FieldUnitCost,
+	FieldNotes,
+	FieldUserID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(float64) error
+	// DefaultUnitCost holds the default value on creation for the "unit_cost" field.
+	DefaultUnitCost money.Amount
+	// UnitCostValidator is a validator for the "unit_cost" field. It is called by the builders before save.
+	UnitCostValidator func(int64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,744 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bundleassembly
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// BundleID applies equality check predicate on the "bundle_id" field. It's identical to BundleIDEQ.
+func BundleID(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBundleID), v))
+	})
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v float64) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// UnitCost applies equality check predicate on the "unit_cost" field. It's identical to UnitCostEQ.
+func UnitCost(v money.Amount) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNotes), v))
+	})
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.BundleAssembly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.BundleAssembly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// BundleIDEQ applies the EQ predicate on the "bundle_id" field.
+func BundleIDEQ(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBundleID), v))
+	})
+}
+
+// BundleIDNEQ applies the NEQ predicate on the "bundle_id" field.
+func BundleIDNEQ(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBundleID), v))
+	})
+}
+
+// BundleIDIn applies the In predicate on the "bundle_id" field.
+func BundleIDIn(vs ...int) predicate.BundleAssembly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBundleID), v...))
+	})
+}
+
+// BundleIDNotIn applies the NotIn predicate on the "bundle_id" field.
+func BundleIDNotIn(vs ...int) predicate.BundleAssembly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBundleID), v...))
+	})
+}
+
+// BundleIDGT applies the GT predicate on the "bundle_id" field.
+func BundleIDGT(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBundleID), v))
+	})
+}
+
+// BundleIDGTE applies the GTE predicate on the "bundle_id" field.
+func BundleIDGTE(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBundleID), v))
+	})
+}
+
+// BundleIDLT applies the LT predicate on the "bundle_id" field.
+func BundleIDLT(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBundleID), v))
+	})
+}
+
+// BundleIDLTE applies the LTE predicate on the "bundle_id" field.
+func BundleIDLTE(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBundleID), v))
+	})
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v float64) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v float64) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...float64) predicate.BundleAssembly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...float64) predicate.BundleAssembly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v float64) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v float64) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v float64) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v float64) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldQuantity), v))
+	})
+}
+
+// UnitCostEQ applies the EQ predicate on the "unit_cost" field.
+func UnitCostEQ(v money.Amount) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostNEQ applies the NEQ predicate on the "unit_cost" field.
+func UnitCostNEQ(v money.Amount) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostIn applies the In predicate on the "unit_cost" field.
+func UnitCostIn(vs ...money.Amount) predicate.BundleAssembly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUnitCost), v...))
+	})
+}
+
+// UnitCostNotIn applies the NotIn predicate on the "unit_cost" field.
+func UnitCostNotIn(vs ...money.Amount) predicate.BundleAssembly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUnitCost), v...))
+	})
+}
+
+// UnitCostGT applies the GT predicate on the "unit_cost" field.
+func UnitCostGT(v money.Amount) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostGTE applies the GTE predicate on the "unit_cost" field.
+func UnitCostGTE(v money.Amount) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostLT applies the LT predicate on the "unit_cost" field.
+func UnitCostLT(v money.Amount) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostLTE applies the LTE predicate on the "unit_cost" field.
+func UnitCostLTE(v money.Amount) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUnitCost), v))
+	})
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNotes), v))
+	})
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNotes), v))
+	})
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.BundleAssembly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNotes), v...))
+	})
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.BundleAssembly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNotes), v...))
+	})
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNotes), v))
+	})
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNotes), v))
+	})
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNotes), v))
+	})
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNotes), v))
+	})
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNotes), v))
+	})
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNotes), v))
+	})
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNotes), v))
+	})
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNotes)))
+	})
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNotes)))
+	})
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNotes), v))
+	})
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNotes), v))
+	})
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.BundleAssembly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.BundleAssembly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserID), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BundleAssembly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BundleAssembly {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BundleAssembly) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BundleAssembly) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BundleAssembly) predicate.BundleAssembly {
+	return predicate.BundleAssembly(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
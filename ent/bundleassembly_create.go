@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/bundleassembly"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BundleAssemblyCreate is the builder for creating a BundleAssembly entity.
+type BundleAssemblyCreate struct {
+	config
+	mutation *BundleAssemblyMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (bac *BundleAssemblyCreate) SetTenantID(i int) *BundleAssemblyCreate {
+	bac.mutation.SetTenantID(i)
+	return bac
+}
+
+// SetBundleID sets the "bundle_id" field.
+func (bac *BundleAssemblyCreate) SetBundleID(i int) *BundleAssemblyCreate {
+	bac.mutation.SetBundleID(i)
+	return bac
+}
+
+// SetQuantity sets the "quantity" field.
+func (bac *BundleAssemblyCreate) SetQuantity(f float64) *BundleAssemblyCreate {
+	bac.mutation.SetQuantity(f)
+	return bac
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (bac *BundleAssemblyCreate) SetUnitCost(m money.Amount) *BundleAssemblyCreate {
+	bac.mutation.SetUnitCost(m)
+	return bac
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (bac *BundleAssemblyCreate) SetNillableUnitCost(m *money.Amount) *BundleAssemblyCreate {
+	if m != nil {
+		bac.SetUnitCost(*m)
+	}
+	return bac
+}
+
+// SetNotes sets the "notes" field.
+func (bac *BundleAssemblyCreate) SetNotes(s string) *BundleAssemblyCreate {
+	bac.mutation.SetNotes(s)
+	return bac
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (bac *BundleAssemblyCreate) SetNillableNotes(s *string) *BundleAssemblyCreate {
+	if s != nil {
+		bac.SetNotes(*s)
+	}
+	return bac
+}
+
+// SetUserID sets the "user_id" field.
+func (bac *BundleAssemblyCreate) SetUserID(i int) *BundleAssemblyCreate {
+	bac.mutation.SetUserID(i)
+	return bac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bac *BundleAssemblyCreate) SetCreatedAt(t time.Time) *BundleAssemblyCreate {
+	bac.mutation.SetCreatedAt(t)
+	return bac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bac *BundleAssemblyCreate) SetNillableCreatedAt(t *time.Time) *BundleAssemblyCreate {
+	if t != nil {
+		bac.SetCreatedAt(*t)
+	}
+	return bac
+}
+
+// Mutation returns the BundleAssemblyMutation object of the builder.
+func (bac *BundleAssemblyCreate) Mutation() *BundleAssemblyMutation {
+	return bac.mutation
+}
+
+// Save creates the BundleAssembly in the database.
+func (bac *BundleAssemblyCreate) Save(ctx context.Context) (*BundleAssembly, error) {
+	var (
+		err  error
+		node *BundleAssembly
+	)
+	bac.defaults()
+	if len(bac.hooks) == 0 {
+		if err = bac.check(); err != nil {
+			return nil, err
+		}
+		node, err = bac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BundleAssemblyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bac.check(); err != nil {
+				return nil, err
+			}
+			bac.mutation = mutation
+			if node, err = bac.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bac.hooks) - 1; i >= 0; i-- {
+			if bac.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bac.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, bac.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*BundleAssembly)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from BundleAssemblyMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bac *BundleAssemblyCreate) SaveX(ctx context.Context) *BundleAssembly {
+	v, err := bac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bac *BundleAssemblyCreate) Exec(ctx context.Context) error {
+	_, err := bac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bac *BundleAssemblyCreate) ExecX(ctx context.Context) {
+	if err := bac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bac *BundleAssemblyCreate) defaults() {
+	if _, ok := bac.mutation.UnitCost(); !ok {
+		v := bundleassembly.DefaultUnitCost
+		bac.mutation.SetUnitCost(v)
+	}
+	if _, ok := bac.mutation.CreatedAt(); !ok {
+		v := bundleassembly.DefaultCreatedAt()
+		bac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bac *BundleAssemblyCreate) check() error {
+	if _, ok := bac.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "BundleAssembly.tenant_id"`)}
+	}
+	if _, ok := bac.mutation.BundleID(); !ok {
+		return &ValidationError{Name: "bundle_id", err: errors.New(`ent: missing required field "BundleAssembly.bundle_id"`)}
+	}
+	if _, ok := bac.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "BundleAssembly.quantity"`)}
+	}
+	if v, ok := bac.mutation.Quantity(); ok {
+		if err := bundleassembly.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BundleAssembly.quantity": %w`, err)}
+		}
+	}
+	if _, ok := bac.mutation.UnitCost(); !ok {
+		return &ValidationError{Name: "unit_cost", err: errors.New(`ent: missing required field "BundleAssembly.unit_cost"`)}
+	}
+	if v, ok := bac.mutation.UnitCost(); ok {
+		if err := bundleassembly.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "BundleAssembly.unit_cost": %w`, err)}
+		}
+	}
+	if _, ok := bac.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "BundleAssembly.user_id"`)}
+	}
+	if _, ok := bac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BundleAssembly.created_at"`)}
+	}
+	return nil
+}
+
+func (bac *BundleAssemblyCreate) sqlSave(ctx context.Context) (*BundleAssembly, error) {
+	_node, _spec := bac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (bac *BundleAssemblyCreate) createSpec() (*BundleAssembly, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BundleAssembly{config: bac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: bundleassembly.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bundleassembly.FieldID,
+			},
+		}
+	)
+	if value, ok := bac.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassembly.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := bac.mutation.BundleID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassembly.FieldBundleID,
+		})
+		_node.BundleID = value
+	}
+	if value, ok := bac.mutation.Quantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundleassembly.FieldQuantity,
+		})
+		_node.Quantity = value
+	}
+	if value, ok := bac.mutation.UnitCost(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: bundleassembly.FieldUnitCost,
+		})
+		_node.UnitCost = value
+	}
+	if value, ok := bac.mutation.Notes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: bundleassembly.FieldNotes,
+		})
+		_node.Notes = value
+	}
+	if value, ok := bac.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassembly.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := bac.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: bundleassembly.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// BundleAssemblyCreateBulk is the builder for creating many BundleAssembly entities in bulk.
+type BundleAssemblyCreateBulk struct {
+	config
+	builders []*BundleAssemblyCreate
+}
+
+// Save creates the BundleAssembly entities in the database.
+func (bacb *BundleAssemblyCreateBulk) Save(ctx context.Context) ([]*BundleAssembly, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(bacb.builders))
+	nodes := make([]*BundleAssembly, len(bacb.builders))
+	mutators := make([]Mutator, len(bacb.builders))
+	for i := range bacb.builders {
+		func(i int, root context.Context) {
+			builder := bacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BundleAssemblyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bacb *BundleAssemblyCreateBulk) SaveX(ctx context.Context) []*BundleAssembly {
+	v, err := bacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bacb *BundleAssemblyCreateBulk) Exec(ctx context.Context) error {
+	_, err := bacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bacb *BundleAssemblyCreateBulk) ExecX(ctx context.Context) {
+	if err := bacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
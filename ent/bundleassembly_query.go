@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/bundleassembly"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BundleAssemblyQuery is the builder for querying BundleAssembly entities.
+type BundleAssemblyQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.BundleAssembly
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BundleAssemblyQuery builder.
+func (baq *BundleAssemblyQuery) Where(ps ...predicate.BundleAssembly) *BundleAssemblyQuery {
+	baq.predicates = append(baq.predicates, ps...)
+	return baq
+}
+
+// Limit adds a limit step to the query.
+func (baq *BundleAssemblyQuery) Limit(limit int) *BundleAssemblyQuery {
+	baq.limit = &limit
+	return baq
+}
+
+// Offset adds an offset step to the query.
+func (baq *BundleAssemblyQuery) Offset(offset int) *BundleAssemblyQuery {
+	baq.offset = &offset
+	return baq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (baq *BundleAssemblyQuery) Unique(unique bool) *BundleAssemblyQuery {
+	baq.unique = &unique
+	return baq
+}
+
+// Order adds an order step to the query.
+func (baq *BundleAssemblyQuery) Order(o ...OrderFunc) *BundleAssemblyQuery {
+	baq.order = append(baq.order, o...)
+	return baq
+}
+
+// First returns the first BundleAssembly entity from the query.
+// Returns a *NotFoundError when no BundleAssembly was found.
+func (baq *BundleAssemblyQuery) First(ctx context.Context) (*BundleAssembly, error) {
+	nodes, err := baq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bundleassembly.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (baq *BundleAssemblyQuery) FirstX(ctx context.Context) *BundleAssembly {
+	node, err := baq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BundleAssembly ID from the query.
+// Returns a *NotFoundError when no BundleAssembly ID was found.
+func (baq *BundleAssemblyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = baq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bundleassembly.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (baq *BundleAssemblyQuery) FirstIDX(ctx context.Context) int {
+	id, err := baq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BundleAssembly entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BundleAssembly entity is found.
+// Returns a *NotFoundError when no BundleAssembly entities are found.
+func (baq *BundleAssemblyQuery) Only(ctx context.Context) (*BundleAssembly, error) {
+	nodes, err := baq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bundleassembly.Label}
+	default:
+		return nil, &NotSingularError{bundleassembly.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (baq *BundleAssemblyQuery) OnlyX(ctx context.Context) *BundleAssembly {
+	node, err := baq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BundleAssembly ID in the query.
+// Returns a *NotSingularError when more than one BundleAssembly ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (baq *BundleAssemblyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = baq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bundleassembly.Label}
+	default:
+		err = &NotSingularError{bundleassembly.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (baq *BundleAssemblyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := baq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BundleAssemblies.
+func (baq *BundleAssemblyQuery) All(ctx context.Context) ([]*BundleAssembly, error) {
+	if err := baq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return baq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (baq *BundleAssemblyQuery) AllX(ctx context.Context) []*BundleAssembly {
+	nodes, err := baq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BundleAssembly IDs.
+func (baq *BundleAssemblyQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := baq.Select(bundleassembly.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (baq *BundleAssemblyQuery) IDsX(ctx context.Context) []int {
+	ids, err := baq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (baq *BundleAssemblyQuery) Count(ctx context.Context) (int, error) {
+	if err := baq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return baq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (baq *BundleAssemblyQuery) CountX(ctx context.Context) int {
+	count, err := baq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (baq *BundleAssemblyQuery) Exist(ctx context.Context) (bool, error) {
+	if err := baq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return baq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (baq *BundleAssemblyQuery) ExistX(ctx context.Context) bool {
+	exist, err := baq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BundleAssemblyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (baq *BundleAssemblyQuery) Clone() *BundleAssemblyQuery {
+	if baq == nil {
+		return nil
+	}
+	return &BundleAssemblyQuery{
+		config:     baq.config,
+		limit:      baq.limit,
+		offset:     baq.offset,
+		order:      append([]OrderFunc{}, baq.order...),
+		predicates: append([]predicate.BundleAssembly{}, baq.predicates...),
+		// clone intermediate query.
+		sql:    baq.sql.Clone(),
+		path:   baq.path,
+		unique: baq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BundleAssembly.Query().
+//		GroupBy(bundleassembly.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (baq *BundleAssemblyQuery) GroupBy(field string, fields ...string) *BundleAssemblyGroupBy {
+	grbuild := &BundleAssemblyGroupBy{config: baq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := baq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return baq.sqlQuery(ctx), nil
+	}
+	grbuild.label = bundleassembly.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.BundleAssembly.Query().
+//		Select(bundleassembly.FieldTenantID).
+//		Scan(ctx, &v)
+func (baq *BundleAssemblyQuery) Select(fields ...string) *BundleAssemblySelect {
+	baq.fields = append(baq.fields, fields...)
+	selbuild := &BundleAssemblySelect{BundleAssemblyQuery: baq}
+	selbuild.label = bundleassembly.Label
+	selbuild.flds, selbuild.scan = &baq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (baq *BundleAssemblyQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range baq.fields {
+		if !bundleassembly.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if baq.path != nil {
+		prev, err := baq.path(ctx)
+		if err != nil {
+			return err
+		}
+		baq.sql = prev
+	}
+	return nil
+}
+
+func (baq *BundleAssemblyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BundleAssembly, error) {
+	var (
+		nodes = []*BundleAssembly{}
+		_spec = baq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*BundleAssembly).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &BundleAssembly{config: baq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(baq.modifiers) > 0 {
+		_spec.Modifiers = baq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, baq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (baq *BundleAssemblyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := baq.querySpec()
+	if len(baq.modifiers) > 0 {
+		_spec.Modifiers = baq.modifiers
+	}
+	_spec.Node.Columns = baq.fields
+	if len(baq.fields) > 0 {
+		_spec.Unique = baq.unique != nil && *baq.unique
+	}
+	return sqlgraph.CountNodes(ctx, baq.driver, _spec)
+}
+
+func (baq *BundleAssemblyQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := baq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (baq *BundleAssemblyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   bundleassembly.Table,
+			Columns: bundleassembly.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bundleassembly.FieldID,
+			},
+		},
+		From:   baq.sql,
+		Unique: true,
+	}
+	if unique := baq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := baq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bundleassembly.FieldID)
+		for i := range fields {
+			if fields[i] != bundleassembly.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := baq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := baq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := baq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := baq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (baq *BundleAssemblyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(baq.driver.Dialect())
+	t1 := builder.Table(bundleassembly.Table)
+	columns := baq.fields
+	if len(columns) == 0 {
+		columns = bundleassembly.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if baq.sql != nil {
+		selector = baq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if baq.unique != nil && *baq.unique {
+		selector.Distinct()
+	}
+	for _, m := range baq.modifiers {
+		m(selector)
+	}
+	for _, p := range baq.predicates {
+		p(selector)
+	}
+	for _, p := range baq.order {
+		p(selector)
+	}
+	if offset := baq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := baq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (baq *BundleAssemblyQuery) ForUpdate(opts ...sql.LockOption) *BundleAssemblyQuery {
+	if baq.driver.Dialect() == dialect.Postgres {
+		baq.Unique(false)
+	}
+	baq.modifiers = append(baq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return baq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (baq *BundleAssemblyQuery) ForShare(opts ...sql.LockOption) *BundleAssemblyQuery {
+	if baq.driver.Dialect() == dialect.Postgres {
+		baq.Unique(false)
+	}
+	baq.modifiers = append(baq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return baq
+}
+
+// BundleAssemblyGroupBy is the group-by builder for BundleAssembly entities.
+type BundleAssemblyGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bagb *BundleAssemblyGroupBy) Aggregate(fns ...AggregateFunc) *BundleAssemblyGroupBy {
+	bagb.fns = append(bagb.fns, fns...)
+	return bagb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (bagb *BundleAssemblyGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := bagb.path(ctx)
+	if err != nil {
+		return err
+	}
+	bagb.sql = query
+	return bagb.sqlScan(ctx, v)
+}
+
+func (bagb *BundleAssemblyGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range bagb.fields {
+		if !bundleassembly.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := bagb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bagb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (bagb *BundleAssemblyGroupBy) sqlQuery() *sql.Selector {
+	selector := bagb.sql.Select()
+	aggregation := make([]string, 0, len(bagb.fns))
+	for _, fn := range bagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(bagb.fields)+len(bagb.fns))
+		for _, f := range bagb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(bagb.fields...)...)
+}
+
+// BundleAssemblySelect is the builder for selecting fields of BundleAssembly entities.
+type BundleAssemblySelect struct {
+	*BundleAssemblyQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bas *BundleAssemblySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := bas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	bas.sql = bas.BundleAssemblyQuery.sqlQuery(ctx)
+	return bas.sqlScan(ctx, v)
+}
+
+func (bas *BundleAssemblySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := bas.sql.Query()
+	if err := bas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
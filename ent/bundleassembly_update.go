@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/bundleassembly"
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BundleAssemblyUpdate is the builder for updating BundleAssembly entities.
+type BundleAssemblyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BundleAssemblyMutation
+}
+
+// Where appends a list predicates to the BundleAssemblyUpdate builder.
+func (bau *BundleAssemblyUpdate) Where(ps ...predicate.BundleAssembly) *BundleAssemblyUpdate {
+	bau.mutation.Where(ps...)
+	return bau
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (bau *BundleAssemblyUpdate) SetTenantID(i int) *BundleAssemblyUpdate {
+	bau.mutation.ResetTenantID()
+	bau.mutation.SetTenantID(i)
+	return bau
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (bau *BundleAssemblyUpdate) AddTenantID(i int) *BundleAssemblyUpdate {
+	bau.mutation.AddTenantID(i)
+	return bau
+}
+
+// SetBundleID sets the "bundle_id" field.
+func (bau *BundleAssemblyUpdate) SetBundleID(i int) *BundleAssemblyUpdate {
+	bau.mutation.ResetBundleID()
+	bau.mutation.SetBundleID(i)
+	return bau
+}
+
+// AddBundleID adds i to the "bundle_id" field.
+func (bau *BundleAssemblyUpdate) AddBundleID(i int) *BundleAssemblyUpdate {
+	bau.mutation.AddBundleID(i)
+	return bau
+}
+
+// SetQuantity sets the "quantity" field.
+func (bau *BundleAssemblyUpdate) SetQuantity(f float64) *BundleAssemblyUpdate {
+	bau.mutation.ResetQuantity()
+	bau.mutation.SetQuantity(f)
+	return bau
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (bau *BundleAssemblyUpdate) AddQuantity(f float64) *BundleAssemblyUpdate {
+	bau.mutation.AddQuantity(f)
+	return bau
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (bau *BundleAssemblyUpdate) SetUnitCost(m money.Amount) *BundleAssemblyUpdate {
+	bau.mutation.ResetUnitCost()
+	bau.mutation.SetUnitCost(m)
+	return bau
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (bau *BundleAssemblyUpdate) SetNillableUnitCost(m *money.Amount) *BundleAssemblyUpdate {
+	if m != nil {
+		bau.SetUnitCost(*m)
+	}
+	return bau
+}
+
+// AddUnitCost adds m to the "unit_cost" field.
+func (bau *BundleAssemblyUpdate) AddUnitCost(m money.Amount) *BundleAssemblyUpdate {
+	bau.mutation.AddUnitCost(m)
+	return bau
+}
+
+// SetNotes sets the "notes" field.
+func (bau *BundleAssemblyUpdate) SetNotes(s string) *BundleAssemblyUpdate {
+	bau.mutation.SetNotes(s)
+	return bau
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (bau *BundleAssemblyUpdate) SetNillableNotes(s *string) *BundleAssemblyUpdate {
+	if s != nil {
+		bau.SetNotes(*s)
+	}
+	return bau
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (bau *BundleAssemblyUpdate) ClearNotes() *BundleAssemblyUpdate {
+	bau.mutation.ClearNotes()
+	return bau
+}
+
+// SetUserID sets the "user_id" field.
+func (bau *BundleAssemblyUpdate) SetUserID(i int) *BundleAssemblyUpdate {
+	bau.mutation.ResetUserID()
+	bau.mutation.SetUserID(i)
+	return bau
+}
+
+// AddUserID adds i to the "user_id" field.
+func (bau *BundleAssemblyUpdate) AddUserID(i int) *BundleAssemblyUpdate {
+	bau.mutation.AddUserID(i)
+	return bau
+}
+
+// Mutation returns the BundleAssemblyMutation object of the builder.
+func (bau *BundleAssemblyUpdate) Mutation() *BundleAssemblyMutation {
+	return bau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bau *BundleAssemblyUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(bau.hooks) == 0 {
+		if err = bau.check(); err != nil {
+			return 0, err
+		}
+		affected, err = bau.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BundleAssemblyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bau.check(); err != nil {
+				return 0, err
+			}
+			bau.mutation = mutation
+			affected, err = bau.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(bau.hooks) - 1; i >= 0; i-- {
+			if bau.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bau.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bau.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bau *BundleAssemblyUpdate) SaveX(ctx context.Context) int {
+	affected, err := bau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bau *BundleAssemblyUpdate) Exec(ctx context.Context) error {
+	_, err := bau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bau *BundleAssemblyUpdate) ExecX(ctx context.Context) {
+	if err := bau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bau *BundleAssemblyUpdate) check() error {
+	if v, ok := bau.mutation.Quantity(); ok {
+		if err := bundleassembly.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BundleAssembly.quantity": %w`, err)}
+		}
+	}
+	if v, ok := bau.mutation.UnitCost(); ok {
+		if err := bundleassembly.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "BundleAssembly.unit_cost": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bau *BundleAssemblyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   bundleassembly.Table,
+			Columns: bundleassembly.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bundleassembly.FieldID,
+			},
+		},
+	}
+	if ps := bau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bau.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassembly.FieldTenantID,
+		})
+	}
+	if value, ok := bau.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassembly.FieldTenantID,
+		})
+	}
+	if value, ok := bau.mutation.BundleID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassembly.FieldBundleID,
+		})
+	}
+	if value, ok := bau.mutation.AddedBundleID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassembly.FieldBundleID,
+		})
+	}
+	if value, ok := bau.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundleassembly.FieldQuantity,
+		})
+	}
+	if value, ok := bau.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundleassembly.FieldQuantity,
+		})
+	}
+	if value, ok := bau.mutation.UnitCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: bundleassembly.FieldUnitCost,
+		})
+	}
+	if value, ok := bau.mutation.AddedUnitCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: bundleassembly.FieldUnitCost,
+		})
+	}
+	if value, ok := bau.mutation.Notes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: bundleassembly.FieldNotes,
+		})
+	}
+	if bau.mutation.NotesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: bundleassembly.FieldNotes,
+		})
+	}
+	if value, ok := bau.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassembly.FieldUserID,
+		})
+	}
+	if value, ok := bau.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassembly.FieldUserID,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bundleassembly.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// BundleAssemblyUpdateOne is the builder for updating a single BundleAssembly entity.
+type BundleAssemblyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BundleAssemblyMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (bauo *BundleAssemblyUpdateOne) SetTenantID(i int) *BundleAssemblyUpdateOne {
+	bauo.mutation.ResetTenantID()
+	bauo.mutation.SetTenantID(i)
+	return bauo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (bauo *BundleAssemblyUpdateOne) AddTenantID(i int) *BundleAssemblyUpdateOne {
+	bauo.mutation.AddTenantID(i)
+	return bauo
+}
+
+// SetBundleID sets the "bundle_id" field.
+func (bauo *BundleAssemblyUpdateOne) SetBundleID(i int) *BundleAssemblyUpdateOne {
+	bauo.mutation.ResetBundleID()
+	bauo.mutation.SetBundleID(i)
+	return bauo
+}
+
+// AddBundleID adds i to the "bundle_id" field.
+func (bauo *BundleAssemblyUpdateOne) AddBundleID(i int) *BundleAssemblyUpdateOne {
+	bauo.mutation.AddBundleID(i)
+	return bauo
+}
+
+// SetQuantity sets the "quantity" field.
+func (bauo *BundleAssemblyUpdateOne) SetQuantity(f float64) *BundleAssemblyUpdateOne {
+	bauo.mutation.ResetQuantity()
+	bauo.mutation.SetQuantity(f)
+	return bauo
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (bauo *BundleAssemblyUpdateOne) AddQuantity(f float64) *BundleAssemblyUpdateOne {
+	bauo.mutation.AddQuantity(f)
+	return bauo
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (bauo *BundleAssemblyUpdateOne) SetUnitCost(m money.Amount) *BundleAssemblyUpdateOne {
+	bauo.mutation.ResetUnitCost()
+	bauo.mutation.SetUnitCost(m)
+	return bauo
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (bauo *BundleAssemblyUpdateOne) SetNillableUnitCost(m *money.Amount) *BundleAssemblyUpdateOne {
+	if m != nil {
+		bauo.SetUnitCost(*m)
+	}
+	return bauo
+}
+
+// AddUnitCost adds m to the "unit_cost" field.
+func (bauo *BundleAssemblyUpdateOne) AddUnitCost(m money.Amount) *BundleAssemblyUpdateOne {
+	bauo.mutation.AddUnitCost(m)
+	return bauo
+}
+
+// SetNotes sets the "notes" field.
+func (bauo *BundleAssemblyUpdateOne) SetNotes(s string) *BundleAssemblyUpdateOne {
+	bauo.mutation.SetNotes(s)
+	return bauo
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (bauo *BundleAssemblyUpdateOne) SetNillableNotes(s *string) *BundleAssemblyUpdateOne {
+	if s != nil {
+		bauo.SetNotes(*s)
+	}
+	return bauo
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (bauo *BundleAssemblyUpdateOne) ClearNotes() *BundleAssemblyUpdateOne {
+	bauo.mutation.ClearNotes()
+	return bauo
+}
+
+// SetUserID sets the "user_id" field.
+func (bauo *BundleAssemblyUpdateOne) SetUserID(i int) *BundleAssemblyUpdateOne {
+	bauo.mutation.ResetUserID()
+	bauo.mutation.SetUserID(i)
+	return bauo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (bauo *BundleAssemblyUpdateOne) AddUserID(i int) *BundleAssemblyUpdateOne {
+	bauo.mutation.AddUserID(i)
+	return bauo
+}
+
+// Mutation returns the BundleAssemblyMutation object of the builder.
+func (bauo *BundleAssemblyUpdateOne) Mutation() *BundleAssemblyMutation {
+	return bauo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bauo *BundleAssemblyUpdateOne) Select(field string, fields ...string) *BundleAssemblyUpdateOne {
+	bauo.fields = append([]string{field}, fields...)
+	return bauo
+}
+
+// Save executes the query and returns the updated BundleAssembly entity.
+func (bauo *BundleAssemblyUpdateOne) Save(ctx context.Context) (*BundleAssembly, error) {
+	var (
+		err  error
+		node *BundleAssembly
+	)
+	if len(bauo.hooks) == 0 {
+		if err = bauo.check(); err != nil {
+			return nil, err
+		}
+		node, err = bauo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BundleAssemblyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bauo.check(); err != nil {
+				return nil, err
+			}
+			bauo.mutation = mutation
+			node, err = bauo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bauo.hooks) - 1; i >= 0; i-- {
+			if bauo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bauo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, bauo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*BundleAssembly)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from BundleAssemblyMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bauo *BundleAssemblyUpdateOne) SaveX(ctx context.Context) *BundleAssembly {
+	node, err := bauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bauo *BundleAssemblyUpdateOne) Exec(ctx context.Context) error {
+	_, err := bauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bauo *BundleAssemblyUpdateOne) ExecX(ctx context.Context) {
+	if err := bauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bauo *BundleAssemblyUpdateOne) check() error {
+	if v, ok := bauo.mutation.Quantity(); ok {
+		if err := bundleassembly.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BundleAssembly.quantity": %w`, err)}
+		}
+	}
+	if v, ok := bauo.mutation.UnitCost(); ok {
+		if err := bundleassembly.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "BundleAssembly.unit_cost": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bauo *BundleAssemblyUpdateOne) sqlSave(ctx context.Context) (_node *BundleAssembly, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   bundleassembly.Table,
+			Columns: bundleassembly.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bundleassembly.FieldID,
+			},
+		},
+	}
+	id, ok := bauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BundleAssembly.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bundleassembly.FieldID)
+		for _, f := range fields {
+			if !bundleassembly.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bundleassembly.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bauo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassembly.FieldTenantID,
+		})
+	}
+	if value, ok := bauo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassembly.FieldTenantID,
+		})
+	}
+	if value, ok := bauo.mutation.BundleID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassembly.FieldBundleID,
+		})
+	}
+	if value, ok := bauo.mutation.AddedBundleID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassembly.FieldBundleID,
+		})
+	}
+	if value, ok := bauo.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundleassembly.FieldQuantity,
+		})
+	}
+	if value, ok := bauo.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundleassembly.FieldQuantity,
+		})
+	}
+	if value, ok := bauo.mutation.UnitCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: bundleassembly.FieldUnitCost,
+		})
+	}
+	if value, ok := bauo.mutation.AddedUnitCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: bundleassembly.FieldUnitCost,
+		})
+	}
+	if value, ok := bauo.mutation.Notes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: bundleassembly.FieldNotes,
+		})
+	}
+	if bauo.mutation.NotesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: bundleassembly.FieldNotes,
+		})
+	}
+	if value, ok := bauo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassembly.FieldUserID,
+		})
+	}
+	if value, ok := bauo.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassembly.FieldUserID,
+		})
+	}
+	_node = &BundleAssembly{config: bauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bundleassembly.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,135 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/bundleassemblyline"
+	"Veritasbackend/pkg/money"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// BundleAssemblyLine is the model entity for the BundleAssemblyLine schema.
+type BundleAssemblyLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del armado
+	AssemblyID int `json:"assembly_id,omitempty"`
+	// ID del componente consumido
+	ProductID int `json:"product_id,omitempty"`
+	// Cantidad consumida del componente, en su unidad base
+	Quantity float64 `json:"quantity,omitempty"`
+	// Costo unitario con que salió el componente
+	UnitCost money.Amount `json:"unit_cost,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BundleAssemblyLine) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bundleassemblyline.FieldUnitCost:
+			values[i] = new(money.Amount)
+		case bundleassemblyline.FieldQuantity:
+			values[i] = new(sql.NullFloat64)
+		case bundleassemblyline.FieldID, bundleassemblyline.FieldAssemblyID, bundleassemblyline.FieldProductID:
+			values[i] = new(sql.NullInt64)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type BundleAssemblyLine", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BundleAssemblyLine fields.
+func (bal *BundleAssemblyLine) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bundleassemblyline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bal.ID = int(value.Int64)
+		case bundleassemblyline.FieldAssemblyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field assembly_id", values[i])
+			} else if value.Valid {
+				bal.AssemblyID = int(value.Int64)
+			}
+		case bundleassemblyline.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				bal.ProductID = int(value.Int64)
+			}
+		case bundleassemblyline.FieldQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				bal.Quantity = value.Float64
+			}
+		case bundleassemblyline.FieldUnitCost:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_cost", values[i])
+			} else if value != nil {
+				bal.UnitCost = *value
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this BundleAssemblyLine.
+// Note that you need to call BundleAssemblyLine.Unwrap() before calling this method if this BundleAssemblyLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bal *BundleAssemblyLine) Update() *BundleAssemblyLineUpdateOne {
+	return (&BundleAssemblyLineClient{config: bal.config}).UpdateOne(bal)
+}
+
+// Unwrap unwraps the BundleAssemblyLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bal *BundleAssemblyLine) Unwrap() *BundleAssemblyLine {
+	_tx, ok := bal.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BundleAssemblyLine is not a transactional entity")
+	}
+	bal.config.driver = _tx.drv
+	return bal
+}
+
+// String implements the fmt.Stringer.
+func (bal *BundleAssemblyLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("BundleAssemblyLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bal.ID))
+	builder.WriteString("assembly_id=")
+	builder.WriteString(fmt.Sprintf("%v", bal.AssemblyID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", bal.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", bal.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("unit_cost=")
+	builder.WriteString(fmt.Sprintf("%v", bal.UnitCost))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BundleAssemblyLines is a parsable slice of BundleAssemblyLine.
+type BundleAssemblyLines []*BundleAssemblyLine
+
+func (bal BundleAssemblyLines) config(cfg config) {
+	for _i := range bal {
+		bal[_i].config = cfg
+	}
+}
@@ -0,0 +1,52 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bundleassemblyline
+
+import (
+	"Veritasbackend/pkg/money"
+)
+
+const (
+	// Label holds the string label denoting the bundleassemblyline type in the database.
+	Label = "bundle_assembly_line"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAssemblyID holds the string denoting the assembly_id field in the database.
+	FieldAssemblyID = "assembly_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldUnitCost holds the string denoting the unit_cost field in the database.
+	FieldUnitCost = "unit_cost"
+	// Table holds the table name of the bundleassemblyline in the database.
+	Table = "bundle_assembly_lines"
+)
+
+// Columns holds all SQL columns for bundleassemblyline fields.
+var Columns = []string{
+	FieldID,
+	FieldAssemblyID,
+	FieldProductID,
+	FieldQuantity,
+	FieldUnitCost,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(float64) error
+	// DefaultUnitCost holds the default value on creation for the "unit_cost" field.
+	DefaultUnitCost money.Amount
+	// UnitCostValidator is a validator for the "unit_cost" field. It is called by the builders before save.
+	UnitCostValidator func(int64) error
+)
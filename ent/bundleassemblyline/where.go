@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bundleassemblyline
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// AssemblyID applies equality check predicate on the "assembly_id" field. It's identical to AssemblyIDEQ.
+func AssemblyID(v int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAssemblyID), v))
+	})
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v float64) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// UnitCost applies equality check predicate on the "unit_cost" field. It's identical to UnitCostEQ.
+func UnitCost(v money.Amount) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// AssemblyIDEQ applies the EQ predicate on the "assembly_id" field.
+func AssemblyIDEQ(v int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAssemblyID), v))
+	})
+}
+
+// AssemblyIDNEQ applies the NEQ predicate on the "assembly_id" field.
+func AssemblyIDNEQ(v int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAssemblyID), v))
+	})
+}
+
+// AssemblyIDIn applies the In predicate on the "assembly_id" field.
+func AssemblyIDIn(vs ...int) predicate.BundleAssemblyLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAssemblyID), v...))
+	})
+}
+
+// AssemblyIDNotIn applies the NotIn predicate on the "assembly_id" field.
+func AssemblyIDNotIn(vs ...int) predicate.BundleAssemblyLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAssemblyID), v...))
+	})
+}
+
+// AssemblyIDGT applies the GT predicate on the "assembly_id" field.
+func AssemblyIDGT(v int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAssemblyID), v))
+	})
+}
+
+// AssemblyIDGTE applies the GTE predicate on the "assembly_id" field.
+func AssemblyIDGTE(v int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAssemblyID), v))
+	})
+}
+
+// AssemblyIDLT applies the LT predicate on the "assembly_id" field.
+func AssemblyIDLT(v int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAssemblyID), v))
+	})
+}
+
+// AssemblyIDLTE applies the LTE predicate on the "assembly_id" field.
+func AssemblyIDLTE(v int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAssemblyID), v))
+	})
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.BundleAssemblyLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.BundleAssemblyLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v int) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProductID), v))
+	})
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v float64) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v float64) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...float64) predicate.BundleAssemblyLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...float64) predicate.BundleAssemblyLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v float64) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v float64) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v float64) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v float64) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldQuantity), v))
+	})
+}
+
+// UnitCostEQ applies the EQ predicate on the "unit_cost" field.
+func UnitCostEQ(v money.Amount) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostNEQ applies the NEQ predicate on the "unit_cost" field.
+func UnitCostNEQ(v money.Amount) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostIn applies the In predicate on the "unit_cost" field.
+func UnitCostIn(vs ...money.Amount) predicate.BundleAssemblyLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUnitCost), v...))
+	})
+}
+
+// UnitCostNotIn applies the NotIn predicate on the "unit_cost" field.
+func UnitCostNotIn(vs ...money.Amount) predicate.BundleAssemblyLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUnitCost), v...))
+	})
+}
+
+// UnitCostGT applies the GT predicate on the "unit_cost" field.
+func UnitCostGT(v money.Amount) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostGTE applies the GTE predicate on the "unit_cost" field.
+func UnitCostGTE(v money.Amount) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostLT applies the LT predicate on the "unit_cost" field.
+func UnitCostLT(v money.Amount) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostLTE applies the LTE predicate on the "unit_cost" field.
+func UnitCostLTE(v money.Amount) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUnitCost), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BundleAssemblyLine) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BundleAssemblyLine) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BundleAssemblyLine) predicate.BundleAssemblyLine {
+	return predicate.BundleAssemblyLine(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/bundleassemblyline"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BundleAssemblyLineCreate is the builder for creating a BundleAssemblyLine entity.
+type BundleAssemblyLineCreate struct {
+	config
+	mutation *BundleAssemblyLineMutation
+	hooks    []Hook
+}
+
+// SetAssemblyID sets the "assembly_id" field.
+func (balc *BundleAssemblyLineCreate) SetAssemblyID(i int) *BundleAssemblyLineCreate {
+	balc.mutation.SetAssemblyID(i)
+	return balc
+}
+
+// SetProductID sets the "product_id" field.
+func (balc *BundleAssemblyLineCreate) SetProductID(i int) *BundleAssemblyLineCreate {
+	balc.mutation.SetProductID(i)
+	return balc
+}
+
+// SetQuantity sets the "quantity" field.
+func (balc *BundleAssemblyLineCreate) SetQuantity(f float64) *BundleAssemblyLineCreate {
+	balc.mutation.SetQuantity(f)
+	return balc
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (balc *BundleAssemblyLineCreate) SetUnitCost(m money.Amount) *BundleAssemblyLineCreate {
+	balc.mutation.SetUnitCost(m)
+	return balc
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (balc *BundleAssemblyLineCreate) SetNillableUnitCost(m *money.Amount) *BundleAssemblyLineCreate {
+	if m != nil {
+		balc.SetUnitCost(*m)
+	}
+	return balc
+}
+
+// Mutation returns the BundleAssemblyLineMutation object of the builder.
+func (balc *BundleAssemblyLineCreate) Mutation() *BundleAssemblyLineMutation {
+	return balc.mutation
+}
+
+// Save creates the BundleAssemblyLine in the database.
+func (balc *BundleAssemblyLineCreate) Save(ctx context.Context) (*BundleAssemblyLine, error) {
+	var (
+		err  error
+		node *BundleAssemblyLine
+	)
+	balc.defaults()
+	if len(balc.hooks) == 0 {
+		if err = balc.check(); err != nil {
+			return nil, err
+		}
+		node, err = balc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BundleAssemblyLineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = balc.check(); err != nil {
+				return nil, err
+			}
+			balc.mutation = mutation
+			if node, err = balc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(balc.hooks) - 1; i >= 0; i-- {
+			if balc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = balc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, balc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*BundleAssemblyLine)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from BundleAssemblyLineMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (balc *BundleAssemblyLineCreate) SaveX(ctx context.Context) *BundleAssemblyLine {
+	v, err := balc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (balc *BundleAssemblyLineCreate) Exec(ctx context.Context) error {
+	_, err := balc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (balc *BundleAssemblyLineCreate) ExecX(ctx context.Context) {
+	if err := balc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (balc *BundleAssemblyLineCreate) defaults() {
+	if _, ok := balc.mutation.UnitCost(); !ok {
+		v := bundleassemblyline.DefaultUnitCost
+		balc.mutation.SetUnitCost(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (balc *BundleAssemblyLineCreate) check() error {
+	if _, ok := balc.mutation.AssemblyID(); !ok {
+		return &ValidationError{Name: "assembly_id", err: errors.New(`ent: missing required field "BundleAssemblyLine.assembly_id"`)}
+	}
+	if _, ok := balc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "BundleAssemblyLine.product_id"`)}
+	}
+	if _, ok := balc.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "BundleAssemblyLine.quantity"`)}
+	}
+	if v, ok := balc.mutation.Quantity(); ok {
+		if err := bundleassemblyline.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BundleAssemblyLine.quantity": %w`, err)}
+		}
+	}
+	if _, ok := balc.mutation.UnitCost(); !ok {
+		return &ValidationError{Name: "unit_cost", err: errors.New(`ent: missing required field "BundleAssemblyLine.unit_cost"`)}
+	}
+	if v, ok := balc.mutation.UnitCost(); ok {
+		if err := bundleassemblyline.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "BundleAssemblyLine.unit_cost": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (balc *BundleAssemblyLineCreate) sqlSave(ctx context.Context) (*BundleAssemblyLine, error) {
+	_node, _spec := balc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, balc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (balc *BundleAssemblyLineCreate) createSpec() (*BundleAssemblyLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BundleAssemblyLine{config: balc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: bundleassemblyline.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bundleassemblyline.FieldID,
+			},
+		}
+	)
+	if value, ok := balc.mutation.AssemblyID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassemblyline.FieldAssemblyID,
+		})
+		_node.AssemblyID = value
+	}
+	if value, ok := balc.mutation.ProductID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassemblyline.FieldProductID,
+		})
+		_node.ProductID = value
+	}
+	if value, ok := balc.mutation.Quantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundleassemblyline.FieldQuantity,
+		})
+		_node.Quantity = value
+	}
+	if value, ok := balc.mutation.UnitCost(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: bundleassemblyline.FieldUnitCost,
+		})
+		_node.UnitCost = value
+	}
+	return _node, _spec
+}
+
+// BundleAssemblyLineCreateBulk is the builder for creating many BundleAssemblyLine entities in bulk.
+type BundleAssemblyLineCreateBulk struct {
+	config
+	builders []*BundleAssemblyLineCreate
+}
+
+// Save creates the BundleAssemblyLine entities in the database.
+func (balcb *BundleAssemblyLineCreateBulk) Save(ctx context.Context) ([]*BundleAssemblyLine, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(balcb.builders))
+	nodes := make([]*BundleAssemblyLine, len(balcb.builders))
+	mutators := make([]Mutator, len(balcb.builders))
+	for i := range balcb.builders {
+		func(i int, root context.Context) {
+			builder := balcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BundleAssemblyLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, balcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, balcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, balcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (balcb *BundleAssemblyLineCreateBulk) SaveX(ctx context.Context) []*BundleAssemblyLine {
+	v, err := balcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (balcb *BundleAssemblyLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := balcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (balcb *BundleAssemblyLineCreateBulk) ExecX(ctx context.Context) {
+	if err := balcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/bundleassemblyline"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BundleAssemblyLineDelete is the builder for deleting a BundleAssemblyLine entity.
+type BundleAssemblyLineDelete struct {
+	config
+	hooks    []Hook
+	mutation *BundleAssemblyLineMutation
+}
+
+// Where appends a list predicates to the BundleAssemblyLineDelete builder.
+func (bald *BundleAssemblyLineDelete) Where(ps ...predicate.BundleAssemblyLine) *BundleAssemblyLineDelete {
+	bald.mutation.Where(ps...)
+	return bald
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bald *BundleAssemblyLineDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(bald.hooks) == 0 {
+		affected, err = bald.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BundleAssemblyLineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			bald.mutation = mutation
+			affected, err = bald.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(bald.hooks) - 1; i >= 0; i-- {
+			if bald.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bald.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bald.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bald *BundleAssemblyLineDelete) ExecX(ctx context.Context) int {
+	n, err := bald.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bald *BundleAssemblyLineDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: bundleassemblyline.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bundleassemblyline.FieldID,
+			},
+		},
+	}
+	if ps := bald.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bald.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// BundleAssemblyLineDeleteOne is the builder for deleting a single BundleAssemblyLine entity.
+type BundleAssemblyLineDeleteOne struct {
+	bald *BundleAssemblyLineDelete
+}
+
+// Exec executes the deletion query.
+func (baldo *BundleAssemblyLineDeleteOne) Exec(ctx context.Context) error {
+	n, err := baldo.bald.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bundleassemblyline.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (baldo *BundleAssemblyLineDeleteOne) ExecX(ctx context.Context) {
+	baldo.bald.ExecX(ctx)
+}
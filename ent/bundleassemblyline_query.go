@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/bundleassemblyline"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BundleAssemblyLineQuery is the builder for querying BundleAssemblyLine entities.
+type BundleAssemblyLineQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.BundleAssemblyLine
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BundleAssemblyLineQuery builder.
+func (balq *BundleAssemblyLineQuery) Where(ps ...predicate.BundleAssemblyLine) *BundleAssemblyLineQuery {
+	balq.predicates = append(balq.predicates, ps...)
+	return balq
+}
+
+// Limit adds a limit step to the query.
+func (balq *BundleAssemblyLineQuery) Limit(limit int) *BundleAssemblyLineQuery {
+	balq.limit = &limit
+	return balq
+}
+
+// Offset adds an offset step to the query.
+func (balq *BundleAssemblyLineQuery) Offset(offset int) *BundleAssemblyLineQuery {
+	balq.offset = &offset
+	return balq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (balq *BundleAssemblyLineQuery) Unique(unique bool) *BundleAssemblyLineQuery {
+	balq.unique = &unique
+	return balq
+}
+
+// Order adds an order step to the query.
+func (balq *BundleAssemblyLineQuery) Order(o ...OrderFunc) *BundleAssemblyLineQuery {
+	balq.order = append(balq.order, o...)
+	return balq
+}
+
+// First returns the first BundleAssemblyLine entity from the query.
+// Returns a *NotFoundError when no BundleAssemblyLine was found.
+func (balq *BundleAssemblyLineQuery) First(ctx context.Context) (*BundleAssemblyLine, error) {
+	nodes, err := balq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bundleassemblyline.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (balq *BundleAssemblyLineQuery) FirstX(ctx context.Context) *BundleAssemblyLine {
+	node, err := balq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BundleAssemblyLine ID from the query.
+// Returns a *NotFoundError when no BundleAssemblyLine ID was found.
+func (balq *BundleAssemblyLineQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = balq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bundleassemblyline.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (balq *BundleAssemblyLineQuery) FirstIDX(ctx context.Context) int {
+	id, err := balq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BundleAssemblyLine entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BundleAssemblyLine entity is found.
+// Returns a *NotFoundError when no BundleAssemblyLine entities are found.
+func (balq *BundleAssemblyLineQuery) Only(ctx context.Context) (*BundleAssemblyLine, error) {
+	nodes, err := balq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bundleassemblyline.Label}
+	default:
+		return nil, &NotSingularError{bundleassemblyline.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (balq *BundleAssemblyLineQuery) OnlyX(ctx context.Context) *BundleAssemblyLine {
+	node, err := balq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BundleAssemblyLine ID in the query.
+// Returns a *NotSingularError when more than one BundleAssemblyLine ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (balq *BundleAssemblyLineQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = balq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bundleassemblyline.Label}
+	default:
+		err = &NotSingularError{bundleassemblyline.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (balq *BundleAssemblyLineQuery) OnlyIDX(ctx context.Context) int {
+	id, err := balq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BundleAssemblyLines.
+func (balq *BundleAssemblyLineQuery) All(ctx context.Context) ([]*BundleAssemblyLine, error) {
+	if err := balq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return balq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (balq *BundleAssemblyLineQuery) AllX(ctx context.Context) []*BundleAssemblyLine {
+	nodes, err := balq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BundleAssemblyLine IDs.
+func (balq *BundleAssemblyLineQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := balq.Select(bundleassemblyline.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (balq *BundleAssemblyLineQuery) IDsX(ctx context.Context) []int {
+	ids, err := balq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (balq *BundleAssemblyLineQuery) Count(ctx context.Context) (int, error) {
+	if err := balq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return balq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (balq *BundleAssemblyLineQuery) CountX(ctx context.Context) int {
+	count, err := balq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (balq *BundleAssemblyLineQuery) Exist(ctx context.Context) (bool, error) {
+	if err := balq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return balq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (balq *BundleAssemblyLineQuery) ExistX(ctx context.Context) bool {
+	exist, err := balq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BundleAssemblyLineQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (balq *BundleAssemblyLineQuery) Clone() *BundleAssemblyLineQuery {
+	if balq == nil {
+		return nil
+	}
+	return &BundleAssemblyLineQuery{
+		config:     balq.config,
+		limit:      balq.limit,
+		offset:     balq.offset,
+		order:      append([]OrderFunc{}, balq.order...),
+		predicates: append([]predicate.BundleAssemblyLine{}, balq.predicates...),
+		// clone intermediate query.
+		sql:    balq.sql.Clone(),
+		path:   balq.path,
+		unique: balq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AssemblyID int `json:"assembly_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BundleAssemblyLine.Query().
+//		GroupBy(bundleassemblyline.FieldAssemblyID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (balq *BundleAssemblyLineQuery) GroupBy(field string, fields ...string) *BundleAssemblyLineGroupBy {
+	grbuild := &BundleAssemblyLineGroupBy{config: balq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := balq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return balq.sqlQuery(ctx), nil
+	}
+	grbuild.label = bundleassemblyline.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AssemblyID int `json:"assembly_id,omitempty"`
+//	}
+//
+//	client.BundleAssemblyLine.Query().
+//		Select(bundleassemblyline.FieldAssemblyID).
+//		Scan(ctx, &v)
+func (balq *BundleAssemblyLineQuery) Select(fields ...string) *BundleAssemblyLineSelect {
+	balq.fields = append(balq.fields, fields...)
+	selbuild := &BundleAssemblyLineSelect{BundleAssemblyLineQuery: balq}
+	selbuild.label = bundleassemblyline.Label
+	selbuild.flds, selbuild.scan = &balq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (balq *BundleAssemblyLineQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range balq.fields {
+		if !bundleassemblyline.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if balq.path != nil {
+		prev, err := balq.path(ctx)
+		if err != nil {
+			return err
+		}
+		balq.sql = prev
+	}
+	return nil
+}
+
+func (balq *BundleAssemblyLineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BundleAssemblyLine, error) {
+	var (
+		nodes = []*BundleAssemblyLine{}
+		_spec = balq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*BundleAssemblyLine).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &BundleAssemblyLine{config: balq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(balq.modifiers) > 0 {
+		_spec.Modifiers = balq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, balq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (balq *BundleAssemblyLineQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := balq.querySpec()
+	if len(balq.modifiers) > 0 {
+		_spec.Modifiers = balq.modifiers
+	}
+	_spec.Node.Columns = balq.fields
+	if len(balq.fields) > 0 {
+		_spec.Unique = balq.unique != nil && *balq.unique
+	}
+	return sqlgraph.CountNodes(ctx, balq.driver, _spec)
+}
+
+func (balq *BundleAssemblyLineQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := balq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (balq *BundleAssemblyLineQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   bundleassemblyline.Table,
+			Columns: bundleassemblyline.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bundleassemblyline.FieldID,
+			},
+		},
+		From:   balq.sql,
+		Unique: true,
+	}
+	if unique := balq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := balq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bundleassemblyline.FieldID)
+		for i := range fields {
+			if fields[i] != bundleassemblyline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := balq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := balq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := balq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := balq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (balq *BundleAssemblyLineQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(balq.driver.Dialect())
+	t1 := builder.Table(bundleassemblyline.Table)
+	columns := balq.fields
+	if len(columns) == 0 {
+		columns = bundleassemblyline.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if balq.sql != nil {
+		selector = balq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if balq.unique != nil && *balq.unique {
+		selector.Distinct()
+	}
+	for _, m := range balq.modifiers {
+		m(selector)
+	}
+	for _, p := range balq.predicates {
+		p(selector)
+	}
+	for _, p := range balq.order {
+		p(selector)
+	}
+	if offset := balq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := balq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (balq *BundleAssemblyLineQuery) ForUpdate(opts ...sql.LockOption) *BundleAssemblyLineQuery {
+	if balq.driver.Dialect() == dialect.Postgres {
+		balq.Unique(false)
+	}
+	balq.modifiers = append(balq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return balq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (balq *BundleAssemblyLineQuery) ForShare(opts ...sql.LockOption) *BundleAssemblyLineQuery {
+	if balq.driver.Dialect() == dialect.Postgres {
+		balq.Unique(false)
+	}
+	balq.modifiers = append(balq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return balq
+}
+
+// BundleAssemblyLineGroupBy is the group-by builder for BundleAssemblyLine entities.
+type BundleAssemblyLineGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (balgb *BundleAssemblyLineGroupBy) Aggregate(fns ...AggregateFunc) *BundleAssemblyLineGroupBy {
+	balgb.fns = append(balgb.fns, fns...)
+	return balgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (balgb *BundleAssemblyLineGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := balgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	balgb.sql = query
+	return balgb.sqlScan(ctx, v)
+}
+
+func (balgb *BundleAssemblyLineGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range balgb.fields {
+		if !bundleassemblyline.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := balgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := balgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (balgb *BundleAssemblyLineGroupBy) sqlQuery() *sql.Selector {
+	selector := balgb.sql.Select()
+	aggregation := make([]string, 0, len(balgb.fns))
+	for _, fn := range balgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(balgb.fields)+len(balgb.fns))
+		for _, f := range balgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(balgb.fields...)...)
+}
+
+// BundleAssemblyLineSelect is the builder for selecting fields of BundleAssemblyLine entities.
+type BundleAssemblyLineSelect struct {
+	*BundleAssemblyLineQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bals *BundleAssemblyLineSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := bals.prepareQuery(ctx); err != nil {
+		return err
+	}
+	bals.sql = bals.BundleAssemblyLineQuery.sqlQuery(ctx)
+	return bals.sqlScan(ctx, v)
+}
+
+func (bals *BundleAssemblyLineSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := bals.sql.Query()
+	if err := bals.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
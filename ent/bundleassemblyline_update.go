@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/bundleassemblyline"
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BundleAssemblyLineUpdate is the builder for updating BundleAssemblyLine entities.
+type BundleAssemblyLineUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BundleAssemblyLineMutation
+}
+
+// Where appends a list predicates to the BundleAssemblyLineUpdate builder.
+func (balu *BundleAssemblyLineUpdate) Where(ps ...predicate.BundleAssemblyLine) *BundleAssemblyLineUpdate {
+	balu.mutation.Where(ps...)
+	return balu
+}
+
+// SetAssemblyID sets the "assembly_id" field.
+func (balu *BundleAssemblyLineUpdate) SetAssemblyID(i int) *BundleAssemblyLineUpdate {
+	balu.mutation.ResetAssemblyID()
+	balu.mutation.SetAssemblyID(i)
+	return balu
+}
+
+// AddAssemblyID adds i to the "assembly_id" field.
+func (balu *BundleAssemblyLineUpdate) AddAssemblyID(i int) *BundleAssemblyLineUpdate {
+	balu.mutation.AddAssemblyID(i)
+	return balu
+}
+
+// SetProductID sets the "product_id" field.
+func (balu *BundleAssemblyLineUpdate) SetProductID(i int) *BundleAssemblyLineUpdate {
+	balu.mutation.ResetProductID()
+	balu.mutation.SetProductID(i)
+	return balu
+}
+
+// AddProductID adds i to the "product_id" field.
+func (balu *BundleAssemblyLineUpdate) AddProductID(i int) *BundleAssemblyLineUpdate {
+	balu.mutation.AddProductID(i)
+	return balu
+}
+
+// SetQuantity sets the "quantity" field.
+func (balu *BundleAssemblyLineUpdate) SetQuantity(f float64) *BundleAssemblyLineUpdate {
+	balu.mutation.ResetQuantity()
+	balu.mutation.SetQuantity(f)
+	return balu
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (balu *BundleAssemblyLineUpdate) AddQuantity(f float64) *BundleAssemblyLineUpdate {
+	balu.mutation.AddQuantity(f)
+	return balu
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (balu *BundleAssemblyLineUpdate) SetUnitCost(m money.Amount) *BundleAssemblyLineUpdate {
+	balu.mutation.ResetUnitCost()
+	balu.mutation.SetUnitCost(m)
+	return balu
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (balu *BundleAssemblyLineUpdate) SetNillableUnitCost(m *money.Amount) *BundleAssemblyLineUpdate {
+	if m != nil {
+		balu.SetUnitCost(*m)
+	}
+	return balu
+}
+
+// AddUnitCost adds m to the "unit_cost" field.
+func (balu *BundleAssemblyLineUpdate) AddUnitCost(m money.Amount) *BundleAssemblyLineUpdate {
+	balu.mutation.AddUnitCost(m)
+	return balu
+}
+
+// Mutation returns the BundleAssemblyLineMutation object of the builder.
+func (balu *BundleAssemblyLineUpdate) Mutation() *BundleAssemblyLineMutation {
+	return balu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (balu *BundleAssemblyLineUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(balu.hooks) == 0 {
+		if err = balu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = balu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BundleAssemblyLineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = balu.check(); err != nil {
+				return 0, err
+			}
+			balu.mutation = mutation
+			affected, err = balu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(balu.hooks) - 1; i >= 0; i-- {
+			if balu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = balu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, balu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (balu *BundleAssemblyLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := balu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (balu *BundleAssemblyLineUpdate) Exec(ctx context.Context) error {
+	_, err := balu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (balu *BundleAssemblyLineUpdate) ExecX(ctx context.Context) {
+	if err := balu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (balu *BundleAssemblyLineUpdate) check() error {
+	if v, ok := balu.mutation.Quantity(); ok {
+		if err := bundleassemblyline.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BundleAssemblyLine.quantity": %w`, err)}
+		}
+	}
+	if v, ok := balu.mutation.UnitCost(); ok {
+		if err := bundleassemblyline.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "BundleAssemblyLine.unit_cost": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (balu *BundleAssemblyLineUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   bundleassemblyline.Table,
+			Columns: bundleassemblyline.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bundleassemblyline.FieldID,
+			},
+		},
+	}
+	if ps := balu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := balu.mutation.AssemblyID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassemblyline.FieldAssemblyID,
+		})
+	}
+	if value, ok := balu.mutation.AddedAssemblyID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassemblyline.FieldAssemblyID,
+		})
+	}
+	if value, ok := balu.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassemblyline.FieldProductID,
+		})
+	}
+	if value, ok := balu.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassemblyline.FieldProductID,
+		})
+	}
+	if value, ok := balu.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundleassemblyline.FieldQuantity,
+		})
+	}
+	if value, ok := balu.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundleassemblyline.FieldQuantity,
+		})
+	}
+	if value, ok := balu.mutation.UnitCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: bundleassemblyline.FieldUnitCost,
+		})
+	}
+	if value, ok := balu.mutation.AddedUnitCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: bundleassemblyline.FieldUnitCost,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, balu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bundleassemblyline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// BundleAssemblyLineUpdateOne is the builder for updating a single BundleAssemblyLine entity.
+type BundleAssemblyLineUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BundleAssemblyLineMutation
+}
+
+// SetAssemblyID sets the "assembly_id" field.
+func (baluo *BundleAssemblyLineUpdateOne) SetAssemblyID(i int) *BundleAssemblyLineUpdateOne {
+	baluo.mutation.ResetAssemblyID()
+	baluo.mutation.SetAssemblyID(i)
+	return baluo
+}
+
+// AddAssemblyID adds i to the "assembly_id" field.
+func (baluo *BundleAssemblyLineUpdateOne) AddAssemblyID(i int) *BundleAssemblyLineUpdateOne {
+	baluo.mutation.AddAssemblyID(i)
+	return baluo
+}
+
+// SetProductID sets the "product_id" field.
+func (baluo *BundleAssemblyLineUpdateOne) SetProductID(i int) *BundleAssemblyLineUpdateOne {
+	baluo.mutation.ResetProductID()
+	baluo.mutation.SetProductID(i)
+	return baluo
+}
+
+// AddProductID adds i to the "product_id" field.
+func (baluo *BundleAssemblyLineUpdateOne) AddProductID(i int) *BundleAssemblyLineUpdateOne {
+	baluo.mutation.AddProductID(i)
+	return baluo
+}
+
+// SetQuantity sets the "quantity" field.
+func (baluo *BundleAssemblyLineUpdateOne) SetQuantity(f float64) *BundleAssemblyLineUpdateOne {
+	baluo.mutation.ResetQuantity()
+	baluo.mutation.SetQuantity(f)
+	return baluo
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (baluo *BundleAssemblyLineUpdateOne) AddQuantity(f float64) *BundleAssemblyLineUpdateOne {
+	baluo.mutation.AddQuantity(f)
+	return baluo
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (baluo *BundleAssemblyLineUpdateOne) SetUnitCost(m money.Amount) *BundleAssemblyLineUpdateOne {
+	baluo.mutation.ResetUnitCost()
+	baluo.mutation.SetUnitCost(m)
+	return baluo
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (baluo *BundleAssemblyLineUpdateOne) SetNillableUnitCost(m *money.Amount) *BundleAssemblyLineUpdateOne {
+	if m != nil {
+		baluo.SetUnitCost(*m)
+	}
+	return baluo
+}
+
+// AddUnitCost adds m to the "unit_cost" field.
+func (baluo *BundleAssemblyLineUpdateOne) AddUnitCost(m money.Amount) *BundleAssemblyLineUpdateOne {
+	baluo.mutation.AddUnitCost(m)
+	return baluo
+}
+
+// Mutation returns the BundleAssemblyLineMutation object of the builder.
+func (baluo *BundleAssemblyLineUpdateOne) Mutation() *BundleAssemblyLineMutation {
+	return baluo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (baluo *BundleAssemblyLineUpdateOne) Select(field string, fields ...string) *BundleAssemblyLineUpdateOne {
+	baluo.fields = append([]string{field}, fields...)
+	return baluo
+}
+
+// Save executes the query and returns the updated BundleAssemblyLine entity.
+func (baluo *BundleAssemblyLineUpdateOne) Save(ctx context.Context) (*BundleAssemblyLine, error) {
+	var (
+		err  error
+		node *BundleAssemblyLine
+	)
+	if len(baluo.hooks) == 0 {
+		if err = baluo.check(); err != nil {
+			return nil, err
+		}
+		node, err = baluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BundleAssemblyLineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = baluo.check(); err != nil {
+				return nil, err
+			}
+			baluo.mutation = mutation
+			node, err = baluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(baluo.hooks) - 1; i >= 0; i-- {
+			if baluo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = baluo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, baluo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*BundleAssemblyLine)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from BundleAssemblyLineMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (baluo *BundleAssemblyLineUpdateOne) SaveX(ctx context.Context) *BundleAssemblyLine {
+	node, err := baluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (baluo *BundleAssemblyLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := baluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (baluo *BundleAssemblyLineUpdateOne) ExecX(ctx context.Context) {
+	if err := baluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (baluo *BundleAssemblyLineUpdateOne) check() error {
+	if v, ok := baluo.mutation.Quantity(); ok {
+		if err := bundleassemblyline.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BundleAssemblyLine.quantity": %w`, err)}
+		}
+	}
+	if v, ok := baluo.mutation.UnitCost(); ok {
+		if err := bundleassemblyline.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "BundleAssemblyLine.unit_cost": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (baluo *BundleAssemblyLineUpdateOne) sqlSave(ctx context.Context) (_node *BundleAssemblyLine, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   bundleassemblyline.Table,
+			Columns: bundleassemblyline.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bundleassemblyline.FieldID,
+			},
+		},
+	}
+	id, ok := baluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BundleAssemblyLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := baluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bundleassemblyline.FieldID)
+		for _, f := range fields {
+			if !bundleassemblyline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bundleassemblyline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := baluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := baluo.mutation.AssemblyID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassemblyline.FieldAssemblyID,
+		})
+	}
+	if value, ok := baluo.mutation.AddedAssemblyID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassemblyline.FieldAssemblyID,
+		})
+	}
+	if value, ok := baluo.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassemblyline.FieldProductID,
+		})
+	}
+	if value, ok := baluo.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundleassemblyline.FieldProductID,
+		})
+	}
+	if value, ok := baluo.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundleassemblyline.FieldQuantity,
+		})
+	}
+	if value, ok := baluo.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundleassemblyline.FieldQuantity,
+		})
+	}
+	if value, ok := baluo.mutation.UnitCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: bundleassemblyline.FieldUnitCost,
+		})
+	}
+	if value, ok := baluo.mutation.AddedUnitCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: bundleassemblyline.FieldUnitCost,
+		})
+	}
+	_node = &BundleAssemblyLine{config: baluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, baluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bundleassemblyline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,160 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/bundlecomponent"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// BundleComponent is the model entity for the BundleComponent schema.
+type BundleComponent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// ID del producto combo
+	BundleID int `json:"bundle_id,omitempty"`
+	// ID del producto que forma parte del combo
+	ComponentID int `json:"component_id,omitempty"`
+	// Cantidad del componente por unidad de combo, en su unidad base
+	Quantity float64 `json:"quantity,omitempty"`
+	// Porcentaje del ingreso del combo que se asigna al componente con reparto manual
+	RevenueShare *float64 `json:"revenue_share,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BundleComponent) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bundlecomponent.FieldQuantity, bundlecomponent.FieldRevenueShare:
+			values[i] = new(sql.NullFloat64)
+		case bundlecomponent.FieldID, bundlecomponent.FieldTenantID, bundlecomponent.FieldBundleID, bundlecomponent.FieldComponentID:
+			values[i] = new(sql.NullInt64)
+		case bundlecomponent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type BundleComponent", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BundleComponent fields.
+func (bc *BundleComponent) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bundlecomponent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bc.ID = int(value.Int64)
+		case bundlecomponent.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				bc.TenantID = int(value.Int64)
+			}
+		case bundlecomponent.FieldBundleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bundle_id", values[i])
+			} else if value.Valid {
+				bc.BundleID = int(value.Int64)
+			}
+		case bundlecomponent.FieldComponentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field component_id", values[i])
+			} else if value.Valid {
+				bc.ComponentID = int(value.Int64)
+			}
+		case bundlecomponent.FieldQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				bc.Quantity = value.Float64
+			}
+		case bundlecomponent.FieldRevenueShare:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field revenue_share", values[i])
+			} else if value.Valid {
+				bc.RevenueShare = new(float64)
+				*bc.RevenueShare = value.Float64
+			}
+		case bundlecomponent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				bc.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this BundleComponent.
+// Note that you need to call BundleComponent.Unwrap() before calling this method if this BundleComponent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bc *BundleComponent) Update() *BundleComponentUpdateOne {
+	return (&BundleComponentClient{config: bc.config}).UpdateOne(bc)
+}
+
+// Unwrap unwraps the BundleComponent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bc *BundleComponent) Unwrap() *BundleComponent {
+	_tx, ok := bc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BundleComponent is not a transactional entity")
+	}
+	bc.config.driver = _tx.drv
+	return bc
+}
+
+// String implements the fmt.Stringer.
+func (bc *BundleComponent) String() string {
+	var builder strings.Builder
+	builder.WriteString("BundleComponent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bc.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", bc.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("bundle_id=")
+	builder.WriteString(fmt.Sprintf("%v", bc.BundleID))
+	builder.WriteString(", ")
+	builder.WriteString("component_id=")
+	builder.WriteString(fmt.Sprintf("%v", bc.ComponentID))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", bc.Quantity))
+	builder.WriteString(", ")
+	if v := bc.RevenueShare; v != nil {
+		builder.WriteString("revenue_share=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(bc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BundleComponents is a parsable slice of BundleComponent.
+type BundleComponents []*BundleComponent
+
+func (bc BundleComponents) config(cfg config) {
+	for _i := range bc {
+		bc[_i].config = cfg
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bundlecomponent
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the bundlecomponent type in the database.
+	Label = "bundle_component"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldBundleID holds the string denoting the bundle_id field in the database.
+	FieldBundleID = "bundle_id"
+	// FieldComponentID holds the string denoting the component_id field in the database.
+	FieldComponentID = "component_id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldRevenueShare holds the string denoting the revenue_share field in the database.
+	FieldRevenueShare = "revenue_share"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the bundlecomponent in the database.
+	Table = "bundle_components"
+)
+
+// Columns holds all SQL columns for bundlecomponent fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldBundleID,
+	FieldComponentID,
+	FieldQuantity,
+	FieldRevenueShare,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(float64) error
+	// RevenueShareValidator is a validator for the "revenue_share" field. It is called by the builders before save.
+	RevenueShareValidator func(float64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
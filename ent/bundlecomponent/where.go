@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bundlecomponent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// BundleID applies equality check predicate on the "bundle_id" field. It's identical to BundleIDEQ.
+func BundleID(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBundleID), v))
+	})
+}
+
+// ComponentID applies equality check predicate on the "component_id" field. It's identical to ComponentIDEQ.
+func ComponentID(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldComponentID), v))
+	})
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v float64) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// RevenueShare applies equality check predicate on the "revenue_share" field. It's identical to RevenueShareEQ.
+func RevenueShare(v float64) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevenueShare), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.BundleComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.BundleComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// BundleIDEQ applies the EQ predicate on the "bundle_id" field.
+func BundleIDEQ(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBundleID), v))
+	})
+}
+
+// BundleIDNEQ applies the NEQ predicate on the "bundle_id" field.
+func BundleIDNEQ(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBundleID), v))
+	})
+}
+
+// BundleIDIn applies the In predicate on the "bundle_id" field.
+func BundleIDIn(vs ...int) predicate.BundleComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBundleID), v...))
+	})
+}
+
+// BundleIDNotIn applies the NotIn predicate on the "bundle_id" field.
+func BundleIDNotIn(vs ...int) predicate.BundleComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBundleID), v...))
+	})
+}
+
+// BundleIDGT applies the GT predicate on the "bundle_id" field.
+func BundleIDGT(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBundleID), v))
+	})
+}
+
+// BundleIDGTE applies the GTE predicate on the "bundle_id" field.
+func BundleIDGTE(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBundleID), v))
+	})
+}
+
+// BundleIDLT applies the LT predicate on the "bundle_id" field.
+func BundleIDLT(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBundleID), v))
+	})
+}
+
+// BundleIDLTE applies the LTE predicate on the "bundle_id" field.
+func BundleIDLTE(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBundleID), v))
+	})
+}
+
+// ComponentIDEQ applies the EQ predicate on the "component_id" field.
+func ComponentIDEQ(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldComponentID), v))
+	})
+}
+
+// ComponentIDNEQ applies the NEQ predicate on the "component_id" field.
+func ComponentIDNEQ(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldComponentID), v))
+	})
+}
+
+// ComponentIDIn applies the In predicate on the "component_id" field.
+func ComponentIDIn(vs ...int) predicate.BundleComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldComponentID), v...))
+	})
+}
+
+// ComponentIDNotIn applies the NotIn predicate on the "component_id" field.
+func ComponentIDNotIn(vs ...int) predicate.BundleComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldComponentID), v...))
+	})
+}
+
+// ComponentIDGT applies the GT predicate on the "component_id" field.
+func ComponentIDGT(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldComponentID), v))
+	})
+}
+
+// ComponentIDGTE applies the GTE predicate on the "component_id" field.
+func ComponentIDGTE(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldComponentID), v))
+	})
+}
+
+// ComponentIDLT applies the LT predicate on the "component_id" field.
+func ComponentIDLT(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldComponentID), v))
+	})
+}
+
+// ComponentIDLTE applies the LTE predicate on the "component_id" field.
+func ComponentIDLTE(v int) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldComponentID), v))
+	})
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v float64) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v float64) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...float64) predicate.BundleComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...float64) predicate.BundleComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v float64) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v float64) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v float64) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v float64) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldQuantity), v))
+	})
+}
+
+// RevenueShareEQ applies the EQ predicate on the "revenue_share" field.
+func RevenueShareEQ(v float64) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevenueShare), v))
+	})
+}
+
+// RevenueShareNEQ applies the NEQ predicate on the "revenue_share" field.
+func RevenueShareNEQ(v float64) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRevenueShare), v))
+	})
+}
+
+// RevenueShareIn applies the In predicate on the "revenue_share" field.
+func RevenueShareIn(vs ...float64) predicate.BundleComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRevenueShare), v...))
+	})
+}
+
+// RevenueShareNotIn applies the NotIn predicate on the "revenue_share" field.
+func RevenueShareNotIn(vs ...float64) predicate.BundleComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRevenueShare), v...))
+	})
+}
+
+// RevenueShareGT applies the GT predicate on the "revenue_share" field.
+func RevenueShareGT(v float64) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRevenueShare), v))
+	})
+}
+
+// RevenueShareGTE applies the GTE predicate on the "revenue_share" field.
+func RevenueShareGTE(v float64) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRevenueShare), v))
+	})
+}
+
+// RevenueShareLT applies the LT predicate on the "revenue_share" field.
+func RevenueShareLT(v float64) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRevenueShare), v))
+	})
+}
+
+// RevenueShareLTE applies the LTE predicate on the "revenue_share" field.
+func RevenueShareLTE(v float64) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRevenueShare), v))
+	})
+}
+
+// RevenueShareIsNil applies the IsNil predicate on the "revenue_share" field.
+func RevenueShareIsNil() predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRevenueShare)))
+	})
+}
+
+// RevenueShareNotNil applies the NotNil predicate on the "revenue_share" field.
+func RevenueShareNotNil() predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRevenueShare)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BundleComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BundleComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BundleComponent) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BundleComponent) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BundleComponent) predicate.BundleComponent {
+	return predicate.BundleComponent(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/bundlecomponent"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BundleComponentCreate is the builder for creating a BundleComponent entity.
+type BundleComponentCreate struct {
+	config
+	mutation *BundleComponentMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (bcc *BundleComponentCreate) SetTenantID(i int) *BundleComponentCreate {
+	bcc.mutation.SetTenantID(i)
+	return bcc
+}
+
+// SetBundleID sets the "bundle_id" field.
+func (bcc *BundleComponentCreate) SetBundleID(i int) *BundleComponentCreate {
+	bcc.mutation.SetBundleID(i)
+	return bcc
+}
+
+// SetComponentID sets the "component_id" field.
+func (bcc *BundleComponentCreate) SetComponentID(i int) *BundleComponentCreate {
+	bcc.mutation.SetComponentID(i)
+	return bcc
+}
+
+// SetQuantity sets the "quantity" field.
+func (bcc *BundleComponentCreate) SetQuantity(f float64) *BundleComponentCreate {
+	bcc.mutation.SetQuantity(f)
+	return bcc
+}
+
+// SetRevenueShare sets the "revenue_share" field.
+func (bcc *BundleComponentCreate) SetRevenueShare(f float64) *BundleComponentCreate {
+	bcc.mutation.SetRevenueShare(f)
+	return bcc
+}
+
+// SetNillableRevenueShare sets the "revenue_share" field if the given value is not nil.
+func (bcc *BundleComponentCreate) SetNillableRevenueShare(f *float64) *BundleComponentCreate {
+	if f != nil {
+		bcc.SetRevenueShare(*f)
+	}
+	return bcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bcc *BundleComponentCreate) SetCreatedAt(t time.Time) *BundleComponentCreate {
+	bcc.mutation.SetCreatedAt(t)
+	return bcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bcc *BundleComponentCreate) SetNillableCreatedAt(t *time.Time) *BundleComponentCreate {
+	if t != nil {
+		bcc.SetCreatedAt(*t)
+	}
+	return bcc
+}
+
+// Mutation returns the BundleComponentMutation object of the builder.
+func (bcc *BundleComponentCreate) Mutation() *BundleComponentMutation {
+	return bcc.mutation
+}
+
+// Save creates the BundleComponent in the database.
+func (bcc *BundleComponentCreate) Save(ctx context.Context) (*BundleComponent, error) {
+	var (
+		err  error
+		node *BundleComponent
+	)
+	bcc.defaults()
+	if len(bcc.hooks) == 0 {
+		if err = bcc.check(); err != nil {
+			return nil, err
+		}
+		node, err = bcc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BundleComponentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bcc.check(); err != nil {
+				return nil, err
+			}
+			bcc.mutation = mutation
+			if node, err = bcc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bcc.hooks) - 1; i >= 0; i-- {
+			if bcc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bcc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, bcc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*BundleComponent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from BundleComponentMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bcc *BundleComponentCreate) SaveX(ctx context.Context) *BundleComponent {
+	v, err := bcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcc *BundleComponentCreate) Exec(ctx context.Context) error {
+	_, err := bcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcc *BundleComponentCreate) ExecX(ctx context.Context) {
+	if err := bcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bcc *BundleComponentCreate) defaults() {
+	if _, ok := bcc.mutation.CreatedAt(); !ok {
+		v := bundlecomponent.DefaultCreatedAt()
+		bcc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bcc *BundleComponentCreate) check() error {
+	if _, ok := bcc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "BundleComponent.tenant_id"`)}
+	}
+	if _, ok := bcc.mutation.BundleID(); !ok {
+		return &ValidationError{Name: "bundle_id", err: errors.New(`ent: missing required field "BundleComponent.bundle_id"`)}
+	}
+	if _, ok := bcc.mutation.ComponentID(); !ok {
+		return &ValidationError{Name: "component_id", err: errors.New(`ent: missing required field "BundleComponent.component_id"`)}
+	}
+	if _, ok := bcc.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "BundleComponent.quantity"`)}
+	}
+	if v, ok := bcc.mutation.Quantity(); ok {
+		if err := bundlecomponent.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BundleComponent.quantity": %w`, err)}
+		}
+	}
+	if v, ok := bcc.mutation.RevenueShare(); ok {
+		if err := bundlecomponent.RevenueShareValidator(v); err != nil {
+			return &ValidationError{Name: "revenue_share", err: fmt.Errorf(`ent: validator failed for field "BundleComponent.revenue_share": %w`, err)}
+		}
+	}
+	if _, ok := bcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BundleComponent.created_at"`)}
+	}
+	return nil
+}
+
+func (bcc *BundleComponentCreate) sqlSave(ctx context.Context) (*BundleComponent, error) {
+	_node, _spec := bcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (bcc *BundleComponentCreate) createSpec() (*BundleComponent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BundleComponent{config: bcc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: bundlecomponent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bundlecomponent.FieldID,
+			},
+		}
+	)
+	if value, ok := bcc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundlecomponent.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := bcc.mutation.BundleID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundlecomponent.FieldBundleID,
+		})
+		_node.BundleID = value
+	}
+	if value, ok := bcc.mutation.ComponentID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundlecomponent.FieldComponentID,
+		})
+		_node.ComponentID = value
+	}
+	if value, ok := bcc.mutation.Quantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundlecomponent.FieldQuantity,
+		})
+		_node.Quantity = value
+	}
+	if value, ok := bcc.mutation.RevenueShare(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundlecomponent.FieldRevenueShare,
+		})
+		_node.RevenueShare = &value
+	}
+	if value, ok := bcc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: bundlecomponent.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// BundleComponentCreateBulk is the builder for creating many BundleComponent entities in bulk.
+type BundleComponentCreateBulk struct {
+	config
+	builders []*BundleComponentCreate
+}
+
+// Save creates the BundleComponent entities in the database.
+func (bccb *BundleComponentCreateBulk) Save(ctx context.Context) ([]*BundleComponent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(bccb.builders))
+	nodes := make([]*BundleComponent, len(bccb.builders))
+	mutators := make([]Mutator, len(bccb.builders))
+	for i := range bccb.builders {
+		func(i int, root context.Context) {
+			builder := bccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BundleComponentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bccb *BundleComponentCreateBulk) SaveX(ctx context.Context) []*BundleComponent {
+	v, err := bccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bccb *BundleComponentCreateBulk) Exec(ctx context.Context) error {
+	_, err := bccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bccb *BundleComponentCreateBulk) ExecX(ctx context.Context) {
+	if err := bccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/bundlecomponent"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BundleComponentDelete is the builder for deleting a BundleComponent entity.
+type BundleComponentDelete struct {
+	config
+	hooks    []Hook
+	mutation *BundleComponentMutation
+}
+
+// Where appends a list predicates to the BundleComponentDelete builder.
+func (bcd *BundleComponentDelete) Where(ps ...predicate.BundleComponent) *BundleComponentDelete {
+	bcd.mutation.Where(ps...)
+	return bcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bcd *BundleComponentDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(bcd.hooks) == 0 {
+		affected, err = bcd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BundleComponentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			bcd.mutation = mutation
+			affected, err = bcd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(bcd.hooks) - 1; i >= 0; i-- {
+			if bcd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bcd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bcd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcd *BundleComponentDelete) ExecX(ctx context.Context) int {
+	n, err := bcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bcd *BundleComponentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: bundlecomponent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bundlecomponent.FieldID,
+			},
+		},
+	}
+	if ps := bcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// BundleComponentDeleteOne is the builder for deleting a single BundleComponent entity.
+type BundleComponentDeleteOne struct {
+	bcd *BundleComponentDelete
+}
+
+// Exec executes the deletion query.
+func (bcdo *BundleComponentDeleteOne) Exec(ctx context.Context) error {
+	n, err := bcdo.bcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bundlecomponent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcdo *BundleComponentDeleteOne) ExecX(ctx context.Context) {
+	bcdo.bcd.ExecX(ctx)
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/bundlecomponent"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BundleComponentQuery is the builder for querying BundleComponent entities.
+type BundleComponentQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.BundleComponent
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BundleComponentQuery builder.
+func (bcq *BundleComponentQuery) Where(ps ...predicate.BundleComponent) *BundleComponentQuery {
+	bcq.predicates = append(bcq.predicates, ps...)
+	return bcq
+}
+
+// Limit adds a limit step to the query.
+func (bcq *BundleComponentQuery) Limit(limit int) *BundleComponentQuery {
+	bcq.limit = &limit
+	return bcq
+}
+
+// Offset adds an offset step to the query.
+func (bcq *BundleComponentQuery) Offset(offset int) *BundleComponentQuery {
+	bcq.offset = &offset
+	return bcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bcq *BundleComponentQuery) Unique(unique bool) *BundleComponentQuery {
+	bcq.unique = &unique
+	return bcq
+}
+
+// Order adds an order step to the query.
+func (bcq *BundleComponentQuery) Order(o ...OrderFunc) *BundleComponentQuery {
+	bcq.order = append(bcq.order, o...)
+	return bcq
+}
+
+// First returns the first BundleComponent entity from the query.
+// Returns a *NotFoundError when no BundleComponent was found.
+func (bcq *BundleComponentQuery) First(ctx context.Context) (*BundleComponent, error) {
+	nodes, err := bcq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bundlecomponent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bcq *BundleComponentQuery) FirstX(ctx context.Context) *BundleComponent {
+	node, err := bcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BundleComponent ID from the query.
+// Returns a *NotFoundError when no BundleComponent ID was found.
+func (bcq *BundleComponentQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = bcq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bundlecomponent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bcq *BundleComponentQuery) FirstIDX(ctx context.Context) int {
+	id, err := bcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BundleComponent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BundleComponent entity is found.
+// Returns a *NotFoundError when no BundleComponent entities are found.
+func (bcq *BundleComponentQuery) Only(ctx context.Context) (*BundleComponent, error) {
+	nodes, err := bcq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bundlecomponent.Label}
+	default:
+		return nil, &NotSingularError{bundlecomponent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bcq *BundleComponentQuery) OnlyX(ctx context.Context) *BundleComponent {
+	node, err := bcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BundleComponent ID in the query.
+// Returns a *NotSingularError when more than one BundleComponent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (bcq *BundleComponentQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = bcq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bundlecomponent.Label}
+	default:
+		err = &NotSingularError{bundlecomponent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bcq *BundleComponentQuery) OnlyIDX(ctx context.Context) int {
+	id, err := bcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BundleComponents.
+func (bcq *BundleComponentQuery) All(ctx context.Context) ([]*BundleComponent, error) {
+	if err := bcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return bcq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bcq *BundleComponentQuery) AllX(ctx context.Context) []*BundleComponent {
+	nodes, err := bcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BundleComponent IDs.
+func (bcq *BundleComponentQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := bcq.Select(bundlecomponent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bcq *BundleComponentQuery) IDsX(ctx context.Context) []int {
+	ids, err := bcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bcq *BundleComponentQuery) Count(ctx context.Context) (int, error) {
+	if err := bcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return bcq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bcq *BundleComponentQuery) CountX(ctx context.Context) int {
+	count, err := bcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bcq *BundleComponentQuery) Exist(ctx context.Context) (bool, error) {
+	if err := bcq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return bcq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bcq *BundleComponentQuery) ExistX(ctx context.Context) bool {
+	exist, err := bcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BundleComponentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bcq *BundleComponentQuery) Clone() *BundleComponentQuery {
+	if bcq == nil {
+		return nil
+	}
+	return &BundleComponentQuery{
+		config:     bcq.config,
+		limit:      bcq.limit,
+		offset:     bcq.offset,
+		order:      append([]OrderFunc{}, bcq.order...),
+		predicates: append([]predicate.BundleComponent{}, bcq.predicates...),
+		// clone intermediate query.
+		sql:    bcq.sql.Clone(),
+		path:   bcq.path,
+		unique: bcq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BundleComponent.Query().
+//		GroupBy(bundlecomponent.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (bcq *BundleComponentQuery) GroupBy(field string, fields ...string) *BundleComponentGroupBy {
+	grbuild := &BundleComponentGroupBy{config: bcq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := bcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return bcq.sqlQuery(ctx), nil
+	}
+	grbuild.label = bundlecomponent.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.BundleComponent.Query().
+//		Select(bundlecomponent.FieldTenantID).
+//		Scan(ctx, &v)
+func (bcq *BundleComponentQuery) Select(fields ...string) *BundleComponentSelect {
+	bcq.fields = append(bcq.fields, fields...)
+	selbuild := &BundleComponentSelect{BundleComponentQuery: bcq}
+	selbuild.label = bundlecomponent.Label
+	selbuild.flds, selbuild.scan = &bcq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (bcq *BundleComponentQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range bcq.fields {
+		if !bundlecomponent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if bcq.path != nil {
+		prev, err := bcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bcq.sql = prev
+	}
+	return nil
+}
+
+func (bcq *BundleComponentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BundleComponent, error) {
+	var (
+		nodes = []*BundleComponent{}
+		_spec = bcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*BundleComponent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &BundleComponent{config: bcq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(bcq.modifiers) > 0 {
+		_spec.Modifiers = bcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (bcq *BundleComponentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bcq.querySpec()
+	if len(bcq.modifiers) > 0 {
+		_spec.Modifiers = bcq.modifiers
+	}
+	_spec.Node.Columns = bcq.fields
+	if len(bcq.fields) > 0 {
+		_spec.Unique = bcq.unique != nil && *bcq.unique
+	}
+	return sqlgraph.CountNodes(ctx, bcq.driver, _spec)
+}
+
+func (bcq *BundleComponentQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := bcq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (bcq *BundleComponentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   bundlecomponent.Table,
+			Columns: bundlecomponent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bundlecomponent.FieldID,
+			},
+		},
+		From:   bcq.sql,
+		Unique: true,
+	}
+	if unique := bcq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := bcq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bundlecomponent.FieldID)
+		for i := range fields {
+			if fields[i] != bundlecomponent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := bcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bcq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bcq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bcq *BundleComponentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bcq.driver.Dialect())
+	t1 := builder.Table(bundlecomponent.Table)
+	columns := bcq.fields
+	if len(columns) == 0 {
+		columns = bundlecomponent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bcq.sql != nil {
+		selector = bcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if bcq.unique != nil && *bcq.unique {
+		selector.Distinct()
+	}
+	for _, m := range bcq.modifiers {
+		m(selector)
+	}
+	for _, p := range bcq.predicates {
+		p(selector)
+	}
+	for _, p := range bcq.order {
+		p(selector)
+	}
+	if offset := bcq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bcq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (bcq *BundleComponentQuery) ForUpdate(opts ...sql.LockOption) *BundleComponentQuery {
+	if bcq.driver.Dialect() == dialect.Postgres {
+		bcq.Unique(false)
+	}
+	bcq.modifiers = append(bcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return bcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (bcq *BundleComponentQuery) ForShare(opts ...sql.LockOption) *BundleComponentQuery {
+	if bcq.driver.Dialect() == dialect.Postgres {
+		bcq.Unique(false)
+	}
+	bcq.modifiers = append(bcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return bcq
+}
+
+// BundleComponentGroupBy is the group-by builder for BundleComponent entities.
+type BundleComponentGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bcgb *BundleComponentGroupBy) Aggregate(fns ...AggregateFunc) *BundleComponentGroupBy {
+	bcgb.fns = append(bcgb.fns, fns...)
+	return bcgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (bcgb *BundleComponentGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := bcgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	bcgb.sql = query
+	return bcgb.sqlScan(ctx, v)
+}
+
+func (bcgb *BundleComponentGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range bcgb.fields {
+		if !bundlecomponent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := bcgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bcgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (bcgb *BundleComponentGroupBy) sqlQuery() *sql.Selector {
+	selector := bcgb.sql.Select()
+	aggregation := make([]string, 0, len(bcgb.fns))
+	for _, fn := range bcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(bcgb.fields)+len(bcgb.fns))
+		for _, f := range bcgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(bcgb.fields...)...)
+}
+
+// BundleComponentSelect is the builder for selecting fields of BundleComponent entities.
+type BundleComponentSelect struct {
+	*BundleComponentQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bcs *BundleComponentSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := bcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	bcs.sql = bcs.BundleComponentQuery.sqlQuery(ctx)
+	return bcs.sqlScan(ctx, v)
+}
+
+func (bcs *BundleComponentSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := bcs.sql.Query()
+	if err := bcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
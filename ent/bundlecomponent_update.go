@@ -0,0 +1,597 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/bundlecomponent"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BundleComponentUpdate is the builder for updating BundleComponent entities.
+type BundleComponentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BundleComponentMutation
+}
+
+// Where appends a list predicates to the BundleComponentUpdate builder.
+func (bcu *BundleComponentUpdate) Where(ps ...predicate.BundleComponent) *BundleComponentUpdate {
+	bcu.mutation.Where(ps...)
+	return bcu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (bcu *BundleComponentUpdate) SetTenantID(i int) *BundleComponentUpdate {
+	bcu.mutation.ResetTenantID()
+	bcu.mutation.SetTenantID(i)
+	return bcu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (bcu *BundleComponentUpdate) AddTenantID(i int) *BundleComponentUpdate {
+	bcu.mutation.AddTenantID(i)
+	return bcu
+}
+
+// SetBundleID sets the "bundle_id" field.
+func (bcu *BundleComponentUpdate) SetBundleID(i int) *BundleComponentUpdate {
+	bcu.mutation.ResetBundleID()
+	bcu.mutation.SetBundleID(i)
+	return bcu
+}
+
+// AddBundleID adds i to the "bundle_id" field.
+func (bcu *BundleComponentUpdate) AddBundleID(i int) *BundleComponentUpdate {
+	bcu.mutation.AddBundleID(i)
+	return bcu
+}
+
+// SetComponentID sets the "component_id" field.
+func (bcu *BundleComponentUpdate) SetComponentID(i int) *BundleComponentUpdate {
+	bcu.mutation.ResetComponentID()
+	bcu.mutation.SetComponentID(i)
+	return bcu
+}
+
+// AddComponentID adds i to the "component_id" field.
+func (bcu *BundleComponentUpdate) AddComponentID(i int) *BundleComponentUpdate {
+	bcu.mutation.AddComponentID(i)
+	return bcu
+}
+
+// SetQuantity sets the "quantity" field.
+func (bcu *BundleComponentUpdate) SetQuantity(f float64) *BundleComponentUpdate {
+	bcu.mutation.ResetQuantity()
+	bcu.mutation.SetQuantity(f)
+	return bcu
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (bcu *BundleComponentUpdate) AddQuantity(f float64) *BundleComponentUpdate {
+	bcu.mutation.AddQuantity(f)
+	return bcu
+}
+
+// SetRevenueShare sets the "revenue_share" field.
+func (bcu *BundleComponentUpdate) SetRevenueShare(f float64) *BundleComponentUpdate {
+	bcu.mutation.ResetRevenueShare()
+	bcu.mutation.SetRevenueShare(f)
+	return bcu
+}
+
+// SetNillableRevenueShare sets the "revenue_share" field if the given value is not nil.
+func (bcu *BundleComponentUpdate) SetNillableRevenueShare(f *float64) *BundleComponentUpdate {
+	if f != nil {
+		bcu.SetRevenueShare(*f)
+	}
+	return bcu
+}
+
+// AddRevenueShare adds f to the "revenue_share" field.
+func (bcu *BundleComponentUpdate) AddRevenueShare(f float64) *BundleComponentUpdate {
+	bcu.mutation.AddRevenueShare(f)
+	return bcu
+}
+
+// ClearRevenueShare clears the value of the "revenue_share" field.
+func (bcu *BundleComponentUpdate) ClearRevenueShare() *BundleComponentUpdate {
+	bcu.mutation.ClearRevenueShare()
+	return bcu
+}
+
+// Mutation returns the BundleComponentMutation object of the builder.
+func (bcu *BundleComponentUpdate) Mutation() *BundleComponentMutation {
+	return bcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bcu *BundleComponentUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(bcu.hooks) == 0 {
+		if err = bcu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = bcu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BundleComponentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bcu.check(); err != nil {
+				return 0, err
+			}
+			bcu.mutation = mutation
+			affected, err = bcu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(bcu.hooks) - 1; i >= 0; i-- {
+			if bcu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bcu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bcu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcu *BundleComponentUpdate) SaveX(ctx context.Context) int {
+	affected, err := bcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bcu *BundleComponentUpdate) Exec(ctx context.Context) error {
+	_, err := bcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcu *BundleComponentUpdate) ExecX(ctx context.Context) {
+	if err := bcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bcu *BundleComponentUpdate) check() error {
+	if v, ok := bcu.mutation.Quantity(); ok {
+		if err := bundlecomponent.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BundleComponent.quantity": %w`, err)}
+		}
+	}
+	if v, ok := bcu.mutation.RevenueShare(); ok {
+		if err := bundlecomponent.RevenueShareValidator(v); err != nil {
+			return &ValidationError{Name: "revenue_share", err: fmt.Errorf(`ent: validator failed for field "BundleComponent.revenue_share": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bcu *BundleComponentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   bundlecomponent.Table,
+			Columns: bundlecomponent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bundlecomponent.FieldID,
+			},
+		},
+	}
+	if ps := bcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bcu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundlecomponent.FieldTenantID,
+		})
+	}
+	if value, ok := bcu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundlecomponent.FieldTenantID,
+		})
+	}
+	if value, ok := bcu.mutation.BundleID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundlecomponent.FieldBundleID,
+		})
+	}
+	if value, ok := bcu.mutation.AddedBundleID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundlecomponent.FieldBundleID,
+		})
+	}
+	if value, ok := bcu.mutation.ComponentID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundlecomponent.FieldComponentID,
+		})
+	}
+	if value, ok := bcu.mutation.AddedComponentID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundlecomponent.FieldComponentID,
+		})
+	}
+	if value, ok := bcu.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundlecomponent.FieldQuantity,
+		})
+	}
+	if value, ok := bcu.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundlecomponent.FieldQuantity,
+		})
+	}
+	if value, ok := bcu.mutation.RevenueShare(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundlecomponent.FieldRevenueShare,
+		})
+	}
+	if value, ok := bcu.mutation.AddedRevenueShare(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundlecomponent.FieldRevenueShare,
+		})
+	}
+	if bcu.mutation.RevenueShareCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: bundlecomponent.FieldRevenueShare,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bundlecomponent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// BundleComponentUpdateOne is the builder for updating a single BundleComponent entity.
+type BundleComponentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BundleComponentMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (bcuo *BundleComponentUpdateOne) SetTenantID(i int) *BundleComponentUpdateOne {
+	bcuo.mutation.ResetTenantID()
+	bcuo.mutation.SetTenantID(i)
+	return bcuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (bcuo *BundleComponentUpdateOne) AddTenantID(i int) *BundleComponentUpdateOne {
+	bcuo.mutation.AddTenantID(i)
+	return bcuo
+}
+
+// SetBundleID sets the "bundle_id" field.
+func (bcuo *BundleComponentUpdateOne) SetBundleID(i int) *BundleComponentUpdateOne {
+	bcuo.mutation.ResetBundleID()
+	bcuo.mutation.SetBundleID(i)
+	return bcuo
+}
+
+// AddBundleID adds i to the "bundle_id" field.
+func (bcuo *BundleComponentUpdateOne) AddBundleID(i int) *BundleComponentUpdateOne {
+	bcuo.mutation.AddBundleID(i)
+	return bcuo
+}
+
+// SetComponentID sets the "component_id" field.
+func (bcuo *BundleComponentUpdateOne) SetComponentID(i int) *BundleComponentUpdateOne {
+	bcuo.mutation.ResetComponentID()
+	bcuo.mutation.SetComponentID(i)
+	return bcuo
+}
+
+// AddComponentID adds i to the "component_id" field.
+func (bcuo *BundleComponentUpdateOne) AddComponentID(i int) *BundleComponentUpdateOne {
+	bcuo.mutation.AddComponentID(i)
+	return bcuo
+}
+
+// SetQuantity sets the "quantity" field.
+func (bcuo *BundleComponentUpdateOne) SetQuantity(f float64) *BundleComponentUpdateOne {
+	bcuo.mutation.ResetQuantity()
+	bcuo.mutation.SetQuantity(f)
+	return bcuo
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (bcuo *BundleComponentUpdateOne) AddQuantity(f float64) *BundleComponentUpdateOne {
+	bcuo.mutation.AddQuantity(f)
+	return bcuo
+}
+
+// SetRevenueShare sets the "revenue_share" field.
+func (bcuo *BundleComponentUpdateOne) SetRevenueShare(f float64) *BundleComponentUpdateOne {
+	bcuo.mutation.ResetRevenueShare()
+	bcuo.mutation.SetRevenueShare(f)
+	return bcuo
+}
+
+// SetNillableRevenueShare sets the "revenue_share" field if the given value is not nil.
+func (bcuo *BundleComponentUpdateOne) SetNillableRevenueShare(f *float64) *BundleComponentUpdateOne {
+	if f != nil {
+		bcuo.SetRevenueShare(*f)
+	}
+	return bcuo
+}
+
+// AddRevenueShare adds f to the "revenue_share" field.
+func (bcuo *BundleComponentUpdateOne) AddRevenueShare(f float64) *BundleComponentUpdateOne {
+	bcuo.mutation.AddRevenueShare(f)
+	return bcuo
+}
+
+// ClearRevenueShare clears the value of the "revenue_share" field.
+func (bcuo *BundleComponentUpdateOne) ClearRevenueShare() *BundleComponentUpdateOne {
+	bcuo.mutation.ClearRevenueShare()
+	return bcuo
+}
+
+// Mutation returns the BundleComponentMutation object of the builder.
+func (bcuo *BundleComponentUpdateOne) Mutation() *BundleComponentMutation {
+	return bcuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bcuo *BundleComponentUpdateOne) Select(field string, fields ...string) *BundleComponentUpdateOne {
+	bcuo.fields = append([]string{field}, fields...)
+	return bcuo
+}
+
+// Save executes the query and returns the updated BundleComponent entity.
+func (bcuo *BundleComponentUpdateOne) Save(ctx context.Context) (*BundleComponent, error) {
+	var (
+		err  error
+		node *BundleComponent
+	)
+	if len(bcuo.hooks) == 0 {
+		if err = bcuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = bcuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BundleComponentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bcuo.check(); err != nil {
+				return nil, err
+			}
+			bcuo.mutation = mutation
+			node, err = bcuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bcuo.hooks) - 1; i >= 0; i-- {
+			if bcuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bcuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, bcuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*BundleComponent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from BundleComponentMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcuo *BundleComponentUpdateOne) SaveX(ctx context.Context) *BundleComponent {
+	node, err := bcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bcuo *BundleComponentUpdateOne) Exec(ctx context.Context) error {
+	_, err := bcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcuo *BundleComponentUpdateOne) ExecX(ctx context.Context) {
+	if err := bcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bcuo *BundleComponentUpdateOne) check() error {
+	if v, ok := bcuo.mutation.Quantity(); ok {
+		if err := bundlecomponent.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BundleComponent.quantity": %w`, err)}
+		}
+	}
+	if v, ok := bcuo.mutation.RevenueShare(); ok {
+		if err := bundlecomponent.RevenueShareValidator(v); err != nil {
+			return &ValidationError{Name: "revenue_share", err: fmt.Errorf(`ent: validator failed for field "BundleComponent.revenue_share": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bcuo *BundleComponentUpdateOne) sqlSave(ctx context.Context) (_node *BundleComponent, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   bundlecomponent.Table,
+			Columns: bundlecomponent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: bundlecomponent.FieldID,
+			},
+		},
+	}
+	id, ok := bcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BundleComponent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bundlecomponent.FieldID)
+		for _, f := range fields {
+			if !bundlecomponent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bundlecomponent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bcuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundlecomponent.FieldTenantID,
+		})
+	}
+	if value, ok := bcuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundlecomponent.FieldTenantID,
+		})
+	}
+	if value, ok := bcuo.mutation.BundleID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundlecomponent.FieldBundleID,
+		})
+	}
+	if value, ok := bcuo.mutation.AddedBundleID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundlecomponent.FieldBundleID,
+		})
+	}
+	if value, ok := bcuo.mutation.ComponentID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundlecomponent.FieldComponentID,
+		})
+	}
+	if value, ok := bcuo.mutation.AddedComponentID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: bundlecomponent.FieldComponentID,
+		})
+	}
+	if value, ok := bcuo.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundlecomponent.FieldQuantity,
+		})
+	}
+	if value, ok := bcuo.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundlecomponent.FieldQuantity,
+		})
+	}
+	if value, ok := bcuo.mutation.RevenueShare(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundlecomponent.FieldRevenueShare,
+		})
+	}
+	if value, ok := bcuo.mutation.AddedRevenueShare(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: bundlecomponent.FieldRevenueShare,
+		})
+	}
+	if bcuo.mutation.RevenueShareCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: bundlecomponent.FieldRevenueShare,
+		})
+	}
+	_node = &BundleComponent{config: bcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bundlecomponent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
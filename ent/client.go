@@ -9,13 +9,44 @@ import (
 
 	"Veritasbackend/ent/migrate"
 
+	"Veritasbackend/ent/adjustmentreason"
+	"Veritasbackend/ent/auditlog"
+	"Veritasbackend/ent/bundleassembly"
+	"Veritasbackend/ent/bundleassemblyline"
+	"Veritasbackend/ent/bundlecomponent"
+	"Veritasbackend/ent/customer"
+	"Veritasbackend/ent/exchangerate"
+	"Veritasbackend/ent/fiscaldocument"
+	"Veritasbackend/ent/inventorycostlayer"
+	"Veritasbackend/ent/inventorycount"
+	"Veritasbackend/ent/inventorycountentry"
+	"Veritasbackend/ent/inventorycountline"
 	"Veritasbackend/ent/invoice"
 	"Veritasbackend/ent/invoiceitem"
+	"Veritasbackend/ent/invoiceitemcomponent"
+	"Veritasbackend/ent/invoicelotallocation"
+	"Veritasbackend/ent/invoicestatusevent"
+	"Veritasbackend/ent/invoicetax"
+	"Veritasbackend/ent/numberingseries"
+	"Veritasbackend/ent/pricelist"
+	"Veritasbackend/ent/pricelistitem"
 	"Veritasbackend/ent/product"
+	"Veritasbackend/ent/productbarcode"
+	"Veritasbackend/ent/productfile"
+	"Veritasbackend/ent/productlot"
+	"Veritasbackend/ent/productprice"
+	"Veritasbackend/ent/productserial"
+	"Veritasbackend/ent/productserialevent"
+	"Veritasbackend/ent/productunit"
+	"Veritasbackend/ent/promotion"
+	"Veritasbackend/ent/promotiontarget"
 	"Veritasbackend/ent/purchaseinvoice"
 	"Veritasbackend/ent/purchaseinvoiceitem"
+	"Veritasbackend/ent/stockadjustment"
+	"Veritasbackend/ent/stockadjustmentline"
 	"Veritasbackend/ent/supplier"
 	"Veritasbackend/ent/supplierpayment"
+	"Veritasbackend/ent/taxrate"
 	"Veritasbackend/ent/tenant"
 	"Veritasbackend/ent/user"
 
@@ -29,20 +60,82 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AdjustmentReason is the client for interacting with the AdjustmentReason builders.
+	AdjustmentReason *AdjustmentReasonClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// BundleAssembly is the client for interacting with the BundleAssembly builders.
+	BundleAssembly *BundleAssemblyClient
+	// BundleAssemblyLine is the client for interacting with the BundleAssemblyLine builders.
+	BundleAssemblyLine *BundleAssemblyLineClient
+	// BundleComponent is the client for interacting with the BundleComponent builders.
+	BundleComponent *BundleComponentClient
+	// Customer is the client for interacting with the Customer builders.
+	Customer *CustomerClient
+	// ExchangeRate is the client for interacting with the ExchangeRate builders.
+	ExchangeRate *ExchangeRateClient
+	// FiscalDocument is the client for interacting with the FiscalDocument builders.
+	FiscalDocument *FiscalDocumentClient
+	// InventoryCostLayer is the client for interacting with the InventoryCostLayer builders.
+	InventoryCostLayer *InventoryCostLayerClient
+	// InventoryCount is the client for interacting with the InventoryCount builders.
+	InventoryCount *InventoryCountClient
+	// InventoryCountEntry is the client for interacting with the InventoryCountEntry builders.
+	InventoryCountEntry *InventoryCountEntryClient
+	// InventoryCountLine is the client for interacting with the InventoryCountLine builders.
+	InventoryCountLine *InventoryCountLineClient
 	// Invoice is the client for interacting with the Invoice builders.
 	Invoice *InvoiceClient
 	// InvoiceItem is the client for interacting with the InvoiceItem builders.
 	InvoiceItem *InvoiceItemClient
+	// InvoiceItemComponent is the client for interacting with the InvoiceItemComponent builders.
+	InvoiceItemComponent *InvoiceItemComponentClient
+	// InvoiceLotAllocation is the client for interacting with the InvoiceLotAllocation builders.
+	InvoiceLotAllocation *InvoiceLotAllocationClient
+	// InvoiceStatusEvent is the client for interacting with the InvoiceStatusEvent builders.
+	InvoiceStatusEvent *InvoiceStatusEventClient
+	// InvoiceTax is the client for interacting with the InvoiceTax builders.
+	InvoiceTax *InvoiceTaxClient
+	// NumberingSeries is the client for interacting with the NumberingSeries builders.
+	NumberingSeries *NumberingSeriesClient
+	// PriceList is the client for interacting with the PriceList builders.
+	PriceList *PriceListClient
+	// PriceListItem is the client for interacting with the PriceListItem builders.
+	PriceListItem *PriceListItemClient
 	// Product is the client for interacting with the Product builders.
 	Product *ProductClient
+	// ProductBarcode is the client for interacting with the ProductBarcode builders.
+	ProductBarcode *ProductBarcodeClient
+	// ProductFile is the client for interacting with the ProductFile builders.
+	ProductFile *ProductFileClient
+	// ProductLot is the client for interacting with the ProductLot builders.
+	ProductLot *ProductLotClient
+	// ProductPrice is the client for interacting with the ProductPrice builders.
+	ProductPrice *ProductPriceClient
+	// ProductSerial is the client for interacting with the ProductSerial builders.
+	ProductSerial *ProductSerialClient
+	// ProductSerialEvent is the client for interacting with the ProductSerialEvent builders.
+	ProductSerialEvent *ProductSerialEventClient
+	// ProductUnit is the client for interacting with the ProductUnit builders.
+	ProductUnit *ProductUnitClient
+	// Promotion is the client for interacting with the Promotion builders.
+	Promotion *PromotionClient
+	// PromotionTarget is the client for interacting with the PromotionTarget builders.
+	PromotionTarget *PromotionTargetClient
 	// PurchaseInvoice is the client for interacting with the PurchaseInvoice builders.
 	PurchaseInvoice *PurchaseInvoiceClient
 	// PurchaseInvoiceItem is the client for interacting with the PurchaseInvoiceItem builders.
 	PurchaseInvoiceItem *PurchaseInvoiceItemClient
+	// StockAdjustment is the client for interacting with the StockAdjustment builders.
+	StockAdjustment *StockAdjustmentClient
+	// StockAdjustmentLine is the client for interacting with the StockAdjustmentLine builders.
+	StockAdjustmentLine *StockAdjustmentLineClient
 	// Supplier is the client for interacting with the Supplier builders.
 	Supplier *SupplierClient
 	// SupplierPayment is the client for interacting with the SupplierPayment builders.
 	SupplierPayment *SupplierPaymentClient
+	// TaxRate is the client for interacting with the TaxRate builders.
+	TaxRate *TaxRateClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
 	// User is the client for interacting with the User builders.
@@ -60,13 +153,44 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AdjustmentReason = NewAdjustmentReasonClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
+	c.BundleAssembly = NewBundleAssemblyClient(c.config)
+	c.BundleAssemblyLine = NewBundleAssemblyLineClient(c.config)
+	c.BundleComponent = NewBundleComponentClient(c.config)
+	c.Customer = NewCustomerClient(c.config)
+	c.ExchangeRate = NewExchangeRateClient(c.config)
+	c.FiscalDocument = NewFiscalDocumentClient(c.config)
+	c.InventoryCostLayer = NewInventoryCostLayerClient(c.config)
+	c.InventoryCount = NewInventoryCountClient(c.config)
+	c.InventoryCountEntry = NewInventoryCountEntryClient(c.config)
+	c.InventoryCountLine = NewInventoryCountLineClient(c.config)
 	c.Invoice = NewInvoiceClient(c.config)
 	c.InvoiceItem = NewInvoiceItemClient(c.config)
+	c.InvoiceItemComponent = NewInvoiceItemComponentClient(c.config)
+	c.InvoiceLotAllocation = NewInvoiceLotAllocationClient(c.config)
+	c.InvoiceStatusEvent = NewInvoiceStatusEventClient(c.config)
+	c.InvoiceTax = NewInvoiceTaxClient(c.config)
+	c.NumberingSeries = NewNumberingSeriesClient(c.config)
+	c.PriceList = NewPriceListClient(c.config)
+	c.PriceListItem = NewPriceListItemClient(c.config)
 	c.Product = NewProductClient(c.config)
+	c.ProductBarcode = NewProductBarcodeClient(c.config)
+	c.ProductFile = NewProductFileClient(c.config)
+	c.ProductLot = NewProductLotClient(c.config)
+	c.ProductPrice = NewProductPriceClient(c.config)
+	c.ProductSerial = NewProductSerialClient(c.config)
+	c.ProductSerialEvent = NewProductSerialEventClient(c.config)
+	c.ProductUnit = NewProductUnitClient(c.config)
+	c.Promotion = NewPromotionClient(c.config)
+	c.PromotionTarget = NewPromotionTargetClient(c.config)
 	c.PurchaseInvoice = NewPurchaseInvoiceClient(c.config)
 	c.PurchaseInvoiceItem = NewPurchaseInvoiceItemClient(c.config)
+	c.StockAdjustment = NewStockAdjustmentClient(c.config)
+	c.StockAdjustmentLine = NewStockAdjustmentLineClient(c.config)
 	c.Supplier = NewSupplierClient(c.config)
 	c.SupplierPayment = NewSupplierPaymentClient(c.config)
+	c.TaxRate = NewTaxRateClient(c.config)
 	c.Tenant = NewTenantClient(c.config)
 	c.User = NewUserClient(c.config)
 }
@@ -100,17 +224,48 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                 ctx,
-		config:              cfg,
-		Invoice:             NewInvoiceClient(cfg),
-		InvoiceItem:         NewInvoiceItemClient(cfg),
-		Product:             NewProductClient(cfg),
-		PurchaseInvoice:     NewPurchaseInvoiceClient(cfg),
-		PurchaseInvoiceItem: NewPurchaseInvoiceItemClient(cfg),
-		Supplier:            NewSupplierClient(cfg),
-		SupplierPayment:     NewSupplierPaymentClient(cfg),
-		Tenant:              NewTenantClient(cfg),
-		User:                NewUserClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		AdjustmentReason:     NewAdjustmentReasonClient(cfg),
+		AuditLog:             NewAuditLogClient(cfg),
+		BundleAssembly:       NewBundleAssemblyClient(cfg),
+		BundleAssemblyLine:   NewBundleAssemblyLineClient(cfg),
+		BundleComponent:      NewBundleComponentClient(cfg),
+		Customer:             NewCustomerClient(cfg),
+		ExchangeRate:         NewExchangeRateClient(cfg),
+		FiscalDocument:       NewFiscalDocumentClient(cfg),
+		InventoryCostLayer:   NewInventoryCostLayerClient(cfg),
+		InventoryCount:       NewInventoryCountClient(cfg),
+		InventoryCountEntry:  NewInventoryCountEntryClient(cfg),
+		InventoryCountLine:   NewInventoryCountLineClient(cfg),
+		Invoice:              NewInvoiceClient(cfg),
+		InvoiceItem:          NewInvoiceItemClient(cfg),
+		InvoiceItemComponent: NewInvoiceItemComponentClient(cfg),
+		InvoiceLotAllocation: NewInvoiceLotAllocationClient(cfg),
+		InvoiceStatusEvent:   NewInvoiceStatusEventClient(cfg),
+		InvoiceTax:           NewInvoiceTaxClient(cfg),
+		NumberingSeries:      NewNumberingSeriesClient(cfg),
+		PriceList:            NewPriceListClient(cfg),
+		PriceListItem:        NewPriceListItemClient(cfg),
+		Product:              NewProductClient(cfg),
+		ProductBarcode:       NewProductBarcodeClient(cfg),
+		ProductFile:          NewProductFileClient(cfg),
+		ProductLot:           NewProductLotClient(cfg),
+		ProductPrice:         NewProductPriceClient(cfg),
+		ProductSerial:        NewProductSerialClient(cfg),
+		ProductSerialEvent:   NewProductSerialEventClient(cfg),
+		ProductUnit:          NewProductUnitClient(cfg),
+		Promotion:            NewPromotionClient(cfg),
+		PromotionTarget:      NewPromotionTargetClient(cfg),
+		PurchaseInvoice:      NewPurchaseInvoiceClient(cfg),
+		PurchaseInvoiceItem:  NewPurchaseInvoiceItemClient(cfg),
+		StockAdjustment:      NewStockAdjustmentClient(cfg),
+		StockAdjustmentLine:  NewStockAdjustmentLineClient(cfg),
+		Supplier:             NewSupplierClient(cfg),
+		SupplierPayment:      NewSupplierPaymentClient(cfg),
+		TaxRate:              NewTaxRateClient(cfg),
+		Tenant:               NewTenantClient(cfg),
+		User:                 NewUserClient(cfg),
 	}, nil
 }
 
@@ -128,27 +283,57 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                 ctx,
-		config:              cfg,
-		Invoice:             NewInvoiceClient(cfg),
-		InvoiceItem:         NewInvoiceItemClient(cfg),
-		Product:             NewProductClient(cfg),
-		PurchaseInvoice:     NewPurchaseInvoiceClient(cfg),
-		PurchaseInvoiceItem: NewPurchaseInvoiceItemClient(cfg),
-		Supplier:            NewSupplierClient(cfg),
-		SupplierPayment:     NewSupplierPaymentClient(cfg),
-		Tenant:              NewTenantClient(cfg),
-		User:                NewUserClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		AdjustmentReason:     NewAdjustmentReasonClient(cfg),
+		AuditLog:             NewAuditLogClient(cfg),
+		BundleAssembly:       NewBundleAssemblyClient(cfg),
+		BundleAssemblyLine:   NewBundleAssemblyLineClient(cfg),
+		BundleComponent:      NewBundleComponentClient(cfg),
+		Customer:             NewCustomerClient(cfg),
+		ExchangeRate:         NewExchangeRateClient(cfg),
+		FiscalDocument:       NewFiscalDocumentClient(cfg),
+		InventoryCostLayer:   NewInventoryCostLayerClient(cfg),
+		InventoryCount:       NewInventoryCountClient(cfg),
+		InventoryCountEntry:  NewInventoryCountEntryClient(cfg),
+		InventoryCountLine:   NewInventoryCountLineClient(cfg),
+		Invoice:              NewInvoiceClient(cfg),
+		InvoiceItem:          NewInvoiceItemClient(cfg),
+		InvoiceItemComponent: NewInvoiceItemComponentClient(cfg),
+		InvoiceLotAllocation: NewInvoiceLotAllocationClient(cfg),
+		InvoiceStatusEvent:   NewInvoiceStatusEventClient(cfg),
+		InvoiceTax:           NewInvoiceTaxClient(cfg),
+		NumberingSeries:      NewNumberingSeriesClient(cfg),
+		PriceList:            NewPriceListClient(cfg),
+		PriceListItem:        NewPriceListItemClient(cfg),
+		Product:              NewProductClient(cfg),
+		ProductBarcode:       NewProductBarcodeClient(cfg),
+		ProductFile:          NewProductFileClient(cfg),
+		ProductLot:           NewProductLotClient(cfg),
+		ProductPrice:         NewProductPriceClient(cfg),
+		ProductSerial:        NewProductSerialClient(cfg),
+		ProductSerialEvent:   NewProductSerialEventClient(cfg),
+		ProductUnit:          NewProductUnitClient(cfg),
+		Promotion:            NewPromotionClient(cfg),
+		PromotionTarget:      NewPromotionTargetClient(cfg),
+		PurchaseInvoice:      NewPurchaseInvoiceClient(cfg),
+		PurchaseInvoiceItem:  NewPurchaseInvoiceItemClient(cfg),
+		StockAdjustment:      NewStockAdjustmentClient(cfg),
+		StockAdjustmentLine:  NewStockAdjustmentLineClient(cfg),
+		Supplier:             NewSupplierClient(cfg),
+		SupplierPayment:      NewSupplierPaymentClient(cfg),
+		TaxRate:              NewTaxRateClient(cfg),
+		Tenant:               NewTenantClient(cfg),
+		User:                 NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Invoice.
+//		AdjustmentReason.
 //		Query().
 //		Count(ctx)
-//
 func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
@@ -168,95 +353,126 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.AdjustmentReason.Use(hooks...)
+	c.AuditLog.Use(hooks...)
+	c.BundleAssembly.Use(hooks...)
+	c.BundleAssemblyLine.Use(hooks...)
+	c.BundleComponent.Use(hooks...)
+	c.Customer.Use(hooks...)
+	c.ExchangeRate.Use(hooks...)
+	c.FiscalDocument.Use(hooks...)
+	c.InventoryCostLayer.Use(hooks...)
+	c.InventoryCount.Use(hooks...)
+	c.InventoryCountEntry.Use(hooks...)
+	c.InventoryCountLine.Use(hooks...)
 	c.Invoice.Use(hooks...)
 	c.InvoiceItem.Use(hooks...)
+	c.InvoiceItemComponent.Use(hooks...)
+	c.InvoiceLotAllocation.Use(hooks...)
+	c.InvoiceStatusEvent.Use(hooks...)
+	c.InvoiceTax.Use(hooks...)
+	c.NumberingSeries.Use(hooks...)
+	c.PriceList.Use(hooks...)
+	c.PriceListItem.Use(hooks...)
 	c.Product.Use(hooks...)
+	c.ProductBarcode.Use(hooks...)
+	c.ProductFile.Use(hooks...)
+	c.ProductLot.Use(hooks...)
+	c.ProductPrice.Use(hooks...)
+	c.ProductSerial.Use(hooks...)
+	c.ProductSerialEvent.Use(hooks...)
+	c.ProductUnit.Use(hooks...)
+	c.Promotion.Use(hooks...)
+	c.PromotionTarget.Use(hooks...)
 	c.PurchaseInvoice.Use(hooks...)
 	c.PurchaseInvoiceItem.Use(hooks...)
+	c.StockAdjustment.Use(hooks...)
+	c.StockAdjustmentLine.Use(hooks...)
 	c.Supplier.Use(hooks...)
 	c.SupplierPayment.Use(hooks...)
+	c.TaxRate.Use(hooks...)
 	c.Tenant.Use(hooks...)
 	c.User.Use(hooks...)
 }
 
-// InvoiceClient is a client for the Invoice schema.
-type InvoiceClient struct {
+// AdjustmentReasonClient is a client for the AdjustmentReason schema.
+type AdjustmentReasonClient struct {
 	config
 }
 
-// NewInvoiceClient returns a client for the Invoice from the given config.
-func NewInvoiceClient(c config) *InvoiceClient {
-	return &InvoiceClient{config: c}
+// NewAdjustmentReasonClient returns a client for the AdjustmentReason from the given config.
+func NewAdjustmentReasonClient(c config) *AdjustmentReasonClient {
+	return &AdjustmentReasonClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `invoice.Hooks(f(g(h())))`.
-func (c *InvoiceClient) Use(hooks ...Hook) {
-	c.hooks.Invoice = append(c.hooks.Invoice, hooks...)
+// A call to `Use(f, g, h)` equals to `adjustmentreason.Hooks(f(g(h())))`.
+func (c *AdjustmentReasonClient) Use(hooks ...Hook) {
+	c.hooks.AdjustmentReason = append(c.hooks.AdjustmentReason, hooks...)
 }
 
-// Create returns a builder for creating a Invoice entity.
-func (c *InvoiceClient) Create() *InvoiceCreate {
-	mutation := newInvoiceMutation(c.config, OpCreate)
-	return &InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AdjustmentReason entity.
+func (c *AdjustmentReasonClient) Create() *AdjustmentReasonCreate {
+	mutation := newAdjustmentReasonMutation(c.config, OpCreate)
+	return &AdjustmentReasonCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Invoice entities.
-func (c *InvoiceClient) CreateBulk(builders ...*InvoiceCreate) *InvoiceCreateBulk {
-	return &InvoiceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AdjustmentReason entities.
+func (c *AdjustmentReasonClient) CreateBulk(builders ...*AdjustmentReasonCreate) *AdjustmentReasonCreateBulk {
+	return &AdjustmentReasonCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Invoice.
-func (c *InvoiceClient) Update() *InvoiceUpdate {
-	mutation := newInvoiceMutation(c.config, OpUpdate)
-	return &InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AdjustmentReason.
+func (c *AdjustmentReasonClient) Update() *AdjustmentReasonUpdate {
+	mutation := newAdjustmentReasonMutation(c.config, OpUpdate)
+	return &AdjustmentReasonUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InvoiceClient) UpdateOne(i *Invoice) *InvoiceUpdateOne {
-	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoice(i))
-	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdjustmentReasonClient) UpdateOne(ar *AdjustmentReason) *AdjustmentReasonUpdateOne {
+	mutation := newAdjustmentReasonMutation(c.config, OpUpdateOne, withAdjustmentReason(ar))
+	return &AdjustmentReasonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InvoiceClient) UpdateOneID(id int) *InvoiceUpdateOne {
-	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoiceID(id))
-	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdjustmentReasonClient) UpdateOneID(id int) *AdjustmentReasonUpdateOne {
+	mutation := newAdjustmentReasonMutation(c.config, OpUpdateOne, withAdjustmentReasonID(id))
+	return &AdjustmentReasonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Invoice.
-func (c *InvoiceClient) Delete() *InvoiceDelete {
-	mutation := newInvoiceMutation(c.config, OpDelete)
-	return &InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AdjustmentReason.
+func (c *AdjustmentReasonClient) Delete() *AdjustmentReasonDelete {
+	mutation := newAdjustmentReasonMutation(c.config, OpDelete)
+	return &AdjustmentReasonDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InvoiceClient) DeleteOne(i *Invoice) *InvoiceDeleteOne {
-	return c.DeleteOneID(i.ID)
+func (c *AdjustmentReasonClient) DeleteOne(ar *AdjustmentReason) *AdjustmentReasonDeleteOne {
+	return c.DeleteOneID(ar.ID)
 }
 
 // DeleteOne returns a builder for deleting the given entity by its id.
-func (c *InvoiceClient) DeleteOneID(id int) *InvoiceDeleteOne {
-	builder := c.Delete().Where(invoice.ID(id))
+func (c *AdjustmentReasonClient) DeleteOneID(id int) *AdjustmentReasonDeleteOne {
+	builder := c.Delete().Where(adjustmentreason.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InvoiceDeleteOne{builder}
+	return &AdjustmentReasonDeleteOne{builder}
 }
 
-// Query returns a query builder for Invoice.
-func (c *InvoiceClient) Query() *InvoiceQuery {
-	return &InvoiceQuery{
+// Query returns a query builder for AdjustmentReason.
+func (c *AdjustmentReasonClient) Query() *AdjustmentReasonQuery {
+	return &AdjustmentReasonQuery{
 		config: c.config,
 	}
 }
 
-// Get returns a Invoice entity by its id.
-func (c *InvoiceClient) Get(ctx context.Context, id int) (*Invoice, error) {
-	return c.Query().Where(invoice.ID(id)).Only(ctx)
+// Get returns a AdjustmentReason entity by its id.
+func (c *AdjustmentReasonClient) Get(ctx context.Context, id int) (*AdjustmentReason, error) {
+	return c.Query().Where(adjustmentreason.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InvoiceClient) GetX(ctx context.Context, id int) *Invoice {
+func (c *AdjustmentReasonClient) GetX(ctx context.Context, id int) *AdjustmentReason {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -264,105 +480,2657 @@ func (c *InvoiceClient) GetX(ctx context.Context, id int) *Invoice {
 	return obj
 }
 
-// QueryItems queries the items edge of a Invoice.
-func (c *InvoiceClient) QueryItems(i *Invoice) *InvoiceItemQuery {
-	query := &InvoiceItemQuery{config: c.config}
-	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(invoice.Table, invoice.FieldID, id),
-			sqlgraph.To(invoiceitem.Table, invoiceitem.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, invoice.ItemsTable, invoice.ItemsColumn),
-		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *AdjustmentReasonClient) Hooks() []Hook {
+	return c.hooks.AdjustmentReason
+}
+
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(al))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id int) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id int) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id int) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id int) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
+}
+
+// BundleAssemblyClient is a client for the BundleAssembly schema.
+type BundleAssemblyClient struct {
+	config
+}
+
+// NewBundleAssemblyClient returns a client for the BundleAssembly from the given config.
+func NewBundleAssemblyClient(c config) *BundleAssemblyClient {
+	return &BundleAssemblyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bundleassembly.Hooks(f(g(h())))`.
+func (c *BundleAssemblyClient) Use(hooks ...Hook) {
+	c.hooks.BundleAssembly = append(c.hooks.BundleAssembly, hooks...)
+}
+
+// Create returns a builder for creating a BundleAssembly entity.
+func (c *BundleAssemblyClient) Create() *BundleAssemblyCreate {
+	mutation := newBundleAssemblyMutation(c.config, OpCreate)
+	return &BundleAssemblyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BundleAssembly entities.
+func (c *BundleAssemblyClient) CreateBulk(builders ...*BundleAssemblyCreate) *BundleAssemblyCreateBulk {
+	return &BundleAssemblyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BundleAssembly.
+func (c *BundleAssemblyClient) Update() *BundleAssemblyUpdate {
+	mutation := newBundleAssemblyMutation(c.config, OpUpdate)
+	return &BundleAssemblyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BundleAssemblyClient) UpdateOne(ba *BundleAssembly) *BundleAssemblyUpdateOne {
+	mutation := newBundleAssemblyMutation(c.config, OpUpdateOne, withBundleAssembly(ba))
+	return &BundleAssemblyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BundleAssemblyClient) UpdateOneID(id int) *BundleAssemblyUpdateOne {
+	mutation := newBundleAssemblyMutation(c.config, OpUpdateOne, withBundleAssemblyID(id))
+	return &BundleAssemblyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BundleAssembly.
+func (c *BundleAssemblyClient) Delete() *BundleAssemblyDelete {
+	mutation := newBundleAssemblyMutation(c.config, OpDelete)
+	return &BundleAssemblyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BundleAssemblyClient) DeleteOne(ba *BundleAssembly) *BundleAssemblyDeleteOne {
+	return c.DeleteOneID(ba.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *BundleAssemblyClient) DeleteOneID(id int) *BundleAssemblyDeleteOne {
+	builder := c.Delete().Where(bundleassembly.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BundleAssemblyDeleteOne{builder}
+}
+
+// Query returns a query builder for BundleAssembly.
+func (c *BundleAssemblyClient) Query() *BundleAssemblyQuery {
+	return &BundleAssemblyQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a BundleAssembly entity by its id.
+func (c *BundleAssemblyClient) Get(ctx context.Context, id int) (*BundleAssembly, error) {
+	return c.Query().Where(bundleassembly.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BundleAssemblyClient) GetX(ctx context.Context, id int) *BundleAssembly {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BundleAssemblyClient) Hooks() []Hook {
+	return c.hooks.BundleAssembly
+}
+
+// BundleAssemblyLineClient is a client for the BundleAssemblyLine schema.
+type BundleAssemblyLineClient struct {
+	config
+}
+
+// NewBundleAssemblyLineClient returns a client for the BundleAssemblyLine from the given config.
+func NewBundleAssemblyLineClient(c config) *BundleAssemblyLineClient {
+	return &BundleAssemblyLineClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bundleassemblyline.Hooks(f(g(h())))`.
+func (c *BundleAssemblyLineClient) Use(hooks ...Hook) {
+	c.hooks.BundleAssemblyLine = append(c.hooks.BundleAssemblyLine, hooks...)
+}
+
+// Create returns a builder for creating a BundleAssemblyLine entity.
+func (c *BundleAssemblyLineClient) Create() *BundleAssemblyLineCreate {
+	mutation := newBundleAssemblyLineMutation(c.config, OpCreate)
+	return &BundleAssemblyLineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BundleAssemblyLine entities.
+func (c *BundleAssemblyLineClient) CreateBulk(builders ...*BundleAssemblyLineCreate) *BundleAssemblyLineCreateBulk {
+	return &BundleAssemblyLineCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BundleAssemblyLine.
+func (c *BundleAssemblyLineClient) Update() *BundleAssemblyLineUpdate {
+	mutation := newBundleAssemblyLineMutation(c.config, OpUpdate)
+	return &BundleAssemblyLineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BundleAssemblyLineClient) UpdateOne(bal *BundleAssemblyLine) *BundleAssemblyLineUpdateOne {
+	mutation := newBundleAssemblyLineMutation(c.config, OpUpdateOne, withBundleAssemblyLine(bal))
+	return &BundleAssemblyLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BundleAssemblyLineClient) UpdateOneID(id int) *BundleAssemblyLineUpdateOne {
+	mutation := newBundleAssemblyLineMutation(c.config, OpUpdateOne, withBundleAssemblyLineID(id))
+	return &BundleAssemblyLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BundleAssemblyLine.
+func (c *BundleAssemblyLineClient) Delete() *BundleAssemblyLineDelete {
+	mutation := newBundleAssemblyLineMutation(c.config, OpDelete)
+	return &BundleAssemblyLineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BundleAssemblyLineClient) DeleteOne(bal *BundleAssemblyLine) *BundleAssemblyLineDeleteOne {
+	return c.DeleteOneID(bal.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *BundleAssemblyLineClient) DeleteOneID(id int) *BundleAssemblyLineDeleteOne {
+	builder := c.Delete().Where(bundleassemblyline.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BundleAssemblyLineDeleteOne{builder}
+}
+
+// Query returns a query builder for BundleAssemblyLine.
+func (c *BundleAssemblyLineClient) Query() *BundleAssemblyLineQuery {
+	return &BundleAssemblyLineQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a BundleAssemblyLine entity by its id.
+func (c *BundleAssemblyLineClient) Get(ctx context.Context, id int) (*BundleAssemblyLine, error) {
+	return c.Query().Where(bundleassemblyline.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BundleAssemblyLineClient) GetX(ctx context.Context, id int) *BundleAssemblyLine {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BundleAssemblyLineClient) Hooks() []Hook {
+	return c.hooks.BundleAssemblyLine
+}
+
+// BundleComponentClient is a client for the BundleComponent schema.
+type BundleComponentClient struct {
+	config
+}
+
+// NewBundleComponentClient returns a client for the BundleComponent from the given config.
+func NewBundleComponentClient(c config) *BundleComponentClient {
+	return &BundleComponentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bundlecomponent.Hooks(f(g(h())))`.
+func (c *BundleComponentClient) Use(hooks ...Hook) {
+	c.hooks.BundleComponent = append(c.hooks.BundleComponent, hooks...)
+}
+
+// Create returns a builder for creating a BundleComponent entity.
+func (c *BundleComponentClient) Create() *BundleComponentCreate {
+	mutation := newBundleComponentMutation(c.config, OpCreate)
+	return &BundleComponentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BundleComponent entities.
+func (c *BundleComponentClient) CreateBulk(builders ...*BundleComponentCreate) *BundleComponentCreateBulk {
+	return &BundleComponentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BundleComponent.
+func (c *BundleComponentClient) Update() *BundleComponentUpdate {
+	mutation := newBundleComponentMutation(c.config, OpUpdate)
+	return &BundleComponentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BundleComponentClient) UpdateOne(bc *BundleComponent) *BundleComponentUpdateOne {
+	mutation := newBundleComponentMutation(c.config, OpUpdateOne, withBundleComponent(bc))
+	return &BundleComponentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BundleComponentClient) UpdateOneID(id int) *BundleComponentUpdateOne {
+	mutation := newBundleComponentMutation(c.config, OpUpdateOne, withBundleComponentID(id))
+	return &BundleComponentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BundleComponent.
+func (c *BundleComponentClient) Delete() *BundleComponentDelete {
+	mutation := newBundleComponentMutation(c.config, OpDelete)
+	return &BundleComponentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BundleComponentClient) DeleteOne(bc *BundleComponent) *BundleComponentDeleteOne {
+	return c.DeleteOneID(bc.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *BundleComponentClient) DeleteOneID(id int) *BundleComponentDeleteOne {
+	builder := c.Delete().Where(bundlecomponent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BundleComponentDeleteOne{builder}
+}
+
+// Query returns a query builder for BundleComponent.
+func (c *BundleComponentClient) Query() *BundleComponentQuery {
+	return &BundleComponentQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a BundleComponent entity by its id.
+func (c *BundleComponentClient) Get(ctx context.Context, id int) (*BundleComponent, error) {
+	return c.Query().Where(bundlecomponent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BundleComponentClient) GetX(ctx context.Context, id int) *BundleComponent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BundleComponentClient) Hooks() []Hook {
+	return c.hooks.BundleComponent
+}
+
+// CustomerClient is a client for the Customer schema.
+type CustomerClient struct {
+	config
+}
+
+// NewCustomerClient returns a client for the Customer from the given config.
+func NewCustomerClient(c config) *CustomerClient {
+	return &CustomerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `customer.Hooks(f(g(h())))`.
+func (c *CustomerClient) Use(hooks ...Hook) {
+	c.hooks.Customer = append(c.hooks.Customer, hooks...)
+}
+
+// Create returns a builder for creating a Customer entity.
+func (c *CustomerClient) Create() *CustomerCreate {
+	mutation := newCustomerMutation(c.config, OpCreate)
+	return &CustomerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Customer entities.
+func (c *CustomerClient) CreateBulk(builders ...*CustomerCreate) *CustomerCreateBulk {
+	return &CustomerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Customer.
+func (c *CustomerClient) Update() *CustomerUpdate {
+	mutation := newCustomerMutation(c.config, OpUpdate)
+	return &CustomerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CustomerClient) UpdateOne(cu *Customer) *CustomerUpdateOne {
+	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomer(cu))
+	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CustomerClient) UpdateOneID(id int) *CustomerUpdateOne {
+	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomerID(id))
+	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Customer.
+func (c *CustomerClient) Delete() *CustomerDelete {
+	mutation := newCustomerMutation(c.config, OpDelete)
+	return &CustomerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CustomerClient) DeleteOne(cu *Customer) *CustomerDeleteOne {
+	return c.DeleteOneID(cu.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *CustomerClient) DeleteOneID(id int) *CustomerDeleteOne {
+	builder := c.Delete().Where(customer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CustomerDeleteOne{builder}
+}
+
+// Query returns a query builder for Customer.
+func (c *CustomerClient) Query() *CustomerQuery {
+	return &CustomerQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Customer entity by its id.
+func (c *CustomerClient) Get(ctx context.Context, id int) (*Customer, error) {
+	return c.Query().Where(customer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CustomerClient) GetX(ctx context.Context, id int) *Customer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPriceList queries the price_list edge of a Customer.
+func (c *CustomerClient) QueryPriceList(cu *Customer) *PriceListQuery {
+	query := &PriceListQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cu.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customer.Table, customer.FieldID, id),
+			sqlgraph.To(pricelist.Table, pricelist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, customer.PriceListTable, customer.PriceListColumn),
+		)
+		fromV = sqlgraph.Neighbors(cu.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CustomerClient) Hooks() []Hook {
+	return c.hooks.Customer
+}
+
+// ExchangeRateClient is a client for the ExchangeRate schema.
+type ExchangeRateClient struct {
+	config
+}
+
+// NewExchangeRateClient returns a client for the ExchangeRate from the given config.
+func NewExchangeRateClient(c config) *ExchangeRateClient {
+	return &ExchangeRateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `exchangerate.Hooks(f(g(h())))`.
+func (c *ExchangeRateClient) Use(hooks ...Hook) {
+	c.hooks.ExchangeRate = append(c.hooks.ExchangeRate, hooks...)
+}
+
+// Create returns a builder for creating a ExchangeRate entity.
+func (c *ExchangeRateClient) Create() *ExchangeRateCreate {
+	mutation := newExchangeRateMutation(c.config, OpCreate)
+	return &ExchangeRateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExchangeRate entities.
+func (c *ExchangeRateClient) CreateBulk(builders ...*ExchangeRateCreate) *ExchangeRateCreateBulk {
+	return &ExchangeRateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExchangeRate.
+func (c *ExchangeRateClient) Update() *ExchangeRateUpdate {
+	mutation := newExchangeRateMutation(c.config, OpUpdate)
+	return &ExchangeRateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExchangeRateClient) UpdateOne(er *ExchangeRate) *ExchangeRateUpdateOne {
+	mutation := newExchangeRateMutation(c.config, OpUpdateOne, withExchangeRate(er))
+	return &ExchangeRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExchangeRateClient) UpdateOneID(id int) *ExchangeRateUpdateOne {
+	mutation := newExchangeRateMutation(c.config, OpUpdateOne, withExchangeRateID(id))
+	return &ExchangeRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExchangeRate.
+func (c *ExchangeRateClient) Delete() *ExchangeRateDelete {
+	mutation := newExchangeRateMutation(c.config, OpDelete)
+	return &ExchangeRateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExchangeRateClient) DeleteOne(er *ExchangeRate) *ExchangeRateDeleteOne {
+	return c.DeleteOneID(er.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ExchangeRateClient) DeleteOneID(id int) *ExchangeRateDeleteOne {
+	builder := c.Delete().Where(exchangerate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExchangeRateDeleteOne{builder}
+}
+
+// Query returns a query builder for ExchangeRate.
+func (c *ExchangeRateClient) Query() *ExchangeRateQuery {
+	return &ExchangeRateQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ExchangeRate entity by its id.
+func (c *ExchangeRateClient) Get(ctx context.Context, id int) (*ExchangeRate, error) {
+	return c.Query().Where(exchangerate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExchangeRateClient) GetX(ctx context.Context, id int) *ExchangeRate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExchangeRateClient) Hooks() []Hook {
+	return c.hooks.ExchangeRate
+}
+
+// FiscalDocumentClient is a client for the FiscalDocument schema.
+type FiscalDocumentClient struct {
+	config
+}
+
+// NewFiscalDocumentClient returns a client for the FiscalDocument from the given config.
+func NewFiscalDocumentClient(c config) *FiscalDocumentClient {
+	return &FiscalDocumentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fiscaldocument.Hooks(f(g(h())))`.
+func (c *FiscalDocumentClient) Use(hooks ...Hook) {
+	c.hooks.FiscalDocument = append(c.hooks.FiscalDocument, hooks...)
+}
+
+// Create returns a builder for creating a FiscalDocument entity.
+func (c *FiscalDocumentClient) Create() *FiscalDocumentCreate {
+	mutation := newFiscalDocumentMutation(c.config, OpCreate)
+	return &FiscalDocumentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FiscalDocument entities.
+func (c *FiscalDocumentClient) CreateBulk(builders ...*FiscalDocumentCreate) *FiscalDocumentCreateBulk {
+	return &FiscalDocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FiscalDocument.
+func (c *FiscalDocumentClient) Update() *FiscalDocumentUpdate {
+	mutation := newFiscalDocumentMutation(c.config, OpUpdate)
+	return &FiscalDocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FiscalDocumentClient) UpdateOne(fd *FiscalDocument) *FiscalDocumentUpdateOne {
+	mutation := newFiscalDocumentMutation(c.config, OpUpdateOne, withFiscalDocument(fd))
+	return &FiscalDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FiscalDocumentClient) UpdateOneID(id int) *FiscalDocumentUpdateOne {
+	mutation := newFiscalDocumentMutation(c.config, OpUpdateOne, withFiscalDocumentID(id))
+	return &FiscalDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FiscalDocument.
+func (c *FiscalDocumentClient) Delete() *FiscalDocumentDelete {
+	mutation := newFiscalDocumentMutation(c.config, OpDelete)
+	return &FiscalDocumentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FiscalDocumentClient) DeleteOne(fd *FiscalDocument) *FiscalDocumentDeleteOne {
+	return c.DeleteOneID(fd.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *FiscalDocumentClient) DeleteOneID(id int) *FiscalDocumentDeleteOne {
+	builder := c.Delete().Where(fiscaldocument.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FiscalDocumentDeleteOne{builder}
+}
+
+// Query returns a query builder for FiscalDocument.
+func (c *FiscalDocumentClient) Query() *FiscalDocumentQuery {
+	return &FiscalDocumentQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a FiscalDocument entity by its id.
+func (c *FiscalDocumentClient) Get(ctx context.Context, id int) (*FiscalDocument, error) {
+	return c.Query().Where(fiscaldocument.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FiscalDocumentClient) GetX(ctx context.Context, id int) *FiscalDocument {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FiscalDocumentClient) Hooks() []Hook {
+	return c.hooks.FiscalDocument
+}
+
+// InventoryCostLayerClient is a client for the InventoryCostLayer schema.
+type InventoryCostLayerClient struct {
+	config
+}
+
+// NewInventoryCostLayerClient returns a client for the InventoryCostLayer from the given config.
+func NewInventoryCostLayerClient(c config) *InventoryCostLayerClient {
+	return &InventoryCostLayerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `inventorycostlayer.Hooks(f(g(h())))`.
+func (c *InventoryCostLayerClient) Use(hooks ...Hook) {
+	c.hooks.InventoryCostLayer = append(c.hooks.InventoryCostLayer, hooks...)
+}
+
+// Create returns a builder for creating a InventoryCostLayer entity.
+func (c *InventoryCostLayerClient) Create() *InventoryCostLayerCreate {
+	mutation := newInventoryCostLayerMutation(c.config, OpCreate)
+	return &InventoryCostLayerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InventoryCostLayer entities.
+func (c *InventoryCostLayerClient) CreateBulk(builders ...*InventoryCostLayerCreate) *InventoryCostLayerCreateBulk {
+	return &InventoryCostLayerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InventoryCostLayer.
+func (c *InventoryCostLayerClient) Update() *InventoryCostLayerUpdate {
+	mutation := newInventoryCostLayerMutation(c.config, OpUpdate)
+	return &InventoryCostLayerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InventoryCostLayerClient) UpdateOne(icl *InventoryCostLayer) *InventoryCostLayerUpdateOne {
+	mutation := newInventoryCostLayerMutation(c.config, OpUpdateOne, withInventoryCostLayer(icl))
+	return &InventoryCostLayerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InventoryCostLayerClient) UpdateOneID(id int) *InventoryCostLayerUpdateOne {
+	mutation := newInventoryCostLayerMutation(c.config, OpUpdateOne, withInventoryCostLayerID(id))
+	return &InventoryCostLayerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InventoryCostLayer.
+func (c *InventoryCostLayerClient) Delete() *InventoryCostLayerDelete {
+	mutation := newInventoryCostLayerMutation(c.config, OpDelete)
+	return &InventoryCostLayerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InventoryCostLayerClient) DeleteOne(icl *InventoryCostLayer) *InventoryCostLayerDeleteOne {
+	return c.DeleteOneID(icl.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *InventoryCostLayerClient) DeleteOneID(id int) *InventoryCostLayerDeleteOne {
+	builder := c.Delete().Where(inventorycostlayer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InventoryCostLayerDeleteOne{builder}
+}
+
+// Query returns a query builder for InventoryCostLayer.
+func (c *InventoryCostLayerClient) Query() *InventoryCostLayerQuery {
+	return &InventoryCostLayerQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a InventoryCostLayer entity by its id.
+func (c *InventoryCostLayerClient) Get(ctx context.Context, id int) (*InventoryCostLayer, error) {
+	return c.Query().Where(inventorycostlayer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InventoryCostLayerClient) GetX(ctx context.Context, id int) *InventoryCostLayer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InventoryCostLayerClient) Hooks() []Hook {
+	return c.hooks.InventoryCostLayer
+}
+
+// InventoryCountClient is a client for the InventoryCount schema.
+type InventoryCountClient struct {
+	config
+}
+
+// NewInventoryCountClient returns a client for the InventoryCount from the given config.
+func NewInventoryCountClient(c config) *InventoryCountClient {
+	return &InventoryCountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `inventorycount.Hooks(f(g(h())))`.
+func (c *InventoryCountClient) Use(hooks ...Hook) {
+	c.hooks.InventoryCount = append(c.hooks.InventoryCount, hooks...)
+}
+
+// Create returns a builder for creating a InventoryCount entity.
+func (c *InventoryCountClient) Create() *InventoryCountCreate {
+	mutation := newInventoryCountMutation(c.config, OpCreate)
+	return &InventoryCountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InventoryCount entities.
+func (c *InventoryCountClient) CreateBulk(builders ...*InventoryCountCreate) *InventoryCountCreateBulk {
+	return &InventoryCountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InventoryCount.
+func (c *InventoryCountClient) Update() *InventoryCountUpdate {
+	mutation := newInventoryCountMutation(c.config, OpUpdate)
+	return &InventoryCountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InventoryCountClient) UpdateOne(ic *InventoryCount) *InventoryCountUpdateOne {
+	mutation := newInventoryCountMutation(c.config, OpUpdateOne, withInventoryCount(ic))
+	return &InventoryCountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InventoryCountClient) UpdateOneID(id int) *InventoryCountUpdateOne {
+	mutation := newInventoryCountMutation(c.config, OpUpdateOne, withInventoryCountID(id))
+	return &InventoryCountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InventoryCount.
+func (c *InventoryCountClient) Delete() *InventoryCountDelete {
+	mutation := newInventoryCountMutation(c.config, OpDelete)
+	return &InventoryCountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InventoryCountClient) DeleteOne(ic *InventoryCount) *InventoryCountDeleteOne {
+	return c.DeleteOneID(ic.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *InventoryCountClient) DeleteOneID(id int) *InventoryCountDeleteOne {
+	builder := c.Delete().Where(inventorycount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InventoryCountDeleteOne{builder}
+}
+
+// Query returns a query builder for InventoryCount.
+func (c *InventoryCountClient) Query() *InventoryCountQuery {
+	return &InventoryCountQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a InventoryCount entity by its id.
+func (c *InventoryCountClient) Get(ctx context.Context, id int) (*InventoryCount, error) {
+	return c.Query().Where(inventorycount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InventoryCountClient) GetX(ctx context.Context, id int) *InventoryCount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InventoryCountClient) Hooks() []Hook {
+	return c.hooks.InventoryCount
+}
+
+// InventoryCountEntryClient is a client for the InventoryCountEntry schema.
+type InventoryCountEntryClient struct {
+	config
+}
+
+// NewInventoryCountEntryClient returns a client for the InventoryCountEntry from the given config.
+func NewInventoryCountEntryClient(c config) *InventoryCountEntryClient {
+	return &InventoryCountEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `inventorycountentry.Hooks(f(g(h())))`.
+func (c *InventoryCountEntryClient) Use(hooks ...Hook) {
+	c.hooks.InventoryCountEntry = append(c.hooks.InventoryCountEntry, hooks...)
+}
+
+// Create returns a builder for creating a InventoryCountEntry entity.
+func (c *InventoryCountEntryClient) Create() *InventoryCountEntryCreate {
+	mutation := newInventoryCountEntryMutation(c.config, OpCreate)
+	return &InventoryCountEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InventoryCountEntry entities.
+func (c *InventoryCountEntryClient) CreateBulk(builders ...*InventoryCountEntryCreate) *InventoryCountEntryCreateBulk {
+	return &InventoryCountEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InventoryCountEntry.
+func (c *InventoryCountEntryClient) Update() *InventoryCountEntryUpdate {
+	mutation := newInventoryCountEntryMutation(c.config, OpUpdate)
+	return &InventoryCountEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InventoryCountEntryClient) UpdateOne(ice *InventoryCountEntry) *InventoryCountEntryUpdateOne {
+	mutation := newInventoryCountEntryMutation(c.config, OpUpdateOne, withInventoryCountEntry(ice))
+	return &InventoryCountEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InventoryCountEntryClient) UpdateOneID(id int) *InventoryCountEntryUpdateOne {
+	mutation := newInventoryCountEntryMutation(c.config, OpUpdateOne, withInventoryCountEntryID(id))
+	return &InventoryCountEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InventoryCountEntry.
+func (c *InventoryCountEntryClient) Delete() *InventoryCountEntryDelete {
+	mutation := newInventoryCountEntryMutation(c.config, OpDelete)
+	return &InventoryCountEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InventoryCountEntryClient) DeleteOne(ice *InventoryCountEntry) *InventoryCountEntryDeleteOne {
+	return c.DeleteOneID(ice.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *InventoryCountEntryClient) DeleteOneID(id int) *InventoryCountEntryDeleteOne {
+	builder := c.Delete().Where(inventorycountentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InventoryCountEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for InventoryCountEntry.
+func (c *InventoryCountEntryClient) Query() *InventoryCountEntryQuery {
+	return &InventoryCountEntryQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a InventoryCountEntry entity by its id.
+func (c *InventoryCountEntryClient) Get(ctx context.Context, id int) (*InventoryCountEntry, error) {
+	return c.Query().Where(inventorycountentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InventoryCountEntryClient) GetX(ctx context.Context, id int) *InventoryCountEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InventoryCountEntryClient) Hooks() []Hook {
+	return c.hooks.InventoryCountEntry
+}
+
+// InventoryCountLineClient is a client for the InventoryCountLine schema.
+type InventoryCountLineClient struct {
+	config
+}
+
+// NewInventoryCountLineClient returns a client for the InventoryCountLine from the given config.
+func NewInventoryCountLineClient(c config) *InventoryCountLineClient {
+	return &InventoryCountLineClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `inventorycountline.Hooks(f(g(h())))`.
+func (c *InventoryCountLineClient) Use(hooks ...Hook) {
+	c.hooks.InventoryCountLine = append(c.hooks.InventoryCountLine, hooks...)
+}
+
+// Create returns a builder for creating a InventoryCountLine entity.
+func (c *InventoryCountLineClient) Create() *InventoryCountLineCreate {
+	mutation := newInventoryCountLineMutation(c.config, OpCreate)
+	return &InventoryCountLineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InventoryCountLine entities.
+func (c *InventoryCountLineClient) CreateBulk(builders ...*InventoryCountLineCreate) *InventoryCountLineCreateBulk {
+	return &InventoryCountLineCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InventoryCountLine.
+func (c *InventoryCountLineClient) Update() *InventoryCountLineUpdate {
+	mutation := newInventoryCountLineMutation(c.config, OpUpdate)
+	return &InventoryCountLineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InventoryCountLineClient) UpdateOne(icl *InventoryCountLine) *InventoryCountLineUpdateOne {
+	mutation := newInventoryCountLineMutation(c.config, OpUpdateOne, withInventoryCountLine(icl))
+	return &InventoryCountLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InventoryCountLineClient) UpdateOneID(id int) *InventoryCountLineUpdateOne {
+	mutation := newInventoryCountLineMutation(c.config, OpUpdateOne, withInventoryCountLineID(id))
+	return &InventoryCountLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InventoryCountLine.
+func (c *InventoryCountLineClient) Delete() *InventoryCountLineDelete {
+	mutation := newInventoryCountLineMutation(c.config, OpDelete)
+	return &InventoryCountLineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InventoryCountLineClient) DeleteOne(icl *InventoryCountLine) *InventoryCountLineDeleteOne {
+	return c.DeleteOneID(icl.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *InventoryCountLineClient) DeleteOneID(id int) *InventoryCountLineDeleteOne {
+	builder := c.Delete().Where(inventorycountline.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InventoryCountLineDeleteOne{builder}
+}
+
+// Query returns a query builder for InventoryCountLine.
+func (c *InventoryCountLineClient) Query() *InventoryCountLineQuery {
+	return &InventoryCountLineQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a InventoryCountLine entity by its id.
+func (c *InventoryCountLineClient) Get(ctx context.Context, id int) (*InventoryCountLine, error) {
+	return c.Query().Where(inventorycountline.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InventoryCountLineClient) GetX(ctx context.Context, id int) *InventoryCountLine {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InventoryCountLineClient) Hooks() []Hook {
+	return c.hooks.InventoryCountLine
+}
+
+// InvoiceClient is a client for the Invoice schema.
+type InvoiceClient struct {
+	config
+}
+
+// NewInvoiceClient returns a client for the Invoice from the given config.
+func NewInvoiceClient(c config) *InvoiceClient {
+	return &InvoiceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoice.Hooks(f(g(h())))`.
+func (c *InvoiceClient) Use(hooks ...Hook) {
+	c.hooks.Invoice = append(c.hooks.Invoice, hooks...)
+}
+
+// Create returns a builder for creating a Invoice entity.
+func (c *InvoiceClient) Create() *InvoiceCreate {
+	mutation := newInvoiceMutation(c.config, OpCreate)
+	return &InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invoice entities.
+func (c *InvoiceClient) CreateBulk(builders ...*InvoiceCreate) *InvoiceCreateBulk {
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invoice.
+func (c *InvoiceClient) Update() *InvoiceUpdate {
+	mutation := newInvoiceMutation(c.config, OpUpdate)
+	return &InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceClient) UpdateOne(i *Invoice) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoice(i))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceClient) UpdateOneID(id int) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoiceID(id))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invoice.
+func (c *InvoiceClient) Delete() *InvoiceDelete {
+	mutation := newInvoiceMutation(c.config, OpDelete)
+	return &InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceClient) DeleteOne(i *Invoice) *InvoiceDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *InvoiceClient) DeleteOneID(id int) *InvoiceDeleteOne {
+	builder := c.Delete().Where(invoice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceDeleteOne{builder}
+}
+
+// Query returns a query builder for Invoice.
+func (c *InvoiceClient) Query() *InvoiceQuery {
+	return &InvoiceQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Invoice entity by its id.
+func (c *InvoiceClient) Get(ctx context.Context, id int) (*Invoice, error) {
+	return c.Query().Where(invoice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceClient) GetX(ctx context.Context, id int) *Invoice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItems queries the items edge of a Invoice.
+func (c *InvoiceClient) QueryItems(i *Invoice) *InvoiceItemQuery {
+	query := &InvoiceItemQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(invoiceitem.Table, invoiceitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, invoice.ItemsTable, invoice.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceClient) Hooks() []Hook {
+	return c.hooks.Invoice
+}
+
+// InvoiceItemClient is a client for the InvoiceItem schema.
+type InvoiceItemClient struct {
+	config
+}
+
+// NewInvoiceItemClient returns a client for the InvoiceItem from the given config.
+func NewInvoiceItemClient(c config) *InvoiceItemClient {
+	return &InvoiceItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoiceitem.Hooks(f(g(h())))`.
+func (c *InvoiceItemClient) Use(hooks ...Hook) {
+	c.hooks.InvoiceItem = append(c.hooks.InvoiceItem, hooks...)
+}
+
+// Create returns a builder for creating a InvoiceItem entity.
+func (c *InvoiceItemClient) Create() *InvoiceItemCreate {
+	mutation := newInvoiceItemMutation(c.config, OpCreate)
+	return &InvoiceItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InvoiceItem entities.
+func (c *InvoiceItemClient) CreateBulk(builders ...*InvoiceItemCreate) *InvoiceItemCreateBulk {
+	return &InvoiceItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InvoiceItem.
+func (c *InvoiceItemClient) Update() *InvoiceItemUpdate {
+	mutation := newInvoiceItemMutation(c.config, OpUpdate)
+	return &InvoiceItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceItemClient) UpdateOne(ii *InvoiceItem) *InvoiceItemUpdateOne {
+	mutation := newInvoiceItemMutation(c.config, OpUpdateOne, withInvoiceItem(ii))
+	return &InvoiceItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceItemClient) UpdateOneID(id int) *InvoiceItemUpdateOne {
+	mutation := newInvoiceItemMutation(c.config, OpUpdateOne, withInvoiceItemID(id))
+	return &InvoiceItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InvoiceItem.
+func (c *InvoiceItemClient) Delete() *InvoiceItemDelete {
+	mutation := newInvoiceItemMutation(c.config, OpDelete)
+	return &InvoiceItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceItemClient) DeleteOne(ii *InvoiceItem) *InvoiceItemDeleteOne {
+	return c.DeleteOneID(ii.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *InvoiceItemClient) DeleteOneID(id int) *InvoiceItemDeleteOne {
+	builder := c.Delete().Where(invoiceitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceItemDeleteOne{builder}
+}
+
+// Query returns a query builder for InvoiceItem.
+func (c *InvoiceItemClient) Query() *InvoiceItemQuery {
+	return &InvoiceItemQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a InvoiceItem entity by its id.
+func (c *InvoiceItemClient) Get(ctx context.Context, id int) (*InvoiceItem, error) {
+	return c.Query().Where(invoiceitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceItemClient) GetX(ctx context.Context, id int) *InvoiceItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryInvoice queries the invoice edge of a InvoiceItem.
+func (c *InvoiceItemClient) QueryInvoice(ii *InvoiceItem) *InvoiceQuery {
+	query := &InvoiceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ii.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoiceitem.Table, invoiceitem.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoiceitem.InvoiceTable, invoiceitem.InvoiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(ii.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceItemClient) Hooks() []Hook {
+	return c.hooks.InvoiceItem
+}
+
+// InvoiceItemComponentClient is a client for the InvoiceItemComponent schema.
+type InvoiceItemComponentClient struct {
+	config
+}
+
+// NewInvoiceItemComponentClient returns a client for the InvoiceItemComponent from the given config.
+func NewInvoiceItemComponentClient(c config) *InvoiceItemComponentClient {
+	return &InvoiceItemComponentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoiceitemcomponent.Hooks(f(g(h())))`.
+func (c *InvoiceItemComponentClient) Use(hooks ...Hook) {
+	c.hooks.InvoiceItemComponent = append(c.hooks.InvoiceItemComponent, hooks...)
+}
+
+// Create returns a builder for creating a InvoiceItemComponent entity.
+func (c *InvoiceItemComponentClient) Create() *InvoiceItemComponentCreate {
+	mutation := newInvoiceItemComponentMutation(c.config, OpCreate)
+	return &InvoiceItemComponentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InvoiceItemComponent entities.
+func (c *InvoiceItemComponentClient) CreateBulk(builders ...*InvoiceItemComponentCreate) *InvoiceItemComponentCreateBulk {
+	return &InvoiceItemComponentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InvoiceItemComponent.
+func (c *InvoiceItemComponentClient) Update() *InvoiceItemComponentUpdate {
+	mutation := newInvoiceItemComponentMutation(c.config, OpUpdate)
+	return &InvoiceItemComponentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceItemComponentClient) UpdateOne(iic *InvoiceItemComponent) *InvoiceItemComponentUpdateOne {
+	mutation := newInvoiceItemComponentMutation(c.config, OpUpdateOne, withInvoiceItemComponent(iic))
+	return &InvoiceItemComponentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceItemComponentClient) UpdateOneID(id int) *InvoiceItemComponentUpdateOne {
+	mutation := newInvoiceItemComponentMutation(c.config, OpUpdateOne, withInvoiceItemComponentID(id))
+	return &InvoiceItemComponentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InvoiceItemComponent.
+func (c *InvoiceItemComponentClient) Delete() *InvoiceItemComponentDelete {
+	mutation := newInvoiceItemComponentMutation(c.config, OpDelete)
+	return &InvoiceItemComponentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceItemComponentClient) DeleteOne(iic *InvoiceItemComponent) *InvoiceItemComponentDeleteOne {
+	return c.DeleteOneID(iic.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *InvoiceItemComponentClient) DeleteOneID(id int) *InvoiceItemComponentDeleteOne {
+	builder := c.Delete().Where(invoiceitemcomponent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceItemComponentDeleteOne{builder}
+}
+
+// Query returns a query builder for InvoiceItemComponent.
+func (c *InvoiceItemComponentClient) Query() *InvoiceItemComponentQuery {
+	return &InvoiceItemComponentQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a InvoiceItemComponent entity by its id.
+func (c *InvoiceItemComponentClient) Get(ctx context.Context, id int) (*InvoiceItemComponent, error) {
+	return c.Query().Where(invoiceitemcomponent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceItemComponentClient) GetX(ctx context.Context, id int) *InvoiceItemComponent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceItemComponentClient) Hooks() []Hook {
+	return c.hooks.InvoiceItemComponent
+}
+
+// InvoiceLotAllocationClient is a client for the InvoiceLotAllocation schema.
+type InvoiceLotAllocationClient struct {
+	config
+}
+
+// NewInvoiceLotAllocationClient returns a client for the InvoiceLotAllocation from the given config.
+func NewInvoiceLotAllocationClient(c config) *InvoiceLotAllocationClient {
+	return &InvoiceLotAllocationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoicelotallocation.Hooks(f(g(h())))`.
+func (c *InvoiceLotAllocationClient) Use(hooks ...Hook) {
+	c.hooks.InvoiceLotAllocation = append(c.hooks.InvoiceLotAllocation, hooks...)
+}
+
+// Create returns a builder for creating a InvoiceLotAllocation entity.
+func (c *InvoiceLotAllocationClient) Create() *InvoiceLotAllocationCreate {
+	mutation := newInvoiceLotAllocationMutation(c.config, OpCreate)
+	return &InvoiceLotAllocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InvoiceLotAllocation entities.
+func (c *InvoiceLotAllocationClient) CreateBulk(builders ...*InvoiceLotAllocationCreate) *InvoiceLotAllocationCreateBulk {
+	return &InvoiceLotAllocationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InvoiceLotAllocation.
+func (c *InvoiceLotAllocationClient) Update() *InvoiceLotAllocationUpdate {
+	mutation := newInvoiceLotAllocationMutation(c.config, OpUpdate)
+	return &InvoiceLotAllocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceLotAllocationClient) UpdateOne(ila *InvoiceLotAllocation) *InvoiceLotAllocationUpdateOne {
+	mutation := newInvoiceLotAllocationMutation(c.config, OpUpdateOne, withInvoiceLotAllocation(ila))
+	return &InvoiceLotAllocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceLotAllocationClient) UpdateOneID(id int) *InvoiceLotAllocationUpdateOne {
+	mutation := newInvoiceLotAllocationMutation(c.config, OpUpdateOne, withInvoiceLotAllocationID(id))
+	return &InvoiceLotAllocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InvoiceLotAllocation.
+func (c *InvoiceLotAllocationClient) Delete() *InvoiceLotAllocationDelete {
+	mutation := newInvoiceLotAllocationMutation(c.config, OpDelete)
+	return &InvoiceLotAllocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceLotAllocationClient) DeleteOne(ila *InvoiceLotAllocation) *InvoiceLotAllocationDeleteOne {
+	return c.DeleteOneID(ila.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *InvoiceLotAllocationClient) DeleteOneID(id int) *InvoiceLotAllocationDeleteOne {
+	builder := c.Delete().Where(invoicelotallocation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceLotAllocationDeleteOne{builder}
+}
+
+// Query returns a query builder for InvoiceLotAllocation.
+func (c *InvoiceLotAllocationClient) Query() *InvoiceLotAllocationQuery {
+	return &InvoiceLotAllocationQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a InvoiceLotAllocation entity by its id.
+func (c *InvoiceLotAllocationClient) Get(ctx context.Context, id int) (*InvoiceLotAllocation, error) {
+	return c.Query().Where(invoicelotallocation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceLotAllocationClient) GetX(ctx context.Context, id int) *InvoiceLotAllocation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceLotAllocationClient) Hooks() []Hook {
+	return c.hooks.InvoiceLotAllocation
+}
+
+// InvoiceStatusEventClient is a client for the InvoiceStatusEvent schema.
+type InvoiceStatusEventClient struct {
+	config
+}
+
+// NewInvoiceStatusEventClient returns a client for the InvoiceStatusEvent from the given config.
+func NewInvoiceStatusEventClient(c config) *InvoiceStatusEventClient {
+	return &InvoiceStatusEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoicestatusevent.Hooks(f(g(h())))`.
+func (c *InvoiceStatusEventClient) Use(hooks ...Hook) {
+	c.hooks.InvoiceStatusEvent = append(c.hooks.InvoiceStatusEvent, hooks...)
+}
+
+// Create returns a builder for creating a InvoiceStatusEvent entity.
+func (c *InvoiceStatusEventClient) Create() *InvoiceStatusEventCreate {
+	mutation := newInvoiceStatusEventMutation(c.config, OpCreate)
+	return &InvoiceStatusEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InvoiceStatusEvent entities.
+func (c *InvoiceStatusEventClient) CreateBulk(builders ...*InvoiceStatusEventCreate) *InvoiceStatusEventCreateBulk {
+	return &InvoiceStatusEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InvoiceStatusEvent.
+func (c *InvoiceStatusEventClient) Update() *InvoiceStatusEventUpdate {
+	mutation := newInvoiceStatusEventMutation(c.config, OpUpdate)
+	return &InvoiceStatusEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceStatusEventClient) UpdateOne(ise *InvoiceStatusEvent) *InvoiceStatusEventUpdateOne {
+	mutation := newInvoiceStatusEventMutation(c.config, OpUpdateOne, withInvoiceStatusEvent(ise))
+	return &InvoiceStatusEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceStatusEventClient) UpdateOneID(id int) *InvoiceStatusEventUpdateOne {
+	mutation := newInvoiceStatusEventMutation(c.config, OpUpdateOne, withInvoiceStatusEventID(id))
+	return &InvoiceStatusEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InvoiceStatusEvent.
+func (c *InvoiceStatusEventClient) Delete() *InvoiceStatusEventDelete {
+	mutation := newInvoiceStatusEventMutation(c.config, OpDelete)
+	return &InvoiceStatusEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceStatusEventClient) DeleteOne(ise *InvoiceStatusEvent) *InvoiceStatusEventDeleteOne {
+	return c.DeleteOneID(ise.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *InvoiceStatusEventClient) DeleteOneID(id int) *InvoiceStatusEventDeleteOne {
+	builder := c.Delete().Where(invoicestatusevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceStatusEventDeleteOne{builder}
+}
+
+// Query returns a query builder for InvoiceStatusEvent.
+func (c *InvoiceStatusEventClient) Query() *InvoiceStatusEventQuery {
+	return &InvoiceStatusEventQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a InvoiceStatusEvent entity by its id.
+func (c *InvoiceStatusEventClient) Get(ctx context.Context, id int) (*InvoiceStatusEvent, error) {
+	return c.Query().Where(invoicestatusevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceStatusEventClient) GetX(ctx context.Context, id int) *InvoiceStatusEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceStatusEventClient) Hooks() []Hook {
+	return c.hooks.InvoiceStatusEvent
+}
+
+// InvoiceTaxClient is a client for the InvoiceTax schema.
+type InvoiceTaxClient struct {
+	config
+}
+
+// NewInvoiceTaxClient returns a client for the InvoiceTax from the given config.
+func NewInvoiceTaxClient(c config) *InvoiceTaxClient {
+	return &InvoiceTaxClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoicetax.Hooks(f(g(h())))`.
+func (c *InvoiceTaxClient) Use(hooks ...Hook) {
+	c.hooks.InvoiceTax = append(c.hooks.InvoiceTax, hooks...)
+}
+
+// Create returns a builder for creating a InvoiceTax entity.
+func (c *InvoiceTaxClient) Create() *InvoiceTaxCreate {
+	mutation := newInvoiceTaxMutation(c.config, OpCreate)
+	return &InvoiceTaxCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InvoiceTax entities.
+func (c *InvoiceTaxClient) CreateBulk(builders ...*InvoiceTaxCreate) *InvoiceTaxCreateBulk {
+	return &InvoiceTaxCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InvoiceTax.
+func (c *InvoiceTaxClient) Update() *InvoiceTaxUpdate {
+	mutation := newInvoiceTaxMutation(c.config, OpUpdate)
+	return &InvoiceTaxUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceTaxClient) UpdateOne(it *InvoiceTax) *InvoiceTaxUpdateOne {
+	mutation := newInvoiceTaxMutation(c.config, OpUpdateOne, withInvoiceTax(it))
+	return &InvoiceTaxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceTaxClient) UpdateOneID(id int) *InvoiceTaxUpdateOne {
+	mutation := newInvoiceTaxMutation(c.config, OpUpdateOne, withInvoiceTaxID(id))
+	return &InvoiceTaxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InvoiceTax.
+func (c *InvoiceTaxClient) Delete() *InvoiceTaxDelete {
+	mutation := newInvoiceTaxMutation(c.config, OpDelete)
+	return &InvoiceTaxDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceTaxClient) DeleteOne(it *InvoiceTax) *InvoiceTaxDeleteOne {
+	return c.DeleteOneID(it.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *InvoiceTaxClient) DeleteOneID(id int) *InvoiceTaxDeleteOne {
+	builder := c.Delete().Where(invoicetax.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceTaxDeleteOne{builder}
+}
+
+// Query returns a query builder for InvoiceTax.
+func (c *InvoiceTaxClient) Query() *InvoiceTaxQuery {
+	return &InvoiceTaxQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a InvoiceTax entity by its id.
+func (c *InvoiceTaxClient) Get(ctx context.Context, id int) (*InvoiceTax, error) {
+	return c.Query().Where(invoicetax.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceTaxClient) GetX(ctx context.Context, id int) *InvoiceTax {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceTaxClient) Hooks() []Hook {
+	return c.hooks.InvoiceTax
+}
+
+// NumberingSeriesClient is a client for the NumberingSeries schema.
+type NumberingSeriesClient struct {
+	config
+}
+
+// NewNumberingSeriesClient returns a client for the NumberingSeries from the given config.
+func NewNumberingSeriesClient(c config) *NumberingSeriesClient {
+	return &NumberingSeriesClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `numberingseries.Hooks(f(g(h())))`.
+func (c *NumberingSeriesClient) Use(hooks ...Hook) {
+	c.hooks.NumberingSeries = append(c.hooks.NumberingSeries, hooks...)
+}
+
+// Create returns a builder for creating a NumberingSeries entity.
+func (c *NumberingSeriesClient) Create() *NumberingSeriesCreate {
+	mutation := newNumberingSeriesMutation(c.config, OpCreate)
+	return &NumberingSeriesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NumberingSeries entities.
+func (c *NumberingSeriesClient) CreateBulk(builders ...*NumberingSeriesCreate) *NumberingSeriesCreateBulk {
+	return &NumberingSeriesCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NumberingSeries.
+func (c *NumberingSeriesClient) Update() *NumberingSeriesUpdate {
+	mutation := newNumberingSeriesMutation(c.config, OpUpdate)
+	return &NumberingSeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NumberingSeriesClient) UpdateOne(ns *NumberingSeries) *NumberingSeriesUpdateOne {
+	mutation := newNumberingSeriesMutation(c.config, OpUpdateOne, withNumberingSeries(ns))
+	return &NumberingSeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NumberingSeriesClient) UpdateOneID(id int) *NumberingSeriesUpdateOne {
+	mutation := newNumberingSeriesMutation(c.config, OpUpdateOne, withNumberingSeriesID(id))
+	return &NumberingSeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NumberingSeries.
+func (c *NumberingSeriesClient) Delete() *NumberingSeriesDelete {
+	mutation := newNumberingSeriesMutation(c.config, OpDelete)
+	return &NumberingSeriesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NumberingSeriesClient) DeleteOne(ns *NumberingSeries) *NumberingSeriesDeleteOne {
+	return c.DeleteOneID(ns.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *NumberingSeriesClient) DeleteOneID(id int) *NumberingSeriesDeleteOne {
+	builder := c.Delete().Where(numberingseries.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NumberingSeriesDeleteOne{builder}
+}
+
+// Query returns a query builder for NumberingSeries.
+func (c *NumberingSeriesClient) Query() *NumberingSeriesQuery {
+	return &NumberingSeriesQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a NumberingSeries entity by its id.
+func (c *NumberingSeriesClient) Get(ctx context.Context, id int) (*NumberingSeries, error) {
+	return c.Query().Where(numberingseries.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NumberingSeriesClient) GetX(ctx context.Context, id int) *NumberingSeries {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NumberingSeriesClient) Hooks() []Hook {
+	return c.hooks.NumberingSeries
+}
+
+// PriceListClient is a client for the PriceList schema.
+type PriceListClient struct {
+	config
+}
+
+// NewPriceListClient returns a client for the PriceList from the given config.
+func NewPriceListClient(c config) *PriceListClient {
+	return &PriceListClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pricelist.Hooks(f(g(h())))`.
+func (c *PriceListClient) Use(hooks ...Hook) {
+	c.hooks.PriceList = append(c.hooks.PriceList, hooks...)
+}
+
+// Create returns a builder for creating a PriceList entity.
+func (c *PriceListClient) Create() *PriceListCreate {
+	mutation := newPriceListMutation(c.config, OpCreate)
+	return &PriceListCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PriceList entities.
+func (c *PriceListClient) CreateBulk(builders ...*PriceListCreate) *PriceListCreateBulk {
+	return &PriceListCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PriceList.
+func (c *PriceListClient) Update() *PriceListUpdate {
+	mutation := newPriceListMutation(c.config, OpUpdate)
+	return &PriceListUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PriceListClient) UpdateOne(pl *PriceList) *PriceListUpdateOne {
+	mutation := newPriceListMutation(c.config, OpUpdateOne, withPriceList(pl))
+	return &PriceListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PriceListClient) UpdateOneID(id int) *PriceListUpdateOne {
+	mutation := newPriceListMutation(c.config, OpUpdateOne, withPriceListID(id))
+	return &PriceListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PriceList.
+func (c *PriceListClient) Delete() *PriceListDelete {
+	mutation := newPriceListMutation(c.config, OpDelete)
+	return &PriceListDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PriceListClient) DeleteOne(pl *PriceList) *PriceListDeleteOne {
+	return c.DeleteOneID(pl.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *PriceListClient) DeleteOneID(id int) *PriceListDeleteOne {
+	builder := c.Delete().Where(pricelist.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PriceListDeleteOne{builder}
+}
+
+// Query returns a query builder for PriceList.
+func (c *PriceListClient) Query() *PriceListQuery {
+	return &PriceListQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a PriceList entity by its id.
+func (c *PriceListClient) Get(ctx context.Context, id int) (*PriceList, error) {
+	return c.Query().Where(pricelist.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PriceListClient) GetX(ctx context.Context, id int) *PriceList {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PriceListClient) Hooks() []Hook {
+	return c.hooks.PriceList
+}
+
+// PriceListItemClient is a client for the PriceListItem schema.
+type PriceListItemClient struct {
+	config
+}
+
+// NewPriceListItemClient returns a client for the PriceListItem from the given config.
+func NewPriceListItemClient(c config) *PriceListItemClient {
+	return &PriceListItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pricelistitem.Hooks(f(g(h())))`.
+func (c *PriceListItemClient) Use(hooks ...Hook) {
+	c.hooks.PriceListItem = append(c.hooks.PriceListItem, hooks...)
+}
+
+// Create returns a builder for creating a PriceListItem entity.
+func (c *PriceListItemClient) Create() *PriceListItemCreate {
+	mutation := newPriceListItemMutation(c.config, OpCreate)
+	return &PriceListItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PriceListItem entities.
+func (c *PriceListItemClient) CreateBulk(builders ...*PriceListItemCreate) *PriceListItemCreateBulk {
+	return &PriceListItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PriceListItem.
+func (c *PriceListItemClient) Update() *PriceListItemUpdate {
+	mutation := newPriceListItemMutation(c.config, OpUpdate)
+	return &PriceListItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PriceListItemClient) UpdateOne(pli *PriceListItem) *PriceListItemUpdateOne {
+	mutation := newPriceListItemMutation(c.config, OpUpdateOne, withPriceListItem(pli))
+	return &PriceListItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PriceListItemClient) UpdateOneID(id int) *PriceListItemUpdateOne {
+	mutation := newPriceListItemMutation(c.config, OpUpdateOne, withPriceListItemID(id))
+	return &PriceListItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PriceListItem.
+func (c *PriceListItemClient) Delete() *PriceListItemDelete {
+	mutation := newPriceListItemMutation(c.config, OpDelete)
+	return &PriceListItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PriceListItemClient) DeleteOne(pli *PriceListItem) *PriceListItemDeleteOne {
+	return c.DeleteOneID(pli.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *PriceListItemClient) DeleteOneID(id int) *PriceListItemDeleteOne {
+	builder := c.Delete().Where(pricelistitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PriceListItemDeleteOne{builder}
+}
+
+// Query returns a query builder for PriceListItem.
+func (c *PriceListItemClient) Query() *PriceListItemQuery {
+	return &PriceListItemQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a PriceListItem entity by its id.
+func (c *PriceListItemClient) Get(ctx context.Context, id int) (*PriceListItem, error) {
+	return c.Query().Where(pricelistitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PriceListItemClient) GetX(ctx context.Context, id int) *PriceListItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PriceListItemClient) Hooks() []Hook {
+	return c.hooks.PriceListItem
+}
+
+// ProductClient is a client for the Product schema.
+type ProductClient struct {
+	config
+}
+
+// NewProductClient returns a client for the Product from the given config.
+func NewProductClient(c config) *ProductClient {
+	return &ProductClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `product.Hooks(f(g(h())))`.
+func (c *ProductClient) Use(hooks ...Hook) {
+	c.hooks.Product = append(c.hooks.Product, hooks...)
+}
+
+// Create returns a builder for creating a Product entity.
+func (c *ProductClient) Create() *ProductCreate {
+	mutation := newProductMutation(c.config, OpCreate)
+	return &ProductCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Product entities.
+func (c *ProductClient) CreateBulk(builders ...*ProductCreate) *ProductCreateBulk {
+	return &ProductCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Product.
+func (c *ProductClient) Update() *ProductUpdate {
+	mutation := newProductMutation(c.config, OpUpdate)
+	return &ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductClient) UpdateOne(pr *Product) *ProductUpdateOne {
+	mutation := newProductMutation(c.config, OpUpdateOne, withProduct(pr))
+	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductClient) UpdateOneID(id int) *ProductUpdateOne {
+	mutation := newProductMutation(c.config, OpUpdateOne, withProductID(id))
+	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Product.
+func (c *ProductClient) Delete() *ProductDelete {
+	mutation := newProductMutation(c.config, OpDelete)
+	return &ProductDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductClient) DeleteOne(pr *Product) *ProductDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ProductClient) DeleteOneID(id int) *ProductDeleteOne {
+	builder := c.Delete().Where(product.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductDeleteOne{builder}
+}
+
+// Query returns a query builder for Product.
+func (c *ProductClient) Query() *ProductQuery {
+	return &ProductQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Product entity by its id.
+func (c *ProductClient) Get(ctx context.Context, id int) (*Product, error) {
+	return c.Query().Where(product.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductClient) GetX(ctx context.Context, id int) *Product {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProductClient) Hooks() []Hook {
+	return c.hooks.Product
+}
+
+// ProductBarcodeClient is a client for the ProductBarcode schema.
+type ProductBarcodeClient struct {
+	config
+}
+
+// NewProductBarcodeClient returns a client for the ProductBarcode from the given config.
+func NewProductBarcodeClient(c config) *ProductBarcodeClient {
+	return &ProductBarcodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `productbarcode.Hooks(f(g(h())))`.
+func (c *ProductBarcodeClient) Use(hooks ...Hook) {
+	c.hooks.ProductBarcode = append(c.hooks.ProductBarcode, hooks...)
+}
+
+// Create returns a builder for creating a ProductBarcode entity.
+func (c *ProductBarcodeClient) Create() *ProductBarcodeCreate {
+	mutation := newProductBarcodeMutation(c.config, OpCreate)
+	return &ProductBarcodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProductBarcode entities.
+func (c *ProductBarcodeClient) CreateBulk(builders ...*ProductBarcodeCreate) *ProductBarcodeCreateBulk {
+	return &ProductBarcodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProductBarcode.
+func (c *ProductBarcodeClient) Update() *ProductBarcodeUpdate {
+	mutation := newProductBarcodeMutation(c.config, OpUpdate)
+	return &ProductBarcodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductBarcodeClient) UpdateOne(pb *ProductBarcode) *ProductBarcodeUpdateOne {
+	mutation := newProductBarcodeMutation(c.config, OpUpdateOne, withProductBarcode(pb))
+	return &ProductBarcodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductBarcodeClient) UpdateOneID(id int) *ProductBarcodeUpdateOne {
+	mutation := newProductBarcodeMutation(c.config, OpUpdateOne, withProductBarcodeID(id))
+	return &ProductBarcodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProductBarcode.
+func (c *ProductBarcodeClient) Delete() *ProductBarcodeDelete {
+	mutation := newProductBarcodeMutation(c.config, OpDelete)
+	return &ProductBarcodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductBarcodeClient) DeleteOne(pb *ProductBarcode) *ProductBarcodeDeleteOne {
+	return c.DeleteOneID(pb.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ProductBarcodeClient) DeleteOneID(id int) *ProductBarcodeDeleteOne {
+	builder := c.Delete().Where(productbarcode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductBarcodeDeleteOne{builder}
+}
+
+// Query returns a query builder for ProductBarcode.
+func (c *ProductBarcodeClient) Query() *ProductBarcodeQuery {
+	return &ProductBarcodeQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ProductBarcode entity by its id.
+func (c *ProductBarcodeClient) Get(ctx context.Context, id int) (*ProductBarcode, error) {
+	return c.Query().Where(productbarcode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductBarcodeClient) GetX(ctx context.Context, id int) *ProductBarcode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProductBarcodeClient) Hooks() []Hook {
+	return c.hooks.ProductBarcode
+}
+
+// ProductFileClient is a client for the ProductFile schema.
+type ProductFileClient struct {
+	config
+}
+
+// NewProductFileClient returns a client for the ProductFile from the given config.
+func NewProductFileClient(c config) *ProductFileClient {
+	return &ProductFileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `productfile.Hooks(f(g(h())))`.
+func (c *ProductFileClient) Use(hooks ...Hook) {
+	c.hooks.ProductFile = append(c.hooks.ProductFile, hooks...)
+}
+
+// Create returns a builder for creating a ProductFile entity.
+func (c *ProductFileClient) Create() *ProductFileCreate {
+	mutation := newProductFileMutation(c.config, OpCreate)
+	return &ProductFileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProductFile entities.
+func (c *ProductFileClient) CreateBulk(builders ...*ProductFileCreate) *ProductFileCreateBulk {
+	return &ProductFileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProductFile.
+func (c *ProductFileClient) Update() *ProductFileUpdate {
+	mutation := newProductFileMutation(c.config, OpUpdate)
+	return &ProductFileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductFileClient) UpdateOne(pf *ProductFile) *ProductFileUpdateOne {
+	mutation := newProductFileMutation(c.config, OpUpdateOne, withProductFile(pf))
+	return &ProductFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductFileClient) UpdateOneID(id int) *ProductFileUpdateOne {
+	mutation := newProductFileMutation(c.config, OpUpdateOne, withProductFileID(id))
+	return &ProductFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProductFile.
+func (c *ProductFileClient) Delete() *ProductFileDelete {
+	mutation := newProductFileMutation(c.config, OpDelete)
+	return &ProductFileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductFileClient) DeleteOne(pf *ProductFile) *ProductFileDeleteOne {
+	return c.DeleteOneID(pf.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ProductFileClient) DeleteOneID(id int) *ProductFileDeleteOne {
+	builder := c.Delete().Where(productfile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductFileDeleteOne{builder}
+}
+
+// Query returns a query builder for ProductFile.
+func (c *ProductFileClient) Query() *ProductFileQuery {
+	return &ProductFileQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ProductFile entity by its id.
+func (c *ProductFileClient) Get(ctx context.Context, id int) (*ProductFile, error) {
+	return c.Query().Where(productfile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductFileClient) GetX(ctx context.Context, id int) *ProductFile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProductFileClient) Hooks() []Hook {
+	return c.hooks.ProductFile
+}
+
+// ProductLotClient is a client for the ProductLot schema.
+type ProductLotClient struct {
+	config
+}
+
+// NewProductLotClient returns a client for the ProductLot from the given config.
+func NewProductLotClient(c config) *ProductLotClient {
+	return &ProductLotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `productlot.Hooks(f(g(h())))`.
+func (c *ProductLotClient) Use(hooks ...Hook) {
+	c.hooks.ProductLot = append(c.hooks.ProductLot, hooks...)
+}
+
+// Create returns a builder for creating a ProductLot entity.
+func (c *ProductLotClient) Create() *ProductLotCreate {
+	mutation := newProductLotMutation(c.config, OpCreate)
+	return &ProductLotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProductLot entities.
+func (c *ProductLotClient) CreateBulk(builders ...*ProductLotCreate) *ProductLotCreateBulk {
+	return &ProductLotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProductLot.
+func (c *ProductLotClient) Update() *ProductLotUpdate {
+	mutation := newProductLotMutation(c.config, OpUpdate)
+	return &ProductLotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductLotClient) UpdateOne(pl *ProductLot) *ProductLotUpdateOne {
+	mutation := newProductLotMutation(c.config, OpUpdateOne, withProductLot(pl))
+	return &ProductLotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductLotClient) UpdateOneID(id int) *ProductLotUpdateOne {
+	mutation := newProductLotMutation(c.config, OpUpdateOne, withProductLotID(id))
+	return &ProductLotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProductLot.
+func (c *ProductLotClient) Delete() *ProductLotDelete {
+	mutation := newProductLotMutation(c.config, OpDelete)
+	return &ProductLotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductLotClient) DeleteOne(pl *ProductLot) *ProductLotDeleteOne {
+	return c.DeleteOneID(pl.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ProductLotClient) DeleteOneID(id int) *ProductLotDeleteOne {
+	builder := c.Delete().Where(productlot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductLotDeleteOne{builder}
+}
+
+// Query returns a query builder for ProductLot.
+func (c *ProductLotClient) Query() *ProductLotQuery {
+	return &ProductLotQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ProductLot entity by its id.
+func (c *ProductLotClient) Get(ctx context.Context, id int) (*ProductLot, error) {
+	return c.Query().Where(productlot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductLotClient) GetX(ctx context.Context, id int) *ProductLot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProductLotClient) Hooks() []Hook {
+	return c.hooks.ProductLot
+}
+
+// ProductPriceClient is a client for the ProductPrice schema.
+type ProductPriceClient struct {
+	config
+}
+
+// NewProductPriceClient returns a client for the ProductPrice from the given config.
+func NewProductPriceClient(c config) *ProductPriceClient {
+	return &ProductPriceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `productprice.Hooks(f(g(h())))`.
+func (c *ProductPriceClient) Use(hooks ...Hook) {
+	c.hooks.ProductPrice = append(c.hooks.ProductPrice, hooks...)
+}
+
+// Create returns a builder for creating a ProductPrice entity.
+func (c *ProductPriceClient) Create() *ProductPriceCreate {
+	mutation := newProductPriceMutation(c.config, OpCreate)
+	return &ProductPriceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProductPrice entities.
+func (c *ProductPriceClient) CreateBulk(builders ...*ProductPriceCreate) *ProductPriceCreateBulk {
+	return &ProductPriceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProductPrice.
+func (c *ProductPriceClient) Update() *ProductPriceUpdate {
+	mutation := newProductPriceMutation(c.config, OpUpdate)
+	return &ProductPriceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductPriceClient) UpdateOne(pp *ProductPrice) *ProductPriceUpdateOne {
+	mutation := newProductPriceMutation(c.config, OpUpdateOne, withProductPrice(pp))
+	return &ProductPriceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductPriceClient) UpdateOneID(id int) *ProductPriceUpdateOne {
+	mutation := newProductPriceMutation(c.config, OpUpdateOne, withProductPriceID(id))
+	return &ProductPriceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProductPrice.
+func (c *ProductPriceClient) Delete() *ProductPriceDelete {
+	mutation := newProductPriceMutation(c.config, OpDelete)
+	return &ProductPriceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductPriceClient) DeleteOne(pp *ProductPrice) *ProductPriceDeleteOne {
+	return c.DeleteOneID(pp.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ProductPriceClient) DeleteOneID(id int) *ProductPriceDeleteOne {
+	builder := c.Delete().Where(productprice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductPriceDeleteOne{builder}
+}
+
+// Query returns a query builder for ProductPrice.
+func (c *ProductPriceClient) Query() *ProductPriceQuery {
+	return &ProductPriceQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ProductPrice entity by its id.
+func (c *ProductPriceClient) Get(ctx context.Context, id int) (*ProductPrice, error) {
+	return c.Query().Where(productprice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductPriceClient) GetX(ctx context.Context, id int) *ProductPrice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProductPriceClient) Hooks() []Hook {
+	return c.hooks.ProductPrice
+}
+
+// ProductSerialClient is a client for the ProductSerial schema.
+type ProductSerialClient struct {
+	config
+}
+
+// NewProductSerialClient returns a client for the ProductSerial from the given config.
+func NewProductSerialClient(c config) *ProductSerialClient {
+	return &ProductSerialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `productserial.Hooks(f(g(h())))`.
+func (c *ProductSerialClient) Use(hooks ...Hook) {
+	c.hooks.ProductSerial = append(c.hooks.ProductSerial, hooks...)
+}
+
+// Create returns a builder for creating a ProductSerial entity.
+func (c *ProductSerialClient) Create() *ProductSerialCreate {
+	mutation := newProductSerialMutation(c.config, OpCreate)
+	return &ProductSerialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProductSerial entities.
+func (c *ProductSerialClient) CreateBulk(builders ...*ProductSerialCreate) *ProductSerialCreateBulk {
+	return &ProductSerialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProductSerial.
+func (c *ProductSerialClient) Update() *ProductSerialUpdate {
+	mutation := newProductSerialMutation(c.config, OpUpdate)
+	return &ProductSerialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductSerialClient) UpdateOne(ps *ProductSerial) *ProductSerialUpdateOne {
+	mutation := newProductSerialMutation(c.config, OpUpdateOne, withProductSerial(ps))
+	return &ProductSerialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductSerialClient) UpdateOneID(id int) *ProductSerialUpdateOne {
+	mutation := newProductSerialMutation(c.config, OpUpdateOne, withProductSerialID(id))
+	return &ProductSerialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProductSerial.
+func (c *ProductSerialClient) Delete() *ProductSerialDelete {
+	mutation := newProductSerialMutation(c.config, OpDelete)
+	return &ProductSerialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductSerialClient) DeleteOne(ps *ProductSerial) *ProductSerialDeleteOne {
+	return c.DeleteOneID(ps.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ProductSerialClient) DeleteOneID(id int) *ProductSerialDeleteOne {
+	builder := c.Delete().Where(productserial.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductSerialDeleteOne{builder}
+}
+
+// Query returns a query builder for ProductSerial.
+func (c *ProductSerialClient) Query() *ProductSerialQuery {
+	return &ProductSerialQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ProductSerial entity by its id.
+func (c *ProductSerialClient) Get(ctx context.Context, id int) (*ProductSerial, error) {
+	return c.Query().Where(productserial.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductSerialClient) GetX(ctx context.Context, id int) *ProductSerial {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProductSerialClient) Hooks() []Hook {
+	return c.hooks.ProductSerial
+}
+
+// ProductSerialEventClient is a client for the ProductSerialEvent schema.
+type ProductSerialEventClient struct {
+	config
+}
+
+// NewProductSerialEventClient returns a client for the ProductSerialEvent from the given config.
+func NewProductSerialEventClient(c config) *ProductSerialEventClient {
+	return &ProductSerialEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `productserialevent.Hooks(f(g(h())))`.
+func (c *ProductSerialEventClient) Use(hooks ...Hook) {
+	c.hooks.ProductSerialEvent = append(c.hooks.ProductSerialEvent, hooks...)
+}
+
+// Create returns a builder for creating a ProductSerialEvent entity.
+func (c *ProductSerialEventClient) Create() *ProductSerialEventCreate {
+	mutation := newProductSerialEventMutation(c.config, OpCreate)
+	return &ProductSerialEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProductSerialEvent entities.
+func (c *ProductSerialEventClient) CreateBulk(builders ...*ProductSerialEventCreate) *ProductSerialEventCreateBulk {
+	return &ProductSerialEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProductSerialEvent.
+func (c *ProductSerialEventClient) Update() *ProductSerialEventUpdate {
+	mutation := newProductSerialEventMutation(c.config, OpUpdate)
+	return &ProductSerialEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductSerialEventClient) UpdateOne(pse *ProductSerialEvent) *ProductSerialEventUpdateOne {
+	mutation := newProductSerialEventMutation(c.config, OpUpdateOne, withProductSerialEvent(pse))
+	return &ProductSerialEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductSerialEventClient) UpdateOneID(id int) *ProductSerialEventUpdateOne {
+	mutation := newProductSerialEventMutation(c.config, OpUpdateOne, withProductSerialEventID(id))
+	return &ProductSerialEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProductSerialEvent.
+func (c *ProductSerialEventClient) Delete() *ProductSerialEventDelete {
+	mutation := newProductSerialEventMutation(c.config, OpDelete)
+	return &ProductSerialEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductSerialEventClient) DeleteOne(pse *ProductSerialEvent) *ProductSerialEventDeleteOne {
+	return c.DeleteOneID(pse.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ProductSerialEventClient) DeleteOneID(id int) *ProductSerialEventDeleteOne {
+	builder := c.Delete().Where(productserialevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductSerialEventDeleteOne{builder}
+}
+
+// Query returns a query builder for ProductSerialEvent.
+func (c *ProductSerialEventClient) Query() *ProductSerialEventQuery {
+	return &ProductSerialEventQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ProductSerialEvent entity by its id.
+func (c *ProductSerialEventClient) Get(ctx context.Context, id int) (*ProductSerialEvent, error) {
+	return c.Query().Where(productserialevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductSerialEventClient) GetX(ctx context.Context, id int) *ProductSerialEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProductSerialEventClient) Hooks() []Hook {
+	return c.hooks.ProductSerialEvent
+}
+
+// ProductUnitClient is a client for the ProductUnit schema.
+type ProductUnitClient struct {
+	config
+}
+
+// NewProductUnitClient returns a client for the ProductUnit from the given config.
+func NewProductUnitClient(c config) *ProductUnitClient {
+	return &ProductUnitClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `productunit.Hooks(f(g(h())))`.
+func (c *ProductUnitClient) Use(hooks ...Hook) {
+	c.hooks.ProductUnit = append(c.hooks.ProductUnit, hooks...)
+}
+
+// Create returns a builder for creating a ProductUnit entity.
+func (c *ProductUnitClient) Create() *ProductUnitCreate {
+	mutation := newProductUnitMutation(c.config, OpCreate)
+	return &ProductUnitCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProductUnit entities.
+func (c *ProductUnitClient) CreateBulk(builders ...*ProductUnitCreate) *ProductUnitCreateBulk {
+	return &ProductUnitCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProductUnit.
+func (c *ProductUnitClient) Update() *ProductUnitUpdate {
+	mutation := newProductUnitMutation(c.config, OpUpdate)
+	return &ProductUnitUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductUnitClient) UpdateOne(pu *ProductUnit) *ProductUnitUpdateOne {
+	mutation := newProductUnitMutation(c.config, OpUpdateOne, withProductUnit(pu))
+	return &ProductUnitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductUnitClient) UpdateOneID(id int) *ProductUnitUpdateOne {
+	mutation := newProductUnitMutation(c.config, OpUpdateOne, withProductUnitID(id))
+	return &ProductUnitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProductUnit.
+func (c *ProductUnitClient) Delete() *ProductUnitDelete {
+	mutation := newProductUnitMutation(c.config, OpDelete)
+	return &ProductUnitDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductUnitClient) DeleteOne(pu *ProductUnit) *ProductUnitDeleteOne {
+	return c.DeleteOneID(pu.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ProductUnitClient) DeleteOneID(id int) *ProductUnitDeleteOne {
+	builder := c.Delete().Where(productunit.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductUnitDeleteOne{builder}
+}
+
+// Query returns a query builder for ProductUnit.
+func (c *ProductUnitClient) Query() *ProductUnitQuery {
+	return &ProductUnitQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ProductUnit entity by its id.
+func (c *ProductUnitClient) Get(ctx context.Context, id int) (*ProductUnit, error) {
+	return c.Query().Where(productunit.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductUnitClient) GetX(ctx context.Context, id int) *ProductUnit {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *InvoiceClient) Hooks() []Hook {
-	return c.hooks.Invoice
+func (c *ProductUnitClient) Hooks() []Hook {
+	return c.hooks.ProductUnit
 }
 
-// InvoiceItemClient is a client for the InvoiceItem schema.
-type InvoiceItemClient struct {
+// PromotionClient is a client for the Promotion schema.
+type PromotionClient struct {
 	config
 }
 
-// NewInvoiceItemClient returns a client for the InvoiceItem from the given config.
-func NewInvoiceItemClient(c config) *InvoiceItemClient {
-	return &InvoiceItemClient{config: c}
+// NewPromotionClient returns a client for the Promotion from the given config.
+func NewPromotionClient(c config) *PromotionClient {
+	return &PromotionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `invoiceitem.Hooks(f(g(h())))`.
-func (c *InvoiceItemClient) Use(hooks ...Hook) {
-	c.hooks.InvoiceItem = append(c.hooks.InvoiceItem, hooks...)
+// A call to `Use(f, g, h)` equals to `promotion.Hooks(f(g(h())))`.
+func (c *PromotionClient) Use(hooks ...Hook) {
+	c.hooks.Promotion = append(c.hooks.Promotion, hooks...)
 }
 
-// Create returns a builder for creating a InvoiceItem entity.
-func (c *InvoiceItemClient) Create() *InvoiceItemCreate {
-	mutation := newInvoiceItemMutation(c.config, OpCreate)
-	return &InvoiceItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Promotion entity.
+func (c *PromotionClient) Create() *PromotionCreate {
+	mutation := newPromotionMutation(c.config, OpCreate)
+	return &PromotionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of InvoiceItem entities.
-func (c *InvoiceItemClient) CreateBulk(builders ...*InvoiceItemCreate) *InvoiceItemCreateBulk {
-	return &InvoiceItemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Promotion entities.
+func (c *PromotionClient) CreateBulk(builders ...*PromotionCreate) *PromotionCreateBulk {
+	return &PromotionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for InvoiceItem.
-func (c *InvoiceItemClient) Update() *InvoiceItemUpdate {
-	mutation := newInvoiceItemMutation(c.config, OpUpdate)
-	return &InvoiceItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Promotion.
+func (c *PromotionClient) Update() *PromotionUpdate {
+	mutation := newPromotionMutation(c.config, OpUpdate)
+	return &PromotionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InvoiceItemClient) UpdateOne(ii *InvoiceItem) *InvoiceItemUpdateOne {
-	mutation := newInvoiceItemMutation(c.config, OpUpdateOne, withInvoiceItem(ii))
-	return &InvoiceItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PromotionClient) UpdateOne(pr *Promotion) *PromotionUpdateOne {
+	mutation := newPromotionMutation(c.config, OpUpdateOne, withPromotion(pr))
+	return &PromotionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InvoiceItemClient) UpdateOneID(id int) *InvoiceItemUpdateOne {
-	mutation := newInvoiceItemMutation(c.config, OpUpdateOne, withInvoiceItemID(id))
-	return &InvoiceItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PromotionClient) UpdateOneID(id int) *PromotionUpdateOne {
+	mutation := newPromotionMutation(c.config, OpUpdateOne, withPromotionID(id))
+	return &PromotionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for InvoiceItem.
-func (c *InvoiceItemClient) Delete() *InvoiceItemDelete {
-	mutation := newInvoiceItemMutation(c.config, OpDelete)
-	return &InvoiceItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Promotion.
+func (c *PromotionClient) Delete() *PromotionDelete {
+	mutation := newPromotionMutation(c.config, OpDelete)
+	return &PromotionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InvoiceItemClient) DeleteOne(ii *InvoiceItem) *InvoiceItemDeleteOne {
-	return c.DeleteOneID(ii.ID)
+func (c *PromotionClient) DeleteOne(pr *Promotion) *PromotionDeleteOne {
+	return c.DeleteOneID(pr.ID)
 }
 
 // DeleteOne returns a builder for deleting the given entity by its id.
-func (c *InvoiceItemClient) DeleteOneID(id int) *InvoiceItemDeleteOne {
-	builder := c.Delete().Where(invoiceitem.ID(id))
+func (c *PromotionClient) DeleteOneID(id int) *PromotionDeleteOne {
+	builder := c.Delete().Where(promotion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InvoiceItemDeleteOne{builder}
+	return &PromotionDeleteOne{builder}
 }
 
-// Query returns a query builder for InvoiceItem.
-func (c *InvoiceItemClient) Query() *InvoiceItemQuery {
-	return &InvoiceItemQuery{
+// Query returns a query builder for Promotion.
+func (c *PromotionClient) Query() *PromotionQuery {
+	return &PromotionQuery{
 		config: c.config,
 	}
 }
 
-// Get returns a InvoiceItem entity by its id.
-func (c *InvoiceItemClient) Get(ctx context.Context, id int) (*InvoiceItem, error) {
-	return c.Query().Where(invoiceitem.ID(id)).Only(ctx)
+// Get returns a Promotion entity by its id.
+func (c *PromotionClient) Get(ctx context.Context, id int) (*Promotion, error) {
+	return c.Query().Where(promotion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InvoiceItemClient) GetX(ctx context.Context, id int) *InvoiceItem {
+func (c *PromotionClient) GetX(ctx context.Context, id int) *Promotion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -370,105 +3138,89 @@ func (c *InvoiceItemClient) GetX(ctx context.Context, id int) *InvoiceItem {
 	return obj
 }
 
-// QueryInvoice queries the invoice edge of a InvoiceItem.
-func (c *InvoiceItemClient) QueryInvoice(ii *InvoiceItem) *InvoiceQuery {
-	query := &InvoiceQuery{config: c.config}
-	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
-		id := ii.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(invoiceitem.Table, invoiceitem.FieldID, id),
-			sqlgraph.To(invoice.Table, invoice.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, invoiceitem.InvoiceTable, invoiceitem.InvoiceColumn),
-		)
-		fromV = sqlgraph.Neighbors(ii.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *InvoiceItemClient) Hooks() []Hook {
-	return c.hooks.InvoiceItem
+func (c *PromotionClient) Hooks() []Hook {
+	return c.hooks.Promotion
 }
 
-// ProductClient is a client for the Product schema.
-type ProductClient struct {
+// PromotionTargetClient is a client for the PromotionTarget schema.
+type PromotionTargetClient struct {
 	config
 }
 
-// NewProductClient returns a client for the Product from the given config.
-func NewProductClient(c config) *ProductClient {
-	return &ProductClient{config: c}
+// NewPromotionTargetClient returns a client for the PromotionTarget from the given config.
+func NewPromotionTargetClient(c config) *PromotionTargetClient {
+	return &PromotionTargetClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `product.Hooks(f(g(h())))`.
-func (c *ProductClient) Use(hooks ...Hook) {
-	c.hooks.Product = append(c.hooks.Product, hooks...)
+// A call to `Use(f, g, h)` equals to `promotiontarget.Hooks(f(g(h())))`.
+func (c *PromotionTargetClient) Use(hooks ...Hook) {
+	c.hooks.PromotionTarget = append(c.hooks.PromotionTarget, hooks...)
 }
 
-// Create returns a builder for creating a Product entity.
-func (c *ProductClient) Create() *ProductCreate {
-	mutation := newProductMutation(c.config, OpCreate)
-	return &ProductCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PromotionTarget entity.
+func (c *PromotionTargetClient) Create() *PromotionTargetCreate {
+	mutation := newPromotionTargetMutation(c.config, OpCreate)
+	return &PromotionTargetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Product entities.
-func (c *ProductClient) CreateBulk(builders ...*ProductCreate) *ProductCreateBulk {
-	return &ProductCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PromotionTarget entities.
+func (c *PromotionTargetClient) CreateBulk(builders ...*PromotionTargetCreate) *PromotionTargetCreateBulk {
+	return &PromotionTargetCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Product.
-func (c *ProductClient) Update() *ProductUpdate {
-	mutation := newProductMutation(c.config, OpUpdate)
-	return &ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PromotionTarget.
+func (c *PromotionTargetClient) Update() *PromotionTargetUpdate {
+	mutation := newPromotionTargetMutation(c.config, OpUpdate)
+	return &PromotionTargetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProductClient) UpdateOne(pr *Product) *ProductUpdateOne {
-	mutation := newProductMutation(c.config, OpUpdateOne, withProduct(pr))
-	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PromotionTargetClient) UpdateOne(pt *PromotionTarget) *PromotionTargetUpdateOne {
+	mutation := newPromotionTargetMutation(c.config, OpUpdateOne, withPromotionTarget(pt))
+	return &PromotionTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProductClient) UpdateOneID(id int) *ProductUpdateOne {
-	mutation := newProductMutation(c.config, OpUpdateOne, withProductID(id))
-	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PromotionTargetClient) UpdateOneID(id int) *PromotionTargetUpdateOne {
+	mutation := newPromotionTargetMutation(c.config, OpUpdateOne, withPromotionTargetID(id))
+	return &PromotionTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Product.
-func (c *ProductClient) Delete() *ProductDelete {
-	mutation := newProductMutation(c.config, OpDelete)
-	return &ProductDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PromotionTarget.
+func (c *PromotionTargetClient) Delete() *PromotionTargetDelete {
+	mutation := newPromotionTargetMutation(c.config, OpDelete)
+	return &PromotionTargetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProductClient) DeleteOne(pr *Product) *ProductDeleteOne {
-	return c.DeleteOneID(pr.ID)
+func (c *PromotionTargetClient) DeleteOne(pt *PromotionTarget) *PromotionTargetDeleteOne {
+	return c.DeleteOneID(pt.ID)
 }
 
 // DeleteOne returns a builder for deleting the given entity by its id.
-func (c *ProductClient) DeleteOneID(id int) *ProductDeleteOne {
-	builder := c.Delete().Where(product.ID(id))
+func (c *PromotionTargetClient) DeleteOneID(id int) *PromotionTargetDeleteOne {
+	builder := c.Delete().Where(promotiontarget.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProductDeleteOne{builder}
+	return &PromotionTargetDeleteOne{builder}
 }
 
-// Query returns a query builder for Product.
-func (c *ProductClient) Query() *ProductQuery {
-	return &ProductQuery{
+// Query returns a query builder for PromotionTarget.
+func (c *PromotionTargetClient) Query() *PromotionTargetQuery {
+	return &PromotionTargetQuery{
 		config: c.config,
 	}
 }
 
-// Get returns a Product entity by its id.
-func (c *ProductClient) Get(ctx context.Context, id int) (*Product, error) {
-	return c.Query().Where(product.ID(id)).Only(ctx)
+// Get returns a PromotionTarget entity by its id.
+func (c *PromotionTargetClient) Get(ctx context.Context, id int) (*PromotionTarget, error) {
+	return c.Query().Where(promotiontarget.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProductClient) GetX(ctx context.Context, id int) *Product {
+func (c *PromotionTargetClient) GetX(ctx context.Context, id int) *PromotionTarget {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -477,8 +3229,8 @@ func (c *ProductClient) GetX(ctx context.Context, id int) *Product {
 }
 
 // Hooks returns the client hooks.
-func (c *ProductClient) Hooks() []Hook {
-	return c.hooks.Product
+func (c *PromotionTargetClient) Hooks() []Hook {
+	return c.hooks.PromotionTarget
 }
 
 // PurchaseInvoiceClient is a client for the PurchaseInvoice schema.
@@ -677,6 +3429,186 @@ func (c *PurchaseInvoiceItemClient) Hooks() []Hook {
 	return c.hooks.PurchaseInvoiceItem
 }
 
+// StockAdjustmentClient is a client for the StockAdjustment schema.
+type StockAdjustmentClient struct {
+	config
+}
+
+// NewStockAdjustmentClient returns a client for the StockAdjustment from the given config.
+func NewStockAdjustmentClient(c config) *StockAdjustmentClient {
+	return &StockAdjustmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `stockadjustment.Hooks(f(g(h())))`.
+func (c *StockAdjustmentClient) Use(hooks ...Hook) {
+	c.hooks.StockAdjustment = append(c.hooks.StockAdjustment, hooks...)
+}
+
+// Create returns a builder for creating a StockAdjustment entity.
+func (c *StockAdjustmentClient) Create() *StockAdjustmentCreate {
+	mutation := newStockAdjustmentMutation(c.config, OpCreate)
+	return &StockAdjustmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StockAdjustment entities.
+func (c *StockAdjustmentClient) CreateBulk(builders ...*StockAdjustmentCreate) *StockAdjustmentCreateBulk {
+	return &StockAdjustmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StockAdjustment.
+func (c *StockAdjustmentClient) Update() *StockAdjustmentUpdate {
+	mutation := newStockAdjustmentMutation(c.config, OpUpdate)
+	return &StockAdjustmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StockAdjustmentClient) UpdateOne(sa *StockAdjustment) *StockAdjustmentUpdateOne {
+	mutation := newStockAdjustmentMutation(c.config, OpUpdateOne, withStockAdjustment(sa))
+	return &StockAdjustmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StockAdjustmentClient) UpdateOneID(id int) *StockAdjustmentUpdateOne {
+	mutation := newStockAdjustmentMutation(c.config, OpUpdateOne, withStockAdjustmentID(id))
+	return &StockAdjustmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StockAdjustment.
+func (c *StockAdjustmentClient) Delete() *StockAdjustmentDelete {
+	mutation := newStockAdjustmentMutation(c.config, OpDelete)
+	return &StockAdjustmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StockAdjustmentClient) DeleteOne(sa *StockAdjustment) *StockAdjustmentDeleteOne {
+	return c.DeleteOneID(sa.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *StockAdjustmentClient) DeleteOneID(id int) *StockAdjustmentDeleteOne {
+	builder := c.Delete().Where(stockadjustment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StockAdjustmentDeleteOne{builder}
+}
+
+// Query returns a query builder for StockAdjustment.
+func (c *StockAdjustmentClient) Query() *StockAdjustmentQuery {
+	return &StockAdjustmentQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a StockAdjustment entity by its id.
+func (c *StockAdjustmentClient) Get(ctx context.Context, id int) (*StockAdjustment, error) {
+	return c.Query().Where(stockadjustment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StockAdjustmentClient) GetX(ctx context.Context, id int) *StockAdjustment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StockAdjustmentClient) Hooks() []Hook {
+	return c.hooks.StockAdjustment
+}
+
+// StockAdjustmentLineClient is a client for the StockAdjustmentLine schema.
+type StockAdjustmentLineClient struct {
+	config
+}
+
+// NewStockAdjustmentLineClient returns a client for the StockAdjustmentLine from the given config.
+func NewStockAdjustmentLineClient(c config) *StockAdjustmentLineClient {
+	return &StockAdjustmentLineClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `stockadjustmentline.Hooks(f(g(h())))`.
+func (c *StockAdjustmentLineClient) Use(hooks ...Hook) {
+	c.hooks.StockAdjustmentLine = append(c.hooks.StockAdjustmentLine, hooks...)
+}
+
+// Create returns a builder for creating a StockAdjustmentLine entity.
+func (c *StockAdjustmentLineClient) Create() *StockAdjustmentLineCreate {
+	mutation := newStockAdjustmentLineMutation(c.config, OpCreate)
+	return &StockAdjustmentLineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StockAdjustmentLine entities.
+func (c *StockAdjustmentLineClient) CreateBulk(builders ...*StockAdjustmentLineCreate) *StockAdjustmentLineCreateBulk {
+	return &StockAdjustmentLineCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StockAdjustmentLine.
+func (c *StockAdjustmentLineClient) Update() *StockAdjustmentLineUpdate {
+	mutation := newStockAdjustmentLineMutation(c.config, OpUpdate)
+	return &StockAdjustmentLineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StockAdjustmentLineClient) UpdateOne(sal *StockAdjustmentLine) *StockAdjustmentLineUpdateOne {
+	mutation := newStockAdjustmentLineMutation(c.config, OpUpdateOne, withStockAdjustmentLine(sal))
+	return &StockAdjustmentLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StockAdjustmentLineClient) UpdateOneID(id int) *StockAdjustmentLineUpdateOne {
+	mutation := newStockAdjustmentLineMutation(c.config, OpUpdateOne, withStockAdjustmentLineID(id))
+	return &StockAdjustmentLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StockAdjustmentLine.
+func (c *StockAdjustmentLineClient) Delete() *StockAdjustmentLineDelete {
+	mutation := newStockAdjustmentLineMutation(c.config, OpDelete)
+	return &StockAdjustmentLineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StockAdjustmentLineClient) DeleteOne(sal *StockAdjustmentLine) *StockAdjustmentLineDeleteOne {
+	return c.DeleteOneID(sal.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *StockAdjustmentLineClient) DeleteOneID(id int) *StockAdjustmentLineDeleteOne {
+	builder := c.Delete().Where(stockadjustmentline.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StockAdjustmentLineDeleteOne{builder}
+}
+
+// Query returns a query builder for StockAdjustmentLine.
+func (c *StockAdjustmentLineClient) Query() *StockAdjustmentLineQuery {
+	return &StockAdjustmentLineQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a StockAdjustmentLine entity by its id.
+func (c *StockAdjustmentLineClient) Get(ctx context.Context, id int) (*StockAdjustmentLine, error) {
+	return c.Query().Where(stockadjustmentline.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StockAdjustmentLineClient) GetX(ctx context.Context, id int) *StockAdjustmentLine {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StockAdjustmentLineClient) Hooks() []Hook {
+	return c.hooks.StockAdjustmentLine
+}
+
 // SupplierClient is a client for the Supplier schema.
 type SupplierClient struct {
 	config
@@ -873,6 +3805,96 @@ func (c *SupplierPaymentClient) Hooks() []Hook {
 	return c.hooks.SupplierPayment
 }
 
+// TaxRateClient is a client for the TaxRate schema.
+type TaxRateClient struct {
+	config
+}
+
+// NewTaxRateClient returns a client for the TaxRate from the given config.
+func NewTaxRateClient(c config) *TaxRateClient {
+	return &TaxRateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taxrate.Hooks(f(g(h())))`.
+func (c *TaxRateClient) Use(hooks ...Hook) {
+	c.hooks.TaxRate = append(c.hooks.TaxRate, hooks...)
+}
+
+// Create returns a builder for creating a TaxRate entity.
+func (c *TaxRateClient) Create() *TaxRateCreate {
+	mutation := newTaxRateMutation(c.config, OpCreate)
+	return &TaxRateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaxRate entities.
+func (c *TaxRateClient) CreateBulk(builders ...*TaxRateCreate) *TaxRateCreateBulk {
+	return &TaxRateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaxRate.
+func (c *TaxRateClient) Update() *TaxRateUpdate {
+	mutation := newTaxRateMutation(c.config, OpUpdate)
+	return &TaxRateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaxRateClient) UpdateOne(tr *TaxRate) *TaxRateUpdateOne {
+	mutation := newTaxRateMutation(c.config, OpUpdateOne, withTaxRate(tr))
+	return &TaxRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaxRateClient) UpdateOneID(id int) *TaxRateUpdateOne {
+	mutation := newTaxRateMutation(c.config, OpUpdateOne, withTaxRateID(id))
+	return &TaxRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaxRate.
+func (c *TaxRateClient) Delete() *TaxRateDelete {
+	mutation := newTaxRateMutation(c.config, OpDelete)
+	return &TaxRateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaxRateClient) DeleteOne(tr *TaxRate) *TaxRateDeleteOne {
+	return c.DeleteOneID(tr.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *TaxRateClient) DeleteOneID(id int) *TaxRateDeleteOne {
+	builder := c.Delete().Where(taxrate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaxRateDeleteOne{builder}
+}
+
+// Query returns a query builder for TaxRate.
+func (c *TaxRateClient) Query() *TaxRateQuery {
+	return &TaxRateQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a TaxRate entity by its id.
+func (c *TaxRateClient) Get(ctx context.Context, id int) (*TaxRate, error) {
+	return c.Query().Where(taxrate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaxRateClient) GetX(ctx context.Context, id int) *TaxRate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaxRateClient) Hooks() []Hook {
+	return c.hooks.TaxRate
+}
+
 // TenantClient is a client for the Tenant schema.
 type TenantClient struct {
 	config
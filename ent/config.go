@@ -24,15 +24,46 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Invoice             []ent.Hook
-	InvoiceItem         []ent.Hook
-	Product             []ent.Hook
-	PurchaseInvoice     []ent.Hook
-	PurchaseInvoiceItem []ent.Hook
-	Supplier            []ent.Hook
-	SupplierPayment     []ent.Hook
-	Tenant              []ent.Hook
-	User                []ent.Hook
+	AdjustmentReason     []ent.Hook
+	AuditLog             []ent.Hook
+	BundleAssembly       []ent.Hook
+	BundleAssemblyLine   []ent.Hook
+	BundleComponent      []ent.Hook
+	Customer             []ent.Hook
+	ExchangeRate         []ent.Hook
+	FiscalDocument       []ent.Hook
+	InventoryCostLayer   []ent.Hook
+	InventoryCount       []ent.Hook
+	InventoryCountEntry  []ent.Hook
+	InventoryCountLine   []ent.Hook
+	Invoice              []ent.Hook
+	InvoiceItem          []ent.Hook
+	InvoiceItemComponent []ent.Hook
+	InvoiceLotAllocation []ent.Hook
+	InvoiceStatusEvent   []ent.Hook
+	InvoiceTax           []ent.Hook
+	NumberingSeries      []ent.Hook
+	PriceList            []ent.Hook
+	PriceListItem        []ent.Hook
+	Product              []ent.Hook
+	ProductBarcode       []ent.Hook
+	ProductFile          []ent.Hook
+	ProductLot           []ent.Hook
+	ProductPrice         []ent.Hook
+	ProductSerial        []ent.Hook
+	ProductSerialEvent   []ent.Hook
+	ProductUnit          []ent.Hook
+	Promotion            []ent.Hook
+	PromotionTarget      []ent.Hook
+	PurchaseInvoice      []ent.Hook
+	PurchaseInvoiceItem  []ent.Hook
+	StockAdjustment      []ent.Hook
+	StockAdjustmentLine  []ent.Hook
+	Supplier             []ent.Hook
+	SupplierPayment      []ent.Hook
+	TaxRate              []ent.Hook
+	Tenant               []ent.Hook
+	User                 []ent.Hook
 }
 
 // Options applies the options on the config object.
@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/customer"
+	"Veritasbackend/ent/pricelist"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Customer is the model entity for the Customer schema.
+type Customer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Nombre o razón social del cliente
+	Name string `json:"name,omitempty"`
+	// Tipo de documento (NIT, RUC, CC, CE, PASSPORT, ...)
+	DocumentType string `json:"document_type,omitempty"`
+	// Número de documento normalizado, sin puntos, guiones ni espacios
+	DocumentNumber string `json:"document_number,omitempty"`
+	// Email de contacto
+	Email string `json:"email,omitempty"`
+	// Teléfono de contacto
+	Phone string `json:"phone,omitempty"`
+	// Dirección del cliente
+	Address string `json:"address,omitempty"`
+	// Régimen tributario del cliente
+	TaxRegime string `json:"tax_regime,omitempty"`
+	// Grupo del cliente (mayorista, VIP, ...) para las promociones
+	CustomerGroup string `json:"customer_group,omitempty"`
+	// Lista de precios que se aplica a sus facturas si no se elige otra
+	PriceListID *int `json:"price_list_id,omitempty"`
+	// Eliminado de forma lógica: no aparece en listados ni búsquedas pero sigue resolviéndose desde las facturas
+	Archived bool `json:"archived,omitempty"`
+	// Fecha de eliminación lógica
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ID del tenant al que pertenece
+	TenantID int `json:"tenant_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CustomerQuery when eager-loading is set.
+	Edges CustomerEdges `json:"edges"`
+}
+
+// CustomerEdges holds the relations/edges for other nodes in the graph.
+type CustomerEdges struct {
+	// PriceList holds the value of the price_list edge.
+	PriceList *PriceList `json:"price_list,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PriceListOrErr returns the PriceList value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CustomerEdges) PriceListOrErr() (*PriceList, error) {
+	if e.loadedTypes[0] {
+		if e.PriceList == nil {
+			// The edge price_list was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: pricelist.Label}
+		}
+		return e.PriceList, nil
+	}
+	return nil, &NotLoadedError{edge: "price_list"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Customer) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case customer.FieldArchived:
+			values[i] = new(sql.NullBool)
+		case customer.FieldID, customer.FieldPriceListID, customer.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case customer.FieldName, customer.FieldDocumentType, customer.FieldDocumentNumber, customer.FieldEmail, customer.FieldPhone, customer.FieldAddress, customer.FieldTaxRegime, customer.FieldCustomerGroup:
+			values[i] = new(sql.NullString)
+		case customer.FieldDeletedAt, customer.FieldCreatedAt, customer.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Customer", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Customer fields.
+func (c *Customer) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case customer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case customer.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case customer.FieldDocumentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_type", values[i])
+			} else if value.Valid {
+				c.DocumentType = value.String
+			}
+		case customer.FieldDocumentNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_number", values[i])
+			} else if value.Valid {
+				c.DocumentNumber = value.String
+			}
+		case customer.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				c.Email = value.String
+			}
+		case customer.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				c.Phone = value.String
+			}
+		case customer.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				c.Address = value.String
+			}
+		case customer.FieldTaxRegime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_regime", values[i])
+			} else if value.Valid {
+				c.TaxRegime = value.String
+			}
+		case customer.FieldCustomerGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_group", values[i])
+			} else if value.Valid {
+				c.CustomerGroup = value.String
+			}
+		case customer.FieldPriceListID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price_list_id", values[i])
+			} else if value.Valid {
+				c.PriceListID = new(int)
+				*c.PriceListID = int(value.Int64)
+			}
+		case customer.FieldArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field archived", values[i])
+			} else if value.Valid {
+				c.Archived = value.Bool
+			}
+		case customer.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				c.DeletedAt = new(time.Time)
+				*c.DeletedAt = value.Time
+			}
+		case customer.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				c.TenantID = int(value.Int64)
+			}
+		case customer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case customer.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryPriceList queries the "price_list" edge of the Customer entity.
+func (c *Customer) QueryPriceList() *PriceListQuery {
+	return (&CustomerClient{config: c.config}).QueryPriceList(c)
+}
+
+// Update returns a builder for updating this Customer.
+// Note that you need to call Customer.Unwrap() before calling this method if this Customer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Customer) Update() *CustomerUpdateOne {
+	return (&CustomerClient{config: c.config}).UpdateOne(c)
+}
+
+// Unwrap unwraps the Customer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Customer) Unwrap() *Customer {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Customer is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Customer) String() string {
+	var builder strings.Builder
+	builder.WriteString("Customer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("name=")
+	builder.WriteString(c.Name)
+	builder.WriteString(", ")
+	builder.WriteString("document_type=")
+	builder.WriteString(c.DocumentType)
+	builder.WriteString(", ")
+	builder.WriteString("document_number=")
+	builder.WriteString(c.DocumentNumber)
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(c.Email)
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(c.Phone)
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(c.Address)
+	builder.WriteString(", ")
+	builder.WriteString("tax_regime=")
+	builder.WriteString(c.TaxRegime)
+	builder.WriteString(", ")
+	builder.WriteString("customer_group=")
+	builder.WriteString(c.CustomerGroup)
+	builder.WriteString(", ")
+	if v := c.PriceListID; v != nil {
+		builder.WriteString("price_list_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("archived=")
+	builder.WriteString(fmt.Sprintf("%v", c.Archived))
+	builder.WriteString(", ")
+	if v := c.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Customers is a parsable slice of Customer.
+type Customers []*Customer
+
+func (c Customers) config(cfg config) {
+	for _i := range c {
+		c[_i].config = cfg
+	}
+}
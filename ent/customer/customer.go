@@ -0,0 +1,95 @@
+// Code generated by ent, DO NOT EDIT.
+
+package customer
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the customer type in the database.
+	Label = "customer"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDocumentType holds the string denoting the document_type field in the database.
+	FieldDocumentType = "document_type"
+	// FieldDocumentNumber holds the string denoting the document_number field in the database.
+	FieldDocumentNumber = "document_number"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldPhone holds the string denoting the phone field in the database.
+	FieldPhone = "phone"
+	// FieldAddress holds the string denoting the address field in the database.
+	FieldAddress = "address"
+	// FieldTaxRegime holds the string denoting the tax_regime field in the database.
+	FieldTaxRegime = "tax_regime"
+	// FieldCustomerGroup holds the string denoting the customer_group field in the database.
+	FieldCustomerGroup = "customer_group"
+	// FieldPriceListID holds the string denoting the price_list_id field in the database.
+	FieldPriceListID = "price_list_id"
+	// FieldArchived holds the string denoting the archived field in the database.
+	FieldArchived = "archived"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgePriceList holds the string denoting the price_list edge name in mutations.
+	EdgePriceList = "price_list"
+	// Table holds the table name of the customer in the database.
+	Table = "customers"
+	// PriceListTable is the table that holds the price_list relation/edge.
+	PriceListTable = "customers"
+	// PriceListInverseTable is the table name for the PriceList entity.
+	// It exists in this package in order to avoid circular dependency with the "pricelist" package.
+	PriceListInverseTable = "price_lists"
+	// PriceListColumn is the table column denoting the price_list relation/edge.
+	PriceListColumn = "price_list_id"
+)
+
+// Columns holds all SQL columns for customer fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldDocumentType,
+	FieldDocumentNumber,
+	FieldEmail,
+	FieldPhone,
+	FieldAddress,
+	FieldTaxRegime,
+	FieldCustomerGroup,
+	FieldPriceListID,
+	FieldArchived,
+	FieldDeletedAt,
+	FieldTenantID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultArchived holds the default value on creation for the "archived" field.
+	DefaultArchived bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
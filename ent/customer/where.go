@@ -0,0 +1,1620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package customer
+
+import (
+	"Veritasbackend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// DocumentType applies equality check predicate on the "document_type" field. It's identical to DocumentTypeEQ.
+func DocumentType(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentNumber applies equality check predicate on the "document_number" field. It's identical to DocumentNumberEQ.
+func DocumentNumber(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentNumber), v))
+	})
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEmail), v))
+	})
+}
+
+// Phone applies equality check predicate on the "phone" field. It's identical to PhoneEQ.
+func Phone(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPhone), v))
+	})
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAddress), v))
+	})
+}
+
+// TaxRegime applies equality check predicate on the "tax_regime" field. It's identical to TaxRegimeEQ.
+func TaxRegime(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRegime), v))
+	})
+}
+
+// CustomerGroup applies equality check predicate on the "customer_group" field. It's identical to CustomerGroupEQ.
+func CustomerGroup(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// PriceListID applies equality check predicate on the "price_list_id" field. It's identical to PriceListIDEQ.
+func PriceListID(v int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriceListID), v))
+	})
+}
+
+// Archived applies equality check predicate on the "archived" field. It's identical to ArchivedEQ.
+func Archived(v bool) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldArchived), v))
+	})
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// DocumentTypeEQ applies the EQ predicate on the "document_type" field.
+func DocumentTypeEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeNEQ applies the NEQ predicate on the "document_type" field.
+func DocumentTypeNEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeIn applies the In predicate on the "document_type" field.
+func DocumentTypeIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDocumentType), v...))
+	})
+}
+
+// DocumentTypeNotIn applies the NotIn predicate on the "document_type" field.
+func DocumentTypeNotIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDocumentType), v...))
+	})
+}
+
+// DocumentTypeGT applies the GT predicate on the "document_type" field.
+func DocumentTypeGT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeGTE applies the GTE predicate on the "document_type" field.
+func DocumentTypeGTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeLT applies the LT predicate on the "document_type" field.
+func DocumentTypeLT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeLTE applies the LTE predicate on the "document_type" field.
+func DocumentTypeLTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeContains applies the Contains predicate on the "document_type" field.
+func DocumentTypeContains(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeHasPrefix applies the HasPrefix predicate on the "document_type" field.
+func DocumentTypeHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeHasSuffix applies the HasSuffix predicate on the "document_type" field.
+func DocumentTypeHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeIsNil applies the IsNil predicate on the "document_type" field.
+func DocumentTypeIsNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDocumentType)))
+	})
+}
+
+// DocumentTypeNotNil applies the NotNil predicate on the "document_type" field.
+func DocumentTypeNotNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDocumentType)))
+	})
+}
+
+// DocumentTypeEqualFold applies the EqualFold predicate on the "document_type" field.
+func DocumentTypeEqualFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeContainsFold applies the ContainsFold predicate on the "document_type" field.
+func DocumentTypeContainsFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentNumberEQ applies the EQ predicate on the "document_number" field.
+func DocumentNumberEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentNumber), v))
+	})
+}
+
+// DocumentNumberNEQ applies the NEQ predicate on the "document_number" field.
+func DocumentNumberNEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDocumentNumber), v))
+	})
+}
+
+// DocumentNumberIn applies the In predicate on the "document_number" field.
+func DocumentNumberIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDocumentNumber), v...))
+	})
+}
+
+// DocumentNumberNotIn applies the NotIn predicate on the "document_number" field.
+func DocumentNumberNotIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDocumentNumber), v...))
+	})
+}
+
+// DocumentNumberGT applies the GT predicate on the "document_number" field.
+func DocumentNumberGT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDocumentNumber), v))
+	})
+}
+
+// DocumentNumberGTE applies the GTE predicate on the "document_number" field.
+func DocumentNumberGTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDocumentNumber), v))
+	})
+}
+
+// DocumentNumberLT applies the LT predicate on the "document_number" field.
+func DocumentNumberLT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDocumentNumber), v))
+	})
+}
+
+// DocumentNumberLTE applies the LTE predicate on the "document_number" field.
+func DocumentNumberLTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDocumentNumber), v))
+	})
+}
+
+// DocumentNumberContains applies the Contains predicate on the "document_number" field.
+func DocumentNumberContains(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDocumentNumber), v))
+	})
+}
+
+// DocumentNumberHasPrefix applies the HasPrefix predicate on the "document_number" field.
+func DocumentNumberHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDocumentNumber), v))
+	})
+}
+
+// DocumentNumberHasSuffix applies the HasSuffix predicate on the "document_number" field.
+func DocumentNumberHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDocumentNumber), v))
+	})
+}
+
+// DocumentNumberIsNil applies the IsNil predicate on the "document_number" field.
+func DocumentNumberIsNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDocumentNumber)))
+	})
+}
+
+// DocumentNumberNotNil applies the NotNil predicate on the "document_number" field.
+func DocumentNumberNotNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDocumentNumber)))
+	})
+}
+
+// DocumentNumberEqualFold applies the EqualFold predicate on the "document_number" field.
+func DocumentNumberEqualFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDocumentNumber), v))
+	})
+}
+
+// DocumentNumberContainsFold applies the ContainsFold predicate on the "document_number" field.
+func DocumentNumberContainsFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDocumentNumber), v))
+	})
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEmail), v))
+	})
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEmail), v))
+	})
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEmail), v...))
+	})
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEmail), v...))
+	})
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEmail), v))
+	})
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEmail), v))
+	})
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEmail), v))
+	})
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEmail), v))
+	})
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEmail), v))
+	})
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEmail), v))
+	})
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEmail), v))
+	})
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEmail)))
+	})
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEmail)))
+	})
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEmail), v))
+	})
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEmail), v))
+	})
+}
+
+// PhoneEQ applies the EQ predicate on the "phone" field.
+func PhoneEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneNEQ applies the NEQ predicate on the "phone" field.
+func PhoneNEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneIn applies the In predicate on the "phone" field.
+func PhoneIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPhone), v...))
+	})
+}
+
+// PhoneNotIn applies the NotIn predicate on the "phone" field.
+func PhoneNotIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPhone), v...))
+	})
+}
+
+// PhoneGT applies the GT predicate on the "phone" field.
+func PhoneGT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneGTE applies the GTE predicate on the "phone" field.
+func PhoneGTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneLT applies the LT predicate on the "phone" field.
+func PhoneLT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneLTE applies the LTE predicate on the "phone" field.
+func PhoneLTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneContains applies the Contains predicate on the "phone" field.
+func PhoneContains(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
+func PhoneHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
+func PhoneHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneIsNil applies the IsNil predicate on the "phone" field.
+func PhoneIsNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPhone)))
+	})
+}
+
+// PhoneNotNil applies the NotNil predicate on the "phone" field.
+func PhoneNotNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPhone)))
+	})
+}
+
+// PhoneEqualFold applies the EqualFold predicate on the "phone" field.
+func PhoneEqualFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneContainsFold applies the ContainsFold predicate on the "phone" field.
+func PhoneContainsFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPhone), v))
+	})
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAddress), v))
+	})
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAddress), v))
+	})
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAddress), v...))
+	})
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAddress), v...))
+	})
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAddress), v))
+	})
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAddress), v))
+	})
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAddress), v))
+	})
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAddress), v))
+	})
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAddress), v))
+	})
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAddress), v))
+	})
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAddress), v))
+	})
+}
+
+// AddressIsNil applies the IsNil predicate on the "address" field.
+func AddressIsNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAddress)))
+	})
+}
+
+// AddressNotNil applies the NotNil predicate on the "address" field.
+func AddressNotNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAddress)))
+	})
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAddress), v))
+	})
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAddress), v))
+	})
+}
+
+// TaxRegimeEQ applies the EQ predicate on the "tax_regime" field.
+func TaxRegimeEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRegime), v))
+	})
+}
+
+// TaxRegimeNEQ applies the NEQ predicate on the "tax_regime" field.
+func TaxRegimeNEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxRegime), v))
+	})
+}
+
+// TaxRegimeIn applies the In predicate on the "tax_regime" field.
+func TaxRegimeIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxRegime), v...))
+	})
+}
+
+// TaxRegimeNotIn applies the NotIn predicate on the "tax_regime" field.
+func TaxRegimeNotIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxRegime), v...))
+	})
+}
+
+// TaxRegimeGT applies the GT predicate on the "tax_regime" field.
+func TaxRegimeGT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxRegime), v))
+	})
+}
+
+// TaxRegimeGTE applies the GTE predicate on the "tax_regime" field.
+func TaxRegimeGTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxRegime), v))
+	})
+}
+
+// TaxRegimeLT applies the LT predicate on the "tax_regime" field.
+func TaxRegimeLT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxRegime), v))
+	})
+}
+
+// TaxRegimeLTE applies the LTE predicate on the "tax_regime" field.
+func TaxRegimeLTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxRegime), v))
+	})
+}
+
+// TaxRegimeContains applies the Contains predicate on the "tax_regime" field.
+func TaxRegimeContains(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTaxRegime), v))
+	})
+}
+
+// TaxRegimeHasPrefix applies the HasPrefix predicate on the "tax_regime" field.
+func TaxRegimeHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTaxRegime), v))
+	})
+}
+
+// TaxRegimeHasSuffix applies the HasSuffix predicate on the "tax_regime" field.
+func TaxRegimeHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTaxRegime), v))
+	})
+}
+
+// TaxRegimeIsNil applies the IsNil predicate on the "tax_regime" field.
+func TaxRegimeIsNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTaxRegime)))
+	})
+}
+
+// TaxRegimeNotNil applies the NotNil predicate on the "tax_regime" field.
+func TaxRegimeNotNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTaxRegime)))
+	})
+}
+
+// TaxRegimeEqualFold applies the EqualFold predicate on the "tax_regime" field.
+func TaxRegimeEqualFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTaxRegime), v))
+	})
+}
+
+// TaxRegimeContainsFold applies the ContainsFold predicate on the "tax_regime" field.
+func TaxRegimeContainsFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTaxRegime), v))
+	})
+}
+
+// CustomerGroupEQ applies the EQ predicate on the "customer_group" field.
+func CustomerGroupEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupNEQ applies the NEQ predicate on the "customer_group" field.
+func CustomerGroupNEQ(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupIn applies the In predicate on the "customer_group" field.
+func CustomerGroupIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCustomerGroup), v...))
+	})
+}
+
+// CustomerGroupNotIn applies the NotIn predicate on the "customer_group" field.
+func CustomerGroupNotIn(vs ...string) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCustomerGroup), v...))
+	})
+}
+
+// CustomerGroupGT applies the GT predicate on the "customer_group" field.
+func CustomerGroupGT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupGTE applies the GTE predicate on the "customer_group" field.
+func CustomerGroupGTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupLT applies the LT predicate on the "customer_group" field.
+func CustomerGroupLT(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupLTE applies the LTE predicate on the "customer_group" field.
+func CustomerGroupLTE(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupContains applies the Contains predicate on the "customer_group" field.
+func CustomerGroupContains(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupHasPrefix applies the HasPrefix predicate on the "customer_group" field.
+func CustomerGroupHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupHasSuffix applies the HasSuffix predicate on the "customer_group" field.
+func CustomerGroupHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupIsNil applies the IsNil predicate on the "customer_group" field.
+func CustomerGroupIsNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCustomerGroup)))
+	})
+}
+
+// CustomerGroupNotNil applies the NotNil predicate on the "customer_group" field.
+func CustomerGroupNotNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCustomerGroup)))
+	})
+}
+
+// CustomerGroupEqualFold applies the EqualFold predicate on the "customer_group" field.
+func CustomerGroupEqualFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupContainsFold applies the ContainsFold predicate on the "customer_group" field.
+func CustomerGroupContainsFold(v string) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// PriceListIDEQ applies the EQ predicate on the "price_list_id" field.
+func PriceListIDEQ(v int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDNEQ applies the NEQ predicate on the "price_list_id" field.
+func PriceListIDNEQ(v int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDIn applies the In predicate on the "price_list_id" field.
+func PriceListIDIn(vs ...int) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPriceListID), v...))
+	})
+}
+
+// PriceListIDNotIn applies the NotIn predicate on the "price_list_id" field.
+func PriceListIDNotIn(vs ...int) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPriceListID), v...))
+	})
+}
+
+// PriceListIDIsNil applies the IsNil predicate on the "price_list_id" field.
+func PriceListIDIsNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPriceListID)))
+	})
+}
+
+// PriceListIDNotNil applies the NotNil predicate on the "price_list_id" field.
+func PriceListIDNotNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPriceListID)))
+	})
+}
+
+// ArchivedEQ applies the EQ predicate on the "archived" field.
+func ArchivedEQ(v bool) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldArchived), v))
+	})
+}
+
+// ArchivedNEQ applies the NEQ predicate on the "archived" field.
+func ArchivedNEQ(v bool) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldArchived), v))
+	})
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDeletedAt)))
+	})
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDeletedAt)))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Customer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Customer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// HasPriceList applies the HasEdge predicate on the "price_list" edge.
+func HasPriceList() predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PriceListTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PriceListTable, PriceListColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPriceListWith applies the HasEdge predicate on the "price_list" edge with a given conditions (other predicates).
+func HasPriceListWith(preds ...predicate.PriceList) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PriceListInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PriceListTable, PriceListColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Customer) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Customer) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Customer) predicate.Customer {
+	return predicate.Customer(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,558 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/customer"
+	"Veritasbackend/ent/pricelist"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CustomerCreate is the builder for creating a Customer entity.
+type CustomerCreate struct {
+	config
+	mutation *CustomerMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (cc *CustomerCreate) SetName(s string) *CustomerCreate {
+	cc.mutation.SetName(s)
+	return cc
+}
+
+// SetDocumentType sets the "document_type" field.
+func (cc *CustomerCreate) SetDocumentType(s string) *CustomerCreate {
+	cc.mutation.SetDocumentType(s)
+	return cc
+}
+
+// SetNillableDocumentType sets the "document_type" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableDocumentType(s *string) *CustomerCreate {
+	if s != nil {
+		cc.SetDocumentType(*s)
+	}
+	return cc
+}
+
+// SetDocumentNumber sets the "document_number" field.
+func (cc *CustomerCreate) SetDocumentNumber(s string) *CustomerCreate {
+	cc.mutation.SetDocumentNumber(s)
+	return cc
+}
+
+// SetNillableDocumentNumber sets the "document_number" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableDocumentNumber(s *string) *CustomerCreate {
+	if s != nil {
+		cc.SetDocumentNumber(*s)
+	}
+	return cc
+}
+
+// SetEmail sets the "email" field.
+func (cc *CustomerCreate) SetEmail(s string) *CustomerCreate {
+	cc.mutation.SetEmail(s)
+	return cc
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableEmail(s *string) *CustomerCreate {
+	if s != nil {
+		cc.SetEmail(*s)
+	}
+	return cc
+}
+
+// SetPhone sets the "phone" field.
+func (cc *CustomerCreate) SetPhone(s string) *CustomerCreate {
+	cc.mutation.SetPhone(s)
+	return cc
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillablePhone(s *string) *CustomerCreate {
+	if s != nil {
+		cc.SetPhone(*s)
+	}
+	return cc
+}
+
+// SetAddress sets the "address" field.
+func (cc *CustomerCreate) SetAddress(s string) *CustomerCreate {
+	cc.mutation.SetAddress(s)
+	return cc
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableAddress(s *string) *CustomerCreate {
+	if s != nil {
+		cc.SetAddress(*s)
+	}
+	return cc
+}
+
+// SetTaxRegime sets the "tax_regime" field.
+func (cc *CustomerCreate) SetTaxRegime(s string) *CustomerCreate {
+	cc.mutation.SetTaxRegime(s)
+	return cc
+}
+
+// SetNillableTaxRegime sets the "tax_regime" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableTaxRegime(s *string) *CustomerCreate {
+	if s != nil {
+		cc.SetTaxRegime(*s)
+	}
+	return cc
+}
+
+// SetCustomerGroup sets the "customer_group" field.
+func (cc *CustomerCreate) SetCustomerGroup(s string) *CustomerCreate {
+	cc.mutation.SetCustomerGroup(s)
+	return cc
+}
+
+// SetNillableCustomerGroup sets the "customer_group" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableCustomerGroup(s *string) *CustomerCreate {
+	if s != nil {
+		cc.SetCustomerGroup(*s)
+	}
+	return cc
+}
+
+// SetPriceListID sets the "price_list_id" field.
+func (cc *CustomerCreate) SetPriceListID(i int) *CustomerCreate {
+	cc.mutation.SetPriceListID(i)
+	return cc
+}
+
+// SetNillablePriceListID sets the "price_list_id" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillablePriceListID(i *int) *CustomerCreate {
+	if i != nil {
+		cc.SetPriceListID(*i)
+	}
+	return cc
+}
+
+// SetArchived sets the "archived" field.
+func (cc *CustomerCreate) SetArchived(b bool) *CustomerCreate {
+	cc.mutation.SetArchived(b)
+	return cc
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableArchived(b *bool) *CustomerCreate {
+	if b != nil {
+		cc.SetArchived(*b)
+	}
+	return cc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cc *CustomerCreate) SetDeletedAt(t time.Time) *CustomerCreate {
+	cc.mutation.SetDeletedAt(t)
+	return cc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableDeletedAt(t *time.Time) *CustomerCreate {
+	if t != nil {
+		cc.SetDeletedAt(*t)
+	}
+	return cc
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (cc *CustomerCreate) SetTenantID(i int) *CustomerCreate {
+	cc.mutation.SetTenantID(i)
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CustomerCreate) SetCreatedAt(t time.Time) *CustomerCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableCreatedAt(t *time.Time) *CustomerCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *CustomerCreate) SetUpdatedAt(t time.Time) *CustomerCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *CustomerCreate) SetNillableUpdatedAt(t *time.Time) *CustomerCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetPriceList sets the "price_list" edge to the PriceList entity.
+func (cc *CustomerCreate) SetPriceList(p *PriceList) *CustomerCreate {
+	return cc.SetPriceListID(p.ID)
+}
+
+// Mutation returns the CustomerMutation object of the builder.
+func (cc *CustomerCreate) Mutation() *CustomerMutation {
+	return cc.mutation
+}
+
+// Save creates the Customer in the database.
+func (cc *CustomerCreate) Save(ctx context.Context) (*Customer, error) {
+	var (
+		err  error
+		node *Customer
+	)
+	cc.defaults()
+	if len(cc.hooks) == 0 {
+		if err = cc.check(); err != nil {
+			return nil, err
+		}
+		node, err = cc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CustomerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cc.check(); err != nil {
+				return nil, err
+			}
+			cc.mutation = mutation
+			if node, err = cc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cc.hooks) - 1; i >= 0; i-- {
+			if cc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, cc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Customer)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from CustomerMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CustomerCreate) SaveX(ctx context.Context) *Customer {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CustomerCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CustomerCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CustomerCreate) defaults() {
+	if _, ok := cc.mutation.Archived(); !ok {
+		v := customer.DefaultArchived
+		cc.mutation.SetArchived(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := customer.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := customer.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CustomerCreate) check() error {
+	if _, ok := cc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Customer.name"`)}
+	}
+	if v, ok := cc.mutation.Name(); ok {
+		if err := customer.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Customer.name": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Archived(); !ok {
+		return &ValidationError{Name: "archived", err: errors.New(`ent: missing required field "Customer.archived"`)}
+	}
+	if _, ok := cc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Customer.tenant_id"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Customer.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Customer.updated_at"`)}
+	}
+	return nil
+}
+
+func (cc *CustomerCreate) sqlSave(ctx context.Context) (*Customer, error) {
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (cc *CustomerCreate) createSpec() (*Customer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Customer{config: cc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: customer.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customer.FieldID,
+			},
+		}
+	)
+	if value, ok := cc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := cc.mutation.DocumentType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldDocumentType,
+		})
+		_node.DocumentType = value
+	}
+	if value, ok := cc.mutation.DocumentNumber(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldDocumentNumber,
+		})
+		_node.DocumentNumber = value
+	}
+	if value, ok := cc.mutation.Email(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldEmail,
+		})
+		_node.Email = value
+	}
+	if value, ok := cc.mutation.Phone(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldPhone,
+		})
+		_node.Phone = value
+	}
+	if value, ok := cc.mutation.Address(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldAddress,
+		})
+		_node.Address = value
+	}
+	if value, ok := cc.mutation.TaxRegime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldTaxRegime,
+		})
+		_node.TaxRegime = value
+	}
+	if value, ok := cc.mutation.CustomerGroup(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldCustomerGroup,
+		})
+		_node.CustomerGroup = value
+	}
+	if value, ok := cc.mutation.Archived(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: customer.FieldArchived,
+		})
+		_node.Archived = value
+	}
+	if value, ok := cc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customer.FieldDeletedAt,
+		})
+		_node.DeletedAt = &value
+	}
+	if value, ok := cc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customer.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customer.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customer.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := cc.mutation.PriceListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customer.PriceListTable,
+			Columns: []string{customer.PriceListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: pricelist.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PriceListID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CustomerCreateBulk is the builder for creating many Customer entities in bulk.
+type CustomerCreateBulk struct {
+	config
+	builders []*CustomerCreate
+}
+
+// Save creates the Customer entities in the database.
+func (ccb *CustomerCreateBulk) Save(ctx context.Context) ([]*Customer, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Customer, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CustomerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CustomerCreateBulk) SaveX(ctx context.Context) []*Customer {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CustomerCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CustomerCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1105 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/customer"
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/pricelist"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CustomerUpdate is the builder for updating Customer entities.
+type CustomerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CustomerMutation
+}
+
+// Where appends a list predicates to the CustomerUpdate builder.
+func (cu *CustomerUpdate) Where(ps ...predicate.Customer) *CustomerUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *CustomerUpdate) SetName(s string) *CustomerUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetDocumentType sets the "document_type" field.
+func (cu *CustomerUpdate) SetDocumentType(s string) *CustomerUpdate {
+	cu.mutation.SetDocumentType(s)
+	return cu
+}
+
+// SetNillableDocumentType sets the "document_type" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableDocumentType(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetDocumentType(*s)
+	}
+	return cu
+}
+
+// ClearDocumentType clears the value of the "document_type" field.
+func (cu *CustomerUpdate) ClearDocumentType() *CustomerUpdate {
+	cu.mutation.ClearDocumentType()
+	return cu
+}
+
+// SetDocumentNumber sets the "document_number" field.
+func (cu *CustomerUpdate) SetDocumentNumber(s string) *CustomerUpdate {
+	cu.mutation.SetDocumentNumber(s)
+	return cu
+}
+
+// SetNillableDocumentNumber sets the "document_number" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableDocumentNumber(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetDocumentNumber(*s)
+	}
+	return cu
+}
+
+// ClearDocumentNumber clears the value of the "document_number" field.
+func (cu *CustomerUpdate) ClearDocumentNumber() *CustomerUpdate {
+	cu.mutation.ClearDocumentNumber()
+	return cu
+}
+
+// SetEmail sets the "email" field.
+func (cu *CustomerUpdate) SetEmail(s string) *CustomerUpdate {
+	cu.mutation.SetEmail(s)
+	return cu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableEmail(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetEmail(*s)
+	}
+	return cu
+}
+
+// ClearEmail clears the value of the "email" field.
+func (cu *CustomerUpdate) ClearEmail() *CustomerUpdate {
+	cu.mutation.ClearEmail()
+	return cu
+}
+
+// SetPhone sets the "phone" field.
+func (cu *CustomerUpdate) SetPhone(s string) *CustomerUpdate {
+	cu.mutation.SetPhone(s)
+	return cu
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillablePhone(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetPhone(*s)
+	}
+	return cu
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (cu *CustomerUpdate) ClearPhone() *CustomerUpdate {
+	cu.mutation.ClearPhone()
+	return cu
+}
+
+// SetAddress sets the "address" field.
+func (cu *CustomerUpdate) SetAddress(s string) *CustomerUpdate {
+	cu.mutation.SetAddress(s)
+	return cu
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableAddress(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetAddress(*s)
+	}
+	return cu
+}
+
+// ClearAddress clears the value of the "address" field.
+func (cu *CustomerUpdate) ClearAddress() *CustomerUpdate {
+	cu.mutation.ClearAddress()
+	return cu
+}
+
+// SetTaxRegime sets the "tax_regime" field.
+func (cu *CustomerUpdate) SetTaxRegime(s string) *CustomerUpdate {
+	cu.mutation.SetTaxRegime(s)
+	return cu
+}
+
+// SetNillableTaxRegime sets the "tax_regime" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableTaxRegime(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetTaxRegime(*s)
+	}
+	return cu
+}
+
+// ClearTaxRegime clears the value of the "tax_regime" field.
+func (cu *CustomerUpdate) ClearTaxRegime() *CustomerUpdate {
+	cu.mutation.ClearTaxRegime()
+	return cu
+}
+
+// SetCustomerGroup sets the "customer_group" field.
+func (cu *CustomerUpdate) SetCustomerGroup(s string) *CustomerUpdate {
+	cu.mutation.SetCustomerGroup(s)
+	return cu
+}
+
+// SetNillableCustomerGroup sets the "customer_group" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableCustomerGroup(s *string) *CustomerUpdate {
+	if s != nil {
+		cu.SetCustomerGroup(*s)
+	}
+	return cu
+}
+
+// ClearCustomerGroup clears the value of the "customer_group" field.
+func (cu *CustomerUpdate) ClearCustomerGroup() *CustomerUpdate {
+	cu.mutation.ClearCustomerGroup()
+	return cu
+}
+
+// SetPriceListID sets the "price_list_id" field.
+func (cu *CustomerUpdate) SetPriceListID(i int) *CustomerUpdate {
+	cu.mutation.SetPriceListID(i)
+	return cu
+}
+
+// SetNillablePriceListID sets the "price_list_id" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillablePriceListID(i *int) *CustomerUpdate {
+	if i != nil {
+		cu.SetPriceListID(*i)
+	}
+	return cu
+}
+
+// ClearPriceListID clears the value of the "price_list_id" field.
+func (cu *CustomerUpdate) ClearPriceListID() *CustomerUpdate {
+	cu.mutation.ClearPriceListID()
+	return cu
+}
+
+// SetArchived sets the "archived" field.
+func (cu *CustomerUpdate) SetArchived(b bool) *CustomerUpdate {
+	cu.mutation.SetArchived(b)
+	return cu
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableArchived(b *bool) *CustomerUpdate {
+	if b != nil {
+		cu.SetArchived(*b)
+	}
+	return cu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cu *CustomerUpdate) SetDeletedAt(t time.Time) *CustomerUpdate {
+	cu.mutation.SetDeletedAt(t)
+	return cu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cu *CustomerUpdate) SetNillableDeletedAt(t *time.Time) *CustomerUpdate {
+	if t != nil {
+		cu.SetDeletedAt(*t)
+	}
+	return cu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cu *CustomerUpdate) ClearDeletedAt() *CustomerUpdate {
+	cu.mutation.ClearDeletedAt()
+	return cu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (cu *CustomerUpdate) SetTenantID(i int) *CustomerUpdate {
+	cu.mutation.ResetTenantID()
+	cu.mutation.SetTenantID(i)
+	return cu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (cu *CustomerUpdate) AddTenantID(i int) *CustomerUpdate {
+	cu.mutation.AddTenantID(i)
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CustomerUpdate) SetUpdatedAt(t time.Time) *CustomerUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetPriceList sets the "price_list" edge to the PriceList entity.
+func (cu *CustomerUpdate) SetPriceList(p *PriceList) *CustomerUpdate {
+	return cu.SetPriceListID(p.ID)
+}
+
+// Mutation returns the CustomerMutation object of the builder.
+func (cu *CustomerUpdate) Mutation() *CustomerMutation {
+	return cu.mutation
+}
+
+// ClearPriceList clears the "price_list" edge to the PriceList entity.
+func (cu *CustomerUpdate) ClearPriceList() *CustomerUpdate {
+	cu.mutation.ClearPriceList()
+	return cu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CustomerUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	cu.defaults()
+	if len(cu.hooks) == 0 {
+		if err = cu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = cu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CustomerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cu.check(); err != nil {
+				return 0, err
+			}
+			cu.mutation = mutation
+			affected, err = cu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cu.hooks) - 1; i >= 0; i-- {
+			if cu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CustomerUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CustomerUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CustomerUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CustomerUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := customer.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CustomerUpdate) check() error {
+	if v, ok := cu.mutation.Name(); ok {
+		if err := customer.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Customer.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cu *CustomerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   customer.Table,
+			Columns: customer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customer.FieldID,
+			},
+		},
+	}
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldName,
+		})
+	}
+	if value, ok := cu.mutation.DocumentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldDocumentType,
+		})
+	}
+	if cu.mutation.DocumentTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldDocumentType,
+		})
+	}
+	if value, ok := cu.mutation.DocumentNumber(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldDocumentNumber,
+		})
+	}
+	if cu.mutation.DocumentNumberCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldDocumentNumber,
+		})
+	}
+	if value, ok := cu.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldEmail,
+		})
+	}
+	if cu.mutation.EmailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldEmail,
+		})
+	}
+	if value, ok := cu.mutation.Phone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldPhone,
+		})
+	}
+	if cu.mutation.PhoneCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldPhone,
+		})
+	}
+	if value, ok := cu.mutation.Address(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldAddress,
+		})
+	}
+	if cu.mutation.AddressCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldAddress,
+		})
+	}
+	if value, ok := cu.mutation.TaxRegime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldTaxRegime,
+		})
+	}
+	if cu.mutation.TaxRegimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldTaxRegime,
+		})
+	}
+	if value, ok := cu.mutation.CustomerGroup(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldCustomerGroup,
+		})
+	}
+	if cu.mutation.CustomerGroupCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldCustomerGroup,
+		})
+	}
+	if value, ok := cu.mutation.Archived(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: customer.FieldArchived,
+		})
+	}
+	if value, ok := cu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customer.FieldDeletedAt,
+		})
+	}
+	if cu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: customer.FieldDeletedAt,
+		})
+	}
+	if value, ok := cu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customer.FieldTenantID,
+		})
+	}
+	if value, ok := cu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customer.FieldTenantID,
+		})
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customer.FieldUpdatedAt,
+		})
+	}
+	if cu.mutation.PriceListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customer.PriceListTable,
+			Columns: []string{customer.PriceListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: pricelist.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.PriceListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customer.PriceListTable,
+			Columns: []string{customer.PriceListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: pricelist.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CustomerUpdateOne is the builder for updating a single Customer entity.
+type CustomerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CustomerMutation
+}
+
+// SetName sets the "name" field.
+func (cuo *CustomerUpdateOne) SetName(s string) *CustomerUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetDocumentType sets the "document_type" field.
+func (cuo *CustomerUpdateOne) SetDocumentType(s string) *CustomerUpdateOne {
+	cuo.mutation.SetDocumentType(s)
+	return cuo
+}
+
+// SetNillableDocumentType sets the "document_type" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableDocumentType(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetDocumentType(*s)
+	}
+	return cuo
+}
+
+// ClearDocumentType clears the value of the "document_type" field.
+func (cuo *CustomerUpdateOne) ClearDocumentType() *CustomerUpdateOne {
+	cuo.mutation.ClearDocumentType()
+	return cuo
+}
+
+// SetDocumentNumber sets the "document_number" field.
+func (cuo *CustomerUpdateOne) SetDocumentNumber(s string) *CustomerUpdateOne {
+	cuo.mutation.SetDocumentNumber(s)
+	return cuo
+}
+
+// SetNillableDocumentNumber sets the "document_number" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableDocumentNumber(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetDocumentNumber(*s)
+	}
+	return cuo
+}
+
+// ClearDocumentNumber clears the value of the "document_number" field.
+func (cuo *CustomerUpdateOne) ClearDocumentNumber() *CustomerUpdateOne {
+	cuo.mutation.ClearDocumentNumber()
+	return cuo
+}
+
+// SetEmail sets the "email" field.
+func (cuo *CustomerUpdateOne) SetEmail(s string) *CustomerUpdateOne {
+	cuo.mutation.SetEmail(s)
+	return cuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableEmail(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetEmail(*s)
+	}
+	return cuo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (cuo *CustomerUpdateOne) ClearEmail() *CustomerUpdateOne {
+	cuo.mutation.ClearEmail()
+	return cuo
+}
+
+// SetPhone sets the "phone" field.
+func (cuo *CustomerUpdateOne) SetPhone(s string) *CustomerUpdateOne {
+	cuo.mutation.SetPhone(s)
+	return cuo
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillablePhone(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetPhone(*s)
+	}
+	return cuo
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (cuo *CustomerUpdateOne) ClearPhone() *CustomerUpdateOne {
+	cuo.mutation.ClearPhone()
+	return cuo
+}
+
+// SetAddress sets the "address" field.
+func (cuo *CustomerUpdateOne) SetAddress(s string) *CustomerUpdateOne {
+	cuo.mutation.SetAddress(s)
+	return cuo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableAddress(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetAddress(*s)
+	}
+	return cuo
+}
+
+// ClearAddress clears the value of the "address" field.
+func (cuo *CustomerUpdateOne) ClearAddress() *CustomerUpdateOne {
+	cuo.mutation.ClearAddress()
+	return cuo
+}
+
+// SetTaxRegime sets the "tax_regime" field.
+func (cuo *CustomerUpdateOne) SetTaxRegime(s string) *CustomerUpdateOne {
+	cuo.mutation.SetTaxRegime(s)
+	return cuo
+}
+
+// SetNillableTaxRegime sets the "tax_regime" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableTaxRegime(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetTaxRegime(*s)
+	}
+	return cuo
+}
+
+// ClearTaxRegime clears the value of the "tax_regime" field.
+func (cuo *CustomerUpdateOne) ClearTaxRegime() *CustomerUpdateOne {
+	cuo.mutation.ClearTaxRegime()
+	return cuo
+}
+
+// SetCustomerGroup sets the "customer_group" field.
+func (cuo *CustomerUpdateOne) SetCustomerGroup(s string) *CustomerUpdateOne {
+	cuo.mutation.SetCustomerGroup(s)
+	return cuo
+}
+
+// SetNillableCustomerGroup sets the "customer_group" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableCustomerGroup(s *string) *CustomerUpdateOne {
+	if s != nil {
+		cuo.SetCustomerGroup(*s)
+	}
+	return cuo
+}
+
+// ClearCustomerGroup clears the value of the "customer_group" field.
+func (cuo *CustomerUpdateOne) ClearCustomerGroup() *CustomerUpdateOne {
+	cuo.mutation.ClearCustomerGroup()
+	return cuo
+}
+
+// SetPriceListID sets the "price_list_id" field.
+func (cuo *CustomerUpdateOne) SetPriceListID(i int) *CustomerUpdateOne {
+	cuo.mutation.SetPriceListID(i)
+	return cuo
+}
+
+// SetNillablePriceListID sets the "price_list_id" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillablePriceListID(i *int) *CustomerUpdateOne {
+	if i != nil {
+		cuo.SetPriceListID(*i)
+	}
+	return cuo
+}
+
+// ClearPriceListID clears the value of the "price_list_id" field.
+func (cuo *CustomerUpdateOne) ClearPriceListID() *CustomerUpdateOne {
+	cuo.mutation.ClearPriceListID()
+	return cuo
+}
+
+// SetArchived sets the "archived" field.
+func (cuo *CustomerUpdateOne) SetArchived(b bool) *CustomerUpdateOne {
+	cuo.mutation.SetArchived(b)
+	return cuo
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableArchived(b *bool) *CustomerUpdateOne {
+	if b != nil {
+		cuo.SetArchived(*b)
+	}
+	return cuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cuo *CustomerUpdateOne) SetDeletedAt(t time.Time) *CustomerUpdateOne {
+	cuo.mutation.SetDeletedAt(t)
+	return cuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cuo *CustomerUpdateOne) SetNillableDeletedAt(t *time.Time) *CustomerUpdateOne {
+	if t != nil {
+		cuo.SetDeletedAt(*t)
+	}
+	return cuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cuo *CustomerUpdateOne) ClearDeletedAt() *CustomerUpdateOne {
+	cuo.mutation.ClearDeletedAt()
+	return cuo
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (cuo *CustomerUpdateOne) SetTenantID(i int) *CustomerUpdateOne {
+	cuo.mutation.ResetTenantID()
+	cuo.mutation.SetTenantID(i)
+	return cuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (cuo *CustomerUpdateOne) AddTenantID(i int) *CustomerUpdateOne {
+	cuo.mutation.AddTenantID(i)
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CustomerUpdateOne) SetUpdatedAt(t time.Time) *CustomerUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetPriceList sets the "price_list" edge to the PriceList entity.
+func (cuo *CustomerUpdateOne) SetPriceList(p *PriceList) *CustomerUpdateOne {
+	return cuo.SetPriceListID(p.ID)
+}
+
+// Mutation returns the CustomerMutation object of the builder.
+func (cuo *CustomerUpdateOne) Mutation() *CustomerMutation {
+	return cuo.mutation
+}
+
+// ClearPriceList clears the "price_list" edge to the PriceList entity.
+func (cuo *CustomerUpdateOne) ClearPriceList() *CustomerUpdateOne {
+	cuo.mutation.ClearPriceList()
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CustomerUpdateOne) Select(field string, fields ...string) *CustomerUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Customer entity.
+func (cuo *CustomerUpdateOne) Save(ctx context.Context) (*Customer, error) {
+	var (
+		err  error
+		node *Customer
+	)
+	cuo.defaults()
+	if len(cuo.hooks) == 0 {
+		if err = cuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = cuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CustomerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cuo.check(); err != nil {
+				return nil, err
+			}
+			cuo.mutation = mutation
+			node, err = cuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cuo.hooks) - 1; i >= 0; i-- {
+			if cuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, cuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Customer)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from CustomerMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CustomerUpdateOne) SaveX(ctx context.Context) *Customer {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CustomerUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CustomerUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CustomerUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := customer.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CustomerUpdateOne) check() error {
+	if v, ok := cuo.mutation.Name(); ok {
+		if err := customer.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Customer.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cuo *CustomerUpdateOne) sqlSave(ctx context.Context) (_node *Customer, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   customer.Table,
+			Columns: customer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customer.FieldID,
+			},
+		},
+	}
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Customer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customer.FieldID)
+		for _, f := range fields {
+			if !customer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != customer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldName,
+		})
+	}
+	if value, ok := cuo.mutation.DocumentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldDocumentType,
+		})
+	}
+	if cuo.mutation.DocumentTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldDocumentType,
+		})
+	}
+	if value, ok := cuo.mutation.DocumentNumber(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldDocumentNumber,
+		})
+	}
+	if cuo.mutation.DocumentNumberCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldDocumentNumber,
+		})
+	}
+	if value, ok := cuo.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldEmail,
+		})
+	}
+	if cuo.mutation.EmailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldEmail,
+		})
+	}
+	if value, ok := cuo.mutation.Phone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldPhone,
+		})
+	}
+	if cuo.mutation.PhoneCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldPhone,
+		})
+	}
+	if value, ok := cuo.mutation.Address(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldAddress,
+		})
+	}
+	if cuo.mutation.AddressCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldAddress,
+		})
+	}
+	if value, ok := cuo.mutation.TaxRegime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldTaxRegime,
+		})
+	}
+	if cuo.mutation.TaxRegimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldTaxRegime,
+		})
+	}
+	if value, ok := cuo.mutation.CustomerGroup(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customer.FieldCustomerGroup,
+		})
+	}
+	if cuo.mutation.CustomerGroupCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customer.FieldCustomerGroup,
+		})
+	}
+	if value, ok := cuo.mutation.Archived(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: customer.FieldArchived,
+		})
+	}
+	if value, ok := cuo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customer.FieldDeletedAt,
+		})
+	}
+	if cuo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: customer.FieldDeletedAt,
+		})
+	}
+	if value, ok := cuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customer.FieldTenantID,
+		})
+	}
+	if value, ok := cuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customer.FieldTenantID,
+		})
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customer.FieldUpdatedAt,
+		})
+	}
+	if cuo.mutation.PriceListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customer.PriceListTable,
+			Columns: []string{customer.PriceListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: pricelist.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.PriceListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   customer.PriceListTable,
+			Columns: []string{customer.PriceListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: pricelist.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Customer{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
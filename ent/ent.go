@@ -3,13 +3,44 @@
 package ent
 
 import (
+	"Veritasbackend/ent/adjustmentreason"
+	"Veritasbackend/ent/auditlog"
+	"Veritasbackend/ent/bundleassembly"
+	"Veritasbackend/ent/bundleassemblyline"
+	"Veritasbackend/ent/bundlecomponent"
+	"Veritasbackend/ent/customer"
+	"Veritasbackend/ent/exchangerate"
+	"Veritasbackend/ent/fiscaldocument"
+	"Veritasbackend/ent/inventorycostlayer"
+	"Veritasbackend/ent/inventorycount"
+	"Veritasbackend/ent/inventorycountentry"
+	"Veritasbackend/ent/inventorycountline"
 	"Veritasbackend/ent/invoice"
 	"Veritasbackend/ent/invoiceitem"
+	"Veritasbackend/ent/invoiceitemcomponent"
+	"Veritasbackend/ent/invoicelotallocation"
+	"Veritasbackend/ent/invoicestatusevent"
+	"Veritasbackend/ent/invoicetax"
+	"Veritasbackend/ent/numberingseries"
+	"Veritasbackend/ent/pricelist"
+	"Veritasbackend/ent/pricelistitem"
 	"Veritasbackend/ent/product"
+	"Veritasbackend/ent/productbarcode"
+	"Veritasbackend/ent/productfile"
+	"Veritasbackend/ent/productlot"
+	"Veritasbackend/ent/productprice"
+	"Veritasbackend/ent/productserial"
+	"Veritasbackend/ent/productserialevent"
+	"Veritasbackend/ent/productunit"
+	"Veritasbackend/ent/promotion"
+	"Veritasbackend/ent/promotiontarget"
 	"Veritasbackend/ent/purchaseinvoice"
 	"Veritasbackend/ent/purchaseinvoiceitem"
+	"Veritasbackend/ent/stockadjustment"
+	"Veritasbackend/ent/stockadjustmentline"
 	"Veritasbackend/ent/supplier"
 	"Veritasbackend/ent/supplierpayment"
+	"Veritasbackend/ent/taxrate"
 	"Veritasbackend/ent/tenant"
 	"Veritasbackend/ent/user"
 	"context"
@@ -39,15 +70,46 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		invoice.Table:             invoice.ValidColumn,
-		invoiceitem.Table:         invoiceitem.ValidColumn,
-		product.Table:             product.ValidColumn,
-		purchaseinvoice.Table:     purchaseinvoice.ValidColumn,
-		purchaseinvoiceitem.Table: purchaseinvoiceitem.ValidColumn,
-		supplier.Table:            supplier.ValidColumn,
-		supplierpayment.Table:     supplierpayment.ValidColumn,
-		tenant.Table:              tenant.ValidColumn,
-		user.Table:                user.ValidColumn,
+		adjustmentreason.Table:     adjustmentreason.ValidColumn,
+		auditlog.Table:             auditlog.ValidColumn,
+		bundleassembly.Table:       bundleassembly.ValidColumn,
+		bundleassemblyline.Table:   bundleassemblyline.ValidColumn,
+		bundlecomponent.Table:      bundlecomponent.ValidColumn,
+		customer.Table:             customer.ValidColumn,
+		exchangerate.Table:         exchangerate.ValidColumn,
+		fiscaldocument.Table:       fiscaldocument.ValidColumn,
+		inventorycostlayer.Table:   inventorycostlayer.ValidColumn,
+		inventorycount.Table:       inventorycount.ValidColumn,
+		inventorycountentry.Table:  inventorycountentry.ValidColumn,
+		inventorycountline.Table:   inventorycountline.ValidColumn,
+		invoice.Table:              invoice.ValidColumn,
+		invoiceitem.Table:          invoiceitem.ValidColumn,
+		invoiceitemcomponent.Table: invoiceitemcomponent.ValidColumn,
+		invoicelotallocation.Table: invoicelotallocation.ValidColumn,
+		invoicestatusevent.Table:   invoicestatusevent.ValidColumn,
+		invoicetax.Table:           invoicetax.ValidColumn,
+		numberingseries.Table:      numberingseries.ValidColumn,
+		pricelist.Table:            pricelist.ValidColumn,
+		pricelistitem.Table:        pricelistitem.ValidColumn,
+		product.Table:              product.ValidColumn,
+		productbarcode.Table:       productbarcode.ValidColumn,
+		productfile.Table:          productfile.ValidColumn,
+		productlot.Table:           productlot.ValidColumn,
+		productprice.Table:         productprice.ValidColumn,
+		productserial.Table:        productserial.ValidColumn,
+		productserialevent.Table:   productserialevent.ValidColumn,
+		productunit.Table:          productunit.ValidColumn,
+		promotion.Table:            promotion.ValidColumn,
+		promotiontarget.Table:      promotiontarget.ValidColumn,
+		purchaseinvoice.Table:      purchaseinvoice.ValidColumn,
+		purchaseinvoiceitem.Table:  purchaseinvoiceitem.ValidColumn,
+		stockadjustment.Table:      stockadjustment.ValidColumn,
+		stockadjustmentline.Table:  stockadjustmentline.ValidColumn,
+		supplier.Table:             supplier.ValidColumn,
+		supplierpayment.Table:      supplierpayment.ValidColumn,
+		taxrate.Table:              taxrate.ValidColumn,
+		tenant.Table:               tenant.ValidColumn,
+		user.Table:                 user.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -97,7 +159,6 @@ type AggregateFunc func(*sql.Selector) string
 //	GroupBy(field1, field2).
 //	Aggregate(ent.As(ent.Sum(field1), "sum_field1"), (ent.As(ent.Sum(field2), "sum_field2")).
 //	Scan(ctx, &v)
-//
 func As(fn AggregateFunc, end string) AggregateFunc {
 	return func(s *sql.Selector) string {
 		return sql.As(fn(s), end)
@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/exchangerate"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ExchangeRate is the model entity for the ExchangeRate schema.
+type ExchangeRate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// Código ISO 4217 de la moneda extranjera
+	Currency string `json:"currency,omitempty"`
+	// Día desde el que rige la tasa
+	Date time.Time `json:"date,omitempty"`
+	// Unidades de la moneda base del tenant por una unidad de la moneda
+	Rate float64 `json:"rate,omitempty"`
+	// Origen de la tasa (manual, csv)
+	Source string `json:"source,omitempty"`
+	// ID del usuario que registró la tasa
+	UserID *int `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExchangeRate) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case exchangerate.FieldRate:
+			values[i] = new(sql.NullFloat64)
+		case exchangerate.FieldID, exchangerate.FieldTenantID, exchangerate.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case exchangerate.FieldCurrency, exchangerate.FieldSource:
+			values[i] = new(sql.NullString)
+		case exchangerate.FieldDate, exchangerate.FieldCreatedAt, exchangerate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ExchangeRate", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExchangeRate fields.
+func (er *ExchangeRate) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case exchangerate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			er.ID = int(value.Int64)
+		case exchangerate.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				er.TenantID = int(value.Int64)
+			}
+		case exchangerate.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				er.Currency = value.String
+			}
+		case exchangerate.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				er.Date = value.Time
+			}
+		case exchangerate.FieldRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value.Valid {
+				er.Rate = value.Float64
+			}
+		case exchangerate.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				er.Source = value.String
+			}
+		case exchangerate.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				er.UserID = new(int)
+				*er.UserID = int(value.Int64)
+			}
+		case exchangerate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				er.CreatedAt = value.Time
+			}
+		case exchangerate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				er.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ExchangeRate.
+// Note that you need to call ExchangeRate.Unwrap() before calling this method if this ExchangeRate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (er *ExchangeRate) Update() *ExchangeRateUpdateOne {
+	return (&ExchangeRateClient{config: er.config}).UpdateOne(er)
+}
+
+// Unwrap unwraps the ExchangeRate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (er *ExchangeRate) Unwrap() *ExchangeRate {
+	_tx, ok := er.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExchangeRate is not a transactional entity")
+	}
+	er.config.driver = _tx.drv
+	return er
+}
+
+// String implements the fmt.Stringer.
+func (er *ExchangeRate) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExchangeRate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", er.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", er.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(er.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(er.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", er.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(er.Source)
+	builder.WriteString(", ")
+	if v := er.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(er.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(er.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExchangeRates is a parsable slice of ExchangeRate.
+type ExchangeRates []*ExchangeRate
+
+func (er ExchangeRates) config(cfg config) {
+	for _i := range er {
+		er[_i].config = cfg
+	}
+}
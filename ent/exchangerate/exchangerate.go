@@ -0,0 +1,70 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exchangerate
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the exchangerate type in the database.
+	Label = "exchange_rate"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldDate holds the string denoting the date field in the database.
+	FieldDate = "date"
+	// FieldRate holds the string denoting the rate field in the database.
+	FieldRate = "rate"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the exchangerate in the database.
+	Table = "exchange_rates"
+)
+
+// Columns holds all SQL columns for exchangerate fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCurrency,
+	FieldDate,
+	FieldRate,
+	FieldSource,
+	FieldUserID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// RateValidator is a validator for the "rate" field. It is called by the builders before save.
+	RateValidator func(float64) error
+	// DefaultSource holds the default value on creation for the "source" field.
+	DefaultSource string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
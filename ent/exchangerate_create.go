@@ -0,0 +1,403 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/exchangerate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExchangeRateCreate is the builder for creating a ExchangeRate entity.
+type ExchangeRateCreate struct {
+	config
+	mutation *ExchangeRateMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (erc *ExchangeRateCreate) SetTenantID(i int) *ExchangeRateCreate {
+	erc.mutation.SetTenantID(i)
+	return erc
+}
+
+// SetCurrency sets the "currency" field.
+func (erc *ExchangeRateCreate) SetCurrency(s string) *ExchangeRateCreate {
+	erc.mutation.SetCurrency(s)
+	return erc
+}
+
+// SetDate sets the "date" field.
+func (erc *ExchangeRateCreate) SetDate(t time.Time) *ExchangeRateCreate {
+	erc.mutation.SetDate(t)
+	return erc
+}
+
+// SetRate sets the "rate" field.
+func (erc *ExchangeRateCreate) SetRate(f float64) *ExchangeRateCreate {
+	erc.mutation.SetRate(f)
+	return erc
+}
+
+// SetSource sets the "source" field.
+func (erc *ExchangeRateCreate) SetSource(s string) *ExchangeRateCreate {
+	erc.mutation.SetSource(s)
+	return erc
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (erc *ExchangeRateCreate) SetNillableSource(s *string) *ExchangeRateCreate {
+	if s != nil {
+		erc.SetSource(*s)
+	}
+	return erc
+}
+
+// SetUserID sets the "user_id" field.
+func (erc *ExchangeRateCreate) SetUserID(i int) *ExchangeRateCreate {
+	erc.mutation.SetUserID(i)
+	return erc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (erc *ExchangeRateCreate) SetNillableUserID(i *int) *ExchangeRateCreate {
+	if i != nil {
+		erc.SetUserID(*i)
+	}
+	return erc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (erc *ExchangeRateCreate) SetCreatedAt(t time.Time) *ExchangeRateCreate {
+	erc.mutation.SetCreatedAt(t)
+	return erc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (erc *ExchangeRateCreate) SetNillableCreatedAt(t *time.Time) *ExchangeRateCreate {
+	if t != nil {
+		erc.SetCreatedAt(*t)
+	}
+	return erc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (erc *ExchangeRateCreate) SetUpdatedAt(t time.Time) *ExchangeRateCreate {
+	erc.mutation.SetUpdatedAt(t)
+	return erc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (erc *ExchangeRateCreate) SetNillableUpdatedAt(t *time.Time) *ExchangeRateCreate {
+	if t != nil {
+		erc.SetUpdatedAt(*t)
+	}
+	return erc
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (erc *ExchangeRateCreate) Mutation() *ExchangeRateMutation {
+	return erc.mutation
+}
+
+// Save creates the ExchangeRate in the database.
+func (erc *ExchangeRateCreate) Save(ctx context.Context) (*ExchangeRate, error) {
+	var (
+		err  error
+		node *ExchangeRate
+	)
+	erc.defaults()
+	if len(erc.hooks) == 0 {
+		if err = erc.check(); err != nil {
+			return nil, err
+		}
+		node, err = erc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ExchangeRateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = erc.check(); err != nil {
+				return nil, err
+			}
+			erc.mutation = mutation
+			if node, err = erc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(erc.hooks) - 1; i >= 0; i-- {
+			if erc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = erc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, erc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ExchangeRate)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ExchangeRateMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (erc *ExchangeRateCreate) SaveX(ctx context.Context) *ExchangeRate {
+	v, err := erc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (erc *ExchangeRateCreate) Exec(ctx context.Context) error {
+	_, err := erc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (erc *ExchangeRateCreate) ExecX(ctx context.Context) {
+	if err := erc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (erc *ExchangeRateCreate) defaults() {
+	if _, ok := erc.mutation.Source(); !ok {
+		v := exchangerate.DefaultSource
+		erc.mutation.SetSource(v)
+	}
+	if _, ok := erc.mutation.CreatedAt(); !ok {
+		v := exchangerate.DefaultCreatedAt()
+		erc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := erc.mutation.UpdatedAt(); !ok {
+		v := exchangerate.DefaultUpdatedAt()
+		erc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (erc *ExchangeRateCreate) check() error {
+	if _, ok := erc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ExchangeRate.tenant_id"`)}
+	}
+	if _, ok := erc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "ExchangeRate.currency"`)}
+	}
+	if v, ok := erc.mutation.Currency(); ok {
+		if err := exchangerate.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "ExchangeRate.currency": %w`, err)}
+		}
+	}
+	if _, ok := erc.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "ExchangeRate.date"`)}
+	}
+	if _, ok := erc.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "ExchangeRate.rate"`)}
+	}
+	if v, ok := erc.mutation.Rate(); ok {
+		if err := exchangerate.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`ent: validator failed for field "ExchangeRate.rate": %w`, err)}
+		}
+	}
+	if _, ok := erc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "ExchangeRate.source"`)}
+	}
+	if _, ok := erc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExchangeRate.created_at"`)}
+	}
+	if _, ok := erc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ExchangeRate.updated_at"`)}
+	}
+	return nil
+}
+
+func (erc *ExchangeRateCreate) sqlSave(ctx context.Context) (*ExchangeRate, error) {
+	_node, _spec := erc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, erc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (erc *ExchangeRateCreate) createSpec() (*ExchangeRate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExchangeRate{config: erc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: exchangerate.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: exchangerate.FieldID,
+			},
+		}
+	)
+	if value, ok := erc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: exchangerate.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := erc.mutation.Currency(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: exchangerate.FieldCurrency,
+		})
+		_node.Currency = value
+	}
+	if value, ok := erc.mutation.Date(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: exchangerate.FieldDate,
+		})
+		_node.Date = value
+	}
+	if value, ok := erc.mutation.Rate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: exchangerate.FieldRate,
+		})
+		_node.Rate = value
+	}
+	if value, ok := erc.mutation.Source(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: exchangerate.FieldSource,
+		})
+		_node.Source = value
+	}
+	if value, ok := erc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: exchangerate.FieldUserID,
+		})
+		_node.UserID = &value
+	}
+	if value, ok := erc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: exchangerate.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := erc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: exchangerate.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ExchangeRateCreateBulk is the builder for creating many ExchangeRate entities in bulk.
+type ExchangeRateCreateBulk struct {
+	config
+	builders []*ExchangeRateCreate
+}
+
+// Save creates the ExchangeRate entities in the database.
+func (ercb *ExchangeRateCreateBulk) Save(ctx context.Context) ([]*ExchangeRate, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ercb.builders))
+	nodes := make([]*ExchangeRate, len(ercb.builders))
+	mutators := make([]Mutator, len(ercb.builders))
+	for i := range ercb.builders {
+		func(i int, root context.Context) {
+			builder := ercb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExchangeRateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ercb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ercb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ercb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ercb *ExchangeRateCreateBulk) SaveX(ctx context.Context) []*ExchangeRate {
+	v, err := ercb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ercb *ExchangeRateCreateBulk) Exec(ctx context.Context) error {
+	_, err := ercb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ercb *ExchangeRateCreateBulk) ExecX(ctx context.Context) {
+	if err := ercb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,628 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/exchangerate"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExchangeRateUpdate is the builder for updating ExchangeRate entities.
+type ExchangeRateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExchangeRateMutation
+}
+
+// Where appends a list predicates to the ExchangeRateUpdate builder.
+func (eru *ExchangeRateUpdate) Where(ps ...predicate.ExchangeRate) *ExchangeRateUpdate {
+	eru.mutation.Where(ps...)
+	return eru
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (eru *ExchangeRateUpdate) SetTenantID(i int) *ExchangeRateUpdate {
+	eru.mutation.ResetTenantID()
+	eru.mutation.SetTenantID(i)
+	return eru
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (eru *ExchangeRateUpdate) AddTenantID(i int) *ExchangeRateUpdate {
+	eru.mutation.AddTenantID(i)
+	return eru
+}
+
+// SetCurrency sets the "currency" field.
+func (eru *ExchangeRateUpdate) SetCurrency(s string) *ExchangeRateUpdate {
+	eru.mutation.SetCurrency(s)
+	return eru
+}
+
+// SetDate sets the "date" field.
+func (eru *ExchangeRateUpdate) SetDate(t time.Time) *ExchangeRateUpdate {
+	eru.mutation.SetDate(t)
+	return eru
+}
+
+// SetRate sets the "rate" field.
+func (eru *ExchangeRateUpdate) SetRate(f float64) *ExchangeRateUpdate {
+	eru.mutation.ResetRate()
+	eru.mutation.SetRate(f)
+	return eru
+}
+
+// AddRate adds f to the "rate" field.
+func (eru *ExchangeRateUpdate) AddRate(f float64) *ExchangeRateUpdate {
+	eru.mutation.AddRate(f)
+	return eru
+}
+
+// SetSource sets the "source" field.
+func (eru *ExchangeRateUpdate) SetSource(s string) *ExchangeRateUpdate {
+	eru.mutation.SetSource(s)
+	return eru
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (eru *ExchangeRateUpdate) SetNillableSource(s *string) *ExchangeRateUpdate {
+	if s != nil {
+		eru.SetSource(*s)
+	}
+	return eru
+}
+
+// SetUserID sets the "user_id" field.
+func (eru *ExchangeRateUpdate) SetUserID(i int) *ExchangeRateUpdate {
+	eru.mutation.ResetUserID()
+	eru.mutation.SetUserID(i)
+	return eru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (eru *ExchangeRateUpdate) SetNillableUserID(i *int) *ExchangeRateUpdate {
+	if i != nil {
+		eru.SetUserID(*i)
+	}
+	return eru
+}
+
+// AddUserID adds i to the "user_id" field.
+func (eru *ExchangeRateUpdate) AddUserID(i int) *ExchangeRateUpdate {
+	eru.mutation.AddUserID(i)
+	return eru
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (eru *ExchangeRateUpdate) ClearUserID() *ExchangeRateUpdate {
+	eru.mutation.ClearUserID()
+	return eru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eru *ExchangeRateUpdate) SetUpdatedAt(t time.Time) *ExchangeRateUpdate {
+	eru.mutation.SetUpdatedAt(t)
+	return eru
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (eru *ExchangeRateUpdate) Mutation() *ExchangeRateMutation {
+	return eru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eru *ExchangeRateUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	eru.defaults()
+	if len(eru.hooks) == 0 {
+		if err = eru.check(); err != nil {
+			return 0, err
+		}
+		affected, err = eru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ExchangeRateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = eru.check(); err != nil {
+				return 0, err
+			}
+			eru.mutation = mutation
+			affected, err = eru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(eru.hooks) - 1; i >= 0; i-- {
+			if eru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = eru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, eru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eru *ExchangeRateUpdate) SaveX(ctx context.Context) int {
+	affected, err := eru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eru *ExchangeRateUpdate) Exec(ctx context.Context) error {
+	_, err := eru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eru *ExchangeRateUpdate) ExecX(ctx context.Context) {
+	if err := eru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eru *ExchangeRateUpdate) defaults() {
+	if _, ok := eru.mutation.UpdatedAt(); !ok {
+		v := exchangerate.UpdateDefaultUpdatedAt()
+		eru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eru *ExchangeRateUpdate) check() error {
+	if v, ok := eru.mutation.Currency(); ok {
+		if err := exchangerate.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "ExchangeRate.currency": %w`, err)}
+		}
+	}
+	if v, ok := eru.mutation.Rate(); ok {
+		if err := exchangerate.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`ent: validator failed for field "ExchangeRate.rate": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (eru *ExchangeRateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   exchangerate.Table,
+			Columns: exchangerate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: exchangerate.FieldID,
+			},
+		},
+	}
+	if ps := eru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eru.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: exchangerate.FieldTenantID,
+		})
+	}
+	if value, ok := eru.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: exchangerate.FieldTenantID,
+		})
+	}
+	if value, ok := eru.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: exchangerate.FieldCurrency,
+		})
+	}
+	if value, ok := eru.mutation.Date(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: exchangerate.FieldDate,
+		})
+	}
+	if value, ok := eru.mutation.Rate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: exchangerate.FieldRate,
+		})
+	}
+	if value, ok := eru.mutation.AddedRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: exchangerate.FieldRate,
+		})
+	}
+	if value, ok := eru.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: exchangerate.FieldSource,
+		})
+	}
+	if value, ok := eru.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: exchangerate.FieldUserID,
+		})
+	}
+	if value, ok := eru.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: exchangerate.FieldUserID,
+		})
+	}
+	if eru.mutation.UserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: exchangerate.FieldUserID,
+		})
+	}
+	if value, ok := eru.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: exchangerate.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exchangerate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ExchangeRateUpdateOne is the builder for updating a single ExchangeRate entity.
+type ExchangeRateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExchangeRateMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (eruo *ExchangeRateUpdateOne) SetTenantID(i int) *ExchangeRateUpdateOne {
+	eruo.mutation.ResetTenantID()
+	eruo.mutation.SetTenantID(i)
+	return eruo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (eruo *ExchangeRateUpdateOne) AddTenantID(i int) *ExchangeRateUpdateOne {
+	eruo.mutation.AddTenantID(i)
+	return eruo
+}
+
+// SetCurrency sets the "currency" field.
+func (eruo *ExchangeRateUpdateOne) SetCurrency(s string) *ExchangeRateUpdateOne {
+	eruo.mutation.SetCurrency(s)
+	return eruo
+}
+
+// SetDate sets the "date" field.
+func (eruo *ExchangeRateUpdateOne) SetDate(t time.Time) *ExchangeRateUpdateOne {
+	eruo.mutation.SetDate(t)
+	return eruo
+}
+
+// SetRate sets the "rate" field.
+func (eruo *ExchangeRateUpdateOne) SetRate(f float64) *ExchangeRateUpdateOne {
+	eruo.mutation.ResetRate()
+	eruo.mutation.SetRate(f)
+	return eruo
+}
+
+// AddRate adds f to the "rate" field.
+func (eruo *ExchangeRateUpdateOne) AddRate(f float64) *ExchangeRateUpdateOne {
+	eruo.mutation.AddRate(f)
+	return eruo
+}
+
+// SetSource sets the "source" field.
+func (eruo *ExchangeRateUpdateOne) SetSource(s string) *ExchangeRateUpdateOne {
+	eruo.mutation.SetSource(s)
+	return eruo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (eruo *ExchangeRateUpdateOne) SetNillableSource(s *string) *ExchangeRateUpdateOne {
+	if s != nil {
+		eruo.SetSource(*s)
+	}
+	return eruo
+}
+
+// SetUserID sets the "user_id" field.
+func (eruo *ExchangeRateUpdateOne) SetUserID(i int) *ExchangeRateUpdateOne {
+	eruo.mutation.ResetUserID()
+	eruo.mutation.SetUserID(i)
+	return eruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (eruo *ExchangeRateUpdateOne) SetNillableUserID(i *int) *ExchangeRateUpdateOne {
+	if i != nil {
+		eruo.SetUserID(*i)
+	}
+	return eruo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (eruo *ExchangeRateUpdateOne) AddUserID(i int) *ExchangeRateUpdateOne {
+	eruo.mutation.AddUserID(i)
+	return eruo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (eruo *ExchangeRateUpdateOne) ClearUserID() *ExchangeRateUpdateOne {
+	eruo.mutation.ClearUserID()
+	return eruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eruo *ExchangeRateUpdateOne) SetUpdatedAt(t time.Time) *ExchangeRateUpdateOne {
+	eruo.mutation.SetUpdatedAt(t)
+	return eruo
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (eruo *ExchangeRateUpdateOne) Mutation() *ExchangeRateMutation {
+	return eruo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eruo *ExchangeRateUpdateOne) Select(field string, fields ...string) *ExchangeRateUpdateOne {
+	eruo.fields = append([]string{field}, fields...)
+	return eruo
+}
+
+// Save executes the query and returns the updated ExchangeRate entity.
+func (eruo *ExchangeRateUpdateOne) Save(ctx context.Context) (*ExchangeRate, error) {
+	var (
+		err  error
+		node *ExchangeRate
+	)
+	eruo.defaults()
+	if len(eruo.hooks) == 0 {
+		if err = eruo.check(); err != nil {
+			return nil, err
+		}
+		node, err = eruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ExchangeRateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = eruo.check(); err != nil {
+				return nil, err
+			}
+			eruo.mutation = mutation
+			node, err = eruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(eruo.hooks) - 1; i >= 0; i-- {
+			if eruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = eruo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, eruo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ExchangeRate)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ExchangeRateMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eruo *ExchangeRateUpdateOne) SaveX(ctx context.Context) *ExchangeRate {
+	node, err := eruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eruo *ExchangeRateUpdateOne) Exec(ctx context.Context) error {
+	_, err := eruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eruo *ExchangeRateUpdateOne) ExecX(ctx context.Context) {
+	if err := eruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eruo *ExchangeRateUpdateOne) defaults() {
+	if _, ok := eruo.mutation.UpdatedAt(); !ok {
+		v := exchangerate.UpdateDefaultUpdatedAt()
+		eruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eruo *ExchangeRateUpdateOne) check() error {
+	if v, ok := eruo.mutation.Currency(); ok {
+		if err := exchangerate.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "ExchangeRate.currency": %w`, err)}
+		}
+	}
+	if v, ok := eruo.mutation.Rate(); ok {
+		if err := exchangerate.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`ent: validator failed for field "ExchangeRate.rate": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (eruo *ExchangeRateUpdateOne) sqlSave(ctx context.Context) (_node *ExchangeRate, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   exchangerate.Table,
+			Columns: exchangerate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: exchangerate.FieldID,
+			},
+		},
+	}
+	id, ok := eruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExchangeRate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exchangerate.FieldID)
+		for _, f := range fields {
+			if !exchangerate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != exchangerate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eruo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: exchangerate.FieldTenantID,
+		})
+	}
+	if value, ok := eruo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: exchangerate.FieldTenantID,
+		})
+	}
+	if value, ok := eruo.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: exchangerate.FieldCurrency,
+		})
+	}
+	if value, ok := eruo.mutation.Date(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: exchangerate.FieldDate,
+		})
+	}
+	if value, ok := eruo.mutation.Rate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: exchangerate.FieldRate,
+		})
+	}
+	if value, ok := eruo.mutation.AddedRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: exchangerate.FieldRate,
+		})
+	}
+	if value, ok := eruo.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: exchangerate.FieldSource,
+		})
+	}
+	if value, ok := eruo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: exchangerate.FieldUserID,
+		})
+	}
+	if value, ok := eruo.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: exchangerate.FieldUserID,
+		})
+	}
+	if eruo.mutation.UserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: exchangerate.FieldUserID,
+		})
+	}
+	if value, ok := eruo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: exchangerate.FieldUpdatedAt,
+		})
+	}
+	_node = &ExchangeRate{config: eruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exchangerate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
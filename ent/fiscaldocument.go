@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/fiscaldocument"
+	"Veritasbackend/pkg/money"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// FiscalDocument is the model entity for the FiscalDocument schema.
+type FiscalDocument struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// ID de la factura de venta
+	InvoiceID int `json:"invoice_id,omitempty"`
+	// Tipo de documento electrónico (invoice, credit_note)
+	DocumentType string `json:"document_type,omitempty"`
+	// Número del documento ante la autoridad tributaria
+	Number *string `json:"number,omitempty"`
+	// Documento electrónico de la factura que corrige una nota crédito
+	ReferenceID *int `json:"reference_id,omitempty"`
+	// Código del concepto de corrección de la nota crédito
+	DiscrepancyCode string `json:"discrepancy_code,omitempty"`
+	// Descripción de la corrección de la nota crédito
+	DiscrepancyReason string `json:"discrepancy_reason,omitempty"`
+	// Moneda del documento
+	Currency string `json:"currency,omitempty"`
+	// Total a pagar del documento
+	Total money.Amount `json:"total,omitempty"`
+	// Código único del documento (CUFE o CUDE)
+	Cufe string `json:"cufe,omitempty"`
+	// Resumen SHA-256 del XML firmado en base64
+	Digest string `json:"digest,omitempty"`
+	// Estado ante la autoridad (pending, accepted, rejected, failed)
+	Status string `json:"status,omitempty"`
+	// Proveedor por el que se envió el documento
+	Provider string `json:"provider,omitempty"`
+	// Identificador del envío en el proveedor
+	TrackID string `json:"track_id,omitempty"`
+	// Motivos del rechazo o del error del envío
+	RejectionReasons []string `json:"rejection_reasons,omitempty"`
+	// Clave del XML firmado en el almacenamiento
+	XMLKey string `json:"xml_key,omitempty"`
+	// Clave de la representación gráfica en PDF en el almacenamiento
+	PdfKey string `json:"pdf_key,omitempty"`
+	// Fecha y hora de emisión del documento
+	IssuedAt time.Time `json:"issued_at,omitempty"`
+	// Último envío al proveedor
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	// Aceptación de la autoridad
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	// ID del usuario que emitió el documento
+	UserID int `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FiscalDocument) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fiscaldocument.FieldRejectionReasons:
+			values[i] = new([]byte)
+		case fiscaldocument.FieldTotal:
+			values[i] = new(money.Amount)
+		case fiscaldocument.FieldID, fiscaldocument.FieldTenantID, fiscaldocument.FieldInvoiceID, fiscaldocument.FieldReferenceID, fiscaldocument.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case fiscaldocument.FieldDocumentType, fiscaldocument.FieldNumber, fiscaldocument.FieldDiscrepancyCode, fiscaldocument.FieldDiscrepancyReason, fiscaldocument.FieldCurrency, fiscaldocument.FieldCufe, fiscaldocument.FieldDigest, fiscaldocument.FieldStatus, fiscaldocument.FieldProvider, fiscaldocument.FieldTrackID, fiscaldocument.FieldXMLKey, fiscaldocument.FieldPdfKey:
+			values[i] = new(sql.NullString)
+		case fiscaldocument.FieldIssuedAt, fiscaldocument.FieldSubmittedAt, fiscaldocument.FieldAcceptedAt, fiscaldocument.FieldCreatedAt, fiscaldocument.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type FiscalDocument", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FiscalDocument fields.
+func (fd *FiscalDocument) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case fiscaldocument.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			fd.ID = int(value.Int64)
+		case fiscaldocument.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				fd.TenantID = int(value.Int64)
+			}
+		case fiscaldocument.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				fd.InvoiceID = int(value.Int64)
+			}
+		case fiscaldocument.FieldDocumentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_type", values[i])
+			} else if value.Valid {
+				fd.DocumentType = value.String
+			}
+		case fiscaldocument.FieldNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				fd.Number = new(string)
+				*fd.Number = value.String
+			}
+		case fiscaldocument.FieldReferenceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_id", values[i])
+			} else if value.Valid {
+				fd.ReferenceID = new(int)
+				*fd.ReferenceID = int(value.Int64)
+			}
+		case fiscaldocument.FieldDiscrepancyCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field discrepancy_code", values[i])
+			} else if value.Valid {
+				fd.DiscrepancyCode = value.String
+			}
+		case fiscaldocument.FieldDiscrepancyReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field discrepancy_reason", values[i])
+			} else if value.Valid {
+				fd.DiscrepancyReason = value.String
+			}
+		case fiscaldocument.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				fd.Currency = value.String
+			}
+		case fiscaldocument.FieldTotal:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value != nil {
+				fd.Total = *value
+			}
+		case fiscaldocument.FieldCufe:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cufe", values[i])
+			} else if value.Valid {
+				fd.Cufe = value.String
+			}
+		case fiscaldocument.FieldDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field digest", values[i])
+			} else if value.Valid {
+				fd.Digest = value.String
+			}
+		case fiscaldocument.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				fd.Status = value.String
+			}
+		case fiscaldocument.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				fd.Provider = value.String
+			}
+		case fiscaldocument.FieldTrackID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field track_id", values[i])
+			} else if value.Valid {
+				fd.TrackID = value.String
+			}
+		case fiscaldocument.FieldRejectionReasons:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rejection_reasons", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &fd.RejectionReasons); err != nil {
+					return fmt.Errorf("unmarshal field rejection_reasons: %w", err)
+				}
+			}
+		case fiscaldocument.FieldXMLKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field xml_key", values[i])
+			} else if value.Valid {
+				fd.XMLKey = value.String
+			}
+		case fiscaldocument.FieldPdfKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pdf_key", values[i])
+			} else if value.Valid {
+				fd.PdfKey = value.String
+			}
+		case fiscaldocument.FieldIssuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field issued_at", values[i])
+			} else if value.Valid {
+				fd.IssuedAt = value.Time
+			}
+		case fiscaldocument.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				fd.SubmittedAt = new(time.Time)
+				*fd.SubmittedAt = value.Time
+			}
+		case fiscaldocument.FieldAcceptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[i])
+			} else if value.Valid {
+				fd.AcceptedAt = new(time.Time)
+				*fd.AcceptedAt = value.Time
+			}
+		case fiscaldocument.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				fd.UserID = int(value.Int64)
+			}
+		case fiscaldocument.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				fd.CreatedAt = value.Time
+			}
+		case fiscaldocument.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				fd.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this FiscalDocument.
+// Note that you need to call FiscalDocument.Unwrap() before calling this method if this FiscalDocument
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fd *FiscalDocument) Update() *FiscalDocumentUpdateOne {
+	return (&FiscalDocumentClient{config: fd.config}).UpdateOne(fd)
+}
+
+// Unwrap unwraps the FiscalDocument entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fd *FiscalDocument) Unwrap() *FiscalDocument {
+	_tx, ok := fd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FiscalDocument is not a transactional entity")
+	}
+	fd.config.driver = _tx.drv
+	return fd
+}
+
+// String implements the fmt.Stringer.
+func (fd *FiscalDocument) String() string {
+	var builder strings.Builder
+	builder.WriteString("FiscalDocument(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fd.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", fd.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", fd.InvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("document_type=")
+	builder.WriteString(fd.DocumentType)
+	builder.WriteString(", ")
+	if v := fd.Number; v != nil {
+		builder.WriteString("number=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := fd.ReferenceID; v != nil {
+		builder.WriteString("reference_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("discrepancy_code=")
+	builder.WriteString(fd.DiscrepancyCode)
+	builder.WriteString(", ")
+	builder.WriteString("discrepancy_reason=")
+	builder.WriteString(fd.DiscrepancyReason)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(fd.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", fd.Total))
+	builder.WriteString(", ")
+	builder.WriteString("cufe=")
+	builder.WriteString(fd.Cufe)
+	builder.WriteString(", ")
+	builder.WriteString("digest=")
+	builder.WriteString(fd.Digest)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fd.Status)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(fd.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("track_id=")
+	builder.WriteString(fd.TrackID)
+	builder.WriteString(", ")
+	builder.WriteString("rejection_reasons=")
+	builder.WriteString(fmt.Sprintf("%v", fd.RejectionReasons))
+	builder.WriteString(", ")
+	builder.WriteString("xml_key=")
+	builder.WriteString(fd.XMLKey)
+	builder.WriteString(", ")
+	builder.WriteString("pdf_key=")
+	builder.WriteString(fd.PdfKey)
+	builder.WriteString(", ")
+	builder.WriteString("issued_at=")
+	builder.WriteString(fd.IssuedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := fd.SubmittedAt; v != nil {
+		builder.WriteString("submitted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := fd.AcceptedAt; v != nil {
+		builder.WriteString("accepted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", fd.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fd.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fd.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FiscalDocuments is a parsable slice of FiscalDocument.
+type FiscalDocuments []*FiscalDocument
+
+func (fd FiscalDocuments) config(cfg config) {
+	for _i := range fd {
+		fd[_i].config = cfg
+	}
+}
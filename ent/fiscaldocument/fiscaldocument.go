@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fiscaldocument
+
+import (
+	"Veritasbackend/pkg/money"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the fiscaldocument type in the database.
+	Label = "fiscal_document"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldInvoiceID holds the string denoting the invoice_id field in the database.
+	FieldInvoiceID = "invoice_id"
+	// FieldDocumentType holds the string denoting the document_type field in the database.
+	FieldDocumentType = "document_type"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldReferenceID holds the string denoting the reference_id field in the database.
+	FieldReferenceID = "reference_id"
+	// FieldDiscrepancyCode holds the string denoting the discrepancy_code field in the database.
+	FieldDiscrepancyCode = "discrepancy_code"
+	// FieldDiscrepancyReason holds the string denoting the discrepancy_reason field in the database.
+	FieldDiscrepancyReason = "discrepancy_reason"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldTotal holds the string denoting the total field in the database.
+	FieldTotal = "total"
+	// FieldCufe holds the string denoting the cufe field in the database.
+	FieldCufe = "cufe"
+	// FieldDigest holds the string denoting the digest field in the database.
+	FieldDigest = "digest"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldTrackID holds the string denoting the track_id field in the database.
+	FieldTrackID = "track_id"
+	// FieldRejectionReasons holds the string denoting the rejection_reasons field in the database.
+	FieldRejectionReasons = "rejection_reasons"
+	// FieldXMLKey holds the string denoting the xml_key field in the database.
+	FieldXMLKey = "xml_key"
+	// FieldPdfKey holds the string denoting the pdf_key field in the database.
+	FieldPdfKey = "pdf_key"
+	// FieldIssuedAt holds the string denoting the issued_at field in the database.
+	FieldIssuedAt = "issued_at"
+	// FieldSubmittedAt holds the string denoting the submitted_at field in the database.
+	FieldSubmittedAt = "submitted_at"
+	// FieldAcceptedAt holds the string denoting the accepted_at field in the database.
+	FieldAcceptedAt = "accepted_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the fiscaldocument in the database.
+	Table = "fiscal_documents"
+)
+
+// Columns holds all SQL columns for fiscaldocument fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldInvoiceID,
+	FieldDocumentType,
+	FieldNumber,
+	FieldReferenceID,
+	FieldDiscrepancyCode,
+	FieldDiscrepancyReason,
+	FieldCurrency,
+	FieldTotal,
+	FieldCufe,
+	FieldDigest,
+	FieldStatus,
+	FieldProvider,
+	FieldTrackID,
+	FieldRejectionReasons,
+	FieldXMLKey,
+	FieldPdfKey,
+	FieldIssuedAt,
+	FieldSubmittedAt,
+	FieldAcceptedAt,
+	FieldUserID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultTotal holds the default value on creation for the "total" field.
+	DefaultTotal money.Amount
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultIssuedAt holds the default value on creation for the "issued_at" field.
+	DefaultIssuedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
@@ -0,0 +1,2542 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fiscaldocument
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInvoiceID), v))
+	})
+}
+
+// DocumentType applies equality check predicate on the "document_type" field. It's identical to DocumentTypeEQ.
+func DocumentType(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentType), v))
+	})
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNumber), v))
+	})
+}
+
+// ReferenceID applies equality check predicate on the "reference_id" field. It's identical to ReferenceIDEQ.
+func ReferenceID(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReferenceID), v))
+	})
+}
+
+// DiscrepancyCode applies equality check predicate on the "discrepancy_code" field. It's identical to DiscrepancyCodeEQ.
+func DiscrepancyCode(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscrepancyCode), v))
+	})
+}
+
+// DiscrepancyReason applies equality check predicate on the "discrepancy_reason" field. It's identical to DiscrepancyReasonEQ.
+func DiscrepancyReason(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscrepancyReason), v))
+	})
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v money.Amount) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotal), v))
+	})
+}
+
+// Cufe applies equality check predicate on the "cufe" field. It's identical to CufeEQ.
+func Cufe(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCufe), v))
+	})
+}
+
+// Digest applies equality check predicate on the "digest" field. It's identical to DigestEQ.
+func Digest(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDigest), v))
+	})
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProvider), v))
+	})
+}
+
+// TrackID applies equality check predicate on the "track_id" field. It's identical to TrackIDEQ.
+func TrackID(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTrackID), v))
+	})
+}
+
+// XMLKey applies equality check predicate on the "xml_key" field. It's identical to XMLKeyEQ.
+func XMLKey(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldXMLKey), v))
+	})
+}
+
+// PdfKey applies equality check predicate on the "pdf_key" field. It's identical to PdfKeyEQ.
+func PdfKey(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPdfKey), v))
+	})
+}
+
+// IssuedAt applies equality check predicate on the "issued_at" field. It's identical to IssuedAtEQ.
+func IssuedAt(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIssuedAt), v))
+	})
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubmittedAt), v))
+	})
+}
+
+// AcceptedAt applies equality check predicate on the "accepted_at" field. It's identical to AcceptedAtEQ.
+func AcceptedAt(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAcceptedAt), v))
+	})
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...int) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldInvoiceID), v...))
+	})
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...int) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldInvoiceID), v...))
+	})
+}
+
+// InvoiceIDGT applies the GT predicate on the "invoice_id" field.
+func InvoiceIDGT(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDGTE applies the GTE predicate on the "invoice_id" field.
+func InvoiceIDGTE(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDLT applies the LT predicate on the "invoice_id" field.
+func InvoiceIDLT(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDLTE applies the LTE predicate on the "invoice_id" field.
+func InvoiceIDLTE(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldInvoiceID), v))
+	})
+}
+
+// DocumentTypeEQ applies the EQ predicate on the "document_type" field.
+func DocumentTypeEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeNEQ applies the NEQ predicate on the "document_type" field.
+func DocumentTypeNEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeIn applies the In predicate on the "document_type" field.
+func DocumentTypeIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDocumentType), v...))
+	})
+}
+
+// DocumentTypeNotIn applies the NotIn predicate on the "document_type" field.
+func DocumentTypeNotIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDocumentType), v...))
+	})
+}
+
+// DocumentTypeGT applies the GT predicate on the "document_type" field.
+func DocumentTypeGT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeGTE applies the GTE predicate on the "document_type" field.
+func DocumentTypeGTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeLT applies the LT predicate on the "document_type" field.
+func DocumentTypeLT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeLTE applies the LTE predicate on the "document_type" field.
+func DocumentTypeLTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeContains applies the Contains predicate on the "document_type" field.
+func DocumentTypeContains(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeHasPrefix applies the HasPrefix predicate on the "document_type" field.
+func DocumentTypeHasPrefix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeHasSuffix applies the HasSuffix predicate on the "document_type" field.
+func DocumentTypeHasSuffix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeEqualFold applies the EqualFold predicate on the "document_type" field.
+func DocumentTypeEqualFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeContainsFold applies the ContainsFold predicate on the "document_type" field.
+func DocumentTypeContainsFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDocumentType), v))
+	})
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNumber), v))
+	})
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNumber), v))
+	})
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNumber), v...))
+	})
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNumber), v...))
+	})
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNumber), v))
+	})
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNumber), v))
+	})
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNumber), v))
+	})
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNumber), v))
+	})
+}
+
+// NumberContains applies the Contains predicate on the "number" field.
+func NumberContains(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNumber), v))
+	})
+}
+
+// NumberHasPrefix applies the HasPrefix predicate on the "number" field.
+func NumberHasPrefix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNumber), v))
+	})
+}
+
+// NumberHasSuffix applies the HasSuffix predicate on the "number" field.
+func NumberHasSuffix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNumber), v))
+	})
+}
+
+// NumberIsNil applies the IsNil predicate on the "number" field.
+func NumberIsNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNumber)))
+	})
+}
+
+// NumberNotNil applies the NotNil predicate on the "number" field.
+func NumberNotNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNumber)))
+	})
+}
+
+// NumberEqualFold applies the EqualFold predicate on the "number" field.
+func NumberEqualFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNumber), v))
+	})
+}
+
+// NumberContainsFold applies the ContainsFold predicate on the "number" field.
+func NumberContainsFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNumber), v))
+	})
+}
+
+// ReferenceIDEQ applies the EQ predicate on the "reference_id" field.
+func ReferenceIDEQ(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDNEQ applies the NEQ predicate on the "reference_id" field.
+func ReferenceIDNEQ(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDIn applies the In predicate on the "reference_id" field.
+func ReferenceIDIn(vs ...int) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReferenceID), v...))
+	})
+}
+
+// ReferenceIDNotIn applies the NotIn predicate on the "reference_id" field.
+func ReferenceIDNotIn(vs ...int) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReferenceID), v...))
+	})
+}
+
+// ReferenceIDGT applies the GT predicate on the "reference_id" field.
+func ReferenceIDGT(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDGTE applies the GTE predicate on the "reference_id" field.
+func ReferenceIDGTE(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDLT applies the LT predicate on the "reference_id" field.
+func ReferenceIDLT(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDLTE applies the LTE predicate on the "reference_id" field.
+func ReferenceIDLTE(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReferenceID), v))
+	})
+}
+
+// ReferenceIDIsNil applies the IsNil predicate on the "reference_id" field.
+func ReferenceIDIsNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldReferenceID)))
+	})
+}
+
+// ReferenceIDNotNil applies the NotNil predicate on the "reference_id" field.
+func ReferenceIDNotNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldReferenceID)))
+	})
+}
+
+// DiscrepancyCodeEQ applies the EQ predicate on the "discrepancy_code" field.
+func DiscrepancyCodeEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscrepancyCode), v))
+	})
+}
+
+// DiscrepancyCodeNEQ applies the NEQ predicate on the "discrepancy_code" field.
+func DiscrepancyCodeNEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDiscrepancyCode), v))
+	})
+}
+
+// DiscrepancyCodeIn applies the In predicate on the "discrepancy_code" field.
+func DiscrepancyCodeIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDiscrepancyCode), v...))
+	})
+}
+
+// DiscrepancyCodeNotIn applies the NotIn predicate on the "discrepancy_code" field.
+func DiscrepancyCodeNotIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDiscrepancyCode), v...))
+	})
+}
+
+// DiscrepancyCodeGT applies the GT predicate on the "discrepancy_code" field.
+func DiscrepancyCodeGT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDiscrepancyCode), v))
+	})
+}
+
+// DiscrepancyCodeGTE applies the GTE predicate on the "discrepancy_code" field.
+func DiscrepancyCodeGTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDiscrepancyCode), v))
+	})
+}
+
+// DiscrepancyCodeLT applies the LT predicate on the "discrepancy_code" field.
+func DiscrepancyCodeLT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDiscrepancyCode), v))
+	})
+}
+
+// DiscrepancyCodeLTE applies the LTE predicate on the "discrepancy_code" field.
+func DiscrepancyCodeLTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDiscrepancyCode), v))
+	})
+}
+
+// DiscrepancyCodeContains applies the Contains predicate on the "discrepancy_code" field.
+func DiscrepancyCodeContains(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDiscrepancyCode), v))
+	})
+}
+
+// DiscrepancyCodeHasPrefix applies the HasPrefix predicate on the "discrepancy_code" field.
+func DiscrepancyCodeHasPrefix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDiscrepancyCode), v))
+	})
+}
+
+// DiscrepancyCodeHasSuffix applies the HasSuffix predicate on the "discrepancy_code" field.
+func DiscrepancyCodeHasSuffix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDiscrepancyCode), v))
+	})
+}
+
+// DiscrepancyCodeIsNil applies the IsNil predicate on the "discrepancy_code" field.
+func DiscrepancyCodeIsNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDiscrepancyCode)))
+	})
+}
+
+// DiscrepancyCodeNotNil applies the NotNil predicate on the "discrepancy_code" field.
+func DiscrepancyCodeNotNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDiscrepancyCode)))
+	})
+}
+
+// DiscrepancyCodeEqualFold applies the EqualFold predicate on the "discrepancy_code" field.
+func DiscrepancyCodeEqualFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDiscrepancyCode), v))
+	})
+}
+
+// DiscrepancyCodeContainsFold applies the ContainsFold predicate on the "discrepancy_code" field.
+func DiscrepancyCodeContainsFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDiscrepancyCode), v))
+	})
+}
+
+// DiscrepancyReasonEQ applies the EQ predicate on the "discrepancy_reason" field.
+func DiscrepancyReasonEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscrepancyReason), v))
+	})
+}
+
+// DiscrepancyReasonNEQ applies the NEQ predicate on the "discrepancy_reason" field.
+func DiscrepancyReasonNEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDiscrepancyReason), v))
+	})
+}
+
+// DiscrepancyReasonIn applies the In predicate on the "discrepancy_reason" field.
+func DiscrepancyReasonIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDiscrepancyReason), v...))
+	})
+}
+
+// DiscrepancyReasonNotIn applies the NotIn predicate on the "discrepancy_reason" field.
+func DiscrepancyReasonNotIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDiscrepancyReason), v...))
+	})
+}
+
+// DiscrepancyReasonGT applies the GT predicate on the "discrepancy_reason" field.
+func DiscrepancyReasonGT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDiscrepancyReason), v))
+	})
+}
+
+// DiscrepancyReasonGTE applies the GTE predicate on the "discrepancy_reason" field.
+func DiscrepancyReasonGTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDiscrepancyReason), v))
+	})
+}
+
+// DiscrepancyReasonLT applies the LT predicate on the "discrepancy_reason" field.
+func DiscrepancyReasonLT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDiscrepancyReason), v))
+	})
+}
+
+// DiscrepancyReasonLTE applies the LTE predicate on the "discrepancy_reason" field.
+func DiscrepancyReasonLTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDiscrepancyReason), v))
+	})
+}
+
+// DiscrepancyReasonContains applies the Contains predicate on the "discrepancy_reason" field.
+func DiscrepancyReasonContains(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDiscrepancyReason), v))
+	})
+}
+
+// DiscrepancyReasonHasPrefix applies the HasPrefix predicate on the "discrepancy_reason" field.
+func DiscrepancyReasonHasPrefix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDiscrepancyReason), v))
+	})
+}
+
+// DiscrepancyReasonHasSuffix applies the HasSuffix predicate on the "discrepancy_reason" field.
+func DiscrepancyReasonHasSuffix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDiscrepancyReason), v))
+	})
+}
+
+// DiscrepancyReasonIsNil applies the IsNil predicate on the "discrepancy_reason" field.
+func DiscrepancyReasonIsNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDiscrepancyReason)))
+	})
+}
+
+// DiscrepancyReasonNotNil applies the NotNil predicate on the "discrepancy_reason" field.
+func DiscrepancyReasonNotNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDiscrepancyReason)))
+	})
+}
+
+// DiscrepancyReasonEqualFold applies the EqualFold predicate on the "discrepancy_reason" field.
+func DiscrepancyReasonEqualFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDiscrepancyReason), v))
+	})
+}
+
+// DiscrepancyReasonContainsFold applies the ContainsFold predicate on the "discrepancy_reason" field.
+func DiscrepancyReasonContainsFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDiscrepancyReason), v))
+	})
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCurrency), v))
+	})
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v money.Amount) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotal), v))
+	})
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v money.Amount) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTotal), v))
+	})
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...money.Amount) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTotal), v...))
+	})
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...money.Amount) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTotal), v...))
+	})
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v money.Amount) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTotal), v))
+	})
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v money.Amount) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTotal), v))
+	})
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v money.Amount) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTotal), v))
+	})
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v money.Amount) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTotal), v))
+	})
+}
+
+// CufeEQ applies the EQ predicate on the "cufe" field.
+func CufeEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCufe), v))
+	})
+}
+
+// CufeNEQ applies the NEQ predicate on the "cufe" field.
+func CufeNEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCufe), v))
+	})
+}
+
+// CufeIn applies the In predicate on the "cufe" field.
+func CufeIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCufe), v...))
+	})
+}
+
+// CufeNotIn applies the NotIn predicate on the "cufe" field.
+func CufeNotIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCufe), v...))
+	})
+}
+
+// CufeGT applies the GT predicate on the "cufe" field.
+func CufeGT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCufe), v))
+	})
+}
+
+// CufeGTE applies the GTE predicate on the "cufe" field.
+func CufeGTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCufe), v))
+	})
+}
+
+// CufeLT applies the LT predicate on the "cufe" field.
+func CufeLT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCufe), v))
+	})
+}
+
+// CufeLTE applies the LTE predicate on the "cufe" field.
+func CufeLTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCufe), v))
+	})
+}
+
+// CufeContains applies the Contains predicate on the "cufe" field.
+func CufeContains(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCufe), v))
+	})
+}
+
+// CufeHasPrefix applies the HasPrefix predicate on the "cufe" field.
+func CufeHasPrefix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCufe), v))
+	})
+}
+
+// CufeHasSuffix applies the HasSuffix predicate on the "cufe" field.
+func CufeHasSuffix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCufe), v))
+	})
+}
+
+// CufeIsNil applies the IsNil predicate on the "cufe" field.
+func CufeIsNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCufe)))
+	})
+}
+
+// CufeNotNil applies the NotNil predicate on the "cufe" field.
+func CufeNotNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCufe)))
+	})
+}
+
+// CufeEqualFold applies the EqualFold predicate on the "cufe" field.
+func CufeEqualFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCufe), v))
+	})
+}
+
+// CufeContainsFold applies the ContainsFold predicate on the "cufe" field.
+func CufeContainsFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCufe), v))
+	})
+}
+
+// DigestEQ applies the EQ predicate on the "digest" field.
+func DigestEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDigest), v))
+	})
+}
+
+// DigestNEQ applies the NEQ predicate on the "digest" field.
+func DigestNEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDigest), v))
+	})
+}
+
+// DigestIn applies the In predicate on the "digest" field.
+func DigestIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDigest), v...))
+	})
+}
+
+// DigestNotIn applies the NotIn predicate on the "digest" field.
+func DigestNotIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDigest), v...))
+	})
+}
+
+// DigestGT applies the GT predicate on the "digest" field.
+func DigestGT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDigest), v))
+	})
+}
+
+// DigestGTE applies the GTE predicate on the "digest" field.
+func DigestGTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDigest), v))
+	})
+}
+
+// DigestLT applies the LT predicate on the "digest" field.
+func DigestLT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDigest), v))
+	})
+}
+
+// DigestLTE applies the LTE predicate on the "digest" field.
+func DigestLTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDigest), v))
+	})
+}
+
+// DigestContains applies the Contains predicate on the "digest" field.
+func DigestContains(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDigest), v))
+	})
+}
+
+// DigestHasPrefix applies the HasPrefix predicate on the "digest" field.
+func DigestHasPrefix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDigest), v))
+	})
+}
+
+// DigestHasSuffix applies the HasSuffix predicate on the "digest" field.
+func DigestHasSuffix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDigest), v))
+	})
+}
+
+// DigestIsNil applies the IsNil predicate on the "digest" field.
+func DigestIsNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDigest)))
+	})
+}
+
+// DigestNotNil applies the NotNil predicate on the "digest" field.
+func DigestNotNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDigest)))
+	})
+}
+
+// DigestEqualFold applies the EqualFold predicate on the "digest" field.
+func DigestEqualFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDigest), v))
+	})
+}
+
+// DigestContainsFold applies the ContainsFold predicate on the "digest" field.
+func DigestContainsFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDigest), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStatus), v))
+	})
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProvider), v...))
+	})
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProvider), v...))
+	})
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderIsNil applies the IsNil predicate on the "provider" field.
+func ProviderIsNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldProvider)))
+	})
+}
+
+// ProviderNotNil applies the NotNil predicate on the "provider" field.
+func ProviderNotNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldProvider)))
+	})
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldProvider), v))
+	})
+}
+
+// TrackIDEQ applies the EQ predicate on the "track_id" field.
+func TrackIDEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTrackID), v))
+	})
+}
+
+// TrackIDNEQ applies the NEQ predicate on the "track_id" field.
+func TrackIDNEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTrackID), v))
+	})
+}
+
+// TrackIDIn applies the In predicate on the "track_id" field.
+func TrackIDIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTrackID), v...))
+	})
+}
+
+// TrackIDNotIn applies the NotIn predicate on the "track_id" field.
+func TrackIDNotIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTrackID), v...))
+	})
+}
+
+// TrackIDGT applies the GT predicate on the "track_id" field.
+func TrackIDGT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTrackID), v))
+	})
+}
+
+// TrackIDGTE applies the GTE predicate on the "track_id" field.
+func TrackIDGTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTrackID), v))
+	})
+}
+
+// TrackIDLT applies the LT predicate on the "track_id" field.
+func TrackIDLT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTrackID), v))
+	})
+}
+
+// TrackIDLTE applies the LTE predicate on the "track_id" field.
+func TrackIDLTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTrackID), v))
+	})
+}
+
+// TrackIDContains applies the Contains predicate on the "track_id" field.
+func TrackIDContains(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTrackID), v))
+	})
+}
+
+// TrackIDHasPrefix applies the HasPrefix predicate on the "track_id" field.
+func TrackIDHasPrefix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTrackID), v))
+	})
+}
+
+// TrackIDHasSuffix applies the HasSuffix predicate on the "track_id" field.
+func TrackIDHasSuffix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTrackID), v))
+	})
+}
+
+// TrackIDIsNil applies the IsNil predicate on the "track_id" field.
+func TrackIDIsNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTrackID)))
+	})
+}
+
+// TrackIDNotNil applies the NotNil predicate on the "track_id" field.
+func TrackIDNotNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTrackID)))
+	})
+}
+
+// TrackIDEqualFold applies the EqualFold predicate on the "track_id" field.
+func TrackIDEqualFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTrackID), v))
+	})
+}
+
+// TrackIDContainsFold applies the ContainsFold predicate on the "track_id" field.
+func TrackIDContainsFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTrackID), v))
+	})
+}
+
+// RejectionReasonsIsNil applies the IsNil predicate on the "rejection_reasons" field.
+func RejectionReasonsIsNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRejectionReasons)))
+	})
+}
+
+// RejectionReasonsNotNil applies the NotNil predicate on the "rejection_reasons" field.
+func RejectionReasonsNotNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRejectionReasons)))
+	})
+}
+
+// XMLKeyEQ applies the EQ predicate on the "xml_key" field.
+func XMLKeyEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldXMLKey), v))
+	})
+}
+
+// XMLKeyNEQ applies the NEQ predicate on the "xml_key" field.
+func XMLKeyNEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldXMLKey), v))
+	})
+}
+
+// XMLKeyIn applies the In predicate on the "xml_key" field.
+func XMLKeyIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldXMLKey), v...))
+	})
+}
+
+// XMLKeyNotIn applies the NotIn predicate on the "xml_key" field.
+func XMLKeyNotIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldXMLKey), v...))
+	})
+}
+
+// XMLKeyGT applies the GT predicate on the "xml_key" field.
+func XMLKeyGT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldXMLKey), v))
+	})
+}
+
+// XMLKeyGTE applies the GTE predicate on the "xml_key" field.
+func XMLKeyGTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldXMLKey), v))
+	})
+}
+
+// XMLKeyLT applies the LT predicate on the "xml_key" field.
+func XMLKeyLT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldXMLKey), v))
+	})
+}
+
+// XMLKeyLTE applies the LTE predicate on the "xml_key" field.
+func XMLKeyLTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldXMLKey), v))
+	})
+}
+
+// XMLKeyContains applies the Contains predicate on the "xml_key" field.
+func XMLKeyContains(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldXMLKey), v))
+	})
+}
+
+// XMLKeyHasPrefix applies the HasPrefix predicate on the "xml_key" field.
+func XMLKeyHasPrefix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldXMLKey), v))
+	})
+}
+
+// XMLKeyHasSuffix applies the HasSuffix predicate on the "xml_key" field.
+func XMLKeyHasSuffix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldXMLKey), v))
+	})
+}
+
+// XMLKeyIsNil applies the IsNil predicate on the "xml_key" field.
+func XMLKeyIsNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldXMLKey)))
+	})
+}
+
+// XMLKeyNotNil applies the NotNil predicate on the "xml_key" field.
+func XMLKeyNotNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldXMLKey)))
+	})
+}
+
+// XMLKeyEqualFold applies the EqualFold predicate on the "xml_key" field.
+func XMLKeyEqualFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldXMLKey), v))
+	})
+}
+
+// XMLKeyContainsFold applies the ContainsFold predicate on the "xml_key" field.
+func XMLKeyContainsFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldXMLKey), v))
+	})
+}
+
+// PdfKeyEQ applies the EQ predicate on the "pdf_key" field.
+func PdfKeyEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPdfKey), v))
+	})
+}
+
+// PdfKeyNEQ applies the NEQ predicate on the "pdf_key" field.
+func PdfKeyNEQ(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPdfKey), v))
+	})
+}
+
+// PdfKeyIn applies the In predicate on the "pdf_key" field.
+func PdfKeyIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPdfKey), v...))
+	})
+}
+
+// PdfKeyNotIn applies the NotIn predicate on the "pdf_key" field.
+func PdfKeyNotIn(vs ...string) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPdfKey), v...))
+	})
+}
+
+// PdfKeyGT applies the GT predicate on the "pdf_key" field.
+func PdfKeyGT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPdfKey), v))
+	})
+}
+
+// PdfKeyGTE applies the GTE predicate on the "pdf_key" field.
+func PdfKeyGTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPdfKey), v))
+	})
+}
+
+// PdfKeyLT applies the LT predicate on the "pdf_key" field.
+func PdfKeyLT(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPdfKey), v))
+	})
+}
+
+// PdfKeyLTE applies the LTE predicate on the "pdf_key" field.
+func PdfKeyLTE(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPdfKey), v))
+	})
+}
+
+// PdfKeyContains applies the Contains predicate on the "pdf_key" field.
+func PdfKeyContains(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPdfKey), v))
+	})
+}
+
+// PdfKeyHasPrefix applies the HasPrefix predicate on the "pdf_key" field.
+func PdfKeyHasPrefix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPdfKey), v))
+	})
+}
+
+// PdfKeyHasSuffix applies the HasSuffix predicate on the "pdf_key" field.
+func PdfKeyHasSuffix(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPdfKey), v))
+	})
+}
+
+// PdfKeyIsNil applies the IsNil predicate on the "pdf_key" field.
+func PdfKeyIsNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPdfKey)))
+	})
+}
+
+// PdfKeyNotNil applies the NotNil predicate on the "pdf_key" field.
+func PdfKeyNotNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPdfKey)))
+	})
+}
+
+// PdfKeyEqualFold applies the EqualFold predicate on the "pdf_key" field.
+func PdfKeyEqualFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPdfKey), v))
+	})
+}
+
+// PdfKeyContainsFold applies the ContainsFold predicate on the "pdf_key" field.
+func PdfKeyContainsFold(v string) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPdfKey), v))
+	})
+}
+
+// IssuedAtEQ applies the EQ predicate on the "issued_at" field.
+func IssuedAtEQ(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtNEQ applies the NEQ predicate on the "issued_at" field.
+func IssuedAtNEQ(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtIn applies the In predicate on the "issued_at" field.
+func IssuedAtIn(vs ...time.Time) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldIssuedAt), v...))
+	})
+}
+
+// IssuedAtNotIn applies the NotIn predicate on the "issued_at" field.
+func IssuedAtNotIn(vs ...time.Time) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldIssuedAt), v...))
+	})
+}
+
+// IssuedAtGT applies the GT predicate on the "issued_at" field.
+func IssuedAtGT(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtGTE applies the GTE predicate on the "issued_at" field.
+func IssuedAtGTE(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtLT applies the LT predicate on the "issued_at" field.
+func IssuedAtLT(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtLTE applies the LTE predicate on the "issued_at" field.
+func IssuedAtLTE(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIssuedAt), v))
+	})
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubmittedAt), v))
+	})
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSubmittedAt), v))
+	})
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSubmittedAt), v...))
+	})
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSubmittedAt), v...))
+	})
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSubmittedAt), v))
+	})
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSubmittedAt), v))
+	})
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSubmittedAt), v))
+	})
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSubmittedAt), v))
+	})
+}
+
+// SubmittedAtIsNil applies the IsNil predicate on the "submitted_at" field.
+func SubmittedAtIsNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSubmittedAt)))
+	})
+}
+
+// SubmittedAtNotNil applies the NotNil predicate on the "submitted_at" field.
+func SubmittedAtNotNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSubmittedAt)))
+	})
+}
+
+// AcceptedAtEQ applies the EQ predicate on the "accepted_at" field.
+func AcceptedAtEQ(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAcceptedAt), v))
+	})
+}
+
+// AcceptedAtNEQ applies the NEQ predicate on the "accepted_at" field.
+func AcceptedAtNEQ(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAcceptedAt), v))
+	})
+}
+
+// AcceptedAtIn applies the In predicate on the "accepted_at" field.
+func AcceptedAtIn(vs ...time.Time) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAcceptedAt), v...))
+	})
+}
+
+// AcceptedAtNotIn applies the NotIn predicate on the "accepted_at" field.
+func AcceptedAtNotIn(vs ...time.Time) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAcceptedAt), v...))
+	})
+}
+
+// AcceptedAtGT applies the GT predicate on the "accepted_at" field.
+func AcceptedAtGT(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAcceptedAt), v))
+	})
+}
+
+// AcceptedAtGTE applies the GTE predicate on the "accepted_at" field.
+func AcceptedAtGTE(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAcceptedAt), v))
+	})
+}
+
+// AcceptedAtLT applies the LT predicate on the "accepted_at" field.
+func AcceptedAtLT(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAcceptedAt), v))
+	})
+}
+
+// AcceptedAtLTE applies the LTE predicate on the "accepted_at" field.
+func AcceptedAtLTE(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAcceptedAt), v))
+	})
+}
+
+// AcceptedAtIsNil applies the IsNil predicate on the "accepted_at" field.
+func AcceptedAtIsNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAcceptedAt)))
+	})
+}
+
+// AcceptedAtNotNil applies the NotNil predicate on the "accepted_at" field.
+func AcceptedAtNotNil() predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAcceptedAt)))
+	})
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserID), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FiscalDocument {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FiscalDocument) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FiscalDocument) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FiscalDocument) predicate.FiscalDocument {
+	return predicate.FiscalDocument(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,737 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/fiscaldocument"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FiscalDocumentCreate is the builder for creating a FiscalDocument entity.
+type FiscalDocumentCreate struct {
+	config
+	mutation *FiscalDocumentMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (fdc *FiscalDocumentCreate) SetTenantID(i int) *FiscalDocumentCreate {
+	fdc.mutation.SetTenantID(i)
+	return fdc
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (fdc *FiscalDocumentCreate) SetInvoiceID(i int) *FiscalDocumentCreate {
+	fdc.mutation.SetInvoiceID(i)
+	return fdc
+}
+
+// SetDocumentType sets the "document_type" field.
+func (fdc *FiscalDocumentCreate) SetDocumentType(s string) *FiscalDocumentCreate {
+	fdc.mutation.SetDocumentType(s)
+	return fdc
+}
+
+// SetNumber sets the "number" field.
+func (fdc *FiscalDocumentCreate) SetNumber(s string) *FiscalDocumentCreate {
+	fdc.mutation.SetNumber(s)
+	return fdc
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableNumber(s *string) *FiscalDocumentCreate {
+	if s != nil {
+		fdc.SetNumber(*s)
+	}
+	return fdc
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (fdc *FiscalDocumentCreate) SetReferenceID(i int) *FiscalDocumentCreate {
+	fdc.mutation.SetReferenceID(i)
+	return fdc
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableReferenceID(i *int) *FiscalDocumentCreate {
+	if i != nil {
+		fdc.SetReferenceID(*i)
+	}
+	return fdc
+}
+
+// SetDiscrepancyCode sets the "discrepancy_code" field.
+func (fdc *FiscalDocumentCreate) SetDiscrepancyCode(s string) *FiscalDocumentCreate {
+	fdc.mutation.SetDiscrepancyCode(s)
+	return fdc
+}
+
+// SetNillableDiscrepancyCode sets the "discrepancy_code" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableDiscrepancyCode(s *string) *FiscalDocumentCreate {
+	if s != nil {
+		fdc.SetDiscrepancyCode(*s)
+	}
+	return fdc
+}
+
+// SetDiscrepancyReason sets the "discrepancy_reason" field.
+func (fdc *FiscalDocumentCreate) SetDiscrepancyReason(s string) *FiscalDocumentCreate {
+	fdc.mutation.SetDiscrepancyReason(s)
+	return fdc
+}
+
+// SetNillableDiscrepancyReason sets the "discrepancy_reason" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableDiscrepancyReason(s *string) *FiscalDocumentCreate {
+	if s != nil {
+		fdc.SetDiscrepancyReason(*s)
+	}
+	return fdc
+}
+
+// SetCurrency sets the "currency" field.
+func (fdc *FiscalDocumentCreate) SetCurrency(s string) *FiscalDocumentCreate {
+	fdc.mutation.SetCurrency(s)
+	return fdc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableCurrency(s *string) *FiscalDocumentCreate {
+	if s != nil {
+		fdc.SetCurrency(*s)
+	}
+	return fdc
+}
+
+// SetTotal sets the "total" field.
+func (fdc *FiscalDocumentCreate) SetTotal(m money.Amount) *FiscalDocumentCreate {
+	fdc.mutation.SetTotal(m)
+	return fdc
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableTotal(m *money.Amount) *FiscalDocumentCreate {
+	if m != nil {
+		fdc.SetTotal(*m)
+	}
+	return fdc
+}
+
+// SetCufe sets the "cufe" field.
+func (fdc *FiscalDocumentCreate) SetCufe(s string) *FiscalDocumentCreate {
+	fdc.mutation.SetCufe(s)
+	return fdc
+}
+
+// SetNillableCufe sets the "cufe" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableCufe(s *string) *FiscalDocumentCreate {
+	if s != nil {
+		fdc.SetCufe(*s)
+	}
+	return fdc
+}
+
+// SetDigest sets the "digest" field.
+func (fdc *FiscalDocumentCreate) SetDigest(s string) *FiscalDocumentCreate {
+	fdc.mutation.SetDigest(s)
+	return fdc
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableDigest(s *string) *FiscalDocumentCreate {
+	if s != nil {
+		fdc.SetDigest(*s)
+	}
+	return fdc
+}
+
+// SetStatus sets the "status" field.
+func (fdc *FiscalDocumentCreate) SetStatus(s string) *FiscalDocumentCreate {
+	fdc.mutation.SetStatus(s)
+	return fdc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableStatus(s *string) *FiscalDocumentCreate {
+	if s != nil {
+		fdc.SetStatus(*s)
+	}
+	return fdc
+}
+
+// SetProvider sets the "provider" field.
+func (fdc *FiscalDocumentCreate) SetProvider(s string) *FiscalDocumentCreate {
+	fdc.mutation.SetProvider(s)
+	return fdc
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableProvider(s *string) *FiscalDocumentCreate {
+	if s != nil {
+		fdc.SetProvider(*s)
+	}
+	return fdc
+}
+
+// SetTrackID sets the "track_id" field.
+func (fdc *FiscalDocumentCreate) SetTrackID(s string) *FiscalDocumentCreate {
+	fdc.mutation.SetTrackID(s)
+	return fdc
+}
+
+// SetNillableTrackID sets the "track_id" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableTrackID(s *string) *FiscalDocumentCreate {
+	if s != nil {
+		fdc.SetTrackID(*s)
+	}
+	return fdc
+}
+
+// SetRejectionReasons sets the "rejection_reasons" field.
+func (fdc *FiscalDocumentCreate) SetRejectionReasons(s []string) *FiscalDocumentCreate {
+	fdc.mutation.SetRejectionReasons(s)
+	return fdc
+}
+
+// SetXMLKey sets the "xml_key" field.
+func (fdc *FiscalDocumentCreate) SetXMLKey(s string) *FiscalDocumentCreate {
+	fdc.mutation.SetXMLKey(s)
+	return fdc
+}
+
+// SetNillableXMLKey sets the "xml_key" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableXMLKey(s *string) *FiscalDocumentCreate {
+	if s != nil {
+		fdc.SetXMLKey(*s)
+	}
+	return fdc
+}
+
+// SetPdfKey sets the "pdf_key" field.
+func (fdc *FiscalDocumentCreate) SetPdfKey(s string) *FiscalDocumentCreate {
+	fdc.mutation.SetPdfKey(s)
+	return fdc
+}
+
+// SetNillablePdfKey sets the "pdf_key" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillablePdfKey(s *string) *FiscalDocumentCreate {
+	if s != nil {
+		fdc.SetPdfKey(*s)
+	}
+	return fdc
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (fdc *FiscalDocumentCreate) SetIssuedAt(t time.Time) *FiscalDocumentCreate {
+	fdc.mutation.SetIssuedAt(t)
+	return fdc
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableIssuedAt(t *time.Time) *FiscalDocumentCreate {
+	if t != nil {
+		fdc.SetIssuedAt(*t)
+	}
+	return fdc
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (fdc *FiscalDocumentCreate) SetSubmittedAt(t time.Time) *FiscalDocumentCreate {
+	fdc.mutation.SetSubmittedAt(t)
+	return fdc
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableSubmittedAt(t *time.Time) *FiscalDocumentCreate {
+	if t != nil {
+		fdc.SetSubmittedAt(*t)
+	}
+	return fdc
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (fdc *FiscalDocumentCreate) SetAcceptedAt(t time.Time) *FiscalDocumentCreate {
+	fdc.mutation.SetAcceptedAt(t)
+	return fdc
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableAcceptedAt(t *time.Time) *FiscalDocumentCreate {
+	if t != nil {
+		fdc.SetAcceptedAt(*t)
+	}
+	return fdc
+}
+
+// SetUserID sets the "user_id" field.
+func (fdc *FiscalDocumentCreate) SetUserID(i int) *FiscalDocumentCreate {
+	fdc.mutation.SetUserID(i)
+	return fdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fdc *FiscalDocumentCreate) SetCreatedAt(t time.Time) *FiscalDocumentCreate {
+	fdc.mutation.SetCreatedAt(t)
+	return fdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableCreatedAt(t *time.Time) *FiscalDocumentCreate {
+	if t != nil {
+		fdc.SetCreatedAt(*t)
+	}
+	return fdc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fdc *FiscalDocumentCreate) SetUpdatedAt(t time.Time) *FiscalDocumentCreate {
+	fdc.mutation.SetUpdatedAt(t)
+	return fdc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (fdc *FiscalDocumentCreate) SetNillableUpdatedAt(t *time.Time) *FiscalDocumentCreate {
+	if t != nil {
+		fdc.SetUpdatedAt(*t)
+	}
+	return fdc
+}
+
+// Mutation returns the FiscalDocumentMutation object of the builder.
+func (fdc *FiscalDocumentCreate) Mutation() *FiscalDocumentMutation {
+	return fdc.mutation
+}
+
+// Save creates the FiscalDocument in the database.
+func (fdc *FiscalDocumentCreate) Save(ctx context.Context) (*FiscalDocument, error) {
+	var (
+		err  error
+		node *FiscalDocument
+	)
+	fdc.defaults()
+	if len(fdc.hooks) == 0 {
+		if err = fdc.check(); err != nil {
+			return nil, err
+		}
+		node, err = fdc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FiscalDocumentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = fdc.check(); err != nil {
+				return nil, err
+			}
+			fdc.mutation = mutation
+			if node, err = fdc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(fdc.hooks) - 1; i >= 0; i-- {
+			if fdc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = fdc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, fdc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*FiscalDocument)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from FiscalDocumentMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fdc *FiscalDocumentCreate) SaveX(ctx context.Context) *FiscalDocument {
+	v, err := fdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fdc *FiscalDocumentCreate) Exec(ctx context.Context) error {
+	_, err := fdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fdc *FiscalDocumentCreate) ExecX(ctx context.Context) {
+	if err := fdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fdc *FiscalDocumentCreate) defaults() {
+	if _, ok := fdc.mutation.Currency(); !ok {
+		v := fiscaldocument.DefaultCurrency
+		fdc.mutation.SetCurrency(v)
+	}
+	if _, ok := fdc.mutation.Total(); !ok {
+		v := fiscaldocument.DefaultTotal
+		fdc.mutation.SetTotal(v)
+	}
+	if _, ok := fdc.mutation.Status(); !ok {
+		v := fiscaldocument.DefaultStatus
+		fdc.mutation.SetStatus(v)
+	}
+	if _, ok := fdc.mutation.IssuedAt(); !ok {
+		v := fiscaldocument.DefaultIssuedAt()
+		fdc.mutation.SetIssuedAt(v)
+	}
+	if _, ok := fdc.mutation.CreatedAt(); !ok {
+		v := fiscaldocument.DefaultCreatedAt()
+		fdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := fdc.mutation.UpdatedAt(); !ok {
+		v := fiscaldocument.DefaultUpdatedAt()
+		fdc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fdc *FiscalDocumentCreate) check() error {
+	if _, ok := fdc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "FiscalDocument.tenant_id"`)}
+	}
+	if _, ok := fdc.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`ent: missing required field "FiscalDocument.invoice_id"`)}
+	}
+	if _, ok := fdc.mutation.DocumentType(); !ok {
+		return &ValidationError{Name: "document_type", err: errors.New(`ent: missing required field "FiscalDocument.document_type"`)}
+	}
+	if _, ok := fdc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "FiscalDocument.currency"`)}
+	}
+	if _, ok := fdc.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "FiscalDocument.total"`)}
+	}
+	if _, ok := fdc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "FiscalDocument.status"`)}
+	}
+	if _, ok := fdc.mutation.IssuedAt(); !ok {
+		return &ValidationError{Name: "issued_at", err: errors.New(`ent: missing required field "FiscalDocument.issued_at"`)}
+	}
+	if _, ok := fdc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "FiscalDocument.user_id"`)}
+	}
+	if _, ok := fdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FiscalDocument.created_at"`)}
+	}
+	if _, ok := fdc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FiscalDocument.updated_at"`)}
+	}
+	return nil
+}
+
+func (fdc *FiscalDocumentCreate) sqlSave(ctx context.Context) (*FiscalDocument, error) {
+	_node, _spec := fdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (fdc *FiscalDocumentCreate) createSpec() (*FiscalDocument, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FiscalDocument{config: fdc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: fiscaldocument.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: fiscaldocument.FieldID,
+			},
+		}
+	)
+	if value, ok := fdc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := fdc.mutation.InvoiceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldInvoiceID,
+		})
+		_node.InvoiceID = value
+	}
+	if value, ok := fdc.mutation.DocumentType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldDocumentType,
+		})
+		_node.DocumentType = value
+	}
+	if value, ok := fdc.mutation.Number(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldNumber,
+		})
+		_node.Number = &value
+	}
+	if value, ok := fdc.mutation.ReferenceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldReferenceID,
+		})
+		_node.ReferenceID = &value
+	}
+	if value, ok := fdc.mutation.DiscrepancyCode(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldDiscrepancyCode,
+		})
+		_node.DiscrepancyCode = value
+	}
+	if value, ok := fdc.mutation.DiscrepancyReason(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldDiscrepancyReason,
+		})
+		_node.DiscrepancyReason = value
+	}
+	if value, ok := fdc.mutation.Currency(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldCurrency,
+		})
+		_node.Currency = value
+	}
+	if value, ok := fdc.mutation.Total(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fiscaldocument.FieldTotal,
+		})
+		_node.Total = value
+	}
+	if value, ok := fdc.mutation.Cufe(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldCufe,
+		})
+		_node.Cufe = value
+	}
+	if value, ok := fdc.mutation.Digest(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldDigest,
+		})
+		_node.Digest = value
+	}
+	if value, ok := fdc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := fdc.mutation.Provider(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldProvider,
+		})
+		_node.Provider = value
+	}
+	if value, ok := fdc.mutation.TrackID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldTrackID,
+		})
+		_node.TrackID = value
+	}
+	if value, ok := fdc.mutation.RejectionReasons(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: fiscaldocument.FieldRejectionReasons,
+		})
+		_node.RejectionReasons = value
+	}
+	if value, ok := fdc.mutation.XMLKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldXMLKey,
+		})
+		_node.XMLKey = value
+	}
+	if value, ok := fdc.mutation.PdfKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldPdfKey,
+		})
+		_node.PdfKey = value
+	}
+	if value, ok := fdc.mutation.IssuedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fiscaldocument.FieldIssuedAt,
+		})
+		_node.IssuedAt = value
+	}
+	if value, ok := fdc.mutation.SubmittedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fiscaldocument.FieldSubmittedAt,
+		})
+		_node.SubmittedAt = &value
+	}
+	if value, ok := fdc.mutation.AcceptedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fiscaldocument.FieldAcceptedAt,
+		})
+		_node.AcceptedAt = &value
+	}
+	if value, ok := fdc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := fdc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fiscaldocument.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := fdc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fiscaldocument.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// FiscalDocumentCreateBulk is the builder for creating many FiscalDocument entities in bulk.
+type FiscalDocumentCreateBulk struct {
+	config
+	builders []*FiscalDocumentCreate
+}
+
+// Save creates the FiscalDocument entities in the database.
+func (fdcb *FiscalDocumentCreateBulk) Save(ctx context.Context) ([]*FiscalDocument, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(fdcb.builders))
+	nodes := make([]*FiscalDocument, len(fdcb.builders))
+	mutators := make([]Mutator, len(fdcb.builders))
+	for i := range fdcb.builders {
+		func(i int, root context.Context) {
+			builder := fdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FiscalDocumentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, fdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fdcb *FiscalDocumentCreateBulk) SaveX(ctx context.Context) []*FiscalDocument {
+	v, err := fdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fdcb *FiscalDocumentCreateBulk) Exec(ctx context.Context) error {
+	_, err := fdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fdcb *FiscalDocumentCreateBulk) ExecX(ctx context.Context) {
+	if err := fdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/fiscaldocument"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FiscalDocumentDelete is the builder for deleting a FiscalDocument entity.
+type FiscalDocumentDelete struct {
+	config
+	hooks    []Hook
+	mutation *FiscalDocumentMutation
+}
+
+// Where appends a list predicates to the FiscalDocumentDelete builder.
+func (fdd *FiscalDocumentDelete) Where(ps ...predicate.FiscalDocument) *FiscalDocumentDelete {
+	fdd.mutation.Where(ps...)
+	return fdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fdd *FiscalDocumentDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(fdd.hooks) == 0 {
+		affected, err = fdd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FiscalDocumentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fdd.mutation = mutation
+			affected, err = fdd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(fdd.hooks) - 1; i >= 0; i-- {
+			if fdd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = fdd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fdd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fdd *FiscalDocumentDelete) ExecX(ctx context.Context) int {
+	n, err := fdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fdd *FiscalDocumentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: fiscaldocument.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: fiscaldocument.FieldID,
+			},
+		},
+	}
+	if ps := fdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// FiscalDocumentDeleteOne is the builder for deleting a single FiscalDocument entity.
+type FiscalDocumentDeleteOne struct {
+	fdd *FiscalDocumentDelete
+}
+
+// Exec executes the deletion query.
+func (fddo *FiscalDocumentDeleteOne) Exec(ctx context.Context) error {
+	n, err := fddo.fdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{fiscaldocument.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fddo *FiscalDocumentDeleteOne) ExecX(ctx context.Context) {
+	fddo.fdd.ExecX(ctx)
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/fiscaldocument"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FiscalDocumentQuery is the builder for querying FiscalDocument entities.
+type FiscalDocumentQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.FiscalDocument
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FiscalDocumentQuery builder.
+func (fdq *FiscalDocumentQuery) Where(ps ...predicate.FiscalDocument) *FiscalDocumentQuery {
+	fdq.predicates = append(fdq.predicates, ps...)
+	return fdq
+}
+
+// Limit adds a limit step to the query.
+func (fdq *FiscalDocumentQuery) Limit(limit int) *FiscalDocumentQuery {
+	fdq.limit = &limit
+	return fdq
+}
+
+// Offset adds an offset step to the query.
+func (fdq *FiscalDocumentQuery) Offset(offset int) *FiscalDocumentQuery {
+	fdq.offset = &offset
+	return fdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (fdq *FiscalDocumentQuery) Unique(unique bool) *FiscalDocumentQuery {
+	fdq.unique = &unique
+	return fdq
+}
+
+// Order adds an order step to the query.
+func (fdq *FiscalDocumentQuery) Order(o ...OrderFunc) *FiscalDocumentQuery {
+	fdq.order = append(fdq.order, o...)
+	return fdq
+}
+
+// First returns the first FiscalDocument entity from the query.
+// Returns a *NotFoundError when no FiscalDocument was found.
+func (fdq *FiscalDocumentQuery) First(ctx context.Context) (*FiscalDocument, error) {
+	nodes, err := fdq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{fiscaldocument.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (fdq *FiscalDocumentQuery) FirstX(ctx context.Context) *FiscalDocument {
+	node, err := fdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FiscalDocument ID from the query.
+// Returns a *NotFoundError when no FiscalDocument ID was found.
+func (fdq *FiscalDocumentQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = fdq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{fiscaldocument.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (fdq *FiscalDocumentQuery) FirstIDX(ctx context.Context) int {
+	id, err := fdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FiscalDocument entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FiscalDocument entity is found.
+// Returns a *NotFoundError when no FiscalDocument entities are found.
+func (fdq *FiscalDocumentQuery) Only(ctx context.Context) (*FiscalDocument, error) {
+	nodes, err := fdq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{fiscaldocument.Label}
+	default:
+		return nil, &NotSingularError{fiscaldocument.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (fdq *FiscalDocumentQuery) OnlyX(ctx context.Context) *FiscalDocument {
+	node, err := fdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FiscalDocument ID in the query.
+// Returns a *NotSingularError when more than one FiscalDocument ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (fdq *FiscalDocumentQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = fdq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{fiscaldocument.Label}
+	default:
+		err = &NotSingularError{fiscaldocument.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (fdq *FiscalDocumentQuery) OnlyIDX(ctx context.Context) int {
+	id, err := fdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FiscalDocuments.
+func (fdq *FiscalDocumentQuery) All(ctx context.Context) ([]*FiscalDocument, error) {
+	if err := fdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return fdq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fdq *FiscalDocumentQuery) AllX(ctx context.Context) []*FiscalDocument {
+	nodes, err := fdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FiscalDocument IDs.
+func (fdq *FiscalDocumentQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := fdq.Select(fiscaldocument.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (fdq *FiscalDocumentQuery) IDsX(ctx context.Context) []int {
+	ids, err := fdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (fdq *FiscalDocumentQuery) Count(ctx context.Context) (int, error) {
+	if err := fdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return fdq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (fdq *FiscalDocumentQuery) CountX(ctx context.Context) int {
+	count, err := fdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (fdq *FiscalDocumentQuery) Exist(ctx context.Context) (bool, error) {
+	if err := fdq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return fdq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (fdq *FiscalDocumentQuery) ExistX(ctx context.Context) bool {
+	exist, err := fdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FiscalDocumentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (fdq *FiscalDocumentQuery) Clone() *FiscalDocumentQuery {
+	if fdq == nil {
+		return nil
+	}
+	return &FiscalDocumentQuery{
+		config:     fdq.config,
+		limit:      fdq.limit,
+		offset:     fdq.offset,
+		order:      append([]OrderFunc{}, fdq.order...),
+		predicates: append([]predicate.FiscalDocument{}, fdq.predicates...),
+		// clone intermediate query.
+		sql:    fdq.sql.Clone(),
+		path:   fdq.path,
+		unique: fdq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FiscalDocument.Query().
+//		GroupBy(fiscaldocument.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (fdq *FiscalDocumentQuery) GroupBy(field string, fields ...string) *FiscalDocumentGroupBy {
+	grbuild := &FiscalDocumentGroupBy{config: fdq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := fdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return fdq.sqlQuery(ctx), nil
+	}
+	grbuild.label = fiscaldocument.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.FiscalDocument.Query().
+//		Select(fiscaldocument.FieldTenantID).
+//		Scan(ctx, &v)
+func (fdq *FiscalDocumentQuery) Select(fields ...string) *FiscalDocumentSelect {
+	fdq.fields = append(fdq.fields, fields...)
+	selbuild := &FiscalDocumentSelect{FiscalDocumentQuery: fdq}
+	selbuild.label = fiscaldocument.Label
+	selbuild.flds, selbuild.scan = &fdq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (fdq *FiscalDocumentQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range fdq.fields {
+		if !fiscaldocument.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if fdq.path != nil {
+		prev, err := fdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		fdq.sql = prev
+	}
+	return nil
+}
+
+func (fdq *FiscalDocumentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FiscalDocument, error) {
+	var (
+		nodes = []*FiscalDocument{}
+		_spec = fdq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*FiscalDocument).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &FiscalDocument{config: fdq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(fdq.modifiers) > 0 {
+		_spec.Modifiers = fdq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, fdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (fdq *FiscalDocumentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := fdq.querySpec()
+	if len(fdq.modifiers) > 0 {
+		_spec.Modifiers = fdq.modifiers
+	}
+	_spec.Node.Columns = fdq.fields
+	if len(fdq.fields) > 0 {
+		_spec.Unique = fdq.unique != nil && *fdq.unique
+	}
+	return sqlgraph.CountNodes(ctx, fdq.driver, _spec)
+}
+
+func (fdq *FiscalDocumentQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := fdq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (fdq *FiscalDocumentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   fiscaldocument.Table,
+			Columns: fiscaldocument.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: fiscaldocument.FieldID,
+			},
+		},
+		From:   fdq.sql,
+		Unique: true,
+	}
+	if unique := fdq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := fdq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fiscaldocument.FieldID)
+		for i := range fields {
+			if fields[i] != fiscaldocument.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := fdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := fdq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := fdq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := fdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (fdq *FiscalDocumentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(fdq.driver.Dialect())
+	t1 := builder.Table(fiscaldocument.Table)
+	columns := fdq.fields
+	if len(columns) == 0 {
+		columns = fiscaldocument.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if fdq.sql != nil {
+		selector = fdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if fdq.unique != nil && *fdq.unique {
+		selector.Distinct()
+	}
+	for _, m := range fdq.modifiers {
+		m(selector)
+	}
+	for _, p := range fdq.predicates {
+		p(selector)
+	}
+	for _, p := range fdq.order {
+		p(selector)
+	}
+	if offset := fdq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := fdq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (fdq *FiscalDocumentQuery) ForUpdate(opts ...sql.LockOption) *FiscalDocumentQuery {
+	if fdq.driver.Dialect() == dialect.Postgres {
+		fdq.Unique(false)
+	}
+	fdq.modifiers = append(fdq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return fdq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (fdq *FiscalDocumentQuery) ForShare(opts ...sql.LockOption) *FiscalDocumentQuery {
+	if fdq.driver.Dialect() == dialect.Postgres {
+		fdq.Unique(false)
+	}
+	fdq.modifiers = append(fdq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return fdq
+}
+
+// FiscalDocumentGroupBy is the group-by builder for FiscalDocument entities.
+type FiscalDocumentGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fdgb *FiscalDocumentGroupBy) Aggregate(fns ...AggregateFunc) *FiscalDocumentGroupBy {
+	fdgb.fns = append(fdgb.fns, fns...)
+	return fdgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (fdgb *FiscalDocumentGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := fdgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	fdgb.sql = query
+	return fdgb.sqlScan(ctx, v)
+}
+
+func (fdgb *FiscalDocumentGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range fdgb.fields {
+		if !fiscaldocument.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := fdgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fdgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (fdgb *FiscalDocumentGroupBy) sqlQuery() *sql.Selector {
+	selector := fdgb.sql.Select()
+	aggregation := make([]string, 0, len(fdgb.fns))
+	for _, fn := range fdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(fdgb.fields)+len(fdgb.fns))
+		for _, f := range fdgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(fdgb.fields...)...)
+}
+
+// FiscalDocumentSelect is the builder for selecting fields of FiscalDocument entities.
+type FiscalDocumentSelect struct {
+	*FiscalDocumentQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fds *FiscalDocumentSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := fds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	fds.sql = fds.FiscalDocumentQuery.sqlQuery(ctx)
+	return fds.sqlScan(ctx, v)
+}
+
+func (fds *FiscalDocumentSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := fds.sql.Query()
+	if err := fds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,1545 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/fiscaldocument"
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FiscalDocumentUpdate is the builder for updating FiscalDocument entities.
+type FiscalDocumentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FiscalDocumentMutation
+}
+
+// Where appends a list predicates to the FiscalDocumentUpdate builder.
+func (fdu *FiscalDocumentUpdate) Where(ps ...predicate.FiscalDocument) *FiscalDocumentUpdate {
+	fdu.mutation.Where(ps...)
+	return fdu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (fdu *FiscalDocumentUpdate) SetTenantID(i int) *FiscalDocumentUpdate {
+	fdu.mutation.ResetTenantID()
+	fdu.mutation.SetTenantID(i)
+	return fdu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (fdu *FiscalDocumentUpdate) AddTenantID(i int) *FiscalDocumentUpdate {
+	fdu.mutation.AddTenantID(i)
+	return fdu
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (fdu *FiscalDocumentUpdate) SetInvoiceID(i int) *FiscalDocumentUpdate {
+	fdu.mutation.ResetInvoiceID()
+	fdu.mutation.SetInvoiceID(i)
+	return fdu
+}
+
+// AddInvoiceID adds i to the "invoice_id" field.
+func (fdu *FiscalDocumentUpdate) AddInvoiceID(i int) *FiscalDocumentUpdate {
+	fdu.mutation.AddInvoiceID(i)
+	return fdu
+}
+
+// SetDocumentType sets the "document_type" field.
+func (fdu *FiscalDocumentUpdate) SetDocumentType(s string) *FiscalDocumentUpdate {
+	fdu.mutation.SetDocumentType(s)
+	return fdu
+}
+
+// SetNumber sets the "number" field.
+func (fdu *FiscalDocumentUpdate) SetNumber(s string) *FiscalDocumentUpdate {
+	fdu.mutation.SetNumber(s)
+	return fdu
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillableNumber(s *string) *FiscalDocumentUpdate {
+	if s != nil {
+		fdu.SetNumber(*s)
+	}
+	return fdu
+}
+
+// ClearNumber clears the value of the "number" field.
+func (fdu *FiscalDocumentUpdate) ClearNumber() *FiscalDocumentUpdate {
+	fdu.mutation.ClearNumber()
+	return fdu
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (fdu *FiscalDocumentUpdate) SetReferenceID(i int) *FiscalDocumentUpdate {
+	fdu.mutation.ResetReferenceID()
+	fdu.mutation.SetReferenceID(i)
+	return fdu
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillableReferenceID(i *int) *FiscalDocumentUpdate {
+	if i != nil {
+		fdu.SetReferenceID(*i)
+	}
+	return fdu
+}
+
+// AddReferenceID adds i to the "reference_id" field.
+func (fdu *FiscalDocumentUpdate) AddReferenceID(i int) *FiscalDocumentUpdate {
+	fdu.mutation.AddReferenceID(i)
+	return fdu
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (fdu *FiscalDocumentUpdate) ClearReferenceID() *FiscalDocumentUpdate {
+	fdu.mutation.ClearReferenceID()
+	return fdu
+}
+
+// SetDiscrepancyCode sets the "discrepancy_code" field.
+func (fdu *FiscalDocumentUpdate) SetDiscrepancyCode(s string) *FiscalDocumentUpdate {
+	fdu.mutation.SetDiscrepancyCode(s)
+	return fdu
+}
+
+// SetNillableDiscrepancyCode sets the "discrepancy_code" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillableDiscrepancyCode(s *string) *FiscalDocumentUpdate {
+	if s != nil {
+		fdu.SetDiscrepancyCode(*s)
+	}
+	return fdu
+}
+
+// ClearDiscrepancyCode clears the value of the "discrepancy_code" field.
+func (fdu *FiscalDocumentUpdate) ClearDiscrepancyCode() *FiscalDocumentUpdate {
+	fdu.mutation.ClearDiscrepancyCode()
+	return fdu
+}
+
+// SetDiscrepancyReason sets the "discrepancy_reason" field.
+func (fdu *FiscalDocumentUpdate) SetDiscrepancyReason(s string) *FiscalDocumentUpdate {
+	fdu.mutation.SetDiscrepancyReason(s)
+	return fdu
+}
+
+// SetNillableDiscrepancyReason sets the "discrepancy_reason" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillableDiscrepancyReason(s *string) *FiscalDocumentUpdate {
+	if s != nil {
+		fdu.SetDiscrepancyReason(*s)
+	}
+	return fdu
+}
+
+// ClearDiscrepancyReason clears the value of the "discrepancy_reason" field.
+func (fdu *FiscalDocumentUpdate) ClearDiscrepancyReason() *FiscalDocumentUpdate {
+	fdu.mutation.ClearDiscrepancyReason()
+	return fdu
+}
+
+// SetCurrency sets the "currency" field.
+func (fdu *FiscalDocumentUpdate) SetCurrency(s string) *FiscalDocumentUpdate {
+	fdu.mutation.SetCurrency(s)
+	return fdu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillableCurrency(s *string) *FiscalDocumentUpdate {
+	if s != nil {
+		fdu.SetCurrency(*s)
+	}
+	return fdu
+}
+
+// SetTotal sets the "total" field.
+func (fdu *FiscalDocumentUpdate) SetTotal(m money.Amount) *FiscalDocumentUpdate {
+	fdu.mutation.ResetTotal()
+	fdu.mutation.SetTotal(m)
+	return fdu
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillableTotal(m *money.Amount) *FiscalDocumentUpdate {
+	if m != nil {
+		fdu.SetTotal(*m)
+	}
+	return fdu
+}
+
+// AddTotal adds m to the "total" field.
+func (fdu *FiscalDocumentUpdate) AddTotal(m money.Amount) *FiscalDocumentUpdate {
+	fdu.mutation.AddTotal(m)
+	return fdu
+}
+
+// SetCufe sets the "cufe" field.
+func (fdu *FiscalDocumentUpdate) SetCufe(s string) *FiscalDocumentUpdate {
+	fdu.mutation.SetCufe(s)
+	return fdu
+}
+
+// SetNillableCufe sets the "cufe" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillableCufe(s *string) *FiscalDocumentUpdate {
+	if s != nil {
+		fdu.SetCufe(*s)
+	}
+	return fdu
+}
+
+// ClearCufe clears the value of the "cufe" field.
+func (fdu *FiscalDocumentUpdate) ClearCufe() *FiscalDocumentUpdate {
+	fdu.mutation.ClearCufe()
+	return fdu
+}
+
+// SetDigest sets the "digest" field.
+func (fdu *FiscalDocumentUpdate) SetDigest(s string) *FiscalDocumentUpdate {
+	fdu.mutation.SetDigest(s)
+	return fdu
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillableDigest(s *string) *FiscalDocumentUpdate {
+	if s != nil {
+		fdu.SetDigest(*s)
+	}
+	return fdu
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (fdu *FiscalDocumentUpdate) ClearDigest() *FiscalDocumentUpdate {
+	fdu.mutation.ClearDigest()
+	return fdu
+}
+
+// SetStatus sets the "status" field.
+func (fdu *FiscalDocumentUpdate) SetStatus(s string) *FiscalDocumentUpdate {
+	fdu.mutation.SetStatus(s)
+	return fdu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillableStatus(s *string) *FiscalDocumentUpdate {
+	if s != nil {
+		fdu.SetStatus(*s)
+	}
+	return fdu
+}
+
+// SetProvider sets the "provider" field.
+func (fdu *FiscalDocumentUpdate) SetProvider(s string) *FiscalDocumentUpdate {
+	fdu.mutation.SetProvider(s)
+	return fdu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillableProvider(s *string) *FiscalDocumentUpdate {
+	if s != nil {
+		fdu.SetProvider(*s)
+	}
+	return fdu
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (fdu *FiscalDocumentUpdate) ClearProvider() *FiscalDocumentUpdate {
+	fdu.mutation.ClearProvider()
+	return fdu
+}
+
+// SetTrackID sets the "track_id" field.
+func (fdu *FiscalDocumentUpdate) SetTrackID(s string) *FiscalDocumentUpdate {
+	fdu.mutation.SetTrackID(s)
+	return fdu
+}
+
+// SetNillableTrackID sets the "track_id" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillableTrackID(s *string) *FiscalDocumentUpdate {
+	if s != nil {
+		fdu.SetTrackID(*s)
+	}
+	return fdu
+}
+
+// ClearTrackID clears the value of the "track_id" field.
+func (fdu *FiscalDocumentUpdate) ClearTrackID() *FiscalDocumentUpdate {
+	fdu.mutation.ClearTrackID()
+	return fdu
+}
+
+// SetRejectionReasons sets the "rejection_reasons" field.
+func (fdu *FiscalDocumentUpdate) SetRejectionReasons(s []string) *FiscalDocumentUpdate {
+	fdu.mutation.SetRejectionReasons(s)
+	return fdu
+}
+
+// ClearRejectionReasons clears the value of the "rejection_reasons" field.
+func (fdu *FiscalDocumentUpdate) ClearRejectionReasons() *FiscalDocumentUpdate {
+	fdu.mutation.ClearRejectionReasons()
+	return fdu
+}
+
+// SetXMLKey sets the "xml_key" field.
+func (fdu *FiscalDocumentUpdate) SetXMLKey(s string) *FiscalDocumentUpdate {
+	fdu.mutation.SetXMLKey(s)
+	return fdu
+}
+
+// SetNillableXMLKey sets the "xml_key" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillableXMLKey(s *string) *FiscalDocumentUpdate {
+	if s != nil {
+		fdu.SetXMLKey(*s)
+	}
+	return fdu
+}
+
+// ClearXMLKey clears the value of the "xml_key" field.
+func (fdu *FiscalDocumentUpdate) ClearXMLKey() *FiscalDocumentUpdate {
+	fdu.mutation.ClearXMLKey()
+	return fdu
+}
+
+// SetPdfKey sets the "pdf_key" field.
+func (fdu *FiscalDocumentUpdate) SetPdfKey(s string) *FiscalDocumentUpdate {
+	fdu.mutation.SetPdfKey(s)
+	return fdu
+}
+
+// SetNillablePdfKey sets the "pdf_key" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillablePdfKey(s *string) *FiscalDocumentUpdate {
+	if s != nil {
+		fdu.SetPdfKey(*s)
+	}
+	return fdu
+}
+
+// ClearPdfKey clears the value of the "pdf_key" field.
+func (fdu *FiscalDocumentUpdate) ClearPdfKey() *FiscalDocumentUpdate {
+	fdu.mutation.ClearPdfKey()
+	return fdu
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (fdu *FiscalDocumentUpdate) SetIssuedAt(t time.Time) *FiscalDocumentUpdate {
+	fdu.mutation.SetIssuedAt(t)
+	return fdu
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillableIssuedAt(t *time.Time) *FiscalDocumentUpdate {
+	if t != nil {
+		fdu.SetIssuedAt(*t)
+	}
+	return fdu
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (fdu *FiscalDocumentUpdate) SetSubmittedAt(t time.Time) *FiscalDocumentUpdate {
+	fdu.mutation.SetSubmittedAt(t)
+	return fdu
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillableSubmittedAt(t *time.Time) *FiscalDocumentUpdate {
+	if t != nil {
+		fdu.SetSubmittedAt(*t)
+	}
+	return fdu
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (fdu *FiscalDocumentUpdate) ClearSubmittedAt() *FiscalDocumentUpdate {
+	fdu.mutation.ClearSubmittedAt()
+	return fdu
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (fdu *FiscalDocumentUpdate) SetAcceptedAt(t time.Time) *FiscalDocumentUpdate {
+	fdu.mutation.SetAcceptedAt(t)
+	return fdu
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (fdu *FiscalDocumentUpdate) SetNillableAcceptedAt(t *time.Time) *FiscalDocumentUpdate {
+	if t != nil {
+		fdu.SetAcceptedAt(*t)
+	}
+	return fdu
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (fdu *FiscalDocumentUpdate) ClearAcceptedAt() *FiscalDocumentUpdate {
+	fdu.mutation.ClearAcceptedAt()
+	return fdu
+}
+
+// SetUserID sets the "user_id" field.
+func (fdu *FiscalDocumentUpdate) SetUserID(i int) *FiscalDocumentUpdate {
+	fdu.mutation.ResetUserID()
+	fdu.mutation.SetUserID(i)
+	return fdu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (fdu *FiscalDocumentUpdate) AddUserID(i int) *FiscalDocumentUpdate {
+	fdu.mutation.AddUserID(i)
+	return fdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fdu *FiscalDocumentUpdate) SetUpdatedAt(t time.Time) *FiscalDocumentUpdate {
+	fdu.mutation.SetUpdatedAt(t)
+	return fdu
+}
+
+// Mutation returns the FiscalDocumentMutation object of the builder.
+func (fdu *FiscalDocumentUpdate) Mutation() *FiscalDocumentMutation {
+	return fdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fdu *FiscalDocumentUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	fdu.defaults()
+	if len(fdu.hooks) == 0 {
+		affected, err = fdu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FiscalDocumentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fdu.mutation = mutation
+			affected, err = fdu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(fdu.hooks) - 1; i >= 0; i-- {
+			if fdu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = fdu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fdu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fdu *FiscalDocumentUpdate) SaveX(ctx context.Context) int {
+	affected, err := fdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fdu *FiscalDocumentUpdate) Exec(ctx context.Context) error {
+	_, err := fdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fdu *FiscalDocumentUpdate) ExecX(ctx context.Context) {
+	if err := fdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fdu *FiscalDocumentUpdate) defaults() {
+	if _, ok := fdu.mutation.UpdatedAt(); !ok {
+		v := fiscaldocument.UpdateDefaultUpdatedAt()
+		fdu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (fdu *FiscalDocumentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   fiscaldocument.Table,
+			Columns: fiscaldocument.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: fiscaldocument.FieldID,
+			},
+		},
+	}
+	if ps := fdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fdu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldTenantID,
+		})
+	}
+	if value, ok := fdu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldTenantID,
+		})
+	}
+	if value, ok := fdu.mutation.InvoiceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldInvoiceID,
+		})
+	}
+	if value, ok := fdu.mutation.AddedInvoiceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldInvoiceID,
+		})
+	}
+	if value, ok := fdu.mutation.DocumentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldDocumentType,
+		})
+	}
+	if value, ok := fdu.mutation.Number(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldNumber,
+		})
+	}
+	if fdu.mutation.NumberCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldNumber,
+		})
+	}
+	if value, ok := fdu.mutation.ReferenceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldReferenceID,
+		})
+	}
+	if value, ok := fdu.mutation.AddedReferenceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldReferenceID,
+		})
+	}
+	if fdu.mutation.ReferenceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: fiscaldocument.FieldReferenceID,
+		})
+	}
+	if value, ok := fdu.mutation.DiscrepancyCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldDiscrepancyCode,
+		})
+	}
+	if fdu.mutation.DiscrepancyCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldDiscrepancyCode,
+		})
+	}
+	if value, ok := fdu.mutation.DiscrepancyReason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldDiscrepancyReason,
+		})
+	}
+	if fdu.mutation.DiscrepancyReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldDiscrepancyReason,
+		})
+	}
+	if value, ok := fdu.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldCurrency,
+		})
+	}
+	if value, ok := fdu.mutation.Total(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fiscaldocument.FieldTotal,
+		})
+	}
+	if value, ok := fdu.mutation.AddedTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fiscaldocument.FieldTotal,
+		})
+	}
+	if value, ok := fdu.mutation.Cufe(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldCufe,
+		})
+	}
+	if fdu.mutation.CufeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldCufe,
+		})
+	}
+	if value, ok := fdu.mutation.Digest(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldDigest,
+		})
+	}
+	if fdu.mutation.DigestCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldDigest,
+		})
+	}
+	if value, ok := fdu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldStatus,
+		})
+	}
+	if value, ok := fdu.mutation.Provider(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldProvider,
+		})
+	}
+	if fdu.mutation.ProviderCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldProvider,
+		})
+	}
+	if value, ok := fdu.mutation.TrackID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldTrackID,
+		})
+	}
+	if fdu.mutation.TrackIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldTrackID,
+		})
+	}
+	if value, ok := fdu.mutation.RejectionReasons(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: fiscaldocument.FieldRejectionReasons,
+		})
+	}
+	if fdu.mutation.RejectionReasonsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: fiscaldocument.FieldRejectionReasons,
+		})
+	}
+	if value, ok := fdu.mutation.XMLKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldXMLKey,
+		})
+	}
+	if fdu.mutation.XMLKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldXMLKey,
+		})
+	}
+	if value, ok := fdu.mutation.PdfKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldPdfKey,
+		})
+	}
+	if fdu.mutation.PdfKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldPdfKey,
+		})
+	}
+	if value, ok := fdu.mutation.IssuedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fiscaldocument.FieldIssuedAt,
+		})
+	}
+	if value, ok := fdu.mutation.SubmittedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fiscaldocument.FieldSubmittedAt,
+		})
+	}
+	if fdu.mutation.SubmittedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: fiscaldocument.FieldSubmittedAt,
+		})
+	}
+	if value, ok := fdu.mutation.AcceptedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fiscaldocument.FieldAcceptedAt,
+		})
+	}
+	if fdu.mutation.AcceptedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: fiscaldocument.FieldAcceptedAt,
+		})
+	}
+	if value, ok := fdu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldUserID,
+		})
+	}
+	if value, ok := fdu.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldUserID,
+		})
+	}
+	if value, ok := fdu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fiscaldocument.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fiscaldocument.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// FiscalDocumentUpdateOne is the builder for updating a single FiscalDocument entity.
+type FiscalDocumentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FiscalDocumentMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (fduo *FiscalDocumentUpdateOne) SetTenantID(i int) *FiscalDocumentUpdateOne {
+	fduo.mutation.ResetTenantID()
+	fduo.mutation.SetTenantID(i)
+	return fduo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (fduo *FiscalDocumentUpdateOne) AddTenantID(i int) *FiscalDocumentUpdateOne {
+	fduo.mutation.AddTenantID(i)
+	return fduo
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (fduo *FiscalDocumentUpdateOne) SetInvoiceID(i int) *FiscalDocumentUpdateOne {
+	fduo.mutation.ResetInvoiceID()
+	fduo.mutation.SetInvoiceID(i)
+	return fduo
+}
+
+// AddInvoiceID adds i to the "invoice_id" field.
+func (fduo *FiscalDocumentUpdateOne) AddInvoiceID(i int) *FiscalDocumentUpdateOne {
+	fduo.mutation.AddInvoiceID(i)
+	return fduo
+}
+
+// SetDocumentType sets the "document_type" field.
+func (fduo *FiscalDocumentUpdateOne) SetDocumentType(s string) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetDocumentType(s)
+	return fduo
+}
+
+// SetNumber sets the "number" field.
+func (fduo *FiscalDocumentUpdateOne) SetNumber(s string) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetNumber(s)
+	return fduo
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillableNumber(s *string) *FiscalDocumentUpdateOne {
+	if s != nil {
+		fduo.SetNumber(*s)
+	}
+	return fduo
+}
+
+// ClearNumber clears the value of the "number" field.
+func (fduo *FiscalDocumentUpdateOne) ClearNumber() *FiscalDocumentUpdateOne {
+	fduo.mutation.ClearNumber()
+	return fduo
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (fduo *FiscalDocumentUpdateOne) SetReferenceID(i int) *FiscalDocumentUpdateOne {
+	fduo.mutation.ResetReferenceID()
+	fduo.mutation.SetReferenceID(i)
+	return fduo
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillableReferenceID(i *int) *FiscalDocumentUpdateOne {
+	if i != nil {
+		fduo.SetReferenceID(*i)
+	}
+	return fduo
+}
+
+// AddReferenceID adds i to the "reference_id" field.
+func (fduo *FiscalDocumentUpdateOne) AddReferenceID(i int) *FiscalDocumentUpdateOne {
+	fduo.mutation.AddReferenceID(i)
+	return fduo
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (fduo *FiscalDocumentUpdateOne) ClearReferenceID() *FiscalDocumentUpdateOne {
+	fduo.mutation.ClearReferenceID()
+	return fduo
+}
+
+// SetDiscrepancyCode sets the "discrepancy_code" field.
+func (fduo *FiscalDocumentUpdateOne) SetDiscrepancyCode(s string) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetDiscrepancyCode(s)
+	return fduo
+}
+
+// SetNillableDiscrepancyCode sets the "discrepancy_code" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillableDiscrepancyCode(s *string) *FiscalDocumentUpdateOne {
+	if s != nil {
+		fduo.SetDiscrepancyCode(*s)
+	}
+	return fduo
+}
+
+// ClearDiscrepancyCode clears the value of the "discrepancy_code" field.
+func (fduo *FiscalDocumentUpdateOne) ClearDiscrepancyCode() *FiscalDocumentUpdateOne {
+	fduo.mutation.ClearDiscrepancyCode()
+	return fduo
+}
+
+// SetDiscrepancyReason sets the "discrepancy_reason" field.
+func (fduo *FiscalDocumentUpdateOne) SetDiscrepancyReason(s string) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetDiscrepancyReason(s)
+	return fduo
+}
+
+// SetNillableDiscrepancyReason sets the "discrepancy_reason" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillableDiscrepancyReason(s *string) *FiscalDocumentUpdateOne {
+	if s != nil {
+		fduo.SetDiscrepancyReason(*s)
+	}
+	return fduo
+}
+
+// ClearDiscrepancyReason clears the value of the "discrepancy_reason" field.
+func (fduo *FiscalDocumentUpdateOne) ClearDiscrepancyReason() *FiscalDocumentUpdateOne {
+	fduo.mutation.ClearDiscrepancyReason()
+	return fduo
+}
+
+// SetCurrency sets the "currency" field.
+func (fduo *FiscalDocumentUpdateOne) SetCurrency(s string) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetCurrency(s)
+	return fduo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillableCurrency(s *string) *FiscalDocumentUpdateOne {
+	if s != nil {
+		fduo.SetCurrency(*s)
+	}
+	return fduo
+}
+
+// SetTotal sets the "total" field.
+func (fduo *FiscalDocumentUpdateOne) SetTotal(m money.Amount) *FiscalDocumentUpdateOne {
+	fduo.mutation.ResetTotal()
+	fduo.mutation.SetTotal(m)
+	return fduo
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillableTotal(m *money.Amount) *FiscalDocumentUpdateOne {
+	if m != nil {
+		fduo.SetTotal(*m)
+	}
+	return fduo
+}
+
+// AddTotal adds m to the "total" field.
+func (fduo *FiscalDocumentUpdateOne) AddTotal(m money.Amount) *FiscalDocumentUpdateOne {
+	fduo.mutation.AddTotal(m)
+	return fduo
+}
+
+// SetCufe sets the "cufe" field.
+func (fduo *FiscalDocumentUpdateOne) SetCufe(s string) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetCufe(s)
+	return fduo
+}
+
+// SetNillableCufe sets the "cufe" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillableCufe(s *string) *FiscalDocumentUpdateOne {
+	if s != nil {
+		fduo.SetCufe(*s)
+	}
+	return fduo
+}
+
+// ClearCufe clears the value of the "cufe" field.
+func (fduo *FiscalDocumentUpdateOne) ClearCufe() *FiscalDocumentUpdateOne {
+	fduo.mutation.ClearCufe()
+	return fduo
+}
+
+// SetDigest sets the "digest" field.
+func (fduo *FiscalDocumentUpdateOne) SetDigest(s string) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetDigest(s)
+	return fduo
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillableDigest(s *string) *FiscalDocumentUpdateOne {
+	if s != nil {
+		fduo.SetDigest(*s)
+	}
+	return fduo
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (fduo *FiscalDocumentUpdateOne) ClearDigest() *FiscalDocumentUpdateOne {
+	fduo.mutation.ClearDigest()
+	return fduo
+}
+
+// SetStatus sets the "status" field.
+func (fduo *FiscalDocumentUpdateOne) SetStatus(s string) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetStatus(s)
+	return fduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillableStatus(s *string) *FiscalDocumentUpdateOne {
+	if s != nil {
+		fduo.SetStatus(*s)
+	}
+	return fduo
+}
+
+// SetProvider sets the "provider" field.
+func (fduo *FiscalDocumentUpdateOne) SetProvider(s string) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetProvider(s)
+	return fduo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillableProvider(s *string) *FiscalDocumentUpdateOne {
+	if s != nil {
+		fduo.SetProvider(*s)
+	}
+	return fduo
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (fduo *FiscalDocumentUpdateOne) ClearProvider() *FiscalDocumentUpdateOne {
+	fduo.mutation.ClearProvider()
+	return fduo
+}
+
+// SetTrackID sets the "track_id" field.
+func (fduo *FiscalDocumentUpdateOne) SetTrackID(s string) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetTrackID(s)
+	return fduo
+}
+
+// SetNillableTrackID sets the "track_id" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillableTrackID(s *string) *FiscalDocumentUpdateOne {
+	if s != nil {
+		fduo.SetTrackID(*s)
+	}
+	return fduo
+}
+
+// ClearTrackID clears the value of the "track_id" field.
+func (fduo *FiscalDocumentUpdateOne) ClearTrackID() *FiscalDocumentUpdateOne {
+	fduo.mutation.ClearTrackID()
+	return fduo
+}
+
+// SetRejectionReasons sets the "rejection_reasons" field.
+func (fduo *FiscalDocumentUpdateOne) SetRejectionReasons(s []string) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetRejectionReasons(s)
+	return fduo
+}
+
+// ClearRejectionReasons clears the value of the "rejection_reasons" field.
+func (fduo *FiscalDocumentUpdateOne) ClearRejectionReasons() *FiscalDocumentUpdateOne {
+	fduo.mutation.ClearRejectionReasons()
+	return fduo
+}
+
+// SetXMLKey sets the "xml_key" field.
+func (fduo *FiscalDocumentUpdateOne) SetXMLKey(s string) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetXMLKey(s)
+	return fduo
+}
+
+// SetNillableXMLKey sets the "xml_key" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillableXMLKey(s *string) *FiscalDocumentUpdateOne {
+	if s != nil {
+		fduo.SetXMLKey(*s)
+	}
+	return fduo
+}
+
+// ClearXMLKey clears the value of the "xml_key" field.
+func (fduo *FiscalDocumentUpdateOne) ClearXMLKey() *FiscalDocumentUpdateOne {
+	fduo.mutation.ClearXMLKey()
+	return fduo
+}
+
+// SetPdfKey sets the "pdf_key" field.
+func (fduo *FiscalDocumentUpdateOne) SetPdfKey(s string) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetPdfKey(s)
+	return fduo
+}
+
+// SetNillablePdfKey sets the "pdf_key" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillablePdfKey(s *string) *FiscalDocumentUpdateOne {
+	if s != nil {
+		fduo.SetPdfKey(*s)
+	}
+	return fduo
+}
+
+// ClearPdfKey clears the value of the "pdf_key" field.
+func (fduo *FiscalDocumentUpdateOne) ClearPdfKey() *FiscalDocumentUpdateOne {
+	fduo.mutation.ClearPdfKey()
+	return fduo
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (fduo *FiscalDocumentUpdateOne) SetIssuedAt(t time.Time) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetIssuedAt(t)
+	return fduo
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillableIssuedAt(t *time.Time) *FiscalDocumentUpdateOne {
+	if t != nil {
+		fduo.SetIssuedAt(*t)
+	}
+	return fduo
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (fduo *FiscalDocumentUpdateOne) SetSubmittedAt(t time.Time) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetSubmittedAt(t)
+	return fduo
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillableSubmittedAt(t *time.Time) *FiscalDocumentUpdateOne {
+	if t != nil {
+		fduo.SetSubmittedAt(*t)
+	}
+	return fduo
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (fduo *FiscalDocumentUpdateOne) ClearSubmittedAt() *FiscalDocumentUpdateOne {
+	fduo.mutation.ClearSubmittedAt()
+	return fduo
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (fduo *FiscalDocumentUpdateOne) SetAcceptedAt(t time.Time) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetAcceptedAt(t)
+	return fduo
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (fduo *FiscalDocumentUpdateOne) SetNillableAcceptedAt(t *time.Time) *FiscalDocumentUpdateOne {
+	if t != nil {
+		fduo.SetAcceptedAt(*t)
+	}
+	return fduo
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (fduo *FiscalDocumentUpdateOne) ClearAcceptedAt() *FiscalDocumentUpdateOne {
+	fduo.mutation.ClearAcceptedAt()
+	return fduo
+}
+
+// SetUserID sets the "user_id" field.
+func (fduo *FiscalDocumentUpdateOne) SetUserID(i int) *FiscalDocumentUpdateOne {
+	fduo.mutation.ResetUserID()
+	fduo.mutation.SetUserID(i)
+	return fduo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (fduo *FiscalDocumentUpdateOne) AddUserID(i int) *FiscalDocumentUpdateOne {
+	fduo.mutation.AddUserID(i)
+	return fduo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fduo *FiscalDocumentUpdateOne) SetUpdatedAt(t time.Time) *FiscalDocumentUpdateOne {
+	fduo.mutation.SetUpdatedAt(t)
+	return fduo
+}
+
+// Mutation returns the FiscalDocumentMutation object of the builder.
+func (fduo *FiscalDocumentUpdateOne) Mutation() *FiscalDocumentMutation {
+	return fduo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fduo *FiscalDocumentUpdateOne) Select(field string, fields ...string) *FiscalDocumentUpdateOne {
+	fduo.fields = append([]string{field}, fields...)
+	return fduo
+}
+
+// Save executes the query and returns the updated FiscalDocument entity.
+func (fduo *FiscalDocumentUpdateOne) Save(ctx context.Context) (*FiscalDocument, error) {
+	var (
+		err  error
+		node *FiscalDocument
+	)
+	fduo.defaults()
+	if len(fduo.hooks) == 0 {
+		node, err = fduo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FiscalDocumentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fduo.mutation = mutation
+			node, err = fduo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(fduo.hooks) - 1; i >= 0; i-- {
+			if fduo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = fduo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, fduo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*FiscalDocument)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from FiscalDocumentMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fduo *FiscalDocumentUpdateOne) SaveX(ctx context.Context) *FiscalDocument {
+	node, err := fduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fduo *FiscalDocumentUpdateOne) Exec(ctx context.Context) error {
+	_, err := fduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fduo *FiscalDocumentUpdateOne) ExecX(ctx context.Context) {
+	if err := fduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fduo *FiscalDocumentUpdateOne) defaults() {
+	if _, ok := fduo.mutation.UpdatedAt(); !ok {
+		v := fiscaldocument.UpdateDefaultUpdatedAt()
+		fduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (fduo *FiscalDocumentUpdateOne) sqlSave(ctx context.Context) (_node *FiscalDocument, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   fiscaldocument.Table,
+			Columns: fiscaldocument.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: fiscaldocument.FieldID,
+			},
+		},
+	}
+	id, ok := fduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FiscalDocument.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fiscaldocument.FieldID)
+		for _, f := range fields {
+			if !fiscaldocument.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != fiscaldocument.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fduo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldTenantID,
+		})
+	}
+	if value, ok := fduo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldTenantID,
+		})
+	}
+	if value, ok := fduo.mutation.InvoiceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldInvoiceID,
+		})
+	}
+	if value, ok := fduo.mutation.AddedInvoiceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldInvoiceID,
+		})
+	}
+	if value, ok := fduo.mutation.DocumentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldDocumentType,
+		})
+	}
+	if value, ok := fduo.mutation.Number(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldNumber,
+		})
+	}
+	if fduo.mutation.NumberCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldNumber,
+		})
+	}
+	if value, ok := fduo.mutation.ReferenceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldReferenceID,
+		})
+	}
+	if value, ok := fduo.mutation.AddedReferenceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldReferenceID,
+		})
+	}
+	if fduo.mutation.ReferenceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: fiscaldocument.FieldReferenceID,
+		})
+	}
+	if value, ok := fduo.mutation.DiscrepancyCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldDiscrepancyCode,
+		})
+	}
+	if fduo.mutation.DiscrepancyCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldDiscrepancyCode,
+		})
+	}
+	if value, ok := fduo.mutation.DiscrepancyReason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldDiscrepancyReason,
+		})
+	}
+	if fduo.mutation.DiscrepancyReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldDiscrepancyReason,
+		})
+	}
+	if value, ok := fduo.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldCurrency,
+		})
+	}
+	if value, ok := fduo.mutation.Total(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fiscaldocument.FieldTotal,
+		})
+	}
+	if value, ok := fduo.mutation.AddedTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fiscaldocument.FieldTotal,
+		})
+	}
+	if value, ok := fduo.mutation.Cufe(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldCufe,
+		})
+	}
+	if fduo.mutation.CufeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldCufe,
+		})
+	}
+	if value, ok := fduo.mutation.Digest(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldDigest,
+		})
+	}
+	if fduo.mutation.DigestCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldDigest,
+		})
+	}
+	if value, ok := fduo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldStatus,
+		})
+	}
+	if value, ok := fduo.mutation.Provider(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldProvider,
+		})
+	}
+	if fduo.mutation.ProviderCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldProvider,
+		})
+	}
+	if value, ok := fduo.mutation.TrackID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldTrackID,
+		})
+	}
+	if fduo.mutation.TrackIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldTrackID,
+		})
+	}
+	if value, ok := fduo.mutation.RejectionReasons(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: fiscaldocument.FieldRejectionReasons,
+		})
+	}
+	if fduo.mutation.RejectionReasonsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: fiscaldocument.FieldRejectionReasons,
+		})
+	}
+	if value, ok := fduo.mutation.XMLKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldXMLKey,
+		})
+	}
+	if fduo.mutation.XMLKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldXMLKey,
+		})
+	}
+	if value, ok := fduo.mutation.PdfKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fiscaldocument.FieldPdfKey,
+		})
+	}
+	if fduo.mutation.PdfKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: fiscaldocument.FieldPdfKey,
+		})
+	}
+	if value, ok := fduo.mutation.IssuedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fiscaldocument.FieldIssuedAt,
+		})
+	}
+	if value, ok := fduo.mutation.SubmittedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fiscaldocument.FieldSubmittedAt,
+		})
+	}
+	if fduo.mutation.SubmittedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: fiscaldocument.FieldSubmittedAt,
+		})
+	}
+	if value, ok := fduo.mutation.AcceptedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fiscaldocument.FieldAcceptedAt,
+		})
+	}
+	if fduo.mutation.AcceptedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: fiscaldocument.FieldAcceptedAt,
+		})
+	}
+	if value, ok := fduo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldUserID,
+		})
+	}
+	if value, ok := fduo.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fiscaldocument.FieldUserID,
+		})
+	}
+	if value, ok := fduo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fiscaldocument.FieldUpdatedAt,
+		})
+	}
+	_node = &FiscalDocument{config: fduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fiscaldocument.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
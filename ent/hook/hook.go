@@ -8,6 +8,162 @@ import (
 	"fmt"
 )
 
+// The AdjustmentReasonFunc type is an adapter to allow the use of ordinary
+// function as AdjustmentReason mutator.
+type AdjustmentReasonFunc func(context.Context, *ent.AdjustmentReasonMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdjustmentReasonFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.AdjustmentReasonMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdjustmentReasonMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The AuditLogFunc type is an adapter to allow the use of ordinary
+// function as AuditLog mutator.
+type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.AuditLogMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The BundleAssemblyFunc type is an adapter to allow the use of ordinary
+// function as BundleAssembly mutator.
+type BundleAssemblyFunc func(context.Context, *ent.BundleAssemblyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BundleAssemblyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.BundleAssemblyMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BundleAssemblyMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The BundleAssemblyLineFunc type is an adapter to allow the use of ordinary
+// function as BundleAssemblyLine mutator.
+type BundleAssemblyLineFunc func(context.Context, *ent.BundleAssemblyLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BundleAssemblyLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.BundleAssemblyLineMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BundleAssemblyLineMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The BundleComponentFunc type is an adapter to allow the use of ordinary
+// function as BundleComponent mutator.
+type BundleComponentFunc func(context.Context, *ent.BundleComponentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BundleComponentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.BundleComponentMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BundleComponentMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The CustomerFunc type is an adapter to allow the use of ordinary
+// function as Customer mutator.
+type CustomerFunc func(context.Context, *ent.CustomerMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CustomerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.CustomerMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CustomerMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ExchangeRateFunc type is an adapter to allow the use of ordinary
+// function as ExchangeRate mutator.
+type ExchangeRateFunc func(context.Context, *ent.ExchangeRateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExchangeRateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ExchangeRateMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExchangeRateMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The FiscalDocumentFunc type is an adapter to allow the use of ordinary
+// function as FiscalDocument mutator.
+type FiscalDocumentFunc func(context.Context, *ent.FiscalDocumentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FiscalDocumentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.FiscalDocumentMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FiscalDocumentMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The InventoryCostLayerFunc type is an adapter to allow the use of ordinary
+// function as InventoryCostLayer mutator.
+type InventoryCostLayerFunc func(context.Context, *ent.InventoryCostLayerMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InventoryCostLayerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.InventoryCostLayerMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InventoryCostLayerMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The InventoryCountFunc type is an adapter to allow the use of ordinary
+// function as InventoryCount mutator.
+type InventoryCountFunc func(context.Context, *ent.InventoryCountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InventoryCountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.InventoryCountMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InventoryCountMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The InventoryCountEntryFunc type is an adapter to allow the use of ordinary
+// function as InventoryCountEntry mutator.
+type InventoryCountEntryFunc func(context.Context, *ent.InventoryCountEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InventoryCountEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.InventoryCountEntryMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InventoryCountEntryMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The InventoryCountLineFunc type is an adapter to allow the use of ordinary
+// function as InventoryCountLine mutator.
+type InventoryCountLineFunc func(context.Context, *ent.InventoryCountLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InventoryCountLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.InventoryCountLineMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InventoryCountLineMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The InvoiceFunc type is an adapter to allow the use of ordinary
 // function as Invoice mutator.
 type InvoiceFunc func(context.Context, *ent.InvoiceMutation) (ent.Value, error)
@@ -34,6 +190,97 @@ func (f InvoiceItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return f(ctx, mv)
 }
 
+// The InvoiceItemComponentFunc type is an adapter to allow the use of ordinary
+// function as InvoiceItemComponent mutator.
+type InvoiceItemComponentFunc func(context.Context, *ent.InvoiceItemComponentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceItemComponentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.InvoiceItemComponentMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoiceItemComponentMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The InvoiceLotAllocationFunc type is an adapter to allow the use of ordinary
+// function as InvoiceLotAllocation mutator.
+type InvoiceLotAllocationFunc func(context.Context, *ent.InvoiceLotAllocationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceLotAllocationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.InvoiceLotAllocationMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoiceLotAllocationMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The InvoiceStatusEventFunc type is an adapter to allow the use of ordinary
+// function as InvoiceStatusEvent mutator.
+type InvoiceStatusEventFunc func(context.Context, *ent.InvoiceStatusEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceStatusEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.InvoiceStatusEventMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoiceStatusEventMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The InvoiceTaxFunc type is an adapter to allow the use of ordinary
+// function as InvoiceTax mutator.
+type InvoiceTaxFunc func(context.Context, *ent.InvoiceTaxMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceTaxFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.InvoiceTaxMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoiceTaxMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The NumberingSeriesFunc type is an adapter to allow the use of ordinary
+// function as NumberingSeries mutator.
+type NumberingSeriesFunc func(context.Context, *ent.NumberingSeriesMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NumberingSeriesFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.NumberingSeriesMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NumberingSeriesMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The PriceListFunc type is an adapter to allow the use of ordinary
+// function as PriceList mutator.
+type PriceListFunc func(context.Context, *ent.PriceListMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PriceListFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.PriceListMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PriceListMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The PriceListItemFunc type is an adapter to allow the use of ordinary
+// function as PriceListItem mutator.
+type PriceListItemFunc func(context.Context, *ent.PriceListItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PriceListItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.PriceListItemMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PriceListItemMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The ProductFunc type is an adapter to allow the use of ordinary
 // function as Product mutator.
 type ProductFunc func(context.Context, *ent.ProductMutation) (ent.Value, error)
@@ -47,6 +294,123 @@ func (f ProductFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return f(ctx, mv)
 }
 
+// The ProductBarcodeFunc type is an adapter to allow the use of ordinary
+// function as ProductBarcode mutator.
+type ProductBarcodeFunc func(context.Context, *ent.ProductBarcodeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProductBarcodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ProductBarcodeMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductBarcodeMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ProductFileFunc type is an adapter to allow the use of ordinary
+// function as ProductFile mutator.
+type ProductFileFunc func(context.Context, *ent.ProductFileMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProductFileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ProductFileMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductFileMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ProductLotFunc type is an adapter to allow the use of ordinary
+// function as ProductLot mutator.
+type ProductLotFunc func(context.Context, *ent.ProductLotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProductLotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ProductLotMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductLotMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ProductPriceFunc type is an adapter to allow the use of ordinary
+// function as ProductPrice mutator.
+type ProductPriceFunc func(context.Context, *ent.ProductPriceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProductPriceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ProductPriceMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductPriceMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ProductSerialFunc type is an adapter to allow the use of ordinary
+// function as ProductSerial mutator.
+type ProductSerialFunc func(context.Context, *ent.ProductSerialMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProductSerialFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ProductSerialMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductSerialMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ProductSerialEventFunc type is an adapter to allow the use of ordinary
+// function as ProductSerialEvent mutator.
+type ProductSerialEventFunc func(context.Context, *ent.ProductSerialEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProductSerialEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ProductSerialEventMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductSerialEventMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ProductUnitFunc type is an adapter to allow the use of ordinary
+// function as ProductUnit mutator.
+type ProductUnitFunc func(context.Context, *ent.ProductUnitMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProductUnitFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ProductUnitMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductUnitMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The PromotionFunc type is an adapter to allow the use of ordinary
+// function as Promotion mutator.
+type PromotionFunc func(context.Context, *ent.PromotionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PromotionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.PromotionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PromotionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The PromotionTargetFunc type is an adapter to allow the use of ordinary
+// function as PromotionTarget mutator.
+type PromotionTargetFunc func(context.Context, *ent.PromotionTargetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PromotionTargetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.PromotionTargetMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PromotionTargetMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The PurchaseInvoiceFunc type is an adapter to allow the use of ordinary
 // function as PurchaseInvoice mutator.
 type PurchaseInvoiceFunc func(context.Context, *ent.PurchaseInvoiceMutation) (ent.Value, error)
@@ -73,6 +437,32 @@ func (f PurchaseInvoiceItemFunc) Mutate(ctx context.Context, m ent.Mutation) (en
 	return f(ctx, mv)
 }
 
+// The StockAdjustmentFunc type is an adapter to allow the use of ordinary
+// function as StockAdjustment mutator.
+type StockAdjustmentFunc func(context.Context, *ent.StockAdjustmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StockAdjustmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.StockAdjustmentMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StockAdjustmentMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The StockAdjustmentLineFunc type is an adapter to allow the use of ordinary
+// function as StockAdjustmentLine mutator.
+type StockAdjustmentLineFunc func(context.Context, *ent.StockAdjustmentLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StockAdjustmentLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.StockAdjustmentLineMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StockAdjustmentLineMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The SupplierFunc type is an adapter to allow the use of ordinary
 // function as Supplier mutator.
 type SupplierFunc func(context.Context, *ent.SupplierMutation) (ent.Value, error)
@@ -99,6 +489,19 @@ func (f SupplierPaymentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return f(ctx, mv)
 }
 
+// The TaxRateFunc type is an adapter to allow the use of ordinary
+// function as TaxRate mutator.
+type TaxRateFunc func(context.Context, *ent.TaxRateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaxRateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.TaxRateMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaxRateMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The TenantFunc type is an adapter to allow the use of ordinary
 // function as Tenant mutator.
 type TenantFunc func(context.Context, *ent.TenantMutation) (ent.Value, error)
@@ -220,7 +623,6 @@ func HasFields(field string, fields ...string) Condition {
 // If executes the given hook under condition.
 //
 //	hook.If(ComputeAverage, And(HasFields(...), HasAddedFields(...)))
-//
 func If(hk ent.Hook, cond Condition) ent.Hook {
 	return func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
@@ -235,7 +637,6 @@ func If(hk ent.Hook, cond Condition) ent.Hook {
 // On executes the given hook only for the given operation.
 //
 //	hook.On(Log, ent.Delete|ent.Create)
-//
 func On(hk ent.Hook, op ent.Op) ent.Hook {
 	return If(hk, HasOp(op))
 }
@@ -243,7 +644,6 @@ func On(hk ent.Hook, op ent.Op) ent.Hook {
 // Unless skips the given hook only for the given operation.
 //
 //	hook.Unless(Log, ent.Update|ent.UpdateOne)
-//
 func Unless(hk ent.Hook, op ent.Op) ent.Hook {
 	return If(hk, Not(HasOp(op)))
 }
@@ -264,7 +664,6 @@ func FixedError(err error) ent.Hook {
 //			Reject(ent.Delete|ent.Update),
 //		}
 //	}
-//
 func Reject(op ent.Op) ent.Hook {
 	hk := FixedError(fmt.Errorf("%s operation is not allowed", op))
 	return On(hk, op)
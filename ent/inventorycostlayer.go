@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycostlayer"
+	"Veritasbackend/pkg/money"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// InventoryCostLayer is the model entity for the InventoryCostLayer schema.
+type InventoryCostLayer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// ID del producto
+	ProductID int `json:"product_id,omitempty"`
+	// Origen de la capa (opening, purchase, adjustment, inventory_count, assembly, invoice_cancel, return)
+	SourceType string `json:"source_type,omitempty"`
+	// ID del documento que originó la capa
+	SourceID int `json:"source_id,omitempty"`
+	// Unidades que ingresaron en la capa
+	Quantity float64 `json:"quantity,omitempty"`
+	// Unidades de la capa que aún no se han consumido
+	Remaining float64 `json:"remaining,omitempty"`
+	// Costo unitario de ingreso
+	UnitCost money.Amount `json:"unit_cost,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InventoryCostLayer) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case inventorycostlayer.FieldUnitCost:
+			values[i] = new(money.Amount)
+		case inventorycostlayer.FieldQuantity, inventorycostlayer.FieldRemaining:
+			values[i] = new(sql.NullFloat64)
+		case inventorycostlayer.FieldID, inventorycostlayer.FieldTenantID, inventorycostlayer.FieldProductID, inventorycostlayer.FieldSourceID:
+			values[i] = new(sql.NullInt64)
+		case inventorycostlayer.FieldSourceType:
+			values[i] = new(sql.NullString)
+		case inventorycostlayer.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type InventoryCostLayer", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InventoryCostLayer fields.
+func (icl *InventoryCostLayer) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case inventorycostlayer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			icl.ID = int(value.Int64)
+		case inventorycostlayer.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				icl.TenantID = int(value.Int64)
+			}
+		case inventorycostlayer.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				icl.ProductID = int(value.Int64)
+			}
+		case inventorycostlayer.FieldSourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_type", values[i])
+			} else if value.Valid {
+				icl.SourceType = value.String
+			}
+		case inventorycostlayer.FieldSourceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				icl.SourceID = int(value.Int64)
+			}
+		case inventorycostlayer.FieldQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				icl.Quantity = value.Float64
+			}
+		case inventorycostlayer.FieldRemaining:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field remaining", values[i])
+			} else if value.Valid {
+				icl.Remaining = value.Float64
+			}
+		case inventorycostlayer.FieldUnitCost:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_cost", values[i])
+			} else if value != nil {
+				icl.UnitCost = *value
+			}
+		case inventorycostlayer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				icl.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this InventoryCostLayer.
+// Note that you need to call InventoryCostLayer.Unwrap() before calling this method if this InventoryCostLayer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (icl *InventoryCostLayer) Update() *InventoryCostLayerUpdateOne {
+	return (&InventoryCostLayerClient{config: icl.config}).UpdateOne(icl)
+}
+
+// Unwrap unwraps the InventoryCostLayer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (icl *InventoryCostLayer) Unwrap() *InventoryCostLayer {
+	_tx, ok := icl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InventoryCostLayer is not a transactional entity")
+	}
+	icl.config.driver = _tx.drv
+	return icl
+}
+
+// String implements the fmt.Stringer.
+func (icl *InventoryCostLayer) String() string {
+	var builder strings.Builder
+	builder.WriteString("InventoryCostLayer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", icl.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", icl.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", icl.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("source_type=")
+	builder.WriteString(icl.SourceType)
+	builder.WriteString(", ")
+	builder.WriteString("source_id=")
+	builder.WriteString(fmt.Sprintf("%v", icl.SourceID))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", icl.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("remaining=")
+	builder.WriteString(fmt.Sprintf("%v", icl.Remaining))
+	builder.WriteString(", ")
+	builder.WriteString("unit_cost=")
+	builder.WriteString(fmt.Sprintf("%v", icl.UnitCost))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(icl.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InventoryCostLayers is a parsable slice of InventoryCostLayer.
+type InventoryCostLayers []*InventoryCostLayer
+
+func (icl InventoryCostLayers) config(cfg config) {
+	for _i := range icl {
+		icl[_i].config = cfg
+	}
+}
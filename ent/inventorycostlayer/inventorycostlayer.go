@@ -0,0 +1,66 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventorycostlayer
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the inventorycostlayer type in the database.
+	Label = "inventory_cost_layer"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldSourceType holds the string denoting the source_type field in the database.
+	FieldSourceType = "source_type"
+	// FieldSourceID holds the string denoting the source_id field in the database.
+	FieldSourceID = "source_id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldRemaining holds the string denoting the remaining field in the database.
+	FieldRemaining = "remaining"
+	// FieldUnitCost holds the string denoting the unit_cost field in the database.
+	FieldUnitCost = "unit_cost"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the inventorycostlayer in the database.
+	Table = "inventory_cost_layers"
+)
+
+// Columns holds all SQL columns for inventorycostlayer fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldProductID,
+	FieldSourceType,
+	FieldSourceID,
+	FieldQuantity,
+	FieldRemaining,
+	FieldUnitCost,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(float64) error
+	// RemainingValidator is a validator for the "remaining" field. It is called by the builders before save.
+	RemainingValidator func(float64) error
+	// UnitCostValidator is a validator for the "unit_cost" field. It is called by the builders before save.
+	UnitCostValidator func(int64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,827 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventorycostlayer
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// SourceType applies equality check predicate on the "source_type" field. It's identical to SourceTypeEQ.
+func SourceType(v string) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSourceType), v))
+	})
+}
+
+// SourceID applies equality check predicate on the "source_id" field. It's identical to SourceIDEQ.
+func SourceID(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSourceID), v))
+	})
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v float64) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// Remaining applies equality check predicate on the "remaining" field. It's identical to RemainingEQ.
+func Remaining(v float64) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRemaining), v))
+	})
+}
+
+// UnitCost applies equality check predicate on the "unit_cost" field. It's identical to UnitCostEQ.
+func UnitCost(v money.Amount) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProductID), v))
+	})
+}
+
+// SourceTypeEQ applies the EQ predicate on the "source_type" field.
+func SourceTypeEQ(v string) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSourceType), v))
+	})
+}
+
+// SourceTypeNEQ applies the NEQ predicate on the "source_type" field.
+func SourceTypeNEQ(v string) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSourceType), v))
+	})
+}
+
+// SourceTypeIn applies the In predicate on the "source_type" field.
+func SourceTypeIn(vs ...string) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSourceType), v...))
+	})
+}
+
+// SourceTypeNotIn applies the NotIn predicate on the "source_type" field.
+func SourceTypeNotIn(vs ...string) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSourceType), v...))
+	})
+}
+
+// SourceTypeGT applies the GT predicate on the "source_type" field.
+func SourceTypeGT(v string) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSourceType), v))
+	})
+}
+
+// SourceTypeGTE applies the GTE predicate on the "source_type" field.
+func SourceTypeGTE(v string) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSourceType), v))
+	})
+}
+
+// SourceTypeLT applies the LT predicate on the "source_type" field.
+func SourceTypeLT(v string) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSourceType), v))
+	})
+}
+
+// SourceTypeLTE applies the LTE predicate on the "source_type" field.
+func SourceTypeLTE(v string) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSourceType), v))
+	})
+}
+
+// SourceTypeContains applies the Contains predicate on the "source_type" field.
+func SourceTypeContains(v string) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSourceType), v))
+	})
+}
+
+// SourceTypeHasPrefix applies the HasPrefix predicate on the "source_type" field.
+func SourceTypeHasPrefix(v string) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSourceType), v))
+	})
+}
+
+// SourceTypeHasSuffix applies the HasSuffix predicate on the "source_type" field.
+func SourceTypeHasSuffix(v string) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSourceType), v))
+	})
+}
+
+// SourceTypeEqualFold applies the EqualFold predicate on the "source_type" field.
+func SourceTypeEqualFold(v string) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSourceType), v))
+	})
+}
+
+// SourceTypeContainsFold applies the ContainsFold predicate on the "source_type" field.
+func SourceTypeContainsFold(v string) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSourceType), v))
+	})
+}
+
+// SourceIDEQ applies the EQ predicate on the "source_id" field.
+func SourceIDEQ(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSourceID), v))
+	})
+}
+
+// SourceIDNEQ applies the NEQ predicate on the "source_id" field.
+func SourceIDNEQ(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSourceID), v))
+	})
+}
+
+// SourceIDIn applies the In predicate on the "source_id" field.
+func SourceIDIn(vs ...int) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSourceID), v...))
+	})
+}
+
+// SourceIDNotIn applies the NotIn predicate on the "source_id" field.
+func SourceIDNotIn(vs ...int) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSourceID), v...))
+	})
+}
+
+// SourceIDGT applies the GT predicate on the "source_id" field.
+func SourceIDGT(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSourceID), v))
+	})
+}
+
+// SourceIDGTE applies the GTE predicate on the "source_id" field.
+func SourceIDGTE(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSourceID), v))
+	})
+}
+
+// SourceIDLT applies the LT predicate on the "source_id" field.
+func SourceIDLT(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSourceID), v))
+	})
+}
+
+// SourceIDLTE applies the LTE predicate on the "source_id" field.
+func SourceIDLTE(v int) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSourceID), v))
+	})
+}
+
+// SourceIDIsNil applies the IsNil predicate on the "source_id" field.
+func SourceIDIsNil() predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSourceID)))
+	})
+}
+
+// SourceIDNotNil applies the NotNil predicate on the "source_id" field.
+func SourceIDNotNil() predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSourceID)))
+	})
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v float64) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v float64) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...float64) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...float64) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v float64) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v float64) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v float64) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v float64) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldQuantity), v))
+	})
+}
+
+// RemainingEQ applies the EQ predicate on the "remaining" field.
+func RemainingEQ(v float64) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRemaining), v))
+	})
+}
+
+// RemainingNEQ applies the NEQ predicate on the "remaining" field.
+func RemainingNEQ(v float64) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRemaining), v))
+	})
+}
+
+// RemainingIn applies the In predicate on the "remaining" field.
+func RemainingIn(vs ...float64) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRemaining), v...))
+	})
+}
+
+// RemainingNotIn applies the NotIn predicate on the "remaining" field.
+func RemainingNotIn(vs ...float64) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRemaining), v...))
+	})
+}
+
+// RemainingGT applies the GT predicate on the "remaining" field.
+func RemainingGT(v float64) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRemaining), v))
+	})
+}
+
+// RemainingGTE applies the GTE predicate on the "remaining" field.
+func RemainingGTE(v float64) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRemaining), v))
+	})
+}
+
+// RemainingLT applies the LT predicate on the "remaining" field.
+func RemainingLT(v float64) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRemaining), v))
+	})
+}
+
+// RemainingLTE applies the LTE predicate on the "remaining" field.
+func RemainingLTE(v float64) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRemaining), v))
+	})
+}
+
+// UnitCostEQ applies the EQ predicate on the "unit_cost" field.
+func UnitCostEQ(v money.Amount) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostNEQ applies the NEQ predicate on the "unit_cost" field.
+func UnitCostNEQ(v money.Amount) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostIn applies the In predicate on the "unit_cost" field.
+func UnitCostIn(vs ...money.Amount) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUnitCost), v...))
+	})
+}
+
+// UnitCostNotIn applies the NotIn predicate on the "unit_cost" field.
+func UnitCostNotIn(vs ...money.Amount) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUnitCost), v...))
+	})
+}
+
+// UnitCostGT applies the GT predicate on the "unit_cost" field.
+func UnitCostGT(v money.Amount) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostGTE applies the GTE predicate on the "unit_cost" field.
+func UnitCostGTE(v money.Amount) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostLT applies the LT predicate on the "unit_cost" field.
+func UnitCostLT(v money.Amount) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostLTE applies the LTE predicate on the "unit_cost" field.
+func UnitCostLTE(v money.Amount) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUnitCost), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InventoryCostLayer {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InventoryCostLayer) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InventoryCostLayer) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InventoryCostLayer) predicate.InventoryCostLayer {
+	return predicate.InventoryCostLayer(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
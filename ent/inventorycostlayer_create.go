@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycostlayer"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryCostLayerCreate is the builder for creating a InventoryCostLayer entity.
+type InventoryCostLayerCreate struct {
+	config
+	mutation *InventoryCostLayerMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (iclc *InventoryCostLayerCreate) SetTenantID(i int) *InventoryCostLayerCreate {
+	iclc.mutation.SetTenantID(i)
+	return iclc
+}
+
+// SetProductID sets the "product_id" field.
+func (iclc *InventoryCostLayerCreate) SetProductID(i int) *InventoryCostLayerCreate {
+	iclc.mutation.SetProductID(i)
+	return iclc
+}
+
+// SetSourceType sets the "source_type" field.
+func (iclc *InventoryCostLayerCreate) SetSourceType(s string) *InventoryCostLayerCreate {
+	iclc.mutation.SetSourceType(s)
+	return iclc
+}
+
+// SetSourceID sets the "source_id" field.
+func (iclc *InventoryCostLayerCreate) SetSourceID(i int) *InventoryCostLayerCreate {
+	iclc.mutation.SetSourceID(i)
+	return iclc
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (iclc *InventoryCostLayerCreate) SetNillableSourceID(i *int) *InventoryCostLayerCreate {
+	if i != nil {
+		iclc.SetSourceID(*i)
+	}
+	return iclc
+}
+
+// SetQuantity sets the "quantity" field.
+func (iclc *InventoryCostLayerCreate) SetQuantity(f float64) *InventoryCostLayerCreate {
+	iclc.mutation.SetQuantity(f)
+	return iclc
+}
+
+// SetRemaining sets the "remaining" field.
+func (iclc *InventoryCostLayerCreate) SetRemaining(f float64) *InventoryCostLayerCreate {
+	iclc.mutation.SetRemaining(f)
+	return iclc
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (iclc *InventoryCostLayerCreate) SetUnitCost(m money.Amount) *InventoryCostLayerCreate {
+	iclc.mutation.SetUnitCost(m)
+	return iclc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iclc *InventoryCostLayerCreate) SetCreatedAt(t time.Time) *InventoryCostLayerCreate {
+	iclc.mutation.SetCreatedAt(t)
+	return iclc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iclc *InventoryCostLayerCreate) SetNillableCreatedAt(t *time.Time) *InventoryCostLayerCreate {
+	if t != nil {
+		iclc.SetCreatedAt(*t)
+	}
+	return iclc
+}
+
+// Mutation returns the InventoryCostLayerMutation object of the builder.
+func (iclc *InventoryCostLayerCreate) Mutation() *InventoryCostLayerMutation {
+	return iclc.mutation
+}
+
+// Save creates the InventoryCostLayer in the database.
+func (iclc *InventoryCostLayerCreate) Save(ctx context.Context) (*InventoryCostLayer, error) {
+	var (
+		err  error
+		node *InventoryCostLayer
+	)
+	iclc.defaults()
+	if len(iclc.hooks) == 0 {
+		if err = iclc.check(); err != nil {
+			return nil, err
+		}
+		node, err = iclc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InventoryCostLayerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iclc.check(); err != nil {
+				return nil, err
+			}
+			iclc.mutation = mutation
+			if node, err = iclc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iclc.hooks) - 1; i >= 0; i-- {
+			if iclc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iclc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, iclc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InventoryCostLayer)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InventoryCostLayerMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iclc *InventoryCostLayerCreate) SaveX(ctx context.Context) *InventoryCostLayer {
+	v, err := iclc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iclc *InventoryCostLayerCreate) Exec(ctx context.Context) error {
+	_, err := iclc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iclc *InventoryCostLayerCreate) ExecX(ctx context.Context) {
+	if err := iclc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iclc *InventoryCostLayerCreate) defaults() {
+	if _, ok := iclc.mutation.CreatedAt(); !ok {
+		v := inventorycostlayer.DefaultCreatedAt()
+		iclc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iclc *InventoryCostLayerCreate) check() error {
+	if _, ok := iclc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "InventoryCostLayer.tenant_id"`)}
+	}
+	if _, ok := iclc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "InventoryCostLayer.product_id"`)}
+	}
+	if _, ok := iclc.mutation.SourceType(); !ok {
+		return &ValidationError{Name: "source_type", err: errors.New(`ent: missing required field "InventoryCostLayer.source_type"`)}
+	}
+	if _, ok := iclc.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "InventoryCostLayer.quantity"`)}
+	}
+	if v, ok := iclc.mutation.Quantity(); ok {
+		if err := inventorycostlayer.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "InventoryCostLayer.quantity": %w`, err)}
+		}
+	}
+	if _, ok := iclc.mutation.Remaining(); !ok {
+		return &ValidationError{Name: "remaining", err: errors.New(`ent: missing required field "InventoryCostLayer.remaining"`)}
+	}
+	if v, ok := iclc.mutation.Remaining(); ok {
+		if err := inventorycostlayer.RemainingValidator(v); err != nil {
+			return &ValidationError{Name: "remaining", err: fmt.Errorf(`ent: validator failed for field "InventoryCostLayer.remaining": %w`, err)}
+		}
+	}
+	if _, ok := iclc.mutation.UnitCost(); !ok {
+		return &ValidationError{Name: "unit_cost", err: errors.New(`ent: missing required field "InventoryCostLayer.unit_cost"`)}
+	}
+	if v, ok := iclc.mutation.UnitCost(); ok {
+		if err := inventorycostlayer.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "InventoryCostLayer.unit_cost": %w`, err)}
+		}
+	}
+	if _, ok := iclc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InventoryCostLayer.created_at"`)}
+	}
+	return nil
+}
+
+func (iclc *InventoryCostLayerCreate) sqlSave(ctx context.Context) (*InventoryCostLayer, error) {
+	_node, _spec := iclc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iclc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (iclc *InventoryCostLayerCreate) createSpec() (*InventoryCostLayer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InventoryCostLayer{config: iclc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: inventorycostlayer.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycostlayer.FieldID,
+			},
+		}
+	)
+	if value, ok := iclc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycostlayer.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := iclc.mutation.ProductID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycostlayer.FieldProductID,
+		})
+		_node.ProductID = value
+	}
+	if value, ok := iclc.mutation.SourceType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: inventorycostlayer.FieldSourceType,
+		})
+		_node.SourceType = value
+	}
+	if value, ok := iclc.mutation.SourceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycostlayer.FieldSourceID,
+		})
+		_node.SourceID = value
+	}
+	if value, ok := iclc.mutation.Quantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycostlayer.FieldQuantity,
+		})
+		_node.Quantity = value
+	}
+	if value, ok := iclc.mutation.Remaining(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycostlayer.FieldRemaining,
+		})
+		_node.Remaining = value
+	}
+	if value, ok := iclc.mutation.UnitCost(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: inventorycostlayer.FieldUnitCost,
+		})
+		_node.UnitCost = value
+	}
+	if value, ok := iclc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: inventorycostlayer.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// InventoryCostLayerCreateBulk is the builder for creating many InventoryCostLayer entities in bulk.
+type InventoryCostLayerCreateBulk struct {
+	config
+	builders []*InventoryCostLayerCreate
+}
+
+// Save creates the InventoryCostLayer entities in the database.
+func (iclcb *InventoryCostLayerCreateBulk) Save(ctx context.Context) ([]*InventoryCostLayer, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(iclcb.builders))
+	nodes := make([]*InventoryCostLayer, len(iclcb.builders))
+	mutators := make([]Mutator, len(iclcb.builders))
+	for i := range iclcb.builders {
+		func(i int, root context.Context) {
+			builder := iclcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InventoryCostLayerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iclcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iclcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iclcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iclcb *InventoryCostLayerCreateBulk) SaveX(ctx context.Context) []*InventoryCostLayer {
+	v, err := iclcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iclcb *InventoryCostLayerCreateBulk) Exec(ctx context.Context) error {
+	_, err := iclcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iclcb *InventoryCostLayerCreateBulk) ExecX(ctx context.Context) {
+	if err := iclcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
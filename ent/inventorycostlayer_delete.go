@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycostlayer"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryCostLayerDelete is the builder for deleting a InventoryCostLayer entity.
+type InventoryCostLayerDelete struct {
+	config
+	hooks    []Hook
+	mutation *InventoryCostLayerMutation
+}
+
+// Where appends a list predicates to the InventoryCostLayerDelete builder.
+func (icld *InventoryCostLayerDelete) Where(ps ...predicate.InventoryCostLayer) *InventoryCostLayerDelete {
+	icld.mutation.Where(ps...)
+	return icld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (icld *InventoryCostLayerDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(icld.hooks) == 0 {
+		affected, err = icld.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InventoryCostLayerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			icld.mutation = mutation
+			affected, err = icld.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(icld.hooks) - 1; i >= 0; i-- {
+			if icld.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = icld.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, icld.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icld *InventoryCostLayerDelete) ExecX(ctx context.Context) int {
+	n, err := icld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (icld *InventoryCostLayerDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: inventorycostlayer.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycostlayer.FieldID,
+			},
+		},
+	}
+	if ps := icld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, icld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// InventoryCostLayerDeleteOne is the builder for deleting a single InventoryCostLayer entity.
+type InventoryCostLayerDeleteOne struct {
+	icld *InventoryCostLayerDelete
+}
+
+// Exec executes the deletion query.
+func (icldo *InventoryCostLayerDeleteOne) Exec(ctx context.Context) error {
+	n, err := icldo.icld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{inventorycostlayer.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icldo *InventoryCostLayerDeleteOne) ExecX(ctx context.Context) {
+	icldo.icld.ExecX(ctx)
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycostlayer"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryCostLayerQuery is the builder for querying InventoryCostLayer entities.
+type InventoryCostLayerQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.InventoryCostLayer
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InventoryCostLayerQuery builder.
+func (iclq *InventoryCostLayerQuery) Where(ps ...predicate.InventoryCostLayer) *InventoryCostLayerQuery {
+	iclq.predicates = append(iclq.predicates, ps...)
+	return iclq
+}
+
+// Limit adds a limit step to the query.
+func (iclq *InventoryCostLayerQuery) Limit(limit int) *InventoryCostLayerQuery {
+	iclq.limit = &limit
+	return iclq
+}
+
+// Offset adds an offset step to the query.
+func (iclq *InventoryCostLayerQuery) Offset(offset int) *InventoryCostLayerQuery {
+	iclq.offset = &offset
+	return iclq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iclq *InventoryCostLayerQuery) Unique(unique bool) *InventoryCostLayerQuery {
+	iclq.unique = &unique
+	return iclq
+}
+
+// Order adds an order step to the query.
+func (iclq *InventoryCostLayerQuery) Order(o ...OrderFunc) *InventoryCostLayerQuery {
+	iclq.order = append(iclq.order, o...)
+	return iclq
+}
+
+// First returns the first InventoryCostLayer entity from the query.
+// Returns a *NotFoundError when no InventoryCostLayer was found.
+func (iclq *InventoryCostLayerQuery) First(ctx context.Context) (*InventoryCostLayer, error) {
+	nodes, err := iclq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{inventorycostlayer.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iclq *InventoryCostLayerQuery) FirstX(ctx context.Context) *InventoryCostLayer {
+	node, err := iclq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InventoryCostLayer ID from the query.
+// Returns a *NotFoundError when no InventoryCostLayer ID was found.
+func (iclq *InventoryCostLayerQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iclq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{inventorycostlayer.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iclq *InventoryCostLayerQuery) FirstIDX(ctx context.Context) int {
+	id, err := iclq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InventoryCostLayer entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InventoryCostLayer entity is found.
+// Returns a *NotFoundError when no InventoryCostLayer entities are found.
+func (iclq *InventoryCostLayerQuery) Only(ctx context.Context) (*InventoryCostLayer, error) {
+	nodes, err := iclq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{inventorycostlayer.Label}
+	default:
+		return nil, &NotSingularError{inventorycostlayer.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iclq *InventoryCostLayerQuery) OnlyX(ctx context.Context) *InventoryCostLayer {
+	node, err := iclq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InventoryCostLayer ID in the query.
+// Returns a *NotSingularError when more than one InventoryCostLayer ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iclq *InventoryCostLayerQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iclq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{inventorycostlayer.Label}
+	default:
+		err = &NotSingularError{inventorycostlayer.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iclq *InventoryCostLayerQuery) OnlyIDX(ctx context.Context) int {
+	id, err := iclq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InventoryCostLayers.
+func (iclq *InventoryCostLayerQuery) All(ctx context.Context) ([]*InventoryCostLayer, error) {
+	if err := iclq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return iclq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iclq *InventoryCostLayerQuery) AllX(ctx context.Context) []*InventoryCostLayer {
+	nodes, err := iclq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InventoryCostLayer IDs.
+func (iclq *InventoryCostLayerQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := iclq.Select(inventorycostlayer.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iclq *InventoryCostLayerQuery) IDsX(ctx context.Context) []int {
+	ids, err := iclq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iclq *InventoryCostLayerQuery) Count(ctx context.Context) (int, error) {
+	if err := iclq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return iclq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iclq *InventoryCostLayerQuery) CountX(ctx context.Context) int {
+	count, err := iclq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iclq *InventoryCostLayerQuery) Exist(ctx context.Context) (bool, error) {
+	if err := iclq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return iclq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iclq *InventoryCostLayerQuery) ExistX(ctx context.Context) bool {
+	exist, err := iclq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InventoryCostLayerQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iclq *InventoryCostLayerQuery) Clone() *InventoryCostLayerQuery {
+	if iclq == nil {
+		return nil
+	}
+	return &InventoryCostLayerQuery{
+		config:     iclq.config,
+		limit:      iclq.limit,
+		offset:     iclq.offset,
+		order:      append([]OrderFunc{}, iclq.order...),
+		predicates: append([]predicate.InventoryCostLayer{}, iclq.predicates...),
+		// clone intermediate query.
+		sql:    iclq.sql.Clone(),
+		path:   iclq.path,
+		unique: iclq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InventoryCostLayer.Query().
+//		GroupBy(inventorycostlayer.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (iclq *InventoryCostLayerQuery) GroupBy(field string, fields ...string) *InventoryCostLayerGroupBy {
+	grbuild := &InventoryCostLayerGroupBy{config: iclq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := iclq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return iclq.sqlQuery(ctx), nil
+	}
+	grbuild.label = inventorycostlayer.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.InventoryCostLayer.Query().
+//		Select(inventorycostlayer.FieldTenantID).
+//		Scan(ctx, &v)
+func (iclq *InventoryCostLayerQuery) Select(fields ...string) *InventoryCostLayerSelect {
+	iclq.fields = append(iclq.fields, fields...)
+	selbuild := &InventoryCostLayerSelect{InventoryCostLayerQuery: iclq}
+	selbuild.label = inventorycostlayer.Label
+	selbuild.flds, selbuild.scan = &iclq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (iclq *InventoryCostLayerQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range iclq.fields {
+		if !inventorycostlayer.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if iclq.path != nil {
+		prev, err := iclq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iclq.sql = prev
+	}
+	return nil
+}
+
+func (iclq *InventoryCostLayerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InventoryCostLayer, error) {
+	var (
+		nodes = []*InventoryCostLayer{}
+		_spec = iclq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*InventoryCostLayer).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &InventoryCostLayer{config: iclq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(iclq.modifiers) > 0 {
+		_spec.Modifiers = iclq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iclq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (iclq *InventoryCostLayerQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iclq.querySpec()
+	if len(iclq.modifiers) > 0 {
+		_spec.Modifiers = iclq.modifiers
+	}
+	_spec.Node.Columns = iclq.fields
+	if len(iclq.fields) > 0 {
+		_spec.Unique = iclq.unique != nil && *iclq.unique
+	}
+	return sqlgraph.CountNodes(ctx, iclq.driver, _spec)
+}
+
+func (iclq *InventoryCostLayerQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := iclq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (iclq *InventoryCostLayerQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   inventorycostlayer.Table,
+			Columns: inventorycostlayer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycostlayer.FieldID,
+			},
+		},
+		From:   iclq.sql,
+		Unique: true,
+	}
+	if unique := iclq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := iclq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventorycostlayer.FieldID)
+		for i := range fields {
+			if fields[i] != inventorycostlayer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := iclq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iclq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iclq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iclq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iclq *InventoryCostLayerQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iclq.driver.Dialect())
+	t1 := builder.Table(inventorycostlayer.Table)
+	columns := iclq.fields
+	if len(columns) == 0 {
+		columns = inventorycostlayer.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iclq.sql != nil {
+		selector = iclq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iclq.unique != nil && *iclq.unique {
+		selector.Distinct()
+	}
+	for _, m := range iclq.modifiers {
+		m(selector)
+	}
+	for _, p := range iclq.predicates {
+		p(selector)
+	}
+	for _, p := range iclq.order {
+		p(selector)
+	}
+	if offset := iclq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iclq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (iclq *InventoryCostLayerQuery) ForUpdate(opts ...sql.LockOption) *InventoryCostLayerQuery {
+	if iclq.driver.Dialect() == dialect.Postgres {
+		iclq.Unique(false)
+	}
+	iclq.modifiers = append(iclq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return iclq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (iclq *InventoryCostLayerQuery) ForShare(opts ...sql.LockOption) *InventoryCostLayerQuery {
+	if iclq.driver.Dialect() == dialect.Postgres {
+		iclq.Unique(false)
+	}
+	iclq.modifiers = append(iclq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return iclq
+}
+
+// InventoryCostLayerGroupBy is the group-by builder for InventoryCostLayer entities.
+type InventoryCostLayerGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iclgb *InventoryCostLayerGroupBy) Aggregate(fns ...AggregateFunc) *InventoryCostLayerGroupBy {
+	iclgb.fns = append(iclgb.fns, fns...)
+	return iclgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (iclgb *InventoryCostLayerGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := iclgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	iclgb.sql = query
+	return iclgb.sqlScan(ctx, v)
+}
+
+func (iclgb *InventoryCostLayerGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range iclgb.fields {
+		if !inventorycostlayer.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := iclgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iclgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (iclgb *InventoryCostLayerGroupBy) sqlQuery() *sql.Selector {
+	selector := iclgb.sql.Select()
+	aggregation := make([]string, 0, len(iclgb.fns))
+	for _, fn := range iclgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(iclgb.fields)+len(iclgb.fns))
+		for _, f := range iclgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(iclgb.fields...)...)
+}
+
+// InventoryCostLayerSelect is the builder for selecting fields of InventoryCostLayer entities.
+type InventoryCostLayerSelect struct {
+	*InventoryCostLayerQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (icls *InventoryCostLayerSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := icls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	icls.sql = icls.InventoryCostLayerQuery.sqlQuery(ctx)
+	return icls.sqlScan(ctx, v)
+}
+
+func (icls *InventoryCostLayerSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := icls.sql.Query()
+	if err := icls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
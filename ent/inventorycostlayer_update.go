@@ -0,0 +1,688 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycostlayer"
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryCostLayerUpdate is the builder for updating InventoryCostLayer entities.
+type InventoryCostLayerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InventoryCostLayerMutation
+}
+
+// Where appends a list predicates to the InventoryCostLayerUpdate builder.
+func (iclu *InventoryCostLayerUpdate) Where(ps ...predicate.InventoryCostLayer) *InventoryCostLayerUpdate {
+	iclu.mutation.Where(ps...)
+	return iclu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (iclu *InventoryCostLayerUpdate) SetTenantID(i int) *InventoryCostLayerUpdate {
+	iclu.mutation.ResetTenantID()
+	iclu.mutation.SetTenantID(i)
+	return iclu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (iclu *InventoryCostLayerUpdate) AddTenantID(i int) *InventoryCostLayerUpdate {
+	iclu.mutation.AddTenantID(i)
+	return iclu
+}
+
+// SetProductID sets the "product_id" field.
+func (iclu *InventoryCostLayerUpdate) SetProductID(i int) *InventoryCostLayerUpdate {
+	iclu.mutation.ResetProductID()
+	iclu.mutation.SetProductID(i)
+	return iclu
+}
+
+// AddProductID adds i to the "product_id" field.
+func (iclu *InventoryCostLayerUpdate) AddProductID(i int) *InventoryCostLayerUpdate {
+	iclu.mutation.AddProductID(i)
+	return iclu
+}
+
+// SetSourceType sets the "source_type" field.
+func (iclu *InventoryCostLayerUpdate) SetSourceType(s string) *InventoryCostLayerUpdate {
+	iclu.mutation.SetSourceType(s)
+	return iclu
+}
+
+// SetSourceID sets the "source_id" field.
+func (iclu *InventoryCostLayerUpdate) SetSourceID(i int) *InventoryCostLayerUpdate {
+	iclu.mutation.ResetSourceID()
+	iclu.mutation.SetSourceID(i)
+	return iclu
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (iclu *InventoryCostLayerUpdate) SetNillableSourceID(i *int) *InventoryCostLayerUpdate {
+	if i != nil {
+		iclu.SetSourceID(*i)
+	}
+	return iclu
+}
+
+// AddSourceID adds i to the "source_id" field.
+func (iclu *InventoryCostLayerUpdate) AddSourceID(i int) *InventoryCostLayerUpdate {
+	iclu.mutation.AddSourceID(i)
+	return iclu
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (iclu *InventoryCostLayerUpdate) ClearSourceID() *InventoryCostLayerUpdate {
+	iclu.mutation.ClearSourceID()
+	return iclu
+}
+
+// SetQuantity sets the "quantity" field.
+func (iclu *InventoryCostLayerUpdate) SetQuantity(f float64) *InventoryCostLayerUpdate {
+	iclu.mutation.ResetQuantity()
+	iclu.mutation.SetQuantity(f)
+	return iclu
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (iclu *InventoryCostLayerUpdate) AddQuantity(f float64) *InventoryCostLayerUpdate {
+	iclu.mutation.AddQuantity(f)
+	return iclu
+}
+
+// SetRemaining sets the "remaining" field.
+func (iclu *InventoryCostLayerUpdate) SetRemaining(f float64) *InventoryCostLayerUpdate {
+	iclu.mutation.ResetRemaining()
+	iclu.mutation.SetRemaining(f)
+	return iclu
+}
+
+// AddRemaining adds f to the "remaining" field.
+func (iclu *InventoryCostLayerUpdate) AddRemaining(f float64) *InventoryCostLayerUpdate {
+	iclu.mutation.AddRemaining(f)
+	return iclu
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (iclu *InventoryCostLayerUpdate) SetUnitCost(m money.Amount) *InventoryCostLayerUpdate {
+	iclu.mutation.ResetUnitCost()
+	iclu.mutation.SetUnitCost(m)
+	return iclu
+}
+
+// AddUnitCost adds m to the "unit_cost" field.
+func (iclu *InventoryCostLayerUpdate) AddUnitCost(m money.Amount) *InventoryCostLayerUpdate {
+	iclu.mutation.AddUnitCost(m)
+	return iclu
+}
+
+// Mutation returns the InventoryCostLayerMutation object of the builder.
+func (iclu *InventoryCostLayerUpdate) Mutation() *InventoryCostLayerMutation {
+	return iclu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iclu *InventoryCostLayerUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(iclu.hooks) == 0 {
+		if err = iclu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = iclu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InventoryCostLayerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iclu.check(); err != nil {
+				return 0, err
+			}
+			iclu.mutation = mutation
+			affected, err = iclu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iclu.hooks) - 1; i >= 0; i-- {
+			if iclu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iclu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iclu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iclu *InventoryCostLayerUpdate) SaveX(ctx context.Context) int {
+	affected, err := iclu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iclu *InventoryCostLayerUpdate) Exec(ctx context.Context) error {
+	_, err := iclu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iclu *InventoryCostLayerUpdate) ExecX(ctx context.Context) {
+	if err := iclu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iclu *InventoryCostLayerUpdate) check() error {
+	if v, ok := iclu.mutation.Quantity(); ok {
+		if err := inventorycostlayer.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "InventoryCostLayer.quantity": %w`, err)}
+		}
+	}
+	if v, ok := iclu.mutation.Remaining(); ok {
+		if err := inventorycostlayer.RemainingValidator(v); err != nil {
+			return &ValidationError{Name: "remaining", err: fmt.Errorf(`ent: validator failed for field "InventoryCostLayer.remaining": %w`, err)}
+		}
+	}
+	if v, ok := iclu.mutation.UnitCost(); ok {
+		if err := inventorycostlayer.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "InventoryCostLayer.unit_cost": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iclu *InventoryCostLayerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   inventorycostlayer.Table,
+			Columns: inventorycostlayer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycostlayer.FieldID,
+			},
+		},
+	}
+	if ps := iclu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iclu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycostlayer.FieldTenantID,
+		})
+	}
+	if value, ok := iclu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycostlayer.FieldTenantID,
+		})
+	}
+	if value, ok := iclu.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycostlayer.FieldProductID,
+		})
+	}
+	if value, ok := iclu.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycostlayer.FieldProductID,
+		})
+	}
+	if value, ok := iclu.mutation.SourceType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: inventorycostlayer.FieldSourceType,
+		})
+	}
+	if value, ok := iclu.mutation.SourceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycostlayer.FieldSourceID,
+		})
+	}
+	if value, ok := iclu.mutation.AddedSourceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycostlayer.FieldSourceID,
+		})
+	}
+	if iclu.mutation.SourceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: inventorycostlayer.FieldSourceID,
+		})
+	}
+	if value, ok := iclu.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycostlayer.FieldQuantity,
+		})
+	}
+	if value, ok := iclu.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycostlayer.FieldQuantity,
+		})
+	}
+	if value, ok := iclu.mutation.Remaining(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycostlayer.FieldRemaining,
+		})
+	}
+	if value, ok := iclu.mutation.AddedRemaining(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycostlayer.FieldRemaining,
+		})
+	}
+	if value, ok := iclu.mutation.UnitCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: inventorycostlayer.FieldUnitCost,
+		})
+	}
+	if value, ok := iclu.mutation.AddedUnitCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: inventorycostlayer.FieldUnitCost,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iclu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventorycostlayer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InventoryCostLayerUpdateOne is the builder for updating a single InventoryCostLayer entity.
+type InventoryCostLayerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InventoryCostLayerMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (icluo *InventoryCostLayerUpdateOne) SetTenantID(i int) *InventoryCostLayerUpdateOne {
+	icluo.mutation.ResetTenantID()
+	icluo.mutation.SetTenantID(i)
+	return icluo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (icluo *InventoryCostLayerUpdateOne) AddTenantID(i int) *InventoryCostLayerUpdateOne {
+	icluo.mutation.AddTenantID(i)
+	return icluo
+}
+
+// SetProductID sets the "product_id" field.
+func (icluo *InventoryCostLayerUpdateOne) SetProductID(i int) *InventoryCostLayerUpdateOne {
+	icluo.mutation.ResetProductID()
+	icluo.mutation.SetProductID(i)
+	return icluo
+}
+
+// AddProductID adds i to the "product_id" field.
+func (icluo *InventoryCostLayerUpdateOne) AddProductID(i int) *InventoryCostLayerUpdateOne {
+	icluo.mutation.AddProductID(i)
+	return icluo
+}
+
+// SetSourceType sets the "source_type" field.
+func (icluo *InventoryCostLayerUpdateOne) SetSourceType(s string) *InventoryCostLayerUpdateOne {
+	icluo.mutation.SetSourceType(s)
+	return icluo
+}
+
+// SetSourceID sets the "source_id" field.
+func (icluo *InventoryCostLayerUpdateOne) SetSourceID(i int) *InventoryCostLayerUpdateOne {
+	icluo.mutation.ResetSourceID()
+	icluo.mutation.SetSourceID(i)
+	return icluo
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (icluo *InventoryCostLayerUpdateOne) SetNillableSourceID(i *int) *InventoryCostLayerUpdateOne {
+	if i != nil {
+		icluo.SetSourceID(*i)
+	}
+	return icluo
+}
+
+// AddSourceID adds i to the "source_id" field.
+func (icluo *InventoryCostLayerUpdateOne) AddSourceID(i int) *InventoryCostLayerUpdateOne {
+	icluo.mutation.AddSourceID(i)
+	return icluo
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (icluo *InventoryCostLayerUpdateOne) ClearSourceID() *InventoryCostLayerUpdateOne {
+	icluo.mutation.ClearSourceID()
+	return icluo
+}
+
+// SetQuantity sets the "quantity" field.
+func (icluo *InventoryCostLayerUpdateOne) SetQuantity(f float64) *InventoryCostLayerUpdateOne {
+	icluo.mutation.ResetQuantity()
+	icluo.mutation.SetQuantity(f)
+	return icluo
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (icluo *InventoryCostLayerUpdateOne) AddQuantity(f float64) *InventoryCostLayerUpdateOne {
+	icluo.mutation.AddQuantity(f)
+	return icluo
+}
+
+// SetRemaining sets the "remaining" field.
+func (icluo *InventoryCostLayerUpdateOne) SetRemaining(f float64) *InventoryCostLayerUpdateOne {
+	icluo.mutation.ResetRemaining()
+	icluo.mutation.SetRemaining(f)
+	return icluo
+}
+
+// AddRemaining adds f to the "remaining" field.
+func (icluo *InventoryCostLayerUpdateOne) AddRemaining(f float64) *InventoryCostLayerUpdateOne {
+	icluo.mutation.AddRemaining(f)
+	return icluo
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (icluo *InventoryCostLayerUpdateOne) SetUnitCost(m money.Amount) *InventoryCostLayerUpdateOne {
+	icluo.mutation.ResetUnitCost()
+	icluo.mutation.SetUnitCost(m)
+	return icluo
+}
+
+// AddUnitCost adds m to the "unit_cost" field.
+func (icluo *InventoryCostLayerUpdateOne) AddUnitCost(m money.Amount) *InventoryCostLayerUpdateOne {
+	icluo.mutation.AddUnitCost(m)
+	return icluo
+}
+
+// Mutation returns the InventoryCostLayerMutation object of the builder.
+func (icluo *InventoryCostLayerUpdateOne) Mutation() *InventoryCostLayerMutation {
+	return icluo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (icluo *InventoryCostLayerUpdateOne) Select(field string, fields ...string) *InventoryCostLayerUpdateOne {
+	icluo.fields = append([]string{field}, fields...)
+	return icluo
+}
+
+// Save executes the query and returns the updated InventoryCostLayer entity.
+func (icluo *InventoryCostLayerUpdateOne) Save(ctx context.Context) (*InventoryCostLayer, error) {
+	var (
+		err  error
+		node *InventoryCostLayer
+	)
+	if len(icluo.hooks) == 0 {
+		if err = icluo.check(); err != nil {
+			return nil, err
+		}
+		node, err = icluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InventoryCostLayerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = icluo.check(); err != nil {
+				return nil, err
+			}
+			icluo.mutation = mutation
+			node, err = icluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(icluo.hooks) - 1; i >= 0; i-- {
+			if icluo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = icluo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, icluo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InventoryCostLayer)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InventoryCostLayerMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icluo *InventoryCostLayerUpdateOne) SaveX(ctx context.Context) *InventoryCostLayer {
+	node, err := icluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (icluo *InventoryCostLayerUpdateOne) Exec(ctx context.Context) error {
+	_, err := icluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icluo *InventoryCostLayerUpdateOne) ExecX(ctx context.Context) {
+	if err := icluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icluo *InventoryCostLayerUpdateOne) check() error {
+	if v, ok := icluo.mutation.Quantity(); ok {
+		if err := inventorycostlayer.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "InventoryCostLayer.quantity": %w`, err)}
+		}
+	}
+	if v, ok := icluo.mutation.Remaining(); ok {
+		if err := inventorycostlayer.RemainingValidator(v); err != nil {
+			return &ValidationError{Name: "remaining", err: fmt.Errorf(`ent: validator failed for field "InventoryCostLayer.remaining": %w`, err)}
+		}
+	}
+	if v, ok := icluo.mutation.UnitCost(); ok {
+		if err := inventorycostlayer.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "InventoryCostLayer.unit_cost": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (icluo *InventoryCostLayerUpdateOne) sqlSave(ctx context.Context) (_node *InventoryCostLayer, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   inventorycostlayer.Table,
+			Columns: inventorycostlayer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycostlayer.FieldID,
+			},
+		},
+	}
+	id, ok := icluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InventoryCostLayer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := icluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventorycostlayer.FieldID)
+		for _, f := range fields {
+			if !inventorycostlayer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != inventorycostlayer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := icluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := icluo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycostlayer.FieldTenantID,
+		})
+	}
+	if value, ok := icluo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycostlayer.FieldTenantID,
+		})
+	}
+	if value, ok := icluo.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycostlayer.FieldProductID,
+		})
+	}
+	if value, ok := icluo.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycostlayer.FieldProductID,
+		})
+	}
+	if value, ok := icluo.mutation.SourceType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: inventorycostlayer.FieldSourceType,
+		})
+	}
+	if value, ok := icluo.mutation.SourceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycostlayer.FieldSourceID,
+		})
+	}
+	if value, ok := icluo.mutation.AddedSourceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycostlayer.FieldSourceID,
+		})
+	}
+	if icluo.mutation.SourceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: inventorycostlayer.FieldSourceID,
+		})
+	}
+	if value, ok := icluo.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycostlayer.FieldQuantity,
+		})
+	}
+	if value, ok := icluo.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycostlayer.FieldQuantity,
+		})
+	}
+	if value, ok := icluo.mutation.Remaining(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycostlayer.FieldRemaining,
+		})
+	}
+	if value, ok := icluo.mutation.AddedRemaining(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycostlayer.FieldRemaining,
+		})
+	}
+	if value, ok := icluo.mutation.UnitCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: inventorycostlayer.FieldUnitCost,
+		})
+	}
+	if value, ok := icluo.mutation.AddedUnitCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: inventorycostlayer.FieldUnitCost,
+		})
+	}
+	_node = &InventoryCostLayer{config: icluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, icluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventorycostlayer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycount"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// InventoryCount is the model entity for the InventoryCount schema.
+type InventoryCount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Nombre de la toma de inventario
+	Name string `json:"name,omitempty"`
+	// Notas de la toma de inventario
+	Notes string `json:"notes,omitempty"`
+	// Estado de la toma (open, posted, cancelled)
+	Status string `json:"status,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// ID del usuario que abrió la toma
+	UserID int `json:"user_id,omitempty"`
+	// ID del usuario que contabilizó las diferencias
+	PostedBy int `json:"posted_by,omitempty"`
+	// Fecha en que se contabilizaron las diferencias
+	PostedAt *time.Time `json:"posted_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InventoryCount) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case inventorycount.FieldID, inventorycount.FieldTenantID, inventorycount.FieldUserID, inventorycount.FieldPostedBy:
+			values[i] = new(sql.NullInt64)
+		case inventorycount.FieldName, inventorycount.FieldNotes, inventorycount.FieldStatus:
+			values[i] = new(sql.NullString)
+		case inventorycount.FieldPostedAt, inventorycount.FieldCreatedAt, inventorycount.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type InventoryCount", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InventoryCount fields.
+func (ic *InventoryCount) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case inventorycount.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ic.ID = int(value.Int64)
+		case inventorycount.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ic.Name = value.String
+			}
+		case inventorycount.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				ic.Notes = value.String
+			}
+		case inventorycount.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ic.Status = value.String
+			}
+		case inventorycount.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ic.TenantID = int(value.Int64)
+			}
+		case inventorycount.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ic.UserID = int(value.Int64)
+			}
+		case inventorycount.FieldPostedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field posted_by", values[i])
+			} else if value.Valid {
+				ic.PostedBy = int(value.Int64)
+			}
+		case inventorycount.FieldPostedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field posted_at", values[i])
+			} else if value.Valid {
+				ic.PostedAt = new(time.Time)
+				*ic.PostedAt = value.Time
+			}
+		case inventorycount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ic.CreatedAt = value.Time
+			}
+		case inventorycount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ic.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this InventoryCount.
+// Note that you need to call InventoryCount.Unwrap() before calling this method if this InventoryCount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ic *InventoryCount) Update() *InventoryCountUpdateOne {
+	return (&InventoryCountClient{config: ic.config}).UpdateOne(ic)
+}
+
+// Unwrap unwraps the InventoryCount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ic *InventoryCount) Unwrap() *InventoryCount {
+	_tx, ok := ic.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InventoryCount is not a transactional entity")
+	}
+	ic.config.driver = _tx.drv
+	return ic
+}
+
+// String implements the fmt.Stringer.
+func (ic *InventoryCount) String() string {
+	var builder strings.Builder
+	builder.WriteString("InventoryCount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ic.ID))
+	builder.WriteString("name=")
+	builder.WriteString(ic.Name)
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(ic.Notes)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(ic.Status)
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", ic.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ic.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("posted_by=")
+	builder.WriteString(fmt.Sprintf("%v", ic.PostedBy))
+	builder.WriteString(", ")
+	if v := ic.PostedAt; v != nil {
+		builder.WriteString("posted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ic.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ic.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InventoryCounts is a parsable slice of InventoryCount.
+type InventoryCounts []*InventoryCount
+
+func (ic InventoryCounts) config(cfg config) {
+	for _i := range ic {
+		ic[_i].config = cfg
+	}
+}
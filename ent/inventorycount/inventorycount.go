@@ -0,0 +1,71 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventorycount
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the inventorycount type in the database.
+	Label = "inventory_count"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldPostedBy holds the string denoting the posted_by field in the database.
+	FieldPostedBy = "posted_by"
+	// FieldPostedAt holds the string denoting the posted_at field in the database.
+	FieldPostedAt = "posted_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the inventorycount in the database.
+	Table = "inventory_counts"
+)
+
+// Columns holds all SQL columns for inventorycount fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldNotes,
+	FieldStatus,
+	FieldTenantID,
+	FieldUserID,
+	FieldPostedBy,
+	FieldPostedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
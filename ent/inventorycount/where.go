@@ -0,0 +1,1007 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventorycount
+
+import (
+	"Veritasbackend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNotes), v))
+	})
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// PostedBy applies equality check predicate on the "posted_by" field. It's identical to PostedByEQ.
+func PostedBy(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPostedBy), v))
+	})
+}
+
+// PostedAt applies equality check predicate on the "posted_at" field. It's identical to PostedAtEQ.
+func PostedAt(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPostedAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNotes), v))
+	})
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNotes), v))
+	})
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNotes), v...))
+	})
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNotes), v...))
+	})
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNotes), v))
+	})
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNotes), v))
+	})
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNotes), v))
+	})
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNotes), v))
+	})
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNotes), v))
+	})
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNotes), v))
+	})
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNotes), v))
+	})
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNotes)))
+	})
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNotes)))
+	})
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNotes), v))
+	})
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNotes), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStatus), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserID), v))
+	})
+}
+
+// PostedByEQ applies the EQ predicate on the "posted_by" field.
+func PostedByEQ(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPostedBy), v))
+	})
+}
+
+// PostedByNEQ applies the NEQ predicate on the "posted_by" field.
+func PostedByNEQ(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPostedBy), v))
+	})
+}
+
+// PostedByIn applies the In predicate on the "posted_by" field.
+func PostedByIn(vs ...int) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPostedBy), v...))
+	})
+}
+
+// PostedByNotIn applies the NotIn predicate on the "posted_by" field.
+func PostedByNotIn(vs ...int) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPostedBy), v...))
+	})
+}
+
+// PostedByGT applies the GT predicate on the "posted_by" field.
+func PostedByGT(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPostedBy), v))
+	})
+}
+
+// PostedByGTE applies the GTE predicate on the "posted_by" field.
+func PostedByGTE(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPostedBy), v))
+	})
+}
+
+// PostedByLT applies the LT predicate on the "posted_by" field.
+func PostedByLT(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPostedBy), v))
+	})
+}
+
+// PostedByLTE applies the LTE predicate on the "posted_by" field.
+func PostedByLTE(v int) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPostedBy), v))
+	})
+}
+
+// PostedByIsNil applies the IsNil predicate on the "posted_by" field.
+func PostedByIsNil() predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPostedBy)))
+	})
+}
+
+// PostedByNotNil applies the NotNil predicate on the "posted_by" field.
+func PostedByNotNil() predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPostedBy)))
+	})
+}
+
+// PostedAtEQ applies the EQ predicate on the "posted_at" field.
+func PostedAtEQ(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPostedAt), v))
+	})
+}
+
+// PostedAtNEQ applies the NEQ predicate on the "posted_at" field.
+func PostedAtNEQ(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPostedAt), v))
+	})
+}
+
+// PostedAtIn applies the In predicate on the "posted_at" field.
+func PostedAtIn(vs ...time.Time) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPostedAt), v...))
+	})
+}
+
+// PostedAtNotIn applies the NotIn predicate on the "posted_at" field.
+func PostedAtNotIn(vs ...time.Time) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPostedAt), v...))
+	})
+}
+
+// PostedAtGT applies the GT predicate on the "posted_at" field.
+func PostedAtGT(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPostedAt), v))
+	})
+}
+
+// PostedAtGTE applies the GTE predicate on the "posted_at" field.
+func PostedAtGTE(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPostedAt), v))
+	})
+}
+
+// PostedAtLT applies the LT predicate on the "posted_at" field.
+func PostedAtLT(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPostedAt), v))
+	})
+}
+
+// PostedAtLTE applies the LTE predicate on the "posted_at" field.
+func PostedAtLTE(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPostedAt), v))
+	})
+}
+
+// PostedAtIsNil applies the IsNil predicate on the "posted_at" field.
+func PostedAtIsNil() predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPostedAt)))
+	})
+}
+
+// PostedAtNotNil applies the NotNil predicate on the "posted_at" field.
+func PostedAtNotNil() predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPostedAt)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InventoryCount {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InventoryCount) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InventoryCount) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InventoryCount) predicate.InventoryCount {
+	return predicate.InventoryCount(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
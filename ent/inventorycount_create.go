@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycount"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryCountCreate is the builder for creating a InventoryCount entity.
+type InventoryCountCreate struct {
+	config
+	mutation *InventoryCountMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (icc *InventoryCountCreate) SetName(s string) *InventoryCountCreate {
+	icc.mutation.SetName(s)
+	return icc
+}
+
+// SetNotes sets the "notes" field.
+func (icc *InventoryCountCreate) SetNotes(s string) *InventoryCountCreate {
+	icc.mutation.SetNotes(s)
+	return icc
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (icc *InventoryCountCreate) SetNillableNotes(s *string) *InventoryCountCreate {
+	if s != nil {
+		icc.SetNotes(*s)
+	}
+	return icc
+}
+
+// SetStatus sets the "status" field.
+func (icc *InventoryCountCreate) SetStatus(s string) *InventoryCountCreate {
+	icc.mutation.SetStatus(s)
+	return icc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (icc *InventoryCountCreate) SetNillableStatus(s *string) *InventoryCountCreate {
+	if s != nil {
+		icc.SetStatus(*s)
+	}
+	return icc
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (icc *InventoryCountCreate) SetTenantID(i int) *InventoryCountCreate {
+	icc.mutation.SetTenantID(i)
+	return icc
+}
+
+// SetUserID sets the "user_id" field.
+func (icc *InventoryCountCreate) SetUserID(i int) *InventoryCountCreate {
+	icc.mutation.SetUserID(i)
+	return icc
+}
+
+// SetPostedBy sets the "posted_by" field.
+func (icc *InventoryCountCreate) SetPostedBy(i int) *InventoryCountCreate {
+	icc.mutation.SetPostedBy(i)
+	return icc
+}
+
+// SetNillablePostedBy sets the "posted_by" field if the given value is not nil.
+func (icc *InventoryCountCreate) SetNillablePostedBy(i *int) *InventoryCountCreate {
+	if i != nil {
+		icc.SetPostedBy(*i)
+	}
+	return icc
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (icc *InventoryCountCreate) SetPostedAt(t time.Time) *InventoryCountCreate {
+	icc.mutation.SetPostedAt(t)
+	return icc
+}
+
+// SetNillablePostedAt sets the "posted_at" field if the given value is not nil.
+func (icc *InventoryCountCreate) SetNillablePostedAt(t *time.Time) *InventoryCountCreate {
+	if t != nil {
+		icc.SetPostedAt(*t)
+	}
+	return icc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (icc *InventoryCountCreate) SetCreatedAt(t time.Time) *InventoryCountCreate {
+	icc.mutation.SetCreatedAt(t)
+	return icc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (icc *InventoryCountCreate) SetNillableCreatedAt(t *time.Time) *InventoryCountCreate {
+	if t != nil {
+		icc.SetCreatedAt(*t)
+	}
+	return icc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (icc *InventoryCountCreate) SetUpdatedAt(t time.Time) *InventoryCountCreate {
+	icc.mutation.SetUpdatedAt(t)
+	return icc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (icc *InventoryCountCreate) SetNillableUpdatedAt(t *time.Time) *InventoryCountCreate {
+	if t != nil {
+		icc.SetUpdatedAt(*t)
+	}
+	return icc
+}
+
+// Mutation returns the InventoryCountMutation object of the builder.
+func (icc *InventoryCountCreate) Mutation() *InventoryCountMutation {
+	return icc.mutation
+}
+
+// Save creates the InventoryCount in the database.
+func (icc *InventoryCountCreate) Save(ctx context.Context) (*InventoryCount, error) {
+	var (
+		err  error
+		node *InventoryCount
+	)
+	icc.defaults()
+	if len(icc.hooks) == 0 {
+		if err = icc.check(); err != nil {
+			return nil, err
+		}
+		node, err = icc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InventoryCountMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = icc.check(); err != nil {
+				return nil, err
+			}
+			icc.mutation = mutation
+			if node, err = icc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(icc.hooks) - 1; i >= 0; i-- {
+			if icc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = icc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, icc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InventoryCount)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InventoryCountMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (icc *InventoryCountCreate) SaveX(ctx context.Context) *InventoryCount {
+	v, err := icc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icc *InventoryCountCreate) Exec(ctx context.Context) error {
+	_, err := icc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icc *InventoryCountCreate) ExecX(ctx context.Context) {
+	if err := icc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icc *InventoryCountCreate) defaults() {
+	if _, ok := icc.mutation.Status(); !ok {
+		v := inventorycount.DefaultStatus
+		icc.mutation.SetStatus(v)
+	}
+	if _, ok := icc.mutation.CreatedAt(); !ok {
+		v := inventorycount.DefaultCreatedAt()
+		icc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := icc.mutation.UpdatedAt(); !ok {
+		v := inventorycount.DefaultUpdatedAt()
+		icc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icc *InventoryCountCreate) check() error {
+	if _, ok := icc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "InventoryCount.name"`)}
+	}
+	if v, ok := icc.mutation.Name(); ok {
+		if err := inventorycount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "InventoryCount.name": %w`, err)}
+		}
+	}
+	if _, ok := icc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "InventoryCount.status"`)}
+	}
+	if _, ok := icc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "InventoryCount.tenant_id"`)}
+	}
+	if _, ok := icc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "InventoryCount.user_id"`)}
+	}
+	if _, ok := icc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InventoryCount.created_at"`)}
+	}
+	if _, ok := icc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InventoryCount.updated_at"`)}
+	}
+	return nil
+}
+
+func (icc *InventoryCountCreate) sqlSave(ctx context.Context) (*InventoryCount, error) {
+	_node, _spec := icc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, icc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (icc *InventoryCountCreate) createSpec() (*InventoryCount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InventoryCount{config: icc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: inventorycount.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycount.FieldID,
+			},
+		}
+	)
+	if value, ok := icc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: inventorycount.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := icc.mutation.Notes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: inventorycount.FieldNotes,
+		})
+		_node.Notes = value
+	}
+	if value, ok := icc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: inventorycount.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := icc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycount.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := icc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycount.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := icc.mutation.PostedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycount.FieldPostedBy,
+		})
+		_node.PostedBy = value
+	}
+	if value, ok := icc.mutation.PostedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: inventorycount.FieldPostedAt,
+		})
+		_node.PostedAt = &value
+	}
+	if value, ok := icc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: inventorycount.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := icc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: inventorycount.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// InventoryCountCreateBulk is the builder for creating many InventoryCount entities in bulk.
+type InventoryCountCreateBulk struct {
+	config
+	builders []*InventoryCountCreate
+}
+
+// Save creates the InventoryCount entities in the database.
+func (iccb *InventoryCountCreateBulk) Save(ctx context.Context) ([]*InventoryCount, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(iccb.builders))
+	nodes := make([]*InventoryCount, len(iccb.builders))
+	mutators := make([]Mutator, len(iccb.builders))
+	for i := range iccb.builders {
+		func(i int, root context.Context) {
+			builder := iccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InventoryCountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iccb *InventoryCountCreateBulk) SaveX(ctx context.Context) []*InventoryCount {
+	v, err := iccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iccb *InventoryCountCreateBulk) Exec(ctx context.Context) error {
+	_, err := iccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iccb *InventoryCountCreateBulk) ExecX(ctx context.Context) {
+	if err := iccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycount"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryCountQuery is the builder for querying InventoryCount entities.
+type InventoryCountQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.InventoryCount
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InventoryCountQuery builder.
+func (icq *InventoryCountQuery) Where(ps ...predicate.InventoryCount) *InventoryCountQuery {
+	icq.predicates = append(icq.predicates, ps...)
+	return icq
+}
+
+// Limit adds a limit step to the query.
+func (icq *InventoryCountQuery) Limit(limit int) *InventoryCountQuery {
+	icq.limit = &limit
+	return icq
+}
+
+// Offset adds an offset step to the query.
+func (icq *InventoryCountQuery) Offset(offset int) *InventoryCountQuery {
+	icq.offset = &offset
+	return icq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (icq *InventoryCountQuery) Unique(unique bool) *InventoryCountQuery {
+	icq.unique = &unique
+	return icq
+}
+
+// Order adds an order step to the query.
+func (icq *InventoryCountQuery) Order(o ...OrderFunc) *InventoryCountQuery {
+	icq.order = append(icq.order, o...)
+	return icq
+}
+
+// First returns the first InventoryCount entity from the query.
+// Returns a *NotFoundError when no InventoryCount was found.
+func (icq *InventoryCountQuery) First(ctx context.Context) (*InventoryCount, error) {
+	nodes, err := icq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{inventorycount.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (icq *InventoryCountQuery) FirstX(ctx context.Context) *InventoryCount {
+	node, err := icq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InventoryCount ID from the query.
+// Returns a *NotFoundError when no InventoryCount ID was found.
+func (icq *InventoryCountQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = icq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{inventorycount.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (icq *InventoryCountQuery) FirstIDX(ctx context.Context) int {
+	id, err := icq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InventoryCount entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InventoryCount entity is found.
+// Returns a *NotFoundError when no InventoryCount entities are found.
+func (icq *InventoryCountQuery) Only(ctx context.Context) (*InventoryCount, error) {
+	nodes, err := icq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{inventorycount.Label}
+	default:
+		return nil, &NotSingularError{inventorycount.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (icq *InventoryCountQuery) OnlyX(ctx context.Context) *InventoryCount {
+	node, err := icq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InventoryCount ID in the query.
+// Returns a *NotSingularError when more than one InventoryCount ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (icq *InventoryCountQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = icq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{inventorycount.Label}
+	default:
+		err = &NotSingularError{inventorycount.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (icq *InventoryCountQuery) OnlyIDX(ctx context.Context) int {
+	id, err := icq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InventoryCounts.
+func (icq *InventoryCountQuery) All(ctx context.Context) ([]*InventoryCount, error) {
+	if err := icq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return icq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (icq *InventoryCountQuery) AllX(ctx context.Context) []*InventoryCount {
+	nodes, err := icq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InventoryCount IDs.
+func (icq *InventoryCountQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := icq.Select(inventorycount.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (icq *InventoryCountQuery) IDsX(ctx context.Context) []int {
+	ids, err := icq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (icq *InventoryCountQuery) Count(ctx context.Context) (int, error) {
+	if err := icq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return icq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (icq *InventoryCountQuery) CountX(ctx context.Context) int {
+	count, err := icq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (icq *InventoryCountQuery) Exist(ctx context.Context) (bool, error) {
+	if err := icq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return icq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (icq *InventoryCountQuery) ExistX(ctx context.Context) bool {
+	exist, err := icq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InventoryCountQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (icq *InventoryCountQuery) Clone() *InventoryCountQuery {
+	if icq == nil {
+		return nil
+	}
+	return &InventoryCountQuery{
+		config:     icq.config,
+		limit:      icq.limit,
+		offset:     icq.offset,
+		order:      append([]OrderFunc{}, icq.order...),
+		predicates: append([]predicate.InventoryCount{}, icq.predicates...),
+		// clone intermediate query.
+		sql:    icq.sql.Clone(),
+		path:   icq.path,
+		unique: icq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InventoryCount.Query().
+//		GroupBy(inventorycount.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (icq *InventoryCountQuery) GroupBy(field string, fields ...string) *InventoryCountGroupBy {
+	grbuild := &InventoryCountGroupBy{config: icq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := icq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return icq.sqlQuery(ctx), nil
+	}
+	grbuild.label = inventorycount.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.InventoryCount.Query().
+//		Select(inventorycount.FieldName).
+//		Scan(ctx, &v)
+func (icq *InventoryCountQuery) Select(fields ...string) *InventoryCountSelect {
+	icq.fields = append(icq.fields, fields...)
+	selbuild := &InventoryCountSelect{InventoryCountQuery: icq}
+	selbuild.label = inventorycount.Label
+	selbuild.flds, selbuild.scan = &icq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (icq *InventoryCountQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range icq.fields {
+		if !inventorycount.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if icq.path != nil {
+		prev, err := icq.path(ctx)
+		if err != nil {
+			return err
+		}
+		icq.sql = prev
+	}
+	return nil
+}
+
+func (icq *InventoryCountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InventoryCount, error) {
+	var (
+		nodes = []*InventoryCount{}
+		_spec = icq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*InventoryCount).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &InventoryCount{config: icq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(icq.modifiers) > 0 {
+		_spec.Modifiers = icq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, icq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (icq *InventoryCountQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := icq.querySpec()
+	if len(icq.modifiers) > 0 {
+		_spec.Modifiers = icq.modifiers
+	}
+	_spec.Node.Columns = icq.fields
+	if len(icq.fields) > 0 {
+		_spec.Unique = icq.unique != nil && *icq.unique
+	}
+	return sqlgraph.CountNodes(ctx, icq.driver, _spec)
+}
+
+func (icq *InventoryCountQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := icq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (icq *InventoryCountQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   inventorycount.Table,
+			Columns: inventorycount.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycount.FieldID,
+			},
+		},
+		From:   icq.sql,
+		Unique: true,
+	}
+	if unique := icq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := icq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventorycount.FieldID)
+		for i := range fields {
+			if fields[i] != inventorycount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := icq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := icq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := icq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := icq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (icq *InventoryCountQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(icq.driver.Dialect())
+	t1 := builder.Table(inventorycount.Table)
+	columns := icq.fields
+	if len(columns) == 0 {
+		columns = inventorycount.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if icq.sql != nil {
+		selector = icq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if icq.unique != nil && *icq.unique {
+		selector.Distinct()
+	}
+	for _, m := range icq.modifiers {
+		m(selector)
+	}
+	for _, p := range icq.predicates {
+		p(selector)
+	}
+	for _, p := range icq.order {
+		p(selector)
+	}
+	if offset := icq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := icq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (icq *InventoryCountQuery) ForUpdate(opts ...sql.LockOption) *InventoryCountQuery {
+	if icq.driver.Dialect() == dialect.Postgres {
+		icq.Unique(false)
+	}
+	icq.modifiers = append(icq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return icq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (icq *InventoryCountQuery) ForShare(opts ...sql.LockOption) *InventoryCountQuery {
+	if icq.driver.Dialect() == dialect.Postgres {
+		icq.Unique(false)
+	}
+	icq.modifiers = append(icq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return icq
+}
+
+// InventoryCountGroupBy is the group-by builder for InventoryCount entities.
+type InventoryCountGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (icgb *InventoryCountGroupBy) Aggregate(fns ...AggregateFunc) *InventoryCountGroupBy {
+	icgb.fns = append(icgb.fns, fns...)
+	return icgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (icgb *InventoryCountGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := icgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	icgb.sql = query
+	return icgb.sqlScan(ctx, v)
+}
+
+func (icgb *InventoryCountGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range icgb.fields {
+		if !inventorycount.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := icgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := icgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (icgb *InventoryCountGroupBy) sqlQuery() *sql.Selector {
+	selector := icgb.sql.Select()
+	aggregation := make([]string, 0, len(icgb.fns))
+	for _, fn := range icgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(icgb.fields)+len(icgb.fns))
+		for _, f := range icgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(icgb.fields...)...)
+}
+
+// InventoryCountSelect is the builder for selecting fields of InventoryCount entities.
+type InventoryCountSelect struct {
+	*InventoryCountQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ics *InventoryCountSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ics.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ics.sql = ics.InventoryCountQuery.sqlQuery(ctx)
+	return ics.sqlScan(ctx, v)
+}
+
+func (ics *InventoryCountSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ics.sql.Query()
+	if err := ics.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,724 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycount"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryCountUpdate is the builder for updating InventoryCount entities.
+type InventoryCountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InventoryCountMutation
+}
+
+// Where appends a list predicates to the InventoryCountUpdate builder.
+func (icu *InventoryCountUpdate) Where(ps ...predicate.InventoryCount) *InventoryCountUpdate {
+	icu.mutation.Where(ps...)
+	return icu
+}
+
+// SetName sets the "name" field.
+func (icu *InventoryCountUpdate) SetName(s string) *InventoryCountUpdate {
+	icu.mutation.SetName(s)
+	return icu
+}
+
+// SetNotes sets the "notes" field.
+func (icu *InventoryCountUpdate) SetNotes(s string) *InventoryCountUpdate {
+	icu.mutation.SetNotes(s)
+	return icu
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (icu *InventoryCountUpdate) SetNillableNotes(s *string) *InventoryCountUpdate {
+	if s != nil {
+		icu.SetNotes(*s)
+	}
+	return icu
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (icu *InventoryCountUpdate) ClearNotes() *InventoryCountUpdate {
+	icu.mutation.ClearNotes()
+	return icu
+}
+
+// SetStatus sets the "status" field.
+func (icu *InventoryCountUpdate) SetStatus(s string) *InventoryCountUpdate {
+	icu.mutation.SetStatus(s)
+	return icu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (icu *InventoryCountUpdate) SetNillableStatus(s *string) *InventoryCountUpdate {
+	if s != nil {
+		icu.SetStatus(*s)
+	}
+	return icu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (icu *InventoryCountUpdate) SetTenantID(i int) *InventoryCountUpdate {
+	icu.mutation.ResetTenantID()
+	icu.mutation.SetTenantID(i)
+	return icu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (icu *InventoryCountUpdate) AddTenantID(i int) *InventoryCountUpdate {
+	icu.mutation.AddTenantID(i)
+	return icu
+}
+
+// SetUserID sets the "user_id" field.
+func (icu *InventoryCountUpdate) SetUserID(i int) *InventoryCountUpdate {
+	icu.mutation.ResetUserID()
+	icu.mutation.SetUserID(i)
+	return icu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (icu *InventoryCountUpdate) AddUserID(i int) *InventoryCountUpdate {
+	icu.mutation.AddUserID(i)
+	return icu
+}
+
+// SetPostedBy sets the "posted_by" field.
+func (icu *InventoryCountUpdate) SetPostedBy(i int) *InventoryCountUpdate {
+	icu.mutation.ResetPostedBy()
+	icu.mutation.SetPostedBy(i)
+	return icu
+}
+
+// SetNillablePostedBy sets the "posted_by" field if the given value is not nil.
+func (icu *InventoryCountUpdate) SetNillablePostedBy(i *int) *InventoryCountUpdate {
+	if i != nil {
+		icu.SetPostedBy(*i)
+	}
+	return icu
+}
+
+// AddPostedBy adds i to the "posted_by" field.
+func (icu *InventoryCountUpdate) AddPostedBy(i int) *InventoryCountUpdate {
+	icu.mutation.AddPostedBy(i)
+	return icu
+}
+
+// ClearPostedBy clears the value of the "posted_by" field.
+func (icu *InventoryCountUpdate) ClearPostedBy() *InventoryCountUpdate {
+	icu.mutation.ClearPostedBy()
+	return icu
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (icu *InventoryCountUpdate) SetPostedAt(t time.Time) *InventoryCountUpdate {
+	icu.mutation.SetPostedAt(t)
+	return icu
+}
+
+// SetNillablePostedAt sets the "posted_at" field if the given value is not nil.
+func (icu *InventoryCountUpdate) SetNillablePostedAt(t *time.Time) *InventoryCountUpdate {
+	if t != nil {
+		icu.SetPostedAt(*t)
+	}
+	return icu
+}
+
+// ClearPostedAt clears the value of the "posted_at" field.
+func (icu *InventoryCountUpdate) ClearPostedAt() *InventoryCountUpdate {
+	icu.mutation.ClearPostedAt()
+	return icu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (icu *InventoryCountUpdate) SetUpdatedAt(t time.Time) *InventoryCountUpdate {
+	icu.mutation.SetUpdatedAt(t)
+	return icu
+}
+
+// Mutation returns the InventoryCountMutation object of the builder.
+func (icu *InventoryCountUpdate) Mutation() *InventoryCountMutation {
+	return icu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (icu *InventoryCountUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	icu.defaults()
+	if len(icu.hooks) == 0 {
+		if err = icu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = icu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InventoryCountMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = icu.check(); err != nil {
+				return 0, err
+			}
+			icu.mutation = mutation
+			affected, err = icu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(icu.hooks) - 1; i >= 0; i-- {
+			if icu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = icu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, icu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icu *InventoryCountUpdate) SaveX(ctx context.Context) int {
+	affected, err := icu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (icu *InventoryCountUpdate) Exec(ctx context.Context) error {
+	_, err := icu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icu *InventoryCountUpdate) ExecX(ctx context.Context) {
+	if err := icu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icu *InventoryCountUpdate) defaults() {
+	if _, ok := icu.mutation.UpdatedAt(); !ok {
+		v := inventorycount.UpdateDefaultUpdatedAt()
+		icu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icu *InventoryCountUpdate) check() error {
+	if v, ok := icu.mutation.Name(); ok {
+		if err := inventorycount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "InventoryCount.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (icu *InventoryCountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   inventorycount.Table,
+			Columns: inventorycount.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycount.FieldID,
+			},
+		},
+	}
+	if ps := icu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := icu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: inventorycount.FieldName,
+		})
+	}
+	if value, ok := icu.mutation.Notes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: inventorycount.FieldNotes,
+		})
+	}
+	if icu.mutation.NotesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: inventorycount.FieldNotes,
+		})
+	}
+	if value, ok := icu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: inventorycount.FieldStatus,
+		})
+	}
+	if value, ok := icu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycount.FieldTenantID,
+		})
+	}
+	if value, ok := icu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycount.FieldTenantID,
+		})
+	}
+	if value, ok := icu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycount.FieldUserID,
+		})
+	}
+	if value, ok := icu.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycount.FieldUserID,
+		})
+	}
+	if value, ok := icu.mutation.PostedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycount.FieldPostedBy,
+		})
+	}
+	if value, ok := icu.mutation.AddedPostedBy(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycount.FieldPostedBy,
+		})
+	}
+	if icu.mutation.PostedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: inventorycount.FieldPostedBy,
+		})
+	}
+	if value, ok := icu.mutation.PostedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: inventorycount.FieldPostedAt,
+		})
+	}
+	if icu.mutation.PostedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: inventorycount.FieldPostedAt,
+		})
+	}
+	if value, ok := icu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: inventorycount.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, icu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventorycount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InventoryCountUpdateOne is the builder for updating a single InventoryCount entity.
+type InventoryCountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InventoryCountMutation
+}
+
+// SetName sets the "name" field.
+func (icuo *InventoryCountUpdateOne) SetName(s string) *InventoryCountUpdateOne {
+	icuo.mutation.SetName(s)
+	return icuo
+}
+
+// SetNotes sets the "notes" field.
+func (icuo *InventoryCountUpdateOne) SetNotes(s string) *InventoryCountUpdateOne {
+	icuo.mutation.SetNotes(s)
+	return icuo
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (icuo *InventoryCountUpdateOne) SetNillableNotes(s *string) *InventoryCountUpdateOne {
+	if s != nil {
+		icuo.SetNotes(*s)
+	}
+	return icuo
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (icuo *InventoryCountUpdateOne) ClearNotes() *InventoryCountUpdateOne {
+	icuo.mutation.ClearNotes()
+	return icuo
+}
+
+// SetStatus sets the "status" field.
+func (icuo *InventoryCountUpdateOne) SetStatus(s string) *InventoryCountUpdateOne {
+	icuo.mutation.SetStatus(s)
+	return icuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (icuo *InventoryCountUpdateOne) SetNillableStatus(s *string) *InventoryCountUpdateOne {
+	if s != nil {
+		icuo.SetStatus(*s)
+	}
+	return icuo
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (icuo *InventoryCountUpdateOne) SetTenantID(i int) *InventoryCountUpdateOne {
+	icuo.mutation.ResetTenantID()
+	icuo.mutation.SetTenantID(i)
+	return icuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (icuo *InventoryCountUpdateOne) AddTenantID(i int) *InventoryCountUpdateOne {
+	icuo.mutation.AddTenantID(i)
+	return icuo
+}
+
+// SetUserID sets the "user_id" field.
+func (icuo *InventoryCountUpdateOne) SetUserID(i int) *InventoryCountUpdateOne {
+	icuo.mutation.ResetUserID()
+	icuo.mutation.SetUserID(i)
+	return icuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (icuo *InventoryCountUpdateOne) AddUserID(i int) *InventoryCountUpdateOne {
+	icuo.mutation.AddUserID(i)
+	return icuo
+}
+
+// SetPostedBy sets the "posted_by" field.
+func (icuo *InventoryCountUpdateOne) SetPostedBy(i int) *InventoryCountUpdateOne {
+	icuo.mutation.ResetPostedBy()
+	icuo.mutation.SetPostedBy(i)
+	return icuo
+}
+
+// SetNillablePostedBy sets the "posted_by" field if the given value is not nil.
+func (icuo *InventoryCountUpdateOne) SetNillablePostedBy(i *int) *InventoryCountUpdateOne {
+	if i != nil {
+		icuo.SetPostedBy(*i)
+	}
+	return icuo
+}
+
+// AddPostedBy adds i to the "posted_by" field.
+func (icuo *InventoryCountUpdateOne) AddPostedBy(i int) *InventoryCountUpdateOne {
+	icuo.mutation.AddPostedBy(i)
+	return icuo
+}
+
+// ClearPostedBy clears the value of the "posted_by" field.
+func (icuo *InventoryCountUpdateOne) ClearPostedBy() *InventoryCountUpdateOne {
+	icuo.mutation.ClearPostedBy()
+	return icuo
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (icuo *InventoryCountUpdateOne) SetPostedAt(t time.Time) *InventoryCountUpdateOne {
+	icuo.mutation.SetPostedAt(t)
+	return icuo
+}
+
+// SetNillablePostedAt sets the "posted_at" field if the given value is not nil.
+func (icuo *InventoryCountUpdateOne) SetNillablePostedAt(t *time.Time) *InventoryCountUpdateOne {
+	if t != nil {
+		icuo.SetPostedAt(*t)
+	}
+	return icuo
+}
+
+// ClearPostedAt clears the value of the "posted_at" field.
+func (icuo *InventoryCountUpdateOne) ClearPostedAt() *InventoryCountUpdateOne {
+	icuo.mutation.ClearPostedAt()
+	return icuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (icuo *InventoryCountUpdateOne) SetUpdatedAt(t time.Time) *InventoryCountUpdateOne {
+	icuo.mutation.SetUpdatedAt(t)
+	return icuo
+}
+
+// Mutation returns the InventoryCountMutation object of the builder.
+func (icuo *InventoryCountUpdateOne) Mutation() *InventoryCountMutation {
+	return icuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (icuo *InventoryCountUpdateOne) Select(field string, fields ...string) *InventoryCountUpdateOne {
+	icuo.fields = append([]string{field}, fields...)
+	return icuo
+}
+
+// Save executes the query and returns the updated InventoryCount entity.
+func (icuo *InventoryCountUpdateOne) Save(ctx context.Context) (*InventoryCount, error) {
+	var (
+		err  error
+		node *InventoryCount
+	)
+	icuo.defaults()
+	if len(icuo.hooks) == 0 {
+		if err = icuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = icuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InventoryCountMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = icuo.check(); err != nil {
+				return nil, err
+			}
+			icuo.mutation = mutation
+			node, err = icuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(icuo.hooks) - 1; i >= 0; i-- {
+			if icuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = icuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, icuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InventoryCount)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InventoryCountMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icuo *InventoryCountUpdateOne) SaveX(ctx context.Context) *InventoryCount {
+	node, err := icuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (icuo *InventoryCountUpdateOne) Exec(ctx context.Context) error {
+	_, err := icuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icuo *InventoryCountUpdateOne) ExecX(ctx context.Context) {
+	if err := icuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icuo *InventoryCountUpdateOne) defaults() {
+	if _, ok := icuo.mutation.UpdatedAt(); !ok {
+		v := inventorycount.UpdateDefaultUpdatedAt()
+		icuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icuo *InventoryCountUpdateOne) check() error {
+	if v, ok := icuo.mutation.Name(); ok {
+		if err := inventorycount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "InventoryCount.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (icuo *InventoryCountUpdateOne) sqlSave(ctx context.Context) (_node *InventoryCount, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   inventorycount.Table,
+			Columns: inventorycount.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycount.FieldID,
+			},
+		},
+	}
+	id, ok := icuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InventoryCount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := icuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventorycount.FieldID)
+		for _, f := range fields {
+			if !inventorycount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != inventorycount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := icuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := icuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: inventorycount.FieldName,
+		})
+	}
+	if value, ok := icuo.mutation.Notes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: inventorycount.FieldNotes,
+		})
+	}
+	if icuo.mutation.NotesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: inventorycount.FieldNotes,
+		})
+	}
+	if value, ok := icuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: inventorycount.FieldStatus,
+		})
+	}
+	if value, ok := icuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycount.FieldTenantID,
+		})
+	}
+	if value, ok := icuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycount.FieldTenantID,
+		})
+	}
+	if value, ok := icuo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycount.FieldUserID,
+		})
+	}
+	if value, ok := icuo.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycount.FieldUserID,
+		})
+	}
+	if value, ok := icuo.mutation.PostedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycount.FieldPostedBy,
+		})
+	}
+	if value, ok := icuo.mutation.AddedPostedBy(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycount.FieldPostedBy,
+		})
+	}
+	if icuo.mutation.PostedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: inventorycount.FieldPostedBy,
+		})
+	}
+	if value, ok := icuo.mutation.PostedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: inventorycount.FieldPostedAt,
+		})
+	}
+	if icuo.mutation.PostedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: inventorycount.FieldPostedAt,
+		})
+	}
+	if value, ok := icuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: inventorycount.FieldUpdatedAt,
+		})
+	}
+	_node = &InventoryCount{config: icuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, icuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventorycount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
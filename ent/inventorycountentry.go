@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycountentry"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// InventoryCountEntry is the model entity for the InventoryCountEntry schema.
+type InventoryCountEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID de la toma de inventario
+	CountID int `json:"count_id,omitempty"`
+	// ID de la línea contada
+	LineID int `json:"line_id,omitempty"`
+	// Cantidad registrada en este conteo
+	Quantity float64 `json:"quantity,omitempty"`
+	// Identificador del dispositivo que registró el conteo
+	DeviceID string `json:"device_id,omitempty"`
+	// ID del usuario que contó
+	UserID int `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InventoryCountEntry) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case inventorycountentry.FieldQuantity:
+			values[i] = new(sql.NullFloat64)
+		case inventorycountentry.FieldID, inventorycountentry.FieldCountID, inventorycountentry.FieldLineID, inventorycountentry.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case inventorycountentry.FieldDeviceID:
+			values[i] = new(sql.NullString)
+		case inventorycountentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type InventoryCountEntry", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InventoryCountEntry fields.
+func (ice *InventoryCountEntry) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case inventorycountentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ice.ID = int(value.Int64)
+		case inventorycountentry.FieldCountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field count_id", values[i])
+			} else if value.Valid {
+				ice.CountID = int(value.Int64)
+			}
+		case inventorycountentry.FieldLineID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field line_id", values[i])
+			} else if value.Valid {
+				ice.LineID = int(value.Int64)
+			}
+		case inventorycountentry.FieldQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				ice.Quantity = value.Float64
+			}
+		case inventorycountentry.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				ice.DeviceID = value.String
+			}
+		case inventorycountentry.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ice.UserID = int(value.Int64)
+			}
+		case inventorycountentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ice.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this InventoryCountEntry.
+// Note that you need to call InventoryCountEntry.Unwrap() before calling this method if this InventoryCountEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ice *InventoryCountEntry) Update() *InventoryCountEntryUpdateOne {
+	return (&InventoryCountEntryClient{config: ice.config}).UpdateOne(ice)
+}
+
+// Unwrap unwraps the InventoryCountEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ice *InventoryCountEntry) Unwrap() *InventoryCountEntry {
+	_tx, ok := ice.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InventoryCountEntry is not a transactional entity")
+	}
+	ice.config.driver = _tx.drv
+	return ice
+}
+
+// String implements the fmt.Stringer.
+func (ice *InventoryCountEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("InventoryCountEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ice.ID))
+	builder.WriteString("count_id=")
+	builder.WriteString(fmt.Sprintf("%v", ice.CountID))
+	builder.WriteString(", ")
+	builder.WriteString("line_id=")
+	builder.WriteString(fmt.Sprintf("%v", ice.LineID))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", ice.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("device_id=")
+	builder.WriteString(ice.DeviceID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ice.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ice.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InventoryCountEntries is a parsable slice of InventoryCountEntry.
+type InventoryCountEntries []*InventoryCountEntry
+
+func (ice InventoryCountEntries) config(cfg config) {
+	for _i := range ice {
+		ice[_i].config = cfg
+	}
+}
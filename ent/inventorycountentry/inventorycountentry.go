@@ -0,0 +1,54 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventorycountentry
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the inventorycountentry type in the database.
+	Label = "inventory_count_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCountID holds the string denoting the count_id field in the database.
+	FieldCountID = "count_id"
+	// FieldLineID holds the string denoting the line_id field in the database.
+	FieldLineID = "line_id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldDeviceID holds the string denoting the device_id field in the database.
+	FieldDeviceID = "device_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the inventorycountentry in the database.
+	Table = "inventory_count_entries"
+)
+
+// Columns holds all SQL columns for inventorycountentry fields.
+var Columns = []string{
+	FieldID,
+	FieldCountID,
+	FieldLineID,
+	FieldQuantity,
+	FieldDeviceID,
+	FieldUserID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,660 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventorycountentry
+
+import (
+	"Veritasbackend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CountID applies equality check predicate on the "count_id" field. It's identical to CountIDEQ.
+func CountID(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCountID), v))
+	})
+}
+
+// LineID applies equality check predicate on the "line_id" field. It's identical to LineIDEQ.
+func LineID(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLineID), v))
+	})
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v float64) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v string) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeviceID), v))
+	})
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CountIDEQ applies the EQ predicate on the "count_id" field.
+func CountIDEQ(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCountID), v))
+	})
+}
+
+// CountIDNEQ applies the NEQ predicate on the "count_id" field.
+func CountIDNEQ(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCountID), v))
+	})
+}
+
+// CountIDIn applies the In predicate on the "count_id" field.
+func CountIDIn(vs ...int) predicate.InventoryCountEntry {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCountID), v...))
+	})
+}
+
+// CountIDNotIn applies the NotIn predicate on the "count_id" field.
+func CountIDNotIn(vs ...int) predicate.InventoryCountEntry {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCountID), v...))
+	})
+}
+
+// CountIDGT applies the GT predicate on the "count_id" field.
+func CountIDGT(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCountID), v))
+	})
+}
+
+// CountIDGTE applies the GTE predicate on the "count_id" field.
+func CountIDGTE(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCountID), v))
+	})
+}
+
+// CountIDLT applies the LT predicate on the "count_id" field.
+func CountIDLT(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCountID), v))
+	})
+}
+
+// CountIDLTE applies the LTE predicate on the "count_id" field.
+func CountIDLTE(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCountID), v))
+	})
+}
+
+// LineIDEQ applies the EQ predicate on the "line_id" field.
+func LineIDEQ(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLineID), v))
+	})
+}
+
+// LineIDNEQ applies the NEQ predicate on the "line_id" field.
+func LineIDNEQ(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLineID), v))
+	})
+}
+
+// LineIDIn applies the In predicate on the "line_id" field.
+func LineIDIn(vs ...int) predicate.InventoryCountEntry {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLineID), v...))
+	})
+}
+
+// LineIDNotIn applies the NotIn predicate on the "line_id" field.
+func LineIDNotIn(vs ...int) predicate.InventoryCountEntry {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLineID), v...))
+	})
+}
+
+// LineIDGT applies the GT predicate on the "line_id" field.
+func LineIDGT(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLineID), v))
+	})
+}
+
+// LineIDGTE applies the GTE predicate on the "line_id" field.
+func LineIDGTE(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLineID), v))
+	})
+}
+
+// LineIDLT applies the LT predicate on the "line_id" field.
+func LineIDLT(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLineID), v))
+	})
+}
+
+// LineIDLTE applies the LTE predicate on the "line_id" field.
+func LineIDLTE(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLineID), v))
+	})
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v float64) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v float64) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...float64) predicate.InventoryCountEntry {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...float64) predicate.InventoryCountEntry {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v float64) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v float64) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v float64) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v float64) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldQuantity), v))
+	})
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v string) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeviceID), v))
+	})
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v string) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeviceID), v))
+	})
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...string) predicate.InventoryCountEntry {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDeviceID), v...))
+	})
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...string) predicate.InventoryCountEntry {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDeviceID), v...))
+	})
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v string) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeviceID), v))
+	})
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v string) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeviceID), v))
+	})
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v string) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeviceID), v))
+	})
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v string) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeviceID), v))
+	})
+}
+
+// DeviceIDContains applies the Contains predicate on the "device_id" field.
+func DeviceIDContains(v string) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDeviceID), v))
+	})
+}
+
+// DeviceIDHasPrefix applies the HasPrefix predicate on the "device_id" field.
+func DeviceIDHasPrefix(v string) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDeviceID), v))
+	})
+}
+
+// DeviceIDHasSuffix applies the HasSuffix predicate on the "device_id" field.
+func DeviceIDHasSuffix(v string) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDeviceID), v))
+	})
+}
+
+// DeviceIDIsNil applies the IsNil predicate on the "device_id" field.
+func DeviceIDIsNil() predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDeviceID)))
+	})
+}
+
+// DeviceIDNotNil applies the NotNil predicate on the "device_id" field.
+func DeviceIDNotNil() predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDeviceID)))
+	})
+}
+
+// DeviceIDEqualFold applies the EqualFold predicate on the "device_id" field.
+func DeviceIDEqualFold(v string) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDeviceID), v))
+	})
+}
+
+// DeviceIDContainsFold applies the ContainsFold predicate on the "device_id" field.
+func DeviceIDContainsFold(v string) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDeviceID), v))
+	})
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.InventoryCountEntry {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.InventoryCountEntry {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserID), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InventoryCountEntry {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InventoryCountEntry {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InventoryCountEntry) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InventoryCountEntry) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InventoryCountEntry) predicate.InventoryCountEntry {
+	return predicate.InventoryCountEntry(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycountentry"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryCountEntryCreate is the builder for creating a InventoryCountEntry entity.
+type InventoryCountEntryCreate struct {
+	config
+	mutation *InventoryCountEntryMutation
+	hooks    []Hook
+}
+
+// SetCountID sets the "count_id" field.
+func (icec *InventoryCountEntryCreate) SetCountID(i int) *InventoryCountEntryCreate {
+	icec.mutation.SetCountID(i)
+	return icec
+}
+
+// SetLineID sets the "line_id" field.
+func (icec *InventoryCountEntryCreate) SetLineID(i int) *InventoryCountEntryCreate {
+	icec.mutation.SetLineID(i)
+	return icec
+}
+
+// SetQuantity sets the "quantity" field.
+func (icec *InventoryCountEntryCreate) SetQuantity(f float64) *InventoryCountEntryCreate {
+	icec.mutation.SetQuantity(f)
+	return icec
+}
+
+// SetDeviceID sets the "device_id" field.
+func (icec *InventoryCountEntryCreate) SetDeviceID(s string) *InventoryCountEntryCreate {
+	icec.mutation.SetDeviceID(s)
+	return icec
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (icec *InventoryCountEntryCreate) SetNillableDeviceID(s *string) *InventoryCountEntryCreate {
+	if s != nil {
+		icec.SetDeviceID(*s)
+	}
+	return icec
+}
+
+// SetUserID sets the "user_id" field.
+func (icec *InventoryCountEntryCreate) SetUserID(i int) *InventoryCountEntryCreate {
+	icec.mutation.SetUserID(i)
+	return icec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (icec *InventoryCountEntryCreate) SetCreatedAt(t time.Time) *InventoryCountEntryCreate {
+	icec.mutation.SetCreatedAt(t)
+	return icec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (icec *InventoryCountEntryCreate) SetNillableCreatedAt(t *time.Time) *InventoryCountEntryCreate {
+	if t != nil {
+		icec.SetCreatedAt(*t)
+	}
+	return icec
+}
+
+// Mutation returns the InventoryCountEntryMutation object of the builder.
+func (icec *InventoryCountEntryCreate) Mutation() *InventoryCountEntryMutation {
+	return icec.mutation
+}
+
+// Save creates the InventoryCountEntry in the database.
+func (icec *InventoryCountEntryCreate) Save(ctx context.Context) (*InventoryCountEntry, error) {
+	var (
+		err  error
+		node *InventoryCountEntry
+	)
+	icec.defaults()
+	if len(icec.hooks) == 0 {
+		if err = icec.check(); err != nil {
+			return nil, err
+		}
+		node, err = icec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InventoryCountEntryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = icec.check(); err != nil {
+				return nil, err
+			}
+			icec.mutation = mutation
+			if node, err = icec.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(icec.hooks) - 1; i >= 0; i-- {
+			if icec.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = icec.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, icec.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InventoryCountEntry)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InventoryCountEntryMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (icec *InventoryCountEntryCreate) SaveX(ctx context.Context) *InventoryCountEntry {
+	v, err := icec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icec *InventoryCountEntryCreate) Exec(ctx context.Context) error {
+	_, err := icec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icec *InventoryCountEntryCreate) ExecX(ctx context.Context) {
+	if err := icec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icec *InventoryCountEntryCreate) defaults() {
+	if _, ok := icec.mutation.CreatedAt(); !ok {
+		v := inventorycountentry.DefaultCreatedAt()
+		icec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icec *InventoryCountEntryCreate) check() error {
+	if _, ok := icec.mutation.CountID(); !ok {
+		return &ValidationError{Name: "count_id", err: errors.New(`ent: missing required field "InventoryCountEntry.count_id"`)}
+	}
+	if _, ok := icec.mutation.LineID(); !ok {
+		return &ValidationError{Name: "line_id", err: errors.New(`ent: missing required field "InventoryCountEntry.line_id"`)}
+	}
+	if _, ok := icec.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "InventoryCountEntry.quantity"`)}
+	}
+	if _, ok := icec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "InventoryCountEntry.user_id"`)}
+	}
+	if _, ok := icec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InventoryCountEntry.created_at"`)}
+	}
+	return nil
+}
+
+func (icec *InventoryCountEntryCreate) sqlSave(ctx context.Context) (*InventoryCountEntry, error) {
+	_node, _spec := icec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, icec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (icec *InventoryCountEntryCreate) createSpec() (*InventoryCountEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InventoryCountEntry{config: icec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: inventorycountentry.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycountentry.FieldID,
+			},
+		}
+	)
+	if value, ok := icec.mutation.CountID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountentry.FieldCountID,
+		})
+		_node.CountID = value
+	}
+	if value, ok := icec.mutation.LineID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountentry.FieldLineID,
+		})
+		_node.LineID = value
+	}
+	if value, ok := icec.mutation.Quantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycountentry.FieldQuantity,
+		})
+		_node.Quantity = value
+	}
+	if value, ok := icec.mutation.DeviceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: inventorycountentry.FieldDeviceID,
+		})
+		_node.DeviceID = value
+	}
+	if value, ok := icec.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountentry.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := icec.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: inventorycountentry.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// InventoryCountEntryCreateBulk is the builder for creating many InventoryCountEntry entities in bulk.
+type InventoryCountEntryCreateBulk struct {
+	config
+	builders []*InventoryCountEntryCreate
+}
+
+// Save creates the InventoryCountEntry entities in the database.
+func (icecb *InventoryCountEntryCreateBulk) Save(ctx context.Context) ([]*InventoryCountEntry, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(icecb.builders))
+	nodes := make([]*InventoryCountEntry, len(icecb.builders))
+	mutators := make([]Mutator, len(icecb.builders))
+	for i := range icecb.builders {
+		func(i int, root context.Context) {
+			builder := icecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InventoryCountEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icecb *InventoryCountEntryCreateBulk) SaveX(ctx context.Context) []*InventoryCountEntry {
+	v, err := icecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icecb *InventoryCountEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := icecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icecb *InventoryCountEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := icecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycountentry"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryCountEntryDelete is the builder for deleting a InventoryCountEntry entity.
+type InventoryCountEntryDelete struct {
+	config
+	hooks    []Hook
+	mutation *InventoryCountEntryMutation
+}
+
+// Where appends a list predicates to the InventoryCountEntryDelete builder.
+func (iced *InventoryCountEntryDelete) Where(ps ...predicate.InventoryCountEntry) *InventoryCountEntryDelete {
+	iced.mutation.Where(ps...)
+	return iced
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (iced *InventoryCountEntryDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(iced.hooks) == 0 {
+		affected, err = iced.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InventoryCountEntryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iced.mutation = mutation
+			affected, err = iced.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iced.hooks) - 1; i >= 0; i-- {
+			if iced.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iced.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iced.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iced *InventoryCountEntryDelete) ExecX(ctx context.Context) int {
+	n, err := iced.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (iced *InventoryCountEntryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: inventorycountentry.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycountentry.FieldID,
+			},
+		},
+	}
+	if ps := iced.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, iced.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// InventoryCountEntryDeleteOne is the builder for deleting a single InventoryCountEntry entity.
+type InventoryCountEntryDeleteOne struct {
+	iced *InventoryCountEntryDelete
+}
+
+// Exec executes the deletion query.
+func (icedo *InventoryCountEntryDeleteOne) Exec(ctx context.Context) error {
+	n, err := icedo.iced.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{inventorycountentry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icedo *InventoryCountEntryDeleteOne) ExecX(ctx context.Context) {
+	icedo.iced.ExecX(ctx)
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycountentry"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryCountEntryQuery is the builder for querying InventoryCountEntry entities.
+type InventoryCountEntryQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.InventoryCountEntry
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InventoryCountEntryQuery builder.
+func (iceq *InventoryCountEntryQuery) Where(ps ...predicate.InventoryCountEntry) *InventoryCountEntryQuery {
+	iceq.predicates = append(iceq.predicates, ps...)
+	return iceq
+}
+
+// Limit adds a limit step to the query.
+func (iceq *InventoryCountEntryQuery) Limit(limit int) *InventoryCountEntryQuery {
+	iceq.limit = &limit
+	return iceq
+}
+
+// Offset adds an offset step to the query.
+func (iceq *InventoryCountEntryQuery) Offset(offset int) *InventoryCountEntryQuery {
+	iceq.offset = &offset
+	return iceq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iceq *InventoryCountEntryQuery) Unique(unique bool) *InventoryCountEntryQuery {
+	iceq.unique = &unique
+	return iceq
+}
+
+// Order adds an order step to the query.
+func (iceq *InventoryCountEntryQuery) Order(o ...OrderFunc) *InventoryCountEntryQuery {
+	iceq.order = append(iceq.order, o...)
+	return iceq
+}
+
+// First returns the first InventoryCountEntry entity from the query.
+// Returns a *NotFoundError when no InventoryCountEntry was found.
+func (iceq *InventoryCountEntryQuery) First(ctx context.Context) (*InventoryCountEntry, error) {
+	nodes, err := iceq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{inventorycountentry.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iceq *InventoryCountEntryQuery) FirstX(ctx context.Context) *InventoryCountEntry {
+	node, err := iceq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InventoryCountEntry ID from the query.
+// Returns a *NotFoundError when no InventoryCountEntry ID was found.
+func (iceq *InventoryCountEntryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iceq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{inventorycountentry.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iceq *InventoryCountEntryQuery) FirstIDX(ctx context.Context) int {
+	id, err := iceq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InventoryCountEntry entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InventoryCountEntry entity is found.
+// Returns a *NotFoundError when no InventoryCountEntry entities are found.
+func (iceq *InventoryCountEntryQuery) Only(ctx context.Context) (*InventoryCountEntry, error) {
+	nodes, err := iceq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{inventorycountentry.Label}
+	default:
+		return nil, &NotSingularError{inventorycountentry.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iceq *InventoryCountEntryQuery) OnlyX(ctx context.Context) *InventoryCountEntry {
+	node, err := iceq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InventoryCountEntry ID in the query.
+// Returns a *NotSingularError when more than one InventoryCountEntry ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iceq *InventoryCountEntryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iceq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{inventorycountentry.Label}
+	default:
+		err = &NotSingularError{inventorycountentry.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iceq *InventoryCountEntryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := iceq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InventoryCountEntries.
+func (iceq *InventoryCountEntryQuery) All(ctx context.Context) ([]*InventoryCountEntry, error) {
+	if err := iceq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return iceq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iceq *InventoryCountEntryQuery) AllX(ctx context.Context) []*InventoryCountEntry {
+	nodes, err := iceq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InventoryCountEntry IDs.
+func (iceq *InventoryCountEntryQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := iceq.Select(inventorycountentry.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iceq *InventoryCountEntryQuery) IDsX(ctx context.Context) []int {
+	ids, err := iceq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iceq *InventoryCountEntryQuery) Count(ctx context.Context) (int, error) {
+	if err := iceq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return iceq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iceq *InventoryCountEntryQuery) CountX(ctx context.Context) int {
+	count, err := iceq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iceq *InventoryCountEntryQuery) Exist(ctx context.Context) (bool, error) {
+	if err := iceq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return iceq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iceq *InventoryCountEntryQuery) ExistX(ctx context.Context) bool {
+	exist, err := iceq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InventoryCountEntryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iceq *InventoryCountEntryQuery) Clone() *InventoryCountEntryQuery {
+	if iceq == nil {
+		return nil
+	}
+	return &InventoryCountEntryQuery{
+		config:     iceq.config,
+		limit:      iceq.limit,
+		offset:     iceq.offset,
+		order:      append([]OrderFunc{}, iceq.order...),
+		predicates: append([]predicate.InventoryCountEntry{}, iceq.predicates...),
+		// clone intermediate query.
+		sql:    iceq.sql.Clone(),
+		path:   iceq.path,
+		unique: iceq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CountID int `json:"count_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InventoryCountEntry.Query().
+//		GroupBy(inventorycountentry.FieldCountID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (iceq *InventoryCountEntryQuery) GroupBy(field string, fields ...string) *InventoryCountEntryGroupBy {
+	grbuild := &InventoryCountEntryGroupBy{config: iceq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := iceq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return iceq.sqlQuery(ctx), nil
+	}
+	grbuild.label = inventorycountentry.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CountID int `json:"count_id,omitempty"`
+//	}
+//
+//	client.InventoryCountEntry.Query().
+//		Select(inventorycountentry.FieldCountID).
+//		Scan(ctx, &v)
+func (iceq *InventoryCountEntryQuery) Select(fields ...string) *InventoryCountEntrySelect {
+	iceq.fields = append(iceq.fields, fields...)
+	selbuild := &InventoryCountEntrySelect{InventoryCountEntryQuery: iceq}
+	selbuild.label = inventorycountentry.Label
+	selbuild.flds, selbuild.scan = &iceq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (iceq *InventoryCountEntryQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range iceq.fields {
+		if !inventorycountentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if iceq.path != nil {
+		prev, err := iceq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iceq.sql = prev
+	}
+	return nil
+}
+
+func (iceq *InventoryCountEntryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InventoryCountEntry, error) {
+	var (
+		nodes = []*InventoryCountEntry{}
+		_spec = iceq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*InventoryCountEntry).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &InventoryCountEntry{config: iceq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(iceq.modifiers) > 0 {
+		_spec.Modifiers = iceq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iceq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (iceq *InventoryCountEntryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iceq.querySpec()
+	if len(iceq.modifiers) > 0 {
+		_spec.Modifiers = iceq.modifiers
+	}
+	_spec.Node.Columns = iceq.fields
+	if len(iceq.fields) > 0 {
+		_spec.Unique = iceq.unique != nil && *iceq.unique
+	}
+	return sqlgraph.CountNodes(ctx, iceq.driver, _spec)
+}
+
+func (iceq *InventoryCountEntryQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := iceq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (iceq *InventoryCountEntryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   inventorycountentry.Table,
+			Columns: inventorycountentry.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycountentry.FieldID,
+			},
+		},
+		From:   iceq.sql,
+		Unique: true,
+	}
+	if unique := iceq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := iceq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventorycountentry.FieldID)
+		for i := range fields {
+			if fields[i] != inventorycountentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := iceq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iceq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iceq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iceq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iceq *InventoryCountEntryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iceq.driver.Dialect())
+	t1 := builder.Table(inventorycountentry.Table)
+	columns := iceq.fields
+	if len(columns) == 0 {
+		columns = inventorycountentry.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iceq.sql != nil {
+		selector = iceq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iceq.unique != nil && *iceq.unique {
+		selector.Distinct()
+	}
+	for _, m := range iceq.modifiers {
+		m(selector)
+	}
+	for _, p := range iceq.predicates {
+		p(selector)
+	}
+	for _, p := range iceq.order {
+		p(selector)
+	}
+	if offset := iceq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iceq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (iceq *InventoryCountEntryQuery) ForUpdate(opts ...sql.LockOption) *InventoryCountEntryQuery {
+	if iceq.driver.Dialect() == dialect.Postgres {
+		iceq.Unique(false)
+	}
+	iceq.modifiers = append(iceq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return iceq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (iceq *InventoryCountEntryQuery) ForShare(opts ...sql.LockOption) *InventoryCountEntryQuery {
+	if iceq.driver.Dialect() == dialect.Postgres {
+		iceq.Unique(false)
+	}
+	iceq.modifiers = append(iceq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return iceq
+}
+
+// InventoryCountEntryGroupBy is the group-by builder for InventoryCountEntry entities.
+type InventoryCountEntryGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (icegb *InventoryCountEntryGroupBy) Aggregate(fns ...AggregateFunc) *InventoryCountEntryGroupBy {
+	icegb.fns = append(icegb.fns, fns...)
+	return icegb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (icegb *InventoryCountEntryGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := icegb.path(ctx)
+	if err != nil {
+		return err
+	}
+	icegb.sql = query
+	return icegb.sqlScan(ctx, v)
+}
+
+func (icegb *InventoryCountEntryGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range icegb.fields {
+		if !inventorycountentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := icegb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := icegb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (icegb *InventoryCountEntryGroupBy) sqlQuery() *sql.Selector {
+	selector := icegb.sql.Select()
+	aggregation := make([]string, 0, len(icegb.fns))
+	for _, fn := range icegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(icegb.fields)+len(icegb.fns))
+		for _, f := range icegb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(icegb.fields...)...)
+}
+
+// InventoryCountEntrySelect is the builder for selecting fields of InventoryCountEntry entities.
+type InventoryCountEntrySelect struct {
+	*InventoryCountEntryQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ices *InventoryCountEntrySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ices.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ices.sql = ices.InventoryCountEntryQuery.sqlQuery(ctx)
+	return ices.sqlScan(ctx, v)
+}
+
+func (ices *InventoryCountEntrySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ices.sql.Query()
+	if err := ices.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
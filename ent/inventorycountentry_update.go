@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycountentry"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryCountEntryUpdate is the builder for updating InventoryCountEntry entities.
+type InventoryCountEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InventoryCountEntryMutation
+}
+
+// Where appends a list predicates to the InventoryCountEntryUpdate builder.
+func (iceu *InventoryCountEntryUpdate) Where(ps ...predicate.InventoryCountEntry) *InventoryCountEntryUpdate {
+	iceu.mutation.Where(ps...)
+	return iceu
+}
+
+// SetCountID sets the "count_id" field.
+func (iceu *InventoryCountEntryUpdate) SetCountID(i int) *InventoryCountEntryUpdate {
+	iceu.mutation.ResetCountID()
+	iceu.mutation.SetCountID(i)
+	return iceu
+}
+
+// AddCountID adds i to the "count_id" field.
+func (iceu *InventoryCountEntryUpdate) AddCountID(i int) *InventoryCountEntryUpdate {
+	iceu.mutation.AddCountID(i)
+	return iceu
+}
+
+// SetLineID sets the "line_id" field.
+func (iceu *InventoryCountEntryUpdate) SetLineID(i int) *InventoryCountEntryUpdate {
+	iceu.mutation.ResetLineID()
+	iceu.mutation.SetLineID(i)
+	return iceu
+}
+
+// AddLineID adds i to the "line_id" field.
+func (iceu *InventoryCountEntryUpdate) AddLineID(i int) *InventoryCountEntryUpdate {
+	iceu.mutation.AddLineID(i)
+	return iceu
+}
+
+// SetQuantity sets the "quantity" field.
+func (iceu *InventoryCountEntryUpdate) SetQuantity(f float64) *InventoryCountEntryUpdate {
+	iceu.mutation.ResetQuantity()
+	iceu.mutation.SetQuantity(f)
+	return iceu
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (iceu *InventoryCountEntryUpdate) AddQuantity(f float64) *InventoryCountEntryUpdate {
+	iceu.mutation.AddQuantity(f)
+	return iceu
+}
+
+// SetDeviceID sets the "device_id" field.
+func (iceu *InventoryCountEntryUpdate) SetDeviceID(s string) *InventoryCountEntryUpdate {
+	iceu.mutation.SetDeviceID(s)
+	return iceu
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (iceu *InventoryCountEntryUpdate) SetNillableDeviceID(s *string) *InventoryCountEntryUpdate {
+	if s != nil {
+		iceu.SetDeviceID(*s)
+	}
+	return iceu
+}
+
+// ClearDeviceID clears the value of the "device_id" field.
+func (iceu *InventoryCountEntryUpdate) ClearDeviceID() *InventoryCountEntryUpdate {
+	iceu.mutation.ClearDeviceID()
+	return iceu
+}
+
+// SetUserID sets the "user_id" field.
+func (iceu *InventoryCountEntryUpdate) SetUserID(i int) *InventoryCountEntryUpdate {
+	iceu.mutation.ResetUserID()
+	iceu.mutation.SetUserID(i)
+	return iceu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (iceu *InventoryCountEntryUpdate) AddUserID(i int) *InventoryCountEntryUpdate {
+	iceu.mutation.AddUserID(i)
+	return iceu
+}
+
+// Mutation returns the InventoryCountEntryMutation object of the builder.
+func (iceu *InventoryCountEntryUpdate) Mutation() *InventoryCountEntryMutation {
+	return iceu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iceu *InventoryCountEntryUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(iceu.hooks) == 0 {
+		affected, err = iceu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InventoryCountEntryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iceu.mutation = mutation
+			affected, err = iceu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iceu.hooks) - 1; i >= 0; i-- {
+			if iceu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iceu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iceu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iceu *InventoryCountEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := iceu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iceu *InventoryCountEntryUpdate) Exec(ctx context.Context) error {
+	_, err := iceu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iceu *InventoryCountEntryUpdate) ExecX(ctx context.Context) {
+	if err := iceu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iceu *InventoryCountEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   inventorycountentry.Table,
+			Columns: inventorycountentry.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycountentry.FieldID,
+			},
+		},
+	}
+	if ps := iceu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iceu.mutation.CountID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountentry.FieldCountID,
+		})
+	}
+	if value, ok := iceu.mutation.AddedCountID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountentry.FieldCountID,
+		})
+	}
+	if value, ok := iceu.mutation.LineID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountentry.FieldLineID,
+		})
+	}
+	if value, ok := iceu.mutation.AddedLineID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountentry.FieldLineID,
+		})
+	}
+	if value, ok := iceu.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycountentry.FieldQuantity,
+		})
+	}
+	if value, ok := iceu.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycountentry.FieldQuantity,
+		})
+	}
+	if value, ok := iceu.mutation.DeviceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: inventorycountentry.FieldDeviceID,
+		})
+	}
+	if iceu.mutation.DeviceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: inventorycountentry.FieldDeviceID,
+		})
+	}
+	if value, ok := iceu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountentry.FieldUserID,
+		})
+	}
+	if value, ok := iceu.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountentry.FieldUserID,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iceu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventorycountentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InventoryCountEntryUpdateOne is the builder for updating a single InventoryCountEntry entity.
+type InventoryCountEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InventoryCountEntryMutation
+}
+
+// SetCountID sets the "count_id" field.
+func (iceuo *InventoryCountEntryUpdateOne) SetCountID(i int) *InventoryCountEntryUpdateOne {
+	iceuo.mutation.ResetCountID()
+	iceuo.mutation.SetCountID(i)
+	return iceuo
+}
+
+// AddCountID adds i to the "count_id" field.
+func (iceuo *InventoryCountEntryUpdateOne) AddCountID(i int) *InventoryCountEntryUpdateOne {
+	iceuo.mutation.AddCountID(i)
+	return iceuo
+}
+
+// SetLineID sets the "line_id" field.
+func (iceuo *InventoryCountEntryUpdateOne) SetLineID(i int) *InventoryCountEntryUpdateOne {
+	iceuo.mutation.ResetLineID()
+	iceuo.mutation.SetLineID(i)
+	return iceuo
+}
+
+// AddLineID adds i to the "line_id" field.
+func (iceuo *InventoryCountEntryUpdateOne) AddLineID(i int) *InventoryCountEntryUpdateOne {
+	iceuo.mutation.AddLineID(i)
+	return iceuo
+}
+
+// SetQuantity sets the "quantity" field.
+func (iceuo *InventoryCountEntryUpdateOne) SetQuantity(f float64) *InventoryCountEntryUpdateOne {
+	iceuo.mutation.ResetQuantity()
+	iceuo.mutation.SetQuantity(f)
+	return iceuo
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (iceuo *InventoryCountEntryUpdateOne) AddQuantity(f float64) *InventoryCountEntryUpdateOne {
+	iceuo.mutation.AddQuantity(f)
+	return iceuo
+}
+
+// SetDeviceID sets the "device_id" field.
+func (iceuo *InventoryCountEntryUpdateOne) SetDeviceID(s string) *InventoryCountEntryUpdateOne {
+	iceuo.mutation.SetDeviceID(s)
+	return iceuo
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (iceuo *InventoryCountEntryUpdateOne) SetNillableDeviceID(s *string) *InventoryCountEntryUpdateOne {
+	if s != nil {
+		iceuo.SetDeviceID(*s)
+	}
+	return iceuo
+}
+
+// ClearDeviceID clears the value of the "device_id" field.
+func (iceuo *InventoryCountEntryUpdateOne) ClearDeviceID() *InventoryCountEntryUpdateOne {
+	iceuo.mutation.ClearDeviceID()
+	return iceuo
+}
+
+// SetUserID sets the "user_id" field.
+func (iceuo *InventoryCountEntryUpdateOne) SetUserID(i int) *InventoryCountEntryUpdateOne {
+	iceuo.mutation.ResetUserID()
+	iceuo.mutation.SetUserID(i)
+	return iceuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (iceuo *InventoryCountEntryUpdateOne) AddUserID(i int) *InventoryCountEntryUpdateOne {
+	iceuo.mutation.AddUserID(i)
+	return iceuo
+}
+
+// Mutation returns the InventoryCountEntryMutation object of the builder.
+func (iceuo *InventoryCountEntryUpdateOne) Mutation() *InventoryCountEntryMutation {
+	return iceuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iceuo *InventoryCountEntryUpdateOne) Select(field string, fields ...string) *InventoryCountEntryUpdateOne {
+	iceuo.fields = append([]string{field}, fields...)
+	return iceuo
+}
+
+// Save executes the query and returns the updated InventoryCountEntry entity.
+func (iceuo *InventoryCountEntryUpdateOne) Save(ctx context.Context) (*InventoryCountEntry, error) {
+	var (
+		err  error
+		node *InventoryCountEntry
+	)
+	if len(iceuo.hooks) == 0 {
+		node, err = iceuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InventoryCountEntryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iceuo.mutation = mutation
+			node, err = iceuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iceuo.hooks) - 1; i >= 0; i-- {
+			if iceuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iceuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, iceuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InventoryCountEntry)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InventoryCountEntryMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iceuo *InventoryCountEntryUpdateOne) SaveX(ctx context.Context) *InventoryCountEntry {
+	node, err := iceuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iceuo *InventoryCountEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := iceuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iceuo *InventoryCountEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := iceuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iceuo *InventoryCountEntryUpdateOne) sqlSave(ctx context.Context) (_node *InventoryCountEntry, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   inventorycountentry.Table,
+			Columns: inventorycountentry.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycountentry.FieldID,
+			},
+		},
+	}
+	id, ok := iceuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InventoryCountEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iceuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventorycountentry.FieldID)
+		for _, f := range fields {
+			if !inventorycountentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != inventorycountentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iceuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iceuo.mutation.CountID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountentry.FieldCountID,
+		})
+	}
+	if value, ok := iceuo.mutation.AddedCountID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountentry.FieldCountID,
+		})
+	}
+	if value, ok := iceuo.mutation.LineID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountentry.FieldLineID,
+		})
+	}
+	if value, ok := iceuo.mutation.AddedLineID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountentry.FieldLineID,
+		})
+	}
+	if value, ok := iceuo.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycountentry.FieldQuantity,
+		})
+	}
+	if value, ok := iceuo.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycountentry.FieldQuantity,
+		})
+	}
+	if value, ok := iceuo.mutation.DeviceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: inventorycountentry.FieldDeviceID,
+		})
+	}
+	if iceuo.mutation.DeviceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: inventorycountentry.FieldDeviceID,
+		})
+	}
+	if value, ok := iceuo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountentry.FieldUserID,
+		})
+	}
+	if value, ok := iceuo.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountentry.FieldUserID,
+		})
+	}
+	_node = &InventoryCountEntry{config: iceuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iceuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventorycountentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
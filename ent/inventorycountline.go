@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycountline"
+	"Veritasbackend/pkg/money"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// InventoryCountLine is the model entity for the InventoryCountLine schema.
+type InventoryCountLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID de la toma de inventario
+	CountID int `json:"count_id,omitempty"`
+	// ID del producto
+	ProductID int `json:"product_id,omitempty"`
+	// Stock del sistema al abrir la toma
+	ExpectedQuantity float64 `json:"expected_quantity,omitempty"`
+	// Cantidad contada (suma de los conteos de todos los dispositivos)
+	CountedQuantity *float64 `json:"counted_quantity,omitempty"`
+	// Costo unitario al abrir la toma, usado para valorizar diferencias
+	UnitCost money.Amount `json:"unit_cost,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InventoryCountLine) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case inventorycountline.FieldUnitCost:
+			values[i] = new(money.Amount)
+		case inventorycountline.FieldExpectedQuantity, inventorycountline.FieldCountedQuantity:
+			values[i] = new(sql.NullFloat64)
+		case inventorycountline.FieldID, inventorycountline.FieldCountID, inventorycountline.FieldProductID:
+			values[i] = new(sql.NullInt64)
+		case inventorycountline.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type InventoryCountLine", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InventoryCountLine fields.
+func (icl *InventoryCountLine) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case inventorycountline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			icl.ID = int(value.Int64)
+		case inventorycountline.FieldCountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field count_id", values[i])
+			} else if value.Valid {
+				icl.CountID = int(value.Int64)
+			}
+		case inventorycountline.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				icl.ProductID = int(value.Int64)
+			}
+		case inventorycountline.FieldExpectedQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_quantity", values[i])
+			} else if value.Valid {
+				icl.ExpectedQuantity = value.Float64
+			}
+		case inventorycountline.FieldCountedQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field counted_quantity", values[i])
+			} else if value.Valid {
+				icl.CountedQuantity = new(float64)
+				*icl.CountedQuantity = value.Float64
+			}
+		case inventorycountline.FieldUnitCost:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_cost", values[i])
+			} else if value != nil {
+				icl.UnitCost = *value
+			}
+		case inventorycountline.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				icl.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this InventoryCountLine.
+// Note that you need to call InventoryCountLine.Unwrap() before calling this method if this InventoryCountLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (icl *InventoryCountLine) Update() *InventoryCountLineUpdateOne {
+	return (&InventoryCountLineClient{config: icl.config}).UpdateOne(icl)
+}
+
+// Unwrap unwraps the InventoryCountLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (icl *InventoryCountLine) Unwrap() *InventoryCountLine {
+	_tx, ok := icl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InventoryCountLine is not a transactional entity")
+	}
+	icl.config.driver = _tx.drv
+	return icl
+}
+
+// String implements the fmt.Stringer.
+func (icl *InventoryCountLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("InventoryCountLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", icl.ID))
+	builder.WriteString("count_id=")
+	builder.WriteString(fmt.Sprintf("%v", icl.CountID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", icl.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("expected_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", icl.ExpectedQuantity))
+	builder.WriteString(", ")
+	if v := icl.CountedQuantity; v != nil {
+		builder.WriteString("counted_quantity=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("unit_cost=")
+	builder.WriteString(fmt.Sprintf("%v", icl.UnitCost))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(icl.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InventoryCountLines is a parsable slice of InventoryCountLine.
+type InventoryCountLines []*InventoryCountLine
+
+func (icl InventoryCountLines) config(cfg config) {
+	for _i := range icl {
+		icl[_i].config = cfg
+	}
+}
@@ -0,0 +1,61 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventorycountline
+
+import (
+	"Veritasbackend/pkg/money"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the inventorycountline type in the database.
+	Label = "inventory_count_line"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCountID holds the string denoting the count_id field in the database.
+	FieldCountID = "count_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldExpectedQuantity holds the string denoting the expected_quantity field in the database.
+	FieldExpectedQuantity = "expected_quantity"
+	// FieldCountedQuantity holds the string denoting the counted_quantity field in the database.
+	FieldCountedQuantity = "counted_quantity"
+	// FieldUnitCost holds the string denoting the unit_cost field in the database.
+	FieldUnitCost = "unit_cost"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the inventorycountline in the database.
+	Table = "inventory_count_lines"
+)
+
+// Columns holds all SQL columns for inventorycountline fields.
+var Columns = []string{
+	FieldID,
+	FieldCountID,
+	FieldProductID,
+	FieldExpectedQuantity,
+	FieldCountedQuantity,
+	FieldUnitCost,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUnitCost holds the default value on creation for the "unit_cost" field.
+	DefaultUnitCost money.Amount
+	// UnitCostValidator is a validator for the "unit_cost" field. It is called by the builders before save.
+	UnitCostValidator func(int64) error
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
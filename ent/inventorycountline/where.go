@@ -0,0 +1,626 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventorycountline
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CountID applies equality check predicate on the "count_id" field. It's identical to CountIDEQ.
+func CountID(v int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCountID), v))
+	})
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// ExpectedQuantity applies equality check predicate on the "expected_quantity" field. It's identical to ExpectedQuantityEQ.
+func ExpectedQuantity(v float64) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpectedQuantity), v))
+	})
+}
+
+// CountedQuantity applies equality check predicate on the "counted_quantity" field. It's identical to CountedQuantityEQ.
+func CountedQuantity(v float64) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCountedQuantity), v))
+	})
+}
+
+// UnitCost applies equality check predicate on the "unit_cost" field. It's identical to UnitCostEQ.
+func UnitCost(v money.Amount) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// CountIDEQ applies the EQ predicate on the "count_id" field.
+func CountIDEQ(v int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCountID), v))
+	})
+}
+
+// CountIDNEQ applies the NEQ predicate on the "count_id" field.
+func CountIDNEQ(v int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCountID), v))
+	})
+}
+
+// CountIDIn applies the In predicate on the "count_id" field.
+func CountIDIn(vs ...int) predicate.InventoryCountLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCountID), v...))
+	})
+}
+
+// CountIDNotIn applies the NotIn predicate on the "count_id" field.
+func CountIDNotIn(vs ...int) predicate.InventoryCountLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCountID), v...))
+	})
+}
+
+// CountIDGT applies the GT predicate on the "count_id" field.
+func CountIDGT(v int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCountID), v))
+	})
+}
+
+// CountIDGTE applies the GTE predicate on the "count_id" field.
+func CountIDGTE(v int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCountID), v))
+	})
+}
+
+// CountIDLT applies the LT predicate on the "count_id" field.
+func CountIDLT(v int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCountID), v))
+	})
+}
+
+// CountIDLTE applies the LTE predicate on the "count_id" field.
+func CountIDLTE(v int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCountID), v))
+	})
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.InventoryCountLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.InventoryCountLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v int) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProductID), v))
+	})
+}
+
+// ExpectedQuantityEQ applies the EQ predicate on the "expected_quantity" field.
+func ExpectedQuantityEQ(v float64) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpectedQuantity), v))
+	})
+}
+
+// ExpectedQuantityNEQ applies the NEQ predicate on the "expected_quantity" field.
+func ExpectedQuantityNEQ(v float64) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpectedQuantity), v))
+	})
+}
+
+// ExpectedQuantityIn applies the In predicate on the "expected_quantity" field.
+func ExpectedQuantityIn(vs ...float64) predicate.InventoryCountLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpectedQuantity), v...))
+	})
+}
+
+// ExpectedQuantityNotIn applies the NotIn predicate on the "expected_quantity" field.
+func ExpectedQuantityNotIn(vs ...float64) predicate.InventoryCountLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpectedQuantity), v...))
+	})
+}
+
+// ExpectedQuantityGT applies the GT predicate on the "expected_quantity" field.
+func ExpectedQuantityGT(v float64) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpectedQuantity), v))
+	})
+}
+
+// ExpectedQuantityGTE applies the GTE predicate on the "expected_quantity" field.
+func ExpectedQuantityGTE(v float64) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpectedQuantity), v))
+	})
+}
+
+// ExpectedQuantityLT applies the LT predicate on the "expected_quantity" field.
+func ExpectedQuantityLT(v float64) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpectedQuantity), v))
+	})
+}
+
+// ExpectedQuantityLTE applies the LTE predicate on the "expected_quantity" field.
+func ExpectedQuantityLTE(v float64) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpectedQuantity), v))
+	})
+}
+
+// CountedQuantityEQ applies the EQ predicate on the "counted_quantity" field.
+func CountedQuantityEQ(v float64) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCountedQuantity), v))
+	})
+}
+
+// CountedQuantityNEQ applies the NEQ predicate on the "counted_quantity" field.
+func CountedQuantityNEQ(v float64) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCountedQuantity), v))
+	})
+}
+
+// CountedQuantityIn applies the In predicate on the "counted_quantity" field.
+func CountedQuantityIn(vs ...float64) predicate.InventoryCountLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCountedQuantity), v...))
+	})
+}
+
+// CountedQuantityNotIn applies the NotIn predicate on the "counted_quantity" field.
+func CountedQuantityNotIn(vs ...float64) predicate.InventoryCountLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCountedQuantity), v...))
+	})
+}
+
+// CountedQuantityGT applies the GT predicate on the "counted_quantity" field.
+func CountedQuantityGT(v float64) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCountedQuantity), v))
+	})
+}
+
+// CountedQuantityGTE applies the GTE predicate on the "counted_quantity" field.
+func CountedQuantityGTE(v float64) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCountedQuantity), v))
+	})
+}
+
+// CountedQuantityLT applies the LT predicate on the "counted_quantity" field.
+func CountedQuantityLT(v float64) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCountedQuantity), v))
+	})
+}
+
+// CountedQuantityLTE applies the LTE predicate on the "counted_quantity" field.
+func CountedQuantityLTE(v float64) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCountedQuantity), v))
+	})
+}
+
+// CountedQuantityIsNil applies the IsNil predicate on the "counted_quantity" field.
+func CountedQuantityIsNil() predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCountedQuantity)))
+	})
+}
+
+// CountedQuantityNotNil applies the NotNil predicate on the "counted_quantity" field.
+func CountedQuantityNotNil() predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCountedQuantity)))
+	})
+}
+
+// UnitCostEQ applies the EQ predicate on the "unit_cost" field.
+func UnitCostEQ(v money.Amount) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostNEQ applies the NEQ predicate on the "unit_cost" field.
+func UnitCostNEQ(v money.Amount) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostIn applies the In predicate on the "unit_cost" field.
+func UnitCostIn(vs ...money.Amount) predicate.InventoryCountLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUnitCost), v...))
+	})
+}
+
+// UnitCostNotIn applies the NotIn predicate on the "unit_cost" field.
+func UnitCostNotIn(vs ...money.Amount) predicate.InventoryCountLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUnitCost), v...))
+	})
+}
+
+// UnitCostGT applies the GT predicate on the "unit_cost" field.
+func UnitCostGT(v money.Amount) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostGTE applies the GTE predicate on the "unit_cost" field.
+func UnitCostGTE(v money.Amount) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostLT applies the LT predicate on the "unit_cost" field.
+func UnitCostLT(v money.Amount) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostLTE applies the LTE predicate on the "unit_cost" field.
+func UnitCostLTE(v money.Amount) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUnitCost), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InventoryCountLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InventoryCountLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InventoryCountLine) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InventoryCountLine) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InventoryCountLine) predicate.InventoryCountLine {
+	return predicate.InventoryCountLine(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
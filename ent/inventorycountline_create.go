@@ -0,0 +1,353 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycountline"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryCountLineCreate is the builder for creating a InventoryCountLine entity.
+type InventoryCountLineCreate struct {
+	config
+	mutation *InventoryCountLineMutation
+	hooks    []Hook
+}
+
+// SetCountID sets the "count_id" field.
+func (iclc *InventoryCountLineCreate) SetCountID(i int) *InventoryCountLineCreate {
+	iclc.mutation.SetCountID(i)
+	return iclc
+}
+
+// SetProductID sets the "product_id" field.
+func (iclc *InventoryCountLineCreate) SetProductID(i int) *InventoryCountLineCreate {
+	iclc.mutation.SetProductID(i)
+	return iclc
+}
+
+// SetExpectedQuantity sets the "expected_quantity" field.
+func (iclc *InventoryCountLineCreate) SetExpectedQuantity(f float64) *InventoryCountLineCreate {
+	iclc.mutation.SetExpectedQuantity(f)
+	return iclc
+}
+
+// SetCountedQuantity sets the "counted_quantity" field.
+func (iclc *InventoryCountLineCreate) SetCountedQuantity(f float64) *InventoryCountLineCreate {
+	iclc.mutation.SetCountedQuantity(f)
+	return iclc
+}
+
+// SetNillableCountedQuantity sets the "counted_quantity" field if the given value is not nil.
+func (iclc *InventoryCountLineCreate) SetNillableCountedQuantity(f *float64) *InventoryCountLineCreate {
+	if f != nil {
+		iclc.SetCountedQuantity(*f)
+	}
+	return iclc
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (iclc *InventoryCountLineCreate) SetUnitCost(m money.Amount) *InventoryCountLineCreate {
+	iclc.mutation.SetUnitCost(m)
+	return iclc
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (iclc *InventoryCountLineCreate) SetNillableUnitCost(m *money.Amount) *InventoryCountLineCreate {
+	if m != nil {
+		iclc.SetUnitCost(*m)
+	}
+	return iclc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iclc *InventoryCountLineCreate) SetUpdatedAt(t time.Time) *InventoryCountLineCreate {
+	iclc.mutation.SetUpdatedAt(t)
+	return iclc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (iclc *InventoryCountLineCreate) SetNillableUpdatedAt(t *time.Time) *InventoryCountLineCreate {
+	if t != nil {
+		iclc.SetUpdatedAt(*t)
+	}
+	return iclc
+}
+
+// Mutation returns the InventoryCountLineMutation object of the builder.
+func (iclc *InventoryCountLineCreate) Mutation() *InventoryCountLineMutation {
+	return iclc.mutation
+}
+
+// Save creates the InventoryCountLine in the database.
+func (iclc *InventoryCountLineCreate) Save(ctx context.Context) (*InventoryCountLine, error) {
+	var (
+		err  error
+		node *InventoryCountLine
+	)
+	iclc.defaults()
+	if len(iclc.hooks) == 0 {
+		if err = iclc.check(); err != nil {
+			return nil, err
+		}
+		node, err = iclc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InventoryCountLineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iclc.check(); err != nil {
+				return nil, err
+			}
+			iclc.mutation = mutation
+			if node, err = iclc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iclc.hooks) - 1; i >= 0; i-- {
+			if iclc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iclc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, iclc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InventoryCountLine)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InventoryCountLineMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iclc *InventoryCountLineCreate) SaveX(ctx context.Context) *InventoryCountLine {
+	v, err := iclc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iclc *InventoryCountLineCreate) Exec(ctx context.Context) error {
+	_, err := iclc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iclc *InventoryCountLineCreate) ExecX(ctx context.Context) {
+	if err := iclc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iclc *InventoryCountLineCreate) defaults() {
+	if _, ok := iclc.mutation.UnitCost(); !ok {
+		v := inventorycountline.DefaultUnitCost
+		iclc.mutation.SetUnitCost(v)
+	}
+	if _, ok := iclc.mutation.UpdatedAt(); !ok {
+		v := inventorycountline.DefaultUpdatedAt()
+		iclc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iclc *InventoryCountLineCreate) check() error {
+	if _, ok := iclc.mutation.CountID(); !ok {
+		return &ValidationError{Name: "count_id", err: errors.New(`ent: missing required field "InventoryCountLine.count_id"`)}
+	}
+	if _, ok := iclc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "InventoryCountLine.product_id"`)}
+	}
+	if _, ok := iclc.mutation.ExpectedQuantity(); !ok {
+		return &ValidationError{Name: "expected_quantity", err: errors.New(`ent: missing required field "InventoryCountLine.expected_quantity"`)}
+	}
+	if _, ok := iclc.mutation.UnitCost(); !ok {
+		return &ValidationError{Name: "unit_cost", err: errors.New(`ent: missing required field "InventoryCountLine.unit_cost"`)}
+	}
+	if v, ok := iclc.mutation.UnitCost(); ok {
+		if err := inventorycountline.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "InventoryCountLine.unit_cost": %w`, err)}
+		}
+	}
+	if _, ok := iclc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InventoryCountLine.updated_at"`)}
+	}
+	return nil
+}
+
+func (iclc *InventoryCountLineCreate) sqlSave(ctx context.Context) (*InventoryCountLine, error) {
+	_node, _spec := iclc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iclc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (iclc *InventoryCountLineCreate) createSpec() (*InventoryCountLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InventoryCountLine{config: iclc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: inventorycountline.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycountline.FieldID,
+			},
+		}
+	)
+	if value, ok := iclc.mutation.CountID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountline.FieldCountID,
+		})
+		_node.CountID = value
+	}
+	if value, ok := iclc.mutation.ProductID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountline.FieldProductID,
+		})
+		_node.ProductID = value
+	}
+	if value, ok := iclc.mutation.ExpectedQuantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycountline.FieldExpectedQuantity,
+		})
+		_node.ExpectedQuantity = value
+	}
+	if value, ok := iclc.mutation.CountedQuantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycountline.FieldCountedQuantity,
+		})
+		_node.CountedQuantity = &value
+	}
+	if value, ok := iclc.mutation.UnitCost(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: inventorycountline.FieldUnitCost,
+		})
+		_node.UnitCost = value
+	}
+	if value, ok := iclc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: inventorycountline.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// InventoryCountLineCreateBulk is the builder for creating many InventoryCountLine entities in bulk.
+type InventoryCountLineCreateBulk struct {
+	config
+	builders []*InventoryCountLineCreate
+}
+
+// Save creates the InventoryCountLine entities in the database.
+func (iclcb *InventoryCountLineCreateBulk) Save(ctx context.Context) ([]*InventoryCountLine, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(iclcb.builders))
+	nodes := make([]*InventoryCountLine, len(iclcb.builders))
+	mutators := make([]Mutator, len(iclcb.builders))
+	for i := range iclcb.builders {
+		func(i int, root context.Context) {
+			builder := iclcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InventoryCountLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iclcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iclcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iclcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iclcb *InventoryCountLineCreateBulk) SaveX(ctx context.Context) []*InventoryCountLine {
+	v, err := iclcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iclcb *InventoryCountLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := iclcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iclcb *InventoryCountLineCreateBulk) ExecX(ctx context.Context) {
+	if err := iclcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
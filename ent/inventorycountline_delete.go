@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycountline"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryCountLineDelete is the builder for deleting a InventoryCountLine entity.
+type InventoryCountLineDelete struct {
+	config
+	hooks    []Hook
+	mutation *InventoryCountLineMutation
+}
+
+// Where appends a list predicates to the InventoryCountLineDelete builder.
+func (icld *InventoryCountLineDelete) Where(ps ...predicate.InventoryCountLine) *InventoryCountLineDelete {
+	icld.mutation.Where(ps...)
+	return icld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (icld *InventoryCountLineDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(icld.hooks) == 0 {
+		affected, err = icld.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InventoryCountLineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			icld.mutation = mutation
+			affected, err = icld.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(icld.hooks) - 1; i >= 0; i-- {
+			if icld.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = icld.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, icld.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icld *InventoryCountLineDelete) ExecX(ctx context.Context) int {
+	n, err := icld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (icld *InventoryCountLineDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: inventorycountline.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycountline.FieldID,
+			},
+		},
+	}
+	if ps := icld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, icld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// InventoryCountLineDeleteOne is the builder for deleting a single InventoryCountLine entity.
+type InventoryCountLineDeleteOne struct {
+	icld *InventoryCountLineDelete
+}
+
+// Exec executes the deletion query.
+func (icldo *InventoryCountLineDeleteOne) Exec(ctx context.Context) error {
+	n, err := icldo.icld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{inventorycountline.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icldo *InventoryCountLineDeleteOne) ExecX(ctx context.Context) {
+	icldo.icld.ExecX(ctx)
+}
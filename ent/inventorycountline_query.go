@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycountline"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryCountLineQuery is the builder for querying InventoryCountLine entities.
+type InventoryCountLineQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.InventoryCountLine
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InventoryCountLineQuery builder.
+func (iclq *InventoryCountLineQuery) Where(ps ...predicate.InventoryCountLine) *InventoryCountLineQuery {
+	iclq.predicates = append(iclq.predicates, ps...)
+	return iclq
+}
+
+// Limit adds a limit step to the query.
+func (iclq *InventoryCountLineQuery) Limit(limit int) *InventoryCountLineQuery {
+	iclq.limit = &limit
+	return iclq
+}
+
+// Offset adds an offset step to the query.
+func (iclq *InventoryCountLineQuery) Offset(offset int) *InventoryCountLineQuery {
+	iclq.offset = &offset
+	return iclq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iclq *InventoryCountLineQuery) Unique(unique bool) *InventoryCountLineQuery {
+	iclq.unique = &unique
+	return iclq
+}
+
+// Order adds an order step to the query.
+func (iclq *InventoryCountLineQuery) Order(o ...OrderFunc) *InventoryCountLineQuery {
+	iclq.order = append(iclq.order, o...)
+	return iclq
+}
+
+// First returns the first InventoryCountLine entity from the query.
+// Returns a *NotFoundError when no InventoryCountLine was found.
+func (iclq *InventoryCountLineQuery) First(ctx context.Context) (*InventoryCountLine, error) {
+	nodes, err := iclq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{inventorycountline.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iclq *InventoryCountLineQuery) FirstX(ctx context.Context) *InventoryCountLine {
+	node, err := iclq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InventoryCountLine ID from the query.
+// Returns a *NotFoundError when no InventoryCountLine ID was found.
+func (iclq *InventoryCountLineQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iclq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{inventorycountline.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iclq *InventoryCountLineQuery) FirstIDX(ctx context.Context) int {
+	id, err := iclq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InventoryCountLine entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InventoryCountLine entity is found.
+// Returns a *NotFoundError when no InventoryCountLine entities are found.
+func (iclq *InventoryCountLineQuery) Only(ctx context.Context) (*InventoryCountLine, error) {
+	nodes, err := iclq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{inventorycountline.Label}
+	default:
+		return nil, &NotSingularError{inventorycountline.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iclq *InventoryCountLineQuery) OnlyX(ctx context.Context) *InventoryCountLine {
+	node, err := iclq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InventoryCountLine ID in the query.
+// Returns a *NotSingularError when more than one InventoryCountLine ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iclq *InventoryCountLineQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iclq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{inventorycountline.Label}
+	default:
+		err = &NotSingularError{inventorycountline.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iclq *InventoryCountLineQuery) OnlyIDX(ctx context.Context) int {
+	id, err := iclq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InventoryCountLines.
+func (iclq *InventoryCountLineQuery) All(ctx context.Context) ([]*InventoryCountLine, error) {
+	if err := iclq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return iclq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iclq *InventoryCountLineQuery) AllX(ctx context.Context) []*InventoryCountLine {
+	nodes, err := iclq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InventoryCountLine IDs.
+func (iclq *InventoryCountLineQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := iclq.Select(inventorycountline.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iclq *InventoryCountLineQuery) IDsX(ctx context.Context) []int {
+	ids, err := iclq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iclq *InventoryCountLineQuery) Count(ctx context.Context) (int, error) {
+	if err := iclq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return iclq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iclq *InventoryCountLineQuery) CountX(ctx context.Context) int {
+	count, err := iclq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iclq *InventoryCountLineQuery) Exist(ctx context.Context) (bool, error) {
+	if err := iclq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return iclq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iclq *InventoryCountLineQuery) ExistX(ctx context.Context) bool {
+	exist, err := iclq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InventoryCountLineQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iclq *InventoryCountLineQuery) Clone() *InventoryCountLineQuery {
+	if iclq == nil {
+		return nil
+	}
+	return &InventoryCountLineQuery{
+		config:     iclq.config,
+		limit:      iclq.limit,
+		offset:     iclq.offset,
+		order:      append([]OrderFunc{}, iclq.order...),
+		predicates: append([]predicate.InventoryCountLine{}, iclq.predicates...),
+		// clone intermediate query.
+		sql:    iclq.sql.Clone(),
+		path:   iclq.path,
+		unique: iclq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CountID int `json:"count_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InventoryCountLine.Query().
+//		GroupBy(inventorycountline.FieldCountID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (iclq *InventoryCountLineQuery) GroupBy(field string, fields ...string) *InventoryCountLineGroupBy {
+	grbuild := &InventoryCountLineGroupBy{config: iclq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := iclq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return iclq.sqlQuery(ctx), nil
+	}
+	grbuild.label = inventorycountline.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CountID int `json:"count_id,omitempty"`
+//	}
+//
+//	client.InventoryCountLine.Query().
+//		Select(inventorycountline.FieldCountID).
+//		Scan(ctx, &v)
+func (iclq *InventoryCountLineQuery) Select(fields ...string) *InventoryCountLineSelect {
+	iclq.fields = append(iclq.fields, fields...)
+	selbuild := &InventoryCountLineSelect{InventoryCountLineQuery: iclq}
+	selbuild.label = inventorycountline.Label
+	selbuild.flds, selbuild.scan = &iclq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (iclq *InventoryCountLineQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range iclq.fields {
+		if !inventorycountline.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if iclq.path != nil {
+		prev, err := iclq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iclq.sql = prev
+	}
+	return nil
+}
+
+func (iclq *InventoryCountLineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InventoryCountLine, error) {
+	var (
+		nodes = []*InventoryCountLine{}
+		_spec = iclq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*InventoryCountLine).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &InventoryCountLine{config: iclq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(iclq.modifiers) > 0 {
+		_spec.Modifiers = iclq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iclq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (iclq *InventoryCountLineQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iclq.querySpec()
+	if len(iclq.modifiers) > 0 {
+		_spec.Modifiers = iclq.modifiers
+	}
+	_spec.Node.Columns = iclq.fields
+	if len(iclq.fields) > 0 {
+		_spec.Unique = iclq.unique != nil && *iclq.unique
+	}
+	return sqlgraph.CountNodes(ctx, iclq.driver, _spec)
+}
+
+func (iclq *InventoryCountLineQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := iclq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (iclq *InventoryCountLineQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   inventorycountline.Table,
+			Columns: inventorycountline.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycountline.FieldID,
+			},
+		},
+		From:   iclq.sql,
+		Unique: true,
+	}
+	if unique := iclq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := iclq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventorycountline.FieldID)
+		for i := range fields {
+			if fields[i] != inventorycountline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := iclq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iclq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iclq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iclq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iclq *InventoryCountLineQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iclq.driver.Dialect())
+	t1 := builder.Table(inventorycountline.Table)
+	columns := iclq.fields
+	if len(columns) == 0 {
+		columns = inventorycountline.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iclq.sql != nil {
+		selector = iclq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iclq.unique != nil && *iclq.unique {
+		selector.Distinct()
+	}
+	for _, m := range iclq.modifiers {
+		m(selector)
+	}
+	for _, p := range iclq.predicates {
+		p(selector)
+	}
+	for _, p := range iclq.order {
+		p(selector)
+	}
+	if offset := iclq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iclq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (iclq *InventoryCountLineQuery) ForUpdate(opts ...sql.LockOption) *InventoryCountLineQuery {
+	if iclq.driver.Dialect() == dialect.Postgres {
+		iclq.Unique(false)
+	}
+	iclq.modifiers = append(iclq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return iclq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (iclq *InventoryCountLineQuery) ForShare(opts ...sql.LockOption) *InventoryCountLineQuery {
+	if iclq.driver.Dialect() == dialect.Postgres {
+		iclq.Unique(false)
+	}
+	iclq.modifiers = append(iclq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return iclq
+}
+
+// InventoryCountLineGroupBy is the group-by builder for InventoryCountLine entities.
+type InventoryCountLineGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iclgb *InventoryCountLineGroupBy) Aggregate(fns ...AggregateFunc) *InventoryCountLineGroupBy {
+	iclgb.fns = append(iclgb.fns, fns...)
+	return iclgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (iclgb *InventoryCountLineGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := iclgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	iclgb.sql = query
+	return iclgb.sqlScan(ctx, v)
+}
+
+func (iclgb *InventoryCountLineGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range iclgb.fields {
+		if !inventorycountline.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := iclgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iclgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (iclgb *InventoryCountLineGroupBy) sqlQuery() *sql.Selector {
+	selector := iclgb.sql.Select()
+	aggregation := make([]string, 0, len(iclgb.fns))
+	for _, fn := range iclgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(iclgb.fields)+len(iclgb.fns))
+		for _, f := range iclgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(iclgb.fields...)...)
+}
+
+// InventoryCountLineSelect is the builder for selecting fields of InventoryCountLine entities.
+type InventoryCountLineSelect struct {
+	*InventoryCountLineQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (icls *InventoryCountLineSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := icls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	icls.sql = icls.InventoryCountLineQuery.sqlQuery(ctx)
+	return icls.sqlScan(ctx, v)
+}
+
+func (icls *InventoryCountLineSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := icls.sql.Query()
+	if err := icls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
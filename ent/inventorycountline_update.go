@@ -0,0 +1,649 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/inventorycountline"
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryCountLineUpdate is the builder for updating InventoryCountLine entities.
+type InventoryCountLineUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InventoryCountLineMutation
+}
+
+// Where appends a list predicates to the InventoryCountLineUpdate builder.
+func (iclu *InventoryCountLineUpdate) Where(ps ...predicate.InventoryCountLine) *InventoryCountLineUpdate {
+	iclu.mutation.Where(ps...)
+	return iclu
+}
+
+// SetCountID sets the "count_id" field.
+func (iclu *InventoryCountLineUpdate) SetCountID(i int) *InventoryCountLineUpdate {
+	iclu.mutation.ResetCountID()
+	iclu.mutation.SetCountID(i)
+	return iclu
+}
+
+// AddCountID adds i to the "count_id" field.
+func (iclu *InventoryCountLineUpdate) AddCountID(i int) *InventoryCountLineUpdate {
+	iclu.mutation.AddCountID(i)
+	return iclu
+}
+
+// SetProductID sets the "product_id" field.
+func (iclu *InventoryCountLineUpdate) SetProductID(i int) *InventoryCountLineUpdate {
+	iclu.mutation.ResetProductID()
+	iclu.mutation.SetProductID(i)
+	return iclu
+}
+
+// AddProductID adds i to the "product_id" field.
+func (iclu *InventoryCountLineUpdate) AddProductID(i int) *InventoryCountLineUpdate {
+	iclu.mutation.AddProductID(i)
+	return iclu
+}
+
+// SetExpectedQuantity sets the "expected_quantity" field.
+func (iclu *InventoryCountLineUpdate) SetExpectedQuantity(f float64) *InventoryCountLineUpdate {
+	iclu.mutation.ResetExpectedQuantity()
+	iclu.mutation.SetExpectedQuantity(f)
+	return iclu
+}
+
+// AddExpectedQuantity adds f to the "expected_quantity" field.
+func (iclu *InventoryCountLineUpdate) AddExpectedQuantity(f float64) *InventoryCountLineUpdate {
+	iclu.mutation.AddExpectedQuantity(f)
+	return iclu
+}
+
+// SetCountedQuantity sets the "counted_quantity" field.
+func (iclu *InventoryCountLineUpdate) SetCountedQuantity(f float64) *InventoryCountLineUpdate {
+	iclu.mutation.ResetCountedQuantity()
+	iclu.mutation.SetCountedQuantity(f)
+	return iclu
+}
+
+// SetNillableCountedQuantity sets the "counted_quantity" field if the given value is not nil.
+func (iclu *InventoryCountLineUpdate) SetNillableCountedQuantity(f *float64) *InventoryCountLineUpdate {
+	if f != nil {
+		iclu.SetCountedQuantity(*f)
+	}
+	return iclu
+}
+
+// AddCountedQuantity adds f to the "counted_quantity" field.
+func (iclu *InventoryCountLineUpdate) AddCountedQuantity(f float64) *InventoryCountLineUpdate {
+	iclu.mutation.AddCountedQuantity(f)
+	return iclu
+}
+
+// ClearCountedQuantity clears the value of the "counted_quantity" field.
+func (iclu *InventoryCountLineUpdate) ClearCountedQuantity() *InventoryCountLineUpdate {
+	iclu.mutation.ClearCountedQuantity()
+	return iclu
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (iclu *InventoryCountLineUpdate) SetUnitCost(m money.Amount) *InventoryCountLineUpdate {
+	iclu.mutation.ResetUnitCost()
+	iclu.mutation.SetUnitCost(m)
+	return iclu
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (iclu *InventoryCountLineUpdate) SetNillableUnitCost(m *money.Amount) *InventoryCountLineUpdate {
+	if m != nil {
+		iclu.SetUnitCost(*m)
+	}
+	return iclu
+}
+
+// AddUnitCost adds m to the "unit_cost" field.
+func (iclu *InventoryCountLineUpdate) AddUnitCost(m money.Amount) *InventoryCountLineUpdate {
+	iclu.mutation.AddUnitCost(m)
+	return iclu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iclu *InventoryCountLineUpdate) SetUpdatedAt(t time.Time) *InventoryCountLineUpdate {
+	iclu.mutation.SetUpdatedAt(t)
+	return iclu
+}
+
+// Mutation returns the InventoryCountLineMutation object of the builder.
+func (iclu *InventoryCountLineUpdate) Mutation() *InventoryCountLineMutation {
+	return iclu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iclu *InventoryCountLineUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	iclu.defaults()
+	if len(iclu.hooks) == 0 {
+		if err = iclu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = iclu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InventoryCountLineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iclu.check(); err != nil {
+				return 0, err
+			}
+			iclu.mutation = mutation
+			affected, err = iclu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iclu.hooks) - 1; i >= 0; i-- {
+			if iclu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iclu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iclu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iclu *InventoryCountLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := iclu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iclu *InventoryCountLineUpdate) Exec(ctx context.Context) error {
+	_, err := iclu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iclu *InventoryCountLineUpdate) ExecX(ctx context.Context) {
+	if err := iclu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iclu *InventoryCountLineUpdate) defaults() {
+	if _, ok := iclu.mutation.UpdatedAt(); !ok {
+		v := inventorycountline.UpdateDefaultUpdatedAt()
+		iclu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iclu *InventoryCountLineUpdate) check() error {
+	if v, ok := iclu.mutation.UnitCost(); ok {
+		if err := inventorycountline.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "InventoryCountLine.unit_cost": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iclu *InventoryCountLineUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   inventorycountline.Table,
+			Columns: inventorycountline.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycountline.FieldID,
+			},
+		},
+	}
+	if ps := iclu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iclu.mutation.CountID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountline.FieldCountID,
+		})
+	}
+	if value, ok := iclu.mutation.AddedCountID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountline.FieldCountID,
+		})
+	}
+	if value, ok := iclu.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountline.FieldProductID,
+		})
+	}
+	if value, ok := iclu.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountline.FieldProductID,
+		})
+	}
+	if value, ok := iclu.mutation.ExpectedQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycountline.FieldExpectedQuantity,
+		})
+	}
+	if value, ok := iclu.mutation.AddedExpectedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycountline.FieldExpectedQuantity,
+		})
+	}
+	if value, ok := iclu.mutation.CountedQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycountline.FieldCountedQuantity,
+		})
+	}
+	if value, ok := iclu.mutation.AddedCountedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycountline.FieldCountedQuantity,
+		})
+	}
+	if iclu.mutation.CountedQuantityCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: inventorycountline.FieldCountedQuantity,
+		})
+	}
+	if value, ok := iclu.mutation.UnitCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: inventorycountline.FieldUnitCost,
+		})
+	}
+	if value, ok := iclu.mutation.AddedUnitCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: inventorycountline.FieldUnitCost,
+		})
+	}
+	if value, ok := iclu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: inventorycountline.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iclu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventorycountline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InventoryCountLineUpdateOne is the builder for updating a single InventoryCountLine entity.
+type InventoryCountLineUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InventoryCountLineMutation
+}
+
+// SetCountID sets the "count_id" field.
+func (icluo *InventoryCountLineUpdateOne) SetCountID(i int) *InventoryCountLineUpdateOne {
+	icluo.mutation.ResetCountID()
+	icluo.mutation.SetCountID(i)
+	return icluo
+}
+
+// AddCountID adds i to the "count_id" field.
+func (icluo *InventoryCountLineUpdateOne) AddCountID(i int) *InventoryCountLineUpdateOne {
+	icluo.mutation.AddCountID(i)
+	return icluo
+}
+
+// SetProductID sets the "product_id" field.
+func (icluo *InventoryCountLineUpdateOne) SetProductID(i int) *InventoryCountLineUpdateOne {
+	icluo.mutation.ResetProductID()
+	icluo.mutation.SetProductID(i)
+	return icluo
+}
+
+// AddProductID adds i to the "product_id" field.
+func (icluo *InventoryCountLineUpdateOne) AddProductID(i int) *InventoryCountLineUpdateOne {
+	icluo.mutation.AddProductID(i)
+	return icluo
+}
+
+// SetExpectedQuantity sets the "expected_quantity" field.
+func (icluo *InventoryCountLineUpdateOne) SetExpectedQuantity(f float64) *InventoryCountLineUpdateOne {
+	icluo.mutation.ResetExpectedQuantity()
+	icluo.mutation.SetExpectedQuantity(f)
+	return icluo
+}
+
+// AddExpectedQuantity adds f to the "expected_quantity" field.
+func (icluo *InventoryCountLineUpdateOne) AddExpectedQuantity(f float64) *InventoryCountLineUpdateOne {
+	icluo.mutation.AddExpectedQuantity(f)
+	return icluo
+}
+
+// SetCountedQuantity sets the "counted_quantity" field.
+func (icluo *InventoryCountLineUpdateOne) SetCountedQuantity(f float64) *InventoryCountLineUpdateOne {
+	icluo.mutation.ResetCountedQuantity()
+	icluo.mutation.SetCountedQuantity(f)
+	return icluo
+}
+
+// SetNillableCountedQuantity sets the "counted_quantity" field if the given value is not nil.
+func (icluo *InventoryCountLineUpdateOne) SetNillableCountedQuantity(f *float64) *InventoryCountLineUpdateOne {
+	if f != nil {
+		icluo.SetCountedQuantity(*f)
+	}
+	return icluo
+}
+
+// AddCountedQuantity adds f to the "counted_quantity" field.
+func (icluo *InventoryCountLineUpdateOne) AddCountedQuantity(f float64) *InventoryCountLineUpdateOne {
+	icluo.mutation.AddCountedQuantity(f)
+	return icluo
+}
+
+// ClearCountedQuantity clears the value of the "counted_quantity" field.
+func (icluo *InventoryCountLineUpdateOne) ClearCountedQuantity() *InventoryCountLineUpdateOne {
+	icluo.mutation.ClearCountedQuantity()
+	return icluo
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (icluo *InventoryCountLineUpdateOne) SetUnitCost(m money.Amount) *InventoryCountLineUpdateOne {
+	icluo.mutation.ResetUnitCost()
+	icluo.mutation.SetUnitCost(m)
+	return icluo
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (icluo *InventoryCountLineUpdateOne) SetNillableUnitCost(m *money.Amount) *InventoryCountLineUpdateOne {
+	if m != nil {
+		icluo.SetUnitCost(*m)
+	}
+	return icluo
+}
+
+// AddUnitCost adds m to the "unit_cost" field.
+func (icluo *InventoryCountLineUpdateOne) AddUnitCost(m money.Amount) *InventoryCountLineUpdateOne {
+	icluo.mutation.AddUnitCost(m)
+	return icluo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (icluo *InventoryCountLineUpdateOne) SetUpdatedAt(t time.Time) *InventoryCountLineUpdateOne {
+	icluo.mutation.SetUpdatedAt(t)
+	return icluo
+}
+
+// Mutation returns the InventoryCountLineMutation object of the builder.
+func (icluo *InventoryCountLineUpdateOne) Mutation() *InventoryCountLineMutation {
+	return icluo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (icluo *InventoryCountLineUpdateOne) Select(field string, fields ...string) *InventoryCountLineUpdateOne {
+	icluo.fields = append([]string{field}, fields...)
+	return icluo
+}
+
+// Save executes the query and returns the updated InventoryCountLine entity.
+func (icluo *InventoryCountLineUpdateOne) Save(ctx context.Context) (*InventoryCountLine, error) {
+	var (
+		err  error
+		node *InventoryCountLine
+	)
+	icluo.defaults()
+	if len(icluo.hooks) == 0 {
+		if err = icluo.check(); err != nil {
+			return nil, err
+		}
+		node, err = icluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InventoryCountLineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = icluo.check(); err != nil {
+				return nil, err
+			}
+			icluo.mutation = mutation
+			node, err = icluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(icluo.hooks) - 1; i >= 0; i-- {
+			if icluo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = icluo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, icluo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InventoryCountLine)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InventoryCountLineMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icluo *InventoryCountLineUpdateOne) SaveX(ctx context.Context) *InventoryCountLine {
+	node, err := icluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (icluo *InventoryCountLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := icluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icluo *InventoryCountLineUpdateOne) ExecX(ctx context.Context) {
+	if err := icluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icluo *InventoryCountLineUpdateOne) defaults() {
+	if _, ok := icluo.mutation.UpdatedAt(); !ok {
+		v := inventorycountline.UpdateDefaultUpdatedAt()
+		icluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icluo *InventoryCountLineUpdateOne) check() error {
+	if v, ok := icluo.mutation.UnitCost(); ok {
+		if err := inventorycountline.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "InventoryCountLine.unit_cost": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (icluo *InventoryCountLineUpdateOne) sqlSave(ctx context.Context) (_node *InventoryCountLine, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   inventorycountline.Table,
+			Columns: inventorycountline.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: inventorycountline.FieldID,
+			},
+		},
+	}
+	id, ok := icluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InventoryCountLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := icluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventorycountline.FieldID)
+		for _, f := range fields {
+			if !inventorycountline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != inventorycountline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := icluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := icluo.mutation.CountID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountline.FieldCountID,
+		})
+	}
+	if value, ok := icluo.mutation.AddedCountID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountline.FieldCountID,
+		})
+	}
+	if value, ok := icluo.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountline.FieldProductID,
+		})
+	}
+	if value, ok := icluo.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: inventorycountline.FieldProductID,
+		})
+	}
+	if value, ok := icluo.mutation.ExpectedQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycountline.FieldExpectedQuantity,
+		})
+	}
+	if value, ok := icluo.mutation.AddedExpectedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycountline.FieldExpectedQuantity,
+		})
+	}
+	if value, ok := icluo.mutation.CountedQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycountline.FieldCountedQuantity,
+		})
+	}
+	if value, ok := icluo.mutation.AddedCountedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: inventorycountline.FieldCountedQuantity,
+		})
+	}
+	if icluo.mutation.CountedQuantityCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: inventorycountline.FieldCountedQuantity,
+		})
+	}
+	if value, ok := icluo.mutation.UnitCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: inventorycountline.FieldUnitCost,
+		})
+	}
+	if value, ok := icluo.mutation.AddedUnitCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: inventorycountline.FieldUnitCost,
+		})
+	}
+	if value, ok := icluo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: inventorycountline.FieldUpdatedAt,
+		})
+	}
+	_node = &InventoryCountLine{config: icluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, icluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventorycountline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"Veritasbackend/ent/invoice"
+	"Veritasbackend/pkg/money"
 	"fmt"
 	"strings"
 	"time"
@@ -17,13 +18,51 @@ type Invoice struct {
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
 	// Total de la factura
-	Total float64 `json:"total,omitempty"`
-	// Estado de la factura (pending, paid, cancelled)
+	Total money.Amount `json:"total,omitempty"`
+	// Suma de las bases gravables, sin impuestos
+	Subtotal money.Amount `json:"subtotal,omitempty"`
+	// Suma de los impuestos
+	TaxTotal money.Amount `json:"tax_total,omitempty"`
+	// Descuento sobre el total de la factura, repartido entre las líneas
+	DiscountAmount money.Amount `json:"discount_amount,omitempty"`
+	// Suma de los descuentos de línea y de factura
+	DiscountTotal money.Amount `json:"discount_total,omitempty"`
+	// Suma de los descuentos por promociones
+	PromotionTotal money.Amount `json:"promotion_total,omitempty"`
+	// Cupón usado en la factura
+	CouponCode string `json:"coupon_code,omitempty"`
+	// Usuario que autorizó un descuento por encima del límite del vendedor
+	DiscountApprovedBy *int `json:"discount_approved_by,omitempty"`
+	// Los precios de las líneas incluían impuestos
+	PricesIncludeTax bool `json:"prices_include_tax,omitempty"`
+	// Moneda del documento (código ISO 4217)
+	Currency string `json:"currency,omitempty"`
+	// Unidades de la moneda base por una unidad de la moneda del documento
+	ExchangeRate float64 `json:"exchange_rate,omitempty"`
+	// Subtotal en la moneda base
+	BaseSubtotal money.Amount `json:"base_subtotal,omitempty"`
+	// Impuestos en la moneda base
+	BaseTaxTotal money.Amount `json:"base_tax_total,omitempty"`
+	// Total en la moneda base
+	BaseTotal money.Amount `json:"base_total,omitempty"`
+	// Tasa de cambio del día en que se cobró la factura
+	PaymentExchangeRate *float64 `json:"payment_exchange_rate,omitempty"`
+	// Diferencia en cambio realizada al cobrar, en la moneda base (positiva = ganancia)
+	FxGainLoss money.Amount `json:"fx_gain_loss,omitempty"`
+	// Número consecutivo de la factura en la serie del tenant, con su prefijo
+	Number *string `json:"number,omitempty"`
+	// Serie de numeración que asignó el número
+	SeriesID *int `json:"series_id,omitempty"`
+	// Estado de la factura (pending, paid, cancelled, voided)
 	Status string `json:"status,omitempty"`
 	// ID del tenant
 	TenantID int `json:"tenant_id,omitempty"`
 	// ID del usuario que creó la factura
 	UserID int `json:"user_id,omitempty"`
+	// Lista de precios elegida para la factura
+	PriceListID *int `json:"price_list_id,omitempty"`
+	// Cliente al que se emitió la factura; vacío para consumidor final
+	CustomerID *int `json:"customer_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -56,11 +95,15 @@ func (*Invoice) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case invoice.FieldTotal:
+		case invoice.FieldTotal, invoice.FieldSubtotal, invoice.FieldTaxTotal, invoice.FieldDiscountAmount, invoice.FieldDiscountTotal, invoice.FieldPromotionTotal, invoice.FieldBaseSubtotal, invoice.FieldBaseTaxTotal, invoice.FieldBaseTotal, invoice.FieldFxGainLoss:
+			values[i] = new(money.Amount)
+		case invoice.FieldPricesIncludeTax:
+			values[i] = new(sql.NullBool)
+		case invoice.FieldExchangeRate, invoice.FieldPaymentExchangeRate:
 			values[i] = new(sql.NullFloat64)
-		case invoice.FieldID, invoice.FieldTenantID, invoice.FieldUserID:
+		case invoice.FieldID, invoice.FieldDiscountApprovedBy, invoice.FieldSeriesID, invoice.FieldTenantID, invoice.FieldUserID, invoice.FieldPriceListID, invoice.FieldCustomerID:
 			values[i] = new(sql.NullInt64)
-		case invoice.FieldStatus:
+		case invoice.FieldCouponCode, invoice.FieldCurrency, invoice.FieldNumber, invoice.FieldStatus:
 			values[i] = new(sql.NullString)
 		case invoice.FieldCreatedAt, invoice.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -86,10 +129,116 @@ func (i *Invoice) assignValues(columns []string, values []interface{}) error {
 			}
 			i.ID = int(value.Int64)
 		case invoice.FieldTotal:
-			if value, ok := values[j].(*sql.NullFloat64); !ok {
+			if value, ok := values[j].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field total", values[j])
+			} else if value != nil {
+				i.Total = *value
+			}
+		case invoice.FieldSubtotal:
+			if value, ok := values[j].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field subtotal", values[j])
+			} else if value != nil {
+				i.Subtotal = *value
+			}
+		case invoice.FieldTaxTotal:
+			if value, ok := values[j].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_total", values[j])
+			} else if value != nil {
+				i.TaxTotal = *value
+			}
+		case invoice.FieldDiscountAmount:
+			if value, ok := values[j].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_amount", values[j])
+			} else if value != nil {
+				i.DiscountAmount = *value
+			}
+		case invoice.FieldDiscountTotal:
+			if value, ok := values[j].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_total", values[j])
+			} else if value != nil {
+				i.DiscountTotal = *value
+			}
+		case invoice.FieldPromotionTotal:
+			if value, ok := values[j].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field promotion_total", values[j])
+			} else if value != nil {
+				i.PromotionTotal = *value
+			}
+		case invoice.FieldCouponCode:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_code", values[j])
+			} else if value.Valid {
+				i.CouponCode = value.String
+			}
+		case invoice.FieldDiscountApprovedBy:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_approved_by", values[j])
 			} else if value.Valid {
-				i.Total = value.Float64
+				i.DiscountApprovedBy = new(int)
+				*i.DiscountApprovedBy = int(value.Int64)
+			}
+		case invoice.FieldPricesIncludeTax:
+			if value, ok := values[j].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field prices_include_tax", values[j])
+			} else if value.Valid {
+				i.PricesIncludeTax = value.Bool
+			}
+		case invoice.FieldCurrency:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[j])
+			} else if value.Valid {
+				i.Currency = value.String
+			}
+		case invoice.FieldExchangeRate:
+			if value, ok := values[j].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate", values[j])
+			} else if value.Valid {
+				i.ExchangeRate = value.Float64
+			}
+		case invoice.FieldBaseSubtotal:
+			if value, ok := values[j].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field base_subtotal", values[j])
+			} else if value != nil {
+				i.BaseSubtotal = *value
+			}
+		case invoice.FieldBaseTaxTotal:
+			if value, ok := values[j].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field base_tax_total", values[j])
+			} else if value != nil {
+				i.BaseTaxTotal = *value
+			}
+		case invoice.FieldBaseTotal:
+			if value, ok := values[j].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field base_total", values[j])
+			} else if value != nil {
+				i.BaseTotal = *value
+			}
+		case invoice.FieldPaymentExchangeRate:
+			if value, ok := values[j].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_exchange_rate", values[j])
+			} else if value.Valid {
+				i.PaymentExchangeRate = new(float64)
+				*i.PaymentExchangeRate = value.Float64
+			}
+		case invoice.FieldFxGainLoss:
+			if value, ok := values[j].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field fx_gain_loss", values[j])
+			} else if value != nil {
+				i.FxGainLoss = *value
+			}
+		case invoice.FieldNumber:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[j])
+			} else if value.Valid {
+				i.Number = new(string)
+				*i.Number = value.String
+			}
+		case invoice.FieldSeriesID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field series_id", values[j])
+			} else if value.Valid {
+				i.SeriesID = new(int)
+				*i.SeriesID = int(value.Int64)
 			}
 		case invoice.FieldStatus:
 			if value, ok := values[j].(*sql.NullString); !ok {
@@ -109,6 +258,20 @@ func (i *Invoice) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				i.UserID = int(value.Int64)
 			}
+		case invoice.FieldPriceListID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price_list_id", values[j])
+			} else if value.Valid {
+				i.PriceListID = new(int)
+				*i.PriceListID = int(value.Int64)
+			}
+		case invoice.FieldCustomerID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[j])
+			} else if value.Valid {
+				i.CustomerID = new(int)
+				*i.CustomerID = int(value.Int64)
+			}
 		case invoice.FieldCreatedAt:
 			if value, ok := values[j].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[j])
@@ -157,6 +320,65 @@ func (i *Invoice) String() string {
 	builder.WriteString("total=")
 	builder.WriteString(fmt.Sprintf("%v", i.Total))
 	builder.WriteString(", ")
+	builder.WriteString("subtotal=")
+	builder.WriteString(fmt.Sprintf("%v", i.Subtotal))
+	builder.WriteString(", ")
+	builder.WriteString("tax_total=")
+	builder.WriteString(fmt.Sprintf("%v", i.TaxTotal))
+	builder.WriteString(", ")
+	builder.WriteString("discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", i.DiscountAmount))
+	builder.WriteString(", ")
+	builder.WriteString("discount_total=")
+	builder.WriteString(fmt.Sprintf("%v", i.DiscountTotal))
+	builder.WriteString(", ")
+	builder.WriteString("promotion_total=")
+	builder.WriteString(fmt.Sprintf("%v", i.PromotionTotal))
+	builder.WriteString(", ")
+	builder.WriteString("coupon_code=")
+	builder.WriteString(i.CouponCode)
+	builder.WriteString(", ")
+	if v := i.DiscountApprovedBy; v != nil {
+		builder.WriteString("discount_approved_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("prices_include_tax=")
+	builder.WriteString(fmt.Sprintf("%v", i.PricesIncludeTax))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(i.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("exchange_rate=")
+	builder.WriteString(fmt.Sprintf("%v", i.ExchangeRate))
+	builder.WriteString(", ")
+	builder.WriteString("base_subtotal=")
+	builder.WriteString(fmt.Sprintf("%v", i.BaseSubtotal))
+	builder.WriteString(", ")
+	builder.WriteString("base_tax_total=")
+	builder.WriteString(fmt.Sprintf("%v", i.BaseTaxTotal))
+	builder.WriteString(", ")
+	builder.WriteString("base_total=")
+	builder.WriteString(fmt.Sprintf("%v", i.BaseTotal))
+	builder.WriteString(", ")
+	if v := i.PaymentExchangeRate; v != nil {
+		builder.WriteString("payment_exchange_rate=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("fx_gain_loss=")
+	builder.WriteString(fmt.Sprintf("%v", i.FxGainLoss))
+	builder.WriteString(", ")
+	if v := i.Number; v != nil {
+		builder.WriteString("number=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := i.SeriesID; v != nil {
+		builder.WriteString("series_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(i.Status)
 	builder.WriteString(", ")
@@ -166,6 +388,16 @@ func (i *Invoice) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", i.UserID))
 	builder.WriteString(", ")
+	if v := i.PriceListID; v != nil {
+		builder.WriteString("price_list_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := i.CustomerID; v != nil {
+		builder.WriteString("customer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(i.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
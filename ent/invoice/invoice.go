@@ -3,6 +3,7 @@
 package invoice
 
 import (
+	"Veritasbackend/pkg/money"
 	"time"
 )
 
@@ -13,12 +14,50 @@ const (
 	FieldID = "id"
 	// FieldTotal holds the string denoting the total field in the database.
 	FieldTotal = "total"
+	// FieldSubtotal holds the string denoting the subtotal field in the database.
+	FieldSubtotal = "subtotal"
+	// FieldTaxTotal holds the string denoting the tax_total field in the database.
+	FieldTaxTotal = "tax_total"
+	// FieldDiscountAmount holds the string denoting the discount_amount field in the database.
+	FieldDiscountAmount = "discount_amount"
+	// FieldDiscountTotal holds the string denoting the discount_total field in the database.
+	FieldDiscountTotal = "discount_total"
+	// FieldPromotionTotal holds the string denoting the promotion_total field in the database.
+	FieldPromotionTotal = "promotion_total"
+	// FieldCouponCode holds the string denoting the coupon_code field in the database.
+	FieldCouponCode = "coupon_code"
+	// FieldDiscountApprovedBy holds the string denoting the discount_approved_by field in the database.
+	FieldDiscountApprovedBy = "discount_approved_by"
+	// FieldPricesIncludeTax holds the string denoting the prices_include_tax field in the database.
+	FieldPricesIncludeTax = "prices_include_tax"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldExchangeRate holds the string denoting the exchange_rate field in the database.
+	FieldExchangeRate = "exchange_rate"
+	// FieldBaseSubtotal holds the string denoting the base_subtotal field in the database.
+	FieldBaseSubtotal = "base_subtotal"
+	// FieldBaseTaxTotal holds the string denoting the base_tax_total field in the database.
+	FieldBaseTaxTotal = "base_tax_total"
+	// FieldBaseTotal holds the string denoting the base_total field in the database.
+	FieldBaseTotal = "base_total"
+	// FieldPaymentExchangeRate holds the string denoting the payment_exchange_rate field in the database.
+	FieldPaymentExchangeRate = "payment_exchange_rate"
+	// FieldFxGainLoss holds the string denoting the fx_gain_loss field in the database.
+	FieldFxGainLoss = "fx_gain_loss"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldSeriesID holds the string denoting the series_id field in the database.
+	FieldSeriesID = "series_id"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldTenantID holds the string denoting the tenant_id field in the database.
 	FieldTenantID = "tenant_id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldPriceListID holds the string denoting the price_list_id field in the database.
+	FieldPriceListID = "price_list_id"
+	// FieldCustomerID holds the string denoting the customer_id field in the database.
+	FieldCustomerID = "customer_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -40,9 +79,28 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldTotal,
+	FieldSubtotal,
+	FieldTaxTotal,
+	FieldDiscountAmount,
+	FieldDiscountTotal,
+	FieldPromotionTotal,
+	FieldCouponCode,
+	FieldDiscountApprovedBy,
+	FieldPricesIncludeTax,
+	FieldCurrency,
+	FieldExchangeRate,
+	FieldBaseSubtotal,
+	FieldBaseTaxTotal,
+	FieldBaseTotal,
+	FieldPaymentExchangeRate,
+	FieldFxGainLoss,
+	FieldNumber,
+	FieldSeriesID,
 	FieldStatus,
 	FieldTenantID,
 	FieldUserID,
+	FieldPriceListID,
+	FieldCustomerID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -59,7 +117,33 @@ func ValidColumn(column string) bool {
 
 var (
 	// TotalValidator is a validator for the "total" field. It is called by the builders before save.
-	TotalValidator func(float64) error
+	TotalValidator func(int64) error
+	// DefaultSubtotal holds the default value on creation for the "subtotal" field.
+	DefaultSubtotal money.Amount
+	// DefaultTaxTotal holds the default value on creation for the "tax_total" field.
+	DefaultTaxTotal money.Amount
+	// DefaultDiscountAmount holds the default value on creation for the "discount_amount" field.
+	DefaultDiscountAmount money.Amount
+	// DefaultDiscountTotal holds the default value on creation for the "discount_total" field.
+	DefaultDiscountTotal money.Amount
+	// DefaultPromotionTotal holds the default value on creation for the "promotion_total" field.
+	DefaultPromotionTotal money.Amount
+	// DefaultPricesIncludeTax holds the default value on creation for the "prices_include_tax" field.
+	DefaultPricesIncludeTax bool
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultExchangeRate holds the default value on creation for the "exchange_rate" field.
+	DefaultExchangeRate float64
+	// ExchangeRateValidator is a validator for the "exchange_rate" field. It is called by the builders before save.
+	ExchangeRateValidator func(float64) error
+	// DefaultBaseSubtotal holds the default value on creation for the "base_subtotal" field.
+	DefaultBaseSubtotal money.Amount
+	// DefaultBaseTaxTotal holds the default value on creation for the "base_tax_total" field.
+	DefaultBaseTaxTotal money.Amount
+	// DefaultBaseTotal holds the default value on creation for the "base_total" field.
+	DefaultBaseTotal money.Amount
+	// DefaultFxGainLoss holds the default value on creation for the "fx_gain_loss" field.
+	DefaultFxGainLoss money.Amount
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
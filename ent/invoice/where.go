@@ -4,6 +4,7 @@ package invoice
 
 import (
 	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -82,63 +83,1462 @@ func IDLTE(id int) predicate.Invoice {
 }
 
 // Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
-func Total(v float64) predicate.Invoice {
+func Total(v money.Amount) predicate.Invoice {
 	return predicate.Invoice(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldTotal), v))
 	})
 }
 
+// Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
+func Subtotal(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubtotal), v))
+	})
+}
+
+// TaxTotal applies equality check predicate on the "tax_total" field. It's identical to TaxTotalEQ.
+func TaxTotal(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxTotal), v))
+	})
+}
+
+// DiscountAmount applies equality check predicate on the "discount_amount" field. It's identical to DiscountAmountEQ.
+func DiscountAmount(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscountAmount), v))
+	})
+}
+
+// DiscountTotal applies equality check predicate on the "discount_total" field. It's identical to DiscountTotalEQ.
+func DiscountTotal(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscountTotal), v))
+	})
+}
+
+// PromotionTotal applies equality check predicate on the "promotion_total" field. It's identical to PromotionTotalEQ.
+func PromotionTotal(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPromotionTotal), v))
+	})
+}
+
+// CouponCode applies equality check predicate on the "coupon_code" field. It's identical to CouponCodeEQ.
+func CouponCode(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCouponCode), v))
+	})
+}
+
+// DiscountApprovedBy applies equality check predicate on the "discount_approved_by" field. It's identical to DiscountApprovedByEQ.
+func DiscountApprovedBy(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscountApprovedBy), v))
+	})
+}
+
+// PricesIncludeTax applies equality check predicate on the "prices_include_tax" field. It's identical to PricesIncludeTaxEQ.
+func PricesIncludeTax(v bool) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPricesIncludeTax), v))
+	})
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// ExchangeRate applies equality check predicate on the "exchange_rate" field. It's identical to ExchangeRateEQ.
+func ExchangeRate(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExchangeRate), v))
+	})
+}
+
+// BaseSubtotal applies equality check predicate on the "base_subtotal" field. It's identical to BaseSubtotalEQ.
+func BaseSubtotal(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseSubtotal), v))
+	})
+}
+
+// BaseTaxTotal applies equality check predicate on the "base_tax_total" field. It's identical to BaseTaxTotalEQ.
+func BaseTaxTotal(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseTaxTotal), v))
+	})
+}
+
+// BaseTotal applies equality check predicate on the "base_total" field. It's identical to BaseTotalEQ.
+func BaseTotal(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseTotal), v))
+	})
+}
+
+// PaymentExchangeRate applies equality check predicate on the "payment_exchange_rate" field. It's identical to PaymentExchangeRateEQ.
+func PaymentExchangeRate(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPaymentExchangeRate), v))
+	})
+}
+
+// FxGainLoss applies equality check predicate on the "fx_gain_loss" field. It's identical to FxGainLossEQ.
+func FxGainLoss(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFxGainLoss), v))
+	})
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNumber), v))
+	})
+}
+
+// SeriesID applies equality check predicate on the "series_id" field. It's identical to SeriesIDEQ.
+func SeriesID(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeriesID), v))
+	})
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v string) predicate.Invoice {
 	return predicate.Invoice(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldStatus), v))
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// PriceListID applies equality check predicate on the "price_list_id" field. It's identical to PriceListIDEQ.
+func PriceListID(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriceListID), v))
+	})
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCustomerID), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotal), v))
+	})
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTotal), v))
+	})
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTotal), v...))
+	})
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTotal), v...))
+	})
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTotal), v))
+	})
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTotal), v))
+	})
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTotal), v))
+	})
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTotal), v))
+	})
+}
+
+// SubtotalEQ applies the EQ predicate on the "subtotal" field.
+func SubtotalEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubtotal), v))
+	})
+}
+
+// SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
+func SubtotalNEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSubtotal), v))
+	})
+}
+
+// SubtotalIn applies the In predicate on the "subtotal" field.
+func SubtotalIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSubtotal), v...))
+	})
+}
+
+// SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
+func SubtotalNotIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSubtotal), v...))
+	})
+}
+
+// SubtotalGT applies the GT predicate on the "subtotal" field.
+func SubtotalGT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSubtotal), v))
+	})
+}
+
+// SubtotalGTE applies the GTE predicate on the "subtotal" field.
+func SubtotalGTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSubtotal), v))
+	})
+}
+
+// SubtotalLT applies the LT predicate on the "subtotal" field.
+func SubtotalLT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSubtotal), v))
+	})
+}
+
+// SubtotalLTE applies the LTE predicate on the "subtotal" field.
+func SubtotalLTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSubtotal), v))
+	})
+}
+
+// TaxTotalEQ applies the EQ predicate on the "tax_total" field.
+func TaxTotalEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxTotal), v))
+	})
+}
+
+// TaxTotalNEQ applies the NEQ predicate on the "tax_total" field.
+func TaxTotalNEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxTotal), v))
+	})
+}
+
+// TaxTotalIn applies the In predicate on the "tax_total" field.
+func TaxTotalIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxTotal), v...))
+	})
+}
+
+// TaxTotalNotIn applies the NotIn predicate on the "tax_total" field.
+func TaxTotalNotIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxTotal), v...))
+	})
+}
+
+// TaxTotalGT applies the GT predicate on the "tax_total" field.
+func TaxTotalGT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxTotal), v))
+	})
+}
+
+// TaxTotalGTE applies the GTE predicate on the "tax_total" field.
+func TaxTotalGTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxTotal), v))
+	})
+}
+
+// TaxTotalLT applies the LT predicate on the "tax_total" field.
+func TaxTotalLT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxTotal), v))
+	})
+}
+
+// TaxTotalLTE applies the LTE predicate on the "tax_total" field.
+func TaxTotalLTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxTotal), v))
+	})
+}
+
+// DiscountAmountEQ applies the EQ predicate on the "discount_amount" field.
+func DiscountAmountEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscountAmount), v))
+	})
+}
+
+// DiscountAmountNEQ applies the NEQ predicate on the "discount_amount" field.
+func DiscountAmountNEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDiscountAmount), v))
+	})
+}
+
+// DiscountAmountIn applies the In predicate on the "discount_amount" field.
+func DiscountAmountIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDiscountAmount), v...))
+	})
+}
+
+// DiscountAmountNotIn applies the NotIn predicate on the "discount_amount" field.
+func DiscountAmountNotIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDiscountAmount), v...))
+	})
+}
+
+// DiscountAmountGT applies the GT predicate on the "discount_amount" field.
+func DiscountAmountGT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDiscountAmount), v))
+	})
+}
+
+// DiscountAmountGTE applies the GTE predicate on the "discount_amount" field.
+func DiscountAmountGTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDiscountAmount), v))
+	})
+}
+
+// DiscountAmountLT applies the LT predicate on the "discount_amount" field.
+func DiscountAmountLT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDiscountAmount), v))
+	})
+}
+
+// DiscountAmountLTE applies the LTE predicate on the "discount_amount" field.
+func DiscountAmountLTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDiscountAmount), v))
+	})
+}
+
+// DiscountTotalEQ applies the EQ predicate on the "discount_total" field.
+func DiscountTotalEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscountTotal), v))
+	})
+}
+
+// DiscountTotalNEQ applies the NEQ predicate on the "discount_total" field.
+func DiscountTotalNEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDiscountTotal), v))
+	})
+}
+
+// DiscountTotalIn applies the In predicate on the "discount_total" field.
+func DiscountTotalIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDiscountTotal), v...))
+	})
+}
+
+// DiscountTotalNotIn applies the NotIn predicate on the "discount_total" field.
+func DiscountTotalNotIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDiscountTotal), v...))
+	})
+}
+
+// DiscountTotalGT applies the GT predicate on the "discount_total" field.
+func DiscountTotalGT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDiscountTotal), v))
+	})
+}
+
+// DiscountTotalGTE applies the GTE predicate on the "discount_total" field.
+func DiscountTotalGTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDiscountTotal), v))
+	})
+}
+
+// DiscountTotalLT applies the LT predicate on the "discount_total" field.
+func DiscountTotalLT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDiscountTotal), v))
+	})
+}
+
+// DiscountTotalLTE applies the LTE predicate on the "discount_total" field.
+func DiscountTotalLTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDiscountTotal), v))
+	})
+}
+
+// PromotionTotalEQ applies the EQ predicate on the "promotion_total" field.
+func PromotionTotalEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPromotionTotal), v))
+	})
+}
+
+// PromotionTotalNEQ applies the NEQ predicate on the "promotion_total" field.
+func PromotionTotalNEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPromotionTotal), v))
+	})
+}
+
+// PromotionTotalIn applies the In predicate on the "promotion_total" field.
+func PromotionTotalIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPromotionTotal), v...))
+	})
+}
+
+// PromotionTotalNotIn applies the NotIn predicate on the "promotion_total" field.
+func PromotionTotalNotIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPromotionTotal), v...))
+	})
+}
+
+// PromotionTotalGT applies the GT predicate on the "promotion_total" field.
+func PromotionTotalGT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPromotionTotal), v))
+	})
+}
+
+// PromotionTotalGTE applies the GTE predicate on the "promotion_total" field.
+func PromotionTotalGTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPromotionTotal), v))
+	})
+}
+
+// PromotionTotalLT applies the LT predicate on the "promotion_total" field.
+func PromotionTotalLT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPromotionTotal), v))
+	})
+}
+
+// PromotionTotalLTE applies the LTE predicate on the "promotion_total" field.
+func PromotionTotalLTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPromotionTotal), v))
+	})
+}
+
+// CouponCodeEQ applies the EQ predicate on the "coupon_code" field.
+func CouponCodeEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeNEQ applies the NEQ predicate on the "coupon_code" field.
+func CouponCodeNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeIn applies the In predicate on the "coupon_code" field.
+func CouponCodeIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCouponCode), v...))
+	})
+}
+
+// CouponCodeNotIn applies the NotIn predicate on the "coupon_code" field.
+func CouponCodeNotIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCouponCode), v...))
+	})
+}
+
+// CouponCodeGT applies the GT predicate on the "coupon_code" field.
+func CouponCodeGT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeGTE applies the GTE predicate on the "coupon_code" field.
+func CouponCodeGTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeLT applies the LT predicate on the "coupon_code" field.
+func CouponCodeLT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeLTE applies the LTE predicate on the "coupon_code" field.
+func CouponCodeLTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeContains applies the Contains predicate on the "coupon_code" field.
+func CouponCodeContains(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeHasPrefix applies the HasPrefix predicate on the "coupon_code" field.
+func CouponCodeHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeHasSuffix applies the HasSuffix predicate on the "coupon_code" field.
+func CouponCodeHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeIsNil applies the IsNil predicate on the "coupon_code" field.
+func CouponCodeIsNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCouponCode)))
+	})
+}
+
+// CouponCodeNotNil applies the NotNil predicate on the "coupon_code" field.
+func CouponCodeNotNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCouponCode)))
+	})
+}
+
+// CouponCodeEqualFold applies the EqualFold predicate on the "coupon_code" field.
+func CouponCodeEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeContainsFold applies the ContainsFold predicate on the "coupon_code" field.
+func CouponCodeContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCouponCode), v))
+	})
+}
+
+// DiscountApprovedByEQ applies the EQ predicate on the "discount_approved_by" field.
+func DiscountApprovedByEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscountApprovedBy), v))
+	})
+}
+
+// DiscountApprovedByNEQ applies the NEQ predicate on the "discount_approved_by" field.
+func DiscountApprovedByNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDiscountApprovedBy), v))
+	})
+}
+
+// DiscountApprovedByIn applies the In predicate on the "discount_approved_by" field.
+func DiscountApprovedByIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDiscountApprovedBy), v...))
+	})
+}
+
+// DiscountApprovedByNotIn applies the NotIn predicate on the "discount_approved_by" field.
+func DiscountApprovedByNotIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDiscountApprovedBy), v...))
+	})
+}
+
+// DiscountApprovedByGT applies the GT predicate on the "discount_approved_by" field.
+func DiscountApprovedByGT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDiscountApprovedBy), v))
+	})
+}
+
+// DiscountApprovedByGTE applies the GTE predicate on the "discount_approved_by" field.
+func DiscountApprovedByGTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDiscountApprovedBy), v))
+	})
+}
+
+// DiscountApprovedByLT applies the LT predicate on the "discount_approved_by" field.
+func DiscountApprovedByLT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDiscountApprovedBy), v))
+	})
+}
+
+// DiscountApprovedByLTE applies the LTE predicate on the "discount_approved_by" field.
+func DiscountApprovedByLTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDiscountApprovedBy), v))
+	})
+}
+
+// DiscountApprovedByIsNil applies the IsNil predicate on the "discount_approved_by" field.
+func DiscountApprovedByIsNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDiscountApprovedBy)))
+	})
+}
+
+// DiscountApprovedByNotNil applies the NotNil predicate on the "discount_approved_by" field.
+func DiscountApprovedByNotNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDiscountApprovedBy)))
+	})
+}
+
+// PricesIncludeTaxEQ applies the EQ predicate on the "prices_include_tax" field.
+func PricesIncludeTaxEQ(v bool) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPricesIncludeTax), v))
+	})
+}
+
+// PricesIncludeTaxNEQ applies the NEQ predicate on the "prices_include_tax" field.
+func PricesIncludeTaxNEQ(v bool) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPricesIncludeTax), v))
+	})
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCurrency), v))
+	})
+}
+
+// ExchangeRateEQ applies the EQ predicate on the "exchange_rate" field.
+func ExchangeRateEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExchangeRate), v))
+	})
+}
+
+// ExchangeRateNEQ applies the NEQ predicate on the "exchange_rate" field.
+func ExchangeRateNEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExchangeRate), v))
+	})
+}
+
+// ExchangeRateIn applies the In predicate on the "exchange_rate" field.
+func ExchangeRateIn(vs ...float64) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExchangeRate), v...))
+	})
+}
+
+// ExchangeRateNotIn applies the NotIn predicate on the "exchange_rate" field.
+func ExchangeRateNotIn(vs ...float64) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExchangeRate), v...))
+	})
+}
+
+// ExchangeRateGT applies the GT predicate on the "exchange_rate" field.
+func ExchangeRateGT(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExchangeRate), v))
+	})
+}
+
+// ExchangeRateGTE applies the GTE predicate on the "exchange_rate" field.
+func ExchangeRateGTE(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExchangeRate), v))
+	})
+}
+
+// ExchangeRateLT applies the LT predicate on the "exchange_rate" field.
+func ExchangeRateLT(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExchangeRate), v))
+	})
+}
+
+// ExchangeRateLTE applies the LTE predicate on the "exchange_rate" field.
+func ExchangeRateLTE(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExchangeRate), v))
+	})
+}
+
+// BaseSubtotalEQ applies the EQ predicate on the "base_subtotal" field.
+func BaseSubtotalEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseSubtotal), v))
+	})
+}
+
+// BaseSubtotalNEQ applies the NEQ predicate on the "base_subtotal" field.
+func BaseSubtotalNEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBaseSubtotal), v))
+	})
+}
+
+// BaseSubtotalIn applies the In predicate on the "base_subtotal" field.
+func BaseSubtotalIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBaseSubtotal), v...))
+	})
+}
+
+// BaseSubtotalNotIn applies the NotIn predicate on the "base_subtotal" field.
+func BaseSubtotalNotIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBaseSubtotal), v...))
+	})
+}
+
+// BaseSubtotalGT applies the GT predicate on the "base_subtotal" field.
+func BaseSubtotalGT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBaseSubtotal), v))
+	})
+}
+
+// BaseSubtotalGTE applies the GTE predicate on the "base_subtotal" field.
+func BaseSubtotalGTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBaseSubtotal), v))
+	})
+}
+
+// BaseSubtotalLT applies the LT predicate on the "base_subtotal" field.
+func BaseSubtotalLT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBaseSubtotal), v))
+	})
+}
+
+// BaseSubtotalLTE applies the LTE predicate on the "base_subtotal" field.
+func BaseSubtotalLTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBaseSubtotal), v))
+	})
+}
+
+// BaseTaxTotalEQ applies the EQ predicate on the "base_tax_total" field.
+func BaseTaxTotalEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseTaxTotal), v))
+	})
+}
+
+// BaseTaxTotalNEQ applies the NEQ predicate on the "base_tax_total" field.
+func BaseTaxTotalNEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBaseTaxTotal), v))
+	})
+}
+
+// BaseTaxTotalIn applies the In predicate on the "base_tax_total" field.
+func BaseTaxTotalIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBaseTaxTotal), v...))
+	})
+}
+
+// BaseTaxTotalNotIn applies the NotIn predicate on the "base_tax_total" field.
+func BaseTaxTotalNotIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBaseTaxTotal), v...))
+	})
+}
+
+// BaseTaxTotalGT applies the GT predicate on the "base_tax_total" field.
+func BaseTaxTotalGT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBaseTaxTotal), v))
+	})
+}
+
+// BaseTaxTotalGTE applies the GTE predicate on the "base_tax_total" field.
+func BaseTaxTotalGTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBaseTaxTotal), v))
+	})
+}
+
+// BaseTaxTotalLT applies the LT predicate on the "base_tax_total" field.
+func BaseTaxTotalLT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBaseTaxTotal), v))
+	})
+}
+
+// BaseTaxTotalLTE applies the LTE predicate on the "base_tax_total" field.
+func BaseTaxTotalLTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBaseTaxTotal), v))
+	})
+}
+
+// BaseTotalEQ applies the EQ predicate on the "base_total" field.
+func BaseTotalEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseTotal), v))
+	})
+}
+
+// BaseTotalNEQ applies the NEQ predicate on the "base_total" field.
+func BaseTotalNEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBaseTotal), v))
+	})
+}
+
+// BaseTotalIn applies the In predicate on the "base_total" field.
+func BaseTotalIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBaseTotal), v...))
+	})
+}
+
+// BaseTotalNotIn applies the NotIn predicate on the "base_total" field.
+func BaseTotalNotIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBaseTotal), v...))
+	})
+}
+
+// BaseTotalGT applies the GT predicate on the "base_total" field.
+func BaseTotalGT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBaseTotal), v))
+	})
+}
+
+// BaseTotalGTE applies the GTE predicate on the "base_total" field.
+func BaseTotalGTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBaseTotal), v))
+	})
+}
+
+// BaseTotalLT applies the LT predicate on the "base_total" field.
+func BaseTotalLT(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBaseTotal), v))
+	})
+}
+
+// BaseTotalLTE applies the LTE predicate on the "base_total" field.
+func BaseTotalLTE(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBaseTotal), v))
+	})
+}
+
+// PaymentExchangeRateEQ applies the EQ predicate on the "payment_exchange_rate" field.
+func PaymentExchangeRateEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPaymentExchangeRate), v))
+	})
+}
+
+// PaymentExchangeRateNEQ applies the NEQ predicate on the "payment_exchange_rate" field.
+func PaymentExchangeRateNEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPaymentExchangeRate), v))
+	})
+}
+
+// PaymentExchangeRateIn applies the In predicate on the "payment_exchange_rate" field.
+func PaymentExchangeRateIn(vs ...float64) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPaymentExchangeRate), v...))
+	})
+}
+
+// PaymentExchangeRateNotIn applies the NotIn predicate on the "payment_exchange_rate" field.
+func PaymentExchangeRateNotIn(vs ...float64) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPaymentExchangeRate), v...))
+	})
+}
+
+// PaymentExchangeRateGT applies the GT predicate on the "payment_exchange_rate" field.
+func PaymentExchangeRateGT(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPaymentExchangeRate), v))
+	})
+}
+
+// PaymentExchangeRateGTE applies the GTE predicate on the "payment_exchange_rate" field.
+func PaymentExchangeRateGTE(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPaymentExchangeRate), v))
+	})
+}
+
+// PaymentExchangeRateLT applies the LT predicate on the "payment_exchange_rate" field.
+func PaymentExchangeRateLT(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPaymentExchangeRate), v))
+	})
+}
+
+// PaymentExchangeRateLTE applies the LTE predicate on the "payment_exchange_rate" field.
+func PaymentExchangeRateLTE(v float64) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPaymentExchangeRate), v))
+	})
+}
+
+// PaymentExchangeRateIsNil applies the IsNil predicate on the "payment_exchange_rate" field.
+func PaymentExchangeRateIsNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPaymentExchangeRate)))
+	})
+}
+
+// PaymentExchangeRateNotNil applies the NotNil predicate on the "payment_exchange_rate" field.
+func PaymentExchangeRateNotNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPaymentExchangeRate)))
+	})
+}
+
+// FxGainLossEQ applies the EQ predicate on the "fx_gain_loss" field.
+func FxGainLossEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFxGainLoss), v))
+	})
+}
+
+// FxGainLossNEQ applies the NEQ predicate on the "fx_gain_loss" field.
+func FxGainLossNEQ(v money.Amount) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFxGainLoss), v))
+	})
+}
+
+// FxGainLossIn applies the In predicate on the "fx_gain_loss" field.
+func FxGainLossIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldFxGainLoss), v...))
+	})
+}
+
+// FxGainLossNotIn applies the NotIn predicate on the "fx_gain_loss" field.
+func FxGainLossNotIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldFxGainLoss), v...))
 	})
 }
 
-// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
-func TenantID(v int) predicate.Invoice {
+// FxGainLossGT applies the GT predicate on the "fx_gain_loss" field.
+func FxGainLossGT(v money.Amount) predicate.Invoice {
 	return predicate.Invoice(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldTenantID), v))
+		s.Where(sql.GT(s.C(FieldFxGainLoss), v))
 	})
 }
 
-// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
-func UserID(v int) predicate.Invoice {
+// FxGainLossGTE applies the GTE predicate on the "fx_gain_loss" field.
+func FxGainLossGTE(v money.Amount) predicate.Invoice {
 	return predicate.Invoice(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldUserID), v))
+		s.Where(sql.GTE(s.C(FieldFxGainLoss), v))
 	})
 }
 
-// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
-func CreatedAt(v time.Time) predicate.Invoice {
+// FxGainLossLT applies the LT predicate on the "fx_gain_loss" field.
+func FxGainLossLT(v money.Amount) predicate.Invoice {
 	return predicate.Invoice(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+		s.Where(sql.LT(s.C(FieldFxGainLoss), v))
 	})
 }
 
-// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
-func UpdatedAt(v time.Time) predicate.Invoice {
+// FxGainLossLTE applies the LTE predicate on the "fx_gain_loss" field.
+func FxGainLossLTE(v money.Amount) predicate.Invoice {
 	return predicate.Invoice(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+		s.Where(sql.LTE(s.C(FieldFxGainLoss), v))
 	})
 }
 
-// TotalEQ applies the EQ predicate on the "total" field.
-func TotalEQ(v float64) predicate.Invoice {
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v string) predicate.Invoice {
 	return predicate.Invoice(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldTotal), v))
+		s.Where(sql.EQ(s.C(FieldNumber), v))
 	})
 }
 
-// TotalNEQ applies the NEQ predicate on the "total" field.
-func TotalNEQ(v float64) predicate.Invoice {
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v string) predicate.Invoice {
 	return predicate.Invoice(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldTotal), v))
+		s.Where(sql.NEQ(s.C(FieldNumber), v))
 	})
 }
 
-// TotalIn applies the In predicate on the "total" field.
-func TotalIn(vs ...float64) predicate.Invoice {
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...string) predicate.Invoice {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -150,12 +1550,12 @@ func TotalIn(vs ...float64) predicate.Invoice {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.In(s.C(FieldTotal), v...))
+		s.Where(sql.In(s.C(FieldNumber), v...))
 	})
 }
 
-// TotalNotIn applies the NotIn predicate on the "total" field.
-func TotalNotIn(vs ...float64) predicate.Invoice {
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...string) predicate.Invoice {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -167,35 +1567,174 @@ func TotalNotIn(vs ...float64) predicate.Invoice {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.NotIn(s.C(FieldTotal), v...))
+		s.Where(sql.NotIn(s.C(FieldNumber), v...))
 	})
 }
 
-// TotalGT applies the GT predicate on the "total" field.
-func TotalGT(v float64) predicate.Invoice {
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v string) predicate.Invoice {
 	return predicate.Invoice(func(s *sql.Selector) {
-		s.Where(sql.GT(s.C(FieldTotal), v))
+		s.Where(sql.GT(s.C(FieldNumber), v))
 	})
 }
 
-// TotalGTE applies the GTE predicate on the "total" field.
-func TotalGTE(v float64) predicate.Invoice {
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v string) predicate.Invoice {
 	return predicate.Invoice(func(s *sql.Selector) {
-		s.Where(sql.GTE(s.C(FieldTotal), v))
+		s.Where(sql.GTE(s.C(FieldNumber), v))
 	})
 }
 
-// TotalLT applies the LT predicate on the "total" field.
-func TotalLT(v float64) predicate.Invoice {
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v string) predicate.Invoice {
 	return predicate.Invoice(func(s *sql.Selector) {
-		s.Where(sql.LT(s.C(FieldTotal), v))
+		s.Where(sql.LT(s.C(FieldNumber), v))
 	})
 }
 
-// TotalLTE applies the LTE predicate on the "total" field.
-func TotalLTE(v float64) predicate.Invoice {
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v string) predicate.Invoice {
 	return predicate.Invoice(func(s *sql.Selector) {
-		s.Where(sql.LTE(s.C(FieldTotal), v))
+		s.Where(sql.LTE(s.C(FieldNumber), v))
+	})
+}
+
+// NumberContains applies the Contains predicate on the "number" field.
+func NumberContains(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNumber), v))
+	})
+}
+
+// NumberHasPrefix applies the HasPrefix predicate on the "number" field.
+func NumberHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNumber), v))
+	})
+}
+
+// NumberHasSuffix applies the HasSuffix predicate on the "number" field.
+func NumberHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNumber), v))
+	})
+}
+
+// NumberIsNil applies the IsNil predicate on the "number" field.
+func NumberIsNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNumber)))
+	})
+}
+
+// NumberNotNil applies the NotNil predicate on the "number" field.
+func NumberNotNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNumber)))
+	})
+}
+
+// NumberEqualFold applies the EqualFold predicate on the "number" field.
+func NumberEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNumber), v))
+	})
+}
+
+// NumberContainsFold applies the ContainsFold predicate on the "number" field.
+func NumberContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNumber), v))
+	})
+}
+
+// SeriesIDEQ applies the EQ predicate on the "series_id" field.
+func SeriesIDEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDNEQ applies the NEQ predicate on the "series_id" field.
+func SeriesIDNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDIn applies the In predicate on the "series_id" field.
+func SeriesIDIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSeriesID), v...))
+	})
+}
+
+// SeriesIDNotIn applies the NotIn predicate on the "series_id" field.
+func SeriesIDNotIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSeriesID), v...))
+	})
+}
+
+// SeriesIDGT applies the GT predicate on the "series_id" field.
+func SeriesIDGT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDGTE applies the GTE predicate on the "series_id" field.
+func SeriesIDGTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDLT applies the LT predicate on the "series_id" field.
+func SeriesIDLT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDLTE applies the LTE predicate on the "series_id" field.
+func SeriesIDLTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSeriesID), v))
+	})
+}
+
+// SeriesIDIsNil applies the IsNil predicate on the "series_id" field.
+func SeriesIDIsNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSeriesID)))
+	})
+}
+
+// SeriesIDNotNil applies the NotNil predicate on the "series_id" field.
+func SeriesIDNotNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSeriesID)))
 	})
 }
 
@@ -462,6 +2001,186 @@ func UserIDLTE(v int) predicate.Invoice {
 	})
 }
 
+// PriceListIDEQ applies the EQ predicate on the "price_list_id" field.
+func PriceListIDEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDNEQ applies the NEQ predicate on the "price_list_id" field.
+func PriceListIDNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDIn applies the In predicate on the "price_list_id" field.
+func PriceListIDIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPriceListID), v...))
+	})
+}
+
+// PriceListIDNotIn applies the NotIn predicate on the "price_list_id" field.
+func PriceListIDNotIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPriceListID), v...))
+	})
+}
+
+// PriceListIDGT applies the GT predicate on the "price_list_id" field.
+func PriceListIDGT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDGTE applies the GTE predicate on the "price_list_id" field.
+func PriceListIDGTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDLT applies the LT predicate on the "price_list_id" field.
+func PriceListIDLT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDLTE applies the LTE predicate on the "price_list_id" field.
+func PriceListIDLTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDIsNil applies the IsNil predicate on the "price_list_id" field.
+func PriceListIDIsNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPriceListID)))
+	})
+}
+
+// PriceListIDNotNil applies the NotNil predicate on the "price_list_id" field.
+func PriceListIDNotNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPriceListID)))
+	})
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCustomerID), v))
+	})
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCustomerID), v))
+	})
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCustomerID), v...))
+	})
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCustomerID), v...))
+	})
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCustomerID), v))
+	})
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCustomerID), v))
+	})
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCustomerID), v))
+	})
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCustomerID), v))
+	})
+}
+
+// CustomerIDIsNil applies the IsNil predicate on the "customer_id" field.
+func CustomerIDIsNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCustomerID)))
+	})
+}
+
+// CustomerIDNotNil applies the NotNil predicate on the "customer_id" field.
+func CustomerIDNotNil() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCustomerID)))
+	})
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Invoice {
 	return predicate.Invoice(func(s *sql.Selector) {
@@ -5,6 +5,7 @@ package ent
 import (
 	"Veritasbackend/ent/invoice"
 	"Veritasbackend/ent/invoiceitem"
+	"Veritasbackend/pkg/money"
 	"context"
 	"errors"
 	"fmt"
@@ -22,8 +23,246 @@ type InvoiceCreate struct {
 }
 
 // SetTotal sets the "total" field.
-func (ic *InvoiceCreate) SetTotal(f float64) *InvoiceCreate {
-	ic.mutation.SetTotal(f)
+func (ic *InvoiceCreate) SetTotal(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetTotal(m)
+	return ic
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (ic *InvoiceCreate) SetSubtotal(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetSubtotal(m)
+	return ic
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableSubtotal(m *money.Amount) *InvoiceCreate {
+	if m != nil {
+		ic.SetSubtotal(*m)
+	}
+	return ic
+}
+
+// SetTaxTotal sets the "tax_total" field.
+func (ic *InvoiceCreate) SetTaxTotal(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetTaxTotal(m)
+	return ic
+}
+
+// SetNillableTaxTotal sets the "tax_total" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableTaxTotal(m *money.Amount) *InvoiceCreate {
+	if m != nil {
+		ic.SetTaxTotal(*m)
+	}
+	return ic
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (ic *InvoiceCreate) SetDiscountAmount(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetDiscountAmount(m)
+	return ic
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableDiscountAmount(m *money.Amount) *InvoiceCreate {
+	if m != nil {
+		ic.SetDiscountAmount(*m)
+	}
+	return ic
+}
+
+// SetDiscountTotal sets the "discount_total" field.
+func (ic *InvoiceCreate) SetDiscountTotal(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetDiscountTotal(m)
+	return ic
+}
+
+// SetNillableDiscountTotal sets the "discount_total" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableDiscountTotal(m *money.Amount) *InvoiceCreate {
+	if m != nil {
+		ic.SetDiscountTotal(*m)
+	}
+	return ic
+}
+
+// SetPromotionTotal sets the "promotion_total" field.
+func (ic *InvoiceCreate) SetPromotionTotal(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetPromotionTotal(m)
+	return ic
+}
+
+// SetNillablePromotionTotal sets the "promotion_total" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillablePromotionTotal(m *money.Amount) *InvoiceCreate {
+	if m != nil {
+		ic.SetPromotionTotal(*m)
+	}
+	return ic
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (ic *InvoiceCreate) SetCouponCode(s string) *InvoiceCreate {
+	ic.mutation.SetCouponCode(s)
+	return ic
+}
+
+// SetNillableCouponCode sets the "coupon_code" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableCouponCode(s *string) *InvoiceCreate {
+	if s != nil {
+		ic.SetCouponCode(*s)
+	}
+	return ic
+}
+
+// SetDiscountApprovedBy sets the "discount_approved_by" field.
+func (ic *InvoiceCreate) SetDiscountApprovedBy(i int) *InvoiceCreate {
+	ic.mutation.SetDiscountApprovedBy(i)
+	return ic
+}
+
+// SetNillableDiscountApprovedBy sets the "discount_approved_by" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableDiscountApprovedBy(i *int) *InvoiceCreate {
+	if i != nil {
+		ic.SetDiscountApprovedBy(*i)
+	}
+	return ic
+}
+
+// SetPricesIncludeTax sets the "prices_include_tax" field.
+func (ic *InvoiceCreate) SetPricesIncludeTax(b bool) *InvoiceCreate {
+	ic.mutation.SetPricesIncludeTax(b)
+	return ic
+}
+
+// SetNillablePricesIncludeTax sets the "prices_include_tax" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillablePricesIncludeTax(b *bool) *InvoiceCreate {
+	if b != nil {
+		ic.SetPricesIncludeTax(*b)
+	}
+	return ic
+}
+
+// SetCurrency sets the "currency" field.
+func (ic *InvoiceCreate) SetCurrency(s string) *InvoiceCreate {
+	ic.mutation.SetCurrency(s)
+	return ic
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableCurrency(s *string) *InvoiceCreate {
+	if s != nil {
+		ic.SetCurrency(*s)
+	}
+	return ic
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (ic *InvoiceCreate) SetExchangeRate(f float64) *InvoiceCreate {
+	ic.mutation.SetExchangeRate(f)
+	return ic
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableExchangeRate(f *float64) *InvoiceCreate {
+	if f != nil {
+		ic.SetExchangeRate(*f)
+	}
+	return ic
+}
+
+// SetBaseSubtotal sets the "base_subtotal" field.
+func (ic *InvoiceCreate) SetBaseSubtotal(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetBaseSubtotal(m)
+	return ic
+}
+
+// SetNillableBaseSubtotal sets the "base_subtotal" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableBaseSubtotal(m *money.Amount) *InvoiceCreate {
+	if m != nil {
+		ic.SetBaseSubtotal(*m)
+	}
+	return ic
+}
+
+// SetBaseTaxTotal sets the "base_tax_total" field.
+func (ic *InvoiceCreate) SetBaseTaxTotal(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetBaseTaxTotal(m)
+	return ic
+}
+
+// SetNillableBaseTaxTotal sets the "base_tax_total" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableBaseTaxTotal(m *money.Amount) *InvoiceCreate {
+	if m != nil {
+		ic.SetBaseTaxTotal(*m)
+	}
+	return ic
+}
+
+// SetBaseTotal sets the "base_total" field.
+func (ic *InvoiceCreate) SetBaseTotal(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetBaseTotal(m)
+	return ic
+}
+
+// SetNillableBaseTotal sets the "base_total" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableBaseTotal(m *money.Amount) *InvoiceCreate {
+	if m != nil {
+		ic.SetBaseTotal(*m)
+	}
+	return ic
+}
+
+// SetPaymentExchangeRate sets the "payment_exchange_rate" field.
+func (ic *InvoiceCreate) SetPaymentExchangeRate(f float64) *InvoiceCreate {
+	ic.mutation.SetPaymentExchangeRate(f)
+	return ic
+}
+
+// SetNillablePaymentExchangeRate sets the "payment_exchange_rate" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillablePaymentExchangeRate(f *float64) *InvoiceCreate {
+	if f != nil {
+		ic.SetPaymentExchangeRate(*f)
+	}
+	return ic
+}
+
+// SetFxGainLoss sets the "fx_gain_loss" field.
+func (ic *InvoiceCreate) SetFxGainLoss(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetFxGainLoss(m)
+	return ic
+}
+
+// SetNillableFxGainLoss sets the "fx_gain_loss" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableFxGainLoss(m *money.Amount) *InvoiceCreate {
+	if m != nil {
+		ic.SetFxGainLoss(*m)
+	}
+	return ic
+}
+
+// SetNumber sets the "number" field.
+func (ic *InvoiceCreate) SetNumber(s string) *InvoiceCreate {
+	ic.mutation.SetNumber(s)
+	return ic
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableNumber(s *string) *InvoiceCreate {
+	if s != nil {
+		ic.SetNumber(*s)
+	}
+	return ic
+}
+
+// SetSeriesID sets the "series_id" field.
+func (ic *InvoiceCreate) SetSeriesID(i int) *InvoiceCreate {
+	ic.mutation.SetSeriesID(i)
+	return ic
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableSeriesID(i *int) *InvoiceCreate {
+	if i != nil {
+		ic.SetSeriesID(*i)
+	}
 	return ic
 }
 
@@ -53,6 +292,34 @@ func (ic *InvoiceCreate) SetUserID(i int) *InvoiceCreate {
 	return ic
 }
 
+// SetPriceListID sets the "price_list_id" field.
+func (ic *InvoiceCreate) SetPriceListID(i int) *InvoiceCreate {
+	ic.mutation.SetPriceListID(i)
+	return ic
+}
+
+// SetNillablePriceListID sets the "price_list_id" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillablePriceListID(i *int) *InvoiceCreate {
+	if i != nil {
+		ic.SetPriceListID(*i)
+	}
+	return ic
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (ic *InvoiceCreate) SetCustomerID(i int) *InvoiceCreate {
+	ic.mutation.SetCustomerID(i)
+	return ic
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableCustomerID(i *int) *InvoiceCreate {
+	if i != nil {
+		ic.SetCustomerID(*i)
+	}
+	return ic
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ic *InvoiceCreate) SetCreatedAt(t time.Time) *InvoiceCreate {
 	ic.mutation.SetCreatedAt(t)
@@ -173,6 +440,54 @@ func (ic *InvoiceCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (ic *InvoiceCreate) defaults() {
+	if _, ok := ic.mutation.Subtotal(); !ok {
+		v := invoice.DefaultSubtotal
+		ic.mutation.SetSubtotal(v)
+	}
+	if _, ok := ic.mutation.TaxTotal(); !ok {
+		v := invoice.DefaultTaxTotal
+		ic.mutation.SetTaxTotal(v)
+	}
+	if _, ok := ic.mutation.DiscountAmount(); !ok {
+		v := invoice.DefaultDiscountAmount
+		ic.mutation.SetDiscountAmount(v)
+	}
+	if _, ok := ic.mutation.DiscountTotal(); !ok {
+		v := invoice.DefaultDiscountTotal
+		ic.mutation.SetDiscountTotal(v)
+	}
+	if _, ok := ic.mutation.PromotionTotal(); !ok {
+		v := invoice.DefaultPromotionTotal
+		ic.mutation.SetPromotionTotal(v)
+	}
+	if _, ok := ic.mutation.PricesIncludeTax(); !ok {
+		v := invoice.DefaultPricesIncludeTax
+		ic.mutation.SetPricesIncludeTax(v)
+	}
+	if _, ok := ic.mutation.Currency(); !ok {
+		v := invoice.DefaultCurrency
+		ic.mutation.SetCurrency(v)
+	}
+	if _, ok := ic.mutation.ExchangeRate(); !ok {
+		v := invoice.DefaultExchangeRate
+		ic.mutation.SetExchangeRate(v)
+	}
+	if _, ok := ic.mutation.BaseSubtotal(); !ok {
+		v := invoice.DefaultBaseSubtotal
+		ic.mutation.SetBaseSubtotal(v)
+	}
+	if _, ok := ic.mutation.BaseTaxTotal(); !ok {
+		v := invoice.DefaultBaseTaxTotal
+		ic.mutation.SetBaseTaxTotal(v)
+	}
+	if _, ok := ic.mutation.BaseTotal(); !ok {
+		v := invoice.DefaultBaseTotal
+		ic.mutation.SetBaseTotal(v)
+	}
+	if _, ok := ic.mutation.FxGainLoss(); !ok {
+		v := invoice.DefaultFxGainLoss
+		ic.mutation.SetFxGainLoss(v)
+	}
 	if _, ok := ic.mutation.Status(); !ok {
 		v := invoice.DefaultStatus
 		ic.mutation.SetStatus(v)
@@ -193,10 +508,51 @@ func (ic *InvoiceCreate) check() error {
 		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "Invoice.total"`)}
 	}
 	if v, ok := ic.mutation.Total(); ok {
-		if err := invoice.TotalValidator(v); err != nil {
+		if err := invoice.TotalValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "Invoice.total": %w`, err)}
 		}
 	}
+	if _, ok := ic.mutation.Subtotal(); !ok {
+		return &ValidationError{Name: "subtotal", err: errors.New(`ent: missing required field "Invoice.subtotal"`)}
+	}
+	if _, ok := ic.mutation.TaxTotal(); !ok {
+		return &ValidationError{Name: "tax_total", err: errors.New(`ent: missing required field "Invoice.tax_total"`)}
+	}
+	if _, ok := ic.mutation.DiscountAmount(); !ok {
+		return &ValidationError{Name: "discount_amount", err: errors.New(`ent: missing required field "Invoice.discount_amount"`)}
+	}
+	if _, ok := ic.mutation.DiscountTotal(); !ok {
+		return &ValidationError{Name: "discount_total", err: errors.New(`ent: missing required field "Invoice.discount_total"`)}
+	}
+	if _, ok := ic.mutation.PromotionTotal(); !ok {
+		return &ValidationError{Name: "promotion_total", err: errors.New(`ent: missing required field "Invoice.promotion_total"`)}
+	}
+	if _, ok := ic.mutation.PricesIncludeTax(); !ok {
+		return &ValidationError{Name: "prices_include_tax", err: errors.New(`ent: missing required field "Invoice.prices_include_tax"`)}
+	}
+	if _, ok := ic.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Invoice.currency"`)}
+	}
+	if _, ok := ic.mutation.ExchangeRate(); !ok {
+		return &ValidationError{Name: "exchange_rate", err: errors.New(`ent: missing required field "Invoice.exchange_rate"`)}
+	}
+	if v, ok := ic.mutation.ExchangeRate(); ok {
+		if err := invoice.ExchangeRateValidator(v); err != nil {
+			return &ValidationError{Name: "exchange_rate", err: fmt.Errorf(`ent: validator failed for field "Invoice.exchange_rate": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.BaseSubtotal(); !ok {
+		return &ValidationError{Name: "base_subtotal", err: errors.New(`ent: missing required field "Invoice.base_subtotal"`)}
+	}
+	if _, ok := ic.mutation.BaseTaxTotal(); !ok {
+		return &ValidationError{Name: "base_tax_total", err: errors.New(`ent: missing required field "Invoice.base_tax_total"`)}
+	}
+	if _, ok := ic.mutation.BaseTotal(); !ok {
+		return &ValidationError{Name: "base_total", err: errors.New(`ent: missing required field "Invoice.base_total"`)}
+	}
+	if _, ok := ic.mutation.FxGainLoss(); !ok {
+		return &ValidationError{Name: "fx_gain_loss", err: errors.New(`ent: missing required field "Invoice.fx_gain_loss"`)}
+	}
 	if _, ok := ic.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Invoice.status"`)}
 	}
@@ -241,12 +597,148 @@ func (ic *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
 	)
 	if value, ok := ic.mutation.Total(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: invoice.FieldTotal,
 		})
 		_node.Total = value
 	}
+	if value, ok := ic.mutation.Subtotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldSubtotal,
+		})
+		_node.Subtotal = value
+	}
+	if value, ok := ic.mutation.TaxTotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldTaxTotal,
+		})
+		_node.TaxTotal = value
+	}
+	if value, ok := ic.mutation.DiscountAmount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldDiscountAmount,
+		})
+		_node.DiscountAmount = value
+	}
+	if value, ok := ic.mutation.DiscountTotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldDiscountTotal,
+		})
+		_node.DiscountTotal = value
+	}
+	if value, ok := ic.mutation.PromotionTotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldPromotionTotal,
+		})
+		_node.PromotionTotal = value
+	}
+	if value, ok := ic.mutation.CouponCode(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldCouponCode,
+		})
+		_node.CouponCode = value
+	}
+	if value, ok := ic.mutation.DiscountApprovedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldDiscountApprovedBy,
+		})
+		_node.DiscountApprovedBy = &value
+	}
+	if value, ok := ic.mutation.PricesIncludeTax(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: invoice.FieldPricesIncludeTax,
+		})
+		_node.PricesIncludeTax = value
+	}
+	if value, ok := ic.mutation.Currency(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldCurrency,
+		})
+		_node.Currency = value
+	}
+	if value, ok := ic.mutation.ExchangeRate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldExchangeRate,
+		})
+		_node.ExchangeRate = value
+	}
+	if value, ok := ic.mutation.BaseSubtotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldBaseSubtotal,
+		})
+		_node.BaseSubtotal = value
+	}
+	if value, ok := ic.mutation.BaseTaxTotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldBaseTaxTotal,
+		})
+		_node.BaseTaxTotal = value
+	}
+	if value, ok := ic.mutation.BaseTotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldBaseTotal,
+		})
+		_node.BaseTotal = value
+	}
+	if value, ok := ic.mutation.PaymentExchangeRate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldPaymentExchangeRate,
+		})
+		_node.PaymentExchangeRate = &value
+	}
+	if value, ok := ic.mutation.FxGainLoss(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldFxGainLoss,
+		})
+		_node.FxGainLoss = value
+	}
+	if value, ok := ic.mutation.Number(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldNumber,
+		})
+		_node.Number = &value
+	}
+	if value, ok := ic.mutation.SeriesID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldSeriesID,
+		})
+		_node.SeriesID = &value
+	}
 	if value, ok := ic.mutation.Status(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -271,6 +763,22 @@ func (ic *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
 		})
 		_node.UserID = value
 	}
+	if value, ok := ic.mutation.PriceListID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldPriceListID,
+		})
+		_node.PriceListID = &value
+	}
+	if value, ok := ic.mutation.CustomerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldCustomerID,
+		})
+		_node.CustomerID = &value
+	}
 	if value, ok := ic.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
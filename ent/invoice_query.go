@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -27,6 +28,7 @@ type InvoiceQuery struct {
 	predicates []predicate.Invoice
 	// eager-loading edges.
 	withItems *InvoiceItemQuery
+	modifiers []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -291,7 +293,7 @@ func (iq *InvoiceQuery) WithItems(opts ...func(*InvoiceItemQuery)) *InvoiceQuery
 // Example:
 //
 //	var v []struct {
-//		Total float64 `json:"total,omitempty"`
+//		Total money.Amount `json:"total,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
@@ -299,7 +301,6 @@ func (iq *InvoiceQuery) WithItems(opts ...func(*InvoiceItemQuery)) *InvoiceQuery
 //		GroupBy(invoice.FieldTotal).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (iq *InvoiceQuery) GroupBy(field string, fields ...string) *InvoiceGroupBy {
 	grbuild := &InvoiceGroupBy{config: iq.config}
 	grbuild.fields = append([]string{field}, fields...)
@@ -320,13 +321,12 @@ func (iq *InvoiceQuery) GroupBy(field string, fields ...string) *InvoiceGroupBy
 // Example:
 //
 //	var v []struct {
-//		Total float64 `json:"total,omitempty"`
+//		Total money.Amount `json:"total,omitempty"`
 //	}
 //
 //	client.Invoice.Query().
 //		Select(invoice.FieldTotal).
 //		Scan(ctx, &v)
-//
 func (iq *InvoiceQuery) Select(fields ...string) *InvoiceSelect {
 	iq.fields = append(iq.fields, fields...)
 	selbuild := &InvoiceSelect{InvoiceQuery: iq}
@@ -368,6 +368,9 @@ func (iq *InvoiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Invo
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(iq.modifiers) > 0 {
+		_spec.Modifiers = iq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -408,6 +411,9 @@ func (iq *InvoiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Invo
 
 func (iq *InvoiceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := iq.querySpec()
+	if len(iq.modifiers) > 0 {
+		_spec.Modifiers = iq.modifiers
+	}
 	_spec.Node.Columns = iq.fields
 	if len(iq.fields) > 0 {
 		_spec.Unique = iq.unique != nil && *iq.unique
@@ -486,6 +492,9 @@ func (iq *InvoiceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if iq.unique != nil && *iq.unique {
 		selector.Distinct()
 	}
+	for _, m := range iq.modifiers {
+		m(selector)
+	}
 	for _, p := range iq.predicates {
 		p(selector)
 	}
@@ -503,6 +512,32 @@ func (iq *InvoiceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (iq *InvoiceQuery) ForUpdate(opts ...sql.LockOption) *InvoiceQuery {
+	if iq.driver.Dialect() == dialect.Postgres {
+		iq.Unique(false)
+	}
+	iq.modifiers = append(iq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return iq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (iq *InvoiceQuery) ForShare(opts ...sql.LockOption) *InvoiceQuery {
+	if iq.driver.Dialect() == dialect.Postgres {
+		iq.Unique(false)
+	}
+	iq.modifiers = append(iq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return iq
+}
+
 // InvoiceGroupBy is the group-by builder for Invoice entities.
 type InvoiceGroupBy struct {
 	config
@@ -6,6 +6,7 @@ import (
 	"Veritasbackend/ent/invoice"
 	"Veritasbackend/ent/invoiceitem"
 	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
 	"context"
 	"errors"
 	"fmt"
@@ -30,15 +31,374 @@ func (iu *InvoiceUpdate) Where(ps ...predicate.Invoice) *InvoiceUpdate {
 }
 
 // SetTotal sets the "total" field.
-func (iu *InvoiceUpdate) SetTotal(f float64) *InvoiceUpdate {
+func (iu *InvoiceUpdate) SetTotal(m money.Amount) *InvoiceUpdate {
 	iu.mutation.ResetTotal()
-	iu.mutation.SetTotal(f)
+	iu.mutation.SetTotal(m)
 	return iu
 }
 
-// AddTotal adds f to the "total" field.
-func (iu *InvoiceUpdate) AddTotal(f float64) *InvoiceUpdate {
-	iu.mutation.AddTotal(f)
+// AddTotal adds m to the "total" field.
+func (iu *InvoiceUpdate) AddTotal(m money.Amount) *InvoiceUpdate {
+	iu.mutation.AddTotal(m)
+	return iu
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (iu *InvoiceUpdate) SetSubtotal(m money.Amount) *InvoiceUpdate {
+	iu.mutation.ResetSubtotal()
+	iu.mutation.SetSubtotal(m)
+	return iu
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableSubtotal(m *money.Amount) *InvoiceUpdate {
+	if m != nil {
+		iu.SetSubtotal(*m)
+	}
+	return iu
+}
+
+// AddSubtotal adds m to the "subtotal" field.
+func (iu *InvoiceUpdate) AddSubtotal(m money.Amount) *InvoiceUpdate {
+	iu.mutation.AddSubtotal(m)
+	return iu
+}
+
+// SetTaxTotal sets the "tax_total" field.
+func (iu *InvoiceUpdate) SetTaxTotal(m money.Amount) *InvoiceUpdate {
+	iu.mutation.ResetTaxTotal()
+	iu.mutation.SetTaxTotal(m)
+	return iu
+}
+
+// SetNillableTaxTotal sets the "tax_total" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableTaxTotal(m *money.Amount) *InvoiceUpdate {
+	if m != nil {
+		iu.SetTaxTotal(*m)
+	}
+	return iu
+}
+
+// AddTaxTotal adds m to the "tax_total" field.
+func (iu *InvoiceUpdate) AddTaxTotal(m money.Amount) *InvoiceUpdate {
+	iu.mutation.AddTaxTotal(m)
+	return iu
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (iu *InvoiceUpdate) SetDiscountAmount(m money.Amount) *InvoiceUpdate {
+	iu.mutation.ResetDiscountAmount()
+	iu.mutation.SetDiscountAmount(m)
+	return iu
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableDiscountAmount(m *money.Amount) *InvoiceUpdate {
+	if m != nil {
+		iu.SetDiscountAmount(*m)
+	}
+	return iu
+}
+
+// AddDiscountAmount adds m to the "discount_amount" field.
+func (iu *InvoiceUpdate) AddDiscountAmount(m money.Amount) *InvoiceUpdate {
+	iu.mutation.AddDiscountAmount(m)
+	return iu
+}
+
+// SetDiscountTotal sets the "discount_total" field.
+func (iu *InvoiceUpdate) SetDiscountTotal(m money.Amount) *InvoiceUpdate {
+	iu.mutation.ResetDiscountTotal()
+	iu.mutation.SetDiscountTotal(m)
+	return iu
+}
+
+// SetNillableDiscountTotal sets the "discount_total" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableDiscountTotal(m *money.Amount) *InvoiceUpdate {
+	if m != nil {
+		iu.SetDiscountTotal(*m)
+	}
+	return iu
+}
+
+// AddDiscountTotal adds m to the "discount_total" field.
+func (iu *InvoiceUpdate) AddDiscountTotal(m money.Amount) *InvoiceUpdate {
+	iu.mutation.AddDiscountTotal(m)
+	return iu
+}
+
+// SetPromotionTotal sets the "promotion_total" field.
+func (iu *InvoiceUpdate) SetPromotionTotal(m money.Amount) *InvoiceUpdate {
+	iu.mutation.ResetPromotionTotal()
+	iu.mutation.SetPromotionTotal(m)
+	return iu
+}
+
+// SetNillablePromotionTotal sets the "promotion_total" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillablePromotionTotal(m *money.Amount) *InvoiceUpdate {
+	if m != nil {
+		iu.SetPromotionTotal(*m)
+	}
+	return iu
+}
+
+// AddPromotionTotal adds m to the "promotion_total" field.
+func (iu *InvoiceUpdate) AddPromotionTotal(m money.Amount) *InvoiceUpdate {
+	iu.mutation.AddPromotionTotal(m)
+	return iu
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (iu *InvoiceUpdate) SetCouponCode(s string) *InvoiceUpdate {
+	iu.mutation.SetCouponCode(s)
+	return iu
+}
+
+// SetNillableCouponCode sets the "coupon_code" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableCouponCode(s *string) *InvoiceUpdate {
+	if s != nil {
+		iu.SetCouponCode(*s)
+	}
+	return iu
+}
+
+// ClearCouponCode clears the value of the "coupon_code" field.
+func (iu *InvoiceUpdate) ClearCouponCode() *InvoiceUpdate {
+	iu.mutation.ClearCouponCode()
+	return iu
+}
+
+// SetDiscountApprovedBy sets the "discount_approved_by" field.
+func (iu *InvoiceUpdate) SetDiscountApprovedBy(i int) *InvoiceUpdate {
+	iu.mutation.ResetDiscountApprovedBy()
+	iu.mutation.SetDiscountApprovedBy(i)
+	return iu
+}
+
+// SetNillableDiscountApprovedBy sets the "discount_approved_by" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableDiscountApprovedBy(i *int) *InvoiceUpdate {
+	if i != nil {
+		iu.SetDiscountApprovedBy(*i)
+	}
+	return iu
+}
+
+// AddDiscountApprovedBy adds i to the "discount_approved_by" field.
+func (iu *InvoiceUpdate) AddDiscountApprovedBy(i int) *InvoiceUpdate {
+	iu.mutation.AddDiscountApprovedBy(i)
+	return iu
+}
+
+// ClearDiscountApprovedBy clears the value of the "discount_approved_by" field.
+func (iu *InvoiceUpdate) ClearDiscountApprovedBy() *InvoiceUpdate {
+	iu.mutation.ClearDiscountApprovedBy()
+	return iu
+}
+
+// SetPricesIncludeTax sets the "prices_include_tax" field.
+func (iu *InvoiceUpdate) SetPricesIncludeTax(b bool) *InvoiceUpdate {
+	iu.mutation.SetPricesIncludeTax(b)
+	return iu
+}
+
+// SetNillablePricesIncludeTax sets the "prices_include_tax" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillablePricesIncludeTax(b *bool) *InvoiceUpdate {
+	if b != nil {
+		iu.SetPricesIncludeTax(*b)
+	}
+	return iu
+}
+
+// SetCurrency sets the "currency" field.
+func (iu *InvoiceUpdate) SetCurrency(s string) *InvoiceUpdate {
+	iu.mutation.SetCurrency(s)
+	return iu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableCurrency(s *string) *InvoiceUpdate {
+	if s != nil {
+		iu.SetCurrency(*s)
+	}
+	return iu
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (iu *InvoiceUpdate) SetExchangeRate(f float64) *InvoiceUpdate {
+	iu.mutation.ResetExchangeRate()
+	iu.mutation.SetExchangeRate(f)
+	return iu
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableExchangeRate(f *float64) *InvoiceUpdate {
+	if f != nil {
+		iu.SetExchangeRate(*f)
+	}
+	return iu
+}
+
+// AddExchangeRate adds f to the "exchange_rate" field.
+func (iu *InvoiceUpdate) AddExchangeRate(f float64) *InvoiceUpdate {
+	iu.mutation.AddExchangeRate(f)
+	return iu
+}
+
+// SetBaseSubtotal sets the "base_subtotal" field.
+func (iu *InvoiceUpdate) SetBaseSubtotal(m money.Amount) *InvoiceUpdate {
+	iu.mutation.ResetBaseSubtotal()
+	iu.mutation.SetBaseSubtotal(m)
+	return iu
+}
+
+// SetNillableBaseSubtotal sets the "base_subtotal" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableBaseSubtotal(m *money.Amount) *InvoiceUpdate {
+	if m != nil {
+		iu.SetBaseSubtotal(*m)
+	}
+	return iu
+}
+
+// AddBaseSubtotal adds m to the "base_subtotal" field.
+func (iu *InvoiceUpdate) AddBaseSubtotal(m money.Amount) *InvoiceUpdate {
+	iu.mutation.AddBaseSubtotal(m)
+	return iu
+}
+
+// SetBaseTaxTotal sets the "base_tax_total" field.
+func (iu *InvoiceUpdate) SetBaseTaxTotal(m money.Amount) *InvoiceUpdate {
+	iu.mutation.ResetBaseTaxTotal()
+	iu.mutation.SetBaseTaxTotal(m)
+	return iu
+}
+
+// SetNillableBaseTaxTotal sets the "base_tax_total" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableBaseTaxTotal(m *money.Amount) *InvoiceUpdate {
+	if m != nil {
+		iu.SetBaseTaxTotal(*m)
+	}
+	return iu
+}
+
+// AddBaseTaxTotal adds m to the "base_tax_total" field.
+func (iu *InvoiceUpdate) AddBaseTaxTotal(m money.Amount) *InvoiceUpdate {
+	iu.mutation.AddBaseTaxTotal(m)
+	return iu
+}
+
+// SetBaseTotal sets the "base_total" field.
+func (iu *InvoiceUpdate) SetBaseTotal(m money.Amount) *InvoiceUpdate {
+	iu.mutation.ResetBaseTotal()
+	iu.mutation.SetBaseTotal(m)
+	return iu
+}
+
+// SetNillableBaseTotal sets the "base_total" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableBaseTotal(m *money.Amount) *InvoiceUpdate {
+	if m != nil {
+		iu.SetBaseTotal(*m)
+	}
+	return iu
+}
+
+// AddBaseTotal adds m to the "base_total" field.
+func (iu *InvoiceUpdate) AddBaseTotal(m money.Amount) *InvoiceUpdate {
+	iu.mutation.AddBaseTotal(m)
+	return iu
+}
+
+// SetPaymentExchangeRate sets the "payment_exchange_rate" field.
+func (iu *InvoiceUpdate) SetPaymentExchangeRate(f float64) *InvoiceUpdate {
+	iu.mutation.ResetPaymentExchangeRate()
+	iu.mutation.SetPaymentExchangeRate(f)
+	return iu
+}
+
+// SetNillablePaymentExchangeRate sets the "payment_exchange_rate" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillablePaymentExchangeRate(f *float64) *InvoiceUpdate {
+	if f != nil {
+		iu.SetPaymentExchangeRate(*f)
+	}
+	return iu
+}
+
+// AddPaymentExchangeRate adds f to the "payment_exchange_rate" field.
+func (iu *InvoiceUpdate) AddPaymentExchangeRate(f float64) *InvoiceUpdate {
+	iu.mutation.AddPaymentExchangeRate(f)
+	return iu
+}
+
+// ClearPaymentExchangeRate clears the value of the "payment_exchange_rate" field.
+func (iu *InvoiceUpdate) ClearPaymentExchangeRate() *InvoiceUpdate {
+	iu.mutation.ClearPaymentExchangeRate()
+	return iu
+}
+
+// SetFxGainLoss sets the "fx_gain_loss" field.
+func (iu *InvoiceUpdate) SetFxGainLoss(m money.Amount) *InvoiceUpdate {
+	iu.mutation.ResetFxGainLoss()
+	iu.mutation.SetFxGainLoss(m)
+	return iu
+}
+
+// SetNillableFxGainLoss sets the "fx_gain_loss" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableFxGainLoss(m *money.Amount) *InvoiceUpdate {
+	if m != nil {
+		iu.SetFxGainLoss(*m)
+	}
+	return iu
+}
+
+// AddFxGainLoss adds m to the "fx_gain_loss" field.
+func (iu *InvoiceUpdate) AddFxGainLoss(m money.Amount) *InvoiceUpdate {
+	iu.mutation.AddFxGainLoss(m)
+	return iu
+}
+
+// SetNumber sets the "number" field.
+func (iu *InvoiceUpdate) SetNumber(s string) *InvoiceUpdate {
+	iu.mutation.SetNumber(s)
+	return iu
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableNumber(s *string) *InvoiceUpdate {
+	if s != nil {
+		iu.SetNumber(*s)
+	}
+	return iu
+}
+
+// ClearNumber clears the value of the "number" field.
+func (iu *InvoiceUpdate) ClearNumber() *InvoiceUpdate {
+	iu.mutation.ClearNumber()
+	return iu
+}
+
+// SetSeriesID sets the "series_id" field.
+func (iu *InvoiceUpdate) SetSeriesID(i int) *InvoiceUpdate {
+	iu.mutation.ResetSeriesID()
+	iu.mutation.SetSeriesID(i)
+	return iu
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableSeriesID(i *int) *InvoiceUpdate {
+	if i != nil {
+		iu.SetSeriesID(*i)
+	}
+	return iu
+}
+
+// AddSeriesID adds i to the "series_id" field.
+func (iu *InvoiceUpdate) AddSeriesID(i int) *InvoiceUpdate {
+	iu.mutation.AddSeriesID(i)
+	return iu
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (iu *InvoiceUpdate) ClearSeriesID() *InvoiceUpdate {
+	iu.mutation.ClearSeriesID()
 	return iu
 }
 
@@ -82,6 +442,60 @@ func (iu *InvoiceUpdate) AddUserID(i int) *InvoiceUpdate {
 	return iu
 }
 
+// SetPriceListID sets the "price_list_id" field.
+func (iu *InvoiceUpdate) SetPriceListID(i int) *InvoiceUpdate {
+	iu.mutation.ResetPriceListID()
+	iu.mutation.SetPriceListID(i)
+	return iu
+}
+
+// SetNillablePriceListID sets the "price_list_id" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillablePriceListID(i *int) *InvoiceUpdate {
+	if i != nil {
+		iu.SetPriceListID(*i)
+	}
+	return iu
+}
+
+// AddPriceListID adds i to the "price_list_id" field.
+func (iu *InvoiceUpdate) AddPriceListID(i int) *InvoiceUpdate {
+	iu.mutation.AddPriceListID(i)
+	return iu
+}
+
+// ClearPriceListID clears the value of the "price_list_id" field.
+func (iu *InvoiceUpdate) ClearPriceListID() *InvoiceUpdate {
+	iu.mutation.ClearPriceListID()
+	return iu
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (iu *InvoiceUpdate) SetCustomerID(i int) *InvoiceUpdate {
+	iu.mutation.ResetCustomerID()
+	iu.mutation.SetCustomerID(i)
+	return iu
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableCustomerID(i *int) *InvoiceUpdate {
+	if i != nil {
+		iu.SetCustomerID(*i)
+	}
+	return iu
+}
+
+// AddCustomerID adds i to the "customer_id" field.
+func (iu *InvoiceUpdate) AddCustomerID(i int) *InvoiceUpdate {
+	iu.mutation.AddCustomerID(i)
+	return iu
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (iu *InvoiceUpdate) ClearCustomerID() *InvoiceUpdate {
+	iu.mutation.ClearCustomerID()
+	return iu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (iu *InvoiceUpdate) SetUpdatedAt(t time.Time) *InvoiceUpdate {
 	iu.mutation.SetUpdatedAt(t)
@@ -201,10 +615,15 @@ func (iu *InvoiceUpdate) defaults() {
 // check runs all checks and user-defined validators on the builder.
 func (iu *InvoiceUpdate) check() error {
 	if v, ok := iu.mutation.Total(); ok {
-		if err := invoice.TotalValidator(v); err != nil {
+		if err := invoice.TotalValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "Invoice.total": %w`, err)}
 		}
 	}
+	if v, ok := iu.mutation.ExchangeRate(); ok {
+		if err := invoice.ExchangeRateValidator(v); err != nil {
+			return &ValidationError{Name: "exchange_rate", err: fmt.Errorf(`ent: validator failed for field "Invoice.exchange_rate": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -228,86 +647,366 @@ func (iu *InvoiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if value, ok := iu.mutation.Total(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: invoice.FieldTotal,
 		})
 	}
 	if value, ok := iu.mutation.AddedTotal(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: invoice.FieldTotal,
 		})
 	}
-	if value, ok := iu.mutation.Status(); ok {
+	if value, ok := iu.mutation.Subtotal(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
+			Type:   field.TypeInt64,
 			Value:  value,
-			Column: invoice.FieldStatus,
+			Column: invoice.FieldSubtotal,
 		})
 	}
-	if value, ok := iu.mutation.TenantID(); ok {
+	if value, ok := iu.mutation.AddedSubtotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldSubtotal,
+		})
+	}
+	if value, ok := iu.mutation.TaxTotal(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeInt64,
 			Value:  value,
-			Column: invoice.FieldTenantID,
+			Column: invoice.FieldTaxTotal,
 		})
 	}
-	if value, ok := iu.mutation.AddedTenantID(); ok {
+	if value, ok := iu.mutation.AddedTaxTotal(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeInt64,
 			Value:  value,
-			Column: invoice.FieldTenantID,
+			Column: invoice.FieldTaxTotal,
 		})
 	}
-	if value, ok := iu.mutation.UserID(); ok {
+	if value, ok := iu.mutation.DiscountAmount(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeInt64,
 			Value:  value,
-			Column: invoice.FieldUserID,
+			Column: invoice.FieldDiscountAmount,
 		})
 	}
-	if value, ok := iu.mutation.AddedUserID(); ok {
+	if value, ok := iu.mutation.AddedDiscountAmount(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeInt64,
 			Value:  value,
-			Column: invoice.FieldUserID,
+			Column: invoice.FieldDiscountAmount,
 		})
 	}
-	if value, ok := iu.mutation.UpdatedAt(); ok {
+	if value, ok := iu.mutation.DiscountTotal(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeTime,
+			Type:   field.TypeInt64,
 			Value:  value,
-			Column: invoice.FieldUpdatedAt,
+			Column: invoice.FieldDiscountTotal,
 		})
 	}
-	if iu.mutation.ItemsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   invoice.ItemsTable,
-			Columns: []string{invoice.ItemsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeInt,
-					Column: invoiceitem.FieldID,
-				},
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	if value, ok := iu.mutation.AddedDiscountTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldDiscountTotal,
+		})
 	}
-	if nodes := iu.mutation.RemovedItemsIDs(); len(nodes) > 0 && !iu.mutation.ItemsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   invoice.ItemsTable,
-			Columns: []string{invoice.ItemsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeInt,
+	if value, ok := iu.mutation.PromotionTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldPromotionTotal,
+		})
+	}
+	if value, ok := iu.mutation.AddedPromotionTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldPromotionTotal,
+		})
+	}
+	if value, ok := iu.mutation.CouponCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldCouponCode,
+		})
+	}
+	if iu.mutation.CouponCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: invoice.FieldCouponCode,
+		})
+	}
+	if value, ok := iu.mutation.DiscountApprovedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldDiscountApprovedBy,
+		})
+	}
+	if value, ok := iu.mutation.AddedDiscountApprovedBy(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldDiscountApprovedBy,
+		})
+	}
+	if iu.mutation.DiscountApprovedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoice.FieldDiscountApprovedBy,
+		})
+	}
+	if value, ok := iu.mutation.PricesIncludeTax(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: invoice.FieldPricesIncludeTax,
+		})
+	}
+	if value, ok := iu.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldCurrency,
+		})
+	}
+	if value, ok := iu.mutation.ExchangeRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldExchangeRate,
+		})
+	}
+	if value, ok := iu.mutation.AddedExchangeRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldExchangeRate,
+		})
+	}
+	if value, ok := iu.mutation.BaseSubtotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldBaseSubtotal,
+		})
+	}
+	if value, ok := iu.mutation.AddedBaseSubtotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldBaseSubtotal,
+		})
+	}
+	if value, ok := iu.mutation.BaseTaxTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldBaseTaxTotal,
+		})
+	}
+	if value, ok := iu.mutation.AddedBaseTaxTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldBaseTaxTotal,
+		})
+	}
+	if value, ok := iu.mutation.BaseTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldBaseTotal,
+		})
+	}
+	if value, ok := iu.mutation.AddedBaseTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldBaseTotal,
+		})
+	}
+	if value, ok := iu.mutation.PaymentExchangeRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldPaymentExchangeRate,
+		})
+	}
+	if value, ok := iu.mutation.AddedPaymentExchangeRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldPaymentExchangeRate,
+		})
+	}
+	if iu.mutation.PaymentExchangeRateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: invoice.FieldPaymentExchangeRate,
+		})
+	}
+	if value, ok := iu.mutation.FxGainLoss(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldFxGainLoss,
+		})
+	}
+	if value, ok := iu.mutation.AddedFxGainLoss(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldFxGainLoss,
+		})
+	}
+	if value, ok := iu.mutation.Number(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldNumber,
+		})
+	}
+	if iu.mutation.NumberCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: invoice.FieldNumber,
+		})
+	}
+	if value, ok := iu.mutation.SeriesID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldSeriesID,
+		})
+	}
+	if value, ok := iu.mutation.AddedSeriesID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldSeriesID,
+		})
+	}
+	if iu.mutation.SeriesIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoice.FieldSeriesID,
+		})
+	}
+	if value, ok := iu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldStatus,
+		})
+	}
+	if value, ok := iu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTenantID,
+		})
+	}
+	if value, ok := iu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTenantID,
+		})
+	}
+	if value, ok := iu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldUserID,
+		})
+	}
+	if value, ok := iu.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldUserID,
+		})
+	}
+	if value, ok := iu.mutation.PriceListID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldPriceListID,
+		})
+	}
+	if value, ok := iu.mutation.AddedPriceListID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldPriceListID,
+		})
+	}
+	if iu.mutation.PriceListIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoice.FieldPriceListID,
+		})
+	}
+	if value, ok := iu.mutation.CustomerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldCustomerID,
+		})
+	}
+	if value, ok := iu.mutation.AddedCustomerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldCustomerID,
+		})
+	}
+	if iu.mutation.CustomerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoice.FieldCustomerID,
+		})
+	}
+	if value, ok := iu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invoice.FieldUpdatedAt,
+		})
+	}
+	if iu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.ItemsTable,
+			Columns: []string{invoice.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoiceitem.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedItemsIDs(); len(nodes) > 0 && !iu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.ItemsTable,
+			Columns: []string{invoice.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
 					Column: invoiceitem.FieldID,
 				},
 			},
@@ -336,35 +1035,394 @@ func (iu *InvoiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{invoice.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InvoiceUpdateOne is the builder for updating a single Invoice entity.
+type InvoiceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// SetTotal sets the "total" field.
+func (iuo *InvoiceUpdateOne) SetTotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.ResetTotal()
+	iuo.mutation.SetTotal(m)
+	return iuo
+}
+
+// AddTotal adds m to the "total" field.
+func (iuo *InvoiceUpdateOne) AddTotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.AddTotal(m)
+	return iuo
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (iuo *InvoiceUpdateOne) SetSubtotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.ResetSubtotal()
+	iuo.mutation.SetSubtotal(m)
+	return iuo
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableSubtotal(m *money.Amount) *InvoiceUpdateOne {
+	if m != nil {
+		iuo.SetSubtotal(*m)
+	}
+	return iuo
+}
+
+// AddSubtotal adds m to the "subtotal" field.
+func (iuo *InvoiceUpdateOne) AddSubtotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.AddSubtotal(m)
+	return iuo
+}
+
+// SetTaxTotal sets the "tax_total" field.
+func (iuo *InvoiceUpdateOne) SetTaxTotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.ResetTaxTotal()
+	iuo.mutation.SetTaxTotal(m)
+	return iuo
+}
+
+// SetNillableTaxTotal sets the "tax_total" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableTaxTotal(m *money.Amount) *InvoiceUpdateOne {
+	if m != nil {
+		iuo.SetTaxTotal(*m)
+	}
+	return iuo
+}
+
+// AddTaxTotal adds m to the "tax_total" field.
+func (iuo *InvoiceUpdateOne) AddTaxTotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.AddTaxTotal(m)
+	return iuo
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (iuo *InvoiceUpdateOne) SetDiscountAmount(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.ResetDiscountAmount()
+	iuo.mutation.SetDiscountAmount(m)
+	return iuo
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableDiscountAmount(m *money.Amount) *InvoiceUpdateOne {
+	if m != nil {
+		iuo.SetDiscountAmount(*m)
+	}
+	return iuo
+}
+
+// AddDiscountAmount adds m to the "discount_amount" field.
+func (iuo *InvoiceUpdateOne) AddDiscountAmount(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.AddDiscountAmount(m)
+	return iuo
+}
+
+// SetDiscountTotal sets the "discount_total" field.
+func (iuo *InvoiceUpdateOne) SetDiscountTotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.ResetDiscountTotal()
+	iuo.mutation.SetDiscountTotal(m)
+	return iuo
+}
+
+// SetNillableDiscountTotal sets the "discount_total" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableDiscountTotal(m *money.Amount) *InvoiceUpdateOne {
+	if m != nil {
+		iuo.SetDiscountTotal(*m)
+	}
+	return iuo
+}
+
+// AddDiscountTotal adds m to the "discount_total" field.
+func (iuo *InvoiceUpdateOne) AddDiscountTotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.AddDiscountTotal(m)
+	return iuo
+}
+
+// SetPromotionTotal sets the "promotion_total" field.
+func (iuo *InvoiceUpdateOne) SetPromotionTotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.ResetPromotionTotal()
+	iuo.mutation.SetPromotionTotal(m)
+	return iuo
+}
+
+// SetNillablePromotionTotal sets the "promotion_total" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillablePromotionTotal(m *money.Amount) *InvoiceUpdateOne {
+	if m != nil {
+		iuo.SetPromotionTotal(*m)
+	}
+	return iuo
+}
+
+// AddPromotionTotal adds m to the "promotion_total" field.
+func (iuo *InvoiceUpdateOne) AddPromotionTotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.AddPromotionTotal(m)
+	return iuo
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (iuo *InvoiceUpdateOne) SetCouponCode(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetCouponCode(s)
+	return iuo
+}
+
+// SetNillableCouponCode sets the "coupon_code" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableCouponCode(s *string) *InvoiceUpdateOne {
+	if s != nil {
+		iuo.SetCouponCode(*s)
+	}
+	return iuo
+}
+
+// ClearCouponCode clears the value of the "coupon_code" field.
+func (iuo *InvoiceUpdateOne) ClearCouponCode() *InvoiceUpdateOne {
+	iuo.mutation.ClearCouponCode()
+	return iuo
+}
+
+// SetDiscountApprovedBy sets the "discount_approved_by" field.
+func (iuo *InvoiceUpdateOne) SetDiscountApprovedBy(i int) *InvoiceUpdateOne {
+	iuo.mutation.ResetDiscountApprovedBy()
+	iuo.mutation.SetDiscountApprovedBy(i)
+	return iuo
+}
+
+// SetNillableDiscountApprovedBy sets the "discount_approved_by" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableDiscountApprovedBy(i *int) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetDiscountApprovedBy(*i)
+	}
+	return iuo
+}
+
+// AddDiscountApprovedBy adds i to the "discount_approved_by" field.
+func (iuo *InvoiceUpdateOne) AddDiscountApprovedBy(i int) *InvoiceUpdateOne {
+	iuo.mutation.AddDiscountApprovedBy(i)
+	return iuo
+}
+
+// ClearDiscountApprovedBy clears the value of the "discount_approved_by" field.
+func (iuo *InvoiceUpdateOne) ClearDiscountApprovedBy() *InvoiceUpdateOne {
+	iuo.mutation.ClearDiscountApprovedBy()
+	return iuo
+}
+
+// SetPricesIncludeTax sets the "prices_include_tax" field.
+func (iuo *InvoiceUpdateOne) SetPricesIncludeTax(b bool) *InvoiceUpdateOne {
+	iuo.mutation.SetPricesIncludeTax(b)
+	return iuo
+}
+
+// SetNillablePricesIncludeTax sets the "prices_include_tax" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillablePricesIncludeTax(b *bool) *InvoiceUpdateOne {
+	if b != nil {
+		iuo.SetPricesIncludeTax(*b)
+	}
+	return iuo
+}
+
+// SetCurrency sets the "currency" field.
+func (iuo *InvoiceUpdateOne) SetCurrency(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetCurrency(s)
+	return iuo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableCurrency(s *string) *InvoiceUpdateOne {
+	if s != nil {
+		iuo.SetCurrency(*s)
+	}
+	return iuo
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (iuo *InvoiceUpdateOne) SetExchangeRate(f float64) *InvoiceUpdateOne {
+	iuo.mutation.ResetExchangeRate()
+	iuo.mutation.SetExchangeRate(f)
+	return iuo
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableExchangeRate(f *float64) *InvoiceUpdateOne {
+	if f != nil {
+		iuo.SetExchangeRate(*f)
+	}
+	return iuo
+}
+
+// AddExchangeRate adds f to the "exchange_rate" field.
+func (iuo *InvoiceUpdateOne) AddExchangeRate(f float64) *InvoiceUpdateOne {
+	iuo.mutation.AddExchangeRate(f)
+	return iuo
+}
+
+// SetBaseSubtotal sets the "base_subtotal" field.
+func (iuo *InvoiceUpdateOne) SetBaseSubtotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.ResetBaseSubtotal()
+	iuo.mutation.SetBaseSubtotal(m)
+	return iuo
+}
+
+// SetNillableBaseSubtotal sets the "base_subtotal" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableBaseSubtotal(m *money.Amount) *InvoiceUpdateOne {
+	if m != nil {
+		iuo.SetBaseSubtotal(*m)
+	}
+	return iuo
+}
+
+// AddBaseSubtotal adds m to the "base_subtotal" field.
+func (iuo *InvoiceUpdateOne) AddBaseSubtotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.AddBaseSubtotal(m)
+	return iuo
+}
+
+// SetBaseTaxTotal sets the "base_tax_total" field.
+func (iuo *InvoiceUpdateOne) SetBaseTaxTotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.ResetBaseTaxTotal()
+	iuo.mutation.SetBaseTaxTotal(m)
+	return iuo
+}
+
+// SetNillableBaseTaxTotal sets the "base_tax_total" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableBaseTaxTotal(m *money.Amount) *InvoiceUpdateOne {
+	if m != nil {
+		iuo.SetBaseTaxTotal(*m)
+	}
+	return iuo
+}
+
+// AddBaseTaxTotal adds m to the "base_tax_total" field.
+func (iuo *InvoiceUpdateOne) AddBaseTaxTotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.AddBaseTaxTotal(m)
+	return iuo
+}
+
+// SetBaseTotal sets the "base_total" field.
+func (iuo *InvoiceUpdateOne) SetBaseTotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.ResetBaseTotal()
+	iuo.mutation.SetBaseTotal(m)
+	return iuo
+}
+
+// SetNillableBaseTotal sets the "base_total" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableBaseTotal(m *money.Amount) *InvoiceUpdateOne {
+	if m != nil {
+		iuo.SetBaseTotal(*m)
+	}
+	return iuo
+}
+
+// AddBaseTotal adds m to the "base_total" field.
+func (iuo *InvoiceUpdateOne) AddBaseTotal(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.AddBaseTotal(m)
+	return iuo
+}
+
+// SetPaymentExchangeRate sets the "payment_exchange_rate" field.
+func (iuo *InvoiceUpdateOne) SetPaymentExchangeRate(f float64) *InvoiceUpdateOne {
+	iuo.mutation.ResetPaymentExchangeRate()
+	iuo.mutation.SetPaymentExchangeRate(f)
+	return iuo
+}
+
+// SetNillablePaymentExchangeRate sets the "payment_exchange_rate" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillablePaymentExchangeRate(f *float64) *InvoiceUpdateOne {
+	if f != nil {
+		iuo.SetPaymentExchangeRate(*f)
+	}
+	return iuo
+}
+
+// AddPaymentExchangeRate adds f to the "payment_exchange_rate" field.
+func (iuo *InvoiceUpdateOne) AddPaymentExchangeRate(f float64) *InvoiceUpdateOne {
+	iuo.mutation.AddPaymentExchangeRate(f)
+	return iuo
+}
+
+// ClearPaymentExchangeRate clears the value of the "payment_exchange_rate" field.
+func (iuo *InvoiceUpdateOne) ClearPaymentExchangeRate() *InvoiceUpdateOne {
+	iuo.mutation.ClearPaymentExchangeRate()
+	return iuo
+}
+
+// SetFxGainLoss sets the "fx_gain_loss" field.
+func (iuo *InvoiceUpdateOne) SetFxGainLoss(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.ResetFxGainLoss()
+	iuo.mutation.SetFxGainLoss(m)
+	return iuo
+}
+
+// SetNillableFxGainLoss sets the "fx_gain_loss" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableFxGainLoss(m *money.Amount) *InvoiceUpdateOne {
+	if m != nil {
+		iuo.SetFxGainLoss(*m)
 	}
-	return n, nil
+	return iuo
 }
 
-// InvoiceUpdateOne is the builder for updating a single Invoice entity.
-type InvoiceUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *InvoiceMutation
+// AddFxGainLoss adds m to the "fx_gain_loss" field.
+func (iuo *InvoiceUpdateOne) AddFxGainLoss(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.AddFxGainLoss(m)
+	return iuo
 }
 
-// SetTotal sets the "total" field.
-func (iuo *InvoiceUpdateOne) SetTotal(f float64) *InvoiceUpdateOne {
-	iuo.mutation.ResetTotal()
-	iuo.mutation.SetTotal(f)
+// SetNumber sets the "number" field.
+func (iuo *InvoiceUpdateOne) SetNumber(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetNumber(s)
+	return iuo
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableNumber(s *string) *InvoiceUpdateOne {
+	if s != nil {
+		iuo.SetNumber(*s)
+	}
+	return iuo
+}
+
+// ClearNumber clears the value of the "number" field.
+func (iuo *InvoiceUpdateOne) ClearNumber() *InvoiceUpdateOne {
+	iuo.mutation.ClearNumber()
+	return iuo
+}
+
+// SetSeriesID sets the "series_id" field.
+func (iuo *InvoiceUpdateOne) SetSeriesID(i int) *InvoiceUpdateOne {
+	iuo.mutation.ResetSeriesID()
+	iuo.mutation.SetSeriesID(i)
+	return iuo
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableSeriesID(i *int) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetSeriesID(*i)
+	}
+	return iuo
+}
+
+// AddSeriesID adds i to the "series_id" field.
+func (iuo *InvoiceUpdateOne) AddSeriesID(i int) *InvoiceUpdateOne {
+	iuo.mutation.AddSeriesID(i)
 	return iuo
 }
 
-// AddTotal adds f to the "total" field.
-func (iuo *InvoiceUpdateOne) AddTotal(f float64) *InvoiceUpdateOne {
-	iuo.mutation.AddTotal(f)
+// ClearSeriesID clears the value of the "series_id" field.
+func (iuo *InvoiceUpdateOne) ClearSeriesID() *InvoiceUpdateOne {
+	iuo.mutation.ClearSeriesID()
 	return iuo
 }
 
@@ -408,6 +1466,60 @@ func (iuo *InvoiceUpdateOne) AddUserID(i int) *InvoiceUpdateOne {
 	return iuo
 }
 
+// SetPriceListID sets the "price_list_id" field.
+func (iuo *InvoiceUpdateOne) SetPriceListID(i int) *InvoiceUpdateOne {
+	iuo.mutation.ResetPriceListID()
+	iuo.mutation.SetPriceListID(i)
+	return iuo
+}
+
+// SetNillablePriceListID sets the "price_list_id" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillablePriceListID(i *int) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetPriceListID(*i)
+	}
+	return iuo
+}
+
+// AddPriceListID adds i to the "price_list_id" field.
+func (iuo *InvoiceUpdateOne) AddPriceListID(i int) *InvoiceUpdateOne {
+	iuo.mutation.AddPriceListID(i)
+	return iuo
+}
+
+// ClearPriceListID clears the value of the "price_list_id" field.
+func (iuo *InvoiceUpdateOne) ClearPriceListID() *InvoiceUpdateOne {
+	iuo.mutation.ClearPriceListID()
+	return iuo
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (iuo *InvoiceUpdateOne) SetCustomerID(i int) *InvoiceUpdateOne {
+	iuo.mutation.ResetCustomerID()
+	iuo.mutation.SetCustomerID(i)
+	return iuo
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableCustomerID(i *int) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetCustomerID(*i)
+	}
+	return iuo
+}
+
+// AddCustomerID adds i to the "customer_id" field.
+func (iuo *InvoiceUpdateOne) AddCustomerID(i int) *InvoiceUpdateOne {
+	iuo.mutation.AddCustomerID(i)
+	return iuo
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (iuo *InvoiceUpdateOne) ClearCustomerID() *InvoiceUpdateOne {
+	iuo.mutation.ClearCustomerID()
+	return iuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (iuo *InvoiceUpdateOne) SetUpdatedAt(t time.Time) *InvoiceUpdateOne {
 	iuo.mutation.SetUpdatedAt(t)
@@ -540,10 +1652,15 @@ func (iuo *InvoiceUpdateOne) defaults() {
 // check runs all checks and user-defined validators on the builder.
 func (iuo *InvoiceUpdateOne) check() error {
 	if v, ok := iuo.mutation.Total(); ok {
-		if err := invoice.TotalValidator(v); err != nil {
+		if err := invoice.TotalValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "Invoice.total": %w`, err)}
 		}
 	}
+	if v, ok := iuo.mutation.ExchangeRate(); ok {
+		if err := invoice.ExchangeRateValidator(v); err != nil {
+			return &ValidationError{Name: "exchange_rate", err: fmt.Errorf(`ent: validator failed for field "Invoice.exchange_rate": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -584,18 +1701,258 @@ func (iuo *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err e
 	}
 	if value, ok := iuo.mutation.Total(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: invoice.FieldTotal,
 		})
 	}
 	if value, ok := iuo.mutation.AddedTotal(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: invoice.FieldTotal,
 		})
 	}
+	if value, ok := iuo.mutation.Subtotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldSubtotal,
+		})
+	}
+	if value, ok := iuo.mutation.AddedSubtotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldSubtotal,
+		})
+	}
+	if value, ok := iuo.mutation.TaxTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldTaxTotal,
+		})
+	}
+	if value, ok := iuo.mutation.AddedTaxTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldTaxTotal,
+		})
+	}
+	if value, ok := iuo.mutation.DiscountAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldDiscountAmount,
+		})
+	}
+	if value, ok := iuo.mutation.AddedDiscountAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldDiscountAmount,
+		})
+	}
+	if value, ok := iuo.mutation.DiscountTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldDiscountTotal,
+		})
+	}
+	if value, ok := iuo.mutation.AddedDiscountTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldDiscountTotal,
+		})
+	}
+	if value, ok := iuo.mutation.PromotionTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldPromotionTotal,
+		})
+	}
+	if value, ok := iuo.mutation.AddedPromotionTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldPromotionTotal,
+		})
+	}
+	if value, ok := iuo.mutation.CouponCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldCouponCode,
+		})
+	}
+	if iuo.mutation.CouponCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: invoice.FieldCouponCode,
+		})
+	}
+	if value, ok := iuo.mutation.DiscountApprovedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldDiscountApprovedBy,
+		})
+	}
+	if value, ok := iuo.mutation.AddedDiscountApprovedBy(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldDiscountApprovedBy,
+		})
+	}
+	if iuo.mutation.DiscountApprovedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoice.FieldDiscountApprovedBy,
+		})
+	}
+	if value, ok := iuo.mutation.PricesIncludeTax(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: invoice.FieldPricesIncludeTax,
+		})
+	}
+	if value, ok := iuo.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldCurrency,
+		})
+	}
+	if value, ok := iuo.mutation.ExchangeRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldExchangeRate,
+		})
+	}
+	if value, ok := iuo.mutation.AddedExchangeRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldExchangeRate,
+		})
+	}
+	if value, ok := iuo.mutation.BaseSubtotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldBaseSubtotal,
+		})
+	}
+	if value, ok := iuo.mutation.AddedBaseSubtotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldBaseSubtotal,
+		})
+	}
+	if value, ok := iuo.mutation.BaseTaxTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldBaseTaxTotal,
+		})
+	}
+	if value, ok := iuo.mutation.AddedBaseTaxTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldBaseTaxTotal,
+		})
+	}
+	if value, ok := iuo.mutation.BaseTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldBaseTotal,
+		})
+	}
+	if value, ok := iuo.mutation.AddedBaseTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldBaseTotal,
+		})
+	}
+	if value, ok := iuo.mutation.PaymentExchangeRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldPaymentExchangeRate,
+		})
+	}
+	if value, ok := iuo.mutation.AddedPaymentExchangeRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoice.FieldPaymentExchangeRate,
+		})
+	}
+	if iuo.mutation.PaymentExchangeRateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: invoice.FieldPaymentExchangeRate,
+		})
+	}
+	if value, ok := iuo.mutation.FxGainLoss(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldFxGainLoss,
+		})
+	}
+	if value, ok := iuo.mutation.AddedFxGainLoss(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoice.FieldFxGainLoss,
+		})
+	}
+	if value, ok := iuo.mutation.Number(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoice.FieldNumber,
+		})
+	}
+	if iuo.mutation.NumberCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: invoice.FieldNumber,
+		})
+	}
+	if value, ok := iuo.mutation.SeriesID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldSeriesID,
+		})
+	}
+	if value, ok := iuo.mutation.AddedSeriesID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldSeriesID,
+		})
+	}
+	if iuo.mutation.SeriesIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoice.FieldSeriesID,
+		})
+	}
 	if value, ok := iuo.mutation.Status(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -631,6 +1988,46 @@ func (iuo *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err e
 			Column: invoice.FieldUserID,
 		})
 	}
+	if value, ok := iuo.mutation.PriceListID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldPriceListID,
+		})
+	}
+	if value, ok := iuo.mutation.AddedPriceListID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldPriceListID,
+		})
+	}
+	if iuo.mutation.PriceListIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoice.FieldPriceListID,
+		})
+	}
+	if value, ok := iuo.mutation.CustomerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldCustomerID,
+		})
+	}
+	if value, ok := iuo.mutation.AddedCustomerID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldCustomerID,
+		})
+	}
+	if iuo.mutation.CustomerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoice.FieldCustomerID,
+		})
+	}
 	if value, ok := iuo.mutation.UpdatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
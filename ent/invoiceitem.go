@@ -5,6 +5,7 @@ package ent
 import (
 	"Veritasbackend/ent/invoice"
 	"Veritasbackend/ent/invoiceitem"
+	"Veritasbackend/pkg/money"
 	"fmt"
 	"strings"
 
@@ -20,12 +21,42 @@ type InvoiceItem struct {
 	InvoiceID int `json:"invoice_id,omitempty"`
 	// ID del producto
 	ProductID int `json:"product_id,omitempty"`
-	// Cantidad vendida
-	Quantity int `json:"quantity,omitempty"`
-	// Precio unitario al momento de la venta
-	UnitPrice float64 `json:"unit_price,omitempty"`
+	// Cantidad vendida, en la unidad base del producto
+	Quantity float64 `json:"quantity,omitempty"`
+	// Precio por unidad base al momento de la venta
+	UnitPrice money.Amount `json:"unit_price,omitempty"`
 	// Subtotal (quantity * unit_price)
-	Subtotal float64 `json:"subtotal,omitempty"`
+	Subtotal money.Amount `json:"subtotal,omitempty"`
+	// Promoción aplicada a la línea
+	PromotionID *int `json:"promotion_id,omitempty"`
+	// Descuento de la promoción sobre el subtotal de la línea
+	PromotionDiscount money.Amount `json:"promotion_discount,omitempty"`
+	// Descuento propio de la línea
+	DiscountAmount money.Amount `json:"discount_amount,omitempty"`
+	// Parte del descuento de la factura que corresponde a la línea
+	InvoiceDiscountAmount money.Amount `json:"invoice_discount_amount,omitempty"`
+	// Costo unitario de la mercadería vendida al momento de la venta
+	UnitCost money.Amount `json:"unit_cost,omitempty"`
+	// Costo de la mercadería vendida (quantity * unit_cost)
+	CostTotal money.Amount `json:"cost_total,omitempty"`
+	// Regla que fijó el precio (retail, wholesale, price_list_fixed, price_list_percent)
+	PriceRule string `json:"price_rule,omitempty"`
+	// Lista de precios aplicada a la línea
+	PriceListID *int `json:"price_list_id,omitempty"`
+	// Unidad de venta cuando no es la unidad base
+	UnitID *int `json:"unit_id,omitempty"`
+	// Nombre de la unidad de venta al momento de la venta
+	UnitName string `json:"unit_name,omitempty"`
+	// Cantidad vendida expresada en la unidad de venta
+	UnitQuantity *float64 `json:"unit_quantity,omitempty"`
+	// Tarifa de impuesto aplicada a la línea
+	TaxRateID *int `json:"tax_rate_id,omitempty"`
+	// Porcentaje de impuesto aplicado a la línea
+	TaxRate float64 `json:"tax_rate,omitempty"`
+	// Base gravable de la línea, sin impuestos
+	TaxBase money.Amount `json:"tax_base,omitempty"`
+	// Impuesto de la línea
+	TaxAmount money.Amount `json:"tax_amount,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the InvoiceItemQuery when eager-loading is set.
 	Edges InvoiceItemEdges `json:"edges"`
@@ -59,10 +90,14 @@ func (*InvoiceItem) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case invoiceitem.FieldUnitPrice, invoiceitem.FieldSubtotal:
+		case invoiceitem.FieldUnitPrice, invoiceitem.FieldSubtotal, invoiceitem.FieldPromotionDiscount, invoiceitem.FieldDiscountAmount, invoiceitem.FieldInvoiceDiscountAmount, invoiceitem.FieldUnitCost, invoiceitem.FieldCostTotal, invoiceitem.FieldTaxBase, invoiceitem.FieldTaxAmount:
+			values[i] = new(money.Amount)
+		case invoiceitem.FieldQuantity, invoiceitem.FieldUnitQuantity, invoiceitem.FieldTaxRate:
 			values[i] = new(sql.NullFloat64)
-		case invoiceitem.FieldID, invoiceitem.FieldInvoiceID, invoiceitem.FieldProductID, invoiceitem.FieldQuantity:
+		case invoiceitem.FieldID, invoiceitem.FieldInvoiceID, invoiceitem.FieldProductID, invoiceitem.FieldPromotionID, invoiceitem.FieldPriceListID, invoiceitem.FieldUnitID, invoiceitem.FieldTaxRateID:
 			values[i] = new(sql.NullInt64)
+		case invoiceitem.FieldPriceRule, invoiceitem.FieldUnitName:
+			values[i] = new(sql.NullString)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type InvoiceItem", columns[i])
 		}
@@ -97,22 +132,117 @@ func (ii *InvoiceItem) assignValues(columns []string, values []interface{}) erro
 				ii.ProductID = int(value.Int64)
 			}
 		case invoiceitem.FieldQuantity:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field quantity", values[i])
 			} else if value.Valid {
-				ii.Quantity = int(value.Int64)
+				ii.Quantity = value.Float64
 			}
 		case invoiceitem.FieldUnitPrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field unit_price", values[i])
-			} else if value.Valid {
-				ii.UnitPrice = value.Float64
+			} else if value != nil {
+				ii.UnitPrice = *value
 			}
 		case invoiceitem.FieldSubtotal:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field subtotal", values[i])
+			} else if value != nil {
+				ii.Subtotal = *value
+			}
+		case invoiceitem.FieldPromotionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field promotion_id", values[i])
+			} else if value.Valid {
+				ii.PromotionID = new(int)
+				*ii.PromotionID = int(value.Int64)
+			}
+		case invoiceitem.FieldPromotionDiscount:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field promotion_discount", values[i])
+			} else if value != nil {
+				ii.PromotionDiscount = *value
+			}
+		case invoiceitem.FieldDiscountAmount:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_amount", values[i])
+			} else if value != nil {
+				ii.DiscountAmount = *value
+			}
+		case invoiceitem.FieldInvoiceDiscountAmount:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_discount_amount", values[i])
+			} else if value != nil {
+				ii.InvoiceDiscountAmount = *value
+			}
+		case invoiceitem.FieldUnitCost:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_cost", values[i])
+			} else if value != nil {
+				ii.UnitCost = *value
+			}
+		case invoiceitem.FieldCostTotal:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_total", values[i])
+			} else if value != nil {
+				ii.CostTotal = *value
+			}
+		case invoiceitem.FieldPriceRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field price_rule", values[i])
 			} else if value.Valid {
-				ii.Subtotal = value.Float64
+				ii.PriceRule = value.String
+			}
+		case invoiceitem.FieldPriceListID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price_list_id", values[i])
+			} else if value.Valid {
+				ii.PriceListID = new(int)
+				*ii.PriceListID = int(value.Int64)
+			}
+		case invoiceitem.FieldUnitID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_id", values[i])
+			} else if value.Valid {
+				ii.UnitID = new(int)
+				*ii.UnitID = int(value.Int64)
+			}
+		case invoiceitem.FieldUnitName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_name", values[i])
+			} else if value.Valid {
+				ii.UnitName = value.String
+			}
+		case invoiceitem.FieldUnitQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_quantity", values[i])
+			} else if value.Valid {
+				ii.UnitQuantity = new(float64)
+				*ii.UnitQuantity = value.Float64
+			}
+		case invoiceitem.FieldTaxRateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate_id", values[i])
+			} else if value.Valid {
+				ii.TaxRateID = new(int)
+				*ii.TaxRateID = int(value.Int64)
+			}
+		case invoiceitem.FieldTaxRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate", values[i])
+			} else if value.Valid {
+				ii.TaxRate = value.Float64
+			}
+		case invoiceitem.FieldTaxBase:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_base", values[i])
+			} else if value != nil {
+				ii.TaxBase = *value
+			}
+		case invoiceitem.FieldTaxAmount:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_amount", values[i])
+			} else if value != nil {
+				ii.TaxAmount = *value
 			}
 		}
 	}
@@ -161,6 +291,61 @@ func (ii *InvoiceItem) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("subtotal=")
 	builder.WriteString(fmt.Sprintf("%v", ii.Subtotal))
+	builder.WriteString(", ")
+	if v := ii.PromotionID; v != nil {
+		builder.WriteString("promotion_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("promotion_discount=")
+	builder.WriteString(fmt.Sprintf("%v", ii.PromotionDiscount))
+	builder.WriteString(", ")
+	builder.WriteString("discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", ii.DiscountAmount))
+	builder.WriteString(", ")
+	builder.WriteString("invoice_discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", ii.InvoiceDiscountAmount))
+	builder.WriteString(", ")
+	builder.WriteString("unit_cost=")
+	builder.WriteString(fmt.Sprintf("%v", ii.UnitCost))
+	builder.WriteString(", ")
+	builder.WriteString("cost_total=")
+	builder.WriteString(fmt.Sprintf("%v", ii.CostTotal))
+	builder.WriteString(", ")
+	builder.WriteString("price_rule=")
+	builder.WriteString(ii.PriceRule)
+	builder.WriteString(", ")
+	if v := ii.PriceListID; v != nil {
+		builder.WriteString("price_list_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ii.UnitID; v != nil {
+		builder.WriteString("unit_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("unit_name=")
+	builder.WriteString(ii.UnitName)
+	builder.WriteString(", ")
+	if v := ii.UnitQuantity; v != nil {
+		builder.WriteString("unit_quantity=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ii.TaxRateID; v != nil {
+		builder.WriteString("tax_rate_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tax_rate=")
+	builder.WriteString(fmt.Sprintf("%v", ii.TaxRate))
+	builder.WriteString(", ")
+	builder.WriteString("tax_base=")
+	builder.WriteString(fmt.Sprintf("%v", ii.TaxBase))
+	builder.WriteString(", ")
+	builder.WriteString("tax_amount=")
+	builder.WriteString(fmt.Sprintf("%v", ii.TaxAmount))
 	builder.WriteByte(')')
 	return builder.String()
 }
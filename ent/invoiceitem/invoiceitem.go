@@ -2,6 +2,10 @@
 
 package invoiceitem
 
+import (
+	"Veritasbackend/pkg/money"
+)
+
 const (
 	// Label holds the string label denoting the invoiceitem type in the database.
 	Label = "invoice_item"
@@ -17,6 +21,36 @@ const (
 	FieldUnitPrice = "unit_price"
 	// FieldSubtotal holds the string denoting the subtotal field in the database.
 	FieldSubtotal = "subtotal"
+	// FieldPromotionID holds the string denoting the promotion_id field in the database.
+	FieldPromotionID = "promotion_id"
+	// FieldPromotionDiscount holds the string denoting the promotion_discount field in the database.
+	FieldPromotionDiscount = "promotion_discount"
+	// FieldDiscountAmount holds the string denoting the discount_amount field in the database.
+	FieldDiscountAmount = "discount_amount"
+	// FieldInvoiceDiscountAmount holds the string denoting the invoice_discount_amount field in the database.
+	FieldInvoiceDiscountAmount = "invoice_discount_amount"
+	// FieldUnitCost holds the string denoting the unit_cost field in the database.
+	FieldUnitCost = "unit_cost"
+	// FieldCostTotal holds the string denoting the cost_total field in the database.
+	FieldCostTotal = "cost_total"
+	// FieldPriceRule holds the string denoting the price_rule field in the database.
+	FieldPriceRule = "price_rule"
+	// FieldPriceListID holds the string denoting the price_list_id field in the database.
+	FieldPriceListID = "price_list_id"
+	// FieldUnitID holds the string denoting the unit_id field in the database.
+	FieldUnitID = "unit_id"
+	// FieldUnitName holds the string denoting the unit_name field in the database.
+	FieldUnitName = "unit_name"
+	// FieldUnitQuantity holds the string denoting the unit_quantity field in the database.
+	FieldUnitQuantity = "unit_quantity"
+	// FieldTaxRateID holds the string denoting the tax_rate_id field in the database.
+	FieldTaxRateID = "tax_rate_id"
+	// FieldTaxRate holds the string denoting the tax_rate field in the database.
+	FieldTaxRate = "tax_rate"
+	// FieldTaxBase holds the string denoting the tax_base field in the database.
+	FieldTaxBase = "tax_base"
+	// FieldTaxAmount holds the string denoting the tax_amount field in the database.
+	FieldTaxAmount = "tax_amount"
 	// EdgeInvoice holds the string denoting the invoice edge name in mutations.
 	EdgeInvoice = "invoice"
 	// Table holds the table name of the invoiceitem in the database.
@@ -38,6 +72,21 @@ var Columns = []string{
 	FieldQuantity,
 	FieldUnitPrice,
 	FieldSubtotal,
+	FieldPromotionID,
+	FieldPromotionDiscount,
+	FieldDiscountAmount,
+	FieldInvoiceDiscountAmount,
+	FieldUnitCost,
+	FieldCostTotal,
+	FieldPriceRule,
+	FieldPriceListID,
+	FieldUnitID,
+	FieldUnitName,
+	FieldUnitQuantity,
+	FieldTaxRateID,
+	FieldTaxRate,
+	FieldTaxBase,
+	FieldTaxAmount,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -52,9 +101,31 @@ func ValidColumn(column string) bool {
 
 var (
 	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
-	QuantityValidator func(int) error
+	QuantityValidator func(float64) error
 	// UnitPriceValidator is a validator for the "unit_price" field. It is called by the builders before save.
-	UnitPriceValidator func(float64) error
+	UnitPriceValidator func(int64) error
 	// SubtotalValidator is a validator for the "subtotal" field. It is called by the builders before save.
-	SubtotalValidator func(float64) error
+	SubtotalValidator func(int64) error
+	// DefaultPromotionDiscount holds the default value on creation for the "promotion_discount" field.
+	DefaultPromotionDiscount money.Amount
+	// DefaultDiscountAmount holds the default value on creation for the "discount_amount" field.
+	DefaultDiscountAmount money.Amount
+	// DefaultInvoiceDiscountAmount holds the default value on creation for the "invoice_discount_amount" field.
+	DefaultInvoiceDiscountAmount money.Amount
+	// DefaultUnitCost holds the default value on creation for the "unit_cost" field.
+	DefaultUnitCost money.Amount
+	// UnitCostValidator is a validator for the "unit_cost" field. It is called by the builders before save.
+	UnitCostValidator func(int64) error
+	// DefaultCostTotal holds the default value on creation for the "cost_total" field.
+	DefaultCostTotal money.Amount
+	// CostTotalValidator is a validator for the "cost_total" field. It is called by the builders before save.
+	CostTotalValidator func(int64) error
+	// DefaultPriceRule holds the default value on creation for the "price_rule" field.
+	DefaultPriceRule string
+	// DefaultTaxRate holds the default value on creation for the "tax_rate" field.
+	DefaultTaxRate float64
+	// DefaultTaxBase holds the default value on creation for the "tax_base" field.
+	DefaultTaxBase money.Amount
+	// DefaultTaxAmount holds the default value on creation for the "tax_amount" field.
+	DefaultTaxAmount money.Amount
 )
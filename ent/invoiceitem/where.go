@@ -4,6 +4,7 @@ package invoiceitem
 
 import (
 	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -95,26 +96,131 @@ func ProductID(v int) predicate.InvoiceItem {
 }
 
 // Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
-func Quantity(v int) predicate.InvoiceItem {
+func Quantity(v float64) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldQuantity), v))
 	})
 }
 
 // UnitPrice applies equality check predicate on the "unit_price" field. It's identical to UnitPriceEQ.
-func UnitPrice(v float64) predicate.InvoiceItem {
+func UnitPrice(v money.Amount) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldUnitPrice), v))
 	})
 }
 
 // Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
-func Subtotal(v float64) predicate.InvoiceItem {
+func Subtotal(v money.Amount) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldSubtotal), v))
 	})
 }
 
+// PromotionID applies equality check predicate on the "promotion_id" field. It's identical to PromotionIDEQ.
+func PromotionID(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPromotionID), v))
+	})
+}
+
+// PromotionDiscount applies equality check predicate on the "promotion_discount" field. It's identical to PromotionDiscountEQ.
+func PromotionDiscount(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPromotionDiscount), v))
+	})
+}
+
+// DiscountAmount applies equality check predicate on the "discount_amount" field. It's identical to DiscountAmountEQ.
+func DiscountAmount(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscountAmount), v))
+	})
+}
+
+// InvoiceDiscountAmount applies equality check predicate on the "invoice_discount_amount" field. It's identical to InvoiceDiscountAmountEQ.
+func InvoiceDiscountAmount(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInvoiceDiscountAmount), v))
+	})
+}
+
+// UnitCost applies equality check predicate on the "unit_cost" field. It's identical to UnitCostEQ.
+func UnitCost(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// CostTotal applies equality check predicate on the "cost_total" field. It's identical to CostTotalEQ.
+func CostTotal(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCostTotal), v))
+	})
+}
+
+// PriceRule applies equality check predicate on the "price_rule" field. It's identical to PriceRuleEQ.
+func PriceRule(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriceRule), v))
+	})
+}
+
+// PriceListID applies equality check predicate on the "price_list_id" field. It's identical to PriceListIDEQ.
+func PriceListID(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriceListID), v))
+	})
+}
+
+// UnitID applies equality check predicate on the "unit_id" field. It's identical to UnitIDEQ.
+func UnitID(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitName applies equality check predicate on the "unit_name" field. It's identical to UnitNameEQ.
+func UnitName(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitQuantity applies equality check predicate on the "unit_quantity" field. It's identical to UnitQuantityEQ.
+func UnitQuantity(v float64) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitQuantity), v))
+	})
+}
+
+// TaxRateID applies equality check predicate on the "tax_rate_id" field. It's identical to TaxRateIDEQ.
+func TaxRateID(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRate applies equality check predicate on the "tax_rate" field. It's identical to TaxRateEQ.
+func TaxRate(v float64) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxBase applies equality check predicate on the "tax_base" field. It's identical to TaxBaseEQ.
+func TaxBase(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxBase), v))
+	})
+}
+
+// TaxAmount applies equality check predicate on the "tax_amount" field. It's identical to TaxAmountEQ.
+func TaxAmount(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxAmount), v))
+	})
+}
+
 // InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
 func InvoiceIDEQ(v int) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
@@ -240,21 +346,21 @@ func ProductIDLTE(v int) predicate.InvoiceItem {
 }
 
 // QuantityEQ applies the EQ predicate on the "quantity" field.
-func QuantityEQ(v int) predicate.InvoiceItem {
+func QuantityEQ(v float64) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldQuantity), v))
 	})
 }
 
 // QuantityNEQ applies the NEQ predicate on the "quantity" field.
-func QuantityNEQ(v int) predicate.InvoiceItem {
+func QuantityNEQ(v float64) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.NEQ(s.C(FieldQuantity), v))
 	})
 }
 
 // QuantityIn applies the In predicate on the "quantity" field.
-func QuantityIn(vs ...int) predicate.InvoiceItem {
+func QuantityIn(vs ...float64) predicate.InvoiceItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -271,7 +377,7 @@ func QuantityIn(vs ...int) predicate.InvoiceItem {
 }
 
 // QuantityNotIn applies the NotIn predicate on the "quantity" field.
-func QuantityNotIn(vs ...int) predicate.InvoiceItem {
+func QuantityNotIn(vs ...float64) predicate.InvoiceItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -288,49 +394,49 @@ func QuantityNotIn(vs ...int) predicate.InvoiceItem {
 }
 
 // QuantityGT applies the GT predicate on the "quantity" field.
-func QuantityGT(v int) predicate.InvoiceItem {
+func QuantityGT(v float64) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.GT(s.C(FieldQuantity), v))
 	})
 }
 
 // QuantityGTE applies the GTE predicate on the "quantity" field.
-func QuantityGTE(v int) predicate.InvoiceItem {
+func QuantityGTE(v float64) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.GTE(s.C(FieldQuantity), v))
 	})
 }
 
 // QuantityLT applies the LT predicate on the "quantity" field.
-func QuantityLT(v int) predicate.InvoiceItem {
+func QuantityLT(v float64) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.LT(s.C(FieldQuantity), v))
 	})
 }
 
 // QuantityLTE applies the LTE predicate on the "quantity" field.
-func QuantityLTE(v int) predicate.InvoiceItem {
+func QuantityLTE(v float64) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.LTE(s.C(FieldQuantity), v))
 	})
 }
 
 // UnitPriceEQ applies the EQ predicate on the "unit_price" field.
-func UnitPriceEQ(v float64) predicate.InvoiceItem {
+func UnitPriceEQ(v money.Amount) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldUnitPrice), v))
 	})
 }
 
 // UnitPriceNEQ applies the NEQ predicate on the "unit_price" field.
-func UnitPriceNEQ(v float64) predicate.InvoiceItem {
+func UnitPriceNEQ(v money.Amount) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.NEQ(s.C(FieldUnitPrice), v))
 	})
 }
 
 // UnitPriceIn applies the In predicate on the "unit_price" field.
-func UnitPriceIn(vs ...float64) predicate.InvoiceItem {
+func UnitPriceIn(vs ...money.Amount) predicate.InvoiceItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -347,7 +453,7 @@ func UnitPriceIn(vs ...float64) predicate.InvoiceItem {
 }
 
 // UnitPriceNotIn applies the NotIn predicate on the "unit_price" field.
-func UnitPriceNotIn(vs ...float64) predicate.InvoiceItem {
+func UnitPriceNotIn(vs ...money.Amount) predicate.InvoiceItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -364,49 +470,49 @@ func UnitPriceNotIn(vs ...float64) predicate.InvoiceItem {
 }
 
 // UnitPriceGT applies the GT predicate on the "unit_price" field.
-func UnitPriceGT(v float64) predicate.InvoiceItem {
+func UnitPriceGT(v money.Amount) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.GT(s.C(FieldUnitPrice), v))
 	})
 }
 
 // UnitPriceGTE applies the GTE predicate on the "unit_price" field.
-func UnitPriceGTE(v float64) predicate.InvoiceItem {
+func UnitPriceGTE(v money.Amount) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.GTE(s.C(FieldUnitPrice), v))
 	})
 }
 
 // UnitPriceLT applies the LT predicate on the "unit_price" field.
-func UnitPriceLT(v float64) predicate.InvoiceItem {
+func UnitPriceLT(v money.Amount) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.LT(s.C(FieldUnitPrice), v))
 	})
 }
 
 // UnitPriceLTE applies the LTE predicate on the "unit_price" field.
-func UnitPriceLTE(v float64) predicate.InvoiceItem {
+func UnitPriceLTE(v money.Amount) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.LTE(s.C(FieldUnitPrice), v))
 	})
 }
 
 // SubtotalEQ applies the EQ predicate on the "subtotal" field.
-func SubtotalEQ(v float64) predicate.InvoiceItem {
+func SubtotalEQ(v money.Amount) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldSubtotal), v))
 	})
 }
 
 // SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
-func SubtotalNEQ(v float64) predicate.InvoiceItem {
+func SubtotalNEQ(v money.Amount) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.NEQ(s.C(FieldSubtotal), v))
 	})
 }
 
 // SubtotalIn applies the In predicate on the "subtotal" field.
-func SubtotalIn(vs ...float64) predicate.InvoiceItem {
+func SubtotalIn(vs ...money.Amount) predicate.InvoiceItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -423,7 +529,7 @@ func SubtotalIn(vs ...float64) predicate.InvoiceItem {
 }
 
 // SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
-func SubtotalNotIn(vs ...float64) predicate.InvoiceItem {
+func SubtotalNotIn(vs ...money.Amount) predicate.InvoiceItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -440,33 +546,1327 @@ func SubtotalNotIn(vs ...float64) predicate.InvoiceItem {
 }
 
 // SubtotalGT applies the GT predicate on the "subtotal" field.
-func SubtotalGT(v float64) predicate.InvoiceItem {
+func SubtotalGT(v money.Amount) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.GT(s.C(FieldSubtotal), v))
 	})
 }
 
 // SubtotalGTE applies the GTE predicate on the "subtotal" field.
-func SubtotalGTE(v float64) predicate.InvoiceItem {
+func SubtotalGTE(v money.Amount) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.GTE(s.C(FieldSubtotal), v))
 	})
 }
 
 // SubtotalLT applies the LT predicate on the "subtotal" field.
-func SubtotalLT(v float64) predicate.InvoiceItem {
+func SubtotalLT(v money.Amount) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.LT(s.C(FieldSubtotal), v))
 	})
 }
 
 // SubtotalLTE applies the LTE predicate on the "subtotal" field.
-func SubtotalLTE(v float64) predicate.InvoiceItem {
+func SubtotalLTE(v money.Amount) predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.LTE(s.C(FieldSubtotal), v))
 	})
 }
 
+// PromotionIDEQ applies the EQ predicate on the "promotion_id" field.
+func PromotionIDEQ(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPromotionID), v))
+	})
+}
+
+// PromotionIDNEQ applies the NEQ predicate on the "promotion_id" field.
+func PromotionIDNEQ(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPromotionID), v))
+	})
+}
+
+// PromotionIDIn applies the In predicate on the "promotion_id" field.
+func PromotionIDIn(vs ...int) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPromotionID), v...))
+	})
+}
+
+// PromotionIDNotIn applies the NotIn predicate on the "promotion_id" field.
+func PromotionIDNotIn(vs ...int) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPromotionID), v...))
+	})
+}
+
+// PromotionIDGT applies the GT predicate on the "promotion_id" field.
+func PromotionIDGT(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPromotionID), v))
+	})
+}
+
+// PromotionIDGTE applies the GTE predicate on the "promotion_id" field.
+func PromotionIDGTE(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPromotionID), v))
+	})
+}
+
+// PromotionIDLT applies the LT predicate on the "promotion_id" field.
+func PromotionIDLT(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPromotionID), v))
+	})
+}
+
+// PromotionIDLTE applies the LTE predicate on the "promotion_id" field.
+func PromotionIDLTE(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPromotionID), v))
+	})
+}
+
+// PromotionIDIsNil applies the IsNil predicate on the "promotion_id" field.
+func PromotionIDIsNil() predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPromotionID)))
+	})
+}
+
+// PromotionIDNotNil applies the NotNil predicate on the "promotion_id" field.
+func PromotionIDNotNil() predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPromotionID)))
+	})
+}
+
+// PromotionDiscountEQ applies the EQ predicate on the "promotion_discount" field.
+func PromotionDiscountEQ(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPromotionDiscount), v))
+	})
+}
+
+// PromotionDiscountNEQ applies the NEQ predicate on the "promotion_discount" field.
+func PromotionDiscountNEQ(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPromotionDiscount), v))
+	})
+}
+
+// PromotionDiscountIn applies the In predicate on the "promotion_discount" field.
+func PromotionDiscountIn(vs ...money.Amount) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPromotionDiscount), v...))
+	})
+}
+
+// PromotionDiscountNotIn applies the NotIn predicate on the "promotion_discount" field.
+func PromotionDiscountNotIn(vs ...money.Amount) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPromotionDiscount), v...))
+	})
+}
+
+// PromotionDiscountGT applies the GT predicate on the "promotion_discount" field.
+func PromotionDiscountGT(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPromotionDiscount), v))
+	})
+}
+
+// PromotionDiscountGTE applies the GTE predicate on the "promotion_discount" field.
+func PromotionDiscountGTE(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPromotionDiscount), v))
+	})
+}
+
+// PromotionDiscountLT applies the LT predicate on the "promotion_discount" field.
+func PromotionDiscountLT(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPromotionDiscount), v))
+	})
+}
+
+// PromotionDiscountLTE applies the LTE predicate on the "promotion_discount" field.
+func PromotionDiscountLTE(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPromotionDiscount), v))
+	})
+}
+
+// DiscountAmountEQ applies the EQ predicate on the "discount_amount" field.
+func DiscountAmountEQ(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscountAmount), v))
+	})
+}
+
+// DiscountAmountNEQ applies the NEQ predicate on the "discount_amount" field.
+func DiscountAmountNEQ(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDiscountAmount), v))
+	})
+}
+
+// DiscountAmountIn applies the In predicate on the "discount_amount" field.
+func DiscountAmountIn(vs ...money.Amount) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDiscountAmount), v...))
+	})
+}
+
+// DiscountAmountNotIn applies the NotIn predicate on the "discount_amount" field.
+func DiscountAmountNotIn(vs ...money.Amount) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDiscountAmount), v...))
+	})
+}
+
+// DiscountAmountGT applies the GT predicate on the "discount_amount" field.
+func DiscountAmountGT(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDiscountAmount), v))
+	})
+}
+
+// DiscountAmountGTE applies the GTE predicate on the "discount_amount" field.
+func DiscountAmountGTE(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDiscountAmount), v))
+	})
+}
+
+// DiscountAmountLT applies the LT predicate on the "discount_amount" field.
+func DiscountAmountLT(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDiscountAmount), v))
+	})
+}
+
+// DiscountAmountLTE applies the LTE predicate on the "discount_amount" field.
+func DiscountAmountLTE(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDiscountAmount), v))
+	})
+}
+
+// InvoiceDiscountAmountEQ applies the EQ predicate on the "invoice_discount_amount" field.
+func InvoiceDiscountAmountEQ(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInvoiceDiscountAmount), v))
+	})
+}
+
+// InvoiceDiscountAmountNEQ applies the NEQ predicate on the "invoice_discount_amount" field.
+func InvoiceDiscountAmountNEQ(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldInvoiceDiscountAmount), v))
+	})
+}
+
+// InvoiceDiscountAmountIn applies the In predicate on the "invoice_discount_amount" field.
+func InvoiceDiscountAmountIn(vs ...money.Amount) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldInvoiceDiscountAmount), v...))
+	})
+}
+
+// InvoiceDiscountAmountNotIn applies the NotIn predicate on the "invoice_discount_amount" field.
+func InvoiceDiscountAmountNotIn(vs ...money.Amount) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldInvoiceDiscountAmount), v...))
+	})
+}
+
+// InvoiceDiscountAmountGT applies the GT predicate on the "invoice_discount_amount" field.
+func InvoiceDiscountAmountGT(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldInvoiceDiscountAmount), v))
+	})
+}
+
+// InvoiceDiscountAmountGTE applies the GTE predicate on the "invoice_discount_amount" field.
+func InvoiceDiscountAmountGTE(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldInvoiceDiscountAmount), v))
+	})
+}
+
+// InvoiceDiscountAmountLT applies the LT predicate on the "invoice_discount_amount" field.
+func InvoiceDiscountAmountLT(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldInvoiceDiscountAmount), v))
+	})
+}
+
+// InvoiceDiscountAmountLTE applies the LTE predicate on the "invoice_discount_amount" field.
+func InvoiceDiscountAmountLTE(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldInvoiceDiscountAmount), v))
+	})
+}
+
+// UnitCostEQ applies the EQ predicate on the "unit_cost" field.
+func UnitCostEQ(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostNEQ applies the NEQ predicate on the "unit_cost" field.
+func UnitCostNEQ(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostIn applies the In predicate on the "unit_cost" field.
+func UnitCostIn(vs ...money.Amount) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUnitCost), v...))
+	})
+}
+
+// UnitCostNotIn applies the NotIn predicate on the "unit_cost" field.
+func UnitCostNotIn(vs ...money.Amount) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUnitCost), v...))
+	})
+}
+
+// UnitCostGT applies the GT predicate on the "unit_cost" field.
+func UnitCostGT(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostGTE applies the GTE predicate on the "unit_cost" field.
+func UnitCostGTE(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostLT applies the LT predicate on the "unit_cost" field.
+func UnitCostLT(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostLTE applies the LTE predicate on the "unit_cost" field.
+func UnitCostLTE(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUnitCost), v))
+	})
+}
+
+// CostTotalEQ applies the EQ predicate on the "cost_total" field.
+func CostTotalEQ(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCostTotal), v))
+	})
+}
+
+// CostTotalNEQ applies the NEQ predicate on the "cost_total" field.
+func CostTotalNEQ(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCostTotal), v))
+	})
+}
+
+// CostTotalIn applies the In predicate on the "cost_total" field.
+func CostTotalIn(vs ...money.Amount) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCostTotal), v...))
+	})
+}
+
+// CostTotalNotIn applies the NotIn predicate on the "cost_total" field.
+func CostTotalNotIn(vs ...money.Amount) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCostTotal), v...))
+	})
+}
+
+// CostTotalGT applies the GT predicate on the "cost_total" field.
+func CostTotalGT(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCostTotal), v))
+	})
+}
+
+// CostTotalGTE applies the GTE predicate on the "cost_total" field.
+func CostTotalGTE(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCostTotal), v))
+	})
+}
+
+// CostTotalLT applies the LT predicate on the "cost_total" field.
+func CostTotalLT(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCostTotal), v))
+	})
+}
+
+// CostTotalLTE applies the LTE predicate on the "cost_total" field.
+func CostTotalLTE(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCostTotal), v))
+	})
+}
+
+// PriceRuleEQ applies the EQ predicate on the "price_rule" field.
+func PriceRuleEQ(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriceRule), v))
+	})
+}
+
+// PriceRuleNEQ applies the NEQ predicate on the "price_rule" field.
+func PriceRuleNEQ(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPriceRule), v))
+	})
+}
+
+// PriceRuleIn applies the In predicate on the "price_rule" field.
+func PriceRuleIn(vs ...string) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPriceRule), v...))
+	})
+}
+
+// PriceRuleNotIn applies the NotIn predicate on the "price_rule" field.
+func PriceRuleNotIn(vs ...string) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPriceRule), v...))
+	})
+}
+
+// PriceRuleGT applies the GT predicate on the "price_rule" field.
+func PriceRuleGT(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPriceRule), v))
+	})
+}
+
+// PriceRuleGTE applies the GTE predicate on the "price_rule" field.
+func PriceRuleGTE(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPriceRule), v))
+	})
+}
+
+// PriceRuleLT applies the LT predicate on the "price_rule" field.
+func PriceRuleLT(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPriceRule), v))
+	})
+}
+
+// PriceRuleLTE applies the LTE predicate on the "price_rule" field.
+func PriceRuleLTE(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPriceRule), v))
+	})
+}
+
+// PriceRuleContains applies the Contains predicate on the "price_rule" field.
+func PriceRuleContains(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPriceRule), v))
+	})
+}
+
+// PriceRuleHasPrefix applies the HasPrefix predicate on the "price_rule" field.
+func PriceRuleHasPrefix(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPriceRule), v))
+	})
+}
+
+// PriceRuleHasSuffix applies the HasSuffix predicate on the "price_rule" field.
+func PriceRuleHasSuffix(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPriceRule), v))
+	})
+}
+
+// PriceRuleEqualFold applies the EqualFold predicate on the "price_rule" field.
+func PriceRuleEqualFold(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPriceRule), v))
+	})
+}
+
+// PriceRuleContainsFold applies the ContainsFold predicate on the "price_rule" field.
+func PriceRuleContainsFold(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPriceRule), v))
+	})
+}
+
+// PriceListIDEQ applies the EQ predicate on the "price_list_id" field.
+func PriceListIDEQ(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDNEQ applies the NEQ predicate on the "price_list_id" field.
+func PriceListIDNEQ(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDIn applies the In predicate on the "price_list_id" field.
+func PriceListIDIn(vs ...int) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPriceListID), v...))
+	})
+}
+
+// PriceListIDNotIn applies the NotIn predicate on the "price_list_id" field.
+func PriceListIDNotIn(vs ...int) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPriceListID), v...))
+	})
+}
+
+// PriceListIDGT applies the GT predicate on the "price_list_id" field.
+func PriceListIDGT(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDGTE applies the GTE predicate on the "price_list_id" field.
+func PriceListIDGTE(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDLT applies the LT predicate on the "price_list_id" field.
+func PriceListIDLT(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDLTE applies the LTE predicate on the "price_list_id" field.
+func PriceListIDLTE(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDIsNil applies the IsNil predicate on the "price_list_id" field.
+func PriceListIDIsNil() predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPriceListID)))
+	})
+}
+
+// PriceListIDNotNil applies the NotNil predicate on the "price_list_id" field.
+func PriceListIDNotNil() predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPriceListID)))
+	})
+}
+
+// UnitIDEQ applies the EQ predicate on the "unit_id" field.
+func UnitIDEQ(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDNEQ applies the NEQ predicate on the "unit_id" field.
+func UnitIDNEQ(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDIn applies the In predicate on the "unit_id" field.
+func UnitIDIn(vs ...int) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUnitID), v...))
+	})
+}
+
+// UnitIDNotIn applies the NotIn predicate on the "unit_id" field.
+func UnitIDNotIn(vs ...int) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUnitID), v...))
+	})
+}
+
+// UnitIDGT applies the GT predicate on the "unit_id" field.
+func UnitIDGT(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDGTE applies the GTE predicate on the "unit_id" field.
+func UnitIDGTE(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDLT applies the LT predicate on the "unit_id" field.
+func UnitIDLT(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDLTE applies the LTE predicate on the "unit_id" field.
+func UnitIDLTE(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDIsNil applies the IsNil predicate on the "unit_id" field.
+func UnitIDIsNil() predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldUnitID)))
+	})
+}
+
+// UnitIDNotNil applies the NotNil predicate on the "unit_id" field.
+func UnitIDNotNil() predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldUnitID)))
+	})
+}
+
+// UnitNameEQ applies the EQ predicate on the "unit_name" field.
+func UnitNameEQ(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameNEQ applies the NEQ predicate on the "unit_name" field.
+func UnitNameNEQ(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameIn applies the In predicate on the "unit_name" field.
+func UnitNameIn(vs ...string) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUnitName), v...))
+	})
+}
+
+// UnitNameNotIn applies the NotIn predicate on the "unit_name" field.
+func UnitNameNotIn(vs ...string) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUnitName), v...))
+	})
+}
+
+// UnitNameGT applies the GT predicate on the "unit_name" field.
+func UnitNameGT(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameGTE applies the GTE predicate on the "unit_name" field.
+func UnitNameGTE(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameLT applies the LT predicate on the "unit_name" field.
+func UnitNameLT(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameLTE applies the LTE predicate on the "unit_name" field.
+func UnitNameLTE(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameContains applies the Contains predicate on the "unit_name" field.
+func UnitNameContains(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameHasPrefix applies the HasPrefix predicate on the "unit_name" field.
+func UnitNameHasPrefix(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameHasSuffix applies the HasSuffix predicate on the "unit_name" field.
+func UnitNameHasSuffix(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameIsNil applies the IsNil predicate on the "unit_name" field.
+func UnitNameIsNil() predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldUnitName)))
+	})
+}
+
+// UnitNameNotNil applies the NotNil predicate on the "unit_name" field.
+func UnitNameNotNil() predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldUnitName)))
+	})
+}
+
+// UnitNameEqualFold applies the EqualFold predicate on the "unit_name" field.
+func UnitNameEqualFold(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameContainsFold applies the ContainsFold predicate on the "unit_name" field.
+func UnitNameContainsFold(v string) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitQuantityEQ applies the EQ predicate on the "unit_quantity" field.
+func UnitQuantityEQ(v float64) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitQuantity), v))
+	})
+}
+
+// UnitQuantityNEQ applies the NEQ predicate on the "unit_quantity" field.
+func UnitQuantityNEQ(v float64) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnitQuantity), v))
+	})
+}
+
+// UnitQuantityIn applies the In predicate on the "unit_quantity" field.
+func UnitQuantityIn(vs ...float64) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUnitQuantity), v...))
+	})
+}
+
+// UnitQuantityNotIn applies the NotIn predicate on the "unit_quantity" field.
+func UnitQuantityNotIn(vs ...float64) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUnitQuantity), v...))
+	})
+}
+
+// UnitQuantityGT applies the GT predicate on the "unit_quantity" field.
+func UnitQuantityGT(v float64) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUnitQuantity), v))
+	})
+}
+
+// UnitQuantityGTE applies the GTE predicate on the "unit_quantity" field.
+func UnitQuantityGTE(v float64) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUnitQuantity), v))
+	})
+}
+
+// UnitQuantityLT applies the LT predicate on the "unit_quantity" field.
+func UnitQuantityLT(v float64) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUnitQuantity), v))
+	})
+}
+
+// UnitQuantityLTE applies the LTE predicate on the "unit_quantity" field.
+func UnitQuantityLTE(v float64) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUnitQuantity), v))
+	})
+}
+
+// UnitQuantityIsNil applies the IsNil predicate on the "unit_quantity" field.
+func UnitQuantityIsNil() predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldUnitQuantity)))
+	})
+}
+
+// UnitQuantityNotNil applies the NotNil predicate on the "unit_quantity" field.
+func UnitQuantityNotNil() predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldUnitQuantity)))
+	})
+}
+
+// TaxRateIDEQ applies the EQ predicate on the "tax_rate_id" field.
+func TaxRateIDEQ(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDNEQ applies the NEQ predicate on the "tax_rate_id" field.
+func TaxRateIDNEQ(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDIn applies the In predicate on the "tax_rate_id" field.
+func TaxRateIDIn(vs ...int) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxRateID), v...))
+	})
+}
+
+// TaxRateIDNotIn applies the NotIn predicate on the "tax_rate_id" field.
+func TaxRateIDNotIn(vs ...int) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxRateID), v...))
+	})
+}
+
+// TaxRateIDGT applies the GT predicate on the "tax_rate_id" field.
+func TaxRateIDGT(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDGTE applies the GTE predicate on the "tax_rate_id" field.
+func TaxRateIDGTE(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDLT applies the LT predicate on the "tax_rate_id" field.
+func TaxRateIDLT(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDLTE applies the LTE predicate on the "tax_rate_id" field.
+func TaxRateIDLTE(v int) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDIsNil applies the IsNil predicate on the "tax_rate_id" field.
+func TaxRateIDIsNil() predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTaxRateID)))
+	})
+}
+
+// TaxRateIDNotNil applies the NotNil predicate on the "tax_rate_id" field.
+func TaxRateIDNotNil() predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTaxRateID)))
+	})
+}
+
+// TaxRateEQ applies the EQ predicate on the "tax_rate" field.
+func TaxRateEQ(v float64) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxRateNEQ applies the NEQ predicate on the "tax_rate" field.
+func TaxRateNEQ(v float64) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxRateIn applies the In predicate on the "tax_rate" field.
+func TaxRateIn(vs ...float64) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxRate), v...))
+	})
+}
+
+// TaxRateNotIn applies the NotIn predicate on the "tax_rate" field.
+func TaxRateNotIn(vs ...float64) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxRate), v...))
+	})
+}
+
+// TaxRateGT applies the GT predicate on the "tax_rate" field.
+func TaxRateGT(v float64) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxRateGTE applies the GTE predicate on the "tax_rate" field.
+func TaxRateGTE(v float64) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxRateLT applies the LT predicate on the "tax_rate" field.
+func TaxRateLT(v float64) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxRateLTE applies the LTE predicate on the "tax_rate" field.
+func TaxRateLTE(v float64) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxBaseEQ applies the EQ predicate on the "tax_base" field.
+func TaxBaseEQ(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxBase), v))
+	})
+}
+
+// TaxBaseNEQ applies the NEQ predicate on the "tax_base" field.
+func TaxBaseNEQ(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxBase), v))
+	})
+}
+
+// TaxBaseIn applies the In predicate on the "tax_base" field.
+func TaxBaseIn(vs ...money.Amount) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxBase), v...))
+	})
+}
+
+// TaxBaseNotIn applies the NotIn predicate on the "tax_base" field.
+func TaxBaseNotIn(vs ...money.Amount) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxBase), v...))
+	})
+}
+
+// TaxBaseGT applies the GT predicate on the "tax_base" field.
+func TaxBaseGT(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxBase), v))
+	})
+}
+
+// TaxBaseGTE applies the GTE predicate on the "tax_base" field.
+func TaxBaseGTE(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxBase), v))
+	})
+}
+
+// TaxBaseLT applies the LT predicate on the "tax_base" field.
+func TaxBaseLT(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxBase), v))
+	})
+}
+
+// TaxBaseLTE applies the LTE predicate on the "tax_base" field.
+func TaxBaseLTE(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxBase), v))
+	})
+}
+
+// TaxAmountEQ applies the EQ predicate on the "tax_amount" field.
+func TaxAmountEQ(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxAmount), v))
+	})
+}
+
+// TaxAmountNEQ applies the NEQ predicate on the "tax_amount" field.
+func TaxAmountNEQ(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxAmount), v))
+	})
+}
+
+// TaxAmountIn applies the In predicate on the "tax_amount" field.
+func TaxAmountIn(vs ...money.Amount) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxAmount), v...))
+	})
+}
+
+// TaxAmountNotIn applies the NotIn predicate on the "tax_amount" field.
+func TaxAmountNotIn(vs ...money.Amount) predicate.InvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxAmount), v...))
+	})
+}
+
+// TaxAmountGT applies the GT predicate on the "tax_amount" field.
+func TaxAmountGT(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxAmount), v))
+	})
+}
+
+// TaxAmountGTE applies the GTE predicate on the "tax_amount" field.
+func TaxAmountGTE(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxAmount), v))
+	})
+}
+
+// TaxAmountLT applies the LT predicate on the "tax_amount" field.
+func TaxAmountLT(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxAmount), v))
+	})
+}
+
+// TaxAmountLTE applies the LTE predicate on the "tax_amount" field.
+func TaxAmountLTE(v money.Amount) predicate.InvoiceItem {
+	return predicate.InvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxAmount), v))
+	})
+}
+
 // HasInvoice applies the HasEdge predicate on the "invoice" edge.
 func HasInvoice() predicate.InvoiceItem {
 	return predicate.InvoiceItem(func(s *sql.Selector) {
@@ -5,6 +5,7 @@ package ent
 import (
 	"Veritasbackend/ent/invoice"
 	"Veritasbackend/ent/invoiceitem"
+	"Veritasbackend/pkg/money"
 	"context"
 	"errors"
 	"fmt"
@@ -33,20 +34,230 @@ func (iic *InvoiceItemCreate) SetProductID(i int) *InvoiceItemCreate {
 }
 
 // SetQuantity sets the "quantity" field.
-func (iic *InvoiceItemCreate) SetQuantity(i int) *InvoiceItemCreate {
-	iic.mutation.SetQuantity(i)
+func (iic *InvoiceItemCreate) SetQuantity(f float64) *InvoiceItemCreate {
+	iic.mutation.SetQuantity(f)
 	return iic
 }
 
 // SetUnitPrice sets the "unit_price" field.
-func (iic *InvoiceItemCreate) SetUnitPrice(f float64) *InvoiceItemCreate {
-	iic.mutation.SetUnitPrice(f)
+func (iic *InvoiceItemCreate) SetUnitPrice(m money.Amount) *InvoiceItemCreate {
+	iic.mutation.SetUnitPrice(m)
 	return iic
 }
 
 // SetSubtotal sets the "subtotal" field.
-func (iic *InvoiceItemCreate) SetSubtotal(f float64) *InvoiceItemCreate {
-	iic.mutation.SetSubtotal(f)
+func (iic *InvoiceItemCreate) SetSubtotal(m money.Amount) *InvoiceItemCreate {
+	iic.mutation.SetSubtotal(m)
+	return iic
+}
+
+// SetPromotionID sets the "promotion_id" field.
+func (iic *InvoiceItemCreate) SetPromotionID(i int) *InvoiceItemCreate {
+	iic.mutation.SetPromotionID(i)
+	return iic
+}
+
+// SetNillablePromotionID sets the "promotion_id" field if the given value is not nil.
+func (iic *InvoiceItemCreate) SetNillablePromotionID(i *int) *InvoiceItemCreate {
+	if i != nil {
+		iic.SetPromotionID(*i)
+	}
+	return iic
+}
+
+// SetPromotionDiscount sets the "promotion_discount" field.
+func (iic *InvoiceItemCreate) SetPromotionDiscount(m money.Amount) *InvoiceItemCreate {
+	iic.mutation.SetPromotionDiscount(m)
+	return iic
+}
+
+// SetNillablePromotionDiscount sets the "promotion_discount" field if the given value is not nil.
+func (iic *InvoiceItemCreate) SetNillablePromotionDiscount(m *money.Amount) *InvoiceItemCreate {
+	if m != nil {
+		iic.SetPromotionDiscount(*m)
+	}
+	return iic
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (iic *InvoiceItemCreate) SetDiscountAmount(m money.Amount) *InvoiceItemCreate {
+	iic.mutation.SetDiscountAmount(m)
+	return iic
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (iic *InvoiceItemCreate) SetNillableDiscountAmount(m *money.Amount) *InvoiceItemCreate {
+	if m != nil {
+		iic.SetDiscountAmount(*m)
+	}
+	return iic
+}
+
+// SetInvoiceDiscountAmount sets the "invoice_discount_amount" field.
+func (iic *InvoiceItemCreate) SetInvoiceDiscountAmount(m money.Amount) *InvoiceItemCreate {
+	iic.mutation.SetInvoiceDiscountAmount(m)
+	return iic
+}
+
+// SetNillableInvoiceDiscountAmount sets the "invoice_discount_amount" field if the given value is not nil.
+func (iic *InvoiceItemCreate) SetNillableInvoiceDiscountAmount(m *money.Amount) *InvoiceItemCreate {
+	if m != nil {
+		iic.SetInvoiceDiscountAmount(*m)
+	}
+	return iic
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (iic *InvoiceItemCreate) SetUnitCost(m money.Amount) *InvoiceItemCreate {
+	iic.mutation.SetUnitCost(m)
+	return iic
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (iic *InvoiceItemCreate) SetNillableUnitCost(m *money.Amount) *InvoiceItemCreate {
+	if m != nil {
+		iic.SetUnitCost(*m)
+	}
+	return iic
+}
+
+// SetCostTotal sets the "cost_total" field.
+func (iic *InvoiceItemCreate) SetCostTotal(m money.Amount) *InvoiceItemCreate {
+	iic.mutation.SetCostTotal(m)
+	return iic
+}
+
+// SetNillableCostTotal sets the "cost_total" field if the given value is not nil.
+func (iic *InvoiceItemCreate) SetNillableCostTotal(m *money.Amount) *InvoiceItemCreate {
+	if m != nil {
+		iic.SetCostTotal(*m)
+	}
+	return iic
+}
+
+// SetPriceRule sets the "price_rule" field.
+func (iic *InvoiceItemCreate) SetPriceRule(s string) *InvoiceItemCreate {
+	iic.mutation.SetPriceRule(s)
+	return iic
+}
+
+// SetNillablePriceRule sets the "price_rule" field if the given value is not nil.
+func (iic *InvoiceItemCreate) SetNillablePriceRule(s *string) *InvoiceItemCreate {
+	if s != nil {
+		iic.SetPriceRule(*s)
+	}
+	return iic
+}
+
+// SetPriceListID sets the "price_list_id" field.
+func (iic *InvoiceItemCreate) SetPriceListID(i int) *InvoiceItemCreate {
+	iic.mutation.SetPriceListID(i)
+	return iic
+}
+
+// SetNillablePriceListID sets the "price_list_id" field if the given value is not nil.
+func (iic *InvoiceItemCreate) SetNillablePriceListID(i *int) *InvoiceItemCreate {
+	if i != nil {
+		iic.SetPriceListID(*i)
+	}
+	return iic
+}
+
+// SetUnitID sets the "unit_id" field.
+func (iic *InvoiceItemCreate) SetUnitID(i int) *InvoiceItemCreate {
+	iic.mutation.SetUnitID(i)
+	return iic
+}
+
+// SetNillableUnitID sets the "unit_id" field if the given value is not nil.
+func (iic *InvoiceItemCreate) SetNillableUnitID(i *int) *InvoiceItemCreate {
+	if i != nil {
+		iic.SetUnitID(*i)
+	}
+	return iic
+}
+
+// SetUnitName sets the "unit_name" field.
+func (iic *InvoiceItemCreate) SetUnitName(s string) *InvoiceItemCreate {
+	iic.mutation.SetUnitName(s)
+	return iic
+}
+
+// SetNillableUnitName sets the "unit_name" field if the given value is not nil.
+func (iic *InvoiceItemCreate) SetNillableUnitName(s *string) *InvoiceItemCreate {
+	if s != nil {
+		iic.SetUnitName(*s)
+	}
+	return iic
+}
+
+// SetUnitQuantity sets the "unit_quantity" field.
+func (iic *InvoiceItemCreate) SetUnitQuantity(f float64) *InvoiceItemCreate {
+	iic.mutation.SetUnitQuantity(f)
+	return iic
+}
+
+// SetNillableUnitQuantity sets the "unit_quantity" field if the given value is not nil.
+func (iic *InvoiceItemCreate) SetNillableUnitQuantity(f *float64) *InvoiceItemCreate {
+	if f != nil {
+		iic.SetUnitQuantity(*f)
+	}
+	return iic
+}
+
+// SetTaxRateID sets the "tax_rate_id" field.
+func (iic *InvoiceItemCreate) SetTaxRateID(i int) *InvoiceItemCreate {
+	iic.mutation.SetTaxRateID(i)
+	return iic
+}
+
+// SetNillableTaxRateID sets the "tax_rate_id" field if the given value is not nil.
+func (iic *InvoiceItemCreate) SetNillableTaxRateID(i *int) *InvoiceItemCreate {
+	if i != nil {
+		iic.SetTaxRateID(*i)
+	}
+	return iic
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (iic *InvoiceItemCreate) SetTaxRate(f float64) *InvoiceItemCreate {
+	iic.mutation.SetTaxRate(f)
+	return iic
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (iic *InvoiceItemCreate) SetNillableTaxRate(f *float64) *InvoiceItemCreate {
+	if f != nil {
+		iic.SetTaxRate(*f)
+	}
+	return iic
+}
+
+// SetTaxBase sets the "tax_base" field.
+func (iic *InvoiceItemCreate) SetTaxBase(m money.Amount) *InvoiceItemCreate {
+	iic.mutation.SetTaxBase(m)
+	return iic
+}
+
+// SetNillableTaxBase sets the "tax_base" field if the given value is not nil.
+func (iic *InvoiceItemCreate) SetNillableTaxBase(m *money.Amount) *InvoiceItemCreate {
+	if m != nil {
+		iic.SetTaxBase(*m)
+	}
+	return iic
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (iic *InvoiceItemCreate) SetTaxAmount(m money.Amount) *InvoiceItemCreate {
+	iic.mutation.SetTaxAmount(m)
+	return iic
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (iic *InvoiceItemCreate) SetNillableTaxAmount(m *money.Amount) *InvoiceItemCreate {
+	if m != nil {
+		iic.SetTaxAmount(*m)
+	}
 	return iic
 }
 
@@ -66,6 +277,7 @@ func (iic *InvoiceItemCreate) Save(ctx context.Context) (*InvoiceItem, error) {
 		err  error
 		node *InvoiceItem
 	)
+	iic.defaults()
 	if len(iic.hooks) == 0 {
 		if err = iic.check(); err != nil {
 			return nil, err
@@ -129,6 +341,46 @@ func (iic *InvoiceItemCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (iic *InvoiceItemCreate) defaults() {
+	if _, ok := iic.mutation.PromotionDiscount(); !ok {
+		v := invoiceitem.DefaultPromotionDiscount
+		iic.mutation.SetPromotionDiscount(v)
+	}
+	if _, ok := iic.mutation.DiscountAmount(); !ok {
+		v := invoiceitem.DefaultDiscountAmount
+		iic.mutation.SetDiscountAmount(v)
+	}
+	if _, ok := iic.mutation.InvoiceDiscountAmount(); !ok {
+		v := invoiceitem.DefaultInvoiceDiscountAmount
+		iic.mutation.SetInvoiceDiscountAmount(v)
+	}
+	if _, ok := iic.mutation.UnitCost(); !ok {
+		v := invoiceitem.DefaultUnitCost
+		iic.mutation.SetUnitCost(v)
+	}
+	if _, ok := iic.mutation.CostTotal(); !ok {
+		v := invoiceitem.DefaultCostTotal
+		iic.mutation.SetCostTotal(v)
+	}
+	if _, ok := iic.mutation.PriceRule(); !ok {
+		v := invoiceitem.DefaultPriceRule
+		iic.mutation.SetPriceRule(v)
+	}
+	if _, ok := iic.mutation.TaxRate(); !ok {
+		v := invoiceitem.DefaultTaxRate
+		iic.mutation.SetTaxRate(v)
+	}
+	if _, ok := iic.mutation.TaxBase(); !ok {
+		v := invoiceitem.DefaultTaxBase
+		iic.mutation.SetTaxBase(v)
+	}
+	if _, ok := iic.mutation.TaxAmount(); !ok {
+		v := invoiceitem.DefaultTaxAmount
+		iic.mutation.SetTaxAmount(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (iic *InvoiceItemCreate) check() error {
 	if _, ok := iic.mutation.InvoiceID(); !ok {
@@ -149,7 +401,7 @@ func (iic *InvoiceItemCreate) check() error {
 		return &ValidationError{Name: "unit_price", err: errors.New(`ent: missing required field "InvoiceItem.unit_price"`)}
 	}
 	if v, ok := iic.mutation.UnitPrice(); ok {
-		if err := invoiceitem.UnitPriceValidator(v); err != nil {
+		if err := invoiceitem.UnitPriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "unit_price", err: fmt.Errorf(`ent: validator failed for field "InvoiceItem.unit_price": %w`, err)}
 		}
 	}
@@ -157,10 +409,47 @@ func (iic *InvoiceItemCreate) check() error {
 		return &ValidationError{Name: "subtotal", err: errors.New(`ent: missing required field "InvoiceItem.subtotal"`)}
 	}
 	if v, ok := iic.mutation.Subtotal(); ok {
-		if err := invoiceitem.SubtotalValidator(v); err != nil {
+		if err := invoiceitem.SubtotalValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "subtotal", err: fmt.Errorf(`ent: validator failed for field "InvoiceItem.subtotal": %w`, err)}
 		}
 	}
+	if _, ok := iic.mutation.PromotionDiscount(); !ok {
+		return &ValidationError{Name: "promotion_discount", err: errors.New(`ent: missing required field "InvoiceItem.promotion_discount"`)}
+	}
+	if _, ok := iic.mutation.DiscountAmount(); !ok {
+		return &ValidationError{Name: "discount_amount", err: errors.New(`ent: missing required field "InvoiceItem.discount_amount"`)}
+	}
+	if _, ok := iic.mutation.InvoiceDiscountAmount(); !ok {
+		return &ValidationError{Name: "invoice_discount_amount", err: errors.New(`ent: missing required field "InvoiceItem.invoice_discount_amount"`)}
+	}
+	if _, ok := iic.mutation.UnitCost(); !ok {
+		return &ValidationError{Name: "unit_cost", err: errors.New(`ent: missing required field "InvoiceItem.unit_cost"`)}
+	}
+	if v, ok := iic.mutation.UnitCost(); ok {
+		if err := invoiceitem.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "InvoiceItem.unit_cost": %w`, err)}
+		}
+	}
+	if _, ok := iic.mutation.CostTotal(); !ok {
+		return &ValidationError{Name: "cost_total", err: errors.New(`ent: missing required field "InvoiceItem.cost_total"`)}
+	}
+	if v, ok := iic.mutation.CostTotal(); ok {
+		if err := invoiceitem.CostTotalValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "cost_total", err: fmt.Errorf(`ent: validator failed for field "InvoiceItem.cost_total": %w`, err)}
+		}
+	}
+	if _, ok := iic.mutation.PriceRule(); !ok {
+		return &ValidationError{Name: "price_rule", err: errors.New(`ent: missing required field "InvoiceItem.price_rule"`)}
+	}
+	if _, ok := iic.mutation.TaxRate(); !ok {
+		return &ValidationError{Name: "tax_rate", err: errors.New(`ent: missing required field "InvoiceItem.tax_rate"`)}
+	}
+	if _, ok := iic.mutation.TaxBase(); !ok {
+		return &ValidationError{Name: "tax_base", err: errors.New(`ent: missing required field "InvoiceItem.tax_base"`)}
+	}
+	if _, ok := iic.mutation.TaxAmount(); !ok {
+		return &ValidationError{Name: "tax_amount", err: errors.New(`ent: missing required field "InvoiceItem.tax_amount"`)}
+	}
 	if _, ok := iic.mutation.InvoiceID(); !ok {
 		return &ValidationError{Name: "invoice", err: errors.New(`ent: missing required edge "InvoiceItem.invoice"`)}
 	}
@@ -201,7 +490,7 @@ func (iic *InvoiceItemCreate) createSpec() (*InvoiceItem, *sqlgraph.CreateSpec)
 	}
 	if value, ok := iic.mutation.Quantity(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeFloat64,
 			Value:  value,
 			Column: invoiceitem.FieldQuantity,
 		})
@@ -209,7 +498,7 @@ func (iic *InvoiceItemCreate) createSpec() (*InvoiceItem, *sqlgraph.CreateSpec)
 	}
 	if value, ok := iic.mutation.UnitPrice(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: invoiceitem.FieldUnitPrice,
 		})
@@ -217,12 +506,132 @@ func (iic *InvoiceItemCreate) createSpec() (*InvoiceItem, *sqlgraph.CreateSpec)
 	}
 	if value, ok := iic.mutation.Subtotal(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: invoiceitem.FieldSubtotal,
 		})
 		_node.Subtotal = value
 	}
+	if value, ok := iic.mutation.PromotionID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldPromotionID,
+		})
+		_node.PromotionID = &value
+	}
+	if value, ok := iic.mutation.PromotionDiscount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldPromotionDiscount,
+		})
+		_node.PromotionDiscount = value
+	}
+	if value, ok := iic.mutation.DiscountAmount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldDiscountAmount,
+		})
+		_node.DiscountAmount = value
+	}
+	if value, ok := iic.mutation.InvoiceDiscountAmount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldInvoiceDiscountAmount,
+		})
+		_node.InvoiceDiscountAmount = value
+	}
+	if value, ok := iic.mutation.UnitCost(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldUnitCost,
+		})
+		_node.UnitCost = value
+	}
+	if value, ok := iic.mutation.CostTotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldCostTotal,
+		})
+		_node.CostTotal = value
+	}
+	if value, ok := iic.mutation.PriceRule(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoiceitem.FieldPriceRule,
+		})
+		_node.PriceRule = value
+	}
+	if value, ok := iic.mutation.PriceListID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldPriceListID,
+		})
+		_node.PriceListID = &value
+	}
+	if value, ok := iic.mutation.UnitID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldUnitID,
+		})
+		_node.UnitID = &value
+	}
+	if value, ok := iic.mutation.UnitName(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoiceitem.FieldUnitName,
+		})
+		_node.UnitName = value
+	}
+	if value, ok := iic.mutation.UnitQuantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitem.FieldUnitQuantity,
+		})
+		_node.UnitQuantity = &value
+	}
+	if value, ok := iic.mutation.TaxRateID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldTaxRateID,
+		})
+		_node.TaxRateID = &value
+	}
+	if value, ok := iic.mutation.TaxRate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitem.FieldTaxRate,
+		})
+		_node.TaxRate = value
+	}
+	if value, ok := iic.mutation.TaxBase(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldTaxBase,
+		})
+		_node.TaxBase = value
+	}
+	if value, ok := iic.mutation.TaxAmount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldTaxAmount,
+		})
+		_node.TaxAmount = value
+	}
 	if nodes := iic.mutation.InvoiceIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -260,6 +669,7 @@ func (iicb *InvoiceItemCreateBulk) Save(ctx context.Context) ([]*InvoiceItem, er
 	for i := range iicb.builders {
 		func(i int, root context.Context) {
 			builder := iicb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*InvoiceItemMutation)
 				if !ok {
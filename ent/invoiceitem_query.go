@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -26,6 +27,7 @@ type InvoiceItemQuery struct {
 	predicates []predicate.InvoiceItem
 	// eager-loading edges.
 	withInvoice *InvoiceQuery
+	modifiers   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -298,7 +300,6 @@ func (iiq *InvoiceItemQuery) WithInvoice(opts ...func(*InvoiceQuery)) *InvoiceIt
 //		GroupBy(invoiceitem.FieldInvoiceID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (iiq *InvoiceItemQuery) GroupBy(field string, fields ...string) *InvoiceItemGroupBy {
 	grbuild := &InvoiceItemGroupBy{config: iiq.config}
 	grbuild.fields = append([]string{field}, fields...)
@@ -325,7 +326,6 @@ func (iiq *InvoiceItemQuery) GroupBy(field string, fields ...string) *InvoiceIte
 //	client.InvoiceItem.Query().
 //		Select(invoiceitem.FieldInvoiceID).
 //		Scan(ctx, &v)
-//
 func (iiq *InvoiceItemQuery) Select(fields ...string) *InvoiceItemSelect {
 	iiq.fields = append(iiq.fields, fields...)
 	selbuild := &InvoiceItemSelect{InvoiceItemQuery: iiq}
@@ -367,6 +367,9 @@ func (iiq *InvoiceItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(iiq.modifiers) > 0 {
+		_spec.Modifiers = iiq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -408,6 +411,9 @@ func (iiq *InvoiceItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 
 func (iiq *InvoiceItemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := iiq.querySpec()
+	if len(iiq.modifiers) > 0 {
+		_spec.Modifiers = iiq.modifiers
+	}
 	_spec.Node.Columns = iiq.fields
 	if len(iiq.fields) > 0 {
 		_spec.Unique = iiq.unique != nil && *iiq.unique
@@ -486,6 +492,9 @@ func (iiq *InvoiceItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if iiq.unique != nil && *iiq.unique {
 		selector.Distinct()
 	}
+	for _, m := range iiq.modifiers {
+		m(selector)
+	}
 	for _, p := range iiq.predicates {
 		p(selector)
 	}
@@ -503,6 +512,32 @@ func (iiq *InvoiceItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (iiq *InvoiceItemQuery) ForUpdate(opts ...sql.LockOption) *InvoiceItemQuery {
+	if iiq.driver.Dialect() == dialect.Postgres {
+		iiq.Unique(false)
+	}
+	iiq.modifiers = append(iiq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return iiq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (iiq *InvoiceItemQuery) ForShare(opts ...sql.LockOption) *InvoiceItemQuery {
+	if iiq.driver.Dialect() == dialect.Postgres {
+		iiq.Unique(false)
+	}
+	iiq.modifiers = append(iiq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return iiq
+}
+
 // InvoiceItemGroupBy is the group-by builder for InvoiceItem entities.
 type InvoiceItemGroupBy struct {
 	config
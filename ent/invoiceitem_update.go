@@ -6,6 +6,7 @@ import (
 	"Veritasbackend/ent/invoice"
 	"Veritasbackend/ent/invoiceitem"
 	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
 	"context"
 	"errors"
 	"fmt"
@@ -48,41 +49,378 @@ func (iiu *InvoiceItemUpdate) AddProductID(i int) *InvoiceItemUpdate {
 }
 
 // SetQuantity sets the "quantity" field.
-func (iiu *InvoiceItemUpdate) SetQuantity(i int) *InvoiceItemUpdate {
+func (iiu *InvoiceItemUpdate) SetQuantity(f float64) *InvoiceItemUpdate {
 	iiu.mutation.ResetQuantity()
-	iiu.mutation.SetQuantity(i)
+	iiu.mutation.SetQuantity(f)
 	return iiu
 }
 
-// AddQuantity adds i to the "quantity" field.
-func (iiu *InvoiceItemUpdate) AddQuantity(i int) *InvoiceItemUpdate {
-	iiu.mutation.AddQuantity(i)
+// AddQuantity adds f to the "quantity" field.
+func (iiu *InvoiceItemUpdate) AddQuantity(f float64) *InvoiceItemUpdate {
+	iiu.mutation.AddQuantity(f)
 	return iiu
 }
 
 // SetUnitPrice sets the "unit_price" field.
-func (iiu *InvoiceItemUpdate) SetUnitPrice(f float64) *InvoiceItemUpdate {
+func (iiu *InvoiceItemUpdate) SetUnitPrice(m money.Amount) *InvoiceItemUpdate {
 	iiu.mutation.ResetUnitPrice()
-	iiu.mutation.SetUnitPrice(f)
+	iiu.mutation.SetUnitPrice(m)
 	return iiu
 }
 
-// AddUnitPrice adds f to the "unit_price" field.
-func (iiu *InvoiceItemUpdate) AddUnitPrice(f float64) *InvoiceItemUpdate {
-	iiu.mutation.AddUnitPrice(f)
+// AddUnitPrice adds m to the "unit_price" field.
+func (iiu *InvoiceItemUpdate) AddUnitPrice(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.AddUnitPrice(m)
 	return iiu
 }
 
 // SetSubtotal sets the "subtotal" field.
-func (iiu *InvoiceItemUpdate) SetSubtotal(f float64) *InvoiceItemUpdate {
+func (iiu *InvoiceItemUpdate) SetSubtotal(m money.Amount) *InvoiceItemUpdate {
 	iiu.mutation.ResetSubtotal()
-	iiu.mutation.SetSubtotal(f)
+	iiu.mutation.SetSubtotal(m)
 	return iiu
 }
 
-// AddSubtotal adds f to the "subtotal" field.
-func (iiu *InvoiceItemUpdate) AddSubtotal(f float64) *InvoiceItemUpdate {
-	iiu.mutation.AddSubtotal(f)
+// AddSubtotal adds m to the "subtotal" field.
+func (iiu *InvoiceItemUpdate) AddSubtotal(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.AddSubtotal(m)
+	return iiu
+}
+
+// SetPromotionID sets the "promotion_id" field.
+func (iiu *InvoiceItemUpdate) SetPromotionID(i int) *InvoiceItemUpdate {
+	iiu.mutation.ResetPromotionID()
+	iiu.mutation.SetPromotionID(i)
+	return iiu
+}
+
+// SetNillablePromotionID sets the "promotion_id" field if the given value is not nil.
+func (iiu *InvoiceItemUpdate) SetNillablePromotionID(i *int) *InvoiceItemUpdate {
+	if i != nil {
+		iiu.SetPromotionID(*i)
+	}
+	return iiu
+}
+
+// AddPromotionID adds i to the "promotion_id" field.
+func (iiu *InvoiceItemUpdate) AddPromotionID(i int) *InvoiceItemUpdate {
+	iiu.mutation.AddPromotionID(i)
+	return iiu
+}
+
+// ClearPromotionID clears the value of the "promotion_id" field.
+func (iiu *InvoiceItemUpdate) ClearPromotionID() *InvoiceItemUpdate {
+	iiu.mutation.ClearPromotionID()
+	return iiu
+}
+
+// SetPromotionDiscount sets the "promotion_discount" field.
+func (iiu *InvoiceItemUpdate) SetPromotionDiscount(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.ResetPromotionDiscount()
+	iiu.mutation.SetPromotionDiscount(m)
+	return iiu
+}
+
+// SetNillablePromotionDiscount sets the "promotion_discount" field if the given value is not nil.
+func (iiu *InvoiceItemUpdate) SetNillablePromotionDiscount(m *money.Amount) *InvoiceItemUpdate {
+	if m != nil {
+		iiu.SetPromotionDiscount(*m)
+	}
+	return iiu
+}
+
+// AddPromotionDiscount adds m to the "promotion_discount" field.
+func (iiu *InvoiceItemUpdate) AddPromotionDiscount(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.AddPromotionDiscount(m)
+	return iiu
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (iiu *InvoiceItemUpdate) SetDiscountAmount(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.ResetDiscountAmount()
+	iiu.mutation.SetDiscountAmount(m)
+	return iiu
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (iiu *InvoiceItemUpdate) SetNillableDiscountAmount(m *money.Amount) *InvoiceItemUpdate {
+	if m != nil {
+		iiu.SetDiscountAmount(*m)
+	}
+	return iiu
+}
+
+// AddDiscountAmount adds m to the "discount_amount" field.
+func (iiu *InvoiceItemUpdate) AddDiscountAmount(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.AddDiscountAmount(m)
+	return iiu
+}
+
+// SetInvoiceDiscountAmount sets the "invoice_discount_amount" field.
+func (iiu *InvoiceItemUpdate) SetInvoiceDiscountAmount(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.ResetInvoiceDiscountAmount()
+	iiu.mutation.SetInvoiceDiscountAmount(m)
+	return iiu
+}
+
+// SetNillableInvoiceDiscountAmount sets the "invoice_discount_amount" field if the given value is not nil.
+func (iiu *InvoiceItemUpdate) SetNillableInvoiceDiscountAmount(m *money.Amount) *InvoiceItemUpdate {
+	if m != nil {
+		iiu.SetInvoiceDiscountAmount(*m)
+	}
+	return iiu
+}
+
+// AddInvoiceDiscountAmount adds m to the "invoice_discount_amount" field.
+func (iiu *InvoiceItemUpdate) AddInvoiceDiscountAmount(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.AddInvoiceDiscountAmount(m)
+	return iiu
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (iiu *InvoiceItemUpdate) SetUnitCost(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.ResetUnitCost()
+	iiu.mutation.SetUnitCost(m)
+	return iiu
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (iiu *InvoiceItemUpdate) SetNillableUnitCost(m *money.Amount) *InvoiceItemUpdate {
+	if m != nil {
+		iiu.SetUnitCost(*m)
+	}
+	return iiu
+}
+
+// AddUnitCost adds m to the "unit_cost" field.
+func (iiu *InvoiceItemUpdate) AddUnitCost(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.AddUnitCost(m)
+	return iiu
+}
+
+// SetCostTotal sets the "cost_total" field.
+func (iiu *InvoiceItemUpdate) SetCostTotal(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.ResetCostTotal()
+	iiu.mutation.SetCostTotal(m)
+	return iiu
+}
+
+// SetNillableCostTotal sets the "cost_total" field if the given value is not nil.
+func (iiu *InvoiceItemUpdate) SetNillableCostTotal(m *money.Amount) *InvoiceItemUpdate {
+	if m != nil {
+		iiu.SetCostTotal(*m)
+	}
+	return iiu
+}
+
+// AddCostTotal adds m to the "cost_total" field.
+func (iiu *InvoiceItemUpdate) AddCostTotal(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.AddCostTotal(m)
+	return iiu
+}
+
+// SetPriceRule sets the "price_rule" field.
+func (iiu *InvoiceItemUpdate) SetPriceRule(s string) *InvoiceItemUpdate {
+	iiu.mutation.SetPriceRule(s)
+	return iiu
+}
+
+// SetNillablePriceRule sets the "price_rule" field if the given value is not nil.
+func (iiu *InvoiceItemUpdate) SetNillablePriceRule(s *string) *InvoiceItemUpdate {
+	if s != nil {
+		iiu.SetPriceRule(*s)
+	}
+	return iiu
+}
+
+// SetPriceListID sets the "price_list_id" field.
+func (iiu *InvoiceItemUpdate) SetPriceListID(i int) *InvoiceItemUpdate {
+	iiu.mutation.ResetPriceListID()
+	iiu.mutation.SetPriceListID(i)
+	return iiu
+}
+
+// SetNillablePriceListID sets the "price_list_id" field if the given value is not nil.
+func (iiu *InvoiceItemUpdate) SetNillablePriceListID(i *int) *InvoiceItemUpdate {
+	if i != nil {
+		iiu.SetPriceListID(*i)
+	}
+	return iiu
+}
+
+// AddPriceListID adds i to the "price_list_id" field.
+func (iiu *InvoiceItemUpdate) AddPriceListID(i int) *InvoiceItemUpdate {
+	iiu.mutation.AddPriceListID(i)
+	return iiu
+}
+
+// ClearPriceListID clears the value of the "price_list_id" field.
+func (iiu *InvoiceItemUpdate) ClearPriceListID() *InvoiceItemUpdate {
+	iiu.mutation.ClearPriceListID()
+	return iiu
+}
+
+// SetUnitID sets the "unit_id" field.
+func (iiu *InvoiceItemUpdate) SetUnitID(i int) *InvoiceItemUpdate {
+	iiu.mutation.ResetUnitID()
+	iiu.mutation.SetUnitID(i)
+	return iiu
+}
+
+// SetNillableUnitID sets the "unit_id" field if the given value is not nil.
+func (iiu *InvoiceItemUpdate) SetNillableUnitID(i *int) *InvoiceItemUpdate {
+	if i != nil {
+		iiu.SetUnitID(*i)
+	}
+	return iiu
+}
+
+// AddUnitID adds i to the "unit_id" field.
+func (iiu *InvoiceItemUpdate) AddUnitID(i int) *InvoiceItemUpdate {
+	iiu.mutation.AddUnitID(i)
+	return iiu
+}
+
+// ClearUnitID clears the value of the "unit_id" field.
+func (iiu *InvoiceItemUpdate) ClearUnitID() *InvoiceItemUpdate {
+	iiu.mutation.ClearUnitID()
+	return iiu
+}
+
+// SetUnitName sets the "unit_name" field.
+func (iiu *InvoiceItemUpdate) SetUnitName(s string) *InvoiceItemUpdate {
+	iiu.mutation.SetUnitName(s)
+	return iiu
+}
+
+// SetNillableUnitName sets the "unit_name" field if the given value is not nil.
+func (iiu *InvoiceItemUpdate) SetNillableUnitName(s *string) *InvoiceItemUpdate {
+	if s != nil {
+		iiu.SetUnitName(*s)
+	}
+	return iiu
+}
+
+// ClearUnitName clears the value of the "unit_name" field.
+func (iiu *InvoiceItemUpdate) ClearUnitName() *InvoiceItemUpdate {
+	iiu.mutation.ClearUnitName()
+	return iiu
+}
+
+// SetUnitQuantity sets the "unit_quantity" field.
+func (iiu *InvoiceItemUpdate) SetUnitQuantity(f float64) *InvoiceItemUpdate {
+	iiu.mutation.ResetUnitQuantity()
+	iiu.mutation.SetUnitQuantity(f)
+	return iiu
+}
+
+// SetNillableUnitQuantity sets the "unit_quantity" field if the given value is not nil.
+func (iiu *InvoiceItemUpdate) SetNillableUnitQuantity(f *float64) *InvoiceItemUpdate {
+	if f != nil {
+		iiu.SetUnitQuantity(*f)
+	}
+	return iiu
+}
+
+// AddUnitQuantity adds f to the "unit_quantity" field.
+func (iiu *InvoiceItemUpdate) AddUnitQuantity(f float64) *InvoiceItemUpdate {
+	iiu.mutation.AddUnitQuantity(f)
+	return iiu
+}
+
+// ClearUnitQuantity clears the value of the "unit_quantity" field.
+func (iiu *InvoiceItemUpdate) ClearUnitQuantity() *InvoiceItemUpdate {
+	iiu.mutation.ClearUnitQuantity()
+	return iiu
+}
+
+// SetTaxRateID sets the "tax_rate_id" field.
+func (iiu *InvoiceItemUpdate) SetTaxRateID(i int) *InvoiceItemUpdate {
+	iiu.mutation.ResetTaxRateID()
+	iiu.mutation.SetTaxRateID(i)
+	return iiu
+}
+
+// SetNillableTaxRateID sets the "tax_rate_id" field if the given value is not nil.
+func (iiu *InvoiceItemUpdate) SetNillableTaxRateID(i *int) *InvoiceItemUpdate {
+	if i != nil {
+		iiu.SetTaxRateID(*i)
+	}
+	return iiu
+}
+
+// AddTaxRateID adds i to the "tax_rate_id" field.
+func (iiu *InvoiceItemUpdate) AddTaxRateID(i int) *InvoiceItemUpdate {
+	iiu.mutation.AddTaxRateID(i)
+	return iiu
+}
+
+// ClearTaxRateID clears the value of the "tax_rate_id" field.
+func (iiu *InvoiceItemUpdate) ClearTaxRateID() *InvoiceItemUpdate {
+	iiu.mutation.ClearTaxRateID()
+	return iiu
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (iiu *InvoiceItemUpdate) SetTaxRate(f float64) *InvoiceItemUpdate {
+	iiu.mutation.ResetTaxRate()
+	iiu.mutation.SetTaxRate(f)
+	return iiu
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (iiu *InvoiceItemUpdate) SetNillableTaxRate(f *float64) *InvoiceItemUpdate {
+	if f != nil {
+		iiu.SetTaxRate(*f)
+	}
+	return iiu
+}
+
+// AddTaxRate adds f to the "tax_rate" field.
+func (iiu *InvoiceItemUpdate) AddTaxRate(f float64) *InvoiceItemUpdate {
+	iiu.mutation.AddTaxRate(f)
+	return iiu
+}
+
+// SetTaxBase sets the "tax_base" field.
+func (iiu *InvoiceItemUpdate) SetTaxBase(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.ResetTaxBase()
+	iiu.mutation.SetTaxBase(m)
+	return iiu
+}
+
+// SetNillableTaxBase sets the "tax_base" field if the given value is not nil.
+func (iiu *InvoiceItemUpdate) SetNillableTaxBase(m *money.Amount) *InvoiceItemUpdate {
+	if m != nil {
+		iiu.SetTaxBase(*m)
+	}
+	return iiu
+}
+
+// AddTaxBase adds m to the "tax_base" field.
+func (iiu *InvoiceItemUpdate) AddTaxBase(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.AddTaxBase(m)
+	return iiu
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (iiu *InvoiceItemUpdate) SetTaxAmount(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.ResetTaxAmount()
+	iiu.mutation.SetTaxAmount(m)
+	return iiu
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (iiu *InvoiceItemUpdate) SetNillableTaxAmount(m *money.Amount) *InvoiceItemUpdate {
+	if m != nil {
+		iiu.SetTaxAmount(*m)
+	}
+	return iiu
+}
+
+// AddTaxAmount adds m to the "tax_amount" field.
+func (iiu *InvoiceItemUpdate) AddTaxAmount(m money.Amount) *InvoiceItemUpdate {
+	iiu.mutation.AddTaxAmount(m)
 	return iiu
 }
 
@@ -170,15 +508,25 @@ func (iiu *InvoiceItemUpdate) check() error {
 		}
 	}
 	if v, ok := iiu.mutation.UnitPrice(); ok {
-		if err := invoiceitem.UnitPriceValidator(v); err != nil {
+		if err := invoiceitem.UnitPriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "unit_price", err: fmt.Errorf(`ent: validator failed for field "InvoiceItem.unit_price": %w`, err)}
 		}
 	}
 	if v, ok := iiu.mutation.Subtotal(); ok {
-		if err := invoiceitem.SubtotalValidator(v); err != nil {
+		if err := invoiceitem.SubtotalValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "subtotal", err: fmt.Errorf(`ent: validator failed for field "InvoiceItem.subtotal": %w`, err)}
 		}
 	}
+	if v, ok := iiu.mutation.UnitCost(); ok {
+		if err := invoiceitem.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "InvoiceItem.unit_cost": %w`, err)}
+		}
+	}
+	if v, ok := iiu.mutation.CostTotal(); ok {
+		if err := invoiceitem.CostTotalValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "cost_total", err: fmt.Errorf(`ent: validator failed for field "InvoiceItem.cost_total": %w`, err)}
+		}
+	}
 	if _, ok := iiu.mutation.InvoiceID(); iiu.mutation.InvoiceCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "InvoiceItem.invoice"`)
 	}
@@ -219,46 +567,278 @@ func (iiu *InvoiceItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if value, ok := iiu.mutation.Quantity(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeFloat64,
 			Value:  value,
 			Column: invoiceitem.FieldQuantity,
 		})
 	}
 	if value, ok := iiu.mutation.AddedQuantity(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeFloat64,
 			Value:  value,
 			Column: invoiceitem.FieldQuantity,
 		})
 	}
 	if value, ok := iiu.mutation.UnitPrice(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: invoiceitem.FieldUnitPrice,
 		})
 	}
 	if value, ok := iiu.mutation.AddedUnitPrice(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: invoiceitem.FieldUnitPrice,
 		})
 	}
 	if value, ok := iiu.mutation.Subtotal(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: invoiceitem.FieldSubtotal,
 		})
 	}
 	if value, ok := iiu.mutation.AddedSubtotal(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: invoiceitem.FieldSubtotal,
 		})
 	}
+	if value, ok := iiu.mutation.PromotionID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldPromotionID,
+		})
+	}
+	if value, ok := iiu.mutation.AddedPromotionID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldPromotionID,
+		})
+	}
+	if iiu.mutation.PromotionIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoiceitem.FieldPromotionID,
+		})
+	}
+	if value, ok := iiu.mutation.PromotionDiscount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldPromotionDiscount,
+		})
+	}
+	if value, ok := iiu.mutation.AddedPromotionDiscount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldPromotionDiscount,
+		})
+	}
+	if value, ok := iiu.mutation.DiscountAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldDiscountAmount,
+		})
+	}
+	if value, ok := iiu.mutation.AddedDiscountAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldDiscountAmount,
+		})
+	}
+	if value, ok := iiu.mutation.InvoiceDiscountAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldInvoiceDiscountAmount,
+		})
+	}
+	if value, ok := iiu.mutation.AddedInvoiceDiscountAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldInvoiceDiscountAmount,
+		})
+	}
+	if value, ok := iiu.mutation.UnitCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldUnitCost,
+		})
+	}
+	if value, ok := iiu.mutation.AddedUnitCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldUnitCost,
+		})
+	}
+	if value, ok := iiu.mutation.CostTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldCostTotal,
+		})
+	}
+	if value, ok := iiu.mutation.AddedCostTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldCostTotal,
+		})
+	}
+	if value, ok := iiu.mutation.PriceRule(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoiceitem.FieldPriceRule,
+		})
+	}
+	if value, ok := iiu.mutation.PriceListID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldPriceListID,
+		})
+	}
+	if value, ok := iiu.mutation.AddedPriceListID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldPriceListID,
+		})
+	}
+	if iiu.mutation.PriceListIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoiceitem.FieldPriceListID,
+		})
+	}
+	if value, ok := iiu.mutation.UnitID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldUnitID,
+		})
+	}
+	if value, ok := iiu.mutation.AddedUnitID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldUnitID,
+		})
+	}
+	if iiu.mutation.UnitIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoiceitem.FieldUnitID,
+		})
+	}
+	if value, ok := iiu.mutation.UnitName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoiceitem.FieldUnitName,
+		})
+	}
+	if iiu.mutation.UnitNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: invoiceitem.FieldUnitName,
+		})
+	}
+	if value, ok := iiu.mutation.UnitQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitem.FieldUnitQuantity,
+		})
+	}
+	if value, ok := iiu.mutation.AddedUnitQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitem.FieldUnitQuantity,
+		})
+	}
+	if iiu.mutation.UnitQuantityCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: invoiceitem.FieldUnitQuantity,
+		})
+	}
+	if value, ok := iiu.mutation.TaxRateID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldTaxRateID,
+		})
+	}
+	if value, ok := iiu.mutation.AddedTaxRateID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldTaxRateID,
+		})
+	}
+	if iiu.mutation.TaxRateIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoiceitem.FieldTaxRateID,
+		})
+	}
+	if value, ok := iiu.mutation.TaxRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitem.FieldTaxRate,
+		})
+	}
+	if value, ok := iiu.mutation.AddedTaxRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitem.FieldTaxRate,
+		})
+	}
+	if value, ok := iiu.mutation.TaxBase(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldTaxBase,
+		})
+	}
+	if value, ok := iiu.mutation.AddedTaxBase(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldTaxBase,
+		})
+	}
+	if value, ok := iiu.mutation.TaxAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldTaxAmount,
+		})
+	}
+	if value, ok := iiu.mutation.AddedTaxAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldTaxAmount,
+		})
+	}
 	if iiu.mutation.InvoiceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -275,99 +855,436 @@ func (iiu *InvoiceItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := iiu.mutation.InvoiceIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   invoiceitem.InvoiceTable,
-			Columns: []string{invoiceitem.InvoiceColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeInt,
-					Column: invoice.FieldID,
-				},
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	if nodes := iiu.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoiceitem.InvoiceTable,
+			Columns: []string{invoiceitem.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoiceitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InvoiceItemUpdateOne is the builder for updating a single InvoiceItem entity.
+type InvoiceItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceItemMutation
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (iiuo *InvoiceItemUpdateOne) SetInvoiceID(i int) *InvoiceItemUpdateOne {
+	iiuo.mutation.SetInvoiceID(i)
+	return iiuo
+}
+
+// SetProductID sets the "product_id" field.
+func (iiuo *InvoiceItemUpdateOne) SetProductID(i int) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetProductID()
+	iiuo.mutation.SetProductID(i)
+	return iiuo
+}
+
+// AddProductID adds i to the "product_id" field.
+func (iiuo *InvoiceItemUpdateOne) AddProductID(i int) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddProductID(i)
+	return iiuo
+}
+
+// SetQuantity sets the "quantity" field.
+func (iiuo *InvoiceItemUpdateOne) SetQuantity(f float64) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetQuantity()
+	iiuo.mutation.SetQuantity(f)
+	return iiuo
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (iiuo *InvoiceItemUpdateOne) AddQuantity(f float64) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddQuantity(f)
+	return iiuo
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (iiuo *InvoiceItemUpdateOne) SetUnitPrice(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetUnitPrice()
+	iiuo.mutation.SetUnitPrice(m)
+	return iiuo
+}
+
+// AddUnitPrice adds m to the "unit_price" field.
+func (iiuo *InvoiceItemUpdateOne) AddUnitPrice(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddUnitPrice(m)
+	return iiuo
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (iiuo *InvoiceItemUpdateOne) SetSubtotal(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetSubtotal()
+	iiuo.mutation.SetSubtotal(m)
+	return iiuo
+}
+
+// AddSubtotal adds m to the "subtotal" field.
+func (iiuo *InvoiceItemUpdateOne) AddSubtotal(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddSubtotal(m)
+	return iiuo
+}
+
+// SetPromotionID sets the "promotion_id" field.
+func (iiuo *InvoiceItemUpdateOne) SetPromotionID(i int) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetPromotionID()
+	iiuo.mutation.SetPromotionID(i)
+	return iiuo
+}
+
+// SetNillablePromotionID sets the "promotion_id" field if the given value is not nil.
+func (iiuo *InvoiceItemUpdateOne) SetNillablePromotionID(i *int) *InvoiceItemUpdateOne {
+	if i != nil {
+		iiuo.SetPromotionID(*i)
+	}
+	return iiuo
+}
+
+// AddPromotionID adds i to the "promotion_id" field.
+func (iiuo *InvoiceItemUpdateOne) AddPromotionID(i int) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddPromotionID(i)
+	return iiuo
+}
+
+// ClearPromotionID clears the value of the "promotion_id" field.
+func (iiuo *InvoiceItemUpdateOne) ClearPromotionID() *InvoiceItemUpdateOne {
+	iiuo.mutation.ClearPromotionID()
+	return iiuo
+}
+
+// SetPromotionDiscount sets the "promotion_discount" field.
+func (iiuo *InvoiceItemUpdateOne) SetPromotionDiscount(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetPromotionDiscount()
+	iiuo.mutation.SetPromotionDiscount(m)
+	return iiuo
+}
+
+// SetNillablePromotionDiscount sets the "promotion_discount" field if the given value is not nil.
+func (iiuo *InvoiceItemUpdateOne) SetNillablePromotionDiscount(m *money.Amount) *InvoiceItemUpdateOne {
+	if m != nil {
+		iiuo.SetPromotionDiscount(*m)
+	}
+	return iiuo
+}
+
+// AddPromotionDiscount adds m to the "promotion_discount" field.
+func (iiuo *InvoiceItemUpdateOne) AddPromotionDiscount(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddPromotionDiscount(m)
+	return iiuo
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (iiuo *InvoiceItemUpdateOne) SetDiscountAmount(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetDiscountAmount()
+	iiuo.mutation.SetDiscountAmount(m)
+	return iiuo
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (iiuo *InvoiceItemUpdateOne) SetNillableDiscountAmount(m *money.Amount) *InvoiceItemUpdateOne {
+	if m != nil {
+		iiuo.SetDiscountAmount(*m)
+	}
+	return iiuo
+}
+
+// AddDiscountAmount adds m to the "discount_amount" field.
+func (iiuo *InvoiceItemUpdateOne) AddDiscountAmount(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddDiscountAmount(m)
+	return iiuo
+}
+
+// SetInvoiceDiscountAmount sets the "invoice_discount_amount" field.
+func (iiuo *InvoiceItemUpdateOne) SetInvoiceDiscountAmount(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetInvoiceDiscountAmount()
+	iiuo.mutation.SetInvoiceDiscountAmount(m)
+	return iiuo
+}
+
+// SetNillableInvoiceDiscountAmount sets the "invoice_discount_amount" field if the given value is not nil.
+func (iiuo *InvoiceItemUpdateOne) SetNillableInvoiceDiscountAmount(m *money.Amount) *InvoiceItemUpdateOne {
+	if m != nil {
+		iiuo.SetInvoiceDiscountAmount(*m)
+	}
+	return iiuo
+}
+
+// AddInvoiceDiscountAmount adds m to the "invoice_discount_amount" field.
+func (iiuo *InvoiceItemUpdateOne) AddInvoiceDiscountAmount(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddInvoiceDiscountAmount(m)
+	return iiuo
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (iiuo *InvoiceItemUpdateOne) SetUnitCost(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetUnitCost()
+	iiuo.mutation.SetUnitCost(m)
+	return iiuo
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (iiuo *InvoiceItemUpdateOne) SetNillableUnitCost(m *money.Amount) *InvoiceItemUpdateOne {
+	if m != nil {
+		iiuo.SetUnitCost(*m)
+	}
+	return iiuo
+}
+
+// AddUnitCost adds m to the "unit_cost" field.
+func (iiuo *InvoiceItemUpdateOne) AddUnitCost(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddUnitCost(m)
+	return iiuo
+}
+
+// SetCostTotal sets the "cost_total" field.
+func (iiuo *InvoiceItemUpdateOne) SetCostTotal(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetCostTotal()
+	iiuo.mutation.SetCostTotal(m)
+	return iiuo
+}
+
+// SetNillableCostTotal sets the "cost_total" field if the given value is not nil.
+func (iiuo *InvoiceItemUpdateOne) SetNillableCostTotal(m *money.Amount) *InvoiceItemUpdateOne {
+	if m != nil {
+		iiuo.SetCostTotal(*m)
+	}
+	return iiuo
+}
+
+// AddCostTotal adds m to the "cost_total" field.
+func (iiuo *InvoiceItemUpdateOne) AddCostTotal(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddCostTotal(m)
+	return iiuo
+}
+
+// SetPriceRule sets the "price_rule" field.
+func (iiuo *InvoiceItemUpdateOne) SetPriceRule(s string) *InvoiceItemUpdateOne {
+	iiuo.mutation.SetPriceRule(s)
+	return iiuo
+}
+
+// SetNillablePriceRule sets the "price_rule" field if the given value is not nil.
+func (iiuo *InvoiceItemUpdateOne) SetNillablePriceRule(s *string) *InvoiceItemUpdateOne {
+	if s != nil {
+		iiuo.SetPriceRule(*s)
+	}
+	return iiuo
+}
+
+// SetPriceListID sets the "price_list_id" field.
+func (iiuo *InvoiceItemUpdateOne) SetPriceListID(i int) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetPriceListID()
+	iiuo.mutation.SetPriceListID(i)
+	return iiuo
+}
+
+// SetNillablePriceListID sets the "price_list_id" field if the given value is not nil.
+func (iiuo *InvoiceItemUpdateOne) SetNillablePriceListID(i *int) *InvoiceItemUpdateOne {
+	if i != nil {
+		iiuo.SetPriceListID(*i)
+	}
+	return iiuo
+}
+
+// AddPriceListID adds i to the "price_list_id" field.
+func (iiuo *InvoiceItemUpdateOne) AddPriceListID(i int) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddPriceListID(i)
+	return iiuo
+}
+
+// ClearPriceListID clears the value of the "price_list_id" field.
+func (iiuo *InvoiceItemUpdateOne) ClearPriceListID() *InvoiceItemUpdateOne {
+	iiuo.mutation.ClearPriceListID()
+	return iiuo
+}
+
+// SetUnitID sets the "unit_id" field.
+func (iiuo *InvoiceItemUpdateOne) SetUnitID(i int) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetUnitID()
+	iiuo.mutation.SetUnitID(i)
+	return iiuo
+}
+
+// SetNillableUnitID sets the "unit_id" field if the given value is not nil.
+func (iiuo *InvoiceItemUpdateOne) SetNillableUnitID(i *int) *InvoiceItemUpdateOne {
+	if i != nil {
+		iiuo.SetUnitID(*i)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, iiu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{invoiceitem.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	return iiuo
+}
+
+// AddUnitID adds i to the "unit_id" field.
+func (iiuo *InvoiceItemUpdateOne) AddUnitID(i int) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddUnitID(i)
+	return iiuo
+}
+
+// ClearUnitID clears the value of the "unit_id" field.
+func (iiuo *InvoiceItemUpdateOne) ClearUnitID() *InvoiceItemUpdateOne {
+	iiuo.mutation.ClearUnitID()
+	return iiuo
+}
+
+// SetUnitName sets the "unit_name" field.
+func (iiuo *InvoiceItemUpdateOne) SetUnitName(s string) *InvoiceItemUpdateOne {
+	iiuo.mutation.SetUnitName(s)
+	return iiuo
+}
+
+// SetNillableUnitName sets the "unit_name" field if the given value is not nil.
+func (iiuo *InvoiceItemUpdateOne) SetNillableUnitName(s *string) *InvoiceItemUpdateOne {
+	if s != nil {
+		iiuo.SetUnitName(*s)
 	}
-	return n, nil
+	return iiuo
 }
 
-// InvoiceItemUpdateOne is the builder for updating a single InvoiceItem entity.
-type InvoiceItemUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *InvoiceItemMutation
+// ClearUnitName clears the value of the "unit_name" field.
+func (iiuo *InvoiceItemUpdateOne) ClearUnitName() *InvoiceItemUpdateOne {
+	iiuo.mutation.ClearUnitName()
+	return iiuo
 }
 
-// SetInvoiceID sets the "invoice_id" field.
-func (iiuo *InvoiceItemUpdateOne) SetInvoiceID(i int) *InvoiceItemUpdateOne {
-	iiuo.mutation.SetInvoiceID(i)
+// SetUnitQuantity sets the "unit_quantity" field.
+func (iiuo *InvoiceItemUpdateOne) SetUnitQuantity(f float64) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetUnitQuantity()
+	iiuo.mutation.SetUnitQuantity(f)
 	return iiuo
 }
 
-// SetProductID sets the "product_id" field.
-func (iiuo *InvoiceItemUpdateOne) SetProductID(i int) *InvoiceItemUpdateOne {
-	iiuo.mutation.ResetProductID()
-	iiuo.mutation.SetProductID(i)
+// SetNillableUnitQuantity sets the "unit_quantity" field if the given value is not nil.
+func (iiuo *InvoiceItemUpdateOne) SetNillableUnitQuantity(f *float64) *InvoiceItemUpdateOne {
+	if f != nil {
+		iiuo.SetUnitQuantity(*f)
+	}
 	return iiuo
 }
 
-// AddProductID adds i to the "product_id" field.
-func (iiuo *InvoiceItemUpdateOne) AddProductID(i int) *InvoiceItemUpdateOne {
-	iiuo.mutation.AddProductID(i)
+// AddUnitQuantity adds f to the "unit_quantity" field.
+func (iiuo *InvoiceItemUpdateOne) AddUnitQuantity(f float64) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddUnitQuantity(f)
 	return iiuo
 }
 
-// SetQuantity sets the "quantity" field.
-func (iiuo *InvoiceItemUpdateOne) SetQuantity(i int) *InvoiceItemUpdateOne {
-	iiuo.mutation.ResetQuantity()
-	iiuo.mutation.SetQuantity(i)
+// ClearUnitQuantity clears the value of the "unit_quantity" field.
+func (iiuo *InvoiceItemUpdateOne) ClearUnitQuantity() *InvoiceItemUpdateOne {
+	iiuo.mutation.ClearUnitQuantity()
 	return iiuo
 }
 
-// AddQuantity adds i to the "quantity" field.
-func (iiuo *InvoiceItemUpdateOne) AddQuantity(i int) *InvoiceItemUpdateOne {
-	iiuo.mutation.AddQuantity(i)
+// SetTaxRateID sets the "tax_rate_id" field.
+func (iiuo *InvoiceItemUpdateOne) SetTaxRateID(i int) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetTaxRateID()
+	iiuo.mutation.SetTaxRateID(i)
 	return iiuo
 }
 
-// SetUnitPrice sets the "unit_price" field.
-func (iiuo *InvoiceItemUpdateOne) SetUnitPrice(f float64) *InvoiceItemUpdateOne {
-	iiuo.mutation.ResetUnitPrice()
-	iiuo.mutation.SetUnitPrice(f)
+// SetNillableTaxRateID sets the "tax_rate_id" field if the given value is not nil.
+func (iiuo *InvoiceItemUpdateOne) SetNillableTaxRateID(i *int) *InvoiceItemUpdateOne {
+	if i != nil {
+		iiuo.SetTaxRateID(*i)
+	}
 	return iiuo
 }
 
-// AddUnitPrice adds f to the "unit_price" field.
-func (iiuo *InvoiceItemUpdateOne) AddUnitPrice(f float64) *InvoiceItemUpdateOne {
-	iiuo.mutation.AddUnitPrice(f)
+// AddTaxRateID adds i to the "tax_rate_id" field.
+func (iiuo *InvoiceItemUpdateOne) AddTaxRateID(i int) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddTaxRateID(i)
 	return iiuo
 }
 
-// SetSubtotal sets the "subtotal" field.
-func (iiuo *InvoiceItemUpdateOne) SetSubtotal(f float64) *InvoiceItemUpdateOne {
-	iiuo.mutation.ResetSubtotal()
-	iiuo.mutation.SetSubtotal(f)
+// ClearTaxRateID clears the value of the "tax_rate_id" field.
+func (iiuo *InvoiceItemUpdateOne) ClearTaxRateID() *InvoiceItemUpdateOne {
+	iiuo.mutation.ClearTaxRateID()
+	return iiuo
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (iiuo *InvoiceItemUpdateOne) SetTaxRate(f float64) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetTaxRate()
+	iiuo.mutation.SetTaxRate(f)
+	return iiuo
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (iiuo *InvoiceItemUpdateOne) SetNillableTaxRate(f *float64) *InvoiceItemUpdateOne {
+	if f != nil {
+		iiuo.SetTaxRate(*f)
+	}
+	return iiuo
+}
+
+// AddTaxRate adds f to the "tax_rate" field.
+func (iiuo *InvoiceItemUpdateOne) AddTaxRate(f float64) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddTaxRate(f)
+	return iiuo
+}
+
+// SetTaxBase sets the "tax_base" field.
+func (iiuo *InvoiceItemUpdateOne) SetTaxBase(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetTaxBase()
+	iiuo.mutation.SetTaxBase(m)
+	return iiuo
+}
+
+// SetNillableTaxBase sets the "tax_base" field if the given value is not nil.
+func (iiuo *InvoiceItemUpdateOne) SetNillableTaxBase(m *money.Amount) *InvoiceItemUpdateOne {
+	if m != nil {
+		iiuo.SetTaxBase(*m)
+	}
+	return iiuo
+}
+
+// AddTaxBase adds m to the "tax_base" field.
+func (iiuo *InvoiceItemUpdateOne) AddTaxBase(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddTaxBase(m)
 	return iiuo
 }
 
-// AddSubtotal adds f to the "subtotal" field.
-func (iiuo *InvoiceItemUpdateOne) AddSubtotal(f float64) *InvoiceItemUpdateOne {
-	iiuo.mutation.AddSubtotal(f)
+// SetTaxAmount sets the "tax_amount" field.
+func (iiuo *InvoiceItemUpdateOne) SetTaxAmount(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.ResetTaxAmount()
+	iiuo.mutation.SetTaxAmount(m)
+	return iiuo
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (iiuo *InvoiceItemUpdateOne) SetNillableTaxAmount(m *money.Amount) *InvoiceItemUpdateOne {
+	if m != nil {
+		iiuo.SetTaxAmount(*m)
+	}
+	return iiuo
+}
+
+// AddTaxAmount adds m to the "tax_amount" field.
+func (iiuo *InvoiceItemUpdateOne) AddTaxAmount(m money.Amount) *InvoiceItemUpdateOne {
+	iiuo.mutation.AddTaxAmount(m)
 	return iiuo
 }
 
@@ -468,15 +1385,25 @@ func (iiuo *InvoiceItemUpdateOne) check() error {
 		}
 	}
 	if v, ok := iiuo.mutation.UnitPrice(); ok {
-		if err := invoiceitem.UnitPriceValidator(v); err != nil {
+		if err := invoiceitem.UnitPriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "unit_price", err: fmt.Errorf(`ent: validator failed for field "InvoiceItem.unit_price": %w`, err)}
 		}
 	}
 	if v, ok := iiuo.mutation.Subtotal(); ok {
-		if err := invoiceitem.SubtotalValidator(v); err != nil {
+		if err := invoiceitem.SubtotalValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "subtotal", err: fmt.Errorf(`ent: validator failed for field "InvoiceItem.subtotal": %w`, err)}
 		}
 	}
+	if v, ok := iiuo.mutation.UnitCost(); ok {
+		if err := invoiceitem.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "InvoiceItem.unit_cost": %w`, err)}
+		}
+	}
+	if v, ok := iiuo.mutation.CostTotal(); ok {
+		if err := invoiceitem.CostTotalValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "cost_total", err: fmt.Errorf(`ent: validator failed for field "InvoiceItem.cost_total": %w`, err)}
+		}
+	}
 	if _, ok := iiuo.mutation.InvoiceID(); iiuo.mutation.InvoiceCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "InvoiceItem.invoice"`)
 	}
@@ -534,46 +1461,278 @@ func (iiuo *InvoiceItemUpdateOne) sqlSave(ctx context.Context) (_node *InvoiceIt
 	}
 	if value, ok := iiuo.mutation.Quantity(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeFloat64,
 			Value:  value,
 			Column: invoiceitem.FieldQuantity,
 		})
 	}
 	if value, ok := iiuo.mutation.AddedQuantity(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeFloat64,
 			Value:  value,
 			Column: invoiceitem.FieldQuantity,
 		})
 	}
 	if value, ok := iiuo.mutation.UnitPrice(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: invoiceitem.FieldUnitPrice,
 		})
 	}
 	if value, ok := iiuo.mutation.AddedUnitPrice(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: invoiceitem.FieldUnitPrice,
 		})
 	}
 	if value, ok := iiuo.mutation.Subtotal(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: invoiceitem.FieldSubtotal,
 		})
 	}
 	if value, ok := iiuo.mutation.AddedSubtotal(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: invoiceitem.FieldSubtotal,
 		})
 	}
+	if value, ok := iiuo.mutation.PromotionID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldPromotionID,
+		})
+	}
+	if value, ok := iiuo.mutation.AddedPromotionID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldPromotionID,
+		})
+	}
+	if iiuo.mutation.PromotionIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoiceitem.FieldPromotionID,
+		})
+	}
+	if value, ok := iiuo.mutation.PromotionDiscount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldPromotionDiscount,
+		})
+	}
+	if value, ok := iiuo.mutation.AddedPromotionDiscount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldPromotionDiscount,
+		})
+	}
+	if value, ok := iiuo.mutation.DiscountAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldDiscountAmount,
+		})
+	}
+	if value, ok := iiuo.mutation.AddedDiscountAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldDiscountAmount,
+		})
+	}
+	if value, ok := iiuo.mutation.InvoiceDiscountAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldInvoiceDiscountAmount,
+		})
+	}
+	if value, ok := iiuo.mutation.AddedInvoiceDiscountAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldInvoiceDiscountAmount,
+		})
+	}
+	if value, ok := iiuo.mutation.UnitCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldUnitCost,
+		})
+	}
+	if value, ok := iiuo.mutation.AddedUnitCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldUnitCost,
+		})
+	}
+	if value, ok := iiuo.mutation.CostTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldCostTotal,
+		})
+	}
+	if value, ok := iiuo.mutation.AddedCostTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldCostTotal,
+		})
+	}
+	if value, ok := iiuo.mutation.PriceRule(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoiceitem.FieldPriceRule,
+		})
+	}
+	if value, ok := iiuo.mutation.PriceListID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldPriceListID,
+		})
+	}
+	if value, ok := iiuo.mutation.AddedPriceListID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldPriceListID,
+		})
+	}
+	if iiuo.mutation.PriceListIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoiceitem.FieldPriceListID,
+		})
+	}
+	if value, ok := iiuo.mutation.UnitID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldUnitID,
+		})
+	}
+	if value, ok := iiuo.mutation.AddedUnitID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldUnitID,
+		})
+	}
+	if iiuo.mutation.UnitIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoiceitem.FieldUnitID,
+		})
+	}
+	if value, ok := iiuo.mutation.UnitName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoiceitem.FieldUnitName,
+		})
+	}
+	if iiuo.mutation.UnitNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: invoiceitem.FieldUnitName,
+		})
+	}
+	if value, ok := iiuo.mutation.UnitQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitem.FieldUnitQuantity,
+		})
+	}
+	if value, ok := iiuo.mutation.AddedUnitQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitem.FieldUnitQuantity,
+		})
+	}
+	if iiuo.mutation.UnitQuantityCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: invoiceitem.FieldUnitQuantity,
+		})
+	}
+	if value, ok := iiuo.mutation.TaxRateID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldTaxRateID,
+		})
+	}
+	if value, ok := iiuo.mutation.AddedTaxRateID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitem.FieldTaxRateID,
+		})
+	}
+	if iiuo.mutation.TaxRateIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoiceitem.FieldTaxRateID,
+		})
+	}
+	if value, ok := iiuo.mutation.TaxRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitem.FieldTaxRate,
+		})
+	}
+	if value, ok := iiuo.mutation.AddedTaxRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitem.FieldTaxRate,
+		})
+	}
+	if value, ok := iiuo.mutation.TaxBase(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldTaxBase,
+		})
+	}
+	if value, ok := iiuo.mutation.AddedTaxBase(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldTaxBase,
+		})
+	}
+	if value, ok := iiuo.mutation.TaxAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldTaxAmount,
+		})
+	}
+	if value, ok := iiuo.mutation.AddedTaxAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitem.FieldTaxAmount,
+		})
+	}
 	if iiuo.mutation.InvoiceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
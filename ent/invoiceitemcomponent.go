@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoiceitemcomponent"
+	"Veritasbackend/pkg/money"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// InvoiceItemComponent is the model entity for the InvoiceItemComponent schema.
+type InvoiceItemComponent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID de la factura de venta
+	InvoiceID int `json:"invoice_id,omitempty"`
+	// ID del item del combo vendido
+	InvoiceItemID int `json:"invoice_item_id,omitempty"`
+	// ID del producto combo
+	BundleID int `json:"bundle_id,omitempty"`
+	// ID del componente
+	ProductID int `json:"product_id,omitempty"`
+	// Cantidad del componente incluida en la venta, en su unidad base
+	Quantity float64 `json:"quantity,omitempty"`
+	// Parte del subtotal del combo asignada al componente según el reparto configurado
+	Revenue money.Amount `json:"revenue,omitempty"`
+	// Parte del costo del combo asignada al componente
+	CostTotal money.Amount `json:"cost_total,omitempty"`
+	// Cantidad del componente armada en el momento de la venta y descontada de su stock
+	BuiltQuantity float64 `json:"built_quantity,omitempty"`
+	// Costo unitario del componente descontado al armar el combo en la venta
+	BuiltUnitCost money.Amount `json:"built_unit_cost,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvoiceItemComponent) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoiceitemcomponent.FieldRevenue, invoiceitemcomponent.FieldCostTotal, invoiceitemcomponent.FieldBuiltUnitCost:
+			values[i] = new(money.Amount)
+		case invoiceitemcomponent.FieldQuantity, invoiceitemcomponent.FieldBuiltQuantity:
+			values[i] = new(sql.NullFloat64)
+		case invoiceitemcomponent.FieldID, invoiceitemcomponent.FieldInvoiceID, invoiceitemcomponent.FieldInvoiceItemID, invoiceitemcomponent.FieldBundleID, invoiceitemcomponent.FieldProductID:
+			values[i] = new(sql.NullInt64)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type InvoiceItemComponent", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvoiceItemComponent fields.
+func (iic *InvoiceItemComponent) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoiceitemcomponent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			iic.ID = int(value.Int64)
+		case invoiceitemcomponent.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				iic.InvoiceID = int(value.Int64)
+			}
+		case invoiceitemcomponent.FieldInvoiceItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_item_id", values[i])
+			} else if value.Valid {
+				iic.InvoiceItemID = int(value.Int64)
+			}
+		case invoiceitemcomponent.FieldBundleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bundle_id", values[i])
+			} else if value.Valid {
+				iic.BundleID = int(value.Int64)
+			}
+		case invoiceitemcomponent.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				iic.ProductID = int(value.Int64)
+			}
+		case invoiceitemcomponent.FieldQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				iic.Quantity = value.Float64
+			}
+		case invoiceitemcomponent.FieldRevenue:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field revenue", values[i])
+			} else if value != nil {
+				iic.Revenue = *value
+			}
+		case invoiceitemcomponent.FieldCostTotal:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_total", values[i])
+			} else if value != nil {
+				iic.CostTotal = *value
+			}
+		case invoiceitemcomponent.FieldBuiltQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field built_quantity", values[i])
+			} else if value.Valid {
+				iic.BuiltQuantity = value.Float64
+			}
+		case invoiceitemcomponent.FieldBuiltUnitCost:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field built_unit_cost", values[i])
+			} else if value != nil {
+				iic.BuiltUnitCost = *value
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this InvoiceItemComponent.
+// Note that you need to call InvoiceItemComponent.Unwrap() before calling this method if this InvoiceItemComponent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (iic *InvoiceItemComponent) Update() *InvoiceItemComponentUpdateOne {
+	return (&InvoiceItemComponentClient{config: iic.config}).UpdateOne(iic)
+}
+
+// Unwrap unwraps the InvoiceItemComponent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (iic *InvoiceItemComponent) Unwrap() *InvoiceItemComponent {
+	_tx, ok := iic.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoiceItemComponent is not a transactional entity")
+	}
+	iic.config.driver = _tx.drv
+	return iic
+}
+
+// String implements the fmt.Stringer.
+func (iic *InvoiceItemComponent) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvoiceItemComponent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", iic.ID))
+	builder.WriteString("invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", iic.InvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("invoice_item_id=")
+	builder.WriteString(fmt.Sprintf("%v", iic.InvoiceItemID))
+	builder.WriteString(", ")
+	builder.WriteString("bundle_id=")
+	builder.WriteString(fmt.Sprintf("%v", iic.BundleID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", iic.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", iic.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("revenue=")
+	builder.WriteString(fmt.Sprintf("%v", iic.Revenue))
+	builder.WriteString(", ")
+	builder.WriteString("cost_total=")
+	builder.WriteString(fmt.Sprintf("%v", iic.CostTotal))
+	builder.WriteString(", ")
+	builder.WriteString("built_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", iic.BuiltQuantity))
+	builder.WriteString(", ")
+	builder.WriteString("built_unit_cost=")
+	builder.WriteString(fmt.Sprintf("%v", iic.BuiltUnitCost))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvoiceItemComponents is a parsable slice of InvoiceItemComponent.
+type InvoiceItemComponents []*InvoiceItemComponent
+
+func (iic InvoiceItemComponents) config(cfg config) {
+	for _i := range iic {
+		iic[_i].config = cfg
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoiceitemcomponent
+
+import (
+	"Veritasbackend/pkg/money"
+)
+
+const (
+	// Label holds the string label denoting the invoiceitemcomponent type in the database.
+	Label = "invoice_item_component"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldInvoiceID holds the string denoting the invoice_id field in the database.
+	FieldInvoiceID = "invoice_id"
+	// FieldInvoiceItemID holds the string denoting the invoice_item_id field in the database.
+	FieldInvoiceItemID = "invoice_item_id"
+	// FieldBundleID holds the string denoting the bundle_id field in the database.
+	FieldBundleID = "bundle_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldRevenue holds the string denoting the revenue field in the database.
+	FieldRevenue = "revenue"
+	// FieldCostTotal holds the string denoting the cost_total field in the database.
+	FieldCostTotal = "cost_total"
+	// FieldBuiltQuantity holds the string denoting the built_quantity field in the database.
+	FieldBuiltQuantity = "built_quantity"
+	// FieldBuiltUnitCost holds the string denoting the built_unit_cost field in the database.
+	FieldBuiltUnitCost = "built_unit_cost"
+	// Table holds the table name of the invoiceitemcomponent in the database.
+	Table = "invoice_item_components"
+)
+
+// Columns holds all SQL columns for invoiceitemcomponent fields.
+var Columns = []string{
+	FieldID,
+	FieldInvoiceID,
+	FieldInvoiceItemID,
+	FieldBundleID,
+	FieldProductID,
+	FieldQuantity,
+	FieldRevenue,
+	FieldCostTotal,
+	FieldBuiltQuantity,
+	FieldBuiltUnitCost,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(float64) error
+	// DefaultRevenue holds the default value on creation for the "revenue" field.
+	DefaultRevenue money.Amount
+	// DefaultCostTotal holds the default value on creation for the "cost_total" field.
+	DefaultCostTotal money.Amount
+	// DefaultBuiltQuantity holds the default value on creation for the "built_quantity" field.
+	DefaultBuiltQuantity float64
+	// DefaultBuiltUnitCost holds the default value on creation for the "built_unit_cost" field.
+	DefaultBuiltUnitCost money.Amount
+)
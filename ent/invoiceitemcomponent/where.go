@@ -0,0 +1,860 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoiceitemcomponent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceItemID applies equality check predicate on the "invoice_item_id" field. It's identical to InvoiceItemIDEQ.
+func InvoiceItemID(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInvoiceItemID), v))
+	})
+}
+
+// BundleID applies equality check predicate on the "bundle_id" field. It's identical to BundleIDEQ.
+func BundleID(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBundleID), v))
+	})
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v float64) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// Revenue applies equality check predicate on the "revenue" field. It's identical to RevenueEQ.
+func Revenue(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevenue), v))
+	})
+}
+
+// CostTotal applies equality check predicate on the "cost_total" field. It's identical to CostTotalEQ.
+func CostTotal(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCostTotal), v))
+	})
+}
+
+// BuiltQuantity applies equality check predicate on the "built_quantity" field. It's identical to BuiltQuantityEQ.
+func BuiltQuantity(v float64) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBuiltQuantity), v))
+	})
+}
+
+// BuiltUnitCost applies equality check predicate on the "built_unit_cost" field. It's identical to BuiltUnitCostEQ.
+func BuiltUnitCost(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBuiltUnitCost), v))
+	})
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...int) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldInvoiceID), v...))
+	})
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...int) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldInvoiceID), v...))
+	})
+}
+
+// InvoiceIDGT applies the GT predicate on the "invoice_id" field.
+func InvoiceIDGT(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDGTE applies the GTE predicate on the "invoice_id" field.
+func InvoiceIDGTE(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDLT applies the LT predicate on the "invoice_id" field.
+func InvoiceIDLT(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDLTE applies the LTE predicate on the "invoice_id" field.
+func InvoiceIDLTE(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceItemIDEQ applies the EQ predicate on the "invoice_item_id" field.
+func InvoiceItemIDEQ(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInvoiceItemID), v))
+	})
+}
+
+// InvoiceItemIDNEQ applies the NEQ predicate on the "invoice_item_id" field.
+func InvoiceItemIDNEQ(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldInvoiceItemID), v))
+	})
+}
+
+// InvoiceItemIDIn applies the In predicate on the "invoice_item_id" field.
+func InvoiceItemIDIn(vs ...int) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldInvoiceItemID), v...))
+	})
+}
+
+// InvoiceItemIDNotIn applies the NotIn predicate on the "invoice_item_id" field.
+func InvoiceItemIDNotIn(vs ...int) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldInvoiceItemID), v...))
+	})
+}
+
+// InvoiceItemIDGT applies the GT predicate on the "invoice_item_id" field.
+func InvoiceItemIDGT(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldInvoiceItemID), v))
+	})
+}
+
+// InvoiceItemIDGTE applies the GTE predicate on the "invoice_item_id" field.
+func InvoiceItemIDGTE(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldInvoiceItemID), v))
+	})
+}
+
+// InvoiceItemIDLT applies the LT predicate on the "invoice_item_id" field.
+func InvoiceItemIDLT(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldInvoiceItemID), v))
+	})
+}
+
+// InvoiceItemIDLTE applies the LTE predicate on the "invoice_item_id" field.
+func InvoiceItemIDLTE(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldInvoiceItemID), v))
+	})
+}
+
+// BundleIDEQ applies the EQ predicate on the "bundle_id" field.
+func BundleIDEQ(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBundleID), v))
+	})
+}
+
+// BundleIDNEQ applies the NEQ predicate on the "bundle_id" field.
+func BundleIDNEQ(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBundleID), v))
+	})
+}
+
+// BundleIDIn applies the In predicate on the "bundle_id" field.
+func BundleIDIn(vs ...int) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBundleID), v...))
+	})
+}
+
+// BundleIDNotIn applies the NotIn predicate on the "bundle_id" field.
+func BundleIDNotIn(vs ...int) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBundleID), v...))
+	})
+}
+
+// BundleIDGT applies the GT predicate on the "bundle_id" field.
+func BundleIDGT(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBundleID), v))
+	})
+}
+
+// BundleIDGTE applies the GTE predicate on the "bundle_id" field.
+func BundleIDGTE(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBundleID), v))
+	})
+}
+
+// BundleIDLT applies the LT predicate on the "bundle_id" field.
+func BundleIDLT(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBundleID), v))
+	})
+}
+
+// BundleIDLTE applies the LTE predicate on the "bundle_id" field.
+func BundleIDLTE(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBundleID), v))
+	})
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v int) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProductID), v))
+	})
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v float64) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v float64) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...float64) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...float64) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v float64) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v float64) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v float64) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v float64) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldQuantity), v))
+	})
+}
+
+// RevenueEQ applies the EQ predicate on the "revenue" field.
+func RevenueEQ(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevenue), v))
+	})
+}
+
+// RevenueNEQ applies the NEQ predicate on the "revenue" field.
+func RevenueNEQ(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRevenue), v))
+	})
+}
+
+// RevenueIn applies the In predicate on the "revenue" field.
+func RevenueIn(vs ...money.Amount) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRevenue), v...))
+	})
+}
+
+// RevenueNotIn applies the NotIn predicate on the "revenue" field.
+func RevenueNotIn(vs ...money.Amount) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRevenue), v...))
+	})
+}
+
+// RevenueGT applies the GT predicate on the "revenue" field.
+func RevenueGT(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRevenue), v))
+	})
+}
+
+// RevenueGTE applies the GTE predicate on the "revenue" field.
+func RevenueGTE(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRevenue), v))
+	})
+}
+
+// RevenueLT applies the LT predicate on the "revenue" field.
+func RevenueLT(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRevenue), v))
+	})
+}
+
+// RevenueLTE applies the LTE predicate on the "revenue" field.
+func RevenueLTE(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRevenue), v))
+	})
+}
+
+// CostTotalEQ applies the EQ predicate on the "cost_total" field.
+func CostTotalEQ(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCostTotal), v))
+	})
+}
+
+// CostTotalNEQ applies the NEQ predicate on the "cost_total" field.
+func CostTotalNEQ(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCostTotal), v))
+	})
+}
+
+// CostTotalIn applies the In predicate on the "cost_total" field.
+func CostTotalIn(vs ...money.Amount) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCostTotal), v...))
+	})
+}
+
+// CostTotalNotIn applies the NotIn predicate on the "cost_total" field.
+func CostTotalNotIn(vs ...money.Amount) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCostTotal), v...))
+	})
+}
+
+// CostTotalGT applies the GT predicate on the "cost_total" field.
+func CostTotalGT(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCostTotal), v))
+	})
+}
+
+// CostTotalGTE applies the GTE predicate on the "cost_total" field.
+func CostTotalGTE(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCostTotal), v))
+	})
+}
+
+// CostTotalLT applies the LT predicate on the "cost_total" field.
+func CostTotalLT(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCostTotal), v))
+	})
+}
+
+// CostTotalLTE applies the LTE predicate on the "cost_total" field.
+func CostTotalLTE(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCostTotal), v))
+	})
+}
+
+// BuiltQuantityEQ applies the EQ predicate on the "built_quantity" field.
+func BuiltQuantityEQ(v float64) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBuiltQuantity), v))
+	})
+}
+
+// BuiltQuantityNEQ applies the NEQ predicate on the "built_quantity" field.
+func BuiltQuantityNEQ(v float64) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBuiltQuantity), v))
+	})
+}
+
+// BuiltQuantityIn applies the In predicate on the "built_quantity" field.
+func BuiltQuantityIn(vs ...float64) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBuiltQuantity), v...))
+	})
+}
+
+// BuiltQuantityNotIn applies the NotIn predicate on the "built_quantity" field.
+func BuiltQuantityNotIn(vs ...float64) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBuiltQuantity), v...))
+	})
+}
+
+// BuiltQuantityGT applies the GT predicate on the "built_quantity" field.
+func BuiltQuantityGT(v float64) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBuiltQuantity), v))
+	})
+}
+
+// BuiltQuantityGTE applies the GTE predicate on the "built_quantity" field.
+func BuiltQuantityGTE(v float64) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBuiltQuantity), v))
+	})
+}
+
+// BuiltQuantityLT applies the LT predicate on the "built_quantity" field.
+func BuiltQuantityLT(v float64) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBuiltQuantity), v))
+	})
+}
+
+// BuiltQuantityLTE applies the LTE predicate on the "built_quantity" field.
+func BuiltQuantityLTE(v float64) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBuiltQuantity), v))
+	})
+}
+
+// BuiltUnitCostEQ applies the EQ predicate on the "built_unit_cost" field.
+func BuiltUnitCostEQ(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBuiltUnitCost), v))
+	})
+}
+
+// BuiltUnitCostNEQ applies the NEQ predicate on the "built_unit_cost" field.
+func BuiltUnitCostNEQ(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBuiltUnitCost), v))
+	})
+}
+
+// BuiltUnitCostIn applies the In predicate on the "built_unit_cost" field.
+func BuiltUnitCostIn(vs ...money.Amount) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBuiltUnitCost), v...))
+	})
+}
+
+// BuiltUnitCostNotIn applies the NotIn predicate on the "built_unit_cost" field.
+func BuiltUnitCostNotIn(vs ...money.Amount) predicate.InvoiceItemComponent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBuiltUnitCost), v...))
+	})
+}
+
+// BuiltUnitCostGT applies the GT predicate on the "built_unit_cost" field.
+func BuiltUnitCostGT(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBuiltUnitCost), v))
+	})
+}
+
+// BuiltUnitCostGTE applies the GTE predicate on the "built_unit_cost" field.
+func BuiltUnitCostGTE(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBuiltUnitCost), v))
+	})
+}
+
+// BuiltUnitCostLT applies the LT predicate on the "built_unit_cost" field.
+func BuiltUnitCostLT(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBuiltUnitCost), v))
+	})
+}
+
+// BuiltUnitCostLTE applies the LTE predicate on the "built_unit_cost" field.
+func BuiltUnitCostLTE(v money.Amount) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBuiltUnitCost), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InvoiceItemComponent) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InvoiceItemComponent) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InvoiceItemComponent) predicate.InvoiceItemComponent {
+	return predicate.InvoiceItemComponent(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,422 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoiceitemcomponent"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceItemComponentCreate is the builder for creating a InvoiceItemComponent entity.
+type InvoiceItemComponentCreate struct {
+	config
+	mutation *InvoiceItemComponentMutation
+	hooks    []Hook
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (iicc *InvoiceItemComponentCreate) SetInvoiceID(i int) *InvoiceItemComponentCreate {
+	iicc.mutation.SetInvoiceID(i)
+	return iicc
+}
+
+// SetInvoiceItemID sets the "invoice_item_id" field.
+func (iicc *InvoiceItemComponentCreate) SetInvoiceItemID(i int) *InvoiceItemComponentCreate {
+	iicc.mutation.SetInvoiceItemID(i)
+	return iicc
+}
+
+// SetBundleID sets the "bundle_id" field.
+func (iicc *InvoiceItemComponentCreate) SetBundleID(i int) *InvoiceItemComponentCreate {
+	iicc.mutation.SetBundleID(i)
+	return iicc
+}
+
+// SetProductID sets the "product_id" field.
+func (iicc *InvoiceItemComponentCreate) SetProductID(i int) *InvoiceItemComponentCreate {
+	iicc.mutation.SetProductID(i)
+	return iicc
+}
+
+// SetQuantity sets the "quantity" field.
+func (iicc *InvoiceItemComponentCreate) SetQuantity(f float64) *InvoiceItemComponentCreate {
+	iicc.mutation.SetQuantity(f)
+	return iicc
+}
+
+// SetRevenue sets the "revenue" field.
+func (iicc *InvoiceItemComponentCreate) SetRevenue(m money.Amount) *InvoiceItemComponentCreate {
+	iicc.mutation.SetRevenue(m)
+	return iicc
+}
+
+// SetNillableRevenue sets the "revenue" field if the given value is not nil.
+func (iicc *InvoiceItemComponentCreate) SetNillableRevenue(m *money.Amount) *InvoiceItemComponentCreate {
+	if m != nil {
+		iicc.SetRevenue(*m)
+	}
+	return iicc
+}
+
+// SetCostTotal sets the "cost_total" field.
+func (iicc *InvoiceItemComponentCreate) SetCostTotal(m money.Amount) *InvoiceItemComponentCreate {
+	iicc.mutation.SetCostTotal(m)
+	return iicc
+}
+
+// SetNillableCostTotal sets the "cost_total" field if the given value is not nil.
+func (iicc *InvoiceItemComponentCreate) SetNillableCostTotal(m *money.Amount) *InvoiceItemComponentCreate {
+	if m != nil {
+		iicc.SetCostTotal(*m)
+	}
+	return iicc
+}
+
+// SetBuiltQuantity sets the "built_quantity" field.
+func (iicc *InvoiceItemComponentCreate) SetBuiltQuantity(f float64) *InvoiceItemComponentCreate {
+	iicc.mutation.SetBuiltQuantity(f)
+	return iicc
+}
+
+// SetNillableBuiltQuantity sets the "built_quantity" field if the given value is not nil.
+func (iicc *InvoiceItemComponentCreate) SetNillableBuiltQuantity(f *float64) *InvoiceItemComponentCreate {
+	if f != nil {
+		iicc.SetBuiltQuantity(*f)
+	}
+	return iicc
+}
+
+// SetBuiltUnitCost sets the "built_unit_cost" field.
+func (iicc *InvoiceItemComponentCreate) SetBuiltUnitCost(m money.Amount) *InvoiceItemComponentCreate {
+	iicc.mutation.SetBuiltUnitCost(m)
+	return iicc
+}
+
+// SetNillableBuiltUnitCost sets the "built_unit_cost" field if the given value is not nil.
+func (iicc *InvoiceItemComponentCreate) SetNillableBuiltUnitCost(m *money.Amount) *InvoiceItemComponentCreate {
+	if m != nil {
+		iicc.SetBuiltUnitCost(*m)
+	}
+	return iicc
+}
+
+// Mutation returns the InvoiceItemComponentMutation object of the builder.
+func (iicc *InvoiceItemComponentCreate) Mutation() *InvoiceItemComponentMutation {
+	return iicc.mutation
+}
+
+// Save creates the InvoiceItemComponent in the database.
+func (iicc *InvoiceItemComponentCreate) Save(ctx context.Context) (*InvoiceItemComponent, error) {
+	var (
+		err  error
+		node *InvoiceItemComponent
+	)
+	iicc.defaults()
+	if len(iicc.hooks) == 0 {
+		if err = iicc.check(); err != nil {
+			return nil, err
+		}
+		node, err = iicc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceItemComponentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iicc.check(); err != nil {
+				return nil, err
+			}
+			iicc.mutation = mutation
+			if node, err = iicc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iicc.hooks) - 1; i >= 0; i-- {
+			if iicc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iicc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, iicc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InvoiceItemComponent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InvoiceItemComponentMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iicc *InvoiceItemComponentCreate) SaveX(ctx context.Context) *InvoiceItemComponent {
+	v, err := iicc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iicc *InvoiceItemComponentCreate) Exec(ctx context.Context) error {
+	_, err := iicc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iicc *InvoiceItemComponentCreate) ExecX(ctx context.Context) {
+	if err := iicc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iicc *InvoiceItemComponentCreate) defaults() {
+	if _, ok := iicc.mutation.Revenue(); !ok {
+		v := invoiceitemcomponent.DefaultRevenue
+		iicc.mutation.SetRevenue(v)
+	}
+	if _, ok := iicc.mutation.CostTotal(); !ok {
+		v := invoiceitemcomponent.DefaultCostTotal
+		iicc.mutation.SetCostTotal(v)
+	}
+	if _, ok := iicc.mutation.BuiltQuantity(); !ok {
+		v := invoiceitemcomponent.DefaultBuiltQuantity
+		iicc.mutation.SetBuiltQuantity(v)
+	}
+	if _, ok := iicc.mutation.BuiltUnitCost(); !ok {
+		v := invoiceitemcomponent.DefaultBuiltUnitCost
+		iicc.mutation.SetBuiltUnitCost(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iicc *InvoiceItemComponentCreate) check() error {
+	if _, ok := iicc.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`ent: missing required field "InvoiceItemComponent.invoice_id"`)}
+	}
+	if _, ok := iicc.mutation.InvoiceItemID(); !ok {
+		return &ValidationError{Name: "invoice_item_id", err: errors.New(`ent: missing required field "InvoiceItemComponent.invoice_item_id"`)}
+	}
+	if _, ok := iicc.mutation.BundleID(); !ok {
+		return &ValidationError{Name: "bundle_id", err: errors.New(`ent: missing required field "InvoiceItemComponent.bundle_id"`)}
+	}
+	if _, ok := iicc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "InvoiceItemComponent.product_id"`)}
+	}
+	if _, ok := iicc.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "InvoiceItemComponent.quantity"`)}
+	}
+	if v, ok := iicc.mutation.Quantity(); ok {
+		if err := invoiceitemcomponent.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "InvoiceItemComponent.quantity": %w`, err)}
+		}
+	}
+	if _, ok := iicc.mutation.Revenue(); !ok {
+		return &ValidationError{Name: "revenue", err: errors.New(`ent: missing required field "InvoiceItemComponent.revenue"`)}
+	}
+	if _, ok := iicc.mutation.CostTotal(); !ok {
+		return &ValidationError{Name: "cost_total", err: errors.New(`ent: missing required field "InvoiceItemComponent.cost_total"`)}
+	}
+	if _, ok := iicc.mutation.BuiltQuantity(); !ok {
+		return &ValidationError{Name: "built_quantity", err: errors.New(`ent: missing required field "InvoiceItemComponent.built_quantity"`)}
+	}
+	if _, ok := iicc.mutation.BuiltUnitCost(); !ok {
+		return &ValidationError{Name: "built_unit_cost", err: errors.New(`ent: missing required field "InvoiceItemComponent.built_unit_cost"`)}
+	}
+	return nil
+}
+
+func (iicc *InvoiceItemComponentCreate) sqlSave(ctx context.Context) (*InvoiceItemComponent, error) {
+	_node, _spec := iicc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iicc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (iicc *InvoiceItemComponentCreate) createSpec() (*InvoiceItemComponent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvoiceItemComponent{config: iicc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: invoiceitemcomponent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoiceitemcomponent.FieldID,
+			},
+		}
+	)
+	if value, ok := iicc.mutation.InvoiceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldInvoiceID,
+		})
+		_node.InvoiceID = value
+	}
+	if value, ok := iicc.mutation.InvoiceItemID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldInvoiceItemID,
+		})
+		_node.InvoiceItemID = value
+	}
+	if value, ok := iicc.mutation.BundleID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldBundleID,
+		})
+		_node.BundleID = value
+	}
+	if value, ok := iicc.mutation.ProductID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldProductID,
+		})
+		_node.ProductID = value
+	}
+	if value, ok := iicc.mutation.Quantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldQuantity,
+		})
+		_node.Quantity = value
+	}
+	if value, ok := iicc.mutation.Revenue(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldRevenue,
+		})
+		_node.Revenue = value
+	}
+	if value, ok := iicc.mutation.CostTotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldCostTotal,
+		})
+		_node.CostTotal = value
+	}
+	if value, ok := iicc.mutation.BuiltQuantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldBuiltQuantity,
+		})
+		_node.BuiltQuantity = value
+	}
+	if value, ok := iicc.mutation.BuiltUnitCost(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldBuiltUnitCost,
+		})
+		_node.BuiltUnitCost = value
+	}
+	return _node, _spec
+}
+
+// InvoiceItemComponentCreateBulk is the builder for creating many InvoiceItemComponent entities in bulk.
+type InvoiceItemComponentCreateBulk struct {
+	config
+	builders []*InvoiceItemComponentCreate
+}
+
+// Save creates the InvoiceItemComponent entities in the database.
+func (iiccb *InvoiceItemComponentCreateBulk) Save(ctx context.Context) ([]*InvoiceItemComponent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(iiccb.builders))
+	nodes := make([]*InvoiceItemComponent, len(iiccb.builders))
+	mutators := make([]Mutator, len(iiccb.builders))
+	for i := range iiccb.builders {
+		func(i int, root context.Context) {
+			builder := iiccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceItemComponentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iiccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iiccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iiccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iiccb *InvoiceItemComponentCreateBulk) SaveX(ctx context.Context) []*InvoiceItemComponent {
+	v, err := iiccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iiccb *InvoiceItemComponentCreateBulk) Exec(ctx context.Context) error {
+	_, err := iiccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iiccb *InvoiceItemComponentCreateBulk) ExecX(ctx context.Context) {
+	if err := iiccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoiceitemcomponent"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceItemComponentDelete is the builder for deleting a InvoiceItemComponent entity.
+type InvoiceItemComponentDelete struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceItemComponentMutation
+}
+
+// Where appends a list predicates to the InvoiceItemComponentDelete builder.
+func (iicd *InvoiceItemComponentDelete) Where(ps ...predicate.InvoiceItemComponent) *InvoiceItemComponentDelete {
+	iicd.mutation.Where(ps...)
+	return iicd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (iicd *InvoiceItemComponentDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(iicd.hooks) == 0 {
+		affected, err = iicd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceItemComponentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iicd.mutation = mutation
+			affected, err = iicd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iicd.hooks) - 1; i >= 0; i-- {
+			if iicd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iicd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iicd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iicd *InvoiceItemComponentDelete) ExecX(ctx context.Context) int {
+	n, err := iicd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (iicd *InvoiceItemComponentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: invoiceitemcomponent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoiceitemcomponent.FieldID,
+			},
+		},
+	}
+	if ps := iicd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, iicd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// InvoiceItemComponentDeleteOne is the builder for deleting a single InvoiceItemComponent entity.
+type InvoiceItemComponentDeleteOne struct {
+	iicd *InvoiceItemComponentDelete
+}
+
+// Exec executes the deletion query.
+func (iicdo *InvoiceItemComponentDeleteOne) Exec(ctx context.Context) error {
+	n, err := iicdo.iicd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{invoiceitemcomponent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iicdo *InvoiceItemComponentDeleteOne) ExecX(ctx context.Context) {
+	iicdo.iicd.ExecX(ctx)
+}
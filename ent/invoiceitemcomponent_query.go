@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoiceitemcomponent"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceItemComponentQuery is the builder for querying InvoiceItemComponent entities.
+type InvoiceItemComponentQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.InvoiceItemComponent
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InvoiceItemComponentQuery builder.
+func (iicq *InvoiceItemComponentQuery) Where(ps ...predicate.InvoiceItemComponent) *InvoiceItemComponentQuery {
+	iicq.predicates = append(iicq.predicates, ps...)
+	return iicq
+}
+
+// Limit adds a limit step to the query.
+func (iicq *InvoiceItemComponentQuery) Limit(limit int) *InvoiceItemComponentQuery {
+	iicq.limit = &limit
+	return iicq
+}
+
+// Offset adds an offset step to the query.
+func (iicq *InvoiceItemComponentQuery) Offset(offset int) *InvoiceItemComponentQuery {
+	iicq.offset = &offset
+	return iicq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iicq *InvoiceItemComponentQuery) Unique(unique bool) *InvoiceItemComponentQuery {
+	iicq.unique = &unique
+	return iicq
+}
+
+// Order adds an order step to the query.
+func (iicq *InvoiceItemComponentQuery) Order(o ...OrderFunc) *InvoiceItemComponentQuery {
+	iicq.order = append(iicq.order, o...)
+	return iicq
+}
+
+// First returns the first InvoiceItemComponent entity from the query.
+// Returns a *NotFoundError when no InvoiceItemComponent was found.
+func (iicq *InvoiceItemComponentQuery) First(ctx context.Context) (*InvoiceItemComponent, error) {
+	nodes, err := iicq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{invoiceitemcomponent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iicq *InvoiceItemComponentQuery) FirstX(ctx context.Context) *InvoiceItemComponent {
+	node, err := iicq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InvoiceItemComponent ID from the query.
+// Returns a *NotFoundError when no InvoiceItemComponent ID was found.
+func (iicq *InvoiceItemComponentQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iicq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{invoiceitemcomponent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iicq *InvoiceItemComponentQuery) FirstIDX(ctx context.Context) int {
+	id, err := iicq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InvoiceItemComponent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InvoiceItemComponent entity is found.
+// Returns a *NotFoundError when no InvoiceItemComponent entities are found.
+func (iicq *InvoiceItemComponentQuery) Only(ctx context.Context) (*InvoiceItemComponent, error) {
+	nodes, err := iicq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{invoiceitemcomponent.Label}
+	default:
+		return nil, &NotSingularError{invoiceitemcomponent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iicq *InvoiceItemComponentQuery) OnlyX(ctx context.Context) *InvoiceItemComponent {
+	node, err := iicq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InvoiceItemComponent ID in the query.
+// Returns a *NotSingularError when more than one InvoiceItemComponent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iicq *InvoiceItemComponentQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iicq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{invoiceitemcomponent.Label}
+	default:
+		err = &NotSingularError{invoiceitemcomponent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iicq *InvoiceItemComponentQuery) OnlyIDX(ctx context.Context) int {
+	id, err := iicq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InvoiceItemComponents.
+func (iicq *InvoiceItemComponentQuery) All(ctx context.Context) ([]*InvoiceItemComponent, error) {
+	if err := iicq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return iicq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iicq *InvoiceItemComponentQuery) AllX(ctx context.Context) []*InvoiceItemComponent {
+	nodes, err := iicq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InvoiceItemComponent IDs.
+func (iicq *InvoiceItemComponentQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := iicq.Select(invoiceitemcomponent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iicq *InvoiceItemComponentQuery) IDsX(ctx context.Context) []int {
+	ids, err := iicq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iicq *InvoiceItemComponentQuery) Count(ctx context.Context) (int, error) {
+	if err := iicq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return iicq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iicq *InvoiceItemComponentQuery) CountX(ctx context.Context) int {
+	count, err := iicq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iicq *InvoiceItemComponentQuery) Exist(ctx context.Context) (bool, error) {
+	if err := iicq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return iicq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iicq *InvoiceItemComponentQuery) ExistX(ctx context.Context) bool {
+	exist, err := iicq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InvoiceItemComponentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iicq *InvoiceItemComponentQuery) Clone() *InvoiceItemComponentQuery {
+	if iicq == nil {
+		return nil
+	}
+	return &InvoiceItemComponentQuery{
+		config:     iicq.config,
+		limit:      iicq.limit,
+		offset:     iicq.offset,
+		order:      append([]OrderFunc{}, iicq.order...),
+		predicates: append([]predicate.InvoiceItemComponent{}, iicq.predicates...),
+		// clone intermediate query.
+		sql:    iicq.sql.Clone(),
+		path:   iicq.path,
+		unique: iicq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		InvoiceID int `json:"invoice_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InvoiceItemComponent.Query().
+//		GroupBy(invoiceitemcomponent.FieldInvoiceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (iicq *InvoiceItemComponentQuery) GroupBy(field string, fields ...string) *InvoiceItemComponentGroupBy {
+	grbuild := &InvoiceItemComponentGroupBy{config: iicq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := iicq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return iicq.sqlQuery(ctx), nil
+	}
+	grbuild.label = invoiceitemcomponent.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		InvoiceID int `json:"invoice_id,omitempty"`
+//	}
+//
+//	client.InvoiceItemComponent.Query().
+//		Select(invoiceitemcomponent.FieldInvoiceID).
+//		Scan(ctx, &v)
+func (iicq *InvoiceItemComponentQuery) Select(fields ...string) *InvoiceItemComponentSelect {
+	iicq.fields = append(iicq.fields, fields...)
+	selbuild := &InvoiceItemComponentSelect{InvoiceItemComponentQuery: iicq}
+	selbuild.label = invoiceitemcomponent.Label
+	selbuild.flds, selbuild.scan = &iicq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (iicq *InvoiceItemComponentQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range iicq.fields {
+		if !invoiceitemcomponent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if iicq.path != nil {
+		prev, err := iicq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iicq.sql = prev
+	}
+	return nil
+}
+
+func (iicq *InvoiceItemComponentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InvoiceItemComponent, error) {
+	var (
+		nodes = []*InvoiceItemComponent{}
+		_spec = iicq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*InvoiceItemComponent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &InvoiceItemComponent{config: iicq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(iicq.modifiers) > 0 {
+		_spec.Modifiers = iicq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iicq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (iicq *InvoiceItemComponentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iicq.querySpec()
+	if len(iicq.modifiers) > 0 {
+		_spec.Modifiers = iicq.modifiers
+	}
+	_spec.Node.Columns = iicq.fields
+	if len(iicq.fields) > 0 {
+		_spec.Unique = iicq.unique != nil && *iicq.unique
+	}
+	return sqlgraph.CountNodes(ctx, iicq.driver, _spec)
+}
+
+func (iicq *InvoiceItemComponentQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := iicq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (iicq *InvoiceItemComponentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoiceitemcomponent.Table,
+			Columns: invoiceitemcomponent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoiceitemcomponent.FieldID,
+			},
+		},
+		From:   iicq.sql,
+		Unique: true,
+	}
+	if unique := iicq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := iicq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoiceitemcomponent.FieldID)
+		for i := range fields {
+			if fields[i] != invoiceitemcomponent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := iicq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iicq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iicq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iicq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iicq *InvoiceItemComponentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iicq.driver.Dialect())
+	t1 := builder.Table(invoiceitemcomponent.Table)
+	columns := iicq.fields
+	if len(columns) == 0 {
+		columns = invoiceitemcomponent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iicq.sql != nil {
+		selector = iicq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iicq.unique != nil && *iicq.unique {
+		selector.Distinct()
+	}
+	for _, m := range iicq.modifiers {
+		m(selector)
+	}
+	for _, p := range iicq.predicates {
+		p(selector)
+	}
+	for _, p := range iicq.order {
+		p(selector)
+	}
+	if offset := iicq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iicq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (iicq *InvoiceItemComponentQuery) ForUpdate(opts ...sql.LockOption) *InvoiceItemComponentQuery {
+	if iicq.driver.Dialect() == dialect.Postgres {
+		iicq.Unique(false)
+	}
+	iicq.modifiers = append(iicq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return iicq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (iicq *InvoiceItemComponentQuery) ForShare(opts ...sql.LockOption) *InvoiceItemComponentQuery {
+	if iicq.driver.Dialect() == dialect.Postgres {
+		iicq.Unique(false)
+	}
+	iicq.modifiers = append(iicq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return iicq
+}
+
+// InvoiceItemComponentGroupBy is the group-by builder for InvoiceItemComponent entities.
+type InvoiceItemComponentGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iicgb *InvoiceItemComponentGroupBy) Aggregate(fns ...AggregateFunc) *InvoiceItemComponentGroupBy {
+	iicgb.fns = append(iicgb.fns, fns...)
+	return iicgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (iicgb *InvoiceItemComponentGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := iicgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	iicgb.sql = query
+	return iicgb.sqlScan(ctx, v)
+}
+
+func (iicgb *InvoiceItemComponentGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range iicgb.fields {
+		if !invoiceitemcomponent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := iicgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iicgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (iicgb *InvoiceItemComponentGroupBy) sqlQuery() *sql.Selector {
+	selector := iicgb.sql.Select()
+	aggregation := make([]string, 0, len(iicgb.fns))
+	for _, fn := range iicgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(iicgb.fields)+len(iicgb.fns))
+		for _, f := range iicgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(iicgb.fields...)...)
+}
+
+// InvoiceItemComponentSelect is the builder for selecting fields of InvoiceItemComponent entities.
+type InvoiceItemComponentSelect struct {
+	*InvoiceItemComponentQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iics *InvoiceItemComponentSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := iics.prepareQuery(ctx); err != nil {
+		return err
+	}
+	iics.sql = iics.InvoiceItemComponentQuery.sqlQuery(ctx)
+	return iics.sqlScan(ctx, v)
+}
+
+func (iics *InvoiceItemComponentSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := iics.sql.Query()
+	if err := iics.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
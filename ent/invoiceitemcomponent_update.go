@@ -0,0 +1,828 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoiceitemcomponent"
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceItemComponentUpdate is the builder for updating InvoiceItemComponent entities.
+type InvoiceItemComponentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceItemComponentMutation
+}
+
+// Where appends a list predicates to the InvoiceItemComponentUpdate builder.
+func (iicu *InvoiceItemComponentUpdate) Where(ps ...predicate.InvoiceItemComponent) *InvoiceItemComponentUpdate {
+	iicu.mutation.Where(ps...)
+	return iicu
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (iicu *InvoiceItemComponentUpdate) SetInvoiceID(i int) *InvoiceItemComponentUpdate {
+	iicu.mutation.ResetInvoiceID()
+	iicu.mutation.SetInvoiceID(i)
+	return iicu
+}
+
+// AddInvoiceID adds i to the "invoice_id" field.
+func (iicu *InvoiceItemComponentUpdate) AddInvoiceID(i int) *InvoiceItemComponentUpdate {
+	iicu.mutation.AddInvoiceID(i)
+	return iicu
+}
+
+// SetInvoiceItemID sets the "invoice_item_id" field.
+func (iicu *InvoiceItemComponentUpdate) SetInvoiceItemID(i int) *InvoiceItemComponentUpdate {
+	iicu.mutation.ResetInvoiceItemID()
+	iicu.mutation.SetInvoiceItemID(i)
+	return iicu
+}
+
+// AddInvoiceItemID adds i to the "invoice_item_id" field.
+func (iicu *InvoiceItemComponentUpdate) AddInvoiceItemID(i int) *InvoiceItemComponentUpdate {
+	iicu.mutation.AddInvoiceItemID(i)
+	return iicu
+}
+
+// SetBundleID sets the "bundle_id" field.
+func (iicu *InvoiceItemComponentUpdate) SetBundleID(i int) *InvoiceItemComponentUpdate {
+	iicu.mutation.ResetBundleID()
+	iicu.mutation.SetBundleID(i)
+	return iicu
+}
+
+// AddBundleID adds i to the "bundle_id" field.
+func (iicu *InvoiceItemComponentUpdate) AddBundleID(i int) *InvoiceItemComponentUpdate {
+	iicu.mutation.AddBundleID(i)
+	return iicu
+}
+
+// SetProductID sets the "product_id" field.
+func (iicu *InvoiceItemComponentUpdate) SetProductID(i int) *InvoiceItemComponentUpdate {
+	iicu.mutation.ResetProductID()
+	iicu.mutation.SetProductID(i)
+	return iicu
+}
+
+// AddProductID adds i to the "product_id" field.
+func (iicu *InvoiceItemComponentUpdate) AddProductID(i int) *InvoiceItemComponentUpdate {
+	iicu.mutation.AddProductID(i)
+	return iicu
+}
+
+// SetQuantity sets the "quantity" field.
+func (iicu *InvoiceItemComponentUpdate) SetQuantity(f float64) *InvoiceItemComponentUpdate {
+	iicu.mutation.ResetQuantity()
+	iicu.mutation.SetQuantity(f)
+	return iicu
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (iicu *InvoiceItemComponentUpdate) AddQuantity(f float64) *InvoiceItemComponentUpdate {
+	iicu.mutation.AddQuantity(f)
+	return iicu
+}
+
+// SetRevenue sets the "revenue" field.
+func (iicu *InvoiceItemComponentUpdate) SetRevenue(m money.Amount) *InvoiceItemComponentUpdate {
+	iicu.mutation.ResetRevenue()
+	iicu.mutation.SetRevenue(m)
+	return iicu
+}
+
+// SetNillableRevenue sets the "revenue" field if the given value is not nil.
+func (iicu *InvoiceItemComponentUpdate) SetNillableRevenue(m *money.Amount) *InvoiceItemComponentUpdate {
+	if m != nil {
+		iicu.SetRevenue(*m)
+	}
+	return iicu
+}
+
+// AddRevenue adds m to the "revenue" field.
+func (iicu *InvoiceItemComponentUpdate) AddRevenue(m money.Amount) *InvoiceItemComponentUpdate {
+	iicu.mutation.AddRevenue(m)
+	return iicu
+}
+
+// SetCostTotal sets the "cost_total" field.
+func (iicu *InvoiceItemComponentUpdate) SetCostTotal(m money.Amount) *InvoiceItemComponentUpdate {
+	iicu.mutation.ResetCostTotal()
+	iicu.mutation.SetCostTotal(m)
+	return iicu
+}
+
+// SetNillableCostTotal sets the "cost_total" field if the given value is not nil.
+func (iicu *InvoiceItemComponentUpdate) SetNillableCostTotal(m *money.Amount) *InvoiceItemComponentUpdate {
+	if m != nil {
+		iicu.SetCostTotal(*m)
+	}
+	return iicu
+}
+
+// AddCostTotal adds m to the "cost_total" field.
+func (iicu *InvoiceItemComponentUpdate) AddCostTotal(m money.Amount) *InvoiceItemComponentUpdate {
+	iicu.mutation.AddCostTotal(m)
+	return iicu
+}
+
+// SetBuiltQuantity sets the "built_quantity" field.
+func (iicu *InvoiceItemComponentUpdate) SetBuiltQuantity(f float64) *InvoiceItemComponentUpdate {
+	iicu.mutation.ResetBuiltQuantity()
+	iicu.mutation.SetBuiltQuantity(f)
+	return iicu
+}
+
+// SetNillableBuiltQuantity sets the "built_quantity" field if the given value is not nil.
+func (iicu *InvoiceItemComponentUpdate) SetNillableBuiltQuantity(f *float64) *InvoiceItemComponentUpdate {
+	if f != nil {
+		iicu.SetBuiltQuantity(*f)
+	}
+	return iicu
+}
+
+// AddBuiltQuantity adds f to the "built_quantity" field.
+func (iicu *InvoiceItemComponentUpdate) AddBuiltQuantity(f float64) *InvoiceItemComponentUpdate {
+	iicu.mutation.AddBuiltQuantity(f)
+	return iicu
+}
+
+// SetBuiltUnitCost sets the "built_unit_cost" field.
+func (iicu *InvoiceItemComponentUpdate) SetBuiltUnitCost(m money.Amount) *InvoiceItemComponentUpdate {
+	iicu.mutation.ResetBuiltUnitCost()
+	iicu.mutation.SetBuiltUnitCost(m)
+	return iicu
+}
+
+// SetNillableBuiltUnitCost sets the "built_unit_cost" field if the given value is not nil.
+func (iicu *InvoiceItemComponentUpdate) SetNillableBuiltUnitCost(m *money.Amount) *InvoiceItemComponentUpdate {
+	if m != nil {
+		iicu.SetBuiltUnitCost(*m)
+	}
+	return iicu
+}
+
+// AddBuiltUnitCost adds m to the "built_unit_cost" field.
+func (iicu *InvoiceItemComponentUpdate) AddBuiltUnitCost(m money.Amount) *InvoiceItemComponentUpdate {
+	iicu.mutation.AddBuiltUnitCost(m)
+	return iicu
+}
+
+// Mutation returns the InvoiceItemComponentMutation object of the builder.
+func (iicu *InvoiceItemComponentUpdate) Mutation() *InvoiceItemComponentMutation {
+	return iicu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iicu *InvoiceItemComponentUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(iicu.hooks) == 0 {
+		if err = iicu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = iicu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceItemComponentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iicu.check(); err != nil {
+				return 0, err
+			}
+			iicu.mutation = mutation
+			affected, err = iicu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iicu.hooks) - 1; i >= 0; i-- {
+			if iicu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iicu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iicu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iicu *InvoiceItemComponentUpdate) SaveX(ctx context.Context) int {
+	affected, err := iicu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iicu *InvoiceItemComponentUpdate) Exec(ctx context.Context) error {
+	_, err := iicu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iicu *InvoiceItemComponentUpdate) ExecX(ctx context.Context) {
+	if err := iicu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iicu *InvoiceItemComponentUpdate) check() error {
+	if v, ok := iicu.mutation.Quantity(); ok {
+		if err := invoiceitemcomponent.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "InvoiceItemComponent.quantity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iicu *InvoiceItemComponentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoiceitemcomponent.Table,
+			Columns: invoiceitemcomponent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoiceitemcomponent.FieldID,
+			},
+		},
+	}
+	if ps := iicu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iicu.mutation.InvoiceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldInvoiceID,
+		})
+	}
+	if value, ok := iicu.mutation.AddedInvoiceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldInvoiceID,
+		})
+	}
+	if value, ok := iicu.mutation.InvoiceItemID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldInvoiceItemID,
+		})
+	}
+	if value, ok := iicu.mutation.AddedInvoiceItemID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldInvoiceItemID,
+		})
+	}
+	if value, ok := iicu.mutation.BundleID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldBundleID,
+		})
+	}
+	if value, ok := iicu.mutation.AddedBundleID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldBundleID,
+		})
+	}
+	if value, ok := iicu.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldProductID,
+		})
+	}
+	if value, ok := iicu.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldProductID,
+		})
+	}
+	if value, ok := iicu.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldQuantity,
+		})
+	}
+	if value, ok := iicu.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldQuantity,
+		})
+	}
+	if value, ok := iicu.mutation.Revenue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldRevenue,
+		})
+	}
+	if value, ok := iicu.mutation.AddedRevenue(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldRevenue,
+		})
+	}
+	if value, ok := iicu.mutation.CostTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldCostTotal,
+		})
+	}
+	if value, ok := iicu.mutation.AddedCostTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldCostTotal,
+		})
+	}
+	if value, ok := iicu.mutation.BuiltQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldBuiltQuantity,
+		})
+	}
+	if value, ok := iicu.mutation.AddedBuiltQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldBuiltQuantity,
+		})
+	}
+	if value, ok := iicu.mutation.BuiltUnitCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldBuiltUnitCost,
+		})
+	}
+	if value, ok := iicu.mutation.AddedBuiltUnitCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldBuiltUnitCost,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iicu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoiceitemcomponent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InvoiceItemComponentUpdateOne is the builder for updating a single InvoiceItemComponent entity.
+type InvoiceItemComponentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceItemComponentMutation
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (iicuo *InvoiceItemComponentUpdateOne) SetInvoiceID(i int) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.ResetInvoiceID()
+	iicuo.mutation.SetInvoiceID(i)
+	return iicuo
+}
+
+// AddInvoiceID adds i to the "invoice_id" field.
+func (iicuo *InvoiceItemComponentUpdateOne) AddInvoiceID(i int) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.AddInvoiceID(i)
+	return iicuo
+}
+
+// SetInvoiceItemID sets the "invoice_item_id" field.
+func (iicuo *InvoiceItemComponentUpdateOne) SetInvoiceItemID(i int) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.ResetInvoiceItemID()
+	iicuo.mutation.SetInvoiceItemID(i)
+	return iicuo
+}
+
+// AddInvoiceItemID adds i to the "invoice_item_id" field.
+func (iicuo *InvoiceItemComponentUpdateOne) AddInvoiceItemID(i int) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.AddInvoiceItemID(i)
+	return iicuo
+}
+
+// SetBundleID sets the "bundle_id" field.
+func (iicuo *InvoiceItemComponentUpdateOne) SetBundleID(i int) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.ResetBundleID()
+	iicuo.mutation.SetBundleID(i)
+	return iicuo
+}
+
+// AddBundleID adds i to the "bundle_id" field.
+func (iicuo *InvoiceItemComponentUpdateOne) AddBundleID(i int) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.AddBundleID(i)
+	return iicuo
+}
+
+// SetProductID sets the "product_id" field.
+func (iicuo *InvoiceItemComponentUpdateOne) SetProductID(i int) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.ResetProductID()
+	iicuo.mutation.SetProductID(i)
+	return iicuo
+}
+
+// AddProductID adds i to the "product_id" field.
+func (iicuo *InvoiceItemComponentUpdateOne) AddProductID(i int) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.AddProductID(i)
+	return iicuo
+}
+
+// SetQuantity sets the "quantity" field.
+func (iicuo *InvoiceItemComponentUpdateOne) SetQuantity(f float64) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.ResetQuantity()
+	iicuo.mutation.SetQuantity(f)
+	return iicuo
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (iicuo *InvoiceItemComponentUpdateOne) AddQuantity(f float64) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.AddQuantity(f)
+	return iicuo
+}
+
+// SetRevenue sets the "revenue" field.
+func (iicuo *InvoiceItemComponentUpdateOne) SetRevenue(m money.Amount) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.ResetRevenue()
+	iicuo.mutation.SetRevenue(m)
+	return iicuo
+}
+
+// SetNillableRevenue sets the "revenue" field if the given value is not nil.
+func (iicuo *InvoiceItemComponentUpdateOne) SetNillableRevenue(m *money.Amount) *InvoiceItemComponentUpdateOne {
+	if m != nil {
+		iicuo.SetRevenue(*m)
+	}
+	return iicuo
+}
+
+// AddRevenue adds m to the "revenue" field.
+func (iicuo *InvoiceItemComponentUpdateOne) AddRevenue(m money.Amount) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.AddRevenue(m)
+	return iicuo
+}
+
+// SetCostTotal sets the "cost_total" field.
+func (iicuo *InvoiceItemComponentUpdateOne) SetCostTotal(m money.Amount) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.ResetCostTotal()
+	iicuo.mutation.SetCostTotal(m)
+	return iicuo
+}
+
+// SetNillableCostTotal sets the "cost_total" field if the given value is not nil.
+func (iicuo *InvoiceItemComponentUpdateOne) SetNillableCostTotal(m *money.Amount) *InvoiceItemComponentUpdateOne {
+	if m != nil {
+		iicuo.SetCostTotal(*m)
+	}
+	return iicuo
+}
+
+// AddCostTotal adds m to the "cost_total" field.
+func (iicuo *InvoiceItemComponentUpdateOne) AddCostTotal(m money.Amount) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.AddCostTotal(m)
+	return iicuo
+}
+
+// SetBuiltQuantity sets the "built_quantity" field.
+func (iicuo *InvoiceItemComponentUpdateOne) SetBuiltQuantity(f float64) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.ResetBuiltQuantity()
+	iicuo.mutation.SetBuiltQuantity(f)
+	return iicuo
+}
+
+// SetNillableBuiltQuantity sets the "built_quantity" field if the given value is not nil.
+func (iicuo *InvoiceItemComponentUpdateOne) SetNillableBuiltQuantity(f *float64) *InvoiceItemComponentUpdateOne {
+	if f != nil {
+		iicuo.SetBuiltQuantity(*f)
+	}
+	return iicuo
+}
+
+// AddBuiltQuantity adds f to the "built_quantity" field.
+func (iicuo *InvoiceItemComponentUpdateOne) AddBuiltQuantity(f float64) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.AddBuiltQuantity(f)
+	return iicuo
+}
+
+// SetBuiltUnitCost sets the "built_unit_cost" field.
+func (iicuo *InvoiceItemComponentUpdateOne) SetBuiltUnitCost(m money.Amount) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.ResetBuiltUnitCost()
+	iicuo.mutation.SetBuiltUnitCost(m)
+	return iicuo
+}
+
+// SetNillableBuiltUnitCost sets the "built_unit_cost" field if the given value is not nil.
+func (iicuo *InvoiceItemComponentUpdateOne) SetNillableBuiltUnitCost(m *money.Amount) *InvoiceItemComponentUpdateOne {
+	if m != nil {
+		iicuo.SetBuiltUnitCost(*m)
+	}
+	return iicuo
+}
+
+// AddBuiltUnitCost adds m to the "built_unit_cost" field.
+func (iicuo *InvoiceItemComponentUpdateOne) AddBuiltUnitCost(m money.Amount) *InvoiceItemComponentUpdateOne {
+	iicuo.mutation.AddBuiltUnitCost(m)
+	return iicuo
+}
+
+// Mutation returns the InvoiceItemComponentMutation object of the builder.
+func (iicuo *InvoiceItemComponentUpdateOne) Mutation() *InvoiceItemComponentMutation {
+	return iicuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iicuo *InvoiceItemComponentUpdateOne) Select(field string, fields ...string) *InvoiceItemComponentUpdateOne {
+	iicuo.fields = append([]string{field}, fields...)
+	return iicuo
+}
+
+// Save executes the query and returns the updated InvoiceItemComponent entity.
+func (iicuo *InvoiceItemComponentUpdateOne) Save(ctx context.Context) (*InvoiceItemComponent, error) {
+	var (
+		err  error
+		node *InvoiceItemComponent
+	)
+	if len(iicuo.hooks) == 0 {
+		if err = iicuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = iicuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceItemComponentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iicuo.check(); err != nil {
+				return nil, err
+			}
+			iicuo.mutation = mutation
+			node, err = iicuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iicuo.hooks) - 1; i >= 0; i-- {
+			if iicuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iicuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, iicuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InvoiceItemComponent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InvoiceItemComponentMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iicuo *InvoiceItemComponentUpdateOne) SaveX(ctx context.Context) *InvoiceItemComponent {
+	node, err := iicuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iicuo *InvoiceItemComponentUpdateOne) Exec(ctx context.Context) error {
+	_, err := iicuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iicuo *InvoiceItemComponentUpdateOne) ExecX(ctx context.Context) {
+	if err := iicuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iicuo *InvoiceItemComponentUpdateOne) check() error {
+	if v, ok := iicuo.mutation.Quantity(); ok {
+		if err := invoiceitemcomponent.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "InvoiceItemComponent.quantity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iicuo *InvoiceItemComponentUpdateOne) sqlSave(ctx context.Context) (_node *InvoiceItemComponent, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoiceitemcomponent.Table,
+			Columns: invoiceitemcomponent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoiceitemcomponent.FieldID,
+			},
+		},
+	}
+	id, ok := iicuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InvoiceItemComponent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iicuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoiceitemcomponent.FieldID)
+		for _, f := range fields {
+			if !invoiceitemcomponent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoiceitemcomponent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iicuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iicuo.mutation.InvoiceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldInvoiceID,
+		})
+	}
+	if value, ok := iicuo.mutation.AddedInvoiceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldInvoiceID,
+		})
+	}
+	if value, ok := iicuo.mutation.InvoiceItemID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldInvoiceItemID,
+		})
+	}
+	if value, ok := iicuo.mutation.AddedInvoiceItemID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldInvoiceItemID,
+		})
+	}
+	if value, ok := iicuo.mutation.BundleID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldBundleID,
+		})
+	}
+	if value, ok := iicuo.mutation.AddedBundleID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldBundleID,
+		})
+	}
+	if value, ok := iicuo.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldProductID,
+		})
+	}
+	if value, ok := iicuo.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldProductID,
+		})
+	}
+	if value, ok := iicuo.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldQuantity,
+		})
+	}
+	if value, ok := iicuo.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldQuantity,
+		})
+	}
+	if value, ok := iicuo.mutation.Revenue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldRevenue,
+		})
+	}
+	if value, ok := iicuo.mutation.AddedRevenue(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldRevenue,
+		})
+	}
+	if value, ok := iicuo.mutation.CostTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldCostTotal,
+		})
+	}
+	if value, ok := iicuo.mutation.AddedCostTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldCostTotal,
+		})
+	}
+	if value, ok := iicuo.mutation.BuiltQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldBuiltQuantity,
+		})
+	}
+	if value, ok := iicuo.mutation.AddedBuiltQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldBuiltQuantity,
+		})
+	}
+	if value, ok := iicuo.mutation.BuiltUnitCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldBuiltUnitCost,
+		})
+	}
+	if value, ok := iicuo.mutation.AddedBuiltUnitCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoiceitemcomponent.FieldBuiltUnitCost,
+		})
+	}
+	_node = &InvoiceItemComponent{config: iicuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iicuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoiceitemcomponent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
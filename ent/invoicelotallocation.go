@@ -0,0 +1,132 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoicelotallocation"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// InvoiceLotAllocation is the model entity for the InvoiceLotAllocation schema.
+type InvoiceLotAllocation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID de la factura de venta
+	InvoiceID int `json:"invoice_id,omitempty"`
+	// ID del producto vendido
+	ProductID int `json:"product_id,omitempty"`
+	// ID del lote consumido
+	LotID int `json:"lot_id,omitempty"`
+	// Cantidad tomada del lote
+	Quantity float64 `json:"quantity,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvoiceLotAllocation) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoicelotallocation.FieldQuantity:
+			values[i] = new(sql.NullFloat64)
+		case invoicelotallocation.FieldID, invoicelotallocation.FieldInvoiceID, invoicelotallocation.FieldProductID, invoicelotallocation.FieldLotID:
+			values[i] = new(sql.NullInt64)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type InvoiceLotAllocation", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvoiceLotAllocation fields.
+func (ila *InvoiceLotAllocation) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoicelotallocation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ila.ID = int(value.Int64)
+		case invoicelotallocation.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				ila.InvoiceID = int(value.Int64)
+			}
+		case invoicelotallocation.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				ila.ProductID = int(value.Int64)
+			}
+		case invoicelotallocation.FieldLotID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lot_id", values[i])
+			} else if value.Valid {
+				ila.LotID = int(value.Int64)
+			}
+		case invoicelotallocation.FieldQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				ila.Quantity = value.Float64
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this InvoiceLotAllocation.
+// Note that you need to call InvoiceLotAllocation.Unwrap() before calling this method if this InvoiceLotAllocation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ila *InvoiceLotAllocation) Update() *InvoiceLotAllocationUpdateOne {
+	return (&InvoiceLotAllocationClient{config: ila.config}).UpdateOne(ila)
+}
+
+// Unwrap unwraps the InvoiceLotAllocation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ila *InvoiceLotAllocation) Unwrap() *InvoiceLotAllocation {
+	_tx, ok := ila.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoiceLotAllocation is not a transactional entity")
+	}
+	ila.config.driver = _tx.drv
+	return ila
+}
+
+// String implements the fmt.Stringer.
+func (ila *InvoiceLotAllocation) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvoiceLotAllocation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ila.ID))
+	builder.WriteString("invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", ila.InvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", ila.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("lot_id=")
+	builder.WriteString(fmt.Sprintf("%v", ila.LotID))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", ila.Quantity))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvoiceLotAllocations is a parsable slice of InvoiceLotAllocation.
+type InvoiceLotAllocations []*InvoiceLotAllocation
+
+func (ila InvoiceLotAllocations) config(cfg config) {
+	for _i := range ila {
+		ila[_i].config = cfg
+	}
+}
@@ -0,0 +1,44 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoicelotallocation
+
+const (
+	// Label holds the string label denoting the invoicelotallocation type in the database.
+	Label = "invoice_lot_allocation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldInvoiceID holds the string denoting the invoice_id field in the database.
+	FieldInvoiceID = "invoice_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldLotID holds the string denoting the lot_id field in the database.
+	FieldLotID = "lot_id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// Table holds the table name of the invoicelotallocation in the database.
+	Table = "invoice_lot_allocations"
+)
+
+// Columns holds all SQL columns for invoicelotallocation fields.
+var Columns = []string{
+	FieldID,
+	FieldInvoiceID,
+	FieldProductID,
+	FieldLotID,
+	FieldQuantity,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(float64) error
+)
@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoicelotallocation
+
+import (
+	"Veritasbackend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInvoiceID), v))
+	})
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// LotID applies equality check predicate on the "lot_id" field. It's identical to LotIDEQ.
+func LotID(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLotID), v))
+	})
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v float64) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...int) predicate.InvoiceLotAllocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldInvoiceID), v...))
+	})
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...int) predicate.InvoiceLotAllocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldInvoiceID), v...))
+	})
+}
+
+// InvoiceIDGT applies the GT predicate on the "invoice_id" field.
+func InvoiceIDGT(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDGTE applies the GTE predicate on the "invoice_id" field.
+func InvoiceIDGTE(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDLT applies the LT predicate on the "invoice_id" field.
+func InvoiceIDLT(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDLTE applies the LTE predicate on the "invoice_id" field.
+func InvoiceIDLTE(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldInvoiceID), v))
+	})
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.InvoiceLotAllocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.InvoiceLotAllocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProductID), v))
+	})
+}
+
+// LotIDEQ applies the EQ predicate on the "lot_id" field.
+func LotIDEQ(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLotID), v))
+	})
+}
+
+// LotIDNEQ applies the NEQ predicate on the "lot_id" field.
+func LotIDNEQ(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLotID), v))
+	})
+}
+
+// LotIDIn applies the In predicate on the "lot_id" field.
+func LotIDIn(vs ...int) predicate.InvoiceLotAllocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLotID), v...))
+	})
+}
+
+// LotIDNotIn applies the NotIn predicate on the "lot_id" field.
+func LotIDNotIn(vs ...int) predicate.InvoiceLotAllocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLotID), v...))
+	})
+}
+
+// LotIDGT applies the GT predicate on the "lot_id" field.
+func LotIDGT(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLotID), v))
+	})
+}
+
+// LotIDGTE applies the GTE predicate on the "lot_id" field.
+func LotIDGTE(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLotID), v))
+	})
+}
+
+// LotIDLT applies the LT predicate on the "lot_id" field.
+func LotIDLT(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLotID), v))
+	})
+}
+
+// LotIDLTE applies the LTE predicate on the "lot_id" field.
+func LotIDLTE(v int) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLotID), v))
+	})
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v float64) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v float64) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...float64) predicate.InvoiceLotAllocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...float64) predicate.InvoiceLotAllocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v float64) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v float64) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v float64) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v float64) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldQuantity), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InvoiceLotAllocation) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InvoiceLotAllocation) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InvoiceLotAllocation) predicate.InvoiceLotAllocation {
+	return predicate.InvoiceLotAllocation(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
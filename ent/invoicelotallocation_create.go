@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoicelotallocation"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceLotAllocationCreate is the builder for creating a InvoiceLotAllocation entity.
+type InvoiceLotAllocationCreate struct {
+	config
+	mutation *InvoiceLotAllocationMutation
+	hooks    []Hook
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (ilac *InvoiceLotAllocationCreate) SetInvoiceID(i int) *InvoiceLotAllocationCreate {
+	ilac.mutation.SetInvoiceID(i)
+	return ilac
+}
+
+// SetProductID sets the "product_id" field.
+func (ilac *InvoiceLotAllocationCreate) SetProductID(i int) *InvoiceLotAllocationCreate {
+	ilac.mutation.SetProductID(i)
+	return ilac
+}
+
+// SetLotID sets the "lot_id" field.
+func (ilac *InvoiceLotAllocationCreate) SetLotID(i int) *InvoiceLotAllocationCreate {
+	ilac.mutation.SetLotID(i)
+	return ilac
+}
+
+// SetQuantity sets the "quantity" field.
+func (ilac *InvoiceLotAllocationCreate) SetQuantity(f float64) *InvoiceLotAllocationCreate {
+	ilac.mutation.SetQuantity(f)
+	return ilac
+}
+
+// Mutation returns the InvoiceLotAllocationMutation object of the builder.
+func (ilac *InvoiceLotAllocationCreate) Mutation() *InvoiceLotAllocationMutation {
+	return ilac.mutation
+}
+
+// Save creates the InvoiceLotAllocation in the database.
+func (ilac *InvoiceLotAllocationCreate) Save(ctx context.Context) (*InvoiceLotAllocation, error) {
+	var (
+		err  error
+		node *InvoiceLotAllocation
+	)
+	if len(ilac.hooks) == 0 {
+		if err = ilac.check(); err != nil {
+			return nil, err
+		}
+		node, err = ilac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceLotAllocationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ilac.check(); err != nil {
+				return nil, err
+			}
+			ilac.mutation = mutation
+			if node, err = ilac.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ilac.hooks) - 1; i >= 0; i-- {
+			if ilac.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ilac.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ilac.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InvoiceLotAllocation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InvoiceLotAllocationMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ilac *InvoiceLotAllocationCreate) SaveX(ctx context.Context) *InvoiceLotAllocation {
+	v, err := ilac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ilac *InvoiceLotAllocationCreate) Exec(ctx context.Context) error {
+	_, err := ilac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ilac *InvoiceLotAllocationCreate) ExecX(ctx context.Context) {
+	if err := ilac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ilac *InvoiceLotAllocationCreate) check() error {
+	if _, ok := ilac.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`ent: missing required field "InvoiceLotAllocation.invoice_id"`)}
+	}
+	if _, ok := ilac.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "InvoiceLotAllocation.product_id"`)}
+	}
+	if _, ok := ilac.mutation.LotID(); !ok {
+		return &ValidationError{Name: "lot_id", err: errors.New(`ent: missing required field "InvoiceLotAllocation.lot_id"`)}
+	}
+	if _, ok := ilac.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "InvoiceLotAllocation.quantity"`)}
+	}
+	if v, ok := ilac.mutation.Quantity(); ok {
+		if err := invoicelotallocation.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "InvoiceLotAllocation.quantity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ilac *InvoiceLotAllocationCreate) sqlSave(ctx context.Context) (*InvoiceLotAllocation, error) {
+	_node, _spec := ilac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ilac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ilac *InvoiceLotAllocationCreate) createSpec() (*InvoiceLotAllocation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvoiceLotAllocation{config: ilac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: invoicelotallocation.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoicelotallocation.FieldID,
+			},
+		}
+	)
+	if value, ok := ilac.mutation.InvoiceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicelotallocation.FieldInvoiceID,
+		})
+		_node.InvoiceID = value
+	}
+	if value, ok := ilac.mutation.ProductID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicelotallocation.FieldProductID,
+		})
+		_node.ProductID = value
+	}
+	if value, ok := ilac.mutation.LotID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicelotallocation.FieldLotID,
+		})
+		_node.LotID = value
+	}
+	if value, ok := ilac.mutation.Quantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoicelotallocation.FieldQuantity,
+		})
+		_node.Quantity = value
+	}
+	return _node, _spec
+}
+
+// InvoiceLotAllocationCreateBulk is the builder for creating many InvoiceLotAllocation entities in bulk.
+type InvoiceLotAllocationCreateBulk struct {
+	config
+	builders []*InvoiceLotAllocationCreate
+}
+
+// Save creates the InvoiceLotAllocation entities in the database.
+func (ilacb *InvoiceLotAllocationCreateBulk) Save(ctx context.Context) ([]*InvoiceLotAllocation, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ilacb.builders))
+	nodes := make([]*InvoiceLotAllocation, len(ilacb.builders))
+	mutators := make([]Mutator, len(ilacb.builders))
+	for i := range ilacb.builders {
+		func(i int, root context.Context) {
+			builder := ilacb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceLotAllocationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ilacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ilacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ilacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ilacb *InvoiceLotAllocationCreateBulk) SaveX(ctx context.Context) []*InvoiceLotAllocation {
+	v, err := ilacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ilacb *InvoiceLotAllocationCreateBulk) Exec(ctx context.Context) error {
+	_, err := ilacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ilacb *InvoiceLotAllocationCreateBulk) ExecX(ctx context.Context) {
+	if err := ilacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoicelotallocation"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceLotAllocationDelete is the builder for deleting a InvoiceLotAllocation entity.
+type InvoiceLotAllocationDelete struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceLotAllocationMutation
+}
+
+// Where appends a list predicates to the InvoiceLotAllocationDelete builder.
+func (ilad *InvoiceLotAllocationDelete) Where(ps ...predicate.InvoiceLotAllocation) *InvoiceLotAllocationDelete {
+	ilad.mutation.Where(ps...)
+	return ilad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ilad *InvoiceLotAllocationDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ilad.hooks) == 0 {
+		affected, err = ilad.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceLotAllocationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ilad.mutation = mutation
+			affected, err = ilad.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ilad.hooks) - 1; i >= 0; i-- {
+			if ilad.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ilad.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ilad.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ilad *InvoiceLotAllocationDelete) ExecX(ctx context.Context) int {
+	n, err := ilad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ilad *InvoiceLotAllocationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: invoicelotallocation.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoicelotallocation.FieldID,
+			},
+		},
+	}
+	if ps := ilad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ilad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// InvoiceLotAllocationDeleteOne is the builder for deleting a single InvoiceLotAllocation entity.
+type InvoiceLotAllocationDeleteOne struct {
+	ilad *InvoiceLotAllocationDelete
+}
+
+// Exec executes the deletion query.
+func (ilado *InvoiceLotAllocationDeleteOne) Exec(ctx context.Context) error {
+	n, err := ilado.ilad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{invoicelotallocation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ilado *InvoiceLotAllocationDeleteOne) ExecX(ctx context.Context) {
+	ilado.ilad.ExecX(ctx)
+}
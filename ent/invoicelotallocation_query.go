@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoicelotallocation"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceLotAllocationQuery is the builder for querying InvoiceLotAllocation entities.
+type InvoiceLotAllocationQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.InvoiceLotAllocation
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InvoiceLotAllocationQuery builder.
+func (ilaq *InvoiceLotAllocationQuery) Where(ps ...predicate.InvoiceLotAllocation) *InvoiceLotAllocationQuery {
+	ilaq.predicates = append(ilaq.predicates, ps...)
+	return ilaq
+}
+
+// Limit adds a limit step to the query.
+func (ilaq *InvoiceLotAllocationQuery) Limit(limit int) *InvoiceLotAllocationQuery {
+	ilaq.limit = &limit
+	return ilaq
+}
+
+// Offset adds an offset step to the query.
+func (ilaq *InvoiceLotAllocationQuery) Offset(offset int) *InvoiceLotAllocationQuery {
+	ilaq.offset = &offset
+	return ilaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ilaq *InvoiceLotAllocationQuery) Unique(unique bool) *InvoiceLotAllocationQuery {
+	ilaq.unique = &unique
+	return ilaq
+}
+
+// Order adds an order step to the query.
+func (ilaq *InvoiceLotAllocationQuery) Order(o ...OrderFunc) *InvoiceLotAllocationQuery {
+	ilaq.order = append(ilaq.order, o...)
+	return ilaq
+}
+
+// First returns the first InvoiceLotAllocation entity from the query.
+// Returns a *NotFoundError when no InvoiceLotAllocation was found.
+func (ilaq *InvoiceLotAllocationQuery) First(ctx context.Context) (*InvoiceLotAllocation, error) {
+	nodes, err := ilaq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{invoicelotallocation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ilaq *InvoiceLotAllocationQuery) FirstX(ctx context.Context) *InvoiceLotAllocation {
+	node, err := ilaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InvoiceLotAllocation ID from the query.
+// Returns a *NotFoundError when no InvoiceLotAllocation ID was found.
+func (ilaq *InvoiceLotAllocationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ilaq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{invoicelotallocation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ilaq *InvoiceLotAllocationQuery) FirstIDX(ctx context.Context) int {
+	id, err := ilaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InvoiceLotAllocation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InvoiceLotAllocation entity is found.
+// Returns a *NotFoundError when no InvoiceLotAllocation entities are found.
+func (ilaq *InvoiceLotAllocationQuery) Only(ctx context.Context) (*InvoiceLotAllocation, error) {
+	nodes, err := ilaq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{invoicelotallocation.Label}
+	default:
+		return nil, &NotSingularError{invoicelotallocation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ilaq *InvoiceLotAllocationQuery) OnlyX(ctx context.Context) *InvoiceLotAllocation {
+	node, err := ilaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InvoiceLotAllocation ID in the query.
+// Returns a *NotSingularError when more than one InvoiceLotAllocation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ilaq *InvoiceLotAllocationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ilaq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{invoicelotallocation.Label}
+	default:
+		err = &NotSingularError{invoicelotallocation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ilaq *InvoiceLotAllocationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ilaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InvoiceLotAllocations.
+func (ilaq *InvoiceLotAllocationQuery) All(ctx context.Context) ([]*InvoiceLotAllocation, error) {
+	if err := ilaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ilaq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ilaq *InvoiceLotAllocationQuery) AllX(ctx context.Context) []*InvoiceLotAllocation {
+	nodes, err := ilaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InvoiceLotAllocation IDs.
+func (ilaq *InvoiceLotAllocationQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := ilaq.Select(invoicelotallocation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ilaq *InvoiceLotAllocationQuery) IDsX(ctx context.Context) []int {
+	ids, err := ilaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ilaq *InvoiceLotAllocationQuery) Count(ctx context.Context) (int, error) {
+	if err := ilaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ilaq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ilaq *InvoiceLotAllocationQuery) CountX(ctx context.Context) int {
+	count, err := ilaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ilaq *InvoiceLotAllocationQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ilaq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ilaq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ilaq *InvoiceLotAllocationQuery) ExistX(ctx context.Context) bool {
+	exist, err := ilaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InvoiceLotAllocationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ilaq *InvoiceLotAllocationQuery) Clone() *InvoiceLotAllocationQuery {
+	if ilaq == nil {
+		return nil
+	}
+	return &InvoiceLotAllocationQuery{
+		config:     ilaq.config,
+		limit:      ilaq.limit,
+		offset:     ilaq.offset,
+		order:      append([]OrderFunc{}, ilaq.order...),
+		predicates: append([]predicate.InvoiceLotAllocation{}, ilaq.predicates...),
+		// clone intermediate query.
+		sql:    ilaq.sql.Clone(),
+		path:   ilaq.path,
+		unique: ilaq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		InvoiceID int `json:"invoice_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InvoiceLotAllocation.Query().
+//		GroupBy(invoicelotallocation.FieldInvoiceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ilaq *InvoiceLotAllocationQuery) GroupBy(field string, fields ...string) *InvoiceLotAllocationGroupBy {
+	grbuild := &InvoiceLotAllocationGroupBy{config: ilaq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ilaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ilaq.sqlQuery(ctx), nil
+	}
+	grbuild.label = invoicelotallocation.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		InvoiceID int `json:"invoice_id,omitempty"`
+//	}
+//
+//	client.InvoiceLotAllocation.Query().
+//		Select(invoicelotallocation.FieldInvoiceID).
+//		Scan(ctx, &v)
+func (ilaq *InvoiceLotAllocationQuery) Select(fields ...string) *InvoiceLotAllocationSelect {
+	ilaq.fields = append(ilaq.fields, fields...)
+	selbuild := &InvoiceLotAllocationSelect{InvoiceLotAllocationQuery: ilaq}
+	selbuild.label = invoicelotallocation.Label
+	selbuild.flds, selbuild.scan = &ilaq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (ilaq *InvoiceLotAllocationQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ilaq.fields {
+		if !invoicelotallocation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ilaq.path != nil {
+		prev, err := ilaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ilaq.sql = prev
+	}
+	return nil
+}
+
+func (ilaq *InvoiceLotAllocationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InvoiceLotAllocation, error) {
+	var (
+		nodes = []*InvoiceLotAllocation{}
+		_spec = ilaq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*InvoiceLotAllocation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &InvoiceLotAllocation{config: ilaq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(ilaq.modifiers) > 0 {
+		_spec.Modifiers = ilaq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ilaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ilaq *InvoiceLotAllocationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ilaq.querySpec()
+	if len(ilaq.modifiers) > 0 {
+		_spec.Modifiers = ilaq.modifiers
+	}
+	_spec.Node.Columns = ilaq.fields
+	if len(ilaq.fields) > 0 {
+		_spec.Unique = ilaq.unique != nil && *ilaq.unique
+	}
+	return sqlgraph.CountNodes(ctx, ilaq.driver, _spec)
+}
+
+func (ilaq *InvoiceLotAllocationQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ilaq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ilaq *InvoiceLotAllocationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoicelotallocation.Table,
+			Columns: invoicelotallocation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoicelotallocation.FieldID,
+			},
+		},
+		From:   ilaq.sql,
+		Unique: true,
+	}
+	if unique := ilaq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ilaq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoicelotallocation.FieldID)
+		for i := range fields {
+			if fields[i] != invoicelotallocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ilaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ilaq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ilaq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ilaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ilaq *InvoiceLotAllocationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ilaq.driver.Dialect())
+	t1 := builder.Table(invoicelotallocation.Table)
+	columns := ilaq.fields
+	if len(columns) == 0 {
+		columns = invoicelotallocation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ilaq.sql != nil {
+		selector = ilaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ilaq.unique != nil && *ilaq.unique {
+		selector.Distinct()
+	}
+	for _, m := range ilaq.modifiers {
+		m(selector)
+	}
+	for _, p := range ilaq.predicates {
+		p(selector)
+	}
+	for _, p := range ilaq.order {
+		p(selector)
+	}
+	if offset := ilaq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ilaq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ilaq *InvoiceLotAllocationQuery) ForUpdate(opts ...sql.LockOption) *InvoiceLotAllocationQuery {
+	if ilaq.driver.Dialect() == dialect.Postgres {
+		ilaq.Unique(false)
+	}
+	ilaq.modifiers = append(ilaq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ilaq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ilaq *InvoiceLotAllocationQuery) ForShare(opts ...sql.LockOption) *InvoiceLotAllocationQuery {
+	if ilaq.driver.Dialect() == dialect.Postgres {
+		ilaq.Unique(false)
+	}
+	ilaq.modifiers = append(ilaq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ilaq
+}
+
+// InvoiceLotAllocationGroupBy is the group-by builder for InvoiceLotAllocation entities.
+type InvoiceLotAllocationGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ilagb *InvoiceLotAllocationGroupBy) Aggregate(fns ...AggregateFunc) *InvoiceLotAllocationGroupBy {
+	ilagb.fns = append(ilagb.fns, fns...)
+	return ilagb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ilagb *InvoiceLotAllocationGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ilagb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ilagb.sql = query
+	return ilagb.sqlScan(ctx, v)
+}
+
+func (ilagb *InvoiceLotAllocationGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ilagb.fields {
+		if !invoicelotallocation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ilagb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ilagb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ilagb *InvoiceLotAllocationGroupBy) sqlQuery() *sql.Selector {
+	selector := ilagb.sql.Select()
+	aggregation := make([]string, 0, len(ilagb.fns))
+	for _, fn := range ilagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(ilagb.fields)+len(ilagb.fns))
+		for _, f := range ilagb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(ilagb.fields...)...)
+}
+
+// InvoiceLotAllocationSelect is the builder for selecting fields of InvoiceLotAllocation entities.
+type InvoiceLotAllocationSelect struct {
+	*InvoiceLotAllocationQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ilas *InvoiceLotAllocationSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ilas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ilas.sql = ilas.InvoiceLotAllocationQuery.sqlQuery(ctx)
+	return ilas.sqlScan(ctx, v)
+}
+
+func (ilas *InvoiceLotAllocationSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ilas.sql.Query()
+	if err := ilas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,493 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoicelotallocation"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceLotAllocationUpdate is the builder for updating InvoiceLotAllocation entities.
+type InvoiceLotAllocationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceLotAllocationMutation
+}
+
+// Where appends a list predicates to the InvoiceLotAllocationUpdate builder.
+func (ilau *InvoiceLotAllocationUpdate) Where(ps ...predicate.InvoiceLotAllocation) *InvoiceLotAllocationUpdate {
+	ilau.mutation.Where(ps...)
+	return ilau
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (ilau *InvoiceLotAllocationUpdate) SetInvoiceID(i int) *InvoiceLotAllocationUpdate {
+	ilau.mutation.ResetInvoiceID()
+	ilau.mutation.SetInvoiceID(i)
+	return ilau
+}
+
+// AddInvoiceID adds i to the "invoice_id" field.
+func (ilau *InvoiceLotAllocationUpdate) AddInvoiceID(i int) *InvoiceLotAllocationUpdate {
+	ilau.mutation.AddInvoiceID(i)
+	return ilau
+}
+
+// SetProductID sets the "product_id" field.
+func (ilau *InvoiceLotAllocationUpdate) SetProductID(i int) *InvoiceLotAllocationUpdate {
+	ilau.mutation.ResetProductID()
+	ilau.mutation.SetProductID(i)
+	return ilau
+}
+
+// AddProductID adds i to the "product_id" field.
+func (ilau *InvoiceLotAllocationUpdate) AddProductID(i int) *InvoiceLotAllocationUpdate {
+	ilau.mutation.AddProductID(i)
+	return ilau
+}
+
+// SetLotID sets the "lot_id" field.
+func (ilau *InvoiceLotAllocationUpdate) SetLotID(i int) *InvoiceLotAllocationUpdate {
+	ilau.mutation.ResetLotID()
+	ilau.mutation.SetLotID(i)
+	return ilau
+}
+
+// AddLotID adds i to the "lot_id" field.
+func (ilau *InvoiceLotAllocationUpdate) AddLotID(i int) *InvoiceLotAllocationUpdate {
+	ilau.mutation.AddLotID(i)
+	return ilau
+}
+
+// SetQuantity sets the "quantity" field.
+func (ilau *InvoiceLotAllocationUpdate) SetQuantity(f float64) *InvoiceLotAllocationUpdate {
+	ilau.mutation.ResetQuantity()
+	ilau.mutation.SetQuantity(f)
+	return ilau
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (ilau *InvoiceLotAllocationUpdate) AddQuantity(f float64) *InvoiceLotAllocationUpdate {
+	ilau.mutation.AddQuantity(f)
+	return ilau
+}
+
+// Mutation returns the InvoiceLotAllocationMutation object of the builder.
+func (ilau *InvoiceLotAllocationUpdate) Mutation() *InvoiceLotAllocationMutation {
+	return ilau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ilau *InvoiceLotAllocationUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ilau.hooks) == 0 {
+		if err = ilau.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ilau.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceLotAllocationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ilau.check(); err != nil {
+				return 0, err
+			}
+			ilau.mutation = mutation
+			affected, err = ilau.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ilau.hooks) - 1; i >= 0; i-- {
+			if ilau.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ilau.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ilau.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ilau *InvoiceLotAllocationUpdate) SaveX(ctx context.Context) int {
+	affected, err := ilau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ilau *InvoiceLotAllocationUpdate) Exec(ctx context.Context) error {
+	_, err := ilau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ilau *InvoiceLotAllocationUpdate) ExecX(ctx context.Context) {
+	if err := ilau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ilau *InvoiceLotAllocationUpdate) check() error {
+	if v, ok := ilau.mutation.Quantity(); ok {
+		if err := invoicelotallocation.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "InvoiceLotAllocation.quantity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ilau *InvoiceLotAllocationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoicelotallocation.Table,
+			Columns: invoicelotallocation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoicelotallocation.FieldID,
+			},
+		},
+	}
+	if ps := ilau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ilau.mutation.InvoiceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicelotallocation.FieldInvoiceID,
+		})
+	}
+	if value, ok := ilau.mutation.AddedInvoiceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicelotallocation.FieldInvoiceID,
+		})
+	}
+	if value, ok := ilau.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicelotallocation.FieldProductID,
+		})
+	}
+	if value, ok := ilau.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicelotallocation.FieldProductID,
+		})
+	}
+	if value, ok := ilau.mutation.LotID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicelotallocation.FieldLotID,
+		})
+	}
+	if value, ok := ilau.mutation.AddedLotID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicelotallocation.FieldLotID,
+		})
+	}
+	if value, ok := ilau.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoicelotallocation.FieldQuantity,
+		})
+	}
+	if value, ok := ilau.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoicelotallocation.FieldQuantity,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ilau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicelotallocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InvoiceLotAllocationUpdateOne is the builder for updating a single InvoiceLotAllocation entity.
+type InvoiceLotAllocationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceLotAllocationMutation
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (ilauo *InvoiceLotAllocationUpdateOne) SetInvoiceID(i int) *InvoiceLotAllocationUpdateOne {
+	ilauo.mutation.ResetInvoiceID()
+	ilauo.mutation.SetInvoiceID(i)
+	return ilauo
+}
+
+// AddInvoiceID adds i to the "invoice_id" field.
+func (ilauo *InvoiceLotAllocationUpdateOne) AddInvoiceID(i int) *InvoiceLotAllocationUpdateOne {
+	ilauo.mutation.AddInvoiceID(i)
+	return ilauo
+}
+
+// SetProductID sets the "product_id" field.
+func (ilauo *InvoiceLotAllocationUpdateOne) SetProductID(i int) *InvoiceLotAllocationUpdateOne {
+	ilauo.mutation.ResetProductID()
+	ilauo.mutation.SetProductID(i)
+	return ilauo
+}
+
+// AddProductID adds i to the "product_id" field.
+func (ilauo *InvoiceLotAllocationUpdateOne) AddProductID(i int) *InvoiceLotAllocationUpdateOne {
+	ilauo.mutation.AddProductID(i)
+	return ilauo
+}
+
+// SetLotID sets the "lot_id" field.
+func (ilauo *InvoiceLotAllocationUpdateOne) SetLotID(i int) *InvoiceLotAllocationUpdateOne {
+	ilauo.mutation.ResetLotID()
+	ilauo.mutation.SetLotID(i)
+	return ilauo
+}
+
+// AddLotID adds i to the "lot_id" field.
+func (ilauo *InvoiceLotAllocationUpdateOne) AddLotID(i int) *InvoiceLotAllocationUpdateOne {
+	ilauo.mutation.AddLotID(i)
+	return ilauo
+}
+
+// SetQuantity sets the "quantity" field.
+func (ilauo *InvoiceLotAllocationUpdateOne) SetQuantity(f float64) *InvoiceLotAllocationUpdateOne {
+	ilauo.mutation.ResetQuantity()
+	ilauo.mutation.SetQuantity(f)
+	return ilauo
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (ilauo *InvoiceLotAllocationUpdateOne) AddQuantity(f float64) *InvoiceLotAllocationUpdateOne {
+	ilauo.mutation.AddQuantity(f)
+	return ilauo
+}
+
+// Mutation returns the InvoiceLotAllocationMutation object of the builder.
+func (ilauo *InvoiceLotAllocationUpdateOne) Mutation() *InvoiceLotAllocationMutation {
+	return ilauo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ilauo *InvoiceLotAllocationUpdateOne) Select(field string, fields ...string) *InvoiceLotAllocationUpdateOne {
+	ilauo.fields = append([]string{field}, fields...)
+	return ilauo
+}
+
+// Save executes the query and returns the updated InvoiceLotAllocation entity.
+func (ilauo *InvoiceLotAllocationUpdateOne) Save(ctx context.Context) (*InvoiceLotAllocation, error) {
+	var (
+		err  error
+		node *InvoiceLotAllocation
+	)
+	if len(ilauo.hooks) == 0 {
+		if err = ilauo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ilauo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceLotAllocationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ilauo.check(); err != nil {
+				return nil, err
+			}
+			ilauo.mutation = mutation
+			node, err = ilauo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ilauo.hooks) - 1; i >= 0; i-- {
+			if ilauo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ilauo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ilauo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InvoiceLotAllocation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InvoiceLotAllocationMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ilauo *InvoiceLotAllocationUpdateOne) SaveX(ctx context.Context) *InvoiceLotAllocation {
+	node, err := ilauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ilauo *InvoiceLotAllocationUpdateOne) Exec(ctx context.Context) error {
+	_, err := ilauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ilauo *InvoiceLotAllocationUpdateOne) ExecX(ctx context.Context) {
+	if err := ilauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ilauo *InvoiceLotAllocationUpdateOne) check() error {
+	if v, ok := ilauo.mutation.Quantity(); ok {
+		if err := invoicelotallocation.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "InvoiceLotAllocation.quantity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ilauo *InvoiceLotAllocationUpdateOne) sqlSave(ctx context.Context) (_node *InvoiceLotAllocation, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoicelotallocation.Table,
+			Columns: invoicelotallocation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoicelotallocation.FieldID,
+			},
+		},
+	}
+	id, ok := ilauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InvoiceLotAllocation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ilauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoicelotallocation.FieldID)
+		for _, f := range fields {
+			if !invoicelotallocation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoicelotallocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ilauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ilauo.mutation.InvoiceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicelotallocation.FieldInvoiceID,
+		})
+	}
+	if value, ok := ilauo.mutation.AddedInvoiceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicelotallocation.FieldInvoiceID,
+		})
+	}
+	if value, ok := ilauo.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicelotallocation.FieldProductID,
+		})
+	}
+	if value, ok := ilauo.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicelotallocation.FieldProductID,
+		})
+	}
+	if value, ok := ilauo.mutation.LotID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicelotallocation.FieldLotID,
+		})
+	}
+	if value, ok := ilauo.mutation.AddedLotID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicelotallocation.FieldLotID,
+		})
+	}
+	if value, ok := ilauo.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoicelotallocation.FieldQuantity,
+		})
+	}
+	if value, ok := ilauo.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoicelotallocation.FieldQuantity,
+		})
+	}
+	_node = &InvoiceLotAllocation{config: ilauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ilauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicelotallocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
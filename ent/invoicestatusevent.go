@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoicestatusevent"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// InvoiceStatusEvent is the model entity for the InvoiceStatusEvent schema.
+type InvoiceStatusEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID de la factura de venta
+	InvoiceID int `json:"invoice_id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// Estado anterior de la factura
+	FromStatus string `json:"from_status,omitempty"`
+	// Estado nuevo de la factura (paid, cancelled, voided)
+	ToStatus string `json:"to_status,omitempty"`
+	// Motivo informado por el usuario
+	Reason string `json:"reason,omitempty"`
+	// ID del usuario que hizo el cambio
+	UserID int `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvoiceStatusEvent) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoicestatusevent.FieldID, invoicestatusevent.FieldInvoiceID, invoicestatusevent.FieldTenantID, invoicestatusevent.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case invoicestatusevent.FieldFromStatus, invoicestatusevent.FieldToStatus, invoicestatusevent.FieldReason:
+			values[i] = new(sql.NullString)
+		case invoicestatusevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type InvoiceStatusEvent", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvoiceStatusEvent fields.
+func (ise *InvoiceStatusEvent) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoicestatusevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ise.ID = int(value.Int64)
+		case invoicestatusevent.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				ise.InvoiceID = int(value.Int64)
+			}
+		case invoicestatusevent.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ise.TenantID = int(value.Int64)
+			}
+		case invoicestatusevent.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				ise.FromStatus = value.String
+			}
+		case invoicestatusevent.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				ise.ToStatus = value.String
+			}
+		case invoicestatusevent.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				ise.Reason = value.String
+			}
+		case invoicestatusevent.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ise.UserID = int(value.Int64)
+			}
+		case invoicestatusevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ise.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this InvoiceStatusEvent.
+// Note that you need to call InvoiceStatusEvent.Unwrap() before calling this method if this InvoiceStatusEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ise *InvoiceStatusEvent) Update() *InvoiceStatusEventUpdateOne {
+	return (&InvoiceStatusEventClient{config: ise.config}).UpdateOne(ise)
+}
+
+// Unwrap unwraps the InvoiceStatusEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ise *InvoiceStatusEvent) Unwrap() *InvoiceStatusEvent {
+	_tx, ok := ise.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoiceStatusEvent is not a transactional entity")
+	}
+	ise.config.driver = _tx.drv
+	return ise
+}
+
+// String implements the fmt.Stringer.
+func (ise *InvoiceStatusEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvoiceStatusEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ise.ID))
+	builder.WriteString("invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", ise.InvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", ise.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("from_status=")
+	builder.WriteString(ise.FromStatus)
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(ise.ToStatus)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(ise.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ise.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ise.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvoiceStatusEvents is a parsable slice of InvoiceStatusEvent.
+type InvoiceStatusEvents []*InvoiceStatusEvent
+
+func (ise InvoiceStatusEvents) config(cfg config) {
+	for _i := range ise {
+		ise[_i].config = cfg
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoicestatusevent
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the invoicestatusevent type in the database.
+	Label = "invoice_status_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldInvoiceID holds the string denoting the invoice_id field in the database.
+	FieldInvoiceID = "invoice_id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldFromStatus holds the string denoting the from_status field in the database.
+	FieldFromStatus = "from_status"
+	// FieldToStatus holds the string denoting the to_status field in the database.
+	FieldToStatus = "to_status"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the invoicestatusevent in the database.
+	Table = "invoice_status_events"
+)
+
+// Columns holds all SQL columns for invoicestatusevent fields.
+var Columns = []string{
+	FieldID,
+	FieldInvoiceID,
+	FieldTenantID,
+	FieldFromStatus,
+	FieldToStatus,
+	FieldReason,
+	FieldUserID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoicestatusevent"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceStatusEventCreate is the builder for creating a InvoiceStatusEvent entity.
+type InvoiceStatusEventCreate struct {
+	config
+	mutation *InvoiceStatusEventMutation
+	hooks    []Hook
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (isec *InvoiceStatusEventCreate) SetInvoiceID(i int) *InvoiceStatusEventCreate {
+	isec.mutation.SetInvoiceID(i)
+	return isec
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (isec *InvoiceStatusEventCreate) SetTenantID(i int) *InvoiceStatusEventCreate {
+	isec.mutation.SetTenantID(i)
+	return isec
+}
+
+// SetFromStatus sets the "from_status" field.
+func (isec *InvoiceStatusEventCreate) SetFromStatus(s string) *InvoiceStatusEventCreate {
+	isec.mutation.SetFromStatus(s)
+	return isec
+}
+
+// SetToStatus sets the "to_status" field.
+func (isec *InvoiceStatusEventCreate) SetToStatus(s string) *InvoiceStatusEventCreate {
+	isec.mutation.SetToStatus(s)
+	return isec
+}
+
+// SetReason sets the "reason" field.
+func (isec *InvoiceStatusEventCreate) SetReason(s string) *InvoiceStatusEventCreate {
+	isec.mutation.SetReason(s)
+	return isec
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (isec *InvoiceStatusEventCreate) SetNillableReason(s *string) *InvoiceStatusEventCreate {
+	if s != nil {
+		isec.SetReason(*s)
+	}
+	return isec
+}
+
+// SetUserID sets the "user_id" field.
+func (isec *InvoiceStatusEventCreate) SetUserID(i int) *InvoiceStatusEventCreate {
+	isec.mutation.SetUserID(i)
+	return isec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (isec *InvoiceStatusEventCreate) SetCreatedAt(t time.Time) *InvoiceStatusEventCreate {
+	isec.mutation.SetCreatedAt(t)
+	return isec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (isec *InvoiceStatusEventCreate) SetNillableCreatedAt(t *time.Time) *InvoiceStatusEventCreate {
+	if t != nil {
+		isec.SetCreatedAt(*t)
+	}
+	return isec
+}
+
+// Mutation returns the InvoiceStatusEventMutation object of the builder.
+func (isec *InvoiceStatusEventCreate) Mutation() *InvoiceStatusEventMutation {
+	return isec.mutation
+}
+
+// Save creates the InvoiceStatusEvent in the database.
+func (isec *InvoiceStatusEventCreate) Save(ctx context.Context) (*InvoiceStatusEvent, error) {
+	var (
+		err  error
+		node *InvoiceStatusEvent
+	)
+	isec.defaults()
+	if len(isec.hooks) == 0 {
+		if err = isec.check(); err != nil {
+			return nil, err
+		}
+		node, err = isec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceStatusEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = isec.check(); err != nil {
+				return nil, err
+			}
+			isec.mutation = mutation
+			if node, err = isec.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(isec.hooks) - 1; i >= 0; i-- {
+			if isec.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = isec.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, isec.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InvoiceStatusEvent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InvoiceStatusEventMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (isec *InvoiceStatusEventCreate) SaveX(ctx context.Context) *InvoiceStatusEvent {
+	v, err := isec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (isec *InvoiceStatusEventCreate) Exec(ctx context.Context) error {
+	_, err := isec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (isec *InvoiceStatusEventCreate) ExecX(ctx context.Context) {
+	if err := isec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (isec *InvoiceStatusEventCreate) defaults() {
+	if _, ok := isec.mutation.CreatedAt(); !ok {
+		v := invoicestatusevent.DefaultCreatedAt()
+		isec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (isec *InvoiceStatusEventCreate) check() error {
+	if _, ok := isec.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`ent: missing required field "InvoiceStatusEvent.invoice_id"`)}
+	}
+	if _, ok := isec.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "InvoiceStatusEvent.tenant_id"`)}
+	}
+	if _, ok := isec.mutation.FromStatus(); !ok {
+		return &ValidationError{Name: "from_status", err: errors.New(`ent: missing required field "InvoiceStatusEvent.from_status"`)}
+	}
+	if _, ok := isec.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "InvoiceStatusEvent.to_status"`)}
+	}
+	if _, ok := isec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "InvoiceStatusEvent.user_id"`)}
+	}
+	if _, ok := isec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InvoiceStatusEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (isec *InvoiceStatusEventCreate) sqlSave(ctx context.Context) (*InvoiceStatusEvent, error) {
+	_node, _spec := isec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, isec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (isec *InvoiceStatusEventCreate) createSpec() (*InvoiceStatusEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvoiceStatusEvent{config: isec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: invoicestatusevent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoicestatusevent.FieldID,
+			},
+		}
+	)
+	if value, ok := isec.mutation.InvoiceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicestatusevent.FieldInvoiceID,
+		})
+		_node.InvoiceID = value
+	}
+	if value, ok := isec.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicestatusevent.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := isec.mutation.FromStatus(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicestatusevent.FieldFromStatus,
+		})
+		_node.FromStatus = value
+	}
+	if value, ok := isec.mutation.ToStatus(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicestatusevent.FieldToStatus,
+		})
+		_node.ToStatus = value
+	}
+	if value, ok := isec.mutation.Reason(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicestatusevent.FieldReason,
+		})
+		_node.Reason = value
+	}
+	if value, ok := isec.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicestatusevent.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := isec.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invoicestatusevent.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// InvoiceStatusEventCreateBulk is the builder for creating many InvoiceStatusEvent entities in bulk.
+type InvoiceStatusEventCreateBulk struct {
+	config
+	builders []*InvoiceStatusEventCreate
+}
+
+// Save creates the InvoiceStatusEvent entities in the database.
+func (isecb *InvoiceStatusEventCreateBulk) Save(ctx context.Context) ([]*InvoiceStatusEvent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(isecb.builders))
+	nodes := make([]*InvoiceStatusEvent, len(isecb.builders))
+	mutators := make([]Mutator, len(isecb.builders))
+	for i := range isecb.builders {
+		func(i int, root context.Context) {
+			builder := isecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceStatusEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, isecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, isecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, isecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (isecb *InvoiceStatusEventCreateBulk) SaveX(ctx context.Context) []*InvoiceStatusEvent {
+	v, err := isecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (isecb *InvoiceStatusEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := isecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (isecb *InvoiceStatusEventCreateBulk) ExecX(ctx context.Context) {
+	if err := isecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
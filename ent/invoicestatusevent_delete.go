@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoicestatusevent"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceStatusEventDelete is the builder for deleting a InvoiceStatusEvent entity.
+type InvoiceStatusEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceStatusEventMutation
+}
+
+// Where appends a list predicates to the InvoiceStatusEventDelete builder.
+func (ised *InvoiceStatusEventDelete) Where(ps ...predicate.InvoiceStatusEvent) *InvoiceStatusEventDelete {
+	ised.mutation.Where(ps...)
+	return ised
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ised *InvoiceStatusEventDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ised.hooks) == 0 {
+		affected, err = ised.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceStatusEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ised.mutation = mutation
+			affected, err = ised.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ised.hooks) - 1; i >= 0; i-- {
+			if ised.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ised.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ised.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ised *InvoiceStatusEventDelete) ExecX(ctx context.Context) int {
+	n, err := ised.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ised *InvoiceStatusEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: invoicestatusevent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoicestatusevent.FieldID,
+			},
+		},
+	}
+	if ps := ised.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ised.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// InvoiceStatusEventDeleteOne is the builder for deleting a single InvoiceStatusEvent entity.
+type InvoiceStatusEventDeleteOne struct {
+	ised *InvoiceStatusEventDelete
+}
+
+// Exec executes the deletion query.
+func (isedo *InvoiceStatusEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := isedo.ised.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{invoicestatusevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (isedo *InvoiceStatusEventDeleteOne) ExecX(ctx context.Context) {
+	isedo.ised.ExecX(ctx)
+}
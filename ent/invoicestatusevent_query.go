@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoicestatusevent"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceStatusEventQuery is the builder for querying InvoiceStatusEvent entities.
+type InvoiceStatusEventQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.InvoiceStatusEvent
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InvoiceStatusEventQuery builder.
+func (iseq *InvoiceStatusEventQuery) Where(ps ...predicate.InvoiceStatusEvent) *InvoiceStatusEventQuery {
+	iseq.predicates = append(iseq.predicates, ps...)
+	return iseq
+}
+
+// Limit adds a limit step to the query.
+func (iseq *InvoiceStatusEventQuery) Limit(limit int) *InvoiceStatusEventQuery {
+	iseq.limit = &limit
+	return iseq
+}
+
+// Offset adds an offset step to the query.
+func (iseq *InvoiceStatusEventQuery) Offset(offset int) *InvoiceStatusEventQuery {
+	iseq.offset = &offset
+	return iseq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iseq *InvoiceStatusEventQuery) Unique(unique bool) *InvoiceStatusEventQuery {
+	iseq.unique = &unique
+	return iseq
+}
+
+// Order adds an order step to the query.
+func (iseq *InvoiceStatusEventQuery) Order(o ...OrderFunc) *InvoiceStatusEventQuery {
+	iseq.order = append(iseq.order, o...)
+	return iseq
+}
+
+// First returns the first InvoiceStatusEvent entity from the query.
+// Returns a *NotFoundError when no InvoiceStatusEvent was found.
+func (iseq *InvoiceStatusEventQuery) First(ctx context.Context) (*InvoiceStatusEvent, error) {
+	nodes, err := iseq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{invoicestatusevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iseq *InvoiceStatusEventQuery) FirstX(ctx context.Context) *InvoiceStatusEvent {
+	node, err := iseq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InvoiceStatusEvent ID from the query.
+// Returns a *NotFoundError when no InvoiceStatusEvent ID was found.
+func (iseq *InvoiceStatusEventQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iseq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{invoicestatusevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iseq *InvoiceStatusEventQuery) FirstIDX(ctx context.Context) int {
+	id, err := iseq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InvoiceStatusEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InvoiceStatusEvent entity is found.
+// Returns a *NotFoundError when no InvoiceStatusEvent entities are found.
+func (iseq *InvoiceStatusEventQuery) Only(ctx context.Context) (*InvoiceStatusEvent, error) {
+	nodes, err := iseq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{invoicestatusevent.Label}
+	default:
+		return nil, &NotSingularError{invoicestatusevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iseq *InvoiceStatusEventQuery) OnlyX(ctx context.Context) *InvoiceStatusEvent {
+	node, err := iseq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InvoiceStatusEvent ID in the query.
+// Returns a *NotSingularError when more than one InvoiceStatusEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iseq *InvoiceStatusEventQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iseq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{invoicestatusevent.Label}
+	default:
+		err = &NotSingularError{invoicestatusevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iseq *InvoiceStatusEventQuery) OnlyIDX(ctx context.Context) int {
+	id, err := iseq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InvoiceStatusEvents.
+func (iseq *InvoiceStatusEventQuery) All(ctx context.Context) ([]*InvoiceStatusEvent, error) {
+	if err := iseq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return iseq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iseq *InvoiceStatusEventQuery) AllX(ctx context.Context) []*InvoiceStatusEvent {
+	nodes, err := iseq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InvoiceStatusEvent IDs.
+func (iseq *InvoiceStatusEventQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := iseq.Select(invoicestatusevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iseq *InvoiceStatusEventQuery) IDsX(ctx context.Context) []int {
+	ids, err := iseq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iseq *InvoiceStatusEventQuery) Count(ctx context.Context) (int, error) {
+	if err := iseq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return iseq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iseq *InvoiceStatusEventQuery) CountX(ctx context.Context) int {
+	count, err := iseq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iseq *InvoiceStatusEventQuery) Exist(ctx context.Context) (bool, error) {
+	if err := iseq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return iseq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iseq *InvoiceStatusEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := iseq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InvoiceStatusEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iseq *InvoiceStatusEventQuery) Clone() *InvoiceStatusEventQuery {
+	if iseq == nil {
+		return nil
+	}
+	return &InvoiceStatusEventQuery{
+		config:     iseq.config,
+		limit:      iseq.limit,
+		offset:     iseq.offset,
+		order:      append([]OrderFunc{}, iseq.order...),
+		predicates: append([]predicate.InvoiceStatusEvent{}, iseq.predicates...),
+		// clone intermediate query.
+		sql:    iseq.sql.Clone(),
+		path:   iseq.path,
+		unique: iseq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		InvoiceID int `json:"invoice_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InvoiceStatusEvent.Query().
+//		GroupBy(invoicestatusevent.FieldInvoiceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (iseq *InvoiceStatusEventQuery) GroupBy(field string, fields ...string) *InvoiceStatusEventGroupBy {
+	grbuild := &InvoiceStatusEventGroupBy{config: iseq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := iseq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return iseq.sqlQuery(ctx), nil
+	}
+	grbuild.label = invoicestatusevent.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		InvoiceID int `json:"invoice_id,omitempty"`
+//	}
+//
+//	client.InvoiceStatusEvent.Query().
+//		Select(invoicestatusevent.FieldInvoiceID).
+//		Scan(ctx, &v)
+func (iseq *InvoiceStatusEventQuery) Select(fields ...string) *InvoiceStatusEventSelect {
+	iseq.fields = append(iseq.fields, fields...)
+	selbuild := &InvoiceStatusEventSelect{InvoiceStatusEventQuery: iseq}
+	selbuild.label = invoicestatusevent.Label
+	selbuild.flds, selbuild.scan = &iseq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (iseq *InvoiceStatusEventQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range iseq.fields {
+		if !invoicestatusevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if iseq.path != nil {
+		prev, err := iseq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iseq.sql = prev
+	}
+	return nil
+}
+
+func (iseq *InvoiceStatusEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InvoiceStatusEvent, error) {
+	var (
+		nodes = []*InvoiceStatusEvent{}
+		_spec = iseq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*InvoiceStatusEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &InvoiceStatusEvent{config: iseq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(iseq.modifiers) > 0 {
+		_spec.Modifiers = iseq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iseq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (iseq *InvoiceStatusEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iseq.querySpec()
+	if len(iseq.modifiers) > 0 {
+		_spec.Modifiers = iseq.modifiers
+	}
+	_spec.Node.Columns = iseq.fields
+	if len(iseq.fields) > 0 {
+		_spec.Unique = iseq.unique != nil && *iseq.unique
+	}
+	return sqlgraph.CountNodes(ctx, iseq.driver, _spec)
+}
+
+func (iseq *InvoiceStatusEventQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := iseq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (iseq *InvoiceStatusEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoicestatusevent.Table,
+			Columns: invoicestatusevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoicestatusevent.FieldID,
+			},
+		},
+		From:   iseq.sql,
+		Unique: true,
+	}
+	if unique := iseq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := iseq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoicestatusevent.FieldID)
+		for i := range fields {
+			if fields[i] != invoicestatusevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := iseq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iseq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iseq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iseq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iseq *InvoiceStatusEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iseq.driver.Dialect())
+	t1 := builder.Table(invoicestatusevent.Table)
+	columns := iseq.fields
+	if len(columns) == 0 {
+		columns = invoicestatusevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iseq.sql != nil {
+		selector = iseq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iseq.unique != nil && *iseq.unique {
+		selector.Distinct()
+	}
+	for _, m := range iseq.modifiers {
+		m(selector)
+	}
+	for _, p := range iseq.predicates {
+		p(selector)
+	}
+	for _, p := range iseq.order {
+		p(selector)
+	}
+	if offset := iseq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iseq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (iseq *InvoiceStatusEventQuery) ForUpdate(opts ...sql.LockOption) *InvoiceStatusEventQuery {
+	if iseq.driver.Dialect() == dialect.Postgres {
+		iseq.Unique(false)
+	}
+	iseq.modifiers = append(iseq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return iseq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (iseq *InvoiceStatusEventQuery) ForShare(opts ...sql.LockOption) *InvoiceStatusEventQuery {
+	if iseq.driver.Dialect() == dialect.Postgres {
+		iseq.Unique(false)
+	}
+	iseq.modifiers = append(iseq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return iseq
+}
+
+// InvoiceStatusEventGroupBy is the group-by builder for InvoiceStatusEvent entities.
+type InvoiceStatusEventGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (isegb *InvoiceStatusEventGroupBy) Aggregate(fns ...AggregateFunc) *InvoiceStatusEventGroupBy {
+	isegb.fns = append(isegb.fns, fns...)
+	return isegb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (isegb *InvoiceStatusEventGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := isegb.path(ctx)
+	if err != nil {
+		return err
+	}
+	isegb.sql = query
+	return isegb.sqlScan(ctx, v)
+}
+
+func (isegb *InvoiceStatusEventGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range isegb.fields {
+		if !invoicestatusevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := isegb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := isegb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (isegb *InvoiceStatusEventGroupBy) sqlQuery() *sql.Selector {
+	selector := isegb.sql.Select()
+	aggregation := make([]string, 0, len(isegb.fns))
+	for _, fn := range isegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(isegb.fields)+len(isegb.fns))
+		for _, f := range isegb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(isegb.fields...)...)
+}
+
+// InvoiceStatusEventSelect is the builder for selecting fields of InvoiceStatusEvent entities.
+type InvoiceStatusEventSelect struct {
+	*InvoiceStatusEventQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ises *InvoiceStatusEventSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ises.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ises.sql = ises.InvoiceStatusEventQuery.sqlQuery(ctx)
+	return ises.sqlScan(ctx, v)
+}
+
+func (ises *InvoiceStatusEventSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ises.sql.Query()
+	if err := ises.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoicestatusevent"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceStatusEventUpdate is the builder for updating InvoiceStatusEvent entities.
+type InvoiceStatusEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceStatusEventMutation
+}
+
+// Where appends a list predicates to the InvoiceStatusEventUpdate builder.
+func (iseu *InvoiceStatusEventUpdate) Where(ps ...predicate.InvoiceStatusEvent) *InvoiceStatusEventUpdate {
+	iseu.mutation.Where(ps...)
+	return iseu
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (iseu *InvoiceStatusEventUpdate) SetInvoiceID(i int) *InvoiceStatusEventUpdate {
+	iseu.mutation.ResetInvoiceID()
+	iseu.mutation.SetInvoiceID(i)
+	return iseu
+}
+
+// AddInvoiceID adds i to the "invoice_id" field.
+func (iseu *InvoiceStatusEventUpdate) AddInvoiceID(i int) *InvoiceStatusEventUpdate {
+	iseu.mutation.AddInvoiceID(i)
+	return iseu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (iseu *InvoiceStatusEventUpdate) SetTenantID(i int) *InvoiceStatusEventUpdate {
+	iseu.mutation.ResetTenantID()
+	iseu.mutation.SetTenantID(i)
+	return iseu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (iseu *InvoiceStatusEventUpdate) AddTenantID(i int) *InvoiceStatusEventUpdate {
+	iseu.mutation.AddTenantID(i)
+	return iseu
+}
+
+// SetFromStatus sets the "from_status" field.
+func (iseu *InvoiceStatusEventUpdate) SetFromStatus(s string) *InvoiceStatusEventUpdate {
+	iseu.mutation.SetFromStatus(s)
+	return iseu
+}
+
+// SetToStatus sets the "to_status" field.
+func (iseu *InvoiceStatusEventUpdate) SetToStatus(s string) *InvoiceStatusEventUpdate {
+	iseu.mutation.SetToStatus(s)
+	return iseu
+}
+
+// SetReason sets the "reason" field.
+func (iseu *InvoiceStatusEventUpdate) SetReason(s string) *InvoiceStatusEventUpdate {
+	iseu.mutation.SetReason(s)
+	return iseu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (iseu *InvoiceStatusEventUpdate) SetNillableReason(s *string) *InvoiceStatusEventUpdate {
+	if s != nil {
+		iseu.SetReason(*s)
+	}
+	return iseu
+}
+
+// ClearReason clears the value of the "reason" field.
+func (iseu *InvoiceStatusEventUpdate) ClearReason() *InvoiceStatusEventUpdate {
+	iseu.mutation.ClearReason()
+	return iseu
+}
+
+// SetUserID sets the "user_id" field.
+func (iseu *InvoiceStatusEventUpdate) SetUserID(i int) *InvoiceStatusEventUpdate {
+	iseu.mutation.ResetUserID()
+	iseu.mutation.SetUserID(i)
+	return iseu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (iseu *InvoiceStatusEventUpdate) AddUserID(i int) *InvoiceStatusEventUpdate {
+	iseu.mutation.AddUserID(i)
+	return iseu
+}
+
+// Mutation returns the InvoiceStatusEventMutation object of the builder.
+func (iseu *InvoiceStatusEventUpdate) Mutation() *InvoiceStatusEventMutation {
+	return iseu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iseu *InvoiceStatusEventUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(iseu.hooks) == 0 {
+		affected, err = iseu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceStatusEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iseu.mutation = mutation
+			affected, err = iseu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iseu.hooks) - 1; i >= 0; i-- {
+			if iseu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iseu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iseu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iseu *InvoiceStatusEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := iseu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iseu *InvoiceStatusEventUpdate) Exec(ctx context.Context) error {
+	_, err := iseu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iseu *InvoiceStatusEventUpdate) ExecX(ctx context.Context) {
+	if err := iseu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iseu *InvoiceStatusEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoicestatusevent.Table,
+			Columns: invoicestatusevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoicestatusevent.FieldID,
+			},
+		},
+	}
+	if ps := iseu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iseu.mutation.InvoiceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicestatusevent.FieldInvoiceID,
+		})
+	}
+	if value, ok := iseu.mutation.AddedInvoiceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicestatusevent.FieldInvoiceID,
+		})
+	}
+	if value, ok := iseu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicestatusevent.FieldTenantID,
+		})
+	}
+	if value, ok := iseu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicestatusevent.FieldTenantID,
+		})
+	}
+	if value, ok := iseu.mutation.FromStatus(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicestatusevent.FieldFromStatus,
+		})
+	}
+	if value, ok := iseu.mutation.ToStatus(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicestatusevent.FieldToStatus,
+		})
+	}
+	if value, ok := iseu.mutation.Reason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicestatusevent.FieldReason,
+		})
+	}
+	if iseu.mutation.ReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: invoicestatusevent.FieldReason,
+		})
+	}
+	if value, ok := iseu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicestatusevent.FieldUserID,
+		})
+	}
+	if value, ok := iseu.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicestatusevent.FieldUserID,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iseu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicestatusevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InvoiceStatusEventUpdateOne is the builder for updating a single InvoiceStatusEvent entity.
+type InvoiceStatusEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceStatusEventMutation
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (iseuo *InvoiceStatusEventUpdateOne) SetInvoiceID(i int) *InvoiceStatusEventUpdateOne {
+	iseuo.mutation.ResetInvoiceID()
+	iseuo.mutation.SetInvoiceID(i)
+	return iseuo
+}
+
+// AddInvoiceID adds i to the "invoice_id" field.
+func (iseuo *InvoiceStatusEventUpdateOne) AddInvoiceID(i int) *InvoiceStatusEventUpdateOne {
+	iseuo.mutation.AddInvoiceID(i)
+	return iseuo
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (iseuo *InvoiceStatusEventUpdateOne) SetTenantID(i int) *InvoiceStatusEventUpdateOne {
+	iseuo.mutation.ResetTenantID()
+	iseuo.mutation.SetTenantID(i)
+	return iseuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (iseuo *InvoiceStatusEventUpdateOne) AddTenantID(i int) *InvoiceStatusEventUpdateOne {
+	iseuo.mutation.AddTenantID(i)
+	return iseuo
+}
+
+// SetFromStatus sets the "from_status" field.
+func (iseuo *InvoiceStatusEventUpdateOne) SetFromStatus(s string) *InvoiceStatusEventUpdateOne {
+	iseuo.mutation.SetFromStatus(s)
+	return iseuo
+}
+
+// SetToStatus sets the "to_status" field.
+func (iseuo *InvoiceStatusEventUpdateOne) SetToStatus(s string) *InvoiceStatusEventUpdateOne {
+	iseuo.mutation.SetToStatus(s)
+	return iseuo
+}
+
+// SetReason sets the "reason" field.
+func (iseuo *InvoiceStatusEventUpdateOne) SetReason(s string) *InvoiceStatusEventUpdateOne {
+	iseuo.mutation.SetReason(s)
+	return iseuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (iseuo *InvoiceStatusEventUpdateOne) SetNillableReason(s *string) *InvoiceStatusEventUpdateOne {
+	if s != nil {
+		iseuo.SetReason(*s)
+	}
+	return iseuo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (iseuo *InvoiceStatusEventUpdateOne) ClearReason() *InvoiceStatusEventUpdateOne {
+	iseuo.mutation.ClearReason()
+	return iseuo
+}
+
+// SetUserID sets the "user_id" field.
+func (iseuo *InvoiceStatusEventUpdateOne) SetUserID(i int) *InvoiceStatusEventUpdateOne {
+	iseuo.mutation.ResetUserID()
+	iseuo.mutation.SetUserID(i)
+	return iseuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (iseuo *InvoiceStatusEventUpdateOne) AddUserID(i int) *InvoiceStatusEventUpdateOne {
+	iseuo.mutation.AddUserID(i)
+	return iseuo
+}
+
+// Mutation returns the InvoiceStatusEventMutation object of the builder.
+func (iseuo *InvoiceStatusEventUpdateOne) Mutation() *InvoiceStatusEventMutation {
+	return iseuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iseuo *InvoiceStatusEventUpdateOne) Select(field string, fields ...string) *InvoiceStatusEventUpdateOne {
+	iseuo.fields = append([]string{field}, fields...)
+	return iseuo
+}
+
+// Save executes the query and returns the updated InvoiceStatusEvent entity.
+func (iseuo *InvoiceStatusEventUpdateOne) Save(ctx context.Context) (*InvoiceStatusEvent, error) {
+	var (
+		err  error
+		node *InvoiceStatusEvent
+	)
+	if len(iseuo.hooks) == 0 {
+		node, err = iseuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceStatusEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iseuo.mutation = mutation
+			node, err = iseuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iseuo.hooks) - 1; i >= 0; i-- {
+			if iseuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iseuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, iseuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InvoiceStatusEvent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InvoiceStatusEventMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iseuo *InvoiceStatusEventUpdateOne) SaveX(ctx context.Context) *InvoiceStatusEvent {
+	node, err := iseuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iseuo *InvoiceStatusEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := iseuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iseuo *InvoiceStatusEventUpdateOne) ExecX(ctx context.Context) {
+	if err := iseuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iseuo *InvoiceStatusEventUpdateOne) sqlSave(ctx context.Context) (_node *InvoiceStatusEvent, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoicestatusevent.Table,
+			Columns: invoicestatusevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoicestatusevent.FieldID,
+			},
+		},
+	}
+	id, ok := iseuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InvoiceStatusEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iseuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoicestatusevent.FieldID)
+		for _, f := range fields {
+			if !invoicestatusevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoicestatusevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iseuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iseuo.mutation.InvoiceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicestatusevent.FieldInvoiceID,
+		})
+	}
+	if value, ok := iseuo.mutation.AddedInvoiceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicestatusevent.FieldInvoiceID,
+		})
+	}
+	if value, ok := iseuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicestatusevent.FieldTenantID,
+		})
+	}
+	if value, ok := iseuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicestatusevent.FieldTenantID,
+		})
+	}
+	if value, ok := iseuo.mutation.FromStatus(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicestatusevent.FieldFromStatus,
+		})
+	}
+	if value, ok := iseuo.mutation.ToStatus(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicestatusevent.FieldToStatus,
+		})
+	}
+	if value, ok := iseuo.mutation.Reason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicestatusevent.FieldReason,
+		})
+	}
+	if iseuo.mutation.ReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: invoicestatusevent.FieldReason,
+		})
+	}
+	if value, ok := iseuo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicestatusevent.FieldUserID,
+		})
+	}
+	if value, ok := iseuo.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicestatusevent.FieldUserID,
+		})
+	}
+	_node = &InvoiceStatusEvent{config: iseuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iseuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicestatusevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
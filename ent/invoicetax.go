@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoicetax"
+	"Veritasbackend/pkg/money"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// InvoiceTax is the model entity for the InvoiceTax schema.
+type InvoiceTax struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Tipo de documento (invoice, purchase_invoice)
+	DocumentType string `json:"document_type,omitempty"`
+	// ID de la factura de venta o de compra
+	DocumentID int `json:"document_id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// ID de la tarifa aplicada
+	TaxRateID int `json:"tax_rate_id,omitempty"`
+	// Nombre de la tarifa al momento de la factura
+	Name string `json:"name,omitempty"`
+	// Tipo de tarifa al momento de la factura (taxable, exempt, excluded)
+	Kind string `json:"kind,omitempty"`
+	// Porcentaje de la tarifa al momento de la factura
+	Rate float64 `json:"rate,omitempty"`
+	// Base gravable de las líneas con esta tarifa
+	Base money.Amount `json:"base,omitempty"`
+	// Impuesto de las líneas con esta tarifa
+	Amount money.Amount `json:"amount,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvoiceTax) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoicetax.FieldBase, invoicetax.FieldAmount:
+			values[i] = new(money.Amount)
+		case invoicetax.FieldRate:
+			values[i] = new(sql.NullFloat64)
+		case invoicetax.FieldID, invoicetax.FieldDocumentID, invoicetax.FieldTenantID, invoicetax.FieldTaxRateID:
+			values[i] = new(sql.NullInt64)
+		case invoicetax.FieldDocumentType, invoicetax.FieldName, invoicetax.FieldKind:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type InvoiceTax", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvoiceTax fields.
+func (it *InvoiceTax) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoicetax.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			it.ID = int(value.Int64)
+		case invoicetax.FieldDocumentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_type", values[i])
+			} else if value.Valid {
+				it.DocumentType = value.String
+			}
+		case invoicetax.FieldDocumentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field document_id", values[i])
+			} else if value.Valid {
+				it.DocumentID = int(value.Int64)
+			}
+		case invoicetax.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				it.TenantID = int(value.Int64)
+			}
+		case invoicetax.FieldTaxRateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate_id", values[i])
+			} else if value.Valid {
+				it.TaxRateID = int(value.Int64)
+			}
+		case invoicetax.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				it.Name = value.String
+			}
+		case invoicetax.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				it.Kind = value.String
+			}
+		case invoicetax.FieldRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value.Valid {
+				it.Rate = value.Float64
+			}
+		case invoicetax.FieldBase:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field base", values[i])
+			} else if value != nil {
+				it.Base = *value
+			}
+		case invoicetax.FieldAmount:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				it.Amount = *value
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this InvoiceTax.
+// Note that you need to call InvoiceTax.Unwrap() before calling this method if this InvoiceTax
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (it *InvoiceTax) Update() *InvoiceTaxUpdateOne {
+	return (&InvoiceTaxClient{config: it.config}).UpdateOne(it)
+}
+
+// Unwrap unwraps the InvoiceTax entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (it *InvoiceTax) Unwrap() *InvoiceTax {
+	_tx, ok := it.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoiceTax is not a transactional entity")
+	}
+	it.config.driver = _tx.drv
+	return it
+}
+
+// String implements the fmt.Stringer.
+func (it *InvoiceTax) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvoiceTax(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", it.ID))
+	builder.WriteString("document_type=")
+	builder.WriteString(it.DocumentType)
+	builder.WriteString(", ")
+	builder.WriteString("document_id=")
+	builder.WriteString(fmt.Sprintf("%v", it.DocumentID))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", it.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("tax_rate_id=")
+	builder.WriteString(fmt.Sprintf("%v", it.TaxRateID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(it.Name)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(it.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", it.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("base=")
+	builder.WriteString(fmt.Sprintf("%v", it.Base))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", it.Amount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvoiceTaxes is a parsable slice of InvoiceTax.
+type InvoiceTaxes []*InvoiceTax
+
+func (it InvoiceTaxes) config(cfg config) {
+	for _i := range it {
+		it[_i].config = cfg
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoicetax
+
+import (
+	"Veritasbackend/pkg/money"
+)
+
+const (
+	// Label holds the string label denoting the invoicetax type in the database.
+	Label = "invoice_tax"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDocumentType holds the string denoting the document_type field in the database.
+	FieldDocumentType = "document_type"
+	// FieldDocumentID holds the string denoting the document_id field in the database.
+	FieldDocumentID = "document_id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldTaxRateID holds the string denoting the tax_rate_id field in the database.
+	FieldTaxRateID = "tax_rate_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldRate holds the string denoting the rate field in the database.
+	FieldRate = "rate"
+	// FieldBase holds the string denoting the base field in the database.
+	FieldBase = "base"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// Table holds the table name of the invoicetax in the database.
+	Table = "invoice_taxes"
+)
+
+// Columns holds all SQL columns for invoicetax fields.
+var Columns = []string{
+	FieldID,
+	FieldDocumentType,
+	FieldDocumentID,
+	FieldTenantID,
+	FieldTaxRateID,
+	FieldName,
+	FieldKind,
+	FieldRate,
+	FieldBase,
+	FieldAmount,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultRate holds the default value on creation for the "rate" field.
+	DefaultRate float64
+	// DefaultBase holds the default value on creation for the "base" field.
+	DefaultBase money.Amount
+	// BaseValidator is a validator for the "base" field. It is called by the builders before save.
+	BaseValidator func(int64) error
+	// DefaultAmount holds the default value on creation for the "amount" field.
+	DefaultAmount money.Amount
+)
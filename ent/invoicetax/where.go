@@ -0,0 +1,965 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoicetax
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// DocumentType applies equality check predicate on the "document_type" field. It's identical to DocumentTypeEQ.
+func DocumentType(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentID applies equality check predicate on the "document_id" field. It's identical to DocumentIDEQ.
+func DocumentID(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentID), v))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TaxRateID applies equality check predicate on the "tax_rate_id" field. It's identical to TaxRateIDEQ.
+func TaxRateID(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRateID), v))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKind), v))
+	})
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v float64) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRate), v))
+	})
+}
+
+// Base applies equality check predicate on the "base" field. It's identical to BaseEQ.
+func Base(v money.Amount) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBase), v))
+	})
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v money.Amount) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmount), v))
+	})
+}
+
+// DocumentTypeEQ applies the EQ predicate on the "document_type" field.
+func DocumentTypeEQ(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeNEQ applies the NEQ predicate on the "document_type" field.
+func DocumentTypeNEQ(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeIn applies the In predicate on the "document_type" field.
+func DocumentTypeIn(vs ...string) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDocumentType), v...))
+	})
+}
+
+// DocumentTypeNotIn applies the NotIn predicate on the "document_type" field.
+func DocumentTypeNotIn(vs ...string) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDocumentType), v...))
+	})
+}
+
+// DocumentTypeGT applies the GT predicate on the "document_type" field.
+func DocumentTypeGT(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeGTE applies the GTE predicate on the "document_type" field.
+func DocumentTypeGTE(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeLT applies the LT predicate on the "document_type" field.
+func DocumentTypeLT(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeLTE applies the LTE predicate on the "document_type" field.
+func DocumentTypeLTE(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeContains applies the Contains predicate on the "document_type" field.
+func DocumentTypeContains(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeHasPrefix applies the HasPrefix predicate on the "document_type" field.
+func DocumentTypeHasPrefix(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeHasSuffix applies the HasSuffix predicate on the "document_type" field.
+func DocumentTypeHasSuffix(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeEqualFold applies the EqualFold predicate on the "document_type" field.
+func DocumentTypeEqualFold(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeContainsFold applies the ContainsFold predicate on the "document_type" field.
+func DocumentTypeContainsFold(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentIDEQ applies the EQ predicate on the "document_id" field.
+func DocumentIDEQ(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentID), v))
+	})
+}
+
+// DocumentIDNEQ applies the NEQ predicate on the "document_id" field.
+func DocumentIDNEQ(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDocumentID), v))
+	})
+}
+
+// DocumentIDIn applies the In predicate on the "document_id" field.
+func DocumentIDIn(vs ...int) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDocumentID), v...))
+	})
+}
+
+// DocumentIDNotIn applies the NotIn predicate on the "document_id" field.
+func DocumentIDNotIn(vs ...int) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDocumentID), v...))
+	})
+}
+
+// DocumentIDGT applies the GT predicate on the "document_id" field.
+func DocumentIDGT(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDocumentID), v))
+	})
+}
+
+// DocumentIDGTE applies the GTE predicate on the "document_id" field.
+func DocumentIDGTE(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDocumentID), v))
+	})
+}
+
+// DocumentIDLT applies the LT predicate on the "document_id" field.
+func DocumentIDLT(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDocumentID), v))
+	})
+}
+
+// DocumentIDLTE applies the LTE predicate on the "document_id" field.
+func DocumentIDLTE(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDocumentID), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TaxRateIDEQ applies the EQ predicate on the "tax_rate_id" field.
+func TaxRateIDEQ(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDNEQ applies the NEQ predicate on the "tax_rate_id" field.
+func TaxRateIDNEQ(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDIn applies the In predicate on the "tax_rate_id" field.
+func TaxRateIDIn(vs ...int) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxRateID), v...))
+	})
+}
+
+// TaxRateIDNotIn applies the NotIn predicate on the "tax_rate_id" field.
+func TaxRateIDNotIn(vs ...int) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxRateID), v...))
+	})
+}
+
+// TaxRateIDGT applies the GT predicate on the "tax_rate_id" field.
+func TaxRateIDGT(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDGTE applies the GTE predicate on the "tax_rate_id" field.
+func TaxRateIDGTE(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDLT applies the LT predicate on the "tax_rate_id" field.
+func TaxRateIDLT(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDLTE applies the LTE predicate on the "tax_rate_id" field.
+func TaxRateIDLTE(v int) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxRateID), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKind), v))
+	})
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldKind), v))
+	})
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldKind), v...))
+	})
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldKind), v...))
+	})
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldKind), v))
+	})
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldKind), v))
+	})
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldKind), v))
+	})
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldKind), v))
+	})
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldKind), v))
+	})
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldKind), v))
+	})
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldKind), v))
+	})
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldKind), v))
+	})
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldKind), v))
+	})
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v float64) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRate), v))
+	})
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v float64) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRate), v))
+	})
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...float64) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRate), v...))
+	})
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...float64) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRate), v...))
+	})
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v float64) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRate), v))
+	})
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v float64) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRate), v))
+	})
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v float64) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRate), v))
+	})
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v float64) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRate), v))
+	})
+}
+
+// BaseEQ applies the EQ predicate on the "base" field.
+func BaseEQ(v money.Amount) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBase), v))
+	})
+}
+
+// BaseNEQ applies the NEQ predicate on the "base" field.
+func BaseNEQ(v money.Amount) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBase), v))
+	})
+}
+
+// BaseIn applies the In predicate on the "base" field.
+func BaseIn(vs ...money.Amount) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBase), v...))
+	})
+}
+
+// BaseNotIn applies the NotIn predicate on the "base" field.
+func BaseNotIn(vs ...money.Amount) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBase), v...))
+	})
+}
+
+// BaseGT applies the GT predicate on the "base" field.
+func BaseGT(v money.Amount) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBase), v))
+	})
+}
+
+// BaseGTE applies the GTE predicate on the "base" field.
+func BaseGTE(v money.Amount) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBase), v))
+	})
+}
+
+// BaseLT applies the LT predicate on the "base" field.
+func BaseLT(v money.Amount) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBase), v))
+	})
+}
+
+// BaseLTE applies the LTE predicate on the "base" field.
+func BaseLTE(v money.Amount) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBase), v))
+	})
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v money.Amount) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmount), v))
+	})
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v money.Amount) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAmount), v))
+	})
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...money.Amount) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAmount), v...))
+	})
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...money.Amount) predicate.InvoiceTax {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAmount), v...))
+	})
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v money.Amount) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAmount), v))
+	})
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v money.Amount) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAmount), v))
+	})
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v money.Amount) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAmount), v))
+	})
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v money.Amount) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAmount), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InvoiceTax) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InvoiceTax) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InvoiceTax) predicate.InvoiceTax {
+	return predicate.InvoiceTax(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
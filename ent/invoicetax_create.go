@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoicetax"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceTaxCreate is the builder for creating a InvoiceTax entity.
+type InvoiceTaxCreate struct {
+	config
+	mutation *InvoiceTaxMutation
+	hooks    []Hook
+}
+
+// SetDocumentType sets the "document_type" field.
+func (itc *InvoiceTaxCreate) SetDocumentType(s string) *InvoiceTaxCreate {
+	itc.mutation.SetDocumentType(s)
+	return itc
+}
+
+// SetDocumentID sets the "document_id" field.
+func (itc *InvoiceTaxCreate) SetDocumentID(i int) *InvoiceTaxCreate {
+	itc.mutation.SetDocumentID(i)
+	return itc
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (itc *InvoiceTaxCreate) SetTenantID(i int) *InvoiceTaxCreate {
+	itc.mutation.SetTenantID(i)
+	return itc
+}
+
+// SetTaxRateID sets the "tax_rate_id" field.
+func (itc *InvoiceTaxCreate) SetTaxRateID(i int) *InvoiceTaxCreate {
+	itc.mutation.SetTaxRateID(i)
+	return itc
+}
+
+// SetName sets the "name" field.
+func (itc *InvoiceTaxCreate) SetName(s string) *InvoiceTaxCreate {
+	itc.mutation.SetName(s)
+	return itc
+}
+
+// SetKind sets the "kind" field.
+func (itc *InvoiceTaxCreate) SetKind(s string) *InvoiceTaxCreate {
+	itc.mutation.SetKind(s)
+	return itc
+}
+
+// SetRate sets the "rate" field.
+func (itc *InvoiceTaxCreate) SetRate(f float64) *InvoiceTaxCreate {
+	itc.mutation.SetRate(f)
+	return itc
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (itc *InvoiceTaxCreate) SetNillableRate(f *float64) *InvoiceTaxCreate {
+	if f != nil {
+		itc.SetRate(*f)
+	}
+	return itc
+}
+
+// SetBase sets the "base" field.
+func (itc *InvoiceTaxCreate) SetBase(m money.Amount) *InvoiceTaxCreate {
+	itc.mutation.SetBase(m)
+	return itc
+}
+
+// SetNillableBase sets the "base" field if the given value is not nil.
+func (itc *InvoiceTaxCreate) SetNillableBase(m *money.Amount) *InvoiceTaxCreate {
+	if m != nil {
+		itc.SetBase(*m)
+	}
+	return itc
+}
+
+// SetAmount sets the "amount" field.
+func (itc *InvoiceTaxCreate) SetAmount(m money.Amount) *InvoiceTaxCreate {
+	itc.mutation.SetAmount(m)
+	return itc
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (itc *InvoiceTaxCreate) SetNillableAmount(m *money.Amount) *InvoiceTaxCreate {
+	if m != nil {
+		itc.SetAmount(*m)
+	}
+	return itc
+}
+
+// Mutation returns the InvoiceTaxMutation object of the builder.
+func (itc *InvoiceTaxCreate) Mutation() *InvoiceTaxMutation {
+	return itc.mutation
+}
+
+// Save creates the InvoiceTax in the database.
+func (itc *InvoiceTaxCreate) Save(ctx context.Context) (*InvoiceTax, error) {
+	var (
+		err  error
+		node *InvoiceTax
+	)
+	itc.defaults()
+	if len(itc.hooks) == 0 {
+		if err = itc.check(); err != nil {
+			return nil, err
+		}
+		node, err = itc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceTaxMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = itc.check(); err != nil {
+				return nil, err
+			}
+			itc.mutation = mutation
+			if node, err = itc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(itc.hooks) - 1; i >= 0; i-- {
+			if itc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = itc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, itc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InvoiceTax)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InvoiceTaxMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (itc *InvoiceTaxCreate) SaveX(ctx context.Context) *InvoiceTax {
+	v, err := itc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (itc *InvoiceTaxCreate) Exec(ctx context.Context) error {
+	_, err := itc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itc *InvoiceTaxCreate) ExecX(ctx context.Context) {
+	if err := itc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (itc *InvoiceTaxCreate) defaults() {
+	if _, ok := itc.mutation.Rate(); !ok {
+		v := invoicetax.DefaultRate
+		itc.mutation.SetRate(v)
+	}
+	if _, ok := itc.mutation.Base(); !ok {
+		v := invoicetax.DefaultBase
+		itc.mutation.SetBase(v)
+	}
+	if _, ok := itc.mutation.Amount(); !ok {
+		v := invoicetax.DefaultAmount
+		itc.mutation.SetAmount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (itc *InvoiceTaxCreate) check() error {
+	if _, ok := itc.mutation.DocumentType(); !ok {
+		return &ValidationError{Name: "document_type", err: errors.New(`ent: missing required field "InvoiceTax.document_type"`)}
+	}
+	if _, ok := itc.mutation.DocumentID(); !ok {
+		return &ValidationError{Name: "document_id", err: errors.New(`ent: missing required field "InvoiceTax.document_id"`)}
+	}
+	if _, ok := itc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "InvoiceTax.tenant_id"`)}
+	}
+	if _, ok := itc.mutation.TaxRateID(); !ok {
+		return &ValidationError{Name: "tax_rate_id", err: errors.New(`ent: missing required field "InvoiceTax.tax_rate_id"`)}
+	}
+	if _, ok := itc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "InvoiceTax.name"`)}
+	}
+	if _, ok := itc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "InvoiceTax.kind"`)}
+	}
+	if _, ok := itc.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "InvoiceTax.rate"`)}
+	}
+	if _, ok := itc.mutation.Base(); !ok {
+		return &ValidationError{Name: "base", err: errors.New(`ent: missing required field "InvoiceTax.base"`)}
+	}
+	if v, ok := itc.mutation.Base(); ok {
+		if err := invoicetax.BaseValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "base", err: fmt.Errorf(`ent: validator failed for field "InvoiceTax.base": %w`, err)}
+		}
+	}
+	if _, ok := itc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "InvoiceTax.amount"`)}
+	}
+	return nil
+}
+
+func (itc *InvoiceTaxCreate) sqlSave(ctx context.Context) (*InvoiceTax, error) {
+	_node, _spec := itc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, itc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (itc *InvoiceTaxCreate) createSpec() (*InvoiceTax, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvoiceTax{config: itc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: invoicetax.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoicetax.FieldID,
+			},
+		}
+	)
+	if value, ok := itc.mutation.DocumentType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicetax.FieldDocumentType,
+		})
+		_node.DocumentType = value
+	}
+	if value, ok := itc.mutation.DocumentID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicetax.FieldDocumentID,
+		})
+		_node.DocumentID = value
+	}
+	if value, ok := itc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicetax.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := itc.mutation.TaxRateID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicetax.FieldTaxRateID,
+		})
+		_node.TaxRateID = value
+	}
+	if value, ok := itc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicetax.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := itc.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicetax.FieldKind,
+		})
+		_node.Kind = value
+	}
+	if value, ok := itc.mutation.Rate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoicetax.FieldRate,
+		})
+		_node.Rate = value
+	}
+	if value, ok := itc.mutation.Base(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoicetax.FieldBase,
+		})
+		_node.Base = value
+	}
+	if value, ok := itc.mutation.Amount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoicetax.FieldAmount,
+		})
+		_node.Amount = value
+	}
+	return _node, _spec
+}
+
+// InvoiceTaxCreateBulk is the builder for creating many InvoiceTax entities in bulk.
+type InvoiceTaxCreateBulk struct {
+	config
+	builders []*InvoiceTaxCreate
+}
+
+// Save creates the InvoiceTax entities in the database.
+func (itcb *InvoiceTaxCreateBulk) Save(ctx context.Context) ([]*InvoiceTax, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(itcb.builders))
+	nodes := make([]*InvoiceTax, len(itcb.builders))
+	mutators := make([]Mutator, len(itcb.builders))
+	for i := range itcb.builders {
+		func(i int, root context.Context) {
+			builder := itcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceTaxMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, itcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, itcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, itcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (itcb *InvoiceTaxCreateBulk) SaveX(ctx context.Context) []*InvoiceTax {
+	v, err := itcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (itcb *InvoiceTaxCreateBulk) Exec(ctx context.Context) error {
+	_, err := itcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itcb *InvoiceTaxCreateBulk) ExecX(ctx context.Context) {
+	if err := itcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoicetax"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceTaxDelete is the builder for deleting a InvoiceTax entity.
+type InvoiceTaxDelete struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceTaxMutation
+}
+
+// Where appends a list predicates to the InvoiceTaxDelete builder.
+func (itd *InvoiceTaxDelete) Where(ps ...predicate.InvoiceTax) *InvoiceTaxDelete {
+	itd.mutation.Where(ps...)
+	return itd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (itd *InvoiceTaxDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(itd.hooks) == 0 {
+		affected, err = itd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceTaxMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			itd.mutation = mutation
+			affected, err = itd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(itd.hooks) - 1; i >= 0; i-- {
+			if itd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = itd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, itd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itd *InvoiceTaxDelete) ExecX(ctx context.Context) int {
+	n, err := itd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (itd *InvoiceTaxDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: invoicetax.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoicetax.FieldID,
+			},
+		},
+	}
+	if ps := itd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, itd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// InvoiceTaxDeleteOne is the builder for deleting a single InvoiceTax entity.
+type InvoiceTaxDeleteOne struct {
+	itd *InvoiceTaxDelete
+}
+
+// Exec executes the deletion query.
+func (itdo *InvoiceTaxDeleteOne) Exec(ctx context.Context) error {
+	n, err := itdo.itd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{invoicetax.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itdo *InvoiceTaxDeleteOne) ExecX(ctx context.Context) {
+	itdo.itd.ExecX(ctx)
+}
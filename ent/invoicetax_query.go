@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoicetax"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceTaxQuery is the builder for querying InvoiceTax entities.
+type InvoiceTaxQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.InvoiceTax
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InvoiceTaxQuery builder.
+func (itq *InvoiceTaxQuery) Where(ps ...predicate.InvoiceTax) *InvoiceTaxQuery {
+	itq.predicates = append(itq.predicates, ps...)
+	return itq
+}
+
+// Limit adds a limit step to the query.
+func (itq *InvoiceTaxQuery) Limit(limit int) *InvoiceTaxQuery {
+	itq.limit = &limit
+	return itq
+}
+
+// Offset adds an offset step to the query.
+func (itq *InvoiceTaxQuery) Offset(offset int) *InvoiceTaxQuery {
+	itq.offset = &offset
+	return itq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (itq *InvoiceTaxQuery) Unique(unique bool) *InvoiceTaxQuery {
+	itq.unique = &unique
+	return itq
+}
+
+// Order adds an order step to the query.
+func (itq *InvoiceTaxQuery) Order(o ...OrderFunc) *InvoiceTaxQuery {
+	itq.order = append(itq.order, o...)
+	return itq
+}
+
+// First returns the first InvoiceTax entity from the query.
+// Returns a *NotFoundError when no InvoiceTax was found.
+func (itq *InvoiceTaxQuery) First(ctx context.Context) (*InvoiceTax, error) {
+	nodes, err := itq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{invoicetax.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (itq *InvoiceTaxQuery) FirstX(ctx context.Context) *InvoiceTax {
+	node, err := itq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InvoiceTax ID from the query.
+// Returns a *NotFoundError when no InvoiceTax ID was found.
+func (itq *InvoiceTaxQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = itq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{invoicetax.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (itq *InvoiceTaxQuery) FirstIDX(ctx context.Context) int {
+	id, err := itq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InvoiceTax entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InvoiceTax entity is found.
+// Returns a *NotFoundError when no InvoiceTax entities are found.
+func (itq *InvoiceTaxQuery) Only(ctx context.Context) (*InvoiceTax, error) {
+	nodes, err := itq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{invoicetax.Label}
+	default:
+		return nil, &NotSingularError{invoicetax.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (itq *InvoiceTaxQuery) OnlyX(ctx context.Context) *InvoiceTax {
+	node, err := itq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InvoiceTax ID in the query.
+// Returns a *NotSingularError when more than one InvoiceTax ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (itq *InvoiceTaxQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = itq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{invoicetax.Label}
+	default:
+		err = &NotSingularError{invoicetax.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (itq *InvoiceTaxQuery) OnlyIDX(ctx context.Context) int {
+	id, err := itq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InvoiceTaxes.
+func (itq *InvoiceTaxQuery) All(ctx context.Context) ([]*InvoiceTax, error) {
+	if err := itq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return itq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (itq *InvoiceTaxQuery) AllX(ctx context.Context) []*InvoiceTax {
+	nodes, err := itq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InvoiceTax IDs.
+func (itq *InvoiceTaxQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := itq.Select(invoicetax.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (itq *InvoiceTaxQuery) IDsX(ctx context.Context) []int {
+	ids, err := itq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (itq *InvoiceTaxQuery) Count(ctx context.Context) (int, error) {
+	if err := itq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return itq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (itq *InvoiceTaxQuery) CountX(ctx context.Context) int {
+	count, err := itq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (itq *InvoiceTaxQuery) Exist(ctx context.Context) (bool, error) {
+	if err := itq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return itq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (itq *InvoiceTaxQuery) ExistX(ctx context.Context) bool {
+	exist, err := itq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InvoiceTaxQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (itq *InvoiceTaxQuery) Clone() *InvoiceTaxQuery {
+	if itq == nil {
+		return nil
+	}
+	return &InvoiceTaxQuery{
+		config:     itq.config,
+		limit:      itq.limit,
+		offset:     itq.offset,
+		order:      append([]OrderFunc{}, itq.order...),
+		predicates: append([]predicate.InvoiceTax{}, itq.predicates...),
+		// clone intermediate query.
+		sql:    itq.sql.Clone(),
+		path:   itq.path,
+		unique: itq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		DocumentType string `json:"document_type,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InvoiceTax.Query().
+//		GroupBy(invoicetax.FieldDocumentType).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (itq *InvoiceTaxQuery) GroupBy(field string, fields ...string) *InvoiceTaxGroupBy {
+	grbuild := &InvoiceTaxGroupBy{config: itq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := itq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return itq.sqlQuery(ctx), nil
+	}
+	grbuild.label = invoicetax.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		DocumentType string `json:"document_type,omitempty"`
+//	}
+//
+//	client.InvoiceTax.Query().
+//		Select(invoicetax.FieldDocumentType).
+//		Scan(ctx, &v)
+func (itq *InvoiceTaxQuery) Select(fields ...string) *InvoiceTaxSelect {
+	itq.fields = append(itq.fields, fields...)
+	selbuild := &InvoiceTaxSelect{InvoiceTaxQuery: itq}
+	selbuild.label = invoicetax.Label
+	selbuild.flds, selbuild.scan = &itq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (itq *InvoiceTaxQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range itq.fields {
+		if !invoicetax.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if itq.path != nil {
+		prev, err := itq.path(ctx)
+		if err != nil {
+			return err
+		}
+		itq.sql = prev
+	}
+	return nil
+}
+
+func (itq *InvoiceTaxQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InvoiceTax, error) {
+	var (
+		nodes = []*InvoiceTax{}
+		_spec = itq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*InvoiceTax).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &InvoiceTax{config: itq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(itq.modifiers) > 0 {
+		_spec.Modifiers = itq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, itq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (itq *InvoiceTaxQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := itq.querySpec()
+	if len(itq.modifiers) > 0 {
+		_spec.Modifiers = itq.modifiers
+	}
+	_spec.Node.Columns = itq.fields
+	if len(itq.fields) > 0 {
+		_spec.Unique = itq.unique != nil && *itq.unique
+	}
+	return sqlgraph.CountNodes(ctx, itq.driver, _spec)
+}
+
+func (itq *InvoiceTaxQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := itq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (itq *InvoiceTaxQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoicetax.Table,
+			Columns: invoicetax.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoicetax.FieldID,
+			},
+		},
+		From:   itq.sql,
+		Unique: true,
+	}
+	if unique := itq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := itq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoicetax.FieldID)
+		for i := range fields {
+			if fields[i] != invoicetax.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := itq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := itq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := itq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := itq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (itq *InvoiceTaxQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(itq.driver.Dialect())
+	t1 := builder.Table(invoicetax.Table)
+	columns := itq.fields
+	if len(columns) == 0 {
+		columns = invoicetax.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if itq.sql != nil {
+		selector = itq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if itq.unique != nil && *itq.unique {
+		selector.Distinct()
+	}
+	for _, m := range itq.modifiers {
+		m(selector)
+	}
+	for _, p := range itq.predicates {
+		p(selector)
+	}
+	for _, p := range itq.order {
+		p(selector)
+	}
+	if offset := itq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := itq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (itq *InvoiceTaxQuery) ForUpdate(opts ...sql.LockOption) *InvoiceTaxQuery {
+	if itq.driver.Dialect() == dialect.Postgres {
+		itq.Unique(false)
+	}
+	itq.modifiers = append(itq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return itq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (itq *InvoiceTaxQuery) ForShare(opts ...sql.LockOption) *InvoiceTaxQuery {
+	if itq.driver.Dialect() == dialect.Postgres {
+		itq.Unique(false)
+	}
+	itq.modifiers = append(itq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return itq
+}
+
+// InvoiceTaxGroupBy is the group-by builder for InvoiceTax entities.
+type InvoiceTaxGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (itgb *InvoiceTaxGroupBy) Aggregate(fns ...AggregateFunc) *InvoiceTaxGroupBy {
+	itgb.fns = append(itgb.fns, fns...)
+	return itgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (itgb *InvoiceTaxGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := itgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	itgb.sql = query
+	return itgb.sqlScan(ctx, v)
+}
+
+func (itgb *InvoiceTaxGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range itgb.fields {
+		if !invoicetax.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := itgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := itgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (itgb *InvoiceTaxGroupBy) sqlQuery() *sql.Selector {
+	selector := itgb.sql.Select()
+	aggregation := make([]string, 0, len(itgb.fns))
+	for _, fn := range itgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(itgb.fields)+len(itgb.fns))
+		for _, f := range itgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(itgb.fields...)...)
+}
+
+// InvoiceTaxSelect is the builder for selecting fields of InvoiceTax entities.
+type InvoiceTaxSelect struct {
+	*InvoiceTaxQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (its *InvoiceTaxSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := its.prepareQuery(ctx); err != nil {
+		return err
+	}
+	its.sql = its.InvoiceTaxQuery.sqlQuery(ctx)
+	return its.sqlScan(ctx, v)
+}
+
+func (its *InvoiceTaxSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := its.sql.Query()
+	if err := its.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,728 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/invoicetax"
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceTaxUpdate is the builder for updating InvoiceTax entities.
+type InvoiceTaxUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceTaxMutation
+}
+
+// Where appends a list predicates to the InvoiceTaxUpdate builder.
+func (itu *InvoiceTaxUpdate) Where(ps ...predicate.InvoiceTax) *InvoiceTaxUpdate {
+	itu.mutation.Where(ps...)
+	return itu
+}
+
+// SetDocumentType sets the "document_type" field.
+func (itu *InvoiceTaxUpdate) SetDocumentType(s string) *InvoiceTaxUpdate {
+	itu.mutation.SetDocumentType(s)
+	return itu
+}
+
+// SetDocumentID sets the "document_id" field.
+func (itu *InvoiceTaxUpdate) SetDocumentID(i int) *InvoiceTaxUpdate {
+	itu.mutation.ResetDocumentID()
+	itu.mutation.SetDocumentID(i)
+	return itu
+}
+
+// AddDocumentID adds i to the "document_id" field.
+func (itu *InvoiceTaxUpdate) AddDocumentID(i int) *InvoiceTaxUpdate {
+	itu.mutation.AddDocumentID(i)
+	return itu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (itu *InvoiceTaxUpdate) SetTenantID(i int) *InvoiceTaxUpdate {
+	itu.mutation.ResetTenantID()
+	itu.mutation.SetTenantID(i)
+	return itu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (itu *InvoiceTaxUpdate) AddTenantID(i int) *InvoiceTaxUpdate {
+	itu.mutation.AddTenantID(i)
+	return itu
+}
+
+// SetTaxRateID sets the "tax_rate_id" field.
+func (itu *InvoiceTaxUpdate) SetTaxRateID(i int) *InvoiceTaxUpdate {
+	itu.mutation.ResetTaxRateID()
+	itu.mutation.SetTaxRateID(i)
+	return itu
+}
+
+// AddTaxRateID adds i to the "tax_rate_id" field.
+func (itu *InvoiceTaxUpdate) AddTaxRateID(i int) *InvoiceTaxUpdate {
+	itu.mutation.AddTaxRateID(i)
+	return itu
+}
+
+// SetName sets the "name" field.
+func (itu *InvoiceTaxUpdate) SetName(s string) *InvoiceTaxUpdate {
+	itu.mutation.SetName(s)
+	return itu
+}
+
+// SetKind sets the "kind" field.
+func (itu *InvoiceTaxUpdate) SetKind(s string) *InvoiceTaxUpdate {
+	itu.mutation.SetKind(s)
+	return itu
+}
+
+// SetRate sets the "rate" field.
+func (itu *InvoiceTaxUpdate) SetRate(f float64) *InvoiceTaxUpdate {
+	itu.mutation.ResetRate()
+	itu.mutation.SetRate(f)
+	return itu
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (itu *InvoiceTaxUpdate) SetNillableRate(f *float64) *InvoiceTaxUpdate {
+	if f != nil {
+		itu.SetRate(*f)
+	}
+	return itu
+}
+
+// AddRate adds f to the "rate" field.
+func (itu *InvoiceTaxUpdate) AddRate(f float64) *InvoiceTaxUpdate {
+	itu.mutation.AddRate(f)
+	return itu
+}
+
+// SetBase sets the "base" field.
+func (itu *InvoiceTaxUpdate) SetBase(m money.Amount) *InvoiceTaxUpdate {
+	itu.mutation.ResetBase()
+	itu.mutation.SetBase(m)
+	return itu
+}
+
+// SetNillableBase sets the "base" field if the given value is not nil.
+func (itu *InvoiceTaxUpdate) SetNillableBase(m *money.Amount) *InvoiceTaxUpdate {
+	if m != nil {
+		itu.SetBase(*m)
+	}
+	return itu
+}
+
+// AddBase adds m to the "base" field.
+func (itu *InvoiceTaxUpdate) AddBase(m money.Amount) *InvoiceTaxUpdate {
+	itu.mutation.AddBase(m)
+	return itu
+}
+
+// SetAmount sets the "amount" field.
+func (itu *InvoiceTaxUpdate) SetAmount(m money.Amount) *InvoiceTaxUpdate {
+	itu.mutation.ResetAmount()
+	itu.mutation.SetAmount(m)
+	return itu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (itu *InvoiceTaxUpdate) SetNillableAmount(m *money.Amount) *InvoiceTaxUpdate {
+	if m != nil {
+		itu.SetAmount(*m)
+	}
+	return itu
+}
+
+// AddAmount adds m to the "amount" field.
+func (itu *InvoiceTaxUpdate) AddAmount(m money.Amount) *InvoiceTaxUpdate {
+	itu.mutation.AddAmount(m)
+	return itu
+}
+
+// Mutation returns the InvoiceTaxMutation object of the builder.
+func (itu *InvoiceTaxUpdate) Mutation() *InvoiceTaxMutation {
+	return itu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (itu *InvoiceTaxUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(itu.hooks) == 0 {
+		if err = itu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = itu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceTaxMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = itu.check(); err != nil {
+				return 0, err
+			}
+			itu.mutation = mutation
+			affected, err = itu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(itu.hooks) - 1; i >= 0; i-- {
+			if itu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = itu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, itu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (itu *InvoiceTaxUpdate) SaveX(ctx context.Context) int {
+	affected, err := itu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (itu *InvoiceTaxUpdate) Exec(ctx context.Context) error {
+	_, err := itu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itu *InvoiceTaxUpdate) ExecX(ctx context.Context) {
+	if err := itu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (itu *InvoiceTaxUpdate) check() error {
+	if v, ok := itu.mutation.Base(); ok {
+		if err := invoicetax.BaseValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "base", err: fmt.Errorf(`ent: validator failed for field "InvoiceTax.base": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (itu *InvoiceTaxUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoicetax.Table,
+			Columns: invoicetax.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoicetax.FieldID,
+			},
+		},
+	}
+	if ps := itu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := itu.mutation.DocumentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicetax.FieldDocumentType,
+		})
+	}
+	if value, ok := itu.mutation.DocumentID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicetax.FieldDocumentID,
+		})
+	}
+	if value, ok := itu.mutation.AddedDocumentID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicetax.FieldDocumentID,
+		})
+	}
+	if value, ok := itu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicetax.FieldTenantID,
+		})
+	}
+	if value, ok := itu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicetax.FieldTenantID,
+		})
+	}
+	if value, ok := itu.mutation.TaxRateID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicetax.FieldTaxRateID,
+		})
+	}
+	if value, ok := itu.mutation.AddedTaxRateID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicetax.FieldTaxRateID,
+		})
+	}
+	if value, ok := itu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicetax.FieldName,
+		})
+	}
+	if value, ok := itu.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicetax.FieldKind,
+		})
+	}
+	if value, ok := itu.mutation.Rate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoicetax.FieldRate,
+		})
+	}
+	if value, ok := itu.mutation.AddedRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoicetax.FieldRate,
+		})
+	}
+	if value, ok := itu.mutation.Base(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoicetax.FieldBase,
+		})
+	}
+	if value, ok := itu.mutation.AddedBase(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoicetax.FieldBase,
+		})
+	}
+	if value, ok := itu.mutation.Amount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoicetax.FieldAmount,
+		})
+	}
+	if value, ok := itu.mutation.AddedAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoicetax.FieldAmount,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, itu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicetax.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InvoiceTaxUpdateOne is the builder for updating a single InvoiceTax entity.
+type InvoiceTaxUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceTaxMutation
+}
+
+// SetDocumentType sets the "document_type" field.
+func (ituo *InvoiceTaxUpdateOne) SetDocumentType(s string) *InvoiceTaxUpdateOne {
+	ituo.mutation.SetDocumentType(s)
+	return ituo
+}
+
+// SetDocumentID sets the "document_id" field.
+func (ituo *InvoiceTaxUpdateOne) SetDocumentID(i int) *InvoiceTaxUpdateOne {
+	ituo.mutation.ResetDocumentID()
+	ituo.mutation.SetDocumentID(i)
+	return ituo
+}
+
+// AddDocumentID adds i to the "document_id" field.
+func (ituo *InvoiceTaxUpdateOne) AddDocumentID(i int) *InvoiceTaxUpdateOne {
+	ituo.mutation.AddDocumentID(i)
+	return ituo
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ituo *InvoiceTaxUpdateOne) SetTenantID(i int) *InvoiceTaxUpdateOne {
+	ituo.mutation.ResetTenantID()
+	ituo.mutation.SetTenantID(i)
+	return ituo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (ituo *InvoiceTaxUpdateOne) AddTenantID(i int) *InvoiceTaxUpdateOne {
+	ituo.mutation.AddTenantID(i)
+	return ituo
+}
+
+// SetTaxRateID sets the "tax_rate_id" field.
+func (ituo *InvoiceTaxUpdateOne) SetTaxRateID(i int) *InvoiceTaxUpdateOne {
+	ituo.mutation.ResetTaxRateID()
+	ituo.mutation.SetTaxRateID(i)
+	return ituo
+}
+
+// AddTaxRateID adds i to the "tax_rate_id" field.
+func (ituo *InvoiceTaxUpdateOne) AddTaxRateID(i int) *InvoiceTaxUpdateOne {
+	ituo.mutation.AddTaxRateID(i)
+	return ituo
+}
+
+// SetName sets the "name" field.
+func (ituo *InvoiceTaxUpdateOne) SetName(s string) *InvoiceTaxUpdateOne {
+	ituo.mutation.SetName(s)
+	return ituo
+}
+
+// SetKind sets the "kind" field.
+func (ituo *InvoiceTaxUpdateOne) SetKind(s string) *InvoiceTaxUpdateOne {
+	ituo.mutation.SetKind(s)
+	return ituo
+}
+
+// SetRate sets the "rate" field.
+func (ituo *InvoiceTaxUpdateOne) SetRate(f float64) *InvoiceTaxUpdateOne {
+	ituo.mutation.ResetRate()
+	ituo.mutation.SetRate(f)
+	return ituo
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (ituo *InvoiceTaxUpdateOne) SetNillableRate(f *float64) *InvoiceTaxUpdateOne {
+	if f != nil {
+		ituo.SetRate(*f)
+	}
+	return ituo
+}
+
+// AddRate adds f to the "rate" field.
+func (ituo *InvoiceTaxUpdateOne) AddRate(f float64) *InvoiceTaxUpdateOne {
+	ituo.mutation.AddRate(f)
+	return ituo
+}
+
+// SetBase sets the "base" field.
+func (ituo *InvoiceTaxUpdateOne) SetBase(m money.Amount) *InvoiceTaxUpdateOne {
+	ituo.mutation.ResetBase()
+	ituo.mutation.SetBase(m)
+	return ituo
+}
+
+// SetNillableBase sets the "base" field if the given value is not nil.
+func (ituo *InvoiceTaxUpdateOne) SetNillableBase(m *money.Amount) *InvoiceTaxUpdateOne {
+	if m != nil {
+		ituo.SetBase(*m)
+	}
+	return ituo
+}
+
+// AddBase adds m to the "base" field.
+func (ituo *InvoiceTaxUpdateOne) AddBase(m money.Amount) *InvoiceTaxUpdateOne {
+	ituo.mutation.AddBase(m)
+	return ituo
+}
+
+// SetAmount sets the "amount" field.
+func (ituo *InvoiceTaxUpdateOne) SetAmount(m money.Amount) *InvoiceTaxUpdateOne {
+	ituo.mutation.ResetAmount()
+	ituo.mutation.SetAmount(m)
+	return ituo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (ituo *InvoiceTaxUpdateOne) SetNillableAmount(m *money.Amount) *InvoiceTaxUpdateOne {
+	if m != nil {
+		ituo.SetAmount(*m)
+	}
+	return ituo
+}
+
+// AddAmount adds m to the "amount" field.
+func (ituo *InvoiceTaxUpdateOne) AddAmount(m money.Amount) *InvoiceTaxUpdateOne {
+	ituo.mutation.AddAmount(m)
+	return ituo
+}
+
+// Mutation returns the InvoiceTaxMutation object of the builder.
+func (ituo *InvoiceTaxUpdateOne) Mutation() *InvoiceTaxMutation {
+	return ituo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ituo *InvoiceTaxUpdateOne) Select(field string, fields ...string) *InvoiceTaxUpdateOne {
+	ituo.fields = append([]string{field}, fields...)
+	return ituo
+}
+
+// Save executes the query and returns the updated InvoiceTax entity.
+func (ituo *InvoiceTaxUpdateOne) Save(ctx context.Context) (*InvoiceTax, error) {
+	var (
+		err  error
+		node *InvoiceTax
+	)
+	if len(ituo.hooks) == 0 {
+		if err = ituo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ituo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceTaxMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ituo.check(); err != nil {
+				return nil, err
+			}
+			ituo.mutation = mutation
+			node, err = ituo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ituo.hooks) - 1; i >= 0; i-- {
+			if ituo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ituo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ituo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*InvoiceTax)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from InvoiceTaxMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ituo *InvoiceTaxUpdateOne) SaveX(ctx context.Context) *InvoiceTax {
+	node, err := ituo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ituo *InvoiceTaxUpdateOne) Exec(ctx context.Context) error {
+	_, err := ituo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ituo *InvoiceTaxUpdateOne) ExecX(ctx context.Context) {
+	if err := ituo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ituo *InvoiceTaxUpdateOne) check() error {
+	if v, ok := ituo.mutation.Base(); ok {
+		if err := invoicetax.BaseValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "base", err: fmt.Errorf(`ent: validator failed for field "InvoiceTax.base": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ituo *InvoiceTaxUpdateOne) sqlSave(ctx context.Context) (_node *InvoiceTax, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoicetax.Table,
+			Columns: invoicetax.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoicetax.FieldID,
+			},
+		},
+	}
+	id, ok := ituo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InvoiceTax.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ituo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoicetax.FieldID)
+		for _, f := range fields {
+			if !invoicetax.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoicetax.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ituo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ituo.mutation.DocumentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicetax.FieldDocumentType,
+		})
+	}
+	if value, ok := ituo.mutation.DocumentID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicetax.FieldDocumentID,
+		})
+	}
+	if value, ok := ituo.mutation.AddedDocumentID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicetax.FieldDocumentID,
+		})
+	}
+	if value, ok := ituo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicetax.FieldTenantID,
+		})
+	}
+	if value, ok := ituo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicetax.FieldTenantID,
+		})
+	}
+	if value, ok := ituo.mutation.TaxRateID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicetax.FieldTaxRateID,
+		})
+	}
+	if value, ok := ituo.mutation.AddedTaxRateID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoicetax.FieldTaxRateID,
+		})
+	}
+	if value, ok := ituo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicetax.FieldName,
+		})
+	}
+	if value, ok := ituo.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invoicetax.FieldKind,
+		})
+	}
+	if value, ok := ituo.mutation.Rate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoicetax.FieldRate,
+		})
+	}
+	if value, ok := ituo.mutation.AddedRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: invoicetax.FieldRate,
+		})
+	}
+	if value, ok := ituo.mutation.Base(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoicetax.FieldBase,
+		})
+	}
+	if value, ok := ituo.mutation.AddedBase(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoicetax.FieldBase,
+		})
+	}
+	if value, ok := ituo.mutation.Amount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoicetax.FieldAmount,
+		})
+	}
+	if value, ok := ituo.mutation.AddedAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: invoicetax.FieldAmount,
+		})
+	}
+	_node = &InvoiceTax{config: ituo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ituo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicetax.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
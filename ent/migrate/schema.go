@@ -8,13 +8,395 @@ import (
 )
 
 var (
+	// AdjustmentReasonsColumns holds the columns for the "adjustment_reasons" table.
+	AdjustmentReasonsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "code", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "write_off", Type: field.TypeBool, Default: true},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AdjustmentReasonsTable holds the schema information for the "adjustment_reasons" table.
+	AdjustmentReasonsTable = &schema.Table{
+		Name:       "adjustment_reasons",
+		Columns:    AdjustmentReasonsColumns,
+		PrimaryKey: []*schema.Column{AdjustmentReasonsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adjustmentreason_tenant_id_code",
+				Unique:  true,
+				Columns: []*schema.Column{AdjustmentReasonsColumns[1], AdjustmentReasonsColumns[2]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "action", Type: field.TypeString},
+		{Name: "entity", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeInt, Nullable: true},
+		{Name: "summary", Type: field.TypeString},
+		{Name: "details", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_tenant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[8]},
+			},
+			{
+				Name:    "auditlog_tenant_id_entity_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[4], AuditLogsColumns[5]},
+			},
+		},
+	}
+	// BundleAssembliesColumns holds the columns for the "bundle_assemblies" table.
+	BundleAssembliesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "bundle_id", Type: field.TypeInt},
+		{Name: "quantity", Type: field.TypeFloat64},
+		{Name: "unit_cost", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BundleAssembliesTable holds the schema information for the "bundle_assemblies" table.
+	BundleAssembliesTable = &schema.Table{
+		Name:       "bundle_assemblies",
+		Columns:    BundleAssembliesColumns,
+		PrimaryKey: []*schema.Column{BundleAssembliesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bundleassembly_tenant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{BundleAssembliesColumns[1], BundleAssembliesColumns[7]},
+			},
+			{
+				Name:    "bundleassembly_bundle_id",
+				Unique:  false,
+				Columns: []*schema.Column{BundleAssembliesColumns[2]},
+			},
+		},
+	}
+	// BundleAssemblyLinesColumns holds the columns for the "bundle_assembly_lines" table.
+	BundleAssemblyLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "assembly_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "quantity", Type: field.TypeFloat64},
+		{Name: "unit_cost", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+	}
+	// BundleAssemblyLinesTable holds the schema information for the "bundle_assembly_lines" table.
+	BundleAssemblyLinesTable = &schema.Table{
+		Name:       "bundle_assembly_lines",
+		Columns:    BundleAssemblyLinesColumns,
+		PrimaryKey: []*schema.Column{BundleAssemblyLinesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bundleassemblyline_assembly_id",
+				Unique:  false,
+				Columns: []*schema.Column{BundleAssemblyLinesColumns[1]},
+			},
+			{
+				Name:    "bundleassemblyline_product_id",
+				Unique:  false,
+				Columns: []*schema.Column{BundleAssemblyLinesColumns[2]},
+			},
+		},
+	}
+	// BundleComponentsColumns holds the columns for the "bundle_components" table.
+	BundleComponentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "bundle_id", Type: field.TypeInt},
+		{Name: "component_id", Type: field.TypeInt},
+		{Name: "quantity", Type: field.TypeFloat64},
+		{Name: "revenue_share", Type: field.TypeFloat64, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BundleComponentsTable holds the schema information for the "bundle_components" table.
+	BundleComponentsTable = &schema.Table{
+		Name:       "bundle_components",
+		Columns:    BundleComponentsColumns,
+		PrimaryKey: []*schema.Column{BundleComponentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bundlecomponent_bundle_id_component_id",
+				Unique:  true,
+				Columns: []*schema.Column{BundleComponentsColumns[2], BundleComponentsColumns[3]},
+			},
+			{
+				Name:    "bundlecomponent_component_id",
+				Unique:  false,
+				Columns: []*schema.Column{BundleComponentsColumns[3]},
+			},
+		},
+	}
+	// CustomersColumns holds the columns for the "customers" table.
+	CustomersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "document_type", Type: field.TypeString, Nullable: true},
+		{Name: "document_number", Type: field.TypeString, Nullable: true},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "phone", Type: field.TypeString, Nullable: true},
+		{Name: "address", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "tax_regime", Type: field.TypeString, Nullable: true},
+		{Name: "customer_group", Type: field.TypeString, Nullable: true},
+		{Name: "archived", Type: field.TypeBool, Default: false},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "price_list_id", Type: field.TypeInt, Nullable: true},
+	}
+	// CustomersTable holds the schema information for the "customers" table.
+	CustomersTable = &schema.Table{
+		Name:       "customers",
+		Columns:    CustomersColumns,
+		PrimaryKey: []*schema.Column{CustomersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "customers_price_lists_price_list",
+				Columns:    []*schema.Column{CustomersColumns[14]},
+				RefColumns: []*schema.Column{PriceListsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "customer_tenant_id_archived",
+				Unique:  false,
+				Columns: []*schema.Column{CustomersColumns[11], CustomersColumns[9]},
+			},
+			{
+				Name:    "customer_tenant_id_document_number",
+				Unique:  true,
+				Columns: []*schema.Column{CustomersColumns[11], CustomersColumns[3]},
+			},
+		},
+	}
+	// ExchangeRatesColumns holds the columns for the "exchange_rates" table.
+	ExchangeRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "rate", Type: field.TypeFloat64},
+		{Name: "source", Type: field.TypeString, Default: "manual"},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ExchangeRatesTable holds the schema information for the "exchange_rates" table.
+	ExchangeRatesTable = &schema.Table{
+		Name:       "exchange_rates",
+		Columns:    ExchangeRatesColumns,
+		PrimaryKey: []*schema.Column{ExchangeRatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exchangerate_tenant_id_currency_date",
+				Unique:  true,
+				Columns: []*schema.Column{ExchangeRatesColumns[1], ExchangeRatesColumns[2], ExchangeRatesColumns[3]},
+			},
+		},
+	}
+	// FiscalDocumentsColumns holds the columns for the "fiscal_documents" table.
+	FiscalDocumentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "invoice_id", Type: field.TypeInt},
+		{Name: "document_type", Type: field.TypeString},
+		{Name: "number", Type: field.TypeString, Nullable: true},
+		{Name: "reference_id", Type: field.TypeInt, Nullable: true},
+		{Name: "discrepancy_code", Type: field.TypeString, Nullable: true},
+		{Name: "discrepancy_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "currency", Type: field.TypeString, Default: "COP"},
+		{Name: "total", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "cufe", Type: field.TypeString, Nullable: true},
+		{Name: "digest", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "provider", Type: field.TypeString, Nullable: true},
+		{Name: "track_id", Type: field.TypeString, Nullable: true},
+		{Name: "rejection_reasons", Type: field.TypeJSON, Nullable: true},
+		{Name: "xml_key", Type: field.TypeString, Nullable: true},
+		{Name: "pdf_key", Type: field.TypeString, Nullable: true},
+		{Name: "issued_at", Type: field.TypeTime},
+		{Name: "submitted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "accepted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// FiscalDocumentsTable holds the schema information for the "fiscal_documents" table.
+	FiscalDocumentsTable = &schema.Table{
+		Name:       "fiscal_documents",
+		Columns:    FiscalDocumentsColumns,
+		PrimaryKey: []*schema.Column{FiscalDocumentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fiscaldocument_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{FiscalDocumentsColumns[1], FiscalDocumentsColumns[12]},
+			},
+			{
+				Name:    "fiscaldocument_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{FiscalDocumentsColumns[2]},
+			},
+			{
+				Name:    "fiscaldocument_tenant_id_document_type_number",
+				Unique:  true,
+				Columns: []*schema.Column{FiscalDocumentsColumns[1], FiscalDocumentsColumns[3], FiscalDocumentsColumns[4]},
+			},
+		},
+	}
+	// InventoryCostLayersColumns holds the columns for the "inventory_cost_layers" table.
+	InventoryCostLayersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "source_type", Type: field.TypeString},
+		{Name: "source_id", Type: field.TypeInt, Nullable: true},
+		{Name: "quantity", Type: field.TypeFloat64},
+		{Name: "remaining", Type: field.TypeFloat64},
+		{Name: "unit_cost", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// InventoryCostLayersTable holds the schema information for the "inventory_cost_layers" table.
+	InventoryCostLayersTable = &schema.Table{
+		Name:       "inventory_cost_layers",
+		Columns:    InventoryCostLayersColumns,
+		PrimaryKey: []*schema.Column{InventoryCostLayersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "inventorycostlayer_product_id_remaining",
+				Unique:  false,
+				Columns: []*schema.Column{InventoryCostLayersColumns[2], InventoryCostLayersColumns[6]},
+			},
+		},
+	}
+	// InventoryCountsColumns holds the columns for the "inventory_counts" table.
+	InventoryCountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeString, Default: "open"},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "posted_by", Type: field.TypeInt, Nullable: true},
+		{Name: "posted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// InventoryCountsTable holds the schema information for the "inventory_counts" table.
+	InventoryCountsTable = &schema.Table{
+		Name:       "inventory_counts",
+		Columns:    InventoryCountsColumns,
+		PrimaryKey: []*schema.Column{InventoryCountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "inventorycount_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{InventoryCountsColumns[4]},
+			},
+			{
+				Name:    "inventorycount_status",
+				Unique:  false,
+				Columns: []*schema.Column{InventoryCountsColumns[3]},
+			},
+		},
+	}
+	// InventoryCountEntriesColumns holds the columns for the "inventory_count_entries" table.
+	InventoryCountEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "count_id", Type: field.TypeInt},
+		{Name: "line_id", Type: field.TypeInt},
+		{Name: "quantity", Type: field.TypeFloat64},
+		{Name: "device_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// InventoryCountEntriesTable holds the schema information for the "inventory_count_entries" table.
+	InventoryCountEntriesTable = &schema.Table{
+		Name:       "inventory_count_entries",
+		Columns:    InventoryCountEntriesColumns,
+		PrimaryKey: []*schema.Column{InventoryCountEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "inventorycountentry_count_id",
+				Unique:  false,
+				Columns: []*schema.Column{InventoryCountEntriesColumns[1]},
+			},
+			{
+				Name:    "inventorycountentry_line_id",
+				Unique:  false,
+				Columns: []*schema.Column{InventoryCountEntriesColumns[2]},
+			},
+		},
+	}
+	// InventoryCountLinesColumns holds the columns for the "inventory_count_lines" table.
+	InventoryCountLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "count_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "expected_quantity", Type: field.TypeFloat64},
+		{Name: "counted_quantity", Type: field.TypeFloat64, Nullable: true},
+		{Name: "unit_cost", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// InventoryCountLinesTable holds the schema information for the "inventory_count_lines" table.
+	InventoryCountLinesTable = &schema.Table{
+		Name:       "inventory_count_lines",
+		Columns:    InventoryCountLinesColumns,
+		PrimaryKey: []*schema.Column{InventoryCountLinesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "inventorycountline_count_id_product_id",
+				Unique:  true,
+				Columns: []*schema.Column{InventoryCountLinesColumns[1], InventoryCountLinesColumns[2]},
+			},
+		},
+	}
 	// InvoicesColumns holds the columns for the "invoices" table.
 	InvoicesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "total", Type: field.TypeFloat64},
+		{Name: "total", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "subtotal", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "tax_total", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "discount_amount", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "discount_total", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "promotion_total", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "coupon_code", Type: field.TypeString, Nullable: true},
+		{Name: "discount_approved_by", Type: field.TypeInt, Nullable: true},
+		{Name: "prices_include_tax", Type: field.TypeBool, Default: false},
+		{Name: "currency", Type: field.TypeString, Default: "COP"},
+		{Name: "exchange_rate", Type: field.TypeFloat64, Default: 1},
+		{Name: "base_subtotal", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "base_tax_total", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "base_total", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "payment_exchange_rate", Type: field.TypeFloat64, Nullable: true},
+		{Name: "fx_gain_loss", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "number", Type: field.TypeString, Nullable: true},
+		{Name: "series_id", Type: field.TypeInt, Nullable: true},
 		{Name: "status", Type: field.TypeString, Default: "pending"},
 		{Name: "tenant_id", Type: field.TypeInt},
 		{Name: "user_id", Type: field.TypeInt},
+		{Name: "price_list_id", Type: field.TypeInt, Nullable: true},
+		{Name: "customer_id", Type: field.TypeInt, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -27,17 +409,27 @@ var (
 			{
 				Name:    "invoice_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{InvoicesColumns[3]},
+				Columns: []*schema.Column{InvoicesColumns[20]},
 			},
 			{
 				Name:    "invoice_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{InvoicesColumns[4]},
+				Columns: []*schema.Column{InvoicesColumns[21]},
 			},
 			{
 				Name:    "invoice_status",
 				Unique:  false,
-				Columns: []*schema.Column{InvoicesColumns[2]},
+				Columns: []*schema.Column{InvoicesColumns[19]},
+			},
+			{
+				Name:    "invoice_customer_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[23]},
+			},
+			{
+				Name:    "invoice_tenant_id_number",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicesColumns[20], InvoicesColumns[17]},
 			},
 		},
 	}
@@ -45,9 +437,24 @@ var (
 	InvoiceItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "product_id", Type: field.TypeInt},
-		{Name: "quantity", Type: field.TypeInt},
-		{Name: "unit_price", Type: field.TypeFloat64},
-		{Name: "subtotal", Type: field.TypeFloat64},
+		{Name: "quantity", Type: field.TypeFloat64},
+		{Name: "unit_price", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "subtotal", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "promotion_id", Type: field.TypeInt, Nullable: true},
+		{Name: "promotion_discount", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "discount_amount", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "invoice_discount_amount", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "unit_cost", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "cost_total", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "price_rule", Type: field.TypeString, Default: "retail"},
+		{Name: "price_list_id", Type: field.TypeInt, Nullable: true},
+		{Name: "unit_id", Type: field.TypeInt, Nullable: true},
+		{Name: "unit_name", Type: field.TypeString, Nullable: true},
+		{Name: "unit_quantity", Type: field.TypeFloat64, Nullable: true},
+		{Name: "tax_rate_id", Type: field.TypeInt, Nullable: true},
+		{Name: "tax_rate", Type: field.TypeFloat64, Default: 0},
+		{Name: "tax_base", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "tax_amount", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
 		{Name: "invoice_id", Type: field.TypeInt},
 	}
 	// InvoiceItemsTable holds the schema information for the "invoice_items" table.
@@ -58,7 +465,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "invoice_items_invoices_items",
-				Columns:    []*schema.Column{InvoiceItemsColumns[5]},
+				Columns:    []*schema.Column{InvoiceItemsColumns[20]},
 				RefColumns: []*schema.Column{InvoicesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -67,7 +474,7 @@ var (
 			{
 				Name:    "invoiceitem_invoice_id",
 				Unique:  false,
-				Columns: []*schema.Column{InvoiceItemsColumns[5]},
+				Columns: []*schema.Column{InvoiceItemsColumns[20]},
 			},
 			{
 				Name:    "invoiceitem_product_id",
@@ -76,18 +483,219 @@ var (
 			},
 		},
 	}
+	// InvoiceItemComponentsColumns holds the columns for the "invoice_item_components" table.
+	InvoiceItemComponentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "invoice_id", Type: field.TypeInt},
+		{Name: "invoice_item_id", Type: field.TypeInt},
+		{Name: "bundle_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "quantity", Type: field.TypeFloat64},
+		{Name: "revenue", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "cost_total", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "built_quantity", Type: field.TypeFloat64, Default: 0},
+		{Name: "built_unit_cost", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+	}
+	// InvoiceItemComponentsTable holds the schema information for the "invoice_item_components" table.
+	InvoiceItemComponentsTable = &schema.Table{
+		Name:       "invoice_item_components",
+		Columns:    InvoiceItemComponentsColumns,
+		PrimaryKey: []*schema.Column{InvoiceItemComponentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoiceitemcomponent_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceItemComponentsColumns[1]},
+			},
+			{
+				Name:    "invoiceitemcomponent_product_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceItemComponentsColumns[4]},
+			},
+		},
+	}
+	// InvoiceLotAllocationsColumns holds the columns for the "invoice_lot_allocations" table.
+	InvoiceLotAllocationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "invoice_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "lot_id", Type: field.TypeInt},
+		{Name: "quantity", Type: field.TypeFloat64},
+	}
+	// InvoiceLotAllocationsTable holds the schema information for the "invoice_lot_allocations" table.
+	InvoiceLotAllocationsTable = &schema.Table{
+		Name:       "invoice_lot_allocations",
+		Columns:    InvoiceLotAllocationsColumns,
+		PrimaryKey: []*schema.Column{InvoiceLotAllocationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoicelotallocation_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceLotAllocationsColumns[1]},
+			},
+			{
+				Name:    "invoicelotallocation_lot_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceLotAllocationsColumns[3]},
+			},
+		},
+	}
+	// InvoiceStatusEventsColumns holds the columns for the "invoice_status_events" table.
+	InvoiceStatusEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "invoice_id", Type: field.TypeInt},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "from_status", Type: field.TypeString},
+		{Name: "to_status", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// InvoiceStatusEventsTable holds the schema information for the "invoice_status_events" table.
+	InvoiceStatusEventsTable = &schema.Table{
+		Name:       "invoice_status_events",
+		Columns:    InvoiceStatusEventsColumns,
+		PrimaryKey: []*schema.Column{InvoiceStatusEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoicestatusevent_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceStatusEventsColumns[1]},
+			},
+		},
+	}
+	// InvoiceTaxesColumns holds the columns for the "invoice_taxes" table.
+	InvoiceTaxesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "document_type", Type: field.TypeString},
+		{Name: "document_id", Type: field.TypeInt},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "tax_rate_id", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "rate", Type: field.TypeFloat64, Default: 0},
+		{Name: "base", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "amount", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+	}
+	// InvoiceTaxesTable holds the schema information for the "invoice_taxes" table.
+	InvoiceTaxesTable = &schema.Table{
+		Name:       "invoice_taxes",
+		Columns:    InvoiceTaxesColumns,
+		PrimaryKey: []*schema.Column{InvoiceTaxesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoicetax_document_type_document_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceTaxesColumns[1], InvoiceTaxesColumns[2]},
+			},
+			{
+				Name:    "invoicetax_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceTaxesColumns[3]},
+			},
+		},
+	}
+	// NumberingSeriesColumns holds the columns for the "numbering_series" table.
+	NumberingSeriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "document_type", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "prefix", Type: field.TypeString, Nullable: true},
+		{Name: "range_from", Type: field.TypeInt64},
+		{Name: "range_to", Type: field.TypeInt64},
+		{Name: "current_number", Type: field.TypeInt64},
+		{Name: "valid_from", Type: field.TypeTime, Nullable: true},
+		{Name: "valid_until", Type: field.TypeTime, Nullable: true},
+		{Name: "resolution", Type: field.TypeString, Nullable: true},
+		{Name: "alert_remaining", Type: field.TypeInt64, Default: 100},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// NumberingSeriesTable holds the schema information for the "numbering_series" table.
+	NumberingSeriesTable = &schema.Table{
+		Name:       "numbering_series",
+		Columns:    NumberingSeriesColumns,
+		PrimaryKey: []*schema.Column{NumberingSeriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "numberingseries_tenant_id_document_type",
+				Unique:  false,
+				Columns: []*schema.Column{NumberingSeriesColumns[1], NumberingSeriesColumns[2]},
+			},
+		},
+	}
+	// PriceListsColumns holds the columns for the "price_lists" table.
+	PriceListsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "discount_percent", Type: field.TypeFloat64, Default: 0},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PriceListsTable holds the schema information for the "price_lists" table.
+	PriceListsTable = &schema.Table{
+		Name:       "price_lists",
+		Columns:    PriceListsColumns,
+		PrimaryKey: []*schema.Column{PriceListsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pricelist_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{PriceListsColumns[1], PriceListsColumns[2]},
+			},
+		},
+	}
+	// PriceListItemsColumns holds the columns for the "price_list_items" table.
+	PriceListItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "price_list_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "min_quantity", Type: field.TypeInt, Default: 1},
+		{Name: "price", Type: field.TypeInt64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "discount_percent", Type: field.TypeFloat64, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PriceListItemsTable holds the schema information for the "price_list_items" table.
+	PriceListItemsTable = &schema.Table{
+		Name:       "price_list_items",
+		Columns:    PriceListItemsColumns,
+		PrimaryKey: []*schema.Column{PriceListItemsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pricelistitem_price_list_id_product_id_min_quantity",
+				Unique:  true,
+				Columns: []*schema.Column{PriceListItemsColumns[1], PriceListItemsColumns[2], PriceListItemsColumns[3]},
+			},
+		},
+	}
 	// ProductsColumns holds the columns for the "products" table.
 	ProductsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
-		{Name: "price", Type: field.TypeFloat64},
-		{Name: "purchase_price", Type: field.TypeFloat64, Default: 0},
-		{Name: "retail_price", Type: field.TypeFloat64, Default: 0},
-		{Name: "wholesale_price", Type: field.TypeFloat64, Nullable: true},
+		{Name: "purchase_price", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "retail_price", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "wholesale_price", Type: field.TypeInt64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
 		{Name: "min_wholesale_quantity", Type: field.TypeInt, Nullable: true},
-		{Name: "stock", Type: field.TypeInt, Default: 0},
+		{Name: "stock", Type: field.TypeFloat64, Default: 0},
+		{Name: "base_unit", Type: field.TypeString, Default: "unidad"},
+		{Name: "fractional", Type: field.TypeBool, Default: false},
+		{Name: "bundle", Type: field.TypeBool, Default: false},
+		{Name: "revenue_split", Type: field.TypeString, Default: "retail_price"},
 		{Name: "sku", Type: field.TypeString, Nullable: true},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "tax_rate_id", Type: field.TypeInt, Nullable: true},
+		{Name: "track_lots", Type: field.TypeBool, Default: false},
+		{Name: "serialized", Type: field.TypeBool, Default: false},
+		{Name: "warranty_months", Type: field.TypeInt, Default: 0},
+		{Name: "archived", Type: field.TypeBool, Default: false},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "tenant_id", Type: field.TypeInt},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -101,12 +709,320 @@ var (
 			{
 				Name:    "product_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{ProductsColumns[10]},
+				Columns: []*schema.Column{ProductsColumns[20]},
 			},
 			{
 				Name:    "product_sku",
 				Unique:  false,
-				Columns: []*schema.Column{ProductsColumns[9]},
+				Columns: []*schema.Column{ProductsColumns[12]},
+			},
+			{
+				Name:    "product_tenant_id_category",
+				Unique:  false,
+				Columns: []*schema.Column{ProductsColumns[20], ProductsColumns[13]},
+			},
+			{
+				Name:    "product_tenant_id_archived",
+				Unique:  false,
+				Columns: []*schema.Column{ProductsColumns[20], ProductsColumns[18]},
+			},
+		},
+	}
+	// ProductBarcodesColumns holds the columns for the "product_barcodes" table.
+	ProductBarcodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "unit_id", Type: field.TypeInt, Nullable: true},
+		{Name: "code", Type: field.TypeString},
+		{Name: "symbology", Type: field.TypeString},
+		{Name: "internal", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ProductBarcodesTable holds the schema information for the "product_barcodes" table.
+	ProductBarcodesTable = &schema.Table{
+		Name:       "product_barcodes",
+		Columns:    ProductBarcodesColumns,
+		PrimaryKey: []*schema.Column{ProductBarcodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productbarcode_tenant_id_code",
+				Unique:  true,
+				Columns: []*schema.Column{ProductBarcodesColumns[1], ProductBarcodesColumns[4]},
+			},
+			{
+				Name:    "productbarcode_product_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProductBarcodesColumns[2]},
+			},
+		},
+	}
+	// ProductFilesColumns holds the columns for the "product_files" table.
+	ProductFilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "kind", Type: field.TypeString, Default: "image"},
+		{Name: "file_name", Type: field.TypeString},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "storage_key", Type: field.TypeString},
+		{Name: "thumbnail_key", Type: field.TypeString, Nullable: true},
+		{Name: "width", Type: field.TypeInt, Default: 0},
+		{Name: "height", Type: field.TypeInt, Default: 0},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ProductFilesTable holds the schema information for the "product_files" table.
+	ProductFilesTable = &schema.Table{
+		Name:       "product_files",
+		Columns:    ProductFilesColumns,
+		PrimaryKey: []*schema.Column{ProductFilesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productfile_product_id_kind_position",
+				Unique:  false,
+				Columns: []*schema.Column{ProductFilesColumns[2], ProductFilesColumns[3], ProductFilesColumns[11]},
+			},
+			{
+				Name:    "productfile_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProductFilesColumns[1]},
+			},
+		},
+	}
+	// ProductLotsColumns holds the columns for the "product_lots" table.
+	ProductLotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "lot_number", Type: field.TypeString},
+		{Name: "expiry_date", Type: field.TypeTime, Nullable: true},
+		{Name: "quantity", Type: field.TypeFloat64},
+		{Name: "remaining", Type: field.TypeFloat64},
+		{Name: "purchase_invoice_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ProductLotsTable holds the schema information for the "product_lots" table.
+	ProductLotsTable = &schema.Table{
+		Name:       "product_lots",
+		Columns:    ProductLotsColumns,
+		PrimaryKey: []*schema.Column{ProductLotsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productlot_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProductLotsColumns[2]},
+			},
+			{
+				Name:    "productlot_product_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProductLotsColumns[1]},
+			},
+			{
+				Name:    "productlot_expiry_date",
+				Unique:  false,
+				Columns: []*schema.Column{ProductLotsColumns[4]},
+			},
+			{
+				Name:    "productlot_product_id_lot_number",
+				Unique:  false,
+				Columns: []*schema.Column{ProductLotsColumns[1], ProductLotsColumns[3]},
+			},
+		},
+	}
+	// ProductPricesColumns holds the columns for the "product_prices" table.
+	ProductPricesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "retail_price", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "wholesale_price", Type: field.TypeInt64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "purchase_price", Type: field.TypeInt64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "effective_from", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString, Default: "scheduled"},
+		{Name: "source", Type: field.TypeString, Default: "manual"},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "applied_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ProductPricesTable holds the schema information for the "product_prices" table.
+	ProductPricesTable = &schema.Table{
+		Name:       "product_prices",
+		Columns:    ProductPricesColumns,
+		PrimaryKey: []*schema.Column{ProductPricesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productprice_product_id_effective_from",
+				Unique:  false,
+				Columns: []*schema.Column{ProductPricesColumns[2], ProductPricesColumns[6]},
+			},
+			{
+				Name:    "productprice_status_effective_from",
+				Unique:  false,
+				Columns: []*schema.Column{ProductPricesColumns[7], ProductPricesColumns[6]},
+			},
+		},
+	}
+	// ProductSerialsColumns holds the columns for the "product_serials" table.
+	ProductSerialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "serial_number", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString, Default: "in_stock"},
+		{Name: "supplier_id", Type: field.TypeInt, Nullable: true},
+		{Name: "purchase_invoice_id", Type: field.TypeInt, Nullable: true},
+		{Name: "invoice_id", Type: field.TypeInt, Nullable: true},
+		{Name: "received_at", Type: field.TypeTime},
+		{Name: "sold_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ProductSerialsTable holds the schema information for the "product_serials" table.
+	ProductSerialsTable = &schema.Table{
+		Name:       "product_serials",
+		Columns:    ProductSerialsColumns,
+		PrimaryKey: []*schema.Column{ProductSerialsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productserial_tenant_id_serial_number",
+				Unique:  false,
+				Columns: []*schema.Column{ProductSerialsColumns[2], ProductSerialsColumns[3]},
+			},
+			{
+				Name:    "productserial_product_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ProductSerialsColumns[1], ProductSerialsColumns[4]},
+			},
+			{
+				Name:    "productserial_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProductSerialsColumns[7]},
+			},
+			{
+				Name:    "productserial_tenant_id_product_id_serial_number",
+				Unique:  true,
+				Columns: []*schema.Column{ProductSerialsColumns[2], ProductSerialsColumns[1], ProductSerialsColumns[3]},
+			},
+		},
+	}
+	// ProductSerialEventsColumns holds the columns for the "product_serial_events" table.
+	ProductSerialEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "serial_id", Type: field.TypeInt},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "document_type", Type: field.TypeString},
+		{Name: "document_id", Type: field.TypeInt},
+		{Name: "supplier_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ProductSerialEventsTable holds the schema information for the "product_serial_events" table.
+	ProductSerialEventsTable = &schema.Table{
+		Name:       "product_serial_events",
+		Columns:    ProductSerialEventsColumns,
+		PrimaryKey: []*schema.Column{ProductSerialEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productserialevent_serial_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProductSerialEventsColumns[1]},
+			},
+		},
+	}
+	// ProductUnitsColumns holds the columns for the "product_units" table.
+	ProductUnitsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString},
+		{Name: "factor", Type: field.TypeFloat64},
+		{Name: "retail_price", Type: field.TypeInt64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ProductUnitsTable holds the schema information for the "product_units" table.
+	ProductUnitsTable = &schema.Table{
+		Name:       "product_units",
+		Columns:    ProductUnitsColumns,
+		PrimaryKey: []*schema.Column{ProductUnitsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productunit_product_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{ProductUnitsColumns[2], ProductUnitsColumns[3]},
+			},
+			{
+				Name:    "productunit_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProductUnitsColumns[1]},
+			},
+		},
+	}
+	// PromotionsColumns holds the columns for the "promotions" table.
+	PromotionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "coupon_code", Type: field.TypeString, Nullable: true},
+		{Name: "effect", Type: field.TypeString},
+		{Name: "percent", Type: field.TypeFloat64, Default: 0},
+		{Name: "amount", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "buy_quantity", Type: field.TypeFloat64, Default: 0},
+		{Name: "free_quantity", Type: field.TypeFloat64, Default: 0},
+		{Name: "min_quantity", Type: field.TypeFloat64, Default: 0},
+		{Name: "min_amount", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "starts_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ends_at", Type: field.TypeTime, Nullable: true},
+		{Name: "weekdays", Type: field.TypeString, Nullable: true},
+		{Name: "start_time", Type: field.TypeString, Nullable: true},
+		{Name: "end_time", Type: field.TypeString, Nullable: true},
+		{Name: "customer_group", Type: field.TypeString, Nullable: true},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PromotionsTable holds the schema information for the "promotions" table.
+	PromotionsTable = &schema.Table{
+		Name:       "promotions",
+		Columns:    PromotionsColumns,
+		PrimaryKey: []*schema.Column{PromotionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "promotion_tenant_id_active",
+				Unique:  false,
+				Columns: []*schema.Column{PromotionsColumns[1], PromotionsColumns[19]},
+			},
+			{
+				Name:    "promotion_tenant_id_coupon_code",
+				Unique:  false,
+				Columns: []*schema.Column{PromotionsColumns[1], PromotionsColumns[4]},
+			},
+		},
+	}
+	// PromotionTargetsColumns holds the columns for the "promotion_targets" table.
+	PromotionTargetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "promotion_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt, Nullable: true},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PromotionTargetsTable holds the schema information for the "promotion_targets" table.
+	PromotionTargetsTable = &schema.Table{
+		Name:       "promotion_targets",
+		Columns:    PromotionTargetsColumns,
+		PrimaryKey: []*schema.Column{PromotionTargetsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "promotiontarget_promotion_id",
+				Unique:  false,
+				Columns: []*schema.Column{PromotionTargetsColumns[1]},
 			},
 		},
 	}
@@ -114,11 +1030,20 @@ var (
 	PurchaseInvoicesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "invoice_number", Type: field.TypeString},
-		{Name: "total", Type: field.TypeFloat64},
+		{Name: "total", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "subtotal", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "tax_total", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "prices_include_tax", Type: field.TypeBool, Default: false},
+		{Name: "currency", Type: field.TypeString, Default: "COP"},
+		{Name: "exchange_rate", Type: field.TypeFloat64, Default: 1},
+		{Name: "base_subtotal", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "base_tax_total", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "base_total", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "fx_gain_loss", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
 		{Name: "status", Type: field.TypeString, Default: "pending"},
 		{Name: "payment_method", Type: field.TypeString, Nullable: true},
 		{Name: "due_date", Type: field.TypeTime, Nullable: true},
-		{Name: "paid_amount", Type: field.TypeFloat64, Default: 0},
+		{Name: "paid_amount", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
 		{Name: "tenant_id", Type: field.TypeInt},
 		{Name: "user_id", Type: field.TypeInt},
 		{Name: "created_at", Type: field.TypeTime},
@@ -133,7 +1058,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "purchase_invoices_suppliers_supplier",
-				Columns:    []*schema.Column{PurchaseInvoicesColumns[11]},
+				Columns:    []*schema.Column{PurchaseInvoicesColumns[20]},
 				RefColumns: []*schema.Column{SuppliersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -142,22 +1067,22 @@ var (
 			{
 				Name:    "purchaseinvoice_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{PurchaseInvoicesColumns[7]},
+				Columns: []*schema.Column{PurchaseInvoicesColumns[16]},
 			},
 			{
 				Name:    "purchaseinvoice_supplier_id",
 				Unique:  false,
-				Columns: []*schema.Column{PurchaseInvoicesColumns[11]},
+				Columns: []*schema.Column{PurchaseInvoicesColumns[20]},
 			},
 			{
 				Name:    "purchaseinvoice_status",
 				Unique:  false,
-				Columns: []*schema.Column{PurchaseInvoicesColumns[3]},
+				Columns: []*schema.Column{PurchaseInvoicesColumns[12]},
 			},
 			{
 				Name:    "purchaseinvoice_due_date",
 				Unique:  false,
-				Columns: []*schema.Column{PurchaseInvoicesColumns[5]},
+				Columns: []*schema.Column{PurchaseInvoicesColumns[14]},
 			},
 			{
 				Name:    "purchaseinvoice_invoice_number",
@@ -171,9 +1096,16 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "purchase_invoice_id", Type: field.TypeInt},
 		{Name: "product_id", Type: field.TypeInt},
-		{Name: "quantity", Type: field.TypeInt},
-		{Name: "unit_cost", Type: field.TypeFloat64},
-		{Name: "subtotal", Type: field.TypeFloat64},
+		{Name: "quantity", Type: field.TypeFloat64},
+		{Name: "unit_cost", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "subtotal", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "unit_id", Type: field.TypeInt, Nullable: true},
+		{Name: "unit_name", Type: field.TypeString, Nullable: true},
+		{Name: "unit_quantity", Type: field.TypeFloat64, Nullable: true},
+		{Name: "tax_rate_id", Type: field.TypeInt, Nullable: true},
+		{Name: "tax_rate", Type: field.TypeFloat64, Default: 0},
+		{Name: "tax_base", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "tax_amount", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
 	}
 	// PurchaseInvoiceItemsTable holds the schema information for the "purchase_invoice_items" table.
 	PurchaseInvoiceItemsTable = &schema.Table{
@@ -193,6 +1125,68 @@ var (
 			},
 		},
 	}
+	// StockAdjustmentsColumns holds the columns for the "stock_adjustments" table.
+	StockAdjustmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "reason_id", Type: field.TypeInt},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "source", Type: field.TypeString, Default: "manual"},
+		{Name: "total_value", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "reviewed_by", Type: field.TypeInt, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "applied_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// StockAdjustmentsTable holds the schema information for the "stock_adjustments" table.
+	StockAdjustmentsTable = &schema.Table{
+		Name:       "stock_adjustments",
+		Columns:    StockAdjustmentsColumns,
+		PrimaryKey: []*schema.Column{StockAdjustmentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stockadjustment_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{StockAdjustmentsColumns[1], StockAdjustmentsColumns[4]},
+			},
+			{
+				Name:    "stockadjustment_tenant_id_applied_at",
+				Unique:  false,
+				Columns: []*schema.Column{StockAdjustmentsColumns[1], StockAdjustmentsColumns[10]},
+			},
+		},
+	}
+	// StockAdjustmentLinesColumns holds the columns for the "stock_adjustment_lines" table.
+	StockAdjustmentLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "adjustment_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "lot_id", Type: field.TypeInt, Nullable: true},
+		{Name: "quantity", Type: field.TypeFloat64},
+		{Name: "unit_cost", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "total_cost", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+	}
+	// StockAdjustmentLinesTable holds the schema information for the "stock_adjustment_lines" table.
+	StockAdjustmentLinesTable = &schema.Table{
+		Name:       "stock_adjustment_lines",
+		Columns:    StockAdjustmentLinesColumns,
+		PrimaryKey: []*schema.Column{StockAdjustmentLinesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stockadjustmentline_adjustment_id",
+				Unique:  false,
+				Columns: []*schema.Column{StockAdjustmentLinesColumns[1]},
+			},
+			{
+				Name:    "stockadjustmentline_product_id",
+				Unique:  false,
+				Columns: []*schema.Column{StockAdjustmentLinesColumns[2]},
+			},
+		},
+	}
 	// SuppliersColumns holds the columns for the "suppliers" table.
 	SuppliersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -201,6 +1195,9 @@ var (
 		{Name: "phone", Type: field.TypeString, Nullable: true},
 		{Name: "address", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "ruc_nit", Type: field.TypeString, Nullable: true},
+		{Name: "lead_time_days", Type: field.TypeInt, Default: 0},
+		{Name: "archived", Type: field.TypeBool, Default: false},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "tenant_id", Type: field.TypeInt},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -214,7 +1211,12 @@ var (
 			{
 				Name:    "supplier_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{SuppliersColumns[6]},
+				Columns: []*schema.Column{SuppliersColumns[9]},
+			},
+			{
+				Name:    "supplier_tenant_id_archived",
+				Unique:  false,
+				Columns: []*schema.Column{SuppliersColumns[9], SuppliersColumns[7]},
 			},
 			{
 				Name:    "supplier_ruc_nit",
@@ -228,7 +1230,11 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "purchase_invoice_id", Type: field.TypeInt},
 		{Name: "supplier_id", Type: field.TypeInt},
-		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "amount", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "currency", Type: field.TypeString, Default: "COP"},
+		{Name: "exchange_rate", Type: field.TypeFloat64, Default: 1},
+		{Name: "base_amount", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "fx_gain_loss", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
 		{Name: "payment_date", Type: field.TypeTime},
 		{Name: "payment_method", Type: field.TypeString},
 		{Name: "reference", Type: field.TypeString, Nullable: true},
@@ -247,7 +1253,7 @@ var (
 			{
 				Name:    "supplierpayment_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{SupplierPaymentsColumns[8]},
+				Columns: []*schema.Column{SupplierPaymentsColumns[12]},
 			},
 			{
 				Name:    "supplierpayment_supplier_id",
@@ -262,7 +1268,37 @@ var (
 			{
 				Name:    "supplierpayment_payment_date",
 				Unique:  false,
-				Columns: []*schema.Column{SupplierPaymentsColumns[4]},
+				Columns: []*schema.Column{SupplierPaymentsColumns[8]},
+			},
+		},
+	}
+	// TaxRatesColumns holds the columns for the "tax_rates" table.
+	TaxRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString},
+		{Name: "rate", Type: field.TypeFloat64, Default: 0},
+		{Name: "kind", Type: field.TypeString, Default: "taxable"},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TaxRatesTable holds the schema information for the "tax_rates" table.
+	TaxRatesTable = &schema.Table{
+		Name:       "tax_rates",
+		Columns:    TaxRatesColumns,
+		PrimaryKey: []*schema.Column{TaxRatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taxrate_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaxRatesColumns[1]},
+			},
+			{
+				Name:    "taxrate_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{TaxRatesColumns[1], TaxRatesColumns[2]},
 			},
 		},
 	}
@@ -272,6 +1308,26 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "slug", Type: field.TypeString, Unique: true},
 		{Name: "domain", Type: field.TypeString, Nullable: true},
+		{Name: "costing_method", Type: field.TypeString, Default: "average"},
+		{Name: "adjustment_approval_threshold", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "numeric(19,4)"}},
+		{Name: "user_discount_limit", Type: field.TypeFloat64, Default: 100},
+		{Name: "manager_discount_limit", Type: field.TypeFloat64, Default: 100},
+		{Name: "prices_include_tax", Type: field.TypeBool, Default: false},
+		{Name: "base_currency", Type: field.TypeString, Default: "COP"},
+		{Name: "legal_name", Type: field.TypeString, Nullable: true},
+		{Name: "tax_id", Type: field.TypeString, Nullable: true},
+		{Name: "address", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "phone", Type: field.TypeString, Nullable: true},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "logo", Type: field.TypeBytes, Nullable: true},
+		{Name: "einvoice_country", Type: field.TypeString, Nullable: true},
+		{Name: "einvoice_environment", Type: field.TypeString, Default: "test"},
+		{Name: "einvoice_software_id", Type: field.TypeString, Nullable: true},
+		{Name: "einvoice_software_pin", Type: field.TypeString, Nullable: true},
+		{Name: "einvoice_technical_key", Type: field.TypeString, Nullable: true},
+		{Name: "einvoice_certificate", Type: field.TypeBytes, Nullable: true},
+		{Name: "einvoice_private_key", Type: field.TypeBytes, Nullable: true},
+		{Name: "einvoice_certificate_expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -295,6 +1351,8 @@ var (
 		{Name: "password", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
 		{Name: "role", Type: field.TypeString, Default: "user"},
+		{Name: "archived", Type: field.TypeBool, Default: false},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "tenant_id", Type: field.TypeInt},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -313,25 +1371,57 @@ var (
 			{
 				Name:    "user_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[5]},
+				Columns: []*schema.Column{UsersColumns[7]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AdjustmentReasonsTable,
+		AuditLogsTable,
+		BundleAssembliesTable,
+		BundleAssemblyLinesTable,
+		BundleComponentsTable,
+		CustomersTable,
+		ExchangeRatesTable,
+		FiscalDocumentsTable,
+		InventoryCostLayersTable,
+		InventoryCountsTable,
+		InventoryCountEntriesTable,
+		InventoryCountLinesTable,
 		InvoicesTable,
 		InvoiceItemsTable,
+		InvoiceItemComponentsTable,
+		InvoiceLotAllocationsTable,
+		InvoiceStatusEventsTable,
+		InvoiceTaxesTable,
+		NumberingSeriesTable,
+		PriceListsTable,
+		PriceListItemsTable,
 		ProductsTable,
+		ProductBarcodesTable,
+		ProductFilesTable,
+		ProductLotsTable,
+		ProductPricesTable,
+		ProductSerialsTable,
+		ProductSerialEventsTable,
+		ProductUnitsTable,
+		PromotionsTable,
+		PromotionTargetsTable,
 		PurchaseInvoicesTable,
 		PurchaseInvoiceItemsTable,
+		StockAdjustmentsTable,
+		StockAdjustmentLinesTable,
 		SuppliersTable,
 		SupplierPaymentsTable,
+		TaxRatesTable,
 		TenantsTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	CustomersTable.ForeignKeys[0].RefTable = PriceListsTable
 	InvoiceItemsTable.ForeignKeys[0].RefTable = InvoicesTable
 	PurchaseInvoicesTable.ForeignKeys[0].RefTable = SuppliersTable
 }
@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/numberingseries"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// NumberingSeries is the model entity for the NumberingSeries schema.
+type NumberingSeries struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// Tipo de documento que numera (invoice, credit_note)
+	DocumentType string `json:"document_type,omitempty"`
+	// Nombre descriptivo de la serie
+	Name string `json:"name,omitempty"`
+	// Prefijo que antecede al consecutivo, por ejemplo FE
+	Prefix string `json:"prefix,omitempty"`
+	// Primer número del rango autorizado
+	RangeFrom int64 `json:"range_from,omitempty"`
+	// Último número del rango autorizado
+	RangeTo int64 `json:"range_to,omitempty"`
+	// Último número asignado; range_from - 1 si la serie no se ha usado
+	CurrentNumber int64 `json:"current_number,omitempty"`
+	// Inicio de la vigencia de la autorización
+	ValidFrom *time.Time `json:"valid_from,omitempty"`
+	// Último día de vigencia de la autorización
+	ValidUntil *time.Time `json:"valid_until,omitempty"`
+	// Número de la resolución que autoriza la numeración
+	Resolution string `json:"resolution,omitempty"`
+	// Números disponibles por debajo de los cuales se advierte que la serie se agota
+	AlertRemaining int64 `json:"alert_remaining,omitempty"`
+	// Las series inactivas no asignan números
+	Active bool `json:"active,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NumberingSeries) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case numberingseries.FieldActive:
+			values[i] = new(sql.NullBool)
+		case numberingseries.FieldID, numberingseries.FieldTenantID, numberingseries.FieldRangeFrom, numberingseries.FieldRangeTo, numberingseries.FieldCurrentNumber, numberingseries.FieldAlertRemaining:
+			values[i] = new(sql.NullInt64)
+		case numberingseries.FieldDocumentType, numberingseries.FieldName, numberingseries.FieldPrefix, numberingseries.FieldResolution:
+			values[i] = new(sql.NullString)
+		case numberingseries.FieldValidFrom, numberingseries.FieldValidUntil, numberingseries.FieldCreatedAt, numberingseries.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type NumberingSeries", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NumberingSeries fields.
+func (ns *NumberingSeries) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case numberingseries.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ns.ID = int(value.Int64)
+		case numberingseries.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ns.TenantID = int(value.Int64)
+			}
+		case numberingseries.FieldDocumentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_type", values[i])
+			} else if value.Valid {
+				ns.DocumentType = value.String
+			}
+		case numberingseries.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ns.Name = value.String
+			}
+		case numberingseries.FieldPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prefix", values[i])
+			} else if value.Valid {
+				ns.Prefix = value.String
+			}
+		case numberingseries.FieldRangeFrom:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field range_from", values[i])
+			} else if value.Valid {
+				ns.RangeFrom = value.Int64
+			}
+		case numberingseries.FieldRangeTo:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field range_to", values[i])
+			} else if value.Valid {
+				ns.RangeTo = value.Int64
+			}
+		case numberingseries.FieldCurrentNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field current_number", values[i])
+			} else if value.Valid {
+				ns.CurrentNumber = value.Int64
+			}
+		case numberingseries.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				ns.ValidFrom = new(time.Time)
+				*ns.ValidFrom = value.Time
+			}
+		case numberingseries.FieldValidUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_until", values[i])
+			} else if value.Valid {
+				ns.ValidUntil = new(time.Time)
+				*ns.ValidUntil = value.Time
+			}
+		case numberingseries.FieldResolution:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution", values[i])
+			} else if value.Valid {
+				ns.Resolution = value.String
+			}
+		case numberingseries.FieldAlertRemaining:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_remaining", values[i])
+			} else if value.Valid {
+				ns.AlertRemaining = value.Int64
+			}
+		case numberingseries.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				ns.Active = value.Bool
+			}
+		case numberingseries.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ns.CreatedAt = value.Time
+			}
+		case numberingseries.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ns.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this NumberingSeries.
+// Note that you need to call NumberingSeries.Unwrap() before calling this method if this NumberingSeries
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ns *NumberingSeries) Update() *NumberingSeriesUpdateOne {
+	return (&NumberingSeriesClient{config: ns.config}).UpdateOne(ns)
+}
+
+// Unwrap unwraps the NumberingSeries entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ns *NumberingSeries) Unwrap() *NumberingSeries {
+	_tx, ok := ns.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NumberingSeries is not a transactional entity")
+	}
+	ns.config.driver = _tx.drv
+	return ns
+}
+
+// String implements the fmt.Stringer.
+func (ns *NumberingSeries) String() string {
+	var builder strings.Builder
+	builder.WriteString("NumberingSeries(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ns.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", ns.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("document_type=")
+	builder.WriteString(ns.DocumentType)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ns.Name)
+	builder.WriteString(", ")
+	builder.WriteString("prefix=")
+	builder.WriteString(ns.Prefix)
+	builder.WriteString(", ")
+	builder.WriteString("range_from=")
+	builder.WriteString(fmt.Sprintf("%v", ns.RangeFrom))
+	builder.WriteString(", ")
+	builder.WriteString("range_to=")
+	builder.WriteString(fmt.Sprintf("%v", ns.RangeTo))
+	builder.WriteString(", ")
+	builder.WriteString("current_number=")
+	builder.WriteString(fmt.Sprintf("%v", ns.CurrentNumber))
+	builder.WriteString(", ")
+	if v := ns.ValidFrom; v != nil {
+		builder.WriteString("valid_from=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ns.ValidUntil; v != nil {
+		builder.WriteString("valid_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("resolution=")
+	builder.WriteString(ns.Resolution)
+	builder.WriteString(", ")
+	builder.WriteString("alert_remaining=")
+	builder.WriteString(fmt.Sprintf("%v", ns.AlertRemaining))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", ns.Active))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ns.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ns.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NumberingSeriesSlice is a parsable slice of NumberingSeries.
+type NumberingSeriesSlice []*NumberingSeries
+
+func (ns NumberingSeriesSlice) config(cfg config) {
+	for _i := range ns {
+		ns[_i].config = cfg
+	}
+}
@@ -0,0 +1,94 @@
+// Code generated by ent, DO NOT EDIT.
+
+package numberingseries
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the numberingseries type in the database.
+	Label = "numbering_series"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldDocumentType holds the string denoting the document_type field in the database.
+	FieldDocumentType = "document_type"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPrefix holds the string denoting the prefix field in the database.
+	FieldPrefix = "prefix"
+	// FieldRangeFrom holds the string denoting the range_from field in the database.
+	FieldRangeFrom = "range_from"
+	// FieldRangeTo holds the string denoting the range_to field in the database.
+	FieldRangeTo = "range_to"
+	// FieldCurrentNumber holds the string denoting the current_number field in the database.
+	FieldCurrentNumber = "current_number"
+	// FieldValidFrom holds the string denoting the valid_from field in the database.
+	FieldValidFrom = "valid_from"
+	// FieldValidUntil holds the string denoting the valid_until field in the database.
+	FieldValidUntil = "valid_until"
+	// FieldResolution holds the string denoting the resolution field in the database.
+	FieldResolution = "resolution"
+	// FieldAlertRemaining holds the string denoting the alert_remaining field in the database.
+	FieldAlertRemaining = "alert_remaining"
+	// FieldActive holds the string denoting the active field in the database.
+	FieldActive = "active"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the numberingseries in the database.
+	Table = "numbering_series"
+)
+
+// Columns holds all SQL columns for numberingseries fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldDocumentType,
+	FieldName,
+	FieldPrefix,
+	FieldRangeFrom,
+	FieldRangeTo,
+	FieldCurrentNumber,
+	FieldValidFrom,
+	FieldValidUntil,
+	FieldResolution,
+	FieldAlertRemaining,
+	FieldActive,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RangeFromValidator is a validator for the "range_from" field. It is called by the builders before save.
+	RangeFromValidator func(int64) error
+	// RangeToValidator is a validator for the "range_to" field. It is called by the builders before save.
+	RangeToValidator func(int64) error
+	// CurrentNumberValidator is a validator for the "current_number" field. It is called by the builders before save.
+	CurrentNumberValidator func(int64) error
+	// DefaultAlertRemaining holds the default value on creation for the "alert_remaining" field.
+	DefaultAlertRemaining int64
+	// AlertRemainingValidator is a validator for the "alert_remaining" field. It is called by the builders before save.
+	AlertRemainingValidator func(int64) error
+	// DefaultActive holds the default value on creation for the "active" field.
+	DefaultActive bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
@@ -0,0 +1,1423 @@
+// Code generated by ent, DO NOT EDIT.
+
+package numberingseries
+
+import (
+	"Veritasbackend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// DocumentType applies equality check predicate on the "document_type" field. It's identical to DocumentTypeEQ.
+func DocumentType(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentType), v))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// Prefix applies equality check predicate on the "prefix" field. It's identical to PrefixEQ.
+func Prefix(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrefix), v))
+	})
+}
+
+// RangeFrom applies equality check predicate on the "range_from" field. It's identical to RangeFromEQ.
+func RangeFrom(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRangeFrom), v))
+	})
+}
+
+// RangeTo applies equality check predicate on the "range_to" field. It's identical to RangeToEQ.
+func RangeTo(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRangeTo), v))
+	})
+}
+
+// CurrentNumber applies equality check predicate on the "current_number" field. It's identical to CurrentNumberEQ.
+func CurrentNumber(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrentNumber), v))
+	})
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldValidFrom), v))
+	})
+}
+
+// ValidUntil applies equality check predicate on the "valid_until" field. It's identical to ValidUntilEQ.
+func ValidUntil(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldValidUntil), v))
+	})
+}
+
+// Resolution applies equality check predicate on the "resolution" field. It's identical to ResolutionEQ.
+func Resolution(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResolution), v))
+	})
+}
+
+// AlertRemaining applies equality check predicate on the "alert_remaining" field. It's identical to AlertRemainingEQ.
+func AlertRemaining(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAlertRemaining), v))
+	})
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActive), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// DocumentTypeEQ applies the EQ predicate on the "document_type" field.
+func DocumentTypeEQ(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeNEQ applies the NEQ predicate on the "document_type" field.
+func DocumentTypeNEQ(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeIn applies the In predicate on the "document_type" field.
+func DocumentTypeIn(vs ...string) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDocumentType), v...))
+	})
+}
+
+// DocumentTypeNotIn applies the NotIn predicate on the "document_type" field.
+func DocumentTypeNotIn(vs ...string) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDocumentType), v...))
+	})
+}
+
+// DocumentTypeGT applies the GT predicate on the "document_type" field.
+func DocumentTypeGT(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeGTE applies the GTE predicate on the "document_type" field.
+func DocumentTypeGTE(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeLT applies the LT predicate on the "document_type" field.
+func DocumentTypeLT(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeLTE applies the LTE predicate on the "document_type" field.
+func DocumentTypeLTE(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeContains applies the Contains predicate on the "document_type" field.
+func DocumentTypeContains(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeHasPrefix applies the HasPrefix predicate on the "document_type" field.
+func DocumentTypeHasPrefix(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeHasSuffix applies the HasSuffix predicate on the "document_type" field.
+func DocumentTypeHasSuffix(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeEqualFold applies the EqualFold predicate on the "document_type" field.
+func DocumentTypeEqualFold(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeContainsFold applies the ContainsFold predicate on the "document_type" field.
+func DocumentTypeContainsFold(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDocumentType), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldName)))
+	})
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldName)))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// PrefixEQ applies the EQ predicate on the "prefix" field.
+func PrefixEQ(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixNEQ applies the NEQ predicate on the "prefix" field.
+func PrefixNEQ(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixIn applies the In predicate on the "prefix" field.
+func PrefixIn(vs ...string) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPrefix), v...))
+	})
+}
+
+// PrefixNotIn applies the NotIn predicate on the "prefix" field.
+func PrefixNotIn(vs ...string) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPrefix), v...))
+	})
+}
+
+// PrefixGT applies the GT predicate on the "prefix" field.
+func PrefixGT(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixGTE applies the GTE predicate on the "prefix" field.
+func PrefixGTE(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixLT applies the LT predicate on the "prefix" field.
+func PrefixLT(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixLTE applies the LTE predicate on the "prefix" field.
+func PrefixLTE(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixContains applies the Contains predicate on the "prefix" field.
+func PrefixContains(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixHasPrefix applies the HasPrefix predicate on the "prefix" field.
+func PrefixHasPrefix(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixHasSuffix applies the HasSuffix predicate on the "prefix" field.
+func PrefixHasSuffix(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixIsNil applies the IsNil predicate on the "prefix" field.
+func PrefixIsNil() predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPrefix)))
+	})
+}
+
+// PrefixNotNil applies the NotNil predicate on the "prefix" field.
+func PrefixNotNil() predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPrefix)))
+	})
+}
+
+// PrefixEqualFold applies the EqualFold predicate on the "prefix" field.
+func PrefixEqualFold(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixContainsFold applies the ContainsFold predicate on the "prefix" field.
+func PrefixContainsFold(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPrefix), v))
+	})
+}
+
+// RangeFromEQ applies the EQ predicate on the "range_from" field.
+func RangeFromEQ(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRangeFrom), v))
+	})
+}
+
+// RangeFromNEQ applies the NEQ predicate on the "range_from" field.
+func RangeFromNEQ(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRangeFrom), v))
+	})
+}
+
+// RangeFromIn applies the In predicate on the "range_from" field.
+func RangeFromIn(vs ...int64) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRangeFrom), v...))
+	})
+}
+
+// RangeFromNotIn applies the NotIn predicate on the "range_from" field.
+func RangeFromNotIn(vs ...int64) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRangeFrom), v...))
+	})
+}
+
+// RangeFromGT applies the GT predicate on the "range_from" field.
+func RangeFromGT(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRangeFrom), v))
+	})
+}
+
+// RangeFromGTE applies the GTE predicate on the "range_from" field.
+func RangeFromGTE(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRangeFrom), v))
+	})
+}
+
+// RangeFromLT applies the LT predicate on the "range_from" field.
+func RangeFromLT(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRangeFrom), v))
+	})
+}
+
+// RangeFromLTE applies the LTE predicate on the "range_from" field.
+func RangeFromLTE(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRangeFrom), v))
+	})
+}
+
+// RangeToEQ applies the EQ predicate on the "range_to" field.
+func RangeToEQ(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRangeTo), v))
+	})
+}
+
+// RangeToNEQ applies the NEQ predicate on the "range_to" field.
+func RangeToNEQ(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRangeTo), v))
+	})
+}
+
+// RangeToIn applies the In predicate on the "range_to" field.
+func RangeToIn(vs ...int64) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRangeTo), v...))
+	})
+}
+
+// RangeToNotIn applies the NotIn predicate on the "range_to" field.
+func RangeToNotIn(vs ...int64) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRangeTo), v...))
+	})
+}
+
+// RangeToGT applies the GT predicate on the "range_to" field.
+func RangeToGT(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRangeTo), v))
+	})
+}
+
+// RangeToGTE applies the GTE predicate on the "range_to" field.
+func RangeToGTE(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRangeTo), v))
+	})
+}
+
+// RangeToLT applies the LT predicate on the "range_to" field.
+func RangeToLT(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRangeTo), v))
+	})
+}
+
+// RangeToLTE applies the LTE predicate on the "range_to" field.
+func RangeToLTE(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRangeTo), v))
+	})
+}
+
+// CurrentNumberEQ applies the EQ predicate on the "current_number" field.
+func CurrentNumberEQ(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrentNumber), v))
+	})
+}
+
+// CurrentNumberNEQ applies the NEQ predicate on the "current_number" field.
+func CurrentNumberNEQ(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCurrentNumber), v))
+	})
+}
+
+// CurrentNumberIn applies the In predicate on the "current_number" field.
+func CurrentNumberIn(vs ...int64) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCurrentNumber), v...))
+	})
+}
+
+// CurrentNumberNotIn applies the NotIn predicate on the "current_number" field.
+func CurrentNumberNotIn(vs ...int64) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCurrentNumber), v...))
+	})
+}
+
+// CurrentNumberGT applies the GT predicate on the "current_number" field.
+func CurrentNumberGT(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCurrentNumber), v))
+	})
+}
+
+// CurrentNumberGTE applies the GTE predicate on the "current_number" field.
+func CurrentNumberGTE(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCurrentNumber), v))
+	})
+}
+
+// CurrentNumberLT applies the LT predicate on the "current_number" field.
+func CurrentNumberLT(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCurrentNumber), v))
+	})
+}
+
+// CurrentNumberLTE applies the LTE predicate on the "current_number" field.
+func CurrentNumberLTE(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCurrentNumber), v))
+	})
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldValidFrom), v))
+	})
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldValidFrom), v))
+	})
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldValidFrom), v...))
+	})
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldValidFrom), v...))
+	})
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldValidFrom), v))
+	})
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldValidFrom), v))
+	})
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldValidFrom), v))
+	})
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldValidFrom), v))
+	})
+}
+
+// ValidFromIsNil applies the IsNil predicate on the "valid_from" field.
+func ValidFromIsNil() predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldValidFrom)))
+	})
+}
+
+// ValidFromNotNil applies the NotNil predicate on the "valid_from" field.
+func ValidFromNotNil() predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldValidFrom)))
+	})
+}
+
+// ValidUntilEQ applies the EQ predicate on the "valid_until" field.
+func ValidUntilEQ(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldValidUntil), v))
+	})
+}
+
+// ValidUntilNEQ applies the NEQ predicate on the "valid_until" field.
+func ValidUntilNEQ(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldValidUntil), v))
+	})
+}
+
+// ValidUntilIn applies the In predicate on the "valid_until" field.
+func ValidUntilIn(vs ...time.Time) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldValidUntil), v...))
+	})
+}
+
+// ValidUntilNotIn applies the NotIn predicate on the "valid_until" field.
+func ValidUntilNotIn(vs ...time.Time) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldValidUntil), v...))
+	})
+}
+
+// ValidUntilGT applies the GT predicate on the "valid_until" field.
+func ValidUntilGT(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldValidUntil), v))
+	})
+}
+
+// ValidUntilGTE applies the GTE predicate on the "valid_until" field.
+func ValidUntilGTE(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldValidUntil), v))
+	})
+}
+
+// ValidUntilLT applies the LT predicate on the "valid_until" field.
+func ValidUntilLT(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldValidUntil), v))
+	})
+}
+
+// ValidUntilLTE applies the LTE predicate on the "valid_until" field.
+func ValidUntilLTE(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldValidUntil), v))
+	})
+}
+
+// ValidUntilIsNil applies the IsNil predicate on the "valid_until" field.
+func ValidUntilIsNil() predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldValidUntil)))
+	})
+}
+
+// ValidUntilNotNil applies the NotNil predicate on the "valid_until" field.
+func ValidUntilNotNil() predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldValidUntil)))
+	})
+}
+
+// ResolutionEQ applies the EQ predicate on the "resolution" field.
+func ResolutionEQ(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResolution), v))
+	})
+}
+
+// ResolutionNEQ applies the NEQ predicate on the "resolution" field.
+func ResolutionNEQ(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldResolution), v))
+	})
+}
+
+// ResolutionIn applies the In predicate on the "resolution" field.
+func ResolutionIn(vs ...string) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldResolution), v...))
+	})
+}
+
+// ResolutionNotIn applies the NotIn predicate on the "resolution" field.
+func ResolutionNotIn(vs ...string) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldResolution), v...))
+	})
+}
+
+// ResolutionGT applies the GT predicate on the "resolution" field.
+func ResolutionGT(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldResolution), v))
+	})
+}
+
+// ResolutionGTE applies the GTE predicate on the "resolution" field.
+func ResolutionGTE(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldResolution), v))
+	})
+}
+
+// ResolutionLT applies the LT predicate on the "resolution" field.
+func ResolutionLT(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldResolution), v))
+	})
+}
+
+// ResolutionLTE applies the LTE predicate on the "resolution" field.
+func ResolutionLTE(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldResolution), v))
+	})
+}
+
+// ResolutionContains applies the Contains predicate on the "resolution" field.
+func ResolutionContains(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldResolution), v))
+	})
+}
+
+// ResolutionHasPrefix applies the HasPrefix predicate on the "resolution" field.
+func ResolutionHasPrefix(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldResolution), v))
+	})
+}
+
+// ResolutionHasSuffix applies the HasSuffix predicate on the "resolution" field.
+func ResolutionHasSuffix(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldResolution), v))
+	})
+}
+
+// ResolutionIsNil applies the IsNil predicate on the "resolution" field.
+func ResolutionIsNil() predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldResolution)))
+	})
+}
+
+// ResolutionNotNil applies the NotNil predicate on the "resolution" field.
+func ResolutionNotNil() predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldResolution)))
+	})
+}
+
+// ResolutionEqualFold applies the EqualFold predicate on the "resolution" field.
+func ResolutionEqualFold(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldResolution), v))
+	})
+}
+
+// ResolutionContainsFold applies the ContainsFold predicate on the "resolution" field.
+func ResolutionContainsFold(v string) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldResolution), v))
+	})
+}
+
+// AlertRemainingEQ applies the EQ predicate on the "alert_remaining" field.
+func AlertRemainingEQ(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAlertRemaining), v))
+	})
+}
+
+// AlertRemainingNEQ applies the NEQ predicate on the "alert_remaining" field.
+func AlertRemainingNEQ(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAlertRemaining), v))
+	})
+}
+
+// AlertRemainingIn applies the In predicate on the "alert_remaining" field.
+func AlertRemainingIn(vs ...int64) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAlertRemaining), v...))
+	})
+}
+
+// AlertRemainingNotIn applies the NotIn predicate on the "alert_remaining" field.
+func AlertRemainingNotIn(vs ...int64) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAlertRemaining), v...))
+	})
+}
+
+// AlertRemainingGT applies the GT predicate on the "alert_remaining" field.
+func AlertRemainingGT(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAlertRemaining), v))
+	})
+}
+
+// AlertRemainingGTE applies the GTE predicate on the "alert_remaining" field.
+func AlertRemainingGTE(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAlertRemaining), v))
+	})
+}
+
+// AlertRemainingLT applies the LT predicate on the "alert_remaining" field.
+func AlertRemainingLT(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAlertRemaining), v))
+	})
+}
+
+// AlertRemainingLTE applies the LTE predicate on the "alert_remaining" field.
+func AlertRemainingLTE(v int64) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAlertRemaining), v))
+	})
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActive), v))
+	})
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldActive), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NumberingSeries {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NumberingSeries) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NumberingSeries) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NumberingSeries) predicate.NumberingSeries {
+	return predicate.NumberingSeries(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
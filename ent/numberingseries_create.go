@@ -0,0 +1,547 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/numberingseries"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NumberingSeriesCreate is the builder for creating a NumberingSeries entity.
+type NumberingSeriesCreate struct {
+	config
+	mutation *NumberingSeriesMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (nsc *NumberingSeriesCreate) SetTenantID(i int) *NumberingSeriesCreate {
+	nsc.mutation.SetTenantID(i)
+	return nsc
+}
+
+// SetDocumentType sets the "document_type" field.
+func (nsc *NumberingSeriesCreate) SetDocumentType(s string) *NumberingSeriesCreate {
+	nsc.mutation.SetDocumentType(s)
+	return nsc
+}
+
+// SetName sets the "name" field.
+func (nsc *NumberingSeriesCreate) SetName(s string) *NumberingSeriesCreate {
+	nsc.mutation.SetName(s)
+	return nsc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (nsc *NumberingSeriesCreate) SetNillableName(s *string) *NumberingSeriesCreate {
+	if s != nil {
+		nsc.SetName(*s)
+	}
+	return nsc
+}
+
+// SetPrefix sets the "prefix" field.
+func (nsc *NumberingSeriesCreate) SetPrefix(s string) *NumberingSeriesCreate {
+	nsc.mutation.SetPrefix(s)
+	return nsc
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (nsc *NumberingSeriesCreate) SetNillablePrefix(s *string) *NumberingSeriesCreate {
+	if s != nil {
+		nsc.SetPrefix(*s)
+	}
+	return nsc
+}
+
+// SetRangeFrom sets the "range_from" field.
+func (nsc *NumberingSeriesCreate) SetRangeFrom(i int64) *NumberingSeriesCreate {
+	nsc.mutation.SetRangeFrom(i)
+	return nsc
+}
+
+// SetRangeTo sets the "range_to" field.
+func (nsc *NumberingSeriesCreate) SetRangeTo(i int64) *NumberingSeriesCreate {
+	nsc.mutation.SetRangeTo(i)
+	return nsc
+}
+
+// SetCurrentNumber sets the "current_number" field.
+func (nsc *NumberingSeriesCreate) SetCurrentNumber(i int64) *NumberingSeriesCreate {
+	nsc.mutation.SetCurrentNumber(i)
+	return nsc
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (nsc *NumberingSeriesCreate) SetValidFrom(t time.Time) *NumberingSeriesCreate {
+	nsc.mutation.SetValidFrom(t)
+	return nsc
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (nsc *NumberingSeriesCreate) SetNillableValidFrom(t *time.Time) *NumberingSeriesCreate {
+	if t != nil {
+		nsc.SetValidFrom(*t)
+	}
+	return nsc
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (nsc *NumberingSeriesCreate) SetValidUntil(t time.Time) *NumberingSeriesCreate {
+	nsc.mutation.SetValidUntil(t)
+	return nsc
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (nsc *NumberingSeriesCreate) SetNillableValidUntil(t *time.Time) *NumberingSeriesCreate {
+	if t != nil {
+		nsc.SetValidUntil(*t)
+	}
+	return nsc
+}
+
+// SetResolution sets the "resolution" field.
+func (nsc *NumberingSeriesCreate) SetResolution(s string) *NumberingSeriesCreate {
+	nsc.mutation.SetResolution(s)
+	return nsc
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (nsc *NumberingSeriesCreate) SetNillableResolution(s *string) *NumberingSeriesCreate {
+	if s != nil {
+		nsc.SetResolution(*s)
+	}
+	return nsc
+}
+
+// SetAlertRemaining sets the "alert_remaining" field.
+func (nsc *NumberingSeriesCreate) SetAlertRemaining(i int64) *NumberingSeriesCreate {
+	nsc.mutation.SetAlertRemaining(i)
+	return nsc
+}
+
+// SetNillableAlertRemaining sets the "alert_remaining" field if the given value is not nil.
+func (nsc *NumberingSeriesCreate) SetNillableAlertRemaining(i *int64) *NumberingSeriesCreate {
+	if i != nil {
+		nsc.SetAlertRemaining(*i)
+	}
+	return nsc
+}
+
+// SetActive sets the "active" field.
+func (nsc *NumberingSeriesCreate) SetActive(b bool) *NumberingSeriesCreate {
+	nsc.mutation.SetActive(b)
+	return nsc
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (nsc *NumberingSeriesCreate) SetNillableActive(b *bool) *NumberingSeriesCreate {
+	if b != nil {
+		nsc.SetActive(*b)
+	}
+	return nsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nsc *NumberingSeriesCreate) SetCreatedAt(t time.Time) *NumberingSeriesCreate {
+	nsc.mutation.SetCreatedAt(t)
+	return nsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nsc *NumberingSeriesCreate) SetNillableCreatedAt(t *time.Time) *NumberingSeriesCreate {
+	if t != nil {
+		nsc.SetCreatedAt(*t)
+	}
+	return nsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nsc *NumberingSeriesCreate) SetUpdatedAt(t time.Time) *NumberingSeriesCreate {
+	nsc.mutation.SetUpdatedAt(t)
+	return nsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (nsc *NumberingSeriesCreate) SetNillableUpdatedAt(t *time.Time) *NumberingSeriesCreate {
+	if t != nil {
+		nsc.SetUpdatedAt(*t)
+	}
+	return nsc
+}
+
+// Mutation returns the NumberingSeriesMutation object of the builder.
+func (nsc *NumberingSeriesCreate) Mutation() *NumberingSeriesMutation {
+	return nsc.mutation
+}
+
+// Save creates the NumberingSeries in the database.
+func (nsc *NumberingSeriesCreate) Save(ctx context.Context) (*NumberingSeries, error) {
+	var (
+		err  error
+		node *NumberingSeries
+	)
+	nsc.defaults()
+	if len(nsc.hooks) == 0 {
+		if err = nsc.check(); err != nil {
+			return nil, err
+		}
+		node, err = nsc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NumberingSeriesMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = nsc.check(); err != nil {
+				return nil, err
+			}
+			nsc.mutation = mutation
+			if node, err = nsc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(nsc.hooks) - 1; i >= 0; i-- {
+			if nsc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = nsc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, nsc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*NumberingSeries)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from NumberingSeriesMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nsc *NumberingSeriesCreate) SaveX(ctx context.Context) *NumberingSeries {
+	v, err := nsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nsc *NumberingSeriesCreate) Exec(ctx context.Context) error {
+	_, err := nsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nsc *NumberingSeriesCreate) ExecX(ctx context.Context) {
+	if err := nsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nsc *NumberingSeriesCreate) defaults() {
+	if _, ok := nsc.mutation.AlertRemaining(); !ok {
+		v := numberingseries.DefaultAlertRemaining
+		nsc.mutation.SetAlertRemaining(v)
+	}
+	if _, ok := nsc.mutation.Active(); !ok {
+		v := numberingseries.DefaultActive
+		nsc.mutation.SetActive(v)
+	}
+	if _, ok := nsc.mutation.CreatedAt(); !ok {
+		v := numberingseries.DefaultCreatedAt()
+		nsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := nsc.mutation.UpdatedAt(); !ok {
+		v := numberingseries.DefaultUpdatedAt()
+		nsc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nsc *NumberingSeriesCreate) check() error {
+	if _, ok := nsc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "NumberingSeries.tenant_id"`)}
+	}
+	if _, ok := nsc.mutation.DocumentType(); !ok {
+		return &ValidationError{Name: "document_type", err: errors.New(`ent: missing required field "NumberingSeries.document_type"`)}
+	}
+	if _, ok := nsc.mutation.RangeFrom(); !ok {
+		return &ValidationError{Name: "range_from", err: errors.New(`ent: missing required field "NumberingSeries.range_from"`)}
+	}
+	if v, ok := nsc.mutation.RangeFrom(); ok {
+		if err := numberingseries.RangeFromValidator(v); err != nil {
+			return &ValidationError{Name: "range_from", err: fmt.Errorf(`ent: validator failed for field "NumberingSeries.range_from": %w`, err)}
+		}
+	}
+	if _, ok := nsc.mutation.RangeTo(); !ok {
+		return &ValidationError{Name: "range_to", err: errors.New(`ent: missing required field "NumberingSeries.range_to"`)}
+	}
+	if v, ok := nsc.mutation.RangeTo(); ok {
+		if err := numberingseries.RangeToValidator(v); err != nil {
+			return &ValidationError{Name: "range_to", err: fmt.Errorf(`ent: validator failed for field "NumberingSeries.range_to": %w`, err)}
+		}
+	}
+	if _, ok := nsc.mutation.CurrentNumber(); !ok {
+		return &ValidationError{Name: "current_number", err: errors.New(`ent: missing required field "NumberingSeries.current_number"`)}
+	}
+	if v, ok := nsc.mutation.CurrentNumber(); ok {
+		if err := numberingseries.CurrentNumberValidator(v); err != nil {
+			return &ValidationError{Name: "current_number", err: fmt.Errorf(`ent: validator failed for field "NumberingSeries.current_number": %w`, err)}
+		}
+	}
+	if _, ok := nsc.mutation.AlertRemaining(); !ok {
+		return &ValidationError{Name: "alert_remaining", err: errors.New(`ent: missing required field "NumberingSeries.alert_remaining"`)}
+	}
+	if v, ok := nsc.mutation.AlertRemaining(); ok {
+		if err := numberingseries.AlertRemainingValidator(v); err != nil {
+			return &ValidationError{Name: "alert_remaining", err: fmt.Errorf(`ent: validator failed for field "NumberingSeries.alert_remaining": %w`, err)}
+		}
+	}
+	if _, ok := nsc.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "NumberingSeries.active"`)}
+	}
+	if _, ok := nsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NumberingSeries.created_at"`)}
+	}
+	if _, ok := nsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NumberingSeries.updated_at"`)}
+	}
+	return nil
+}
+
+func (nsc *NumberingSeriesCreate) sqlSave(ctx context.Context) (*NumberingSeries, error) {
+	_node, _spec := nsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (nsc *NumberingSeriesCreate) createSpec() (*NumberingSeries, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NumberingSeries{config: nsc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: numberingseries.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: numberingseries.FieldID,
+			},
+		}
+	)
+	if value, ok := nsc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: numberingseries.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := nsc.mutation.DocumentType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: numberingseries.FieldDocumentType,
+		})
+		_node.DocumentType = value
+	}
+	if value, ok := nsc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: numberingseries.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := nsc.mutation.Prefix(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: numberingseries.FieldPrefix,
+		})
+		_node.Prefix = value
+	}
+	if value, ok := nsc.mutation.RangeFrom(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldRangeFrom,
+		})
+		_node.RangeFrom = value
+	}
+	if value, ok := nsc.mutation.RangeTo(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldRangeTo,
+		})
+		_node.RangeTo = value
+	}
+	if value, ok := nsc.mutation.CurrentNumber(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldCurrentNumber,
+		})
+		_node.CurrentNumber = value
+	}
+	if value, ok := nsc.mutation.ValidFrom(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: numberingseries.FieldValidFrom,
+		})
+		_node.ValidFrom = &value
+	}
+	if value, ok := nsc.mutation.ValidUntil(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: numberingseries.FieldValidUntil,
+		})
+		_node.ValidUntil = &value
+	}
+	if value, ok := nsc.mutation.Resolution(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: numberingseries.FieldResolution,
+		})
+		_node.Resolution = value
+	}
+	if value, ok := nsc.mutation.AlertRemaining(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldAlertRemaining,
+		})
+		_node.AlertRemaining = value
+	}
+	if value, ok := nsc.mutation.Active(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: numberingseries.FieldActive,
+		})
+		_node.Active = value
+	}
+	if value, ok := nsc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: numberingseries.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := nsc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: numberingseries.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// NumberingSeriesCreateBulk is the builder for creating many NumberingSeries entities in bulk.
+type NumberingSeriesCreateBulk struct {
+	config
+	builders []*NumberingSeriesCreate
+}
+
+// Save creates the NumberingSeries entities in the database.
+func (nscb *NumberingSeriesCreateBulk) Save(ctx context.Context) ([]*NumberingSeries, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(nscb.builders))
+	nodes := make([]*NumberingSeries, len(nscb.builders))
+	mutators := make([]Mutator, len(nscb.builders))
+	for i := range nscb.builders {
+		func(i int, root context.Context) {
+			builder := nscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NumberingSeriesMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, nscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, nscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, nscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nscb *NumberingSeriesCreateBulk) SaveX(ctx context.Context) []*NumberingSeries {
+	v, err := nscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nscb *NumberingSeriesCreateBulk) Exec(ctx context.Context) error {
+	_, err := nscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nscb *NumberingSeriesCreateBulk) ExecX(ctx context.Context) {
+	if err := nscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/numberingseries"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NumberingSeriesDelete is the builder for deleting a NumberingSeries entity.
+type NumberingSeriesDelete struct {
+	config
+	hooks    []Hook
+	mutation *NumberingSeriesMutation
+}
+
+// Where appends a list predicates to the NumberingSeriesDelete builder.
+func (nsd *NumberingSeriesDelete) Where(ps ...predicate.NumberingSeries) *NumberingSeriesDelete {
+	nsd.mutation.Where(ps...)
+	return nsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (nsd *NumberingSeriesDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(nsd.hooks) == 0 {
+		affected, err = nsd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NumberingSeriesMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			nsd.mutation = mutation
+			affected, err = nsd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(nsd.hooks) - 1; i >= 0; i-- {
+			if nsd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = nsd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nsd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nsd *NumberingSeriesDelete) ExecX(ctx context.Context) int {
+	n, err := nsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (nsd *NumberingSeriesDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: numberingseries.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: numberingseries.FieldID,
+			},
+		},
+	}
+	if ps := nsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, nsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// NumberingSeriesDeleteOne is the builder for deleting a single NumberingSeries entity.
+type NumberingSeriesDeleteOne struct {
+	nsd *NumberingSeriesDelete
+}
+
+// Exec executes the deletion query.
+func (nsdo *NumberingSeriesDeleteOne) Exec(ctx context.Context) error {
+	n, err := nsdo.nsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{numberingseries.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nsdo *NumberingSeriesDeleteOne) ExecX(ctx context.Context) {
+	nsdo.nsd.ExecX(ctx)
+}
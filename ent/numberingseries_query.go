@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/numberingseries"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NumberingSeriesQuery is the builder for querying NumberingSeries entities.
+type NumberingSeriesQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.NumberingSeries
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NumberingSeriesQuery builder.
+func (nsq *NumberingSeriesQuery) Where(ps ...predicate.NumberingSeries) *NumberingSeriesQuery {
+	nsq.predicates = append(nsq.predicates, ps...)
+	return nsq
+}
+
+// Limit adds a limit step to the query.
+func (nsq *NumberingSeriesQuery) Limit(limit int) *NumberingSeriesQuery {
+	nsq.limit = &limit
+	return nsq
+}
+
+// Offset adds an offset step to the query.
+func (nsq *NumberingSeriesQuery) Offset(offset int) *NumberingSeriesQuery {
+	nsq.offset = &offset
+	return nsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (nsq *NumberingSeriesQuery) Unique(unique bool) *NumberingSeriesQuery {
+	nsq.unique = &unique
+	return nsq
+}
+
+// Order adds an order step to the query.
+func (nsq *NumberingSeriesQuery) Order(o ...OrderFunc) *NumberingSeriesQuery {
+	nsq.order = append(nsq.order, o...)
+	return nsq
+}
+
+// First returns the first NumberingSeries entity from the query.
+// Returns a *NotFoundError when no NumberingSeries was found.
+func (nsq *NumberingSeriesQuery) First(ctx context.Context) (*NumberingSeries, error) {
+	nodes, err := nsq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{numberingseries.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (nsq *NumberingSeriesQuery) FirstX(ctx context.Context) *NumberingSeries {
+	node, err := nsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NumberingSeries ID from the query.
+// Returns a *NotFoundError when no NumberingSeries ID was found.
+func (nsq *NumberingSeriesQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = nsq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{numberingseries.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (nsq *NumberingSeriesQuery) FirstIDX(ctx context.Context) int {
+	id, err := nsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NumberingSeries entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one NumberingSeries entity is found.
+// Returns a *NotFoundError when no NumberingSeries entities are found.
+func (nsq *NumberingSeriesQuery) Only(ctx context.Context) (*NumberingSeries, error) {
+	nodes, err := nsq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{numberingseries.Label}
+	default:
+		return nil, &NotSingularError{numberingseries.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (nsq *NumberingSeriesQuery) OnlyX(ctx context.Context) *NumberingSeries {
+	node, err := nsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NumberingSeries ID in the query.
+// Returns a *NotSingularError when more than one NumberingSeries ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (nsq *NumberingSeriesQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = nsq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{numberingseries.Label}
+	default:
+		err = &NotSingularError{numberingseries.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (nsq *NumberingSeriesQuery) OnlyIDX(ctx context.Context) int {
+	id, err := nsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NumberingSeriesSlice.
+func (nsq *NumberingSeriesQuery) All(ctx context.Context) ([]*NumberingSeries, error) {
+	if err := nsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return nsq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (nsq *NumberingSeriesQuery) AllX(ctx context.Context) []*NumberingSeries {
+	nodes, err := nsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NumberingSeries IDs.
+func (nsq *NumberingSeriesQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := nsq.Select(numberingseries.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (nsq *NumberingSeriesQuery) IDsX(ctx context.Context) []int {
+	ids, err := nsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (nsq *NumberingSeriesQuery) Count(ctx context.Context) (int, error) {
+	if err := nsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return nsq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (nsq *NumberingSeriesQuery) CountX(ctx context.Context) int {
+	count, err := nsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (nsq *NumberingSeriesQuery) Exist(ctx context.Context) (bool, error) {
+	if err := nsq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return nsq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (nsq *NumberingSeriesQuery) ExistX(ctx context.Context) bool {
+	exist, err := nsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NumberingSeriesQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (nsq *NumberingSeriesQuery) Clone() *NumberingSeriesQuery {
+	if nsq == nil {
+		return nil
+	}
+	return &NumberingSeriesQuery{
+		config:     nsq.config,
+		limit:      nsq.limit,
+		offset:     nsq.offset,
+		order:      append([]OrderFunc{}, nsq.order...),
+		predicates: append([]predicate.NumberingSeries{}, nsq.predicates...),
+		// clone intermediate query.
+		sql:    nsq.sql.Clone(),
+		path:   nsq.path,
+		unique: nsq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NumberingSeries.Query().
+//		GroupBy(numberingseries.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (nsq *NumberingSeriesQuery) GroupBy(field string, fields ...string) *NumberingSeriesGroupBy {
+	grbuild := &NumberingSeriesGroupBy{config: nsq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := nsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return nsq.sqlQuery(ctx), nil
+	}
+	grbuild.label = numberingseries.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.NumberingSeries.Query().
+//		Select(numberingseries.FieldTenantID).
+//		Scan(ctx, &v)
+func (nsq *NumberingSeriesQuery) Select(fields ...string) *NumberingSeriesSelect {
+	nsq.fields = append(nsq.fields, fields...)
+	selbuild := &NumberingSeriesSelect{NumberingSeriesQuery: nsq}
+	selbuild.label = numberingseries.Label
+	selbuild.flds, selbuild.scan = &nsq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (nsq *NumberingSeriesQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range nsq.fields {
+		if !numberingseries.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if nsq.path != nil {
+		prev, err := nsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		nsq.sql = prev
+	}
+	return nil
+}
+
+func (nsq *NumberingSeriesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NumberingSeries, error) {
+	var (
+		nodes = []*NumberingSeries{}
+		_spec = nsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*NumberingSeries).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &NumberingSeries{config: nsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(nsq.modifiers) > 0 {
+		_spec.Modifiers = nsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, nsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (nsq *NumberingSeriesQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := nsq.querySpec()
+	if len(nsq.modifiers) > 0 {
+		_spec.Modifiers = nsq.modifiers
+	}
+	_spec.Node.Columns = nsq.fields
+	if len(nsq.fields) > 0 {
+		_spec.Unique = nsq.unique != nil && *nsq.unique
+	}
+	return sqlgraph.CountNodes(ctx, nsq.driver, _spec)
+}
+
+func (nsq *NumberingSeriesQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := nsq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (nsq *NumberingSeriesQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   numberingseries.Table,
+			Columns: numberingseries.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: numberingseries.FieldID,
+			},
+		},
+		From:   nsq.sql,
+		Unique: true,
+	}
+	if unique := nsq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := nsq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, numberingseries.FieldID)
+		for i := range fields {
+			if fields[i] != numberingseries.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := nsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := nsq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := nsq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := nsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (nsq *NumberingSeriesQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(nsq.driver.Dialect())
+	t1 := builder.Table(numberingseries.Table)
+	columns := nsq.fields
+	if len(columns) == 0 {
+		columns = numberingseries.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if nsq.sql != nil {
+		selector = nsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if nsq.unique != nil && *nsq.unique {
+		selector.Distinct()
+	}
+	for _, m := range nsq.modifiers {
+		m(selector)
+	}
+	for _, p := range nsq.predicates {
+		p(selector)
+	}
+	for _, p := range nsq.order {
+		p(selector)
+	}
+	if offset := nsq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := nsq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (nsq *NumberingSeriesQuery) ForUpdate(opts ...sql.LockOption) *NumberingSeriesQuery {
+	if nsq.driver.Dialect() == dialect.Postgres {
+		nsq.Unique(false)
+	}
+	nsq.modifiers = append(nsq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return nsq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (nsq *NumberingSeriesQuery) ForShare(opts ...sql.LockOption) *NumberingSeriesQuery {
+	if nsq.driver.Dialect() == dialect.Postgres {
+		nsq.Unique(false)
+	}
+	nsq.modifiers = append(nsq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return nsq
+}
+
+// NumberingSeriesGroupBy is the group-by builder for NumberingSeries entities.
+type NumberingSeriesGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (nsgb *NumberingSeriesGroupBy) Aggregate(fns ...AggregateFunc) *NumberingSeriesGroupBy {
+	nsgb.fns = append(nsgb.fns, fns...)
+	return nsgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (nsgb *NumberingSeriesGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := nsgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	nsgb.sql = query
+	return nsgb.sqlScan(ctx, v)
+}
+
+func (nsgb *NumberingSeriesGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range nsgb.fields {
+		if !numberingseries.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := nsgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nsgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (nsgb *NumberingSeriesGroupBy) sqlQuery() *sql.Selector {
+	selector := nsgb.sql.Select()
+	aggregation := make([]string, 0, len(nsgb.fns))
+	for _, fn := range nsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(nsgb.fields)+len(nsgb.fns))
+		for _, f := range nsgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(nsgb.fields...)...)
+}
+
+// NumberingSeriesSelect is the builder for selecting fields of NumberingSeries entities.
+type NumberingSeriesSelect struct {
+	*NumberingSeriesQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nss *NumberingSeriesSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := nss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	nss.sql = nss.NumberingSeriesQuery.sqlQuery(ctx)
+	return nss.sqlScan(ctx, v)
+}
+
+func (nss *NumberingSeriesSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := nss.sql.Query()
+	if err := nss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,1036 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/numberingseries"
+	"Veritasbackend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NumberingSeriesUpdate is the builder for updating NumberingSeries entities.
+type NumberingSeriesUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NumberingSeriesMutation
+}
+
+// Where appends a list predicates to the NumberingSeriesUpdate builder.
+func (nsu *NumberingSeriesUpdate) Where(ps ...predicate.NumberingSeries) *NumberingSeriesUpdate {
+	nsu.mutation.Where(ps...)
+	return nsu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (nsu *NumberingSeriesUpdate) SetTenantID(i int) *NumberingSeriesUpdate {
+	nsu.mutation.ResetTenantID()
+	nsu.mutation.SetTenantID(i)
+	return nsu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (nsu *NumberingSeriesUpdate) AddTenantID(i int) *NumberingSeriesUpdate {
+	nsu.mutation.AddTenantID(i)
+	return nsu
+}
+
+// SetDocumentType sets the "document_type" field.
+func (nsu *NumberingSeriesUpdate) SetDocumentType(s string) *NumberingSeriesUpdate {
+	nsu.mutation.SetDocumentType(s)
+	return nsu
+}
+
+// SetName sets the "name" field.
+func (nsu *NumberingSeriesUpdate) SetName(s string) *NumberingSeriesUpdate {
+	nsu.mutation.SetName(s)
+	return nsu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (nsu *NumberingSeriesUpdate) SetNillableName(s *string) *NumberingSeriesUpdate {
+	if s != nil {
+		nsu.SetName(*s)
+	}
+	return nsu
+}
+
+// ClearName clears the value of the "name" field.
+func (nsu *NumberingSeriesUpdate) ClearName() *NumberingSeriesUpdate {
+	nsu.mutation.ClearName()
+	return nsu
+}
+
+// SetPrefix sets the "prefix" field.
+func (nsu *NumberingSeriesUpdate) SetPrefix(s string) *NumberingSeriesUpdate {
+	nsu.mutation.SetPrefix(s)
+	return nsu
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (nsu *NumberingSeriesUpdate) SetNillablePrefix(s *string) *NumberingSeriesUpdate {
+	if s != nil {
+		nsu.SetPrefix(*s)
+	}
+	return nsu
+}
+
+// ClearPrefix clears the value of the "prefix" field.
+func (nsu *NumberingSeriesUpdate) ClearPrefix() *NumberingSeriesUpdate {
+	nsu.mutation.ClearPrefix()
+	return nsu
+}
+
+// SetRangeFrom sets the "range_from" field.
+func (nsu *NumberingSeriesUpdate) SetRangeFrom(i int64) *NumberingSeriesUpdate {
+	nsu.mutation.ResetRangeFrom()
+	nsu.mutation.SetRangeFrom(i)
+	return nsu
+}
+
+// AddRangeFrom adds i to the "range_from" field.
+func (nsu *NumberingSeriesUpdate) AddRangeFrom(i int64) *NumberingSeriesUpdate {
+	nsu.mutation.AddRangeFrom(i)
+	return nsu
+}
+
+// SetRangeTo sets the "range_to" field.
+func (nsu *NumberingSeriesUpdate) SetRangeTo(i int64) *NumberingSeriesUpdate {
+	nsu.mutation.ResetRangeTo()
+	nsu.mutation.SetRangeTo(i)
+	return nsu
+}
+
+// AddRangeTo adds i to the "range_to" field.
+func (nsu *NumberingSeriesUpdate) AddRangeTo(i int64) *NumberingSeriesUpdate {
+	nsu.mutation.AddRangeTo(i)
+	return nsu
+}
+
+// SetCurrentNumber sets the "current_number" field.
+func (nsu *NumberingSeriesUpdate) SetCurrentNumber(i int64) *NumberingSeriesUpdate {
+	nsu.mutation.ResetCurrentNumber()
+	nsu.mutation.SetCurrentNumber(i)
+	return nsu
+}
+
+// AddCurrentNumber adds i to the "current_number" field.
+func (nsu *NumberingSeriesUpdate) AddCurrentNumber(i int64) *NumberingSeriesUpdate {
+	nsu.mutation.AddCurrentNumber(i)
+	return nsu
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (nsu *NumberingSeriesUpdate) SetValidFrom(t time.Time) *NumberingSeriesUpdate {
+	nsu.mutation.SetValidFrom(t)
+	return nsu
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (nsu *NumberingSeriesUpdate) SetNillableValidFrom(t *time.Time) *NumberingSeriesUpdate {
+	if t != nil {
+		nsu.SetValidFrom(*t)
+	}
+	return nsu
+}
+
+// ClearValidFrom clears the value of the "valid_from" field.
+func (nsu *NumberingSeriesUpdate) ClearValidFrom() *NumberingSeriesUpdate {
+	nsu.mutation.ClearValidFrom()
+	return nsu
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (nsu *NumberingSeriesUpdate) SetValidUntil(t time.Time) *NumberingSeriesUpdate {
+	nsu.mutation.SetValidUntil(t)
+	return nsu
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (nsu *NumberingSeriesUpdate) SetNillableValidUntil(t *time.Time) *NumberingSeriesUpdate {
+	if t != nil {
+		nsu.SetValidUntil(*t)
+	}
+	return nsu
+}
+
+// ClearValidUntil clears the value of the "valid_until" field.
+func (nsu *NumberingSeriesUpdate) ClearValidUntil() *NumberingSeriesUpdate {
+	nsu.mutation.ClearValidUntil()
+	return nsu
+}
+
+// SetResolution sets the "resolution" field.
+func (nsu *NumberingSeriesUpdate) SetResolution(s string) *NumberingSeriesUpdate {
+	nsu.mutation.SetResolution(s)
+	return nsu
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (nsu *NumberingSeriesUpdate) SetNillableResolution(s *string) *NumberingSeriesUpdate {
+	if s != nil {
+		nsu.SetResolution(*s)
+	}
+	return nsu
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (nsu *NumberingSeriesUpdate) ClearResolution() *NumberingSeriesUpdate {
+	nsu.mutation.ClearResolution()
+	return nsu
+}
+
+// SetAlertRemaining sets the "alert_remaining" field.
+func (nsu *NumberingSeriesUpdate) SetAlertRemaining(i int64) *NumberingSeriesUpdate {
+	nsu.mutation.ResetAlertRemaining()
+	nsu.mutation.SetAlertRemaining(i)
+	return nsu
+}
+
+// SetNillableAlertRemaining sets the "alert_remaining" field if the given value is not nil.
+func (nsu *NumberingSeriesUpdate) SetNillableAlertRemaining(i *int64) *NumberingSeriesUpdate {
+	if i != nil {
+		nsu.SetAlertRemaining(*i)
+	}
+	return nsu
+}
+
+// AddAlertRemaining adds i to the "alert_remaining" field.
+func (nsu *NumberingSeriesUpdate) AddAlertRemaining(i int64) *NumberingSeriesUpdate {
+	nsu.mutation.AddAlertRemaining(i)
+	return nsu
+}
+
+// SetActive sets the "active" field.
+func (nsu *NumberingSeriesUpdate) SetActive(b bool) *NumberingSeriesUpdate {
+	nsu.mutation.SetActive(b)
+	return nsu
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (nsu *NumberingSeriesUpdate) SetNillableActive(b *bool) *NumberingSeriesUpdate {
+	if b != nil {
+		nsu.SetActive(*b)
+	}
+	return nsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nsu *NumberingSeriesUpdate) SetUpdatedAt(t time.Time) *NumberingSeriesUpdate {
+	nsu.mutation.SetUpdatedAt(t)
+	return nsu
+}
+
+// Mutation returns the NumberingSeriesMutation object of the builder.
+func (nsu *NumberingSeriesUpdate) Mutation() *NumberingSeriesMutation {
+	return nsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nsu *NumberingSeriesUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	nsu.defaults()
+	if len(nsu.hooks) == 0 {
+		if err = nsu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = nsu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NumberingSeriesMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = nsu.check(); err != nil {
+				return 0, err
+			}
+			nsu.mutation = mutation
+			affected, err = nsu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(nsu.hooks) - 1; i >= 0; i-- {
+			if nsu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = nsu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nsu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nsu *NumberingSeriesUpdate) SaveX(ctx context.Context) int {
+	affected, err := nsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nsu *NumberingSeriesUpdate) Exec(ctx context.Context) error {
+	_, err := nsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nsu *NumberingSeriesUpdate) ExecX(ctx context.Context) {
+	if err := nsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nsu *NumberingSeriesUpdate) defaults() {
+	if _, ok := nsu.mutation.UpdatedAt(); !ok {
+		v := numberingseries.UpdateDefaultUpdatedAt()
+		nsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nsu *NumberingSeriesUpdate) check() error {
+	if v, ok := nsu.mutation.RangeFrom(); ok {
+		if err := numberingseries.RangeFromValidator(v); err != nil {
+			return &ValidationError{Name: "range_from", err: fmt.Errorf(`ent: validator failed for field "NumberingSeries.range_from": %w`, err)}
+		}
+	}
+	if v, ok := nsu.mutation.RangeTo(); ok {
+		if err := numberingseries.RangeToValidator(v); err != nil {
+			return &ValidationError{Name: "range_to", err: fmt.Errorf(`ent: validator failed for field "NumberingSeries.range_to": %w`, err)}
+		}
+	}
+	if v, ok := nsu.mutation.CurrentNumber(); ok {
+		if err := numberingseries.CurrentNumberValidator(v); err != nil {
+			return &ValidationError{Name: "current_number", err: fmt.Errorf(`ent: validator failed for field "NumberingSeries.current_number": %w`, err)}
+		}
+	}
+	if v, ok := nsu.mutation.AlertRemaining(); ok {
+		if err := numberingseries.AlertRemainingValidator(v); err != nil {
+			return &ValidationError{Name: "alert_remaining", err: fmt.Errorf(`ent: validator failed for field "NumberingSeries.alert_remaining": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (nsu *NumberingSeriesUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   numberingseries.Table,
+			Columns: numberingseries.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: numberingseries.FieldID,
+			},
+		},
+	}
+	if ps := nsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nsu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: numberingseries.FieldTenantID,
+		})
+	}
+	if value, ok := nsu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: numberingseries.FieldTenantID,
+		})
+	}
+	if value, ok := nsu.mutation.DocumentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: numberingseries.FieldDocumentType,
+		})
+	}
+	if value, ok := nsu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: numberingseries.FieldName,
+		})
+	}
+	if nsu.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: numberingseries.FieldName,
+		})
+	}
+	if value, ok := nsu.mutation.Prefix(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: numberingseries.FieldPrefix,
+		})
+	}
+	if nsu.mutation.PrefixCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: numberingseries.FieldPrefix,
+		})
+	}
+	if value, ok := nsu.mutation.RangeFrom(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldRangeFrom,
+		})
+	}
+	if value, ok := nsu.mutation.AddedRangeFrom(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldRangeFrom,
+		})
+	}
+	if value, ok := nsu.mutation.RangeTo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldRangeTo,
+		})
+	}
+	if value, ok := nsu.mutation.AddedRangeTo(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldRangeTo,
+		})
+	}
+	if value, ok := nsu.mutation.CurrentNumber(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldCurrentNumber,
+		})
+	}
+	if value, ok := nsu.mutation.AddedCurrentNumber(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldCurrentNumber,
+		})
+	}
+	if value, ok := nsu.mutation.ValidFrom(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: numberingseries.FieldValidFrom,
+		})
+	}
+	if nsu.mutation.ValidFromCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: numberingseries.FieldValidFrom,
+		})
+	}
+	if value, ok := nsu.mutation.ValidUntil(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: numberingseries.FieldValidUntil,
+		})
+	}
+	if nsu.mutation.ValidUntilCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: numberingseries.FieldValidUntil,
+		})
+	}
+	if value, ok := nsu.mutation.Resolution(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: numberingseries.FieldResolution,
+		})
+	}
+	if nsu.mutation.ResolutionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: numberingseries.FieldResolution,
+		})
+	}
+	if value, ok := nsu.mutation.AlertRemaining(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldAlertRemaining,
+		})
+	}
+	if value, ok := nsu.mutation.AddedAlertRemaining(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldAlertRemaining,
+		})
+	}
+	if value, ok := nsu.mutation.Active(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: numberingseries.FieldActive,
+		})
+	}
+	if value, ok := nsu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: numberingseries.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{numberingseries.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// NumberingSeriesUpdateOne is the builder for updating a single NumberingSeries entity.
+type NumberingSeriesUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NumberingSeriesMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (nsuo *NumberingSeriesUpdateOne) SetTenantID(i int) *NumberingSeriesUpdateOne {
+	nsuo.mutation.ResetTenantID()
+	nsuo.mutation.SetTenantID(i)
+	return nsuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (nsuo *NumberingSeriesUpdateOne) AddTenantID(i int) *NumberingSeriesUpdateOne {
+	nsuo.mutation.AddTenantID(i)
+	return nsuo
+}
+
+// SetDocumentType sets the "document_type" field.
+func (nsuo *NumberingSeriesUpdateOne) SetDocumentType(s string) *NumberingSeriesUpdateOne {
+	nsuo.mutation.SetDocumentType(s)
+	return nsuo
+}
+
+// SetName sets the "name" field.
+func (nsuo *NumberingSeriesUpdateOne) SetName(s string) *NumberingSeriesUpdateOne {
+	nsuo.mutation.SetName(s)
+	return nsuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (nsuo *NumberingSeriesUpdateOne) SetNillableName(s *string) *NumberingSeriesUpdateOne {
+	if s != nil {
+		nsuo.SetName(*s)
+	}
+	return nsuo
+}
+
+// ClearName clears the value of the "name" field.
+func (nsuo *NumberingSeriesUpdateOne) ClearName() *NumberingSeriesUpdateOne {
+	nsuo.mutation.ClearName()
+	return nsuo
+}
+
+// SetPrefix sets the "prefix" field.
+func (nsuo *NumberingSeriesUpdateOne) SetPrefix(s string) *NumberingSeriesUpdateOne {
+	nsuo.mutation.SetPrefix(s)
+	return nsuo
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (nsuo *NumberingSeriesUpdateOne) SetNillablePrefix(s *string) *NumberingSeriesUpdateOne {
+	if s != nil {
+		nsuo.SetPrefix(*s)
+	}
+	return nsuo
+}
+
+// ClearPrefix clears the value of the "prefix" field.
+func (nsuo *NumberingSeriesUpdateOne) ClearPrefix() *NumberingSeriesUpdateOne {
+	nsuo.mutation.ClearPrefix()
+	return nsuo
+}
+
+// SetRangeFrom sets the "range_from" field.
+func (nsuo *NumberingSeriesUpdateOne) SetRangeFrom(i int64) *NumberingSeriesUpdateOne {
+	nsuo.mutation.ResetRangeFrom()
+	nsuo.mutation.SetRangeFrom(i)
+	return nsuo
+}
+
+// AddRangeFrom adds i to the "range_from" field.
+func (nsuo *NumberingSeriesUpdateOne) AddRangeFrom(i int64) *NumberingSeriesUpdateOne {
+	nsuo.mutation.AddRangeFrom(i)
+	return nsuo
+}
+
+// SetRangeTo sets the "range_to" field.
+func (nsuo *NumberingSeriesUpdateOne) SetRangeTo(i int64) *NumberingSeriesUpdateOne {
+	nsuo.mutation.ResetRangeTo()
+	nsuo.mutation.SetRangeTo(i)
+	return nsuo
+}
+
+// AddRangeTo adds i to the "range_to" field.
+func (nsuo *NumberingSeriesUpdateOne) AddRangeTo(i int64) *NumberingSeriesUpdateOne {
+	nsuo.mutation.AddRangeTo(i)
+	return nsuo
+}
+
+// SetCurrentNumber sets the "current_number" field.
+func (nsuo *NumberingSeriesUpdateOne) SetCurrentNumber(i int64) *NumberingSeriesUpdateOne {
+	nsuo.mutation.ResetCurrentNumber()
+	nsuo.mutation.SetCurrentNumber(i)
+	return nsuo
+}
+
+// AddCurrentNumber adds i to the "current_number" field.
+func (nsuo *NumberingSeriesUpdateOne) AddCurrentNumber(i int64) *NumberingSeriesUpdateOne {
+	nsuo.mutation.AddCurrentNumber(i)
+	return nsuo
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (nsuo *NumberingSeriesUpdateOne) SetValidFrom(t time.Time) *NumberingSeriesUpdateOne {
+	nsuo.mutation.SetValidFrom(t)
+	return nsuo
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (nsuo *NumberingSeriesUpdateOne) SetNillableValidFrom(t *time.Time) *NumberingSeriesUpdateOne {
+	if t != nil {
+		nsuo.SetValidFrom(*t)
+	}
+	return nsuo
+}
+
+// ClearValidFrom clears the value of the "valid_from" field.
+func (nsuo *NumberingSeriesUpdateOne) ClearValidFrom() *NumberingSeriesUpdateOne {
+	nsuo.mutation.ClearValidFrom()
+	return nsuo
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (nsuo *NumberingSeriesUpdateOne) SetValidUntil(t time.Time) *NumberingSeriesUpdateOne {
+	nsuo.mutation.SetValidUntil(t)
+	return nsuo
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (nsuo *NumberingSeriesUpdateOne) SetNillableValidUntil(t *time.Time) *NumberingSeriesUpdateOne {
+	if t != nil {
+		nsuo.SetValidUntil(*t)
+	}
+	return nsuo
+}
+
+// ClearValidUntil clears the value of the "valid_until" field.
+func (nsuo *NumberingSeriesUpdateOne) ClearValidUntil() *NumberingSeriesUpdateOne {
+	nsuo.mutation.ClearValidUntil()
+	return nsuo
+}
+
+// SetResolution sets the "resolution" field.
+func (nsuo *NumberingSeriesUpdateOne) SetResolution(s string) *NumberingSeriesUpdateOne {
+	nsuo.mutation.SetResolution(s)
+	return nsuo
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (nsuo *NumberingSeriesUpdateOne) SetNillableResolution(s *string) *NumberingSeriesUpdateOne {
+	if s != nil {
+		nsuo.SetResolution(*s)
+	}
+	return nsuo
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (nsuo *NumberingSeriesUpdateOne) ClearResolution() *NumberingSeriesUpdateOne {
+	nsuo.mutation.ClearResolution()
+	return nsuo
+}
+
+// SetAlertRemaining sets the "alert_remaining" field.
+func (nsuo *NumberingSeriesUpdateOne) SetAlertRemaining(i int64) *NumberingSeriesUpdateOne {
+	nsuo.mutation.ResetAlertRemaining()
+	nsuo.mutation.SetAlertRemaining(i)
+	return nsuo
+}
+
+// SetNillableAlertRemaining sets the "alert_remaining" field if the given value is not nil.
+func (nsuo *NumberingSeriesUpdateOne) SetNillableAlertRemaining(i *int64) *NumberingSeriesUpdateOne {
+	if i != nil {
+		nsuo.SetAlertRemaining(*i)
+	}
+	return nsuo
+}
+
+// AddAlertRemaining adds i to the "alert_remaining" field.
+func (nsuo *NumberingSeriesUpdateOne) AddAlertRemaining(i int64) *NumberingSeriesUpdateOne {
+	nsuo.mutation.AddAlertRemaining(i)
+	return nsuo
+}
+
+// SetActive sets the "active" field.
+func (nsuo *NumberingSeriesUpdateOne) SetActive(b bool) *NumberingSeriesUpdateOne {
+	nsuo.mutation.SetActive(b)
+	return nsuo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (nsuo *NumberingSeriesUpdateOne) SetNillableActive(b *bool) *NumberingSeriesUpdateOne {
+	if b != nil {
+		nsuo.SetActive(*b)
+	}
+	return nsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nsuo *NumberingSeriesUpdateOne) SetUpdatedAt(t time.Time) *NumberingSeriesUpdateOne {
+	nsuo.mutation.SetUpdatedAt(t)
+	return nsuo
+}
+
+// Mutation returns the NumberingSeriesMutation object of the builder.
+func (nsuo *NumberingSeriesUpdateOne) Mutation() *NumberingSeriesMutation {
+	return nsuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nsuo *NumberingSeriesUpdateOne) Select(field string, fields ...string) *NumberingSeriesUpdateOne {
+	nsuo.fields = append([]string{field}, fields...)
+	return nsuo
+}
+
+// Save executes the query and returns the updated NumberingSeries entity.
+func (nsuo *NumberingSeriesUpdateOne) Save(ctx context.Context) (*NumberingSeries, error) {
+	var (
+		err  error
+		node *NumberingSeries
+	)
+	nsuo.defaults()
+	if len(nsuo.hooks) == 0 {
+		if err = nsuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = nsuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NumberingSeriesMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = nsuo.check(); err != nil {
+				return nil, err
+			}
+			nsuo.mutation = mutation
+			node, err = nsuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(nsuo.hooks) - 1; i >= 0; i-- {
+			if nsuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = nsuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, nsuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*NumberingSeries)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from NumberingSeriesMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nsuo *NumberingSeriesUpdateOne) SaveX(ctx context.Context) *NumberingSeries {
+	node, err := nsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nsuo *NumberingSeriesUpdateOne) Exec(ctx context.Context) error {
+	_, err := nsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nsuo *NumberingSeriesUpdateOne) ExecX(ctx context.Context) {
+	if err := nsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nsuo *NumberingSeriesUpdateOne) defaults() {
+	if _, ok := nsuo.mutation.UpdatedAt(); !ok {
+		v := numberingseries.UpdateDefaultUpdatedAt()
+		nsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nsuo *NumberingSeriesUpdateOne) check() error {
+	if v, ok := nsuo.mutation.RangeFrom(); ok {
+		if err := numberingseries.RangeFromValidator(v); err != nil {
+			return &ValidationError{Name: "range_from", err: fmt.Errorf(`ent: validator failed for field "NumberingSeries.range_from": %w`, err)}
+		}
+	}
+	if v, ok := nsuo.mutation.RangeTo(); ok {
+		if err := numberingseries.RangeToValidator(v); err != nil {
+			return &ValidationError{Name: "range_to", err: fmt.Errorf(`ent: validator failed for field "NumberingSeries.range_to": %w`, err)}
+		}
+	}
+	if v, ok := nsuo.mutation.CurrentNumber(); ok {
+		if err := numberingseries.CurrentNumberValidator(v); err != nil {
+			return &ValidationError{Name: "current_number", err: fmt.Errorf(`ent: validator failed for field "NumberingSeries.current_number": %w`, err)}
+		}
+	}
+	if v, ok := nsuo.mutation.AlertRemaining(); ok {
+		if err := numberingseries.AlertRemainingValidator(v); err != nil {
+			return &ValidationError{Name: "alert_remaining", err: fmt.Errorf(`ent: validator failed for field "NumberingSeries.alert_remaining": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (nsuo *NumberingSeriesUpdateOne) sqlSave(ctx context.Context) (_node *NumberingSeries, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   numberingseries.Table,
+			Columns: numberingseries.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: numberingseries.FieldID,
+			},
+		},
+	}
+	id, ok := nsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NumberingSeries.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, numberingseries.FieldID)
+		for _, f := range fields {
+			if !numberingseries.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != numberingseries.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nsuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: numberingseries.FieldTenantID,
+		})
+	}
+	if value, ok := nsuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: numberingseries.FieldTenantID,
+		})
+	}
+	if value, ok := nsuo.mutation.DocumentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: numberingseries.FieldDocumentType,
+		})
+	}
+	if value, ok := nsuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: numberingseries.FieldName,
+		})
+	}
+	if nsuo.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: numberingseries.FieldName,
+		})
+	}
+	if value, ok := nsuo.mutation.Prefix(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: numberingseries.FieldPrefix,
+		})
+	}
+	if nsuo.mutation.PrefixCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: numberingseries.FieldPrefix,
+		})
+	}
+	if value, ok := nsuo.mutation.RangeFrom(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldRangeFrom,
+		})
+	}
+	if value, ok := nsuo.mutation.AddedRangeFrom(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldRangeFrom,
+		})
+	}
+	if value, ok := nsuo.mutation.RangeTo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldRangeTo,
+		})
+	}
+	if value, ok := nsuo.mutation.AddedRangeTo(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldRangeTo,
+		})
+	}
+	if value, ok := nsuo.mutation.CurrentNumber(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldCurrentNumber,
+		})
+	}
+	if value, ok := nsuo.mutation.AddedCurrentNumber(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldCurrentNumber,
+		})
+	}
+	if value, ok := nsuo.mutation.ValidFrom(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: numberingseries.FieldValidFrom,
+		})
+	}
+	if nsuo.mutation.ValidFromCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: numberingseries.FieldValidFrom,
+		})
+	}
+	if value, ok := nsuo.mutation.ValidUntil(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: numberingseries.FieldValidUntil,
+		})
+	}
+	if nsuo.mutation.ValidUntilCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: numberingseries.FieldValidUntil,
+		})
+	}
+	if value, ok := nsuo.mutation.Resolution(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: numberingseries.FieldResolution,
+		})
+	}
+	if nsuo.mutation.ResolutionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: numberingseries.FieldResolution,
+		})
+	}
+	if value, ok := nsuo.mutation.AlertRemaining(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldAlertRemaining,
+		})
+	}
+	if value, ok := nsuo.mutation.AddedAlertRemaining(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: numberingseries.FieldAlertRemaining,
+		})
+	}
+	if value, ok := nsuo.mutation.Active(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: numberingseries.FieldActive,
+		})
+	}
+	if value, ok := nsuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: numberingseries.FieldUpdatedAt,
+		})
+	}
+	_node = &NumberingSeries{config: nsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{numberingseries.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -6,27 +6,120 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AdjustmentReason is the predicate function for adjustmentreason builders.
+type AdjustmentReason func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// BundleAssembly is the predicate function for bundleassembly builders.
+type BundleAssembly func(*sql.Selector)
+
+// BundleAssemblyLine is the predicate function for bundleassemblyline builders.
+type BundleAssemblyLine func(*sql.Selector)
+
+// BundleComponent is the predicate function for bundlecomponent builders.
+type BundleComponent func(*sql.Selector)
+
+// Customer is the predicate function for customer builders.
+type Customer func(*sql.Selector)
+
+// ExchangeRate is the predicate function for exchangerate builders.
+type ExchangeRate func(*sql.Selector)
+
+// FiscalDocument is the predicate function for fiscaldocument builders.
+type FiscalDocument func(*sql.Selector)
+
+// InventoryCostLayer is the predicate function for inventorycostlayer builders.
+type InventoryCostLayer func(*sql.Selector)
+
+// InventoryCount is the predicate function for inventorycount builders.
+type InventoryCount func(*sql.Selector)
+
+// InventoryCountEntry is the predicate function for inventorycountentry builders.
+type InventoryCountEntry func(*sql.Selector)
+
+// InventoryCountLine is the predicate function for inventorycountline builders.
+type InventoryCountLine func(*sql.Selector)
+
 // Invoice is the predicate function for invoice builders.
 type Invoice func(*sql.Selector)
 
 // InvoiceItem is the predicate function for invoiceitem builders.
 type InvoiceItem func(*sql.Selector)
 
+// InvoiceItemComponent is the predicate function for invoiceitemcomponent builders.
+type InvoiceItemComponent func(*sql.Selector)
+
+// InvoiceLotAllocation is the predicate function for invoicelotallocation builders.
+type InvoiceLotAllocation func(*sql.Selector)
+
+// InvoiceStatusEvent is the predicate function for invoicestatusevent builders.
+type InvoiceStatusEvent func(*sql.Selector)
+
+// InvoiceTax is the predicate function for invoicetax builders.
+type InvoiceTax func(*sql.Selector)
+
+// NumberingSeries is the predicate function for numberingseries builders.
+type NumberingSeries func(*sql.Selector)
+
+// PriceList is the predicate function for pricelist builders.
+type PriceList func(*sql.Selector)
+
+// PriceListItem is the predicate function for pricelistitem builders.
+type PriceListItem func(*sql.Selector)
+
 // Product is the predicate function for product builders.
 type Product func(*sql.Selector)
 
+// ProductBarcode is the predicate function for productbarcode builders.
+type ProductBarcode func(*sql.Selector)
+
+// ProductFile is the predicate function for productfile builders.
+type ProductFile func(*sql.Selector)
+
+// ProductLot is the predicate function for productlot builders.
+type ProductLot func(*sql.Selector)
+
+// ProductPrice is the predicate function for productprice builders.
+type ProductPrice func(*sql.Selector)
+
+// ProductSerial is the predicate function for productserial builders.
+type ProductSerial func(*sql.Selector)
+
+// ProductSerialEvent is the predicate function for productserialevent builders.
+type ProductSerialEvent func(*sql.Selector)
+
+// ProductUnit is the predicate function for productunit builders.
+type ProductUnit func(*sql.Selector)
+
+// Promotion is the predicate function for promotion builders.
+type Promotion func(*sql.Selector)
+
+// PromotionTarget is the predicate function for promotiontarget builders.
+type PromotionTarget func(*sql.Selector)
+
 // PurchaseInvoice is the predicate function for purchaseinvoice builders.
 type PurchaseInvoice func(*sql.Selector)
 
 // PurchaseInvoiceItem is the predicate function for purchaseinvoiceitem builders.
 type PurchaseInvoiceItem func(*sql.Selector)
 
+// StockAdjustment is the predicate function for stockadjustment builders.
+type StockAdjustment func(*sql.Selector)
+
+// StockAdjustmentLine is the predicate function for stockadjustmentline builders.
+type StockAdjustmentLine func(*sql.Selector)
+
 // Supplier is the predicate function for supplier builders.
 type Supplier func(*sql.Selector)
 
 // SupplierPayment is the predicate function for supplierpayment builders.
 type SupplierPayment func(*sql.Selector)
 
+// TaxRate is the predicate function for taxrate builders.
+type TaxRate func(*sql.Selector)
+
 // Tenant is the predicate function for tenant builders.
 type Tenant func(*sql.Selector)
 
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/pricelist"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// PriceList is the model entity for the PriceList schema.
+type PriceList struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// Nombre de la lista (distribuidores, VIP, ...)
+	Name string `json:"name,omitempty"`
+	// Descripción de la lista
+	Description string `json:"description,omitempty"`
+	// Descuento sobre el precio detal o mayor para los productos sin precio propio en la lista; negativo para recargo
+	DiscountPercent float64 `json:"discount_percent,omitempty"`
+	// Las listas inactivas no se pueden usar en nuevas facturas
+	Active bool `json:"active,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PriceList) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pricelist.FieldActive:
+			values[i] = new(sql.NullBool)
+		case pricelist.FieldDiscountPercent:
+			values[i] = new(sql.NullFloat64)
+		case pricelist.FieldID, pricelist.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case pricelist.FieldName, pricelist.FieldDescription:
+			values[i] = new(sql.NullString)
+		case pricelist.FieldCreatedAt, pricelist.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type PriceList", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PriceList fields.
+func (pl *PriceList) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pricelist.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pl.ID = int(value.Int64)
+		case pricelist.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				pl.TenantID = int(value.Int64)
+			}
+		case pricelist.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pl.Name = value.String
+			}
+		case pricelist.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				pl.Description = value.String
+			}
+		case pricelist.FieldDiscountPercent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_percent", values[i])
+			} else if value.Valid {
+				pl.DiscountPercent = value.Float64
+			}
+		case pricelist.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				pl.Active = value.Bool
+			}
+		case pricelist.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pl.CreatedAt = value.Time
+			}
+		case pricelist.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pl.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this PriceList.
+// Note that you need to call PriceList.Unwrap() before calling this method if this PriceList
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pl *PriceList) Update() *PriceListUpdateOne {
+	return (&PriceListClient{config: pl.config}).UpdateOne(pl)
+}
+
+// Unwrap unwraps the PriceList entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pl *PriceList) Unwrap() *PriceList {
+	_tx, ok := pl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PriceList is not a transactional entity")
+	}
+	pl.config.driver = _tx.drv
+	return pl
+}
+
+// String implements the fmt.Stringer.
+func (pl *PriceList) String() string {
+	var builder strings.Builder
+	builder.WriteString("PriceList(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pl.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", pl.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pl.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(pl.Description)
+	builder.WriteString(", ")
+	builder.WriteString("discount_percent=")
+	builder.WriteString(fmt.Sprintf("%v", pl.DiscountPercent))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", pl.Active))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pl.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PriceLists is a parsable slice of PriceList.
+type PriceLists []*PriceList
+
+func (pl PriceLists) config(cfg config) {
+	for _i := range pl {
+		pl[_i].config = cfg
+	}
+}
@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/pricelist"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PriceListCreate is the builder for creating a PriceList entity.
+type PriceListCreate struct {
+	config
+	mutation *PriceListMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (plc *PriceListCreate) SetTenantID(i int) *PriceListCreate {
+	plc.mutation.SetTenantID(i)
+	return plc
+}
+
+// SetName sets the "name" field.
+func (plc *PriceListCreate) SetName(s string) *PriceListCreate {
+	plc.mutation.SetName(s)
+	return plc
+}
+
+// SetDescription sets the "description" field.
+func (plc *PriceListCreate) SetDescription(s string) *PriceListCreate {
+	plc.mutation.SetDescription(s)
+	return plc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (plc *PriceListCreate) SetNillableDescription(s *string) *PriceListCreate {
+	if s != nil {
+		plc.SetDescription(*s)
+	}
+	return plc
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (plc *PriceListCreate) SetDiscountPercent(f float64) *PriceListCreate {
+	plc.mutation.SetDiscountPercent(f)
+	return plc
+}
+
+// SetNillableDiscountPercent sets the "discount_percent" field if the given value is not nil.
+func (plc *PriceListCreate) SetNillableDiscountPercent(f *float64) *PriceListCreate {
+	if f != nil {
+		plc.SetDiscountPercent(*f)
+	}
+	return plc
+}
+
+// SetActive sets the "active" field.
+func (plc *PriceListCreate) SetActive(b bool) *PriceListCreate {
+	plc.mutation.SetActive(b)
+	return plc
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (plc *PriceListCreate) SetNillableActive(b *bool) *PriceListCreate {
+	if b != nil {
+		plc.SetActive(*b)
+	}
+	return plc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (plc *PriceListCreate) SetCreatedAt(t time.Time) *PriceListCreate {
+	plc.mutation.SetCreatedAt(t)
+	return plc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (plc *PriceListCreate) SetNillableCreatedAt(t *time.Time) *PriceListCreate {
+	if t != nil {
+		plc.SetCreatedAt(*t)
+	}
+	return plc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (plc *PriceListCreate) SetUpdatedAt(t time.Time) *PriceListCreate {
+	plc.mutation.SetUpdatedAt(t)
+	return plc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (plc *PriceListCreate) SetNillableUpdatedAt(t *time.Time) *PriceListCreate {
+	if t != nil {
+		plc.SetUpdatedAt(*t)
+	}
+	return plc
+}
+
+// Mutation returns the PriceListMutation object of the builder.
+func (plc *PriceListCreate) Mutation() *PriceListMutation {
+	return plc.mutation
+}
+
+// Save creates the PriceList in the database.
+func (plc *PriceListCreate) Save(ctx context.Context) (*PriceList, error) {
+	var (
+		err  error
+		node *PriceList
+	)
+	plc.defaults()
+	if len(plc.hooks) == 0 {
+		if err = plc.check(); err != nil {
+			return nil, err
+		}
+		node, err = plc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PriceListMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = plc.check(); err != nil {
+				return nil, err
+			}
+			plc.mutation = mutation
+			if node, err = plc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(plc.hooks) - 1; i >= 0; i-- {
+			if plc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = plc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, plc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*PriceList)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from PriceListMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (plc *PriceListCreate) SaveX(ctx context.Context) *PriceList {
+	v, err := plc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (plc *PriceListCreate) Exec(ctx context.Context) error {
+	_, err := plc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (plc *PriceListCreate) ExecX(ctx context.Context) {
+	if err := plc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (plc *PriceListCreate) defaults() {
+	if _, ok := plc.mutation.DiscountPercent(); !ok {
+		v := pricelist.DefaultDiscountPercent
+		plc.mutation.SetDiscountPercent(v)
+	}
+	if _, ok := plc.mutation.Active(); !ok {
+		v := pricelist.DefaultActive
+		plc.mutation.SetActive(v)
+	}
+	if _, ok := plc.mutation.CreatedAt(); !ok {
+		v := pricelist.DefaultCreatedAt()
+		plc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := plc.mutation.UpdatedAt(); !ok {
+		v := pricelist.DefaultUpdatedAt()
+		plc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (plc *PriceListCreate) check() error {
+	if _, ok := plc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "PriceList.tenant_id"`)}
+	}
+	if _, ok := plc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PriceList.name"`)}
+	}
+	if v, ok := plc.mutation.Name(); ok {
+		if err := pricelist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PriceList.name": %w`, err)}
+		}
+	}
+	if _, ok := plc.mutation.DiscountPercent(); !ok {
+		return &ValidationError{Name: "discount_percent", err: errors.New(`ent: missing required field "PriceList.discount_percent"`)}
+	}
+	if v, ok := plc.mutation.DiscountPercent(); ok {
+		if err := pricelist.DiscountPercentValidator(v); err != nil {
+			return &ValidationError{Name: "discount_percent", err: fmt.Errorf(`ent: validator failed for field "PriceList.discount_percent": %w`, err)}
+		}
+	}
+	if _, ok := plc.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "PriceList.active"`)}
+	}
+	if _, ok := plc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PriceList.created_at"`)}
+	}
+	if _, ok := plc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PriceList.updated_at"`)}
+	}
+	return nil
+}
+
+func (plc *PriceListCreate) sqlSave(ctx context.Context) (*PriceList, error) {
+	_node, _spec := plc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, plc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (plc *PriceListCreate) createSpec() (*PriceList, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PriceList{config: plc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: pricelist.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: pricelist.FieldID,
+			},
+		}
+	)
+	if value, ok := plc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelist.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := plc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pricelist.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := plc.mutation.Description(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pricelist.FieldDescription,
+		})
+		_node.Description = value
+	}
+	if value, ok := plc.mutation.DiscountPercent(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: pricelist.FieldDiscountPercent,
+		})
+		_node.DiscountPercent = value
+	}
+	if value, ok := plc.mutation.Active(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: pricelist.FieldActive,
+		})
+		_node.Active = value
+	}
+	if value, ok := plc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pricelist.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := plc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pricelist.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// PriceListCreateBulk is the builder for creating many PriceList entities in bulk.
+type PriceListCreateBulk struct {
+	config
+	builders []*PriceListCreate
+}
+
+// Save creates the PriceList entities in the database.
+func (plcb *PriceListCreateBulk) Save(ctx context.Context) ([]*PriceList, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(plcb.builders))
+	nodes := make([]*PriceList, len(plcb.builders))
+	mutators := make([]Mutator, len(plcb.builders))
+	for i := range plcb.builders {
+		func(i int, root context.Context) {
+			builder := plcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PriceListMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, plcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, plcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, plcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (plcb *PriceListCreateBulk) SaveX(ctx context.Context) []*PriceList {
+	v, err := plcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (plcb *PriceListCreateBulk) Exec(ctx context.Context) error {
+	_, err := plcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (plcb *PriceListCreateBulk) ExecX(ctx context.Context) {
+	if err := plcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/pricelist"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PriceListQuery is the builder for querying PriceList entities.
+type PriceListQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.PriceList
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PriceListQuery builder.
+func (plq *PriceListQuery) Where(ps ...predicate.PriceList) *PriceListQuery {
+	plq.predicates = append(plq.predicates, ps...)
+	return plq
+}
+
+// Limit adds a limit step to the query.
+func (plq *PriceListQuery) Limit(limit int) *PriceListQuery {
+	plq.limit = &limit
+	return plq
+}
+
+// Offset adds an offset step to the query.
+func (plq *PriceListQuery) Offset(offset int) *PriceListQuery {
+	plq.offset = &offset
+	return plq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (plq *PriceListQuery) Unique(unique bool) *PriceListQuery {
+	plq.unique = &unique
+	return plq
+}
+
+// Order adds an order step to the query.
+func (plq *PriceListQuery) Order(o ...OrderFunc) *PriceListQuery {
+	plq.order = append(plq.order, o...)
+	return plq
+}
+
+// First returns the first PriceList entity from the query.
+// Returns a *NotFoundError when no PriceList was found.
+func (plq *PriceListQuery) First(ctx context.Context) (*PriceList, error) {
+	nodes, err := plq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{pricelist.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (plq *PriceListQuery) FirstX(ctx context.Context) *PriceList {
+	node, err := plq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PriceList ID from the query.
+// Returns a *NotFoundError when no PriceList ID was found.
+func (plq *PriceListQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = plq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{pricelist.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (plq *PriceListQuery) FirstIDX(ctx context.Context) int {
+	id, err := plq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PriceList entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PriceList entity is found.
+// Returns a *NotFoundError when no PriceList entities are found.
+func (plq *PriceListQuery) Only(ctx context.Context) (*PriceList, error) {
+	nodes, err := plq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{pricelist.Label}
+	default:
+		return nil, &NotSingularError{pricelist.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (plq *PriceListQuery) OnlyX(ctx context.Context) *PriceList {
+	node, err := plq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PriceList ID in the query.
+// Returns a *NotSingularError when more than one PriceList ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (plq *PriceListQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = plq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{pricelist.Label}
+	default:
+		err = &NotSingularError{pricelist.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (plq *PriceListQuery) OnlyIDX(ctx context.Context) int {
+	id, err := plq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PriceLists.
+func (plq *PriceListQuery) All(ctx context.Context) ([]*PriceList, error) {
+	if err := plq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return plq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (plq *PriceListQuery) AllX(ctx context.Context) []*PriceList {
+	nodes, err := plq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PriceList IDs.
+func (plq *PriceListQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := plq.Select(pricelist.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (plq *PriceListQuery) IDsX(ctx context.Context) []int {
+	ids, err := plq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (plq *PriceListQuery) Count(ctx context.Context) (int, error) {
+	if err := plq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return plq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (plq *PriceListQuery) CountX(ctx context.Context) int {
+	count, err := plq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (plq *PriceListQuery) Exist(ctx context.Context) (bool, error) {
+	if err := plq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return plq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (plq *PriceListQuery) ExistX(ctx context.Context) bool {
+	exist, err := plq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PriceListQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (plq *PriceListQuery) Clone() *PriceListQuery {
+	if plq == nil {
+		return nil
+	}
+	return &PriceListQuery{
+		config:     plq.config,
+		limit:      plq.limit,
+		offset:     plq.offset,
+		order:      append([]OrderFunc{}, plq.order...),
+		predicates: append([]predicate.PriceList{}, plq.predicates...),
+		// clone intermediate query.
+		sql:    plq.sql.Clone(),
+		path:   plq.path,
+		unique: plq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PriceList.Query().
+//		GroupBy(pricelist.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (plq *PriceListQuery) GroupBy(field string, fields ...string) *PriceListGroupBy {
+	grbuild := &PriceListGroupBy{config: plq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := plq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return plq.sqlQuery(ctx), nil
+	}
+	grbuild.label = pricelist.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.PriceList.Query().
+//		Select(pricelist.FieldTenantID).
+//		Scan(ctx, &v)
+func (plq *PriceListQuery) Select(fields ...string) *PriceListSelect {
+	plq.fields = append(plq.fields, fields...)
+	selbuild := &PriceListSelect{PriceListQuery: plq}
+	selbuild.label = pricelist.Label
+	selbuild.flds, selbuild.scan = &plq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (plq *PriceListQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range plq.fields {
+		if !pricelist.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if plq.path != nil {
+		prev, err := plq.path(ctx)
+		if err != nil {
+			return err
+		}
+		plq.sql = prev
+	}
+	return nil
+}
+
+func (plq *PriceListQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PriceList, error) {
+	var (
+		nodes = []*PriceList{}
+		_spec = plq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*PriceList).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &PriceList{config: plq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(plq.modifiers) > 0 {
+		_spec.Modifiers = plq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, plq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (plq *PriceListQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := plq.querySpec()
+	if len(plq.modifiers) > 0 {
+		_spec.Modifiers = plq.modifiers
+	}
+	_spec.Node.Columns = plq.fields
+	if len(plq.fields) > 0 {
+		_spec.Unique = plq.unique != nil && *plq.unique
+	}
+	return sqlgraph.CountNodes(ctx, plq.driver, _spec)
+}
+
+func (plq *PriceListQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := plq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (plq *PriceListQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   pricelist.Table,
+			Columns: pricelist.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: pricelist.FieldID,
+			},
+		},
+		From:   plq.sql,
+		Unique: true,
+	}
+	if unique := plq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := plq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricelist.FieldID)
+		for i := range fields {
+			if fields[i] != pricelist.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := plq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := plq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := plq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := plq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (plq *PriceListQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(plq.driver.Dialect())
+	t1 := builder.Table(pricelist.Table)
+	columns := plq.fields
+	if len(columns) == 0 {
+		columns = pricelist.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if plq.sql != nil {
+		selector = plq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if plq.unique != nil && *plq.unique {
+		selector.Distinct()
+	}
+	for _, m := range plq.modifiers {
+		m(selector)
+	}
+	for _, p := range plq.predicates {
+		p(selector)
+	}
+	for _, p := range plq.order {
+		p(selector)
+	}
+	if offset := plq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := plq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (plq *PriceListQuery) ForUpdate(opts ...sql.LockOption) *PriceListQuery {
+	if plq.driver.Dialect() == dialect.Postgres {
+		plq.Unique(false)
+	}
+	plq.modifiers = append(plq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return plq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (plq *PriceListQuery) ForShare(opts ...sql.LockOption) *PriceListQuery {
+	if plq.driver.Dialect() == dialect.Postgres {
+		plq.Unique(false)
+	}
+	plq.modifiers = append(plq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return plq
+}
+
+// PriceListGroupBy is the group-by builder for PriceList entities.
+type PriceListGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (plgb *PriceListGroupBy) Aggregate(fns ...AggregateFunc) *PriceListGroupBy {
+	plgb.fns = append(plgb.fns, fns...)
+	return plgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (plgb *PriceListGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := plgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	plgb.sql = query
+	return plgb.sqlScan(ctx, v)
+}
+
+func (plgb *PriceListGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range plgb.fields {
+		if !pricelist.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := plgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := plgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (plgb *PriceListGroupBy) sqlQuery() *sql.Selector {
+	selector := plgb.sql.Select()
+	aggregation := make([]string, 0, len(plgb.fns))
+	for _, fn := range plgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(plgb.fields)+len(plgb.fns))
+		for _, f := range plgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(plgb.fields...)...)
+}
+
+// PriceListSelect is the builder for selecting fields of PriceList entities.
+type PriceListSelect struct {
+	*PriceListQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pls *PriceListSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := pls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	pls.sql = pls.PriceListQuery.sqlQuery(ctx)
+	return pls.sqlScan(ctx, v)
+}
+
+func (pls *PriceListSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := pls.sql.Query()
+	if err := pls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,590 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/pricelist"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PriceListUpdate is the builder for updating PriceList entities.
+type PriceListUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PriceListMutation
+}
+
+// Where appends a list predicates to the PriceListUpdate builder.
+func (plu *PriceListUpdate) Where(ps ...predicate.PriceList) *PriceListUpdate {
+	plu.mutation.Where(ps...)
+	return plu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (plu *PriceListUpdate) SetTenantID(i int) *PriceListUpdate {
+	plu.mutation.ResetTenantID()
+	plu.mutation.SetTenantID(i)
+	return plu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (plu *PriceListUpdate) AddTenantID(i int) *PriceListUpdate {
+	plu.mutation.AddTenantID(i)
+	return plu
+}
+
+// SetName sets the "name" field.
+func (plu *PriceListUpdate) SetName(s string) *PriceListUpdate {
+	plu.mutation.SetName(s)
+	return plu
+}
+
+// SetDescription sets the "description" field.
+func (plu *PriceListUpdate) SetDescription(s string) *PriceListUpdate {
+	plu.mutation.SetDescription(s)
+	return plu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (plu *PriceListUpdate) SetNillableDescription(s *string) *PriceListUpdate {
+	if s != nil {
+		plu.SetDescription(*s)
+	}
+	return plu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (plu *PriceListUpdate) ClearDescription() *PriceListUpdate {
+	plu.mutation.ClearDescription()
+	return plu
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (plu *PriceListUpdate) SetDiscountPercent(f float64) *PriceListUpdate {
+	plu.mutation.ResetDiscountPercent()
+	plu.mutation.SetDiscountPercent(f)
+	return plu
+}
+
+// SetNillableDiscountPercent sets the "discount_percent" field if the given value is not nil.
+func (plu *PriceListUpdate) SetNillableDiscountPercent(f *float64) *PriceListUpdate {
+	if f != nil {
+		plu.SetDiscountPercent(*f)
+	}
+	return plu
+}
+
+// AddDiscountPercent adds f to the "discount_percent" field.
+func (plu *PriceListUpdate) AddDiscountPercent(f float64) *PriceListUpdate {
+	plu.mutation.AddDiscountPercent(f)
+	return plu
+}
+
+// SetActive sets the "active" field.
+func (plu *PriceListUpdate) SetActive(b bool) *PriceListUpdate {
+	plu.mutation.SetActive(b)
+	return plu
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (plu *PriceListUpdate) SetNillableActive(b *bool) *PriceListUpdate {
+	if b != nil {
+		plu.SetActive(*b)
+	}
+	return plu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (plu *PriceListUpdate) SetUpdatedAt(t time.Time) *PriceListUpdate {
+	plu.mutation.SetUpdatedAt(t)
+	return plu
+}
+
+// Mutation returns the PriceListMutation object of the builder.
+func (plu *PriceListUpdate) Mutation() *PriceListMutation {
+	return plu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (plu *PriceListUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	plu.defaults()
+	if len(plu.hooks) == 0 {
+		if err = plu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = plu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PriceListMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = plu.check(); err != nil {
+				return 0, err
+			}
+			plu.mutation = mutation
+			affected, err = plu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(plu.hooks) - 1; i >= 0; i-- {
+			if plu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = plu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, plu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (plu *PriceListUpdate) SaveX(ctx context.Context) int {
+	affected, err := plu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (plu *PriceListUpdate) Exec(ctx context.Context) error {
+	_, err := plu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (plu *PriceListUpdate) ExecX(ctx context.Context) {
+	if err := plu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (plu *PriceListUpdate) defaults() {
+	if _, ok := plu.mutation.UpdatedAt(); !ok {
+		v := pricelist.UpdateDefaultUpdatedAt()
+		plu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (plu *PriceListUpdate) check() error {
+	if v, ok := plu.mutation.Name(); ok {
+		if err := pricelist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PriceList.name": %w`, err)}
+		}
+	}
+	if v, ok := plu.mutation.DiscountPercent(); ok {
+		if err := pricelist.DiscountPercentValidator(v); err != nil {
+			return &ValidationError{Name: "discount_percent", err: fmt.Errorf(`ent: validator failed for field "PriceList.discount_percent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (plu *PriceListUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   pricelist.Table,
+			Columns: pricelist.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: pricelist.FieldID,
+			},
+		},
+	}
+	if ps := plu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := plu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelist.FieldTenantID,
+		})
+	}
+	if value, ok := plu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelist.FieldTenantID,
+		})
+	}
+	if value, ok := plu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pricelist.FieldName,
+		})
+	}
+	if value, ok := plu.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pricelist.FieldDescription,
+		})
+	}
+	if plu.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: pricelist.FieldDescription,
+		})
+	}
+	if value, ok := plu.mutation.DiscountPercent(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: pricelist.FieldDiscountPercent,
+		})
+	}
+	if value, ok := plu.mutation.AddedDiscountPercent(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: pricelist.FieldDiscountPercent,
+		})
+	}
+	if value, ok := plu.mutation.Active(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: pricelist.FieldActive,
+		})
+	}
+	if value, ok := plu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pricelist.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, plu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricelist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PriceListUpdateOne is the builder for updating a single PriceList entity.
+type PriceListUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PriceListMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (pluo *PriceListUpdateOne) SetTenantID(i int) *PriceListUpdateOne {
+	pluo.mutation.ResetTenantID()
+	pluo.mutation.SetTenantID(i)
+	return pluo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (pluo *PriceListUpdateOne) AddTenantID(i int) *PriceListUpdateOne {
+	pluo.mutation.AddTenantID(i)
+	return pluo
+}
+
+// SetName sets the "name" field.
+func (pluo *PriceListUpdateOne) SetName(s string) *PriceListUpdateOne {
+	pluo.mutation.SetName(s)
+	return pluo
+}
+
+// SetDescription sets the "description" field.
+func (pluo *PriceListUpdateOne) SetDescription(s string) *PriceListUpdateOne {
+	pluo.mutation.SetDescription(s)
+	return pluo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pluo *PriceListUpdateOne) SetNillableDescription(s *string) *PriceListUpdateOne {
+	if s != nil {
+		pluo.SetDescription(*s)
+	}
+	return pluo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (pluo *PriceListUpdateOne) ClearDescription() *PriceListUpdateOne {
+	pluo.mutation.ClearDescription()
+	return pluo
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (pluo *PriceListUpdateOne) SetDiscountPercent(f float64) *PriceListUpdateOne {
+	pluo.mutation.ResetDiscountPercent()
+	pluo.mutation.SetDiscountPercent(f)
+	return pluo
+}
+
+// SetNillableDiscountPercent sets the "discount_percent" field if the given value is not nil.
+func (pluo *PriceListUpdateOne) SetNillableDiscountPercent(f *float64) *PriceListUpdateOne {
+	if f != nil {
+		pluo.SetDiscountPercent(*f)
+	}
+	return pluo
+}
+
+// AddDiscountPercent adds f to the "discount_percent" field.
+func (pluo *PriceListUpdateOne) AddDiscountPercent(f float64) *PriceListUpdateOne {
+	pluo.mutation.AddDiscountPercent(f)
+	return pluo
+}
+
+// SetActive sets the "active" field.
+func (pluo *PriceListUpdateOne) SetActive(b bool) *PriceListUpdateOne {
+	pluo.mutation.SetActive(b)
+	return pluo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (pluo *PriceListUpdateOne) SetNillableActive(b *bool) *PriceListUpdateOne {
+	if b != nil {
+		pluo.SetActive(*b)
+	}
+	return pluo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pluo *PriceListUpdateOne) SetUpdatedAt(t time.Time) *PriceListUpdateOne {
+	pluo.mutation.SetUpdatedAt(t)
+	return pluo
+}
+
+// Mutation returns the PriceListMutation object of the builder.
+func (pluo *PriceListUpdateOne) Mutation() *PriceListMutation {
+	return pluo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pluo *PriceListUpdateOne) Select(field string, fields ...string) *PriceListUpdateOne {
+	pluo.fields = append([]string{field}, fields...)
+	return pluo
+}
+
+// Save executes the query and returns the updated PriceList entity.
+func (pluo *PriceListUpdateOne) Save(ctx context.Context) (*PriceList, error) {
+	var (
+		err  error
+		node *PriceList
+	)
+	pluo.defaults()
+	if len(pluo.hooks) == 0 {
+		if err = pluo.check(); err != nil {
+			return nil, err
+		}
+		node, err = pluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PriceListMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pluo.check(); err != nil {
+				return nil, err
+			}
+			pluo.mutation = mutation
+			node, err = pluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pluo.hooks) - 1; i >= 0; i-- {
+			if pluo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pluo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, pluo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*PriceList)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from PriceListMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pluo *PriceListUpdateOne) SaveX(ctx context.Context) *PriceList {
+	node, err := pluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pluo *PriceListUpdateOne) Exec(ctx context.Context) error {
+	_, err := pluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pluo *PriceListUpdateOne) ExecX(ctx context.Context) {
+	if err := pluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pluo *PriceListUpdateOne) defaults() {
+	if _, ok := pluo.mutation.UpdatedAt(); !ok {
+		v := pricelist.UpdateDefaultUpdatedAt()
+		pluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pluo *PriceListUpdateOne) check() error {
+	if v, ok := pluo.mutation.Name(); ok {
+		if err := pricelist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PriceList.name": %w`, err)}
+		}
+	}
+	if v, ok := pluo.mutation.DiscountPercent(); ok {
+		if err := pricelist.DiscountPercentValidator(v); err != nil {
+			return &ValidationError{Name: "discount_percent", err: fmt.Errorf(`ent: validator failed for field "PriceList.discount_percent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pluo *PriceListUpdateOne) sqlSave(ctx context.Context) (_node *PriceList, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   pricelist.Table,
+			Columns: pricelist.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: pricelist.FieldID,
+			},
+		},
+	}
+	id, ok := pluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PriceList.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricelist.FieldID)
+		for _, f := range fields {
+			if !pricelist.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pricelist.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pluo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelist.FieldTenantID,
+		})
+	}
+	if value, ok := pluo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelist.FieldTenantID,
+		})
+	}
+	if value, ok := pluo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pricelist.FieldName,
+		})
+	}
+	if value, ok := pluo.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pricelist.FieldDescription,
+		})
+	}
+	if pluo.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: pricelist.FieldDescription,
+		})
+	}
+	if value, ok := pluo.mutation.DiscountPercent(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: pricelist.FieldDiscountPercent,
+		})
+	}
+	if value, ok := pluo.mutation.AddedDiscountPercent(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: pricelist.FieldDiscountPercent,
+		})
+	}
+	if value, ok := pluo.mutation.Active(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: pricelist.FieldActive,
+		})
+	}
+	if value, ok := pluo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pricelist.FieldUpdatedAt,
+		})
+	}
+	_node = &PriceList{config: pluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricelist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
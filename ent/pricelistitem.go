@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/pricelistitem"
+	"Veritasbackend/pkg/money"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// PriceListItem is the model entity for the PriceListItem schema.
+type PriceListItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID de la lista de precios
+	PriceListID int `json:"price_list_id,omitempty"`
+	// ID del producto
+	ProductID int `json:"product_id,omitempty"`
+	// Cantidad mínima desde la que rige este precio; varios tramos por producto forman una escala
+	MinQuantity int `json:"min_quantity,omitempty"`
+	// Precio fijo del producto en la lista
+	Price *money.Amount `json:"price,omitempty"`
+	// Descuento sobre el precio detal cuando no hay precio fijo; negativo para recargo
+	DiscountPercent *float64 `json:"discount_percent,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PriceListItem) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pricelistitem.FieldPrice:
+			values[i] = &sql.NullScanner{S: new(money.Amount)}
+		case pricelistitem.FieldDiscountPercent:
+			values[i] = new(sql.NullFloat64)
+		case pricelistitem.FieldID, pricelistitem.FieldPriceListID, pricelistitem.FieldProductID, pricelistitem.FieldMinQuantity:
+			values[i] = new(sql.NullInt64)
+		case pricelistitem.FieldCreatedAt, pricelistitem.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type PriceListItem", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PriceListItem fields.
+func (pli *PriceListItem) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pricelistitem.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pli.ID = int(value.Int64)
+		case pricelistitem.FieldPriceListID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price_list_id", values[i])
+			} else if value.Valid {
+				pli.PriceListID = int(value.Int64)
+			}
+		case pricelistitem.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				pli.ProductID = int(value.Int64)
+			}
+		case pricelistitem.FieldMinQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_quantity", values[i])
+			} else if value.Valid {
+				pli.MinQuantity = int(value.Int64)
+			}
+		case pricelistitem.FieldPrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				pli.Price = new(money.Amount)
+				*pli.Price = *value.S.(*money.Amount)
+			}
+		case pricelistitem.FieldDiscountPercent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_percent", values[i])
+			} else if value.Valid {
+				pli.DiscountPercent = new(float64)
+				*pli.DiscountPercent = value.Float64
+			}
+		case pricelistitem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pli.CreatedAt = value.Time
+			}
+		case pricelistitem.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pli.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this PriceListItem.
+// Note that you need to call PriceListItem.Unwrap() before calling this method if this PriceListItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pli *PriceListItem) Update() *PriceListItemUpdateOne {
+	return (&PriceListItemClient{config: pli.config}).UpdateOne(pli)
+}
+
+// Unwrap unwraps the PriceListItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pli *PriceListItem) Unwrap() *PriceListItem {
+	_tx, ok := pli.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PriceListItem is not a transactional entity")
+	}
+	pli.config.driver = _tx.drv
+	return pli
+}
+
+// String implements the fmt.Stringer.
+func (pli *PriceListItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("PriceListItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pli.ID))
+	builder.WriteString("price_list_id=")
+	builder.WriteString(fmt.Sprintf("%v", pli.PriceListID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", pli.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("min_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", pli.MinQuantity))
+	builder.WriteString(", ")
+	if v := pli.Price; v != nil {
+		builder.WriteString("price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pli.DiscountPercent; v != nil {
+		builder.WriteString("discount_percent=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pli.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pli.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PriceListItems is a parsable slice of PriceListItem.
+type PriceListItems []*PriceListItem
+
+func (pli PriceListItems) config(cfg config) {
+	for _i := range pli {
+		pli[_i].config = cfg
+	}
+}
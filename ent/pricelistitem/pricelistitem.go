@@ -0,0 +1,69 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pricelistitem
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the pricelistitem type in the database.
+	Label = "price_list_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPriceListID holds the string denoting the price_list_id field in the database.
+	FieldPriceListID = "price_list_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldMinQuantity holds the string denoting the min_quantity field in the database.
+	FieldMinQuantity = "min_quantity"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldDiscountPercent holds the string denoting the discount_percent field in the database.
+	FieldDiscountPercent = "discount_percent"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the pricelistitem in the database.
+	Table = "price_list_items"
+)
+
+// Columns holds all SQL columns for pricelistitem fields.
+var Columns = []string{
+	FieldID,
+	FieldPriceListID,
+	FieldProductID,
+	FieldMinQuantity,
+	FieldPrice,
+	FieldDiscountPercent,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMinQuantity holds the default value on creation for the "min_quantity" field.
+	DefaultMinQuantity int
+	// MinQuantityValidator is a validator for the "min_quantity" field. It is called by the builders before save.
+	MinQuantityValidator func(int) error
+	// PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	PriceValidator func(int64) error
+	// DiscountPercentValidator is a validator for the "discount_percent" field. It is called by the builders before save.
+	DiscountPercentValidator func(float64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
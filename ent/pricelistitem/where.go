@@ -0,0 +1,723 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pricelistitem
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// PriceListID applies equality check predicate on the "price_list_id" field. It's identical to PriceListIDEQ.
+func PriceListID(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriceListID), v))
+	})
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// MinQuantity applies equality check predicate on the "min_quantity" field. It's identical to MinQuantityEQ.
+func MinQuantity(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMinQuantity), v))
+	})
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v money.Amount) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrice), v))
+	})
+}
+
+// DiscountPercent applies equality check predicate on the "discount_percent" field. It's identical to DiscountPercentEQ.
+func DiscountPercent(v float64) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscountPercent), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// PriceListIDEQ applies the EQ predicate on the "price_list_id" field.
+func PriceListIDEQ(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDNEQ applies the NEQ predicate on the "price_list_id" field.
+func PriceListIDNEQ(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDIn applies the In predicate on the "price_list_id" field.
+func PriceListIDIn(vs ...int) predicate.PriceListItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPriceListID), v...))
+	})
+}
+
+// PriceListIDNotIn applies the NotIn predicate on the "price_list_id" field.
+func PriceListIDNotIn(vs ...int) predicate.PriceListItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPriceListID), v...))
+	})
+}
+
+// PriceListIDGT applies the GT predicate on the "price_list_id" field.
+func PriceListIDGT(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDGTE applies the GTE predicate on the "price_list_id" field.
+func PriceListIDGTE(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDLT applies the LT predicate on the "price_list_id" field.
+func PriceListIDLT(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPriceListID), v))
+	})
+}
+
+// PriceListIDLTE applies the LTE predicate on the "price_list_id" field.
+func PriceListIDLTE(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPriceListID), v))
+	})
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.PriceListItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.PriceListItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProductID), v))
+	})
+}
+
+// MinQuantityEQ applies the EQ predicate on the "min_quantity" field.
+func MinQuantityEQ(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMinQuantity), v))
+	})
+}
+
+// MinQuantityNEQ applies the NEQ predicate on the "min_quantity" field.
+func MinQuantityNEQ(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMinQuantity), v))
+	})
+}
+
+// MinQuantityIn applies the In predicate on the "min_quantity" field.
+func MinQuantityIn(vs ...int) predicate.PriceListItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMinQuantity), v...))
+	})
+}
+
+// MinQuantityNotIn applies the NotIn predicate on the "min_quantity" field.
+func MinQuantityNotIn(vs ...int) predicate.PriceListItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMinQuantity), v...))
+	})
+}
+
+// MinQuantityGT applies the GT predicate on the "min_quantity" field.
+func MinQuantityGT(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMinQuantity), v))
+	})
+}
+
+// MinQuantityGTE applies the GTE predicate on the "min_quantity" field.
+func MinQuantityGTE(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMinQuantity), v))
+	})
+}
+
+// MinQuantityLT applies the LT predicate on the "min_quantity" field.
+func MinQuantityLT(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMinQuantity), v))
+	})
+}
+
+// MinQuantityLTE applies the LTE predicate on the "min_quantity" field.
+func MinQuantityLTE(v int) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMinQuantity), v))
+	})
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v money.Amount) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrice), v))
+	})
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v money.Amount) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPrice), v))
+	})
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...money.Amount) predicate.PriceListItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPrice), v...))
+	})
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...money.Amount) predicate.PriceListItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPrice), v...))
+	})
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v money.Amount) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPrice), v))
+	})
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v money.Amount) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPrice), v))
+	})
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v money.Amount) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPrice), v))
+	})
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v money.Amount) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPrice), v))
+	})
+}
+
+// PriceIsNil applies the IsNil predicate on the "price" field.
+func PriceIsNil() predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPrice)))
+	})
+}
+
+// PriceNotNil applies the NotNil predicate on the "price" field.
+func PriceNotNil() predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPrice)))
+	})
+}
+
+// DiscountPercentEQ applies the EQ predicate on the "discount_percent" field.
+func DiscountPercentEQ(v float64) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscountPercent), v))
+	})
+}
+
+// DiscountPercentNEQ applies the NEQ predicate on the "discount_percent" field.
+func DiscountPercentNEQ(v float64) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDiscountPercent), v))
+	})
+}
+
+// DiscountPercentIn applies the In predicate on the "discount_percent" field.
+func DiscountPercentIn(vs ...float64) predicate.PriceListItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDiscountPercent), v...))
+	})
+}
+
+// DiscountPercentNotIn applies the NotIn predicate on the "discount_percent" field.
+func DiscountPercentNotIn(vs ...float64) predicate.PriceListItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDiscountPercent), v...))
+	})
+}
+
+// DiscountPercentGT applies the GT predicate on the "discount_percent" field.
+func DiscountPercentGT(v float64) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDiscountPercent), v))
+	})
+}
+
+// DiscountPercentGTE applies the GTE predicate on the "discount_percent" field.
+func DiscountPercentGTE(v float64) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDiscountPercent), v))
+	})
+}
+
+// DiscountPercentLT applies the LT predicate on the "discount_percent" field.
+func DiscountPercentLT(v float64) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDiscountPercent), v))
+	})
+}
+
+// DiscountPercentLTE applies the LTE predicate on the "discount_percent" field.
+func DiscountPercentLTE(v float64) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDiscountPercent), v))
+	})
+}
+
+// DiscountPercentIsNil applies the IsNil predicate on the "discount_percent" field.
+func DiscountPercentIsNil() predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDiscountPercent)))
+	})
+}
+
+// DiscountPercentNotNil applies the NotNil predicate on the "discount_percent" field.
+func DiscountPercentNotNil() predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDiscountPercent)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PriceListItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PriceListItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PriceListItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PriceListItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PriceListItem) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PriceListItem) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PriceListItem) predicate.PriceListItem {
+	return predicate.PriceListItem(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/pricelistitem"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PriceListItemCreate is the builder for creating a PriceListItem entity.
+type PriceListItemCreate struct {
+	config
+	mutation *PriceListItemMutation
+	hooks    []Hook
+}
+
+// SetPriceListID sets the "price_list_id" field.
+func (plic *PriceListItemCreate) SetPriceListID(i int) *PriceListItemCreate {
+	plic.mutation.SetPriceListID(i)
+	return plic
+}
+
+// SetProductID sets the "product_id" field.
+func (plic *PriceListItemCreate) SetProductID(i int) *PriceListItemCreate {
+	plic.mutation.SetProductID(i)
+	return plic
+}
+
+// SetMinQuantity sets the "min_quantity" field.
+func (plic *PriceListItemCreate) SetMinQuantity(i int) *PriceListItemCreate {
+	plic.mutation.SetMinQuantity(i)
+	return plic
+}
+
+// SetNillableMinQuantity sets the "min_quantity" field if the given value is not nil.
+func (plic *PriceListItemCreate) SetNillableMinQuantity(i *int) *PriceListItemCreate {
+	if i != nil {
+		plic.SetMinQuantity(*i)
+	}
+	return plic
+}
+
+// SetPrice sets the "price" field.
+func (plic *PriceListItemCreate) SetPrice(m money.Amount) *PriceListItemCreate {
+	plic.mutation.SetPrice(m)
+	return plic
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (plic *PriceListItemCreate) SetNillablePrice(m *money.Amount) *PriceListItemCreate {
+	if m != nil {
+		plic.SetPrice(*m)
+	}
+	return plic
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (plic *PriceListItemCreate) SetDiscountPercent(f float64) *PriceListItemCreate {
+	plic.mutation.SetDiscountPercent(f)
+	return plic
+}
+
+// SetNillableDiscountPercent sets the "discount_percent" field if the given value is not nil.
+func (plic *PriceListItemCreate) SetNillableDiscountPercent(f *float64) *PriceListItemCreate {
+	if f != nil {
+		plic.SetDiscountPercent(*f)
+	}
+	return plic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (plic *PriceListItemCreate) SetCreatedAt(t time.Time) *PriceListItemCreate {
+	plic.mutation.SetCreatedAt(t)
+	return plic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (plic *PriceListItemCreate) SetNillableCreatedAt(t *time.Time) *PriceListItemCreate {
+	if t != nil {
+		plic.SetCreatedAt(*t)
+	}
+	return plic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (plic *PriceListItemCreate) SetUpdatedAt(t time.Time) *PriceListItemCreate {
+	plic.mutation.SetUpdatedAt(t)
+	return plic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (plic *PriceListItemCreate) SetNillableUpdatedAt(t *time.Time) *PriceListItemCreate {
+	if t != nil {
+		plic.SetUpdatedAt(*t)
+	}
+	return plic
+}
+
+// Mutation returns the PriceListItemMutation object of the builder.
+func (plic *PriceListItemCreate) Mutation() *PriceListItemMutation {
+	return plic.mutation
+}
+
+// Save creates the PriceListItem in the database.
+func (plic *PriceListItemCreate) Save(ctx context.Context) (*PriceListItem, error) {
+	var (
+		err  error
+		node *PriceListItem
+	)
+	plic.defaults()
+	if len(plic.hooks) == 0 {
+		if err = plic.check(); err != nil {
+			return nil, err
+		}
+		node, err = plic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PriceListItemMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = plic.check(); err != nil {
+				return nil, err
+			}
+			plic.mutation = mutation
+			if node, err = plic.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(plic.hooks) - 1; i >= 0; i-- {
+			if plic.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = plic.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, plic.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*PriceListItem)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from PriceListItemMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (plic *PriceListItemCreate) SaveX(ctx context.Context) *PriceListItem {
+	v, err := plic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (plic *PriceListItemCreate) Exec(ctx context.Context) error {
+	_, err := plic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (plic *PriceListItemCreate) ExecX(ctx context.Context) {
+	if err := plic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (plic *PriceListItemCreate) defaults() {
+	if _, ok := plic.mutation.MinQuantity(); !ok {
+		v := pricelistitem.DefaultMinQuantity
+		plic.mutation.SetMinQuantity(v)
+	}
+	if _, ok := plic.mutation.CreatedAt(); !ok {
+		v := pricelistitem.DefaultCreatedAt()
+		plic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := plic.mutation.UpdatedAt(); !ok {
+		v := pricelistitem.DefaultUpdatedAt()
+		plic.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (plic *PriceListItemCreate) check() error {
+	if _, ok := plic.mutation.PriceListID(); !ok {
+		return &ValidationError{Name: "price_list_id", err: errors.New(`ent: missing required field "PriceListItem.price_list_id"`)}
+	}
+	if _, ok := plic.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "PriceListItem.product_id"`)}
+	}
+	if _, ok := plic.mutation.MinQuantity(); !ok {
+		return &ValidationError{Name: "min_quantity", err: errors.New(`ent: missing required field "PriceListItem.min_quantity"`)}
+	}
+	if v, ok := plic.mutation.MinQuantity(); ok {
+		if err := pricelistitem.MinQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "min_quantity", err: fmt.Errorf(`ent: validator failed for field "PriceListItem.min_quantity": %w`, err)}
+		}
+	}
+	if v, ok := plic.mutation.Price(); ok {
+		if err := pricelistitem.PriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "PriceListItem.price": %w`, err)}
+		}
+	}
+	if v, ok := plic.mutation.DiscountPercent(); ok {
+		if err := pricelistitem.DiscountPercentValidator(v); err != nil {
+			return &ValidationError{Name: "discount_percent", err: fmt.Errorf(`ent: validator failed for field "PriceListItem.discount_percent": %w`, err)}
+		}
+	}
+	if _, ok := plic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PriceListItem.created_at"`)}
+	}
+	if _, ok := plic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PriceListItem.updated_at"`)}
+	}
+	return nil
+}
+
+func (plic *PriceListItemCreate) sqlSave(ctx context.Context) (*PriceListItem, error) {
+	_node, _spec := plic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, plic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (plic *PriceListItemCreate) createSpec() (*PriceListItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PriceListItem{config: plic.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: pricelistitem.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: pricelistitem.FieldID,
+			},
+		}
+	)
+	if value, ok := plic.mutation.PriceListID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelistitem.FieldPriceListID,
+		})
+		_node.PriceListID = value
+	}
+	if value, ok := plic.mutation.ProductID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelistitem.FieldProductID,
+		})
+		_node.ProductID = value
+	}
+	if value, ok := plic.mutation.MinQuantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelistitem.FieldMinQuantity,
+		})
+		_node.MinQuantity = value
+	}
+	if value, ok := plic.mutation.Price(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: pricelistitem.FieldPrice,
+		})
+		_node.Price = &value
+	}
+	if value, ok := plic.mutation.DiscountPercent(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: pricelistitem.FieldDiscountPercent,
+		})
+		_node.DiscountPercent = &value
+	}
+	if value, ok := plic.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pricelistitem.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := plic.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pricelistitem.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// PriceListItemCreateBulk is the builder for creating many PriceListItem entities in bulk.
+type PriceListItemCreateBulk struct {
+	config
+	builders []*PriceListItemCreate
+}
+
+// Save creates the PriceListItem entities in the database.
+func (plicb *PriceListItemCreateBulk) Save(ctx context.Context) ([]*PriceListItem, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(plicb.builders))
+	nodes := make([]*PriceListItem, len(plicb.builders))
+	mutators := make([]Mutator, len(plicb.builders))
+	for i := range plicb.builders {
+		func(i int, root context.Context) {
+			builder := plicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PriceListItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, plicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, plicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, plicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (plicb *PriceListItemCreateBulk) SaveX(ctx context.Context) []*PriceListItem {
+	v, err := plicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (plicb *PriceListItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := plicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (plicb *PriceListItemCreateBulk) ExecX(ctx context.Context) {
+	if err := plicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
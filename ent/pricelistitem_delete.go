@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/pricelistitem"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PriceListItemDelete is the builder for deleting a PriceListItem entity.
+type PriceListItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *PriceListItemMutation
+}
+
+// Where appends a list predicates to the PriceListItemDelete builder.
+func (plid *PriceListItemDelete) Where(ps ...predicate.PriceListItem) *PriceListItemDelete {
+	plid.mutation.Where(ps...)
+	return plid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (plid *PriceListItemDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(plid.hooks) == 0 {
+		affected, err = plid.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PriceListItemMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			plid.mutation = mutation
+			affected, err = plid.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(plid.hooks) - 1; i >= 0; i-- {
+			if plid.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = plid.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, plid.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (plid *PriceListItemDelete) ExecX(ctx context.Context) int {
+	n, err := plid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (plid *PriceListItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: pricelistitem.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: pricelistitem.FieldID,
+			},
+		},
+	}
+	if ps := plid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, plid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// PriceListItemDeleteOne is the builder for deleting a single PriceListItem entity.
+type PriceListItemDeleteOne struct {
+	plid *PriceListItemDelete
+}
+
+// Exec executes the deletion query.
+func (plido *PriceListItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := plido.plid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pricelistitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (plido *PriceListItemDeleteOne) ExecX(ctx context.Context) {
+	plido.plid.ExecX(ctx)
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/pricelistitem"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PriceListItemQuery is the builder for querying PriceListItem entities.
+type PriceListItemQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.PriceListItem
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PriceListItemQuery builder.
+func (pliq *PriceListItemQuery) Where(ps ...predicate.PriceListItem) *PriceListItemQuery {
+	pliq.predicates = append(pliq.predicates, ps...)
+	return pliq
+}
+
+// Limit adds a limit step to the query.
+func (pliq *PriceListItemQuery) Limit(limit int) *PriceListItemQuery {
+	pliq.limit = &limit
+	return pliq
+}
+
+// Offset adds an offset step to the query.
+func (pliq *PriceListItemQuery) Offset(offset int) *PriceListItemQuery {
+	pliq.offset = &offset
+	return pliq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pliq *PriceListItemQuery) Unique(unique bool) *PriceListItemQuery {
+	pliq.unique = &unique
+	return pliq
+}
+
+// Order adds an order step to the query.
+func (pliq *PriceListItemQuery) Order(o ...OrderFunc) *PriceListItemQuery {
+	pliq.order = append(pliq.order, o...)
+	return pliq
+}
+
+// First returns the first PriceListItem entity from the query.
+// Returns a *NotFoundError when no PriceListItem was found.
+func (pliq *PriceListItemQuery) First(ctx context.Context) (*PriceListItem, error) {
+	nodes, err := pliq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{pricelistitem.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pliq *PriceListItemQuery) FirstX(ctx context.Context) *PriceListItem {
+	node, err := pliq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PriceListItem ID from the query.
+// Returns a *NotFoundError when no PriceListItem ID was found.
+func (pliq *PriceListItemQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pliq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{pricelistitem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pliq *PriceListItemQuery) FirstIDX(ctx context.Context) int {
+	id, err := pliq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PriceListItem entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PriceListItem entity is found.
+// Returns a *NotFoundError when no PriceListItem entities are found.
+func (pliq *PriceListItemQuery) Only(ctx context.Context) (*PriceListItem, error) {
+	nodes, err := pliq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{pricelistitem.Label}
+	default:
+		return nil, &NotSingularError{pricelistitem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pliq *PriceListItemQuery) OnlyX(ctx context.Context) *PriceListItem {
+	node, err := pliq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PriceListItem ID in the query.
+// Returns a *NotSingularError when more than one PriceListItem ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pliq *PriceListItemQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pliq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{pricelistitem.Label}
+	default:
+		err = &NotSingularError{pricelistitem.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pliq *PriceListItemQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pliq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PriceListItems.
+func (pliq *PriceListItemQuery) All(ctx context.Context) ([]*PriceListItem, error) {
+	if err := pliq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return pliq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pliq *PriceListItemQuery) AllX(ctx context.Context) []*PriceListItem {
+	nodes, err := pliq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PriceListItem IDs.
+func (pliq *PriceListItemQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := pliq.Select(pricelistitem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pliq *PriceListItemQuery) IDsX(ctx context.Context) []int {
+	ids, err := pliq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pliq *PriceListItemQuery) Count(ctx context.Context) (int, error) {
+	if err := pliq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return pliq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pliq *PriceListItemQuery) CountX(ctx context.Context) int {
+	count, err := pliq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pliq *PriceListItemQuery) Exist(ctx context.Context) (bool, error) {
+	if err := pliq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return pliq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pliq *PriceListItemQuery) ExistX(ctx context.Context) bool {
+	exist, err := pliq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PriceListItemQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pliq *PriceListItemQuery) Clone() *PriceListItemQuery {
+	if pliq == nil {
+		return nil
+	}
+	return &PriceListItemQuery{
+		config:     pliq.config,
+		limit:      pliq.limit,
+		offset:     pliq.offset,
+		order:      append([]OrderFunc{}, pliq.order...),
+		predicates: append([]predicate.PriceListItem{}, pliq.predicates...),
+		// clone intermediate query.
+		sql:    pliq.sql.Clone(),
+		path:   pliq.path,
+		unique: pliq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PriceListID int `json:"price_list_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PriceListItem.Query().
+//		GroupBy(pricelistitem.FieldPriceListID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pliq *PriceListItemQuery) GroupBy(field string, fields ...string) *PriceListItemGroupBy {
+	grbuild := &PriceListItemGroupBy{config: pliq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := pliq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return pliq.sqlQuery(ctx), nil
+	}
+	grbuild.label = pricelistitem.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PriceListID int `json:"price_list_id,omitempty"`
+//	}
+//
+//	client.PriceListItem.Query().
+//		Select(pricelistitem.FieldPriceListID).
+//		Scan(ctx, &v)
+func (pliq *PriceListItemQuery) Select(fields ...string) *PriceListItemSelect {
+	pliq.fields = append(pliq.fields, fields...)
+	selbuild := &PriceListItemSelect{PriceListItemQuery: pliq}
+	selbuild.label = pricelistitem.Label
+	selbuild.flds, selbuild.scan = &pliq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (pliq *PriceListItemQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range pliq.fields {
+		if !pricelistitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pliq.path != nil {
+		prev, err := pliq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pliq.sql = prev
+	}
+	return nil
+}
+
+func (pliq *PriceListItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PriceListItem, error) {
+	var (
+		nodes = []*PriceListItem{}
+		_spec = pliq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*PriceListItem).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &PriceListItem{config: pliq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(pliq.modifiers) > 0 {
+		_spec.Modifiers = pliq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pliq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (pliq *PriceListItemQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pliq.querySpec()
+	if len(pliq.modifiers) > 0 {
+		_spec.Modifiers = pliq.modifiers
+	}
+	_spec.Node.Columns = pliq.fields
+	if len(pliq.fields) > 0 {
+		_spec.Unique = pliq.unique != nil && *pliq.unique
+	}
+	return sqlgraph.CountNodes(ctx, pliq.driver, _spec)
+}
+
+func (pliq *PriceListItemQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := pliq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (pliq *PriceListItemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   pricelistitem.Table,
+			Columns: pricelistitem.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: pricelistitem.FieldID,
+			},
+		},
+		From:   pliq.sql,
+		Unique: true,
+	}
+	if unique := pliq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := pliq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricelistitem.FieldID)
+		for i := range fields {
+			if fields[i] != pricelistitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pliq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pliq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pliq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pliq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pliq *PriceListItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pliq.driver.Dialect())
+	t1 := builder.Table(pricelistitem.Table)
+	columns := pliq.fields
+	if len(columns) == 0 {
+		columns = pricelistitem.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pliq.sql != nil {
+		selector = pliq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pliq.unique != nil && *pliq.unique {
+		selector.Distinct()
+	}
+	for _, m := range pliq.modifiers {
+		m(selector)
+	}
+	for _, p := range pliq.predicates {
+		p(selector)
+	}
+	for _, p := range pliq.order {
+		p(selector)
+	}
+	if offset := pliq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pliq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pliq *PriceListItemQuery) ForUpdate(opts ...sql.LockOption) *PriceListItemQuery {
+	if pliq.driver.Dialect() == dialect.Postgres {
+		pliq.Unique(false)
+	}
+	pliq.modifiers = append(pliq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pliq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pliq *PriceListItemQuery) ForShare(opts ...sql.LockOption) *PriceListItemQuery {
+	if pliq.driver.Dialect() == dialect.Postgres {
+		pliq.Unique(false)
+	}
+	pliq.modifiers = append(pliq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pliq
+}
+
+// PriceListItemGroupBy is the group-by builder for PriceListItem entities.
+type PriceListItemGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pligb *PriceListItemGroupBy) Aggregate(fns ...AggregateFunc) *PriceListItemGroupBy {
+	pligb.fns = append(pligb.fns, fns...)
+	return pligb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (pligb *PriceListItemGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := pligb.path(ctx)
+	if err != nil {
+		return err
+	}
+	pligb.sql = query
+	return pligb.sqlScan(ctx, v)
+}
+
+func (pligb *PriceListItemGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range pligb.fields {
+		if !pricelistitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := pligb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pligb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (pligb *PriceListItemGroupBy) sqlQuery() *sql.Selector {
+	selector := pligb.sql.Select()
+	aggregation := make([]string, 0, len(pligb.fns))
+	for _, fn := range pligb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(pligb.fields)+len(pligb.fns))
+		for _, f := range pligb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(pligb.fields...)...)
+}
+
+// PriceListItemSelect is the builder for selecting fields of PriceListItem entities.
+type PriceListItemSelect struct {
+	*PriceListItemQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (plis *PriceListItemSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := plis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	plis.sql = plis.PriceListItemQuery.sqlQuery(ctx)
+	return plis.sqlScan(ctx, v)
+}
+
+func (plis *PriceListItemSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := plis.sql.Query()
+	if err := plis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
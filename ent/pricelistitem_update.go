@@ -0,0 +1,709 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/pricelistitem"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PriceListItemUpdate is the builder for updating PriceListItem entities.
+type PriceListItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PriceListItemMutation
+}
+
+// Where appends a list predicates to the PriceListItemUpdate builder.
+func (pliu *PriceListItemUpdate) Where(ps ...predicate.PriceListItem) *PriceListItemUpdate {
+	pliu.mutation.Where(ps...)
+	return pliu
+}
+
+// SetPriceListID sets the "price_list_id" field.
+func (pliu *PriceListItemUpdate) SetPriceListID(i int) *PriceListItemUpdate {
+	pliu.mutation.ResetPriceListID()
+	pliu.mutation.SetPriceListID(i)
+	return pliu
+}
+
+// AddPriceListID adds i to the "price_list_id" field.
+func (pliu *PriceListItemUpdate) AddPriceListID(i int) *PriceListItemUpdate {
+	pliu.mutation.AddPriceListID(i)
+	return pliu
+}
+
+// SetProductID sets the "product_id" field.
+func (pliu *PriceListItemUpdate) SetProductID(i int) *PriceListItemUpdate {
+	pliu.mutation.ResetProductID()
+	pliu.mutation.SetProductID(i)
+	return pliu
+}
+
+// AddProductID adds i to the "product_id" field.
+func (pliu *PriceListItemUpdate) AddProductID(i int) *PriceListItemUpdate {
+	pliu.mutation.AddProductID(i)
+	return pliu
+}
+
+// SetMinQuantity sets the "min_quantity" field.
+func (pliu *PriceListItemUpdate) SetMinQuantity(i int) *PriceListItemUpdate {
+	pliu.mutation.ResetMinQuantity()
+	pliu.mutation.SetMinQuantity(i)
+	return pliu
+}
+
+// SetNillableMinQuantity sets the "min_quantity" field if the given value is not nil.
+func (pliu *PriceListItemUpdate) SetNillableMinQuantity(i *int) *PriceListItemUpdate {
+	if i != nil {
+		pliu.SetMinQuantity(*i)
+	}
+	return pliu
+}
+
+// AddMinQuantity adds i to the "min_quantity" field.
+func (pliu *PriceListItemUpdate) AddMinQuantity(i int) *PriceListItemUpdate {
+	pliu.mutation.AddMinQuantity(i)
+	return pliu
+}
+
+// SetPrice sets the "price" field.
+func (pliu *PriceListItemUpdate) SetPrice(m money.Amount) *PriceListItemUpdate {
+	pliu.mutation.ResetPrice()
+	pliu.mutation.SetPrice(m)
+	return pliu
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (pliu *PriceListItemUpdate) SetNillablePrice(m *money.Amount) *PriceListItemUpdate {
+	if m != nil {
+		pliu.SetPrice(*m)
+	}
+	return pliu
+}
+
+// AddPrice adds m to the "price" field.
+func (pliu *PriceListItemUpdate) AddPrice(m money.Amount) *PriceListItemUpdate {
+	pliu.mutation.AddPrice(m)
+	return pliu
+}
+
+// ClearPrice clears the value of the "price" field.
+func (pliu *PriceListItemUpdate) ClearPrice() *PriceListItemUpdate {
+	pliu.mutation.ClearPrice()
+	return pliu
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (pliu *PriceListItemUpdate) SetDiscountPercent(f float64) *PriceListItemUpdate {
+	pliu.mutation.ResetDiscountPercent()
+	pliu.mutation.SetDiscountPercent(f)
+	return pliu
+}
+
+// SetNillableDiscountPercent sets the "discount_percent" field if the given value is not nil.
+func (pliu *PriceListItemUpdate) SetNillableDiscountPercent(f *float64) *PriceListItemUpdate {
+	if f != nil {
+		pliu.SetDiscountPercent(*f)
+	}
+	return pliu
+}
+
+// AddDiscountPercent adds f to the "discount_percent" field.
+func (pliu *PriceListItemUpdate) AddDiscountPercent(f float64) *PriceListItemUpdate {
+	pliu.mutation.AddDiscountPercent(f)
+	return pliu
+}
+
+// ClearDiscountPercent clears the value of the "discount_percent" field.
+func (pliu *PriceListItemUpdate) ClearDiscountPercent() *PriceListItemUpdate {
+	pliu.mutation.ClearDiscountPercent()
+	return pliu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pliu *PriceListItemUpdate) SetUpdatedAt(t time.Time) *PriceListItemUpdate {
+	pliu.mutation.SetUpdatedAt(t)
+	return pliu
+}
+
+// Mutation returns the PriceListItemMutation object of the builder.
+func (pliu *PriceListItemUpdate) Mutation() *PriceListItemMutation {
+	return pliu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pliu *PriceListItemUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	pliu.defaults()
+	if len(pliu.hooks) == 0 {
+		if err = pliu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = pliu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PriceListItemMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pliu.check(); err != nil {
+				return 0, err
+			}
+			pliu.mutation = mutation
+			affected, err = pliu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pliu.hooks) - 1; i >= 0; i-- {
+			if pliu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pliu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pliu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pliu *PriceListItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := pliu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pliu *PriceListItemUpdate) Exec(ctx context.Context) error {
+	_, err := pliu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pliu *PriceListItemUpdate) ExecX(ctx context.Context) {
+	if err := pliu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pliu *PriceListItemUpdate) defaults() {
+	if _, ok := pliu.mutation.UpdatedAt(); !ok {
+		v := pricelistitem.UpdateDefaultUpdatedAt()
+		pliu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pliu *PriceListItemUpdate) check() error {
+	if v, ok := pliu.mutation.MinQuantity(); ok {
+		if err := pricelistitem.MinQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "min_quantity", err: fmt.Errorf(`ent: validator failed for field "PriceListItem.min_quantity": %w`, err)}
+		}
+	}
+	if v, ok := pliu.mutation.Price(); ok {
+		if err := pricelistitem.PriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "PriceListItem.price": %w`, err)}
+		}
+	}
+	if v, ok := pliu.mutation.DiscountPercent(); ok {
+		if err := pricelistitem.DiscountPercentValidator(v); err != nil {
+			return &ValidationError{Name: "discount_percent", err: fmt.Errorf(`ent: validator failed for field "PriceListItem.discount_percent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pliu *PriceListItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   pricelistitem.Table,
+			Columns: pricelistitem.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: pricelistitem.FieldID,
+			},
+		},
+	}
+	if ps := pliu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pliu.mutation.PriceListID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelistitem.FieldPriceListID,
+		})
+	}
+	if value, ok := pliu.mutation.AddedPriceListID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelistitem.FieldPriceListID,
+		})
+	}
+	if value, ok := pliu.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelistitem.FieldProductID,
+		})
+	}
+	if value, ok := pliu.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelistitem.FieldProductID,
+		})
+	}
+	if value, ok := pliu.mutation.MinQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelistitem.FieldMinQuantity,
+		})
+	}
+	if value, ok := pliu.mutation.AddedMinQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelistitem.FieldMinQuantity,
+		})
+	}
+	if value, ok := pliu.mutation.Price(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: pricelistitem.FieldPrice,
+		})
+	}
+	if value, ok := pliu.mutation.AddedPrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: pricelistitem.FieldPrice,
+		})
+	}
+	if pliu.mutation.PriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: pricelistitem.FieldPrice,
+		})
+	}
+	if value, ok := pliu.mutation.DiscountPercent(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: pricelistitem.FieldDiscountPercent,
+		})
+	}
+	if value, ok := pliu.mutation.AddedDiscountPercent(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: pricelistitem.FieldDiscountPercent,
+		})
+	}
+	if pliu.mutation.DiscountPercentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: pricelistitem.FieldDiscountPercent,
+		})
+	}
+	if value, ok := pliu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pricelistitem.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pliu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricelistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PriceListItemUpdateOne is the builder for updating a single PriceListItem entity.
+type PriceListItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PriceListItemMutation
+}
+
+// SetPriceListID sets the "price_list_id" field.
+func (pliuo *PriceListItemUpdateOne) SetPriceListID(i int) *PriceListItemUpdateOne {
+	pliuo.mutation.ResetPriceListID()
+	pliuo.mutation.SetPriceListID(i)
+	return pliuo
+}
+
+// AddPriceListID adds i to the "price_list_id" field.
+func (pliuo *PriceListItemUpdateOne) AddPriceListID(i int) *PriceListItemUpdateOne {
+	pliuo.mutation.AddPriceListID(i)
+	return pliuo
+}
+
+// SetProductID sets the "product_id" field.
+func (pliuo *PriceListItemUpdateOne) SetProductID(i int) *PriceListItemUpdateOne {
+	pliuo.mutation.ResetProductID()
+	pliuo.mutation.SetProductID(i)
+	return pliuo
+}
+
+// AddProductID adds i to the "product_id" field.
+func (pliuo *PriceListItemUpdateOne) AddProductID(i int) *PriceListItemUpdateOne {
+	pliuo.mutation.AddProductID(i)
+	return pliuo
+}
+
+// SetMinQuantity sets the "min_quantity" field.
+func (pliuo *PriceListItemUpdateOne) SetMinQuantity(i int) *PriceListItemUpdateOne {
+	pliuo.mutation.ResetMinQuantity()
+	pliuo.mutation.SetMinQuantity(i)
+	return pliuo
+}
+
+// SetNillableMinQuantity sets the "min_quantity" field if the given value is not nil.
+func (pliuo *PriceListItemUpdateOne) SetNillableMinQuantity(i *int) *PriceListItemUpdateOne {
+	if i != nil {
+		pliuo.SetMinQuantity(*i)
+	}
+	return pliuo
+}
+
+// AddMinQuantity adds i to the "min_quantity" field.
+func (pliuo *PriceListItemUpdateOne) AddMinQuantity(i int) *PriceListItemUpdateOne {
+	pliuo.mutation.AddMinQuantity(i)
+	return pliuo
+}
+
+// SetPrice sets the "price" field.
+func (pliuo *PriceListItemUpdateOne) SetPrice(m money.Amount) *PriceListItemUpdateOne {
+	pliuo.mutation.ResetPrice()
+	pliuo.mutation.SetPrice(m)
+	return pliuo
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (pliuo *PriceListItemUpdateOne) SetNillablePrice(m *money.Amount) *PriceListItemUpdateOne {
+	if m != nil {
+		pliuo.SetPrice(*m)
+	}
+	return pliuo
+}
+
+// AddPrice adds m to the "price" field.
+func (pliuo *PriceListItemUpdateOne) AddPrice(m money.Amount) *PriceListItemUpdateOne {
+	pliuo.mutation.AddPrice(m)
+	return pliuo
+}
+
+// ClearPrice clears the value of the "price" field.
+func (pliuo *PriceListItemUpdateOne) ClearPrice() *PriceListItemUpdateOne {
+	pliuo.mutation.ClearPrice()
+	return pliuo
+}
+
+// SetDiscountPercent sets the "discount_percent" field.
+func (pliuo *PriceListItemUpdateOne) SetDiscountPercent(f float64) *PriceListItemUpdateOne {
+	pliuo.mutation.ResetDiscountPercent()
+	pliuo.mutation.SetDiscountPercent(f)
+	return pliuo
+}
+
+// SetNillableDiscountPercent sets the "discount_percent" field if the given value is not nil.
+func (pliuo *PriceListItemUpdateOne) SetNillableDiscountPercent(f *float64) *PriceListItemUpdateOne {
+	if f != nil {
+		pliuo.SetDiscountPercent(*f)
+	}
+	return pliuo
+}
+
+// AddDiscountPercent adds f to the "discount_percent" field.
+func (pliuo *PriceListItemUpdateOne) AddDiscountPercent(f float64) *PriceListItemUpdateOne {
+	pliuo.mutation.AddDiscountPercent(f)
+	return pliuo
+}
+
+// ClearDiscountPercent clears the value of the "discount_percent" field.
+func (pliuo *PriceListItemUpdateOne) ClearDiscountPercent() *PriceListItemUpdateOne {
+	pliuo.mutation.ClearDiscountPercent()
+	return pliuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pliuo *PriceListItemUpdateOne) SetUpdatedAt(t time.Time) *PriceListItemUpdateOne {
+	pliuo.mutation.SetUpdatedAt(t)
+	return pliuo
+}
+
+// Mutation returns the PriceListItemMutation object of the builder.
+func (pliuo *PriceListItemUpdateOne) Mutation() *PriceListItemMutation {
+	return pliuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pliuo *PriceListItemUpdateOne) Select(field string, fields ...string) *PriceListItemUpdateOne {
+	pliuo.fields = append([]string{field}, fields...)
+	return pliuo
+}
+
+// Save executes the query and returns the updated PriceListItem entity.
+func (pliuo *PriceListItemUpdateOne) Save(ctx context.Context) (*PriceListItem, error) {
+	var (
+		err  error
+		node *PriceListItem
+	)
+	pliuo.defaults()
+	if len(pliuo.hooks) == 0 {
+		if err = pliuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = pliuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PriceListItemMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pliuo.check(); err != nil {
+				return nil, err
+			}
+			pliuo.mutation = mutation
+			node, err = pliuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pliuo.hooks) - 1; i >= 0; i-- {
+			if pliuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pliuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, pliuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*PriceListItem)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from PriceListItemMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pliuo *PriceListItemUpdateOne) SaveX(ctx context.Context) *PriceListItem {
+	node, err := pliuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pliuo *PriceListItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := pliuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pliuo *PriceListItemUpdateOne) ExecX(ctx context.Context) {
+	if err := pliuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pliuo *PriceListItemUpdateOne) defaults() {
+	if _, ok := pliuo.mutation.UpdatedAt(); !ok {
+		v := pricelistitem.UpdateDefaultUpdatedAt()
+		pliuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pliuo *PriceListItemUpdateOne) check() error {
+	if v, ok := pliuo.mutation.MinQuantity(); ok {
+		if err := pricelistitem.MinQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "min_quantity", err: fmt.Errorf(`ent: validator failed for field "PriceListItem.min_quantity": %w`, err)}
+		}
+	}
+	if v, ok := pliuo.mutation.Price(); ok {
+		if err := pricelistitem.PriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "PriceListItem.price": %w`, err)}
+		}
+	}
+	if v, ok := pliuo.mutation.DiscountPercent(); ok {
+		if err := pricelistitem.DiscountPercentValidator(v); err != nil {
+			return &ValidationError{Name: "discount_percent", err: fmt.Errorf(`ent: validator failed for field "PriceListItem.discount_percent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pliuo *PriceListItemUpdateOne) sqlSave(ctx context.Context) (_node *PriceListItem, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   pricelistitem.Table,
+			Columns: pricelistitem.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: pricelistitem.FieldID,
+			},
+		},
+	}
+	id, ok := pliuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PriceListItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pliuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pricelistitem.FieldID)
+		for _, f := range fields {
+			if !pricelistitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pricelistitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pliuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pliuo.mutation.PriceListID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelistitem.FieldPriceListID,
+		})
+	}
+	if value, ok := pliuo.mutation.AddedPriceListID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelistitem.FieldPriceListID,
+		})
+	}
+	if value, ok := pliuo.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelistitem.FieldProductID,
+		})
+	}
+	if value, ok := pliuo.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelistitem.FieldProductID,
+		})
+	}
+	if value, ok := pliuo.mutation.MinQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelistitem.FieldMinQuantity,
+		})
+	}
+	if value, ok := pliuo.mutation.AddedMinQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: pricelistitem.FieldMinQuantity,
+		})
+	}
+	if value, ok := pliuo.mutation.Price(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: pricelistitem.FieldPrice,
+		})
+	}
+	if value, ok := pliuo.mutation.AddedPrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: pricelistitem.FieldPrice,
+		})
+	}
+	if pliuo.mutation.PriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: pricelistitem.FieldPrice,
+		})
+	}
+	if value, ok := pliuo.mutation.DiscountPercent(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: pricelistitem.FieldDiscountPercent,
+		})
+	}
+	if value, ok := pliuo.mutation.AddedDiscountPercent(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: pricelistitem.FieldDiscountPercent,
+		})
+	}
+	if pliuo.mutation.DiscountPercentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: pricelistitem.FieldDiscountPercent,
+		})
+	}
+	if value, ok := pliuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pricelistitem.FieldUpdatedAt,
+		})
+	}
+	_node = &PriceListItem{config: pliuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pliuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pricelistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"Veritasbackend/ent/product"
+	"Veritasbackend/pkg/money"
 	"fmt"
 	"strings"
 	"time"
@@ -20,20 +21,40 @@ type Product struct {
 	Name string `json:"name,omitempty"`
 	// Descripción del producto
 	Description string `json:"description,omitempty"`
-	// Precio del producto (legacy - usar retail_price)
-	Price float64 `json:"price,omitempty"`
-	// Precio de compra al proveedor
-	PurchasePrice float64 `json:"purchase_price,omitempty"`
+	// Costo unitario del inventario; lo mantiene el método de costeo del tenant
+	PurchasePrice money.Amount `json:"purchase_price,omitempty"`
 	// Precio de venta al detal
-	RetailPrice float64 `json:"retail_price,omitempty"`
+	RetailPrice money.Amount `json:"retail_price,omitempty"`
 	// Precio de venta al mayor
-	WholesalePrice float64 `json:"wholesale_price,omitempty"`
+	WholesalePrice money.Amount `json:"wholesale_price,omitempty"`
 	// Cantidad mínima para precio mayor
 	MinWholesaleQuantity int `json:"min_wholesale_quantity,omitempty"`
-	// Cantidad en stock
-	Stock int `json:"stock,omitempty"`
+	// Cantidad en stock, en la unidad base
+	Stock float64 `json:"stock,omitempty"`
+	// Unidad base en la que se lleva el stock (unidad, kg, litro...)
+	BaseUnit string `json:"base_unit,omitempty"`
+	// Permite cantidades fraccionarias de la unidad base, como en productos pesados
+	Fractional bool `json:"fractional,omitempty"`
+	// Producto compuesto por otros (combo, canasta, kit); se vende descontando sus componentes
+	Bundle bool `json:"bundle,omitempty"`
+	// Reparto del ingreso de un combo entre sus componentes en los reportes (retail_price, cost, manual)
+	RevenueSplit string `json:"revenue_split,omitempty"`
 	// SKU del producto
 	Sku string `json:"sku,omitempty"`
+	// Categoría del producto
+	Category string `json:"category,omitempty"`
+	// Tarifa de impuesto del producto; vacío usa la tarifa por defecto del tenant
+	TaxRateID *int `json:"tax_rate_id,omitempty"`
+	// Controla lotes y fechas de vencimiento
+	TrackLots bool `json:"track_lots,omitempty"`
+	// Controla número de serie por unidad
+	Serialized bool `json:"serialized,omitempty"`
+	// Meses de garantía desde la venta
+	WarrantyMonths int `json:"warranty_months,omitempty"`
+	// Eliminado de forma lógica: no aparece en listados ni búsquedas pero sigue resolviéndose desde los documentos
+	Archived bool `json:"archived,omitempty"`
+	// Fecha de eliminación lógica
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// ID del tenant al que pertenece
 	TenantID int `json:"tenant_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -47,13 +68,17 @@ func (*Product) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case product.FieldPrice, product.FieldPurchasePrice, product.FieldRetailPrice, product.FieldWholesalePrice:
+		case product.FieldPurchasePrice, product.FieldRetailPrice, product.FieldWholesalePrice:
+			values[i] = new(money.Amount)
+		case product.FieldFractional, product.FieldBundle, product.FieldTrackLots, product.FieldSerialized, product.FieldArchived:
+			values[i] = new(sql.NullBool)
+		case product.FieldStock:
 			values[i] = new(sql.NullFloat64)
-		case product.FieldID, product.FieldMinWholesaleQuantity, product.FieldStock, product.FieldTenantID:
+		case product.FieldID, product.FieldMinWholesaleQuantity, product.FieldTaxRateID, product.FieldWarrantyMonths, product.FieldTenantID:
 			values[i] = new(sql.NullInt64)
-		case product.FieldName, product.FieldDescription, product.FieldSku:
+		case product.FieldName, product.FieldDescription, product.FieldBaseUnit, product.FieldRevenueSplit, product.FieldSku, product.FieldCategory:
 			values[i] = new(sql.NullString)
-		case product.FieldCreatedAt, product.FieldUpdatedAt:
+		case product.FieldDeletedAt, product.FieldCreatedAt, product.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Product", columns[i])
@@ -88,29 +113,23 @@ func (pr *Product) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				pr.Description = value.String
 			}
-		case product.FieldPrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
-				return fmt.Errorf("unexpected type %T for field price", values[i])
-			} else if value.Valid {
-				pr.Price = value.Float64
-			}
 		case product.FieldPurchasePrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field purchase_price", values[i])
-			} else if value.Valid {
-				pr.PurchasePrice = value.Float64
+			} else if value != nil {
+				pr.PurchasePrice = *value
 			}
 		case product.FieldRetailPrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field retail_price", values[i])
-			} else if value.Valid {
-				pr.RetailPrice = value.Float64
+			} else if value != nil {
+				pr.RetailPrice = *value
 			}
 		case product.FieldWholesalePrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field wholesale_price", values[i])
-			} else if value.Valid {
-				pr.WholesalePrice = value.Float64
+			} else if value != nil {
+				pr.WholesalePrice = *value
 			}
 		case product.FieldMinWholesaleQuantity:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
@@ -119,10 +138,34 @@ func (pr *Product) assignValues(columns []string, values []interface{}) error {
 				pr.MinWholesaleQuantity = int(value.Int64)
 			}
 		case product.FieldStock:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field stock", values[i])
 			} else if value.Valid {
-				pr.Stock = int(value.Int64)
+				pr.Stock = value.Float64
+			}
+		case product.FieldBaseUnit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_unit", values[i])
+			} else if value.Valid {
+				pr.BaseUnit = value.String
+			}
+		case product.FieldFractional:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field fractional", values[i])
+			} else if value.Valid {
+				pr.Fractional = value.Bool
+			}
+		case product.FieldBundle:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field bundle", values[i])
+			} else if value.Valid {
+				pr.Bundle = value.Bool
+			}
+		case product.FieldRevenueSplit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field revenue_split", values[i])
+			} else if value.Valid {
+				pr.RevenueSplit = value.String
 			}
 		case product.FieldSku:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -130,6 +173,50 @@ func (pr *Product) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				pr.Sku = value.String
 			}
+		case product.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				pr.Category = value.String
+			}
+		case product.FieldTaxRateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate_id", values[i])
+			} else if value.Valid {
+				pr.TaxRateID = new(int)
+				*pr.TaxRateID = int(value.Int64)
+			}
+		case product.FieldTrackLots:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field track_lots", values[i])
+			} else if value.Valid {
+				pr.TrackLots = value.Bool
+			}
+		case product.FieldSerialized:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field serialized", values[i])
+			} else if value.Valid {
+				pr.Serialized = value.Bool
+			}
+		case product.FieldWarrantyMonths:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field warranty_months", values[i])
+			} else if value.Valid {
+				pr.WarrantyMonths = int(value.Int64)
+			}
+		case product.FieldArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field archived", values[i])
+			} else if value.Valid {
+				pr.Archived = value.Bool
+			}
+		case product.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pr.DeletedAt = new(time.Time)
+				*pr.DeletedAt = value.Time
+			}
 		case product.FieldTenantID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
@@ -182,9 +269,6 @@ func (pr *Product) String() string {
 	builder.WriteString("description=")
 	builder.WriteString(pr.Description)
 	builder.WriteString(", ")
-	builder.WriteString("price=")
-	builder.WriteString(fmt.Sprintf("%v", pr.Price))
-	builder.WriteString(", ")
 	builder.WriteString("purchase_price=")
 	builder.WriteString(fmt.Sprintf("%v", pr.PurchasePrice))
 	builder.WriteString(", ")
@@ -200,9 +284,46 @@ func (pr *Product) String() string {
 	builder.WriteString("stock=")
 	builder.WriteString(fmt.Sprintf("%v", pr.Stock))
 	builder.WriteString(", ")
+	builder.WriteString("base_unit=")
+	builder.WriteString(pr.BaseUnit)
+	builder.WriteString(", ")
+	builder.WriteString("fractional=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Fractional))
+	builder.WriteString(", ")
+	builder.WriteString("bundle=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Bundle))
+	builder.WriteString(", ")
+	builder.WriteString("revenue_split=")
+	builder.WriteString(pr.RevenueSplit)
+	builder.WriteString(", ")
 	builder.WriteString("sku=")
 	builder.WriteString(pr.Sku)
 	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(pr.Category)
+	builder.WriteString(", ")
+	if v := pr.TaxRateID; v != nil {
+		builder.WriteString("tax_rate_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("track_lots=")
+	builder.WriteString(fmt.Sprintf("%v", pr.TrackLots))
+	builder.WriteString(", ")
+	builder.WriteString("serialized=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Serialized))
+	builder.WriteString(", ")
+	builder.WriteString("warranty_months=")
+	builder.WriteString(fmt.Sprintf("%v", pr.WarrantyMonths))
+	builder.WriteString(", ")
+	builder.WriteString("archived=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Archived))
+	builder.WriteString(", ")
+	if v := pr.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("tenant_id=")
 	builder.WriteString(fmt.Sprintf("%v", pr.TenantID))
 	builder.WriteString(", ")
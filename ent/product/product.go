@@ -3,6 +3,7 @@
 package product
 
 import (
+	"Veritasbackend/pkg/money"
 	"time"
 )
 
@@ -15,8 +16,6 @@ const (
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
-	// FieldPrice holds the string denoting the price field in the database.
-	FieldPrice = "price"
 	// FieldPurchasePrice holds the string denoting the purchase_price field in the database.
 	FieldPurchasePrice = "purchase_price"
 	// FieldRetailPrice holds the string denoting the retail_price field in the database.
@@ -27,8 +26,30 @@ const (
 	FieldMinWholesaleQuantity = "min_wholesale_quantity"
 	// FieldStock holds the string denoting the stock field in the database.
 	FieldStock = "stock"
+	// FieldBaseUnit holds the string denoting the base_unit field in the database.
+	FieldBaseUnit = "base_unit"
+	// FieldFractional holds the string denoting the fractional field in the database.
+	FieldFractional = "fractional"
+	// FieldBundle holds the string denoting the bundle field in the database.
+	FieldBundle = "bundle"
+	// FieldRevenueSplit holds the string denoting the revenue_split field in the database.
+	FieldRevenueSplit = "revenue_split"
 	// FieldSku holds the string denoting the sku field in the database.
 	FieldSku = "sku"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldTaxRateID holds the string denoting the tax_rate_id field in the database.
+	FieldTaxRateID = "tax_rate_id"
+	// FieldTrackLots holds the string denoting the track_lots field in the database.
+	FieldTrackLots = "track_lots"
+	// FieldSerialized holds the string denoting the serialized field in the database.
+	FieldSerialized = "serialized"
+	// FieldWarrantyMonths holds the string denoting the warranty_months field in the database.
+	FieldWarrantyMonths = "warranty_months"
+	// FieldArchived holds the string denoting the archived field in the database.
+	FieldArchived = "archived"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldTenantID holds the string denoting the tenant_id field in the database.
 	FieldTenantID = "tenant_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -44,13 +65,23 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldDescription,
-	FieldPrice,
 	FieldPurchasePrice,
 	FieldRetailPrice,
 	FieldWholesalePrice,
 	FieldMinWholesaleQuantity,
 	FieldStock,
+	FieldBaseUnit,
+	FieldFractional,
+	FieldBundle,
+	FieldRevenueSplit,
 	FieldSku,
+	FieldCategory,
+	FieldTaxRateID,
+	FieldTrackLots,
+	FieldSerialized,
+	FieldWarrantyMonths,
+	FieldArchived,
+	FieldDeletedAt,
 	FieldTenantID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -69,24 +100,40 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
-	// PriceValidator is a validator for the "price" field. It is called by the builders before save.
-	PriceValidator func(float64) error
 	// DefaultPurchasePrice holds the default value on creation for the "purchase_price" field.
-	DefaultPurchasePrice float64
+	DefaultPurchasePrice money.Amount
 	// PurchasePriceValidator is a validator for the "purchase_price" field. It is called by the builders before save.
-	PurchasePriceValidator func(float64) error
+	PurchasePriceValidator func(int64) error
 	// DefaultRetailPrice holds the default value on creation for the "retail_price" field.
-	DefaultRetailPrice float64
+	DefaultRetailPrice money.Amount
 	// RetailPriceValidator is a validator for the "retail_price" field. It is called by the builders before save.
-	RetailPriceValidator func(float64) error
+	RetailPriceValidator func(int64) error
 	// WholesalePriceValidator is a validator for the "wholesale_price" field. It is called by the builders before save.
-	WholesalePriceValidator func(float64) error
+	WholesalePriceValidator func(int64) error
 	// MinWholesaleQuantityValidator is a validator for the "min_wholesale_quantity" field. It is called by the builders before save.
 	MinWholesaleQuantityValidator func(int) error
 	// DefaultStock holds the default value on creation for the "stock" field.
-	DefaultStock int
+	DefaultStock float64
 	// StockValidator is a validator for the "stock" field. It is called by the builders before save.
-	StockValidator func(int) error
+	StockValidator func(float64) error
+	// DefaultBaseUnit holds the default value on creation for the "base_unit" field.
+	DefaultBaseUnit string
+	// DefaultFractional holds the default value on creation for the "fractional" field.
+	DefaultFractional bool
+	// DefaultBundle holds the default value on creation for the "bundle" field.
+	DefaultBundle bool
+	// DefaultRevenueSplit holds the default value on creation for the "revenue_split" field.
+	DefaultRevenueSplit string
+	// DefaultTrackLots holds the default value on creation for the "track_lots" field.
+	DefaultTrackLots bool
+	// DefaultSerialized holds the default value on creation for the "serialized" field.
+	DefaultSerialized bool
+	// DefaultWarrantyMonths holds the default value on creation for the "warranty_months" field.
+	DefaultWarrantyMonths int
+	// WarrantyMonthsValidator is a validator for the "warranty_months" field. It is called by the builders before save.
+	WarrantyMonthsValidator func(int) error
+	// DefaultArchived holds the default value on creation for the "archived" field.
+	DefaultArchived bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
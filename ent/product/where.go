@@ -4,6 +4,7 @@ package product
 
 import (
 	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -94,29 +95,22 @@ func Description(v string) predicate.Product {
 	})
 }
 
-// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
-func Price(v float64) predicate.Product {
-	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldPrice), v))
-	})
-}
-
 // PurchasePrice applies equality check predicate on the "purchase_price" field. It's identical to PurchasePriceEQ.
-func PurchasePrice(v float64) predicate.Product {
+func PurchasePrice(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldPurchasePrice), v))
 	})
 }
 
 // RetailPrice applies equality check predicate on the "retail_price" field. It's identical to RetailPriceEQ.
-func RetailPrice(v float64) predicate.Product {
+func RetailPrice(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldRetailPrice), v))
 	})
 }
 
 // WholesalePrice applies equality check predicate on the "wholesale_price" field. It's identical to WholesalePriceEQ.
-func WholesalePrice(v float64) predicate.Product {
+func WholesalePrice(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldWholesalePrice), v))
 	})
@@ -130,12 +124,40 @@ func MinWholesaleQuantity(v int) predicate.Product {
 }
 
 // Stock applies equality check predicate on the "stock" field. It's identical to StockEQ.
-func Stock(v int) predicate.Product {
+func Stock(v float64) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldStock), v))
 	})
 }
 
+// BaseUnit applies equality check predicate on the "base_unit" field. It's identical to BaseUnitEQ.
+func BaseUnit(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseUnit), v))
+	})
+}
+
+// Fractional applies equality check predicate on the "fractional" field. It's identical to FractionalEQ.
+func Fractional(v bool) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFractional), v))
+	})
+}
+
+// Bundle applies equality check predicate on the "bundle" field. It's identical to BundleEQ.
+func Bundle(v bool) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBundle), v))
+	})
+}
+
+// RevenueSplit applies equality check predicate on the "revenue_split" field. It's identical to RevenueSplitEQ.
+func RevenueSplit(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevenueSplit), v))
+	})
+}
+
 // Sku applies equality check predicate on the "sku" field. It's identical to SkuEQ.
 func Sku(v string) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
@@ -143,6 +165,55 @@ func Sku(v string) predicate.Product {
 	})
 }
 
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCategory), v))
+	})
+}
+
+// TaxRateID applies equality check predicate on the "tax_rate_id" field. It's identical to TaxRateIDEQ.
+func TaxRateID(v int) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TrackLots applies equality check predicate on the "track_lots" field. It's identical to TrackLotsEQ.
+func TrackLots(v bool) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTrackLots), v))
+	})
+}
+
+// Serialized applies equality check predicate on the "serialized" field. It's identical to SerializedEQ.
+func Serialized(v bool) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSerialized), v))
+	})
+}
+
+// WarrantyMonths applies equality check predicate on the "warranty_months" field. It's identical to WarrantyMonthsEQ.
+func WarrantyMonths(v int) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWarrantyMonths), v))
+	})
+}
+
+// Archived applies equality check predicate on the "archived" field. It's identical to ArchivedEQ.
+func Archived(v bool) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldArchived), v))
+	})
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
+	})
+}
+
 // TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
 func TenantID(v int) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
@@ -400,98 +471,22 @@ func DescriptionContainsFold(v string) predicate.Product {
 	})
 }
 
-// PriceEQ applies the EQ predicate on the "price" field.
-func PriceEQ(v float64) predicate.Product {
-	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldPrice), v))
-	})
-}
-
-// PriceNEQ applies the NEQ predicate on the "price" field.
-func PriceNEQ(v float64) predicate.Product {
-	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldPrice), v))
-	})
-}
-
-// PriceIn applies the In predicate on the "price" field.
-func PriceIn(vs ...float64) predicate.Product {
-	v := make([]interface{}, len(vs))
-	for i := range v {
-		v[i] = vs[i]
-	}
-	return predicate.Product(func(s *sql.Selector) {
-		// if not arguments were provided, append the FALSE constants,
-		// since we can't apply "IN ()". This will make this predicate falsy.
-		if len(v) == 0 {
-			s.Where(sql.False())
-			return
-		}
-		s.Where(sql.In(s.C(FieldPrice), v...))
-	})
-}
-
-// PriceNotIn applies the NotIn predicate on the "price" field.
-func PriceNotIn(vs ...float64) predicate.Product {
-	v := make([]interface{}, len(vs))
-	for i := range v {
-		v[i] = vs[i]
-	}
-	return predicate.Product(func(s *sql.Selector) {
-		// if not arguments were provided, append the FALSE constants,
-		// since we can't apply "IN ()". This will make this predicate falsy.
-		if len(v) == 0 {
-			s.Where(sql.False())
-			return
-		}
-		s.Where(sql.NotIn(s.C(FieldPrice), v...))
-	})
-}
-
-// PriceGT applies the GT predicate on the "price" field.
-func PriceGT(v float64) predicate.Product {
-	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.GT(s.C(FieldPrice), v))
-	})
-}
-
-// PriceGTE applies the GTE predicate on the "price" field.
-func PriceGTE(v float64) predicate.Product {
-	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.GTE(s.C(FieldPrice), v))
-	})
-}
-
-// PriceLT applies the LT predicate on the "price" field.
-func PriceLT(v float64) predicate.Product {
-	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.LT(s.C(FieldPrice), v))
-	})
-}
-
-// PriceLTE applies the LTE predicate on the "price" field.
-func PriceLTE(v float64) predicate.Product {
-	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.LTE(s.C(FieldPrice), v))
-	})
-}
-
 // PurchasePriceEQ applies the EQ predicate on the "purchase_price" field.
-func PurchasePriceEQ(v float64) predicate.Product {
+func PurchasePriceEQ(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldPurchasePrice), v))
 	})
 }
 
 // PurchasePriceNEQ applies the NEQ predicate on the "purchase_price" field.
-func PurchasePriceNEQ(v float64) predicate.Product {
+func PurchasePriceNEQ(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.NEQ(s.C(FieldPurchasePrice), v))
 	})
 }
 
 // PurchasePriceIn applies the In predicate on the "purchase_price" field.
-func PurchasePriceIn(vs ...float64) predicate.Product {
+func PurchasePriceIn(vs ...money.Amount) predicate.Product {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -508,7 +503,7 @@ func PurchasePriceIn(vs ...float64) predicate.Product {
 }
 
 // PurchasePriceNotIn applies the NotIn predicate on the "purchase_price" field.
-func PurchasePriceNotIn(vs ...float64) predicate.Product {
+func PurchasePriceNotIn(vs ...money.Amount) predicate.Product {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -525,49 +520,49 @@ func PurchasePriceNotIn(vs ...float64) predicate.Product {
 }
 
 // PurchasePriceGT applies the GT predicate on the "purchase_price" field.
-func PurchasePriceGT(v float64) predicate.Product {
+func PurchasePriceGT(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.GT(s.C(FieldPurchasePrice), v))
 	})
 }
 
 // PurchasePriceGTE applies the GTE predicate on the "purchase_price" field.
-func PurchasePriceGTE(v float64) predicate.Product {
+func PurchasePriceGTE(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.GTE(s.C(FieldPurchasePrice), v))
 	})
 }
 
 // PurchasePriceLT applies the LT predicate on the "purchase_price" field.
-func PurchasePriceLT(v float64) predicate.Product {
+func PurchasePriceLT(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.LT(s.C(FieldPurchasePrice), v))
 	})
 }
 
 // PurchasePriceLTE applies the LTE predicate on the "purchase_price" field.
-func PurchasePriceLTE(v float64) predicate.Product {
+func PurchasePriceLTE(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.LTE(s.C(FieldPurchasePrice), v))
 	})
 }
 
 // RetailPriceEQ applies the EQ predicate on the "retail_price" field.
-func RetailPriceEQ(v float64) predicate.Product {
+func RetailPriceEQ(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldRetailPrice), v))
 	})
 }
 
 // RetailPriceNEQ applies the NEQ predicate on the "retail_price" field.
-func RetailPriceNEQ(v float64) predicate.Product {
+func RetailPriceNEQ(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.NEQ(s.C(FieldRetailPrice), v))
 	})
 }
 
 // RetailPriceIn applies the In predicate on the "retail_price" field.
-func RetailPriceIn(vs ...float64) predicate.Product {
+func RetailPriceIn(vs ...money.Amount) predicate.Product {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -584,7 +579,7 @@ func RetailPriceIn(vs ...float64) predicate.Product {
 }
 
 // RetailPriceNotIn applies the NotIn predicate on the "retail_price" field.
-func RetailPriceNotIn(vs ...float64) predicate.Product {
+func RetailPriceNotIn(vs ...money.Amount) predicate.Product {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -601,49 +596,49 @@ func RetailPriceNotIn(vs ...float64) predicate.Product {
 }
 
 // RetailPriceGT applies the GT predicate on the "retail_price" field.
-func RetailPriceGT(v float64) predicate.Product {
+func RetailPriceGT(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.GT(s.C(FieldRetailPrice), v))
 	})
 }
 
 // RetailPriceGTE applies the GTE predicate on the "retail_price" field.
-func RetailPriceGTE(v float64) predicate.Product {
+func RetailPriceGTE(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.GTE(s.C(FieldRetailPrice), v))
 	})
 }
 
 // RetailPriceLT applies the LT predicate on the "retail_price" field.
-func RetailPriceLT(v float64) predicate.Product {
+func RetailPriceLT(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.LT(s.C(FieldRetailPrice), v))
 	})
 }
 
 // RetailPriceLTE applies the LTE predicate on the "retail_price" field.
-func RetailPriceLTE(v float64) predicate.Product {
+func RetailPriceLTE(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.LTE(s.C(FieldRetailPrice), v))
 	})
 }
 
 // WholesalePriceEQ applies the EQ predicate on the "wholesale_price" field.
-func WholesalePriceEQ(v float64) predicate.Product {
+func WholesalePriceEQ(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldWholesalePrice), v))
 	})
 }
 
 // WholesalePriceNEQ applies the NEQ predicate on the "wholesale_price" field.
-func WholesalePriceNEQ(v float64) predicate.Product {
+func WholesalePriceNEQ(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.NEQ(s.C(FieldWholesalePrice), v))
 	})
 }
 
 // WholesalePriceIn applies the In predicate on the "wholesale_price" field.
-func WholesalePriceIn(vs ...float64) predicate.Product {
+func WholesalePriceIn(vs ...money.Amount) predicate.Product {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -660,7 +655,7 @@ func WholesalePriceIn(vs ...float64) predicate.Product {
 }
 
 // WholesalePriceNotIn applies the NotIn predicate on the "wholesale_price" field.
-func WholesalePriceNotIn(vs ...float64) predicate.Product {
+func WholesalePriceNotIn(vs ...money.Amount) predicate.Product {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -677,28 +672,28 @@ func WholesalePriceNotIn(vs ...float64) predicate.Product {
 }
 
 // WholesalePriceGT applies the GT predicate on the "wholesale_price" field.
-func WholesalePriceGT(v float64) predicate.Product {
+func WholesalePriceGT(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.GT(s.C(FieldWholesalePrice), v))
 	})
 }
 
 // WholesalePriceGTE applies the GTE predicate on the "wholesale_price" field.
-func WholesalePriceGTE(v float64) predicate.Product {
+func WholesalePriceGTE(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.GTE(s.C(FieldWholesalePrice), v))
 	})
 }
 
 // WholesalePriceLT applies the LT predicate on the "wholesale_price" field.
-func WholesalePriceLT(v float64) predicate.Product {
+func WholesalePriceLT(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.LT(s.C(FieldWholesalePrice), v))
 	})
 }
 
 // WholesalePriceLTE applies the LTE predicate on the "wholesale_price" field.
-func WholesalePriceLTE(v float64) predicate.Product {
+func WholesalePriceLTE(v money.Amount) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.LTE(s.C(FieldWholesalePrice), v))
 	})
@@ -809,21 +804,21 @@ func MinWholesaleQuantityNotNil() predicate.Product {
 }
 
 // StockEQ applies the EQ predicate on the "stock" field.
-func StockEQ(v int) predicate.Product {
+func StockEQ(v float64) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldStock), v))
 	})
 }
 
 // StockNEQ applies the NEQ predicate on the "stock" field.
-func StockNEQ(v int) predicate.Product {
+func StockNEQ(v float64) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.NEQ(s.C(FieldStock), v))
 	})
 }
 
 // StockIn applies the In predicate on the "stock" field.
-func StockIn(vs ...int) predicate.Product {
+func StockIn(vs ...float64) predicate.Product {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -840,7 +835,7 @@ func StockIn(vs ...int) predicate.Product {
 }
 
 // StockNotIn applies the NotIn predicate on the "stock" field.
-func StockNotIn(vs ...int) predicate.Product {
+func StockNotIn(vs ...float64) predicate.Product {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -857,49 +852,49 @@ func StockNotIn(vs ...int) predicate.Product {
 }
 
 // StockGT applies the GT predicate on the "stock" field.
-func StockGT(v int) predicate.Product {
+func StockGT(v float64) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.GT(s.C(FieldStock), v))
 	})
 }
 
 // StockGTE applies the GTE predicate on the "stock" field.
-func StockGTE(v int) predicate.Product {
+func StockGTE(v float64) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.GTE(s.C(FieldStock), v))
 	})
 }
 
 // StockLT applies the LT predicate on the "stock" field.
-func StockLT(v int) predicate.Product {
+func StockLT(v float64) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.LT(s.C(FieldStock), v))
 	})
 }
 
 // StockLTE applies the LTE predicate on the "stock" field.
-func StockLTE(v int) predicate.Product {
+func StockLTE(v float64) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
 		s.Where(sql.LTE(s.C(FieldStock), v))
 	})
 }
 
-// SkuEQ applies the EQ predicate on the "sku" field.
-func SkuEQ(v string) predicate.Product {
+// BaseUnitEQ applies the EQ predicate on the "base_unit" field.
+func BaseUnitEQ(v string) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldSku), v))
+		s.Where(sql.EQ(s.C(FieldBaseUnit), v))
 	})
 }
 
-// SkuNEQ applies the NEQ predicate on the "sku" field.
-func SkuNEQ(v string) predicate.Product {
+// BaseUnitNEQ applies the NEQ predicate on the "base_unit" field.
+func BaseUnitNEQ(v string) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldSku), v))
+		s.Where(sql.NEQ(s.C(FieldBaseUnit), v))
 	})
 }
 
-// SkuIn applies the In predicate on the "sku" field.
-func SkuIn(vs ...string) predicate.Product {
+// BaseUnitIn applies the In predicate on the "base_unit" field.
+func BaseUnitIn(vs ...string) predicate.Product {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -911,12 +906,12 @@ func SkuIn(vs ...string) predicate.Product {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.In(s.C(FieldSku), v...))
+		s.Where(sql.In(s.C(FieldBaseUnit), v...))
 	})
 }
 
-// SkuNotIn applies the NotIn predicate on the "sku" field.
-func SkuNotIn(vs ...string) predicate.Product {
+// BaseUnitNotIn applies the NotIn predicate on the "base_unit" field.
+func BaseUnitNotIn(vs ...string) predicate.Product {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -928,84 +923,757 @@ func SkuNotIn(vs ...string) predicate.Product {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.NotIn(s.C(FieldSku), v...))
+		s.Where(sql.NotIn(s.C(FieldBaseUnit), v...))
 	})
 }
 
-// SkuGT applies the GT predicate on the "sku" field.
-func SkuGT(v string) predicate.Product {
+// BaseUnitGT applies the GT predicate on the "base_unit" field.
+func BaseUnitGT(v string) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.GT(s.C(FieldSku), v))
+		s.Where(sql.GT(s.C(FieldBaseUnit), v))
 	})
 }
 
-// SkuGTE applies the GTE predicate on the "sku" field.
-func SkuGTE(v string) predicate.Product {
+// BaseUnitGTE applies the GTE predicate on the "base_unit" field.
+func BaseUnitGTE(v string) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.GTE(s.C(FieldSku), v))
+		s.Where(sql.GTE(s.C(FieldBaseUnit), v))
 	})
 }
 
-// SkuLT applies the LT predicate on the "sku" field.
-func SkuLT(v string) predicate.Product {
+// BaseUnitLT applies the LT predicate on the "base_unit" field.
+func BaseUnitLT(v string) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.LT(s.C(FieldSku), v))
+		s.Where(sql.LT(s.C(FieldBaseUnit), v))
 	})
 }
 
-// SkuLTE applies the LTE predicate on the "sku" field.
-func SkuLTE(v string) predicate.Product {
+// BaseUnitLTE applies the LTE predicate on the "base_unit" field.
+func BaseUnitLTE(v string) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.LTE(s.C(FieldSku), v))
+		s.Where(sql.LTE(s.C(FieldBaseUnit), v))
 	})
 }
 
-// SkuContains applies the Contains predicate on the "sku" field.
-func SkuContains(v string) predicate.Product {
+// BaseUnitContains applies the Contains predicate on the "base_unit" field.
+func BaseUnitContains(v string) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.Contains(s.C(FieldSku), v))
+		s.Where(sql.Contains(s.C(FieldBaseUnit), v))
 	})
 }
 
-// SkuHasPrefix applies the HasPrefix predicate on the "sku" field.
-func SkuHasPrefix(v string) predicate.Product {
+// BaseUnitHasPrefix applies the HasPrefix predicate on the "base_unit" field.
+func BaseUnitHasPrefix(v string) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.HasPrefix(s.C(FieldSku), v))
+		s.Where(sql.HasPrefix(s.C(FieldBaseUnit), v))
 	})
 }
 
-// SkuHasSuffix applies the HasSuffix predicate on the "sku" field.
-func SkuHasSuffix(v string) predicate.Product {
+// BaseUnitHasSuffix applies the HasSuffix predicate on the "base_unit" field.
+func BaseUnitHasSuffix(v string) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.HasSuffix(s.C(FieldSku), v))
+		s.Where(sql.HasSuffix(s.C(FieldBaseUnit), v))
 	})
 }
 
-// SkuIsNil applies the IsNil predicate on the "sku" field.
-func SkuIsNil() predicate.Product {
+// BaseUnitEqualFold applies the EqualFold predicate on the "base_unit" field.
+func BaseUnitEqualFold(v string) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.IsNull(s.C(FieldSku)))
+		s.Where(sql.EqualFold(s.C(FieldBaseUnit), v))
 	})
 }
 
-// SkuNotNil applies the NotNil predicate on the "sku" field.
-func SkuNotNil() predicate.Product {
+// BaseUnitContainsFold applies the ContainsFold predicate on the "base_unit" field.
+func BaseUnitContainsFold(v string) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.NotNull(s.C(FieldSku)))
+		s.Where(sql.ContainsFold(s.C(FieldBaseUnit), v))
 	})
 }
 
-// SkuEqualFold applies the EqualFold predicate on the "sku" field.
-func SkuEqualFold(v string) predicate.Product {
+// FractionalEQ applies the EQ predicate on the "fractional" field.
+func FractionalEQ(v bool) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.EqualFold(s.C(FieldSku), v))
+		s.Where(sql.EQ(s.C(FieldFractional), v))
 	})
 }
 
-// SkuContainsFold applies the ContainsFold predicate on the "sku" field.
-func SkuContainsFold(v string) predicate.Product {
+// FractionalNEQ applies the NEQ predicate on the "fractional" field.
+func FractionalNEQ(v bool) predicate.Product {
 	return predicate.Product(func(s *sql.Selector) {
-		s.Where(sql.ContainsFold(s.C(FieldSku), v))
+		s.Where(sql.NEQ(s.C(FieldFractional), v))
+	})
+}
+
+// BundleEQ applies the EQ predicate on the "bundle" field.
+func BundleEQ(v bool) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBundle), v))
+	})
+}
+
+// BundleNEQ applies the NEQ predicate on the "bundle" field.
+func BundleNEQ(v bool) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBundle), v))
+	})
+}
+
+// RevenueSplitEQ applies the EQ predicate on the "revenue_split" field.
+func RevenueSplitEQ(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevenueSplit), v))
+	})
+}
+
+// RevenueSplitNEQ applies the NEQ predicate on the "revenue_split" field.
+func RevenueSplitNEQ(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRevenueSplit), v))
+	})
+}
+
+// RevenueSplitIn applies the In predicate on the "revenue_split" field.
+func RevenueSplitIn(vs ...string) predicate.Product {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Product(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRevenueSplit), v...))
+	})
+}
+
+// RevenueSplitNotIn applies the NotIn predicate on the "revenue_split" field.
+func RevenueSplitNotIn(vs ...string) predicate.Product {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Product(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRevenueSplit), v...))
+	})
+}
+
+// RevenueSplitGT applies the GT predicate on the "revenue_split" field.
+func RevenueSplitGT(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRevenueSplit), v))
+	})
+}
+
+// RevenueSplitGTE applies the GTE predicate on the "revenue_split" field.
+func RevenueSplitGTE(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRevenueSplit), v))
+	})
+}
+
+// RevenueSplitLT applies the LT predicate on the "revenue_split" field.
+func RevenueSplitLT(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRevenueSplit), v))
+	})
+}
+
+// RevenueSplitLTE applies the LTE predicate on the "revenue_split" field.
+func RevenueSplitLTE(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRevenueSplit), v))
+	})
+}
+
+// RevenueSplitContains applies the Contains predicate on the "revenue_split" field.
+func RevenueSplitContains(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldRevenueSplit), v))
+	})
+}
+
+// RevenueSplitHasPrefix applies the HasPrefix predicate on the "revenue_split" field.
+func RevenueSplitHasPrefix(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldRevenueSplit), v))
+	})
+}
+
+// RevenueSplitHasSuffix applies the HasSuffix predicate on the "revenue_split" field.
+func RevenueSplitHasSuffix(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldRevenueSplit), v))
+	})
+}
+
+// RevenueSplitEqualFold applies the EqualFold predicate on the "revenue_split" field.
+func RevenueSplitEqualFold(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldRevenueSplit), v))
+	})
+}
+
+// RevenueSplitContainsFold applies the ContainsFold predicate on the "revenue_split" field.
+func RevenueSplitContainsFold(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldRevenueSplit), v))
+	})
+}
+
+// SkuEQ applies the EQ predicate on the "sku" field.
+func SkuEQ(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSku), v))
+	})
+}
+
+// SkuNEQ applies the NEQ predicate on the "sku" field.
+func SkuNEQ(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSku), v))
+	})
+}
+
+// SkuIn applies the In predicate on the "sku" field.
+func SkuIn(vs ...string) predicate.Product {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Product(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSku), v...))
+	})
+}
+
+// SkuNotIn applies the NotIn predicate on the "sku" field.
+func SkuNotIn(vs ...string) predicate.Product {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Product(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSku), v...))
+	})
+}
+
+// SkuGT applies the GT predicate on the "sku" field.
+func SkuGT(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSku), v))
+	})
+}
+
+// SkuGTE applies the GTE predicate on the "sku" field.
+func SkuGTE(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSku), v))
+	})
+}
+
+// SkuLT applies the LT predicate on the "sku" field.
+func SkuLT(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSku), v))
+	})
+}
+
+// SkuLTE applies the LTE predicate on the "sku" field.
+func SkuLTE(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSku), v))
+	})
+}
+
+// SkuContains applies the Contains predicate on the "sku" field.
+func SkuContains(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSku), v))
+	})
+}
+
+// SkuHasPrefix applies the HasPrefix predicate on the "sku" field.
+func SkuHasPrefix(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSku), v))
+	})
+}
+
+// SkuHasSuffix applies the HasSuffix predicate on the "sku" field.
+func SkuHasSuffix(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSku), v))
+	})
+}
+
+// SkuIsNil applies the IsNil predicate on the "sku" field.
+func SkuIsNil() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSku)))
+	})
+}
+
+// SkuNotNil applies the NotNil predicate on the "sku" field.
+func SkuNotNil() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSku)))
+	})
+}
+
+// SkuEqualFold applies the EqualFold predicate on the "sku" field.
+func SkuEqualFold(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSku), v))
+	})
+}
+
+// SkuContainsFold applies the ContainsFold predicate on the "sku" field.
+func SkuContainsFold(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSku), v))
+	})
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.Product {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Product(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCategory), v...))
+	})
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.Product {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Product(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCategory), v...))
+	})
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryIsNil applies the IsNil predicate on the "category" field.
+func CategoryIsNil() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCategory)))
+	})
+}
+
+// CategoryNotNil applies the NotNil predicate on the "category" field.
+func CategoryNotNil() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCategory)))
+	})
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCategory), v))
+	})
+}
+
+// TaxRateIDEQ applies the EQ predicate on the "tax_rate_id" field.
+func TaxRateIDEQ(v int) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDNEQ applies the NEQ predicate on the "tax_rate_id" field.
+func TaxRateIDNEQ(v int) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDIn applies the In predicate on the "tax_rate_id" field.
+func TaxRateIDIn(vs ...int) predicate.Product {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Product(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxRateID), v...))
+	})
+}
+
+// TaxRateIDNotIn applies the NotIn predicate on the "tax_rate_id" field.
+func TaxRateIDNotIn(vs ...int) predicate.Product {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Product(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxRateID), v...))
+	})
+}
+
+// TaxRateIDGT applies the GT predicate on the "tax_rate_id" field.
+func TaxRateIDGT(v int) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDGTE applies the GTE predicate on the "tax_rate_id" field.
+func TaxRateIDGTE(v int) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDLT applies the LT predicate on the "tax_rate_id" field.
+func TaxRateIDLT(v int) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDLTE applies the LTE predicate on the "tax_rate_id" field.
+func TaxRateIDLTE(v int) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDIsNil applies the IsNil predicate on the "tax_rate_id" field.
+func TaxRateIDIsNil() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTaxRateID)))
+	})
+}
+
+// TaxRateIDNotNil applies the NotNil predicate on the "tax_rate_id" field.
+func TaxRateIDNotNil() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTaxRateID)))
+	})
+}
+
+// TrackLotsEQ applies the EQ predicate on the "track_lots" field.
+func TrackLotsEQ(v bool) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTrackLots), v))
+	})
+}
+
+// TrackLotsNEQ applies the NEQ predicate on the "track_lots" field.
+func TrackLotsNEQ(v bool) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTrackLots), v))
+	})
+}
+
+// SerializedEQ applies the EQ predicate on the "serialized" field.
+func SerializedEQ(v bool) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSerialized), v))
+	})
+}
+
+// SerializedNEQ applies the NEQ predicate on the "serialized" field.
+func SerializedNEQ(v bool) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSerialized), v))
+	})
+}
+
+// WarrantyMonthsEQ applies the EQ predicate on the "warranty_months" field.
+func WarrantyMonthsEQ(v int) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWarrantyMonths), v))
+	})
+}
+
+// WarrantyMonthsNEQ applies the NEQ predicate on the "warranty_months" field.
+func WarrantyMonthsNEQ(v int) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWarrantyMonths), v))
+	})
+}
+
+// WarrantyMonthsIn applies the In predicate on the "warranty_months" field.
+func WarrantyMonthsIn(vs ...int) predicate.Product {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Product(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldWarrantyMonths), v...))
+	})
+}
+
+// WarrantyMonthsNotIn applies the NotIn predicate on the "warranty_months" field.
+func WarrantyMonthsNotIn(vs ...int) predicate.Product {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Product(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldWarrantyMonths), v...))
+	})
+}
+
+// WarrantyMonthsGT applies the GT predicate on the "warranty_months" field.
+func WarrantyMonthsGT(v int) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWarrantyMonths), v))
+	})
+}
+
+// WarrantyMonthsGTE applies the GTE predicate on the "warranty_months" field.
+func WarrantyMonthsGTE(v int) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWarrantyMonths), v))
+	})
+}
+
+// WarrantyMonthsLT applies the LT predicate on the "warranty_months" field.
+func WarrantyMonthsLT(v int) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWarrantyMonths), v))
+	})
+}
+
+// WarrantyMonthsLTE applies the LTE predicate on the "warranty_months" field.
+func WarrantyMonthsLTE(v int) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWarrantyMonths), v))
+	})
+}
+
+// ArchivedEQ applies the EQ predicate on the "archived" field.
+func ArchivedEQ(v bool) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldArchived), v))
+	})
+}
+
+// ArchivedNEQ applies the NEQ predicate on the "archived" field.
+func ArchivedNEQ(v bool) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldArchived), v))
+	})
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Product {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Product(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Product {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Product(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDeletedAt)))
+	})
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDeletedAt)))
 	})
 }
 
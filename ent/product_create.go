@@ -4,6 +4,7 @@ package ent
 
 import (
 	"Veritasbackend/ent/product"
+	"Veritasbackend/pkg/money"
 	"context"
 	"errors"
 	"fmt"
@@ -40,50 +41,44 @@ func (pc *ProductCreate) SetNillableDescription(s *string) *ProductCreate {
 	return pc
 }
 
-// SetPrice sets the "price" field.
-func (pc *ProductCreate) SetPrice(f float64) *ProductCreate {
-	pc.mutation.SetPrice(f)
-	return pc
-}
-
 // SetPurchasePrice sets the "purchase_price" field.
-func (pc *ProductCreate) SetPurchasePrice(f float64) *ProductCreate {
-	pc.mutation.SetPurchasePrice(f)
+func (pc *ProductCreate) SetPurchasePrice(m money.Amount) *ProductCreate {
+	pc.mutation.SetPurchasePrice(m)
 	return pc
 }
 
 // SetNillablePurchasePrice sets the "purchase_price" field if the given value is not nil.
-func (pc *ProductCreate) SetNillablePurchasePrice(f *float64) *ProductCreate {
-	if f != nil {
-		pc.SetPurchasePrice(*f)
+func (pc *ProductCreate) SetNillablePurchasePrice(m *money.Amount) *ProductCreate {
+	if m != nil {
+		pc.SetPurchasePrice(*m)
 	}
 	return pc
 }
 
 // SetRetailPrice sets the "retail_price" field.
-func (pc *ProductCreate) SetRetailPrice(f float64) *ProductCreate {
-	pc.mutation.SetRetailPrice(f)
+func (pc *ProductCreate) SetRetailPrice(m money.Amount) *ProductCreate {
+	pc.mutation.SetRetailPrice(m)
 	return pc
 }
 
 // SetNillableRetailPrice sets the "retail_price" field if the given value is not nil.
-func (pc *ProductCreate) SetNillableRetailPrice(f *float64) *ProductCreate {
-	if f != nil {
-		pc.SetRetailPrice(*f)
+func (pc *ProductCreate) SetNillableRetailPrice(m *money.Amount) *ProductCreate {
+	if m != nil {
+		pc.SetRetailPrice(*m)
 	}
 	return pc
 }
 
 // SetWholesalePrice sets the "wholesale_price" field.
-func (pc *ProductCreate) SetWholesalePrice(f float64) *ProductCreate {
-	pc.mutation.SetWholesalePrice(f)
+func (pc *ProductCreate) SetWholesalePrice(m money.Amount) *ProductCreate {
+	pc.mutation.SetWholesalePrice(m)
 	return pc
 }
 
 // SetNillableWholesalePrice sets the "wholesale_price" field if the given value is not nil.
-func (pc *ProductCreate) SetNillableWholesalePrice(f *float64) *ProductCreate {
-	if f != nil {
-		pc.SetWholesalePrice(*f)
+func (pc *ProductCreate) SetNillableWholesalePrice(m *money.Amount) *ProductCreate {
+	if m != nil {
+		pc.SetWholesalePrice(*m)
 	}
 	return pc
 }
@@ -103,15 +98,71 @@ func (pc *ProductCreate) SetNillableMinWholesaleQuantity(i *int) *ProductCreate
 }
 
 // SetStock sets the "stock" field.
-func (pc *ProductCreate) SetStock(i int) *ProductCreate {
-	pc.mutation.SetStock(i)
+func (pc *ProductCreate) SetStock(f float64) *ProductCreate {
+	pc.mutation.SetStock(f)
 	return pc
 }
 
 // SetNillableStock sets the "stock" field if the given value is not nil.
-func (pc *ProductCreate) SetNillableStock(i *int) *ProductCreate {
-	if i != nil {
-		pc.SetStock(*i)
+func (pc *ProductCreate) SetNillableStock(f *float64) *ProductCreate {
+	if f != nil {
+		pc.SetStock(*f)
+	}
+	return pc
+}
+
+// SetBaseUnit sets the "base_unit" field.
+func (pc *ProductCreate) SetBaseUnit(s string) *ProductCreate {
+	pc.mutation.SetBaseUnit(s)
+	return pc
+}
+
+// SetNillableBaseUnit sets the "base_unit" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableBaseUnit(s *string) *ProductCreate {
+	if s != nil {
+		pc.SetBaseUnit(*s)
+	}
+	return pc
+}
+
+// SetFractional sets the "fractional" field.
+func (pc *ProductCreate) SetFractional(b bool) *ProductCreate {
+	pc.mutation.SetFractional(b)
+	return pc
+}
+
+// SetNillableFractional sets the "fractional" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableFractional(b *bool) *ProductCreate {
+	if b != nil {
+		pc.SetFractional(*b)
+	}
+	return pc
+}
+
+// SetBundle sets the "bundle" field.
+func (pc *ProductCreate) SetBundle(b bool) *ProductCreate {
+	pc.mutation.SetBundle(b)
+	return pc
+}
+
+// SetNillableBundle sets the "bundle" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableBundle(b *bool) *ProductCreate {
+	if b != nil {
+		pc.SetBundle(*b)
+	}
+	return pc
+}
+
+// SetRevenueSplit sets the "revenue_split" field.
+func (pc *ProductCreate) SetRevenueSplit(s string) *ProductCreate {
+	pc.mutation.SetRevenueSplit(s)
+	return pc
+}
+
+// SetNillableRevenueSplit sets the "revenue_split" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableRevenueSplit(s *string) *ProductCreate {
+	if s != nil {
+		pc.SetRevenueSplit(*s)
 	}
 	return pc
 }
@@ -130,6 +181,104 @@ func (pc *ProductCreate) SetNillableSku(s *string) *ProductCreate {
 	return pc
 }
 
+// SetCategory sets the "category" field.
+func (pc *ProductCreate) SetCategory(s string) *ProductCreate {
+	pc.mutation.SetCategory(s)
+	return pc
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableCategory(s *string) *ProductCreate {
+	if s != nil {
+		pc.SetCategory(*s)
+	}
+	return pc
+}
+
+// SetTaxRateID sets the "tax_rate_id" field.
+func (pc *ProductCreate) SetTaxRateID(i int) *ProductCreate {
+	pc.mutation.SetTaxRateID(i)
+	return pc
+}
+
+// SetNillableTaxRateID sets the "tax_rate_id" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableTaxRateID(i *int) *ProductCreate {
+	if i != nil {
+		pc.SetTaxRateID(*i)
+	}
+	return pc
+}
+
+// SetTrackLots sets the "track_lots" field.
+func (pc *ProductCreate) SetTrackLots(b bool) *ProductCreate {
+	pc.mutation.SetTrackLots(b)
+	return pc
+}
+
+// SetNillableTrackLots sets the "track_lots" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableTrackLots(b *bool) *ProductCreate {
+	if b != nil {
+		pc.SetTrackLots(*b)
+	}
+	return pc
+}
+
+// SetSerialized sets the "serialized" field.
+func (pc *ProductCreate) SetSerialized(b bool) *ProductCreate {
+	pc.mutation.SetSerialized(b)
+	return pc
+}
+
+// SetNillableSerialized sets the "serialized" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableSerialized(b *bool) *ProductCreate {
+	if b != nil {
+		pc.SetSerialized(*b)
+	}
+	return pc
+}
+
+// SetWarrantyMonths sets the "warranty_months" field.
+func (pc *ProductCreate) SetWarrantyMonths(i int) *ProductCreate {
+	pc.mutation.SetWarrantyMonths(i)
+	return pc
+}
+
+// SetNillableWarrantyMonths sets the "warranty_months" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableWarrantyMonths(i *int) *ProductCreate {
+	if i != nil {
+		pc.SetWarrantyMonths(*i)
+	}
+	return pc
+}
+
+// SetArchived sets the "archived" field.
+func (pc *ProductCreate) SetArchived(b bool) *ProductCreate {
+	pc.mutation.SetArchived(b)
+	return pc
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableArchived(b *bool) *ProductCreate {
+	if b != nil {
+		pc.SetArchived(*b)
+	}
+	return pc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pc *ProductCreate) SetDeletedAt(t time.Time) *ProductCreate {
+	pc.mutation.SetDeletedAt(t)
+	return pc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableDeletedAt(t *time.Time) *ProductCreate {
+	if t != nil {
+		pc.SetDeletedAt(*t)
+	}
+	return pc
+}
+
 // SetTenantID sets the "tenant_id" field.
 func (pc *ProductCreate) SetTenantID(i int) *ProductCreate {
 	pc.mutation.SetTenantID(i)
@@ -253,6 +402,38 @@ func (pc *ProductCreate) defaults() {
 		v := product.DefaultStock
 		pc.mutation.SetStock(v)
 	}
+	if _, ok := pc.mutation.BaseUnit(); !ok {
+		v := product.DefaultBaseUnit
+		pc.mutation.SetBaseUnit(v)
+	}
+	if _, ok := pc.mutation.Fractional(); !ok {
+		v := product.DefaultFractional
+		pc.mutation.SetFractional(v)
+	}
+	if _, ok := pc.mutation.Bundle(); !ok {
+		v := product.DefaultBundle
+		pc.mutation.SetBundle(v)
+	}
+	if _, ok := pc.mutation.RevenueSplit(); !ok {
+		v := product.DefaultRevenueSplit
+		pc.mutation.SetRevenueSplit(v)
+	}
+	if _, ok := pc.mutation.TrackLots(); !ok {
+		v := product.DefaultTrackLots
+		pc.mutation.SetTrackLots(v)
+	}
+	if _, ok := pc.mutation.Serialized(); !ok {
+		v := product.DefaultSerialized
+		pc.mutation.SetSerialized(v)
+	}
+	if _, ok := pc.mutation.WarrantyMonths(); !ok {
+		v := product.DefaultWarrantyMonths
+		pc.mutation.SetWarrantyMonths(v)
+	}
+	if _, ok := pc.mutation.Archived(); !ok {
+		v := product.DefaultArchived
+		pc.mutation.SetArchived(v)
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		v := product.DefaultCreatedAt()
 		pc.mutation.SetCreatedAt(v)
@@ -273,19 +454,11 @@ func (pc *ProductCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Product.name": %w`, err)}
 		}
 	}
-	if _, ok := pc.mutation.Price(); !ok {
-		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Product.price"`)}
-	}
-	if v, ok := pc.mutation.Price(); ok {
-		if err := product.PriceValidator(v); err != nil {
-			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Product.price": %w`, err)}
-		}
-	}
 	if _, ok := pc.mutation.PurchasePrice(); !ok {
 		return &ValidationError{Name: "purchase_price", err: errors.New(`ent: missing required field "Product.purchase_price"`)}
 	}
 	if v, ok := pc.mutation.PurchasePrice(); ok {
-		if err := product.PurchasePriceValidator(v); err != nil {
+		if err := product.PurchasePriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "purchase_price", err: fmt.Errorf(`ent: validator failed for field "Product.purchase_price": %w`, err)}
 		}
 	}
@@ -293,12 +466,12 @@ func (pc *ProductCreate) check() error {
 		return &ValidationError{Name: "retail_price", err: errors.New(`ent: missing required field "Product.retail_price"`)}
 	}
 	if v, ok := pc.mutation.RetailPrice(); ok {
-		if err := product.RetailPriceValidator(v); err != nil {
+		if err := product.RetailPriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "retail_price", err: fmt.Errorf(`ent: validator failed for field "Product.retail_price": %w`, err)}
 		}
 	}
 	if v, ok := pc.mutation.WholesalePrice(); ok {
-		if err := product.WholesalePriceValidator(v); err != nil {
+		if err := product.WholesalePriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "wholesale_price", err: fmt.Errorf(`ent: validator failed for field "Product.wholesale_price": %w`, err)}
 		}
 	}
@@ -315,6 +488,35 @@ func (pc *ProductCreate) check() error {
 			return &ValidationError{Name: "stock", err: fmt.Errorf(`ent: validator failed for field "Product.stock": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.BaseUnit(); !ok {
+		return &ValidationError{Name: "base_unit", err: errors.New(`ent: missing required field "Product.base_unit"`)}
+	}
+	if _, ok := pc.mutation.Fractional(); !ok {
+		return &ValidationError{Name: "fractional", err: errors.New(`ent: missing required field "Product.fractional"`)}
+	}
+	if _, ok := pc.mutation.Bundle(); !ok {
+		return &ValidationError{Name: "bundle", err: errors.New(`ent: missing required field "Product.bundle"`)}
+	}
+	if _, ok := pc.mutation.RevenueSplit(); !ok {
+		return &ValidationError{Name: "revenue_split", err: errors.New(`ent: missing required field "Product.revenue_split"`)}
+	}
+	if _, ok := pc.mutation.TrackLots(); !ok {
+		return &ValidationError{Name: "track_lots", err: errors.New(`ent: missing required field "Product.track_lots"`)}
+	}
+	if _, ok := pc.mutation.Serialized(); !ok {
+		return &ValidationError{Name: "serialized", err: errors.New(`ent: missing required field "Product.serialized"`)}
+	}
+	if _, ok := pc.mutation.WarrantyMonths(); !ok {
+		return &ValidationError{Name: "warranty_months", err: errors.New(`ent: missing required field "Product.warranty_months"`)}
+	}
+	if v, ok := pc.mutation.WarrantyMonths(); ok {
+		if err := product.WarrantyMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "warranty_months", err: fmt.Errorf(`ent: validator failed for field "Product.warranty_months": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Archived(); !ok {
+		return &ValidationError{Name: "archived", err: errors.New(`ent: missing required field "Product.archived"`)}
+	}
 	if _, ok := pc.mutation.TenantID(); !ok {
 		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Product.tenant_id"`)}
 	}
@@ -367,17 +569,9 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		})
 		_node.Description = value
 	}
-	if value, ok := pc.mutation.Price(); ok {
-		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
-			Value:  value,
-			Column: product.FieldPrice,
-		})
-		_node.Price = value
-	}
 	if value, ok := pc.mutation.PurchasePrice(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: product.FieldPurchasePrice,
 		})
@@ -385,7 +579,7 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 	}
 	if value, ok := pc.mutation.RetailPrice(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: product.FieldRetailPrice,
 		})
@@ -393,7 +587,7 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 	}
 	if value, ok := pc.mutation.WholesalePrice(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: product.FieldWholesalePrice,
 		})
@@ -409,12 +603,44 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 	}
 	if value, ok := pc.mutation.Stock(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeFloat64,
 			Value:  value,
 			Column: product.FieldStock,
 		})
 		_node.Stock = value
 	}
+	if value, ok := pc.mutation.BaseUnit(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: product.FieldBaseUnit,
+		})
+		_node.BaseUnit = value
+	}
+	if value, ok := pc.mutation.Fractional(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: product.FieldFractional,
+		})
+		_node.Fractional = value
+	}
+	if value, ok := pc.mutation.Bundle(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: product.FieldBundle,
+		})
+		_node.Bundle = value
+	}
+	if value, ok := pc.mutation.RevenueSplit(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: product.FieldRevenueSplit,
+		})
+		_node.RevenueSplit = value
+	}
 	if value, ok := pc.mutation.Sku(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -423,6 +649,62 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		})
 		_node.Sku = value
 	}
+	if value, ok := pc.mutation.Category(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: product.FieldCategory,
+		})
+		_node.Category = value
+	}
+	if value, ok := pc.mutation.TaxRateID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: product.FieldTaxRateID,
+		})
+		_node.TaxRateID = &value
+	}
+	if value, ok := pc.mutation.TrackLots(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: product.FieldTrackLots,
+		})
+		_node.TrackLots = value
+	}
+	if value, ok := pc.mutation.Serialized(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: product.FieldSerialized,
+		})
+		_node.Serialized = value
+	}
+	if value, ok := pc.mutation.WarrantyMonths(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: product.FieldWarrantyMonths,
+		})
+		_node.WarrantyMonths = value
+	}
+	if value, ok := pc.mutation.Archived(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: product.FieldArchived,
+		})
+		_node.Archived = value
+	}
+	if value, ok := pc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: product.FieldDeletedAt,
+		})
+		_node.DeletedAt = &value
+	}
 	if value, ok := pc.mutation.TenantID(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -5,6 +5,7 @@ package ent
 import (
 	"Veritasbackend/ent/predicate"
 	"Veritasbackend/ent/product"
+	"Veritasbackend/pkg/money"
 	"context"
 	"errors"
 	"fmt"
@@ -54,79 +55,66 @@ func (pu *ProductUpdate) ClearDescription() *ProductUpdate {
 	return pu
 }
 
-// SetPrice sets the "price" field.
-func (pu *ProductUpdate) SetPrice(f float64) *ProductUpdate {
-	pu.mutation.ResetPrice()
-	pu.mutation.SetPrice(f)
-	return pu
-}
-
-// AddPrice adds f to the "price" field.
-func (pu *ProductUpdate) AddPrice(f float64) *ProductUpdate {
-	pu.mutation.AddPrice(f)
-	return pu
-}
-
 // SetPurchasePrice sets the "purchase_price" field.
-func (pu *ProductUpdate) SetPurchasePrice(f float64) *ProductUpdate {
+func (pu *ProductUpdate) SetPurchasePrice(m money.Amount) *ProductUpdate {
 	pu.mutation.ResetPurchasePrice()
-	pu.mutation.SetPurchasePrice(f)
+	pu.mutation.SetPurchasePrice(m)
 	return pu
 }
 
 // SetNillablePurchasePrice sets the "purchase_price" field if the given value is not nil.
-func (pu *ProductUpdate) SetNillablePurchasePrice(f *float64) *ProductUpdate {
-	if f != nil {
-		pu.SetPurchasePrice(*f)
+func (pu *ProductUpdate) SetNillablePurchasePrice(m *money.Amount) *ProductUpdate {
+	if m != nil {
+		pu.SetPurchasePrice(*m)
 	}
 	return pu
 }
 
-// AddPurchasePrice adds f to the "purchase_price" field.
-func (pu *ProductUpdate) AddPurchasePrice(f float64) *ProductUpdate {
-	pu.mutation.AddPurchasePrice(f)
+// AddPurchasePrice adds m to the "purchase_price" field.
+func (pu *ProductUpdate) AddPurchasePrice(m money.Amount) *ProductUpdate {
+	pu.mutation.AddPurchasePrice(m)
 	return pu
 }
 
 // SetRetailPrice sets the "retail_price" field.
-func (pu *ProductUpdate) SetRetailPrice(f float64) *ProductUpdate {
+func (pu *ProductUpdate) SetRetailPrice(m money.Amount) *ProductUpdate {
 	pu.mutation.ResetRetailPrice()
-	pu.mutation.SetRetailPrice(f)
+	pu.mutation.SetRetailPrice(m)
 	return pu
 }
 
 // SetNillableRetailPrice sets the "retail_price" field if the given value is not nil.
-func (pu *ProductUpdate) SetNillableRetailPrice(f *float64) *ProductUpdate {
-	if f != nil {
-		pu.SetRetailPrice(*f)
+func (pu *ProductUpdate) SetNillableRetailPrice(m *money.Amount) *ProductUpdate {
+	if m != nil {
+		pu.SetRetailPrice(*m)
 	}
 	return pu
 }
 
-// AddRetailPrice adds f to the "retail_price" field.
-func (pu *ProductUpdate) AddRetailPrice(f float64) *ProductUpdate {
-	pu.mutation.AddRetailPrice(f)
+// AddRetailPrice adds m to the "retail_price" field.
+func (pu *ProductUpdate) AddRetailPrice(m money.Amount) *ProductUpdate {
+	pu.mutation.AddRetailPrice(m)
 	return pu
 }
 
 // SetWholesalePrice sets the "wholesale_price" field.
-func (pu *ProductUpdate) SetWholesalePrice(f float64) *ProductUpdate {
+func (pu *ProductUpdate) SetWholesalePrice(m money.Amount) *ProductUpdate {
 	pu.mutation.ResetWholesalePrice()
-	pu.mutation.SetWholesalePrice(f)
+	pu.mutation.SetWholesalePrice(m)
 	return pu
 }
 
 // SetNillableWholesalePrice sets the "wholesale_price" field if the given value is not nil.
-func (pu *ProductUpdate) SetNillableWholesalePrice(f *float64) *ProductUpdate {
-	if f != nil {
-		pu.SetWholesalePrice(*f)
+func (pu *ProductUpdate) SetNillableWholesalePrice(m *money.Amount) *ProductUpdate {
+	if m != nil {
+		pu.SetWholesalePrice(*m)
 	}
 	return pu
 }
 
-// AddWholesalePrice adds f to the "wholesale_price" field.
-func (pu *ProductUpdate) AddWholesalePrice(f float64) *ProductUpdate {
-	pu.mutation.AddWholesalePrice(f)
+// AddWholesalePrice adds m to the "wholesale_price" field.
+func (pu *ProductUpdate) AddWholesalePrice(m money.Amount) *ProductUpdate {
+	pu.mutation.AddWholesalePrice(m)
 	return pu
 }
 
@@ -164,23 +152,79 @@ func (pu *ProductUpdate) ClearMinWholesaleQuantity() *ProductUpdate {
 }
 
 // SetStock sets the "stock" field.
-func (pu *ProductUpdate) SetStock(i int) *ProductUpdate {
+func (pu *ProductUpdate) SetStock(f float64) *ProductUpdate {
 	pu.mutation.ResetStock()
-	pu.mutation.SetStock(i)
+	pu.mutation.SetStock(f)
 	return pu
 }
 
 // SetNillableStock sets the "stock" field if the given value is not nil.
-func (pu *ProductUpdate) SetNillableStock(i *int) *ProductUpdate {
-	if i != nil {
-		pu.SetStock(*i)
+func (pu *ProductUpdate) SetNillableStock(f *float64) *ProductUpdate {
+	if f != nil {
+		pu.SetStock(*f)
+	}
+	return pu
+}
+
+// AddStock adds f to the "stock" field.
+func (pu *ProductUpdate) AddStock(f float64) *ProductUpdate {
+	pu.mutation.AddStock(f)
+	return pu
+}
+
+// SetBaseUnit sets the "base_unit" field.
+func (pu *ProductUpdate) SetBaseUnit(s string) *ProductUpdate {
+	pu.mutation.SetBaseUnit(s)
+	return pu
+}
+
+// SetNillableBaseUnit sets the "base_unit" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableBaseUnit(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetBaseUnit(*s)
+	}
+	return pu
+}
+
+// SetFractional sets the "fractional" field.
+func (pu *ProductUpdate) SetFractional(b bool) *ProductUpdate {
+	pu.mutation.SetFractional(b)
+	return pu
+}
+
+// SetNillableFractional sets the "fractional" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableFractional(b *bool) *ProductUpdate {
+	if b != nil {
+		pu.SetFractional(*b)
 	}
 	return pu
 }
 
-// AddStock adds i to the "stock" field.
-func (pu *ProductUpdate) AddStock(i int) *ProductUpdate {
-	pu.mutation.AddStock(i)
+// SetBundle sets the "bundle" field.
+func (pu *ProductUpdate) SetBundle(b bool) *ProductUpdate {
+	pu.mutation.SetBundle(b)
+	return pu
+}
+
+// SetNillableBundle sets the "bundle" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableBundle(b *bool) *ProductUpdate {
+	if b != nil {
+		pu.SetBundle(*b)
+	}
+	return pu
+}
+
+// SetRevenueSplit sets the "revenue_split" field.
+func (pu *ProductUpdate) SetRevenueSplit(s string) *ProductUpdate {
+	pu.mutation.SetRevenueSplit(s)
+	return pu
+}
+
+// SetNillableRevenueSplit sets the "revenue_split" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableRevenueSplit(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetRevenueSplit(*s)
+	}
 	return pu
 }
 
@@ -204,6 +248,136 @@ func (pu *ProductUpdate) ClearSku() *ProductUpdate {
 	return pu
 }
 
+// SetCategory sets the "category" field.
+func (pu *ProductUpdate) SetCategory(s string) *ProductUpdate {
+	pu.mutation.SetCategory(s)
+	return pu
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableCategory(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetCategory(*s)
+	}
+	return pu
+}
+
+// ClearCategory clears the value of the "category" field.
+func (pu *ProductUpdate) ClearCategory() *ProductUpdate {
+	pu.mutation.ClearCategory()
+	return pu
+}
+
+// SetTaxRateID sets the "tax_rate_id" field.
+func (pu *ProductUpdate) SetTaxRateID(i int) *ProductUpdate {
+	pu.mutation.ResetTaxRateID()
+	pu.mutation.SetTaxRateID(i)
+	return pu
+}
+
+// SetNillableTaxRateID sets the "tax_rate_id" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableTaxRateID(i *int) *ProductUpdate {
+	if i != nil {
+		pu.SetTaxRateID(*i)
+	}
+	return pu
+}
+
+// AddTaxRateID adds i to the "tax_rate_id" field.
+func (pu *ProductUpdate) AddTaxRateID(i int) *ProductUpdate {
+	pu.mutation.AddTaxRateID(i)
+	return pu
+}
+
+// ClearTaxRateID clears the value of the "tax_rate_id" field.
+func (pu *ProductUpdate) ClearTaxRateID() *ProductUpdate {
+	pu.mutation.ClearTaxRateID()
+	return pu
+}
+
+// SetTrackLots sets the "track_lots" field.
+func (pu *ProductUpdate) SetTrackLots(b bool) *ProductUpdate {
+	pu.mutation.SetTrackLots(b)
+	return pu
+}
+
+// SetNillableTrackLots sets the "track_lots" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableTrackLots(b *bool) *ProductUpdate {
+	if b != nil {
+		pu.SetTrackLots(*b)
+	}
+	return pu
+}
+
+// SetSerialized sets the "serialized" field.
+func (pu *ProductUpdate) SetSerialized(b bool) *ProductUpdate {
+	pu.mutation.SetSerialized(b)
+	return pu
+}
+
+// SetNillableSerialized sets the "serialized" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableSerialized(b *bool) *ProductUpdate {
+	if b != nil {
+		pu.SetSerialized(*b)
+	}
+	return pu
+}
+
+// SetWarrantyMonths sets the "warranty_months" field.
+func (pu *ProductUpdate) SetWarrantyMonths(i int) *ProductUpdate {
+	pu.mutation.ResetWarrantyMonths()
+	pu.mutation.SetWarrantyMonths(i)
+	return pu
+}
+
+// SetNillableWarrantyMonths sets the "warranty_months" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableWarrantyMonths(i *int) *ProductUpdate {
+	if i != nil {
+		pu.SetWarrantyMonths(*i)
+	}
+	return pu
+}
+
+// AddWarrantyMonths adds i to the "warranty_months" field.
+func (pu *ProductUpdate) AddWarrantyMonths(i int) *ProductUpdate {
+	pu.mutation.AddWarrantyMonths(i)
+	return pu
+}
+
+// SetArchived sets the "archived" field.
+func (pu *ProductUpdate) SetArchived(b bool) *ProductUpdate {
+	pu.mutation.SetArchived(b)
+	return pu
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableArchived(b *bool) *ProductUpdate {
+	if b != nil {
+		pu.SetArchived(*b)
+	}
+	return pu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pu *ProductUpdate) SetDeletedAt(t time.Time) *ProductUpdate {
+	pu.mutation.SetDeletedAt(t)
+	return pu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableDeletedAt(t *time.Time) *ProductUpdate {
+	if t != nil {
+		pu.SetDeletedAt(*t)
+	}
+	return pu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pu *ProductUpdate) ClearDeletedAt() *ProductUpdate {
+	pu.mutation.ClearDeletedAt()
+	return pu
+}
+
 // SetTenantID sets the "tenant_id" field.
 func (pu *ProductUpdate) SetTenantID(i int) *ProductUpdate {
 	pu.mutation.ResetTenantID()
@@ -304,23 +478,18 @@ func (pu *ProductUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Product.name": %w`, err)}
 		}
 	}
-	if v, ok := pu.mutation.Price(); ok {
-		if err := product.PriceValidator(v); err != nil {
-			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Product.price": %w`, err)}
-		}
-	}
 	if v, ok := pu.mutation.PurchasePrice(); ok {
-		if err := product.PurchasePriceValidator(v); err != nil {
+		if err := product.PurchasePriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "purchase_price", err: fmt.Errorf(`ent: validator failed for field "Product.purchase_price": %w`, err)}
 		}
 	}
 	if v, ok := pu.mutation.RetailPrice(); ok {
-		if err := product.RetailPriceValidator(v); err != nil {
+		if err := product.RetailPriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "retail_price", err: fmt.Errorf(`ent: validator failed for field "Product.retail_price": %w`, err)}
 		}
 	}
 	if v, ok := pu.mutation.WholesalePrice(); ok {
-		if err := product.WholesalePriceValidator(v); err != nil {
+		if err := product.WholesalePriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "wholesale_price", err: fmt.Errorf(`ent: validator failed for field "Product.wholesale_price": %w`, err)}
 		}
 	}
@@ -334,6 +503,11 @@ func (pu *ProductUpdate) check() error {
 			return &ValidationError{Name: "stock", err: fmt.Errorf(`ent: validator failed for field "Product.stock": %w`, err)}
 		}
 	}
+	if v, ok := pu.mutation.WarrantyMonths(); ok {
+		if err := product.WarrantyMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "warranty_months", err: fmt.Errorf(`ent: validator failed for field "Product.warranty_months": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -375,65 +549,51 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: product.FieldDescription,
 		})
 	}
-	if value, ok := pu.mutation.Price(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
-			Value:  value,
-			Column: product.FieldPrice,
-		})
-	}
-	if value, ok := pu.mutation.AddedPrice(); ok {
-		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
-			Value:  value,
-			Column: product.FieldPrice,
-		})
-	}
 	if value, ok := pu.mutation.PurchasePrice(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: product.FieldPurchasePrice,
 		})
 	}
 	if value, ok := pu.mutation.AddedPurchasePrice(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: product.FieldPurchasePrice,
 		})
 	}
 	if value, ok := pu.mutation.RetailPrice(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: product.FieldRetailPrice,
 		})
 	}
 	if value, ok := pu.mutation.AddedRetailPrice(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: product.FieldRetailPrice,
 		})
 	}
 	if value, ok := pu.mutation.WholesalePrice(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: product.FieldWholesalePrice,
 		})
 	}
 	if value, ok := pu.mutation.AddedWholesalePrice(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: product.FieldWholesalePrice,
 		})
 	}
 	if pu.mutation.WholesalePriceCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Column: product.FieldWholesalePrice,
 		})
 	}
@@ -459,18 +619,46 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if value, ok := pu.mutation.Stock(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeFloat64,
 			Value:  value,
 			Column: product.FieldStock,
 		})
 	}
 	if value, ok := pu.mutation.AddedStock(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeFloat64,
 			Value:  value,
 			Column: product.FieldStock,
 		})
 	}
+	if value, ok := pu.mutation.BaseUnit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: product.FieldBaseUnit,
+		})
+	}
+	if value, ok := pu.mutation.Fractional(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: product.FieldFractional,
+		})
+	}
+	if value, ok := pu.mutation.Bundle(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: product.FieldBundle,
+		})
+	}
+	if value, ok := pu.mutation.RevenueSplit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: product.FieldRevenueSplit,
+		})
+	}
 	if value, ok := pu.mutation.Sku(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -484,6 +672,87 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: product.FieldSku,
 		})
 	}
+	if value, ok := pu.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: product.FieldCategory,
+		})
+	}
+	if pu.mutation.CategoryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: product.FieldCategory,
+		})
+	}
+	if value, ok := pu.mutation.TaxRateID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: product.FieldTaxRateID,
+		})
+	}
+	if value, ok := pu.mutation.AddedTaxRateID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: product.FieldTaxRateID,
+		})
+	}
+	if pu.mutation.TaxRateIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: product.FieldTaxRateID,
+		})
+	}
+	if value, ok := pu.mutation.TrackLots(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: product.FieldTrackLots,
+		})
+	}
+	if value, ok := pu.mutation.Serialized(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: product.FieldSerialized,
+		})
+	}
+	if value, ok := pu.mutation.WarrantyMonths(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: product.FieldWarrantyMonths,
+		})
+	}
+	if value, ok := pu.mutation.AddedWarrantyMonths(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: product.FieldWarrantyMonths,
+		})
+	}
+	if value, ok := pu.mutation.Archived(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: product.FieldArchived,
+		})
+	}
+	if value, ok := pu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: product.FieldDeletedAt,
+		})
+	}
+	if pu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: product.FieldDeletedAt,
+		})
+	}
 	if value, ok := pu.mutation.TenantID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -550,79 +819,66 @@ func (puo *ProductUpdateOne) ClearDescription() *ProductUpdateOne {
 	return puo
 }
 
-// SetPrice sets the "price" field.
-func (puo *ProductUpdateOne) SetPrice(f float64) *ProductUpdateOne {
-	puo.mutation.ResetPrice()
-	puo.mutation.SetPrice(f)
-	return puo
-}
-
-// AddPrice adds f to the "price" field.
-func (puo *ProductUpdateOne) AddPrice(f float64) *ProductUpdateOne {
-	puo.mutation.AddPrice(f)
-	return puo
-}
-
 // SetPurchasePrice sets the "purchase_price" field.
-func (puo *ProductUpdateOne) SetPurchasePrice(f float64) *ProductUpdateOne {
+func (puo *ProductUpdateOne) SetPurchasePrice(m money.Amount) *ProductUpdateOne {
 	puo.mutation.ResetPurchasePrice()
-	puo.mutation.SetPurchasePrice(f)
+	puo.mutation.SetPurchasePrice(m)
 	return puo
 }
 
 // SetNillablePurchasePrice sets the "purchase_price" field if the given value is not nil.
-func (puo *ProductUpdateOne) SetNillablePurchasePrice(f *float64) *ProductUpdateOne {
-	if f != nil {
-		puo.SetPurchasePrice(*f)
+func (puo *ProductUpdateOne) SetNillablePurchasePrice(m *money.Amount) *ProductUpdateOne {
+	if m != nil {
+		puo.SetPurchasePrice(*m)
 	}
 	return puo
 }
 
-// AddPurchasePrice adds f to the "purchase_price" field.
-func (puo *ProductUpdateOne) AddPurchasePrice(f float64) *ProductUpdateOne {
-	puo.mutation.AddPurchasePrice(f)
+// AddPurchasePrice adds m to the "purchase_price" field.
+func (puo *ProductUpdateOne) AddPurchasePrice(m money.Amount) *ProductUpdateOne {
+	puo.mutation.AddPurchasePrice(m)
 	return puo
 }
 
 // SetRetailPrice sets the "retail_price" field.
-func (puo *ProductUpdateOne) SetRetailPrice(f float64) *ProductUpdateOne {
+func (puo *ProductUpdateOne) SetRetailPrice(m money.Amount) *ProductUpdateOne {
 	puo.mutation.ResetRetailPrice()
-	puo.mutation.SetRetailPrice(f)
+	puo.mutation.SetRetailPrice(m)
 	return puo
 }
 
 // SetNillableRetailPrice sets the "retail_price" field if the given value is not nil.
-func (puo *ProductUpdateOne) SetNillableRetailPrice(f *float64) *ProductUpdateOne {
-	if f != nil {
-		puo.SetRetailPrice(*f)
+func (puo *ProductUpdateOne) SetNillableRetailPrice(m *money.Amount) *ProductUpdateOne {
+	if m != nil {
+		puo.SetRetailPrice(*m)
 	}
 	return puo
 }
 
-// AddRetailPrice adds f to the "retail_price" field.
-func (puo *ProductUpdateOne) AddRetailPrice(f float64) *ProductUpdateOne {
-	puo.mutation.AddRetailPrice(f)
+// AddRetailPrice adds m to the "retail_price" field.
+func (puo *ProductUpdateOne) AddRetailPrice(m money.Amount) *ProductUpdateOne {
+	puo.mutation.AddRetailPrice(m)
 	return puo
 }
 
 // SetWholesalePrice sets the "wholesale_price" field.
-func (puo *ProductUpdateOne) SetWholesalePrice(f float64) *ProductUpdateOne {
+func (puo *ProductUpdateOne) SetWholesalePrice(m money.Amount) *ProductUpdateOne {
 	puo.mutation.ResetWholesalePrice()
-	puo.mutation.SetWholesalePrice(f)
+	puo.mutation.SetWholesalePrice(m)
 	return puo
 }
 
 // SetNillableWholesalePrice sets the "wholesale_price" field if the given value is not nil.
-func (puo *ProductUpdateOne) SetNillableWholesalePrice(f *float64) *ProductUpdateOne {
-	if f != nil {
-		puo.SetWholesalePrice(*f)
+func (puo *ProductUpdateOne) SetNillableWholesalePrice(m *money.Amount) *ProductUpdateOne {
+	if m != nil {
+		puo.SetWholesalePrice(*m)
 	}
 	return puo
 }
 
-// AddWholesalePrice adds f to the "wholesale_price" field.
-func (puo *ProductUpdateOne) AddWholesalePrice(f float64) *ProductUpdateOne {
-	puo.mutation.AddWholesalePrice(f)
+// AddWholesalePrice adds m to the "wholesale_price" field.
+func (puo *ProductUpdateOne) AddWholesalePrice(m money.Amount) *ProductUpdateOne {
+	puo.mutation.AddWholesalePrice(m)
 	return puo
 }
 
@@ -660,23 +916,79 @@ func (puo *ProductUpdateOne) ClearMinWholesaleQuantity() *ProductUpdateOne {
 }
 
 // SetStock sets the "stock" field.
-func (puo *ProductUpdateOne) SetStock(i int) *ProductUpdateOne {
+func (puo *ProductUpdateOne) SetStock(f float64) *ProductUpdateOne {
 	puo.mutation.ResetStock()
-	puo.mutation.SetStock(i)
+	puo.mutation.SetStock(f)
 	return puo
 }
 
 // SetNillableStock sets the "stock" field if the given value is not nil.
-func (puo *ProductUpdateOne) SetNillableStock(i *int) *ProductUpdateOne {
-	if i != nil {
-		puo.SetStock(*i)
+func (puo *ProductUpdateOne) SetNillableStock(f *float64) *ProductUpdateOne {
+	if f != nil {
+		puo.SetStock(*f)
+	}
+	return puo
+}
+
+// AddStock adds f to the "stock" field.
+func (puo *ProductUpdateOne) AddStock(f float64) *ProductUpdateOne {
+	puo.mutation.AddStock(f)
+	return puo
+}
+
+// SetBaseUnit sets the "base_unit" field.
+func (puo *ProductUpdateOne) SetBaseUnit(s string) *ProductUpdateOne {
+	puo.mutation.SetBaseUnit(s)
+	return puo
+}
+
+// SetNillableBaseUnit sets the "base_unit" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableBaseUnit(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetBaseUnit(*s)
+	}
+	return puo
+}
+
+// SetFractional sets the "fractional" field.
+func (puo *ProductUpdateOne) SetFractional(b bool) *ProductUpdateOne {
+	puo.mutation.SetFractional(b)
+	return puo
+}
+
+// SetNillableFractional sets the "fractional" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableFractional(b *bool) *ProductUpdateOne {
+	if b != nil {
+		puo.SetFractional(*b)
 	}
 	return puo
 }
 
-// AddStock adds i to the "stock" field.
-func (puo *ProductUpdateOne) AddStock(i int) *ProductUpdateOne {
-	puo.mutation.AddStock(i)
+// SetBundle sets the "bundle" field.
+func (puo *ProductUpdateOne) SetBundle(b bool) *ProductUpdateOne {
+	puo.mutation.SetBundle(b)
+	return puo
+}
+
+// SetNillableBundle sets the "bundle" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableBundle(b *bool) *ProductUpdateOne {
+	if b != nil {
+		puo.SetBundle(*b)
+	}
+	return puo
+}
+
+// SetRevenueSplit sets the "revenue_split" field.
+func (puo *ProductUpdateOne) SetRevenueSplit(s string) *ProductUpdateOne {
+	puo.mutation.SetRevenueSplit(s)
+	return puo
+}
+
+// SetNillableRevenueSplit sets the "revenue_split" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableRevenueSplit(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetRevenueSplit(*s)
+	}
 	return puo
 }
 
@@ -700,6 +1012,136 @@ func (puo *ProductUpdateOne) ClearSku() *ProductUpdateOne {
 	return puo
 }
 
+// SetCategory sets the "category" field.
+func (puo *ProductUpdateOne) SetCategory(s string) *ProductUpdateOne {
+	puo.mutation.SetCategory(s)
+	return puo
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableCategory(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetCategory(*s)
+	}
+	return puo
+}
+
+// ClearCategory clears the value of the "category" field.
+func (puo *ProductUpdateOne) ClearCategory() *ProductUpdateOne {
+	puo.mutation.ClearCategory()
+	return puo
+}
+
+// SetTaxRateID sets the "tax_rate_id" field.
+func (puo *ProductUpdateOne) SetTaxRateID(i int) *ProductUpdateOne {
+	puo.mutation.ResetTaxRateID()
+	puo.mutation.SetTaxRateID(i)
+	return puo
+}
+
+// SetNillableTaxRateID sets the "tax_rate_id" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableTaxRateID(i *int) *ProductUpdateOne {
+	if i != nil {
+		puo.SetTaxRateID(*i)
+	}
+	return puo
+}
+
+// AddTaxRateID adds i to the "tax_rate_id" field.
+func (puo *ProductUpdateOne) AddTaxRateID(i int) *ProductUpdateOne {
+	puo.mutation.AddTaxRateID(i)
+	return puo
+}
+
+// ClearTaxRateID clears the value of the "tax_rate_id" field.
+func (puo *ProductUpdateOne) ClearTaxRateID() *ProductUpdateOne {
+	puo.mutation.ClearTaxRateID()
+	return puo
+}
+
+// SetTrackLots sets the "track_lots" field.
+func (puo *ProductUpdateOne) SetTrackLots(b bool) *ProductUpdateOne {
+	puo.mutation.SetTrackLots(b)
+	return puo
+}
+
+// SetNillableTrackLots sets the "track_lots" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableTrackLots(b *bool) *ProductUpdateOne {
+	if b != nil {
+		puo.SetTrackLots(*b)
+	}
+	return puo
+}
+
+// SetSerialized sets the "serialized" field.
+func (puo *ProductUpdateOne) SetSerialized(b bool) *ProductUpdateOne {
+	puo.mutation.SetSerialized(b)
+	return puo
+}
+
+// SetNillableSerialized sets the "serialized" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableSerialized(b *bool) *ProductUpdateOne {
+	if b != nil {
+		puo.SetSerialized(*b)
+	}
+	return puo
+}
+
+// SetWarrantyMonths sets the "warranty_months" field.
+func (puo *ProductUpdateOne) SetWarrantyMonths(i int) *ProductUpdateOne {
+	puo.mutation.ResetWarrantyMonths()
+	puo.mutation.SetWarrantyMonths(i)
+	return puo
+}
+
+// SetNillableWarrantyMonths sets the "warranty_months" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableWarrantyMonths(i *int) *ProductUpdateOne {
+	if i != nil {
+		puo.SetWarrantyMonths(*i)
+	}
+	return puo
+}
+
+// AddWarrantyMonths adds i to the "warranty_months" field.
+func (puo *ProductUpdateOne) AddWarrantyMonths(i int) *ProductUpdateOne {
+	puo.mutation.AddWarrantyMonths(i)
+	return puo
+}
+
+// SetArchived sets the "archived" field.
+func (puo *ProductUpdateOne) SetArchived(b bool) *ProductUpdateOne {
+	puo.mutation.SetArchived(b)
+	return puo
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableArchived(b *bool) *ProductUpdateOne {
+	if b != nil {
+		puo.SetArchived(*b)
+	}
+	return puo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (puo *ProductUpdateOne) SetDeletedAt(t time.Time) *ProductUpdateOne {
+	puo.mutation.SetDeletedAt(t)
+	return puo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableDeletedAt(t *time.Time) *ProductUpdateOne {
+	if t != nil {
+		puo.SetDeletedAt(*t)
+	}
+	return puo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (puo *ProductUpdateOne) ClearDeletedAt() *ProductUpdateOne {
+	puo.mutation.ClearDeletedAt()
+	return puo
+}
+
 // SetTenantID sets the "tenant_id" field.
 func (puo *ProductUpdateOne) SetTenantID(i int) *ProductUpdateOne {
 	puo.mutation.ResetTenantID()
@@ -813,23 +1255,18 @@ func (puo *ProductUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Product.name": %w`, err)}
 		}
 	}
-	if v, ok := puo.mutation.Price(); ok {
-		if err := product.PriceValidator(v); err != nil {
-			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Product.price": %w`, err)}
-		}
-	}
 	if v, ok := puo.mutation.PurchasePrice(); ok {
-		if err := product.PurchasePriceValidator(v); err != nil {
+		if err := product.PurchasePriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "purchase_price", err: fmt.Errorf(`ent: validator failed for field "Product.purchase_price": %w`, err)}
 		}
 	}
 	if v, ok := puo.mutation.RetailPrice(); ok {
-		if err := product.RetailPriceValidator(v); err != nil {
+		if err := product.RetailPriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "retail_price", err: fmt.Errorf(`ent: validator failed for field "Product.retail_price": %w`, err)}
 		}
 	}
 	if v, ok := puo.mutation.WholesalePrice(); ok {
-		if err := product.WholesalePriceValidator(v); err != nil {
+		if err := product.WholesalePriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "wholesale_price", err: fmt.Errorf(`ent: validator failed for field "Product.wholesale_price": %w`, err)}
 		}
 	}
@@ -843,6 +1280,11 @@ func (puo *ProductUpdateOne) check() error {
 			return &ValidationError{Name: "stock", err: fmt.Errorf(`ent: validator failed for field "Product.stock": %w`, err)}
 		}
 	}
+	if v, ok := puo.mutation.WarrantyMonths(); ok {
+		if err := product.WarrantyMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "warranty_months", err: fmt.Errorf(`ent: validator failed for field "Product.warranty_months": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -901,65 +1343,51 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 			Column: product.FieldDescription,
 		})
 	}
-	if value, ok := puo.mutation.Price(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
-			Value:  value,
-			Column: product.FieldPrice,
-		})
-	}
-	if value, ok := puo.mutation.AddedPrice(); ok {
-		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
-			Value:  value,
-			Column: product.FieldPrice,
-		})
-	}
 	if value, ok := puo.mutation.PurchasePrice(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: product.FieldPurchasePrice,
 		})
 	}
 	if value, ok := puo.mutation.AddedPurchasePrice(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: product.FieldPurchasePrice,
 		})
 	}
 	if value, ok := puo.mutation.RetailPrice(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: product.FieldRetailPrice,
 		})
 	}
 	if value, ok := puo.mutation.AddedRetailPrice(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: product.FieldRetailPrice,
 		})
 	}
 	if value, ok := puo.mutation.WholesalePrice(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: product.FieldWholesalePrice,
 		})
 	}
 	if value, ok := puo.mutation.AddedWholesalePrice(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: product.FieldWholesalePrice,
 		})
 	}
 	if puo.mutation.WholesalePriceCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Column: product.FieldWholesalePrice,
 		})
 	}
@@ -985,18 +1413,46 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 	}
 	if value, ok := puo.mutation.Stock(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeFloat64,
 			Value:  value,
 			Column: product.FieldStock,
 		})
 	}
 	if value, ok := puo.mutation.AddedStock(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeFloat64,
 			Value:  value,
 			Column: product.FieldStock,
 		})
 	}
+	if value, ok := puo.mutation.BaseUnit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: product.FieldBaseUnit,
+		})
+	}
+	if value, ok := puo.mutation.Fractional(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: product.FieldFractional,
+		})
+	}
+	if value, ok := puo.mutation.Bundle(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: product.FieldBundle,
+		})
+	}
+	if value, ok := puo.mutation.RevenueSplit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: product.FieldRevenueSplit,
+		})
+	}
 	if value, ok := puo.mutation.Sku(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -1010,6 +1466,87 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 			Column: product.FieldSku,
 		})
 	}
+	if value, ok := puo.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: product.FieldCategory,
+		})
+	}
+	if puo.mutation.CategoryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: product.FieldCategory,
+		})
+	}
+	if value, ok := puo.mutation.TaxRateID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: product.FieldTaxRateID,
+		})
+	}
+	if value, ok := puo.mutation.AddedTaxRateID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: product.FieldTaxRateID,
+		})
+	}
+	if puo.mutation.TaxRateIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: product.FieldTaxRateID,
+		})
+	}
+	if value, ok := puo.mutation.TrackLots(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: product.FieldTrackLots,
+		})
+	}
+	if value, ok := puo.mutation.Serialized(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: product.FieldSerialized,
+		})
+	}
+	if value, ok := puo.mutation.WarrantyMonths(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: product.FieldWarrantyMonths,
+		})
+	}
+	if value, ok := puo.mutation.AddedWarrantyMonths(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: product.FieldWarrantyMonths,
+		})
+	}
+	if value, ok := puo.mutation.Archived(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: product.FieldArchived,
+		})
+	}
+	if value, ok := puo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: product.FieldDeletedAt,
+		})
+	}
+	if puo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: product.FieldDeletedAt,
+		})
+	}
 	if value, ok := puo.mutation.TenantID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
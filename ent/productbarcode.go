@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/productbarcode"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProductBarcode is the model entity for the ProductBarcode schema.
+type ProductBarcode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// ID del producto
+	ProductID int `json:"product_id,omitempty"`
+	// Unidad que identifica el código, por ejemplo la caja; vacío es la unidad base
+	UnitID *int `json:"unit_id,omitempty"`
+	// Código de barras tal como lo lee el escáner
+	Code string `json:"code,omitempty"`
+	// Simbología del código (ean13, ean8, upca, code128)
+	Symbology string `json:"symbology,omitempty"`
+	// Indica si el código lo generó el sistema
+	Internal bool `json:"internal,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProductBarcode) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case productbarcode.FieldInternal:
+			values[i] = new(sql.NullBool)
+		case productbarcode.FieldID, productbarcode.FieldTenantID, productbarcode.FieldProductID, productbarcode.FieldUnitID:
+			values[i] = new(sql.NullInt64)
+		case productbarcode.FieldCode, productbarcode.FieldSymbology:
+			values[i] = new(sql.NullString)
+		case productbarcode.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ProductBarcode", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProductBarcode fields.
+func (pb *ProductBarcode) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case productbarcode.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pb.ID = int(value.Int64)
+		case productbarcode.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				pb.TenantID = int(value.Int64)
+			}
+		case productbarcode.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				pb.ProductID = int(value.Int64)
+			}
+		case productbarcode.FieldUnitID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_id", values[i])
+			} else if value.Valid {
+				pb.UnitID = new(int)
+				*pb.UnitID = int(value.Int64)
+			}
+		case productbarcode.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				pb.Code = value.String
+			}
+		case productbarcode.FieldSymbology:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbology", values[i])
+			} else if value.Valid {
+				pb.Symbology = value.String
+			}
+		case productbarcode.FieldInternal:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field internal", values[i])
+			} else if value.Valid {
+				pb.Internal = value.Bool
+			}
+		case productbarcode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pb.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ProductBarcode.
+// Note that you need to call ProductBarcode.Unwrap() before calling this method if this ProductBarcode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pb *ProductBarcode) Update() *ProductBarcodeUpdateOne {
+	return (&ProductBarcodeClient{config: pb.config}).UpdateOne(pb)
+}
+
+// Unwrap unwraps the ProductBarcode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pb *ProductBarcode) Unwrap() *ProductBarcode {
+	_tx, ok := pb.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProductBarcode is not a transactional entity")
+	}
+	pb.config.driver = _tx.drv
+	return pb
+}
+
+// String implements the fmt.Stringer.
+func (pb *ProductBarcode) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProductBarcode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pb.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", pb.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", pb.ProductID))
+	builder.WriteString(", ")
+	if v := pb.UnitID; v != nil {
+		builder.WriteString("unit_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(pb.Code)
+	builder.WriteString(", ")
+	builder.WriteString("symbology=")
+	builder.WriteString(pb.Symbology)
+	builder.WriteString(", ")
+	builder.WriteString("internal=")
+	builder.WriteString(fmt.Sprintf("%v", pb.Internal))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pb.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProductBarcodes is a parsable slice of ProductBarcode.
+type ProductBarcodes []*ProductBarcode
+
+func (pb ProductBarcodes) config(cfg config) {
+	for _i := range pb {
+		pb[_i].config = cfg
+	}
+}
@@ -0,0 +1,61 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productbarcode
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the productbarcode type in the database.
+	Label = "product_barcode"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldUnitID holds the string denoting the unit_id field in the database.
+	FieldUnitID = "unit_id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldSymbology holds the string denoting the symbology field in the database.
+	FieldSymbology = "symbology"
+	// FieldInternal holds the string denoting the internal field in the database.
+	FieldInternal = "internal"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the productbarcode in the database.
+	Table = "product_barcodes"
+)
+
+// Columns holds all SQL columns for productbarcode fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldProductID,
+	FieldUnitID,
+	FieldCode,
+	FieldSymbology,
+	FieldInternal,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// DefaultInternal holds the default value on creation for the "internal" field.
+	DefaultInternal bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,716 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productbarcode
+
+import (
+	"Veritasbackend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// UnitID applies equality check predicate on the "unit_id" field. It's identical to UnitIDEQ.
+func UnitID(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitID), v))
+	})
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCode), v))
+	})
+}
+
+// Symbology applies equality check predicate on the "symbology" field. It's identical to SymbologyEQ.
+func Symbology(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSymbology), v))
+	})
+}
+
+// Internal applies equality check predicate on the "internal" field. It's identical to InternalEQ.
+func Internal(v bool) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInternal), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.ProductBarcode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.ProductBarcode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.ProductBarcode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.ProductBarcode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProductID), v))
+	})
+}
+
+// UnitIDEQ applies the EQ predicate on the "unit_id" field.
+func UnitIDEQ(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDNEQ applies the NEQ predicate on the "unit_id" field.
+func UnitIDNEQ(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDIn applies the In predicate on the "unit_id" field.
+func UnitIDIn(vs ...int) predicate.ProductBarcode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUnitID), v...))
+	})
+}
+
+// UnitIDNotIn applies the NotIn predicate on the "unit_id" field.
+func UnitIDNotIn(vs ...int) predicate.ProductBarcode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUnitID), v...))
+	})
+}
+
+// UnitIDGT applies the GT predicate on the "unit_id" field.
+func UnitIDGT(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDGTE applies the GTE predicate on the "unit_id" field.
+func UnitIDGTE(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDLT applies the LT predicate on the "unit_id" field.
+func UnitIDLT(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDLTE applies the LTE predicate on the "unit_id" field.
+func UnitIDLTE(v int) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDIsNil applies the IsNil predicate on the "unit_id" field.
+func UnitIDIsNil() predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldUnitID)))
+	})
+}
+
+// UnitIDNotNil applies the NotNil predicate on the "unit_id" field.
+func UnitIDNotNil() predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldUnitID)))
+	})
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCode), v))
+	})
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCode), v))
+	})
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.ProductBarcode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCode), v...))
+	})
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.ProductBarcode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCode), v...))
+	})
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCode), v))
+	})
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCode), v))
+	})
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCode), v))
+	})
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCode), v))
+	})
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCode), v))
+	})
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCode), v))
+	})
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCode), v))
+	})
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCode), v))
+	})
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCode), v))
+	})
+}
+
+// SymbologyEQ applies the EQ predicate on the "symbology" field.
+func SymbologyEQ(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSymbology), v))
+	})
+}
+
+// SymbologyNEQ applies the NEQ predicate on the "symbology" field.
+func SymbologyNEQ(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSymbology), v))
+	})
+}
+
+// SymbologyIn applies the In predicate on the "symbology" field.
+func SymbologyIn(vs ...string) predicate.ProductBarcode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSymbology), v...))
+	})
+}
+
+// SymbologyNotIn applies the NotIn predicate on the "symbology" field.
+func SymbologyNotIn(vs ...string) predicate.ProductBarcode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSymbology), v...))
+	})
+}
+
+// SymbologyGT applies the GT predicate on the "symbology" field.
+func SymbologyGT(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSymbology), v))
+	})
+}
+
+// SymbologyGTE applies the GTE predicate on the "symbology" field.
+func SymbologyGTE(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSymbology), v))
+	})
+}
+
+// SymbologyLT applies the LT predicate on the "symbology" field.
+func SymbologyLT(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSymbology), v))
+	})
+}
+
+// SymbologyLTE applies the LTE predicate on the "symbology" field.
+func SymbologyLTE(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSymbology), v))
+	})
+}
+
+// SymbologyContains applies the Contains predicate on the "symbology" field.
+func SymbologyContains(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSymbology), v))
+	})
+}
+
+// SymbologyHasPrefix applies the HasPrefix predicate on the "symbology" field.
+func SymbologyHasPrefix(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSymbology), v))
+	})
+}
+
+// SymbologyHasSuffix applies the HasSuffix predicate on the "symbology" field.
+func SymbologyHasSuffix(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSymbology), v))
+	})
+}
+
+// SymbologyEqualFold applies the EqualFold predicate on the "symbology" field.
+func SymbologyEqualFold(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSymbology), v))
+	})
+}
+
+// SymbologyContainsFold applies the ContainsFold predicate on the "symbology" field.
+func SymbologyContainsFold(v string) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSymbology), v))
+	})
+}
+
+// InternalEQ applies the EQ predicate on the "internal" field.
+func InternalEQ(v bool) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInternal), v))
+	})
+}
+
+// InternalNEQ applies the NEQ predicate on the "internal" field.
+func InternalNEQ(v bool) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldInternal), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProductBarcode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProductBarcode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProductBarcode) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProductBarcode) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProductBarcode) predicate.ProductBarcode {
+	return predicate.ProductBarcode(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/productbarcode"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductBarcodeCreate is the builder for creating a ProductBarcode entity.
+type ProductBarcodeCreate struct {
+	config
+	mutation *ProductBarcodeMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (pbc *ProductBarcodeCreate) SetTenantID(i int) *ProductBarcodeCreate {
+	pbc.mutation.SetTenantID(i)
+	return pbc
+}
+
+// SetProductID sets the "product_id" field.
+func (pbc *ProductBarcodeCreate) SetProductID(i int) *ProductBarcodeCreate {
+	pbc.mutation.SetProductID(i)
+	return pbc
+}
+
+// SetUnitID sets the "unit_id" field.
+func (pbc *ProductBarcodeCreate) SetUnitID(i int) *ProductBarcodeCreate {
+	pbc.mutation.SetUnitID(i)
+	return pbc
+}
+
+// SetNillableUnitID sets the "unit_id" field if the given value is not nil.
+func (pbc *ProductBarcodeCreate) SetNillableUnitID(i *int) *ProductBarcodeCreate {
+	if i != nil {
+		pbc.SetUnitID(*i)
+	}
+	return pbc
+}
+
+// SetCode sets the "code" field.
+func (pbc *ProductBarcodeCreate) SetCode(s string) *ProductBarcodeCreate {
+	pbc.mutation.SetCode(s)
+	return pbc
+}
+
+// SetSymbology sets the "symbology" field.
+func (pbc *ProductBarcodeCreate) SetSymbology(s string) *ProductBarcodeCreate {
+	pbc.mutation.SetSymbology(s)
+	return pbc
+}
+
+// SetInternal sets the "internal" field.
+func (pbc *ProductBarcodeCreate) SetInternal(b bool) *ProductBarcodeCreate {
+	pbc.mutation.SetInternal(b)
+	return pbc
+}
+
+// SetNillableInternal sets the "internal" field if the given value is not nil.
+func (pbc *ProductBarcodeCreate) SetNillableInternal(b *bool) *ProductBarcodeCreate {
+	if b != nil {
+		pbc.SetInternal(*b)
+	}
+	return pbc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pbc *ProductBarcodeCreate) SetCreatedAt(t time.Time) *ProductBarcodeCreate {
+	pbc.mutation.SetCreatedAt(t)
+	return pbc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pbc *ProductBarcodeCreate) SetNillableCreatedAt(t *time.Time) *ProductBarcodeCreate {
+	if t != nil {
+		pbc.SetCreatedAt(*t)
+	}
+	return pbc
+}
+
+// Mutation returns the ProductBarcodeMutation object of the builder.
+func (pbc *ProductBarcodeCreate) Mutation() *ProductBarcodeMutation {
+	return pbc.mutation
+}
+
+// Save creates the ProductBarcode in the database.
+func (pbc *ProductBarcodeCreate) Save(ctx context.Context) (*ProductBarcode, error) {
+	var (
+		err  error
+		node *ProductBarcode
+	)
+	pbc.defaults()
+	if len(pbc.hooks) == 0 {
+		if err = pbc.check(); err != nil {
+			return nil, err
+		}
+		node, err = pbc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductBarcodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pbc.check(); err != nil {
+				return nil, err
+			}
+			pbc.mutation = mutation
+			if node, err = pbc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pbc.hooks) - 1; i >= 0; i-- {
+			if pbc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pbc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, pbc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ProductBarcode)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ProductBarcodeMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pbc *ProductBarcodeCreate) SaveX(ctx context.Context) *ProductBarcode {
+	v, err := pbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pbc *ProductBarcodeCreate) Exec(ctx context.Context) error {
+	_, err := pbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbc *ProductBarcodeCreate) ExecX(ctx context.Context) {
+	if err := pbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pbc *ProductBarcodeCreate) defaults() {
+	if _, ok := pbc.mutation.Internal(); !ok {
+		v := productbarcode.DefaultInternal
+		pbc.mutation.SetInternal(v)
+	}
+	if _, ok := pbc.mutation.CreatedAt(); !ok {
+		v := productbarcode.DefaultCreatedAt()
+		pbc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pbc *ProductBarcodeCreate) check() error {
+	if _, ok := pbc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ProductBarcode.tenant_id"`)}
+	}
+	if _, ok := pbc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "ProductBarcode.product_id"`)}
+	}
+	if _, ok := pbc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "ProductBarcode.code"`)}
+	}
+	if v, ok := pbc.mutation.Code(); ok {
+		if err := productbarcode.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "ProductBarcode.code": %w`, err)}
+		}
+	}
+	if _, ok := pbc.mutation.Symbology(); !ok {
+		return &ValidationError{Name: "symbology", err: errors.New(`ent: missing required field "ProductBarcode.symbology"`)}
+	}
+	if _, ok := pbc.mutation.Internal(); !ok {
+		return &ValidationError{Name: "internal", err: errors.New(`ent: missing required field "ProductBarcode.internal"`)}
+	}
+	if _, ok := pbc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductBarcode.created_at"`)}
+	}
+	return nil
+}
+
+func (pbc *ProductBarcodeCreate) sqlSave(ctx context.Context) (*ProductBarcode, error) {
+	_node, _spec := pbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (pbc *ProductBarcodeCreate) createSpec() (*ProductBarcode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductBarcode{config: pbc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: productbarcode.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productbarcode.FieldID,
+			},
+		}
+	)
+	if value, ok := pbc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productbarcode.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := pbc.mutation.ProductID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productbarcode.FieldProductID,
+		})
+		_node.ProductID = value
+	}
+	if value, ok := pbc.mutation.UnitID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productbarcode.FieldUnitID,
+		})
+		_node.UnitID = &value
+	}
+	if value, ok := pbc.mutation.Code(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productbarcode.FieldCode,
+		})
+		_node.Code = value
+	}
+	if value, ok := pbc.mutation.Symbology(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productbarcode.FieldSymbology,
+		})
+		_node.Symbology = value
+	}
+	if value, ok := pbc.mutation.Internal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: productbarcode.FieldInternal,
+		})
+		_node.Internal = value
+	}
+	if value, ok := pbc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productbarcode.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ProductBarcodeCreateBulk is the builder for creating many ProductBarcode entities in bulk.
+type ProductBarcodeCreateBulk struct {
+	config
+	builders []*ProductBarcodeCreate
+}
+
+// Save creates the ProductBarcode entities in the database.
+func (pbcb *ProductBarcodeCreateBulk) Save(ctx context.Context) ([]*ProductBarcode, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pbcb.builders))
+	nodes := make([]*ProductBarcode, len(pbcb.builders))
+	mutators := make([]Mutator, len(pbcb.builders))
+	for i := range pbcb.builders {
+		func(i int, root context.Context) {
+			builder := pbcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductBarcodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pbcb *ProductBarcodeCreateBulk) SaveX(ctx context.Context) []*ProductBarcode {
+	v, err := pbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pbcb *ProductBarcodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := pbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbcb *ProductBarcodeCreateBulk) ExecX(ctx context.Context) {
+	if err := pbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productbarcode"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductBarcodeDelete is the builder for deleting a ProductBarcode entity.
+type ProductBarcodeDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProductBarcodeMutation
+}
+
+// Where appends a list predicates to the ProductBarcodeDelete builder.
+func (pbd *ProductBarcodeDelete) Where(ps ...predicate.ProductBarcode) *ProductBarcodeDelete {
+	pbd.mutation.Where(ps...)
+	return pbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pbd *ProductBarcodeDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(pbd.hooks) == 0 {
+		affected, err = pbd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductBarcodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			pbd.mutation = mutation
+			affected, err = pbd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pbd.hooks) - 1; i >= 0; i-- {
+			if pbd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pbd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pbd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbd *ProductBarcodeDelete) ExecX(ctx context.Context) int {
+	n, err := pbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pbd *ProductBarcodeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: productbarcode.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productbarcode.FieldID,
+			},
+		},
+	}
+	if ps := pbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// ProductBarcodeDeleteOne is the builder for deleting a single ProductBarcode entity.
+type ProductBarcodeDeleteOne struct {
+	pbd *ProductBarcodeDelete
+}
+
+// Exec executes the deletion query.
+func (pbdo *ProductBarcodeDeleteOne) Exec(ctx context.Context) error {
+	n, err := pbdo.pbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{productbarcode.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbdo *ProductBarcodeDeleteOne) ExecX(ctx context.Context) {
+	pbdo.pbd.ExecX(ctx)
+}
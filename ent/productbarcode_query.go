@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productbarcode"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductBarcodeQuery is the builder for querying ProductBarcode entities.
+type ProductBarcodeQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ProductBarcode
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProductBarcodeQuery builder.
+func (pbq *ProductBarcodeQuery) Where(ps ...predicate.ProductBarcode) *ProductBarcodeQuery {
+	pbq.predicates = append(pbq.predicates, ps...)
+	return pbq
+}
+
+// Limit adds a limit step to the query.
+func (pbq *ProductBarcodeQuery) Limit(limit int) *ProductBarcodeQuery {
+	pbq.limit = &limit
+	return pbq
+}
+
+// Offset adds an offset step to the query.
+func (pbq *ProductBarcodeQuery) Offset(offset int) *ProductBarcodeQuery {
+	pbq.offset = &offset
+	return pbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pbq *ProductBarcodeQuery) Unique(unique bool) *ProductBarcodeQuery {
+	pbq.unique = &unique
+	return pbq
+}
+
+// Order adds an order step to the query.
+func (pbq *ProductBarcodeQuery) Order(o ...OrderFunc) *ProductBarcodeQuery {
+	pbq.order = append(pbq.order, o...)
+	return pbq
+}
+
+// First returns the first ProductBarcode entity from the query.
+// Returns a *NotFoundError when no ProductBarcode was found.
+func (pbq *ProductBarcodeQuery) First(ctx context.Context) (*ProductBarcode, error) {
+	nodes, err := pbq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{productbarcode.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pbq *ProductBarcodeQuery) FirstX(ctx context.Context) *ProductBarcode {
+	node, err := pbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProductBarcode ID from the query.
+// Returns a *NotFoundError when no ProductBarcode ID was found.
+func (pbq *ProductBarcodeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pbq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{productbarcode.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pbq *ProductBarcodeQuery) FirstIDX(ctx context.Context) int {
+	id, err := pbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProductBarcode entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProductBarcode entity is found.
+// Returns a *NotFoundError when no ProductBarcode entities are found.
+func (pbq *ProductBarcodeQuery) Only(ctx context.Context) (*ProductBarcode, error) {
+	nodes, err := pbq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{productbarcode.Label}
+	default:
+		return nil, &NotSingularError{productbarcode.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pbq *ProductBarcodeQuery) OnlyX(ctx context.Context) *ProductBarcode {
+	node, err := pbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProductBarcode ID in the query.
+// Returns a *NotSingularError when more than one ProductBarcode ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pbq *ProductBarcodeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pbq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{productbarcode.Label}
+	default:
+		err = &NotSingularError{productbarcode.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pbq *ProductBarcodeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProductBarcodes.
+func (pbq *ProductBarcodeQuery) All(ctx context.Context) ([]*ProductBarcode, error) {
+	if err := pbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return pbq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pbq *ProductBarcodeQuery) AllX(ctx context.Context) []*ProductBarcode {
+	nodes, err := pbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProductBarcode IDs.
+func (pbq *ProductBarcodeQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := pbq.Select(productbarcode.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pbq *ProductBarcodeQuery) IDsX(ctx context.Context) []int {
+	ids, err := pbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pbq *ProductBarcodeQuery) Count(ctx context.Context) (int, error) {
+	if err := pbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return pbq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pbq *ProductBarcodeQuery) CountX(ctx context.Context) int {
+	count, err := pbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pbq *ProductBarcodeQuery) Exist(ctx context.Context) (bool, error) {
+	if err := pbq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return pbq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pbq *ProductBarcodeQuery) ExistX(ctx context.Context) bool {
+	exist, err := pbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProductBarcodeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pbq *ProductBarcodeQuery) Clone() *ProductBarcodeQuery {
+	if pbq == nil {
+		return nil
+	}
+	return &ProductBarcodeQuery{
+		config:     pbq.config,
+		limit:      pbq.limit,
+		offset:     pbq.offset,
+		order:      append([]OrderFunc{}, pbq.order...),
+		predicates: append([]predicate.ProductBarcode{}, pbq.predicates...),
+		// clone intermediate query.
+		sql:    pbq.sql.Clone(),
+		path:   pbq.path,
+		unique: pbq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProductBarcode.Query().
+//		GroupBy(productbarcode.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pbq *ProductBarcodeQuery) GroupBy(field string, fields ...string) *ProductBarcodeGroupBy {
+	grbuild := &ProductBarcodeGroupBy{config: pbq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := pbq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return pbq.sqlQuery(ctx), nil
+	}
+	grbuild.label = productbarcode.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.ProductBarcode.Query().
+//		Select(productbarcode.FieldTenantID).
+//		Scan(ctx, &v)
+func (pbq *ProductBarcodeQuery) Select(fields ...string) *ProductBarcodeSelect {
+	pbq.fields = append(pbq.fields, fields...)
+	selbuild := &ProductBarcodeSelect{ProductBarcodeQuery: pbq}
+	selbuild.label = productbarcode.Label
+	selbuild.flds, selbuild.scan = &pbq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (pbq *ProductBarcodeQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range pbq.fields {
+		if !productbarcode.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pbq.path != nil {
+		prev, err := pbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pbq.sql = prev
+	}
+	return nil
+}
+
+func (pbq *ProductBarcodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProductBarcode, error) {
+	var (
+		nodes = []*ProductBarcode{}
+		_spec = pbq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ProductBarcode).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ProductBarcode{config: pbq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(pbq.modifiers) > 0 {
+		_spec.Modifiers = pbq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (pbq *ProductBarcodeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pbq.querySpec()
+	if len(pbq.modifiers) > 0 {
+		_spec.Modifiers = pbq.modifiers
+	}
+	_spec.Node.Columns = pbq.fields
+	if len(pbq.fields) > 0 {
+		_spec.Unique = pbq.unique != nil && *pbq.unique
+	}
+	return sqlgraph.CountNodes(ctx, pbq.driver, _spec)
+}
+
+func (pbq *ProductBarcodeQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := pbq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (pbq *ProductBarcodeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productbarcode.Table,
+			Columns: productbarcode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productbarcode.FieldID,
+			},
+		},
+		From:   pbq.sql,
+		Unique: true,
+	}
+	if unique := pbq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := pbq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productbarcode.FieldID)
+		for i := range fields {
+			if fields[i] != productbarcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pbq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pbq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pbq *ProductBarcodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pbq.driver.Dialect())
+	t1 := builder.Table(productbarcode.Table)
+	columns := pbq.fields
+	if len(columns) == 0 {
+		columns = productbarcode.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pbq.sql != nil {
+		selector = pbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pbq.unique != nil && *pbq.unique {
+		selector.Distinct()
+	}
+	for _, m := range pbq.modifiers {
+		m(selector)
+	}
+	for _, p := range pbq.predicates {
+		p(selector)
+	}
+	for _, p := range pbq.order {
+		p(selector)
+	}
+	if offset := pbq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pbq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pbq *ProductBarcodeQuery) ForUpdate(opts ...sql.LockOption) *ProductBarcodeQuery {
+	if pbq.driver.Dialect() == dialect.Postgres {
+		pbq.Unique(false)
+	}
+	pbq.modifiers = append(pbq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pbq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pbq *ProductBarcodeQuery) ForShare(opts ...sql.LockOption) *ProductBarcodeQuery {
+	if pbq.driver.Dialect() == dialect.Postgres {
+		pbq.Unique(false)
+	}
+	pbq.modifiers = append(pbq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pbq
+}
+
+// ProductBarcodeGroupBy is the group-by builder for ProductBarcode entities.
+type ProductBarcodeGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pbgb *ProductBarcodeGroupBy) Aggregate(fns ...AggregateFunc) *ProductBarcodeGroupBy {
+	pbgb.fns = append(pbgb.fns, fns...)
+	return pbgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (pbgb *ProductBarcodeGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := pbgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	pbgb.sql = query
+	return pbgb.sqlScan(ctx, v)
+}
+
+func (pbgb *ProductBarcodeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range pbgb.fields {
+		if !productbarcode.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := pbgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pbgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (pbgb *ProductBarcodeGroupBy) sqlQuery() *sql.Selector {
+	selector := pbgb.sql.Select()
+	aggregation := make([]string, 0, len(pbgb.fns))
+	for _, fn := range pbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(pbgb.fields)+len(pbgb.fns))
+		for _, f := range pbgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(pbgb.fields...)...)
+}
+
+// ProductBarcodeSelect is the builder for selecting fields of ProductBarcode entities.
+type ProductBarcodeSelect struct {
+	*ProductBarcodeQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pbs *ProductBarcodeSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := pbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	pbs.sql = pbs.ProductBarcodeQuery.sqlQuery(ctx)
+	return pbs.sqlScan(ctx, v)
+}
+
+func (pbs *ProductBarcodeSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := pbs.sql.Query()
+	if err := pbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
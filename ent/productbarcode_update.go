@@ -0,0 +1,573 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productbarcode"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductBarcodeUpdate is the builder for updating ProductBarcode entities.
+type ProductBarcodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductBarcodeMutation
+}
+
+// Where appends a list predicates to the ProductBarcodeUpdate builder.
+func (pbu *ProductBarcodeUpdate) Where(ps ...predicate.ProductBarcode) *ProductBarcodeUpdate {
+	pbu.mutation.Where(ps...)
+	return pbu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (pbu *ProductBarcodeUpdate) SetTenantID(i int) *ProductBarcodeUpdate {
+	pbu.mutation.ResetTenantID()
+	pbu.mutation.SetTenantID(i)
+	return pbu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (pbu *ProductBarcodeUpdate) AddTenantID(i int) *ProductBarcodeUpdate {
+	pbu.mutation.AddTenantID(i)
+	return pbu
+}
+
+// SetProductID sets the "product_id" field.
+func (pbu *ProductBarcodeUpdate) SetProductID(i int) *ProductBarcodeUpdate {
+	pbu.mutation.ResetProductID()
+	pbu.mutation.SetProductID(i)
+	return pbu
+}
+
+// AddProductID adds i to the "product_id" field.
+func (pbu *ProductBarcodeUpdate) AddProductID(i int) *ProductBarcodeUpdate {
+	pbu.mutation.AddProductID(i)
+	return pbu
+}
+
+// SetUnitID sets the "unit_id" field.
+func (pbu *ProductBarcodeUpdate) SetUnitID(i int) *ProductBarcodeUpdate {
+	pbu.mutation.ResetUnitID()
+	pbu.mutation.SetUnitID(i)
+	return pbu
+}
+
+// SetNillableUnitID sets the "unit_id" field if the given value is not nil.
+func (pbu *ProductBarcodeUpdate) SetNillableUnitID(i *int) *ProductBarcodeUpdate {
+	if i != nil {
+		pbu.SetUnitID(*i)
+	}
+	return pbu
+}
+
+// AddUnitID adds i to the "unit_id" field.
+func (pbu *ProductBarcodeUpdate) AddUnitID(i int) *ProductBarcodeUpdate {
+	pbu.mutation.AddUnitID(i)
+	return pbu
+}
+
+// ClearUnitID clears the value of the "unit_id" field.
+func (pbu *ProductBarcodeUpdate) ClearUnitID() *ProductBarcodeUpdate {
+	pbu.mutation.ClearUnitID()
+	return pbu
+}
+
+// SetCode sets the "code" field.
+func (pbu *ProductBarcodeUpdate) SetCode(s string) *ProductBarcodeUpdate {
+	pbu.mutation.SetCode(s)
+	return pbu
+}
+
+// SetSymbology sets the "symbology" field.
+func (pbu *ProductBarcodeUpdate) SetSymbology(s string) *ProductBarcodeUpdate {
+	pbu.mutation.SetSymbology(s)
+	return pbu
+}
+
+// SetInternal sets the "internal" field.
+func (pbu *ProductBarcodeUpdate) SetInternal(b bool) *ProductBarcodeUpdate {
+	pbu.mutation.SetInternal(b)
+	return pbu
+}
+
+// SetNillableInternal sets the "internal" field if the given value is not nil.
+func (pbu *ProductBarcodeUpdate) SetNillableInternal(b *bool) *ProductBarcodeUpdate {
+	if b != nil {
+		pbu.SetInternal(*b)
+	}
+	return pbu
+}
+
+// Mutation returns the ProductBarcodeMutation object of the builder.
+func (pbu *ProductBarcodeUpdate) Mutation() *ProductBarcodeMutation {
+	return pbu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pbu *ProductBarcodeUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(pbu.hooks) == 0 {
+		if err = pbu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = pbu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductBarcodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pbu.check(); err != nil {
+				return 0, err
+			}
+			pbu.mutation = mutation
+			affected, err = pbu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pbu.hooks) - 1; i >= 0; i-- {
+			if pbu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pbu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pbu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pbu *ProductBarcodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := pbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pbu *ProductBarcodeUpdate) Exec(ctx context.Context) error {
+	_, err := pbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbu *ProductBarcodeUpdate) ExecX(ctx context.Context) {
+	if err := pbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pbu *ProductBarcodeUpdate) check() error {
+	if v, ok := pbu.mutation.Code(); ok {
+		if err := productbarcode.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "ProductBarcode.code": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pbu *ProductBarcodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productbarcode.Table,
+			Columns: productbarcode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productbarcode.FieldID,
+			},
+		},
+	}
+	if ps := pbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pbu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productbarcode.FieldTenantID,
+		})
+	}
+	if value, ok := pbu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productbarcode.FieldTenantID,
+		})
+	}
+	if value, ok := pbu.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productbarcode.FieldProductID,
+		})
+	}
+	if value, ok := pbu.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productbarcode.FieldProductID,
+		})
+	}
+	if value, ok := pbu.mutation.UnitID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productbarcode.FieldUnitID,
+		})
+	}
+	if value, ok := pbu.mutation.AddedUnitID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productbarcode.FieldUnitID,
+		})
+	}
+	if pbu.mutation.UnitIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: productbarcode.FieldUnitID,
+		})
+	}
+	if value, ok := pbu.mutation.Code(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productbarcode.FieldCode,
+		})
+	}
+	if value, ok := pbu.mutation.Symbology(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productbarcode.FieldSymbology,
+		})
+	}
+	if value, ok := pbu.mutation.Internal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: productbarcode.FieldInternal,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productbarcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ProductBarcodeUpdateOne is the builder for updating a single ProductBarcode entity.
+type ProductBarcodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductBarcodeMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (pbuo *ProductBarcodeUpdateOne) SetTenantID(i int) *ProductBarcodeUpdateOne {
+	pbuo.mutation.ResetTenantID()
+	pbuo.mutation.SetTenantID(i)
+	return pbuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (pbuo *ProductBarcodeUpdateOne) AddTenantID(i int) *ProductBarcodeUpdateOne {
+	pbuo.mutation.AddTenantID(i)
+	return pbuo
+}
+
+// SetProductID sets the "product_id" field.
+func (pbuo *ProductBarcodeUpdateOne) SetProductID(i int) *ProductBarcodeUpdateOne {
+	pbuo.mutation.ResetProductID()
+	pbuo.mutation.SetProductID(i)
+	return pbuo
+}
+
+// AddProductID adds i to the "product_id" field.
+func (pbuo *ProductBarcodeUpdateOne) AddProductID(i int) *ProductBarcodeUpdateOne {
+	pbuo.mutation.AddProductID(i)
+	return pbuo
+}
+
+// SetUnitID sets the "unit_id" field.
+func (pbuo *ProductBarcodeUpdateOne) SetUnitID(i int) *ProductBarcodeUpdateOne {
+	pbuo.mutation.ResetUnitID()
+	pbuo.mutation.SetUnitID(i)
+	return pbuo
+}
+
+// SetNillableUnitID sets the "unit_id" field if the given value is not nil.
+func (pbuo *ProductBarcodeUpdateOne) SetNillableUnitID(i *int) *ProductBarcodeUpdateOne {
+	if i != nil {
+		pbuo.SetUnitID(*i)
+	}
+	return pbuo
+}
+
+// AddUnitID adds i to the "unit_id" field.
+func (pbuo *ProductBarcodeUpdateOne) AddUnitID(i int) *ProductBarcodeUpdateOne {
+	pbuo.mutation.AddUnitID(i)
+	return pbuo
+}
+
+// ClearUnitID clears the value of the "unit_id" field.
+func (pbuo *ProductBarcodeUpdateOne) ClearUnitID() *ProductBarcodeUpdateOne {
+	pbuo.mutation.ClearUnitID()
+	return pbuo
+}
+
+// SetCode sets the "code" field.
+func (pbuo *ProductBarcodeUpdateOne) SetCode(s string) *ProductBarcodeUpdateOne {
+	pbuo.mutation.SetCode(s)
+	return pbuo
+}
+
+// SetSymbology sets the "symbology" field.
+func (pbuo *ProductBarcodeUpdateOne) SetSymbology(s string) *ProductBarcodeUpdateOne {
+	pbuo.mutation.SetSymbology(s)
+	return pbuo
+}
+
+// SetInternal sets the "internal" field.
+func (pbuo *ProductBarcodeUpdateOne) SetInternal(b bool) *ProductBarcodeUpdateOne {
+	pbuo.mutation.SetInternal(b)
+	return pbuo
+}
+
+// SetNillableInternal sets the "internal" field if the given value is not nil.
+func (pbuo *ProductBarcodeUpdateOne) SetNillableInternal(b *bool) *ProductBarcodeUpdateOne {
+	if b != nil {
+		pbuo.SetInternal(*b)
+	}
+	return pbuo
+}
+
+// Mutation returns the ProductBarcodeMutation object of the builder.
+func (pbuo *ProductBarcodeUpdateOne) Mutation() *ProductBarcodeMutation {
+	return pbuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pbuo *ProductBarcodeUpdateOne) Select(field string, fields ...string) *ProductBarcodeUpdateOne {
+	pbuo.fields = append([]string{field}, fields...)
+	return pbuo
+}
+
+// Save executes the query and returns the updated ProductBarcode entity.
+func (pbuo *ProductBarcodeUpdateOne) Save(ctx context.Context) (*ProductBarcode, error) {
+	var (
+		err  error
+		node *ProductBarcode
+	)
+	if len(pbuo.hooks) == 0 {
+		if err = pbuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = pbuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductBarcodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pbuo.check(); err != nil {
+				return nil, err
+			}
+			pbuo.mutation = mutation
+			node, err = pbuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pbuo.hooks) - 1; i >= 0; i-- {
+			if pbuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pbuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, pbuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ProductBarcode)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ProductBarcodeMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pbuo *ProductBarcodeUpdateOne) SaveX(ctx context.Context) *ProductBarcode {
+	node, err := pbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pbuo *ProductBarcodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := pbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pbuo *ProductBarcodeUpdateOne) ExecX(ctx context.Context) {
+	if err := pbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pbuo *ProductBarcodeUpdateOne) check() error {
+	if v, ok := pbuo.mutation.Code(); ok {
+		if err := productbarcode.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "ProductBarcode.code": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pbuo *ProductBarcodeUpdateOne) sqlSave(ctx context.Context) (_node *ProductBarcode, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productbarcode.Table,
+			Columns: productbarcode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productbarcode.FieldID,
+			},
+		},
+	}
+	id, ok := pbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductBarcode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productbarcode.FieldID)
+		for _, f := range fields {
+			if !productbarcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productbarcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pbuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productbarcode.FieldTenantID,
+		})
+	}
+	if value, ok := pbuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productbarcode.FieldTenantID,
+		})
+	}
+	if value, ok := pbuo.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productbarcode.FieldProductID,
+		})
+	}
+	if value, ok := pbuo.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productbarcode.FieldProductID,
+		})
+	}
+	if value, ok := pbuo.mutation.UnitID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productbarcode.FieldUnitID,
+		})
+	}
+	if value, ok := pbuo.mutation.AddedUnitID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productbarcode.FieldUnitID,
+		})
+	}
+	if pbuo.mutation.UnitIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: productbarcode.FieldUnitID,
+		})
+	}
+	if value, ok := pbuo.mutation.Code(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productbarcode.FieldCode,
+		})
+	}
+	if value, ok := pbuo.mutation.Symbology(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productbarcode.FieldSymbology,
+		})
+	}
+	if value, ok := pbuo.mutation.Internal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: productbarcode.FieldInternal,
+		})
+	}
+	_node = &ProductBarcode{config: pbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productbarcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/productfile"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProductFile is the model entity for the ProductFile schema.
+type ProductFile struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// ID del producto
+	ProductID int `json:"product_id,omitempty"`
+	// Tipo de archivo (image, attachment)
+	Kind string `json:"kind,omitempty"`
+	// Nombre original del archivo
+	FileName string `json:"file_name,omitempty"`
+	// Tipo MIME del archivo
+	ContentType string `json:"content_type,omitempty"`
+	// Tamaño en bytes
+	Size int64 `json:"size,omitempty"`
+	// Clave del archivo en el almacenamiento
+	StorageKey string `json:"storage_key,omitempty"`
+	// Clave de la miniatura; solo para imágenes
+	ThumbnailKey string `json:"thumbnail_key,omitempty"`
+	// Ancho en píxeles de la imagen original
+	Width int `json:"width,omitempty"`
+	// Alto en píxeles de la imagen original
+	Height int `json:"height,omitempty"`
+	// Orden de la imagen; la primera es la principal del producto
+	Position int `json:"position,omitempty"`
+	// ID del usuario que subió el archivo
+	UserID int `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProductFile) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case productfile.FieldID, productfile.FieldTenantID, productfile.FieldProductID, productfile.FieldSize, productfile.FieldWidth, productfile.FieldHeight, productfile.FieldPosition, productfile.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case productfile.FieldKind, productfile.FieldFileName, productfile.FieldContentType, productfile.FieldStorageKey, productfile.FieldThumbnailKey:
+			values[i] = new(sql.NullString)
+		case productfile.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ProductFile", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProductFile fields.
+func (pf *ProductFile) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case productfile.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pf.ID = int(value.Int64)
+		case productfile.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				pf.TenantID = int(value.Int64)
+			}
+		case productfile.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				pf.ProductID = int(value.Int64)
+			}
+		case productfile.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				pf.Kind = value.String
+			}
+		case productfile.FieldFileName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_name", values[i])
+			} else if value.Valid {
+				pf.FileName = value.String
+			}
+		case productfile.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				pf.ContentType = value.String
+			}
+		case productfile.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				pf.Size = value.Int64
+			}
+		case productfile.FieldStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_key", values[i])
+			} else if value.Valid {
+				pf.StorageKey = value.String
+			}
+		case productfile.FieldThumbnailKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail_key", values[i])
+			} else if value.Valid {
+				pf.ThumbnailKey = value.String
+			}
+		case productfile.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				pf.Width = int(value.Int64)
+			}
+		case productfile.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				pf.Height = int(value.Int64)
+			}
+		case productfile.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				pf.Position = int(value.Int64)
+			}
+		case productfile.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				pf.UserID = int(value.Int64)
+			}
+		case productfile.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pf.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ProductFile.
+// Note that you need to call ProductFile.Unwrap() before calling this method if this ProductFile
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pf *ProductFile) Update() *ProductFileUpdateOne {
+	return (&ProductFileClient{config: pf.config}).UpdateOne(pf)
+}
+
+// Unwrap unwraps the ProductFile entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pf *ProductFile) Unwrap() *ProductFile {
+	_tx, ok := pf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProductFile is not a transactional entity")
+	}
+	pf.config.driver = _tx.drv
+	return pf
+}
+
+// String implements the fmt.Stringer.
+func (pf *ProductFile) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProductFile(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pf.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", pf.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", pf.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(pf.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("file_name=")
+	builder.WriteString(pf.FileName)
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(pf.ContentType)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", pf.Size))
+	builder.WriteString(", ")
+	builder.WriteString("storage_key=")
+	builder.WriteString(pf.StorageKey)
+	builder.WriteString(", ")
+	builder.WriteString("thumbnail_key=")
+	builder.WriteString(pf.ThumbnailKey)
+	builder.WriteString(", ")
+	builder.WriteString("width=")
+	builder.WriteString(fmt.Sprintf("%v", pf.Width))
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", pf.Height))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", pf.Position))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", pf.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pf.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProductFiles is a parsable slice of ProductFile.
+type ProductFiles []*ProductFile
+
+func (pf ProductFiles) config(cfg config) {
+	for _i := range pf {
+		pf[_i].config = cfg
+	}
+}
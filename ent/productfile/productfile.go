@@ -0,0 +1,89 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productfile
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the productfile type in the database.
+	Label = "product_file"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldFileName holds the string denoting the file_name field in the database.
+	FieldFileName = "file_name"
+	// FieldContentType holds the string denoting the content_type field in the database.
+	FieldContentType = "content_type"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldStorageKey holds the string denoting the storage_key field in the database.
+	FieldStorageKey = "storage_key"
+	// FieldThumbnailKey holds the string denoting the thumbnail_key field in the database.
+	FieldThumbnailKey = "thumbnail_key"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the productfile in the database.
+	Table = "product_files"
+)
+
+// Columns holds all SQL columns for productfile fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldProductID,
+	FieldKind,
+	FieldFileName,
+	FieldContentType,
+	FieldSize,
+	FieldStorageKey,
+	FieldThumbnailKey,
+	FieldWidth,
+	FieldHeight,
+	FieldPosition,
+	FieldUserID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultKind holds the default value on creation for the "kind" field.
+	DefaultKind string
+	// FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
+	FileNameValidator func(string) error
+	// SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	SizeValidator func(int64) error
+	// StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	StorageKeyValidator func(string) error
+	// DefaultWidth holds the default value on creation for the "width" field.
+	DefaultWidth int
+	// DefaultHeight holds the default value on creation for the "height" field.
+	DefaultHeight int
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,1381 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productfile
+
+import (
+	"Veritasbackend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKind), v))
+	})
+}
+
+// FileName applies equality check predicate on the "file_name" field. It's identical to FileNameEQ.
+func FileName(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFileName), v))
+	})
+}
+
+// ContentType applies equality check predicate on the "content_type" field. It's identical to ContentTypeEQ.
+func ContentType(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldContentType), v))
+	})
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSize), v))
+	})
+}
+
+// StorageKey applies equality check predicate on the "storage_key" field. It's identical to StorageKeyEQ.
+func StorageKey(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStorageKey), v))
+	})
+}
+
+// ThumbnailKey applies equality check predicate on the "thumbnail_key" field. It's identical to ThumbnailKeyEQ.
+func ThumbnailKey(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldThumbnailKey), v))
+	})
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWidth), v))
+	})
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHeight), v))
+	})
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPosition), v))
+	})
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProductID), v))
+	})
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKind), v))
+	})
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldKind), v))
+	})
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldKind), v...))
+	})
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldKind), v...))
+	})
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldKind), v))
+	})
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldKind), v))
+	})
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldKind), v))
+	})
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldKind), v))
+	})
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldKind), v))
+	})
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldKind), v))
+	})
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldKind), v))
+	})
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldKind), v))
+	})
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldKind), v))
+	})
+}
+
+// FileNameEQ applies the EQ predicate on the "file_name" field.
+func FileNameEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFileName), v))
+	})
+}
+
+// FileNameNEQ applies the NEQ predicate on the "file_name" field.
+func FileNameNEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFileName), v))
+	})
+}
+
+// FileNameIn applies the In predicate on the "file_name" field.
+func FileNameIn(vs ...string) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldFileName), v...))
+	})
+}
+
+// FileNameNotIn applies the NotIn predicate on the "file_name" field.
+func FileNameNotIn(vs ...string) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldFileName), v...))
+	})
+}
+
+// FileNameGT applies the GT predicate on the "file_name" field.
+func FileNameGT(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldFileName), v))
+	})
+}
+
+// FileNameGTE applies the GTE predicate on the "file_name" field.
+func FileNameGTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldFileName), v))
+	})
+}
+
+// FileNameLT applies the LT predicate on the "file_name" field.
+func FileNameLT(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldFileName), v))
+	})
+}
+
+// FileNameLTE applies the LTE predicate on the "file_name" field.
+func FileNameLTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldFileName), v))
+	})
+}
+
+// FileNameContains applies the Contains predicate on the "file_name" field.
+func FileNameContains(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldFileName), v))
+	})
+}
+
+// FileNameHasPrefix applies the HasPrefix predicate on the "file_name" field.
+func FileNameHasPrefix(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldFileName), v))
+	})
+}
+
+// FileNameHasSuffix applies the HasSuffix predicate on the "file_name" field.
+func FileNameHasSuffix(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldFileName), v))
+	})
+}
+
+// FileNameEqualFold applies the EqualFold predicate on the "file_name" field.
+func FileNameEqualFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldFileName), v))
+	})
+}
+
+// FileNameContainsFold applies the ContainsFold predicate on the "file_name" field.
+func FileNameContainsFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldFileName), v))
+	})
+}
+
+// ContentTypeEQ applies the EQ predicate on the "content_type" field.
+func ContentTypeEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldContentType), v))
+	})
+}
+
+// ContentTypeNEQ applies the NEQ predicate on the "content_type" field.
+func ContentTypeNEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldContentType), v))
+	})
+}
+
+// ContentTypeIn applies the In predicate on the "content_type" field.
+func ContentTypeIn(vs ...string) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldContentType), v...))
+	})
+}
+
+// ContentTypeNotIn applies the NotIn predicate on the "content_type" field.
+func ContentTypeNotIn(vs ...string) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldContentType), v...))
+	})
+}
+
+// ContentTypeGT applies the GT predicate on the "content_type" field.
+func ContentTypeGT(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldContentType), v))
+	})
+}
+
+// ContentTypeGTE applies the GTE predicate on the "content_type" field.
+func ContentTypeGTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldContentType), v))
+	})
+}
+
+// ContentTypeLT applies the LT predicate on the "content_type" field.
+func ContentTypeLT(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldContentType), v))
+	})
+}
+
+// ContentTypeLTE applies the LTE predicate on the "content_type" field.
+func ContentTypeLTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldContentType), v))
+	})
+}
+
+// ContentTypeContains applies the Contains predicate on the "content_type" field.
+func ContentTypeContains(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldContentType), v))
+	})
+}
+
+// ContentTypeHasPrefix applies the HasPrefix predicate on the "content_type" field.
+func ContentTypeHasPrefix(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldContentType), v))
+	})
+}
+
+// ContentTypeHasSuffix applies the HasSuffix predicate on the "content_type" field.
+func ContentTypeHasSuffix(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldContentType), v))
+	})
+}
+
+// ContentTypeEqualFold applies the EqualFold predicate on the "content_type" field.
+func ContentTypeEqualFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldContentType), v))
+	})
+}
+
+// ContentTypeContainsFold applies the ContainsFold predicate on the "content_type" field.
+func ContentTypeContainsFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldContentType), v))
+	})
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSize), v))
+	})
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSize), v))
+	})
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSize), v...))
+	})
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSize), v...))
+	})
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSize), v))
+	})
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSize), v))
+	})
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSize), v))
+	})
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSize), v))
+	})
+}
+
+// StorageKeyEQ applies the EQ predicate on the "storage_key" field.
+func StorageKeyEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStorageKey), v))
+	})
+}
+
+// StorageKeyNEQ applies the NEQ predicate on the "storage_key" field.
+func StorageKeyNEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStorageKey), v))
+	})
+}
+
+// StorageKeyIn applies the In predicate on the "storage_key" field.
+func StorageKeyIn(vs ...string) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStorageKey), v...))
+	})
+}
+
+// StorageKeyNotIn applies the NotIn predicate on the "storage_key" field.
+func StorageKeyNotIn(vs ...string) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStorageKey), v...))
+	})
+}
+
+// StorageKeyGT applies the GT predicate on the "storage_key" field.
+func StorageKeyGT(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStorageKey), v))
+	})
+}
+
+// StorageKeyGTE applies the GTE predicate on the "storage_key" field.
+func StorageKeyGTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStorageKey), v))
+	})
+}
+
+// StorageKeyLT applies the LT predicate on the "storage_key" field.
+func StorageKeyLT(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStorageKey), v))
+	})
+}
+
+// StorageKeyLTE applies the LTE predicate on the "storage_key" field.
+func StorageKeyLTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStorageKey), v))
+	})
+}
+
+// StorageKeyContains applies the Contains predicate on the "storage_key" field.
+func StorageKeyContains(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStorageKey), v))
+	})
+}
+
+// StorageKeyHasPrefix applies the HasPrefix predicate on the "storage_key" field.
+func StorageKeyHasPrefix(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStorageKey), v))
+	})
+}
+
+// StorageKeyHasSuffix applies the HasSuffix predicate on the "storage_key" field.
+func StorageKeyHasSuffix(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStorageKey), v))
+	})
+}
+
+// StorageKeyEqualFold applies the EqualFold predicate on the "storage_key" field.
+func StorageKeyEqualFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStorageKey), v))
+	})
+}
+
+// StorageKeyContainsFold applies the ContainsFold predicate on the "storage_key" field.
+func StorageKeyContainsFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStorageKey), v))
+	})
+}
+
+// ThumbnailKeyEQ applies the EQ predicate on the "thumbnail_key" field.
+func ThumbnailKeyEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldThumbnailKey), v))
+	})
+}
+
+// ThumbnailKeyNEQ applies the NEQ predicate on the "thumbnail_key" field.
+func ThumbnailKeyNEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldThumbnailKey), v))
+	})
+}
+
+// ThumbnailKeyIn applies the In predicate on the "thumbnail_key" field.
+func ThumbnailKeyIn(vs ...string) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldThumbnailKey), v...))
+	})
+}
+
+// ThumbnailKeyNotIn applies the NotIn predicate on the "thumbnail_key" field.
+func ThumbnailKeyNotIn(vs ...string) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldThumbnailKey), v...))
+	})
+}
+
+// ThumbnailKeyGT applies the GT predicate on the "thumbnail_key" field.
+func ThumbnailKeyGT(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldThumbnailKey), v))
+	})
+}
+
+// ThumbnailKeyGTE applies the GTE predicate on the "thumbnail_key" field.
+func ThumbnailKeyGTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldThumbnailKey), v))
+	})
+}
+
+// ThumbnailKeyLT applies the LT predicate on the "thumbnail_key" field.
+func ThumbnailKeyLT(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldThumbnailKey), v))
+	})
+}
+
+// ThumbnailKeyLTE applies the LTE predicate on the "thumbnail_key" field.
+func ThumbnailKeyLTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldThumbnailKey), v))
+	})
+}
+
+// ThumbnailKeyContains applies the Contains predicate on the "thumbnail_key" field.
+func ThumbnailKeyContains(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldThumbnailKey), v))
+	})
+}
+
+// ThumbnailKeyHasPrefix applies the HasPrefix predicate on the "thumbnail_key" field.
+func ThumbnailKeyHasPrefix(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldThumbnailKey), v))
+	})
+}
+
+// ThumbnailKeyHasSuffix applies the HasSuffix predicate on the "thumbnail_key" field.
+func ThumbnailKeyHasSuffix(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldThumbnailKey), v))
+	})
+}
+
+// ThumbnailKeyIsNil applies the IsNil predicate on the "thumbnail_key" field.
+func ThumbnailKeyIsNil() predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldThumbnailKey)))
+	})
+}
+
+// ThumbnailKeyNotNil applies the NotNil predicate on the "thumbnail_key" field.
+func ThumbnailKeyNotNil() predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldThumbnailKey)))
+	})
+}
+
+// ThumbnailKeyEqualFold applies the EqualFold predicate on the "thumbnail_key" field.
+func ThumbnailKeyEqualFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldThumbnailKey), v))
+	})
+}
+
+// ThumbnailKeyContainsFold applies the ContainsFold predicate on the "thumbnail_key" field.
+func ThumbnailKeyContainsFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldThumbnailKey), v))
+	})
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWidth), v))
+	})
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWidth), v))
+	})
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldWidth), v...))
+	})
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldWidth), v...))
+	})
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWidth), v))
+	})
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWidth), v))
+	})
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWidth), v))
+	})
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWidth), v))
+	})
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHeight), v))
+	})
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHeight), v))
+	})
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldHeight), v...))
+	})
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldHeight), v...))
+	})
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldHeight), v))
+	})
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldHeight), v))
+	})
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldHeight), v))
+	})
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldHeight), v))
+	})
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPosition), v))
+	})
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPosition), v))
+	})
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPosition), v...))
+	})
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPosition), v...))
+	})
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPosition), v))
+	})
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPosition), v))
+	})
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPosition), v))
+	})
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPosition), v))
+	})
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserID), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProductFile {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductFile(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProductFile) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProductFile) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProductFile) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,517 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/productfile"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductFileCreate is the builder for creating a ProductFile entity.
+type ProductFileCreate struct {
+	config
+	mutation *ProductFileMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (pfc *ProductFileCreate) SetTenantID(i int) *ProductFileCreate {
+	pfc.mutation.SetTenantID(i)
+	return pfc
+}
+
+// SetProductID sets the "product_id" field.
+func (pfc *ProductFileCreate) SetProductID(i int) *ProductFileCreate {
+	pfc.mutation.SetProductID(i)
+	return pfc
+}
+
+// SetKind sets the "kind" field.
+func (pfc *ProductFileCreate) SetKind(s string) *ProductFileCreate {
+	pfc.mutation.SetKind(s)
+	return pfc
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (pfc *ProductFileCreate) SetNillableKind(s *string) *ProductFileCreate {
+	if s != nil {
+		pfc.SetKind(*s)
+	}
+	return pfc
+}
+
+// SetFileName sets the "file_name" field.
+func (pfc *ProductFileCreate) SetFileName(s string) *ProductFileCreate {
+	pfc.mutation.SetFileName(s)
+	return pfc
+}
+
+// SetContentType sets the "content_type" field.
+func (pfc *ProductFileCreate) SetContentType(s string) *ProductFileCreate {
+	pfc.mutation.SetContentType(s)
+	return pfc
+}
+
+// SetSize sets the "size" field.
+func (pfc *ProductFileCreate) SetSize(i int64) *ProductFileCreate {
+	pfc.mutation.SetSize(i)
+	return pfc
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (pfc *ProductFileCreate) SetStorageKey(s string) *ProductFileCreate {
+	pfc.mutation.SetStorageKey(s)
+	return pfc
+}
+
+// SetThumbnailKey sets the "thumbnail_key" field.
+func (pfc *ProductFileCreate) SetThumbnailKey(s string) *ProductFileCreate {
+	pfc.mutation.SetThumbnailKey(s)
+	return pfc
+}
+
+// SetNillableThumbnailKey sets the "thumbnail_key" field if the given value is not nil.
+func (pfc *ProductFileCreate) SetNillableThumbnailKey(s *string) *ProductFileCreate {
+	if s != nil {
+		pfc.SetThumbnailKey(*s)
+	}
+	return pfc
+}
+
+// SetWidth sets the "width" field.
+func (pfc *ProductFileCreate) SetWidth(i int) *ProductFileCreate {
+	pfc.mutation.SetWidth(i)
+	return pfc
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (pfc *ProductFileCreate) SetNillableWidth(i *int) *ProductFileCreate {
+	if i != nil {
+		pfc.SetWidth(*i)
+	}
+	return pfc
+}
+
+// SetHeight sets the "height" field.
+func (pfc *ProductFileCreate) SetHeight(i int) *ProductFileCreate {
+	pfc.mutation.SetHeight(i)
+	return pfc
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (pfc *ProductFileCreate) SetNillableHeight(i *int) *ProductFileCreate {
+	if i != nil {
+		pfc.SetHeight(*i)
+	}
+	return pfc
+}
+
+// SetPosition sets the "position" field.
+func (pfc *ProductFileCreate) SetPosition(i int) *ProductFileCreate {
+	pfc.mutation.SetPosition(i)
+	return pfc
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pfc *ProductFileCreate) SetNillablePosition(i *int) *ProductFileCreate {
+	if i != nil {
+		pfc.SetPosition(*i)
+	}
+	return pfc
+}
+
+// SetUserID sets the "user_id" field.
+func (pfc *ProductFileCreate) SetUserID(i int) *ProductFileCreate {
+	pfc.mutation.SetUserID(i)
+	return pfc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pfc *ProductFileCreate) SetCreatedAt(t time.Time) *ProductFileCreate {
+	pfc.mutation.SetCreatedAt(t)
+	return pfc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pfc *ProductFileCreate) SetNillableCreatedAt(t *time.Time) *ProductFileCreate {
+	if t != nil {
+		pfc.SetCreatedAt(*t)
+	}
+	return pfc
+}
+
+// Mutation returns the ProductFileMutation object of the builder.
+func (pfc *ProductFileCreate) Mutation() *ProductFileMutation {
+	return pfc.mutation
+}
+
+// Save creates the ProductFile in the database.
+func (pfc *ProductFileCreate) Save(ctx context.Context) (*ProductFile, error) {
+	var (
+		err  error
+		node *ProductFile
+	)
+	pfc.defaults()
+	if len(pfc.hooks) == 0 {
+		if err = pfc.check(); err != nil {
+			return nil, err
+		}
+		node, err = pfc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductFileMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pfc.check(); err != nil {
+				return nil, err
+			}
+			pfc.mutation = mutation
+			if node, err = pfc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pfc.hooks) - 1; i >= 0; i-- {
+			if pfc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pfc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, pfc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ProductFile)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ProductFileMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pfc *ProductFileCreate) SaveX(ctx context.Context) *ProductFile {
+	v, err := pfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pfc *ProductFileCreate) Exec(ctx context.Context) error {
+	_, err := pfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfc *ProductFileCreate) ExecX(ctx context.Context) {
+	if err := pfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pfc *ProductFileCreate) defaults() {
+	if _, ok := pfc.mutation.Kind(); !ok {
+		v := productfile.DefaultKind
+		pfc.mutation.SetKind(v)
+	}
+	if _, ok := pfc.mutation.Width(); !ok {
+		v := productfile.DefaultWidth
+		pfc.mutation.SetWidth(v)
+	}
+	if _, ok := pfc.mutation.Height(); !ok {
+		v := productfile.DefaultHeight
+		pfc.mutation.SetHeight(v)
+	}
+	if _, ok := pfc.mutation.Position(); !ok {
+		v := productfile.DefaultPosition
+		pfc.mutation.SetPosition(v)
+	}
+	if _, ok := pfc.mutation.CreatedAt(); !ok {
+		v := productfile.DefaultCreatedAt()
+		pfc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pfc *ProductFileCreate) check() error {
+	if _, ok := pfc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ProductFile.tenant_id"`)}
+	}
+	if _, ok := pfc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "ProductFile.product_id"`)}
+	}
+	if _, ok := pfc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ProductFile.kind"`)}
+	}
+	if _, ok := pfc.mutation.FileName(); !ok {
+		return &ValidationError{Name: "file_name", err: errors.New(`ent: missing required field "ProductFile.file_name"`)}
+	}
+	if v, ok := pfc.mutation.FileName(); ok {
+		if err := productfile.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "ProductFile.file_name": %w`, err)}
+		}
+	}
+	if _, ok := pfc.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`ent: missing required field "ProductFile.content_type"`)}
+	}
+	if _, ok := pfc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "ProductFile.size"`)}
+	}
+	if v, ok := pfc.mutation.Size(); ok {
+		if err := productfile.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "ProductFile.size": %w`, err)}
+		}
+	}
+	if _, ok := pfc.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`ent: missing required field "ProductFile.storage_key"`)}
+	}
+	if v, ok := pfc.mutation.StorageKey(); ok {
+		if err := productfile.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "ProductFile.storage_key": %w`, err)}
+		}
+	}
+	if _, ok := pfc.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "ProductFile.width"`)}
+	}
+	if _, ok := pfc.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "ProductFile.height"`)}
+	}
+	if _, ok := pfc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "ProductFile.position"`)}
+	}
+	if _, ok := pfc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ProductFile.user_id"`)}
+	}
+	if _, ok := pfc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductFile.created_at"`)}
+	}
+	return nil
+}
+
+func (pfc *ProductFileCreate) sqlSave(ctx context.Context) (*ProductFile, error) {
+	_node, _spec := pfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (pfc *ProductFileCreate) createSpec() (*ProductFile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductFile{config: pfc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: productfile.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productfile.FieldID,
+			},
+		}
+	)
+	if value, ok := pfc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := pfc.mutation.ProductID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldProductID,
+		})
+		_node.ProductID = value
+	}
+	if value, ok := pfc.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productfile.FieldKind,
+		})
+		_node.Kind = value
+	}
+	if value, ok := pfc.mutation.FileName(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productfile.FieldFileName,
+		})
+		_node.FileName = value
+	}
+	if value, ok := pfc.mutation.ContentType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productfile.FieldContentType,
+		})
+		_node.ContentType = value
+	}
+	if value, ok := pfc.mutation.Size(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productfile.FieldSize,
+		})
+		_node.Size = value
+	}
+	if value, ok := pfc.mutation.StorageKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productfile.FieldStorageKey,
+		})
+		_node.StorageKey = value
+	}
+	if value, ok := pfc.mutation.ThumbnailKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productfile.FieldThumbnailKey,
+		})
+		_node.ThumbnailKey = value
+	}
+	if value, ok := pfc.mutation.Width(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldWidth,
+		})
+		_node.Width = value
+	}
+	if value, ok := pfc.mutation.Height(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldHeight,
+		})
+		_node.Height = value
+	}
+	if value, ok := pfc.mutation.Position(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldPosition,
+		})
+		_node.Position = value
+	}
+	if value, ok := pfc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := pfc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productfile.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ProductFileCreateBulk is the builder for creating many ProductFile entities in bulk.
+type ProductFileCreateBulk struct {
+	config
+	builders []*ProductFileCreate
+}
+
+// Save creates the ProductFile entities in the database.
+func (pfcb *ProductFileCreateBulk) Save(ctx context.Context) ([]*ProductFile, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pfcb.builders))
+	nodes := make([]*ProductFile, len(pfcb.builders))
+	mutators := make([]Mutator, len(pfcb.builders))
+	for i := range pfcb.builders {
+		func(i int, root context.Context) {
+			builder := pfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductFileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pfcb *ProductFileCreateBulk) SaveX(ctx context.Context) []*ProductFile {
+	v, err := pfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pfcb *ProductFileCreateBulk) Exec(ctx context.Context) error {
+	_, err := pfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfcb *ProductFileCreateBulk) ExecX(ctx context.Context) {
+	if err := pfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
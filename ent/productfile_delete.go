@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productfile"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductFileDelete is the builder for deleting a ProductFile entity.
+type ProductFileDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProductFileMutation
+}
+
+// Where appends a list predicates to the ProductFileDelete builder.
+func (pfd *ProductFileDelete) Where(ps ...predicate.ProductFile) *ProductFileDelete {
+	pfd.mutation.Where(ps...)
+	return pfd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pfd *ProductFileDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(pfd.hooks) == 0 {
+		affected, err = pfd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductFileMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			pfd.mutation = mutation
+			affected, err = pfd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pfd.hooks) - 1; i >= 0; i-- {
+			if pfd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pfd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pfd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfd *ProductFileDelete) ExecX(ctx context.Context) int {
+	n, err := pfd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pfd *ProductFileDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: productfile.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productfile.FieldID,
+			},
+		},
+	}
+	if ps := pfd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pfd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// ProductFileDeleteOne is the builder for deleting a single ProductFile entity.
+type ProductFileDeleteOne struct {
+	pfd *ProductFileDelete
+}
+
+// Exec executes the deletion query.
+func (pfdo *ProductFileDeleteOne) Exec(ctx context.Context) error {
+	n, err := pfdo.pfd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{productfile.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfdo *ProductFileDeleteOne) ExecX(ctx context.Context) {
+	pfdo.pfd.ExecX(ctx)
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productfile"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductFileQuery is the builder for querying ProductFile entities.
+type ProductFileQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ProductFile
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProductFileQuery builder.
+func (pfq *ProductFileQuery) Where(ps ...predicate.ProductFile) *ProductFileQuery {
+	pfq.predicates = append(pfq.predicates, ps...)
+	return pfq
+}
+
+// Limit adds a limit step to the query.
+func (pfq *ProductFileQuery) Limit(limit int) *ProductFileQuery {
+	pfq.limit = &limit
+	return pfq
+}
+
+// Offset adds an offset step to the query.
+func (pfq *ProductFileQuery) Offset(offset int) *ProductFileQuery {
+	pfq.offset = &offset
+	return pfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pfq *ProductFileQuery) Unique(unique bool) *ProductFileQuery {
+	pfq.unique = &unique
+	return pfq
+}
+
+// Order adds an order step to the query.
+func (pfq *ProductFileQuery) Order(o ...OrderFunc) *ProductFileQuery {
+	pfq.order = append(pfq.order, o...)
+	return pfq
+}
+
+// First returns the first ProductFile entity from the query.
+// Returns a *NotFoundError when no ProductFile was found.
+func (pfq *ProductFileQuery) First(ctx context.Context) (*ProductFile, error) {
+	nodes, err := pfq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{productfile.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pfq *ProductFileQuery) FirstX(ctx context.Context) *ProductFile {
+	node, err := pfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProductFile ID from the query.
+// Returns a *NotFoundError when no ProductFile ID was found.
+func (pfq *ProductFileQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pfq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{productfile.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pfq *ProductFileQuery) FirstIDX(ctx context.Context) int {
+	id, err := pfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProductFile entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProductFile entity is found.
+// Returns a *NotFoundError when no ProductFile entities are found.
+func (pfq *ProductFileQuery) Only(ctx context.Context) (*ProductFile, error) {
+	nodes, err := pfq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{productfile.Label}
+	default:
+		return nil, &NotSingularError{productfile.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pfq *ProductFileQuery) OnlyX(ctx context.Context) *ProductFile {
+	node, err := pfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProductFile ID in the query.
+// Returns a *NotSingularError when more than one ProductFile ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pfq *ProductFileQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pfq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{productfile.Label}
+	default:
+		err = &NotSingularError{productfile.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pfq *ProductFileQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProductFiles.
+func (pfq *ProductFileQuery) All(ctx context.Context) ([]*ProductFile, error) {
+	if err := pfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return pfq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pfq *ProductFileQuery) AllX(ctx context.Context) []*ProductFile {
+	nodes, err := pfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProductFile IDs.
+func (pfq *ProductFileQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := pfq.Select(productfile.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pfq *ProductFileQuery) IDsX(ctx context.Context) []int {
+	ids, err := pfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pfq *ProductFileQuery) Count(ctx context.Context) (int, error) {
+	if err := pfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return pfq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pfq *ProductFileQuery) CountX(ctx context.Context) int {
+	count, err := pfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pfq *ProductFileQuery) Exist(ctx context.Context) (bool, error) {
+	if err := pfq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return pfq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pfq *ProductFileQuery) ExistX(ctx context.Context) bool {
+	exist, err := pfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProductFileQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pfq *ProductFileQuery) Clone() *ProductFileQuery {
+	if pfq == nil {
+		return nil
+	}
+	return &ProductFileQuery{
+		config:     pfq.config,
+		limit:      pfq.limit,
+		offset:     pfq.offset,
+		order:      append([]OrderFunc{}, pfq.order...),
+		predicates: append([]predicate.ProductFile{}, pfq.predicates...),
+		// clone intermediate query.
+		sql:    pfq.sql.Clone(),
+		path:   pfq.path,
+		unique: pfq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProductFile.Query().
+//		GroupBy(productfile.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pfq *ProductFileQuery) GroupBy(field string, fields ...string) *ProductFileGroupBy {
+	grbuild := &ProductFileGroupBy{config: pfq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := pfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return pfq.sqlQuery(ctx), nil
+	}
+	grbuild.label = productfile.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.ProductFile.Query().
+//		Select(productfile.FieldTenantID).
+//		Scan(ctx, &v)
+func (pfq *ProductFileQuery) Select(fields ...string) *ProductFileSelect {
+	pfq.fields = append(pfq.fields, fields...)
+	selbuild := &ProductFileSelect{ProductFileQuery: pfq}
+	selbuild.label = productfile.Label
+	selbuild.flds, selbuild.scan = &pfq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (pfq *ProductFileQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range pfq.fields {
+		if !productfile.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pfq.path != nil {
+		prev, err := pfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pfq.sql = prev
+	}
+	return nil
+}
+
+func (pfq *ProductFileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProductFile, error) {
+	var (
+		nodes = []*ProductFile{}
+		_spec = pfq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ProductFile).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ProductFile{config: pfq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(pfq.modifiers) > 0 {
+		_spec.Modifiers = pfq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (pfq *ProductFileQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pfq.querySpec()
+	if len(pfq.modifiers) > 0 {
+		_spec.Modifiers = pfq.modifiers
+	}
+	_spec.Node.Columns = pfq.fields
+	if len(pfq.fields) > 0 {
+		_spec.Unique = pfq.unique != nil && *pfq.unique
+	}
+	return sqlgraph.CountNodes(ctx, pfq.driver, _spec)
+}
+
+func (pfq *ProductFileQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := pfq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (pfq *ProductFileQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productfile.Table,
+			Columns: productfile.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productfile.FieldID,
+			},
+		},
+		From:   pfq.sql,
+		Unique: true,
+	}
+	if unique := pfq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := pfq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productfile.FieldID)
+		for i := range fields {
+			if fields[i] != productfile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pfq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pfq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pfq *ProductFileQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pfq.driver.Dialect())
+	t1 := builder.Table(productfile.Table)
+	columns := pfq.fields
+	if len(columns) == 0 {
+		columns = productfile.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pfq.sql != nil {
+		selector = pfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pfq.unique != nil && *pfq.unique {
+		selector.Distinct()
+	}
+	for _, m := range pfq.modifiers {
+		m(selector)
+	}
+	for _, p := range pfq.predicates {
+		p(selector)
+	}
+	for _, p := range pfq.order {
+		p(selector)
+	}
+	if offset := pfq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pfq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pfq *ProductFileQuery) ForUpdate(opts ...sql.LockOption) *ProductFileQuery {
+	if pfq.driver.Dialect() == dialect.Postgres {
+		pfq.Unique(false)
+	}
+	pfq.modifiers = append(pfq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pfq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pfq *ProductFileQuery) ForShare(opts ...sql.LockOption) *ProductFileQuery {
+	if pfq.driver.Dialect() == dialect.Postgres {
+		pfq.Unique(false)
+	}
+	pfq.modifiers = append(pfq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pfq
+}
+
+// ProductFileGroupBy is the group-by builder for ProductFile entities.
+type ProductFileGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pfgb *ProductFileGroupBy) Aggregate(fns ...AggregateFunc) *ProductFileGroupBy {
+	pfgb.fns = append(pfgb.fns, fns...)
+	return pfgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (pfgb *ProductFileGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := pfgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	pfgb.sql = query
+	return pfgb.sqlScan(ctx, v)
+}
+
+func (pfgb *ProductFileGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range pfgb.fields {
+		if !productfile.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := pfgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pfgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (pfgb *ProductFileGroupBy) sqlQuery() *sql.Selector {
+	selector := pfgb.sql.Select()
+	aggregation := make([]string, 0, len(pfgb.fns))
+	for _, fn := range pfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(pfgb.fields)+len(pfgb.fns))
+		for _, f := range pfgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(pfgb.fields...)...)
+}
+
+// ProductFileSelect is the builder for selecting fields of ProductFile entities.
+type ProductFileSelect struct {
+	*ProductFileQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pfs *ProductFileSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := pfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	pfs.sql = pfs.ProductFileQuery.sqlQuery(ctx)
+	return pfs.sqlScan(ctx, v)
+}
+
+func (pfs *ProductFileSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := pfs.sql.Query()
+	if err := pfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
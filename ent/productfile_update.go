@@ -0,0 +1,909 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productfile"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductFileUpdate is the builder for updating ProductFile entities.
+type ProductFileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductFileMutation
+}
+
+// Where appends a list predicates to the ProductFileUpdate builder.
+func (pfu *ProductFileUpdate) Where(ps ...predicate.ProductFile) *ProductFileUpdate {
+	pfu.mutation.Where(ps...)
+	return pfu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (pfu *ProductFileUpdate) SetTenantID(i int) *ProductFileUpdate {
+	pfu.mutation.ResetTenantID()
+	pfu.mutation.SetTenantID(i)
+	return pfu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (pfu *ProductFileUpdate) AddTenantID(i int) *ProductFileUpdate {
+	pfu.mutation.AddTenantID(i)
+	return pfu
+}
+
+// SetProductID sets the "product_id" field.
+func (pfu *ProductFileUpdate) SetProductID(i int) *ProductFileUpdate {
+	pfu.mutation.ResetProductID()
+	pfu.mutation.SetProductID(i)
+	return pfu
+}
+
+// AddProductID adds i to the "product_id" field.
+func (pfu *ProductFileUpdate) AddProductID(i int) *ProductFileUpdate {
+	pfu.mutation.AddProductID(i)
+	return pfu
+}
+
+// SetKind sets the "kind" field.
+func (pfu *ProductFileUpdate) SetKind(s string) *ProductFileUpdate {
+	pfu.mutation.SetKind(s)
+	return pfu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (pfu *ProductFileUpdate) SetNillableKind(s *string) *ProductFileUpdate {
+	if s != nil {
+		pfu.SetKind(*s)
+	}
+	return pfu
+}
+
+// SetFileName sets the "file_name" field.
+func (pfu *ProductFileUpdate) SetFileName(s string) *ProductFileUpdate {
+	pfu.mutation.SetFileName(s)
+	return pfu
+}
+
+// SetContentType sets the "content_type" field.
+func (pfu *ProductFileUpdate) SetContentType(s string) *ProductFileUpdate {
+	pfu.mutation.SetContentType(s)
+	return pfu
+}
+
+// SetSize sets the "size" field.
+func (pfu *ProductFileUpdate) SetSize(i int64) *ProductFileUpdate {
+	pfu.mutation.ResetSize()
+	pfu.mutation.SetSize(i)
+	return pfu
+}
+
+// AddSize adds i to the "size" field.
+func (pfu *ProductFileUpdate) AddSize(i int64) *ProductFileUpdate {
+	pfu.mutation.AddSize(i)
+	return pfu
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (pfu *ProductFileUpdate) SetStorageKey(s string) *ProductFileUpdate {
+	pfu.mutation.SetStorageKey(s)
+	return pfu
+}
+
+// SetThumbnailKey sets the "thumbnail_key" field.
+func (pfu *ProductFileUpdate) SetThumbnailKey(s string) *ProductFileUpdate {
+	pfu.mutation.SetThumbnailKey(s)
+	return pfu
+}
+
+// SetNillableThumbnailKey sets the "thumbnail_key" field if the given value is not nil.
+func (pfu *ProductFileUpdate) SetNillableThumbnailKey(s *string) *ProductFileUpdate {
+	if s != nil {
+		pfu.SetThumbnailKey(*s)
+	}
+	return pfu
+}
+
+// ClearThumbnailKey clears the value of the "thumbnail_key" field.
+func (pfu *ProductFileUpdate) ClearThumbnailKey() *ProductFileUpdate {
+	pfu.mutation.ClearThumbnailKey()
+	return pfu
+}
+
+// SetWidth sets the "width" field.
+func (pfu *ProductFileUpdate) SetWidth(i int) *ProductFileUpdate {
+	pfu.mutation.ResetWidth()
+	pfu.mutation.SetWidth(i)
+	return pfu
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (pfu *ProductFileUpdate) SetNillableWidth(i *int) *ProductFileUpdate {
+	if i != nil {
+		pfu.SetWidth(*i)
+	}
+	return pfu
+}
+
+// AddWidth adds i to the "width" field.
+func (pfu *ProductFileUpdate) AddWidth(i int) *ProductFileUpdate {
+	pfu.mutation.AddWidth(i)
+	return pfu
+}
+
+// SetHeight sets the "height" field.
+func (pfu *ProductFileUpdate) SetHeight(i int) *ProductFileUpdate {
+	pfu.mutation.ResetHeight()
+	pfu.mutation.SetHeight(i)
+	return pfu
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (pfu *ProductFileUpdate) SetNillableHeight(i *int) *ProductFileUpdate {
+	if i != nil {
+		pfu.SetHeight(*i)
+	}
+	return pfu
+}
+
+// AddHeight adds i to the "height" field.
+func (pfu *ProductFileUpdate) AddHeight(i int) *ProductFileUpdate {
+	pfu.mutation.AddHeight(i)
+	return pfu
+}
+
+// SetPosition sets the "position" field.
+func (pfu *ProductFileUpdate) SetPosition(i int) *ProductFileUpdate {
+	pfu.mutation.ResetPosition()
+	pfu.mutation.SetPosition(i)
+	return pfu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pfu *ProductFileUpdate) SetNillablePosition(i *int) *ProductFileUpdate {
+	if i != nil {
+		pfu.SetPosition(*i)
+	}
+	return pfu
+}
+
+// AddPosition adds i to the "position" field.
+func (pfu *ProductFileUpdate) AddPosition(i int) *ProductFileUpdate {
+	pfu.mutation.AddPosition(i)
+	return pfu
+}
+
+// SetUserID sets the "user_id" field.
+func (pfu *ProductFileUpdate) SetUserID(i int) *ProductFileUpdate {
+	pfu.mutation.ResetUserID()
+	pfu.mutation.SetUserID(i)
+	return pfu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (pfu *ProductFileUpdate) AddUserID(i int) *ProductFileUpdate {
+	pfu.mutation.AddUserID(i)
+	return pfu
+}
+
+// Mutation returns the ProductFileMutation object of the builder.
+func (pfu *ProductFileUpdate) Mutation() *ProductFileMutation {
+	return pfu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pfu *ProductFileUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(pfu.hooks) == 0 {
+		if err = pfu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = pfu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductFileMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pfu.check(); err != nil {
+				return 0, err
+			}
+			pfu.mutation = mutation
+			affected, err = pfu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pfu.hooks) - 1; i >= 0; i-- {
+			if pfu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pfu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pfu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pfu *ProductFileUpdate) SaveX(ctx context.Context) int {
+	affected, err := pfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pfu *ProductFileUpdate) Exec(ctx context.Context) error {
+	_, err := pfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfu *ProductFileUpdate) ExecX(ctx context.Context) {
+	if err := pfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pfu *ProductFileUpdate) check() error {
+	if v, ok := pfu.mutation.FileName(); ok {
+		if err := productfile.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "ProductFile.file_name": %w`, err)}
+		}
+	}
+	if v, ok := pfu.mutation.Size(); ok {
+		if err := productfile.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "ProductFile.size": %w`, err)}
+		}
+	}
+	if v, ok := pfu.mutation.StorageKey(); ok {
+		if err := productfile.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "ProductFile.storage_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pfu *ProductFileUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productfile.Table,
+			Columns: productfile.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productfile.FieldID,
+			},
+		},
+	}
+	if ps := pfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pfu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldTenantID,
+		})
+	}
+	if value, ok := pfu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldTenantID,
+		})
+	}
+	if value, ok := pfu.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldProductID,
+		})
+	}
+	if value, ok := pfu.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldProductID,
+		})
+	}
+	if value, ok := pfu.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productfile.FieldKind,
+		})
+	}
+	if value, ok := pfu.mutation.FileName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productfile.FieldFileName,
+		})
+	}
+	if value, ok := pfu.mutation.ContentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productfile.FieldContentType,
+		})
+	}
+	if value, ok := pfu.mutation.Size(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productfile.FieldSize,
+		})
+	}
+	if value, ok := pfu.mutation.AddedSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productfile.FieldSize,
+		})
+	}
+	if value, ok := pfu.mutation.StorageKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productfile.FieldStorageKey,
+		})
+	}
+	if value, ok := pfu.mutation.ThumbnailKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productfile.FieldThumbnailKey,
+		})
+	}
+	if pfu.mutation.ThumbnailKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: productfile.FieldThumbnailKey,
+		})
+	}
+	if value, ok := pfu.mutation.Width(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldWidth,
+		})
+	}
+	if value, ok := pfu.mutation.AddedWidth(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldWidth,
+		})
+	}
+	if value, ok := pfu.mutation.Height(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldHeight,
+		})
+	}
+	if value, ok := pfu.mutation.AddedHeight(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldHeight,
+		})
+	}
+	if value, ok := pfu.mutation.Position(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldPosition,
+		})
+	}
+	if value, ok := pfu.mutation.AddedPosition(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldPosition,
+		})
+	}
+	if value, ok := pfu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldUserID,
+		})
+	}
+	if value, ok := pfu.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldUserID,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productfile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ProductFileUpdateOne is the builder for updating a single ProductFile entity.
+type ProductFileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductFileMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (pfuo *ProductFileUpdateOne) SetTenantID(i int) *ProductFileUpdateOne {
+	pfuo.mutation.ResetTenantID()
+	pfuo.mutation.SetTenantID(i)
+	return pfuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (pfuo *ProductFileUpdateOne) AddTenantID(i int) *ProductFileUpdateOne {
+	pfuo.mutation.AddTenantID(i)
+	return pfuo
+}
+
+// SetProductID sets the "product_id" field.
+func (pfuo *ProductFileUpdateOne) SetProductID(i int) *ProductFileUpdateOne {
+	pfuo.mutation.ResetProductID()
+	pfuo.mutation.SetProductID(i)
+	return pfuo
+}
+
+// AddProductID adds i to the "product_id" field.
+func (pfuo *ProductFileUpdateOne) AddProductID(i int) *ProductFileUpdateOne {
+	pfuo.mutation.AddProductID(i)
+	return pfuo
+}
+
+// SetKind sets the "kind" field.
+func (pfuo *ProductFileUpdateOne) SetKind(s string) *ProductFileUpdateOne {
+	pfuo.mutation.SetKind(s)
+	return pfuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (pfuo *ProductFileUpdateOne) SetNillableKind(s *string) *ProductFileUpdateOne {
+	if s != nil {
+		pfuo.SetKind(*s)
+	}
+	return pfuo
+}
+
+// SetFileName sets the "file_name" field.
+func (pfuo *ProductFileUpdateOne) SetFileName(s string) *ProductFileUpdateOne {
+	pfuo.mutation.SetFileName(s)
+	return pfuo
+}
+
+// SetContentType sets the "content_type" field.
+func (pfuo *ProductFileUpdateOne) SetContentType(s string) *ProductFileUpdateOne {
+	pfuo.mutation.SetContentType(s)
+	return pfuo
+}
+
+// SetSize sets the "size" field.
+func (pfuo *ProductFileUpdateOne) SetSize(i int64) *ProductFileUpdateOne {
+	pfuo.mutation.ResetSize()
+	pfuo.mutation.SetSize(i)
+	return pfuo
+}
+
+// AddSize adds i to the "size" field.
+func (pfuo *ProductFileUpdateOne) AddSize(i int64) *ProductFileUpdateOne {
+	pfuo.mutation.AddSize(i)
+	return pfuo
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (pfuo *ProductFileUpdateOne) SetStorageKey(s string) *ProductFileUpdateOne {
+	pfuo.mutation.SetStorageKey(s)
+	return pfuo
+}
+
+// SetThumbnailKey sets the "thumbnail_key" field.
+func (pfuo *ProductFileUpdateOne) SetThumbnailKey(s string) *ProductFileUpdateOne {
+	pfuo.mutation.SetThumbnailKey(s)
+	return pfuo
+}
+
+// SetNillableThumbnailKey sets the "thumbnail_key" field if the given value is not nil.
+func (pfuo *ProductFileUpdateOne) SetNillableThumbnailKey(s *string) *ProductFileUpdateOne {
+	if s != nil {
+		pfuo.SetThumbnailKey(*s)
+	}
+	return pfuo
+}
+
+// ClearThumbnailKey clears the value of the "thumbnail_key" field.
+func (pfuo *ProductFileUpdateOne) ClearThumbnailKey() *ProductFileUpdateOne {
+	pfuo.mutation.ClearThumbnailKey()
+	return pfuo
+}
+
+// SetWidth sets the "width" field.
+func (pfuo *ProductFileUpdateOne) SetWidth(i int) *ProductFileUpdateOne {
+	pfuo.mutation.ResetWidth()
+	pfuo.mutation.SetWidth(i)
+	return pfuo
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (pfuo *ProductFileUpdateOne) SetNillableWidth(i *int) *ProductFileUpdateOne {
+	if i != nil {
+		pfuo.SetWidth(*i)
+	}
+	return pfuo
+}
+
+// AddWidth adds i to the "width" field.
+func (pfuo *ProductFileUpdateOne) AddWidth(i int) *ProductFileUpdateOne {
+	pfuo.mutation.AddWidth(i)
+	return pfuo
+}
+
+// SetHeight sets the "height" field.
+func (pfuo *ProductFileUpdateOne) SetHeight(i int) *ProductFileUpdateOne {
+	pfuo.mutation.ResetHeight()
+	pfuo.mutation.SetHeight(i)
+	return pfuo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (pfuo *ProductFileUpdateOne) SetNillableHeight(i *int) *ProductFileUpdateOne {
+	if i != nil {
+		pfuo.SetHeight(*i)
+	}
+	return pfuo
+}
+
+// AddHeight adds i to the "height" field.
+func (pfuo *ProductFileUpdateOne) AddHeight(i int) *ProductFileUpdateOne {
+	pfuo.mutation.AddHeight(i)
+	return pfuo
+}
+
+// SetPosition sets the "position" field.
+func (pfuo *ProductFileUpdateOne) SetPosition(i int) *ProductFileUpdateOne {
+	pfuo.mutation.ResetPosition()
+	pfuo.mutation.SetPosition(i)
+	return pfuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pfuo *ProductFileUpdateOne) SetNillablePosition(i *int) *ProductFileUpdateOne {
+	if i != nil {
+		pfuo.SetPosition(*i)
+	}
+	return pfuo
+}
+
+// AddPosition adds i to the "position" field.
+func (pfuo *ProductFileUpdateOne) AddPosition(i int) *ProductFileUpdateOne {
+	pfuo.mutation.AddPosition(i)
+	return pfuo
+}
+
+// SetUserID sets the "user_id" field.
+func (pfuo *ProductFileUpdateOne) SetUserID(i int) *ProductFileUpdateOne {
+	pfuo.mutation.ResetUserID()
+	pfuo.mutation.SetUserID(i)
+	return pfuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (pfuo *ProductFileUpdateOne) AddUserID(i int) *ProductFileUpdateOne {
+	pfuo.mutation.AddUserID(i)
+	return pfuo
+}
+
+// Mutation returns the ProductFileMutation object of the builder.
+func (pfuo *ProductFileUpdateOne) Mutation() *ProductFileMutation {
+	return pfuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pfuo *ProductFileUpdateOne) Select(field string, fields ...string) *ProductFileUpdateOne {
+	pfuo.fields = append([]string{field}, fields...)
+	return pfuo
+}
+
+// Save executes the query and returns the updated ProductFile entity.
+func (pfuo *ProductFileUpdateOne) Save(ctx context.Context) (*ProductFile, error) {
+	var (
+		err  error
+		node *ProductFile
+	)
+	if len(pfuo.hooks) == 0 {
+		if err = pfuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = pfuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductFileMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pfuo.check(); err != nil {
+				return nil, err
+			}
+			pfuo.mutation = mutation
+			node, err = pfuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pfuo.hooks) - 1; i >= 0; i-- {
+			if pfuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pfuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, pfuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ProductFile)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ProductFileMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pfuo *ProductFileUpdateOne) SaveX(ctx context.Context) *ProductFile {
+	node, err := pfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pfuo *ProductFileUpdateOne) Exec(ctx context.Context) error {
+	_, err := pfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfuo *ProductFileUpdateOne) ExecX(ctx context.Context) {
+	if err := pfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pfuo *ProductFileUpdateOne) check() error {
+	if v, ok := pfuo.mutation.FileName(); ok {
+		if err := productfile.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "ProductFile.file_name": %w`, err)}
+		}
+	}
+	if v, ok := pfuo.mutation.Size(); ok {
+		if err := productfile.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "ProductFile.size": %w`, err)}
+		}
+	}
+	if v, ok := pfuo.mutation.StorageKey(); ok {
+		if err := productfile.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "ProductFile.storage_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pfuo *ProductFileUpdateOne) sqlSave(ctx context.Context) (_node *ProductFile, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productfile.Table,
+			Columns: productfile.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productfile.FieldID,
+			},
+		},
+	}
+	id, ok := pfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductFile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productfile.FieldID)
+		for _, f := range fields {
+			if !productfile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productfile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pfuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldTenantID,
+		})
+	}
+	if value, ok := pfuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldTenantID,
+		})
+	}
+	if value, ok := pfuo.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldProductID,
+		})
+	}
+	if value, ok := pfuo.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldProductID,
+		})
+	}
+	if value, ok := pfuo.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productfile.FieldKind,
+		})
+	}
+	if value, ok := pfuo.mutation.FileName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productfile.FieldFileName,
+		})
+	}
+	if value, ok := pfuo.mutation.ContentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productfile.FieldContentType,
+		})
+	}
+	if value, ok := pfuo.mutation.Size(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productfile.FieldSize,
+		})
+	}
+	if value, ok := pfuo.mutation.AddedSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productfile.FieldSize,
+		})
+	}
+	if value, ok := pfuo.mutation.StorageKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productfile.FieldStorageKey,
+		})
+	}
+	if value, ok := pfuo.mutation.ThumbnailKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productfile.FieldThumbnailKey,
+		})
+	}
+	if pfuo.mutation.ThumbnailKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: productfile.FieldThumbnailKey,
+		})
+	}
+	if value, ok := pfuo.mutation.Width(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldWidth,
+		})
+	}
+	if value, ok := pfuo.mutation.AddedWidth(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldWidth,
+		})
+	}
+	if value, ok := pfuo.mutation.Height(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldHeight,
+		})
+	}
+	if value, ok := pfuo.mutation.AddedHeight(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldHeight,
+		})
+	}
+	if value, ok := pfuo.mutation.Position(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldPosition,
+		})
+	}
+	if value, ok := pfuo.mutation.AddedPosition(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldPosition,
+		})
+	}
+	if value, ok := pfuo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldUserID,
+		})
+	}
+	if value, ok := pfuo.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productfile.FieldUserID,
+		})
+	}
+	_node = &ProductFile{config: pfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productfile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/productlot"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProductLot is the model entity for the ProductLot schema.
+type ProductLot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del producto
+	ProductID int `json:"product_id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// Número de lote del fabricante
+	LotNumber string `json:"lot_number,omitempty"`
+	// Fecha de vencimiento del lote
+	ExpiryDate *time.Time `json:"expiry_date,omitempty"`
+	// Cantidad recibida en el lote
+	Quantity float64 `json:"quantity,omitempty"`
+	// Cantidad disponible del lote
+	Remaining float64 `json:"remaining,omitempty"`
+	// ID de la factura de compra con la que se recibió el lote
+	PurchaseInvoiceID int `json:"purchase_invoice_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProductLot) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case productlot.FieldQuantity, productlot.FieldRemaining:
+			values[i] = new(sql.NullFloat64)
+		case productlot.FieldID, productlot.FieldProductID, productlot.FieldTenantID, productlot.FieldPurchaseInvoiceID:
+			values[i] = new(sql.NullInt64)
+		case productlot.FieldLotNumber:
+			values[i] = new(sql.NullString)
+		case productlot.FieldExpiryDate, productlot.FieldCreatedAt, productlot.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ProductLot", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProductLot fields.
+func (pl *ProductLot) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case productlot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pl.ID = int(value.Int64)
+		case productlot.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				pl.ProductID = int(value.Int64)
+			}
+		case productlot.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				pl.TenantID = int(value.Int64)
+			}
+		case productlot.FieldLotNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lot_number", values[i])
+			} else if value.Valid {
+				pl.LotNumber = value.String
+			}
+		case productlot.FieldExpiryDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry_date", values[i])
+			} else if value.Valid {
+				pl.ExpiryDate = new(time.Time)
+				*pl.ExpiryDate = value.Time
+			}
+		case productlot.FieldQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				pl.Quantity = value.Float64
+			}
+		case productlot.FieldRemaining:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field remaining", values[i])
+			} else if value.Valid {
+				pl.Remaining = value.Float64
+			}
+		case productlot.FieldPurchaseInvoiceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field purchase_invoice_id", values[i])
+			} else if value.Valid {
+				pl.PurchaseInvoiceID = int(value.Int64)
+			}
+		case productlot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pl.CreatedAt = value.Time
+			}
+		case productlot.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pl.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ProductLot.
+// Note that you need to call ProductLot.Unwrap() before calling this method if this ProductLot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pl *ProductLot) Update() *ProductLotUpdateOne {
+	return (&ProductLotClient{config: pl.config}).UpdateOne(pl)
+}
+
+// Unwrap unwraps the ProductLot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pl *ProductLot) Unwrap() *ProductLot {
+	_tx, ok := pl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProductLot is not a transactional entity")
+	}
+	pl.config.driver = _tx.drv
+	return pl
+}
+
+// String implements the fmt.Stringer.
+func (pl *ProductLot) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProductLot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pl.ID))
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", pl.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", pl.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("lot_number=")
+	builder.WriteString(pl.LotNumber)
+	builder.WriteString(", ")
+	if v := pl.ExpiryDate; v != nil {
+		builder.WriteString("expiry_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", pl.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("remaining=")
+	builder.WriteString(fmt.Sprintf("%v", pl.Remaining))
+	builder.WriteString(", ")
+	builder.WriteString("purchase_invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", pl.PurchaseInvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pl.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProductLots is a parsable slice of ProductLot.
+type ProductLots []*ProductLot
+
+func (pl ProductLots) config(cfg config) {
+	for _i := range pl {
+		pl[_i].config = cfg
+	}
+}
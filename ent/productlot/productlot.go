@@ -0,0 +1,73 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productlot
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the productlot type in the database.
+	Label = "product_lot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldLotNumber holds the string denoting the lot_number field in the database.
+	FieldLotNumber = "lot_number"
+	// FieldExpiryDate holds the string denoting the expiry_date field in the database.
+	FieldExpiryDate = "expiry_date"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldRemaining holds the string denoting the remaining field in the database.
+	FieldRemaining = "remaining"
+	// FieldPurchaseInvoiceID holds the string denoting the purchase_invoice_id field in the database.
+	FieldPurchaseInvoiceID = "purchase_invoice_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the productlot in the database.
+	Table = "product_lots"
+)
+
+// Columns holds all SQL columns for productlot fields.
+var Columns = []string{
+	FieldID,
+	FieldProductID,
+	FieldTenantID,
+	FieldLotNumber,
+	FieldExpiryDate,
+	FieldQuantity,
+	FieldRemaining,
+	FieldPurchaseInvoiceID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// LotNumberValidator is a validator for the "lot_number" field. It is called by the builders before save.
+	LotNumberValidator func(string) error
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(float64) error
+	// RemainingValidator is a validator for the "remaining" field. It is called by the builders before save.
+	RemainingValidator func(float64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
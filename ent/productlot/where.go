@@ -0,0 +1,923 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productlot
+
+import (
+	"Veritasbackend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// LotNumber applies equality check predicate on the "lot_number" field. It's identical to LotNumberEQ.
+func LotNumber(v string) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLotNumber), v))
+	})
+}
+
+// ExpiryDate applies equality check predicate on the "expiry_date" field. It's identical to ExpiryDateEQ.
+func ExpiryDate(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiryDate), v))
+	})
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v float64) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// Remaining applies equality check predicate on the "remaining" field. It's identical to RemainingEQ.
+func Remaining(v float64) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRemaining), v))
+	})
+}
+
+// PurchaseInvoiceID applies equality check predicate on the "purchase_invoice_id" field. It's identical to PurchaseInvoiceIDEQ.
+func PurchaseInvoiceID(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPurchaseInvoiceID), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProductID), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// LotNumberEQ applies the EQ predicate on the "lot_number" field.
+func LotNumberEQ(v string) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLotNumber), v))
+	})
+}
+
+// LotNumberNEQ applies the NEQ predicate on the "lot_number" field.
+func LotNumberNEQ(v string) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLotNumber), v))
+	})
+}
+
+// LotNumberIn applies the In predicate on the "lot_number" field.
+func LotNumberIn(vs ...string) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLotNumber), v...))
+	})
+}
+
+// LotNumberNotIn applies the NotIn predicate on the "lot_number" field.
+func LotNumberNotIn(vs ...string) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLotNumber), v...))
+	})
+}
+
+// LotNumberGT applies the GT predicate on the "lot_number" field.
+func LotNumberGT(v string) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLotNumber), v))
+	})
+}
+
+// LotNumberGTE applies the GTE predicate on the "lot_number" field.
+func LotNumberGTE(v string) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLotNumber), v))
+	})
+}
+
+// LotNumberLT applies the LT predicate on the "lot_number" field.
+func LotNumberLT(v string) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLotNumber), v))
+	})
+}
+
+// LotNumberLTE applies the LTE predicate on the "lot_number" field.
+func LotNumberLTE(v string) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLotNumber), v))
+	})
+}
+
+// LotNumberContains applies the Contains predicate on the "lot_number" field.
+func LotNumberContains(v string) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLotNumber), v))
+	})
+}
+
+// LotNumberHasPrefix applies the HasPrefix predicate on the "lot_number" field.
+func LotNumberHasPrefix(v string) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLotNumber), v))
+	})
+}
+
+// LotNumberHasSuffix applies the HasSuffix predicate on the "lot_number" field.
+func LotNumberHasSuffix(v string) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLotNumber), v))
+	})
+}
+
+// LotNumberEqualFold applies the EqualFold predicate on the "lot_number" field.
+func LotNumberEqualFold(v string) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLotNumber), v))
+	})
+}
+
+// LotNumberContainsFold applies the ContainsFold predicate on the "lot_number" field.
+func LotNumberContainsFold(v string) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLotNumber), v))
+	})
+}
+
+// ExpiryDateEQ applies the EQ predicate on the "expiry_date" field.
+func ExpiryDateEQ(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiryDate), v))
+	})
+}
+
+// ExpiryDateNEQ applies the NEQ predicate on the "expiry_date" field.
+func ExpiryDateNEQ(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpiryDate), v))
+	})
+}
+
+// ExpiryDateIn applies the In predicate on the "expiry_date" field.
+func ExpiryDateIn(vs ...time.Time) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpiryDate), v...))
+	})
+}
+
+// ExpiryDateNotIn applies the NotIn predicate on the "expiry_date" field.
+func ExpiryDateNotIn(vs ...time.Time) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpiryDate), v...))
+	})
+}
+
+// ExpiryDateGT applies the GT predicate on the "expiry_date" field.
+func ExpiryDateGT(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpiryDate), v))
+	})
+}
+
+// ExpiryDateGTE applies the GTE predicate on the "expiry_date" field.
+func ExpiryDateGTE(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpiryDate), v))
+	})
+}
+
+// ExpiryDateLT applies the LT predicate on the "expiry_date" field.
+func ExpiryDateLT(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpiryDate), v))
+	})
+}
+
+// ExpiryDateLTE applies the LTE predicate on the "expiry_date" field.
+func ExpiryDateLTE(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpiryDate), v))
+	})
+}
+
+// ExpiryDateIsNil applies the IsNil predicate on the "expiry_date" field.
+func ExpiryDateIsNil() predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExpiryDate)))
+	})
+}
+
+// ExpiryDateNotNil applies the NotNil predicate on the "expiry_date" field.
+func ExpiryDateNotNil() predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExpiryDate)))
+	})
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v float64) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v float64) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...float64) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...float64) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v float64) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v float64) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v float64) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v float64) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldQuantity), v))
+	})
+}
+
+// RemainingEQ applies the EQ predicate on the "remaining" field.
+func RemainingEQ(v float64) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRemaining), v))
+	})
+}
+
+// RemainingNEQ applies the NEQ predicate on the "remaining" field.
+func RemainingNEQ(v float64) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRemaining), v))
+	})
+}
+
+// RemainingIn applies the In predicate on the "remaining" field.
+func RemainingIn(vs ...float64) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRemaining), v...))
+	})
+}
+
+// RemainingNotIn applies the NotIn predicate on the "remaining" field.
+func RemainingNotIn(vs ...float64) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRemaining), v...))
+	})
+}
+
+// RemainingGT applies the GT predicate on the "remaining" field.
+func RemainingGT(v float64) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRemaining), v))
+	})
+}
+
+// RemainingGTE applies the GTE predicate on the "remaining" field.
+func RemainingGTE(v float64) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRemaining), v))
+	})
+}
+
+// RemainingLT applies the LT predicate on the "remaining" field.
+func RemainingLT(v float64) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRemaining), v))
+	})
+}
+
+// RemainingLTE applies the LTE predicate on the "remaining" field.
+func RemainingLTE(v float64) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRemaining), v))
+	})
+}
+
+// PurchaseInvoiceIDEQ applies the EQ predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDEQ(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPurchaseInvoiceID), v))
+	})
+}
+
+// PurchaseInvoiceIDNEQ applies the NEQ predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDNEQ(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPurchaseInvoiceID), v))
+	})
+}
+
+// PurchaseInvoiceIDIn applies the In predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDIn(vs ...int) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPurchaseInvoiceID), v...))
+	})
+}
+
+// PurchaseInvoiceIDNotIn applies the NotIn predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDNotIn(vs ...int) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPurchaseInvoiceID), v...))
+	})
+}
+
+// PurchaseInvoiceIDGT applies the GT predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDGT(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPurchaseInvoiceID), v))
+	})
+}
+
+// PurchaseInvoiceIDGTE applies the GTE predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDGTE(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPurchaseInvoiceID), v))
+	})
+}
+
+// PurchaseInvoiceIDLT applies the LT predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDLT(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPurchaseInvoiceID), v))
+	})
+}
+
+// PurchaseInvoiceIDLTE applies the LTE predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDLTE(v int) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPurchaseInvoiceID), v))
+	})
+}
+
+// PurchaseInvoiceIDIsNil applies the IsNil predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDIsNil() predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPurchaseInvoiceID)))
+	})
+}
+
+// PurchaseInvoiceIDNotNil applies the NotNil predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDNotNil() predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPurchaseInvoiceID)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProductLot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductLot(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProductLot) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProductLot) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProductLot) predicate.ProductLot {
+	return predicate.ProductLot(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,418 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/productlot"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductLotCreate is the builder for creating a ProductLot entity.
+type ProductLotCreate struct {
+	config
+	mutation *ProductLotMutation
+	hooks    []Hook
+}
+
+// SetProductID sets the "product_id" field.
+func (plc *ProductLotCreate) SetProductID(i int) *ProductLotCreate {
+	plc.mutation.SetProductID(i)
+	return plc
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (plc *ProductLotCreate) SetTenantID(i int) *ProductLotCreate {
+	plc.mutation.SetTenantID(i)
+	return plc
+}
+
+// SetLotNumber sets the "lot_number" field.
+func (plc *ProductLotCreate) SetLotNumber(s string) *ProductLotCreate {
+	plc.mutation.SetLotNumber(s)
+	return plc
+}
+
+// SetExpiryDate sets the "expiry_date" field.
+func (plc *ProductLotCreate) SetExpiryDate(t time.Time) *ProductLotCreate {
+	plc.mutation.SetExpiryDate(t)
+	return plc
+}
+
+// SetNillableExpiryDate sets the "expiry_date" field if the given value is not nil.
+func (plc *ProductLotCreate) SetNillableExpiryDate(t *time.Time) *ProductLotCreate {
+	if t != nil {
+		plc.SetExpiryDate(*t)
+	}
+	return plc
+}
+
+// SetQuantity sets the "quantity" field.
+func (plc *ProductLotCreate) SetQuantity(f float64) *ProductLotCreate {
+	plc.mutation.SetQuantity(f)
+	return plc
+}
+
+// SetRemaining sets the "remaining" field.
+func (plc *ProductLotCreate) SetRemaining(f float64) *ProductLotCreate {
+	plc.mutation.SetRemaining(f)
+	return plc
+}
+
+// SetPurchaseInvoiceID sets the "purchase_invoice_id" field.
+func (plc *ProductLotCreate) SetPurchaseInvoiceID(i int) *ProductLotCreate {
+	plc.mutation.SetPurchaseInvoiceID(i)
+	return plc
+}
+
+// SetNillablePurchaseInvoiceID sets the "purchase_invoice_id" field if the given value is not nil.
+func (plc *ProductLotCreate) SetNillablePurchaseInvoiceID(i *int) *ProductLotCreate {
+	if i != nil {
+		plc.SetPurchaseInvoiceID(*i)
+	}
+	return plc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (plc *ProductLotCreate) SetCreatedAt(t time.Time) *ProductLotCreate {
+	plc.mutation.SetCreatedAt(t)
+	return plc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (plc *ProductLotCreate) SetNillableCreatedAt(t *time.Time) *ProductLotCreate {
+	if t != nil {
+		plc.SetCreatedAt(*t)
+	}
+	return plc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (plc *ProductLotCreate) SetUpdatedAt(t time.Time) *ProductLotCreate {
+	plc.mutation.SetUpdatedAt(t)
+	return plc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (plc *ProductLotCreate) SetNillableUpdatedAt(t *time.Time) *ProductLotCreate {
+	if t != nil {
+		plc.SetUpdatedAt(*t)
+	}
+	return plc
+}
+
+// Mutation returns the ProductLotMutation object of the builder.
+func (plc *ProductLotCreate) Mutation() *ProductLotMutation {
+	return plc.mutation
+}
+
+// Save creates the ProductLot in the database.
+func (plc *ProductLotCreate) Save(ctx context.Context) (*ProductLot, error) {
+	var (
+		err  error
+		node *ProductLot
+	)
+	plc.defaults()
+	if len(plc.hooks) == 0 {
+		if err = plc.check(); err != nil {
+			return nil, err
+		}
+		node, err = plc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductLotMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = plc.check(); err != nil {
+				return nil, err
+			}
+			plc.mutation = mutation
+			if node, err = plc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(plc.hooks) - 1; i >= 0; i-- {
+			if plc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = plc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, plc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ProductLot)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ProductLotMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (plc *ProductLotCreate) SaveX(ctx context.Context) *ProductLot {
+	v, err := plc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (plc *ProductLotCreate) Exec(ctx context.Context) error {
+	_, err := plc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (plc *ProductLotCreate) ExecX(ctx context.Context) {
+	if err := plc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (plc *ProductLotCreate) defaults() {
+	if _, ok := plc.mutation.CreatedAt(); !ok {
+		v := productlot.DefaultCreatedAt()
+		plc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := plc.mutation.UpdatedAt(); !ok {
+		v := productlot.DefaultUpdatedAt()
+		plc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (plc *ProductLotCreate) check() error {
+	if _, ok := plc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "ProductLot.product_id"`)}
+	}
+	if _, ok := plc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ProductLot.tenant_id"`)}
+	}
+	if _, ok := plc.mutation.LotNumber(); !ok {
+		return &ValidationError{Name: "lot_number", err: errors.New(`ent: missing required field "ProductLot.lot_number"`)}
+	}
+	if v, ok := plc.mutation.LotNumber(); ok {
+		if err := productlot.LotNumberValidator(v); err != nil {
+			return &ValidationError{Name: "lot_number", err: fmt.Errorf(`ent: validator failed for field "ProductLot.lot_number": %w`, err)}
+		}
+	}
+	if _, ok := plc.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "ProductLot.quantity"`)}
+	}
+	if v, ok := plc.mutation.Quantity(); ok {
+		if err := productlot.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "ProductLot.quantity": %w`, err)}
+		}
+	}
+	if _, ok := plc.mutation.Remaining(); !ok {
+		return &ValidationError{Name: "remaining", err: errors.New(`ent: missing required field "ProductLot.remaining"`)}
+	}
+	if v, ok := plc.mutation.Remaining(); ok {
+		if err := productlot.RemainingValidator(v); err != nil {
+			return &ValidationError{Name: "remaining", err: fmt.Errorf(`ent: validator failed for field "ProductLot.remaining": %w`, err)}
+		}
+	}
+	if _, ok := plc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductLot.created_at"`)}
+	}
+	if _, ok := plc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProductLot.updated_at"`)}
+	}
+	return nil
+}
+
+func (plc *ProductLotCreate) sqlSave(ctx context.Context) (*ProductLot, error) {
+	_node, _spec := plc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, plc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (plc *ProductLotCreate) createSpec() (*ProductLot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductLot{config: plc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: productlot.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productlot.FieldID,
+			},
+		}
+	)
+	if value, ok := plc.mutation.ProductID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productlot.FieldProductID,
+		})
+		_node.ProductID = value
+	}
+	if value, ok := plc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productlot.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := plc.mutation.LotNumber(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productlot.FieldLotNumber,
+		})
+		_node.LotNumber = value
+	}
+	if value, ok := plc.mutation.ExpiryDate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productlot.FieldExpiryDate,
+		})
+		_node.ExpiryDate = &value
+	}
+	if value, ok := plc.mutation.Quantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: productlot.FieldQuantity,
+		})
+		_node.Quantity = value
+	}
+	if value, ok := plc.mutation.Remaining(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: productlot.FieldRemaining,
+		})
+		_node.Remaining = value
+	}
+	if value, ok := plc.mutation.PurchaseInvoiceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productlot.FieldPurchaseInvoiceID,
+		})
+		_node.PurchaseInvoiceID = value
+	}
+	if value, ok := plc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productlot.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := plc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productlot.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ProductLotCreateBulk is the builder for creating many ProductLot entities in bulk.
+type ProductLotCreateBulk struct {
+	config
+	builders []*ProductLotCreate
+}
+
+// Save creates the ProductLot entities in the database.
+func (plcb *ProductLotCreateBulk) Save(ctx context.Context) ([]*ProductLot, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(plcb.builders))
+	nodes := make([]*ProductLot, len(plcb.builders))
+	mutators := make([]Mutator, len(plcb.builders))
+	for i := range plcb.builders {
+		func(i int, root context.Context) {
+			builder := plcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductLotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, plcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, plcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, plcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (plcb *ProductLotCreateBulk) SaveX(ctx context.Context) []*ProductLot {
+	v, err := plcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (plcb *ProductLotCreateBulk) Exec(ctx context.Context) error {
+	_, err := plcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (plcb *ProductLotCreateBulk) ExecX(ctx context.Context) {
+	if err := plcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
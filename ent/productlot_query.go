@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productlot"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductLotQuery is the builder for querying ProductLot entities.
+type ProductLotQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ProductLot
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProductLotQuery builder.
+func (plq *ProductLotQuery) Where(ps ...predicate.ProductLot) *ProductLotQuery {
+	plq.predicates = append(plq.predicates, ps...)
+	return plq
+}
+
+// Limit adds a limit step to the query.
+func (plq *ProductLotQuery) Limit(limit int) *ProductLotQuery {
+	plq.limit = &limit
+	return plq
+}
+
+// Offset adds an offset step to the query.
+func (plq *ProductLotQuery) Offset(offset int) *ProductLotQuery {
+	plq.offset = &offset
+	return plq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (plq *ProductLotQuery) Unique(unique bool) *ProductLotQuery {
+	plq.unique = &unique
+	return plq
+}
+
+// Order adds an order step to the query.
+func (plq *ProductLotQuery) Order(o ...OrderFunc) *ProductLotQuery {
+	plq.order = append(plq.order, o...)
+	return plq
+}
+
+// First returns the first ProductLot entity from the query.
+// Returns a *NotFoundError when no ProductLot was found.
+func (plq *ProductLotQuery) First(ctx context.Context) (*ProductLot, error) {
+	nodes, err := plq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{productlot.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (plq *ProductLotQuery) FirstX(ctx context.Context) *ProductLot {
+	node, err := plq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProductLot ID from the query.
+// Returns a *NotFoundError when no ProductLot ID was found.
+func (plq *ProductLotQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = plq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{productlot.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (plq *ProductLotQuery) FirstIDX(ctx context.Context) int {
+	id, err := plq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProductLot entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProductLot entity is found.
+// Returns a *NotFoundError when no ProductLot entities are found.
+func (plq *ProductLotQuery) Only(ctx context.Context) (*ProductLot, error) {
+	nodes, err := plq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{productlot.Label}
+	default:
+		return nil, &NotSingularError{productlot.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (plq *ProductLotQuery) OnlyX(ctx context.Context) *ProductLot {
+	node, err := plq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProductLot ID in the query.
+// Returns a *NotSingularError when more than one ProductLot ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (plq *ProductLotQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = plq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{productlot.Label}
+	default:
+		err = &NotSingularError{productlot.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (plq *ProductLotQuery) OnlyIDX(ctx context.Context) int {
+	id, err := plq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProductLots.
+func (plq *ProductLotQuery) All(ctx context.Context) ([]*ProductLot, error) {
+	if err := plq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return plq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (plq *ProductLotQuery) AllX(ctx context.Context) []*ProductLot {
+	nodes, err := plq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProductLot IDs.
+func (plq *ProductLotQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := plq.Select(productlot.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (plq *ProductLotQuery) IDsX(ctx context.Context) []int {
+	ids, err := plq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (plq *ProductLotQuery) Count(ctx context.Context) (int, error) {
+	if err := plq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return plq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (plq *ProductLotQuery) CountX(ctx context.Context) int {
+	count, err := plq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (plq *ProductLotQuery) Exist(ctx context.Context) (bool, error) {
+	if err := plq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return plq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (plq *ProductLotQuery) ExistX(ctx context.Context) bool {
+	exist, err := plq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProductLotQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (plq *ProductLotQuery) Clone() *ProductLotQuery {
+	if plq == nil {
+		return nil
+	}
+	return &ProductLotQuery{
+		config:     plq.config,
+		limit:      plq.limit,
+		offset:     plq.offset,
+		order:      append([]OrderFunc{}, plq.order...),
+		predicates: append([]predicate.ProductLot{}, plq.predicates...),
+		// clone intermediate query.
+		sql:    plq.sql.Clone(),
+		path:   plq.path,
+		unique: plq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID int `json:"product_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProductLot.Query().
+//		GroupBy(productlot.FieldProductID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (plq *ProductLotQuery) GroupBy(field string, fields ...string) *ProductLotGroupBy {
+	grbuild := &ProductLotGroupBy{config: plq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := plq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return plq.sqlQuery(ctx), nil
+	}
+	grbuild.label = productlot.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID int `json:"product_id,omitempty"`
+//	}
+//
+//	client.ProductLot.Query().
+//		Select(productlot.FieldProductID).
+//		Scan(ctx, &v)
+func (plq *ProductLotQuery) Select(fields ...string) *ProductLotSelect {
+	plq.fields = append(plq.fields, fields...)
+	selbuild := &ProductLotSelect{ProductLotQuery: plq}
+	selbuild.label = productlot.Label
+	selbuild.flds, selbuild.scan = &plq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (plq *ProductLotQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range plq.fields {
+		if !productlot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if plq.path != nil {
+		prev, err := plq.path(ctx)
+		if err != nil {
+			return err
+		}
+		plq.sql = prev
+	}
+	return nil
+}
+
+func (plq *ProductLotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProductLot, error) {
+	var (
+		nodes = []*ProductLot{}
+		_spec = plq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ProductLot).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ProductLot{config: plq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(plq.modifiers) > 0 {
+		_spec.Modifiers = plq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, plq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (plq *ProductLotQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := plq.querySpec()
+	if len(plq.modifiers) > 0 {
+		_spec.Modifiers = plq.modifiers
+	}
+	_spec.Node.Columns = plq.fields
+	if len(plq.fields) > 0 {
+		_spec.Unique = plq.unique != nil && *plq.unique
+	}
+	return sqlgraph.CountNodes(ctx, plq.driver, _spec)
+}
+
+func (plq *ProductLotQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := plq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (plq *ProductLotQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productlot.Table,
+			Columns: productlot.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productlot.FieldID,
+			},
+		},
+		From:   plq.sql,
+		Unique: true,
+	}
+	if unique := plq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := plq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productlot.FieldID)
+		for i := range fields {
+			if fields[i] != productlot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := plq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := plq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := plq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := plq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (plq *ProductLotQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(plq.driver.Dialect())
+	t1 := builder.Table(productlot.Table)
+	columns := plq.fields
+	if len(columns) == 0 {
+		columns = productlot.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if plq.sql != nil {
+		selector = plq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if plq.unique != nil && *plq.unique {
+		selector.Distinct()
+	}
+	for _, m := range plq.modifiers {
+		m(selector)
+	}
+	for _, p := range plq.predicates {
+		p(selector)
+	}
+	for _, p := range plq.order {
+		p(selector)
+	}
+	if offset := plq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := plq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (plq *ProductLotQuery) ForUpdate(opts ...sql.LockOption) *ProductLotQuery {
+	if plq.driver.Dialect() == dialect.Postgres {
+		plq.Unique(false)
+	}
+	plq.modifiers = append(plq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return plq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (plq *ProductLotQuery) ForShare(opts ...sql.LockOption) *ProductLotQuery {
+	if plq.driver.Dialect() == dialect.Postgres {
+		plq.Unique(false)
+	}
+	plq.modifiers = append(plq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return plq
+}
+
+// ProductLotGroupBy is the group-by builder for ProductLot entities.
+type ProductLotGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (plgb *ProductLotGroupBy) Aggregate(fns ...AggregateFunc) *ProductLotGroupBy {
+	plgb.fns = append(plgb.fns, fns...)
+	return plgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (plgb *ProductLotGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := plgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	plgb.sql = query
+	return plgb.sqlScan(ctx, v)
+}
+
+func (plgb *ProductLotGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range plgb.fields {
+		if !productlot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := plgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := plgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (plgb *ProductLotGroupBy) sqlQuery() *sql.Selector {
+	selector := plgb.sql.Select()
+	aggregation := make([]string, 0, len(plgb.fns))
+	for _, fn := range plgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(plgb.fields)+len(plgb.fns))
+		for _, f := range plgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(plgb.fields...)...)
+}
+
+// ProductLotSelect is the builder for selecting fields of ProductLot entities.
+type ProductLotSelect struct {
+	*ProductLotQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pls *ProductLotSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := pls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	pls.sql = pls.ProductLotQuery.sqlQuery(ctx)
+	return pls.sqlScan(ctx, v)
+}
+
+func (pls *ProductLotSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := pls.sql.Query()
+	if err := pls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,744 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productlot"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductLotUpdate is the builder for updating ProductLot entities.
+type ProductLotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductLotMutation
+}
+
+// Where appends a list predicates to the ProductLotUpdate builder.
+func (plu *ProductLotUpdate) Where(ps ...predicate.ProductLot) *ProductLotUpdate {
+	plu.mutation.Where(ps...)
+	return plu
+}
+
+// SetProductID sets the "product_id" field.
+func (plu *ProductLotUpdate) SetProductID(i int) *ProductLotUpdate {
+	plu.mutation.ResetProductID()
+	plu.mutation.SetProductID(i)
+	return plu
+}
+
+// AddProductID adds i to the "product_id" field.
+func (plu *ProductLotUpdate) AddProductID(i int) *ProductLotUpdate {
+	plu.mutation.AddProductID(i)
+	return plu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (plu *ProductLotUpdate) SetTenantID(i int) *ProductLotUpdate {
+	plu.mutation.ResetTenantID()
+	plu.mutation.SetTenantID(i)
+	return plu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (plu *ProductLotUpdate) AddTenantID(i int) *ProductLotUpdate {
+	plu.mutation.AddTenantID(i)
+	return plu
+}
+
+// SetLotNumber sets the "lot_number" field.
+func (plu *ProductLotUpdate) SetLotNumber(s string) *ProductLotUpdate {
+	plu.mutation.SetLotNumber(s)
+	return plu
+}
+
+// SetExpiryDate sets the "expiry_date" field.
+func (plu *ProductLotUpdate) SetExpiryDate(t time.Time) *ProductLotUpdate {
+	plu.mutation.SetExpiryDate(t)
+	return plu
+}
+
+// SetNillableExpiryDate sets the "expiry_date" field if the given value is not nil.
+func (plu *ProductLotUpdate) SetNillableExpiryDate(t *time.Time) *ProductLotUpdate {
+	if t != nil {
+		plu.SetExpiryDate(*t)
+	}
+	return plu
+}
+
+// ClearExpiryDate clears the value of the "expiry_date" field.
+func (plu *ProductLotUpdate) ClearExpiryDate() *ProductLotUpdate {
+	plu.mutation.ClearExpiryDate()
+	return plu
+}
+
+// SetQuantity sets the "quantity" field.
+func (plu *ProductLotUpdate) SetQuantity(f float64) *ProductLotUpdate {
+	plu.mutation.ResetQuantity()
+	plu.mutation.SetQuantity(f)
+	return plu
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (plu *ProductLotUpdate) AddQuantity(f float64) *ProductLotUpdate {
+	plu.mutation.AddQuantity(f)
+	return plu
+}
+
+// SetRemaining sets the "remaining" field.
+func (plu *ProductLotUpdate) SetRemaining(f float64) *ProductLotUpdate {
+	plu.mutation.ResetRemaining()
+	plu.mutation.SetRemaining(f)
+	return plu
+}
+
+// AddRemaining adds f to the "remaining" field.
+func (plu *ProductLotUpdate) AddRemaining(f float64) *ProductLotUpdate {
+	plu.mutation.AddRemaining(f)
+	return plu
+}
+
+// SetPurchaseInvoiceID sets the "purchase_invoice_id" field.
+func (plu *ProductLotUpdate) SetPurchaseInvoiceID(i int) *ProductLotUpdate {
+	plu.mutation.ResetPurchaseInvoiceID()
+	plu.mutation.SetPurchaseInvoiceID(i)
+	return plu
+}
+
+// SetNillablePurchaseInvoiceID sets the "purchase_invoice_id" field if the given value is not nil.
+func (plu *ProductLotUpdate) SetNillablePurchaseInvoiceID(i *int) *ProductLotUpdate {
+	if i != nil {
+		plu.SetPurchaseInvoiceID(*i)
+	}
+	return plu
+}
+
+// AddPurchaseInvoiceID adds i to the "purchase_invoice_id" field.
+func (plu *ProductLotUpdate) AddPurchaseInvoiceID(i int) *ProductLotUpdate {
+	plu.mutation.AddPurchaseInvoiceID(i)
+	return plu
+}
+
+// ClearPurchaseInvoiceID clears the value of the "purchase_invoice_id" field.
+func (plu *ProductLotUpdate) ClearPurchaseInvoiceID() *ProductLotUpdate {
+	plu.mutation.ClearPurchaseInvoiceID()
+	return plu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (plu *ProductLotUpdate) SetUpdatedAt(t time.Time) *ProductLotUpdate {
+	plu.mutation.SetUpdatedAt(t)
+	return plu
+}
+
+// Mutation returns the ProductLotMutation object of the builder.
+func (plu *ProductLotUpdate) Mutation() *ProductLotMutation {
+	return plu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (plu *ProductLotUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	plu.defaults()
+	if len(plu.hooks) == 0 {
+		if err = plu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = plu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductLotMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = plu.check(); err != nil {
+				return 0, err
+			}
+			plu.mutation = mutation
+			affected, err = plu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(plu.hooks) - 1; i >= 0; i-- {
+			if plu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = plu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, plu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (plu *ProductLotUpdate) SaveX(ctx context.Context) int {
+	affected, err := plu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (plu *ProductLotUpdate) Exec(ctx context.Context) error {
+	_, err := plu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (plu *ProductLotUpdate) ExecX(ctx context.Context) {
+	if err := plu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (plu *ProductLotUpdate) defaults() {
+	if _, ok := plu.mutation.UpdatedAt(); !ok {
+		v := productlot.UpdateDefaultUpdatedAt()
+		plu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (plu *ProductLotUpdate) check() error {
+	if v, ok := plu.mutation.LotNumber(); ok {
+		if err := productlot.LotNumberValidator(v); err != nil {
+			return &ValidationError{Name: "lot_number", err: fmt.Errorf(`ent: validator failed for field "ProductLot.lot_number": %w`, err)}
+		}
+	}
+	if v, ok := plu.mutation.Quantity(); ok {
+		if err := productlot.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "ProductLot.quantity": %w`, err)}
+		}
+	}
+	if v, ok := plu.mutation.Remaining(); ok {
+		if err := productlot.RemainingValidator(v); err != nil {
+			return &ValidationError{Name: "remaining", err: fmt.Errorf(`ent: validator failed for field "ProductLot.remaining": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (plu *ProductLotUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productlot.Table,
+			Columns: productlot.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productlot.FieldID,
+			},
+		},
+	}
+	if ps := plu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := plu.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productlot.FieldProductID,
+		})
+	}
+	if value, ok := plu.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productlot.FieldProductID,
+		})
+	}
+	if value, ok := plu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productlot.FieldTenantID,
+		})
+	}
+	if value, ok := plu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productlot.FieldTenantID,
+		})
+	}
+	if value, ok := plu.mutation.LotNumber(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productlot.FieldLotNumber,
+		})
+	}
+	if value, ok := plu.mutation.ExpiryDate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productlot.FieldExpiryDate,
+		})
+	}
+	if plu.mutation.ExpiryDateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: productlot.FieldExpiryDate,
+		})
+	}
+	if value, ok := plu.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: productlot.FieldQuantity,
+		})
+	}
+	if value, ok := plu.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: productlot.FieldQuantity,
+		})
+	}
+	if value, ok := plu.mutation.Remaining(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: productlot.FieldRemaining,
+		})
+	}
+	if value, ok := plu.mutation.AddedRemaining(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: productlot.FieldRemaining,
+		})
+	}
+	if value, ok := plu.mutation.PurchaseInvoiceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productlot.FieldPurchaseInvoiceID,
+		})
+	}
+	if value, ok := plu.mutation.AddedPurchaseInvoiceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productlot.FieldPurchaseInvoiceID,
+		})
+	}
+	if plu.mutation.PurchaseInvoiceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: productlot.FieldPurchaseInvoiceID,
+		})
+	}
+	if value, ok := plu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productlot.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, plu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productlot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ProductLotUpdateOne is the builder for updating a single ProductLot entity.
+type ProductLotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductLotMutation
+}
+
+// SetProductID sets the "product_id" field.
+func (pluo *ProductLotUpdateOne) SetProductID(i int) *ProductLotUpdateOne {
+	pluo.mutation.ResetProductID()
+	pluo.mutation.SetProductID(i)
+	return pluo
+}
+
+// AddProductID adds i to the "product_id" field.
+func (pluo *ProductLotUpdateOne) AddProductID(i int) *ProductLotUpdateOne {
+	pluo.mutation.AddProductID(i)
+	return pluo
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (pluo *ProductLotUpdateOne) SetTenantID(i int) *ProductLotUpdateOne {
+	pluo.mutation.ResetTenantID()
+	pluo.mutation.SetTenantID(i)
+	return pluo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (pluo *ProductLotUpdateOne) AddTenantID(i int) *ProductLotUpdateOne {
+	pluo.mutation.AddTenantID(i)
+	return pluo
+}
+
+// SetLotNumber sets the "lot_number" field.
+func (pluo *ProductLotUpdateOne) SetLotNumber(s string) *ProductLotUpdateOne {
+	pluo.mutation.SetLotNumber(s)
+	return pluo
+}
+
+// SetExpiryDate sets the "expiry_date" field.
+func (pluo *ProductLotUpdateOne) SetExpiryDate(t time.Time) *ProductLotUpdateOne {
+	pluo.mutation.SetExpiryDate(t)
+	return pluo
+}
+
+// SetNillableExpiryDate sets the "expiry_date" field if the given value is not nil.
+func (pluo *ProductLotUpdateOne) SetNillableExpiryDate(t *time.Time) *ProductLotUpdateOne {
+	if t != nil {
+		pluo.SetExpiryDate(*t)
+	}
+	return pluo
+}
+
+// ClearExpiryDate clears the value of the "expiry_date" field.
+func (pluo *ProductLotUpdateOne) ClearExpiryDate() *ProductLotUpdateOne {
+	pluo.mutation.ClearExpiryDate()
+	return pluo
+}
+
+// SetQuantity sets the "quantity" field.
+func (pluo *ProductLotUpdateOne) SetQuantity(f float64) *ProductLotUpdateOne {
+	pluo.mutation.ResetQuantity()
+	pluo.mutation.SetQuantity(f)
+	return pluo
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (pluo *ProductLotUpdateOne) AddQuantity(f float64) *ProductLotUpdateOne {
+	pluo.mutation.AddQuantity(f)
+	return pluo
+}
+
+// SetRemaining sets the "remaining" field.
+func (pluo *ProductLotUpdateOne) SetRemaining(f float64) *ProductLotUpdateOne {
+	pluo.mutation.ResetRemaining()
+	pluo.mutation.SetRemaining(f)
+	return pluo
+}
+
+// AddRemaining adds f to the "remaining" field.
+func (pluo *ProductLotUpdateOne) AddRemaining(f float64) *ProductLotUpdateOne {
+	pluo.mutation.AddRemaining(f)
+	return pluo
+}
+
+// SetPurchaseInvoiceID sets the "purchase_invoice_id" field.
+func (pluo *ProductLotUpdateOne) SetPurchaseInvoiceID(i int) *ProductLotUpdateOne {
+	pluo.mutation.ResetPurchaseInvoiceID()
+	pluo.mutation.SetPurchaseInvoiceID(i)
+	return pluo
+}
+
+// SetNillablePurchaseInvoiceID sets the "purchase_invoice_id" field if the given value is not nil.
+func (pluo *ProductLotUpdateOne) SetNillablePurchaseInvoiceID(i *int) *ProductLotUpdateOne {
+	if i != nil {
+		pluo.SetPurchaseInvoiceID(*i)
+	}
+	return pluo
+}
+
+// AddPurchaseInvoiceID adds i to the "purchase_invoice_id" field.
+func (pluo *ProductLotUpdateOne) AddPurchaseInvoiceID(i int) *ProductLotUpdateOne {
+	pluo.mutation.AddPurchaseInvoiceID(i)
+	return pluo
+}
+
+// ClearPurchaseInvoiceID clears the value of the "purchase_invoice_id" field.
+func (pluo *ProductLotUpdateOne) ClearPurchaseInvoiceID() *ProductLotUpdateOne {
+	pluo.mutation.ClearPurchaseInvoiceID()
+	return pluo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pluo *ProductLotUpdateOne) SetUpdatedAt(t time.Time) *ProductLotUpdateOne {
+	pluo.mutation.SetUpdatedAt(t)
+	return pluo
+}
+
+// Mutation returns the ProductLotMutation object of the builder.
+func (pluo *ProductLotUpdateOne) Mutation() *ProductLotMutation {
+	return pluo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pluo *ProductLotUpdateOne) Select(field string, fields ...string) *ProductLotUpdateOne {
+	pluo.fields = append([]string{field}, fields...)
+	return pluo
+}
+
+// Save executes the query and returns the updated ProductLot entity.
+func (pluo *ProductLotUpdateOne) Save(ctx context.Context) (*ProductLot, error) {
+	var (
+		err  error
+		node *ProductLot
+	)
+	pluo.defaults()
+	if len(pluo.hooks) == 0 {
+		if err = pluo.check(); err != nil {
+			return nil, err
+		}
+		node, err = pluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductLotMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pluo.check(); err != nil {
+				return nil, err
+			}
+			pluo.mutation = mutation
+			node, err = pluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pluo.hooks) - 1; i >= 0; i-- {
+			if pluo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pluo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, pluo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ProductLot)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ProductLotMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pluo *ProductLotUpdateOne) SaveX(ctx context.Context) *ProductLot {
+	node, err := pluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pluo *ProductLotUpdateOne) Exec(ctx context.Context) error {
+	_, err := pluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pluo *ProductLotUpdateOne) ExecX(ctx context.Context) {
+	if err := pluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pluo *ProductLotUpdateOne) defaults() {
+	if _, ok := pluo.mutation.UpdatedAt(); !ok {
+		v := productlot.UpdateDefaultUpdatedAt()
+		pluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pluo *ProductLotUpdateOne) check() error {
+	if v, ok := pluo.mutation.LotNumber(); ok {
+		if err := productlot.LotNumberValidator(v); err != nil {
+			return &ValidationError{Name: "lot_number", err: fmt.Errorf(`ent: validator failed for field "ProductLot.lot_number": %w`, err)}
+		}
+	}
+	if v, ok := pluo.mutation.Quantity(); ok {
+		if err := productlot.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "ProductLot.quantity": %w`, err)}
+		}
+	}
+	if v, ok := pluo.mutation.Remaining(); ok {
+		if err := productlot.RemainingValidator(v); err != nil {
+			return &ValidationError{Name: "remaining", err: fmt.Errorf(`ent: validator failed for field "ProductLot.remaining": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pluo *ProductLotUpdateOne) sqlSave(ctx context.Context) (_node *ProductLot, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productlot.Table,
+			Columns: productlot.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productlot.FieldID,
+			},
+		},
+	}
+	id, ok := pluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductLot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productlot.FieldID)
+		for _, f := range fields {
+			if !productlot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productlot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pluo.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productlot.FieldProductID,
+		})
+	}
+	if value, ok := pluo.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productlot.FieldProductID,
+		})
+	}
+	if value, ok := pluo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productlot.FieldTenantID,
+		})
+	}
+	if value, ok := pluo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productlot.FieldTenantID,
+		})
+	}
+	if value, ok := pluo.mutation.LotNumber(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productlot.FieldLotNumber,
+		})
+	}
+	if value, ok := pluo.mutation.ExpiryDate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productlot.FieldExpiryDate,
+		})
+	}
+	if pluo.mutation.ExpiryDateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: productlot.FieldExpiryDate,
+		})
+	}
+	if value, ok := pluo.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: productlot.FieldQuantity,
+		})
+	}
+	if value, ok := pluo.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: productlot.FieldQuantity,
+		})
+	}
+	if value, ok := pluo.mutation.Remaining(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: productlot.FieldRemaining,
+		})
+	}
+	if value, ok := pluo.mutation.AddedRemaining(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: productlot.FieldRemaining,
+		})
+	}
+	if value, ok := pluo.mutation.PurchaseInvoiceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productlot.FieldPurchaseInvoiceID,
+		})
+	}
+	if value, ok := pluo.mutation.AddedPurchaseInvoiceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productlot.FieldPurchaseInvoiceID,
+		})
+	}
+	if pluo.mutation.PurchaseInvoiceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: productlot.FieldPurchaseInvoiceID,
+		})
+	}
+	if value, ok := pluo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productlot.FieldUpdatedAt,
+		})
+	}
+	_node = &ProductLot{config: pluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productlot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
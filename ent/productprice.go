@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/productprice"
+	"Veritasbackend/pkg/money"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProductPrice is the model entity for the ProductPrice schema.
+type ProductPrice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// ID del producto
+	ProductID int `json:"product_id,omitempty"`
+	// Precio de venta al detal
+	RetailPrice money.Amount `json:"retail_price,omitempty"`
+	// Precio de venta al mayor (vacío en un cambio programado = se mantiene el vigente)
+	WholesalePrice *money.Amount `json:"wholesale_price,omitempty"`
+	// Costo unitario del producto cuando se aplicó el cambio
+	PurchasePrice *money.Amount `json:"purchase_price,omitempty"`
+	// Fecha desde la que rige el precio
+	EffectiveFrom time.Time `json:"effective_from,omitempty"`
+	// Estado del cambio (scheduled, applied, cancelled)
+	Status string `json:"status,omitempty"`
+	// Origen del cambio (manual, product, bulk)
+	Source string `json:"source,omitempty"`
+	// ID del usuario que registró el cambio
+	UserID int `json:"user_id,omitempty"`
+	// Fecha en que el cambio se aplicó al producto
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProductPrice) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case productprice.FieldWholesalePrice, productprice.FieldPurchasePrice:
+			values[i] = &sql.NullScanner{S: new(money.Amount)}
+		case productprice.FieldRetailPrice:
+			values[i] = new(money.Amount)
+		case productprice.FieldID, productprice.FieldTenantID, productprice.FieldProductID, productprice.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case productprice.FieldStatus, productprice.FieldSource:
+			values[i] = new(sql.NullString)
+		case productprice.FieldEffectiveFrom, productprice.FieldAppliedAt, productprice.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ProductPrice", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProductPrice fields.
+func (pp *ProductPrice) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case productprice.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pp.ID = int(value.Int64)
+		case productprice.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				pp.TenantID = int(value.Int64)
+			}
+		case productprice.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				pp.ProductID = int(value.Int64)
+			}
+		case productprice.FieldRetailPrice:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field retail_price", values[i])
+			} else if value != nil {
+				pp.RetailPrice = *value
+			}
+		case productprice.FieldWholesalePrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field wholesale_price", values[i])
+			} else if value.Valid {
+				pp.WholesalePrice = new(money.Amount)
+				*pp.WholesalePrice = *value.S.(*money.Amount)
+			}
+		case productprice.FieldPurchasePrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field purchase_price", values[i])
+			} else if value.Valid {
+				pp.PurchasePrice = new(money.Amount)
+				*pp.PurchasePrice = *value.S.(*money.Amount)
+			}
+		case productprice.FieldEffectiveFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_from", values[i])
+			} else if value.Valid {
+				pp.EffectiveFrom = value.Time
+			}
+		case productprice.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pp.Status = value.String
+			}
+		case productprice.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				pp.Source = value.String
+			}
+		case productprice.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				pp.UserID = int(value.Int64)
+			}
+		case productprice.FieldAppliedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_at", values[i])
+			} else if value.Valid {
+				pp.AppliedAt = new(time.Time)
+				*pp.AppliedAt = value.Time
+			}
+		case productprice.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pp.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ProductPrice.
+// Note that you need to call ProductPrice.Unwrap() before calling this method if this ProductPrice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pp *ProductPrice) Update() *ProductPriceUpdateOne {
+	return (&ProductPriceClient{config: pp.config}).UpdateOne(pp)
+}
+
+// Unwrap unwraps the ProductPrice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pp *ProductPrice) Unwrap() *ProductPrice {
+	_tx, ok := pp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProductPrice is not a transactional entity")
+	}
+	pp.config.driver = _tx.drv
+	return pp
+}
+
+// String implements the fmt.Stringer.
+func (pp *ProductPrice) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProductPrice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pp.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", pp.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", pp.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("retail_price=")
+	builder.WriteString(fmt.Sprintf("%v", pp.RetailPrice))
+	builder.WriteString(", ")
+	if v := pp.WholesalePrice; v != nil {
+		builder.WriteString("wholesale_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pp.PurchasePrice; v != nil {
+		builder.WriteString("purchase_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("effective_from=")
+	builder.WriteString(pp.EffectiveFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(pp.Status)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(pp.Source)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", pp.UserID))
+	builder.WriteString(", ")
+	if v := pp.AppliedAt; v != nil {
+		builder.WriteString("applied_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pp.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProductPrices is a parsable slice of ProductPrice.
+type ProductPrices []*ProductPrice
+
+func (pp ProductPrices) config(cfg config) {
+	for _i := range pp {
+		pp[_i].config = cfg
+	}
+}
@@ -0,0 +1,79 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productprice
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the productprice type in the database.
+	Label = "product_price"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldRetailPrice holds the string denoting the retail_price field in the database.
+	FieldRetailPrice = "retail_price"
+	// FieldWholesalePrice holds the string denoting the wholesale_price field in the database.
+	FieldWholesalePrice = "wholesale_price"
+	// FieldPurchasePrice holds the string denoting the purchase_price field in the database.
+	FieldPurchasePrice = "purchase_price"
+	// FieldEffectiveFrom holds the string denoting the effective_from field in the database.
+	FieldEffectiveFrom = "effective_from"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAppliedAt holds the string denoting the applied_at field in the database.
+	FieldAppliedAt = "applied_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the productprice in the database.
+	Table = "product_prices"
+)
+
+// Columns holds all SQL columns for productprice fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldProductID,
+	FieldRetailPrice,
+	FieldWholesalePrice,
+	FieldPurchasePrice,
+	FieldEffectiveFrom,
+	FieldStatus,
+	FieldSource,
+	FieldUserID,
+	FieldAppliedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RetailPriceValidator is a validator for the "retail_price" field. It is called by the builders before save.
+	RetailPriceValidator func(int64) error
+	// WholesalePriceValidator is a validator for the "wholesale_price" field. It is called by the builders before save.
+	WholesalePriceValidator func(int64) error
+	// PurchasePriceValidator is a validator for the "purchase_price" field. It is called by the builders before save.
+	PurchasePriceValidator func(int64) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultSource holds the default value on creation for the "source" field.
+	DefaultSource string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
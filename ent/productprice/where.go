@@ -0,0 +1,1153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productprice
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// RetailPrice applies equality check predicate on the "retail_price" field. It's identical to RetailPriceEQ.
+func RetailPrice(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetailPrice), v))
+	})
+}
+
+// WholesalePrice applies equality check predicate on the "wholesale_price" field. It's identical to WholesalePriceEQ.
+func WholesalePrice(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWholesalePrice), v))
+	})
+}
+
+// PurchasePrice applies equality check predicate on the "purchase_price" field. It's identical to PurchasePriceEQ.
+func PurchasePrice(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPurchasePrice), v))
+	})
+}
+
+// EffectiveFrom applies equality check predicate on the "effective_from" field. It's identical to EffectiveFromEQ.
+func EffectiveFrom(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEffectiveFrom), v))
+	})
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// AppliedAt applies equality check predicate on the "applied_at" field. It's identical to AppliedAtEQ.
+func AppliedAt(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppliedAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProductID), v))
+	})
+}
+
+// RetailPriceEQ applies the EQ predicate on the "retail_price" field.
+func RetailPriceEQ(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetailPrice), v))
+	})
+}
+
+// RetailPriceNEQ applies the NEQ predicate on the "retail_price" field.
+func RetailPriceNEQ(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRetailPrice), v))
+	})
+}
+
+// RetailPriceIn applies the In predicate on the "retail_price" field.
+func RetailPriceIn(vs ...money.Amount) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRetailPrice), v...))
+	})
+}
+
+// RetailPriceNotIn applies the NotIn predicate on the "retail_price" field.
+func RetailPriceNotIn(vs ...money.Amount) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRetailPrice), v...))
+	})
+}
+
+// RetailPriceGT applies the GT predicate on the "retail_price" field.
+func RetailPriceGT(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRetailPrice), v))
+	})
+}
+
+// RetailPriceGTE applies the GTE predicate on the "retail_price" field.
+func RetailPriceGTE(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRetailPrice), v))
+	})
+}
+
+// RetailPriceLT applies the LT predicate on the "retail_price" field.
+func RetailPriceLT(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRetailPrice), v))
+	})
+}
+
+// RetailPriceLTE applies the LTE predicate on the "retail_price" field.
+func RetailPriceLTE(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRetailPrice), v))
+	})
+}
+
+// WholesalePriceEQ applies the EQ predicate on the "wholesale_price" field.
+func WholesalePriceEQ(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWholesalePrice), v))
+	})
+}
+
+// WholesalePriceNEQ applies the NEQ predicate on the "wholesale_price" field.
+func WholesalePriceNEQ(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWholesalePrice), v))
+	})
+}
+
+// WholesalePriceIn applies the In predicate on the "wholesale_price" field.
+func WholesalePriceIn(vs ...money.Amount) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldWholesalePrice), v...))
+	})
+}
+
+// WholesalePriceNotIn applies the NotIn predicate on the "wholesale_price" field.
+func WholesalePriceNotIn(vs ...money.Amount) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldWholesalePrice), v...))
+	})
+}
+
+// WholesalePriceGT applies the GT predicate on the "wholesale_price" field.
+func WholesalePriceGT(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWholesalePrice), v))
+	})
+}
+
+// WholesalePriceGTE applies the GTE predicate on the "wholesale_price" field.
+func WholesalePriceGTE(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWholesalePrice), v))
+	})
+}
+
+// WholesalePriceLT applies the LT predicate on the "wholesale_price" field.
+func WholesalePriceLT(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWholesalePrice), v))
+	})
+}
+
+// WholesalePriceLTE applies the LTE predicate on the "wholesale_price" field.
+func WholesalePriceLTE(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWholesalePrice), v))
+	})
+}
+
+// WholesalePriceIsNil applies the IsNil predicate on the "wholesale_price" field.
+func WholesalePriceIsNil() predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldWholesalePrice)))
+	})
+}
+
+// WholesalePriceNotNil applies the NotNil predicate on the "wholesale_price" field.
+func WholesalePriceNotNil() predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldWholesalePrice)))
+	})
+}
+
+// PurchasePriceEQ applies the EQ predicate on the "purchase_price" field.
+func PurchasePriceEQ(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPurchasePrice), v))
+	})
+}
+
+// PurchasePriceNEQ applies the NEQ predicate on the "purchase_price" field.
+func PurchasePriceNEQ(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPurchasePrice), v))
+	})
+}
+
+// PurchasePriceIn applies the In predicate on the "purchase_price" field.
+func PurchasePriceIn(vs ...money.Amount) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPurchasePrice), v...))
+	})
+}
+
+// PurchasePriceNotIn applies the NotIn predicate on the "purchase_price" field.
+func PurchasePriceNotIn(vs ...money.Amount) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPurchasePrice), v...))
+	})
+}
+
+// PurchasePriceGT applies the GT predicate on the "purchase_price" field.
+func PurchasePriceGT(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPurchasePrice), v))
+	})
+}
+
+// PurchasePriceGTE applies the GTE predicate on the "purchase_price" field.
+func PurchasePriceGTE(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPurchasePrice), v))
+	})
+}
+
+// PurchasePriceLT applies the LT predicate on the "purchase_price" field.
+func PurchasePriceLT(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPurchasePrice), v))
+	})
+}
+
+// PurchasePriceLTE applies the LTE predicate on the "purchase_price" field.
+func PurchasePriceLTE(v money.Amount) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPurchasePrice), v))
+	})
+}
+
+// PurchasePriceIsNil applies the IsNil predicate on the "purchase_price" field.
+func PurchasePriceIsNil() predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPurchasePrice)))
+	})
+}
+
+// PurchasePriceNotNil applies the NotNil predicate on the "purchase_price" field.
+func PurchasePriceNotNil() predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPurchasePrice)))
+	})
+}
+
+// EffectiveFromEQ applies the EQ predicate on the "effective_from" field.
+func EffectiveFromEQ(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEffectiveFrom), v))
+	})
+}
+
+// EffectiveFromNEQ applies the NEQ predicate on the "effective_from" field.
+func EffectiveFromNEQ(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEffectiveFrom), v))
+	})
+}
+
+// EffectiveFromIn applies the In predicate on the "effective_from" field.
+func EffectiveFromIn(vs ...time.Time) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEffectiveFrom), v...))
+	})
+}
+
+// EffectiveFromNotIn applies the NotIn predicate on the "effective_from" field.
+func EffectiveFromNotIn(vs ...time.Time) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEffectiveFrom), v...))
+	})
+}
+
+// EffectiveFromGT applies the GT predicate on the "effective_from" field.
+func EffectiveFromGT(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEffectiveFrom), v))
+	})
+}
+
+// EffectiveFromGTE applies the GTE predicate on the "effective_from" field.
+func EffectiveFromGTE(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEffectiveFrom), v))
+	})
+}
+
+// EffectiveFromLT applies the LT predicate on the "effective_from" field.
+func EffectiveFromLT(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEffectiveFrom), v))
+	})
+}
+
+// EffectiveFromLTE applies the LTE predicate on the "effective_from" field.
+func EffectiveFromLTE(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEffectiveFrom), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStatus), v))
+	})
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSource), v...))
+	})
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSource), v...))
+	})
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSource), v))
+	})
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSource), v))
+	})
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSource), v))
+	})
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSource), v))
+	})
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSource), v))
+	})
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldUserID)))
+	})
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldUserID)))
+	})
+}
+
+// AppliedAtEQ applies the EQ predicate on the "applied_at" field.
+func AppliedAtEQ(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppliedAt), v))
+	})
+}
+
+// AppliedAtNEQ applies the NEQ predicate on the "applied_at" field.
+func AppliedAtNEQ(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAppliedAt), v))
+	})
+}
+
+// AppliedAtIn applies the In predicate on the "applied_at" field.
+func AppliedAtIn(vs ...time.Time) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAppliedAt), v...))
+	})
+}
+
+// AppliedAtNotIn applies the NotIn predicate on the "applied_at" field.
+func AppliedAtNotIn(vs ...time.Time) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAppliedAt), v...))
+	})
+}
+
+// AppliedAtGT applies the GT predicate on the "applied_at" field.
+func AppliedAtGT(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAppliedAt), v))
+	})
+}
+
+// AppliedAtGTE applies the GTE predicate on the "applied_at" field.
+func AppliedAtGTE(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAppliedAt), v))
+	})
+}
+
+// AppliedAtLT applies the LT predicate on the "applied_at" field.
+func AppliedAtLT(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAppliedAt), v))
+	})
+}
+
+// AppliedAtLTE applies the LTE predicate on the "applied_at" field.
+func AppliedAtLTE(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAppliedAt), v))
+	})
+}
+
+// AppliedAtIsNil applies the IsNil predicate on the "applied_at" field.
+func AppliedAtIsNil() predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAppliedAt)))
+	})
+}
+
+// AppliedAtNotNil applies the NotNil predicate on the "applied_at" field.
+func AppliedAtNotNil() predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAppliedAt)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProductPrice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProductPrice) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProductPrice) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProductPrice) predicate.ProductPrice {
+	return predicate.ProductPrice(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/productprice"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductPriceCreate is the builder for creating a ProductPrice entity.
+type ProductPriceCreate struct {
+	config
+	mutation *ProductPriceMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ppc *ProductPriceCreate) SetTenantID(i int) *ProductPriceCreate {
+	ppc.mutation.SetTenantID(i)
+	return ppc
+}
+
+// SetProductID sets the "product_id" field.
+func (ppc *ProductPriceCreate) SetProductID(i int) *ProductPriceCreate {
+	ppc.mutation.SetProductID(i)
+	return ppc
+}
+
+// SetRetailPrice sets the "retail_price" field.
+func (ppc *ProductPriceCreate) SetRetailPrice(m money.Amount) *ProductPriceCreate {
+	ppc.mutation.SetRetailPrice(m)
+	return ppc
+}
+
+// SetWholesalePrice sets the "wholesale_price" field.
+func (ppc *ProductPriceCreate) SetWholesalePrice(m money.Amount) *ProductPriceCreate {
+	ppc.mutation.SetWholesalePrice(m)
+	return ppc
+}
+
+// SetNillableWholesalePrice sets the "wholesale_price" field if the given value is not nil.
+func (ppc *ProductPriceCreate) SetNillableWholesalePrice(m *money.Amount) *ProductPriceCreate {
+	if m != nil {
+		ppc.SetWholesalePrice(*m)
+	}
+	return ppc
+}
+
+// SetPurchasePrice sets the "purchase_price" field.
+func (ppc *ProductPriceCreate) SetPurchasePrice(m money.Amount) *ProductPriceCreate {
+	ppc.mutation.SetPurchasePrice(m)
+	return ppc
+}
+
+// SetNillablePurchasePrice sets the "purchase_price" field if the given value is not nil.
+func (ppc *ProductPriceCreate) SetNillablePurchasePrice(m *money.Amount) *ProductPriceCreate {
+	if m != nil {
+		ppc.SetPurchasePrice(*m)
+	}
+	return ppc
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (ppc *ProductPriceCreate) SetEffectiveFrom(t time.Time) *ProductPriceCreate {
+	ppc.mutation.SetEffectiveFrom(t)
+	return ppc
+}
+
+// SetStatus sets the "status" field.
+func (ppc *ProductPriceCreate) SetStatus(s string) *ProductPriceCreate {
+	ppc.mutation.SetStatus(s)
+	return ppc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ppc *ProductPriceCreate) SetNillableStatus(s *string) *ProductPriceCreate {
+	if s != nil {
+		ppc.SetStatus(*s)
+	}
+	return ppc
+}
+
+// SetSource sets the "source" field.
+func (ppc *ProductPriceCreate) SetSource(s string) *ProductPriceCreate {
+	ppc.mutation.SetSource(s)
+	return ppc
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (ppc *ProductPriceCreate) SetNillableSource(s *string) *ProductPriceCreate {
+	if s != nil {
+		ppc.SetSource(*s)
+	}
+	return ppc
+}
+
+// SetUserID sets the "user_id" field.
+func (ppc *ProductPriceCreate) SetUserID(i int) *ProductPriceCreate {
+	ppc.mutation.SetUserID(i)
+	return ppc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ppc *ProductPriceCreate) SetNillableUserID(i *int) *ProductPriceCreate {
+	if i != nil {
+		ppc.SetUserID(*i)
+	}
+	return ppc
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (ppc *ProductPriceCreate) SetAppliedAt(t time.Time) *ProductPriceCreate {
+	ppc.mutation.SetAppliedAt(t)
+	return ppc
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (ppc *ProductPriceCreate) SetNillableAppliedAt(t *time.Time) *ProductPriceCreate {
+	if t != nil {
+		ppc.SetAppliedAt(*t)
+	}
+	return ppc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ppc *ProductPriceCreate) SetCreatedAt(t time.Time) *ProductPriceCreate {
+	ppc.mutation.SetCreatedAt(t)
+	return ppc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ppc *ProductPriceCreate) SetNillableCreatedAt(t *time.Time) *ProductPriceCreate {
+	if t != nil {
+		ppc.SetCreatedAt(*t)
+	}
+	return ppc
+}
+
+// Mutation returns the ProductPriceMutation object of the builder.
+func (ppc *ProductPriceCreate) Mutation() *ProductPriceMutation {
+	return ppc.mutation
+}
+
+// Save creates the ProductPrice in the database.
+func (ppc *ProductPriceCreate) Save(ctx context.Context) (*ProductPrice, error) {
+	var (
+		err  error
+		node *ProductPrice
+	)
+	ppc.defaults()
+	if len(ppc.hooks) == 0 {
+		if err = ppc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ppc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductPriceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ppc.check(); err != nil {
+				return nil, err
+			}
+			ppc.mutation = mutation
+			if node, err = ppc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ppc.hooks) - 1; i >= 0; i-- {
+			if ppc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ppc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ppc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ProductPrice)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ProductPriceMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ppc *ProductPriceCreate) SaveX(ctx context.Context) *ProductPrice {
+	v, err := ppc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ppc *ProductPriceCreate) Exec(ctx context.Context) error {
+	_, err := ppc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppc *ProductPriceCreate) ExecX(ctx context.Context) {
+	if err := ppc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppc *ProductPriceCreate) defaults() {
+	if _, ok := ppc.mutation.Status(); !ok {
+		v := productprice.DefaultStatus
+		ppc.mutation.SetStatus(v)
+	}
+	if _, ok := ppc.mutation.Source(); !ok {
+		v := productprice.DefaultSource
+		ppc.mutation.SetSource(v)
+	}
+	if _, ok := ppc.mutation.CreatedAt(); !ok {
+		v := productprice.DefaultCreatedAt()
+		ppc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppc *ProductPriceCreate) check() error {
+	if _, ok := ppc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ProductPrice.tenant_id"`)}
+	}
+	if _, ok := ppc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "ProductPrice.product_id"`)}
+	}
+	if _, ok := ppc.mutation.RetailPrice(); !ok {
+		return &ValidationError{Name: "retail_price", err: errors.New(`ent: missing required field "ProductPrice.retail_price"`)}
+	}
+	if v, ok := ppc.mutation.RetailPrice(); ok {
+		if err := productprice.RetailPriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "retail_price", err: fmt.Errorf(`ent: validator failed for field "ProductPrice.retail_price": %w`, err)}
+		}
+	}
+	if v, ok := ppc.mutation.WholesalePrice(); ok {
+		if err := productprice.WholesalePriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "wholesale_price", err: fmt.Errorf(`ent: validator failed for field "ProductPrice.wholesale_price": %w`, err)}
+		}
+	}
+	if v, ok := ppc.mutation.PurchasePrice(); ok {
+		if err := productprice.PurchasePriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "purchase_price", err: fmt.Errorf(`ent: validator failed for field "ProductPrice.purchase_price": %w`, err)}
+		}
+	}
+	if _, ok := ppc.mutation.EffectiveFrom(); !ok {
+		return &ValidationError{Name: "effective_from", err: errors.New(`ent: missing required field "ProductPrice.effective_from"`)}
+	}
+	if _, ok := ppc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ProductPrice.status"`)}
+	}
+	if _, ok := ppc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "ProductPrice.source"`)}
+	}
+	if _, ok := ppc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductPrice.created_at"`)}
+	}
+	return nil
+}
+
+func (ppc *ProductPriceCreate) sqlSave(ctx context.Context) (*ProductPrice, error) {
+	_node, _spec := ppc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ppc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ppc *ProductPriceCreate) createSpec() (*ProductPrice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductPrice{config: ppc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: productprice.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productprice.FieldID,
+			},
+		}
+	)
+	if value, ok := ppc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productprice.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := ppc.mutation.ProductID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productprice.FieldProductID,
+		})
+		_node.ProductID = value
+	}
+	if value, ok := ppc.mutation.RetailPrice(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productprice.FieldRetailPrice,
+		})
+		_node.RetailPrice = value
+	}
+	if value, ok := ppc.mutation.WholesalePrice(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productprice.FieldWholesalePrice,
+		})
+		_node.WholesalePrice = &value
+	}
+	if value, ok := ppc.mutation.PurchasePrice(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productprice.FieldPurchasePrice,
+		})
+		_node.PurchasePrice = &value
+	}
+	if value, ok := ppc.mutation.EffectiveFrom(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productprice.FieldEffectiveFrom,
+		})
+		_node.EffectiveFrom = value
+	}
+	if value, ok := ppc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productprice.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := ppc.mutation.Source(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productprice.FieldSource,
+		})
+		_node.Source = value
+	}
+	if value, ok := ppc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productprice.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := ppc.mutation.AppliedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productprice.FieldAppliedAt,
+		})
+		_node.AppliedAt = &value
+	}
+	if value, ok := ppc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productprice.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ProductPriceCreateBulk is the builder for creating many ProductPrice entities in bulk.
+type ProductPriceCreateBulk struct {
+	config
+	builders []*ProductPriceCreate
+}
+
+// Save creates the ProductPrice entities in the database.
+func (ppcb *ProductPriceCreateBulk) Save(ctx context.Context) ([]*ProductPrice, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ppcb.builders))
+	nodes := make([]*ProductPrice, len(ppcb.builders))
+	mutators := make([]Mutator, len(ppcb.builders))
+	for i := range ppcb.builders {
+		func(i int, root context.Context) {
+			builder := ppcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductPriceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ppcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ppcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ppcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppcb *ProductPriceCreateBulk) SaveX(ctx context.Context) []*ProductPrice {
+	v, err := ppcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ppcb *ProductPriceCreateBulk) Exec(ctx context.Context) error {
+	_, err := ppcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppcb *ProductPriceCreateBulk) ExecX(ctx context.Context) {
+	if err := ppcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
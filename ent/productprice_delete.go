@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productprice"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductPriceDelete is the builder for deleting a ProductPrice entity.
+type ProductPriceDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProductPriceMutation
+}
+
+// Where appends a list predicates to the ProductPriceDelete builder.
+func (ppd *ProductPriceDelete) Where(ps ...predicate.ProductPrice) *ProductPriceDelete {
+	ppd.mutation.Where(ps...)
+	return ppd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ppd *ProductPriceDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ppd.hooks) == 0 {
+		affected, err = ppd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductPriceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ppd.mutation = mutation
+			affected, err = ppd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ppd.hooks) - 1; i >= 0; i-- {
+			if ppd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ppd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ppd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppd *ProductPriceDelete) ExecX(ctx context.Context) int {
+	n, err := ppd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ppd *ProductPriceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: productprice.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productprice.FieldID,
+			},
+		},
+	}
+	if ps := ppd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ppd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// ProductPriceDeleteOne is the builder for deleting a single ProductPrice entity.
+type ProductPriceDeleteOne struct {
+	ppd *ProductPriceDelete
+}
+
+// Exec executes the deletion query.
+func (ppdo *ProductPriceDeleteOne) Exec(ctx context.Context) error {
+	n, err := ppdo.ppd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{productprice.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppdo *ProductPriceDeleteOne) ExecX(ctx context.Context) {
+	ppdo.ppd.ExecX(ctx)
+}
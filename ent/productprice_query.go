@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productprice"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductPriceQuery is the builder for querying ProductPrice entities.
+type ProductPriceQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ProductPrice
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProductPriceQuery builder.
+func (ppq *ProductPriceQuery) Where(ps ...predicate.ProductPrice) *ProductPriceQuery {
+	ppq.predicates = append(ppq.predicates, ps...)
+	return ppq
+}
+
+// Limit adds a limit step to the query.
+func (ppq *ProductPriceQuery) Limit(limit int) *ProductPriceQuery {
+	ppq.limit = &limit
+	return ppq
+}
+
+// Offset adds an offset step to the query.
+func (ppq *ProductPriceQuery) Offset(offset int) *ProductPriceQuery {
+	ppq.offset = &offset
+	return ppq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ppq *ProductPriceQuery) Unique(unique bool) *ProductPriceQuery {
+	ppq.unique = &unique
+	return ppq
+}
+
+// Order adds an order step to the query.
+func (ppq *ProductPriceQuery) Order(o ...OrderFunc) *ProductPriceQuery {
+	ppq.order = append(ppq.order, o...)
+	return ppq
+}
+
+// First returns the first ProductPrice entity from the query.
+// Returns a *NotFoundError when no ProductPrice was found.
+func (ppq *ProductPriceQuery) First(ctx context.Context) (*ProductPrice, error) {
+	nodes, err := ppq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{productprice.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ppq *ProductPriceQuery) FirstX(ctx context.Context) *ProductPrice {
+	node, err := ppq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProductPrice ID from the query.
+// Returns a *NotFoundError when no ProductPrice ID was found.
+func (ppq *ProductPriceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ppq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{productprice.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ppq *ProductPriceQuery) FirstIDX(ctx context.Context) int {
+	id, err := ppq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProductPrice entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProductPrice entity is found.
+// Returns a *NotFoundError when no ProductPrice entities are found.
+func (ppq *ProductPriceQuery) Only(ctx context.Context) (*ProductPrice, error) {
+	nodes, err := ppq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{productprice.Label}
+	default:
+		return nil, &NotSingularError{productprice.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ppq *ProductPriceQuery) OnlyX(ctx context.Context) *ProductPrice {
+	node, err := ppq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProductPrice ID in the query.
+// Returns a *NotSingularError when more than one ProductPrice ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ppq *ProductPriceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ppq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{productprice.Label}
+	default:
+		err = &NotSingularError{productprice.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ppq *ProductPriceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ppq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProductPrices.
+func (ppq *ProductPriceQuery) All(ctx context.Context) ([]*ProductPrice, error) {
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ppq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ppq *ProductPriceQuery) AllX(ctx context.Context) []*ProductPrice {
+	nodes, err := ppq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProductPrice IDs.
+func (ppq *ProductPriceQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := ppq.Select(productprice.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ppq *ProductPriceQuery) IDsX(ctx context.Context) []int {
+	ids, err := ppq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ppq *ProductPriceQuery) Count(ctx context.Context) (int, error) {
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ppq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ppq *ProductPriceQuery) CountX(ctx context.Context) int {
+	count, err := ppq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ppq *ProductPriceQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ppq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ppq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ppq *ProductPriceQuery) ExistX(ctx context.Context) bool {
+	exist, err := ppq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProductPriceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ppq *ProductPriceQuery) Clone() *ProductPriceQuery {
+	if ppq == nil {
+		return nil
+	}
+	return &ProductPriceQuery{
+		config:     ppq.config,
+		limit:      ppq.limit,
+		offset:     ppq.offset,
+		order:      append([]OrderFunc{}, ppq.order...),
+		predicates: append([]predicate.ProductPrice{}, ppq.predicates...),
+		// clone intermediate query.
+		sql:    ppq.sql.Clone(),
+		path:   ppq.path,
+		unique: ppq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProductPrice.Query().
+//		GroupBy(productprice.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ppq *ProductPriceQuery) GroupBy(field string, fields ...string) *ProductPriceGroupBy {
+	grbuild := &ProductPriceGroupBy{config: ppq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ppq.sqlQuery(ctx), nil
+	}
+	grbuild.label = productprice.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.ProductPrice.Query().
+//		Select(productprice.FieldTenantID).
+//		Scan(ctx, &v)
+func (ppq *ProductPriceQuery) Select(fields ...string) *ProductPriceSelect {
+	ppq.fields = append(ppq.fields, fields...)
+	selbuild := &ProductPriceSelect{ProductPriceQuery: ppq}
+	selbuild.label = productprice.Label
+	selbuild.flds, selbuild.scan = &ppq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (ppq *ProductPriceQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ppq.fields {
+		if !productprice.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ppq.path != nil {
+		prev, err := ppq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ppq.sql = prev
+	}
+	return nil
+}
+
+func (ppq *ProductPriceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProductPrice, error) {
+	var (
+		nodes = []*ProductPrice{}
+		_spec = ppq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ProductPrice).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ProductPrice{config: ppq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(ppq.modifiers) > 0 {
+		_spec.Modifiers = ppq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ppq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ppq *ProductPriceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ppq.querySpec()
+	if len(ppq.modifiers) > 0 {
+		_spec.Modifiers = ppq.modifiers
+	}
+	_spec.Node.Columns = ppq.fields
+	if len(ppq.fields) > 0 {
+		_spec.Unique = ppq.unique != nil && *ppq.unique
+	}
+	return sqlgraph.CountNodes(ctx, ppq.driver, _spec)
+}
+
+func (ppq *ProductPriceQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ppq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ppq *ProductPriceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productprice.Table,
+			Columns: productprice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productprice.FieldID,
+			},
+		},
+		From:   ppq.sql,
+		Unique: true,
+	}
+	if unique := ppq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ppq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productprice.FieldID)
+		for i := range fields {
+			if fields[i] != productprice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ppq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ppq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ppq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ppq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ppq *ProductPriceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ppq.driver.Dialect())
+	t1 := builder.Table(productprice.Table)
+	columns := ppq.fields
+	if len(columns) == 0 {
+		columns = productprice.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ppq.sql != nil {
+		selector = ppq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ppq.unique != nil && *ppq.unique {
+		selector.Distinct()
+	}
+	for _, m := range ppq.modifiers {
+		m(selector)
+	}
+	for _, p := range ppq.predicates {
+		p(selector)
+	}
+	for _, p := range ppq.order {
+		p(selector)
+	}
+	if offset := ppq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ppq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ppq *ProductPriceQuery) ForUpdate(opts ...sql.LockOption) *ProductPriceQuery {
+	if ppq.driver.Dialect() == dialect.Postgres {
+		ppq.Unique(false)
+	}
+	ppq.modifiers = append(ppq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ppq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ppq *ProductPriceQuery) ForShare(opts ...sql.LockOption) *ProductPriceQuery {
+	if ppq.driver.Dialect() == dialect.Postgres {
+		ppq.Unique(false)
+	}
+	ppq.modifiers = append(ppq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ppq
+}
+
+// ProductPriceGroupBy is the group-by builder for ProductPrice entities.
+type ProductPriceGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ppgb *ProductPriceGroupBy) Aggregate(fns ...AggregateFunc) *ProductPriceGroupBy {
+	ppgb.fns = append(ppgb.fns, fns...)
+	return ppgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ppgb *ProductPriceGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ppgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ppgb.sql = query
+	return ppgb.sqlScan(ctx, v)
+}
+
+func (ppgb *ProductPriceGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ppgb.fields {
+		if !productprice.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ppgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ppgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ppgb *ProductPriceGroupBy) sqlQuery() *sql.Selector {
+	selector := ppgb.sql.Select()
+	aggregation := make([]string, 0, len(ppgb.fns))
+	for _, fn := range ppgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(ppgb.fields)+len(ppgb.fns))
+		for _, f := range ppgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(ppgb.fields...)...)
+}
+
+// ProductPriceSelect is the builder for selecting fields of ProductPrice entities.
+type ProductPriceSelect struct {
+	*ProductPriceQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pps *ProductPriceSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := pps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	pps.sql = pps.ProductPriceQuery.sqlQuery(ctx)
+	return pps.sqlScan(ctx, v)
+}
+
+func (pps *ProductPriceSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := pps.sql.Query()
+	if err := pps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
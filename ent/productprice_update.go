@@ -0,0 +1,919 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productprice"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductPriceUpdate is the builder for updating ProductPrice entities.
+type ProductPriceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductPriceMutation
+}
+
+// Where appends a list predicates to the ProductPriceUpdate builder.
+func (ppu *ProductPriceUpdate) Where(ps ...predicate.ProductPrice) *ProductPriceUpdate {
+	ppu.mutation.Where(ps...)
+	return ppu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ppu *ProductPriceUpdate) SetTenantID(i int) *ProductPriceUpdate {
+	ppu.mutation.ResetTenantID()
+	ppu.mutation.SetTenantID(i)
+	return ppu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (ppu *ProductPriceUpdate) AddTenantID(i int) *ProductPriceUpdate {
+	ppu.mutation.AddTenantID(i)
+	return ppu
+}
+
+// SetProductID sets the "product_id" field.
+func (ppu *ProductPriceUpdate) SetProductID(i int) *ProductPriceUpdate {
+	ppu.mutation.ResetProductID()
+	ppu.mutation.SetProductID(i)
+	return ppu
+}
+
+// AddProductID adds i to the "product_id" field.
+func (ppu *ProductPriceUpdate) AddProductID(i int) *ProductPriceUpdate {
+	ppu.mutation.AddProductID(i)
+	return ppu
+}
+
+// SetRetailPrice sets the "retail_price" field.
+func (ppu *ProductPriceUpdate) SetRetailPrice(m money.Amount) *ProductPriceUpdate {
+	ppu.mutation.ResetRetailPrice()
+	ppu.mutation.SetRetailPrice(m)
+	return ppu
+}
+
+// AddRetailPrice adds m to the "retail_price" field.
+func (ppu *ProductPriceUpdate) AddRetailPrice(m money.Amount) *ProductPriceUpdate {
+	ppu.mutation.AddRetailPrice(m)
+	return ppu
+}
+
+// SetWholesalePrice sets the "wholesale_price" field.
+func (ppu *ProductPriceUpdate) SetWholesalePrice(m money.Amount) *ProductPriceUpdate {
+	ppu.mutation.ResetWholesalePrice()
+	ppu.mutation.SetWholesalePrice(m)
+	return ppu
+}
+
+// SetNillableWholesalePrice sets the "wholesale_price" field if the given value is not nil.
+func (ppu *ProductPriceUpdate) SetNillableWholesalePrice(m *money.Amount) *ProductPriceUpdate {
+	if m != nil {
+		ppu.SetWholesalePrice(*m)
+	}
+	return ppu
+}
+
+// AddWholesalePrice adds m to the "wholesale_price" field.
+func (ppu *ProductPriceUpdate) AddWholesalePrice(m money.Amount) *ProductPriceUpdate {
+	ppu.mutation.AddWholesalePrice(m)
+	return ppu
+}
+
+// ClearWholesalePrice clears the value of the "wholesale_price" field.
+func (ppu *ProductPriceUpdate) ClearWholesalePrice() *ProductPriceUpdate {
+	ppu.mutation.ClearWholesalePrice()
+	return ppu
+}
+
+// SetPurchasePrice sets the "purchase_price" field.
+func (ppu *ProductPriceUpdate) SetPurchasePrice(m money.Amount) *ProductPriceUpdate {
+	ppu.mutation.ResetPurchasePrice()
+	ppu.mutation.SetPurchasePrice(m)
+	return ppu
+}
+
+// SetNillablePurchasePrice sets the "purchase_price" field if the given value is not nil.
+func (ppu *ProductPriceUpdate) SetNillablePurchasePrice(m *money.Amount) *ProductPriceUpdate {
+	if m != nil {
+		ppu.SetPurchasePrice(*m)
+	}
+	return ppu
+}
+
+// AddPurchasePrice adds m to the "purchase_price" field.
+func (ppu *ProductPriceUpdate) AddPurchasePrice(m money.Amount) *ProductPriceUpdate {
+	ppu.mutation.AddPurchasePrice(m)
+	return ppu
+}
+
+// ClearPurchasePrice clears the value of the "purchase_price" field.
+func (ppu *ProductPriceUpdate) ClearPurchasePrice() *ProductPriceUpdate {
+	ppu.mutation.ClearPurchasePrice()
+	return ppu
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (ppu *ProductPriceUpdate) SetEffectiveFrom(t time.Time) *ProductPriceUpdate {
+	ppu.mutation.SetEffectiveFrom(t)
+	return ppu
+}
+
+// SetStatus sets the "status" field.
+func (ppu *ProductPriceUpdate) SetStatus(s string) *ProductPriceUpdate {
+	ppu.mutation.SetStatus(s)
+	return ppu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ppu *ProductPriceUpdate) SetNillableStatus(s *string) *ProductPriceUpdate {
+	if s != nil {
+		ppu.SetStatus(*s)
+	}
+	return ppu
+}
+
+// SetSource sets the "source" field.
+func (ppu *ProductPriceUpdate) SetSource(s string) *ProductPriceUpdate {
+	ppu.mutation.SetSource(s)
+	return ppu
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (ppu *ProductPriceUpdate) SetNillableSource(s *string) *ProductPriceUpdate {
+	if s != nil {
+		ppu.SetSource(*s)
+	}
+	return ppu
+}
+
+// SetUserID sets the "user_id" field.
+func (ppu *ProductPriceUpdate) SetUserID(i int) *ProductPriceUpdate {
+	ppu.mutation.ResetUserID()
+	ppu.mutation.SetUserID(i)
+	return ppu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ppu *ProductPriceUpdate) SetNillableUserID(i *int) *ProductPriceUpdate {
+	if i != nil {
+		ppu.SetUserID(*i)
+	}
+	return ppu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (ppu *ProductPriceUpdate) AddUserID(i int) *ProductPriceUpdate {
+	ppu.mutation.AddUserID(i)
+	return ppu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (ppu *ProductPriceUpdate) ClearUserID() *ProductPriceUpdate {
+	ppu.mutation.ClearUserID()
+	return ppu
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (ppu *ProductPriceUpdate) SetAppliedAt(t time.Time) *ProductPriceUpdate {
+	ppu.mutation.SetAppliedAt(t)
+	return ppu
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (ppu *ProductPriceUpdate) SetNillableAppliedAt(t *time.Time) *ProductPriceUpdate {
+	if t != nil {
+		ppu.SetAppliedAt(*t)
+	}
+	return ppu
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (ppu *ProductPriceUpdate) ClearAppliedAt() *ProductPriceUpdate {
+	ppu.mutation.ClearAppliedAt()
+	return ppu
+}
+
+// Mutation returns the ProductPriceMutation object of the builder.
+func (ppu *ProductPriceUpdate) Mutation() *ProductPriceMutation {
+	return ppu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ppu *ProductPriceUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ppu.hooks) == 0 {
+		if err = ppu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ppu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductPriceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ppu.check(); err != nil {
+				return 0, err
+			}
+			ppu.mutation = mutation
+			affected, err = ppu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ppu.hooks) - 1; i >= 0; i-- {
+			if ppu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ppu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ppu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppu *ProductPriceUpdate) SaveX(ctx context.Context) int {
+	affected, err := ppu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ppu *ProductPriceUpdate) Exec(ctx context.Context) error {
+	_, err := ppu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppu *ProductPriceUpdate) ExecX(ctx context.Context) {
+	if err := ppu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppu *ProductPriceUpdate) check() error {
+	if v, ok := ppu.mutation.RetailPrice(); ok {
+		if err := productprice.RetailPriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "retail_price", err: fmt.Errorf(`ent: validator failed for field "ProductPrice.retail_price": %w`, err)}
+		}
+	}
+	if v, ok := ppu.mutation.WholesalePrice(); ok {
+		if err := productprice.WholesalePriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "wholesale_price", err: fmt.Errorf(`ent: validator failed for field "ProductPrice.wholesale_price": %w`, err)}
+		}
+	}
+	if v, ok := ppu.mutation.PurchasePrice(); ok {
+		if err := productprice.PurchasePriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "purchase_price", err: fmt.Errorf(`ent: validator failed for field "ProductPrice.purchase_price": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ppu *ProductPriceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productprice.Table,
+			Columns: productprice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productprice.FieldID,
+			},
+		},
+	}
+	if ps := ppu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productprice.FieldTenantID,
+		})
+	}
+	if value, ok := ppu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productprice.FieldTenantID,
+		})
+	}
+	if value, ok := ppu.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productprice.FieldProductID,
+		})
+	}
+	if value, ok := ppu.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productprice.FieldProductID,
+		})
+	}
+	if value, ok := ppu.mutation.RetailPrice(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productprice.FieldRetailPrice,
+		})
+	}
+	if value, ok := ppu.mutation.AddedRetailPrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productprice.FieldRetailPrice,
+		})
+	}
+	if value, ok := ppu.mutation.WholesalePrice(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productprice.FieldWholesalePrice,
+		})
+	}
+	if value, ok := ppu.mutation.AddedWholesalePrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productprice.FieldWholesalePrice,
+		})
+	}
+	if ppu.mutation.WholesalePriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: productprice.FieldWholesalePrice,
+		})
+	}
+	if value, ok := ppu.mutation.PurchasePrice(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productprice.FieldPurchasePrice,
+		})
+	}
+	if value, ok := ppu.mutation.AddedPurchasePrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productprice.FieldPurchasePrice,
+		})
+	}
+	if ppu.mutation.PurchasePriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: productprice.FieldPurchasePrice,
+		})
+	}
+	if value, ok := ppu.mutation.EffectiveFrom(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productprice.FieldEffectiveFrom,
+		})
+	}
+	if value, ok := ppu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productprice.FieldStatus,
+		})
+	}
+	if value, ok := ppu.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productprice.FieldSource,
+		})
+	}
+	if value, ok := ppu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productprice.FieldUserID,
+		})
+	}
+	if value, ok := ppu.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productprice.FieldUserID,
+		})
+	}
+	if ppu.mutation.UserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: productprice.FieldUserID,
+		})
+	}
+	if value, ok := ppu.mutation.AppliedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productprice.FieldAppliedAt,
+		})
+	}
+	if ppu.mutation.AppliedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: productprice.FieldAppliedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ppu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productprice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ProductPriceUpdateOne is the builder for updating a single ProductPrice entity.
+type ProductPriceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductPriceMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ppuo *ProductPriceUpdateOne) SetTenantID(i int) *ProductPriceUpdateOne {
+	ppuo.mutation.ResetTenantID()
+	ppuo.mutation.SetTenantID(i)
+	return ppuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (ppuo *ProductPriceUpdateOne) AddTenantID(i int) *ProductPriceUpdateOne {
+	ppuo.mutation.AddTenantID(i)
+	return ppuo
+}
+
+// SetProductID sets the "product_id" field.
+func (ppuo *ProductPriceUpdateOne) SetProductID(i int) *ProductPriceUpdateOne {
+	ppuo.mutation.ResetProductID()
+	ppuo.mutation.SetProductID(i)
+	return ppuo
+}
+
+// AddProductID adds i to the "product_id" field.
+func (ppuo *ProductPriceUpdateOne) AddProductID(i int) *ProductPriceUpdateOne {
+	ppuo.mutation.AddProductID(i)
+	return ppuo
+}
+
+// SetRetailPrice sets the "retail_price" field.
+func (ppuo *ProductPriceUpdateOne) SetRetailPrice(m money.Amount) *ProductPriceUpdateOne {
+	ppuo.mutation.ResetRetailPrice()
+	ppuo.mutation.SetRetailPrice(m)
+	return ppuo
+}
+
+// AddRetailPrice adds m to the "retail_price" field.
+func (ppuo *ProductPriceUpdateOne) AddRetailPrice(m money.Amount) *ProductPriceUpdateOne {
+	ppuo.mutation.AddRetailPrice(m)
+	return ppuo
+}
+
+// SetWholesalePrice sets the "wholesale_price" field.
+func (ppuo *ProductPriceUpdateOne) SetWholesalePrice(m money.Amount) *ProductPriceUpdateOne {
+	ppuo.mutation.ResetWholesalePrice()
+	ppuo.mutation.SetWholesalePrice(m)
+	return ppuo
+}
+
+// SetNillableWholesalePrice sets the "wholesale_price" field if the given value is not nil.
+func (ppuo *ProductPriceUpdateOne) SetNillableWholesalePrice(m *money.Amount) *ProductPriceUpdateOne {
+	if m != nil {
+		ppuo.SetWholesalePrice(*m)
+	}
+	return ppuo
+}
+
+// AddWholesalePrice adds m to the "wholesale_price" field.
+func (ppuo *ProductPriceUpdateOne) AddWholesalePrice(m money.Amount) *ProductPriceUpdateOne {
+	ppuo.mutation.AddWholesalePrice(m)
+	return ppuo
+}
+
+// ClearWholesalePrice clears the value of the "wholesale_price" field.
+func (ppuo *ProductPriceUpdateOne) ClearWholesalePrice() *ProductPriceUpdateOne {
+	ppuo.mutation.ClearWholesalePrice()
+	return ppuo
+}
+
+// SetPurchasePrice sets the "purchase_price" field.
+func (ppuo *ProductPriceUpdateOne) SetPurchasePrice(m money.Amount) *ProductPriceUpdateOne {
+	ppuo.mutation.ResetPurchasePrice()
+	ppuo.mutation.SetPurchasePrice(m)
+	return ppuo
+}
+
+// SetNillablePurchasePrice sets the "purchase_price" field if the given value is not nil.
+func (ppuo *ProductPriceUpdateOne) SetNillablePurchasePrice(m *money.Amount) *ProductPriceUpdateOne {
+	if m != nil {
+		ppuo.SetPurchasePrice(*m)
+	}
+	return ppuo
+}
+
+// AddPurchasePrice adds m to the "purchase_price" field.
+func (ppuo *ProductPriceUpdateOne) AddPurchasePrice(m money.Amount) *ProductPriceUpdateOne {
+	ppuo.mutation.AddPurchasePrice(m)
+	return ppuo
+}
+
+// ClearPurchasePrice clears the value of the "purchase_price" field.
+func (ppuo *ProductPriceUpdateOne) ClearPurchasePrice() *ProductPriceUpdateOne {
+	ppuo.mutation.ClearPurchasePrice()
+	return ppuo
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (ppuo *ProductPriceUpdateOne) SetEffectiveFrom(t time.Time) *ProductPriceUpdateOne {
+	ppuo.mutation.SetEffectiveFrom(t)
+	return ppuo
+}
+
+// SetStatus sets the "status" field.
+func (ppuo *ProductPriceUpdateOne) SetStatus(s string) *ProductPriceUpdateOne {
+	ppuo.mutation.SetStatus(s)
+	return ppuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ppuo *ProductPriceUpdateOne) SetNillableStatus(s *string) *ProductPriceUpdateOne {
+	if s != nil {
+		ppuo.SetStatus(*s)
+	}
+	return ppuo
+}
+
+// SetSource sets the "source" field.
+func (ppuo *ProductPriceUpdateOne) SetSource(s string) *ProductPriceUpdateOne {
+	ppuo.mutation.SetSource(s)
+	return ppuo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (ppuo *ProductPriceUpdateOne) SetNillableSource(s *string) *ProductPriceUpdateOne {
+	if s != nil {
+		ppuo.SetSource(*s)
+	}
+	return ppuo
+}
+
+// SetUserID sets the "user_id" field.
+func (ppuo *ProductPriceUpdateOne) SetUserID(i int) *ProductPriceUpdateOne {
+	ppuo.mutation.ResetUserID()
+	ppuo.mutation.SetUserID(i)
+	return ppuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ppuo *ProductPriceUpdateOne) SetNillableUserID(i *int) *ProductPriceUpdateOne {
+	if i != nil {
+		ppuo.SetUserID(*i)
+	}
+	return ppuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (ppuo *ProductPriceUpdateOne) AddUserID(i int) *ProductPriceUpdateOne {
+	ppuo.mutation.AddUserID(i)
+	return ppuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (ppuo *ProductPriceUpdateOne) ClearUserID() *ProductPriceUpdateOne {
+	ppuo.mutation.ClearUserID()
+	return ppuo
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (ppuo *ProductPriceUpdateOne) SetAppliedAt(t time.Time) *ProductPriceUpdateOne {
+	ppuo.mutation.SetAppliedAt(t)
+	return ppuo
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (ppuo *ProductPriceUpdateOne) SetNillableAppliedAt(t *time.Time) *ProductPriceUpdateOne {
+	if t != nil {
+		ppuo.SetAppliedAt(*t)
+	}
+	return ppuo
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (ppuo *ProductPriceUpdateOne) ClearAppliedAt() *ProductPriceUpdateOne {
+	ppuo.mutation.ClearAppliedAt()
+	return ppuo
+}
+
+// Mutation returns the ProductPriceMutation object of the builder.
+func (ppuo *ProductPriceUpdateOne) Mutation() *ProductPriceMutation {
+	return ppuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ppuo *ProductPriceUpdateOne) Select(field string, fields ...string) *ProductPriceUpdateOne {
+	ppuo.fields = append([]string{field}, fields...)
+	return ppuo
+}
+
+// Save executes the query and returns the updated ProductPrice entity.
+func (ppuo *ProductPriceUpdateOne) Save(ctx context.Context) (*ProductPrice, error) {
+	var (
+		err  error
+		node *ProductPrice
+	)
+	if len(ppuo.hooks) == 0 {
+		if err = ppuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ppuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductPriceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ppuo.check(); err != nil {
+				return nil, err
+			}
+			ppuo.mutation = mutation
+			node, err = ppuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ppuo.hooks) - 1; i >= 0; i-- {
+			if ppuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ppuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ppuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ProductPrice)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ProductPriceMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppuo *ProductPriceUpdateOne) SaveX(ctx context.Context) *ProductPrice {
+	node, err := ppuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ppuo *ProductPriceUpdateOne) Exec(ctx context.Context) error {
+	_, err := ppuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppuo *ProductPriceUpdateOne) ExecX(ctx context.Context) {
+	if err := ppuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppuo *ProductPriceUpdateOne) check() error {
+	if v, ok := ppuo.mutation.RetailPrice(); ok {
+		if err := productprice.RetailPriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "retail_price", err: fmt.Errorf(`ent: validator failed for field "ProductPrice.retail_price": %w`, err)}
+		}
+	}
+	if v, ok := ppuo.mutation.WholesalePrice(); ok {
+		if err := productprice.WholesalePriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "wholesale_price", err: fmt.Errorf(`ent: validator failed for field "ProductPrice.wholesale_price": %w`, err)}
+		}
+	}
+	if v, ok := ppuo.mutation.PurchasePrice(); ok {
+		if err := productprice.PurchasePriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "purchase_price", err: fmt.Errorf(`ent: validator failed for field "ProductPrice.purchase_price": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ppuo *ProductPriceUpdateOne) sqlSave(ctx context.Context) (_node *ProductPrice, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productprice.Table,
+			Columns: productprice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productprice.FieldID,
+			},
+		},
+	}
+	id, ok := ppuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductPrice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ppuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productprice.FieldID)
+		for _, f := range fields {
+			if !productprice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productprice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ppuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ppuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productprice.FieldTenantID,
+		})
+	}
+	if value, ok := ppuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productprice.FieldTenantID,
+		})
+	}
+	if value, ok := ppuo.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productprice.FieldProductID,
+		})
+	}
+	if value, ok := ppuo.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productprice.FieldProductID,
+		})
+	}
+	if value, ok := ppuo.mutation.RetailPrice(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productprice.FieldRetailPrice,
+		})
+	}
+	if value, ok := ppuo.mutation.AddedRetailPrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productprice.FieldRetailPrice,
+		})
+	}
+	if value, ok := ppuo.mutation.WholesalePrice(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productprice.FieldWholesalePrice,
+		})
+	}
+	if value, ok := ppuo.mutation.AddedWholesalePrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productprice.FieldWholesalePrice,
+		})
+	}
+	if ppuo.mutation.WholesalePriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: productprice.FieldWholesalePrice,
+		})
+	}
+	if value, ok := ppuo.mutation.PurchasePrice(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productprice.FieldPurchasePrice,
+		})
+	}
+	if value, ok := ppuo.mutation.AddedPurchasePrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productprice.FieldPurchasePrice,
+		})
+	}
+	if ppuo.mutation.PurchasePriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: productprice.FieldPurchasePrice,
+		})
+	}
+	if value, ok := ppuo.mutation.EffectiveFrom(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productprice.FieldEffectiveFrom,
+		})
+	}
+	if value, ok := ppuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productprice.FieldStatus,
+		})
+	}
+	if value, ok := ppuo.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productprice.FieldSource,
+		})
+	}
+	if value, ok := ppuo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productprice.FieldUserID,
+		})
+	}
+	if value, ok := ppuo.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productprice.FieldUserID,
+		})
+	}
+	if ppuo.mutation.UserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: productprice.FieldUserID,
+		})
+	}
+	if value, ok := ppuo.mutation.AppliedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productprice.FieldAppliedAt,
+		})
+	}
+	if ppuo.mutation.AppliedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: productprice.FieldAppliedAt,
+		})
+	}
+	_node = &ProductPrice{config: ppuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ppuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productprice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
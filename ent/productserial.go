@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/productserial"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProductSerial is the model entity for the ProductSerial schema.
+type ProductSerial struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del producto
+	ProductID int `json:"product_id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// Número de serie de la unidad
+	SerialNumber string `json:"serial_number,omitempty"`
+	// Estado de la unidad (in_stock, sold)
+	Status string `json:"status,omitempty"`
+	// ID del proveedor que entregó la unidad
+	SupplierID int `json:"supplier_id,omitempty"`
+	// ID de la factura de compra con la que se recibió
+	PurchaseInvoiceID int `json:"purchase_invoice_id,omitempty"`
+	// ID de la factura de venta con la que se vendió
+	InvoiceID int `json:"invoice_id,omitempty"`
+	// Fecha de recepción
+	ReceivedAt time.Time `json:"received_at,omitempty"`
+	// Fecha de venta
+	SoldAt *time.Time `json:"sold_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProductSerial) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case productserial.FieldID, productserial.FieldProductID, productserial.FieldTenantID, productserial.FieldSupplierID, productserial.FieldPurchaseInvoiceID, productserial.FieldInvoiceID:
+			values[i] = new(sql.NullInt64)
+		case productserial.FieldSerialNumber, productserial.FieldStatus:
+			values[i] = new(sql.NullString)
+		case productserial.FieldReceivedAt, productserial.FieldSoldAt, productserial.FieldCreatedAt, productserial.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ProductSerial", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProductSerial fields.
+func (ps *ProductSerial) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case productserial.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ps.ID = int(value.Int64)
+		case productserial.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				ps.ProductID = int(value.Int64)
+			}
+		case productserial.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ps.TenantID = int(value.Int64)
+			}
+		case productserial.FieldSerialNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field serial_number", values[i])
+			} else if value.Valid {
+				ps.SerialNumber = value.String
+			}
+		case productserial.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ps.Status = value.String
+			}
+		case productserial.FieldSupplierID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field supplier_id", values[i])
+			} else if value.Valid {
+				ps.SupplierID = int(value.Int64)
+			}
+		case productserial.FieldPurchaseInvoiceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field purchase_invoice_id", values[i])
+			} else if value.Valid {
+				ps.PurchaseInvoiceID = int(value.Int64)
+			}
+		case productserial.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				ps.InvoiceID = int(value.Int64)
+			}
+		case productserial.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				ps.ReceivedAt = value.Time
+			}
+		case productserial.FieldSoldAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sold_at", values[i])
+			} else if value.Valid {
+				ps.SoldAt = new(time.Time)
+				*ps.SoldAt = value.Time
+			}
+		case productserial.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ps.CreatedAt = value.Time
+			}
+		case productserial.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ps.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ProductSerial.
+// Note that you need to call ProductSerial.Unwrap() before calling this method if this ProductSerial
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ps *ProductSerial) Update() *ProductSerialUpdateOne {
+	return (&ProductSerialClient{config: ps.config}).UpdateOne(ps)
+}
+
+// Unwrap unwraps the ProductSerial entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ps *ProductSerial) Unwrap() *ProductSerial {
+	_tx, ok := ps.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProductSerial is not a transactional entity")
+	}
+	ps.config.driver = _tx.drv
+	return ps
+}
+
+// String implements the fmt.Stringer.
+func (ps *ProductSerial) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProductSerial(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ps.ID))
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", ps.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", ps.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("serial_number=")
+	builder.WriteString(ps.SerialNumber)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(ps.Status)
+	builder.WriteString(", ")
+	builder.WriteString("supplier_id=")
+	builder.WriteString(fmt.Sprintf("%v", ps.SupplierID))
+	builder.WriteString(", ")
+	builder.WriteString("purchase_invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", ps.PurchaseInvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", ps.InvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("received_at=")
+	builder.WriteString(ps.ReceivedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ps.SoldAt; v != nil {
+		builder.WriteString("sold_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ps.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ps.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProductSerials is a parsable slice of ProductSerial.
+type ProductSerials []*ProductSerial
+
+func (ps ProductSerials) config(cfg config) {
+	for _i := range ps {
+		ps[_i].config = cfg
+	}
+}
@@ -0,0 +1,79 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productserial
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the productserial type in the database.
+	Label = "product_serial"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldSerialNumber holds the string denoting the serial_number field in the database.
+	FieldSerialNumber = "serial_number"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldSupplierID holds the string denoting the supplier_id field in the database.
+	FieldSupplierID = "supplier_id"
+	// FieldPurchaseInvoiceID holds the string denoting the purchase_invoice_id field in the database.
+	FieldPurchaseInvoiceID = "purchase_invoice_id"
+	// FieldInvoiceID holds the string denoting the invoice_id field in the database.
+	FieldInvoiceID = "invoice_id"
+	// FieldReceivedAt holds the string denoting the received_at field in the database.
+	FieldReceivedAt = "received_at"
+	// FieldSoldAt holds the string denoting the sold_at field in the database.
+	FieldSoldAt = "sold_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the productserial in the database.
+	Table = "product_serials"
+)
+
+// Columns holds all SQL columns for productserial fields.
+var Columns = []string{
+	FieldID,
+	FieldProductID,
+	FieldTenantID,
+	FieldSerialNumber,
+	FieldStatus,
+	FieldSupplierID,
+	FieldPurchaseInvoiceID,
+	FieldInvoiceID,
+	FieldReceivedAt,
+	FieldSoldAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SerialNumberValidator is a validator for the "serial_number" field. It is called by the builders before save.
+	SerialNumberValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultReceivedAt holds the default value on creation for the "received_at" field.
+	DefaultReceivedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
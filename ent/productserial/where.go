@@ -0,0 +1,1152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productserial
+
+import (
+	"Veritasbackend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// SerialNumber applies equality check predicate on the "serial_number" field. It's identical to SerialNumberEQ.
+func SerialNumber(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSerialNumber), v))
+	})
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// SupplierID applies equality check predicate on the "supplier_id" field. It's identical to SupplierIDEQ.
+func SupplierID(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSupplierID), v))
+	})
+}
+
+// PurchaseInvoiceID applies equality check predicate on the "purchase_invoice_id" field. It's identical to PurchaseInvoiceIDEQ.
+func PurchaseInvoiceID(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPurchaseInvoiceID), v))
+	})
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInvoiceID), v))
+	})
+}
+
+// ReceivedAt applies equality check predicate on the "received_at" field. It's identical to ReceivedAtEQ.
+func ReceivedAt(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReceivedAt), v))
+	})
+}
+
+// SoldAt applies equality check predicate on the "sold_at" field. It's identical to SoldAtEQ.
+func SoldAt(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSoldAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProductID), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// SerialNumberEQ applies the EQ predicate on the "serial_number" field.
+func SerialNumberEQ(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberNEQ applies the NEQ predicate on the "serial_number" field.
+func SerialNumberNEQ(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberIn applies the In predicate on the "serial_number" field.
+func SerialNumberIn(vs ...string) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSerialNumber), v...))
+	})
+}
+
+// SerialNumberNotIn applies the NotIn predicate on the "serial_number" field.
+func SerialNumberNotIn(vs ...string) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSerialNumber), v...))
+	})
+}
+
+// SerialNumberGT applies the GT predicate on the "serial_number" field.
+func SerialNumberGT(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberGTE applies the GTE predicate on the "serial_number" field.
+func SerialNumberGTE(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberLT applies the LT predicate on the "serial_number" field.
+func SerialNumberLT(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberLTE applies the LTE predicate on the "serial_number" field.
+func SerialNumberLTE(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberContains applies the Contains predicate on the "serial_number" field.
+func SerialNumberContains(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberHasPrefix applies the HasPrefix predicate on the "serial_number" field.
+func SerialNumberHasPrefix(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberHasSuffix applies the HasSuffix predicate on the "serial_number" field.
+func SerialNumberHasSuffix(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberEqualFold applies the EqualFold predicate on the "serial_number" field.
+func SerialNumberEqualFold(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSerialNumber), v))
+	})
+}
+
+// SerialNumberContainsFold applies the ContainsFold predicate on the "serial_number" field.
+func SerialNumberContainsFold(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSerialNumber), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStatus), v))
+	})
+}
+
+// SupplierIDEQ applies the EQ predicate on the "supplier_id" field.
+func SupplierIDEQ(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSupplierID), v))
+	})
+}
+
+// SupplierIDNEQ applies the NEQ predicate on the "supplier_id" field.
+func SupplierIDNEQ(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSupplierID), v))
+	})
+}
+
+// SupplierIDIn applies the In predicate on the "supplier_id" field.
+func SupplierIDIn(vs ...int) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSupplierID), v...))
+	})
+}
+
+// SupplierIDNotIn applies the NotIn predicate on the "supplier_id" field.
+func SupplierIDNotIn(vs ...int) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSupplierID), v...))
+	})
+}
+
+// SupplierIDGT applies the GT predicate on the "supplier_id" field.
+func SupplierIDGT(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSupplierID), v))
+	})
+}
+
+// SupplierIDGTE applies the GTE predicate on the "supplier_id" field.
+func SupplierIDGTE(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSupplierID), v))
+	})
+}
+
+// SupplierIDLT applies the LT predicate on the "supplier_id" field.
+func SupplierIDLT(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSupplierID), v))
+	})
+}
+
+// SupplierIDLTE applies the LTE predicate on the "supplier_id" field.
+func SupplierIDLTE(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSupplierID), v))
+	})
+}
+
+// SupplierIDIsNil applies the IsNil predicate on the "supplier_id" field.
+func SupplierIDIsNil() predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSupplierID)))
+	})
+}
+
+// SupplierIDNotNil applies the NotNil predicate on the "supplier_id" field.
+func SupplierIDNotNil() predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSupplierID)))
+	})
+}
+
+// PurchaseInvoiceIDEQ applies the EQ predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDEQ(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPurchaseInvoiceID), v))
+	})
+}
+
+// PurchaseInvoiceIDNEQ applies the NEQ predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDNEQ(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPurchaseInvoiceID), v))
+	})
+}
+
+// PurchaseInvoiceIDIn applies the In predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDIn(vs ...int) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPurchaseInvoiceID), v...))
+	})
+}
+
+// PurchaseInvoiceIDNotIn applies the NotIn predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDNotIn(vs ...int) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPurchaseInvoiceID), v...))
+	})
+}
+
+// PurchaseInvoiceIDGT applies the GT predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDGT(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPurchaseInvoiceID), v))
+	})
+}
+
+// PurchaseInvoiceIDGTE applies the GTE predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDGTE(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPurchaseInvoiceID), v))
+	})
+}
+
+// PurchaseInvoiceIDLT applies the LT predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDLT(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPurchaseInvoiceID), v))
+	})
+}
+
+// PurchaseInvoiceIDLTE applies the LTE predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDLTE(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPurchaseInvoiceID), v))
+	})
+}
+
+// PurchaseInvoiceIDIsNil applies the IsNil predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDIsNil() predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPurchaseInvoiceID)))
+	})
+}
+
+// PurchaseInvoiceIDNotNil applies the NotNil predicate on the "purchase_invoice_id" field.
+func PurchaseInvoiceIDNotNil() predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPurchaseInvoiceID)))
+	})
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...int) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldInvoiceID), v...))
+	})
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...int) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldInvoiceID), v...))
+	})
+}
+
+// InvoiceIDGT applies the GT predicate on the "invoice_id" field.
+func InvoiceIDGT(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDGTE applies the GTE predicate on the "invoice_id" field.
+func InvoiceIDGTE(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDLT applies the LT predicate on the "invoice_id" field.
+func InvoiceIDLT(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDLTE applies the LTE predicate on the "invoice_id" field.
+func InvoiceIDLTE(v int) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldInvoiceID), v))
+	})
+}
+
+// InvoiceIDIsNil applies the IsNil predicate on the "invoice_id" field.
+func InvoiceIDIsNil() predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldInvoiceID)))
+	})
+}
+
+// InvoiceIDNotNil applies the NotNil predicate on the "invoice_id" field.
+func InvoiceIDNotNil() predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldInvoiceID)))
+	})
+}
+
+// ReceivedAtEQ applies the EQ predicate on the "received_at" field.
+func ReceivedAtEQ(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReceivedAt), v))
+	})
+}
+
+// ReceivedAtNEQ applies the NEQ predicate on the "received_at" field.
+func ReceivedAtNEQ(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReceivedAt), v))
+	})
+}
+
+// ReceivedAtIn applies the In predicate on the "received_at" field.
+func ReceivedAtIn(vs ...time.Time) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReceivedAt), v...))
+	})
+}
+
+// ReceivedAtNotIn applies the NotIn predicate on the "received_at" field.
+func ReceivedAtNotIn(vs ...time.Time) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReceivedAt), v...))
+	})
+}
+
+// ReceivedAtGT applies the GT predicate on the "received_at" field.
+func ReceivedAtGT(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReceivedAt), v))
+	})
+}
+
+// ReceivedAtGTE applies the GTE predicate on the "received_at" field.
+func ReceivedAtGTE(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReceivedAt), v))
+	})
+}
+
+// ReceivedAtLT applies the LT predicate on the "received_at" field.
+func ReceivedAtLT(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReceivedAt), v))
+	})
+}
+
+// ReceivedAtLTE applies the LTE predicate on the "received_at" field.
+func ReceivedAtLTE(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReceivedAt), v))
+	})
+}
+
+// SoldAtEQ applies the EQ predicate on the "sold_at" field.
+func SoldAtEQ(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSoldAt), v))
+	})
+}
+
+// SoldAtNEQ applies the NEQ predicate on the "sold_at" field.
+func SoldAtNEQ(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSoldAt), v))
+	})
+}
+
+// SoldAtIn applies the In predicate on the "sold_at" field.
+func SoldAtIn(vs ...time.Time) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSoldAt), v...))
+	})
+}
+
+// SoldAtNotIn applies the NotIn predicate on the "sold_at" field.
+func SoldAtNotIn(vs ...time.Time) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSoldAt), v...))
+	})
+}
+
+// SoldAtGT applies the GT predicate on the "sold_at" field.
+func SoldAtGT(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSoldAt), v))
+	})
+}
+
+// SoldAtGTE applies the GTE predicate on the "sold_at" field.
+func SoldAtGTE(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSoldAt), v))
+	})
+}
+
+// SoldAtLT applies the LT predicate on the "sold_at" field.
+func SoldAtLT(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSoldAt), v))
+	})
+}
+
+// SoldAtLTE applies the LTE predicate on the "sold_at" field.
+func SoldAtLTE(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSoldAt), v))
+	})
+}
+
+// SoldAtIsNil applies the IsNil predicate on the "sold_at" field.
+func SoldAtIsNil() predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSoldAt)))
+	})
+}
+
+// SoldAtNotNil applies the NotNil predicate on the "sold_at" field.
+func SoldAtNotNil() predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSoldAt)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProductSerial {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProductSerial) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProductSerial) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProductSerial) predicate.ProductSerial {
+	return predicate.ProductSerial(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
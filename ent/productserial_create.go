@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/productserial"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductSerialCreate is the builder for creating a ProductSerial entity.
+type ProductSerialCreate struct {
+	config
+	mutation *ProductSerialMutation
+	hooks    []Hook
+}
+
+// SetProductID sets the "product_id" field.
+func (psc *ProductSerialCreate) SetProductID(i int) *ProductSerialCreate {
+	psc.mutation.SetProductID(i)
+	return psc
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (psc *ProductSerialCreate) SetTenantID(i int) *ProductSerialCreate {
+	psc.mutation.SetTenantID(i)
+	return psc
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (psc *ProductSerialCreate) SetSerialNumber(s string) *ProductSerialCreate {
+	psc.mutation.SetSerialNumber(s)
+	return psc
+}
+
+// SetStatus sets the "status" field.
+func (psc *ProductSerialCreate) SetStatus(s string) *ProductSerialCreate {
+	psc.mutation.SetStatus(s)
+	return psc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (psc *ProductSerialCreate) SetNillableStatus(s *string) *ProductSerialCreate {
+	if s != nil {
+		psc.SetStatus(*s)
+	}
+	return psc
+}
+
+// SetSupplierID sets the "supplier_id" field.
+func (psc *ProductSerialCreate) SetSupplierID(i int) *ProductSerialCreate {
+	psc.mutation.SetSupplierID(i)
+	return psc
+}
+
+// SetNillableSupplierID sets the "supplier_id" field if the given value is not nil.
+func (psc *ProductSerialCreate) SetNillableSupplierID(i *int) *ProductSerialCreate {
+	if i != nil {
+		psc.SetSupplierID(*i)
+	}
+	return psc
+}
+
+// SetPurchaseInvoiceID sets the "purchase_invoice_id" field.
+func (psc *ProductSerialCreate) SetPurchaseInvoiceID(i int) *ProductSerialCreate {
+	psc.mutation.SetPurchaseInvoiceID(i)
+	return psc
+}
+
+// SetNillablePurchaseInvoiceID sets the "purchase_invoice_id" field if the given value is not nil.
+func (psc *ProductSerialCreate) SetNillablePurchaseInvoiceID(i *int) *ProductSerialCreate {
+	if i != nil {
+		psc.SetPurchaseInvoiceID(*i)
+	}
+	return psc
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (psc *ProductSerialCreate) SetInvoiceID(i int) *ProductSerialCreate {
+	psc.mutation.SetInvoiceID(i)
+	return psc
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (psc *ProductSerialCreate) SetNillableInvoiceID(i *int) *ProductSerialCreate {
+	if i != nil {
+		psc.SetInvoiceID(*i)
+	}
+	return psc
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (psc *ProductSerialCreate) SetReceivedAt(t time.Time) *ProductSerialCreate {
+	psc.mutation.SetReceivedAt(t)
+	return psc
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (psc *ProductSerialCreate) SetNillableReceivedAt(t *time.Time) *ProductSerialCreate {
+	if t != nil {
+		psc.SetReceivedAt(*t)
+	}
+	return psc
+}
+
+// SetSoldAt sets the "sold_at" field.
+func (psc *ProductSerialCreate) SetSoldAt(t time.Time) *ProductSerialCreate {
+	psc.mutation.SetSoldAt(t)
+	return psc
+}
+
+// SetNillableSoldAt sets the "sold_at" field if the given value is not nil.
+func (psc *ProductSerialCreate) SetNillableSoldAt(t *time.Time) *ProductSerialCreate {
+	if t != nil {
+		psc.SetSoldAt(*t)
+	}
+	return psc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (psc *ProductSerialCreate) SetCreatedAt(t time.Time) *ProductSerialCreate {
+	psc.mutation.SetCreatedAt(t)
+	return psc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (psc *ProductSerialCreate) SetNillableCreatedAt(t *time.Time) *ProductSerialCreate {
+	if t != nil {
+		psc.SetCreatedAt(*t)
+	}
+	return psc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (psc *ProductSerialCreate) SetUpdatedAt(t time.Time) *ProductSerialCreate {
+	psc.mutation.SetUpdatedAt(t)
+	return psc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (psc *ProductSerialCreate) SetNillableUpdatedAt(t *time.Time) *ProductSerialCreate {
+	if t != nil {
+		psc.SetUpdatedAt(*t)
+	}
+	return psc
+}
+
+// Mutation returns the ProductSerialMutation object of the builder.
+func (psc *ProductSerialCreate) Mutation() *ProductSerialMutation {
+	return psc.mutation
+}
+
+// Save creates the ProductSerial in the database.
+func (psc *ProductSerialCreate) Save(ctx context.Context) (*ProductSerial, error) {
+	var (
+		err  error
+		node *ProductSerial
+	)
+	psc.defaults()
+	if len(psc.hooks) == 0 {
+		if err = psc.check(); err != nil {
+			return nil, err
+		}
+		node, err = psc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductSerialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = psc.check(); err != nil {
+				return nil, err
+			}
+			psc.mutation = mutation
+			if node, err = psc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(psc.hooks) - 1; i >= 0; i-- {
+			if psc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = psc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, psc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ProductSerial)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ProductSerialMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (psc *ProductSerialCreate) SaveX(ctx context.Context) *ProductSerial {
+	v, err := psc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (psc *ProductSerialCreate) Exec(ctx context.Context) error {
+	_, err := psc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psc *ProductSerialCreate) ExecX(ctx context.Context) {
+	if err := psc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (psc *ProductSerialCreate) defaults() {
+	if _, ok := psc.mutation.Status(); !ok {
+		v := productserial.DefaultStatus
+		psc.mutation.SetStatus(v)
+	}
+	if _, ok := psc.mutation.ReceivedAt(); !ok {
+		v := productserial.DefaultReceivedAt()
+		psc.mutation.SetReceivedAt(v)
+	}
+	if _, ok := psc.mutation.CreatedAt(); !ok {
+		v := productserial.DefaultCreatedAt()
+		psc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := psc.mutation.UpdatedAt(); !ok {
+		v := productserial.DefaultUpdatedAt()
+		psc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psc *ProductSerialCreate) check() error {
+	if _, ok := psc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "ProductSerial.product_id"`)}
+	}
+	if _, ok := psc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ProductSerial.tenant_id"`)}
+	}
+	if _, ok := psc.mutation.SerialNumber(); !ok {
+		return &ValidationError{Name: "serial_number", err: errors.New(`ent: missing required field "ProductSerial.serial_number"`)}
+	}
+	if v, ok := psc.mutation.SerialNumber(); ok {
+		if err := productserial.SerialNumberValidator(v); err != nil {
+			return &ValidationError{Name: "serial_number", err: fmt.Errorf(`ent: validator failed for field "ProductSerial.serial_number": %w`, err)}
+		}
+	}
+	if _, ok := psc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ProductSerial.status"`)}
+	}
+	if _, ok := psc.mutation.ReceivedAt(); !ok {
+		return &ValidationError{Name: "received_at", err: errors.New(`ent: missing required field "ProductSerial.received_at"`)}
+	}
+	if _, ok := psc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductSerial.created_at"`)}
+	}
+	if _, ok := psc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProductSerial.updated_at"`)}
+	}
+	return nil
+}
+
+func (psc *ProductSerialCreate) sqlSave(ctx context.Context) (*ProductSerial, error) {
+	_node, _spec := psc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, psc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (psc *ProductSerialCreate) createSpec() (*ProductSerial, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductSerial{config: psc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: productserial.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productserial.FieldID,
+			},
+		}
+	)
+	if value, ok := psc.mutation.ProductID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldProductID,
+		})
+		_node.ProductID = value
+	}
+	if value, ok := psc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := psc.mutation.SerialNumber(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productserial.FieldSerialNumber,
+		})
+		_node.SerialNumber = value
+	}
+	if value, ok := psc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productserial.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := psc.mutation.SupplierID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldSupplierID,
+		})
+		_node.SupplierID = value
+	}
+	if value, ok := psc.mutation.PurchaseInvoiceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldPurchaseInvoiceID,
+		})
+		_node.PurchaseInvoiceID = value
+	}
+	if value, ok := psc.mutation.InvoiceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldInvoiceID,
+		})
+		_node.InvoiceID = value
+	}
+	if value, ok := psc.mutation.ReceivedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productserial.FieldReceivedAt,
+		})
+		_node.ReceivedAt = value
+	}
+	if value, ok := psc.mutation.SoldAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productserial.FieldSoldAt,
+		})
+		_node.SoldAt = &value
+	}
+	if value, ok := psc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productserial.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := psc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productserial.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ProductSerialCreateBulk is the builder for creating many ProductSerial entities in bulk.
+type ProductSerialCreateBulk struct {
+	config
+	builders []*ProductSerialCreate
+}
+
+// Save creates the ProductSerial entities in the database.
+func (pscb *ProductSerialCreateBulk) Save(ctx context.Context) ([]*ProductSerial, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pscb.builders))
+	nodes := make([]*ProductSerial, len(pscb.builders))
+	mutators := make([]Mutator, len(pscb.builders))
+	for i := range pscb.builders {
+		func(i int, root context.Context) {
+			builder := pscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductSerialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pscb *ProductSerialCreateBulk) SaveX(ctx context.Context) []*ProductSerial {
+	v, err := pscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pscb *ProductSerialCreateBulk) Exec(ctx context.Context) error {
+	_, err := pscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pscb *ProductSerialCreateBulk) ExecX(ctx context.Context) {
+	if err := pscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
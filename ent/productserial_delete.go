@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productserial"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductSerialDelete is the builder for deleting a ProductSerial entity.
+type ProductSerialDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProductSerialMutation
+}
+
+// Where appends a list predicates to the ProductSerialDelete builder.
+func (psd *ProductSerialDelete) Where(ps ...predicate.ProductSerial) *ProductSerialDelete {
+	psd.mutation.Where(ps...)
+	return psd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (psd *ProductSerialDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(psd.hooks) == 0 {
+		affected, err = psd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductSerialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			psd.mutation = mutation
+			affected, err = psd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(psd.hooks) - 1; i >= 0; i-- {
+			if psd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = psd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, psd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psd *ProductSerialDelete) ExecX(ctx context.Context) int {
+	n, err := psd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (psd *ProductSerialDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: productserial.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productserial.FieldID,
+			},
+		},
+	}
+	if ps := psd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, psd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// ProductSerialDeleteOne is the builder for deleting a single ProductSerial entity.
+type ProductSerialDeleteOne struct {
+	psd *ProductSerialDelete
+}
+
+// Exec executes the deletion query.
+func (psdo *ProductSerialDeleteOne) Exec(ctx context.Context) error {
+	n, err := psdo.psd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{productserial.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psdo *ProductSerialDeleteOne) ExecX(ctx context.Context) {
+	psdo.psd.ExecX(ctx)
+}
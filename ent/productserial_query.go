@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productserial"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductSerialQuery is the builder for querying ProductSerial entities.
+type ProductSerialQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ProductSerial
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProductSerialQuery builder.
+func (psq *ProductSerialQuery) Where(ps ...predicate.ProductSerial) *ProductSerialQuery {
+	psq.predicates = append(psq.predicates, ps...)
+	return psq
+}
+
+// Limit adds a limit step to the query.
+func (psq *ProductSerialQuery) Limit(limit int) *ProductSerialQuery {
+	psq.limit = &limit
+	return psq
+}
+
+// Offset adds an offset step to the query.
+func (psq *ProductSerialQuery) Offset(offset int) *ProductSerialQuery {
+	psq.offset = &offset
+	return psq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (psq *ProductSerialQuery) Unique(unique bool) *ProductSerialQuery {
+	psq.unique = &unique
+	return psq
+}
+
+// Order adds an order step to the query.
+func (psq *ProductSerialQuery) Order(o ...OrderFunc) *ProductSerialQuery {
+	psq.order = append(psq.order, o...)
+	return psq
+}
+
+// First returns the first ProductSerial entity from the query.
+// Returns a *NotFoundError when no ProductSerial was found.
+func (psq *ProductSerialQuery) First(ctx context.Context) (*ProductSerial, error) {
+	nodes, err := psq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{productserial.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (psq *ProductSerialQuery) FirstX(ctx context.Context) *ProductSerial {
+	node, err := psq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProductSerial ID from the query.
+// Returns a *NotFoundError when no ProductSerial ID was found.
+func (psq *ProductSerialQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = psq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{productserial.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (psq *ProductSerialQuery) FirstIDX(ctx context.Context) int {
+	id, err := psq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProductSerial entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProductSerial entity is found.
+// Returns a *NotFoundError when no ProductSerial entities are found.
+func (psq *ProductSerialQuery) Only(ctx context.Context) (*ProductSerial, error) {
+	nodes, err := psq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{productserial.Label}
+	default:
+		return nil, &NotSingularError{productserial.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (psq *ProductSerialQuery) OnlyX(ctx context.Context) *ProductSerial {
+	node, err := psq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProductSerial ID in the query.
+// Returns a *NotSingularError when more than one ProductSerial ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (psq *ProductSerialQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = psq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{productserial.Label}
+	default:
+		err = &NotSingularError{productserial.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (psq *ProductSerialQuery) OnlyIDX(ctx context.Context) int {
+	id, err := psq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProductSerials.
+func (psq *ProductSerialQuery) All(ctx context.Context) ([]*ProductSerial, error) {
+	if err := psq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return psq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (psq *ProductSerialQuery) AllX(ctx context.Context) []*ProductSerial {
+	nodes, err := psq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProductSerial IDs.
+func (psq *ProductSerialQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := psq.Select(productserial.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (psq *ProductSerialQuery) IDsX(ctx context.Context) []int {
+	ids, err := psq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (psq *ProductSerialQuery) Count(ctx context.Context) (int, error) {
+	if err := psq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return psq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (psq *ProductSerialQuery) CountX(ctx context.Context) int {
+	count, err := psq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (psq *ProductSerialQuery) Exist(ctx context.Context) (bool, error) {
+	if err := psq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return psq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (psq *ProductSerialQuery) ExistX(ctx context.Context) bool {
+	exist, err := psq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProductSerialQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (psq *ProductSerialQuery) Clone() *ProductSerialQuery {
+	if psq == nil {
+		return nil
+	}
+	return &ProductSerialQuery{
+		config:     psq.config,
+		limit:      psq.limit,
+		offset:     psq.offset,
+		order:      append([]OrderFunc{}, psq.order...),
+		predicates: append([]predicate.ProductSerial{}, psq.predicates...),
+		// clone intermediate query.
+		sql:    psq.sql.Clone(),
+		path:   psq.path,
+		unique: psq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID int `json:"product_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProductSerial.Query().
+//		GroupBy(productserial.FieldProductID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (psq *ProductSerialQuery) GroupBy(field string, fields ...string) *ProductSerialGroupBy {
+	grbuild := &ProductSerialGroupBy{config: psq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := psq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return psq.sqlQuery(ctx), nil
+	}
+	grbuild.label = productserial.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID int `json:"product_id,omitempty"`
+//	}
+//
+//	client.ProductSerial.Query().
+//		Select(productserial.FieldProductID).
+//		Scan(ctx, &v)
+func (psq *ProductSerialQuery) Select(fields ...string) *ProductSerialSelect {
+	psq.fields = append(psq.fields, fields...)
+	selbuild := &ProductSerialSelect{ProductSerialQuery: psq}
+	selbuild.label = productserial.Label
+	selbuild.flds, selbuild.scan = &psq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (psq *ProductSerialQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range psq.fields {
+		if !productserial.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if psq.path != nil {
+		prev, err := psq.path(ctx)
+		if err != nil {
+			return err
+		}
+		psq.sql = prev
+	}
+	return nil
+}
+
+func (psq *ProductSerialQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProductSerial, error) {
+	var (
+		nodes = []*ProductSerial{}
+		_spec = psq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ProductSerial).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ProductSerial{config: psq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(psq.modifiers) > 0 {
+		_spec.Modifiers = psq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, psq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (psq *ProductSerialQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := psq.querySpec()
+	if len(psq.modifiers) > 0 {
+		_spec.Modifiers = psq.modifiers
+	}
+	_spec.Node.Columns = psq.fields
+	if len(psq.fields) > 0 {
+		_spec.Unique = psq.unique != nil && *psq.unique
+	}
+	return sqlgraph.CountNodes(ctx, psq.driver, _spec)
+}
+
+func (psq *ProductSerialQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := psq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (psq *ProductSerialQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productserial.Table,
+			Columns: productserial.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productserial.FieldID,
+			},
+		},
+		From:   psq.sql,
+		Unique: true,
+	}
+	if unique := psq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := psq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productserial.FieldID)
+		for i := range fields {
+			if fields[i] != productserial.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := psq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := psq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := psq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := psq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (psq *ProductSerialQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(psq.driver.Dialect())
+	t1 := builder.Table(productserial.Table)
+	columns := psq.fields
+	if len(columns) == 0 {
+		columns = productserial.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if psq.sql != nil {
+		selector = psq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if psq.unique != nil && *psq.unique {
+		selector.Distinct()
+	}
+	for _, m := range psq.modifiers {
+		m(selector)
+	}
+	for _, p := range psq.predicates {
+		p(selector)
+	}
+	for _, p := range psq.order {
+		p(selector)
+	}
+	if offset := psq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := psq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (psq *ProductSerialQuery) ForUpdate(opts ...sql.LockOption) *ProductSerialQuery {
+	if psq.driver.Dialect() == dialect.Postgres {
+		psq.Unique(false)
+	}
+	psq.modifiers = append(psq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return psq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (psq *ProductSerialQuery) ForShare(opts ...sql.LockOption) *ProductSerialQuery {
+	if psq.driver.Dialect() == dialect.Postgres {
+		psq.Unique(false)
+	}
+	psq.modifiers = append(psq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return psq
+}
+
+// ProductSerialGroupBy is the group-by builder for ProductSerial entities.
+type ProductSerialGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (psgb *ProductSerialGroupBy) Aggregate(fns ...AggregateFunc) *ProductSerialGroupBy {
+	psgb.fns = append(psgb.fns, fns...)
+	return psgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (psgb *ProductSerialGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := psgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	psgb.sql = query
+	return psgb.sqlScan(ctx, v)
+}
+
+func (psgb *ProductSerialGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range psgb.fields {
+		if !productserial.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := psgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := psgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (psgb *ProductSerialGroupBy) sqlQuery() *sql.Selector {
+	selector := psgb.sql.Select()
+	aggregation := make([]string, 0, len(psgb.fns))
+	for _, fn := range psgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(psgb.fields)+len(psgb.fns))
+		for _, f := range psgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(psgb.fields...)...)
+}
+
+// ProductSerialSelect is the builder for selecting fields of ProductSerial entities.
+type ProductSerialSelect struct {
+	*ProductSerialQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pss *ProductSerialSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := pss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	pss.sql = pss.ProductSerialQuery.sqlQuery(ctx)
+	return pss.sqlScan(ctx, v)
+}
+
+func (pss *ProductSerialSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := pss.sql.Query()
+	if err := pss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,888 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productserial"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductSerialUpdate is the builder for updating ProductSerial entities.
+type ProductSerialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductSerialMutation
+}
+
+// Where appends a list predicates to the ProductSerialUpdate builder.
+func (psu *ProductSerialUpdate) Where(ps ...predicate.ProductSerial) *ProductSerialUpdate {
+	psu.mutation.Where(ps...)
+	return psu
+}
+
+// SetProductID sets the "product_id" field.
+func (psu *ProductSerialUpdate) SetProductID(i int) *ProductSerialUpdate {
+	psu.mutation.ResetProductID()
+	psu.mutation.SetProductID(i)
+	return psu
+}
+
+// AddProductID adds i to the "product_id" field.
+func (psu *ProductSerialUpdate) AddProductID(i int) *ProductSerialUpdate {
+	psu.mutation.AddProductID(i)
+	return psu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (psu *ProductSerialUpdate) SetTenantID(i int) *ProductSerialUpdate {
+	psu.mutation.ResetTenantID()
+	psu.mutation.SetTenantID(i)
+	return psu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (psu *ProductSerialUpdate) AddTenantID(i int) *ProductSerialUpdate {
+	psu.mutation.AddTenantID(i)
+	return psu
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (psu *ProductSerialUpdate) SetSerialNumber(s string) *ProductSerialUpdate {
+	psu.mutation.SetSerialNumber(s)
+	return psu
+}
+
+// SetStatus sets the "status" field.
+func (psu *ProductSerialUpdate) SetStatus(s string) *ProductSerialUpdate {
+	psu.mutation.SetStatus(s)
+	return psu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (psu *ProductSerialUpdate) SetNillableStatus(s *string) *ProductSerialUpdate {
+	if s != nil {
+		psu.SetStatus(*s)
+	}
+	return psu
+}
+
+// SetSupplierID sets the "supplier_id" field.
+func (psu *ProductSerialUpdate) SetSupplierID(i int) *ProductSerialUpdate {
+	psu.mutation.ResetSupplierID()
+	psu.mutation.SetSupplierID(i)
+	return psu
+}
+
+// SetNillableSupplierID sets the "supplier_id" field if the given value is not nil.
+func (psu *ProductSerialUpdate) SetNillableSupplierID(i *int) *ProductSerialUpdate {
+	if i != nil {
+		psu.SetSupplierID(*i)
+	}
+	return psu
+}
+
+// AddSupplierID adds i to the "supplier_id" field.
+func (psu *ProductSerialUpdate) AddSupplierID(i int) *ProductSerialUpdate {
+	psu.mutation.AddSupplierID(i)
+	return psu
+}
+
+// ClearSupplierID clears the value of the "supplier_id" field.
+func (psu *ProductSerialUpdate) ClearSupplierID() *ProductSerialUpdate {
+	psu.mutation.ClearSupplierID()
+	return psu
+}
+
+// SetPurchaseInvoiceID sets the "purchase_invoice_id" field.
+func (psu *ProductSerialUpdate) SetPurchaseInvoiceID(i int) *ProductSerialUpdate {
+	psu.mutation.ResetPurchaseInvoiceID()
+	psu.mutation.SetPurchaseInvoiceID(i)
+	return psu
+}
+
+// SetNillablePurchaseInvoiceID sets the "purchase_invoice_id" field if the given value is not nil.
+func (psu *ProductSerialUpdate) SetNillablePurchaseInvoiceID(i *int) *ProductSerialUpdate {
+	if i != nil {
+		psu.SetPurchaseInvoiceID(*i)
+	}
+	return psu
+}
+
+// AddPurchaseInvoiceID adds i to the "purchase_invoice_id" field.
+func (psu *ProductSerialUpdate) AddPurchaseInvoiceID(i int) *ProductSerialUpdate {
+	psu.mutation.AddPurchaseInvoiceID(i)
+	return psu
+}
+
+// ClearPurchaseInvoiceID clears the value of the "purchase_invoice_id" field.
+func (psu *ProductSerialUpdate) ClearPurchaseInvoiceID() *ProductSerialUpdate {
+	psu.mutation.ClearPurchaseInvoiceID()
+	return psu
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (psu *ProductSerialUpdate) SetInvoiceID(i int) *ProductSerialUpdate {
+	psu.mutation.ResetInvoiceID()
+	psu.mutation.SetInvoiceID(i)
+	return psu
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (psu *ProductSerialUpdate) SetNillableInvoiceID(i *int) *ProductSerialUpdate {
+	if i != nil {
+		psu.SetInvoiceID(*i)
+	}
+	return psu
+}
+
+// AddInvoiceID adds i to the "invoice_id" field.
+func (psu *ProductSerialUpdate) AddInvoiceID(i int) *ProductSerialUpdate {
+	psu.mutation.AddInvoiceID(i)
+	return psu
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (psu *ProductSerialUpdate) ClearInvoiceID() *ProductSerialUpdate {
+	psu.mutation.ClearInvoiceID()
+	return psu
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (psu *ProductSerialUpdate) SetReceivedAt(t time.Time) *ProductSerialUpdate {
+	psu.mutation.SetReceivedAt(t)
+	return psu
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (psu *ProductSerialUpdate) SetNillableReceivedAt(t *time.Time) *ProductSerialUpdate {
+	if t != nil {
+		psu.SetReceivedAt(*t)
+	}
+	return psu
+}
+
+// SetSoldAt sets the "sold_at" field.
+func (psu *ProductSerialUpdate) SetSoldAt(t time.Time) *ProductSerialUpdate {
+	psu.mutation.SetSoldAt(t)
+	return psu
+}
+
+// SetNillableSoldAt sets the "sold_at" field if the given value is not nil.
+func (psu *ProductSerialUpdate) SetNillableSoldAt(t *time.Time) *ProductSerialUpdate {
+	if t != nil {
+		psu.SetSoldAt(*t)
+	}
+	return psu
+}
+
+// ClearSoldAt clears the value of the "sold_at" field.
+func (psu *ProductSerialUpdate) ClearSoldAt() *ProductSerialUpdate {
+	psu.mutation.ClearSoldAt()
+	return psu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (psu *ProductSerialUpdate) SetUpdatedAt(t time.Time) *ProductSerialUpdate {
+	psu.mutation.SetUpdatedAt(t)
+	return psu
+}
+
+// Mutation returns the ProductSerialMutation object of the builder.
+func (psu *ProductSerialUpdate) Mutation() *ProductSerialMutation {
+	return psu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (psu *ProductSerialUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	psu.defaults()
+	if len(psu.hooks) == 0 {
+		if err = psu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = psu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductSerialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = psu.check(); err != nil {
+				return 0, err
+			}
+			psu.mutation = mutation
+			affected, err = psu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(psu.hooks) - 1; i >= 0; i-- {
+			if psu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = psu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, psu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psu *ProductSerialUpdate) SaveX(ctx context.Context) int {
+	affected, err := psu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (psu *ProductSerialUpdate) Exec(ctx context.Context) error {
+	_, err := psu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psu *ProductSerialUpdate) ExecX(ctx context.Context) {
+	if err := psu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (psu *ProductSerialUpdate) defaults() {
+	if _, ok := psu.mutation.UpdatedAt(); !ok {
+		v := productserial.UpdateDefaultUpdatedAt()
+		psu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psu *ProductSerialUpdate) check() error {
+	if v, ok := psu.mutation.SerialNumber(); ok {
+		if err := productserial.SerialNumberValidator(v); err != nil {
+			return &ValidationError{Name: "serial_number", err: fmt.Errorf(`ent: validator failed for field "ProductSerial.serial_number": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (psu *ProductSerialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productserial.Table,
+			Columns: productserial.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productserial.FieldID,
+			},
+		},
+	}
+	if ps := psu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := psu.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldProductID,
+		})
+	}
+	if value, ok := psu.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldProductID,
+		})
+	}
+	if value, ok := psu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldTenantID,
+		})
+	}
+	if value, ok := psu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldTenantID,
+		})
+	}
+	if value, ok := psu.mutation.SerialNumber(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productserial.FieldSerialNumber,
+		})
+	}
+	if value, ok := psu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productserial.FieldStatus,
+		})
+	}
+	if value, ok := psu.mutation.SupplierID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldSupplierID,
+		})
+	}
+	if value, ok := psu.mutation.AddedSupplierID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldSupplierID,
+		})
+	}
+	if psu.mutation.SupplierIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: productserial.FieldSupplierID,
+		})
+	}
+	if value, ok := psu.mutation.PurchaseInvoiceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldPurchaseInvoiceID,
+		})
+	}
+	if value, ok := psu.mutation.AddedPurchaseInvoiceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldPurchaseInvoiceID,
+		})
+	}
+	if psu.mutation.PurchaseInvoiceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: productserial.FieldPurchaseInvoiceID,
+		})
+	}
+	if value, ok := psu.mutation.InvoiceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldInvoiceID,
+		})
+	}
+	if value, ok := psu.mutation.AddedInvoiceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldInvoiceID,
+		})
+	}
+	if psu.mutation.InvoiceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: productserial.FieldInvoiceID,
+		})
+	}
+	if value, ok := psu.mutation.ReceivedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productserial.FieldReceivedAt,
+		})
+	}
+	if value, ok := psu.mutation.SoldAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productserial.FieldSoldAt,
+		})
+	}
+	if psu.mutation.SoldAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: productserial.FieldSoldAt,
+		})
+	}
+	if value, ok := psu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productserial.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, psu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productserial.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ProductSerialUpdateOne is the builder for updating a single ProductSerial entity.
+type ProductSerialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductSerialMutation
+}
+
+// SetProductID sets the "product_id" field.
+func (psuo *ProductSerialUpdateOne) SetProductID(i int) *ProductSerialUpdateOne {
+	psuo.mutation.ResetProductID()
+	psuo.mutation.SetProductID(i)
+	return psuo
+}
+
+// AddProductID adds i to the "product_id" field.
+func (psuo *ProductSerialUpdateOne) AddProductID(i int) *ProductSerialUpdateOne {
+	psuo.mutation.AddProductID(i)
+	return psuo
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (psuo *ProductSerialUpdateOne) SetTenantID(i int) *ProductSerialUpdateOne {
+	psuo.mutation.ResetTenantID()
+	psuo.mutation.SetTenantID(i)
+	return psuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (psuo *ProductSerialUpdateOne) AddTenantID(i int) *ProductSerialUpdateOne {
+	psuo.mutation.AddTenantID(i)
+	return psuo
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (psuo *ProductSerialUpdateOne) SetSerialNumber(s string) *ProductSerialUpdateOne {
+	psuo.mutation.SetSerialNumber(s)
+	return psuo
+}
+
+// SetStatus sets the "status" field.
+func (psuo *ProductSerialUpdateOne) SetStatus(s string) *ProductSerialUpdateOne {
+	psuo.mutation.SetStatus(s)
+	return psuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (psuo *ProductSerialUpdateOne) SetNillableStatus(s *string) *ProductSerialUpdateOne {
+	if s != nil {
+		psuo.SetStatus(*s)
+	}
+	return psuo
+}
+
+// SetSupplierID sets the "supplier_id" field.
+func (psuo *ProductSerialUpdateOne) SetSupplierID(i int) *ProductSerialUpdateOne {
+	psuo.mutation.ResetSupplierID()
+	psuo.mutation.SetSupplierID(i)
+	return psuo
+}
+
+// SetNillableSupplierID sets the "supplier_id" field if the given value is not nil.
+func (psuo *ProductSerialUpdateOne) SetNillableSupplierID(i *int) *ProductSerialUpdateOne {
+	if i != nil {
+		psuo.SetSupplierID(*i)
+	}
+	return psuo
+}
+
+// AddSupplierID adds i to the "supplier_id" field.
+func (psuo *ProductSerialUpdateOne) AddSupplierID(i int) *ProductSerialUpdateOne {
+	psuo.mutation.AddSupplierID(i)
+	return psuo
+}
+
+// ClearSupplierID clears the value of the "supplier_id" field.
+func (psuo *ProductSerialUpdateOne) ClearSupplierID() *ProductSerialUpdateOne {
+	psuo.mutation.ClearSupplierID()
+	return psuo
+}
+
+// SetPurchaseInvoiceID sets the "purchase_invoice_id" field.
+func (psuo *ProductSerialUpdateOne) SetPurchaseInvoiceID(i int) *ProductSerialUpdateOne {
+	psuo.mutation.ResetPurchaseInvoiceID()
+	psuo.mutation.SetPurchaseInvoiceID(i)
+	return psuo
+}
+
+// SetNillablePurchaseInvoiceID sets the "purchase_invoice_id" field if the given value is not nil.
+func (psuo *ProductSerialUpdateOne) SetNillablePurchaseInvoiceID(i *int) *ProductSerialUpdateOne {
+	if i != nil {
+		psuo.SetPurchaseInvoiceID(*i)
+	}
+	return psuo
+}
+
+// AddPurchaseInvoiceID adds i to the "purchase_invoice_id" field.
+func (psuo *ProductSerialUpdateOne) AddPurchaseInvoiceID(i int) *ProductSerialUpdateOne {
+	psuo.mutation.AddPurchaseInvoiceID(i)
+	return psuo
+}
+
+// ClearPurchaseInvoiceID clears the value of the "purchase_invoice_id" field.
+func (psuo *ProductSerialUpdateOne) ClearPurchaseInvoiceID() *ProductSerialUpdateOne {
+	psuo.mutation.ClearPurchaseInvoiceID()
+	return psuo
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (psuo *ProductSerialUpdateOne) SetInvoiceID(i int) *ProductSerialUpdateOne {
+	psuo.mutation.ResetInvoiceID()
+	psuo.mutation.SetInvoiceID(i)
+	return psuo
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (psuo *ProductSerialUpdateOne) SetNillableInvoiceID(i *int) *ProductSerialUpdateOne {
+	if i != nil {
+		psuo.SetInvoiceID(*i)
+	}
+	return psuo
+}
+
+// AddInvoiceID adds i to the "invoice_id" field.
+func (psuo *ProductSerialUpdateOne) AddInvoiceID(i int) *ProductSerialUpdateOne {
+	psuo.mutation.AddInvoiceID(i)
+	return psuo
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (psuo *ProductSerialUpdateOne) ClearInvoiceID() *ProductSerialUpdateOne {
+	psuo.mutation.ClearInvoiceID()
+	return psuo
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (psuo *ProductSerialUpdateOne) SetReceivedAt(t time.Time) *ProductSerialUpdateOne {
+	psuo.mutation.SetReceivedAt(t)
+	return psuo
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (psuo *ProductSerialUpdateOne) SetNillableReceivedAt(t *time.Time) *ProductSerialUpdateOne {
+	if t != nil {
+		psuo.SetReceivedAt(*t)
+	}
+	return psuo
+}
+
+// SetSoldAt sets the "sold_at" field.
+func (psuo *ProductSerialUpdateOne) SetSoldAt(t time.Time) *ProductSerialUpdateOne {
+	psuo.mutation.SetSoldAt(t)
+	return psuo
+}
+
+// SetNillableSoldAt sets the "sold_at" field if the given value is not nil.
+func (psuo *ProductSerialUpdateOne) SetNillableSoldAt(t *time.Time) *ProductSerialUpdateOne {
+	if t != nil {
+		psuo.SetSoldAt(*t)
+	}
+	return psuo
+}
+
+// ClearSoldAt clears the value of the "sold_at" field.
+func (psuo *ProductSerialUpdateOne) ClearSoldAt() *ProductSerialUpdateOne {
+	psuo.mutation.ClearSoldAt()
+	return psuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (psuo *ProductSerialUpdateOne) SetUpdatedAt(t time.Time) *ProductSerialUpdateOne {
+	psuo.mutation.SetUpdatedAt(t)
+	return psuo
+}
+
+// Mutation returns the ProductSerialMutation object of the builder.
+func (psuo *ProductSerialUpdateOne) Mutation() *ProductSerialMutation {
+	return psuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (psuo *ProductSerialUpdateOne) Select(field string, fields ...string) *ProductSerialUpdateOne {
+	psuo.fields = append([]string{field}, fields...)
+	return psuo
+}
+
+// Save executes the query and returns the updated ProductSerial entity.
+func (psuo *ProductSerialUpdateOne) Save(ctx context.Context) (*ProductSerial, error) {
+	var (
+		err  error
+		node *ProductSerial
+	)
+	psuo.defaults()
+	if len(psuo.hooks) == 0 {
+		if err = psuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = psuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductSerialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = psuo.check(); err != nil {
+				return nil, err
+			}
+			psuo.mutation = mutation
+			node, err = psuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(psuo.hooks) - 1; i >= 0; i-- {
+			if psuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = psuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, psuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ProductSerial)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ProductSerialMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psuo *ProductSerialUpdateOne) SaveX(ctx context.Context) *ProductSerial {
+	node, err := psuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (psuo *ProductSerialUpdateOne) Exec(ctx context.Context) error {
+	_, err := psuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psuo *ProductSerialUpdateOne) ExecX(ctx context.Context) {
+	if err := psuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (psuo *ProductSerialUpdateOne) defaults() {
+	if _, ok := psuo.mutation.UpdatedAt(); !ok {
+		v := productserial.UpdateDefaultUpdatedAt()
+		psuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psuo *ProductSerialUpdateOne) check() error {
+	if v, ok := psuo.mutation.SerialNumber(); ok {
+		if err := productserial.SerialNumberValidator(v); err != nil {
+			return &ValidationError{Name: "serial_number", err: fmt.Errorf(`ent: validator failed for field "ProductSerial.serial_number": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (psuo *ProductSerialUpdateOne) sqlSave(ctx context.Context) (_node *ProductSerial, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productserial.Table,
+			Columns: productserial.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productserial.FieldID,
+			},
+		},
+	}
+	id, ok := psuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductSerial.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := psuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productserial.FieldID)
+		for _, f := range fields {
+			if !productserial.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productserial.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := psuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := psuo.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldProductID,
+		})
+	}
+	if value, ok := psuo.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldProductID,
+		})
+	}
+	if value, ok := psuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldTenantID,
+		})
+	}
+	if value, ok := psuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldTenantID,
+		})
+	}
+	if value, ok := psuo.mutation.SerialNumber(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productserial.FieldSerialNumber,
+		})
+	}
+	if value, ok := psuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productserial.FieldStatus,
+		})
+	}
+	if value, ok := psuo.mutation.SupplierID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldSupplierID,
+		})
+	}
+	if value, ok := psuo.mutation.AddedSupplierID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldSupplierID,
+		})
+	}
+	if psuo.mutation.SupplierIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: productserial.FieldSupplierID,
+		})
+	}
+	if value, ok := psuo.mutation.PurchaseInvoiceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldPurchaseInvoiceID,
+		})
+	}
+	if value, ok := psuo.mutation.AddedPurchaseInvoiceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldPurchaseInvoiceID,
+		})
+	}
+	if psuo.mutation.PurchaseInvoiceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: productserial.FieldPurchaseInvoiceID,
+		})
+	}
+	if value, ok := psuo.mutation.InvoiceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldInvoiceID,
+		})
+	}
+	if value, ok := psuo.mutation.AddedInvoiceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserial.FieldInvoiceID,
+		})
+	}
+	if psuo.mutation.InvoiceIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: productserial.FieldInvoiceID,
+		})
+	}
+	if value, ok := psuo.mutation.ReceivedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productserial.FieldReceivedAt,
+		})
+	}
+	if value, ok := psuo.mutation.SoldAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productserial.FieldSoldAt,
+		})
+	}
+	if psuo.mutation.SoldAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: productserial.FieldSoldAt,
+		})
+	}
+	if value, ok := psuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productserial.FieldUpdatedAt,
+		})
+	}
+	_node = &ProductSerial{config: psuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, psuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productserial.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/productserialevent"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProductSerialEvent is the model entity for the ProductSerialEvent schema.
+type ProductSerialEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del número de serie
+	SerialID int `json:"serial_id,omitempty"`
+	// Tipo de movimiento (received, sold, returned)
+	EventType string `json:"event_type,omitempty"`
+	// Tipo de documento (purchase_invoice, invoice)
+	DocumentType string `json:"document_type,omitempty"`
+	// ID del documento que originó el movimiento
+	DocumentID int `json:"document_id,omitempty"`
+	// ID del proveedor (recepciones)
+	SupplierID int `json:"supplier_id,omitempty"`
+	// ID del usuario que registró el movimiento
+	UserID int `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProductSerialEvent) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case productserialevent.FieldID, productserialevent.FieldSerialID, productserialevent.FieldDocumentID, productserialevent.FieldSupplierID, productserialevent.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case productserialevent.FieldEventType, productserialevent.FieldDocumentType:
+			values[i] = new(sql.NullString)
+		case productserialevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ProductSerialEvent", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProductSerialEvent fields.
+func (pse *ProductSerialEvent) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case productserialevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pse.ID = int(value.Int64)
+		case productserialevent.FieldSerialID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field serial_id", values[i])
+			} else if value.Valid {
+				pse.SerialID = int(value.Int64)
+			}
+		case productserialevent.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				pse.EventType = value.String
+			}
+		case productserialevent.FieldDocumentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_type", values[i])
+			} else if value.Valid {
+				pse.DocumentType = value.String
+			}
+		case productserialevent.FieldDocumentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field document_id", values[i])
+			} else if value.Valid {
+				pse.DocumentID = int(value.Int64)
+			}
+		case productserialevent.FieldSupplierID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field supplier_id", values[i])
+			} else if value.Valid {
+				pse.SupplierID = int(value.Int64)
+			}
+		case productserialevent.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				pse.UserID = int(value.Int64)
+			}
+		case productserialevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pse.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ProductSerialEvent.
+// Note that you need to call ProductSerialEvent.Unwrap() before calling this method if this ProductSerialEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pse *ProductSerialEvent) Update() *ProductSerialEventUpdateOne {
+	return (&ProductSerialEventClient{config: pse.config}).UpdateOne(pse)
+}
+
+// Unwrap unwraps the ProductSerialEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pse *ProductSerialEvent) Unwrap() *ProductSerialEvent {
+	_tx, ok := pse.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProductSerialEvent is not a transactional entity")
+	}
+	pse.config.driver = _tx.drv
+	return pse
+}
+
+// String implements the fmt.Stringer.
+func (pse *ProductSerialEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProductSerialEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pse.ID))
+	builder.WriteString("serial_id=")
+	builder.WriteString(fmt.Sprintf("%v", pse.SerialID))
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(pse.EventType)
+	builder.WriteString(", ")
+	builder.WriteString("document_type=")
+	builder.WriteString(pse.DocumentType)
+	builder.WriteString(", ")
+	builder.WriteString("document_id=")
+	builder.WriteString(fmt.Sprintf("%v", pse.DocumentID))
+	builder.WriteString(", ")
+	builder.WriteString("supplier_id=")
+	builder.WriteString(fmt.Sprintf("%v", pse.SupplierID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", pse.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pse.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProductSerialEvents is a parsable slice of ProductSerialEvent.
+type ProductSerialEvents []*ProductSerialEvent
+
+func (pse ProductSerialEvents) config(cfg config) {
+	for _i := range pse {
+		pse[_i].config = cfg
+	}
+}
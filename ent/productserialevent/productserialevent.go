@@ -0,0 +1,57 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productserialevent
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the productserialevent type in the database.
+	Label = "product_serial_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSerialID holds the string denoting the serial_id field in the database.
+	FieldSerialID = "serial_id"
+	// FieldEventType holds the string denoting the event_type field in the database.
+	FieldEventType = "event_type"
+	// FieldDocumentType holds the string denoting the document_type field in the database.
+	FieldDocumentType = "document_type"
+	// FieldDocumentID holds the string denoting the document_id field in the database.
+	FieldDocumentID = "document_id"
+	// FieldSupplierID holds the string denoting the supplier_id field in the database.
+	FieldSupplierID = "supplier_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the productserialevent in the database.
+	Table = "product_serial_events"
+)
+
+// Columns holds all SQL columns for productserialevent fields.
+var Columns = []string{
+	FieldID,
+	FieldSerialID,
+	FieldEventType,
+	FieldDocumentType,
+	FieldDocumentID,
+	FieldSupplierID,
+	FieldUserID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
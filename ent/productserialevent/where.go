@@ -0,0 +1,778 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productserialevent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// SerialID applies equality check predicate on the "serial_id" field. It's identical to SerialIDEQ.
+func SerialID(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSerialID), v))
+	})
+}
+
+// EventType applies equality check predicate on the "event_type" field. It's identical to EventTypeEQ.
+func EventType(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEventType), v))
+	})
+}
+
+// DocumentType applies equality check predicate on the "document_type" field. It's identical to DocumentTypeEQ.
+func DocumentType(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentID applies equality check predicate on the "document_id" field. It's identical to DocumentIDEQ.
+func DocumentID(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentID), v))
+	})
+}
+
+// SupplierID applies equality check predicate on the "supplier_id" field. It's identical to SupplierIDEQ.
+func SupplierID(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSupplierID), v))
+	})
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// SerialIDEQ applies the EQ predicate on the "serial_id" field.
+func SerialIDEQ(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSerialID), v))
+	})
+}
+
+// SerialIDNEQ applies the NEQ predicate on the "serial_id" field.
+func SerialIDNEQ(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSerialID), v))
+	})
+}
+
+// SerialIDIn applies the In predicate on the "serial_id" field.
+func SerialIDIn(vs ...int) predicate.ProductSerialEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSerialID), v...))
+	})
+}
+
+// SerialIDNotIn applies the NotIn predicate on the "serial_id" field.
+func SerialIDNotIn(vs ...int) predicate.ProductSerialEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSerialID), v...))
+	})
+}
+
+// SerialIDGT applies the GT predicate on the "serial_id" field.
+func SerialIDGT(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSerialID), v))
+	})
+}
+
+// SerialIDGTE applies the GTE predicate on the "serial_id" field.
+func SerialIDGTE(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSerialID), v))
+	})
+}
+
+// SerialIDLT applies the LT predicate on the "serial_id" field.
+func SerialIDLT(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSerialID), v))
+	})
+}
+
+// SerialIDLTE applies the LTE predicate on the "serial_id" field.
+func SerialIDLTE(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSerialID), v))
+	})
+}
+
+// EventTypeEQ applies the EQ predicate on the "event_type" field.
+func EventTypeEQ(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeNEQ applies the NEQ predicate on the "event_type" field.
+func EventTypeNEQ(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeIn applies the In predicate on the "event_type" field.
+func EventTypeIn(vs ...string) predicate.ProductSerialEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEventType), v...))
+	})
+}
+
+// EventTypeNotIn applies the NotIn predicate on the "event_type" field.
+func EventTypeNotIn(vs ...string) predicate.ProductSerialEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEventType), v...))
+	})
+}
+
+// EventTypeGT applies the GT predicate on the "event_type" field.
+func EventTypeGT(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeGTE applies the GTE predicate on the "event_type" field.
+func EventTypeGTE(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeLT applies the LT predicate on the "event_type" field.
+func EventTypeLT(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeLTE applies the LTE predicate on the "event_type" field.
+func EventTypeLTE(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeContains applies the Contains predicate on the "event_type" field.
+func EventTypeContains(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeHasPrefix applies the HasPrefix predicate on the "event_type" field.
+func EventTypeHasPrefix(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeHasSuffix applies the HasSuffix predicate on the "event_type" field.
+func EventTypeHasSuffix(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeEqualFold applies the EqualFold predicate on the "event_type" field.
+func EventTypeEqualFold(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeContainsFold applies the ContainsFold predicate on the "event_type" field.
+func EventTypeContainsFold(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEventType), v))
+	})
+}
+
+// DocumentTypeEQ applies the EQ predicate on the "document_type" field.
+func DocumentTypeEQ(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeNEQ applies the NEQ predicate on the "document_type" field.
+func DocumentTypeNEQ(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeIn applies the In predicate on the "document_type" field.
+func DocumentTypeIn(vs ...string) predicate.ProductSerialEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDocumentType), v...))
+	})
+}
+
+// DocumentTypeNotIn applies the NotIn predicate on the "document_type" field.
+func DocumentTypeNotIn(vs ...string) predicate.ProductSerialEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDocumentType), v...))
+	})
+}
+
+// DocumentTypeGT applies the GT predicate on the "document_type" field.
+func DocumentTypeGT(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeGTE applies the GTE predicate on the "document_type" field.
+func DocumentTypeGTE(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeLT applies the LT predicate on the "document_type" field.
+func DocumentTypeLT(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeLTE applies the LTE predicate on the "document_type" field.
+func DocumentTypeLTE(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeContains applies the Contains predicate on the "document_type" field.
+func DocumentTypeContains(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeHasPrefix applies the HasPrefix predicate on the "document_type" field.
+func DocumentTypeHasPrefix(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeHasSuffix applies the HasSuffix predicate on the "document_type" field.
+func DocumentTypeHasSuffix(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeEqualFold applies the EqualFold predicate on the "document_type" field.
+func DocumentTypeEqualFold(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentTypeContainsFold applies the ContainsFold predicate on the "document_type" field.
+func DocumentTypeContainsFold(v string) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDocumentType), v))
+	})
+}
+
+// DocumentIDEQ applies the EQ predicate on the "document_id" field.
+func DocumentIDEQ(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDocumentID), v))
+	})
+}
+
+// DocumentIDNEQ applies the NEQ predicate on the "document_id" field.
+func DocumentIDNEQ(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDocumentID), v))
+	})
+}
+
+// DocumentIDIn applies the In predicate on the "document_id" field.
+func DocumentIDIn(vs ...int) predicate.ProductSerialEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDocumentID), v...))
+	})
+}
+
+// DocumentIDNotIn applies the NotIn predicate on the "document_id" field.
+func DocumentIDNotIn(vs ...int) predicate.ProductSerialEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDocumentID), v...))
+	})
+}
+
+// DocumentIDGT applies the GT predicate on the "document_id" field.
+func DocumentIDGT(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDocumentID), v))
+	})
+}
+
+// DocumentIDGTE applies the GTE predicate on the "document_id" field.
+func DocumentIDGTE(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDocumentID), v))
+	})
+}
+
+// DocumentIDLT applies the LT predicate on the "document_id" field.
+func DocumentIDLT(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDocumentID), v))
+	})
+}
+
+// DocumentIDLTE applies the LTE predicate on the "document_id" field.
+func DocumentIDLTE(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDocumentID), v))
+	})
+}
+
+// SupplierIDEQ applies the EQ predicate on the "supplier_id" field.
+func SupplierIDEQ(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSupplierID), v))
+	})
+}
+
+// SupplierIDNEQ applies the NEQ predicate on the "supplier_id" field.
+func SupplierIDNEQ(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSupplierID), v))
+	})
+}
+
+// SupplierIDIn applies the In predicate on the "supplier_id" field.
+func SupplierIDIn(vs ...int) predicate.ProductSerialEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSupplierID), v...))
+	})
+}
+
+// SupplierIDNotIn applies the NotIn predicate on the "supplier_id" field.
+func SupplierIDNotIn(vs ...int) predicate.ProductSerialEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSupplierID), v...))
+	})
+}
+
+// SupplierIDGT applies the GT predicate on the "supplier_id" field.
+func SupplierIDGT(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSupplierID), v))
+	})
+}
+
+// SupplierIDGTE applies the GTE predicate on the "supplier_id" field.
+func SupplierIDGTE(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSupplierID), v))
+	})
+}
+
+// SupplierIDLT applies the LT predicate on the "supplier_id" field.
+func SupplierIDLT(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSupplierID), v))
+	})
+}
+
+// SupplierIDLTE applies the LTE predicate on the "supplier_id" field.
+func SupplierIDLTE(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSupplierID), v))
+	})
+}
+
+// SupplierIDIsNil applies the IsNil predicate on the "supplier_id" field.
+func SupplierIDIsNil() predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSupplierID)))
+	})
+}
+
+// SupplierIDNotNil applies the NotNil predicate on the "supplier_id" field.
+func SupplierIDNotNil() predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSupplierID)))
+	})
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.ProductSerialEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.ProductSerialEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserID), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProductSerialEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProductSerialEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProductSerialEvent) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProductSerialEvent) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProductSerialEvent) predicate.ProductSerialEvent {
+	return predicate.ProductSerialEvent(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/productserialevent"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductSerialEventCreate is the builder for creating a ProductSerialEvent entity.
+type ProductSerialEventCreate struct {
+	config
+	mutation *ProductSerialEventMutation
+	hooks    []Hook
+}
+
+// SetSerialID sets the "serial_id" field.
+func (psec *ProductSerialEventCreate) SetSerialID(i int) *ProductSerialEventCreate {
+	psec.mutation.SetSerialID(i)
+	return psec
+}
+
+// SetEventType sets the "event_type" field.
+func (psec *ProductSerialEventCreate) SetEventType(s string) *ProductSerialEventCreate {
+	psec.mutation.SetEventType(s)
+	return psec
+}
+
+// SetDocumentType sets the "document_type" field.
+func (psec *ProductSerialEventCreate) SetDocumentType(s string) *ProductSerialEventCreate {
+	psec.mutation.SetDocumentType(s)
+	return psec
+}
+
+// SetDocumentID sets the "document_id" field.
+func (psec *ProductSerialEventCreate) SetDocumentID(i int) *ProductSerialEventCreate {
+	psec.mutation.SetDocumentID(i)
+	return psec
+}
+
+// SetSupplierID sets the "supplier_id" field.
+func (psec *ProductSerialEventCreate) SetSupplierID(i int) *ProductSerialEventCreate {
+	psec.mutation.SetSupplierID(i)
+	return psec
+}
+
+// SetNillableSupplierID sets the "supplier_id" field if the given value is not nil.
+func (psec *ProductSerialEventCreate) SetNillableSupplierID(i *int) *ProductSerialEventCreate {
+	if i != nil {
+		psec.SetSupplierID(*i)
+	}
+	return psec
+}
+
+// SetUserID sets the "user_id" field.
+func (psec *ProductSerialEventCreate) SetUserID(i int) *ProductSerialEventCreate {
+	psec.mutation.SetUserID(i)
+	return psec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (psec *ProductSerialEventCreate) SetCreatedAt(t time.Time) *ProductSerialEventCreate {
+	psec.mutation.SetCreatedAt(t)
+	return psec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (psec *ProductSerialEventCreate) SetNillableCreatedAt(t *time.Time) *ProductSerialEventCreate {
+	if t != nil {
+		psec.SetCreatedAt(*t)
+	}
+	return psec
+}
+
+// Mutation returns the ProductSerialEventMutation object of the builder.
+func (psec *ProductSerialEventCreate) Mutation() *ProductSerialEventMutation {
+	return psec.mutation
+}
+
+// Save creates the ProductSerialEvent in the database.
+func (psec *ProductSerialEventCreate) Save(ctx context.Context) (*ProductSerialEvent, error) {
+	var (
+		err  error
+		node *ProductSerialEvent
+	)
+	psec.defaults()
+	if len(psec.hooks) == 0 {
+		if err = psec.check(); err != nil {
+			return nil, err
+		}
+		node, err = psec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductSerialEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = psec.check(); err != nil {
+				return nil, err
+			}
+			psec.mutation = mutation
+			if node, err = psec.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(psec.hooks) - 1; i >= 0; i-- {
+			if psec.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = psec.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, psec.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ProductSerialEvent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ProductSerialEventMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (psec *ProductSerialEventCreate) SaveX(ctx context.Context) *ProductSerialEvent {
+	v, err := psec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (psec *ProductSerialEventCreate) Exec(ctx context.Context) error {
+	_, err := psec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psec *ProductSerialEventCreate) ExecX(ctx context.Context) {
+	if err := psec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (psec *ProductSerialEventCreate) defaults() {
+	if _, ok := psec.mutation.CreatedAt(); !ok {
+		v := productserialevent.DefaultCreatedAt()
+		psec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psec *ProductSerialEventCreate) check() error {
+	if _, ok := psec.mutation.SerialID(); !ok {
+		return &ValidationError{Name: "serial_id", err: errors.New(`ent: missing required field "ProductSerialEvent.serial_id"`)}
+	}
+	if _, ok := psec.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "ProductSerialEvent.event_type"`)}
+	}
+	if _, ok := psec.mutation.DocumentType(); !ok {
+		return &ValidationError{Name: "document_type", err: errors.New(`ent: missing required field "ProductSerialEvent.document_type"`)}
+	}
+	if _, ok := psec.mutation.DocumentID(); !ok {
+		return &ValidationError{Name: "document_id", err: errors.New(`ent: missing required field "ProductSerialEvent.document_id"`)}
+	}
+	if _, ok := psec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ProductSerialEvent.user_id"`)}
+	}
+	if _, ok := psec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductSerialEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (psec *ProductSerialEventCreate) sqlSave(ctx context.Context) (*ProductSerialEvent, error) {
+	_node, _spec := psec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, psec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (psec *ProductSerialEventCreate) createSpec() (*ProductSerialEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductSerialEvent{config: psec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: productserialevent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productserialevent.FieldID,
+			},
+		}
+	)
+	if value, ok := psec.mutation.SerialID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldSerialID,
+		})
+		_node.SerialID = value
+	}
+	if value, ok := psec.mutation.EventType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productserialevent.FieldEventType,
+		})
+		_node.EventType = value
+	}
+	if value, ok := psec.mutation.DocumentType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productserialevent.FieldDocumentType,
+		})
+		_node.DocumentType = value
+	}
+	if value, ok := psec.mutation.DocumentID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldDocumentID,
+		})
+		_node.DocumentID = value
+	}
+	if value, ok := psec.mutation.SupplierID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldSupplierID,
+		})
+		_node.SupplierID = value
+	}
+	if value, ok := psec.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := psec.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productserialevent.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ProductSerialEventCreateBulk is the builder for creating many ProductSerialEvent entities in bulk.
+type ProductSerialEventCreateBulk struct {
+	config
+	builders []*ProductSerialEventCreate
+}
+
+// Save creates the ProductSerialEvent entities in the database.
+func (psecb *ProductSerialEventCreateBulk) Save(ctx context.Context) ([]*ProductSerialEvent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(psecb.builders))
+	nodes := make([]*ProductSerialEvent, len(psecb.builders))
+	mutators := make([]Mutator, len(psecb.builders))
+	for i := range psecb.builders {
+		func(i int, root context.Context) {
+			builder := psecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductSerialEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, psecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, psecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, psecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psecb *ProductSerialEventCreateBulk) SaveX(ctx context.Context) []*ProductSerialEvent {
+	v, err := psecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (psecb *ProductSerialEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := psecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psecb *ProductSerialEventCreateBulk) ExecX(ctx context.Context) {
+	if err := psecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
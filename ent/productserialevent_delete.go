@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productserialevent"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductSerialEventDelete is the builder for deleting a ProductSerialEvent entity.
+type ProductSerialEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProductSerialEventMutation
+}
+
+// Where appends a list predicates to the ProductSerialEventDelete builder.
+func (psed *ProductSerialEventDelete) Where(ps ...predicate.ProductSerialEvent) *ProductSerialEventDelete {
+	psed.mutation.Where(ps...)
+	return psed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (psed *ProductSerialEventDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(psed.hooks) == 0 {
+		affected, err = psed.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductSerialEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			psed.mutation = mutation
+			affected, err = psed.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(psed.hooks) - 1; i >= 0; i-- {
+			if psed.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = psed.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, psed.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psed *ProductSerialEventDelete) ExecX(ctx context.Context) int {
+	n, err := psed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (psed *ProductSerialEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: productserialevent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productserialevent.FieldID,
+			},
+		},
+	}
+	if ps := psed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, psed.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// ProductSerialEventDeleteOne is the builder for deleting a single ProductSerialEvent entity.
+type ProductSerialEventDeleteOne struct {
+	psed *ProductSerialEventDelete
+}
+
+// Exec executes the deletion query.
+func (psedo *ProductSerialEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := psedo.psed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{productserialevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psedo *ProductSerialEventDeleteOne) ExecX(ctx context.Context) {
+	psedo.psed.ExecX(ctx)
+}
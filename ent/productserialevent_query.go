@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productserialevent"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductSerialEventQuery is the builder for querying ProductSerialEvent entities.
+type ProductSerialEventQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ProductSerialEvent
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProductSerialEventQuery builder.
+func (pseq *ProductSerialEventQuery) Where(ps ...predicate.ProductSerialEvent) *ProductSerialEventQuery {
+	pseq.predicates = append(pseq.predicates, ps...)
+	return pseq
+}
+
+// Limit adds a limit step to the query.
+func (pseq *ProductSerialEventQuery) Limit(limit int) *ProductSerialEventQuery {
+	pseq.limit = &limit
+	return pseq
+}
+
+// Offset adds an offset step to the query.
+func (pseq *ProductSerialEventQuery) Offset(offset int) *ProductSerialEventQuery {
+	pseq.offset = &offset
+	return pseq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pseq *ProductSerialEventQuery) Unique(unique bool) *ProductSerialEventQuery {
+	pseq.unique = &unique
+	return pseq
+}
+
+// Order adds an order step to the query.
+func (pseq *ProductSerialEventQuery) Order(o ...OrderFunc) *ProductSerialEventQuery {
+	pseq.order = append(pseq.order, o...)
+	return pseq
+}
+
+// First returns the first ProductSerialEvent entity from the query.
+// Returns a *NotFoundError when no ProductSerialEvent was found.
+func (pseq *ProductSerialEventQuery) First(ctx context.Context) (*ProductSerialEvent, error) {
+	nodes, err := pseq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{productserialevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pseq *ProductSerialEventQuery) FirstX(ctx context.Context) *ProductSerialEvent {
+	node, err := pseq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProductSerialEvent ID from the query.
+// Returns a *NotFoundError when no ProductSerialEvent ID was found.
+func (pseq *ProductSerialEventQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pseq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{productserialevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pseq *ProductSerialEventQuery) FirstIDX(ctx context.Context) int {
+	id, err := pseq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProductSerialEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProductSerialEvent entity is found.
+// Returns a *NotFoundError when no ProductSerialEvent entities are found.
+func (pseq *ProductSerialEventQuery) Only(ctx context.Context) (*ProductSerialEvent, error) {
+	nodes, err := pseq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{productserialevent.Label}
+	default:
+		return nil, &NotSingularError{productserialevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pseq *ProductSerialEventQuery) OnlyX(ctx context.Context) *ProductSerialEvent {
+	node, err := pseq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProductSerialEvent ID in the query.
+// Returns a *NotSingularError when more than one ProductSerialEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pseq *ProductSerialEventQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pseq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{productserialevent.Label}
+	default:
+		err = &NotSingularError{productserialevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pseq *ProductSerialEventQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pseq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProductSerialEvents.
+func (pseq *ProductSerialEventQuery) All(ctx context.Context) ([]*ProductSerialEvent, error) {
+	if err := pseq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return pseq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pseq *ProductSerialEventQuery) AllX(ctx context.Context) []*ProductSerialEvent {
+	nodes, err := pseq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProductSerialEvent IDs.
+func (pseq *ProductSerialEventQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := pseq.Select(productserialevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pseq *ProductSerialEventQuery) IDsX(ctx context.Context) []int {
+	ids, err := pseq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pseq *ProductSerialEventQuery) Count(ctx context.Context) (int, error) {
+	if err := pseq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return pseq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pseq *ProductSerialEventQuery) CountX(ctx context.Context) int {
+	count, err := pseq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pseq *ProductSerialEventQuery) Exist(ctx context.Context) (bool, error) {
+	if err := pseq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return pseq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pseq *ProductSerialEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := pseq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProductSerialEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pseq *ProductSerialEventQuery) Clone() *ProductSerialEventQuery {
+	if pseq == nil {
+		return nil
+	}
+	return &ProductSerialEventQuery{
+		config:     pseq.config,
+		limit:      pseq.limit,
+		offset:     pseq.offset,
+		order:      append([]OrderFunc{}, pseq.order...),
+		predicates: append([]predicate.ProductSerialEvent{}, pseq.predicates...),
+		// clone intermediate query.
+		sql:    pseq.sql.Clone(),
+		path:   pseq.path,
+		unique: pseq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SerialID int `json:"serial_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProductSerialEvent.Query().
+//		GroupBy(productserialevent.FieldSerialID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pseq *ProductSerialEventQuery) GroupBy(field string, fields ...string) *ProductSerialEventGroupBy {
+	grbuild := &ProductSerialEventGroupBy{config: pseq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := pseq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return pseq.sqlQuery(ctx), nil
+	}
+	grbuild.label = productserialevent.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SerialID int `json:"serial_id,omitempty"`
+//	}
+//
+//	client.ProductSerialEvent.Query().
+//		Select(productserialevent.FieldSerialID).
+//		Scan(ctx, &v)
+func (pseq *ProductSerialEventQuery) Select(fields ...string) *ProductSerialEventSelect {
+	pseq.fields = append(pseq.fields, fields...)
+	selbuild := &ProductSerialEventSelect{ProductSerialEventQuery: pseq}
+	selbuild.label = productserialevent.Label
+	selbuild.flds, selbuild.scan = &pseq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (pseq *ProductSerialEventQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range pseq.fields {
+		if !productserialevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pseq.path != nil {
+		prev, err := pseq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pseq.sql = prev
+	}
+	return nil
+}
+
+func (pseq *ProductSerialEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProductSerialEvent, error) {
+	var (
+		nodes = []*ProductSerialEvent{}
+		_spec = pseq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ProductSerialEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ProductSerialEvent{config: pseq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(pseq.modifiers) > 0 {
+		_spec.Modifiers = pseq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pseq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (pseq *ProductSerialEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pseq.querySpec()
+	if len(pseq.modifiers) > 0 {
+		_spec.Modifiers = pseq.modifiers
+	}
+	_spec.Node.Columns = pseq.fields
+	if len(pseq.fields) > 0 {
+		_spec.Unique = pseq.unique != nil && *pseq.unique
+	}
+	return sqlgraph.CountNodes(ctx, pseq.driver, _spec)
+}
+
+func (pseq *ProductSerialEventQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := pseq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (pseq *ProductSerialEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productserialevent.Table,
+			Columns: productserialevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productserialevent.FieldID,
+			},
+		},
+		From:   pseq.sql,
+		Unique: true,
+	}
+	if unique := pseq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := pseq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productserialevent.FieldID)
+		for i := range fields {
+			if fields[i] != productserialevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pseq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pseq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pseq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pseq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pseq *ProductSerialEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pseq.driver.Dialect())
+	t1 := builder.Table(productserialevent.Table)
+	columns := pseq.fields
+	if len(columns) == 0 {
+		columns = productserialevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pseq.sql != nil {
+		selector = pseq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pseq.unique != nil && *pseq.unique {
+		selector.Distinct()
+	}
+	for _, m := range pseq.modifiers {
+		m(selector)
+	}
+	for _, p := range pseq.predicates {
+		p(selector)
+	}
+	for _, p := range pseq.order {
+		p(selector)
+	}
+	if offset := pseq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pseq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pseq *ProductSerialEventQuery) ForUpdate(opts ...sql.LockOption) *ProductSerialEventQuery {
+	if pseq.driver.Dialect() == dialect.Postgres {
+		pseq.Unique(false)
+	}
+	pseq.modifiers = append(pseq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pseq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pseq *ProductSerialEventQuery) ForShare(opts ...sql.LockOption) *ProductSerialEventQuery {
+	if pseq.driver.Dialect() == dialect.Postgres {
+		pseq.Unique(false)
+	}
+	pseq.modifiers = append(pseq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pseq
+}
+
+// ProductSerialEventGroupBy is the group-by builder for ProductSerialEvent entities.
+type ProductSerialEventGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (psegb *ProductSerialEventGroupBy) Aggregate(fns ...AggregateFunc) *ProductSerialEventGroupBy {
+	psegb.fns = append(psegb.fns, fns...)
+	return psegb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (psegb *ProductSerialEventGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := psegb.path(ctx)
+	if err != nil {
+		return err
+	}
+	psegb.sql = query
+	return psegb.sqlScan(ctx, v)
+}
+
+func (psegb *ProductSerialEventGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range psegb.fields {
+		if !productserialevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := psegb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := psegb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (psegb *ProductSerialEventGroupBy) sqlQuery() *sql.Selector {
+	selector := psegb.sql.Select()
+	aggregation := make([]string, 0, len(psegb.fns))
+	for _, fn := range psegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(psegb.fields)+len(psegb.fns))
+		for _, f := range psegb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(psegb.fields...)...)
+}
+
+// ProductSerialEventSelect is the builder for selecting fields of ProductSerialEvent entities.
+type ProductSerialEventSelect struct {
+	*ProductSerialEventQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pses *ProductSerialEventSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := pses.prepareQuery(ctx); err != nil {
+		return err
+	}
+	pses.sql = pses.ProductSerialEventQuery.sqlQuery(ctx)
+	return pses.sqlScan(ctx, v)
+}
+
+func (pses *ProductSerialEventSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := pses.sql.Query()
+	if err := pses.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,553 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productserialevent"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductSerialEventUpdate is the builder for updating ProductSerialEvent entities.
+type ProductSerialEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductSerialEventMutation
+}
+
+// Where appends a list predicates to the ProductSerialEventUpdate builder.
+func (pseu *ProductSerialEventUpdate) Where(ps ...predicate.ProductSerialEvent) *ProductSerialEventUpdate {
+	pseu.mutation.Where(ps...)
+	return pseu
+}
+
+// SetSerialID sets the "serial_id" field.
+func (pseu *ProductSerialEventUpdate) SetSerialID(i int) *ProductSerialEventUpdate {
+	pseu.mutation.ResetSerialID()
+	pseu.mutation.SetSerialID(i)
+	return pseu
+}
+
+// AddSerialID adds i to the "serial_id" field.
+func (pseu *ProductSerialEventUpdate) AddSerialID(i int) *ProductSerialEventUpdate {
+	pseu.mutation.AddSerialID(i)
+	return pseu
+}
+
+// SetEventType sets the "event_type" field.
+func (pseu *ProductSerialEventUpdate) SetEventType(s string) *ProductSerialEventUpdate {
+	pseu.mutation.SetEventType(s)
+	return pseu
+}
+
+// SetDocumentType sets the "document_type" field.
+func (pseu *ProductSerialEventUpdate) SetDocumentType(s string) *ProductSerialEventUpdate {
+	pseu.mutation.SetDocumentType(s)
+	return pseu
+}
+
+// SetDocumentID sets the "document_id" field.
+func (pseu *ProductSerialEventUpdate) SetDocumentID(i int) *ProductSerialEventUpdate {
+	pseu.mutation.ResetDocumentID()
+	pseu.mutation.SetDocumentID(i)
+	return pseu
+}
+
+// AddDocumentID adds i to the "document_id" field.
+func (pseu *ProductSerialEventUpdate) AddDocumentID(i int) *ProductSerialEventUpdate {
+	pseu.mutation.AddDocumentID(i)
+	return pseu
+}
+
+// SetSupplierID sets the "supplier_id" field.
+func (pseu *ProductSerialEventUpdate) SetSupplierID(i int) *ProductSerialEventUpdate {
+	pseu.mutation.ResetSupplierID()
+	pseu.mutation.SetSupplierID(i)
+	return pseu
+}
+
+// SetNillableSupplierID sets the "supplier_id" field if the given value is not nil.
+func (pseu *ProductSerialEventUpdate) SetNillableSupplierID(i *int) *ProductSerialEventUpdate {
+	if i != nil {
+		pseu.SetSupplierID(*i)
+	}
+	return pseu
+}
+
+// AddSupplierID adds i to the "supplier_id" field.
+func (pseu *ProductSerialEventUpdate) AddSupplierID(i int) *ProductSerialEventUpdate {
+	pseu.mutation.AddSupplierID(i)
+	return pseu
+}
+
+// ClearSupplierID clears the value of the "supplier_id" field.
+func (pseu *ProductSerialEventUpdate) ClearSupplierID() *ProductSerialEventUpdate {
+	pseu.mutation.ClearSupplierID()
+	return pseu
+}
+
+// SetUserID sets the "user_id" field.
+func (pseu *ProductSerialEventUpdate) SetUserID(i int) *ProductSerialEventUpdate {
+	pseu.mutation.ResetUserID()
+	pseu.mutation.SetUserID(i)
+	return pseu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (pseu *ProductSerialEventUpdate) AddUserID(i int) *ProductSerialEventUpdate {
+	pseu.mutation.AddUserID(i)
+	return pseu
+}
+
+// Mutation returns the ProductSerialEventMutation object of the builder.
+func (pseu *ProductSerialEventUpdate) Mutation() *ProductSerialEventMutation {
+	return pseu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pseu *ProductSerialEventUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(pseu.hooks) == 0 {
+		affected, err = pseu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductSerialEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			pseu.mutation = mutation
+			affected, err = pseu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pseu.hooks) - 1; i >= 0; i-- {
+			if pseu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pseu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pseu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pseu *ProductSerialEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := pseu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pseu *ProductSerialEventUpdate) Exec(ctx context.Context) error {
+	_, err := pseu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pseu *ProductSerialEventUpdate) ExecX(ctx context.Context) {
+	if err := pseu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pseu *ProductSerialEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productserialevent.Table,
+			Columns: productserialevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productserialevent.FieldID,
+			},
+		},
+	}
+	if ps := pseu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pseu.mutation.SerialID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldSerialID,
+		})
+	}
+	if value, ok := pseu.mutation.AddedSerialID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldSerialID,
+		})
+	}
+	if value, ok := pseu.mutation.EventType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productserialevent.FieldEventType,
+		})
+	}
+	if value, ok := pseu.mutation.DocumentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productserialevent.FieldDocumentType,
+		})
+	}
+	if value, ok := pseu.mutation.DocumentID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldDocumentID,
+		})
+	}
+	if value, ok := pseu.mutation.AddedDocumentID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldDocumentID,
+		})
+	}
+	if value, ok := pseu.mutation.SupplierID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldSupplierID,
+		})
+	}
+	if value, ok := pseu.mutation.AddedSupplierID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldSupplierID,
+		})
+	}
+	if pseu.mutation.SupplierIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: productserialevent.FieldSupplierID,
+		})
+	}
+	if value, ok := pseu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldUserID,
+		})
+	}
+	if value, ok := pseu.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldUserID,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pseu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productserialevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ProductSerialEventUpdateOne is the builder for updating a single ProductSerialEvent entity.
+type ProductSerialEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductSerialEventMutation
+}
+
+// SetSerialID sets the "serial_id" field.
+func (pseuo *ProductSerialEventUpdateOne) SetSerialID(i int) *ProductSerialEventUpdateOne {
+	pseuo.mutation.ResetSerialID()
+	pseuo.mutation.SetSerialID(i)
+	return pseuo
+}
+
+// AddSerialID adds i to the "serial_id" field.
+func (pseuo *ProductSerialEventUpdateOne) AddSerialID(i int) *ProductSerialEventUpdateOne {
+	pseuo.mutation.AddSerialID(i)
+	return pseuo
+}
+
+// SetEventType sets the "event_type" field.
+func (pseuo *ProductSerialEventUpdateOne) SetEventType(s string) *ProductSerialEventUpdateOne {
+	pseuo.mutation.SetEventType(s)
+	return pseuo
+}
+
+// SetDocumentType sets the "document_type" field.
+func (pseuo *ProductSerialEventUpdateOne) SetDocumentType(s string) *ProductSerialEventUpdateOne {
+	pseuo.mutation.SetDocumentType(s)
+	return pseuo
+}
+
+// SetDocumentID sets the "document_id" field.
+func (pseuo *ProductSerialEventUpdateOne) SetDocumentID(i int) *ProductSerialEventUpdateOne {
+	pseuo.mutation.ResetDocumentID()
+	pseuo.mutation.SetDocumentID(i)
+	return pseuo
+}
+
+// AddDocumentID adds i to the "document_id" field.
+func (pseuo *ProductSerialEventUpdateOne) AddDocumentID(i int) *ProductSerialEventUpdateOne {
+	pseuo.mutation.AddDocumentID(i)
+	return pseuo
+}
+
+// SetSupplierID sets the "supplier_id" field.
+func (pseuo *ProductSerialEventUpdateOne) SetSupplierID(i int) *ProductSerialEventUpdateOne {
+	pseuo.mutation.ResetSupplierID()
+	pseuo.mutation.SetSupplierID(i)
+	return pseuo
+}
+
+// SetNillableSupplierID sets the "supplier_id" field if the given value is not nil.
+func (pseuo *ProductSerialEventUpdateOne) SetNillableSupplierID(i *int) *ProductSerialEventUpdateOne {
+	if i != nil {
+		pseuo.SetSupplierID(*i)
+	}
+	return pseuo
+}
+
+// AddSupplierID adds i to the "supplier_id" field.
+func (pseuo *ProductSerialEventUpdateOne) AddSupplierID(i int) *ProductSerialEventUpdateOne {
+	pseuo.mutation.AddSupplierID(i)
+	return pseuo
+}
+
+// ClearSupplierID clears the value of the "supplier_id" field.
+func (pseuo *ProductSerialEventUpdateOne) ClearSupplierID() *ProductSerialEventUpdateOne {
+	pseuo.mutation.ClearSupplierID()
+	return pseuo
+}
+
+// SetUserID sets the "user_id" field.
+func (pseuo *ProductSerialEventUpdateOne) SetUserID(i int) *ProductSerialEventUpdateOne {
+	pseuo.mutation.ResetUserID()
+	pseuo.mutation.SetUserID(i)
+	return pseuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (pseuo *ProductSerialEventUpdateOne) AddUserID(i int) *ProductSerialEventUpdateOne {
+	pseuo.mutation.AddUserID(i)
+	return pseuo
+}
+
+// Mutation returns the ProductSerialEventMutation object of the builder.
+func (pseuo *ProductSerialEventUpdateOne) Mutation() *ProductSerialEventMutation {
+	return pseuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pseuo *ProductSerialEventUpdateOne) Select(field string, fields ...string) *ProductSerialEventUpdateOne {
+	pseuo.fields = append([]string{field}, fields...)
+	return pseuo
+}
+
+// Save executes the query and returns the updated ProductSerialEvent entity.
+func (pseuo *ProductSerialEventUpdateOne) Save(ctx context.Context) (*ProductSerialEvent, error) {
+	var (
+		err  error
+		node *ProductSerialEvent
+	)
+	if len(pseuo.hooks) == 0 {
+		node, err = pseuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductSerialEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			pseuo.mutation = mutation
+			node, err = pseuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pseuo.hooks) - 1; i >= 0; i-- {
+			if pseuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pseuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, pseuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ProductSerialEvent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ProductSerialEventMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pseuo *ProductSerialEventUpdateOne) SaveX(ctx context.Context) *ProductSerialEvent {
+	node, err := pseuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pseuo *ProductSerialEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := pseuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pseuo *ProductSerialEventUpdateOne) ExecX(ctx context.Context) {
+	if err := pseuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pseuo *ProductSerialEventUpdateOne) sqlSave(ctx context.Context) (_node *ProductSerialEvent, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productserialevent.Table,
+			Columns: productserialevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productserialevent.FieldID,
+			},
+		},
+	}
+	id, ok := pseuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductSerialEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pseuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productserialevent.FieldID)
+		for _, f := range fields {
+			if !productserialevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productserialevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pseuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pseuo.mutation.SerialID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldSerialID,
+		})
+	}
+	if value, ok := pseuo.mutation.AddedSerialID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldSerialID,
+		})
+	}
+	if value, ok := pseuo.mutation.EventType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productserialevent.FieldEventType,
+		})
+	}
+	if value, ok := pseuo.mutation.DocumentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productserialevent.FieldDocumentType,
+		})
+	}
+	if value, ok := pseuo.mutation.DocumentID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldDocumentID,
+		})
+	}
+	if value, ok := pseuo.mutation.AddedDocumentID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldDocumentID,
+		})
+	}
+	if value, ok := pseuo.mutation.SupplierID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldSupplierID,
+		})
+	}
+	if value, ok := pseuo.mutation.AddedSupplierID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldSupplierID,
+		})
+	}
+	if pseuo.mutation.SupplierIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: productserialevent.FieldSupplierID,
+		})
+	}
+	if value, ok := pseuo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldUserID,
+		})
+	}
+	if value, ok := pseuo.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productserialevent.FieldUserID,
+		})
+	}
+	_node = &ProductSerialEvent{config: pseuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pseuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productserialevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
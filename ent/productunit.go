@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/productunit"
+	"Veritasbackend/pkg/money"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProductUnit is the model entity for the ProductUnit schema.
+type ProductUnit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// ID del producto
+	ProductID int `json:"product_id,omitempty"`
+	// Nombre de la unidad (caja x24, pack, gramo...)
+	Name string `json:"name,omitempty"`
+	// Cantidad de unidades base que contiene una unidad
+	Factor float64 `json:"factor,omitempty"`
+	// Precio de venta propio de la unidad; si falta se usa el precio base por el factor
+	RetailPrice *money.Amount `json:"retail_price,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProductUnit) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case productunit.FieldRetailPrice:
+			values[i] = &sql.NullScanner{S: new(money.Amount)}
+		case productunit.FieldFactor:
+			values[i] = new(sql.NullFloat64)
+		case productunit.FieldID, productunit.FieldTenantID, productunit.FieldProductID:
+			values[i] = new(sql.NullInt64)
+		case productunit.FieldName:
+			values[i] = new(sql.NullString)
+		case productunit.FieldCreatedAt, productunit.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ProductUnit", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProductUnit fields.
+func (pu *ProductUnit) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case productunit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pu.ID = int(value.Int64)
+		case productunit.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				pu.TenantID = int(value.Int64)
+			}
+		case productunit.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				pu.ProductID = int(value.Int64)
+			}
+		case productunit.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pu.Name = value.String
+			}
+		case productunit.FieldFactor:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field factor", values[i])
+			} else if value.Valid {
+				pu.Factor = value.Float64
+			}
+		case productunit.FieldRetailPrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field retail_price", values[i])
+			} else if value.Valid {
+				pu.RetailPrice = new(money.Amount)
+				*pu.RetailPrice = *value.S.(*money.Amount)
+			}
+		case productunit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pu.CreatedAt = value.Time
+			}
+		case productunit.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pu.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ProductUnit.
+// Note that you need to call ProductUnit.Unwrap() before calling this method if this ProductUnit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pu *ProductUnit) Update() *ProductUnitUpdateOne {
+	return (&ProductUnitClient{config: pu.config}).UpdateOne(pu)
+}
+
+// Unwrap unwraps the ProductUnit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pu *ProductUnit) Unwrap() *ProductUnit {
+	_tx, ok := pu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProductUnit is not a transactional entity")
+	}
+	pu.config.driver = _tx.drv
+	return pu
+}
+
+// String implements the fmt.Stringer.
+func (pu *ProductUnit) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProductUnit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pu.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", pu.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", pu.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pu.Name)
+	builder.WriteString(", ")
+	builder.WriteString("factor=")
+	builder.WriteString(fmt.Sprintf("%v", pu.Factor))
+	builder.WriteString(", ")
+	if v := pu.RetailPrice; v != nil {
+		builder.WriteString("retail_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pu.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pu.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProductUnits is a parsable slice of ProductUnit.
+type ProductUnits []*ProductUnit
+
+func (pu ProductUnits) config(cfg config) {
+	for _i := range pu {
+		pu[_i].config = cfg
+	}
+}
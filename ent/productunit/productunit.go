@@ -0,0 +1,67 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productunit
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the productunit type in the database.
+	Label = "product_unit"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldFactor holds the string denoting the factor field in the database.
+	FieldFactor = "factor"
+	// FieldRetailPrice holds the string denoting the retail_price field in the database.
+	FieldRetailPrice = "retail_price"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the productunit in the database.
+	Table = "product_units"
+)
+
+// Columns holds all SQL columns for productunit fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldProductID,
+	FieldName,
+	FieldFactor,
+	FieldRetailPrice,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// FactorValidator is a validator for the "factor" field. It is called by the builders before save.
+	FactorValidator func(float64) error
+	// RetailPriceValidator is a validator for the "retail_price" field. It is called by the builders before save.
+	RetailPriceValidator func(int64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/productunit"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductUnitCreate is the builder for creating a ProductUnit entity.
+type ProductUnitCreate struct {
+	config
+	mutation *ProductUnitMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (puc *ProductUnitCreate) SetTenantID(i int) *ProductUnitCreate {
+	puc.mutation.SetTenantID(i)
+	return puc
+}
+
+// SetProductID sets the "product_id" field.
+func (puc *ProductUnitCreate) SetProductID(i int) *ProductUnitCreate {
+	puc.mutation.SetProductID(i)
+	return puc
+}
+
+// SetName sets the "name" field.
+func (puc *ProductUnitCreate) SetName(s string) *ProductUnitCreate {
+	puc.mutation.SetName(s)
+	return puc
+}
+
+// SetFactor sets the "factor" field.
+func (puc *ProductUnitCreate) SetFactor(f float64) *ProductUnitCreate {
+	puc.mutation.SetFactor(f)
+	return puc
+}
+
+// SetRetailPrice sets the "retail_price" field.
+func (puc *ProductUnitCreate) SetRetailPrice(m money.Amount) *ProductUnitCreate {
+	puc.mutation.SetRetailPrice(m)
+	return puc
+}
+
+// SetNillableRetailPrice sets the "retail_price" field if the given value is not nil.
+func (puc *ProductUnitCreate) SetNillableRetailPrice(m *money.Amount) *ProductUnitCreate {
+	if m != nil {
+		puc.SetRetailPrice(*m)
+	}
+	return puc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (puc *ProductUnitCreate) SetCreatedAt(t time.Time) *ProductUnitCreate {
+	puc.mutation.SetCreatedAt(t)
+	return puc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (puc *ProductUnitCreate) SetNillableCreatedAt(t *time.Time) *ProductUnitCreate {
+	if t != nil {
+		puc.SetCreatedAt(*t)
+	}
+	return puc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puc *ProductUnitCreate) SetUpdatedAt(t time.Time) *ProductUnitCreate {
+	puc.mutation.SetUpdatedAt(t)
+	return puc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (puc *ProductUnitCreate) SetNillableUpdatedAt(t *time.Time) *ProductUnitCreate {
+	if t != nil {
+		puc.SetUpdatedAt(*t)
+	}
+	return puc
+}
+
+// Mutation returns the ProductUnitMutation object of the builder.
+func (puc *ProductUnitCreate) Mutation() *ProductUnitMutation {
+	return puc.mutation
+}
+
+// Save creates the ProductUnit in the database.
+func (puc *ProductUnitCreate) Save(ctx context.Context) (*ProductUnit, error) {
+	var (
+		err  error
+		node *ProductUnit
+	)
+	puc.defaults()
+	if len(puc.hooks) == 0 {
+		if err = puc.check(); err != nil {
+			return nil, err
+		}
+		node, err = puc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductUnitMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = puc.check(); err != nil {
+				return nil, err
+			}
+			puc.mutation = mutation
+			if node, err = puc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(puc.hooks) - 1; i >= 0; i-- {
+			if puc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = puc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, puc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ProductUnit)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ProductUnitMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (puc *ProductUnitCreate) SaveX(ctx context.Context) *ProductUnit {
+	v, err := puc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (puc *ProductUnitCreate) Exec(ctx context.Context) error {
+	_, err := puc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puc *ProductUnitCreate) ExecX(ctx context.Context) {
+	if err := puc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puc *ProductUnitCreate) defaults() {
+	if _, ok := puc.mutation.CreatedAt(); !ok {
+		v := productunit.DefaultCreatedAt()
+		puc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := puc.mutation.UpdatedAt(); !ok {
+		v := productunit.DefaultUpdatedAt()
+		puc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puc *ProductUnitCreate) check() error {
+	if _, ok := puc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ProductUnit.tenant_id"`)}
+	}
+	if _, ok := puc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "ProductUnit.product_id"`)}
+	}
+	if _, ok := puc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ProductUnit.name"`)}
+	}
+	if v, ok := puc.mutation.Name(); ok {
+		if err := productunit.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProductUnit.name": %w`, err)}
+		}
+	}
+	if _, ok := puc.mutation.Factor(); !ok {
+		return &ValidationError{Name: "factor", err: errors.New(`ent: missing required field "ProductUnit.factor"`)}
+	}
+	if v, ok := puc.mutation.Factor(); ok {
+		if err := productunit.FactorValidator(v); err != nil {
+			return &ValidationError{Name: "factor", err: fmt.Errorf(`ent: validator failed for field "ProductUnit.factor": %w`, err)}
+		}
+	}
+	if v, ok := puc.mutation.RetailPrice(); ok {
+		if err := productunit.RetailPriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "retail_price", err: fmt.Errorf(`ent: validator failed for field "ProductUnit.retail_price": %w`, err)}
+		}
+	}
+	if _, ok := puc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductUnit.created_at"`)}
+	}
+	if _, ok := puc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProductUnit.updated_at"`)}
+	}
+	return nil
+}
+
+func (puc *ProductUnitCreate) sqlSave(ctx context.Context) (*ProductUnit, error) {
+	_node, _spec := puc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, puc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (puc *ProductUnitCreate) createSpec() (*ProductUnit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductUnit{config: puc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: productunit.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productunit.FieldID,
+			},
+		}
+	)
+	if value, ok := puc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productunit.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := puc.mutation.ProductID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productunit.FieldProductID,
+		})
+		_node.ProductID = value
+	}
+	if value, ok := puc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productunit.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := puc.mutation.Factor(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: productunit.FieldFactor,
+		})
+		_node.Factor = value
+	}
+	if value, ok := puc.mutation.RetailPrice(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productunit.FieldRetailPrice,
+		})
+		_node.RetailPrice = &value
+	}
+	if value, ok := puc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productunit.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := puc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productunit.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ProductUnitCreateBulk is the builder for creating many ProductUnit entities in bulk.
+type ProductUnitCreateBulk struct {
+	config
+	builders []*ProductUnitCreate
+}
+
+// Save creates the ProductUnit entities in the database.
+func (pucb *ProductUnitCreateBulk) Save(ctx context.Context) ([]*ProductUnit, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pucb.builders))
+	nodes := make([]*ProductUnit, len(pucb.builders))
+	mutators := make([]Mutator, len(pucb.builders))
+	for i := range pucb.builders {
+		func(i int, root context.Context) {
+			builder := pucb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductUnitMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pucb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pucb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pucb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pucb *ProductUnitCreateBulk) SaveX(ctx context.Context) []*ProductUnit {
+	v, err := pucb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pucb *ProductUnitCreateBulk) Exec(ctx context.Context) error {
+	_, err := pucb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pucb *ProductUnitCreateBulk) ExecX(ctx context.Context) {
+	if err := pucb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
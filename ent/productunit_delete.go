@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productunit"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductUnitDelete is the builder for deleting a ProductUnit entity.
+type ProductUnitDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProductUnitMutation
+}
+
+// Where appends a list predicates to the ProductUnitDelete builder.
+func (pud *ProductUnitDelete) Where(ps ...predicate.ProductUnit) *ProductUnitDelete {
+	pud.mutation.Where(ps...)
+	return pud
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pud *ProductUnitDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(pud.hooks) == 0 {
+		affected, err = pud.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductUnitMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			pud.mutation = mutation
+			affected, err = pud.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pud.hooks) - 1; i >= 0; i-- {
+			if pud.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pud.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pud.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pud *ProductUnitDelete) ExecX(ctx context.Context) int {
+	n, err := pud.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pud *ProductUnitDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: productunit.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productunit.FieldID,
+			},
+		},
+	}
+	if ps := pud.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pud.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// ProductUnitDeleteOne is the builder for deleting a single ProductUnit entity.
+type ProductUnitDeleteOne struct {
+	pud *ProductUnitDelete
+}
+
+// Exec executes the deletion query.
+func (pudo *ProductUnitDeleteOne) Exec(ctx context.Context) error {
+	n, err := pudo.pud.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{productunit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pudo *ProductUnitDeleteOne) ExecX(ctx context.Context) {
+	pudo.pud.ExecX(ctx)
+}
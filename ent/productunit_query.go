@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productunit"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductUnitQuery is the builder for querying ProductUnit entities.
+type ProductUnitQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ProductUnit
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProductUnitQuery builder.
+func (puq *ProductUnitQuery) Where(ps ...predicate.ProductUnit) *ProductUnitQuery {
+	puq.predicates = append(puq.predicates, ps...)
+	return puq
+}
+
+// Limit adds a limit step to the query.
+func (puq *ProductUnitQuery) Limit(limit int) *ProductUnitQuery {
+	puq.limit = &limit
+	return puq
+}
+
+// Offset adds an offset step to the query.
+func (puq *ProductUnitQuery) Offset(offset int) *ProductUnitQuery {
+	puq.offset = &offset
+	return puq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (puq *ProductUnitQuery) Unique(unique bool) *ProductUnitQuery {
+	puq.unique = &unique
+	return puq
+}
+
+// Order adds an order step to the query.
+func (puq *ProductUnitQuery) Order(o ...OrderFunc) *ProductUnitQuery {
+	puq.order = append(puq.order, o...)
+	return puq
+}
+
+// First returns the first ProductUnit entity from the query.
+// Returns a *NotFoundError when no ProductUnit was found.
+func (puq *ProductUnitQuery) First(ctx context.Context) (*ProductUnit, error) {
+	nodes, err := puq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{productunit.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (puq *ProductUnitQuery) FirstX(ctx context.Context) *ProductUnit {
+	node, err := puq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProductUnit ID from the query.
+// Returns a *NotFoundError when no ProductUnit ID was found.
+func (puq *ProductUnitQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = puq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{productunit.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (puq *ProductUnitQuery) FirstIDX(ctx context.Context) int {
+	id, err := puq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProductUnit entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProductUnit entity is found.
+// Returns a *NotFoundError when no ProductUnit entities are found.
+func (puq *ProductUnitQuery) Only(ctx context.Context) (*ProductUnit, error) {
+	nodes, err := puq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{productunit.Label}
+	default:
+		return nil, &NotSingularError{productunit.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (puq *ProductUnitQuery) OnlyX(ctx context.Context) *ProductUnit {
+	node, err := puq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProductUnit ID in the query.
+// Returns a *NotSingularError when more than one ProductUnit ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (puq *ProductUnitQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = puq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{productunit.Label}
+	default:
+		err = &NotSingularError{productunit.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (puq *ProductUnitQuery) OnlyIDX(ctx context.Context) int {
+	id, err := puq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProductUnits.
+func (puq *ProductUnitQuery) All(ctx context.Context) ([]*ProductUnit, error) {
+	if err := puq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return puq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (puq *ProductUnitQuery) AllX(ctx context.Context) []*ProductUnit {
+	nodes, err := puq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProductUnit IDs.
+func (puq *ProductUnitQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := puq.Select(productunit.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (puq *ProductUnitQuery) IDsX(ctx context.Context) []int {
+	ids, err := puq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (puq *ProductUnitQuery) Count(ctx context.Context) (int, error) {
+	if err := puq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return puq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (puq *ProductUnitQuery) CountX(ctx context.Context) int {
+	count, err := puq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (puq *ProductUnitQuery) Exist(ctx context.Context) (bool, error) {
+	if err := puq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return puq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (puq *ProductUnitQuery) ExistX(ctx context.Context) bool {
+	exist, err := puq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProductUnitQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (puq *ProductUnitQuery) Clone() *ProductUnitQuery {
+	if puq == nil {
+		return nil
+	}
+	return &ProductUnitQuery{
+		config:     puq.config,
+		limit:      puq.limit,
+		offset:     puq.offset,
+		order:      append([]OrderFunc{}, puq.order...),
+		predicates: append([]predicate.ProductUnit{}, puq.predicates...),
+		// clone intermediate query.
+		sql:    puq.sql.Clone(),
+		path:   puq.path,
+		unique: puq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProductUnit.Query().
+//		GroupBy(productunit.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (puq *ProductUnitQuery) GroupBy(field string, fields ...string) *ProductUnitGroupBy {
+	grbuild := &ProductUnitGroupBy{config: puq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := puq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return puq.sqlQuery(ctx), nil
+	}
+	grbuild.label = productunit.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.ProductUnit.Query().
+//		Select(productunit.FieldTenantID).
+//		Scan(ctx, &v)
+func (puq *ProductUnitQuery) Select(fields ...string) *ProductUnitSelect {
+	puq.fields = append(puq.fields, fields...)
+	selbuild := &ProductUnitSelect{ProductUnitQuery: puq}
+	selbuild.label = productunit.Label
+	selbuild.flds, selbuild.scan = &puq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (puq *ProductUnitQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range puq.fields {
+		if !productunit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if puq.path != nil {
+		prev, err := puq.path(ctx)
+		if err != nil {
+			return err
+		}
+		puq.sql = prev
+	}
+	return nil
+}
+
+func (puq *ProductUnitQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProductUnit, error) {
+	var (
+		nodes = []*ProductUnit{}
+		_spec = puq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ProductUnit).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ProductUnit{config: puq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(puq.modifiers) > 0 {
+		_spec.Modifiers = puq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, puq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (puq *ProductUnitQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := puq.querySpec()
+	if len(puq.modifiers) > 0 {
+		_spec.Modifiers = puq.modifiers
+	}
+	_spec.Node.Columns = puq.fields
+	if len(puq.fields) > 0 {
+		_spec.Unique = puq.unique != nil && *puq.unique
+	}
+	return sqlgraph.CountNodes(ctx, puq.driver, _spec)
+}
+
+func (puq *ProductUnitQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := puq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (puq *ProductUnitQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productunit.Table,
+			Columns: productunit.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productunit.FieldID,
+			},
+		},
+		From:   puq.sql,
+		Unique: true,
+	}
+	if unique := puq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := puq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productunit.FieldID)
+		for i := range fields {
+			if fields[i] != productunit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := puq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := puq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := puq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := puq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (puq *ProductUnitQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(puq.driver.Dialect())
+	t1 := builder.Table(productunit.Table)
+	columns := puq.fields
+	if len(columns) == 0 {
+		columns = productunit.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if puq.sql != nil {
+		selector = puq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if puq.unique != nil && *puq.unique {
+		selector.Distinct()
+	}
+	for _, m := range puq.modifiers {
+		m(selector)
+	}
+	for _, p := range puq.predicates {
+		p(selector)
+	}
+	for _, p := range puq.order {
+		p(selector)
+	}
+	if offset := puq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := puq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (puq *ProductUnitQuery) ForUpdate(opts ...sql.LockOption) *ProductUnitQuery {
+	if puq.driver.Dialect() == dialect.Postgres {
+		puq.Unique(false)
+	}
+	puq.modifiers = append(puq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return puq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (puq *ProductUnitQuery) ForShare(opts ...sql.LockOption) *ProductUnitQuery {
+	if puq.driver.Dialect() == dialect.Postgres {
+		puq.Unique(false)
+	}
+	puq.modifiers = append(puq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return puq
+}
+
+// ProductUnitGroupBy is the group-by builder for ProductUnit entities.
+type ProductUnitGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pugb *ProductUnitGroupBy) Aggregate(fns ...AggregateFunc) *ProductUnitGroupBy {
+	pugb.fns = append(pugb.fns, fns...)
+	return pugb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (pugb *ProductUnitGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := pugb.path(ctx)
+	if err != nil {
+		return err
+	}
+	pugb.sql = query
+	return pugb.sqlScan(ctx, v)
+}
+
+func (pugb *ProductUnitGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range pugb.fields {
+		if !productunit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := pugb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pugb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (pugb *ProductUnitGroupBy) sqlQuery() *sql.Selector {
+	selector := pugb.sql.Select()
+	aggregation := make([]string, 0, len(pugb.fns))
+	for _, fn := range pugb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(pugb.fields)+len(pugb.fns))
+		for _, f := range pugb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(pugb.fields...)...)
+}
+
+// ProductUnitSelect is the builder for selecting fields of ProductUnit entities.
+type ProductUnitSelect struct {
+	*ProductUnitQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pus *ProductUnitSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := pus.prepareQuery(ctx); err != nil {
+		return err
+	}
+	pus.sql = pus.ProductUnitQuery.sqlQuery(ctx)
+	return pus.sqlScan(ctx, v)
+}
+
+func (pus *ProductUnitSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := pus.sql.Query()
+	if err := pus.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
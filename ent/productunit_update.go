@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/productunit"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductUnitUpdate is the builder for updating ProductUnit entities.
+type ProductUnitUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductUnitMutation
+}
+
+// Where appends a list predicates to the ProductUnitUpdate builder.
+func (puu *ProductUnitUpdate) Where(ps ...predicate.ProductUnit) *ProductUnitUpdate {
+	puu.mutation.Where(ps...)
+	return puu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (puu *ProductUnitUpdate) SetTenantID(i int) *ProductUnitUpdate {
+	puu.mutation.ResetTenantID()
+	puu.mutation.SetTenantID(i)
+	return puu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (puu *ProductUnitUpdate) AddTenantID(i int) *ProductUnitUpdate {
+	puu.mutation.AddTenantID(i)
+	return puu
+}
+
+// SetProductID sets the "product_id" field.
+func (puu *ProductUnitUpdate) SetProductID(i int) *ProductUnitUpdate {
+	puu.mutation.ResetProductID()
+	puu.mutation.SetProductID(i)
+	return puu
+}
+
+// AddProductID adds i to the "product_id" field.
+func (puu *ProductUnitUpdate) AddProductID(i int) *ProductUnitUpdate {
+	puu.mutation.AddProductID(i)
+	return puu
+}
+
+// SetName sets the "name" field.
+func (puu *ProductUnitUpdate) SetName(s string) *ProductUnitUpdate {
+	puu.mutation.SetName(s)
+	return puu
+}
+
+// SetFactor sets the "factor" field.
+func (puu *ProductUnitUpdate) SetFactor(f float64) *ProductUnitUpdate {
+	puu.mutation.ResetFactor()
+	puu.mutation.SetFactor(f)
+	return puu
+}
+
+// AddFactor adds f to the "factor" field.
+func (puu *ProductUnitUpdate) AddFactor(f float64) *ProductUnitUpdate {
+	puu.mutation.AddFactor(f)
+	return puu
+}
+
+// SetRetailPrice sets the "retail_price" field.
+func (puu *ProductUnitUpdate) SetRetailPrice(m money.Amount) *ProductUnitUpdate {
+	puu.mutation.ResetRetailPrice()
+	puu.mutation.SetRetailPrice(m)
+	return puu
+}
+
+// SetNillableRetailPrice sets the "retail_price" field if the given value is not nil.
+func (puu *ProductUnitUpdate) SetNillableRetailPrice(m *money.Amount) *ProductUnitUpdate {
+	if m != nil {
+		puu.SetRetailPrice(*m)
+	}
+	return puu
+}
+
+// AddRetailPrice adds m to the "retail_price" field.
+func (puu *ProductUnitUpdate) AddRetailPrice(m money.Amount) *ProductUnitUpdate {
+	puu.mutation.AddRetailPrice(m)
+	return puu
+}
+
+// ClearRetailPrice clears the value of the "retail_price" field.
+func (puu *ProductUnitUpdate) ClearRetailPrice() *ProductUnitUpdate {
+	puu.mutation.ClearRetailPrice()
+	return puu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puu *ProductUnitUpdate) SetUpdatedAt(t time.Time) *ProductUnitUpdate {
+	puu.mutation.SetUpdatedAt(t)
+	return puu
+}
+
+// Mutation returns the ProductUnitMutation object of the builder.
+func (puu *ProductUnitUpdate) Mutation() *ProductUnitMutation {
+	return puu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (puu *ProductUnitUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	puu.defaults()
+	if len(puu.hooks) == 0 {
+		if err = puu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = puu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductUnitMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = puu.check(); err != nil {
+				return 0, err
+			}
+			puu.mutation = mutation
+			affected, err = puu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(puu.hooks) - 1; i >= 0; i-- {
+			if puu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = puu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, puu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puu *ProductUnitUpdate) SaveX(ctx context.Context) int {
+	affected, err := puu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (puu *ProductUnitUpdate) Exec(ctx context.Context) error {
+	_, err := puu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puu *ProductUnitUpdate) ExecX(ctx context.Context) {
+	if err := puu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puu *ProductUnitUpdate) defaults() {
+	if _, ok := puu.mutation.UpdatedAt(); !ok {
+		v := productunit.UpdateDefaultUpdatedAt()
+		puu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puu *ProductUnitUpdate) check() error {
+	if v, ok := puu.mutation.Name(); ok {
+		if err := productunit.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProductUnit.name": %w`, err)}
+		}
+	}
+	if v, ok := puu.mutation.Factor(); ok {
+		if err := productunit.FactorValidator(v); err != nil {
+			return &ValidationError{Name: "factor", err: fmt.Errorf(`ent: validator failed for field "ProductUnit.factor": %w`, err)}
+		}
+	}
+	if v, ok := puu.mutation.RetailPrice(); ok {
+		if err := productunit.RetailPriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "retail_price", err: fmt.Errorf(`ent: validator failed for field "ProductUnit.retail_price": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (puu *ProductUnitUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productunit.Table,
+			Columns: productunit.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productunit.FieldID,
+			},
+		},
+	}
+	if ps := puu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productunit.FieldTenantID,
+		})
+	}
+	if value, ok := puu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productunit.FieldTenantID,
+		})
+	}
+	if value, ok := puu.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productunit.FieldProductID,
+		})
+	}
+	if value, ok := puu.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productunit.FieldProductID,
+		})
+	}
+	if value, ok := puu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productunit.FieldName,
+		})
+	}
+	if value, ok := puu.mutation.Factor(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: productunit.FieldFactor,
+		})
+	}
+	if value, ok := puu.mutation.AddedFactor(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: productunit.FieldFactor,
+		})
+	}
+	if value, ok := puu.mutation.RetailPrice(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productunit.FieldRetailPrice,
+		})
+	}
+	if value, ok := puu.mutation.AddedRetailPrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productunit.FieldRetailPrice,
+		})
+	}
+	if puu.mutation.RetailPriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: productunit.FieldRetailPrice,
+		})
+	}
+	if value, ok := puu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productunit.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, puu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productunit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ProductUnitUpdateOne is the builder for updating a single ProductUnit entity.
+type ProductUnitUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductUnitMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (puuo *ProductUnitUpdateOne) SetTenantID(i int) *ProductUnitUpdateOne {
+	puuo.mutation.ResetTenantID()
+	puuo.mutation.SetTenantID(i)
+	return puuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (puuo *ProductUnitUpdateOne) AddTenantID(i int) *ProductUnitUpdateOne {
+	puuo.mutation.AddTenantID(i)
+	return puuo
+}
+
+// SetProductID sets the "product_id" field.
+func (puuo *ProductUnitUpdateOne) SetProductID(i int) *ProductUnitUpdateOne {
+	puuo.mutation.ResetProductID()
+	puuo.mutation.SetProductID(i)
+	return puuo
+}
+
+// AddProductID adds i to the "product_id" field.
+func (puuo *ProductUnitUpdateOne) AddProductID(i int) *ProductUnitUpdateOne {
+	puuo.mutation.AddProductID(i)
+	return puuo
+}
+
+// SetName sets the "name" field.
+func (puuo *ProductUnitUpdateOne) SetName(s string) *ProductUnitUpdateOne {
+	puuo.mutation.SetName(s)
+	return puuo
+}
+
+// SetFactor sets the "factor" field.
+func (puuo *ProductUnitUpdateOne) SetFactor(f float64) *ProductUnitUpdateOne {
+	puuo.mutation.ResetFactor()
+	puuo.mutation.SetFactor(f)
+	return puuo
+}
+
+// AddFactor adds f to the "factor" field.
+func (puuo *ProductUnitUpdateOne) AddFactor(f float64) *ProductUnitUpdateOne {
+	puuo.mutation.AddFactor(f)
+	return puuo
+}
+
+// SetRetailPrice sets the "retail_price" field.
+func (puuo *ProductUnitUpdateOne) SetRetailPrice(m money.Amount) *ProductUnitUpdateOne {
+	puuo.mutation.ResetRetailPrice()
+	puuo.mutation.SetRetailPrice(m)
+	return puuo
+}
+
+// SetNillableRetailPrice sets the "retail_price" field if the given value is not nil.
+func (puuo *ProductUnitUpdateOne) SetNillableRetailPrice(m *money.Amount) *ProductUnitUpdateOne {
+	if m != nil {
+		puuo.SetRetailPrice(*m)
+	}
+	return puuo
+}
+
+// AddRetailPrice adds m to the "retail_price" field.
+func (puuo *ProductUnitUpdateOne) AddRetailPrice(m money.Amount) *ProductUnitUpdateOne {
+	puuo.mutation.AddRetailPrice(m)
+	return puuo
+}
+
+// ClearRetailPrice clears the value of the "retail_price" field.
+func (puuo *ProductUnitUpdateOne) ClearRetailPrice() *ProductUnitUpdateOne {
+	puuo.mutation.ClearRetailPrice()
+	return puuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puuo *ProductUnitUpdateOne) SetUpdatedAt(t time.Time) *ProductUnitUpdateOne {
+	puuo.mutation.SetUpdatedAt(t)
+	return puuo
+}
+
+// Mutation returns the ProductUnitMutation object of the builder.
+func (puuo *ProductUnitUpdateOne) Mutation() *ProductUnitMutation {
+	return puuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puuo *ProductUnitUpdateOne) Select(field string, fields ...string) *ProductUnitUpdateOne {
+	puuo.fields = append([]string{field}, fields...)
+	return puuo
+}
+
+// Save executes the query and returns the updated ProductUnit entity.
+func (puuo *ProductUnitUpdateOne) Save(ctx context.Context) (*ProductUnit, error) {
+	var (
+		err  error
+		node *ProductUnit
+	)
+	puuo.defaults()
+	if len(puuo.hooks) == 0 {
+		if err = puuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = puuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProductUnitMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = puuo.check(); err != nil {
+				return nil, err
+			}
+			puuo.mutation = mutation
+			node, err = puuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(puuo.hooks) - 1; i >= 0; i-- {
+			if puuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = puuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, puuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ProductUnit)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ProductUnitMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puuo *ProductUnitUpdateOne) SaveX(ctx context.Context) *ProductUnit {
+	node, err := puuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puuo *ProductUnitUpdateOne) Exec(ctx context.Context) error {
+	_, err := puuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puuo *ProductUnitUpdateOne) ExecX(ctx context.Context) {
+	if err := puuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puuo *ProductUnitUpdateOne) defaults() {
+	if _, ok := puuo.mutation.UpdatedAt(); !ok {
+		v := productunit.UpdateDefaultUpdatedAt()
+		puuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puuo *ProductUnitUpdateOne) check() error {
+	if v, ok := puuo.mutation.Name(); ok {
+		if err := productunit.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProductUnit.name": %w`, err)}
+		}
+	}
+	if v, ok := puuo.mutation.Factor(); ok {
+		if err := productunit.FactorValidator(v); err != nil {
+			return &ValidationError{Name: "factor", err: fmt.Errorf(`ent: validator failed for field "ProductUnit.factor": %w`, err)}
+		}
+	}
+	if v, ok := puuo.mutation.RetailPrice(); ok {
+		if err := productunit.RetailPriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "retail_price", err: fmt.Errorf(`ent: validator failed for field "ProductUnit.retail_price": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (puuo *ProductUnitUpdateOne) sqlSave(ctx context.Context) (_node *ProductUnit, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   productunit.Table,
+			Columns: productunit.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: productunit.FieldID,
+			},
+		},
+	}
+	id, ok := puuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductUnit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productunit.FieldID)
+		for _, f := range fields {
+			if !productunit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productunit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productunit.FieldTenantID,
+		})
+	}
+	if value, ok := puuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productunit.FieldTenantID,
+		})
+	}
+	if value, ok := puuo.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productunit.FieldProductID,
+		})
+	}
+	if value, ok := puuo.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: productunit.FieldProductID,
+		})
+	}
+	if value, ok := puuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: productunit.FieldName,
+		})
+	}
+	if value, ok := puuo.mutation.Factor(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: productunit.FieldFactor,
+		})
+	}
+	if value, ok := puuo.mutation.AddedFactor(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: productunit.FieldFactor,
+		})
+	}
+	if value, ok := puuo.mutation.RetailPrice(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productunit.FieldRetailPrice,
+		})
+	}
+	if value, ok := puuo.mutation.AddedRetailPrice(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: productunit.FieldRetailPrice,
+		})
+	}
+	if puuo.mutation.RetailPriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: productunit.FieldRetailPrice,
+		})
+	}
+	if value, ok := puuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: productunit.FieldUpdatedAt,
+		})
+	}
+	_node = &ProductUnit{config: puuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productunit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/promotion"
+	"Veritasbackend/pkg/money"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Promotion is the model entity for the Promotion schema.
+type Promotion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// Nombre de la promoción (2x1 fin de semana, 10% bebidas, ...)
+	Name string `json:"name,omitempty"`
+	// Descripción de la promoción
+	Description string `json:"description,omitempty"`
+	// Código de cupón en mayúsculas; vacío si la promoción se aplica sola
+	CouponCode string `json:"coupon_code,omitempty"`
+	// Efecto sobre las líneas que cumplen (percent, fixed, free_item, bundle_price)
+	Effect string `json:"effect,omitempty"`
+	// Porcentaje de descuento en percent
+	Percent float64 `json:"percent,omitempty"`
+	// Monto de descuento en fixed y precio del grupo en bundle_price
+	Amount money.Amount `json:"amount,omitempty"`
+	// Unidades que se pagan en free_item o que forman el grupo en bundle_price
+	BuyQuantity float64 `json:"buy_quantity,omitempty"`
+	// Unidades de regalo por cada buy_quantity en free_item
+	FreeQuantity float64 `json:"free_quantity,omitempty"`
+	// Cantidad mínima de productos que cumplen para aplicar
+	MinQuantity float64 `json:"min_quantity,omitempty"`
+	// Valor mínimo de los productos que cumplen para aplicar
+	MinAmount money.Amount `json:"min_amount,omitempty"`
+	// Inicio de vigencia
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	// Fin de vigencia
+	EndsAt *time.Time `json:"ends_at,omitempty"`
+	// Días en que rige, separados por coma (1 = lunes ... 7 = domingo); vacío para todos
+	Weekdays string `json:"weekdays,omitempty"`
+	// Hora de inicio diaria (HH:MM); vacía para todo el día
+	StartTime string `json:"start_time,omitempty"`
+	// Hora de fin diaria (HH:MM)
+	EndTime string `json:"end_time,omitempty"`
+	// Grupo de clientes al que se limita; vacío para todos
+	CustomerGroup string `json:"customer_group,omitempty"`
+	// Entre promociones que compiten por las mismas líneas gana la de mayor prioridad
+	Priority int `json:"priority,omitempty"`
+	// Las promociones inactivas no se evalúan
+	Active bool `json:"active,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Promotion) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case promotion.FieldAmount, promotion.FieldMinAmount:
+			values[i] = new(money.Amount)
+		case promotion.FieldActive:
+			values[i] = new(sql.NullBool)
+		case promotion.FieldPercent, promotion.FieldBuyQuantity, promotion.FieldFreeQuantity, promotion.FieldMinQuantity:
+			values[i] = new(sql.NullFloat64)
+		case promotion.FieldID, promotion.FieldTenantID, promotion.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case promotion.FieldName, promotion.FieldDescription, promotion.FieldCouponCode, promotion.FieldEffect, promotion.FieldWeekdays, promotion.FieldStartTime, promotion.FieldEndTime, promotion.FieldCustomerGroup:
+			values[i] = new(sql.NullString)
+		case promotion.FieldStartsAt, promotion.FieldEndsAt, promotion.FieldCreatedAt, promotion.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Promotion", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Promotion fields.
+func (pr *Promotion) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case promotion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case promotion.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				pr.TenantID = int(value.Int64)
+			}
+		case promotion.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pr.Name = value.String
+			}
+		case promotion.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				pr.Description = value.String
+			}
+		case promotion.FieldCouponCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_code", values[i])
+			} else if value.Valid {
+				pr.CouponCode = value.String
+			}
+		case promotion.FieldEffect:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field effect", values[i])
+			} else if value.Valid {
+				pr.Effect = value.String
+			}
+		case promotion.FieldPercent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field percent", values[i])
+			} else if value.Valid {
+				pr.Percent = value.Float64
+			}
+		case promotion.FieldAmount:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				pr.Amount = *value
+			}
+		case promotion.FieldBuyQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field buy_quantity", values[i])
+			} else if value.Valid {
+				pr.BuyQuantity = value.Float64
+			}
+		case promotion.FieldFreeQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field free_quantity", values[i])
+			} else if value.Valid {
+				pr.FreeQuantity = value.Float64
+			}
+		case promotion.FieldMinQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_quantity", values[i])
+			} else if value.Valid {
+				pr.MinQuantity = value.Float64
+			}
+		case promotion.FieldMinAmount:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field min_amount", values[i])
+			} else if value != nil {
+				pr.MinAmount = *value
+			}
+		case promotion.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				pr.StartsAt = new(time.Time)
+				*pr.StartsAt = value.Time
+			}
+		case promotion.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				pr.EndsAt = new(time.Time)
+				*pr.EndsAt = value.Time
+			}
+		case promotion.FieldWeekdays:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field weekdays", values[i])
+			} else if value.Valid {
+				pr.Weekdays = value.String
+			}
+		case promotion.FieldStartTime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				pr.StartTime = value.String
+			}
+		case promotion.FieldEndTime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				pr.EndTime = value.String
+			}
+		case promotion.FieldCustomerGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_group", values[i])
+			} else if value.Valid {
+				pr.CustomerGroup = value.String
+			}
+		case promotion.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				pr.Priority = int(value.Int64)
+			}
+		case promotion.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				pr.Active = value.Bool
+			}
+		case promotion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case promotion.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Promotion.
+// Note that you need to call Promotion.Unwrap() before calling this method if this Promotion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *Promotion) Update() *PromotionUpdateOne {
+	return (&PromotionClient{config: pr.config}).UpdateOne(pr)
+}
+
+// Unwrap unwraps the Promotion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *Promotion) Unwrap() *Promotion {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Promotion is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *Promotion) String() string {
+	var builder strings.Builder
+	builder.WriteString("Promotion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(pr.Description)
+	builder.WriteString(", ")
+	builder.WriteString("coupon_code=")
+	builder.WriteString(pr.CouponCode)
+	builder.WriteString(", ")
+	builder.WriteString("effect=")
+	builder.WriteString(pr.Effect)
+	builder.WriteString(", ")
+	builder.WriteString("percent=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Percent))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("buy_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", pr.BuyQuantity))
+	builder.WriteString(", ")
+	builder.WriteString("free_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", pr.FreeQuantity))
+	builder.WriteString(", ")
+	builder.WriteString("min_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", pr.MinQuantity))
+	builder.WriteString(", ")
+	builder.WriteString("min_amount=")
+	builder.WriteString(fmt.Sprintf("%v", pr.MinAmount))
+	builder.WriteString(", ")
+	if v := pr.StartsAt; v != nil {
+		builder.WriteString("starts_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pr.EndsAt; v != nil {
+		builder.WriteString("ends_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("weekdays=")
+	builder.WriteString(pr.Weekdays)
+	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(pr.StartTime)
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(pr.EndTime)
+	builder.WriteString(", ")
+	builder.WriteString("customer_group=")
+	builder.WriteString(pr.CustomerGroup)
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Active))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Promotions is a parsable slice of Promotion.
+type Promotions []*Promotion
+
+func (pr Promotions) config(cfg config) {
+	for _i := range pr {
+		pr[_i].config = cfg
+	}
+}
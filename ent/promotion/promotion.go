@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package promotion
+
+import (
+	"Veritasbackend/pkg/money"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the promotion type in the database.
+	Label = "promotion"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldCouponCode holds the string denoting the coupon_code field in the database.
+	FieldCouponCode = "coupon_code"
+	// FieldEffect holds the string denoting the effect field in the database.
+	FieldEffect = "effect"
+	// FieldPercent holds the string denoting the percent field in the database.
+	FieldPercent = "percent"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldBuyQuantity holds the string denoting the buy_quantity field in the database.
+	FieldBuyQuantity = "buy_quantity"
+	// FieldFreeQuantity holds the string denoting the free_quantity field in the database.
+	FieldFreeQuantity = "free_quantity"
+	// FieldMinQuantity holds the string denoting the min_quantity field in the database.
+	FieldMinQuantity = "min_quantity"
+	// FieldMinAmount holds the string denoting the min_amount field in the database.
+	FieldMinAmount = "min_amount"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldEndsAt holds the string denoting the ends_at field in the database.
+	FieldEndsAt = "ends_at"
+	// FieldWeekdays holds the string denoting the weekdays field in the database.
+	FieldWeekdays = "weekdays"
+	// FieldStartTime holds the string denoting the start_time field in the database.
+	FieldStartTime = "start_time"
+	// FieldEndTime holds the string denoting the end_time field in the database.
+	FieldEndTime = "end_time"
+	// FieldCustomerGroup holds the string denoting the customer_group field in the database.
+	FieldCustomerGroup = "customer_group"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldActive holds the string denoting the active field in the database.
+	FieldActive = "active"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the promotion in the database.
+	Table = "promotions"
+)
+
+// Columns holds all SQL columns for promotion fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldName,
+	FieldDescription,
+	FieldCouponCode,
+	FieldEffect,
+	FieldPercent,
+	FieldAmount,
+	FieldBuyQuantity,
+	FieldFreeQuantity,
+	FieldMinQuantity,
+	FieldMinAmount,
+	FieldStartsAt,
+	FieldEndsAt,
+	FieldWeekdays,
+	FieldStartTime,
+	FieldEndTime,
+	FieldCustomerGroup,
+	FieldPriority,
+	FieldActive,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultPercent holds the default value on creation for the "percent" field.
+	DefaultPercent float64
+	// PercentValidator is a validator for the "percent" field. It is called by the builders before save.
+	PercentValidator func(float64) error
+	// DefaultAmount holds the default value on creation for the "amount" field.
+	DefaultAmount money.Amount
+	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	AmountValidator func(int64) error
+	// DefaultBuyQuantity holds the default value on creation for the "buy_quantity" field.
+	DefaultBuyQuantity float64
+	// BuyQuantityValidator is a validator for the "buy_quantity" field. It is called by the builders before save.
+	BuyQuantityValidator func(float64) error
+	// DefaultFreeQuantity holds the default value on creation for the "free_quantity" field.
+	DefaultFreeQuantity float64
+	// FreeQuantityValidator is a validator for the "free_quantity" field. It is called by the builders before save.
+	FreeQuantityValidator func(float64) error
+	// DefaultMinQuantity holds the default value on creation for the "min_quantity" field.
+	DefaultMinQuantity float64
+	// MinQuantityValidator is a validator for the "min_quantity" field. It is called by the builders before save.
+	MinQuantityValidator func(float64) error
+	// DefaultMinAmount holds the default value on creation for the "min_amount" field.
+	DefaultMinAmount money.Amount
+	// MinAmountValidator is a validator for the "min_amount" field. It is called by the builders before save.
+	MinAmountValidator func(int64) error
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultActive holds the default value on creation for the "active" field.
+	DefaultActive bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
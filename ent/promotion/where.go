@@ -0,0 +1,2187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package promotion
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDescription), v))
+	})
+}
+
+// CouponCode applies equality check predicate on the "coupon_code" field. It's identical to CouponCodeEQ.
+func CouponCode(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCouponCode), v))
+	})
+}
+
+// Effect applies equality check predicate on the "effect" field. It's identical to EffectEQ.
+func Effect(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEffect), v))
+	})
+}
+
+// Percent applies equality check predicate on the "percent" field. It's identical to PercentEQ.
+func Percent(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPercent), v))
+	})
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v money.Amount) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmount), v))
+	})
+}
+
+// BuyQuantity applies equality check predicate on the "buy_quantity" field. It's identical to BuyQuantityEQ.
+func BuyQuantity(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBuyQuantity), v))
+	})
+}
+
+// FreeQuantity applies equality check predicate on the "free_quantity" field. It's identical to FreeQuantityEQ.
+func FreeQuantity(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFreeQuantity), v))
+	})
+}
+
+// MinQuantity applies equality check predicate on the "min_quantity" field. It's identical to MinQuantityEQ.
+func MinQuantity(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMinQuantity), v))
+	})
+}
+
+// MinAmount applies equality check predicate on the "min_amount" field. It's identical to MinAmountEQ.
+func MinAmount(v money.Amount) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMinAmount), v))
+	})
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartsAt), v))
+	})
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndsAt), v))
+	})
+}
+
+// Weekdays applies equality check predicate on the "weekdays" field. It's identical to WeekdaysEQ.
+func Weekdays(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWeekdays), v))
+	})
+}
+
+// StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
+func StartTime(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartTime), v))
+	})
+}
+
+// EndTime applies equality check predicate on the "end_time" field. It's identical to EndTimeEQ.
+func EndTime(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndTime), v))
+	})
+}
+
+// CustomerGroup applies equality check predicate on the "customer_group" field. It's identical to CustomerGroupEQ.
+func CustomerGroup(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriority), v))
+	})
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActive), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDescription), v...))
+	})
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDescription), v...))
+	})
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDescription)))
+	})
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDescription)))
+	})
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDescription), v))
+	})
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDescription), v))
+	})
+}
+
+// CouponCodeEQ applies the EQ predicate on the "coupon_code" field.
+func CouponCodeEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeNEQ applies the NEQ predicate on the "coupon_code" field.
+func CouponCodeNEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeIn applies the In predicate on the "coupon_code" field.
+func CouponCodeIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCouponCode), v...))
+	})
+}
+
+// CouponCodeNotIn applies the NotIn predicate on the "coupon_code" field.
+func CouponCodeNotIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCouponCode), v...))
+	})
+}
+
+// CouponCodeGT applies the GT predicate on the "coupon_code" field.
+func CouponCodeGT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeGTE applies the GTE predicate on the "coupon_code" field.
+func CouponCodeGTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeLT applies the LT predicate on the "coupon_code" field.
+func CouponCodeLT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeLTE applies the LTE predicate on the "coupon_code" field.
+func CouponCodeLTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeContains applies the Contains predicate on the "coupon_code" field.
+func CouponCodeContains(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeHasPrefix applies the HasPrefix predicate on the "coupon_code" field.
+func CouponCodeHasPrefix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeHasSuffix applies the HasSuffix predicate on the "coupon_code" field.
+func CouponCodeHasSuffix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeIsNil applies the IsNil predicate on the "coupon_code" field.
+func CouponCodeIsNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCouponCode)))
+	})
+}
+
+// CouponCodeNotNil applies the NotNil predicate on the "coupon_code" field.
+func CouponCodeNotNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCouponCode)))
+	})
+}
+
+// CouponCodeEqualFold applies the EqualFold predicate on the "coupon_code" field.
+func CouponCodeEqualFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCouponCode), v))
+	})
+}
+
+// CouponCodeContainsFold applies the ContainsFold predicate on the "coupon_code" field.
+func CouponCodeContainsFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCouponCode), v))
+	})
+}
+
+// EffectEQ applies the EQ predicate on the "effect" field.
+func EffectEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEffect), v))
+	})
+}
+
+// EffectNEQ applies the NEQ predicate on the "effect" field.
+func EffectNEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEffect), v))
+	})
+}
+
+// EffectIn applies the In predicate on the "effect" field.
+func EffectIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEffect), v...))
+	})
+}
+
+// EffectNotIn applies the NotIn predicate on the "effect" field.
+func EffectNotIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEffect), v...))
+	})
+}
+
+// EffectGT applies the GT predicate on the "effect" field.
+func EffectGT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEffect), v))
+	})
+}
+
+// EffectGTE applies the GTE predicate on the "effect" field.
+func EffectGTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEffect), v))
+	})
+}
+
+// EffectLT applies the LT predicate on the "effect" field.
+func EffectLT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEffect), v))
+	})
+}
+
+// EffectLTE applies the LTE predicate on the "effect" field.
+func EffectLTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEffect), v))
+	})
+}
+
+// EffectContains applies the Contains predicate on the "effect" field.
+func EffectContains(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEffect), v))
+	})
+}
+
+// EffectHasPrefix applies the HasPrefix predicate on the "effect" field.
+func EffectHasPrefix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEffect), v))
+	})
+}
+
+// EffectHasSuffix applies the HasSuffix predicate on the "effect" field.
+func EffectHasSuffix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEffect), v))
+	})
+}
+
+// EffectEqualFold applies the EqualFold predicate on the "effect" field.
+func EffectEqualFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEffect), v))
+	})
+}
+
+// EffectContainsFold applies the ContainsFold predicate on the "effect" field.
+func EffectContainsFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEffect), v))
+	})
+}
+
+// PercentEQ applies the EQ predicate on the "percent" field.
+func PercentEQ(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPercent), v))
+	})
+}
+
+// PercentNEQ applies the NEQ predicate on the "percent" field.
+func PercentNEQ(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPercent), v))
+	})
+}
+
+// PercentIn applies the In predicate on the "percent" field.
+func PercentIn(vs ...float64) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPercent), v...))
+	})
+}
+
+// PercentNotIn applies the NotIn predicate on the "percent" field.
+func PercentNotIn(vs ...float64) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPercent), v...))
+	})
+}
+
+// PercentGT applies the GT predicate on the "percent" field.
+func PercentGT(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPercent), v))
+	})
+}
+
+// PercentGTE applies the GTE predicate on the "percent" field.
+func PercentGTE(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPercent), v))
+	})
+}
+
+// PercentLT applies the LT predicate on the "percent" field.
+func PercentLT(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPercent), v))
+	})
+}
+
+// PercentLTE applies the LTE predicate on the "percent" field.
+func PercentLTE(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPercent), v))
+	})
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v money.Amount) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmount), v))
+	})
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v money.Amount) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAmount), v))
+	})
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...money.Amount) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAmount), v...))
+	})
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...money.Amount) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAmount), v...))
+	})
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v money.Amount) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAmount), v))
+	})
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v money.Amount) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAmount), v))
+	})
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v money.Amount) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAmount), v))
+	})
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v money.Amount) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAmount), v))
+	})
+}
+
+// BuyQuantityEQ applies the EQ predicate on the "buy_quantity" field.
+func BuyQuantityEQ(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBuyQuantity), v))
+	})
+}
+
+// BuyQuantityNEQ applies the NEQ predicate on the "buy_quantity" field.
+func BuyQuantityNEQ(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBuyQuantity), v))
+	})
+}
+
+// BuyQuantityIn applies the In predicate on the "buy_quantity" field.
+func BuyQuantityIn(vs ...float64) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBuyQuantity), v...))
+	})
+}
+
+// BuyQuantityNotIn applies the NotIn predicate on the "buy_quantity" field.
+func BuyQuantityNotIn(vs ...float64) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBuyQuantity), v...))
+	})
+}
+
+// BuyQuantityGT applies the GT predicate on the "buy_quantity" field.
+func BuyQuantityGT(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBuyQuantity), v))
+	})
+}
+
+// BuyQuantityGTE applies the GTE predicate on the "buy_quantity" field.
+func BuyQuantityGTE(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBuyQuantity), v))
+	})
+}
+
+// BuyQuantityLT applies the LT predicate on the "buy_quantity" field.
+func BuyQuantityLT(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBuyQuantity), v))
+	})
+}
+
+// BuyQuantityLTE applies the LTE predicate on the "buy_quantity" field.
+func BuyQuantityLTE(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBuyQuantity), v))
+	})
+}
+
+// FreeQuantityEQ applies the EQ predicate on the "free_quantity" field.
+func FreeQuantityEQ(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFreeQuantity), v))
+	})
+}
+
+// FreeQuantityNEQ applies the NEQ predicate on the "free_quantity" field.
+func FreeQuantityNEQ(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFreeQuantity), v))
+	})
+}
+
+// FreeQuantityIn applies the In predicate on the "free_quantity" field.
+func FreeQuantityIn(vs ...float64) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldFreeQuantity), v...))
+	})
+}
+
+// FreeQuantityNotIn applies the NotIn predicate on the "free_quantity" field.
+func FreeQuantityNotIn(vs ...float64) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldFreeQuantity), v...))
+	})
+}
+
+// FreeQuantityGT applies the GT predicate on the "free_quantity" field.
+func FreeQuantityGT(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldFreeQuantity), v))
+	})
+}
+
+// FreeQuantityGTE applies the GTE predicate on the "free_quantity" field.
+func FreeQuantityGTE(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldFreeQuantity), v))
+	})
+}
+
+// FreeQuantityLT applies the LT predicate on the "free_quantity" field.
+func FreeQuantityLT(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldFreeQuantity), v))
+	})
+}
+
+// FreeQuantityLTE applies the LTE predicate on the "free_quantity" field.
+func FreeQuantityLTE(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldFreeQuantity), v))
+	})
+}
+
+// MinQuantityEQ applies the EQ predicate on the "min_quantity" field.
+func MinQuantityEQ(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMinQuantity), v))
+	})
+}
+
+// MinQuantityNEQ applies the NEQ predicate on the "min_quantity" field.
+func MinQuantityNEQ(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMinQuantity), v))
+	})
+}
+
+// MinQuantityIn applies the In predicate on the "min_quantity" field.
+func MinQuantityIn(vs ...float64) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMinQuantity), v...))
+	})
+}
+
+// MinQuantityNotIn applies the NotIn predicate on the "min_quantity" field.
+func MinQuantityNotIn(vs ...float64) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMinQuantity), v...))
+	})
+}
+
+// MinQuantityGT applies the GT predicate on the "min_quantity" field.
+func MinQuantityGT(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMinQuantity), v))
+	})
+}
+
+// MinQuantityGTE applies the GTE predicate on the "min_quantity" field.
+func MinQuantityGTE(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMinQuantity), v))
+	})
+}
+
+// MinQuantityLT applies the LT predicate on the "min_quantity" field.
+func MinQuantityLT(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMinQuantity), v))
+	})
+}
+
+// MinQuantityLTE applies the LTE predicate on the "min_quantity" field.
+func MinQuantityLTE(v float64) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMinQuantity), v))
+	})
+}
+
+// MinAmountEQ applies the EQ predicate on the "min_amount" field.
+func MinAmountEQ(v money.Amount) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMinAmount), v))
+	})
+}
+
+// MinAmountNEQ applies the NEQ predicate on the "min_amount" field.
+func MinAmountNEQ(v money.Amount) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMinAmount), v))
+	})
+}
+
+// MinAmountIn applies the In predicate on the "min_amount" field.
+func MinAmountIn(vs ...money.Amount) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMinAmount), v...))
+	})
+}
+
+// MinAmountNotIn applies the NotIn predicate on the "min_amount" field.
+func MinAmountNotIn(vs ...money.Amount) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMinAmount), v...))
+	})
+}
+
+// MinAmountGT applies the GT predicate on the "min_amount" field.
+func MinAmountGT(v money.Amount) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMinAmount), v))
+	})
+}
+
+// MinAmountGTE applies the GTE predicate on the "min_amount" field.
+func MinAmountGTE(v money.Amount) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMinAmount), v))
+	})
+}
+
+// MinAmountLT applies the LT predicate on the "min_amount" field.
+func MinAmountLT(v money.Amount) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMinAmount), v))
+	})
+}
+
+// MinAmountLTE applies the LTE predicate on the "min_amount" field.
+func MinAmountLTE(v money.Amount) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMinAmount), v))
+	})
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStartsAt), v...))
+	})
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStartsAt), v...))
+	})
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStartsAt), v))
+	})
+}
+
+// StartsAtIsNil applies the IsNil predicate on the "starts_at" field.
+func StartsAtIsNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStartsAt)))
+	})
+}
+
+// StartsAtNotNil applies the NotNil predicate on the "starts_at" field.
+func StartsAtNotNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStartsAt)))
+	})
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEndsAt), v...))
+	})
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEndsAt), v...))
+	})
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndsAt), v))
+	})
+}
+
+// EndsAtIsNil applies the IsNil predicate on the "ends_at" field.
+func EndsAtIsNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEndsAt)))
+	})
+}
+
+// EndsAtNotNil applies the NotNil predicate on the "ends_at" field.
+func EndsAtNotNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEndsAt)))
+	})
+}
+
+// WeekdaysEQ applies the EQ predicate on the "weekdays" field.
+func WeekdaysEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWeekdays), v))
+	})
+}
+
+// WeekdaysNEQ applies the NEQ predicate on the "weekdays" field.
+func WeekdaysNEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWeekdays), v))
+	})
+}
+
+// WeekdaysIn applies the In predicate on the "weekdays" field.
+func WeekdaysIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldWeekdays), v...))
+	})
+}
+
+// WeekdaysNotIn applies the NotIn predicate on the "weekdays" field.
+func WeekdaysNotIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldWeekdays), v...))
+	})
+}
+
+// WeekdaysGT applies the GT predicate on the "weekdays" field.
+func WeekdaysGT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWeekdays), v))
+	})
+}
+
+// WeekdaysGTE applies the GTE predicate on the "weekdays" field.
+func WeekdaysGTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWeekdays), v))
+	})
+}
+
+// WeekdaysLT applies the LT predicate on the "weekdays" field.
+func WeekdaysLT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWeekdays), v))
+	})
+}
+
+// WeekdaysLTE applies the LTE predicate on the "weekdays" field.
+func WeekdaysLTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWeekdays), v))
+	})
+}
+
+// WeekdaysContains applies the Contains predicate on the "weekdays" field.
+func WeekdaysContains(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldWeekdays), v))
+	})
+}
+
+// WeekdaysHasPrefix applies the HasPrefix predicate on the "weekdays" field.
+func WeekdaysHasPrefix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldWeekdays), v))
+	})
+}
+
+// WeekdaysHasSuffix applies the HasSuffix predicate on the "weekdays" field.
+func WeekdaysHasSuffix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldWeekdays), v))
+	})
+}
+
+// WeekdaysIsNil applies the IsNil predicate on the "weekdays" field.
+func WeekdaysIsNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldWeekdays)))
+	})
+}
+
+// WeekdaysNotNil applies the NotNil predicate on the "weekdays" field.
+func WeekdaysNotNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldWeekdays)))
+	})
+}
+
+// WeekdaysEqualFold applies the EqualFold predicate on the "weekdays" field.
+func WeekdaysEqualFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldWeekdays), v))
+	})
+}
+
+// WeekdaysContainsFold applies the ContainsFold predicate on the "weekdays" field.
+func WeekdaysContainsFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldWeekdays), v))
+	})
+}
+
+// StartTimeEQ applies the EQ predicate on the "start_time" field.
+func StartTimeEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "start_time" field.
+func StartTimeNEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeIn applies the In predicate on the "start_time" field.
+func StartTimeIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStartTime), v...))
+	})
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "start_time" field.
+func StartTimeNotIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStartTime), v...))
+	})
+}
+
+// StartTimeGT applies the GT predicate on the "start_time" field.
+func StartTimeGT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeGTE applies the GTE predicate on the "start_time" field.
+func StartTimeGTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeLT applies the LT predicate on the "start_time" field.
+func StartTimeLT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeLTE applies the LTE predicate on the "start_time" field.
+func StartTimeLTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeContains applies the Contains predicate on the "start_time" field.
+func StartTimeContains(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeHasPrefix applies the HasPrefix predicate on the "start_time" field.
+func StartTimeHasPrefix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeHasSuffix applies the HasSuffix predicate on the "start_time" field.
+func StartTimeHasSuffix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeIsNil applies the IsNil predicate on the "start_time" field.
+func StartTimeIsNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStartTime)))
+	})
+}
+
+// StartTimeNotNil applies the NotNil predicate on the "start_time" field.
+func StartTimeNotNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStartTime)))
+	})
+}
+
+// StartTimeEqualFold applies the EqualFold predicate on the "start_time" field.
+func StartTimeEqualFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStartTime), v))
+	})
+}
+
+// StartTimeContainsFold applies the ContainsFold predicate on the "start_time" field.
+func StartTimeContainsFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStartTime), v))
+	})
+}
+
+// EndTimeEQ applies the EQ predicate on the "end_time" field.
+func EndTimeEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "end_time" field.
+func EndTimeNEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeIn applies the In predicate on the "end_time" field.
+func EndTimeIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEndTime), v...))
+	})
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "end_time" field.
+func EndTimeNotIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEndTime), v...))
+	})
+}
+
+// EndTimeGT applies the GT predicate on the "end_time" field.
+func EndTimeGT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeGTE applies the GTE predicate on the "end_time" field.
+func EndTimeGTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeLT applies the LT predicate on the "end_time" field.
+func EndTimeLT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeLTE applies the LTE predicate on the "end_time" field.
+func EndTimeLTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeContains applies the Contains predicate on the "end_time" field.
+func EndTimeContains(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeHasPrefix applies the HasPrefix predicate on the "end_time" field.
+func EndTimeHasPrefix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeHasSuffix applies the HasSuffix predicate on the "end_time" field.
+func EndTimeHasSuffix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeIsNil applies the IsNil predicate on the "end_time" field.
+func EndTimeIsNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEndTime)))
+	})
+}
+
+// EndTimeNotNil applies the NotNil predicate on the "end_time" field.
+func EndTimeNotNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEndTime)))
+	})
+}
+
+// EndTimeEqualFold applies the EqualFold predicate on the "end_time" field.
+func EndTimeEqualFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEndTime), v))
+	})
+}
+
+// EndTimeContainsFold applies the ContainsFold predicate on the "end_time" field.
+func EndTimeContainsFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEndTime), v))
+	})
+}
+
+// CustomerGroupEQ applies the EQ predicate on the "customer_group" field.
+func CustomerGroupEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupNEQ applies the NEQ predicate on the "customer_group" field.
+func CustomerGroupNEQ(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupIn applies the In predicate on the "customer_group" field.
+func CustomerGroupIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCustomerGroup), v...))
+	})
+}
+
+// CustomerGroupNotIn applies the NotIn predicate on the "customer_group" field.
+func CustomerGroupNotIn(vs ...string) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCustomerGroup), v...))
+	})
+}
+
+// CustomerGroupGT applies the GT predicate on the "customer_group" field.
+func CustomerGroupGT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupGTE applies the GTE predicate on the "customer_group" field.
+func CustomerGroupGTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupLT applies the LT predicate on the "customer_group" field.
+func CustomerGroupLT(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupLTE applies the LTE predicate on the "customer_group" field.
+func CustomerGroupLTE(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupContains applies the Contains predicate on the "customer_group" field.
+func CustomerGroupContains(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupHasPrefix applies the HasPrefix predicate on the "customer_group" field.
+func CustomerGroupHasPrefix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupHasSuffix applies the HasSuffix predicate on the "customer_group" field.
+func CustomerGroupHasSuffix(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupIsNil applies the IsNil predicate on the "customer_group" field.
+func CustomerGroupIsNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCustomerGroup)))
+	})
+}
+
+// CustomerGroupNotNil applies the NotNil predicate on the "customer_group" field.
+func CustomerGroupNotNil() predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCustomerGroup)))
+	})
+}
+
+// CustomerGroupEqualFold applies the EqualFold predicate on the "customer_group" field.
+func CustomerGroupEqualFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// CustomerGroupContainsFold applies the ContainsFold predicate on the "customer_group" field.
+func CustomerGroupContainsFold(v string) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCustomerGroup), v))
+	})
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPriority), v...))
+	})
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPriority), v...))
+	})
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPriority), v))
+	})
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPriority), v))
+	})
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActive), v))
+	})
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldActive), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Promotion {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Promotion(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Promotion) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Promotion) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Promotion) predicate.Promotion {
+	return predicate.Promotion(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
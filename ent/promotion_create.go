@@ -0,0 +1,769 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/promotion"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PromotionCreate is the builder for creating a Promotion entity.
+type PromotionCreate struct {
+	config
+	mutation *PromotionMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (pc *PromotionCreate) SetTenantID(i int) *PromotionCreate {
+	pc.mutation.SetTenantID(i)
+	return pc
+}
+
+// SetName sets the "name" field.
+func (pc *PromotionCreate) SetName(s string) *PromotionCreate {
+	pc.mutation.SetName(s)
+	return pc
+}
+
+// SetDescription sets the "description" field.
+func (pc *PromotionCreate) SetDescription(s string) *PromotionCreate {
+	pc.mutation.SetDescription(s)
+	return pc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableDescription(s *string) *PromotionCreate {
+	if s != nil {
+		pc.SetDescription(*s)
+	}
+	return pc
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (pc *PromotionCreate) SetCouponCode(s string) *PromotionCreate {
+	pc.mutation.SetCouponCode(s)
+	return pc
+}
+
+// SetNillableCouponCode sets the "coupon_code" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableCouponCode(s *string) *PromotionCreate {
+	if s != nil {
+		pc.SetCouponCode(*s)
+	}
+	return pc
+}
+
+// SetEffect sets the "effect" field.
+func (pc *PromotionCreate) SetEffect(s string) *PromotionCreate {
+	pc.mutation.SetEffect(s)
+	return pc
+}
+
+// SetPercent sets the "percent" field.
+func (pc *PromotionCreate) SetPercent(f float64) *PromotionCreate {
+	pc.mutation.SetPercent(f)
+	return pc
+}
+
+// SetNillablePercent sets the "percent" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillablePercent(f *float64) *PromotionCreate {
+	if f != nil {
+		pc.SetPercent(*f)
+	}
+	return pc
+}
+
+// SetAmount sets the "amount" field.
+func (pc *PromotionCreate) SetAmount(m money.Amount) *PromotionCreate {
+	pc.mutation.SetAmount(m)
+	return pc
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableAmount(m *money.Amount) *PromotionCreate {
+	if m != nil {
+		pc.SetAmount(*m)
+	}
+	return pc
+}
+
+// SetBuyQuantity sets the "buy_quantity" field.
+func (pc *PromotionCreate) SetBuyQuantity(f float64) *PromotionCreate {
+	pc.mutation.SetBuyQuantity(f)
+	return pc
+}
+
+// SetNillableBuyQuantity sets the "buy_quantity" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableBuyQuantity(f *float64) *PromotionCreate {
+	if f != nil {
+		pc.SetBuyQuantity(*f)
+	}
+	return pc
+}
+
+// SetFreeQuantity sets the "free_quantity" field.
+func (pc *PromotionCreate) SetFreeQuantity(f float64) *PromotionCreate {
+	pc.mutation.SetFreeQuantity(f)
+	return pc
+}
+
+// SetNillableFreeQuantity sets the "free_quantity" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableFreeQuantity(f *float64) *PromotionCreate {
+	if f != nil {
+		pc.SetFreeQuantity(*f)
+	}
+	return pc
+}
+
+// SetMinQuantity sets the "min_quantity" field.
+func (pc *PromotionCreate) SetMinQuantity(f float64) *PromotionCreate {
+	pc.mutation.SetMinQuantity(f)
+	return pc
+}
+
+// SetNillableMinQuantity sets the "min_quantity" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableMinQuantity(f *float64) *PromotionCreate {
+	if f != nil {
+		pc.SetMinQuantity(*f)
+	}
+	return pc
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (pc *PromotionCreate) SetMinAmount(m money.Amount) *PromotionCreate {
+	pc.mutation.SetMinAmount(m)
+	return pc
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableMinAmount(m *money.Amount) *PromotionCreate {
+	if m != nil {
+		pc.SetMinAmount(*m)
+	}
+	return pc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (pc *PromotionCreate) SetStartsAt(t time.Time) *PromotionCreate {
+	pc.mutation.SetStartsAt(t)
+	return pc
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableStartsAt(t *time.Time) *PromotionCreate {
+	if t != nil {
+		pc.SetStartsAt(*t)
+	}
+	return pc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (pc *PromotionCreate) SetEndsAt(t time.Time) *PromotionCreate {
+	pc.mutation.SetEndsAt(t)
+	return pc
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableEndsAt(t *time.Time) *PromotionCreate {
+	if t != nil {
+		pc.SetEndsAt(*t)
+	}
+	return pc
+}
+
+// SetWeekdays sets the "weekdays" field.
+func (pc *PromotionCreate) SetWeekdays(s string) *PromotionCreate {
+	pc.mutation.SetWeekdays(s)
+	return pc
+}
+
+// SetNillableWeekdays sets the "weekdays" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableWeekdays(s *string) *PromotionCreate {
+	if s != nil {
+		pc.SetWeekdays(*s)
+	}
+	return pc
+}
+
+// SetStartTime sets the "start_time" field.
+func (pc *PromotionCreate) SetStartTime(s string) *PromotionCreate {
+	pc.mutation.SetStartTime(s)
+	return pc
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableStartTime(s *string) *PromotionCreate {
+	if s != nil {
+		pc.SetStartTime(*s)
+	}
+	return pc
+}
+
+// SetEndTime sets the "end_time" field.
+func (pc *PromotionCreate) SetEndTime(s string) *PromotionCreate {
+	pc.mutation.SetEndTime(s)
+	return pc
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableEndTime(s *string) *PromotionCreate {
+	if s != nil {
+		pc.SetEndTime(*s)
+	}
+	return pc
+}
+
+// SetCustomerGroup sets the "customer_group" field.
+func (pc *PromotionCreate) SetCustomerGroup(s string) *PromotionCreate {
+	pc.mutation.SetCustomerGroup(s)
+	return pc
+}
+
+// SetNillableCustomerGroup sets the "customer_group" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableCustomerGroup(s *string) *PromotionCreate {
+	if s != nil {
+		pc.SetCustomerGroup(*s)
+	}
+	return pc
+}
+
+// SetPriority sets the "priority" field.
+func (pc *PromotionCreate) SetPriority(i int) *PromotionCreate {
+	pc.mutation.SetPriority(i)
+	return pc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillablePriority(i *int) *PromotionCreate {
+	if i != nil {
+		pc.SetPriority(*i)
+	}
+	return pc
+}
+
+// SetActive sets the "active" field.
+func (pc *PromotionCreate) SetActive(b bool) *PromotionCreate {
+	pc.mutation.SetActive(b)
+	return pc
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableActive(b *bool) *PromotionCreate {
+	if b != nil {
+		pc.SetActive(*b)
+	}
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PromotionCreate) SetCreatedAt(t time.Time) *PromotionCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableCreatedAt(t *time.Time) *PromotionCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *PromotionCreate) SetUpdatedAt(t time.Time) *PromotionCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *PromotionCreate) SetNillableUpdatedAt(t *time.Time) *PromotionCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// Mutation returns the PromotionMutation object of the builder.
+func (pc *PromotionCreate) Mutation() *PromotionMutation {
+	return pc.mutation
+}
+
+// Save creates the Promotion in the database.
+func (pc *PromotionCreate) Save(ctx context.Context) (*Promotion, error) {
+	var (
+		err  error
+		node *Promotion
+	)
+	pc.defaults()
+	if len(pc.hooks) == 0 {
+		if err = pc.check(); err != nil {
+			return nil, err
+		}
+		node, err = pc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PromotionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pc.check(); err != nil {
+				return nil, err
+			}
+			pc.mutation = mutation
+			if node, err = pc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pc.hooks) - 1; i >= 0; i-- {
+			if pc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, pc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Promotion)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from PromotionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PromotionCreate) SaveX(ctx context.Context) *Promotion {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PromotionCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PromotionCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PromotionCreate) defaults() {
+	if _, ok := pc.mutation.Percent(); !ok {
+		v := promotion.DefaultPercent
+		pc.mutation.SetPercent(v)
+	}
+	if _, ok := pc.mutation.Amount(); !ok {
+		v := promotion.DefaultAmount
+		pc.mutation.SetAmount(v)
+	}
+	if _, ok := pc.mutation.BuyQuantity(); !ok {
+		v := promotion.DefaultBuyQuantity
+		pc.mutation.SetBuyQuantity(v)
+	}
+	if _, ok := pc.mutation.FreeQuantity(); !ok {
+		v := promotion.DefaultFreeQuantity
+		pc.mutation.SetFreeQuantity(v)
+	}
+	if _, ok := pc.mutation.MinQuantity(); !ok {
+		v := promotion.DefaultMinQuantity
+		pc.mutation.SetMinQuantity(v)
+	}
+	if _, ok := pc.mutation.MinAmount(); !ok {
+		v := promotion.DefaultMinAmount
+		pc.mutation.SetMinAmount(v)
+	}
+	if _, ok := pc.mutation.Priority(); !ok {
+		v := promotion.DefaultPriority
+		pc.mutation.SetPriority(v)
+	}
+	if _, ok := pc.mutation.Active(); !ok {
+		v := promotion.DefaultActive
+		pc.mutation.SetActive(v)
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := promotion.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		v := promotion.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PromotionCreate) check() error {
+	if _, ok := pc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Promotion.tenant_id"`)}
+	}
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Promotion.name"`)}
+	}
+	if v, ok := pc.mutation.Name(); ok {
+		if err := promotion.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Promotion.name": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Effect(); !ok {
+		return &ValidationError{Name: "effect", err: errors.New(`ent: missing required field "Promotion.effect"`)}
+	}
+	if _, ok := pc.mutation.Percent(); !ok {
+		return &ValidationError{Name: "percent", err: errors.New(`ent: missing required field "Promotion.percent"`)}
+	}
+	if v, ok := pc.mutation.Percent(); ok {
+		if err := promotion.PercentValidator(v); err != nil {
+			return &ValidationError{Name: "percent", err: fmt.Errorf(`ent: validator failed for field "Promotion.percent": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Promotion.amount"`)}
+	}
+	if v, ok := pc.mutation.Amount(); ok {
+		if err := promotion.AmountValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Promotion.amount": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.BuyQuantity(); !ok {
+		return &ValidationError{Name: "buy_quantity", err: errors.New(`ent: missing required field "Promotion.buy_quantity"`)}
+	}
+	if v, ok := pc.mutation.BuyQuantity(); ok {
+		if err := promotion.BuyQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "buy_quantity", err: fmt.Errorf(`ent: validator failed for field "Promotion.buy_quantity": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.FreeQuantity(); !ok {
+		return &ValidationError{Name: "free_quantity", err: errors.New(`ent: missing required field "Promotion.free_quantity"`)}
+	}
+	if v, ok := pc.mutation.FreeQuantity(); ok {
+		if err := promotion.FreeQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "free_quantity", err: fmt.Errorf(`ent: validator failed for field "Promotion.free_quantity": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.MinQuantity(); !ok {
+		return &ValidationError{Name: "min_quantity", err: errors.New(`ent: missing required field "Promotion.min_quantity"`)}
+	}
+	if v, ok := pc.mutation.MinQuantity(); ok {
+		if err := promotion.MinQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "min_quantity", err: fmt.Errorf(`ent: validator failed for field "Promotion.min_quantity": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.MinAmount(); !ok {
+		return &ValidationError{Name: "min_amount", err: errors.New(`ent: missing required field "Promotion.min_amount"`)}
+	}
+	if v, ok := pc.mutation.MinAmount(); ok {
+		if err := promotion.MinAmountValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "min_amount", err: fmt.Errorf(`ent: validator failed for field "Promotion.min_amount": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Promotion.priority"`)}
+	}
+	if _, ok := pc.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "Promotion.active"`)}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Promotion.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Promotion.updated_at"`)}
+	}
+	return nil
+}
+
+func (pc *PromotionCreate) sqlSave(ctx context.Context) (*Promotion, error) {
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (pc *PromotionCreate) createSpec() (*Promotion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Promotion{config: pc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: promotion.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promotion.FieldID,
+			},
+		}
+	)
+	if value, ok := pc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotion.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := pc.mutation.Description(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldDescription,
+		})
+		_node.Description = value
+	}
+	if value, ok := pc.mutation.CouponCode(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldCouponCode,
+		})
+		_node.CouponCode = value
+	}
+	if value, ok := pc.mutation.Effect(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldEffect,
+		})
+		_node.Effect = value
+	}
+	if value, ok := pc.mutation.Percent(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldPercent,
+		})
+		_node.Percent = value
+	}
+	if value, ok := pc.mutation.Amount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: promotion.FieldAmount,
+		})
+		_node.Amount = value
+	}
+	if value, ok := pc.mutation.BuyQuantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldBuyQuantity,
+		})
+		_node.BuyQuantity = value
+	}
+	if value, ok := pc.mutation.FreeQuantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldFreeQuantity,
+		})
+		_node.FreeQuantity = value
+	}
+	if value, ok := pc.mutation.MinQuantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldMinQuantity,
+		})
+		_node.MinQuantity = value
+	}
+	if value, ok := pc.mutation.MinAmount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: promotion.FieldMinAmount,
+		})
+		_node.MinAmount = value
+	}
+	if value, ok := pc.mutation.StartsAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promotion.FieldStartsAt,
+		})
+		_node.StartsAt = &value
+	}
+	if value, ok := pc.mutation.EndsAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promotion.FieldEndsAt,
+		})
+		_node.EndsAt = &value
+	}
+	if value, ok := pc.mutation.Weekdays(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldWeekdays,
+		})
+		_node.Weekdays = value
+	}
+	if value, ok := pc.mutation.StartTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldStartTime,
+		})
+		_node.StartTime = value
+	}
+	if value, ok := pc.mutation.EndTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldEndTime,
+		})
+		_node.EndTime = value
+	}
+	if value, ok := pc.mutation.CustomerGroup(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldCustomerGroup,
+		})
+		_node.CustomerGroup = value
+	}
+	if value, ok := pc.mutation.Priority(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotion.FieldPriority,
+		})
+		_node.Priority = value
+	}
+	if value, ok := pc.mutation.Active(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: promotion.FieldActive,
+		})
+		_node.Active = value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promotion.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promotion.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// PromotionCreateBulk is the builder for creating many Promotion entities in bulk.
+type PromotionCreateBulk struct {
+	config
+	builders []*PromotionCreate
+}
+
+// Save creates the Promotion entities in the database.
+func (pcb *PromotionCreateBulk) Save(ctx context.Context) ([]*Promotion, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Promotion, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PromotionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PromotionCreateBulk) SaveX(ctx context.Context) []*Promotion {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PromotionCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PromotionCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/promotion"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PromotionUpdate is the builder for updating Promotion entities.
+type PromotionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PromotionMutation
+}
+
+// Where appends a list predicates to the PromotionUpdate builder.
+func (pu *PromotionUpdate) Where(ps ...predicate.Promotion) *PromotionUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (pu *PromotionUpdate) SetTenantID(i int) *PromotionUpdate {
+	pu.mutation.ResetTenantID()
+	pu.mutation.SetTenantID(i)
+	return pu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (pu *PromotionUpdate) AddTenantID(i int) *PromotionUpdate {
+	pu.mutation.AddTenantID(i)
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *PromotionUpdate) SetName(s string) *PromotionUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetDescription sets the "description" field.
+func (pu *PromotionUpdate) SetDescription(s string) *PromotionUpdate {
+	pu.mutation.SetDescription(s)
+	return pu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableDescription(s *string) *PromotionUpdate {
+	if s != nil {
+		pu.SetDescription(*s)
+	}
+	return pu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (pu *PromotionUpdate) ClearDescription() *PromotionUpdate {
+	pu.mutation.ClearDescription()
+	return pu
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (pu *PromotionUpdate) SetCouponCode(s string) *PromotionUpdate {
+	pu.mutation.SetCouponCode(s)
+	return pu
+}
+
+// SetNillableCouponCode sets the "coupon_code" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableCouponCode(s *string) *PromotionUpdate {
+	if s != nil {
+		pu.SetCouponCode(*s)
+	}
+	return pu
+}
+
+// ClearCouponCode clears the value of the "coupon_code" field.
+func (pu *PromotionUpdate) ClearCouponCode() *PromotionUpdate {
+	pu.mutation.ClearCouponCode()
+	return pu
+}
+
+// SetEffect sets the "effect" field.
+func (pu *PromotionUpdate) SetEffect(s string) *PromotionUpdate {
+	pu.mutation.SetEffect(s)
+	return pu
+}
+
+// SetPercent sets the "percent" field.
+func (pu *PromotionUpdate) SetPercent(f float64) *PromotionUpdate {
+	pu.mutation.ResetPercent()
+	pu.mutation.SetPercent(f)
+	return pu
+}
+
+// SetNillablePercent sets the "percent" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillablePercent(f *float64) *PromotionUpdate {
+	if f != nil {
+		pu.SetPercent(*f)
+	}
+	return pu
+}
+
+// AddPercent adds f to the "percent" field.
+func (pu *PromotionUpdate) AddPercent(f float64) *PromotionUpdate {
+	pu.mutation.AddPercent(f)
+	return pu
+}
+
+// SetAmount sets the "amount" field.
+func (pu *PromotionUpdate) SetAmount(m money.Amount) *PromotionUpdate {
+	pu.mutation.ResetAmount()
+	pu.mutation.SetAmount(m)
+	return pu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableAmount(m *money.Amount) *PromotionUpdate {
+	if m != nil {
+		pu.SetAmount(*m)
+	}
+	return pu
+}
+
+// AddAmount adds m to the "amount" field.
+func (pu *PromotionUpdate) AddAmount(m money.Amount) *PromotionUpdate {
+	pu.mutation.AddAmount(m)
+	return pu
+}
+
+// SetBuyQuantity sets the "buy_quantity" field.
+func (pu *PromotionUpdate) SetBuyQuantity(f float64) *PromotionUpdate {
+	pu.mutation.ResetBuyQuantity()
+	pu.mutation.SetBuyQuantity(f)
+	return pu
+}
+
+// SetNillableBuyQuantity sets the "buy_quantity" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableBuyQuantity(f *float64) *PromotionUpdate {
+	if f != nil {
+		pu.SetBuyQuantity(*f)
+	}
+	return pu
+}
+
+// AddBuyQuantity adds f to the "buy_quantity" field.
+func (pu *PromotionUpdate) AddBuyQuantity(f float64) *PromotionUpdate {
+	pu.mutation.AddBuyQuantity(f)
+	return pu
+}
+
+// SetFreeQuantity sets the "free_quantity" field.
+func (pu *PromotionUpdate) SetFreeQuantity(f float64) *PromotionUpdate {
+	pu.mutation.ResetFreeQuantity()
+	pu.mutation.SetFreeQuantity(f)
+	return pu
+}
+
+// SetNillableFreeQuantity sets the "free_quantity" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableFreeQuantity(f *float64) *PromotionUpdate {
+	if f != nil {
+		pu.SetFreeQuantity(*f)
+	}
+	return pu
+}
+
+// AddFreeQuantity adds f to the "free_quantity" field.
+func (pu *PromotionUpdate) AddFreeQuantity(f float64) *PromotionUpdate {
+	pu.mutation.AddFreeQuantity(f)
+	return pu
+}
+
+// SetMinQuantity sets the "min_quantity" field.
+func (pu *PromotionUpdate) SetMinQuantity(f float64) *PromotionUpdate {
+	pu.mutation.ResetMinQuantity()
+	pu.mutation.SetMinQuantity(f)
+	return pu
+}
+
+// SetNillableMinQuantity sets the "min_quantity" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableMinQuantity(f *float64) *PromotionUpdate {
+	if f != nil {
+		pu.SetMinQuantity(*f)
+	}
+	return pu
+}
+
+// AddMinQuantity adds f to the "min_quantity" field.
+func (pu *PromotionUpdate) AddMinQuantity(f float64) *PromotionUpdate {
+	pu.mutation.AddMinQuantity(f)
+	return pu
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (pu *PromotionUpdate) SetMinAmount(m money.Amount) *PromotionUpdate {
+	pu.mutation.ResetMinAmount()
+	pu.mutation.SetMinAmount(m)
+	return pu
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableMinAmount(m *money.Amount) *PromotionUpdate {
+	if m != nil {
+		pu.SetMinAmount(*m)
+	}
+	return pu
+}
+
+// AddMinAmount adds m to the "min_amount" field.
+func (pu *PromotionUpdate) AddMinAmount(m money.Amount) *PromotionUpdate {
+	pu.mutation.AddMinAmount(m)
+	return pu
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (pu *PromotionUpdate) SetStartsAt(t time.Time) *PromotionUpdate {
+	pu.mutation.SetStartsAt(t)
+	return pu
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableStartsAt(t *time.Time) *PromotionUpdate {
+	if t != nil {
+		pu.SetStartsAt(*t)
+	}
+	return pu
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (pu *PromotionUpdate) ClearStartsAt() *PromotionUpdate {
+	pu.mutation.ClearStartsAt()
+	return pu
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (pu *PromotionUpdate) SetEndsAt(t time.Time) *PromotionUpdate {
+	pu.mutation.SetEndsAt(t)
+	return pu
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableEndsAt(t *time.Time) *PromotionUpdate {
+	if t != nil {
+		pu.SetEndsAt(*t)
+	}
+	return pu
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (pu *PromotionUpdate) ClearEndsAt() *PromotionUpdate {
+	pu.mutation.ClearEndsAt()
+	return pu
+}
+
+// SetWeekdays sets the "weekdays" field.
+func (pu *PromotionUpdate) SetWeekdays(s string) *PromotionUpdate {
+	pu.mutation.SetWeekdays(s)
+	return pu
+}
+
+// SetNillableWeekdays sets the "weekdays" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableWeekdays(s *string) *PromotionUpdate {
+	if s != nil {
+		pu.SetWeekdays(*s)
+	}
+	return pu
+}
+
+// ClearWeekdays clears the value of the "weekdays" field.
+func (pu *PromotionUpdate) ClearWeekdays() *PromotionUpdate {
+	pu.mutation.ClearWeekdays()
+	return pu
+}
+
+// SetStartTime sets the "start_time" field.
+func (pu *PromotionUpdate) SetStartTime(s string) *PromotionUpdate {
+	pu.mutation.SetStartTime(s)
+	return pu
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableStartTime(s *string) *PromotionUpdate {
+	if s != nil {
+		pu.SetStartTime(*s)
+	}
+	return pu
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (pu *PromotionUpdate) ClearStartTime() *PromotionUpdate {
+	pu.mutation.ClearStartTime()
+	return pu
+}
+
+// SetEndTime sets the "end_time" field.
+func (pu *PromotionUpdate) SetEndTime(s string) *PromotionUpdate {
+	pu.mutation.SetEndTime(s)
+	return pu
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableEndTime(s *string) *PromotionUpdate {
+	if s != nil {
+		pu.SetEndTime(*s)
+	}
+	return pu
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (pu *PromotionUpdate) ClearEndTime() *PromotionUpdate {
+	pu.mutation.ClearEndTime()
+	return pu
+}
+
+// SetCustomerGroup sets the "customer_group" field.
+func (pu *PromotionUpdate) SetCustomerGroup(s string) *PromotionUpdate {
+	pu.mutation.SetCustomerGroup(s)
+	return pu
+}
+
+// SetNillableCustomerGroup sets the "customer_group" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableCustomerGroup(s *string) *PromotionUpdate {
+	if s != nil {
+		pu.SetCustomerGroup(*s)
+	}
+	return pu
+}
+
+// ClearCustomerGroup clears the value of the "customer_group" field.
+func (pu *PromotionUpdate) ClearCustomerGroup() *PromotionUpdate {
+	pu.mutation.ClearCustomerGroup()
+	return pu
+}
+
+// SetPriority sets the "priority" field.
+func (pu *PromotionUpdate) SetPriority(i int) *PromotionUpdate {
+	pu.mutation.ResetPriority()
+	pu.mutation.SetPriority(i)
+	return pu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillablePriority(i *int) *PromotionUpdate {
+	if i != nil {
+		pu.SetPriority(*i)
+	}
+	return pu
+}
+
+// AddPriority adds i to the "priority" field.
+func (pu *PromotionUpdate) AddPriority(i int) *PromotionUpdate {
+	pu.mutation.AddPriority(i)
+	return pu
+}
+
+// SetActive sets the "active" field.
+func (pu *PromotionUpdate) SetActive(b bool) *PromotionUpdate {
+	pu.mutation.SetActive(b)
+	return pu
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (pu *PromotionUpdate) SetNillableActive(b *bool) *PromotionUpdate {
+	if b != nil {
+		pu.SetActive(*b)
+	}
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PromotionUpdate) SetUpdatedAt(t time.Time) *PromotionUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// Mutation returns the PromotionMutation object of the builder.
+func (pu *PromotionUpdate) Mutation() *PromotionMutation {
+	return pu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PromotionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	pu.defaults()
+	if len(pu.hooks) == 0 {
+		if err = pu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = pu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PromotionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pu.check(); err != nil {
+				return 0, err
+			}
+			pu.mutation = mutation
+			affected, err = pu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pu.hooks) - 1; i >= 0; i-- {
+			if pu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PromotionUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PromotionUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PromotionUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PromotionUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := promotion.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PromotionUpdate) check() error {
+	if v, ok := pu.mutation.Name(); ok {
+		if err := promotion.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Promotion.name": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Percent(); ok {
+		if err := promotion.PercentValidator(v); err != nil {
+			return &ValidationError{Name: "percent", err: fmt.Errorf(`ent: validator failed for field "Promotion.percent": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Amount(); ok {
+		if err := promotion.AmountValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Promotion.amount": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.BuyQuantity(); ok {
+		if err := promotion.BuyQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "buy_quantity", err: fmt.Errorf(`ent: validator failed for field "Promotion.buy_quantity": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.FreeQuantity(); ok {
+		if err := promotion.FreeQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "free_quantity", err: fmt.Errorf(`ent: validator failed for field "Promotion.free_quantity": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.MinQuantity(); ok {
+		if err := promotion.MinQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "min_quantity", err: fmt.Errorf(`ent: validator failed for field "Promotion.min_quantity": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.MinAmount(); ok {
+		if err := promotion.MinAmountValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "min_amount", err: fmt.Errorf(`ent: validator failed for field "Promotion.min_amount": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pu *PromotionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   promotion.Table,
+			Columns: promotion.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promotion.FieldID,
+			},
+		},
+	}
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotion.FieldTenantID,
+		})
+	}
+	if value, ok := pu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotion.FieldTenantID,
+		})
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldName,
+		})
+	}
+	if value, ok := pu.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldDescription,
+		})
+	}
+	if pu.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promotion.FieldDescription,
+		})
+	}
+	if value, ok := pu.mutation.CouponCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldCouponCode,
+		})
+	}
+	if pu.mutation.CouponCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promotion.FieldCouponCode,
+		})
+	}
+	if value, ok := pu.mutation.Effect(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldEffect,
+		})
+	}
+	if value, ok := pu.mutation.Percent(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldPercent,
+		})
+	}
+	if value, ok := pu.mutation.AddedPercent(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldPercent,
+		})
+	}
+	if value, ok := pu.mutation.Amount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: promotion.FieldAmount,
+		})
+	}
+	if value, ok := pu.mutation.AddedAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: promotion.FieldAmount,
+		})
+	}
+	if value, ok := pu.mutation.BuyQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldBuyQuantity,
+		})
+	}
+	if value, ok := pu.mutation.AddedBuyQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldBuyQuantity,
+		})
+	}
+	if value, ok := pu.mutation.FreeQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldFreeQuantity,
+		})
+	}
+	if value, ok := pu.mutation.AddedFreeQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldFreeQuantity,
+		})
+	}
+	if value, ok := pu.mutation.MinQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldMinQuantity,
+		})
+	}
+	if value, ok := pu.mutation.AddedMinQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldMinQuantity,
+		})
+	}
+	if value, ok := pu.mutation.MinAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: promotion.FieldMinAmount,
+		})
+	}
+	if value, ok := pu.mutation.AddedMinAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: promotion.FieldMinAmount,
+		})
+	}
+	if value, ok := pu.mutation.StartsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promotion.FieldStartsAt,
+		})
+	}
+	if pu.mutation.StartsAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: promotion.FieldStartsAt,
+		})
+	}
+	if value, ok := pu.mutation.EndsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promotion.FieldEndsAt,
+		})
+	}
+	if pu.mutation.EndsAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: promotion.FieldEndsAt,
+		})
+	}
+	if value, ok := pu.mutation.Weekdays(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldWeekdays,
+		})
+	}
+	if pu.mutation.WeekdaysCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promotion.FieldWeekdays,
+		})
+	}
+	if value, ok := pu.mutation.StartTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldStartTime,
+		})
+	}
+	if pu.mutation.StartTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promotion.FieldStartTime,
+		})
+	}
+	if value, ok := pu.mutation.EndTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldEndTime,
+		})
+	}
+	if pu.mutation.EndTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promotion.FieldEndTime,
+		})
+	}
+	if value, ok := pu.mutation.CustomerGroup(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldCustomerGroup,
+		})
+	}
+	if pu.mutation.CustomerGroupCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promotion.FieldCustomerGroup,
+		})
+	}
+	if value, ok := pu.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotion.FieldPriority,
+		})
+	}
+	if value, ok := pu.mutation.AddedPriority(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotion.FieldPriority,
+		})
+	}
+	if value, ok := pu.mutation.Active(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: promotion.FieldActive,
+		})
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promotion.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promotion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PromotionUpdateOne is the builder for updating a single Promotion entity.
+type PromotionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PromotionMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (puo *PromotionUpdateOne) SetTenantID(i int) *PromotionUpdateOne {
+	puo.mutation.ResetTenantID()
+	puo.mutation.SetTenantID(i)
+	return puo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (puo *PromotionUpdateOne) AddTenantID(i int) *PromotionUpdateOne {
+	puo.mutation.AddTenantID(i)
+	return puo
+}
+
+// SetName sets the "name" field.
+func (puo *PromotionUpdateOne) SetName(s string) *PromotionUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetDescription sets the "description" field.
+func (puo *PromotionUpdateOne) SetDescription(s string) *PromotionUpdateOne {
+	puo.mutation.SetDescription(s)
+	return puo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableDescription(s *string) *PromotionUpdateOne {
+	if s != nil {
+		puo.SetDescription(*s)
+	}
+	return puo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (puo *PromotionUpdateOne) ClearDescription() *PromotionUpdateOne {
+	puo.mutation.ClearDescription()
+	return puo
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (puo *PromotionUpdateOne) SetCouponCode(s string) *PromotionUpdateOne {
+	puo.mutation.SetCouponCode(s)
+	return puo
+}
+
+// SetNillableCouponCode sets the "coupon_code" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableCouponCode(s *string) *PromotionUpdateOne {
+	if s != nil {
+		puo.SetCouponCode(*s)
+	}
+	return puo
+}
+
+// ClearCouponCode clears the value of the "coupon_code" field.
+func (puo *PromotionUpdateOne) ClearCouponCode() *PromotionUpdateOne {
+	puo.mutation.ClearCouponCode()
+	return puo
+}
+
+// SetEffect sets the "effect" field.
+func (puo *PromotionUpdateOne) SetEffect(s string) *PromotionUpdateOne {
+	puo.mutation.SetEffect(s)
+	return puo
+}
+
+// SetPercent sets the "percent" field.
+func (puo *PromotionUpdateOne) SetPercent(f float64) *PromotionUpdateOne {
+	puo.mutation.ResetPercent()
+	puo.mutation.SetPercent(f)
+	return puo
+}
+
+// SetNillablePercent sets the "percent" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillablePercent(f *float64) *PromotionUpdateOne {
+	if f != nil {
+		puo.SetPercent(*f)
+	}
+	return puo
+}
+
+// AddPercent adds f to the "percent" field.
+func (puo *PromotionUpdateOne) AddPercent(f float64) *PromotionUpdateOne {
+	puo.mutation.AddPercent(f)
+	return puo
+}
+
+// SetAmount sets the "amount" field.
+func (puo *PromotionUpdateOne) SetAmount(m money.Amount) *PromotionUpdateOne {
+	puo.mutation.ResetAmount()
+	puo.mutation.SetAmount(m)
+	return puo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableAmount(m *money.Amount) *PromotionUpdateOne {
+	if m != nil {
+		puo.SetAmount(*m)
+	}
+	return puo
+}
+
+// AddAmount adds m to the "amount" field.
+func (puo *PromotionUpdateOne) AddAmount(m money.Amount) *PromotionUpdateOne {
+	puo.mutation.AddAmount(m)
+	return puo
+}
+
+// SetBuyQuantity sets the "buy_quantity" field.
+func (puo *PromotionUpdateOne) SetBuyQuantity(f float64) *PromotionUpdateOne {
+	puo.mutation.ResetBuyQuantity()
+	puo.mutation.SetBuyQuantity(f)
+	return puo
+}
+
+// SetNillableBuyQuantity sets the "buy_quantity" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableBuyQuantity(f *float64) *PromotionUpdateOne {
+	if f != nil {
+		puo.SetBuyQuantity(*f)
+	}
+	return puo
+}
+
+// AddBuyQuantity adds f to the "buy_quantity" field.
+func (puo *PromotionUpdateOne) AddBuyQuantity(f float64) *PromotionUpdateOne {
+	puo.mutation.AddBuyQuantity(f)
+	return puo
+}
+
+// SetFreeQuantity sets the "free_quantity" field.
+func (puo *PromotionUpdateOne) SetFreeQuantity(f float64) *PromotionUpdateOne {
+	puo.mutation.ResetFreeQuantity()
+	puo.mutation.SetFreeQuantity(f)
+	return puo
+}
+
+// SetNillableFreeQuantity sets the "free_quantity" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableFreeQuantity(f *float64) *PromotionUpdateOne {
+	if f != nil {
+		puo.SetFreeQuantity(*f)
+	}
+	return puo
+}
+
+// AddFreeQuantity adds f to the "free_quantity" field.
+func (puo *PromotionUpdateOne) AddFreeQuantity(f float64) *PromotionUpdateOne {
+	puo.mutation.AddFreeQuantity(f)
+	return puo
+}
+
+// SetMinQuantity sets the "min_quantity" field.
+func (puo *PromotionUpdateOne) SetMinQuantity(f float64) *PromotionUpdateOne {
+	puo.mutation.ResetMinQuantity()
+	puo.mutation.SetMinQuantity(f)
+	return puo
+}
+
+// SetNillableMinQuantity sets the "min_quantity" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableMinQuantity(f *float64) *PromotionUpdateOne {
+	if f != nil {
+		puo.SetMinQuantity(*f)
+	}
+	return puo
+}
+
+// AddMinQuantity adds f to the "min_quantity" field.
+func (puo *PromotionUpdateOne) AddMinQuantity(f float64) *PromotionUpdateOne {
+	puo.mutation.AddMinQuantity(f)
+	return puo
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (puo *PromotionUpdateOne) SetMinAmount(m money.Amount) *PromotionUpdateOne {
+	puo.mutation.ResetMinAmount()
+	puo.mutation.SetMinAmount(m)
+	return puo
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableMinAmount(m *money.Amount) *PromotionUpdateOne {
+	if m != nil {
+		puo.SetMinAmount(*m)
+	}
+	return puo
+}
+
+// AddMinAmount adds m to the "min_amount" field.
+func (puo *PromotionUpdateOne) AddMinAmount(m money.Amount) *PromotionUpdateOne {
+	puo.mutation.AddMinAmount(m)
+	return puo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (puo *PromotionUpdateOne) SetStartsAt(t time.Time) *PromotionUpdateOne {
+	puo.mutation.SetStartsAt(t)
+	return puo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableStartsAt(t *time.Time) *PromotionUpdateOne {
+	if t != nil {
+		puo.SetStartsAt(*t)
+	}
+	return puo
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (puo *PromotionUpdateOne) ClearStartsAt() *PromotionUpdateOne {
+	puo.mutation.ClearStartsAt()
+	return puo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (puo *PromotionUpdateOne) SetEndsAt(t time.Time) *PromotionUpdateOne {
+	puo.mutation.SetEndsAt(t)
+	return puo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableEndsAt(t *time.Time) *PromotionUpdateOne {
+	if t != nil {
+		puo.SetEndsAt(*t)
+	}
+	return puo
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (puo *PromotionUpdateOne) ClearEndsAt() *PromotionUpdateOne {
+	puo.mutation.ClearEndsAt()
+	return puo
+}
+
+// SetWeekdays sets the "weekdays" field.
+func (puo *PromotionUpdateOne) SetWeekdays(s string) *PromotionUpdateOne {
+	puo.mutation.SetWeekdays(s)
+	return puo
+}
+
+// SetNillableWeekdays sets the "weekdays" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableWeekdays(s *string) *PromotionUpdateOne {
+	if s != nil {
+		puo.SetWeekdays(*s)
+	}
+	return puo
+}
+
+// ClearWeekdays clears the value of the "weekdays" field.
+func (puo *PromotionUpdateOne) ClearWeekdays() *PromotionUpdateOne {
+	puo.mutation.ClearWeekdays()
+	return puo
+}
+
+// SetStartTime sets the "start_time" field.
+func (puo *PromotionUpdateOne) SetStartTime(s string) *PromotionUpdateOne {
+	puo.mutation.SetStartTime(s)
+	return puo
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableStartTime(s *string) *PromotionUpdateOne {
+	if s != nil {
+		puo.SetStartTime(*s)
+	}
+	return puo
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (puo *PromotionUpdateOne) ClearStartTime() *PromotionUpdateOne {
+	puo.mutation.ClearStartTime()
+	return puo
+}
+
+// SetEndTime sets the "end_time" field.
+func (puo *PromotionUpdateOne) SetEndTime(s string) *PromotionUpdateOne {
+	puo.mutation.SetEndTime(s)
+	return puo
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableEndTime(s *string) *PromotionUpdateOne {
+	if s != nil {
+		puo.SetEndTime(*s)
+	}
+	return puo
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (puo *PromotionUpdateOne) ClearEndTime() *PromotionUpdateOne {
+	puo.mutation.ClearEndTime()
+	return puo
+}
+
+// SetCustomerGroup sets the "customer_group" field.
+func (puo *PromotionUpdateOne) SetCustomerGroup(s string) *PromotionUpdateOne {
+	puo.mutation.SetCustomerGroup(s)
+	return puo
+}
+
+// SetNillableCustomerGroup sets the "customer_group" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableCustomerGroup(s *string) *PromotionUpdateOne {
+	if s != nil {
+		puo.SetCustomerGroup(*s)
+	}
+	return puo
+}
+
+// ClearCustomerGroup clears the value of the "customer_group" field.
+func (puo *PromotionUpdateOne) ClearCustomerGroup() *PromotionUpdateOne {
+	puo.mutation.ClearCustomerGroup()
+	return puo
+}
+
+// SetPriority sets the "priority" field.
+func (puo *PromotionUpdateOne) SetPriority(i int) *PromotionUpdateOne {
+	puo.mutation.ResetPriority()
+	puo.mutation.SetPriority(i)
+	return puo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillablePriority(i *int) *PromotionUpdateOne {
+	if i != nil {
+		puo.SetPriority(*i)
+	}
+	return puo
+}
+
+// AddPriority adds i to the "priority" field.
+func (puo *PromotionUpdateOne) AddPriority(i int) *PromotionUpdateOne {
+	puo.mutation.AddPriority(i)
+	return puo
+}
+
+// SetActive sets the "active" field.
+func (puo *PromotionUpdateOne) SetActive(b bool) *PromotionUpdateOne {
+	puo.mutation.SetActive(b)
+	return puo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (puo *PromotionUpdateOne) SetNillableActive(b *bool) *PromotionUpdateOne {
+	if b != nil {
+		puo.SetActive(*b)
+	}
+	return puo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PromotionUpdateOne) SetUpdatedAt(t time.Time) *PromotionUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// Mutation returns the PromotionMutation object of the builder.
+func (puo *PromotionUpdateOne) Mutation() *PromotionMutation {
+	return puo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PromotionUpdateOne) Select(field string, fields ...string) *PromotionUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Promotion entity.
+func (puo *PromotionUpdateOne) Save(ctx context.Context) (*Promotion, error) {
+	var (
+		err  error
+		node *Promotion
+	)
+	puo.defaults()
+	if len(puo.hooks) == 0 {
+		if err = puo.check(); err != nil {
+			return nil, err
+		}
+		node, err = puo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PromotionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = puo.check(); err != nil {
+				return nil, err
+			}
+			puo.mutation = mutation
+			node, err = puo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(puo.hooks) - 1; i >= 0; i-- {
+			if puo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = puo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, puo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Promotion)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from PromotionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PromotionUpdateOne) SaveX(ctx context.Context) *Promotion {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PromotionUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PromotionUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PromotionUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := promotion.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PromotionUpdateOne) check() error {
+	if v, ok := puo.mutation.Name(); ok {
+		if err := promotion.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Promotion.name": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Percent(); ok {
+		if err := promotion.PercentValidator(v); err != nil {
+			return &ValidationError{Name: "percent", err: fmt.Errorf(`ent: validator failed for field "Promotion.percent": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Amount(); ok {
+		if err := promotion.AmountValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Promotion.amount": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.BuyQuantity(); ok {
+		if err := promotion.BuyQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "buy_quantity", err: fmt.Errorf(`ent: validator failed for field "Promotion.buy_quantity": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.FreeQuantity(); ok {
+		if err := promotion.FreeQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "free_quantity", err: fmt.Errorf(`ent: validator failed for field "Promotion.free_quantity": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.MinQuantity(); ok {
+		if err := promotion.MinQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "min_quantity", err: fmt.Errorf(`ent: validator failed for field "Promotion.min_quantity": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.MinAmount(); ok {
+		if err := promotion.MinAmountValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "min_amount", err: fmt.Errorf(`ent: validator failed for field "Promotion.min_amount": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (puo *PromotionUpdateOne) sqlSave(ctx context.Context) (_node *Promotion, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   promotion.Table,
+			Columns: promotion.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promotion.FieldID,
+			},
+		},
+	}
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Promotion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promotion.FieldID)
+		for _, f := range fields {
+			if !promotion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != promotion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotion.FieldTenantID,
+		})
+	}
+	if value, ok := puo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotion.FieldTenantID,
+		})
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldName,
+		})
+	}
+	if value, ok := puo.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldDescription,
+		})
+	}
+	if puo.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promotion.FieldDescription,
+		})
+	}
+	if value, ok := puo.mutation.CouponCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldCouponCode,
+		})
+	}
+	if puo.mutation.CouponCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promotion.FieldCouponCode,
+		})
+	}
+	if value, ok := puo.mutation.Effect(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldEffect,
+		})
+	}
+	if value, ok := puo.mutation.Percent(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldPercent,
+		})
+	}
+	if value, ok := puo.mutation.AddedPercent(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldPercent,
+		})
+	}
+	if value, ok := puo.mutation.Amount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: promotion.FieldAmount,
+		})
+	}
+	if value, ok := puo.mutation.AddedAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: promotion.FieldAmount,
+		})
+	}
+	if value, ok := puo.mutation.BuyQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldBuyQuantity,
+		})
+	}
+	if value, ok := puo.mutation.AddedBuyQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldBuyQuantity,
+		})
+	}
+	if value, ok := puo.mutation.FreeQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldFreeQuantity,
+		})
+	}
+	if value, ok := puo.mutation.AddedFreeQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldFreeQuantity,
+		})
+	}
+	if value, ok := puo.mutation.MinQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldMinQuantity,
+		})
+	}
+	if value, ok := puo.mutation.AddedMinQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: promotion.FieldMinQuantity,
+		})
+	}
+	if value, ok := puo.mutation.MinAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: promotion.FieldMinAmount,
+		})
+	}
+	if value, ok := puo.mutation.AddedMinAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: promotion.FieldMinAmount,
+		})
+	}
+	if value, ok := puo.mutation.StartsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promotion.FieldStartsAt,
+		})
+	}
+	if puo.mutation.StartsAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: promotion.FieldStartsAt,
+		})
+	}
+	if value, ok := puo.mutation.EndsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promotion.FieldEndsAt,
+		})
+	}
+	if puo.mutation.EndsAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: promotion.FieldEndsAt,
+		})
+	}
+	if value, ok := puo.mutation.Weekdays(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldWeekdays,
+		})
+	}
+	if puo.mutation.WeekdaysCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promotion.FieldWeekdays,
+		})
+	}
+	if value, ok := puo.mutation.StartTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldStartTime,
+		})
+	}
+	if puo.mutation.StartTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promotion.FieldStartTime,
+		})
+	}
+	if value, ok := puo.mutation.EndTime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldEndTime,
+		})
+	}
+	if puo.mutation.EndTimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promotion.FieldEndTime,
+		})
+	}
+	if value, ok := puo.mutation.CustomerGroup(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotion.FieldCustomerGroup,
+		})
+	}
+	if puo.mutation.CustomerGroupCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promotion.FieldCustomerGroup,
+		})
+	}
+	if value, ok := puo.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotion.FieldPriority,
+		})
+	}
+	if value, ok := puo.mutation.AddedPriority(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotion.FieldPriority,
+		})
+	}
+	if value, ok := puo.mutation.Active(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: promotion.FieldActive,
+		})
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promotion.FieldUpdatedAt,
+		})
+	}
+	_node = &Promotion{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promotion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
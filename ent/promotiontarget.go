@@ -0,0 +1,138 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/promotiontarget"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// PromotionTarget is the model entity for the PromotionTarget schema.
+type PromotionTarget struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID de la promoción
+	PromotionID int `json:"promotion_id,omitempty"`
+	// Producto al que aplica la promoción
+	ProductID *int `json:"product_id,omitempty"`
+	// Categoría a la que aplica la promoción, si no se indica un producto
+	Category string `json:"category,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PromotionTarget) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case promotiontarget.FieldID, promotiontarget.FieldPromotionID, promotiontarget.FieldProductID:
+			values[i] = new(sql.NullInt64)
+		case promotiontarget.FieldCategory:
+			values[i] = new(sql.NullString)
+		case promotiontarget.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type PromotionTarget", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PromotionTarget fields.
+func (pt *PromotionTarget) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case promotiontarget.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pt.ID = int(value.Int64)
+		case promotiontarget.FieldPromotionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field promotion_id", values[i])
+			} else if value.Valid {
+				pt.PromotionID = int(value.Int64)
+			}
+		case promotiontarget.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				pt.ProductID = new(int)
+				*pt.ProductID = int(value.Int64)
+			}
+		case promotiontarget.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				pt.Category = value.String
+			}
+		case promotiontarget.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pt.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this PromotionTarget.
+// Note that you need to call PromotionTarget.Unwrap() before calling this method if this PromotionTarget
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pt *PromotionTarget) Update() *PromotionTargetUpdateOne {
+	return (&PromotionTargetClient{config: pt.config}).UpdateOne(pt)
+}
+
+// Unwrap unwraps the PromotionTarget entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pt *PromotionTarget) Unwrap() *PromotionTarget {
+	_tx, ok := pt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PromotionTarget is not a transactional entity")
+	}
+	pt.config.driver = _tx.drv
+	return pt
+}
+
+// String implements the fmt.Stringer.
+func (pt *PromotionTarget) String() string {
+	var builder strings.Builder
+	builder.WriteString("PromotionTarget(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pt.ID))
+	builder.WriteString("promotion_id=")
+	builder.WriteString(fmt.Sprintf("%v", pt.PromotionID))
+	builder.WriteString(", ")
+	if v := pt.ProductID; v != nil {
+		builder.WriteString("product_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(pt.Category)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pt.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PromotionTargets is a parsable slice of PromotionTarget.
+type PromotionTargets []*PromotionTarget
+
+func (pt PromotionTargets) config(cfg config) {
+	for _i := range pt {
+		pt[_i].config = cfg
+	}
+}
@@ -0,0 +1,48 @@
+// Code generated by ent, DO NOT EDIT.
+
+package promotiontarget
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the promotiontarget type in the database.
+	Label = "promotion_target"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPromotionID holds the string denoting the promotion_id field in the database.
+	FieldPromotionID = "promotion_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the promotiontarget in the database.
+	Table = "promotion_targets"
+)
+
+// Columns holds all SQL columns for promotiontarget fields.
+var Columns = []string{
+	FieldID,
+	FieldPromotionID,
+	FieldProductID,
+	FieldCategory,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/promotiontarget"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PromotionTargetCreate is the builder for creating a PromotionTarget entity.
+type PromotionTargetCreate struct {
+	config
+	mutation *PromotionTargetMutation
+	hooks    []Hook
+}
+
+// SetPromotionID sets the "promotion_id" field.
+func (ptc *PromotionTargetCreate) SetPromotionID(i int) *PromotionTargetCreate {
+	ptc.mutation.SetPromotionID(i)
+	return ptc
+}
+
+// SetProductID sets the "product_id" field.
+func (ptc *PromotionTargetCreate) SetProductID(i int) *PromotionTargetCreate {
+	ptc.mutation.SetProductID(i)
+	return ptc
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (ptc *PromotionTargetCreate) SetNillableProductID(i *int) *PromotionTargetCreate {
+	if i != nil {
+		ptc.SetProductID(*i)
+	}
+	return ptc
+}
+
+// SetCategory sets the "category" field.
+func (ptc *PromotionTargetCreate) SetCategory(s string) *PromotionTargetCreate {
+	ptc.mutation.SetCategory(s)
+	return ptc
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (ptc *PromotionTargetCreate) SetNillableCategory(s *string) *PromotionTargetCreate {
+	if s != nil {
+		ptc.SetCategory(*s)
+	}
+	return ptc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ptc *PromotionTargetCreate) SetCreatedAt(t time.Time) *PromotionTargetCreate {
+	ptc.mutation.SetCreatedAt(t)
+	return ptc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ptc *PromotionTargetCreate) SetNillableCreatedAt(t *time.Time) *PromotionTargetCreate {
+	if t != nil {
+		ptc.SetCreatedAt(*t)
+	}
+	return ptc
+}
+
+// Mutation returns the PromotionTargetMutation object of the builder.
+func (ptc *PromotionTargetCreate) Mutation() *PromotionTargetMutation {
+	return ptc.mutation
+}
+
+// Save creates the PromotionTarget in the database.
+func (ptc *PromotionTargetCreate) Save(ctx context.Context) (*PromotionTarget, error) {
+	var (
+		err  error
+		node *PromotionTarget
+	)
+	ptc.defaults()
+	if len(ptc.hooks) == 0 {
+		if err = ptc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ptc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PromotionTargetMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ptc.check(); err != nil {
+				return nil, err
+			}
+			ptc.mutation = mutation
+			if node, err = ptc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ptc.hooks) - 1; i >= 0; i-- {
+			if ptc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ptc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ptc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*PromotionTarget)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from PromotionTargetMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ptc *PromotionTargetCreate) SaveX(ctx context.Context) *PromotionTarget {
+	v, err := ptc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ptc *PromotionTargetCreate) Exec(ctx context.Context) error {
+	_, err := ptc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptc *PromotionTargetCreate) ExecX(ctx context.Context) {
+	if err := ptc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ptc *PromotionTargetCreate) defaults() {
+	if _, ok := ptc.mutation.CreatedAt(); !ok {
+		v := promotiontarget.DefaultCreatedAt()
+		ptc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptc *PromotionTargetCreate) check() error {
+	if _, ok := ptc.mutation.PromotionID(); !ok {
+		return &ValidationError{Name: "promotion_id", err: errors.New(`ent: missing required field "PromotionTarget.promotion_id"`)}
+	}
+	if _, ok := ptc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PromotionTarget.created_at"`)}
+	}
+	return nil
+}
+
+func (ptc *PromotionTargetCreate) sqlSave(ctx context.Context) (*PromotionTarget, error) {
+	_node, _spec := ptc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ptc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ptc *PromotionTargetCreate) createSpec() (*PromotionTarget, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PromotionTarget{config: ptc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: promotiontarget.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promotiontarget.FieldID,
+			},
+		}
+	)
+	if value, ok := ptc.mutation.PromotionID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotiontarget.FieldPromotionID,
+		})
+		_node.PromotionID = value
+	}
+	if value, ok := ptc.mutation.ProductID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotiontarget.FieldProductID,
+		})
+		_node.ProductID = &value
+	}
+	if value, ok := ptc.mutation.Category(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotiontarget.FieldCategory,
+		})
+		_node.Category = value
+	}
+	if value, ok := ptc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: promotiontarget.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// PromotionTargetCreateBulk is the builder for creating many PromotionTarget entities in bulk.
+type PromotionTargetCreateBulk struct {
+	config
+	builders []*PromotionTargetCreate
+}
+
+// Save creates the PromotionTarget entities in the database.
+func (ptcb *PromotionTargetCreateBulk) Save(ctx context.Context) ([]*PromotionTarget, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ptcb.builders))
+	nodes := make([]*PromotionTarget, len(ptcb.builders))
+	mutators := make([]Mutator, len(ptcb.builders))
+	for i := range ptcb.builders {
+		func(i int, root context.Context) {
+			builder := ptcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PromotionTargetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ptcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ptcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ptcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptcb *PromotionTargetCreateBulk) SaveX(ctx context.Context) []*PromotionTarget {
+	v, err := ptcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ptcb *PromotionTargetCreateBulk) Exec(ctx context.Context) error {
+	_, err := ptcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptcb *PromotionTargetCreateBulk) ExecX(ctx context.Context) {
+	if err := ptcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
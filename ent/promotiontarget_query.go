@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/promotiontarget"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PromotionTargetQuery is the builder for querying PromotionTarget entities.
+type PromotionTargetQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.PromotionTarget
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PromotionTargetQuery builder.
+func (ptq *PromotionTargetQuery) Where(ps ...predicate.PromotionTarget) *PromotionTargetQuery {
+	ptq.predicates = append(ptq.predicates, ps...)
+	return ptq
+}
+
+// Limit adds a limit step to the query.
+func (ptq *PromotionTargetQuery) Limit(limit int) *PromotionTargetQuery {
+	ptq.limit = &limit
+	return ptq
+}
+
+// Offset adds an offset step to the query.
+func (ptq *PromotionTargetQuery) Offset(offset int) *PromotionTargetQuery {
+	ptq.offset = &offset
+	return ptq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ptq *PromotionTargetQuery) Unique(unique bool) *PromotionTargetQuery {
+	ptq.unique = &unique
+	return ptq
+}
+
+// Order adds an order step to the query.
+func (ptq *PromotionTargetQuery) Order(o ...OrderFunc) *PromotionTargetQuery {
+	ptq.order = append(ptq.order, o...)
+	return ptq
+}
+
+// First returns the first PromotionTarget entity from the query.
+// Returns a *NotFoundError when no PromotionTarget was found.
+func (ptq *PromotionTargetQuery) First(ctx context.Context) (*PromotionTarget, error) {
+	nodes, err := ptq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{promotiontarget.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ptq *PromotionTargetQuery) FirstX(ctx context.Context) *PromotionTarget {
+	node, err := ptq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PromotionTarget ID from the query.
+// Returns a *NotFoundError when no PromotionTarget ID was found.
+func (ptq *PromotionTargetQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ptq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{promotiontarget.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ptq *PromotionTargetQuery) FirstIDX(ctx context.Context) int {
+	id, err := ptq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PromotionTarget entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PromotionTarget entity is found.
+// Returns a *NotFoundError when no PromotionTarget entities are found.
+func (ptq *PromotionTargetQuery) Only(ctx context.Context) (*PromotionTarget, error) {
+	nodes, err := ptq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{promotiontarget.Label}
+	default:
+		return nil, &NotSingularError{promotiontarget.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ptq *PromotionTargetQuery) OnlyX(ctx context.Context) *PromotionTarget {
+	node, err := ptq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PromotionTarget ID in the query.
+// Returns a *NotSingularError when more than one PromotionTarget ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ptq *PromotionTargetQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ptq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{promotiontarget.Label}
+	default:
+		err = &NotSingularError{promotiontarget.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ptq *PromotionTargetQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ptq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PromotionTargets.
+func (ptq *PromotionTargetQuery) All(ctx context.Context) ([]*PromotionTarget, error) {
+	if err := ptq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ptq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ptq *PromotionTargetQuery) AllX(ctx context.Context) []*PromotionTarget {
+	nodes, err := ptq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PromotionTarget IDs.
+func (ptq *PromotionTargetQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := ptq.Select(promotiontarget.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ptq *PromotionTargetQuery) IDsX(ctx context.Context) []int {
+	ids, err := ptq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ptq *PromotionTargetQuery) Count(ctx context.Context) (int, error) {
+	if err := ptq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ptq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ptq *PromotionTargetQuery) CountX(ctx context.Context) int {
+	count, err := ptq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ptq *PromotionTargetQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ptq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ptq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ptq *PromotionTargetQuery) ExistX(ctx context.Context) bool {
+	exist, err := ptq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PromotionTargetQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ptq *PromotionTargetQuery) Clone() *PromotionTargetQuery {
+	if ptq == nil {
+		return nil
+	}
+	return &PromotionTargetQuery{
+		config:     ptq.config,
+		limit:      ptq.limit,
+		offset:     ptq.offset,
+		order:      append([]OrderFunc{}, ptq.order...),
+		predicates: append([]predicate.PromotionTarget{}, ptq.predicates...),
+		// clone intermediate query.
+		sql:    ptq.sql.Clone(),
+		path:   ptq.path,
+		unique: ptq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PromotionID int `json:"promotion_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PromotionTarget.Query().
+//		GroupBy(promotiontarget.FieldPromotionID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ptq *PromotionTargetQuery) GroupBy(field string, fields ...string) *PromotionTargetGroupBy {
+	grbuild := &PromotionTargetGroupBy{config: ptq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ptq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ptq.sqlQuery(ctx), nil
+	}
+	grbuild.label = promotiontarget.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PromotionID int `json:"promotion_id,omitempty"`
+//	}
+//
+//	client.PromotionTarget.Query().
+//		Select(promotiontarget.FieldPromotionID).
+//		Scan(ctx, &v)
+func (ptq *PromotionTargetQuery) Select(fields ...string) *PromotionTargetSelect {
+	ptq.fields = append(ptq.fields, fields...)
+	selbuild := &PromotionTargetSelect{PromotionTargetQuery: ptq}
+	selbuild.label = promotiontarget.Label
+	selbuild.flds, selbuild.scan = &ptq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (ptq *PromotionTargetQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ptq.fields {
+		if !promotiontarget.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ptq.path != nil {
+		prev, err := ptq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ptq.sql = prev
+	}
+	return nil
+}
+
+func (ptq *PromotionTargetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PromotionTarget, error) {
+	var (
+		nodes = []*PromotionTarget{}
+		_spec = ptq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*PromotionTarget).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &PromotionTarget{config: ptq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(ptq.modifiers) > 0 {
+		_spec.Modifiers = ptq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ptq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ptq *PromotionTargetQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ptq.querySpec()
+	if len(ptq.modifiers) > 0 {
+		_spec.Modifiers = ptq.modifiers
+	}
+	_spec.Node.Columns = ptq.fields
+	if len(ptq.fields) > 0 {
+		_spec.Unique = ptq.unique != nil && *ptq.unique
+	}
+	return sqlgraph.CountNodes(ctx, ptq.driver, _spec)
+}
+
+func (ptq *PromotionTargetQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ptq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ptq *PromotionTargetQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   promotiontarget.Table,
+			Columns: promotiontarget.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promotiontarget.FieldID,
+			},
+		},
+		From:   ptq.sql,
+		Unique: true,
+	}
+	if unique := ptq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ptq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promotiontarget.FieldID)
+		for i := range fields {
+			if fields[i] != promotiontarget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ptq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ptq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ptq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ptq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ptq *PromotionTargetQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ptq.driver.Dialect())
+	t1 := builder.Table(promotiontarget.Table)
+	columns := ptq.fields
+	if len(columns) == 0 {
+		columns = promotiontarget.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ptq.sql != nil {
+		selector = ptq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ptq.unique != nil && *ptq.unique {
+		selector.Distinct()
+	}
+	for _, m := range ptq.modifiers {
+		m(selector)
+	}
+	for _, p := range ptq.predicates {
+		p(selector)
+	}
+	for _, p := range ptq.order {
+		p(selector)
+	}
+	if offset := ptq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ptq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ptq *PromotionTargetQuery) ForUpdate(opts ...sql.LockOption) *PromotionTargetQuery {
+	if ptq.driver.Dialect() == dialect.Postgres {
+		ptq.Unique(false)
+	}
+	ptq.modifiers = append(ptq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ptq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ptq *PromotionTargetQuery) ForShare(opts ...sql.LockOption) *PromotionTargetQuery {
+	if ptq.driver.Dialect() == dialect.Postgres {
+		ptq.Unique(false)
+	}
+	ptq.modifiers = append(ptq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ptq
+}
+
+// PromotionTargetGroupBy is the group-by builder for PromotionTarget entities.
+type PromotionTargetGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ptgb *PromotionTargetGroupBy) Aggregate(fns ...AggregateFunc) *PromotionTargetGroupBy {
+	ptgb.fns = append(ptgb.fns, fns...)
+	return ptgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ptgb *PromotionTargetGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ptgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ptgb.sql = query
+	return ptgb.sqlScan(ctx, v)
+}
+
+func (ptgb *PromotionTargetGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ptgb.fields {
+		if !promotiontarget.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ptgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ptgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ptgb *PromotionTargetGroupBy) sqlQuery() *sql.Selector {
+	selector := ptgb.sql.Select()
+	aggregation := make([]string, 0, len(ptgb.fns))
+	for _, fn := range ptgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(ptgb.fields)+len(ptgb.fns))
+		for _, f := range ptgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(ptgb.fields...)...)
+}
+
+// PromotionTargetSelect is the builder for selecting fields of PromotionTarget entities.
+type PromotionTargetSelect struct {
+	*PromotionTargetQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pts *PromotionTargetSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := pts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	pts.sql = pts.PromotionTargetQuery.sqlQuery(ctx)
+	return pts.sqlScan(ctx, v)
+}
+
+func (pts *PromotionTargetSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := pts.sql.Query()
+	if err := pts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,459 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/promotiontarget"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PromotionTargetUpdate is the builder for updating PromotionTarget entities.
+type PromotionTargetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PromotionTargetMutation
+}
+
+// Where appends a list predicates to the PromotionTargetUpdate builder.
+func (ptu *PromotionTargetUpdate) Where(ps ...predicate.PromotionTarget) *PromotionTargetUpdate {
+	ptu.mutation.Where(ps...)
+	return ptu
+}
+
+// SetPromotionID sets the "promotion_id" field.
+func (ptu *PromotionTargetUpdate) SetPromotionID(i int) *PromotionTargetUpdate {
+	ptu.mutation.ResetPromotionID()
+	ptu.mutation.SetPromotionID(i)
+	return ptu
+}
+
+// AddPromotionID adds i to the "promotion_id" field.
+func (ptu *PromotionTargetUpdate) AddPromotionID(i int) *PromotionTargetUpdate {
+	ptu.mutation.AddPromotionID(i)
+	return ptu
+}
+
+// SetProductID sets the "product_id" field.
+func (ptu *PromotionTargetUpdate) SetProductID(i int) *PromotionTargetUpdate {
+	ptu.mutation.ResetProductID()
+	ptu.mutation.SetProductID(i)
+	return ptu
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (ptu *PromotionTargetUpdate) SetNillableProductID(i *int) *PromotionTargetUpdate {
+	if i != nil {
+		ptu.SetProductID(*i)
+	}
+	return ptu
+}
+
+// AddProductID adds i to the "product_id" field.
+func (ptu *PromotionTargetUpdate) AddProductID(i int) *PromotionTargetUpdate {
+	ptu.mutation.AddProductID(i)
+	return ptu
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (ptu *PromotionTargetUpdate) ClearProductID() *PromotionTargetUpdate {
+	ptu.mutation.ClearProductID()
+	return ptu
+}
+
+// SetCategory sets the "category" field.
+func (ptu *PromotionTargetUpdate) SetCategory(s string) *PromotionTargetUpdate {
+	ptu.mutation.SetCategory(s)
+	return ptu
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (ptu *PromotionTargetUpdate) SetNillableCategory(s *string) *PromotionTargetUpdate {
+	if s != nil {
+		ptu.SetCategory(*s)
+	}
+	return ptu
+}
+
+// ClearCategory clears the value of the "category" field.
+func (ptu *PromotionTargetUpdate) ClearCategory() *PromotionTargetUpdate {
+	ptu.mutation.ClearCategory()
+	return ptu
+}
+
+// Mutation returns the PromotionTargetMutation object of the builder.
+func (ptu *PromotionTargetUpdate) Mutation() *PromotionTargetMutation {
+	return ptu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ptu *PromotionTargetUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ptu.hooks) == 0 {
+		affected, err = ptu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PromotionTargetMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ptu.mutation = mutation
+			affected, err = ptu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ptu.hooks) - 1; i >= 0; i-- {
+			if ptu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ptu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ptu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptu *PromotionTargetUpdate) SaveX(ctx context.Context) int {
+	affected, err := ptu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ptu *PromotionTargetUpdate) Exec(ctx context.Context) error {
+	_, err := ptu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptu *PromotionTargetUpdate) ExecX(ctx context.Context) {
+	if err := ptu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ptu *PromotionTargetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   promotiontarget.Table,
+			Columns: promotiontarget.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promotiontarget.FieldID,
+			},
+		},
+	}
+	if ps := ptu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ptu.mutation.PromotionID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotiontarget.FieldPromotionID,
+		})
+	}
+	if value, ok := ptu.mutation.AddedPromotionID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotiontarget.FieldPromotionID,
+		})
+	}
+	if value, ok := ptu.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotiontarget.FieldProductID,
+		})
+	}
+	if value, ok := ptu.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotiontarget.FieldProductID,
+		})
+	}
+	if ptu.mutation.ProductIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: promotiontarget.FieldProductID,
+		})
+	}
+	if value, ok := ptu.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotiontarget.FieldCategory,
+		})
+	}
+	if ptu.mutation.CategoryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promotiontarget.FieldCategory,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ptu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promotiontarget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PromotionTargetUpdateOne is the builder for updating a single PromotionTarget entity.
+type PromotionTargetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PromotionTargetMutation
+}
+
+// SetPromotionID sets the "promotion_id" field.
+func (ptuo *PromotionTargetUpdateOne) SetPromotionID(i int) *PromotionTargetUpdateOne {
+	ptuo.mutation.ResetPromotionID()
+	ptuo.mutation.SetPromotionID(i)
+	return ptuo
+}
+
+// AddPromotionID adds i to the "promotion_id" field.
+func (ptuo *PromotionTargetUpdateOne) AddPromotionID(i int) *PromotionTargetUpdateOne {
+	ptuo.mutation.AddPromotionID(i)
+	return ptuo
+}
+
+// SetProductID sets the "product_id" field.
+func (ptuo *PromotionTargetUpdateOne) SetProductID(i int) *PromotionTargetUpdateOne {
+	ptuo.mutation.ResetProductID()
+	ptuo.mutation.SetProductID(i)
+	return ptuo
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (ptuo *PromotionTargetUpdateOne) SetNillableProductID(i *int) *PromotionTargetUpdateOne {
+	if i != nil {
+		ptuo.SetProductID(*i)
+	}
+	return ptuo
+}
+
+// AddProductID adds i to the "product_id" field.
+func (ptuo *PromotionTargetUpdateOne) AddProductID(i int) *PromotionTargetUpdateOne {
+	ptuo.mutation.AddProductID(i)
+	return ptuo
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (ptuo *PromotionTargetUpdateOne) ClearProductID() *PromotionTargetUpdateOne {
+	ptuo.mutation.ClearProductID()
+	return ptuo
+}
+
+// SetCategory sets the "category" field.
+func (ptuo *PromotionTargetUpdateOne) SetCategory(s string) *PromotionTargetUpdateOne {
+	ptuo.mutation.SetCategory(s)
+	return ptuo
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (ptuo *PromotionTargetUpdateOne) SetNillableCategory(s *string) *PromotionTargetUpdateOne {
+	if s != nil {
+		ptuo.SetCategory(*s)
+	}
+	return ptuo
+}
+
+// ClearCategory clears the value of the "category" field.
+func (ptuo *PromotionTargetUpdateOne) ClearCategory() *PromotionTargetUpdateOne {
+	ptuo.mutation.ClearCategory()
+	return ptuo
+}
+
+// Mutation returns the PromotionTargetMutation object of the builder.
+func (ptuo *PromotionTargetUpdateOne) Mutation() *PromotionTargetMutation {
+	return ptuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ptuo *PromotionTargetUpdateOne) Select(field string, fields ...string) *PromotionTargetUpdateOne {
+	ptuo.fields = append([]string{field}, fields...)
+	return ptuo
+}
+
+// Save executes the query and returns the updated PromotionTarget entity.
+func (ptuo *PromotionTargetUpdateOne) Save(ctx context.Context) (*PromotionTarget, error) {
+	var (
+		err  error
+		node *PromotionTarget
+	)
+	if len(ptuo.hooks) == 0 {
+		node, err = ptuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PromotionTargetMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ptuo.mutation = mutation
+			node, err = ptuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ptuo.hooks) - 1; i >= 0; i-- {
+			if ptuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ptuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ptuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*PromotionTarget)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from PromotionTargetMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptuo *PromotionTargetUpdateOne) SaveX(ctx context.Context) *PromotionTarget {
+	node, err := ptuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ptuo *PromotionTargetUpdateOne) Exec(ctx context.Context) error {
+	_, err := ptuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptuo *PromotionTargetUpdateOne) ExecX(ctx context.Context) {
+	if err := ptuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ptuo *PromotionTargetUpdateOne) sqlSave(ctx context.Context) (_node *PromotionTarget, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   promotiontarget.Table,
+			Columns: promotiontarget.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: promotiontarget.FieldID,
+			},
+		},
+	}
+	id, ok := ptuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PromotionTarget.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ptuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promotiontarget.FieldID)
+		for _, f := range fields {
+			if !promotiontarget.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != promotiontarget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ptuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ptuo.mutation.PromotionID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotiontarget.FieldPromotionID,
+		})
+	}
+	if value, ok := ptuo.mutation.AddedPromotionID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotiontarget.FieldPromotionID,
+		})
+	}
+	if value, ok := ptuo.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotiontarget.FieldProductID,
+		})
+	}
+	if value, ok := ptuo.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: promotiontarget.FieldProductID,
+		})
+	}
+	if ptuo.mutation.ProductIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: promotiontarget.FieldProductID,
+		})
+	}
+	if value, ok := ptuo.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: promotiontarget.FieldCategory,
+		})
+	}
+	if ptuo.mutation.CategoryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: promotiontarget.FieldCategory,
+		})
+	}
+	_node = &PromotionTarget{config: ptuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ptuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promotiontarget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
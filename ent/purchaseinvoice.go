@@ -5,6 +5,7 @@ package ent
 import (
 	"Veritasbackend/ent/purchaseinvoice"
 	"Veritasbackend/ent/supplier"
+	"Veritasbackend/pkg/money"
 	"fmt"
 	"strings"
 	"time"
@@ -20,15 +21,33 @@ type PurchaseInvoice struct {
 	// Número de factura del proveedor
 	InvoiceNumber string `json:"invoice_number,omitempty"`
 	// Total de la factura
-	Total float64 `json:"total,omitempty"`
-	// Estado de pago (pending, partial, paid, cancelled)
+	Total money.Amount `json:"total,omitempty"`
+	// Suma de las bases gravables, sin impuestos
+	Subtotal money.Amount `json:"subtotal,omitempty"`
+	// Suma de los impuestos
+	TaxTotal money.Amount `json:"tax_total,omitempty"`
+	// Los precios de las líneas incluían impuestos
+	PricesIncludeTax bool `json:"prices_include_tax,omitempty"`
+	// Moneda del documento (código ISO 4217)
+	Currency string `json:"currency,omitempty"`
+	// Unidades de la moneda base por una unidad de la moneda del documento
+	ExchangeRate float64 `json:"exchange_rate,omitempty"`
+	// Subtotal en la moneda base
+	BaseSubtotal money.Amount `json:"base_subtotal,omitempty"`
+	// Impuestos en la moneda base
+	BaseTaxTotal money.Amount `json:"base_tax_total,omitempty"`
+	// Total en la moneda base
+	BaseTotal money.Amount `json:"base_total,omitempty"`
+	// Diferencia en cambio realizada en los pagos, en la moneda base (positiva = ganancia)
+	FxGainLoss money.Amount `json:"fx_gain_loss,omitempty"`
+	// Estado de pago (draft, pending, partial, paid, cancelled)
 	Status string `json:"status,omitempty"`
 	// Método de pago (cash, credit, partial)
 	PaymentMethod string `json:"payment_method,omitempty"`
 	// Fecha de vencimiento
 	DueDate time.Time `json:"due_date,omitempty"`
 	// Monto pagado hasta el momento
-	PaidAmount float64 `json:"paid_amount,omitempty"`
+	PaidAmount money.Amount `json:"paid_amount,omitempty"`
 	// ID del proveedor
 	SupplierID int `json:"supplier_id,omitempty"`
 	// ID del tenant
@@ -72,11 +91,15 @@ func (*PurchaseInvoice) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case purchaseinvoice.FieldTotal, purchaseinvoice.FieldPaidAmount:
+		case purchaseinvoice.FieldTotal, purchaseinvoice.FieldSubtotal, purchaseinvoice.FieldTaxTotal, purchaseinvoice.FieldBaseSubtotal, purchaseinvoice.FieldBaseTaxTotal, purchaseinvoice.FieldBaseTotal, purchaseinvoice.FieldFxGainLoss, purchaseinvoice.FieldPaidAmount:
+			values[i] = new(money.Amount)
+		case purchaseinvoice.FieldPricesIncludeTax:
+			values[i] = new(sql.NullBool)
+		case purchaseinvoice.FieldExchangeRate:
 			values[i] = new(sql.NullFloat64)
 		case purchaseinvoice.FieldID, purchaseinvoice.FieldSupplierID, purchaseinvoice.FieldTenantID, purchaseinvoice.FieldUserID:
 			values[i] = new(sql.NullInt64)
-		case purchaseinvoice.FieldInvoiceNumber, purchaseinvoice.FieldStatus, purchaseinvoice.FieldPaymentMethod:
+		case purchaseinvoice.FieldInvoiceNumber, purchaseinvoice.FieldCurrency, purchaseinvoice.FieldStatus, purchaseinvoice.FieldPaymentMethod:
 			values[i] = new(sql.NullString)
 		case purchaseinvoice.FieldDueDate, purchaseinvoice.FieldCreatedAt, purchaseinvoice.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -108,10 +131,64 @@ func (pi *PurchaseInvoice) assignValues(columns []string, values []interface{})
 				pi.InvoiceNumber = value.String
 			}
 		case purchaseinvoice.FieldTotal:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value != nil {
+				pi.Total = *value
+			}
+		case purchaseinvoice.FieldSubtotal:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field subtotal", values[i])
+			} else if value != nil {
+				pi.Subtotal = *value
+			}
+		case purchaseinvoice.FieldTaxTotal:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_total", values[i])
+			} else if value != nil {
+				pi.TaxTotal = *value
+			}
+		case purchaseinvoice.FieldPricesIncludeTax:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field prices_include_tax", values[i])
+			} else if value.Valid {
+				pi.PricesIncludeTax = value.Bool
+			}
+		case purchaseinvoice.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
 			} else if value.Valid {
-				pi.Total = value.Float64
+				pi.Currency = value.String
+			}
+		case purchaseinvoice.FieldExchangeRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate", values[i])
+			} else if value.Valid {
+				pi.ExchangeRate = value.Float64
+			}
+		case purchaseinvoice.FieldBaseSubtotal:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field base_subtotal", values[i])
+			} else if value != nil {
+				pi.BaseSubtotal = *value
+			}
+		case purchaseinvoice.FieldBaseTaxTotal:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field base_tax_total", values[i])
+			} else if value != nil {
+				pi.BaseTaxTotal = *value
+			}
+		case purchaseinvoice.FieldBaseTotal:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field base_total", values[i])
+			} else if value != nil {
+				pi.BaseTotal = *value
+			}
+		case purchaseinvoice.FieldFxGainLoss:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field fx_gain_loss", values[i])
+			} else if value != nil {
+				pi.FxGainLoss = *value
 			}
 		case purchaseinvoice.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -132,10 +209,10 @@ func (pi *PurchaseInvoice) assignValues(columns []string, values []interface{})
 				pi.DueDate = value.Time
 			}
 		case purchaseinvoice.FieldPaidAmount:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field paid_amount", values[i])
-			} else if value.Valid {
-				pi.PaidAmount = value.Float64
+			} else if value != nil {
+				pi.PaidAmount = *value
 			}
 		case purchaseinvoice.FieldSupplierID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
@@ -206,6 +283,33 @@ func (pi *PurchaseInvoice) String() string {
 	builder.WriteString("total=")
 	builder.WriteString(fmt.Sprintf("%v", pi.Total))
 	builder.WriteString(", ")
+	builder.WriteString("subtotal=")
+	builder.WriteString(fmt.Sprintf("%v", pi.Subtotal))
+	builder.WriteString(", ")
+	builder.WriteString("tax_total=")
+	builder.WriteString(fmt.Sprintf("%v", pi.TaxTotal))
+	builder.WriteString(", ")
+	builder.WriteString("prices_include_tax=")
+	builder.WriteString(fmt.Sprintf("%v", pi.PricesIncludeTax))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(pi.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("exchange_rate=")
+	builder.WriteString(fmt.Sprintf("%v", pi.ExchangeRate))
+	builder.WriteString(", ")
+	builder.WriteString("base_subtotal=")
+	builder.WriteString(fmt.Sprintf("%v", pi.BaseSubtotal))
+	builder.WriteString(", ")
+	builder.WriteString("base_tax_total=")
+	builder.WriteString(fmt.Sprintf("%v", pi.BaseTaxTotal))
+	builder.WriteString(", ")
+	builder.WriteString("base_total=")
+	builder.WriteString(fmt.Sprintf("%v", pi.BaseTotal))
+	builder.WriteString(", ")
+	builder.WriteString("fx_gain_loss=")
+	builder.WriteString(fmt.Sprintf("%v", pi.FxGainLoss))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(pi.Status)
 	builder.WriteString(", ")
@@ -3,6 +3,7 @@
 package purchaseinvoice
 
 import (
+	"Veritasbackend/pkg/money"
 	"time"
 )
 
@@ -15,6 +16,24 @@ const (
 	FieldInvoiceNumber = "invoice_number"
 	// FieldTotal holds the string denoting the total field in the database.
 	FieldTotal = "total"
+	// FieldSubtotal holds the string denoting the subtotal field in the database.
+	FieldSubtotal = "subtotal"
+	// FieldTaxTotal holds the string denoting the tax_total field in the database.
+	FieldTaxTotal = "tax_total"
+	// FieldPricesIncludeTax holds the string denoting the prices_include_tax field in the database.
+	FieldPricesIncludeTax = "prices_include_tax"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldExchangeRate holds the string denoting the exchange_rate field in the database.
+	FieldExchangeRate = "exchange_rate"
+	// FieldBaseSubtotal holds the string denoting the base_subtotal field in the database.
+	FieldBaseSubtotal = "base_subtotal"
+	// FieldBaseTaxTotal holds the string denoting the base_tax_total field in the database.
+	FieldBaseTaxTotal = "base_tax_total"
+	// FieldBaseTotal holds the string denoting the base_total field in the database.
+	FieldBaseTotal = "base_total"
+	// FieldFxGainLoss holds the string denoting the fx_gain_loss field in the database.
+	FieldFxGainLoss = "fx_gain_loss"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldPaymentMethod holds the string denoting the payment_method field in the database.
@@ -51,6 +70,15 @@ var Columns = []string{
 	FieldID,
 	FieldInvoiceNumber,
 	FieldTotal,
+	FieldSubtotal,
+	FieldTaxTotal,
+	FieldPricesIncludeTax,
+	FieldCurrency,
+	FieldExchangeRate,
+	FieldBaseSubtotal,
+	FieldBaseTaxTotal,
+	FieldBaseTotal,
+	FieldFxGainLoss,
 	FieldStatus,
 	FieldPaymentMethod,
 	FieldDueDate,
@@ -76,13 +104,33 @@ var (
 	// InvoiceNumberValidator is a validator for the "invoice_number" field. It is called by the builders before save.
 	InvoiceNumberValidator func(string) error
 	// TotalValidator is a validator for the "total" field. It is called by the builders before save.
-	TotalValidator func(float64) error
+	TotalValidator func(int64) error
+	// DefaultSubtotal holds the default value on creation for the "subtotal" field.
+	DefaultSubtotal money.Amount
+	// DefaultTaxTotal holds the default value on creation for the "tax_total" field.
+	DefaultTaxTotal money.Amount
+	// DefaultPricesIncludeTax holds the default value on creation for the "prices_include_tax" field.
+	DefaultPricesIncludeTax bool
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultExchangeRate holds the default value on creation for the "exchange_rate" field.
+	DefaultExchangeRate float64
+	// ExchangeRateValidator is a validator for the "exchange_rate" field. It is called by the builders before save.
+	ExchangeRateValidator func(float64) error
+	// DefaultBaseSubtotal holds the default value on creation for the "base_subtotal" field.
+	DefaultBaseSubtotal money.Amount
+	// DefaultBaseTaxTotal holds the default value on creation for the "base_tax_total" field.
+	DefaultBaseTaxTotal money.Amount
+	// DefaultBaseTotal holds the default value on creation for the "base_total" field.
+	DefaultBaseTotal money.Amount
+	// DefaultFxGainLoss holds the default value on creation for the "fx_gain_loss" field.
+	DefaultFxGainLoss money.Amount
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
 	// DefaultPaidAmount holds the default value on creation for the "paid_amount" field.
-	DefaultPaidAmount float64
+	DefaultPaidAmount money.Amount
 	// PaidAmountValidator is a validator for the "paid_amount" field. It is called by the builders before save.
-	PaidAmountValidator func(float64) error
+	PaidAmountValidator func(int64) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
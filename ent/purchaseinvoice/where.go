@@ -4,6 +4,7 @@ package purchaseinvoice
 
 import (
 	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -89,12 +90,75 @@ func InvoiceNumber(v string) predicate.PurchaseInvoice {
 }
 
 // Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
-func Total(v float64) predicate.PurchaseInvoice {
+func Total(v money.Amount) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldTotal), v))
 	})
 }
 
+// Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
+func Subtotal(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubtotal), v))
+	})
+}
+
+// TaxTotal applies equality check predicate on the "tax_total" field. It's identical to TaxTotalEQ.
+func TaxTotal(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxTotal), v))
+	})
+}
+
+// PricesIncludeTax applies equality check predicate on the "prices_include_tax" field. It's identical to PricesIncludeTaxEQ.
+func PricesIncludeTax(v bool) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPricesIncludeTax), v))
+	})
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// ExchangeRate applies equality check predicate on the "exchange_rate" field. It's identical to ExchangeRateEQ.
+func ExchangeRate(v float64) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExchangeRate), v))
+	})
+}
+
+// BaseSubtotal applies equality check predicate on the "base_subtotal" field. It's identical to BaseSubtotalEQ.
+func BaseSubtotal(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseSubtotal), v))
+	})
+}
+
+// BaseTaxTotal applies equality check predicate on the "base_tax_total" field. It's identical to BaseTaxTotalEQ.
+func BaseTaxTotal(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseTaxTotal), v))
+	})
+}
+
+// BaseTotal applies equality check predicate on the "base_total" field. It's identical to BaseTotalEQ.
+func BaseTotal(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseTotal), v))
+	})
+}
+
+// FxGainLoss applies equality check predicate on the "fx_gain_loss" field. It's identical to FxGainLossEQ.
+func FxGainLoss(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFxGainLoss), v))
+	})
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v string) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
@@ -117,7 +181,7 @@ func DueDate(v time.Time) predicate.PurchaseInvoice {
 }
 
 // PaidAmount applies equality check predicate on the "paid_amount" field. It's identical to PaidAmountEQ.
-func PaidAmount(v float64) predicate.PurchaseInvoice {
+func PaidAmount(v money.Amount) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldPaidAmount), v))
 	})
@@ -270,21 +334,21 @@ func InvoiceNumberContainsFold(v string) predicate.PurchaseInvoice {
 }
 
 // TotalEQ applies the EQ predicate on the "total" field.
-func TotalEQ(v float64) predicate.PurchaseInvoice {
+func TotalEQ(v money.Amount) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldTotal), v))
 	})
 }
 
 // TotalNEQ applies the NEQ predicate on the "total" field.
-func TotalNEQ(v float64) predicate.PurchaseInvoice {
+func TotalNEQ(v money.Amount) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
 		s.Where(sql.NEQ(s.C(FieldTotal), v))
 	})
 }
 
 // TotalIn applies the In predicate on the "total" field.
-func TotalIn(vs ...float64) predicate.PurchaseInvoice {
+func TotalIn(vs ...money.Amount) predicate.PurchaseInvoice {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -301,7 +365,7 @@ func TotalIn(vs ...float64) predicate.PurchaseInvoice {
 }
 
 // TotalNotIn applies the NotIn predicate on the "total" field.
-func TotalNotIn(vs ...float64) predicate.PurchaseInvoice {
+func TotalNotIn(vs ...money.Amount) predicate.PurchaseInvoice {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -318,33 +382,690 @@ func TotalNotIn(vs ...float64) predicate.PurchaseInvoice {
 }
 
 // TotalGT applies the GT predicate on the "total" field.
-func TotalGT(v float64) predicate.PurchaseInvoice {
+func TotalGT(v money.Amount) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
 		s.Where(sql.GT(s.C(FieldTotal), v))
 	})
 }
 
 // TotalGTE applies the GTE predicate on the "total" field.
-func TotalGTE(v float64) predicate.PurchaseInvoice {
+func TotalGTE(v money.Amount) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
 		s.Where(sql.GTE(s.C(FieldTotal), v))
 	})
 }
 
 // TotalLT applies the LT predicate on the "total" field.
-func TotalLT(v float64) predicate.PurchaseInvoice {
+func TotalLT(v money.Amount) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
 		s.Where(sql.LT(s.C(FieldTotal), v))
 	})
 }
 
 // TotalLTE applies the LTE predicate on the "total" field.
-func TotalLTE(v float64) predicate.PurchaseInvoice {
+func TotalLTE(v money.Amount) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
 		s.Where(sql.LTE(s.C(FieldTotal), v))
 	})
 }
 
+// SubtotalEQ applies the EQ predicate on the "subtotal" field.
+func SubtotalEQ(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubtotal), v))
+	})
+}
+
+// SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
+func SubtotalNEQ(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSubtotal), v))
+	})
+}
+
+// SubtotalIn applies the In predicate on the "subtotal" field.
+func SubtotalIn(vs ...money.Amount) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSubtotal), v...))
+	})
+}
+
+// SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
+func SubtotalNotIn(vs ...money.Amount) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSubtotal), v...))
+	})
+}
+
+// SubtotalGT applies the GT predicate on the "subtotal" field.
+func SubtotalGT(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSubtotal), v))
+	})
+}
+
+// SubtotalGTE applies the GTE predicate on the "subtotal" field.
+func SubtotalGTE(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSubtotal), v))
+	})
+}
+
+// SubtotalLT applies the LT predicate on the "subtotal" field.
+func SubtotalLT(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSubtotal), v))
+	})
+}
+
+// SubtotalLTE applies the LTE predicate on the "subtotal" field.
+func SubtotalLTE(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSubtotal), v))
+	})
+}
+
+// TaxTotalEQ applies the EQ predicate on the "tax_total" field.
+func TaxTotalEQ(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxTotal), v))
+	})
+}
+
+// TaxTotalNEQ applies the NEQ predicate on the "tax_total" field.
+func TaxTotalNEQ(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxTotal), v))
+	})
+}
+
+// TaxTotalIn applies the In predicate on the "tax_total" field.
+func TaxTotalIn(vs ...money.Amount) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxTotal), v...))
+	})
+}
+
+// TaxTotalNotIn applies the NotIn predicate on the "tax_total" field.
+func TaxTotalNotIn(vs ...money.Amount) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxTotal), v...))
+	})
+}
+
+// TaxTotalGT applies the GT predicate on the "tax_total" field.
+func TaxTotalGT(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxTotal), v))
+	})
+}
+
+// TaxTotalGTE applies the GTE predicate on the "tax_total" field.
+func TaxTotalGTE(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxTotal), v))
+	})
+}
+
+// TaxTotalLT applies the LT predicate on the "tax_total" field.
+func TaxTotalLT(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxTotal), v))
+	})
+}
+
+// TaxTotalLTE applies the LTE predicate on the "tax_total" field.
+func TaxTotalLTE(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxTotal), v))
+	})
+}
+
+// PricesIncludeTaxEQ applies the EQ predicate on the "prices_include_tax" field.
+func PricesIncludeTaxEQ(v bool) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPricesIncludeTax), v))
+	})
+}
+
+// PricesIncludeTaxNEQ applies the NEQ predicate on the "prices_include_tax" field.
+func PricesIncludeTaxNEQ(v bool) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPricesIncludeTax), v))
+	})
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCurrency), v))
+	})
+}
+
+// ExchangeRateEQ applies the EQ predicate on the "exchange_rate" field.
+func ExchangeRateEQ(v float64) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExchangeRate), v))
+	})
+}
+
+// ExchangeRateNEQ applies the NEQ predicate on the "exchange_rate" field.
+func ExchangeRateNEQ(v float64) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExchangeRate), v))
+	})
+}
+
+// ExchangeRateIn applies the In predicate on the "exchange_rate" field.
+func ExchangeRateIn(vs ...float64) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExchangeRate), v...))
+	})
+}
+
+// ExchangeRateNotIn applies the NotIn predicate on the "exchange_rate" field.
+func ExchangeRateNotIn(vs ...float64) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExchangeRate), v...))
+	})
+}
+
+// ExchangeRateGT applies the GT predicate on the "exchange_rate" field.
+func ExchangeRateGT(v float64) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExchangeRate), v))
+	})
+}
+
+// ExchangeRateGTE applies the GTE predicate on the "exchange_rate" field.
+func ExchangeRateGTE(v float64) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExchangeRate), v))
+	})
+}
+
+// ExchangeRateLT applies the LT predicate on the "exchange_rate" field.
+func ExchangeRateLT(v float64) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExchangeRate), v))
+	})
+}
+
+// ExchangeRateLTE applies the LTE predicate on the "exchange_rate" field.
+func ExchangeRateLTE(v float64) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExchangeRate), v))
+	})
+}
+
+// BaseSubtotalEQ applies the EQ predicate on the "base_subtotal" field.
+func BaseSubtotalEQ(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseSubtotal), v))
+	})
+}
+
+// BaseSubtotalNEQ applies the NEQ predicate on the "base_subtotal" field.
+func BaseSubtotalNEQ(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBaseSubtotal), v))
+	})
+}
+
+// BaseSubtotalIn applies the In predicate on the "base_subtotal" field.
+func BaseSubtotalIn(vs ...money.Amount) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBaseSubtotal), v...))
+	})
+}
+
+// BaseSubtotalNotIn applies the NotIn predicate on the "base_subtotal" field.
+func BaseSubtotalNotIn(vs ...money.Amount) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBaseSubtotal), v...))
+	})
+}
+
+// BaseSubtotalGT applies the GT predicate on the "base_subtotal" field.
+func BaseSubtotalGT(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBaseSubtotal), v))
+	})
+}
+
+// BaseSubtotalGTE applies the GTE predicate on the "base_subtotal" field.
+func BaseSubtotalGTE(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBaseSubtotal), v))
+	})
+}
+
+// BaseSubtotalLT applies the LT predicate on the "base_subtotal" field.
+func BaseSubtotalLT(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBaseSubtotal), v))
+	})
+}
+
+// BaseSubtotalLTE applies the LTE predicate on the "base_subtotal" field.
+func BaseSubtotalLTE(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBaseSubtotal), v))
+	})
+}
+
+// BaseTaxTotalEQ applies the EQ predicate on the "base_tax_total" field.
+func BaseTaxTotalEQ(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseTaxTotal), v))
+	})
+}
+
+// BaseTaxTotalNEQ applies the NEQ predicate on the "base_tax_total" field.
+func BaseTaxTotalNEQ(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBaseTaxTotal), v))
+	})
+}
+
+// BaseTaxTotalIn applies the In predicate on the "base_tax_total" field.
+func BaseTaxTotalIn(vs ...money.Amount) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBaseTaxTotal), v...))
+	})
+}
+
+// BaseTaxTotalNotIn applies the NotIn predicate on the "base_tax_total" field.
+func BaseTaxTotalNotIn(vs ...money.Amount) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBaseTaxTotal), v...))
+	})
+}
+
+// BaseTaxTotalGT applies the GT predicate on the "base_tax_total" field.
+func BaseTaxTotalGT(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBaseTaxTotal), v))
+	})
+}
+
+// BaseTaxTotalGTE applies the GTE predicate on the "base_tax_total" field.
+func BaseTaxTotalGTE(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBaseTaxTotal), v))
+	})
+}
+
+// BaseTaxTotalLT applies the LT predicate on the "base_tax_total" field.
+func BaseTaxTotalLT(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBaseTaxTotal), v))
+	})
+}
+
+// BaseTaxTotalLTE applies the LTE predicate on the "base_tax_total" field.
+func BaseTaxTotalLTE(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBaseTaxTotal), v))
+	})
+}
+
+// BaseTotalEQ applies the EQ predicate on the "base_total" field.
+func BaseTotalEQ(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseTotal), v))
+	})
+}
+
+// BaseTotalNEQ applies the NEQ predicate on the "base_total" field.
+func BaseTotalNEQ(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBaseTotal), v))
+	})
+}
+
+// BaseTotalIn applies the In predicate on the "base_total" field.
+func BaseTotalIn(vs ...money.Amount) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBaseTotal), v...))
+	})
+}
+
+// BaseTotalNotIn applies the NotIn predicate on the "base_total" field.
+func BaseTotalNotIn(vs ...money.Amount) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBaseTotal), v...))
+	})
+}
+
+// BaseTotalGT applies the GT predicate on the "base_total" field.
+func BaseTotalGT(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBaseTotal), v))
+	})
+}
+
+// BaseTotalGTE applies the GTE predicate on the "base_total" field.
+func BaseTotalGTE(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBaseTotal), v))
+	})
+}
+
+// BaseTotalLT applies the LT predicate on the "base_total" field.
+func BaseTotalLT(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBaseTotal), v))
+	})
+}
+
+// BaseTotalLTE applies the LTE predicate on the "base_total" field.
+func BaseTotalLTE(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBaseTotal), v))
+	})
+}
+
+// FxGainLossEQ applies the EQ predicate on the "fx_gain_loss" field.
+func FxGainLossEQ(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFxGainLoss), v))
+	})
+}
+
+// FxGainLossNEQ applies the NEQ predicate on the "fx_gain_loss" field.
+func FxGainLossNEQ(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFxGainLoss), v))
+	})
+}
+
+// FxGainLossIn applies the In predicate on the "fx_gain_loss" field.
+func FxGainLossIn(vs ...money.Amount) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldFxGainLoss), v...))
+	})
+}
+
+// FxGainLossNotIn applies the NotIn predicate on the "fx_gain_loss" field.
+func FxGainLossNotIn(vs ...money.Amount) predicate.PurchaseInvoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldFxGainLoss), v...))
+	})
+}
+
+// FxGainLossGT applies the GT predicate on the "fx_gain_loss" field.
+func FxGainLossGT(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldFxGainLoss), v))
+	})
+}
+
+// FxGainLossGTE applies the GTE predicate on the "fx_gain_loss" field.
+func FxGainLossGTE(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldFxGainLoss), v))
+	})
+}
+
+// FxGainLossLT applies the LT predicate on the "fx_gain_loss" field.
+func FxGainLossLT(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldFxGainLoss), v))
+	})
+}
+
+// FxGainLossLTE applies the LTE predicate on the "fx_gain_loss" field.
+func FxGainLossLTE(v money.Amount) predicate.PurchaseInvoice {
+	return predicate.PurchaseInvoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldFxGainLoss), v))
+	})
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
@@ -672,21 +1393,21 @@ func DueDateNotNil() predicate.PurchaseInvoice {
 }
 
 // PaidAmountEQ applies the EQ predicate on the "paid_amount" field.
-func PaidAmountEQ(v float64) predicate.PurchaseInvoice {
+func PaidAmountEQ(v money.Amount) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldPaidAmount), v))
 	})
 }
 
 // PaidAmountNEQ applies the NEQ predicate on the "paid_amount" field.
-func PaidAmountNEQ(v float64) predicate.PurchaseInvoice {
+func PaidAmountNEQ(v money.Amount) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
 		s.Where(sql.NEQ(s.C(FieldPaidAmount), v))
 	})
 }
 
 // PaidAmountIn applies the In predicate on the "paid_amount" field.
-func PaidAmountIn(vs ...float64) predicate.PurchaseInvoice {
+func PaidAmountIn(vs ...money.Amount) predicate.PurchaseInvoice {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -703,7 +1424,7 @@ func PaidAmountIn(vs ...float64) predicate.PurchaseInvoice {
 }
 
 // PaidAmountNotIn applies the NotIn predicate on the "paid_amount" field.
-func PaidAmountNotIn(vs ...float64) predicate.PurchaseInvoice {
+func PaidAmountNotIn(vs ...money.Amount) predicate.PurchaseInvoice {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -720,28 +1441,28 @@ func PaidAmountNotIn(vs ...float64) predicate.PurchaseInvoice {
 }
 
 // PaidAmountGT applies the GT predicate on the "paid_amount" field.
-func PaidAmountGT(v float64) predicate.PurchaseInvoice {
+func PaidAmountGT(v money.Amount) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
 		s.Where(sql.GT(s.C(FieldPaidAmount), v))
 	})
 }
 
 // PaidAmountGTE applies the GTE predicate on the "paid_amount" field.
-func PaidAmountGTE(v float64) predicate.PurchaseInvoice {
+func PaidAmountGTE(v money.Amount) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
 		s.Where(sql.GTE(s.C(FieldPaidAmount), v))
 	})
 }
 
 // PaidAmountLT applies the LT predicate on the "paid_amount" field.
-func PaidAmountLT(v float64) predicate.PurchaseInvoice {
+func PaidAmountLT(v money.Amount) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
 		s.Where(sql.LT(s.C(FieldPaidAmount), v))
 	})
 }
 
 // PaidAmountLTE applies the LTE predicate on the "paid_amount" field.
-func PaidAmountLTE(v float64) predicate.PurchaseInvoice {
+func PaidAmountLTE(v money.Amount) predicate.PurchaseInvoice {
 	return predicate.PurchaseInvoice(func(s *sql.Selector) {
 		s.Where(sql.LTE(s.C(FieldPaidAmount), v))
 	})
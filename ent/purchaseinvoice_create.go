@@ -5,6 +5,7 @@ package ent
 import (
 	"Veritasbackend/ent/purchaseinvoice"
 	"Veritasbackend/ent/supplier"
+	"Veritasbackend/pkg/money"
 	"context"
 	"errors"
 	"fmt"
@@ -28,8 +29,134 @@ func (pic *PurchaseInvoiceCreate) SetInvoiceNumber(s string) *PurchaseInvoiceCre
 }
 
 // SetTotal sets the "total" field.
-func (pic *PurchaseInvoiceCreate) SetTotal(f float64) *PurchaseInvoiceCreate {
-	pic.mutation.SetTotal(f)
+func (pic *PurchaseInvoiceCreate) SetTotal(m money.Amount) *PurchaseInvoiceCreate {
+	pic.mutation.SetTotal(m)
+	return pic
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (pic *PurchaseInvoiceCreate) SetSubtotal(m money.Amount) *PurchaseInvoiceCreate {
+	pic.mutation.SetSubtotal(m)
+	return pic
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (pic *PurchaseInvoiceCreate) SetNillableSubtotal(m *money.Amount) *PurchaseInvoiceCreate {
+	if m != nil {
+		pic.SetSubtotal(*m)
+	}
+	return pic
+}
+
+// SetTaxTotal sets the "tax_total" field.
+func (pic *PurchaseInvoiceCreate) SetTaxTotal(m money.Amount) *PurchaseInvoiceCreate {
+	pic.mutation.SetTaxTotal(m)
+	return pic
+}
+
+// SetNillableTaxTotal sets the "tax_total" field if the given value is not nil.
+func (pic *PurchaseInvoiceCreate) SetNillableTaxTotal(m *money.Amount) *PurchaseInvoiceCreate {
+	if m != nil {
+		pic.SetTaxTotal(*m)
+	}
+	return pic
+}
+
+// SetPricesIncludeTax sets the "prices_include_tax" field.
+func (pic *PurchaseInvoiceCreate) SetPricesIncludeTax(b bool) *PurchaseInvoiceCreate {
+	pic.mutation.SetPricesIncludeTax(b)
+	return pic
+}
+
+// SetNillablePricesIncludeTax sets the "prices_include_tax" field if the given value is not nil.
+func (pic *PurchaseInvoiceCreate) SetNillablePricesIncludeTax(b *bool) *PurchaseInvoiceCreate {
+	if b != nil {
+		pic.SetPricesIncludeTax(*b)
+	}
+	return pic
+}
+
+// SetCurrency sets the "currency" field.
+func (pic *PurchaseInvoiceCreate) SetCurrency(s string) *PurchaseInvoiceCreate {
+	pic.mutation.SetCurrency(s)
+	return pic
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (pic *PurchaseInvoiceCreate) SetNillableCurrency(s *string) *PurchaseInvoiceCreate {
+	if s != nil {
+		pic.SetCurrency(*s)
+	}
+	return pic
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (pic *PurchaseInvoiceCreate) SetExchangeRate(f float64) *PurchaseInvoiceCreate {
+	pic.mutation.SetExchangeRate(f)
+	return pic
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (pic *PurchaseInvoiceCreate) SetNillableExchangeRate(f *float64) *PurchaseInvoiceCreate {
+	if f != nil {
+		pic.SetExchangeRate(*f)
+	}
+	return pic
+}
+
+// SetBaseSubtotal sets the "base_subtotal" field.
+func (pic *PurchaseInvoiceCreate) SetBaseSubtotal(m money.Amount) *PurchaseInvoiceCreate {
+	pic.mutation.SetBaseSubtotal(m)
+	return pic
+}
+
+// SetNillableBaseSubtotal sets the "base_subtotal" field if the given value is not nil.
+func (pic *PurchaseInvoiceCreate) SetNillableBaseSubtotal(m *money.Amount) *PurchaseInvoiceCreate {
+	if m != nil {
+		pic.SetBaseSubtotal(*m)
+	}
+	return pic
+}
+
+// SetBaseTaxTotal sets the "base_tax_total" field.
+func (pic *PurchaseInvoiceCreate) SetBaseTaxTotal(m money.Amount) *PurchaseInvoiceCreate {
+	pic.mutation.SetBaseTaxTotal(m)
+	return pic
+}
+
+// SetNillableBaseTaxTotal sets the "base_tax_total" field if the given value is not nil.
+func (pic *PurchaseInvoiceCreate) SetNillableBaseTaxTotal(m *money.Amount) *PurchaseInvoiceCreate {
+	if m != nil {
+		pic.SetBaseTaxTotal(*m)
+	}
+	return pic
+}
+
+// SetBaseTotal sets the "base_total" field.
+func (pic *PurchaseInvoiceCreate) SetBaseTotal(m money.Amount) *PurchaseInvoiceCreate {
+	pic.mutation.SetBaseTotal(m)
+	return pic
+}
+
+// SetNillableBaseTotal sets the "base_total" field if the given value is not nil.
+func (pic *PurchaseInvoiceCreate) SetNillableBaseTotal(m *money.Amount) *PurchaseInvoiceCreate {
+	if m != nil {
+		pic.SetBaseTotal(*m)
+	}
+	return pic
+}
+
+// SetFxGainLoss sets the "fx_gain_loss" field.
+func (pic *PurchaseInvoiceCreate) SetFxGainLoss(m money.Amount) *PurchaseInvoiceCreate {
+	pic.mutation.SetFxGainLoss(m)
+	return pic
+}
+
+// SetNillableFxGainLoss sets the "fx_gain_loss" field if the given value is not nil.
+func (pic *PurchaseInvoiceCreate) SetNillableFxGainLoss(m *money.Amount) *PurchaseInvoiceCreate {
+	if m != nil {
+		pic.SetFxGainLoss(*m)
+	}
 	return pic
 }
 
@@ -76,15 +203,15 @@ func (pic *PurchaseInvoiceCreate) SetNillableDueDate(t *time.Time) *PurchaseInvo
 }
 
 // SetPaidAmount sets the "paid_amount" field.
-func (pic *PurchaseInvoiceCreate) SetPaidAmount(f float64) *PurchaseInvoiceCreate {
-	pic.mutation.SetPaidAmount(f)
+func (pic *PurchaseInvoiceCreate) SetPaidAmount(m money.Amount) *PurchaseInvoiceCreate {
+	pic.mutation.SetPaidAmount(m)
 	return pic
 }
 
 // SetNillablePaidAmount sets the "paid_amount" field if the given value is not nil.
-func (pic *PurchaseInvoiceCreate) SetNillablePaidAmount(f *float64) *PurchaseInvoiceCreate {
-	if f != nil {
-		pic.SetPaidAmount(*f)
+func (pic *PurchaseInvoiceCreate) SetNillablePaidAmount(m *money.Amount) *PurchaseInvoiceCreate {
+	if m != nil {
+		pic.SetPaidAmount(*m)
 	}
 	return pic
 }
@@ -217,6 +344,42 @@ func (pic *PurchaseInvoiceCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (pic *PurchaseInvoiceCreate) defaults() {
+	if _, ok := pic.mutation.Subtotal(); !ok {
+		v := purchaseinvoice.DefaultSubtotal
+		pic.mutation.SetSubtotal(v)
+	}
+	if _, ok := pic.mutation.TaxTotal(); !ok {
+		v := purchaseinvoice.DefaultTaxTotal
+		pic.mutation.SetTaxTotal(v)
+	}
+	if _, ok := pic.mutation.PricesIncludeTax(); !ok {
+		v := purchaseinvoice.DefaultPricesIncludeTax
+		pic.mutation.SetPricesIncludeTax(v)
+	}
+	if _, ok := pic.mutation.Currency(); !ok {
+		v := purchaseinvoice.DefaultCurrency
+		pic.mutation.SetCurrency(v)
+	}
+	if _, ok := pic.mutation.ExchangeRate(); !ok {
+		v := purchaseinvoice.DefaultExchangeRate
+		pic.mutation.SetExchangeRate(v)
+	}
+	if _, ok := pic.mutation.BaseSubtotal(); !ok {
+		v := purchaseinvoice.DefaultBaseSubtotal
+		pic.mutation.SetBaseSubtotal(v)
+	}
+	if _, ok := pic.mutation.BaseTaxTotal(); !ok {
+		v := purchaseinvoice.DefaultBaseTaxTotal
+		pic.mutation.SetBaseTaxTotal(v)
+	}
+	if _, ok := pic.mutation.BaseTotal(); !ok {
+		v := purchaseinvoice.DefaultBaseTotal
+		pic.mutation.SetBaseTotal(v)
+	}
+	if _, ok := pic.mutation.FxGainLoss(); !ok {
+		v := purchaseinvoice.DefaultFxGainLoss
+		pic.mutation.SetFxGainLoss(v)
+	}
 	if _, ok := pic.mutation.Status(); !ok {
 		v := purchaseinvoice.DefaultStatus
 		pic.mutation.SetStatus(v)
@@ -249,10 +412,42 @@ func (pic *PurchaseInvoiceCreate) check() error {
 		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "PurchaseInvoice.total"`)}
 	}
 	if v, ok := pic.mutation.Total(); ok {
-		if err := purchaseinvoice.TotalValidator(v); err != nil {
+		if err := purchaseinvoice.TotalValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "PurchaseInvoice.total": %w`, err)}
 		}
 	}
+	if _, ok := pic.mutation.Subtotal(); !ok {
+		return &ValidationError{Name: "subtotal", err: errors.New(`ent: missing required field "PurchaseInvoice.subtotal"`)}
+	}
+	if _, ok := pic.mutation.TaxTotal(); !ok {
+		return &ValidationError{Name: "tax_total", err: errors.New(`ent: missing required field "PurchaseInvoice.tax_total"`)}
+	}
+	if _, ok := pic.mutation.PricesIncludeTax(); !ok {
+		return &ValidationError{Name: "prices_include_tax", err: errors.New(`ent: missing required field "PurchaseInvoice.prices_include_tax"`)}
+	}
+	if _, ok := pic.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "PurchaseInvoice.currency"`)}
+	}
+	if _, ok := pic.mutation.ExchangeRate(); !ok {
+		return &ValidationError{Name: "exchange_rate", err: errors.New(`ent: missing required field "PurchaseInvoice.exchange_rate"`)}
+	}
+	if v, ok := pic.mutation.ExchangeRate(); ok {
+		if err := purchaseinvoice.ExchangeRateValidator(v); err != nil {
+			return &ValidationError{Name: "exchange_rate", err: fmt.Errorf(`ent: validator failed for field "PurchaseInvoice.exchange_rate": %w`, err)}
+		}
+	}
+	if _, ok := pic.mutation.BaseSubtotal(); !ok {
+		return &ValidationError{Name: "base_subtotal", err: errors.New(`ent: missing required field "PurchaseInvoice.base_subtotal"`)}
+	}
+	if _, ok := pic.mutation.BaseTaxTotal(); !ok {
+		return &ValidationError{Name: "base_tax_total", err: errors.New(`ent: missing required field "PurchaseInvoice.base_tax_total"`)}
+	}
+	if _, ok := pic.mutation.BaseTotal(); !ok {
+		return &ValidationError{Name: "base_total", err: errors.New(`ent: missing required field "PurchaseInvoice.base_total"`)}
+	}
+	if _, ok := pic.mutation.FxGainLoss(); !ok {
+		return &ValidationError{Name: "fx_gain_loss", err: errors.New(`ent: missing required field "PurchaseInvoice.fx_gain_loss"`)}
+	}
 	if _, ok := pic.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PurchaseInvoice.status"`)}
 	}
@@ -260,7 +455,7 @@ func (pic *PurchaseInvoiceCreate) check() error {
 		return &ValidationError{Name: "paid_amount", err: errors.New(`ent: missing required field "PurchaseInvoice.paid_amount"`)}
 	}
 	if v, ok := pic.mutation.PaidAmount(); ok {
-		if err := purchaseinvoice.PaidAmountValidator(v); err != nil {
+		if err := purchaseinvoice.PaidAmountValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "paid_amount", err: fmt.Errorf(`ent: validator failed for field "PurchaseInvoice.paid_amount": %w`, err)}
 		}
 	}
@@ -319,12 +514,84 @@ func (pic *PurchaseInvoiceCreate) createSpec() (*PurchaseInvoice, *sqlgraph.Crea
 	}
 	if value, ok := pic.mutation.Total(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoice.FieldTotal,
 		})
 		_node.Total = value
 	}
+	if value, ok := pic.mutation.Subtotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldSubtotal,
+		})
+		_node.Subtotal = value
+	}
+	if value, ok := pic.mutation.TaxTotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldTaxTotal,
+		})
+		_node.TaxTotal = value
+	}
+	if value, ok := pic.mutation.PricesIncludeTax(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: purchaseinvoice.FieldPricesIncludeTax,
+		})
+		_node.PricesIncludeTax = value
+	}
+	if value, ok := pic.mutation.Currency(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: purchaseinvoice.FieldCurrency,
+		})
+		_node.Currency = value
+	}
+	if value, ok := pic.mutation.ExchangeRate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: purchaseinvoice.FieldExchangeRate,
+		})
+		_node.ExchangeRate = value
+	}
+	if value, ok := pic.mutation.BaseSubtotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldBaseSubtotal,
+		})
+		_node.BaseSubtotal = value
+	}
+	if value, ok := pic.mutation.BaseTaxTotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldBaseTaxTotal,
+		})
+		_node.BaseTaxTotal = value
+	}
+	if value, ok := pic.mutation.BaseTotal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldBaseTotal,
+		})
+		_node.BaseTotal = value
+	}
+	if value, ok := pic.mutation.FxGainLoss(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldFxGainLoss,
+		})
+		_node.FxGainLoss = value
+	}
 	if value, ok := pic.mutation.Status(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -351,7 +618,7 @@ func (pic *PurchaseInvoiceCreate) createSpec() (*PurchaseInvoice, *sqlgraph.Crea
 	}
 	if value, ok := pic.mutation.PaidAmount(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoice.FieldPaidAmount,
 		})
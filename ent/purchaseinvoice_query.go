@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -26,6 +27,7 @@ type PurchaseInvoiceQuery struct {
 	predicates []predicate.PurchaseInvoice
 	// eager-loading edges.
 	withSupplier *SupplierQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -298,7 +300,6 @@ func (piq *PurchaseInvoiceQuery) WithSupplier(opts ...func(*SupplierQuery)) *Pur
 //		GroupBy(purchaseinvoice.FieldInvoiceNumber).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (piq *PurchaseInvoiceQuery) GroupBy(field string, fields ...string) *PurchaseInvoiceGroupBy {
 	grbuild := &PurchaseInvoiceGroupBy{config: piq.config}
 	grbuild.fields = append([]string{field}, fields...)
@@ -325,7 +326,6 @@ func (piq *PurchaseInvoiceQuery) GroupBy(field string, fields ...string) *Purcha
 //	client.PurchaseInvoice.Query().
 //		Select(purchaseinvoice.FieldInvoiceNumber).
 //		Scan(ctx, &v)
-//
 func (piq *PurchaseInvoiceQuery) Select(fields ...string) *PurchaseInvoiceSelect {
 	piq.fields = append(piq.fields, fields...)
 	selbuild := &PurchaseInvoiceSelect{PurchaseInvoiceQuery: piq}
@@ -367,6 +367,9 @@ func (piq *PurchaseInvoiceQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(piq.modifiers) > 0 {
+		_spec.Modifiers = piq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -408,6 +411,9 @@ func (piq *PurchaseInvoiceQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 
 func (piq *PurchaseInvoiceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := piq.querySpec()
+	if len(piq.modifiers) > 0 {
+		_spec.Modifiers = piq.modifiers
+	}
 	_spec.Node.Columns = piq.fields
 	if len(piq.fields) > 0 {
 		_spec.Unique = piq.unique != nil && *piq.unique
@@ -486,6 +492,9 @@ func (piq *PurchaseInvoiceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if piq.unique != nil && *piq.unique {
 		selector.Distinct()
 	}
+	for _, m := range piq.modifiers {
+		m(selector)
+	}
 	for _, p := range piq.predicates {
 		p(selector)
 	}
@@ -503,6 +512,32 @@ func (piq *PurchaseInvoiceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (piq *PurchaseInvoiceQuery) ForUpdate(opts ...sql.LockOption) *PurchaseInvoiceQuery {
+	if piq.driver.Dialect() == dialect.Postgres {
+		piq.Unique(false)
+	}
+	piq.modifiers = append(piq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return piq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (piq *PurchaseInvoiceQuery) ForShare(opts ...sql.LockOption) *PurchaseInvoiceQuery {
+	if piq.driver.Dialect() == dialect.Postgres {
+		piq.Unique(false)
+	}
+	piq.modifiers = append(piq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return piq
+}
+
 // PurchaseInvoiceGroupBy is the group-by builder for PurchaseInvoice entities.
 type PurchaseInvoiceGroupBy struct {
 	config
@@ -6,6 +6,7 @@ import (
 	"Veritasbackend/ent/predicate"
 	"Veritasbackend/ent/purchaseinvoice"
 	"Veritasbackend/ent/supplier"
+	"Veritasbackend/pkg/money"
 	"context"
 	"errors"
 	"fmt"
@@ -36,15 +37,190 @@ func (piu *PurchaseInvoiceUpdate) SetInvoiceNumber(s string) *PurchaseInvoiceUpd
 }
 
 // SetTotal sets the "total" field.
-func (piu *PurchaseInvoiceUpdate) SetTotal(f float64) *PurchaseInvoiceUpdate {
+func (piu *PurchaseInvoiceUpdate) SetTotal(m money.Amount) *PurchaseInvoiceUpdate {
 	piu.mutation.ResetTotal()
-	piu.mutation.SetTotal(f)
+	piu.mutation.SetTotal(m)
 	return piu
 }
 
-// AddTotal adds f to the "total" field.
-func (piu *PurchaseInvoiceUpdate) AddTotal(f float64) *PurchaseInvoiceUpdate {
-	piu.mutation.AddTotal(f)
+// AddTotal adds m to the "total" field.
+func (piu *PurchaseInvoiceUpdate) AddTotal(m money.Amount) *PurchaseInvoiceUpdate {
+	piu.mutation.AddTotal(m)
+	return piu
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (piu *PurchaseInvoiceUpdate) SetSubtotal(m money.Amount) *PurchaseInvoiceUpdate {
+	piu.mutation.ResetSubtotal()
+	piu.mutation.SetSubtotal(m)
+	return piu
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (piu *PurchaseInvoiceUpdate) SetNillableSubtotal(m *money.Amount) *PurchaseInvoiceUpdate {
+	if m != nil {
+		piu.SetSubtotal(*m)
+	}
+	return piu
+}
+
+// AddSubtotal adds m to the "subtotal" field.
+func (piu *PurchaseInvoiceUpdate) AddSubtotal(m money.Amount) *PurchaseInvoiceUpdate {
+	piu.mutation.AddSubtotal(m)
+	return piu
+}
+
+// SetTaxTotal sets the "tax_total" field.
+func (piu *PurchaseInvoiceUpdate) SetTaxTotal(m money.Amount) *PurchaseInvoiceUpdate {
+	piu.mutation.ResetTaxTotal()
+	piu.mutation.SetTaxTotal(m)
+	return piu
+}
+
+// SetNillableTaxTotal sets the "tax_total" field if the given value is not nil.
+func (piu *PurchaseInvoiceUpdate) SetNillableTaxTotal(m *money.Amount) *PurchaseInvoiceUpdate {
+	if m != nil {
+		piu.SetTaxTotal(*m)
+	}
+	return piu
+}
+
+// AddTaxTotal adds m to the "tax_total" field.
+func (piu *PurchaseInvoiceUpdate) AddTaxTotal(m money.Amount) *PurchaseInvoiceUpdate {
+	piu.mutation.AddTaxTotal(m)
+	return piu
+}
+
+// SetPricesIncludeTax sets the "prices_include_tax" field.
+func (piu *PurchaseInvoiceUpdate) SetPricesIncludeTax(b bool) *PurchaseInvoiceUpdate {
+	piu.mutation.SetPricesIncludeTax(b)
+	return piu
+}
+
+// SetNillablePricesIncludeTax sets the "prices_include_tax" field if the given value is not nil.
+func (piu *PurchaseInvoiceUpdate) SetNillablePricesIncludeTax(b *bool) *PurchaseInvoiceUpdate {
+	if b != nil {
+		piu.SetPricesIncludeTax(*b)
+	}
+	return piu
+}
+
+// SetCurrency sets the "currency" field.
+func (piu *PurchaseInvoiceUpdate) SetCurrency(s string) *PurchaseInvoiceUpdate {
+	piu.mutation.SetCurrency(s)
+	return piu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (piu *PurchaseInvoiceUpdate) SetNillableCurrency(s *string) *PurchaseInvoiceUpdate {
+	if s != nil {
+		piu.SetCurrency(*s)
+	}
+	return piu
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (piu *PurchaseInvoiceUpdate) SetExchangeRate(f float64) *PurchaseInvoiceUpdate {
+	piu.mutation.ResetExchangeRate()
+	piu.mutation.SetExchangeRate(f)
+	return piu
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (piu *PurchaseInvoiceUpdate) SetNillableExchangeRate(f *float64) *PurchaseInvoiceUpdate {
+	if f != nil {
+		piu.SetExchangeRate(*f)
+	}
+	return piu
+}
+
+// AddExchangeRate adds f to the "exchange_rate" field.
+func (piu *PurchaseInvoiceUpdate) AddExchangeRate(f float64) *PurchaseInvoiceUpdate {
+	piu.mutation.AddExchangeRate(f)
+	return piu
+}
+
+// SetBaseSubtotal sets the "base_subtotal" field.
+func (piu *PurchaseInvoiceUpdate) SetBaseSubtotal(m money.Amount) *PurchaseInvoiceUpdate {
+	piu.mutation.ResetBaseSubtotal()
+	piu.mutation.SetBaseSubtotal(m)
+	return piu
+}
+
+// SetNillableBaseSubtotal sets the "base_subtotal" field if the given value is not nil.
+func (piu *PurchaseInvoiceUpdate) SetNillableBaseSubtotal(m *money.Amount) *PurchaseInvoiceUpdate {
+	if m != nil {
+		piu.SetBaseSubtotal(*m)
+	}
+	return piu
+}
+
+// AddBaseSubtotal adds m to the "base_subtotal" field.
+func (piu *PurchaseInvoiceUpdate) AddBaseSubtotal(m money.Amount) *PurchaseInvoiceUpdate {
+	piu.mutation.AddBaseSubtotal(m)
+	return piu
+}
+
+// SetBaseTaxTotal sets the "base_tax_total" field.
+func (piu *PurchaseInvoiceUpdate) SetBaseTaxTotal(m money.Amount) *PurchaseInvoiceUpdate {
+	piu.mutation.ResetBaseTaxTotal()
+	piu.mutation.SetBaseTaxTotal(m)
+	return piu
+}
+
+// SetNillableBaseTaxTotal sets the "base_tax_total" field if the given value is not nil.
+func (piu *PurchaseInvoiceUpdate) SetNillableBaseTaxTotal(m *money.Amount) *PurchaseInvoiceUpdate {
+	if m != nil {
+		piu.SetBaseTaxTotal(*m)
+	}
+	return piu
+}
+
+// AddBaseTaxTotal adds m to the "base_tax_total" field.
+func (piu *PurchaseInvoiceUpdate) AddBaseTaxTotal(m money.Amount) *PurchaseInvoiceUpdate {
+	piu.mutation.AddBaseTaxTotal(m)
+	return piu
+}
+
+// SetBaseTotal sets the "base_total" field.
+func (piu *PurchaseInvoiceUpdate) SetBaseTotal(m money.Amount) *PurchaseInvoiceUpdate {
+	piu.mutation.ResetBaseTotal()
+	piu.mutation.SetBaseTotal(m)
+	return piu
+}
+
+// SetNillableBaseTotal sets the "base_total" field if the given value is not nil.
+func (piu *PurchaseInvoiceUpdate) SetNillableBaseTotal(m *money.Amount) *PurchaseInvoiceUpdate {
+	if m != nil {
+		piu.SetBaseTotal(*m)
+	}
+	return piu
+}
+
+// AddBaseTotal adds m to the "base_total" field.
+func (piu *PurchaseInvoiceUpdate) AddBaseTotal(m money.Amount) *PurchaseInvoiceUpdate {
+	piu.mutation.AddBaseTotal(m)
+	return piu
+}
+
+// SetFxGainLoss sets the "fx_gain_loss" field.
+func (piu *PurchaseInvoiceUpdate) SetFxGainLoss(m money.Amount) *PurchaseInvoiceUpdate {
+	piu.mutation.ResetFxGainLoss()
+	piu.mutation.SetFxGainLoss(m)
+	return piu
+}
+
+// SetNillableFxGainLoss sets the "fx_gain_loss" field if the given value is not nil.
+func (piu *PurchaseInvoiceUpdate) SetNillableFxGainLoss(m *money.Amount) *PurchaseInvoiceUpdate {
+	if m != nil {
+		piu.SetFxGainLoss(*m)
+	}
+	return piu
+}
+
+// AddFxGainLoss adds m to the "fx_gain_loss" field.
+func (piu *PurchaseInvoiceUpdate) AddFxGainLoss(m money.Amount) *PurchaseInvoiceUpdate {
+	piu.mutation.AddFxGainLoss(m)
 	return piu
 }
 
@@ -103,23 +279,23 @@ func (piu *PurchaseInvoiceUpdate) ClearDueDate() *PurchaseInvoiceUpdate {
 }
 
 // SetPaidAmount sets the "paid_amount" field.
-func (piu *PurchaseInvoiceUpdate) SetPaidAmount(f float64) *PurchaseInvoiceUpdate {
+func (piu *PurchaseInvoiceUpdate) SetPaidAmount(m money.Amount) *PurchaseInvoiceUpdate {
 	piu.mutation.ResetPaidAmount()
-	piu.mutation.SetPaidAmount(f)
+	piu.mutation.SetPaidAmount(m)
 	return piu
 }
 
 // SetNillablePaidAmount sets the "paid_amount" field if the given value is not nil.
-func (piu *PurchaseInvoiceUpdate) SetNillablePaidAmount(f *float64) *PurchaseInvoiceUpdate {
-	if f != nil {
-		piu.SetPaidAmount(*f)
+func (piu *PurchaseInvoiceUpdate) SetNillablePaidAmount(m *money.Amount) *PurchaseInvoiceUpdate {
+	if m != nil {
+		piu.SetPaidAmount(*m)
 	}
 	return piu
 }
 
-// AddPaidAmount adds f to the "paid_amount" field.
-func (piu *PurchaseInvoiceUpdate) AddPaidAmount(f float64) *PurchaseInvoiceUpdate {
-	piu.mutation.AddPaidAmount(f)
+// AddPaidAmount adds m to the "paid_amount" field.
+func (piu *PurchaseInvoiceUpdate) AddPaidAmount(m money.Amount) *PurchaseInvoiceUpdate {
+	piu.mutation.AddPaidAmount(m)
 	return piu
 }
 
@@ -254,12 +430,17 @@ func (piu *PurchaseInvoiceUpdate) check() error {
 		}
 	}
 	if v, ok := piu.mutation.Total(); ok {
-		if err := purchaseinvoice.TotalValidator(v); err != nil {
+		if err := purchaseinvoice.TotalValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "PurchaseInvoice.total": %w`, err)}
 		}
 	}
+	if v, ok := piu.mutation.ExchangeRate(); ok {
+		if err := purchaseinvoice.ExchangeRateValidator(v); err != nil {
+			return &ValidationError{Name: "exchange_rate", err: fmt.Errorf(`ent: validator failed for field "PurchaseInvoice.exchange_rate": %w`, err)}
+		}
+	}
 	if v, ok := piu.mutation.PaidAmount(); ok {
-		if err := purchaseinvoice.PaidAmountValidator(v); err != nil {
+		if err := purchaseinvoice.PaidAmountValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "paid_amount", err: fmt.Errorf(`ent: validator failed for field "PurchaseInvoice.paid_amount": %w`, err)}
 		}
 	}
@@ -296,18 +477,130 @@ func (piu *PurchaseInvoiceUpdate) sqlSave(ctx context.Context) (n int, err error
 	}
 	if value, ok := piu.mutation.Total(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoice.FieldTotal,
 		})
 	}
 	if value, ok := piu.mutation.AddedTotal(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoice.FieldTotal,
 		})
 	}
+	if value, ok := piu.mutation.Subtotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldSubtotal,
+		})
+	}
+	if value, ok := piu.mutation.AddedSubtotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldSubtotal,
+		})
+	}
+	if value, ok := piu.mutation.TaxTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldTaxTotal,
+		})
+	}
+	if value, ok := piu.mutation.AddedTaxTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldTaxTotal,
+		})
+	}
+	if value, ok := piu.mutation.PricesIncludeTax(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: purchaseinvoice.FieldPricesIncludeTax,
+		})
+	}
+	if value, ok := piu.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: purchaseinvoice.FieldCurrency,
+		})
+	}
+	if value, ok := piu.mutation.ExchangeRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: purchaseinvoice.FieldExchangeRate,
+		})
+	}
+	if value, ok := piu.mutation.AddedExchangeRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: purchaseinvoice.FieldExchangeRate,
+		})
+	}
+	if value, ok := piu.mutation.BaseSubtotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldBaseSubtotal,
+		})
+	}
+	if value, ok := piu.mutation.AddedBaseSubtotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldBaseSubtotal,
+		})
+	}
+	if value, ok := piu.mutation.BaseTaxTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldBaseTaxTotal,
+		})
+	}
+	if value, ok := piu.mutation.AddedBaseTaxTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldBaseTaxTotal,
+		})
+	}
+	if value, ok := piu.mutation.BaseTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldBaseTotal,
+		})
+	}
+	if value, ok := piu.mutation.AddedBaseTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldBaseTotal,
+		})
+	}
+	if value, ok := piu.mutation.FxGainLoss(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldFxGainLoss,
+		})
+	}
+	if value, ok := piu.mutation.AddedFxGainLoss(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldFxGainLoss,
+		})
+	}
 	if value, ok := piu.mutation.Status(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -343,14 +636,14 @@ func (piu *PurchaseInvoiceUpdate) sqlSave(ctx context.Context) (n int, err error
 	}
 	if value, ok := piu.mutation.PaidAmount(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoice.FieldPaidAmount,
 		})
 	}
 	if value, ok := piu.mutation.AddedPaidAmount(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoice.FieldPaidAmount,
 		})
@@ -451,15 +744,190 @@ func (piuo *PurchaseInvoiceUpdateOne) SetInvoiceNumber(s string) *PurchaseInvoic
 }
 
 // SetTotal sets the "total" field.
-func (piuo *PurchaseInvoiceUpdateOne) SetTotal(f float64) *PurchaseInvoiceUpdateOne {
+func (piuo *PurchaseInvoiceUpdateOne) SetTotal(m money.Amount) *PurchaseInvoiceUpdateOne {
 	piuo.mutation.ResetTotal()
-	piuo.mutation.SetTotal(f)
+	piuo.mutation.SetTotal(m)
 	return piuo
 }
 
-// AddTotal adds f to the "total" field.
-func (piuo *PurchaseInvoiceUpdateOne) AddTotal(f float64) *PurchaseInvoiceUpdateOne {
-	piuo.mutation.AddTotal(f)
+// AddTotal adds m to the "total" field.
+func (piuo *PurchaseInvoiceUpdateOne) AddTotal(m money.Amount) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.AddTotal(m)
+	return piuo
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (piuo *PurchaseInvoiceUpdateOne) SetSubtotal(m money.Amount) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.ResetSubtotal()
+	piuo.mutation.SetSubtotal(m)
+	return piuo
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (piuo *PurchaseInvoiceUpdateOne) SetNillableSubtotal(m *money.Amount) *PurchaseInvoiceUpdateOne {
+	if m != nil {
+		piuo.SetSubtotal(*m)
+	}
+	return piuo
+}
+
+// AddSubtotal adds m to the "subtotal" field.
+func (piuo *PurchaseInvoiceUpdateOne) AddSubtotal(m money.Amount) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.AddSubtotal(m)
+	return piuo
+}
+
+// SetTaxTotal sets the "tax_total" field.
+func (piuo *PurchaseInvoiceUpdateOne) SetTaxTotal(m money.Amount) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.ResetTaxTotal()
+	piuo.mutation.SetTaxTotal(m)
+	return piuo
+}
+
+// SetNillableTaxTotal sets the "tax_total" field if the given value is not nil.
+func (piuo *PurchaseInvoiceUpdateOne) SetNillableTaxTotal(m *money.Amount) *PurchaseInvoiceUpdateOne {
+	if m != nil {
+		piuo.SetTaxTotal(*m)
+	}
+	return piuo
+}
+
+// AddTaxTotal adds m to the "tax_total" field.
+func (piuo *PurchaseInvoiceUpdateOne) AddTaxTotal(m money.Amount) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.AddTaxTotal(m)
+	return piuo
+}
+
+// SetPricesIncludeTax sets the "prices_include_tax" field.
+func (piuo *PurchaseInvoiceUpdateOne) SetPricesIncludeTax(b bool) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.SetPricesIncludeTax(b)
+	return piuo
+}
+
+// SetNillablePricesIncludeTax sets the "prices_include_tax" field if the given value is not nil.
+func (piuo *PurchaseInvoiceUpdateOne) SetNillablePricesIncludeTax(b *bool) *PurchaseInvoiceUpdateOne {
+	if b != nil {
+		piuo.SetPricesIncludeTax(*b)
+	}
+	return piuo
+}
+
+// SetCurrency sets the "currency" field.
+func (piuo *PurchaseInvoiceUpdateOne) SetCurrency(s string) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.SetCurrency(s)
+	return piuo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (piuo *PurchaseInvoiceUpdateOne) SetNillableCurrency(s *string) *PurchaseInvoiceUpdateOne {
+	if s != nil {
+		piuo.SetCurrency(*s)
+	}
+	return piuo
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (piuo *PurchaseInvoiceUpdateOne) SetExchangeRate(f float64) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.ResetExchangeRate()
+	piuo.mutation.SetExchangeRate(f)
+	return piuo
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (piuo *PurchaseInvoiceUpdateOne) SetNillableExchangeRate(f *float64) *PurchaseInvoiceUpdateOne {
+	if f != nil {
+		piuo.SetExchangeRate(*f)
+	}
+	return piuo
+}
+
+// AddExchangeRate adds f to the "exchange_rate" field.
+func (piuo *PurchaseInvoiceUpdateOne) AddExchangeRate(f float64) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.AddExchangeRate(f)
+	return piuo
+}
+
+// SetBaseSubtotal sets the "base_subtotal" field.
+func (piuo *PurchaseInvoiceUpdateOne) SetBaseSubtotal(m money.Amount) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.ResetBaseSubtotal()
+	piuo.mutation.SetBaseSubtotal(m)
+	return piuo
+}
+
+// SetNillableBaseSubtotal sets the "base_subtotal" field if the given value is not nil.
+func (piuo *PurchaseInvoiceUpdateOne) SetNillableBaseSubtotal(m *money.Amount) *PurchaseInvoiceUpdateOne {
+	if m != nil {
+		piuo.SetBaseSubtotal(*m)
+	}
+	return piuo
+}
+
+// AddBaseSubtotal adds m to the "base_subtotal" field.
+func (piuo *PurchaseInvoiceUpdateOne) AddBaseSubtotal(m money.Amount) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.AddBaseSubtotal(m)
+	return piuo
+}
+
+// SetBaseTaxTotal sets the "base_tax_total" field.
+func (piuo *PurchaseInvoiceUpdateOne) SetBaseTaxTotal(m money.Amount) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.ResetBaseTaxTotal()
+	piuo.mutation.SetBaseTaxTotal(m)
+	return piuo
+}
+
+// SetNillableBaseTaxTotal sets the "base_tax_total" field if the given value is not nil.
+func (piuo *PurchaseInvoiceUpdateOne) SetNillableBaseTaxTotal(m *money.Amount) *PurchaseInvoiceUpdateOne {
+	if m != nil {
+		piuo.SetBaseTaxTotal(*m)
+	}
+	return piuo
+}
+
+// AddBaseTaxTotal adds m to the "base_tax_total" field.
+func (piuo *PurchaseInvoiceUpdateOne) AddBaseTaxTotal(m money.Amount) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.AddBaseTaxTotal(m)
+	return piuo
+}
+
+// SetBaseTotal sets the "base_total" field.
+func (piuo *PurchaseInvoiceUpdateOne) SetBaseTotal(m money.Amount) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.ResetBaseTotal()
+	piuo.mutation.SetBaseTotal(m)
+	return piuo
+}
+
+// SetNillableBaseTotal sets the "base_total" field if the given value is not nil.
+func (piuo *PurchaseInvoiceUpdateOne) SetNillableBaseTotal(m *money.Amount) *PurchaseInvoiceUpdateOne {
+	if m != nil {
+		piuo.SetBaseTotal(*m)
+	}
+	return piuo
+}
+
+// AddBaseTotal adds m to the "base_total" field.
+func (piuo *PurchaseInvoiceUpdateOne) AddBaseTotal(m money.Amount) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.AddBaseTotal(m)
+	return piuo
+}
+
+// SetFxGainLoss sets the "fx_gain_loss" field.
+func (piuo *PurchaseInvoiceUpdateOne) SetFxGainLoss(m money.Amount) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.ResetFxGainLoss()
+	piuo.mutation.SetFxGainLoss(m)
+	return piuo
+}
+
+// SetNillableFxGainLoss sets the "fx_gain_loss" field if the given value is not nil.
+func (piuo *PurchaseInvoiceUpdateOne) SetNillableFxGainLoss(m *money.Amount) *PurchaseInvoiceUpdateOne {
+	if m != nil {
+		piuo.SetFxGainLoss(*m)
+	}
+	return piuo
+}
+
+// AddFxGainLoss adds m to the "fx_gain_loss" field.
+func (piuo *PurchaseInvoiceUpdateOne) AddFxGainLoss(m money.Amount) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.AddFxGainLoss(m)
 	return piuo
 }
 
@@ -518,23 +986,23 @@ func (piuo *PurchaseInvoiceUpdateOne) ClearDueDate() *PurchaseInvoiceUpdateOne {
 }
 
 // SetPaidAmount sets the "paid_amount" field.
-func (piuo *PurchaseInvoiceUpdateOne) SetPaidAmount(f float64) *PurchaseInvoiceUpdateOne {
+func (piuo *PurchaseInvoiceUpdateOne) SetPaidAmount(m money.Amount) *PurchaseInvoiceUpdateOne {
 	piuo.mutation.ResetPaidAmount()
-	piuo.mutation.SetPaidAmount(f)
+	piuo.mutation.SetPaidAmount(m)
 	return piuo
 }
 
 // SetNillablePaidAmount sets the "paid_amount" field if the given value is not nil.
-func (piuo *PurchaseInvoiceUpdateOne) SetNillablePaidAmount(f *float64) *PurchaseInvoiceUpdateOne {
-	if f != nil {
-		piuo.SetPaidAmount(*f)
+func (piuo *PurchaseInvoiceUpdateOne) SetNillablePaidAmount(m *money.Amount) *PurchaseInvoiceUpdateOne {
+	if m != nil {
+		piuo.SetPaidAmount(*m)
 	}
 	return piuo
 }
 
-// AddPaidAmount adds f to the "paid_amount" field.
-func (piuo *PurchaseInvoiceUpdateOne) AddPaidAmount(f float64) *PurchaseInvoiceUpdateOne {
-	piuo.mutation.AddPaidAmount(f)
+// AddPaidAmount adds m to the "paid_amount" field.
+func (piuo *PurchaseInvoiceUpdateOne) AddPaidAmount(m money.Amount) *PurchaseInvoiceUpdateOne {
+	piuo.mutation.AddPaidAmount(m)
 	return piuo
 }
 
@@ -682,12 +1150,17 @@ func (piuo *PurchaseInvoiceUpdateOne) check() error {
 		}
 	}
 	if v, ok := piuo.mutation.Total(); ok {
-		if err := purchaseinvoice.TotalValidator(v); err != nil {
+		if err := purchaseinvoice.TotalValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "PurchaseInvoice.total": %w`, err)}
 		}
 	}
+	if v, ok := piuo.mutation.ExchangeRate(); ok {
+		if err := purchaseinvoice.ExchangeRateValidator(v); err != nil {
+			return &ValidationError{Name: "exchange_rate", err: fmt.Errorf(`ent: validator failed for field "PurchaseInvoice.exchange_rate": %w`, err)}
+		}
+	}
 	if v, ok := piuo.mutation.PaidAmount(); ok {
-		if err := purchaseinvoice.PaidAmountValidator(v); err != nil {
+		if err := purchaseinvoice.PaidAmountValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "paid_amount", err: fmt.Errorf(`ent: validator failed for field "PurchaseInvoice.paid_amount": %w`, err)}
 		}
 	}
@@ -741,18 +1214,130 @@ func (piuo *PurchaseInvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Purch
 	}
 	if value, ok := piuo.mutation.Total(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoice.FieldTotal,
 		})
 	}
 	if value, ok := piuo.mutation.AddedTotal(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoice.FieldTotal,
 		})
 	}
+	if value, ok := piuo.mutation.Subtotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldSubtotal,
+		})
+	}
+	if value, ok := piuo.mutation.AddedSubtotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldSubtotal,
+		})
+	}
+	if value, ok := piuo.mutation.TaxTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldTaxTotal,
+		})
+	}
+	if value, ok := piuo.mutation.AddedTaxTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldTaxTotal,
+		})
+	}
+	if value, ok := piuo.mutation.PricesIncludeTax(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: purchaseinvoice.FieldPricesIncludeTax,
+		})
+	}
+	if value, ok := piuo.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: purchaseinvoice.FieldCurrency,
+		})
+	}
+	if value, ok := piuo.mutation.ExchangeRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: purchaseinvoice.FieldExchangeRate,
+		})
+	}
+	if value, ok := piuo.mutation.AddedExchangeRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: purchaseinvoice.FieldExchangeRate,
+		})
+	}
+	if value, ok := piuo.mutation.BaseSubtotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldBaseSubtotal,
+		})
+	}
+	if value, ok := piuo.mutation.AddedBaseSubtotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldBaseSubtotal,
+		})
+	}
+	if value, ok := piuo.mutation.BaseTaxTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldBaseTaxTotal,
+		})
+	}
+	if value, ok := piuo.mutation.AddedBaseTaxTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldBaseTaxTotal,
+		})
+	}
+	if value, ok := piuo.mutation.BaseTotal(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldBaseTotal,
+		})
+	}
+	if value, ok := piuo.mutation.AddedBaseTotal(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldBaseTotal,
+		})
+	}
+	if value, ok := piuo.mutation.FxGainLoss(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldFxGainLoss,
+		})
+	}
+	if value, ok := piuo.mutation.AddedFxGainLoss(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoice.FieldFxGainLoss,
+		})
+	}
 	if value, ok := piuo.mutation.Status(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -788,14 +1373,14 @@ func (piuo *PurchaseInvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Purch
 	}
 	if value, ok := piuo.mutation.PaidAmount(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoice.FieldPaidAmount,
 		})
 	}
 	if value, ok := piuo.mutation.AddedPaidAmount(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoice.FieldPaidAmount,
 		})
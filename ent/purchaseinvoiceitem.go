@@ -4,6 +4,7 @@ package ent
 
 import (
 	"Veritasbackend/ent/purchaseinvoiceitem"
+	"Veritasbackend/pkg/money"
 	"fmt"
 	"strings"
 
@@ -19,12 +20,26 @@ type PurchaseInvoiceItem struct {
 	PurchaseInvoiceID int `json:"purchase_invoice_id,omitempty"`
 	// ID del producto comprado
 	ProductID int `json:"product_id,omitempty"`
-	// Cantidad comprada
-	Quantity int `json:"quantity,omitempty"`
-	// Costo unitario al momento de la compra
-	UnitCost float64 `json:"unit_cost,omitempty"`
+	// Cantidad comprada, en la unidad base del producto
+	Quantity float64 `json:"quantity,omitempty"`
+	// Costo por unidad base al momento de la compra
+	UnitCost money.Amount `json:"unit_cost,omitempty"`
 	// Subtotal (quantity × unit_cost)
-	Subtotal float64 `json:"subtotal,omitempty"`
+	Subtotal money.Amount `json:"subtotal,omitempty"`
+	// Unidad de compra cuando no es la unidad base
+	UnitID *int `json:"unit_id,omitempty"`
+	// Nombre de la unidad de compra al momento de la compra
+	UnitName string `json:"unit_name,omitempty"`
+	// Cantidad comprada expresada en la unidad de compra
+	UnitQuantity *float64 `json:"unit_quantity,omitempty"`
+	// Tarifa de impuesto aplicada a la línea
+	TaxRateID *int `json:"tax_rate_id,omitempty"`
+	// Porcentaje de impuesto aplicado a la línea
+	TaxRate float64 `json:"tax_rate,omitempty"`
+	// Base gravable de la línea, sin impuestos
+	TaxBase money.Amount `json:"tax_base,omitempty"`
+	// Impuesto de la línea
+	TaxAmount money.Amount `json:"tax_amount,omitempty"`
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -32,10 +47,14 @@ func (*PurchaseInvoiceItem) scanValues(columns []string) ([]interface{}, error)
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case purchaseinvoiceitem.FieldUnitCost, purchaseinvoiceitem.FieldSubtotal:
+		case purchaseinvoiceitem.FieldUnitCost, purchaseinvoiceitem.FieldSubtotal, purchaseinvoiceitem.FieldTaxBase, purchaseinvoiceitem.FieldTaxAmount:
+			values[i] = new(money.Amount)
+		case purchaseinvoiceitem.FieldQuantity, purchaseinvoiceitem.FieldUnitQuantity, purchaseinvoiceitem.FieldTaxRate:
 			values[i] = new(sql.NullFloat64)
-		case purchaseinvoiceitem.FieldID, purchaseinvoiceitem.FieldPurchaseInvoiceID, purchaseinvoiceitem.FieldProductID, purchaseinvoiceitem.FieldQuantity:
+		case purchaseinvoiceitem.FieldID, purchaseinvoiceitem.FieldPurchaseInvoiceID, purchaseinvoiceitem.FieldProductID, purchaseinvoiceitem.FieldUnitID, purchaseinvoiceitem.FieldTaxRateID:
 			values[i] = new(sql.NullInt64)
+		case purchaseinvoiceitem.FieldUnitName:
+			values[i] = new(sql.NullString)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type PurchaseInvoiceItem", columns[i])
 		}
@@ -70,22 +89,67 @@ func (pii *PurchaseInvoiceItem) assignValues(columns []string, values []interfac
 				pii.ProductID = int(value.Int64)
 			}
 		case purchaseinvoiceitem.FieldQuantity:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field quantity", values[i])
 			} else if value.Valid {
-				pii.Quantity = int(value.Int64)
+				pii.Quantity = value.Float64
 			}
 		case purchaseinvoiceitem.FieldUnitCost:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field unit_cost", values[i])
-			} else if value.Valid {
-				pii.UnitCost = value.Float64
+			} else if value != nil {
+				pii.UnitCost = *value
 			}
 		case purchaseinvoiceitem.FieldSubtotal:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field subtotal", values[i])
+			} else if value != nil {
+				pii.Subtotal = *value
+			}
+		case purchaseinvoiceitem.FieldUnitID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_id", values[i])
+			} else if value.Valid {
+				pii.UnitID = new(int)
+				*pii.UnitID = int(value.Int64)
+			}
+		case purchaseinvoiceitem.FieldUnitName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_name", values[i])
+			} else if value.Valid {
+				pii.UnitName = value.String
+			}
+		case purchaseinvoiceitem.FieldUnitQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_quantity", values[i])
+			} else if value.Valid {
+				pii.UnitQuantity = new(float64)
+				*pii.UnitQuantity = value.Float64
+			}
+		case purchaseinvoiceitem.FieldTaxRateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate_id", values[i])
 			} else if value.Valid {
-				pii.Subtotal = value.Float64
+				pii.TaxRateID = new(int)
+				*pii.TaxRateID = int(value.Int64)
+			}
+		case purchaseinvoiceitem.FieldTaxRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate", values[i])
+			} else if value.Valid {
+				pii.TaxRate = value.Float64
+			}
+		case purchaseinvoiceitem.FieldTaxBase:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_base", values[i])
+			} else if value != nil {
+				pii.TaxBase = *value
+			}
+		case purchaseinvoiceitem.FieldTaxAmount:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_amount", values[i])
+			} else if value != nil {
+				pii.TaxAmount = *value
 			}
 		}
 	}
@@ -129,6 +193,33 @@ func (pii *PurchaseInvoiceItem) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("subtotal=")
 	builder.WriteString(fmt.Sprintf("%v", pii.Subtotal))
+	builder.WriteString(", ")
+	if v := pii.UnitID; v != nil {
+		builder.WriteString("unit_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("unit_name=")
+	builder.WriteString(pii.UnitName)
+	builder.WriteString(", ")
+	if v := pii.UnitQuantity; v != nil {
+		builder.WriteString("unit_quantity=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pii.TaxRateID; v != nil {
+		builder.WriteString("tax_rate_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tax_rate=")
+	builder.WriteString(fmt.Sprintf("%v", pii.TaxRate))
+	builder.WriteString(", ")
+	builder.WriteString("tax_base=")
+	builder.WriteString(fmt.Sprintf("%v", pii.TaxBase))
+	builder.WriteString(", ")
+	builder.WriteString("tax_amount=")
+	builder.WriteString(fmt.Sprintf("%v", pii.TaxAmount))
 	builder.WriteByte(')')
 	return builder.String()
 }
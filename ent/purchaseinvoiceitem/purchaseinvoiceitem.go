@@ -2,6 +2,10 @@
 
 package purchaseinvoiceitem
 
+import (
+	"Veritasbackend/pkg/money"
+)
+
 const (
 	// Label holds the string label denoting the purchaseinvoiceitem type in the database.
 	Label = "purchase_invoice_item"
@@ -17,6 +21,20 @@ const (
 	FieldUnitCost = "unit_cost"
 	// FieldSubtotal holds the string denoting the subtotal field in the database.
 	FieldSubtotal = "subtotal"
+	// FieldUnitID holds the string denoting the unit_id field in the database.
+	FieldUnitID = "unit_id"
+	// FieldUnitName holds the string denoting the unit_name field in the database.
+	FieldUnitName = "unit_name"
+	// FieldUnitQuantity holds the string denoting the unit_quantity field in the database.
+	FieldUnitQuantity = "unit_quantity"
+	// FieldTaxRateID holds the string denoting the tax_rate_id field in the database.
+	FieldTaxRateID = "tax_rate_id"
+	// FieldTaxRate holds the string denoting the tax_rate field in the database.
+	FieldTaxRate = "tax_rate"
+	// FieldTaxBase holds the string denoting the tax_base field in the database.
+	FieldTaxBase = "tax_base"
+	// FieldTaxAmount holds the string denoting the tax_amount field in the database.
+	FieldTaxAmount = "tax_amount"
 	// Table holds the table name of the purchaseinvoiceitem in the database.
 	Table = "purchase_invoice_items"
 )
@@ -29,6 +47,13 @@ var Columns = []string{
 	FieldQuantity,
 	FieldUnitCost,
 	FieldSubtotal,
+	FieldUnitID,
+	FieldUnitName,
+	FieldUnitQuantity,
+	FieldTaxRateID,
+	FieldTaxRate,
+	FieldTaxBase,
+	FieldTaxAmount,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -43,9 +68,15 @@ func ValidColumn(column string) bool {
 
 var (
 	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
-	QuantityValidator func(int) error
+	QuantityValidator func(float64) error
 	// UnitCostValidator is a validator for the "unit_cost" field. It is called by the builders before save.
-	UnitCostValidator func(float64) error
+	UnitCostValidator func(int64) error
 	// SubtotalValidator is a validator for the "subtotal" field. It is called by the builders before save.
-	SubtotalValidator func(float64) error
+	SubtotalValidator func(int64) error
+	// DefaultTaxRate holds the default value on creation for the "tax_rate" field.
+	DefaultTaxRate float64
+	// DefaultTaxBase holds the default value on creation for the "tax_base" field.
+	DefaultTaxBase money.Amount
+	// DefaultTaxAmount holds the default value on creation for the "tax_amount" field.
+	DefaultTaxAmount money.Amount
 )
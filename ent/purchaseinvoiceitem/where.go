@@ -4,6 +4,7 @@ package purchaseinvoiceitem
 
 import (
 	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
 
 	"entgo.io/ent/dialect/sql"
 )
@@ -94,26 +95,75 @@ func ProductID(v int) predicate.PurchaseInvoiceItem {
 }
 
 // Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
-func Quantity(v int) predicate.PurchaseInvoiceItem {
+func Quantity(v float64) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldQuantity), v))
 	})
 }
 
 // UnitCost applies equality check predicate on the "unit_cost" field. It's identical to UnitCostEQ.
-func UnitCost(v float64) predicate.PurchaseInvoiceItem {
+func UnitCost(v money.Amount) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldUnitCost), v))
 	})
 }
 
 // Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
-func Subtotal(v float64) predicate.PurchaseInvoiceItem {
+func Subtotal(v money.Amount) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldSubtotal), v))
 	})
 }
 
+// UnitID applies equality check predicate on the "unit_id" field. It's identical to UnitIDEQ.
+func UnitID(v int) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitName applies equality check predicate on the "unit_name" field. It's identical to UnitNameEQ.
+func UnitName(v string) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitQuantity applies equality check predicate on the "unit_quantity" field. It's identical to UnitQuantityEQ.
+func UnitQuantity(v float64) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitQuantity), v))
+	})
+}
+
+// TaxRateID applies equality check predicate on the "tax_rate_id" field. It's identical to TaxRateIDEQ.
+func TaxRateID(v int) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRate applies equality check predicate on the "tax_rate" field. It's identical to TaxRateEQ.
+func TaxRate(v float64) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxBase applies equality check predicate on the "tax_base" field. It's identical to TaxBaseEQ.
+func TaxBase(v money.Amount) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxBase), v))
+	})
+}
+
+// TaxAmount applies equality check predicate on the "tax_amount" field. It's identical to TaxAmountEQ.
+func TaxAmount(v money.Amount) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxAmount), v))
+	})
+}
+
 // PurchaseInvoiceIDEQ applies the EQ predicate on the "purchase_invoice_id" field.
 func PurchaseInvoiceIDEQ(v int) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
@@ -267,21 +317,21 @@ func ProductIDLTE(v int) predicate.PurchaseInvoiceItem {
 }
 
 // QuantityEQ applies the EQ predicate on the "quantity" field.
-func QuantityEQ(v int) predicate.PurchaseInvoiceItem {
+func QuantityEQ(v float64) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldQuantity), v))
 	})
 }
 
 // QuantityNEQ applies the NEQ predicate on the "quantity" field.
-func QuantityNEQ(v int) predicate.PurchaseInvoiceItem {
+func QuantityNEQ(v float64) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.NEQ(s.C(FieldQuantity), v))
 	})
 }
 
 // QuantityIn applies the In predicate on the "quantity" field.
-func QuantityIn(vs ...int) predicate.PurchaseInvoiceItem {
+func QuantityIn(vs ...float64) predicate.PurchaseInvoiceItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -298,7 +348,7 @@ func QuantityIn(vs ...int) predicate.PurchaseInvoiceItem {
 }
 
 // QuantityNotIn applies the NotIn predicate on the "quantity" field.
-func QuantityNotIn(vs ...int) predicate.PurchaseInvoiceItem {
+func QuantityNotIn(vs ...float64) predicate.PurchaseInvoiceItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -315,49 +365,49 @@ func QuantityNotIn(vs ...int) predicate.PurchaseInvoiceItem {
 }
 
 // QuantityGT applies the GT predicate on the "quantity" field.
-func QuantityGT(v int) predicate.PurchaseInvoiceItem {
+func QuantityGT(v float64) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.GT(s.C(FieldQuantity), v))
 	})
 }
 
 // QuantityGTE applies the GTE predicate on the "quantity" field.
-func QuantityGTE(v int) predicate.PurchaseInvoiceItem {
+func QuantityGTE(v float64) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.GTE(s.C(FieldQuantity), v))
 	})
 }
 
 // QuantityLT applies the LT predicate on the "quantity" field.
-func QuantityLT(v int) predicate.PurchaseInvoiceItem {
+func QuantityLT(v float64) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.LT(s.C(FieldQuantity), v))
 	})
 }
 
 // QuantityLTE applies the LTE predicate on the "quantity" field.
-func QuantityLTE(v int) predicate.PurchaseInvoiceItem {
+func QuantityLTE(v float64) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.LTE(s.C(FieldQuantity), v))
 	})
 }
 
 // UnitCostEQ applies the EQ predicate on the "unit_cost" field.
-func UnitCostEQ(v float64) predicate.PurchaseInvoiceItem {
+func UnitCostEQ(v money.Amount) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldUnitCost), v))
 	})
 }
 
 // UnitCostNEQ applies the NEQ predicate on the "unit_cost" field.
-func UnitCostNEQ(v float64) predicate.PurchaseInvoiceItem {
+func UnitCostNEQ(v money.Amount) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.NEQ(s.C(FieldUnitCost), v))
 	})
 }
 
 // UnitCostIn applies the In predicate on the "unit_cost" field.
-func UnitCostIn(vs ...float64) predicate.PurchaseInvoiceItem {
+func UnitCostIn(vs ...money.Amount) predicate.PurchaseInvoiceItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -374,7 +424,7 @@ func UnitCostIn(vs ...float64) predicate.PurchaseInvoiceItem {
 }
 
 // UnitCostNotIn applies the NotIn predicate on the "unit_cost" field.
-func UnitCostNotIn(vs ...float64) predicate.PurchaseInvoiceItem {
+func UnitCostNotIn(vs ...money.Amount) predicate.PurchaseInvoiceItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -391,49 +441,49 @@ func UnitCostNotIn(vs ...float64) predicate.PurchaseInvoiceItem {
 }
 
 // UnitCostGT applies the GT predicate on the "unit_cost" field.
-func UnitCostGT(v float64) predicate.PurchaseInvoiceItem {
+func UnitCostGT(v money.Amount) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.GT(s.C(FieldUnitCost), v))
 	})
 }
 
 // UnitCostGTE applies the GTE predicate on the "unit_cost" field.
-func UnitCostGTE(v float64) predicate.PurchaseInvoiceItem {
+func UnitCostGTE(v money.Amount) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.GTE(s.C(FieldUnitCost), v))
 	})
 }
 
 // UnitCostLT applies the LT predicate on the "unit_cost" field.
-func UnitCostLT(v float64) predicate.PurchaseInvoiceItem {
+func UnitCostLT(v money.Amount) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.LT(s.C(FieldUnitCost), v))
 	})
 }
 
 // UnitCostLTE applies the LTE predicate on the "unit_cost" field.
-func UnitCostLTE(v float64) predicate.PurchaseInvoiceItem {
+func UnitCostLTE(v money.Amount) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.LTE(s.C(FieldUnitCost), v))
 	})
 }
 
 // SubtotalEQ applies the EQ predicate on the "subtotal" field.
-func SubtotalEQ(v float64) predicate.PurchaseInvoiceItem {
+func SubtotalEQ(v money.Amount) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldSubtotal), v))
 	})
 }
 
 // SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
-func SubtotalNEQ(v float64) predicate.PurchaseInvoiceItem {
+func SubtotalNEQ(v money.Amount) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.NEQ(s.C(FieldSubtotal), v))
 	})
 }
 
 // SubtotalIn applies the In predicate on the "subtotal" field.
-func SubtotalIn(vs ...float64) predicate.PurchaseInvoiceItem {
+func SubtotalIn(vs ...money.Amount) predicate.PurchaseInvoiceItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -450,7 +500,7 @@ func SubtotalIn(vs ...float64) predicate.PurchaseInvoiceItem {
 }
 
 // SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
-func SubtotalNotIn(vs ...float64) predicate.PurchaseInvoiceItem {
+func SubtotalNotIn(vs ...money.Amount) predicate.PurchaseInvoiceItem {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -467,33 +517,656 @@ func SubtotalNotIn(vs ...float64) predicate.PurchaseInvoiceItem {
 }
 
 // SubtotalGT applies the GT predicate on the "subtotal" field.
-func SubtotalGT(v float64) predicate.PurchaseInvoiceItem {
+func SubtotalGT(v money.Amount) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.GT(s.C(FieldSubtotal), v))
 	})
 }
 
 // SubtotalGTE applies the GTE predicate on the "subtotal" field.
-func SubtotalGTE(v float64) predicate.PurchaseInvoiceItem {
+func SubtotalGTE(v money.Amount) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.GTE(s.C(FieldSubtotal), v))
 	})
 }
 
 // SubtotalLT applies the LT predicate on the "subtotal" field.
-func SubtotalLT(v float64) predicate.PurchaseInvoiceItem {
+func SubtotalLT(v money.Amount) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.LT(s.C(FieldSubtotal), v))
 	})
 }
 
 // SubtotalLTE applies the LTE predicate on the "subtotal" field.
-func SubtotalLTE(v float64) predicate.PurchaseInvoiceItem {
+func SubtotalLTE(v money.Amount) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
 		s.Where(sql.LTE(s.C(FieldSubtotal), v))
 	})
 }
 
+// UnitIDEQ applies the EQ predicate on the "unit_id" field.
+func UnitIDEQ(v int) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDNEQ applies the NEQ predicate on the "unit_id" field.
+func UnitIDNEQ(v int) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDIn applies the In predicate on the "unit_id" field.
+func UnitIDIn(vs ...int) predicate.PurchaseInvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUnitID), v...))
+	})
+}
+
+// UnitIDNotIn applies the NotIn predicate on the "unit_id" field.
+func UnitIDNotIn(vs ...int) predicate.PurchaseInvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUnitID), v...))
+	})
+}
+
+// UnitIDGT applies the GT predicate on the "unit_id" field.
+func UnitIDGT(v int) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDGTE applies the GTE predicate on the "unit_id" field.
+func UnitIDGTE(v int) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDLT applies the LT predicate on the "unit_id" field.
+func UnitIDLT(v int) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDLTE applies the LTE predicate on the "unit_id" field.
+func UnitIDLTE(v int) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUnitID), v))
+	})
+}
+
+// UnitIDIsNil applies the IsNil predicate on the "unit_id" field.
+func UnitIDIsNil() predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldUnitID)))
+	})
+}
+
+// UnitIDNotNil applies the NotNil predicate on the "unit_id" field.
+func UnitIDNotNil() predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldUnitID)))
+	})
+}
+
+// UnitNameEQ applies the EQ predicate on the "unit_name" field.
+func UnitNameEQ(v string) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameNEQ applies the NEQ predicate on the "unit_name" field.
+func UnitNameNEQ(v string) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameIn applies the In predicate on the "unit_name" field.
+func UnitNameIn(vs ...string) predicate.PurchaseInvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUnitName), v...))
+	})
+}
+
+// UnitNameNotIn applies the NotIn predicate on the "unit_name" field.
+func UnitNameNotIn(vs ...string) predicate.PurchaseInvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUnitName), v...))
+	})
+}
+
+// UnitNameGT applies the GT predicate on the "unit_name" field.
+func UnitNameGT(v string) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameGTE applies the GTE predicate on the "unit_name" field.
+func UnitNameGTE(v string) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameLT applies the LT predicate on the "unit_name" field.
+func UnitNameLT(v string) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameLTE applies the LTE predicate on the "unit_name" field.
+func UnitNameLTE(v string) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameContains applies the Contains predicate on the "unit_name" field.
+func UnitNameContains(v string) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameHasPrefix applies the HasPrefix predicate on the "unit_name" field.
+func UnitNameHasPrefix(v string) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameHasSuffix applies the HasSuffix predicate on the "unit_name" field.
+func UnitNameHasSuffix(v string) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameIsNil applies the IsNil predicate on the "unit_name" field.
+func UnitNameIsNil() predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldUnitName)))
+	})
+}
+
+// UnitNameNotNil applies the NotNil predicate on the "unit_name" field.
+func UnitNameNotNil() predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldUnitName)))
+	})
+}
+
+// UnitNameEqualFold applies the EqualFold predicate on the "unit_name" field.
+func UnitNameEqualFold(v string) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitNameContainsFold applies the ContainsFold predicate on the "unit_name" field.
+func UnitNameContainsFold(v string) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldUnitName), v))
+	})
+}
+
+// UnitQuantityEQ applies the EQ predicate on the "unit_quantity" field.
+func UnitQuantityEQ(v float64) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitQuantity), v))
+	})
+}
+
+// UnitQuantityNEQ applies the NEQ predicate on the "unit_quantity" field.
+func UnitQuantityNEQ(v float64) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnitQuantity), v))
+	})
+}
+
+// UnitQuantityIn applies the In predicate on the "unit_quantity" field.
+func UnitQuantityIn(vs ...float64) predicate.PurchaseInvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUnitQuantity), v...))
+	})
+}
+
+// UnitQuantityNotIn applies the NotIn predicate on the "unit_quantity" field.
+func UnitQuantityNotIn(vs ...float64) predicate.PurchaseInvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUnitQuantity), v...))
+	})
+}
+
+// UnitQuantityGT applies the GT predicate on the "unit_quantity" field.
+func UnitQuantityGT(v float64) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUnitQuantity), v))
+	})
+}
+
+// UnitQuantityGTE applies the GTE predicate on the "unit_quantity" field.
+func UnitQuantityGTE(v float64) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUnitQuantity), v))
+	})
+}
+
+// UnitQuantityLT applies the LT predicate on the "unit_quantity" field.
+func UnitQuantityLT(v float64) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUnitQuantity), v))
+	})
+}
+
+// UnitQuantityLTE applies the LTE predicate on the "unit_quantity" field.
+func UnitQuantityLTE(v float64) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUnitQuantity), v))
+	})
+}
+
+// UnitQuantityIsNil applies the IsNil predicate on the "unit_quantity" field.
+func UnitQuantityIsNil() predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldUnitQuantity)))
+	})
+}
+
+// UnitQuantityNotNil applies the NotNil predicate on the "unit_quantity" field.
+func UnitQuantityNotNil() predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldUnitQuantity)))
+	})
+}
+
+// TaxRateIDEQ applies the EQ predicate on the "tax_rate_id" field.
+func TaxRateIDEQ(v int) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDNEQ applies the NEQ predicate on the "tax_rate_id" field.
+func TaxRateIDNEQ(v int) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDIn applies the In predicate on the "tax_rate_id" field.
+func TaxRateIDIn(vs ...int) predicate.PurchaseInvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxRateID), v...))
+	})
+}
+
+// TaxRateIDNotIn applies the NotIn predicate on the "tax_rate_id" field.
+func TaxRateIDNotIn(vs ...int) predicate.PurchaseInvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxRateID), v...))
+	})
+}
+
+// TaxRateIDGT applies the GT predicate on the "tax_rate_id" field.
+func TaxRateIDGT(v int) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDGTE applies the GTE predicate on the "tax_rate_id" field.
+func TaxRateIDGTE(v int) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDLT applies the LT predicate on the "tax_rate_id" field.
+func TaxRateIDLT(v int) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDLTE applies the LTE predicate on the "tax_rate_id" field.
+func TaxRateIDLTE(v int) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxRateID), v))
+	})
+}
+
+// TaxRateIDIsNil applies the IsNil predicate on the "tax_rate_id" field.
+func TaxRateIDIsNil() predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTaxRateID)))
+	})
+}
+
+// TaxRateIDNotNil applies the NotNil predicate on the "tax_rate_id" field.
+func TaxRateIDNotNil() predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTaxRateID)))
+	})
+}
+
+// TaxRateEQ applies the EQ predicate on the "tax_rate" field.
+func TaxRateEQ(v float64) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxRateNEQ applies the NEQ predicate on the "tax_rate" field.
+func TaxRateNEQ(v float64) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxRateIn applies the In predicate on the "tax_rate" field.
+func TaxRateIn(vs ...float64) predicate.PurchaseInvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxRate), v...))
+	})
+}
+
+// TaxRateNotIn applies the NotIn predicate on the "tax_rate" field.
+func TaxRateNotIn(vs ...float64) predicate.PurchaseInvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxRate), v...))
+	})
+}
+
+// TaxRateGT applies the GT predicate on the "tax_rate" field.
+func TaxRateGT(v float64) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxRateGTE applies the GTE predicate on the "tax_rate" field.
+func TaxRateGTE(v float64) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxRateLT applies the LT predicate on the "tax_rate" field.
+func TaxRateLT(v float64) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxRateLTE applies the LTE predicate on the "tax_rate" field.
+func TaxRateLTE(v float64) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxRate), v))
+	})
+}
+
+// TaxBaseEQ applies the EQ predicate on the "tax_base" field.
+func TaxBaseEQ(v money.Amount) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxBase), v))
+	})
+}
+
+// TaxBaseNEQ applies the NEQ predicate on the "tax_base" field.
+func TaxBaseNEQ(v money.Amount) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxBase), v))
+	})
+}
+
+// TaxBaseIn applies the In predicate on the "tax_base" field.
+func TaxBaseIn(vs ...money.Amount) predicate.PurchaseInvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxBase), v...))
+	})
+}
+
+// TaxBaseNotIn applies the NotIn predicate on the "tax_base" field.
+func TaxBaseNotIn(vs ...money.Amount) predicate.PurchaseInvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxBase), v...))
+	})
+}
+
+// TaxBaseGT applies the GT predicate on the "tax_base" field.
+func TaxBaseGT(v money.Amount) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxBase), v))
+	})
+}
+
+// TaxBaseGTE applies the GTE predicate on the "tax_base" field.
+func TaxBaseGTE(v money.Amount) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxBase), v))
+	})
+}
+
+// TaxBaseLT applies the LT predicate on the "tax_base" field.
+func TaxBaseLT(v money.Amount) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxBase), v))
+	})
+}
+
+// TaxBaseLTE applies the LTE predicate on the "tax_base" field.
+func TaxBaseLTE(v money.Amount) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxBase), v))
+	})
+}
+
+// TaxAmountEQ applies the EQ predicate on the "tax_amount" field.
+func TaxAmountEQ(v money.Amount) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxAmount), v))
+	})
+}
+
+// TaxAmountNEQ applies the NEQ predicate on the "tax_amount" field.
+func TaxAmountNEQ(v money.Amount) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxAmount), v))
+	})
+}
+
+// TaxAmountIn applies the In predicate on the "tax_amount" field.
+func TaxAmountIn(vs ...money.Amount) predicate.PurchaseInvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxAmount), v...))
+	})
+}
+
+// TaxAmountNotIn applies the NotIn predicate on the "tax_amount" field.
+func TaxAmountNotIn(vs ...money.Amount) predicate.PurchaseInvoiceItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxAmount), v...))
+	})
+}
+
+// TaxAmountGT applies the GT predicate on the "tax_amount" field.
+func TaxAmountGT(v money.Amount) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxAmount), v))
+	})
+}
+
+// TaxAmountGTE applies the GTE predicate on the "tax_amount" field.
+func TaxAmountGTE(v money.Amount) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxAmount), v))
+	})
+}
+
+// TaxAmountLT applies the LT predicate on the "tax_amount" field.
+func TaxAmountLT(v money.Amount) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxAmount), v))
+	})
+}
+
+// TaxAmountLTE applies the LTE predicate on the "tax_amount" field.
+func TaxAmountLTE(v money.Amount) predicate.PurchaseInvoiceItem {
+	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxAmount), v))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.PurchaseInvoiceItem) predicate.PurchaseInvoiceItem {
 	return predicate.PurchaseInvoiceItem(func(s *sql.Selector) {
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"Veritasbackend/ent/purchaseinvoiceitem"
+	"Veritasbackend/pkg/money"
 	"context"
 	"errors"
 	"fmt"
@@ -32,20 +33,118 @@ func (piic *PurchaseInvoiceItemCreate) SetProductID(i int) *PurchaseInvoiceItemC
 }
 
 // SetQuantity sets the "quantity" field.
-func (piic *PurchaseInvoiceItemCreate) SetQuantity(i int) *PurchaseInvoiceItemCreate {
-	piic.mutation.SetQuantity(i)
+func (piic *PurchaseInvoiceItemCreate) SetQuantity(f float64) *PurchaseInvoiceItemCreate {
+	piic.mutation.SetQuantity(f)
 	return piic
 }
 
 // SetUnitCost sets the "unit_cost" field.
-func (piic *PurchaseInvoiceItemCreate) SetUnitCost(f float64) *PurchaseInvoiceItemCreate {
-	piic.mutation.SetUnitCost(f)
+func (piic *PurchaseInvoiceItemCreate) SetUnitCost(m money.Amount) *PurchaseInvoiceItemCreate {
+	piic.mutation.SetUnitCost(m)
 	return piic
 }
 
 // SetSubtotal sets the "subtotal" field.
-func (piic *PurchaseInvoiceItemCreate) SetSubtotal(f float64) *PurchaseInvoiceItemCreate {
-	piic.mutation.SetSubtotal(f)
+func (piic *PurchaseInvoiceItemCreate) SetSubtotal(m money.Amount) *PurchaseInvoiceItemCreate {
+	piic.mutation.SetSubtotal(m)
+	return piic
+}
+
+// SetUnitID sets the "unit_id" field.
+func (piic *PurchaseInvoiceItemCreate) SetUnitID(i int) *PurchaseInvoiceItemCreate {
+	piic.mutation.SetUnitID(i)
+	return piic
+}
+
+// SetNillableUnitID sets the "unit_id" field if the given value is not nil.
+func (piic *PurchaseInvoiceItemCreate) SetNillableUnitID(i *int) *PurchaseInvoiceItemCreate {
+	if i != nil {
+		piic.SetUnitID(*i)
+	}
+	return piic
+}
+
+// SetUnitName sets the "unit_name" field.
+func (piic *PurchaseInvoiceItemCreate) SetUnitName(s string) *PurchaseInvoiceItemCreate {
+	piic.mutation.SetUnitName(s)
+	return piic
+}
+
+// SetNillableUnitName sets the "unit_name" field if the given value is not nil.
+func (piic *PurchaseInvoiceItemCreate) SetNillableUnitName(s *string) *PurchaseInvoiceItemCreate {
+	if s != nil {
+		piic.SetUnitName(*s)
+	}
+	return piic
+}
+
+// SetUnitQuantity sets the "unit_quantity" field.
+func (piic *PurchaseInvoiceItemCreate) SetUnitQuantity(f float64) *PurchaseInvoiceItemCreate {
+	piic.mutation.SetUnitQuantity(f)
+	return piic
+}
+
+// SetNillableUnitQuantity sets the "unit_quantity" field if the given value is not nil.
+func (piic *PurchaseInvoiceItemCreate) SetNillableUnitQuantity(f *float64) *PurchaseInvoiceItemCreate {
+	if f != nil {
+		piic.SetUnitQuantity(*f)
+	}
+	return piic
+}
+
+// SetTaxRateID sets the "tax_rate_id" field.
+func (piic *PurchaseInvoiceItemCreate) SetTaxRateID(i int) *PurchaseInvoiceItemCreate {
+	piic.mutation.SetTaxRateID(i)
+	return piic
+}
+
+// SetNillableTaxRateID sets the "tax_rate_id" field if the given value is not nil.
+func (piic *PurchaseInvoiceItemCreate) SetNillableTaxRateID(i *int) *PurchaseInvoiceItemCreate {
+	if i != nil {
+		piic.SetTaxRateID(*i)
+	}
+	return piic
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (piic *PurchaseInvoiceItemCreate) SetTaxRate(f float64) *PurchaseInvoiceItemCreate {
+	piic.mutation.SetTaxRate(f)
+	return piic
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (piic *PurchaseInvoiceItemCreate) SetNillableTaxRate(f *float64) *PurchaseInvoiceItemCreate {
+	if f != nil {
+		piic.SetTaxRate(*f)
+	}
+	return piic
+}
+
+// SetTaxBase sets the "tax_base" field.
+func (piic *PurchaseInvoiceItemCreate) SetTaxBase(m money.Amount) *PurchaseInvoiceItemCreate {
+	piic.mutation.SetTaxBase(m)
+	return piic
+}
+
+// SetNillableTaxBase sets the "tax_base" field if the given value is not nil.
+func (piic *PurchaseInvoiceItemCreate) SetNillableTaxBase(m *money.Amount) *PurchaseInvoiceItemCreate {
+	if m != nil {
+		piic.SetTaxBase(*m)
+	}
+	return piic
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (piic *PurchaseInvoiceItemCreate) SetTaxAmount(m money.Amount) *PurchaseInvoiceItemCreate {
+	piic.mutation.SetTaxAmount(m)
+	return piic
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (piic *PurchaseInvoiceItemCreate) SetNillableTaxAmount(m *money.Amount) *PurchaseInvoiceItemCreate {
+	if m != nil {
+		piic.SetTaxAmount(*m)
+	}
 	return piic
 }
 
@@ -60,6 +159,7 @@ func (piic *PurchaseInvoiceItemCreate) Save(ctx context.Context) (*PurchaseInvoi
 		err  error
 		node *PurchaseInvoiceItem
 	)
+	piic.defaults()
 	if len(piic.hooks) == 0 {
 		if err = piic.check(); err != nil {
 			return nil, err
@@ -123,6 +223,22 @@ func (piic *PurchaseInvoiceItemCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (piic *PurchaseInvoiceItemCreate) defaults() {
+	if _, ok := piic.mutation.TaxRate(); !ok {
+		v := purchaseinvoiceitem.DefaultTaxRate
+		piic.mutation.SetTaxRate(v)
+	}
+	if _, ok := piic.mutation.TaxBase(); !ok {
+		v := purchaseinvoiceitem.DefaultTaxBase
+		piic.mutation.SetTaxBase(v)
+	}
+	if _, ok := piic.mutation.TaxAmount(); !ok {
+		v := purchaseinvoiceitem.DefaultTaxAmount
+		piic.mutation.SetTaxAmount(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (piic *PurchaseInvoiceItemCreate) check() error {
 	if _, ok := piic.mutation.PurchaseInvoiceID(); !ok {
@@ -143,7 +259,7 @@ func (piic *PurchaseInvoiceItemCreate) check() error {
 		return &ValidationError{Name: "unit_cost", err: errors.New(`ent: missing required field "PurchaseInvoiceItem.unit_cost"`)}
 	}
 	if v, ok := piic.mutation.UnitCost(); ok {
-		if err := purchaseinvoiceitem.UnitCostValidator(v); err != nil {
+		if err := purchaseinvoiceitem.UnitCostValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "PurchaseInvoiceItem.unit_cost": %w`, err)}
 		}
 	}
@@ -151,10 +267,19 @@ func (piic *PurchaseInvoiceItemCreate) check() error {
 		return &ValidationError{Name: "subtotal", err: errors.New(`ent: missing required field "PurchaseInvoiceItem.subtotal"`)}
 	}
 	if v, ok := piic.mutation.Subtotal(); ok {
-		if err := purchaseinvoiceitem.SubtotalValidator(v); err != nil {
+		if err := purchaseinvoiceitem.SubtotalValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "subtotal", err: fmt.Errorf(`ent: validator failed for field "PurchaseInvoiceItem.subtotal": %w`, err)}
 		}
 	}
+	if _, ok := piic.mutation.TaxRate(); !ok {
+		return &ValidationError{Name: "tax_rate", err: errors.New(`ent: missing required field "PurchaseInvoiceItem.tax_rate"`)}
+	}
+	if _, ok := piic.mutation.TaxBase(); !ok {
+		return &ValidationError{Name: "tax_base", err: errors.New(`ent: missing required field "PurchaseInvoiceItem.tax_base"`)}
+	}
+	if _, ok := piic.mutation.TaxAmount(); !ok {
+		return &ValidationError{Name: "tax_amount", err: errors.New(`ent: missing required field "PurchaseInvoiceItem.tax_amount"`)}
+	}
 	return nil
 }
 
@@ -200,7 +325,7 @@ func (piic *PurchaseInvoiceItemCreate) createSpec() (*PurchaseInvoiceItem, *sqlg
 	}
 	if value, ok := piic.mutation.Quantity(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeFloat64,
 			Value:  value,
 			Column: purchaseinvoiceitem.FieldQuantity,
 		})
@@ -208,7 +333,7 @@ func (piic *PurchaseInvoiceItemCreate) createSpec() (*PurchaseInvoiceItem, *sqlg
 	}
 	if value, ok := piic.mutation.UnitCost(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoiceitem.FieldUnitCost,
 		})
@@ -216,12 +341,68 @@ func (piic *PurchaseInvoiceItemCreate) createSpec() (*PurchaseInvoiceItem, *sqlg
 	}
 	if value, ok := piic.mutation.Subtotal(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoiceitem.FieldSubtotal,
 		})
 		_node.Subtotal = value
 	}
+	if value, ok := piic.mutation.UnitID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldUnitID,
+		})
+		_node.UnitID = &value
+	}
+	if value, ok := piic.mutation.UnitName(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldUnitName,
+		})
+		_node.UnitName = value
+	}
+	if value, ok := piic.mutation.UnitQuantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldUnitQuantity,
+		})
+		_node.UnitQuantity = &value
+	}
+	if value, ok := piic.mutation.TaxRateID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxRateID,
+		})
+		_node.TaxRateID = &value
+	}
+	if value, ok := piic.mutation.TaxRate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxRate,
+		})
+		_node.TaxRate = value
+	}
+	if value, ok := piic.mutation.TaxBase(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxBase,
+		})
+		_node.TaxBase = value
+	}
+	if value, ok := piic.mutation.TaxAmount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxAmount,
+		})
+		_node.TaxAmount = value
+	}
 	return _node, _spec
 }
 
@@ -239,6 +420,7 @@ func (piicb *PurchaseInvoiceItemCreateBulk) Save(ctx context.Context) ([]*Purcha
 	for i := range piicb.builders {
 		func(i int, root context.Context) {
 			builder := piicb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*PurchaseInvoiceItemMutation)
 				if !ok {
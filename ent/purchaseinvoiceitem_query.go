@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -23,6 +24,7 @@ type PurchaseInvoiceItemQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.PurchaseInvoiceItem
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -261,7 +263,6 @@ func (piiq *PurchaseInvoiceItemQuery) Clone() *PurchaseInvoiceItemQuery {
 //		GroupBy(purchaseinvoiceitem.FieldPurchaseInvoiceID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (piiq *PurchaseInvoiceItemQuery) GroupBy(field string, fields ...string) *PurchaseInvoiceItemGroupBy {
 	grbuild := &PurchaseInvoiceItemGroupBy{config: piiq.config}
 	grbuild.fields = append([]string{field}, fields...)
@@ -288,7 +289,6 @@ func (piiq *PurchaseInvoiceItemQuery) GroupBy(field string, fields ...string) *P
 //	client.PurchaseInvoiceItem.Query().
 //		Select(purchaseinvoiceitem.FieldPurchaseInvoiceID).
 //		Scan(ctx, &v)
-//
 func (piiq *PurchaseInvoiceItemQuery) Select(fields ...string) *PurchaseInvoiceItemSelect {
 	piiq.fields = append(piiq.fields, fields...)
 	selbuild := &PurchaseInvoiceItemSelect{PurchaseInvoiceItemQuery: piiq}
@@ -326,6 +326,9 @@ func (piiq *PurchaseInvoiceItemQuery) sqlAll(ctx context.Context, hooks ...query
 		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
+	if len(piiq.modifiers) > 0 {
+		_spec.Modifiers = piiq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -340,6 +343,9 @@ func (piiq *PurchaseInvoiceItemQuery) sqlAll(ctx context.Context, hooks ...query
 
 func (piiq *PurchaseInvoiceItemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := piiq.querySpec()
+	if len(piiq.modifiers) > 0 {
+		_spec.Modifiers = piiq.modifiers
+	}
 	_spec.Node.Columns = piiq.fields
 	if len(piiq.fields) > 0 {
 		_spec.Unique = piiq.unique != nil && *piiq.unique
@@ -418,6 +424,9 @@ func (piiq *PurchaseInvoiceItemQuery) sqlQuery(ctx context.Context) *sql.Selecto
 	if piiq.unique != nil && *piiq.unique {
 		selector.Distinct()
 	}
+	for _, m := range piiq.modifiers {
+		m(selector)
+	}
 	for _, p := range piiq.predicates {
 		p(selector)
 	}
@@ -435,6 +444,32 @@ func (piiq *PurchaseInvoiceItemQuery) sqlQuery(ctx context.Context) *sql.Selecto
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (piiq *PurchaseInvoiceItemQuery) ForUpdate(opts ...sql.LockOption) *PurchaseInvoiceItemQuery {
+	if piiq.driver.Dialect() == dialect.Postgres {
+		piiq.Unique(false)
+	}
+	piiq.modifiers = append(piiq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return piiq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (piiq *PurchaseInvoiceItemQuery) ForShare(opts ...sql.LockOption) *PurchaseInvoiceItemQuery {
+	if piiq.driver.Dialect() == dialect.Postgres {
+		piiq.Unique(false)
+	}
+	piiq.modifiers = append(piiq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return piiq
+}
+
 // PurchaseInvoiceItemGroupBy is the group-by builder for PurchaseInvoiceItem entities.
 type PurchaseInvoiceItemGroupBy struct {
 	config
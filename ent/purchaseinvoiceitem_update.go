@@ -5,6 +5,7 @@ package ent
 import (
 	"Veritasbackend/ent/predicate"
 	"Veritasbackend/ent/purchaseinvoiceitem"
+	"Veritasbackend/pkg/money"
 	"context"
 	"errors"
 	"fmt"
@@ -54,41 +55,205 @@ func (piiu *PurchaseInvoiceItemUpdate) AddProductID(i int) *PurchaseInvoiceItemU
 }
 
 // SetQuantity sets the "quantity" field.
-func (piiu *PurchaseInvoiceItemUpdate) SetQuantity(i int) *PurchaseInvoiceItemUpdate {
+func (piiu *PurchaseInvoiceItemUpdate) SetQuantity(f float64) *PurchaseInvoiceItemUpdate {
 	piiu.mutation.ResetQuantity()
-	piiu.mutation.SetQuantity(i)
+	piiu.mutation.SetQuantity(f)
 	return piiu
 }
 
-// AddQuantity adds i to the "quantity" field.
-func (piiu *PurchaseInvoiceItemUpdate) AddQuantity(i int) *PurchaseInvoiceItemUpdate {
-	piiu.mutation.AddQuantity(i)
+// AddQuantity adds f to the "quantity" field.
+func (piiu *PurchaseInvoiceItemUpdate) AddQuantity(f float64) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.AddQuantity(f)
 	return piiu
 }
 
 // SetUnitCost sets the "unit_cost" field.
-func (piiu *PurchaseInvoiceItemUpdate) SetUnitCost(f float64) *PurchaseInvoiceItemUpdate {
+func (piiu *PurchaseInvoiceItemUpdate) SetUnitCost(m money.Amount) *PurchaseInvoiceItemUpdate {
 	piiu.mutation.ResetUnitCost()
-	piiu.mutation.SetUnitCost(f)
+	piiu.mutation.SetUnitCost(m)
 	return piiu
 }
 
-// AddUnitCost adds f to the "unit_cost" field.
-func (piiu *PurchaseInvoiceItemUpdate) AddUnitCost(f float64) *PurchaseInvoiceItemUpdate {
-	piiu.mutation.AddUnitCost(f)
+// AddUnitCost adds m to the "unit_cost" field.
+func (piiu *PurchaseInvoiceItemUpdate) AddUnitCost(m money.Amount) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.AddUnitCost(m)
 	return piiu
 }
 
 // SetSubtotal sets the "subtotal" field.
-func (piiu *PurchaseInvoiceItemUpdate) SetSubtotal(f float64) *PurchaseInvoiceItemUpdate {
+func (piiu *PurchaseInvoiceItemUpdate) SetSubtotal(m money.Amount) *PurchaseInvoiceItemUpdate {
 	piiu.mutation.ResetSubtotal()
-	piiu.mutation.SetSubtotal(f)
+	piiu.mutation.SetSubtotal(m)
 	return piiu
 }
 
-// AddSubtotal adds f to the "subtotal" field.
-func (piiu *PurchaseInvoiceItemUpdate) AddSubtotal(f float64) *PurchaseInvoiceItemUpdate {
-	piiu.mutation.AddSubtotal(f)
+// AddSubtotal adds m to the "subtotal" field.
+func (piiu *PurchaseInvoiceItemUpdate) AddSubtotal(m money.Amount) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.AddSubtotal(m)
+	return piiu
+}
+
+// SetUnitID sets the "unit_id" field.
+func (piiu *PurchaseInvoiceItemUpdate) SetUnitID(i int) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.ResetUnitID()
+	piiu.mutation.SetUnitID(i)
+	return piiu
+}
+
+// SetNillableUnitID sets the "unit_id" field if the given value is not nil.
+func (piiu *PurchaseInvoiceItemUpdate) SetNillableUnitID(i *int) *PurchaseInvoiceItemUpdate {
+	if i != nil {
+		piiu.SetUnitID(*i)
+	}
+	return piiu
+}
+
+// AddUnitID adds i to the "unit_id" field.
+func (piiu *PurchaseInvoiceItemUpdate) AddUnitID(i int) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.AddUnitID(i)
+	return piiu
+}
+
+// ClearUnitID clears the value of the "unit_id" field.
+func (piiu *PurchaseInvoiceItemUpdate) ClearUnitID() *PurchaseInvoiceItemUpdate {
+	piiu.mutation.ClearUnitID()
+	return piiu
+}
+
+// SetUnitName sets the "unit_name" field.
+func (piiu *PurchaseInvoiceItemUpdate) SetUnitName(s string) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.SetUnitName(s)
+	return piiu
+}
+
+// SetNillableUnitName sets the "unit_name" field if the given value is not nil.
+func (piiu *PurchaseInvoiceItemUpdate) SetNillableUnitName(s *string) *PurchaseInvoiceItemUpdate {
+	if s != nil {
+		piiu.SetUnitName(*s)
+	}
+	return piiu
+}
+
+// ClearUnitName clears the value of the "unit_name" field.
+func (piiu *PurchaseInvoiceItemUpdate) ClearUnitName() *PurchaseInvoiceItemUpdate {
+	piiu.mutation.ClearUnitName()
+	return piiu
+}
+
+// SetUnitQuantity sets the "unit_quantity" field.
+func (piiu *PurchaseInvoiceItemUpdate) SetUnitQuantity(f float64) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.ResetUnitQuantity()
+	piiu.mutation.SetUnitQuantity(f)
+	return piiu
+}
+
+// SetNillableUnitQuantity sets the "unit_quantity" field if the given value is not nil.
+func (piiu *PurchaseInvoiceItemUpdate) SetNillableUnitQuantity(f *float64) *PurchaseInvoiceItemUpdate {
+	if f != nil {
+		piiu.SetUnitQuantity(*f)
+	}
+	return piiu
+}
+
+// AddUnitQuantity adds f to the "unit_quantity" field.
+func (piiu *PurchaseInvoiceItemUpdate) AddUnitQuantity(f float64) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.AddUnitQuantity(f)
+	return piiu
+}
+
+// ClearUnitQuantity clears the value of the "unit_quantity" field.
+func (piiu *PurchaseInvoiceItemUpdate) ClearUnitQuantity() *PurchaseInvoiceItemUpdate {
+	piiu.mutation.ClearUnitQuantity()
+	return piiu
+}
+
+// SetTaxRateID sets the "tax_rate_id" field.
+func (piiu *PurchaseInvoiceItemUpdate) SetTaxRateID(i int) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.ResetTaxRateID()
+	piiu.mutation.SetTaxRateID(i)
+	return piiu
+}
+
+// SetNillableTaxRateID sets the "tax_rate_id" field if the given value is not nil.
+func (piiu *PurchaseInvoiceItemUpdate) SetNillableTaxRateID(i *int) *PurchaseInvoiceItemUpdate {
+	if i != nil {
+		piiu.SetTaxRateID(*i)
+	}
+	return piiu
+}
+
+// AddTaxRateID adds i to the "tax_rate_id" field.
+func (piiu *PurchaseInvoiceItemUpdate) AddTaxRateID(i int) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.AddTaxRateID(i)
+	return piiu
+}
+
+// ClearTaxRateID clears the value of the "tax_rate_id" field.
+func (piiu *PurchaseInvoiceItemUpdate) ClearTaxRateID() *PurchaseInvoiceItemUpdate {
+	piiu.mutation.ClearTaxRateID()
+	return piiu
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (piiu *PurchaseInvoiceItemUpdate) SetTaxRate(f float64) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.ResetTaxRate()
+	piiu.mutation.SetTaxRate(f)
+	return piiu
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (piiu *PurchaseInvoiceItemUpdate) SetNillableTaxRate(f *float64) *PurchaseInvoiceItemUpdate {
+	if f != nil {
+		piiu.SetTaxRate(*f)
+	}
+	return piiu
+}
+
+// AddTaxRate adds f to the "tax_rate" field.
+func (piiu *PurchaseInvoiceItemUpdate) AddTaxRate(f float64) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.AddTaxRate(f)
+	return piiu
+}
+
+// SetTaxBase sets the "tax_base" field.
+func (piiu *PurchaseInvoiceItemUpdate) SetTaxBase(m money.Amount) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.ResetTaxBase()
+	piiu.mutation.SetTaxBase(m)
+	return piiu
+}
+
+// SetNillableTaxBase sets the "tax_base" field if the given value is not nil.
+func (piiu *PurchaseInvoiceItemUpdate) SetNillableTaxBase(m *money.Amount) *PurchaseInvoiceItemUpdate {
+	if m != nil {
+		piiu.SetTaxBase(*m)
+	}
+	return piiu
+}
+
+// AddTaxBase adds m to the "tax_base" field.
+func (piiu *PurchaseInvoiceItemUpdate) AddTaxBase(m money.Amount) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.AddTaxBase(m)
+	return piiu
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (piiu *PurchaseInvoiceItemUpdate) SetTaxAmount(m money.Amount) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.ResetTaxAmount()
+	piiu.mutation.SetTaxAmount(m)
+	return piiu
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (piiu *PurchaseInvoiceItemUpdate) SetNillableTaxAmount(m *money.Amount) *PurchaseInvoiceItemUpdate {
+	if m != nil {
+		piiu.SetTaxAmount(*m)
+	}
+	return piiu
+}
+
+// AddTaxAmount adds m to the "tax_amount" field.
+func (piiu *PurchaseInvoiceItemUpdate) AddTaxAmount(m money.Amount) *PurchaseInvoiceItemUpdate {
+	piiu.mutation.AddTaxAmount(m)
 	return piiu
 }
 
@@ -165,12 +330,12 @@ func (piiu *PurchaseInvoiceItemUpdate) check() error {
 		}
 	}
 	if v, ok := piiu.mutation.UnitCost(); ok {
-		if err := purchaseinvoiceitem.UnitCostValidator(v); err != nil {
+		if err := purchaseinvoiceitem.UnitCostValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "PurchaseInvoiceItem.unit_cost": %w`, err)}
 		}
 	}
 	if v, ok := piiu.mutation.Subtotal(); ok {
-		if err := purchaseinvoiceitem.SubtotalValidator(v); err != nil {
+		if err := purchaseinvoiceitem.SubtotalValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "subtotal", err: fmt.Errorf(`ent: validator failed for field "PurchaseInvoiceItem.subtotal": %w`, err)}
 		}
 	}
@@ -225,46 +390,161 @@ func (piiu *PurchaseInvoiceItemUpdate) sqlSave(ctx context.Context) (n int, err
 	}
 	if value, ok := piiu.mutation.Quantity(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeFloat64,
 			Value:  value,
 			Column: purchaseinvoiceitem.FieldQuantity,
 		})
 	}
 	if value, ok := piiu.mutation.AddedQuantity(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeFloat64,
 			Value:  value,
 			Column: purchaseinvoiceitem.FieldQuantity,
 		})
 	}
 	if value, ok := piiu.mutation.UnitCost(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoiceitem.FieldUnitCost,
 		})
 	}
 	if value, ok := piiu.mutation.AddedUnitCost(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoiceitem.FieldUnitCost,
 		})
 	}
 	if value, ok := piiu.mutation.Subtotal(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoiceitem.FieldSubtotal,
 		})
 	}
 	if value, ok := piiu.mutation.AddedSubtotal(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoiceitem.FieldSubtotal,
 		})
 	}
+	if value, ok := piiu.mutation.UnitID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldUnitID,
+		})
+	}
+	if value, ok := piiu.mutation.AddedUnitID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldUnitID,
+		})
+	}
+	if piiu.mutation.UnitIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: purchaseinvoiceitem.FieldUnitID,
+		})
+	}
+	if value, ok := piiu.mutation.UnitName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldUnitName,
+		})
+	}
+	if piiu.mutation.UnitNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: purchaseinvoiceitem.FieldUnitName,
+		})
+	}
+	if value, ok := piiu.mutation.UnitQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldUnitQuantity,
+		})
+	}
+	if value, ok := piiu.mutation.AddedUnitQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldUnitQuantity,
+		})
+	}
+	if piiu.mutation.UnitQuantityCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: purchaseinvoiceitem.FieldUnitQuantity,
+		})
+	}
+	if value, ok := piiu.mutation.TaxRateID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxRateID,
+		})
+	}
+	if value, ok := piiu.mutation.AddedTaxRateID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxRateID,
+		})
+	}
+	if piiu.mutation.TaxRateIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: purchaseinvoiceitem.FieldTaxRateID,
+		})
+	}
+	if value, ok := piiu.mutation.TaxRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxRate,
+		})
+	}
+	if value, ok := piiu.mutation.AddedTaxRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxRate,
+		})
+	}
+	if value, ok := piiu.mutation.TaxBase(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxBase,
+		})
+	}
+	if value, ok := piiu.mutation.AddedTaxBase(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxBase,
+		})
+	}
+	if value, ok := piiu.mutation.TaxAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxAmount,
+		})
+	}
+	if value, ok := piiu.mutation.AddedTaxAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxAmount,
+		})
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, piiu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{purchaseinvoiceitem.Label}
@@ -311,41 +591,205 @@ func (piiuo *PurchaseInvoiceItemUpdateOne) AddProductID(i int) *PurchaseInvoiceI
 }
 
 // SetQuantity sets the "quantity" field.
-func (piiuo *PurchaseInvoiceItemUpdateOne) SetQuantity(i int) *PurchaseInvoiceItemUpdateOne {
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetQuantity(f float64) *PurchaseInvoiceItemUpdateOne {
 	piiuo.mutation.ResetQuantity()
-	piiuo.mutation.SetQuantity(i)
+	piiuo.mutation.SetQuantity(f)
 	return piiuo
 }
 
-// AddQuantity adds i to the "quantity" field.
-func (piiuo *PurchaseInvoiceItemUpdateOne) AddQuantity(i int) *PurchaseInvoiceItemUpdateOne {
-	piiuo.mutation.AddQuantity(i)
+// AddQuantity adds f to the "quantity" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) AddQuantity(f float64) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.AddQuantity(f)
 	return piiuo
 }
 
 // SetUnitCost sets the "unit_cost" field.
-func (piiuo *PurchaseInvoiceItemUpdateOne) SetUnitCost(f float64) *PurchaseInvoiceItemUpdateOne {
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetUnitCost(m money.Amount) *PurchaseInvoiceItemUpdateOne {
 	piiuo.mutation.ResetUnitCost()
-	piiuo.mutation.SetUnitCost(f)
+	piiuo.mutation.SetUnitCost(m)
 	return piiuo
 }
 
-// AddUnitCost adds f to the "unit_cost" field.
-func (piiuo *PurchaseInvoiceItemUpdateOne) AddUnitCost(f float64) *PurchaseInvoiceItemUpdateOne {
-	piiuo.mutation.AddUnitCost(f)
+// AddUnitCost adds m to the "unit_cost" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) AddUnitCost(m money.Amount) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.AddUnitCost(m)
 	return piiuo
 }
 
 // SetSubtotal sets the "subtotal" field.
-func (piiuo *PurchaseInvoiceItemUpdateOne) SetSubtotal(f float64) *PurchaseInvoiceItemUpdateOne {
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetSubtotal(m money.Amount) *PurchaseInvoiceItemUpdateOne {
 	piiuo.mutation.ResetSubtotal()
-	piiuo.mutation.SetSubtotal(f)
+	piiuo.mutation.SetSubtotal(m)
 	return piiuo
 }
 
-// AddSubtotal adds f to the "subtotal" field.
-func (piiuo *PurchaseInvoiceItemUpdateOne) AddSubtotal(f float64) *PurchaseInvoiceItemUpdateOne {
-	piiuo.mutation.AddSubtotal(f)
+// AddSubtotal adds m to the "subtotal" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) AddSubtotal(m money.Amount) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.AddSubtotal(m)
+	return piiuo
+}
+
+// SetUnitID sets the "unit_id" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetUnitID(i int) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.ResetUnitID()
+	piiuo.mutation.SetUnitID(i)
+	return piiuo
+}
+
+// SetNillableUnitID sets the "unit_id" field if the given value is not nil.
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetNillableUnitID(i *int) *PurchaseInvoiceItemUpdateOne {
+	if i != nil {
+		piiuo.SetUnitID(*i)
+	}
+	return piiuo
+}
+
+// AddUnitID adds i to the "unit_id" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) AddUnitID(i int) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.AddUnitID(i)
+	return piiuo
+}
+
+// ClearUnitID clears the value of the "unit_id" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) ClearUnitID() *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.ClearUnitID()
+	return piiuo
+}
+
+// SetUnitName sets the "unit_name" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetUnitName(s string) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.SetUnitName(s)
+	return piiuo
+}
+
+// SetNillableUnitName sets the "unit_name" field if the given value is not nil.
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetNillableUnitName(s *string) *PurchaseInvoiceItemUpdateOne {
+	if s != nil {
+		piiuo.SetUnitName(*s)
+	}
+	return piiuo
+}
+
+// ClearUnitName clears the value of the "unit_name" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) ClearUnitName() *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.ClearUnitName()
+	return piiuo
+}
+
+// SetUnitQuantity sets the "unit_quantity" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetUnitQuantity(f float64) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.ResetUnitQuantity()
+	piiuo.mutation.SetUnitQuantity(f)
+	return piiuo
+}
+
+// SetNillableUnitQuantity sets the "unit_quantity" field if the given value is not nil.
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetNillableUnitQuantity(f *float64) *PurchaseInvoiceItemUpdateOne {
+	if f != nil {
+		piiuo.SetUnitQuantity(*f)
+	}
+	return piiuo
+}
+
+// AddUnitQuantity adds f to the "unit_quantity" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) AddUnitQuantity(f float64) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.AddUnitQuantity(f)
+	return piiuo
+}
+
+// ClearUnitQuantity clears the value of the "unit_quantity" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) ClearUnitQuantity() *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.ClearUnitQuantity()
+	return piiuo
+}
+
+// SetTaxRateID sets the "tax_rate_id" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetTaxRateID(i int) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.ResetTaxRateID()
+	piiuo.mutation.SetTaxRateID(i)
+	return piiuo
+}
+
+// SetNillableTaxRateID sets the "tax_rate_id" field if the given value is not nil.
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetNillableTaxRateID(i *int) *PurchaseInvoiceItemUpdateOne {
+	if i != nil {
+		piiuo.SetTaxRateID(*i)
+	}
+	return piiuo
+}
+
+// AddTaxRateID adds i to the "tax_rate_id" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) AddTaxRateID(i int) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.AddTaxRateID(i)
+	return piiuo
+}
+
+// ClearTaxRateID clears the value of the "tax_rate_id" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) ClearTaxRateID() *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.ClearTaxRateID()
+	return piiuo
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetTaxRate(f float64) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.ResetTaxRate()
+	piiuo.mutation.SetTaxRate(f)
+	return piiuo
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetNillableTaxRate(f *float64) *PurchaseInvoiceItemUpdateOne {
+	if f != nil {
+		piiuo.SetTaxRate(*f)
+	}
+	return piiuo
+}
+
+// AddTaxRate adds f to the "tax_rate" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) AddTaxRate(f float64) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.AddTaxRate(f)
+	return piiuo
+}
+
+// SetTaxBase sets the "tax_base" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetTaxBase(m money.Amount) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.ResetTaxBase()
+	piiuo.mutation.SetTaxBase(m)
+	return piiuo
+}
+
+// SetNillableTaxBase sets the "tax_base" field if the given value is not nil.
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetNillableTaxBase(m *money.Amount) *PurchaseInvoiceItemUpdateOne {
+	if m != nil {
+		piiuo.SetTaxBase(*m)
+	}
+	return piiuo
+}
+
+// AddTaxBase adds m to the "tax_base" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) AddTaxBase(m money.Amount) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.AddTaxBase(m)
+	return piiuo
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetTaxAmount(m money.Amount) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.ResetTaxAmount()
+	piiuo.mutation.SetTaxAmount(m)
+	return piiuo
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (piiuo *PurchaseInvoiceItemUpdateOne) SetNillableTaxAmount(m *money.Amount) *PurchaseInvoiceItemUpdateOne {
+	if m != nil {
+		piiuo.SetTaxAmount(*m)
+	}
+	return piiuo
+}
+
+// AddTaxAmount adds m to the "tax_amount" field.
+func (piiuo *PurchaseInvoiceItemUpdateOne) AddTaxAmount(m money.Amount) *PurchaseInvoiceItemUpdateOne {
+	piiuo.mutation.AddTaxAmount(m)
 	return piiuo
 }
 
@@ -435,12 +879,12 @@ func (piiuo *PurchaseInvoiceItemUpdateOne) check() error {
 		}
 	}
 	if v, ok := piiuo.mutation.UnitCost(); ok {
-		if err := purchaseinvoiceitem.UnitCostValidator(v); err != nil {
+		if err := purchaseinvoiceitem.UnitCostValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "PurchaseInvoiceItem.unit_cost": %w`, err)}
 		}
 	}
 	if v, ok := piiuo.mutation.Subtotal(); ok {
-		if err := purchaseinvoiceitem.SubtotalValidator(v); err != nil {
+		if err := purchaseinvoiceitem.SubtotalValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "subtotal", err: fmt.Errorf(`ent: validator failed for field "PurchaseInvoiceItem.subtotal": %w`, err)}
 		}
 	}
@@ -512,46 +956,161 @@ func (piiuo *PurchaseInvoiceItemUpdateOne) sqlSave(ctx context.Context) (_node *
 	}
 	if value, ok := piiuo.mutation.Quantity(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeFloat64,
 			Value:  value,
 			Column: purchaseinvoiceitem.FieldQuantity,
 		})
 	}
 	if value, ok := piiuo.mutation.AddedQuantity(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeFloat64,
 			Value:  value,
 			Column: purchaseinvoiceitem.FieldQuantity,
 		})
 	}
 	if value, ok := piiuo.mutation.UnitCost(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoiceitem.FieldUnitCost,
 		})
 	}
 	if value, ok := piiuo.mutation.AddedUnitCost(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoiceitem.FieldUnitCost,
 		})
 	}
 	if value, ok := piiuo.mutation.Subtotal(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoiceitem.FieldSubtotal,
 		})
 	}
 	if value, ok := piiuo.mutation.AddedSubtotal(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: purchaseinvoiceitem.FieldSubtotal,
 		})
 	}
+	if value, ok := piiuo.mutation.UnitID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldUnitID,
+		})
+	}
+	if value, ok := piiuo.mutation.AddedUnitID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldUnitID,
+		})
+	}
+	if piiuo.mutation.UnitIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: purchaseinvoiceitem.FieldUnitID,
+		})
+	}
+	if value, ok := piiuo.mutation.UnitName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldUnitName,
+		})
+	}
+	if piiuo.mutation.UnitNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: purchaseinvoiceitem.FieldUnitName,
+		})
+	}
+	if value, ok := piiuo.mutation.UnitQuantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldUnitQuantity,
+		})
+	}
+	if value, ok := piiuo.mutation.AddedUnitQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldUnitQuantity,
+		})
+	}
+	if piiuo.mutation.UnitQuantityCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: purchaseinvoiceitem.FieldUnitQuantity,
+		})
+	}
+	if value, ok := piiuo.mutation.TaxRateID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxRateID,
+		})
+	}
+	if value, ok := piiuo.mutation.AddedTaxRateID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxRateID,
+		})
+	}
+	if piiuo.mutation.TaxRateIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: purchaseinvoiceitem.FieldTaxRateID,
+		})
+	}
+	if value, ok := piiuo.mutation.TaxRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxRate,
+		})
+	}
+	if value, ok := piiuo.mutation.AddedTaxRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxRate,
+		})
+	}
+	if value, ok := piiuo.mutation.TaxBase(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxBase,
+		})
+	}
+	if value, ok := piiuo.mutation.AddedTaxBase(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxBase,
+		})
+	}
+	if value, ok := piiuo.mutation.TaxAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxAmount,
+		})
+	}
+	if value, ok := piiuo.mutation.AddedTaxAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: purchaseinvoiceitem.FieldTaxAmount,
+		})
+	}
 	_node = &PurchaseInvoiceItem{config: piiuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
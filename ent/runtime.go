@@ -3,16 +3,48 @@
 package ent
 
 import (
+	"Veritasbackend/ent/adjustmentreason"
+	"Veritasbackend/ent/auditlog"
+	"Veritasbackend/ent/bundleassembly"
+	"Veritasbackend/ent/bundleassemblyline"
+	"Veritasbackend/ent/bundlecomponent"
+	"Veritasbackend/ent/customer"
+	"Veritasbackend/ent/exchangerate"
+	"Veritasbackend/ent/fiscaldocument"
+	"Veritasbackend/ent/inventorycostlayer"
+	"Veritasbackend/ent/inventorycount"
+	"Veritasbackend/ent/inventorycountentry"
+	"Veritasbackend/ent/inventorycountline"
 	"Veritasbackend/ent/invoice"
 	"Veritasbackend/ent/invoiceitem"
+	"Veritasbackend/ent/invoiceitemcomponent"
+	"Veritasbackend/ent/invoicelotallocation"
+	"Veritasbackend/ent/invoicestatusevent"
+	"Veritasbackend/ent/invoicetax"
+	"Veritasbackend/ent/numberingseries"
+	"Veritasbackend/ent/pricelist"
+	"Veritasbackend/ent/pricelistitem"
 	"Veritasbackend/ent/product"
+	"Veritasbackend/ent/productbarcode"
+	"Veritasbackend/ent/productfile"
+	"Veritasbackend/ent/productlot"
+	"Veritasbackend/ent/productprice"
+	"Veritasbackend/ent/productserial"
+	"Veritasbackend/ent/productserialevent"
+	"Veritasbackend/ent/productunit"
+	"Veritasbackend/ent/promotion"
+	"Veritasbackend/ent/promotiontarget"
 	"Veritasbackend/ent/purchaseinvoice"
 	"Veritasbackend/ent/purchaseinvoiceitem"
 	"Veritasbackend/ent/schema"
+	"Veritasbackend/ent/stockadjustment"
+	"Veritasbackend/ent/stockadjustmentline"
 	"Veritasbackend/ent/supplier"
 	"Veritasbackend/ent/supplierpayment"
+	"Veritasbackend/ent/taxrate"
 	"Veritasbackend/ent/tenant"
 	"Veritasbackend/ent/user"
+	"Veritasbackend/pkg/money"
 	"time"
 )
 
@@ -20,22 +52,300 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	adjustmentreasonFields := schema.AdjustmentReason{}.Fields()
+	_ = adjustmentreasonFields
+	// adjustmentreasonDescCode is the schema descriptor for code field.
+	adjustmentreasonDescCode := adjustmentreasonFields[1].Descriptor()
+	// adjustmentreason.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	adjustmentreason.CodeValidator = adjustmentreasonDescCode.Validators[0].(func(string) error)
+	// adjustmentreasonDescName is the schema descriptor for name field.
+	adjustmentreasonDescName := adjustmentreasonFields[2].Descriptor()
+	// adjustmentreason.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	adjustmentreason.NameValidator = adjustmentreasonDescName.Validators[0].(func(string) error)
+	// adjustmentreasonDescWriteOff is the schema descriptor for write_off field.
+	adjustmentreasonDescWriteOff := adjustmentreasonFields[3].Descriptor()
+	// adjustmentreason.DefaultWriteOff holds the default value on creation for the write_off field.
+	adjustmentreason.DefaultWriteOff = adjustmentreasonDescWriteOff.Default.(bool)
+	// adjustmentreasonDescActive is the schema descriptor for active field.
+	adjustmentreasonDescActive := adjustmentreasonFields[4].Descriptor()
+	// adjustmentreason.DefaultActive holds the default value on creation for the active field.
+	adjustmentreason.DefaultActive = adjustmentreasonDescActive.Default.(bool)
+	// adjustmentreasonDescCreatedAt is the schema descriptor for created_at field.
+	adjustmentreasonDescCreatedAt := adjustmentreasonFields[5].Descriptor()
+	// adjustmentreason.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adjustmentreason.DefaultCreatedAt = adjustmentreasonDescCreatedAt.Default.(func() time.Time)
+	// adjustmentreasonDescUpdatedAt is the schema descriptor for updated_at field.
+	adjustmentreasonDescUpdatedAt := adjustmentreasonFields[6].Descriptor()
+	// adjustmentreason.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	adjustmentreason.DefaultUpdatedAt = adjustmentreasonDescUpdatedAt.Default.(func() time.Time)
+	// adjustmentreason.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	adjustmentreason.UpdateDefaultUpdatedAt = adjustmentreasonDescUpdatedAt.UpdateDefault.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[2].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = auditlogDescAction.Validators[0].(func(string) error)
+	// auditlogDescEntity is the schema descriptor for entity field.
+	auditlogDescEntity := auditlogFields[3].Descriptor()
+	// auditlog.EntityValidator is a validator for the "entity" field. It is called by the builders before save.
+	auditlog.EntityValidator = auditlogDescEntity.Validators[0].(func(string) error)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[7].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	bundleassemblyFields := schema.BundleAssembly{}.Fields()
+	_ = bundleassemblyFields
+	// bundleassemblyDescQuantity is the schema descriptor for quantity field.
+	bundleassemblyDescQuantity := bundleassemblyFields[2].Descriptor()
+	// bundleassembly.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	bundleassembly.QuantityValidator = bundleassemblyDescQuantity.Validators[0].(func(float64) error)
+	// bundleassemblyDescUnitCost is the schema descriptor for unit_cost field.
+	bundleassemblyDescUnitCost := bundleassemblyFields[3].Descriptor()
+	// bundleassembly.DefaultUnitCost holds the default value on creation for the unit_cost field.
+	bundleassembly.DefaultUnitCost = money.Amount(bundleassemblyDescUnitCost.Default.(int64))
+	// bundleassembly.UnitCostValidator is a validator for the "unit_cost" field. It is called by the builders before save.
+	bundleassembly.UnitCostValidator = bundleassemblyDescUnitCost.Validators[0].(func(int64) error)
+	// bundleassemblyDescCreatedAt is the schema descriptor for created_at field.
+	bundleassemblyDescCreatedAt := bundleassemblyFields[6].Descriptor()
+	// bundleassembly.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bundleassembly.DefaultCreatedAt = bundleassemblyDescCreatedAt.Default.(func() time.Time)
+	bundleassemblylineFields := schema.BundleAssemblyLine{}.Fields()
+	_ = bundleassemblylineFields
+	// bundleassemblylineDescQuantity is the schema descriptor for quantity field.
+	bundleassemblylineDescQuantity := bundleassemblylineFields[2].Descriptor()
+	// bundleassemblyline.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	bundleassemblyline.QuantityValidator = bundleassemblylineDescQuantity.Validators[0].(func(float64) error)
+	// bundleassemblylineDescUnitCost is the schema descriptor for unit_cost field.
+	bundleassemblylineDescUnitCost := bundleassemblylineFields[3].Descriptor()
+	// bundleassemblyline.DefaultUnitCost holds the default value on creation for the unit_cost field.
+	bundleassemblyline.DefaultUnitCost = money.Amount(bundleassemblylineDescUnitCost.Default.(int64))
+	// bundleassemblyline.UnitCostValidator is a validator for the "unit_cost" field. It is called by the builders before save.
+	bundleassemblyline.UnitCostValidator = bundleassemblylineDescUnitCost.Validators[0].(func(int64) error)
+	bundlecomponentFields := schema.BundleComponent{}.Fields()
+	_ = bundlecomponentFields
+	// bundlecomponentDescQuantity is the schema descriptor for quantity field.
+	bundlecomponentDescQuantity := bundlecomponentFields[3].Descriptor()
+	// bundlecomponent.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	bundlecomponent.QuantityValidator = bundlecomponentDescQuantity.Validators[0].(func(float64) error)
+	// bundlecomponentDescRevenueShare is the schema descriptor for revenue_share field.
+	bundlecomponentDescRevenueShare := bundlecomponentFields[4].Descriptor()
+	// bundlecomponent.RevenueShareValidator is a validator for the "revenue_share" field. It is called by the builders before save.
+	bundlecomponent.RevenueShareValidator = func() func(float64) error {
+		validators := bundlecomponentDescRevenueShare.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(revenue_share float64) error {
+			for _, fn := range fns {
+				if err := fn(revenue_share); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// bundlecomponentDescCreatedAt is the schema descriptor for created_at field.
+	bundlecomponentDescCreatedAt := bundlecomponentFields[5].Descriptor()
+	// bundlecomponent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bundlecomponent.DefaultCreatedAt = bundlecomponentDescCreatedAt.Default.(func() time.Time)
+	customerFields := schema.Customer{}.Fields()
+	_ = customerFields
+	// customerDescName is the schema descriptor for name field.
+	customerDescName := customerFields[0].Descriptor()
+	// customer.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	customer.NameValidator = customerDescName.Validators[0].(func(string) error)
+	// customerDescArchived is the schema descriptor for archived field.
+	customerDescArchived := customerFields[9].Descriptor()
+	// customer.DefaultArchived holds the default value on creation for the archived field.
+	customer.DefaultArchived = customerDescArchived.Default.(bool)
+	// customerDescCreatedAt is the schema descriptor for created_at field.
+	customerDescCreatedAt := customerFields[12].Descriptor()
+	// customer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	customer.DefaultCreatedAt = customerDescCreatedAt.Default.(func() time.Time)
+	// customerDescUpdatedAt is the schema descriptor for updated_at field.
+	customerDescUpdatedAt := customerFields[13].Descriptor()
+	// customer.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	customer.DefaultUpdatedAt = customerDescUpdatedAt.Default.(func() time.Time)
+	// customer.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	customer.UpdateDefaultUpdatedAt = customerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	exchangerateFields := schema.ExchangeRate{}.Fields()
+	_ = exchangerateFields
+	// exchangerateDescCurrency is the schema descriptor for currency field.
+	exchangerateDescCurrency := exchangerateFields[1].Descriptor()
+	// exchangerate.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	exchangerate.CurrencyValidator = exchangerateDescCurrency.Validators[0].(func(string) error)
+	// exchangerateDescRate is the schema descriptor for rate field.
+	exchangerateDescRate := exchangerateFields[3].Descriptor()
+	// exchangerate.RateValidator is a validator for the "rate" field. It is called by the builders before save.
+	exchangerate.RateValidator = exchangerateDescRate.Validators[0].(func(float64) error)
+	// exchangerateDescSource is the schema descriptor for source field.
+	exchangerateDescSource := exchangerateFields[4].Descriptor()
+	// exchangerate.DefaultSource holds the default value on creation for the source field.
+	exchangerate.DefaultSource = exchangerateDescSource.Default.(string)
+	// exchangerateDescCreatedAt is the schema descriptor for created_at field.
+	exchangerateDescCreatedAt := exchangerateFields[6].Descriptor()
+	// exchangerate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	exchangerate.DefaultCreatedAt = exchangerateDescCreatedAt.Default.(func() time.Time)
+	// exchangerateDescUpdatedAt is the schema descriptor for updated_at field.
+	exchangerateDescUpdatedAt := exchangerateFields[7].Descriptor()
+	// exchangerate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	exchangerate.DefaultUpdatedAt = exchangerateDescUpdatedAt.Default.(func() time.Time)
+	// exchangerate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	exchangerate.UpdateDefaultUpdatedAt = exchangerateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	fiscaldocumentFields := schema.FiscalDocument{}.Fields()
+	_ = fiscaldocumentFields
+	// fiscaldocumentDescCurrency is the schema descriptor for currency field.
+	fiscaldocumentDescCurrency := fiscaldocumentFields[7].Descriptor()
+	// fiscaldocument.DefaultCurrency holds the default value on creation for the currency field.
+	fiscaldocument.DefaultCurrency = fiscaldocumentDescCurrency.Default.(string)
+	// fiscaldocumentDescTotal is the schema descriptor for total field.
+	fiscaldocumentDescTotal := fiscaldocumentFields[8].Descriptor()
+	// fiscaldocument.DefaultTotal holds the default value on creation for the total field.
+	fiscaldocument.DefaultTotal = money.Amount(fiscaldocumentDescTotal.Default.(int64))
+	// fiscaldocumentDescStatus is the schema descriptor for status field.
+	fiscaldocumentDescStatus := fiscaldocumentFields[11].Descriptor()
+	// fiscaldocument.DefaultStatus holds the default value on creation for the status field.
+	fiscaldocument.DefaultStatus = fiscaldocumentDescStatus.Default.(string)
+	// fiscaldocumentDescIssuedAt is the schema descriptor for issued_at field.
+	fiscaldocumentDescIssuedAt := fiscaldocumentFields[17].Descriptor()
+	// fiscaldocument.DefaultIssuedAt holds the default value on creation for the issued_at field.
+	fiscaldocument.DefaultIssuedAt = fiscaldocumentDescIssuedAt.Default.(func() time.Time)
+	// fiscaldocumentDescCreatedAt is the schema descriptor for created_at field.
+	fiscaldocumentDescCreatedAt := fiscaldocumentFields[21].Descriptor()
+	// fiscaldocument.DefaultCreatedAt holds the default value on creation for the created_at field.
+	fiscaldocument.DefaultCreatedAt = fiscaldocumentDescCreatedAt.Default.(func() time.Time)
+	// fiscaldocumentDescUpdatedAt is the schema descriptor for updated_at field.
+	fiscaldocumentDescUpdatedAt := fiscaldocumentFields[22].Descriptor()
+	// fiscaldocument.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	fiscaldocument.DefaultUpdatedAt = fiscaldocumentDescUpdatedAt.Default.(func() time.Time)
+	// fiscaldocument.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	fiscaldocument.UpdateDefaultUpdatedAt = fiscaldocumentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	inventorycostlayerFields := schema.InventoryCostLayer{}.Fields()
+	_ = inventorycostlayerFields
+	// inventorycostlayerDescQuantity is the schema descriptor for quantity field.
+	inventorycostlayerDescQuantity := inventorycostlayerFields[4].Descriptor()
+	// inventorycostlayer.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	inventorycostlayer.QuantityValidator = inventorycostlayerDescQuantity.Validators[0].(func(float64) error)
+	// inventorycostlayerDescRemaining is the schema descriptor for remaining field.
+	inventorycostlayerDescRemaining := inventorycostlayerFields[5].Descriptor()
+	// inventorycostlayer.RemainingValidator is a validator for the "remaining" field. It is called by the builders before save.
+	inventorycostlayer.RemainingValidator = inventorycostlayerDescRemaining.Validators[0].(func(float64) error)
+	// inventorycostlayerDescUnitCost is the schema descriptor for unit_cost field.
+	inventorycostlayerDescUnitCost := inventorycostlayerFields[6].Descriptor()
+	// inventorycostlayer.UnitCostValidator is a validator for the "unit_cost" field. It is called by the builders before save.
+	inventorycostlayer.UnitCostValidator = inventorycostlayerDescUnitCost.Validators[0].(func(int64) error)
+	// inventorycostlayerDescCreatedAt is the schema descriptor for created_at field.
+	inventorycostlayerDescCreatedAt := inventorycostlayerFields[7].Descriptor()
+	// inventorycostlayer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	inventorycostlayer.DefaultCreatedAt = inventorycostlayerDescCreatedAt.Default.(func() time.Time)
+	inventorycountFields := schema.InventoryCount{}.Fields()
+	_ = inventorycountFields
+	// inventorycountDescName is the schema descriptor for name field.
+	inventorycountDescName := inventorycountFields[0].Descriptor()
+	// inventorycount.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	inventorycount.NameValidator = inventorycountDescName.Validators[0].(func(string) error)
+	// inventorycountDescStatus is the schema descriptor for status field.
+	inventorycountDescStatus := inventorycountFields[2].Descriptor()
+	// inventorycount.DefaultStatus holds the default value on creation for the status field.
+	inventorycount.DefaultStatus = inventorycountDescStatus.Default.(string)
+	// inventorycountDescCreatedAt is the schema descriptor for created_at field.
+	inventorycountDescCreatedAt := inventorycountFields[7].Descriptor()
+	// inventorycount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	inventorycount.DefaultCreatedAt = inventorycountDescCreatedAt.Default.(func() time.Time)
+	// inventorycountDescUpdatedAt is the schema descriptor for updated_at field.
+	inventorycountDescUpdatedAt := inventorycountFields[8].Descriptor()
+	// inventorycount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	inventorycount.DefaultUpdatedAt = inventorycountDescUpdatedAt.Default.(func() time.Time)
+	// inventorycount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	inventorycount.UpdateDefaultUpdatedAt = inventorycountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	inventorycountentryFields := schema.InventoryCountEntry{}.Fields()
+	_ = inventorycountentryFields
+	// inventorycountentryDescCreatedAt is the schema descriptor for created_at field.
+	inventorycountentryDescCreatedAt := inventorycountentryFields[5].Descriptor()
+	// inventorycountentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	inventorycountentry.DefaultCreatedAt = inventorycountentryDescCreatedAt.Default.(func() time.Time)
+	inventorycountlineFields := schema.InventoryCountLine{}.Fields()
+	_ = inventorycountlineFields
+	// inventorycountlineDescUnitCost is the schema descriptor for unit_cost field.
+	inventorycountlineDescUnitCost := inventorycountlineFields[4].Descriptor()
+	// inventorycountline.DefaultUnitCost holds the default value on creation for the unit_cost field.
+	inventorycountline.DefaultUnitCost = money.Amount(inventorycountlineDescUnitCost.Default.(int64))
+	// inventorycountline.UnitCostValidator is a validator for the "unit_cost" field. It is called by the builders before save.
+	inventorycountline.UnitCostValidator = inventorycountlineDescUnitCost.Validators[0].(func(int64) error)
+	// inventorycountlineDescUpdatedAt is the schema descriptor for updated_at field.
+	inventorycountlineDescUpdatedAt := inventorycountlineFields[5].Descriptor()
+	// inventorycountline.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	inventorycountline.DefaultUpdatedAt = inventorycountlineDescUpdatedAt.Default.(func() time.Time)
+	// inventorycountline.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	inventorycountline.UpdateDefaultUpdatedAt = inventorycountlineDescUpdatedAt.UpdateDefault.(func() time.Time)
 	invoiceFields := schema.Invoice{}.Fields()
 	_ = invoiceFields
 	// invoiceDescTotal is the schema descriptor for total field.
 	invoiceDescTotal := invoiceFields[0].Descriptor()
 	// invoice.TotalValidator is a validator for the "total" field. It is called by the builders before save.
-	invoice.TotalValidator = invoiceDescTotal.Validators[0].(func(float64) error)
+	invoice.TotalValidator = invoiceDescTotal.Validators[0].(func(int64) error)
+	// invoiceDescSubtotal is the schema descriptor for subtotal field.
+	invoiceDescSubtotal := invoiceFields[1].Descriptor()
+	// invoice.DefaultSubtotal holds the default value on creation for the subtotal field.
+	invoice.DefaultSubtotal = money.Amount(invoiceDescSubtotal.Default.(int64))
+	// invoiceDescTaxTotal is the schema descriptor for tax_total field.
+	invoiceDescTaxTotal := invoiceFields[2].Descriptor()
+	// invoice.DefaultTaxTotal holds the default value on creation for the tax_total field.
+	invoice.DefaultTaxTotal = money.Amount(invoiceDescTaxTotal.Default.(int64))
+	// invoiceDescDiscountAmount is the schema descriptor for discount_amount field.
+	invoiceDescDiscountAmount := invoiceFields[3].Descriptor()
+	// invoice.DefaultDiscountAmount holds the default value on creation for the discount_amount field.
+	invoice.DefaultDiscountAmount = money.Amount(invoiceDescDiscountAmount.Default.(int64))
+	// invoiceDescDiscountTotal is the schema descriptor for discount_total field.
+	invoiceDescDiscountTotal := invoiceFields[4].Descriptor()
+	// invoice.DefaultDiscountTotal holds the default value on creation for the discount_total field.
+	invoice.DefaultDiscountTotal = money.Amount(invoiceDescDiscountTotal.Default.(int64))
+	// invoiceDescPromotionTotal is the schema descriptor for promotion_total field.
+	invoiceDescPromotionTotal := invoiceFields[5].Descriptor()
+	// invoice.DefaultPromotionTotal holds the default value on creation for the promotion_total field.
+	invoice.DefaultPromotionTotal = money.Amount(invoiceDescPromotionTotal.Default.(int64))
+	// invoiceDescPricesIncludeTax is the schema descriptor for prices_include_tax field.
+	invoiceDescPricesIncludeTax := invoiceFields[8].Descriptor()
+	// invoice.DefaultPricesIncludeTax holds the default value on creation for the prices_include_tax field.
+	invoice.DefaultPricesIncludeTax = invoiceDescPricesIncludeTax.Default.(bool)
+	// invoiceDescCurrency is the schema descriptor for currency field.
+	invoiceDescCurrency := invoiceFields[9].Descriptor()
+	// invoice.DefaultCurrency holds the default value on creation for the currency field.
+	invoice.DefaultCurrency = invoiceDescCurrency.Default.(string)
+	// invoiceDescExchangeRate is the schema descriptor for exchange_rate field.
+	invoiceDescExchangeRate := invoiceFields[10].Descriptor()
+	// invoice.DefaultExchangeRate holds the default value on creation for the exchange_rate field.
+	invoice.DefaultExchangeRate = invoiceDescExchangeRate.Default.(float64)
+	// invoice.ExchangeRateValidator is a validator for the "exchange_rate" field. It is called by the builders before save.
+	invoice.ExchangeRateValidator = invoiceDescExchangeRate.Validators[0].(func(float64) error)
+	// invoiceDescBaseSubtotal is the schema descriptor for base_subtotal field.
+	invoiceDescBaseSubtotal := invoiceFields[11].Descriptor()
+	// invoice.DefaultBaseSubtotal holds the default value on creation for the base_subtotal field.
+	invoice.DefaultBaseSubtotal = money.Amount(invoiceDescBaseSubtotal.Default.(int64))
+	// invoiceDescBaseTaxTotal is the schema descriptor for base_tax_total field.
+	invoiceDescBaseTaxTotal := invoiceFields[12].Descriptor()
+	// invoice.DefaultBaseTaxTotal holds the default value on creation for the base_tax_total field.
+	invoice.DefaultBaseTaxTotal = money.Amount(invoiceDescBaseTaxTotal.Default.(int64))
+	// invoiceDescBaseTotal is the schema descriptor for base_total field.
+	invoiceDescBaseTotal := invoiceFields[13].Descriptor()
+	// invoice.DefaultBaseTotal holds the default value on creation for the base_total field.
+	invoice.DefaultBaseTotal = money.Amount(invoiceDescBaseTotal.Default.(int64))
+	// invoiceDescFxGainLoss is the schema descriptor for fx_gain_loss field.
+	invoiceDescFxGainLoss := invoiceFields[15].Descriptor()
+	// invoice.DefaultFxGainLoss holds the default value on creation for the fx_gain_loss field.
+	invoice.DefaultFxGainLoss = money.Amount(invoiceDescFxGainLoss.Default.(int64))
 	// invoiceDescStatus is the schema descriptor for status field.
-	invoiceDescStatus := invoiceFields[1].Descriptor()
+	invoiceDescStatus := invoiceFields[18].Descriptor()
 	// invoice.DefaultStatus holds the default value on creation for the status field.
 	invoice.DefaultStatus = invoiceDescStatus.Default.(string)
 	// invoiceDescCreatedAt is the schema descriptor for created_at field.
-	invoiceDescCreatedAt := invoiceFields[4].Descriptor()
+	invoiceDescCreatedAt := invoiceFields[23].Descriptor()
 	// invoice.DefaultCreatedAt holds the default value on creation for the created_at field.
 	invoice.DefaultCreatedAt = invoiceDescCreatedAt.Default.(func() time.Time)
 	// invoiceDescUpdatedAt is the schema descriptor for updated_at field.
-	invoiceDescUpdatedAt := invoiceFields[5].Descriptor()
+	invoiceDescUpdatedAt := invoiceFields[24].Descriptor()
 	// invoice.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	invoice.DefaultUpdatedAt = invoiceDescUpdatedAt.Default.(func() time.Time)
 	// invoice.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -45,61 +355,485 @@ func init() {
 	// invoiceitemDescQuantity is the schema descriptor for quantity field.
 	invoiceitemDescQuantity := invoiceitemFields[2].Descriptor()
 	// invoiceitem.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
-	invoiceitem.QuantityValidator = invoiceitemDescQuantity.Validators[0].(func(int) error)
+	invoiceitem.QuantityValidator = invoiceitemDescQuantity.Validators[0].(func(float64) error)
 	// invoiceitemDescUnitPrice is the schema descriptor for unit_price field.
 	invoiceitemDescUnitPrice := invoiceitemFields[3].Descriptor()
 	// invoiceitem.UnitPriceValidator is a validator for the "unit_price" field. It is called by the builders before save.
-	invoiceitem.UnitPriceValidator = invoiceitemDescUnitPrice.Validators[0].(func(float64) error)
+	invoiceitem.UnitPriceValidator = invoiceitemDescUnitPrice.Validators[0].(func(int64) error)
 	// invoiceitemDescSubtotal is the schema descriptor for subtotal field.
 	invoiceitemDescSubtotal := invoiceitemFields[4].Descriptor()
 	// invoiceitem.SubtotalValidator is a validator for the "subtotal" field. It is called by the builders before save.
-	invoiceitem.SubtotalValidator = invoiceitemDescSubtotal.Validators[0].(func(float64) error)
+	invoiceitem.SubtotalValidator = invoiceitemDescSubtotal.Validators[0].(func(int64) error)
+	// invoiceitemDescPromotionDiscount is the schema descriptor for promotion_discount field.
+	invoiceitemDescPromotionDiscount := invoiceitemFields[6].Descriptor()
+	// invoiceitem.DefaultPromotionDiscount holds the default value on creation for the promotion_discount field.
+	invoiceitem.DefaultPromotionDiscount = money.Amount(invoiceitemDescPromotionDiscount.Default.(int64))
+	// invoiceitemDescDiscountAmount is the schema descriptor for discount_amount field.
+	invoiceitemDescDiscountAmount := invoiceitemFields[7].Descriptor()
+	// invoiceitem.DefaultDiscountAmount holds the default value on creation for the discount_amount field.
+	invoiceitem.DefaultDiscountAmount = money.Amount(invoiceitemDescDiscountAmount.Default.(int64))
+	// invoiceitemDescInvoiceDiscountAmount is the schema descriptor for invoice_discount_amount field.
+	invoiceitemDescInvoiceDiscountAmount := invoiceitemFields[8].Descriptor()
+	// invoiceitem.DefaultInvoiceDiscountAmount holds the default value on creation for the invoice_discount_amount field.
+	invoiceitem.DefaultInvoiceDiscountAmount = money.Amount(invoiceitemDescInvoiceDiscountAmount.Default.(int64))
+	// invoiceitemDescUnitCost is the schema descriptor for unit_cost field.
+	invoiceitemDescUnitCost := invoiceitemFields[9].Descriptor()
+	// invoiceitem.DefaultUnitCost holds the default value on creation for the unit_cost field.
+	invoiceitem.DefaultUnitCost = money.Amount(invoiceitemDescUnitCost.Default.(int64))
+	// invoiceitem.UnitCostValidator is a validator for the "unit_cost" field. It is called by the builders before save.
+	invoiceitem.UnitCostValidator = invoiceitemDescUnitCost.Validators[0].(func(int64) error)
+	// invoiceitemDescCostTotal is the schema descriptor for cost_total field.
+	invoiceitemDescCostTotal := invoiceitemFields[10].Descriptor()
+	// invoiceitem.DefaultCostTotal holds the default value on creation for the cost_total field.
+	invoiceitem.DefaultCostTotal = money.Amount(invoiceitemDescCostTotal.Default.(int64))
+	// invoiceitem.CostTotalValidator is a validator for the "cost_total" field. It is called by the builders before save.
+	invoiceitem.CostTotalValidator = invoiceitemDescCostTotal.Validators[0].(func(int64) error)
+	// invoiceitemDescPriceRule is the schema descriptor for price_rule field.
+	invoiceitemDescPriceRule := invoiceitemFields[11].Descriptor()
+	// invoiceitem.DefaultPriceRule holds the default value on creation for the price_rule field.
+	invoiceitem.DefaultPriceRule = invoiceitemDescPriceRule.Default.(string)
+	// invoiceitemDescTaxRate is the schema descriptor for tax_rate field.
+	invoiceitemDescTaxRate := invoiceitemFields[17].Descriptor()
+	// invoiceitem.DefaultTaxRate holds the default value on creation for the tax_rate field.
+	invoiceitem.DefaultTaxRate = invoiceitemDescTaxRate.Default.(float64)
+	// invoiceitemDescTaxBase is the schema descriptor for tax_base field.
+	invoiceitemDescTaxBase := invoiceitemFields[18].Descriptor()
+	// invoiceitem.DefaultTaxBase holds the default value on creation for the tax_base field.
+	invoiceitem.DefaultTaxBase = money.Amount(invoiceitemDescTaxBase.Default.(int64))
+	// invoiceitemDescTaxAmount is the schema descriptor for tax_amount field.
+	invoiceitemDescTaxAmount := invoiceitemFields[19].Descriptor()
+	// invoiceitem.DefaultTaxAmount holds the default value on creation for the tax_amount field.
+	invoiceitem.DefaultTaxAmount = money.Amount(invoiceitemDescTaxAmount.Default.(int64))
+	invoiceitemcomponentFields := schema.InvoiceItemComponent{}.Fields()
+	_ = invoiceitemcomponentFields
+	// invoiceitemcomponentDescQuantity is the schema descriptor for quantity field.
+	invoiceitemcomponentDescQuantity := invoiceitemcomponentFields[4].Descriptor()
+	// invoiceitemcomponent.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	invoiceitemcomponent.QuantityValidator = invoiceitemcomponentDescQuantity.Validators[0].(func(float64) error)
+	// invoiceitemcomponentDescRevenue is the schema descriptor for revenue field.
+	invoiceitemcomponentDescRevenue := invoiceitemcomponentFields[5].Descriptor()
+	// invoiceitemcomponent.DefaultRevenue holds the default value on creation for the revenue field.
+	invoiceitemcomponent.DefaultRevenue = money.Amount(invoiceitemcomponentDescRevenue.Default.(int64))
+	// invoiceitemcomponentDescCostTotal is the schema descriptor for cost_total field.
+	invoiceitemcomponentDescCostTotal := invoiceitemcomponentFields[6].Descriptor()
+	// invoiceitemcomponent.DefaultCostTotal holds the default value on creation for the cost_total field.
+	invoiceitemcomponent.DefaultCostTotal = money.Amount(invoiceitemcomponentDescCostTotal.Default.(int64))
+	// invoiceitemcomponentDescBuiltQuantity is the schema descriptor for built_quantity field.
+	invoiceitemcomponentDescBuiltQuantity := invoiceitemcomponentFields[7].Descriptor()
+	// invoiceitemcomponent.DefaultBuiltQuantity holds the default value on creation for the built_quantity field.
+	invoiceitemcomponent.DefaultBuiltQuantity = invoiceitemcomponentDescBuiltQuantity.Default.(float64)
+	// invoiceitemcomponentDescBuiltUnitCost is the schema descriptor for built_unit_cost field.
+	invoiceitemcomponentDescBuiltUnitCost := invoiceitemcomponentFields[8].Descriptor()
+	// invoiceitemcomponent.DefaultBuiltUnitCost holds the default value on creation for the built_unit_cost field.
+	invoiceitemcomponent.DefaultBuiltUnitCost = money.Amount(invoiceitemcomponentDescBuiltUnitCost.Default.(int64))
+	invoicelotallocationFields := schema.InvoiceLotAllocation{}.Fields()
+	_ = invoicelotallocationFields
+	// invoicelotallocationDescQuantity is the schema descriptor for quantity field.
+	invoicelotallocationDescQuantity := invoicelotallocationFields[3].Descriptor()
+	// invoicelotallocation.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	invoicelotallocation.QuantityValidator = invoicelotallocationDescQuantity.Validators[0].(func(float64) error)
+	invoicestatuseventFields := schema.InvoiceStatusEvent{}.Fields()
+	_ = invoicestatuseventFields
+	// invoicestatuseventDescCreatedAt is the schema descriptor for created_at field.
+	invoicestatuseventDescCreatedAt := invoicestatuseventFields[6].Descriptor()
+	// invoicestatusevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invoicestatusevent.DefaultCreatedAt = invoicestatuseventDescCreatedAt.Default.(func() time.Time)
+	invoicetaxFields := schema.InvoiceTax{}.Fields()
+	_ = invoicetaxFields
+	// invoicetaxDescRate is the schema descriptor for rate field.
+	invoicetaxDescRate := invoicetaxFields[6].Descriptor()
+	// invoicetax.DefaultRate holds the default value on creation for the rate field.
+	invoicetax.DefaultRate = invoicetaxDescRate.Default.(float64)
+	// invoicetaxDescBase is the schema descriptor for base field.
+	invoicetaxDescBase := invoicetaxFields[7].Descriptor()
+	// invoicetax.DefaultBase holds the default value on creation for the base field.
+	invoicetax.DefaultBase = money.Amount(invoicetaxDescBase.Default.(int64))
+	// invoicetax.BaseValidator is a validator for the "base" field. It is called by the builders before save.
+	invoicetax.BaseValidator = invoicetaxDescBase.Validators[0].(func(int64) error)
+	// invoicetaxDescAmount is the schema descriptor for amount field.
+	invoicetaxDescAmount := invoicetaxFields[8].Descriptor()
+	// invoicetax.DefaultAmount holds the default value on creation for the amount field.
+	invoicetax.DefaultAmount = money.Amount(invoicetaxDescAmount.Default.(int64))
+	numberingseriesFields := schema.NumberingSeries{}.Fields()
+	_ = numberingseriesFields
+	// numberingseriesDescRangeFrom is the schema descriptor for range_from field.
+	numberingseriesDescRangeFrom := numberingseriesFields[4].Descriptor()
+	// numberingseries.RangeFromValidator is a validator for the "range_from" field. It is called by the builders before save.
+	numberingseries.RangeFromValidator = numberingseriesDescRangeFrom.Validators[0].(func(int64) error)
+	// numberingseriesDescRangeTo is the schema descriptor for range_to field.
+	numberingseriesDescRangeTo := numberingseriesFields[5].Descriptor()
+	// numberingseries.RangeToValidator is a validator for the "range_to" field. It is called by the builders before save.
+	numberingseries.RangeToValidator = numberingseriesDescRangeTo.Validators[0].(func(int64) error)
+	// numberingseriesDescCurrentNumber is the schema descriptor for current_number field.
+	numberingseriesDescCurrentNumber := numberingseriesFields[6].Descriptor()
+	// numberingseries.CurrentNumberValidator is a validator for the "current_number" field. It is called by the builders before save.
+	numberingseries.CurrentNumberValidator = numberingseriesDescCurrentNumber.Validators[0].(func(int64) error)
+	// numberingseriesDescAlertRemaining is the schema descriptor for alert_remaining field.
+	numberingseriesDescAlertRemaining := numberingseriesFields[10].Descriptor()
+	// numberingseries.DefaultAlertRemaining holds the default value on creation for the alert_remaining field.
+	numberingseries.DefaultAlertRemaining = numberingseriesDescAlertRemaining.Default.(int64)
+	// numberingseries.AlertRemainingValidator is a validator for the "alert_remaining" field. It is called by the builders before save.
+	numberingseries.AlertRemainingValidator = numberingseriesDescAlertRemaining.Validators[0].(func(int64) error)
+	// numberingseriesDescActive is the schema descriptor for active field.
+	numberingseriesDescActive := numberingseriesFields[11].Descriptor()
+	// numberingseries.DefaultActive holds the default value on creation for the active field.
+	numberingseries.DefaultActive = numberingseriesDescActive.Default.(bool)
+	// numberingseriesDescCreatedAt is the schema descriptor for created_at field.
+	numberingseriesDescCreatedAt := numberingseriesFields[12].Descriptor()
+	// numberingseries.DefaultCreatedAt holds the default value on creation for the created_at field.
+	numberingseries.DefaultCreatedAt = numberingseriesDescCreatedAt.Default.(func() time.Time)
+	// numberingseriesDescUpdatedAt is the schema descriptor for updated_at field.
+	numberingseriesDescUpdatedAt := numberingseriesFields[13].Descriptor()
+	// numberingseries.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	numberingseries.DefaultUpdatedAt = numberingseriesDescUpdatedAt.Default.(func() time.Time)
+	// numberingseries.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	numberingseries.UpdateDefaultUpdatedAt = numberingseriesDescUpdatedAt.UpdateDefault.(func() time.Time)
+	pricelistFields := schema.PriceList{}.Fields()
+	_ = pricelistFields
+	// pricelistDescName is the schema descriptor for name field.
+	pricelistDescName := pricelistFields[1].Descriptor()
+	// pricelist.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	pricelist.NameValidator = pricelistDescName.Validators[0].(func(string) error)
+	// pricelistDescDiscountPercent is the schema descriptor for discount_percent field.
+	pricelistDescDiscountPercent := pricelistFields[3].Descriptor()
+	// pricelist.DefaultDiscountPercent holds the default value on creation for the discount_percent field.
+	pricelist.DefaultDiscountPercent = pricelistDescDiscountPercent.Default.(float64)
+	// pricelist.DiscountPercentValidator is a validator for the "discount_percent" field. It is called by the builders before save.
+	pricelist.DiscountPercentValidator = pricelistDescDiscountPercent.Validators[0].(func(float64) error)
+	// pricelistDescActive is the schema descriptor for active field.
+	pricelistDescActive := pricelistFields[4].Descriptor()
+	// pricelist.DefaultActive holds the default value on creation for the active field.
+	pricelist.DefaultActive = pricelistDescActive.Default.(bool)
+	// pricelistDescCreatedAt is the schema descriptor for created_at field.
+	pricelistDescCreatedAt := pricelistFields[5].Descriptor()
+	// pricelist.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pricelist.DefaultCreatedAt = pricelistDescCreatedAt.Default.(func() time.Time)
+	// pricelistDescUpdatedAt is the schema descriptor for updated_at field.
+	pricelistDescUpdatedAt := pricelistFields[6].Descriptor()
+	// pricelist.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pricelist.DefaultUpdatedAt = pricelistDescUpdatedAt.Default.(func() time.Time)
+	// pricelist.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pricelist.UpdateDefaultUpdatedAt = pricelistDescUpdatedAt.UpdateDefault.(func() time.Time)
+	pricelistitemFields := schema.PriceListItem{}.Fields()
+	_ = pricelistitemFields
+	// pricelistitemDescMinQuantity is the schema descriptor for min_quantity field.
+	pricelistitemDescMinQuantity := pricelistitemFields[2].Descriptor()
+	// pricelistitem.DefaultMinQuantity holds the default value on creation for the min_quantity field.
+	pricelistitem.DefaultMinQuantity = pricelistitemDescMinQuantity.Default.(int)
+	// pricelistitem.MinQuantityValidator is a validator for the "min_quantity" field. It is called by the builders before save.
+	pricelistitem.MinQuantityValidator = pricelistitemDescMinQuantity.Validators[0].(func(int) error)
+	// pricelistitemDescPrice is the schema descriptor for price field.
+	pricelistitemDescPrice := pricelistitemFields[3].Descriptor()
+	// pricelistitem.PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	pricelistitem.PriceValidator = pricelistitemDescPrice.Validators[0].(func(int64) error)
+	// pricelistitemDescDiscountPercent is the schema descriptor for discount_percent field.
+	pricelistitemDescDiscountPercent := pricelistitemFields[4].Descriptor()
+	// pricelistitem.DiscountPercentValidator is a validator for the "discount_percent" field. It is called by the builders before save.
+	pricelistitem.DiscountPercentValidator = pricelistitemDescDiscountPercent.Validators[0].(func(float64) error)
+	// pricelistitemDescCreatedAt is the schema descriptor for created_at field.
+	pricelistitemDescCreatedAt := pricelistitemFields[5].Descriptor()
+	// pricelistitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pricelistitem.DefaultCreatedAt = pricelistitemDescCreatedAt.Default.(func() time.Time)
+	// pricelistitemDescUpdatedAt is the schema descriptor for updated_at field.
+	pricelistitemDescUpdatedAt := pricelistitemFields[6].Descriptor()
+	// pricelistitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pricelistitem.DefaultUpdatedAt = pricelistitemDescUpdatedAt.Default.(func() time.Time)
+	// pricelistitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pricelistitem.UpdateDefaultUpdatedAt = pricelistitemDescUpdatedAt.UpdateDefault.(func() time.Time)
 	productFields := schema.Product{}.Fields()
 	_ = productFields
 	// productDescName is the schema descriptor for name field.
 	productDescName := productFields[0].Descriptor()
 	// product.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	product.NameValidator = productDescName.Validators[0].(func(string) error)
-	// productDescPrice is the schema descriptor for price field.
-	productDescPrice := productFields[2].Descriptor()
-	// product.PriceValidator is a validator for the "price" field. It is called by the builders before save.
-	product.PriceValidator = productDescPrice.Validators[0].(func(float64) error)
 	// productDescPurchasePrice is the schema descriptor for purchase_price field.
-	productDescPurchasePrice := productFields[3].Descriptor()
+	productDescPurchasePrice := productFields[2].Descriptor()
 	// product.DefaultPurchasePrice holds the default value on creation for the purchase_price field.
-	product.DefaultPurchasePrice = productDescPurchasePrice.Default.(float64)
+	product.DefaultPurchasePrice = money.Amount(productDescPurchasePrice.Default.(int64))
 	// product.PurchasePriceValidator is a validator for the "purchase_price" field. It is called by the builders before save.
-	product.PurchasePriceValidator = productDescPurchasePrice.Validators[0].(func(float64) error)
+	product.PurchasePriceValidator = productDescPurchasePrice.Validators[0].(func(int64) error)
 	// productDescRetailPrice is the schema descriptor for retail_price field.
-	productDescRetailPrice := productFields[4].Descriptor()
+	productDescRetailPrice := productFields[3].Descriptor()
 	// product.DefaultRetailPrice holds the default value on creation for the retail_price field.
-	product.DefaultRetailPrice = productDescRetailPrice.Default.(float64)
+	product.DefaultRetailPrice = money.Amount(productDescRetailPrice.Default.(int64))
 	// product.RetailPriceValidator is a validator for the "retail_price" field. It is called by the builders before save.
-	product.RetailPriceValidator = productDescRetailPrice.Validators[0].(func(float64) error)
+	product.RetailPriceValidator = productDescRetailPrice.Validators[0].(func(int64) error)
 	// productDescWholesalePrice is the schema descriptor for wholesale_price field.
-	productDescWholesalePrice := productFields[5].Descriptor()
+	productDescWholesalePrice := productFields[4].Descriptor()
 	// product.WholesalePriceValidator is a validator for the "wholesale_price" field. It is called by the builders before save.
-	product.WholesalePriceValidator = productDescWholesalePrice.Validators[0].(func(float64) error)
+	product.WholesalePriceValidator = productDescWholesalePrice.Validators[0].(func(int64) error)
 	// productDescMinWholesaleQuantity is the schema descriptor for min_wholesale_quantity field.
-	productDescMinWholesaleQuantity := productFields[6].Descriptor()
+	productDescMinWholesaleQuantity := productFields[5].Descriptor()
 	// product.MinWholesaleQuantityValidator is a validator for the "min_wholesale_quantity" field. It is called by the builders before save.
 	product.MinWholesaleQuantityValidator = productDescMinWholesaleQuantity.Validators[0].(func(int) error)
 	// productDescStock is the schema descriptor for stock field.
-	productDescStock := productFields[7].Descriptor()
+	productDescStock := productFields[6].Descriptor()
 	// product.DefaultStock holds the default value on creation for the stock field.
-	product.DefaultStock = productDescStock.Default.(int)
+	product.DefaultStock = productDescStock.Default.(float64)
 	// product.StockValidator is a validator for the "stock" field. It is called by the builders before save.
-	product.StockValidator = productDescStock.Validators[0].(func(int) error)
+	product.StockValidator = productDescStock.Validators[0].(func(float64) error)
+	// productDescBaseUnit is the schema descriptor for base_unit field.
+	productDescBaseUnit := productFields[7].Descriptor()
+	// product.DefaultBaseUnit holds the default value on creation for the base_unit field.
+	product.DefaultBaseUnit = productDescBaseUnit.Default.(string)
+	// productDescFractional is the schema descriptor for fractional field.
+	productDescFractional := productFields[8].Descriptor()
+	// product.DefaultFractional holds the default value on creation for the fractional field.
+	product.DefaultFractional = productDescFractional.Default.(bool)
+	// productDescBundle is the schema descriptor for bundle field.
+	productDescBundle := productFields[9].Descriptor()
+	// product.DefaultBundle holds the default value on creation for the bundle field.
+	product.DefaultBundle = productDescBundle.Default.(bool)
+	// productDescRevenueSplit is the schema descriptor for revenue_split field.
+	productDescRevenueSplit := productFields[10].Descriptor()
+	// product.DefaultRevenueSplit holds the default value on creation for the revenue_split field.
+	product.DefaultRevenueSplit = productDescRevenueSplit.Default.(string)
+	// productDescTrackLots is the schema descriptor for track_lots field.
+	productDescTrackLots := productFields[14].Descriptor()
+	// product.DefaultTrackLots holds the default value on creation for the track_lots field.
+	product.DefaultTrackLots = productDescTrackLots.Default.(bool)
+	// productDescSerialized is the schema descriptor for serialized field.
+	productDescSerialized := productFields[15].Descriptor()
+	// product.DefaultSerialized holds the default value on creation for the serialized field.
+	product.DefaultSerialized = productDescSerialized.Default.(bool)
+	// productDescWarrantyMonths is the schema descriptor for warranty_months field.
+	productDescWarrantyMonths := productFields[16].Descriptor()
+	// product.DefaultWarrantyMonths holds the default value on creation for the warranty_months field.
+	product.DefaultWarrantyMonths = productDescWarrantyMonths.Default.(int)
+	// product.WarrantyMonthsValidator is a validator for the "warranty_months" field. It is called by the builders before save.
+	product.WarrantyMonthsValidator = productDescWarrantyMonths.Validators[0].(func(int) error)
+	// productDescArchived is the schema descriptor for archived field.
+	productDescArchived := productFields[17].Descriptor()
+	// product.DefaultArchived holds the default value on creation for the archived field.
+	product.DefaultArchived = productDescArchived.Default.(bool)
 	// productDescCreatedAt is the schema descriptor for created_at field.
-	productDescCreatedAt := productFields[10].Descriptor()
+	productDescCreatedAt := productFields[20].Descriptor()
 	// product.DefaultCreatedAt holds the default value on creation for the created_at field.
 	product.DefaultCreatedAt = productDescCreatedAt.Default.(func() time.Time)
 	// productDescUpdatedAt is the schema descriptor for updated_at field.
-	productDescUpdatedAt := productFields[11].Descriptor()
+	productDescUpdatedAt := productFields[21].Descriptor()
 	// product.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	product.DefaultUpdatedAt = productDescUpdatedAt.Default.(func() time.Time)
 	// product.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	product.UpdateDefaultUpdatedAt = productDescUpdatedAt.UpdateDefault.(func() time.Time)
+	productbarcodeFields := schema.ProductBarcode{}.Fields()
+	_ = productbarcodeFields
+	// productbarcodeDescCode is the schema descriptor for code field.
+	productbarcodeDescCode := productbarcodeFields[3].Descriptor()
+	// productbarcode.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	productbarcode.CodeValidator = productbarcodeDescCode.Validators[0].(func(string) error)
+	// productbarcodeDescInternal is the schema descriptor for internal field.
+	productbarcodeDescInternal := productbarcodeFields[5].Descriptor()
+	// productbarcode.DefaultInternal holds the default value on creation for the internal field.
+	productbarcode.DefaultInternal = productbarcodeDescInternal.Default.(bool)
+	// productbarcodeDescCreatedAt is the schema descriptor for created_at field.
+	productbarcodeDescCreatedAt := productbarcodeFields[6].Descriptor()
+	// productbarcode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productbarcode.DefaultCreatedAt = productbarcodeDescCreatedAt.Default.(func() time.Time)
+	productfileFields := schema.ProductFile{}.Fields()
+	_ = productfileFields
+	// productfileDescKind is the schema descriptor for kind field.
+	productfileDescKind := productfileFields[2].Descriptor()
+	// productfile.DefaultKind holds the default value on creation for the kind field.
+	productfile.DefaultKind = productfileDescKind.Default.(string)
+	// productfileDescFileName is the schema descriptor for file_name field.
+	productfileDescFileName := productfileFields[3].Descriptor()
+	// productfile.FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
+	productfile.FileNameValidator = productfileDescFileName.Validators[0].(func(string) error)
+	// productfileDescSize is the schema descriptor for size field.
+	productfileDescSize := productfileFields[5].Descriptor()
+	// productfile.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	productfile.SizeValidator = productfileDescSize.Validators[0].(func(int64) error)
+	// productfileDescStorageKey is the schema descriptor for storage_key field.
+	productfileDescStorageKey := productfileFields[6].Descriptor()
+	// productfile.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	productfile.StorageKeyValidator = productfileDescStorageKey.Validators[0].(func(string) error)
+	// productfileDescWidth is the schema descriptor for width field.
+	productfileDescWidth := productfileFields[8].Descriptor()
+	// productfile.DefaultWidth holds the default value on creation for the width field.
+	productfile.DefaultWidth = productfileDescWidth.Default.(int)
+	// productfileDescHeight is the schema descriptor for height field.
+	productfileDescHeight := productfileFields[9].Descriptor()
+	// productfile.DefaultHeight holds the default value on creation for the height field.
+	productfile.DefaultHeight = productfileDescHeight.Default.(int)
+	// productfileDescPosition is the schema descriptor for position field.
+	productfileDescPosition := productfileFields[10].Descriptor()
+	// productfile.DefaultPosition holds the default value on creation for the position field.
+	productfile.DefaultPosition = productfileDescPosition.Default.(int)
+	// productfileDescCreatedAt is the schema descriptor for created_at field.
+	productfileDescCreatedAt := productfileFields[12].Descriptor()
+	// productfile.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productfile.DefaultCreatedAt = productfileDescCreatedAt.Default.(func() time.Time)
+	productlotFields := schema.ProductLot{}.Fields()
+	_ = productlotFields
+	// productlotDescLotNumber is the schema descriptor for lot_number field.
+	productlotDescLotNumber := productlotFields[2].Descriptor()
+	// productlot.LotNumberValidator is a validator for the "lot_number" field. It is called by the builders before save.
+	productlot.LotNumberValidator = productlotDescLotNumber.Validators[0].(func(string) error)
+	// productlotDescQuantity is the schema descriptor for quantity field.
+	productlotDescQuantity := productlotFields[4].Descriptor()
+	// productlot.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	productlot.QuantityValidator = productlotDescQuantity.Validators[0].(func(float64) error)
+	// productlotDescRemaining is the schema descriptor for remaining field.
+	productlotDescRemaining := productlotFields[5].Descriptor()
+	// productlot.RemainingValidator is a validator for the "remaining" field. It is called by the builders before save.
+	productlot.RemainingValidator = productlotDescRemaining.Validators[0].(func(float64) error)
+	// productlotDescCreatedAt is the schema descriptor for created_at field.
+	productlotDescCreatedAt := productlotFields[7].Descriptor()
+	// productlot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productlot.DefaultCreatedAt = productlotDescCreatedAt.Default.(func() time.Time)
+	// productlotDescUpdatedAt is the schema descriptor for updated_at field.
+	productlotDescUpdatedAt := productlotFields[8].Descriptor()
+	// productlot.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	productlot.DefaultUpdatedAt = productlotDescUpdatedAt.Default.(func() time.Time)
+	// productlot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	productlot.UpdateDefaultUpdatedAt = productlotDescUpdatedAt.UpdateDefault.(func() time.Time)
+	productpriceFields := schema.ProductPrice{}.Fields()
+	_ = productpriceFields
+	// productpriceDescRetailPrice is the schema descriptor for retail_price field.
+	productpriceDescRetailPrice := productpriceFields[2].Descriptor()
+	// productprice.RetailPriceValidator is a validator for the "retail_price" field. It is called by the builders before save.
+	productprice.RetailPriceValidator = productpriceDescRetailPrice.Validators[0].(func(int64) error)
+	// productpriceDescWholesalePrice is the schema descriptor for wholesale_price field.
+	productpriceDescWholesalePrice := productpriceFields[3].Descriptor()
+	// productprice.WholesalePriceValidator is a validator for the "wholesale_price" field. It is called by the builders before save.
+	productprice.WholesalePriceValidator = productpriceDescWholesalePrice.Validators[0].(func(int64) error)
+	// productpriceDescPurchasePrice is the schema descriptor for purchase_price field.
+	productpriceDescPurchasePrice := productpriceFields[4].Descriptor()
+	// productprice.PurchasePriceValidator is a validator for the "purchase_price" field. It is called by the builders before save.
+	productprice.PurchasePriceValidator = productpriceDescPurchasePrice.Validators[0].(func(int64) error)
+	// productpriceDescStatus is the schema descriptor for status field.
+	productpriceDescStatus := productpriceFields[6].Descriptor()
+	// productprice.DefaultStatus holds the default value on creation for the status field.
+	productprice.DefaultStatus = productpriceDescStatus.Default.(string)
+	// productpriceDescSource is the schema descriptor for source field.
+	productpriceDescSource := productpriceFields[7].Descriptor()
+	// productprice.DefaultSource holds the default value on creation for the source field.
+	productprice.DefaultSource = productpriceDescSource.Default.(string)
+	// productpriceDescCreatedAt is the schema descriptor for created_at field.
+	productpriceDescCreatedAt := productpriceFields[10].Descriptor()
+	// productprice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productprice.DefaultCreatedAt = productpriceDescCreatedAt.Default.(func() time.Time)
+	productserialFields := schema.ProductSerial{}.Fields()
+	_ = productserialFields
+	// productserialDescSerialNumber is the schema descriptor for serial_number field.
+	productserialDescSerialNumber := productserialFields[2].Descriptor()
+	// productserial.SerialNumberValidator is a validator for the "serial_number" field. It is called by the builders before save.
+	productserial.SerialNumberValidator = productserialDescSerialNumber.Validators[0].(func(string) error)
+	// productserialDescStatus is the schema descriptor for status field.
+	productserialDescStatus := productserialFields[3].Descriptor()
+	// productserial.DefaultStatus holds the default value on creation for the status field.
+	productserial.DefaultStatus = productserialDescStatus.Default.(string)
+	// productserialDescReceivedAt is the schema descriptor for received_at field.
+	productserialDescReceivedAt := productserialFields[7].Descriptor()
+	// productserial.DefaultReceivedAt holds the default value on creation for the received_at field.
+	productserial.DefaultReceivedAt = productserialDescReceivedAt.Default.(func() time.Time)
+	// productserialDescCreatedAt is the schema descriptor for created_at field.
+	productserialDescCreatedAt := productserialFields[9].Descriptor()
+	// productserial.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productserial.DefaultCreatedAt = productserialDescCreatedAt.Default.(func() time.Time)
+	// productserialDescUpdatedAt is the schema descriptor for updated_at field.
+	productserialDescUpdatedAt := productserialFields[10].Descriptor()
+	// productserial.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	productserial.DefaultUpdatedAt = productserialDescUpdatedAt.Default.(func() time.Time)
+	// productserial.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	productserial.UpdateDefaultUpdatedAt = productserialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	productserialeventFields := schema.ProductSerialEvent{}.Fields()
+	_ = productserialeventFields
+	// productserialeventDescCreatedAt is the schema descriptor for created_at field.
+	productserialeventDescCreatedAt := productserialeventFields[6].Descriptor()
+	// productserialevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productserialevent.DefaultCreatedAt = productserialeventDescCreatedAt.Default.(func() time.Time)
+	productunitFields := schema.ProductUnit{}.Fields()
+	_ = productunitFields
+	// productunitDescName is the schema descriptor for name field.
+	productunitDescName := productunitFields[2].Descriptor()
+	// productunit.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	productunit.NameValidator = productunitDescName.Validators[0].(func(string) error)
+	// productunitDescFactor is the schema descriptor for factor field.
+	productunitDescFactor := productunitFields[3].Descriptor()
+	// productunit.FactorValidator is a validator for the "factor" field. It is called by the builders before save.
+	productunit.FactorValidator = productunitDescFactor.Validators[0].(func(float64) error)
+	// productunitDescRetailPrice is the schema descriptor for retail_price field.
+	productunitDescRetailPrice := productunitFields[4].Descriptor()
+	// productunit.RetailPriceValidator is a validator for the "retail_price" field. It is called by the builders before save.
+	productunit.RetailPriceValidator = productunitDescRetailPrice.Validators[0].(func(int64) error)
+	// productunitDescCreatedAt is the schema descriptor for created_at field.
+	productunitDescCreatedAt := productunitFields[5].Descriptor()
+	// productunit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productunit.DefaultCreatedAt = productunitDescCreatedAt.Default.(func() time.Time)
+	// productunitDescUpdatedAt is the schema descriptor for updated_at field.
+	productunitDescUpdatedAt := productunitFields[6].Descriptor()
+	// productunit.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	productunit.DefaultUpdatedAt = productunitDescUpdatedAt.Default.(func() time.Time)
+	// productunit.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	productunit.UpdateDefaultUpdatedAt = productunitDescUpdatedAt.UpdateDefault.(func() time.Time)
+	promotionFields := schema.Promotion{}.Fields()
+	_ = promotionFields
+	// promotionDescName is the schema descriptor for name field.
+	promotionDescName := promotionFields[1].Descriptor()
+	// promotion.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	promotion.NameValidator = promotionDescName.Validators[0].(func(string) error)
+	// promotionDescPercent is the schema descriptor for percent field.
+	promotionDescPercent := promotionFields[5].Descriptor()
+	// promotion.DefaultPercent holds the default value on creation for the percent field.
+	promotion.DefaultPercent = promotionDescPercent.Default.(float64)
+	// promotion.PercentValidator is a validator for the "percent" field. It is called by the builders before save.
+	promotion.PercentValidator = promotionDescPercent.Validators[0].(func(float64) error)
+	// promotionDescAmount is the schema descriptor for amount field.
+	promotionDescAmount := promotionFields[6].Descriptor()
+	// promotion.DefaultAmount holds the default value on creation for the amount field.
+	promotion.DefaultAmount = money.Amount(promotionDescAmount.Default.(int64))
+	// promotion.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	promotion.AmountValidator = promotionDescAmount.Validators[0].(func(int64) error)
+	// promotionDescBuyQuantity is the schema descriptor for buy_quantity field.
+	promotionDescBuyQuantity := promotionFields[7].Descriptor()
+	// promotion.DefaultBuyQuantity holds the default value on creation for the buy_quantity field.
+	promotion.DefaultBuyQuantity = promotionDescBuyQuantity.Default.(float64)
+	// promotion.BuyQuantityValidator is a validator for the "buy_quantity" field. It is called by the builders before save.
+	promotion.BuyQuantityValidator = promotionDescBuyQuantity.Validators[0].(func(float64) error)
+	// promotionDescFreeQuantity is the schema descriptor for free_quantity field.
+	promotionDescFreeQuantity := promotionFields[8].Descriptor()
+	// promotion.DefaultFreeQuantity holds the default value on creation for the free_quantity field.
+	promotion.DefaultFreeQuantity = promotionDescFreeQuantity.Default.(float64)
+	// promotion.FreeQuantityValidator is a validator for the "free_quantity" field. It is called by the builders before save.
+	promotion.FreeQuantityValidator = promotionDescFreeQuantity.Validators[0].(func(float64) error)
+	// promotionDescMinQuantity is the schema descriptor for min_quantity field.
+	promotionDescMinQuantity := promotionFields[9].Descriptor()
+	// promotion.DefaultMinQuantity holds the default value on creation for the min_quantity field.
+	promotion.DefaultMinQuantity = promotionDescMinQuantity.Default.(float64)
+	// promotion.MinQuantityValidator is a validator for the "min_quantity" field. It is called by the builders before save.
+	promotion.MinQuantityValidator = promotionDescMinQuantity.Validators[0].(func(float64) error)
+	// promotionDescMinAmount is the schema descriptor for min_amount field.
+	promotionDescMinAmount := promotionFields[10].Descriptor()
+	// promotion.DefaultMinAmount holds the default value on creation for the min_amount field.
+	promotion.DefaultMinAmount = money.Amount(promotionDescMinAmount.Default.(int64))
+	// promotion.MinAmountValidator is a validator for the "min_amount" field. It is called by the builders before save.
+	promotion.MinAmountValidator = promotionDescMinAmount.Validators[0].(func(int64) error)
+	// promotionDescPriority is the schema descriptor for priority field.
+	promotionDescPriority := promotionFields[17].Descriptor()
+	// promotion.DefaultPriority holds the default value on creation for the priority field.
+	promotion.DefaultPriority = promotionDescPriority.Default.(int)
+	// promotionDescActive is the schema descriptor for active field.
+	promotionDescActive := promotionFields[18].Descriptor()
+	// promotion.DefaultActive holds the default value on creation for the active field.
+	promotion.DefaultActive = promotionDescActive.Default.(bool)
+	// promotionDescCreatedAt is the schema descriptor for created_at field.
+	promotionDescCreatedAt := promotionFields[19].Descriptor()
+	// promotion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	promotion.DefaultCreatedAt = promotionDescCreatedAt.Default.(func() time.Time)
+	// promotionDescUpdatedAt is the schema descriptor for updated_at field.
+	promotionDescUpdatedAt := promotionFields[20].Descriptor()
+	// promotion.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	promotion.DefaultUpdatedAt = promotionDescUpdatedAt.Default.(func() time.Time)
+	// promotion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	promotion.UpdateDefaultUpdatedAt = promotionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	promotiontargetFields := schema.PromotionTarget{}.Fields()
+	_ = promotiontargetFields
+	// promotiontargetDescCreatedAt is the schema descriptor for created_at field.
+	promotiontargetDescCreatedAt := promotiontargetFields[3].Descriptor()
+	// promotiontarget.DefaultCreatedAt holds the default value on creation for the created_at field.
+	promotiontarget.DefaultCreatedAt = promotiontargetDescCreatedAt.Default.(func() time.Time)
 	purchaseinvoiceFields := schema.PurchaseInvoice{}.Fields()
 	_ = purchaseinvoiceFields
 	// purchaseinvoiceDescInvoiceNumber is the schema descriptor for invoice_number field.
@@ -109,23 +843,61 @@ func init() {
 	// purchaseinvoiceDescTotal is the schema descriptor for total field.
 	purchaseinvoiceDescTotal := purchaseinvoiceFields[1].Descriptor()
 	// purchaseinvoice.TotalValidator is a validator for the "total" field. It is called by the builders before save.
-	purchaseinvoice.TotalValidator = purchaseinvoiceDescTotal.Validators[0].(func(float64) error)
+	purchaseinvoice.TotalValidator = purchaseinvoiceDescTotal.Validators[0].(func(int64) error)
+	// purchaseinvoiceDescSubtotal is the schema descriptor for subtotal field.
+	purchaseinvoiceDescSubtotal := purchaseinvoiceFields[2].Descriptor()
+	// purchaseinvoice.DefaultSubtotal holds the default value on creation for the subtotal field.
+	purchaseinvoice.DefaultSubtotal = money.Amount(purchaseinvoiceDescSubtotal.Default.(int64))
+	// purchaseinvoiceDescTaxTotal is the schema descriptor for tax_total field.
+	purchaseinvoiceDescTaxTotal := purchaseinvoiceFields[3].Descriptor()
+	// purchaseinvoice.DefaultTaxTotal holds the default value on creation for the tax_total field.
+	purchaseinvoice.DefaultTaxTotal = money.Amount(purchaseinvoiceDescTaxTotal.Default.(int64))
+	// purchaseinvoiceDescPricesIncludeTax is the schema descriptor for prices_include_tax field.
+	purchaseinvoiceDescPricesIncludeTax := purchaseinvoiceFields[4].Descriptor()
+	// purchaseinvoice.DefaultPricesIncludeTax holds the default value on creation for the prices_include_tax field.
+	purchaseinvoice.DefaultPricesIncludeTax = purchaseinvoiceDescPricesIncludeTax.Default.(bool)
+	// purchaseinvoiceDescCurrency is the schema descriptor for currency field.
+	purchaseinvoiceDescCurrency := purchaseinvoiceFields[5].Descriptor()
+	// purchaseinvoice.DefaultCurrency holds the default value on creation for the currency field.
+	purchaseinvoice.DefaultCurrency = purchaseinvoiceDescCurrency.Default.(string)
+	// purchaseinvoiceDescExchangeRate is the schema descriptor for exchange_rate field.
+	purchaseinvoiceDescExchangeRate := purchaseinvoiceFields[6].Descriptor()
+	// purchaseinvoice.DefaultExchangeRate holds the default value on creation for the exchange_rate field.
+	purchaseinvoice.DefaultExchangeRate = purchaseinvoiceDescExchangeRate.Default.(float64)
+	// purchaseinvoice.ExchangeRateValidator is a validator for the "exchange_rate" field. It is called by the builders before save.
+	purchaseinvoice.ExchangeRateValidator = purchaseinvoiceDescExchangeRate.Validators[0].(func(float64) error)
+	// purchaseinvoiceDescBaseSubtotal is the schema descriptor for base_subtotal field.
+	purchaseinvoiceDescBaseSubtotal := purchaseinvoiceFields[7].Descriptor()
+	// purchaseinvoice.DefaultBaseSubtotal holds the default value on creation for the base_subtotal field.
+	purchaseinvoice.DefaultBaseSubtotal = money.Amount(purchaseinvoiceDescBaseSubtotal.Default.(int64))
+	// purchaseinvoiceDescBaseTaxTotal is the schema descriptor for base_tax_total field.
+	purchaseinvoiceDescBaseTaxTotal := purchaseinvoiceFields[8].Descriptor()
+	// purchaseinvoice.DefaultBaseTaxTotal holds the default value on creation for the base_tax_total field.
+	purchaseinvoice.DefaultBaseTaxTotal = money.Amount(purchaseinvoiceDescBaseTaxTotal.Default.(int64))
+	// purchaseinvoiceDescBaseTotal is the schema descriptor for base_total field.
+	purchaseinvoiceDescBaseTotal := purchaseinvoiceFields[9].Descriptor()
+	// purchaseinvoice.DefaultBaseTotal holds the default value on creation for the base_total field.
+	purchaseinvoice.DefaultBaseTotal = money.Amount(purchaseinvoiceDescBaseTotal.Default.(int64))
+	// purchaseinvoiceDescFxGainLoss is the schema descriptor for fx_gain_loss field.
+	purchaseinvoiceDescFxGainLoss := purchaseinvoiceFields[10].Descriptor()
+	// purchaseinvoice.DefaultFxGainLoss holds the default value on creation for the fx_gain_loss field.
+	purchaseinvoice.DefaultFxGainLoss = money.Amount(purchaseinvoiceDescFxGainLoss.Default.(int64))
 	// purchaseinvoiceDescStatus is the schema descriptor for status field.
-	purchaseinvoiceDescStatus := purchaseinvoiceFields[2].Descriptor()
+	purchaseinvoiceDescStatus := purchaseinvoiceFields[11].Descriptor()
 	// purchaseinvoice.DefaultStatus holds the default value on creation for the status field.
 	purchaseinvoice.DefaultStatus = purchaseinvoiceDescStatus.Default.(string)
 	// purchaseinvoiceDescPaidAmount is the schema descriptor for paid_amount field.
-	purchaseinvoiceDescPaidAmount := purchaseinvoiceFields[5].Descriptor()
+	purchaseinvoiceDescPaidAmount := purchaseinvoiceFields[14].Descriptor()
 	// purchaseinvoice.DefaultPaidAmount holds the default value on creation for the paid_amount field.
-	purchaseinvoice.DefaultPaidAmount = purchaseinvoiceDescPaidAmount.Default.(float64)
+	purchaseinvoice.DefaultPaidAmount = money.Amount(purchaseinvoiceDescPaidAmount.Default.(int64))
 	// purchaseinvoice.PaidAmountValidator is a validator for the "paid_amount" field. It is called by the builders before save.
-	purchaseinvoice.PaidAmountValidator = purchaseinvoiceDescPaidAmount.Validators[0].(func(float64) error)
+	purchaseinvoice.PaidAmountValidator = purchaseinvoiceDescPaidAmount.Validators[0].(func(int64) error)
 	// purchaseinvoiceDescCreatedAt is the schema descriptor for created_at field.
-	purchaseinvoiceDescCreatedAt := purchaseinvoiceFields[9].Descriptor()
+	purchaseinvoiceDescCreatedAt := purchaseinvoiceFields[18].Descriptor()
 	// purchaseinvoice.DefaultCreatedAt holds the default value on creation for the created_at field.
 	purchaseinvoice.DefaultCreatedAt = purchaseinvoiceDescCreatedAt.Default.(func() time.Time)
 	// purchaseinvoiceDescUpdatedAt is the schema descriptor for updated_at field.
-	purchaseinvoiceDescUpdatedAt := purchaseinvoiceFields[10].Descriptor()
+	purchaseinvoiceDescUpdatedAt := purchaseinvoiceFields[19].Descriptor()
 	// purchaseinvoice.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	purchaseinvoice.DefaultUpdatedAt = purchaseinvoiceDescUpdatedAt.Default.(func() time.Time)
 	// purchaseinvoice.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -135,27 +907,85 @@ func init() {
 	// purchaseinvoiceitemDescQuantity is the schema descriptor for quantity field.
 	purchaseinvoiceitemDescQuantity := purchaseinvoiceitemFields[2].Descriptor()
 	// purchaseinvoiceitem.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
-	purchaseinvoiceitem.QuantityValidator = purchaseinvoiceitemDescQuantity.Validators[0].(func(int) error)
+	purchaseinvoiceitem.QuantityValidator = purchaseinvoiceitemDescQuantity.Validators[0].(func(float64) error)
 	// purchaseinvoiceitemDescUnitCost is the schema descriptor for unit_cost field.
 	purchaseinvoiceitemDescUnitCost := purchaseinvoiceitemFields[3].Descriptor()
 	// purchaseinvoiceitem.UnitCostValidator is a validator for the "unit_cost" field. It is called by the builders before save.
-	purchaseinvoiceitem.UnitCostValidator = purchaseinvoiceitemDescUnitCost.Validators[0].(func(float64) error)
+	purchaseinvoiceitem.UnitCostValidator = purchaseinvoiceitemDescUnitCost.Validators[0].(func(int64) error)
 	// purchaseinvoiceitemDescSubtotal is the schema descriptor for subtotal field.
 	purchaseinvoiceitemDescSubtotal := purchaseinvoiceitemFields[4].Descriptor()
 	// purchaseinvoiceitem.SubtotalValidator is a validator for the "subtotal" field. It is called by the builders before save.
-	purchaseinvoiceitem.SubtotalValidator = purchaseinvoiceitemDescSubtotal.Validators[0].(func(float64) error)
+	purchaseinvoiceitem.SubtotalValidator = purchaseinvoiceitemDescSubtotal.Validators[0].(func(int64) error)
+	// purchaseinvoiceitemDescTaxRate is the schema descriptor for tax_rate field.
+	purchaseinvoiceitemDescTaxRate := purchaseinvoiceitemFields[9].Descriptor()
+	// purchaseinvoiceitem.DefaultTaxRate holds the default value on creation for the tax_rate field.
+	purchaseinvoiceitem.DefaultTaxRate = purchaseinvoiceitemDescTaxRate.Default.(float64)
+	// purchaseinvoiceitemDescTaxBase is the schema descriptor for tax_base field.
+	purchaseinvoiceitemDescTaxBase := purchaseinvoiceitemFields[10].Descriptor()
+	// purchaseinvoiceitem.DefaultTaxBase holds the default value on creation for the tax_base field.
+	purchaseinvoiceitem.DefaultTaxBase = money.Amount(purchaseinvoiceitemDescTaxBase.Default.(int64))
+	// purchaseinvoiceitemDescTaxAmount is the schema descriptor for tax_amount field.
+	purchaseinvoiceitemDescTaxAmount := purchaseinvoiceitemFields[11].Descriptor()
+	// purchaseinvoiceitem.DefaultTaxAmount holds the default value on creation for the tax_amount field.
+	purchaseinvoiceitem.DefaultTaxAmount = money.Amount(purchaseinvoiceitemDescTaxAmount.Default.(int64))
+	stockadjustmentFields := schema.StockAdjustment{}.Fields()
+	_ = stockadjustmentFields
+	// stockadjustmentDescStatus is the schema descriptor for status field.
+	stockadjustmentDescStatus := stockadjustmentFields[3].Descriptor()
+	// stockadjustment.DefaultStatus holds the default value on creation for the status field.
+	stockadjustment.DefaultStatus = stockadjustmentDescStatus.Default.(string)
+	// stockadjustmentDescSource is the schema descriptor for source field.
+	stockadjustmentDescSource := stockadjustmentFields[4].Descriptor()
+	// stockadjustment.DefaultSource holds the default value on creation for the source field.
+	stockadjustment.DefaultSource = stockadjustmentDescSource.Default.(string)
+	// stockadjustmentDescTotalValue is the schema descriptor for total_value field.
+	stockadjustmentDescTotalValue := stockadjustmentFields[5].Descriptor()
+	// stockadjustment.DefaultTotalValue holds the default value on creation for the total_value field.
+	stockadjustment.DefaultTotalValue = money.Amount(stockadjustmentDescTotalValue.Default.(int64))
+	// stockadjustmentDescCreatedAt is the schema descriptor for created_at field.
+	stockadjustmentDescCreatedAt := stockadjustmentFields[10].Descriptor()
+	// stockadjustment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	stockadjustment.DefaultCreatedAt = stockadjustmentDescCreatedAt.Default.(func() time.Time)
+	// stockadjustmentDescUpdatedAt is the schema descriptor for updated_at field.
+	stockadjustmentDescUpdatedAt := stockadjustmentFields[11].Descriptor()
+	// stockadjustment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	stockadjustment.DefaultUpdatedAt = stockadjustmentDescUpdatedAt.Default.(func() time.Time)
+	// stockadjustment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	stockadjustment.UpdateDefaultUpdatedAt = stockadjustmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	stockadjustmentlineFields := schema.StockAdjustmentLine{}.Fields()
+	_ = stockadjustmentlineFields
+	// stockadjustmentlineDescUnitCost is the schema descriptor for unit_cost field.
+	stockadjustmentlineDescUnitCost := stockadjustmentlineFields[4].Descriptor()
+	// stockadjustmentline.DefaultUnitCost holds the default value on creation for the unit_cost field.
+	stockadjustmentline.DefaultUnitCost = money.Amount(stockadjustmentlineDescUnitCost.Default.(int64))
+	// stockadjustmentline.UnitCostValidator is a validator for the "unit_cost" field. It is called by the builders before save.
+	stockadjustmentline.UnitCostValidator = stockadjustmentlineDescUnitCost.Validators[0].(func(int64) error)
+	// stockadjustmentlineDescTotalCost is the schema descriptor for total_cost field.
+	stockadjustmentlineDescTotalCost := stockadjustmentlineFields[5].Descriptor()
+	// stockadjustmentline.DefaultTotalCost holds the default value on creation for the total_cost field.
+	stockadjustmentline.DefaultTotalCost = money.Amount(stockadjustmentlineDescTotalCost.Default.(int64))
 	supplierFields := schema.Supplier{}.Fields()
 	_ = supplierFields
 	// supplierDescName is the schema descriptor for name field.
 	supplierDescName := supplierFields[0].Descriptor()
 	// supplier.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	supplier.NameValidator = supplierDescName.Validators[0].(func(string) error)
+	// supplierDescLeadTimeDays is the schema descriptor for lead_time_days field.
+	supplierDescLeadTimeDays := supplierFields[5].Descriptor()
+	// supplier.DefaultLeadTimeDays holds the default value on creation for the lead_time_days field.
+	supplier.DefaultLeadTimeDays = supplierDescLeadTimeDays.Default.(int)
+	// supplier.LeadTimeDaysValidator is a validator for the "lead_time_days" field. It is called by the builders before save.
+	supplier.LeadTimeDaysValidator = supplierDescLeadTimeDays.Validators[0].(func(int) error)
+	// supplierDescArchived is the schema descriptor for archived field.
+	supplierDescArchived := supplierFields[6].Descriptor()
+	// supplier.DefaultArchived holds the default value on creation for the archived field.
+	supplier.DefaultArchived = supplierDescArchived.Default.(bool)
 	// supplierDescCreatedAt is the schema descriptor for created_at field.
-	supplierDescCreatedAt := supplierFields[6].Descriptor()
+	supplierDescCreatedAt := supplierFields[9].Descriptor()
 	// supplier.DefaultCreatedAt holds the default value on creation for the created_at field.
 	supplier.DefaultCreatedAt = supplierDescCreatedAt.Default.(func() time.Time)
 	// supplierDescUpdatedAt is the schema descriptor for updated_at field.
-	supplierDescUpdatedAt := supplierFields[7].Descriptor()
+	supplierDescUpdatedAt := supplierFields[10].Descriptor()
 	// supplier.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	supplier.DefaultUpdatedAt = supplierDescUpdatedAt.Default.(func() time.Time)
 	// supplier.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -165,25 +995,91 @@ func init() {
 	// supplierpaymentDescAmount is the schema descriptor for amount field.
 	supplierpaymentDescAmount := supplierpaymentFields[2].Descriptor()
 	// supplierpayment.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
-	supplierpayment.AmountValidator = supplierpaymentDescAmount.Validators[0].(func(float64) error)
+	supplierpayment.AmountValidator = supplierpaymentDescAmount.Validators[0].(func(int64) error)
+	// supplierpaymentDescCurrency is the schema descriptor for currency field.
+	supplierpaymentDescCurrency := supplierpaymentFields[3].Descriptor()
+	// supplierpayment.DefaultCurrency holds the default value on creation for the currency field.
+	supplierpayment.DefaultCurrency = supplierpaymentDescCurrency.Default.(string)
+	// supplierpaymentDescExchangeRate is the schema descriptor for exchange_rate field.
+	supplierpaymentDescExchangeRate := supplierpaymentFields[4].Descriptor()
+	// supplierpayment.DefaultExchangeRate holds the default value on creation for the exchange_rate field.
+	supplierpayment.DefaultExchangeRate = supplierpaymentDescExchangeRate.Default.(float64)
+	// supplierpayment.ExchangeRateValidator is a validator for the "exchange_rate" field. It is called by the builders before save.
+	supplierpayment.ExchangeRateValidator = supplierpaymentDescExchangeRate.Validators[0].(func(float64) error)
+	// supplierpaymentDescBaseAmount is the schema descriptor for base_amount field.
+	supplierpaymentDescBaseAmount := supplierpaymentFields[5].Descriptor()
+	// supplierpayment.DefaultBaseAmount holds the default value on creation for the base_amount field.
+	supplierpayment.DefaultBaseAmount = money.Amount(supplierpaymentDescBaseAmount.Default.(int64))
+	// supplierpaymentDescFxGainLoss is the schema descriptor for fx_gain_loss field.
+	supplierpaymentDescFxGainLoss := supplierpaymentFields[6].Descriptor()
+	// supplierpayment.DefaultFxGainLoss holds the default value on creation for the fx_gain_loss field.
+	supplierpayment.DefaultFxGainLoss = money.Amount(supplierpaymentDescFxGainLoss.Default.(int64))
 	// supplierpaymentDescPaymentDate is the schema descriptor for payment_date field.
-	supplierpaymentDescPaymentDate := supplierpaymentFields[3].Descriptor()
+	supplierpaymentDescPaymentDate := supplierpaymentFields[7].Descriptor()
 	// supplierpayment.DefaultPaymentDate holds the default value on creation for the payment_date field.
 	supplierpayment.DefaultPaymentDate = supplierpaymentDescPaymentDate.Default.(func() time.Time)
 	// supplierpaymentDescPaymentMethod is the schema descriptor for payment_method field.
-	supplierpaymentDescPaymentMethod := supplierpaymentFields[4].Descriptor()
+	supplierpaymentDescPaymentMethod := supplierpaymentFields[8].Descriptor()
 	// supplierpayment.PaymentMethodValidator is a validator for the "payment_method" field. It is called by the builders before save.
 	supplierpayment.PaymentMethodValidator = supplierpaymentDescPaymentMethod.Validators[0].(func(string) error)
 	// supplierpaymentDescCreatedAt is the schema descriptor for created_at field.
-	supplierpaymentDescCreatedAt := supplierpaymentFields[9].Descriptor()
+	supplierpaymentDescCreatedAt := supplierpaymentFields[13].Descriptor()
 	// supplierpayment.DefaultCreatedAt holds the default value on creation for the created_at field.
 	supplierpayment.DefaultCreatedAt = supplierpaymentDescCreatedAt.Default.(func() time.Time)
 	// supplierpaymentDescUpdatedAt is the schema descriptor for updated_at field.
-	supplierpaymentDescUpdatedAt := supplierpaymentFields[10].Descriptor()
+	supplierpaymentDescUpdatedAt := supplierpaymentFields[14].Descriptor()
 	// supplierpayment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	supplierpayment.DefaultUpdatedAt = supplierpaymentDescUpdatedAt.Default.(func() time.Time)
 	// supplierpayment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	supplierpayment.UpdateDefaultUpdatedAt = supplierpaymentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	taxrateFields := schema.TaxRate{}.Fields()
+	_ = taxrateFields
+	// taxrateDescName is the schema descriptor for name field.
+	taxrateDescName := taxrateFields[1].Descriptor()
+	// taxrate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	taxrate.NameValidator = taxrateDescName.Validators[0].(func(string) error)
+	// taxrateDescRate is the schema descriptor for rate field.
+	taxrateDescRate := taxrateFields[2].Descriptor()
+	// taxrate.DefaultRate holds the default value on creation for the rate field.
+	taxrate.DefaultRate = taxrateDescRate.Default.(float64)
+	// taxrate.RateValidator is a validator for the "rate" field. It is called by the builders before save.
+	taxrate.RateValidator = func() func(float64) error {
+		validators := taxrateDescRate.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(rate float64) error {
+			for _, fn := range fns {
+				if err := fn(rate); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// taxrateDescKind is the schema descriptor for kind field.
+	taxrateDescKind := taxrateFields[3].Descriptor()
+	// taxrate.DefaultKind holds the default value on creation for the kind field.
+	taxrate.DefaultKind = taxrateDescKind.Default.(string)
+	// taxrateDescIsDefault is the schema descriptor for is_default field.
+	taxrateDescIsDefault := taxrateFields[4].Descriptor()
+	// taxrate.DefaultIsDefault holds the default value on creation for the is_default field.
+	taxrate.DefaultIsDefault = taxrateDescIsDefault.Default.(bool)
+	// taxrateDescActive is the schema descriptor for active field.
+	taxrateDescActive := taxrateFields[5].Descriptor()
+	// taxrate.DefaultActive holds the default value on creation for the active field.
+	taxrate.DefaultActive = taxrateDescActive.Default.(bool)
+	// taxrateDescCreatedAt is the schema descriptor for created_at field.
+	taxrateDescCreatedAt := taxrateFields[6].Descriptor()
+	// taxrate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taxrate.DefaultCreatedAt = taxrateDescCreatedAt.Default.(func() time.Time)
+	// taxrateDescUpdatedAt is the schema descriptor for updated_at field.
+	taxrateDescUpdatedAt := taxrateFields[7].Descriptor()
+	// taxrate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	taxrate.DefaultUpdatedAt = taxrateDescUpdatedAt.Default.(func() time.Time)
+	// taxrate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	taxrate.UpdateDefaultUpdatedAt = taxrateDescUpdatedAt.UpdateDefault.(func() time.Time)
 	tenantFields := schema.Tenant{}.Fields()
 	_ = tenantFields
 	// tenantDescName is the schema descriptor for name field.
@@ -194,12 +1090,74 @@ func init() {
 	tenantDescSlug := tenantFields[1].Descriptor()
 	// tenant.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
 	tenant.SlugValidator = tenantDescSlug.Validators[0].(func(string) error)
+	// tenantDescCostingMethod is the schema descriptor for costing_method field.
+	tenantDescCostingMethod := tenantFields[3].Descriptor()
+	// tenant.DefaultCostingMethod holds the default value on creation for the costing_method field.
+	tenant.DefaultCostingMethod = tenantDescCostingMethod.Default.(string)
+	// tenantDescAdjustmentApprovalThreshold is the schema descriptor for adjustment_approval_threshold field.
+	tenantDescAdjustmentApprovalThreshold := tenantFields[4].Descriptor()
+	// tenant.DefaultAdjustmentApprovalThreshold holds the default value on creation for the adjustment_approval_threshold field.
+	tenant.DefaultAdjustmentApprovalThreshold = money.Amount(tenantDescAdjustmentApprovalThreshold.Default.(int64))
+	// tenant.AdjustmentApprovalThresholdValidator is a validator for the "adjustment_approval_threshold" field. It is called by the builders before save.
+	tenant.AdjustmentApprovalThresholdValidator = tenantDescAdjustmentApprovalThreshold.Validators[0].(func(int64) error)
+	// tenantDescUserDiscountLimit is the schema descriptor for user_discount_limit field.
+	tenantDescUserDiscountLimit := tenantFields[5].Descriptor()
+	// tenant.DefaultUserDiscountLimit holds the default value on creation for the user_discount_limit field.
+	tenant.DefaultUserDiscountLimit = tenantDescUserDiscountLimit.Default.(float64)
+	// tenant.UserDiscountLimitValidator is a validator for the "user_discount_limit" field. It is called by the builders before save.
+	tenant.UserDiscountLimitValidator = func() func(float64) error {
+		validators := tenantDescUserDiscountLimit.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(user_discount_limit float64) error {
+			for _, fn := range fns {
+				if err := fn(user_discount_limit); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tenantDescManagerDiscountLimit is the schema descriptor for manager_discount_limit field.
+	tenantDescManagerDiscountLimit := tenantFields[6].Descriptor()
+	// tenant.DefaultManagerDiscountLimit holds the default value on creation for the manager_discount_limit field.
+	tenant.DefaultManagerDiscountLimit = tenantDescManagerDiscountLimit.Default.(float64)
+	// tenant.ManagerDiscountLimitValidator is a validator for the "manager_discount_limit" field. It is called by the builders before save.
+	tenant.ManagerDiscountLimitValidator = func() func(float64) error {
+		validators := tenantDescManagerDiscountLimit.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(manager_discount_limit float64) error {
+			for _, fn := range fns {
+				if err := fn(manager_discount_limit); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tenantDescPricesIncludeTax is the schema descriptor for prices_include_tax field.
+	tenantDescPricesIncludeTax := tenantFields[7].Descriptor()
+	// tenant.DefaultPricesIncludeTax holds the default value on creation for the prices_include_tax field.
+	tenant.DefaultPricesIncludeTax = tenantDescPricesIncludeTax.Default.(bool)
+	// tenantDescBaseCurrency is the schema descriptor for base_currency field.
+	tenantDescBaseCurrency := tenantFields[8].Descriptor()
+	// tenant.DefaultBaseCurrency holds the default value on creation for the base_currency field.
+	tenant.DefaultBaseCurrency = tenantDescBaseCurrency.Default.(string)
+	// tenantDescEinvoiceEnvironment is the schema descriptor for einvoice_environment field.
+	tenantDescEinvoiceEnvironment := tenantFields[16].Descriptor()
+	// tenant.DefaultEinvoiceEnvironment holds the default value on creation for the einvoice_environment field.
+	tenant.DefaultEinvoiceEnvironment = tenantDescEinvoiceEnvironment.Default.(string)
 	// tenantDescCreatedAt is the schema descriptor for created_at field.
-	tenantDescCreatedAt := tenantFields[3].Descriptor()
+	tenantDescCreatedAt := tenantFields[23].Descriptor()
 	// tenant.DefaultCreatedAt holds the default value on creation for the created_at field.
 	tenant.DefaultCreatedAt = tenantDescCreatedAt.Default.(func() time.Time)
 	// tenantDescUpdatedAt is the schema descriptor for updated_at field.
-	tenantDescUpdatedAt := tenantFields[4].Descriptor()
+	tenantDescUpdatedAt := tenantFields[24].Descriptor()
 	// tenant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	tenant.DefaultUpdatedAt = tenantDescUpdatedAt.Default.(func() time.Time)
 	// tenant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -222,12 +1180,16 @@ func init() {
 	userDescRole := userFields[3].Descriptor()
 	// user.DefaultRole holds the default value on creation for the role field.
 	user.DefaultRole = userDescRole.Default.(string)
+	// userDescArchived is the schema descriptor for archived field.
+	userDescArchived := userFields[4].Descriptor()
+	// user.DefaultArchived holds the default value on creation for the archived field.
+	user.DefaultArchived = userDescArchived.Default.(bool)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[5].Descriptor()
+	userDescCreatedAt := userFields[7].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[6].Descriptor()
+	userDescUpdatedAt := userFields[8].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AdjustmentReason holds the schema definition for the AdjustmentReason entity.
+type AdjustmentReason struct {
+	ent.Schema
+}
+
+// Fields of the AdjustmentReason.
+func (AdjustmentReason) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.String("code").
+			NotEmpty().
+			Comment("Código del motivo (damaged, theft, expired, internal_use, ...)"),
+		field.String("name").
+			NotEmpty().
+			Comment("Nombre visible del motivo"),
+		field.Bool("write_off").
+			Default(true).
+			Comment("Indica si el motivo es una baja que cuenta como merma"),
+		field.Bool("active").
+			Default(true).
+			Comment("Los motivos inactivos no se pueden usar en nuevos ajustes"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the AdjustmentReason.
+func (AdjustmentReason) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AdjustmentReason.
+func (AdjustmentReason) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "code").Unique(),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.Int("user_id").
+			Comment("ID del usuario que hizo el cambio"),
+		field.String("action").
+			NotEmpty().
+			Comment("Acción registrada, como product.bulk_update"),
+		field.String("entity").
+			NotEmpty().
+			Comment("Tipo de registro afectado (product, invoice...)"),
+		field.Int("entity_id").
+			Optional().
+			Comment("ID del registro afectado; vacío si el cambio abarca varios"),
+		field.String("summary").
+			Comment("Descripción legible del cambio"),
+		field.Text("details").
+			Optional().
+			Comment("Detalle del cambio en JSON (operación y valores antes y después)"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "created_at"),
+		index.Fields("tenant_id", "entity", "entity_id"),
+	}
+}
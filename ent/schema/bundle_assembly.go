@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"Veritasbackend/pkg/money"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BundleAssembly holds the schema definition for the BundleAssembly entity.
+type BundleAssembly struct {
+	ent.Schema
+}
+
+// Fields of the BundleAssembly.
+func (BundleAssembly) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.Int("bundle_id").
+			Comment("ID del producto combo armado"),
+		field.Float("quantity").
+			Positive().
+			Comment("Unidades de combo armadas"),
+		field.Int64("unit_cost").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Min(0).
+			Comment("Costo de una unidad armada (suma del costo de sus componentes)"),
+		field.Text("notes").
+			Optional().
+			Comment("Observaciones del armado"),
+		field.Int("user_id").
+			Comment("ID del usuario que registró el armado"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the BundleAssembly.
+func (BundleAssembly) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the BundleAssembly.
+func (BundleAssembly) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "created_at"),
+		index.Fields("bundle_id"),
+	}
+}
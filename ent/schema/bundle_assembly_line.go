@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"Veritasbackend/pkg/money"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BundleAssemblyLine holds the schema definition for the BundleAssemblyLine entity.
+type BundleAssemblyLine struct {
+	ent.Schema
+}
+
+// Fields of the BundleAssemblyLine.
+func (BundleAssemblyLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("assembly_id").
+			Comment("ID del armado"),
+		field.Int("product_id").
+			Comment("ID del componente consumido"),
+		field.Float("quantity").
+			Positive().
+			Comment("Cantidad consumida del componente, en su unidad base"),
+		field.Int64("unit_cost").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Min(0).
+			Comment("Costo unitario con que salió el componente"),
+	}
+}
+
+// Edges of the BundleAssemblyLine.
+func (BundleAssemblyLine) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the BundleAssemblyLine.
+func (BundleAssemblyLine) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("assembly_id"),
+		index.Fields("product_id"),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BundleComponent holds the schema definition for the BundleComponent entity.
+type BundleComponent struct {
+	ent.Schema
+}
+
+// Fields of the BundleComponent.
+func (BundleComponent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.Int("bundle_id").
+			Comment("ID del producto combo"),
+		field.Int("component_id").
+			Comment("ID del producto que forma parte del combo"),
+		field.Float("quantity").
+			Positive().
+			Comment("Cantidad del componente por unidad de combo, en su unidad base"),
+		field.Float("revenue_share").
+			Optional().
+			Nillable().
+			Min(0).
+			Max(100).
+			Comment("Porcentaje del ingreso del combo que se asigna al componente con reparto manual"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the BundleComponent.
+func (BundleComponent) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the BundleComponent.
+func (BundleComponent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("bundle_id", "component_id").
+			Unique(),
+		index.Fields("component_id"),
+	}
+}
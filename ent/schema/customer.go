@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Customer holds the schema definition for the Customer entity.
+type Customer struct {
+	ent.Schema
+}
+
+// Fields of the Customer.
+func (Customer) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Comment("Nombre o razón social del cliente"),
+		field.String("document_type").
+			Optional().
+			Comment("Tipo de documento (NIT, RUC, CC, CE, PASSPORT, ...)"),
+		field.String("document_number").
+			Optional().
+			Comment("Número de documento normalizado, sin puntos, guiones ni espacios"),
+		field.String("email").
+			Optional().
+			Comment("Email de contacto"),
+		field.String("phone").
+			Optional().
+			Comment("Teléfono de contacto"),
+		field.Text("address").
+			Optional().
+			Comment("Dirección del cliente"),
+		field.String("tax_regime").
+			Optional().
+			Comment("Régimen tributario del cliente"),
+		field.String("customer_group").
+			Optional().
+			Comment("Grupo del cliente (mayorista, VIP, ...) para las promociones"),
+		field.Int("price_list_id").
+			Optional().
+			Nillable().
+			Comment("Lista de precios que se aplica a sus facturas si no se elige otra"),
+		field.Bool("archived").
+			Default(false).
+			Comment("Eliminado de forma lógica: no aparece en listados ni búsquedas pero sigue resolviéndose desde las facturas"),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Fecha de eliminación lógica"),
+		field.Int("tenant_id").
+			Comment("ID del tenant al que pertenece"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Customer.
+func (Customer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("price_list", PriceList.Type).
+			Field("price_list_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Customer.
+func (Customer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "archived"),
+		index.Fields("tenant_id", "document_number").Unique(),
+	}
+}
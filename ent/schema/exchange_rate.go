@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ExchangeRate holds the schema definition for the ExchangeRate entity.
+type ExchangeRate struct {
+	ent.Schema
+}
+
+// Fields of the ExchangeRate.
+func (ExchangeRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.String("currency").
+			NotEmpty().
+			Comment("Código ISO 4217 de la moneda extranjera"),
+		field.Time("date").
+			Comment("Día desde el que rige la tasa"),
+		field.Float("rate").
+			Positive().
+			Comment("Unidades de la moneda base del tenant por una unidad de la moneda"),
+		field.String("source").
+			Default("manual").
+			Comment("Origen de la tasa (manual, csv)"),
+		field.Int("user_id").
+			Optional().
+			Nillable().
+			Comment("ID del usuario que registró la tasa"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ExchangeRate.
+func (ExchangeRate) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ExchangeRate.
+func (ExchangeRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "currency", "date").Unique(),
+	}
+}
@@ -0,0 +1,106 @@
+package schema
+
+import (
+	"time"
+
+	"Veritasbackend/pkg/money"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FiscalDocument holds the schema definition for the FiscalDocument entity.
+type FiscalDocument struct {
+	ent.Schema
+}
+
+// Fields of the FiscalDocument.
+func (FiscalDocument) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.Int("invoice_id").
+			Comment("ID de la factura de venta"),
+		field.String("document_type").
+			Comment("Tipo de documento electrónico (invoice, credit_note)"),
+		field.String("number").
+			Optional().
+			Nillable().
+			Comment("Número del documento ante la autoridad tributaria"),
+		field.Int("reference_id").
+			Optional().
+			Nillable().
+			Comment("Documento electrónico de la factura que corrige una nota crédito"),
+		field.String("discrepancy_code").
+			Optional().
+			Comment("Código del concepto de corrección de la nota crédito"),
+		field.Text("discrepancy_reason").
+			Optional().
+			Comment("Descripción de la corrección de la nota crédito"),
+		field.String("currency").
+			Default("COP").
+			Comment("Moneda del documento"),
+		field.Int64("total").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Total a pagar del documento"),
+		field.String("cufe").
+			Optional().
+			Comment("Código único del documento (CUFE o CUDE)"),
+		field.String("digest").
+			Optional().
+			Comment("Resumen SHA-256 del XML firmado en base64"),
+		field.String("status").
+			Default("pending").
+			Comment("Estado ante la autoridad (pending, accepted, rejected, failed)"),
+		field.String("provider").
+			Optional().
+			Comment("Proveedor por el que se envió el documento"),
+		field.String("track_id").
+			Optional().
+			Comment("Identificador del envío en el proveedor"),
+		field.Strings("rejection_reasons").
+			Optional().
+			Comment("Motivos del rechazo o del error del envío"),
+		field.String("xml_key").
+			Optional().
+			Comment("Clave del XML firmado en el almacenamiento"),
+		field.String("pdf_key").
+			Optional().
+			Comment("Clave de la representación gráfica en PDF en el almacenamiento"),
+		field.Time("issued_at").
+			Default(time.Now).
+			Comment("Fecha y hora de emisión del documento"),
+		field.Time("submitted_at").
+			Optional().
+			Nillable().
+			Comment("Último envío al proveedor"),
+		field.Time("accepted_at").
+			Optional().
+			Nillable().
+			Comment("Aceptación de la autoridad"),
+		field.Int("user_id").
+			Comment("ID del usuario que emitió el documento"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the FiscalDocument.
+func (FiscalDocument) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the FiscalDocument.
+func (FiscalDocument) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "status"),
+		index.Fields("invoice_id"),
+		index.Fields("tenant_id", "document_type", "number").Unique(),
+	}
+}
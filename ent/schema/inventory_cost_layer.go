@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"Veritasbackend/pkg/money"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InventoryCostLayer holds the schema definition for the InventoryCostLayer entity.
+type InventoryCostLayer struct {
+	ent.Schema
+}
+
+// Fields of the InventoryCostLayer.
+func (InventoryCostLayer) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.Int("product_id").
+			Comment("ID del producto"),
+		field.String("source_type").
+			Comment("Origen de la capa (opening, purchase, adjustment, inventory_count, assembly, invoice_cancel, return)"),
+		field.Int("source_id").
+			Optional().
+			Comment("ID del documento que originó la capa"),
+		field.Float("quantity").
+			Positive().
+			Comment("Unidades que ingresaron en la capa"),
+		field.Float("remaining").
+			Min(0).
+			Comment("Unidades de la capa que aún no se han consumido"),
+		field.Int64("unit_cost").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Min(0).
+			Comment("Costo unitario de ingreso"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the InventoryCostLayer.
+func (InventoryCostLayer) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the InventoryCostLayer.
+func (InventoryCostLayer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "remaining"),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InventoryCount holds the schema definition for the InventoryCount entity.
+type InventoryCount struct {
+	ent.Schema
+}
+
+// Fields of the InventoryCount.
+func (InventoryCount) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Comment("Nombre de la toma de inventario"),
+		field.Text("notes").
+			Optional().
+			Comment("Notas de la toma de inventario"),
+		field.String("status").
+			Default("open").
+			Comment("Estado de la toma (open, posted, cancelled)"),
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.Int("user_id").
+			Comment("ID del usuario que abrió la toma"),
+		field.Int("posted_by").
+			Optional().
+			Comment("ID del usuario que contabilizó las diferencias"),
+		field.Time("posted_at").
+			Optional().
+			Nillable().
+			Comment("Fecha en que se contabilizaron las diferencias"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the InventoryCount.
+func (InventoryCount) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the InventoryCount.
+func (InventoryCount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("status"),
+	}
+}
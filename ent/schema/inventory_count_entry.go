@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InventoryCountEntry holds the schema definition for the InventoryCountEntry entity.
+type InventoryCountEntry struct {
+	ent.Schema
+}
+
+// Fields of the InventoryCountEntry.
+func (InventoryCountEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("count_id").
+			Comment("ID de la toma de inventario"),
+		field.Int("line_id").
+			Comment("ID de la línea contada"),
+		field.Float("quantity").
+			Comment("Cantidad registrada en este conteo"),
+		field.String("device_id").
+			Optional().
+			Comment("Identificador del dispositivo que registró el conteo"),
+		field.Int("user_id").
+			Comment("ID del usuario que contó"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the InventoryCountEntry.
+func (InventoryCountEntry) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the InventoryCountEntry.
+func (InventoryCountEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("count_id"),
+		index.Fields("line_id"),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"Veritasbackend/pkg/money"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InventoryCountLine holds the schema definition for the InventoryCountLine entity.
+type InventoryCountLine struct {
+	ent.Schema
+}
+
+// Fields of the InventoryCountLine.
+func (InventoryCountLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("count_id").
+			Comment("ID de la toma de inventario"),
+		field.Int("product_id").
+			Comment("ID del producto"),
+		field.Float("expected_quantity").
+			Comment("Stock del sistema al abrir la toma"),
+		field.Float("counted_quantity").
+			Optional().
+			Nillable().
+			Comment("Cantidad contada (suma de los conteos de todos los dispositivos)"),
+		field.Int64("unit_cost").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Min(0).
+			Comment("Costo unitario al abrir la toma, usado para valorizar diferencias"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the InventoryCountLine.
+func (InventoryCountLine) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the InventoryCountLine.
+func (InventoryCountLine) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("count_id", "product_id").Unique(),
+	}
+}
@@ -3,6 +3,7 @@ package schema
 import (
 	"time"
 
+	"Veritasbackend/pkg/money"
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -17,16 +18,100 @@ type Invoice struct {
 // Fields of the Invoice.
 func (Invoice) Fields() []ent.Field {
 	return []ent.Field{
-		field.Float("total").
+		field.Int64("total").
+			GoType(money.Zero).
+			SchemaType(moneyType).
 			Min(0).
 			Comment("Total de la factura"),
+		field.Int64("subtotal").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Suma de las bases gravables, sin impuestos"),
+		field.Int64("tax_total").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Suma de los impuestos"),
+		field.Int64("discount_amount").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Descuento sobre el total de la factura, repartido entre las líneas"),
+		field.Int64("discount_total").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Suma de los descuentos de línea y de factura"),
+		field.Int64("promotion_total").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Suma de los descuentos por promociones"),
+		field.String("coupon_code").
+			Optional().
+			Comment("Cupón usado en la factura"),
+		field.Int("discount_approved_by").
+			Optional().
+			Nillable().
+			Comment("Usuario que autorizó un descuento por encima del límite del vendedor"),
+		field.Bool("prices_include_tax").
+			Default(false).
+			Comment("Los precios de las líneas incluían impuestos"),
+		field.String("currency").
+			Default("COP").
+			Comment("Moneda del documento (código ISO 4217)"),
+		field.Float("exchange_rate").
+			Default(1).
+			Positive().
+			Comment("Unidades de la moneda base por una unidad de la moneda del documento"),
+		field.Int64("base_subtotal").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Subtotal en la moneda base"),
+		field.Int64("base_tax_total").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Impuestos en la moneda base"),
+		field.Int64("base_total").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Total en la moneda base"),
+		field.Float("payment_exchange_rate").
+			Optional().
+			Nillable().
+			Comment("Tasa de cambio del día en que se cobró la factura"),
+		field.Int64("fx_gain_loss").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Diferencia en cambio realizada al cobrar, en la moneda base (positiva = ganancia)"),
+		field.String("number").
+			Optional().
+			Nillable().
+			Comment("Número consecutivo de la factura en la serie del tenant, con su prefijo"),
+		field.Int("series_id").
+			Optional().
+			Nillable().
+			Comment("Serie de numeración que asignó el número"),
 		field.String("status").
 			Default("pending").
-			Comment("Estado de la factura (pending, paid, cancelled)"),
+			Comment("Estado de la factura (pending, paid, cancelled, voided)"),
 		field.Int("tenant_id").
 			Comment("ID del tenant"),
 		field.Int("user_id").
 			Comment("ID del usuario que creó la factura"),
+		field.Int("price_list_id").
+			Optional().
+			Nillable().
+			Comment("Lista de precios elegida para la factura"),
+		field.Int("customer_id").
+			Optional().
+			Nillable().
+			Comment("Cliente al que se emitió la factura; vacío para consumidor final"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -49,5 +134,8 @@ func (Invoice) Indexes() []ent.Index {
 		index.Fields("tenant_id"),
 		index.Fields("user_id"),
 		index.Fields("status"),
+		index.Fields("customer_id"),
+		index.Fields("tenant_id", "number").
+			Unique(),
 	}
 }
@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"Veritasbackend/pkg/money"
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -19,15 +20,85 @@ func (InvoiceItem) Fields() []ent.Field {
 			Comment("ID de la factura"),
 		field.Int("product_id").
 			Comment("ID del producto"),
-		field.Int("quantity").
-			Min(1).
-			Comment("Cantidad vendida"),
-		field.Float("unit_price").
+		field.Float("quantity").
+			Positive().
+			Comment("Cantidad vendida, en la unidad base del producto"),
+		field.Int64("unit_price").
+			GoType(money.Zero).
+			SchemaType(moneyType).
 			Min(0).
-			Comment("Precio unitario al momento de la venta"),
-		field.Float("subtotal").
+			Comment("Precio por unidad base al momento de la venta"),
+		field.Int64("subtotal").
+			GoType(money.Zero).
+			SchemaType(moneyType).
 			Min(0).
 			Comment("Subtotal (quantity * unit_price)"),
+		field.Int("promotion_id").
+			Optional().
+			Nillable().
+			Comment("Promoción aplicada a la línea"),
+		field.Int64("promotion_discount").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Descuento de la promoción sobre el subtotal de la línea"),
+		field.Int64("discount_amount").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Descuento propio de la línea"),
+		field.Int64("invoice_discount_amount").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Parte del descuento de la factura que corresponde a la línea"),
+		field.Int64("unit_cost").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Min(0).
+			Comment("Costo unitario de la mercadería vendida al momento de la venta"),
+		field.Int64("cost_total").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Min(0).
+			Comment("Costo de la mercadería vendida (quantity * unit_cost)"),
+		field.String("price_rule").
+			Default("retail").
+			Comment("Regla que fijó el precio (retail, wholesale, price_list_fixed, price_list_percent)"),
+		field.Int("price_list_id").
+			Optional().
+			Nillable().
+			Comment("Lista de precios aplicada a la línea"),
+		field.Int("unit_id").
+			Optional().
+			Nillable().
+			Comment("Unidad de venta cuando no es la unidad base"),
+		field.String("unit_name").
+			Optional().
+			Comment("Nombre de la unidad de venta al momento de la venta"),
+		field.Float("unit_quantity").
+			Optional().
+			Nillable().
+			Comment("Cantidad vendida expresada en la unidad de venta"),
+		field.Int("tax_rate_id").
+			Optional().
+			Nillable().
+			Comment("Tarifa de impuesto aplicada a la línea"),
+		field.Float("tax_rate").
+			Default(0).
+			Comment("Porcentaje de impuesto aplicado a la línea"),
+		field.Int64("tax_base").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Base gravable de la línea, sin impuestos"),
+		field.Int64("tax_amount").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Impuesto de la línea"),
 	}
 }
 
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"Veritasbackend/pkg/money"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InvoiceItemComponent holds the schema definition for the InvoiceItemComponent entity.
+type InvoiceItemComponent struct {
+	ent.Schema
+}
+
+// Fields of the InvoiceItemComponent.
+func (InvoiceItemComponent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("invoice_id").
+			Comment("ID de la factura de venta"),
+		field.Int("invoice_item_id").
+			Comment("ID del item del combo vendido"),
+		field.Int("bundle_id").
+			Comment("ID del producto combo"),
+		field.Int("product_id").
+			Comment("ID del componente"),
+		field.Float("quantity").
+			Positive().
+			Comment("Cantidad del componente incluida en la venta, en su unidad base"),
+		field.Int64("revenue").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Parte del subtotal del combo asignada al componente según el reparto configurado"),
+		field.Int64("cost_total").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Parte del costo del combo asignada al componente"),
+		field.Float("built_quantity").
+			Default(0).
+			Comment("Cantidad del componente armada en el momento de la venta y descontada de su stock"),
+		field.Int64("built_unit_cost").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Costo unitario del componente descontado al armar el combo en la venta"),
+	}
+}
+
+// Edges of the InvoiceItemComponent.
+func (InvoiceItemComponent) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the InvoiceItemComponent.
+func (InvoiceItemComponent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("invoice_id"),
+		index.Fields("product_id"),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InvoiceLotAllocation holds the schema definition for the InvoiceLotAllocation entity.
+type InvoiceLotAllocation struct {
+	ent.Schema
+}
+
+// Fields of the InvoiceLotAllocation.
+func (InvoiceLotAllocation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("invoice_id").
+			Comment("ID de la factura de venta"),
+		field.Int("product_id").
+			Comment("ID del producto vendido"),
+		field.Int("lot_id").
+			Comment("ID del lote consumido"),
+		field.Float("quantity").
+			Positive().
+			Comment("Cantidad tomada del lote"),
+	}
+}
+
+// Edges of the InvoiceLotAllocation.
+func (InvoiceLotAllocation) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the InvoiceLotAllocation.
+func (InvoiceLotAllocation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("invoice_id"),
+		index.Fields("lot_id"),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InvoiceStatusEvent holds the schema definition for the InvoiceStatusEvent entity.
+type InvoiceStatusEvent struct {
+	ent.Schema
+}
+
+// Fields of the InvoiceStatusEvent.
+func (InvoiceStatusEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("invoice_id").
+			Comment("ID de la factura de venta"),
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.String("from_status").
+			Comment("Estado anterior de la factura"),
+		field.String("to_status").
+			Comment("Estado nuevo de la factura (paid, cancelled, voided)"),
+		field.String("reason").
+			Optional().
+			Comment("Motivo informado por el usuario"),
+		field.Int("user_id").
+			Comment("ID del usuario que hizo el cambio"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the InvoiceStatusEvent.
+func (InvoiceStatusEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the InvoiceStatusEvent.
+func (InvoiceStatusEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("invoice_id"),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"Veritasbackend/pkg/money"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InvoiceTax holds the schema definition for the InvoiceTax entity.
+type InvoiceTax struct {
+	ent.Schema
+}
+
+// Fields of the InvoiceTax.
+func (InvoiceTax) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("document_type").
+			Comment("Tipo de documento (invoice, purchase_invoice)"),
+		field.Int("document_id").
+			Comment("ID de la factura de venta o de compra"),
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.Int("tax_rate_id").
+			Comment("ID de la tarifa aplicada"),
+		field.String("name").
+			Comment("Nombre de la tarifa al momento de la factura"),
+		field.String("kind").
+			Comment("Tipo de tarifa al momento de la factura (taxable, exempt, excluded)"),
+		field.Float("rate").
+			Default(0).
+			Comment("Porcentaje de la tarifa al momento de la factura"),
+		field.Int64("base").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Min(0).
+			Comment("Base gravable de las líneas con esta tarifa"),
+		field.Int64("amount").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Impuesto de las líneas con esta tarifa"),
+	}
+}
+
+// Edges of the InvoiceTax.
+func (InvoiceTax) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the InvoiceTax.
+func (InvoiceTax) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("document_type", "document_id"),
+		index.Fields("tenant_id"),
+	}
+}
@@ -0,0 +1,11 @@
+package schema
+
+import (
+	"Veritasbackend/pkg/money"
+	"entgo.io/ent/dialect"
+)
+
+// moneyType guarda los importes (money.Amount) en columnas numeric de punto fijo
+var moneyType = map[string]string{
+	dialect.Postgres: money.SchemaType,
+}
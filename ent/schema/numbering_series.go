@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NumberingSeries holds the schema definition for the NumberingSeries entity.
+type NumberingSeries struct {
+	ent.Schema
+}
+
+// Fields of the NumberingSeries.
+func (NumberingSeries) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.String("document_type").
+			Comment("Tipo de documento que numera (invoice, credit_note)"),
+		field.String("name").
+			Optional().
+			Comment("Nombre descriptivo de la serie"),
+		field.String("prefix").
+			Optional().
+			Comment("Prefijo que antecede al consecutivo, por ejemplo FE"),
+		field.Int64("range_from").
+			Positive().
+			Comment("Primer número del rango autorizado"),
+		field.Int64("range_to").
+			Positive().
+			Comment("Último número del rango autorizado"),
+		field.Int64("current_number").
+			Min(0).
+			Comment("Último número asignado; range_from - 1 si la serie no se ha usado"),
+		field.Time("valid_from").
+			Optional().
+			Nillable().
+			Comment("Inicio de la vigencia de la autorización"),
+		field.Time("valid_until").
+			Optional().
+			Nillable().
+			Comment("Último día de vigencia de la autorización"),
+		field.String("resolution").
+			Optional().
+			Comment("Número de la resolución que autoriza la numeración"),
+		field.Int64("alert_remaining").
+			Default(100).
+			Min(0).
+			Comment("Números disponibles por debajo de los cuales se advierte que la serie se agota"),
+		field.Bool("active").
+			Default(true).
+			Comment("Las series inactivas no asignan números"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the NumberingSeries.
+func (NumberingSeries) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the NumberingSeries.
+func (NumberingSeries) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "document_type"),
+	}
+}
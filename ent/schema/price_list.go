@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PriceList holds the schema definition for the PriceList entity.
+type PriceList struct {
+	ent.Schema
+}
+
+// Fields of the PriceList.
+func (PriceList) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.String("name").
+			NotEmpty().
+			Comment("Nombre de la lista (distribuidores, VIP, ...)"),
+		field.Text("description").
+			Optional().
+			Comment("Descripción de la lista"),
+		field.Float("discount_percent").
+			Default(0).
+			Max(100).
+			Comment("Descuento sobre el precio detal o mayor para los productos sin precio propio en la lista; negativo para recargo"),
+		field.Bool("active").
+			Default(true).
+			Comment("Las listas inactivas no se pueden usar en nuevas facturas"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the PriceList.
+func (PriceList) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PriceList.
+func (PriceList) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").Unique(),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"Veritasbackend/pkg/money"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PriceListItem holds the schema definition for the PriceListItem entity.
+type PriceListItem struct {
+	ent.Schema
+}
+
+// Fields of the PriceListItem.
+func (PriceListItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("price_list_id").
+			Comment("ID de la lista de precios"),
+		field.Int("product_id").
+			Comment("ID del producto"),
+		field.Int("min_quantity").
+			Default(1).
+			Min(1).
+			Comment("Cantidad mínima desde la que rige este precio; varios tramos por producto forman una escala"),
+		field.Int64("price").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Optional().
+			Nillable().
+			Min(0).
+			Comment("Precio fijo del producto en la lista"),
+		field.Float("discount_percent").
+			Optional().
+			Nillable().
+			Max(100).
+			Comment("Descuento sobre el precio detal cuando no hay precio fijo; negativo para recargo"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the PriceListItem.
+func (PriceListItem) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PriceListItem.
+func (PriceListItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("price_list_id", "product_id", "min_quantity").Unique(),
+	}
+}
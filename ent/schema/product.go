@@ -3,6 +3,7 @@ package schema
 import (
 	"time"
 
+	"Veritasbackend/pkg/money"
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -22,18 +23,21 @@ func (Product) Fields() []ent.Field {
 		field.Text("description").
 			Optional().
 			Comment("Descripción del producto"),
-		field.Float("price").
-			Min(0).
-			Comment("Precio del producto (legacy - usar retail_price)"),
-		field.Float("purchase_price").
+		field.Int64("purchase_price").
+			GoType(money.Zero).
+			SchemaType(moneyType).
 			Default(0).
 			Min(0).
-			Comment("Precio de compra al proveedor"),
-		field.Float("retail_price").
+			Comment("Costo unitario del inventario; lo mantiene el método de costeo del tenant"),
+		field.Int64("retail_price").
+			GoType(money.Zero).
+			SchemaType(moneyType).
 			Default(0).
 			Min(0).
 			Comment("Precio de venta al detal"),
-		field.Float("wholesale_price").
+		field.Int64("wholesale_price").
+			GoType(money.Zero).
+			SchemaType(moneyType).
 			Optional().
 			Min(0).
 			Comment("Precio de venta al mayor"),
@@ -41,13 +45,49 @@ func (Product) Fields() []ent.Field {
 			Optional().
 			Min(1).
 			Comment("Cantidad mínima para precio mayor"),
-		field.Int("stock").
+		field.Float("stock").
 			Default(0).
 			Min(0).
-			Comment("Cantidad en stock"),
+			Comment("Cantidad en stock, en la unidad base"),
+		field.String("base_unit").
+			Default("unidad").
+			Comment("Unidad base en la que se lleva el stock (unidad, kg, litro...)"),
+		field.Bool("fractional").
+			Default(false).
+			Comment("Permite cantidades fraccionarias de la unidad base, como en productos pesados"),
+		field.Bool("bundle").
+			Default(false).
+			Comment("Producto compuesto por otros (combo, canasta, kit); se vende descontando sus componentes"),
+		field.String("revenue_split").
+			Default("retail_price").
+			Comment("Reparto del ingreso de un combo entre sus componentes en los reportes (retail_price, cost, manual)"),
 		field.String("sku").
 			Optional().
 			Comment("SKU del producto"),
+		field.String("category").
+			Optional().
+			Comment("Categoría del producto"),
+		field.Int("tax_rate_id").
+			Optional().
+			Nillable().
+			Comment("Tarifa de impuesto del producto; vacío usa la tarifa por defecto del tenant"),
+		field.Bool("track_lots").
+			Default(false).
+			Comment("Controla lotes y fechas de vencimiento"),
+		field.Bool("serialized").
+			Default(false).
+			Comment("Controla número de serie por unidad"),
+		field.Int("warranty_months").
+			Default(0).
+			Min(0).
+			Comment("Meses de garantía desde la venta"),
+		field.Bool("archived").
+			Default(false).
+			Comment("Eliminado de forma lógica: no aparece en listados ni búsquedas pero sigue resolviéndose desde los documentos"),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Fecha de eliminación lógica"),
 		field.Int("tenant_id").
 			Comment("ID del tenant al que pertenece"),
 		field.Time("created_at").
@@ -69,5 +109,7 @@ func (Product) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("tenant_id"),
 		index.Fields("sku"),
+		index.Fields("tenant_id", "category"),
+		index.Fields("tenant_id", "archived"),
 	}
 }
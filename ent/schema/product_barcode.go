@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProductBarcode holds the schema definition for the ProductBarcode entity.
+type ProductBarcode struct {
+	ent.Schema
+}
+
+// Fields of the ProductBarcode.
+func (ProductBarcode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.Int("product_id").
+			Comment("ID del producto"),
+		field.Int("unit_id").
+			Optional().
+			Nillable().
+			Comment("Unidad que identifica el código, por ejemplo la caja; vacío es la unidad base"),
+		field.String("code").
+			NotEmpty().
+			Comment("Código de barras tal como lo lee el escáner"),
+		field.String("symbology").
+			Comment("Simbología del código (ean13, ean8, upca, code128)"),
+		field.Bool("internal").
+			Default(false).
+			Comment("Indica si el código lo generó el sistema"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ProductBarcode.
+func (ProductBarcode) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ProductBarcode.
+func (ProductBarcode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "code").
+			Unique(),
+		index.Fields("product_id"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProductFile holds the schema definition for the ProductFile entity.
+type ProductFile struct {
+	ent.Schema
+}
+
+// Fields of the ProductFile.
+func (ProductFile) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.Int("product_id").
+			Comment("ID del producto"),
+		field.String("kind").
+			Default("image").
+			Comment("Tipo de archivo (image, attachment)"),
+		field.String("file_name").
+			NotEmpty().
+			Comment("Nombre original del archivo"),
+		field.String("content_type").
+			Comment("Tipo MIME del archivo"),
+		field.Int64("size").
+			Min(0).
+			Comment("Tamaño en bytes"),
+		field.String("storage_key").
+			NotEmpty().
+			Comment("Clave del archivo en el almacenamiento"),
+		field.String("thumbnail_key").
+			Optional().
+			Comment("Clave de la miniatura; solo para imágenes"),
+		field.Int("width").
+			Default(0).
+			Comment("Ancho en píxeles de la imagen original"),
+		field.Int("height").
+			Default(0).
+			Comment("Alto en píxeles de la imagen original"),
+		field.Int("position").
+			Default(0).
+			Comment("Orden de la imagen; la primera es la principal del producto"),
+		field.Int("user_id").
+			Comment("ID del usuario que subió el archivo"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ProductFile.
+func (ProductFile) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ProductFile.
+func (ProductFile) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "kind", "position"),
+		index.Fields("tenant_id"),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProductLot holds the schema definition for the ProductLot entity.
+type ProductLot struct {
+	ent.Schema
+}
+
+// Fields of the ProductLot.
+func (ProductLot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("product_id").
+			Comment("ID del producto"),
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.String("lot_number").
+			NotEmpty().
+			Comment("Número de lote del fabricante"),
+		field.Time("expiry_date").
+			Optional().
+			Nillable().
+			Comment("Fecha de vencimiento del lote"),
+		field.Float("quantity").
+			Min(0).
+			Comment("Cantidad recibida en el lote"),
+		field.Float("remaining").
+			Min(0).
+			Comment("Cantidad disponible del lote"),
+		field.Int("purchase_invoice_id").
+			Optional().
+			Comment("ID de la factura de compra con la que se recibió el lote"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ProductLot.
+func (ProductLot) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ProductLot.
+func (ProductLot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("product_id"),
+		index.Fields("expiry_date"),
+		index.Fields("product_id", "lot_number"),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"Veritasbackend/pkg/money"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProductPrice holds the schema definition for the ProductPrice entity.
+type ProductPrice struct {
+	ent.Schema
+}
+
+// Fields of the ProductPrice.
+func (ProductPrice) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.Int("product_id").
+			Comment("ID del producto"),
+		field.Int64("retail_price").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Min(0).
+			Comment("Precio de venta al detal"),
+		field.Int64("wholesale_price").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Optional().
+			Nillable().
+			Min(0).
+			Comment("Precio de venta al mayor (vacío en un cambio programado = se mantiene el vigente)"),
+		field.Int64("purchase_price").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Optional().
+			Nillable().
+			Min(0).
+			Comment("Costo unitario del producto cuando se aplicó el cambio"),
+		field.Time("effective_from").
+			Comment("Fecha desde la que rige el precio"),
+		field.String("status").
+			Default("scheduled").
+			Comment("Estado del cambio (scheduled, applied, cancelled)"),
+		field.String("source").
+			Default("manual").
+			Comment("Origen del cambio (manual, product, bulk)"),
+		field.Int("user_id").
+			Optional().
+			Comment("ID del usuario que registró el cambio"),
+		field.Time("applied_at").
+			Optional().
+			Nillable().
+			Comment("Fecha en que el cambio se aplicó al producto"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ProductPrice.
+func (ProductPrice) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ProductPrice.
+func (ProductPrice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "effective_from"),
+		index.Fields("status", "effective_from"),
+	}
+}
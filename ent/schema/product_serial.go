@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProductSerial holds the schema definition for the ProductSerial entity.
+type ProductSerial struct {
+	ent.Schema
+}
+
+// Fields of the ProductSerial.
+func (ProductSerial) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("product_id").
+			Comment("ID del producto"),
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.String("serial_number").
+			NotEmpty().
+			Comment("Número de serie de la unidad"),
+		field.String("status").
+			Default("in_stock").
+			Comment("Estado de la unidad (in_stock, sold)"),
+		field.Int("supplier_id").
+			Optional().
+			Comment("ID del proveedor que entregó la unidad"),
+		field.Int("purchase_invoice_id").
+			Optional().
+			Comment("ID de la factura de compra con la que se recibió"),
+		field.Int("invoice_id").
+			Optional().
+			Comment("ID de la factura de venta con la que se vendió"),
+		field.Time("received_at").
+			Default(time.Now).
+			Comment("Fecha de recepción"),
+		field.Time("sold_at").
+			Optional().
+			Nillable().
+			Comment("Fecha de venta"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ProductSerial.
+func (ProductSerial) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ProductSerial.
+func (ProductSerial) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "serial_number"),
+		index.Fields("product_id", "status"),
+		index.Fields("invoice_id"),
+		index.Fields("tenant_id", "product_id", "serial_number").Unique(),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProductSerialEvent holds the schema definition for the ProductSerialEvent entity.
+type ProductSerialEvent struct {
+	ent.Schema
+}
+
+// Fields of the ProductSerialEvent.
+func (ProductSerialEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("serial_id").
+			Comment("ID del número de serie"),
+		field.String("event_type").
+			Comment("Tipo de movimiento (received, sold, returned)"),
+		field.String("document_type").
+			Comment("Tipo de documento (purchase_invoice, invoice)"),
+		field.Int("document_id").
+			Comment("ID del documento que originó el movimiento"),
+		field.Int("supplier_id").
+			Optional().
+			Comment("ID del proveedor (recepciones)"),
+		field.Int("user_id").
+			Comment("ID del usuario que registró el movimiento"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ProductSerialEvent.
+func (ProductSerialEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ProductSerialEvent.
+func (ProductSerialEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("serial_id"),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"Veritasbackend/pkg/money"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProductUnit holds the schema definition for the ProductUnit entity.
+type ProductUnit struct {
+	ent.Schema
+}
+
+// Fields of the ProductUnit.
+func (ProductUnit) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.Int("product_id").
+			Comment("ID del producto"),
+		field.String("name").
+			NotEmpty().
+			Comment("Nombre de la unidad (caja x24, pack, gramo...)"),
+		field.Float("factor").
+			Positive().
+			Comment("Cantidad de unidades base que contiene una unidad"),
+		field.Int64("retail_price").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Optional().
+			Nillable().
+			Min(0).
+			Comment("Precio de venta propio de la unidad; si falta se usa el precio base por el factor"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ProductUnit.
+func (ProductUnit) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ProductUnit.
+func (ProductUnit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "name").
+			Unique(),
+		index.Fields("tenant_id"),
+	}
+}
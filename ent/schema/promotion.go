@@ -0,0 +1,107 @@
+package schema
+
+import (
+	"time"
+
+	"Veritasbackend/pkg/money"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Promotion holds the schema definition for the Promotion entity.
+type Promotion struct {
+	ent.Schema
+}
+
+// Fields of the Promotion.
+func (Promotion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.String("name").
+			NotEmpty().
+			Comment("Nombre de la promoción (2x1 fin de semana, 10% bebidas, ...)"),
+		field.Text("description").
+			Optional().
+			Comment("Descripción de la promoción"),
+		field.String("coupon_code").
+			Optional().
+			Comment("Código de cupón en mayúsculas; vacío si la promoción se aplica sola"),
+		field.String("effect").
+			Comment("Efecto sobre las líneas que cumplen (percent, fixed, free_item, bundle_price)"),
+		field.Float("percent").
+			Default(0).
+			Min(0).
+			Comment("Porcentaje de descuento en percent"),
+		field.Int64("amount").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Min(0).
+			Comment("Monto de descuento en fixed y precio del grupo en bundle_price"),
+		field.Float("buy_quantity").
+			Default(0).
+			Min(0).
+			Comment("Unidades que se pagan en free_item o que forman el grupo en bundle_price"),
+		field.Float("free_quantity").
+			Default(0).
+			Min(0).
+			Comment("Unidades de regalo por cada buy_quantity en free_item"),
+		field.Float("min_quantity").
+			Default(0).
+			Min(0).
+			Comment("Cantidad mínima de productos que cumplen para aplicar"),
+		field.Int64("min_amount").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Min(0).
+			Comment("Valor mínimo de los productos que cumplen para aplicar"),
+		field.Time("starts_at").
+			Optional().
+			Nillable().
+			Comment("Inicio de vigencia"),
+		field.Time("ends_at").
+			Optional().
+			Nillable().
+			Comment("Fin de vigencia"),
+		field.String("weekdays").
+			Optional().
+			Comment("Días en que rige, separados por coma (1 = lunes ... 7 = domingo); vacío para todos"),
+		field.String("start_time").
+			Optional().
+			Comment("Hora de inicio diaria (HH:MM); vacía para todo el día"),
+		field.String("end_time").
+			Optional().
+			Comment("Hora de fin diaria (HH:MM)"),
+		field.String("customer_group").
+			Optional().
+			Comment("Grupo de clientes al que se limita; vacío para todos"),
+		field.Int("priority").
+			Default(0).
+			Comment("Entre promociones que compiten por las mismas líneas gana la de mayor prioridad"),
+		field.Bool("active").
+			Default(true).
+			Comment("Las promociones inactivas no se evalúan"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Promotion.
+func (Promotion) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Promotion.
+func (Promotion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "active"),
+		index.Fields("tenant_id", "coupon_code"),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PromotionTarget holds the schema definition for the PromotionTarget entity.
+type PromotionTarget struct {
+	ent.Schema
+}
+
+// Fields of the PromotionTarget.
+func (PromotionTarget) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("promotion_id").
+			Comment("ID de la promoción"),
+		field.Int("product_id").
+			Optional().
+			Nillable().
+			Comment("Producto al que aplica la promoción"),
+		field.String("category").
+			Optional().
+			Comment("Categoría a la que aplica la promoción, si no se indica un producto"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PromotionTarget.
+func (PromotionTarget) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PromotionTarget.
+func (PromotionTarget) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("promotion_id"),
+	}
+}
@@ -3,6 +3,7 @@ package schema
 import (
 	"time"
 
+	"Veritasbackend/pkg/money"
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -20,19 +21,63 @@ func (PurchaseInvoice) Fields() []ent.Field {
 		field.String("invoice_number").
 			NotEmpty().
 			Comment("Número de factura del proveedor"),
-		field.Float("total").
+		field.Int64("total").
+			GoType(money.Zero).
+			SchemaType(moneyType).
 			Min(0).
 			Comment("Total de la factura"),
+		field.Int64("subtotal").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Suma de las bases gravables, sin impuestos"),
+		field.Int64("tax_total").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Suma de los impuestos"),
+		field.Bool("prices_include_tax").
+			Default(false).
+			Comment("Los precios de las líneas incluían impuestos"),
+		field.String("currency").
+			Default("COP").
+			Comment("Moneda del documento (código ISO 4217)"),
+		field.Float("exchange_rate").
+			Default(1).
+			Positive().
+			Comment("Unidades de la moneda base por una unidad de la moneda del documento"),
+		field.Int64("base_subtotal").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Subtotal en la moneda base"),
+		field.Int64("base_tax_total").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Impuestos en la moneda base"),
+		field.Int64("base_total").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Total en la moneda base"),
+		field.Int64("fx_gain_loss").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Diferencia en cambio realizada en los pagos, en la moneda base (positiva = ganancia)"),
 		field.String("status").
 			Default("pending").
-			Comment("Estado de pago (pending, partial, paid, cancelled)"),
+			Comment("Estado de pago (draft, pending, partial, paid, cancelled)"),
 		field.String("payment_method").
 			Optional().
 			Comment("Método de pago (cash, credit, partial)"),
 		field.Time("due_date").
 			Optional().
 			Comment("Fecha de vencimiento"),
-		field.Float("paid_amount").
+		field.Int64("paid_amount").
+			GoType(money.Zero).
+			SchemaType(moneyType).
 			Default(0).
 			Min(0).
 			Comment("Monto pagado hasta el momento"),
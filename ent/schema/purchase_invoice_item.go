@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"Veritasbackend/pkg/money"
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -18,15 +19,47 @@ func (PurchaseInvoiceItem) Fields() []ent.Field {
 			Comment("ID de la factura de compra"),
 		field.Int("product_id").
 			Comment("ID del producto comprado"),
-		field.Int("quantity").
-			Min(1).
-			Comment("Cantidad comprada"),
-		field.Float("unit_cost").
+		field.Float("quantity").
+			Positive().
+			Comment("Cantidad comprada, en la unidad base del producto"),
+		field.Int64("unit_cost").
+			GoType(money.Zero).
+			SchemaType(moneyType).
 			Min(0).
-			Comment("Costo unitario al momento de la compra"),
-		field.Float("subtotal").
+			Comment("Costo por unidad base al momento de la compra"),
+		field.Int64("subtotal").
+			GoType(money.Zero).
+			SchemaType(moneyType).
 			Min(0).
 			Comment("Subtotal (quantity × unit_cost)"),
+		field.Int("unit_id").
+			Optional().
+			Nillable().
+			Comment("Unidad de compra cuando no es la unidad base"),
+		field.String("unit_name").
+			Optional().
+			Comment("Nombre de la unidad de compra al momento de la compra"),
+		field.Float("unit_quantity").
+			Optional().
+			Nillable().
+			Comment("Cantidad comprada expresada en la unidad de compra"),
+		field.Int("tax_rate_id").
+			Optional().
+			Nillable().
+			Comment("Tarifa de impuesto aplicada a la línea"),
+		field.Float("tax_rate").
+			Default(0).
+			Comment("Porcentaje de impuesto aplicado a la línea"),
+		field.Int64("tax_base").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Base gravable de la línea, sin impuestos"),
+		field.Int64("tax_amount").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Impuesto de la línea"),
 	}
 }
 
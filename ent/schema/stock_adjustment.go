@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"Veritasbackend/pkg/money"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StockAdjustment holds the schema definition for the StockAdjustment entity.
+type StockAdjustment struct {
+	ent.Schema
+}
+
+// Fields of the StockAdjustment.
+func (StockAdjustment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.Int("reason_id").
+			Comment("ID del motivo del ajuste"),
+		field.Text("notes").
+			Optional().
+			Comment("Observaciones del ajuste"),
+		field.String("status").
+			Default("pending").
+			Comment("Estado del ajuste (pending, applied, rejected)"),
+		field.String("source").
+			Default("manual").
+			Comment("Origen del ajuste (manual, product_update)"),
+		field.Int64("total_value").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Valor del ajuste al costo (suma de las diferencias por costo unitario)"),
+		field.Int("user_id").
+			Comment("ID del usuario que registró el ajuste"),
+		field.Int("reviewed_by").
+			Optional().
+			Comment("ID del usuario que aprobó o rechazó el ajuste"),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable().
+			Comment("Fecha de aprobación o rechazo"),
+		field.Time("applied_at").
+			Optional().
+			Nillable().
+			Comment("Fecha en que se aplicó al stock"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the StockAdjustment.
+func (StockAdjustment) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the StockAdjustment.
+func (StockAdjustment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "status"),
+		index.Fields("tenant_id", "applied_at"),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"Veritasbackend/pkg/money"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StockAdjustmentLine holds the schema definition for the StockAdjustmentLine entity.
+type StockAdjustmentLine struct {
+	ent.Schema
+}
+
+// Fields of the StockAdjustmentLine.
+func (StockAdjustmentLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("adjustment_id").
+			Comment("ID del ajuste"),
+		field.Int("product_id").
+			Comment("ID del producto"),
+		field.Int("lot_id").
+			Optional().
+			Comment("ID del lote del que salen las unidades, para productos con lotes"),
+		field.Float("quantity").
+			Comment("Diferencia de stock: negativa para bajas, positiva para altas"),
+		field.Int64("unit_cost").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Min(0).
+			Comment("Costo unitario al registrar el ajuste"),
+		field.Int64("total_cost").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Diferencia valorizada al costo"),
+	}
+}
+
+// Edges of the StockAdjustmentLine.
+func (StockAdjustmentLine) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the StockAdjustmentLine.
+func (StockAdjustmentLine) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("adjustment_id"),
+		index.Fields("product_id"),
+	}
+}
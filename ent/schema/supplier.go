@@ -32,6 +32,17 @@ func (Supplier) Fields() []ent.Field {
 		field.String("ruc_nit").
 			Optional().
 			Comment("Número de identificación fiscal (RUC/NIT)"),
+		field.Int("lead_time_days").
+			Default(0).
+			Min(0).
+			Comment("Tiempo de entrega del proveedor en días"),
+		field.Bool("archived").
+			Default(false).
+			Comment("Eliminado de forma lógica: no aparece en listados ni búsquedas pero sigue resolviéndose desde los documentos"),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Fecha de eliminación lógica"),
 		field.Int("tenant_id").
 			Comment("ID del tenant al que pertenece"),
 		field.Time("created_at").
@@ -55,6 +66,7 @@ func (Supplier) Edges() []ent.Edge {
 func (Supplier) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("tenant_id"),
+		index.Fields("tenant_id", "archived"),
 		index.Fields("ruc_nit").Unique(),
 	}
 }
@@ -3,6 +3,7 @@ package schema
 import (
 	"time"
 
+	"Veritasbackend/pkg/money"
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -20,9 +21,28 @@ func (SupplierPayment) Fields() []ent.Field {
 			Comment("ID de la factura de compra"),
 		field.Int("supplier_id").
 			Comment("ID del proveedor"),
-		field.Float("amount").
+		field.Int64("amount").
+			GoType(money.Zero).
+			SchemaType(moneyType).
 			Min(0).
-			Comment("Monto del pago"),
+			Comment("Monto del pago en la moneda de la factura"),
+		field.String("currency").
+			Default("COP").
+			Comment("Moneda del pago, la de la factura de compra"),
+		field.Float("exchange_rate").
+			Default(1).
+			Positive().
+			Comment("Tasa de cambio del día del pago"),
+		field.Int64("base_amount").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Monto del pago en la moneda base"),
+		field.Int64("fx_gain_loss").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Comment("Diferencia en cambio realizada con el pago, en la moneda base (positiva = ganancia)"),
 		field.Time("payment_date").
 			Default(time.Now).
 			Comment("Fecha del pago"),
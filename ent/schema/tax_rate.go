@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaxRate holds the schema definition for the TaxRate entity.
+type TaxRate struct {
+	ent.Schema
+}
+
+// Fields of the TaxRate.
+func (TaxRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("ID del tenant"),
+		field.String("name").
+			NotEmpty().
+			Comment("Nombre de la tarifa (IVA 19%, IVA 5%, Exento, Excluido, ...)"),
+		field.Float("rate").
+			Default(0).
+			Min(0).
+			Max(100).
+			Comment("Porcentaje de la tarifa; solo se aplica a las tarifas gravadas"),
+		field.String("kind").
+			Default("taxable").
+			Comment("Tipo de tarifa (taxable, exempt, excluded)"),
+		field.Bool("is_default").
+			Default(false).
+			Comment("Tarifa que se aplica a los productos sin tarifa propia; una por tenant"),
+		field.Bool("active").
+			Default(true).
+			Comment("Las tarifas inactivas no se pueden asignar a productos"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the TaxRate.
+func (TaxRate) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the TaxRate.
+func (TaxRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("tenant_id", "name").Unique(),
+	}
+}
@@ -3,6 +3,7 @@ package schema
 import (
 	"time"
 
+	"Veritasbackend/pkg/money"
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -26,6 +27,78 @@ func (Tenant) Fields() []ent.Field {
 		field.String("domain").
 			Optional().
 			Comment("Dominio del tenant"),
+		field.String("costing_method").
+			Default("average").
+			Comment("Método de costeo del inventario (average, fifo)"),
+		field.Int64("adjustment_approval_threshold").
+			GoType(money.Zero).
+			SchemaType(moneyType).
+			Default(0).
+			Min(0).
+			Comment("Valor al costo a partir del cual un ajuste de stock requiere aprobación (0 = sin aprobación)"),
+		field.Float("user_discount_limit").
+			Default(100).
+			Min(0).
+			Max(100).
+			Comment("Descuento máximo en porcentaje que puede dar un usuario sin autorización"),
+		field.Float("manager_discount_limit").
+			Default(100).
+			Min(0).
+			Max(100).
+			Comment("Descuento máximo en porcentaje que puede dar un manager sin autorización"),
+		field.Bool("prices_include_tax").
+			Default(false).
+			Comment("Los precios de venta de los productos ya incluyen impuestos"),
+		field.String("base_currency").
+			Default("COP").
+			Comment("Moneda base del tenant: la de los precios, los costos y los reportes"),
+		field.String("legal_name").
+			Optional().
+			Comment("Razón social que se imprime en las facturas"),
+		field.String("tax_id").
+			Optional().
+			Comment("Número de identificación fiscal (NIT/RUC)"),
+		field.Text("address").
+			Optional().
+			Comment("Dirección fiscal"),
+		field.String("phone").
+			Optional().
+			Comment("Teléfono de contacto"),
+		field.String("email").
+			Optional().
+			Comment("Email de contacto"),
+		field.Bytes("logo").
+			Optional().
+			Comment("Logo en PNG para el encabezado de facturas y recibos"),
+		field.String("einvoice_country").
+			Optional().
+			Comment("País de la factura electrónica (CO, PE); vacío si no emite facturas electrónicas"),
+		field.String("einvoice_environment").
+			Default("test").
+			Comment("Ambiente de la autoridad tributaria (test, production)"),
+		field.String("einvoice_software_id").
+			Optional().
+			Comment("Identificador del software de facturación ante la autoridad"),
+		field.String("einvoice_software_pin").
+			Optional().
+			Sensitive().
+			Comment("PIN del software de facturación; con él se calcula el CUDE de las notas crédito"),
+		field.String("einvoice_technical_key").
+			Optional().
+			Sensitive().
+			Comment("Clave técnica de la resolución de facturación; con ella se calcula el CUFE"),
+		field.Bytes("einvoice_certificate").
+			Optional().
+			Sensitive().
+			Comment("Certificado de firma digital en PEM"),
+		field.Bytes("einvoice_private_key").
+			Optional().
+			Sensitive().
+			Comment("Llave privada del certificado de firma en PEM (PKCS#8), cifrada con EINVOICE_KEY_ENCRYPTION_KEY"),
+		field.Time("einvoice_certificate_expires_at").
+			Optional().
+			Nillable().
+			Comment("Vencimiento del certificado de firma"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -30,6 +30,13 @@ func (User) Fields() []ent.Field {
 		field.String("role").
 			Default("user").
 			Comment("Rol del usuario (admin, manager, user)"),
+		field.Bool("archived").
+			Default(false).
+			Comment("Eliminado de forma lógica: no puede iniciar sesión pero sigue resolviéndose desde los documentos"),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Fecha de eliminación lógica"),
 		field.Int("tenant_id").
 			Comment("ID del tenant al que pertenece"),
 		field.Time("created_at").
@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/stockadjustment"
+	"Veritasbackend/pkg/money"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// StockAdjustment is the model entity for the StockAdjustment schema.
+type StockAdjustment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// ID del motivo del ajuste
+	ReasonID int `json:"reason_id,omitempty"`
+	// Observaciones del ajuste
+	Notes string `json:"notes,omitempty"`
+	// Estado del ajuste (pending, applied, rejected)
+	Status string `json:"status,omitempty"`
+	// Origen del ajuste (manual, product_update)
+	Source string `json:"source,omitempty"`
+	// Valor del ajuste al costo (suma de las diferencias por costo unitario)
+	TotalValue money.Amount `json:"total_value,omitempty"`
+	// ID del usuario que registró el ajuste
+	UserID int `json:"user_id,omitempty"`
+	// ID del usuario que aprobó o rechazó el ajuste
+	ReviewedBy int `json:"reviewed_by,omitempty"`
+	// Fecha de aprobación o rechazo
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// Fecha en que se aplicó al stock
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StockAdjustment) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stockadjustment.FieldTotalValue:
+			values[i] = new(money.Amount)
+		case stockadjustment.FieldID, stockadjustment.FieldTenantID, stockadjustment.FieldReasonID, stockadjustment.FieldUserID, stockadjustment.FieldReviewedBy:
+			values[i] = new(sql.NullInt64)
+		case stockadjustment.FieldNotes, stockadjustment.FieldStatus, stockadjustment.FieldSource:
+			values[i] = new(sql.NullString)
+		case stockadjustment.FieldReviewedAt, stockadjustment.FieldAppliedAt, stockadjustment.FieldCreatedAt, stockadjustment.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type StockAdjustment", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StockAdjustment fields.
+func (sa *StockAdjustment) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stockadjustment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sa.ID = int(value.Int64)
+		case stockadjustment.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				sa.TenantID = int(value.Int64)
+			}
+		case stockadjustment.FieldReasonID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reason_id", values[i])
+			} else if value.Valid {
+				sa.ReasonID = int(value.Int64)
+			}
+		case stockadjustment.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				sa.Notes = value.String
+			}
+		case stockadjustment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sa.Status = value.String
+			}
+		case stockadjustment.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				sa.Source = value.String
+			}
+		case stockadjustment.FieldTotalValue:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field total_value", values[i])
+			} else if value != nil {
+				sa.TotalValue = *value
+			}
+		case stockadjustment.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				sa.UserID = int(value.Int64)
+			}
+		case stockadjustment.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				sa.ReviewedBy = int(value.Int64)
+			}
+		case stockadjustment.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				sa.ReviewedAt = new(time.Time)
+				*sa.ReviewedAt = value.Time
+			}
+		case stockadjustment.FieldAppliedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_at", values[i])
+			} else if value.Valid {
+				sa.AppliedAt = new(time.Time)
+				*sa.AppliedAt = value.Time
+			}
+		case stockadjustment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sa.CreatedAt = value.Time
+			}
+		case stockadjustment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sa.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this StockAdjustment.
+// Note that you need to call StockAdjustment.Unwrap() before calling this method if this StockAdjustment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *StockAdjustment) Update() *StockAdjustmentUpdateOne {
+	return (&StockAdjustmentClient{config: sa.config}).UpdateOne(sa)
+}
+
+// Unwrap unwraps the StockAdjustment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *StockAdjustment) Unwrap() *StockAdjustment {
+	_tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StockAdjustment is not a transactional entity")
+	}
+	sa.config.driver = _tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *StockAdjustment) String() string {
+	var builder strings.Builder
+	builder.WriteString("StockAdjustment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", sa.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("reason_id=")
+	builder.WriteString(fmt.Sprintf("%v", sa.ReasonID))
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(sa.Notes)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(sa.Status)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(sa.Source)
+	builder.WriteString(", ")
+	builder.WriteString("total_value=")
+	builder.WriteString(fmt.Sprintf("%v", sa.TotalValue))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", sa.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("reviewed_by=")
+	builder.WriteString(fmt.Sprintf("%v", sa.ReviewedBy))
+	builder.WriteString(", ")
+	if v := sa.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := sa.AppliedAt; v != nil {
+		builder.WriteString("applied_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StockAdjustments is a parsable slice of StockAdjustment.
+type StockAdjustments []*StockAdjustment
+
+func (sa StockAdjustments) config(cfg config) {
+	for _i := range sa {
+		sa[_i].config = cfg
+	}
+}
@@ -0,0 +1,83 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stockadjustment
+
+import (
+	"Veritasbackend/pkg/money"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the stockadjustment type in the database.
+	Label = "stock_adjustment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldReasonID holds the string denoting the reason_id field in the database.
+	FieldReasonID = "reason_id"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldTotalValue holds the string denoting the total_value field in the database.
+	FieldTotalValue = "total_value"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldAppliedAt holds the string denoting the applied_at field in the database.
+	FieldAppliedAt = "applied_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the stockadjustment in the database.
+	Table = "stock_adjustments"
+)
+
+// Columns holds all SQL columns for stockadjustment fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldReasonID,
+	FieldNotes,
+	FieldStatus,
+	FieldSource,
+	FieldTotalValue,
+	FieldUserID,
+	FieldReviewedBy,
+	FieldReviewedAt,
+	FieldAppliedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultSource holds the default value on creation for the "source" field.
+	DefaultSource string
+	// DefaultTotalValue holds the default value on creation for the "total_value" field.
+	DefaultTotalValue money.Amount
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
@@ -0,0 +1,1271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stockadjustment
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// ReasonID applies equality check predicate on the "reason_id" field. It's identical to ReasonIDEQ.
+func ReasonID(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReasonID), v))
+	})
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNotes), v))
+	})
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// TotalValue applies equality check predicate on the "total_value" field. It's identical to TotalValueEQ.
+func TotalValue(v money.Amount) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotalValue), v))
+	})
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReviewedAt), v))
+	})
+}
+
+// AppliedAt applies equality check predicate on the "applied_at" field. It's identical to AppliedAtEQ.
+func AppliedAt(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppliedAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// ReasonIDEQ applies the EQ predicate on the "reason_id" field.
+func ReasonIDEQ(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReasonID), v))
+	})
+}
+
+// ReasonIDNEQ applies the NEQ predicate on the "reason_id" field.
+func ReasonIDNEQ(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReasonID), v))
+	})
+}
+
+// ReasonIDIn applies the In predicate on the "reason_id" field.
+func ReasonIDIn(vs ...int) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReasonID), v...))
+	})
+}
+
+// ReasonIDNotIn applies the NotIn predicate on the "reason_id" field.
+func ReasonIDNotIn(vs ...int) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReasonID), v...))
+	})
+}
+
+// ReasonIDGT applies the GT predicate on the "reason_id" field.
+func ReasonIDGT(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReasonID), v))
+	})
+}
+
+// ReasonIDGTE applies the GTE predicate on the "reason_id" field.
+func ReasonIDGTE(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReasonID), v))
+	})
+}
+
+// ReasonIDLT applies the LT predicate on the "reason_id" field.
+func ReasonIDLT(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReasonID), v))
+	})
+}
+
+// ReasonIDLTE applies the LTE predicate on the "reason_id" field.
+func ReasonIDLTE(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReasonID), v))
+	})
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNotes), v))
+	})
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNotes), v))
+	})
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNotes), v...))
+	})
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNotes), v...))
+	})
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNotes), v))
+	})
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNotes), v))
+	})
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNotes), v))
+	})
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNotes), v))
+	})
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNotes), v))
+	})
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNotes), v))
+	})
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNotes), v))
+	})
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNotes)))
+	})
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNotes)))
+	})
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNotes), v))
+	})
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNotes), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStatus), v))
+	})
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSource), v...))
+	})
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSource), v...))
+	})
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSource), v))
+	})
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSource), v))
+	})
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSource), v))
+	})
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSource), v))
+	})
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSource), v))
+	})
+}
+
+// TotalValueEQ applies the EQ predicate on the "total_value" field.
+func TotalValueEQ(v money.Amount) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotalValue), v))
+	})
+}
+
+// TotalValueNEQ applies the NEQ predicate on the "total_value" field.
+func TotalValueNEQ(v money.Amount) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTotalValue), v))
+	})
+}
+
+// TotalValueIn applies the In predicate on the "total_value" field.
+func TotalValueIn(vs ...money.Amount) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTotalValue), v...))
+	})
+}
+
+// TotalValueNotIn applies the NotIn predicate on the "total_value" field.
+func TotalValueNotIn(vs ...money.Amount) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTotalValue), v...))
+	})
+}
+
+// TotalValueGT applies the GT predicate on the "total_value" field.
+func TotalValueGT(v money.Amount) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTotalValue), v))
+	})
+}
+
+// TotalValueGTE applies the GTE predicate on the "total_value" field.
+func TotalValueGTE(v money.Amount) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTotalValue), v))
+	})
+}
+
+// TotalValueLT applies the LT predicate on the "total_value" field.
+func TotalValueLT(v money.Amount) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTotalValue), v))
+	})
+}
+
+// TotalValueLTE applies the LTE predicate on the "total_value" field.
+func TotalValueLTE(v money.Amount) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTotalValue), v))
+	})
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserID), v))
+	})
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...int) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReviewedBy), v...))
+	})
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...int) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReviewedBy), v...))
+	})
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v int) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReviewedBy), v))
+	})
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldReviewedBy)))
+	})
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldReviewedBy)))
+	})
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReviewedAt), v))
+	})
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReviewedAt), v))
+	})
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReviewedAt), v...))
+	})
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReviewedAt), v...))
+	})
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReviewedAt), v))
+	})
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReviewedAt), v))
+	})
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReviewedAt), v))
+	})
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReviewedAt), v))
+	})
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldReviewedAt)))
+	})
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldReviewedAt)))
+	})
+}
+
+// AppliedAtEQ applies the EQ predicate on the "applied_at" field.
+func AppliedAtEQ(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppliedAt), v))
+	})
+}
+
+// AppliedAtNEQ applies the NEQ predicate on the "applied_at" field.
+func AppliedAtNEQ(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAppliedAt), v))
+	})
+}
+
+// AppliedAtIn applies the In predicate on the "applied_at" field.
+func AppliedAtIn(vs ...time.Time) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAppliedAt), v...))
+	})
+}
+
+// AppliedAtNotIn applies the NotIn predicate on the "applied_at" field.
+func AppliedAtNotIn(vs ...time.Time) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAppliedAt), v...))
+	})
+}
+
+// AppliedAtGT applies the GT predicate on the "applied_at" field.
+func AppliedAtGT(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAppliedAt), v))
+	})
+}
+
+// AppliedAtGTE applies the GTE predicate on the "applied_at" field.
+func AppliedAtGTE(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAppliedAt), v))
+	})
+}
+
+// AppliedAtLT applies the LT predicate on the "applied_at" field.
+func AppliedAtLT(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAppliedAt), v))
+	})
+}
+
+// AppliedAtLTE applies the LTE predicate on the "applied_at" field.
+func AppliedAtLTE(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAppliedAt), v))
+	})
+}
+
+// AppliedAtIsNil applies the IsNil predicate on the "applied_at" field.
+func AppliedAtIsNil() predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAppliedAt)))
+	})
+}
+
+// AppliedAtNotNil applies the NotNil predicate on the "applied_at" field.
+func AppliedAtNotNil() predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAppliedAt)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.StockAdjustment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StockAdjustment) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StockAdjustment) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StockAdjustment) predicate.StockAdjustment {
+	return predicate.StockAdjustment(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,501 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/stockadjustment"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StockAdjustmentCreate is the builder for creating a StockAdjustment entity.
+type StockAdjustmentCreate struct {
+	config
+	mutation *StockAdjustmentMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (sac *StockAdjustmentCreate) SetTenantID(i int) *StockAdjustmentCreate {
+	sac.mutation.SetTenantID(i)
+	return sac
+}
+
+// SetReasonID sets the "reason_id" field.
+func (sac *StockAdjustmentCreate) SetReasonID(i int) *StockAdjustmentCreate {
+	sac.mutation.SetReasonID(i)
+	return sac
+}
+
+// SetNotes sets the "notes" field.
+func (sac *StockAdjustmentCreate) SetNotes(s string) *StockAdjustmentCreate {
+	sac.mutation.SetNotes(s)
+	return sac
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (sac *StockAdjustmentCreate) SetNillableNotes(s *string) *StockAdjustmentCreate {
+	if s != nil {
+		sac.SetNotes(*s)
+	}
+	return sac
+}
+
+// SetStatus sets the "status" field.
+func (sac *StockAdjustmentCreate) SetStatus(s string) *StockAdjustmentCreate {
+	sac.mutation.SetStatus(s)
+	return sac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sac *StockAdjustmentCreate) SetNillableStatus(s *string) *StockAdjustmentCreate {
+	if s != nil {
+		sac.SetStatus(*s)
+	}
+	return sac
+}
+
+// SetSource sets the "source" field.
+func (sac *StockAdjustmentCreate) SetSource(s string) *StockAdjustmentCreate {
+	sac.mutation.SetSource(s)
+	return sac
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (sac *StockAdjustmentCreate) SetNillableSource(s *string) *StockAdjustmentCreate {
+	if s != nil {
+		sac.SetSource(*s)
+	}
+	return sac
+}
+
+// SetTotalValue sets the "total_value" field.
+func (sac *StockAdjustmentCreate) SetTotalValue(m money.Amount) *StockAdjustmentCreate {
+	sac.mutation.SetTotalValue(m)
+	return sac
+}
+
+// SetNillableTotalValue sets the "total_value" field if the given value is not nil.
+func (sac *StockAdjustmentCreate) SetNillableTotalValue(m *money.Amount) *StockAdjustmentCreate {
+	if m != nil {
+		sac.SetTotalValue(*m)
+	}
+	return sac
+}
+
+// SetUserID sets the "user_id" field.
+func (sac *StockAdjustmentCreate) SetUserID(i int) *StockAdjustmentCreate {
+	sac.mutation.SetUserID(i)
+	return sac
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (sac *StockAdjustmentCreate) SetReviewedBy(i int) *StockAdjustmentCreate {
+	sac.mutation.SetReviewedBy(i)
+	return sac
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (sac *StockAdjustmentCreate) SetNillableReviewedBy(i *int) *StockAdjustmentCreate {
+	if i != nil {
+		sac.SetReviewedBy(*i)
+	}
+	return sac
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (sac *StockAdjustmentCreate) SetReviewedAt(t time.Time) *StockAdjustmentCreate {
+	sac.mutation.SetReviewedAt(t)
+	return sac
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (sac *StockAdjustmentCreate) SetNillableReviewedAt(t *time.Time) *StockAdjustmentCreate {
+	if t != nil {
+		sac.SetReviewedAt(*t)
+	}
+	return sac
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (sac *StockAdjustmentCreate) SetAppliedAt(t time.Time) *StockAdjustmentCreate {
+	sac.mutation.SetAppliedAt(t)
+	return sac
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (sac *StockAdjustmentCreate) SetNillableAppliedAt(t *time.Time) *StockAdjustmentCreate {
+	if t != nil {
+		sac.SetAppliedAt(*t)
+	}
+	return sac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sac *StockAdjustmentCreate) SetCreatedAt(t time.Time) *StockAdjustmentCreate {
+	sac.mutation.SetCreatedAt(t)
+	return sac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sac *StockAdjustmentCreate) SetNillableCreatedAt(t *time.Time) *StockAdjustmentCreate {
+	if t != nil {
+		sac.SetCreatedAt(*t)
+	}
+	return sac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sac *StockAdjustmentCreate) SetUpdatedAt(t time.Time) *StockAdjustmentCreate {
+	sac.mutation.SetUpdatedAt(t)
+	return sac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sac *StockAdjustmentCreate) SetNillableUpdatedAt(t *time.Time) *StockAdjustmentCreate {
+	if t != nil {
+		sac.SetUpdatedAt(*t)
+	}
+	return sac
+}
+
+// Mutation returns the StockAdjustmentMutation object of the builder.
+func (sac *StockAdjustmentCreate) Mutation() *StockAdjustmentMutation {
+	return sac.mutation
+}
+
+// Save creates the StockAdjustment in the database.
+func (sac *StockAdjustmentCreate) Save(ctx context.Context) (*StockAdjustment, error) {
+	var (
+		err  error
+		node *StockAdjustment
+	)
+	sac.defaults()
+	if len(sac.hooks) == 0 {
+		if err = sac.check(); err != nil {
+			return nil, err
+		}
+		node, err = sac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockAdjustmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sac.check(); err != nil {
+				return nil, err
+			}
+			sac.mutation = mutation
+			if node, err = sac.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sac.hooks) - 1; i >= 0; i-- {
+			if sac.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sac.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, sac.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*StockAdjustment)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from StockAdjustmentMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *StockAdjustmentCreate) SaveX(ctx context.Context) *StockAdjustment {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *StockAdjustmentCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *StockAdjustmentCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sac *StockAdjustmentCreate) defaults() {
+	if _, ok := sac.mutation.Status(); !ok {
+		v := stockadjustment.DefaultStatus
+		sac.mutation.SetStatus(v)
+	}
+	if _, ok := sac.mutation.Source(); !ok {
+		v := stockadjustment.DefaultSource
+		sac.mutation.SetSource(v)
+	}
+	if _, ok := sac.mutation.TotalValue(); !ok {
+		v := stockadjustment.DefaultTotalValue
+		sac.mutation.SetTotalValue(v)
+	}
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		v := stockadjustment.DefaultCreatedAt()
+		sac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sac.mutation.UpdatedAt(); !ok {
+		v := stockadjustment.DefaultUpdatedAt()
+		sac.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *StockAdjustmentCreate) check() error {
+	if _, ok := sac.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "StockAdjustment.tenant_id"`)}
+	}
+	if _, ok := sac.mutation.ReasonID(); !ok {
+		return &ValidationError{Name: "reason_id", err: errors.New(`ent: missing required field "StockAdjustment.reason_id"`)}
+	}
+	if _, ok := sac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "StockAdjustment.status"`)}
+	}
+	if _, ok := sac.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "StockAdjustment.source"`)}
+	}
+	if _, ok := sac.mutation.TotalValue(); !ok {
+		return &ValidationError{Name: "total_value", err: errors.New(`ent: missing required field "StockAdjustment.total_value"`)}
+	}
+	if _, ok := sac.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "StockAdjustment.user_id"`)}
+	}
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StockAdjustment.created_at"`)}
+	}
+	if _, ok := sac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "StockAdjustment.updated_at"`)}
+	}
+	return nil
+}
+
+func (sac *StockAdjustmentCreate) sqlSave(ctx context.Context) (*StockAdjustment, error) {
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (sac *StockAdjustmentCreate) createSpec() (*StockAdjustment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StockAdjustment{config: sac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: stockadjustment.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockadjustment.FieldID,
+			},
+		}
+	)
+	if value, ok := sac.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := sac.mutation.ReasonID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldReasonID,
+		})
+		_node.ReasonID = value
+	}
+	if value, ok := sac.mutation.Notes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockadjustment.FieldNotes,
+		})
+		_node.Notes = value
+	}
+	if value, ok := sac.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockadjustment.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := sac.mutation.Source(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockadjustment.FieldSource,
+		})
+		_node.Source = value
+	}
+	if value, ok := sac.mutation.TotalValue(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: stockadjustment.FieldTotalValue,
+		})
+		_node.TotalValue = value
+	}
+	if value, ok := sac.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := sac.mutation.ReviewedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldReviewedBy,
+		})
+		_node.ReviewedBy = value
+	}
+	if value, ok := sac.mutation.ReviewedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: stockadjustment.FieldReviewedAt,
+		})
+		_node.ReviewedAt = &value
+	}
+	if value, ok := sac.mutation.AppliedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: stockadjustment.FieldAppliedAt,
+		})
+		_node.AppliedAt = &value
+	}
+	if value, ok := sac.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: stockadjustment.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := sac.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: stockadjustment.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// StockAdjustmentCreateBulk is the builder for creating many StockAdjustment entities in bulk.
+type StockAdjustmentCreateBulk struct {
+	config
+	builders []*StockAdjustmentCreate
+}
+
+// Save creates the StockAdjustment entities in the database.
+func (sacb *StockAdjustmentCreateBulk) Save(ctx context.Context) ([]*StockAdjustment, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*StockAdjustment, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StockAdjustmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *StockAdjustmentCreateBulk) SaveX(ctx context.Context) []*StockAdjustment {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *StockAdjustmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *StockAdjustmentCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,899 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/stockadjustment"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StockAdjustmentUpdate is the builder for updating StockAdjustment entities.
+type StockAdjustmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StockAdjustmentMutation
+}
+
+// Where appends a list predicates to the StockAdjustmentUpdate builder.
+func (sau *StockAdjustmentUpdate) Where(ps ...predicate.StockAdjustment) *StockAdjustmentUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (sau *StockAdjustmentUpdate) SetTenantID(i int) *StockAdjustmentUpdate {
+	sau.mutation.ResetTenantID()
+	sau.mutation.SetTenantID(i)
+	return sau
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (sau *StockAdjustmentUpdate) AddTenantID(i int) *StockAdjustmentUpdate {
+	sau.mutation.AddTenantID(i)
+	return sau
+}
+
+// SetReasonID sets the "reason_id" field.
+func (sau *StockAdjustmentUpdate) SetReasonID(i int) *StockAdjustmentUpdate {
+	sau.mutation.ResetReasonID()
+	sau.mutation.SetReasonID(i)
+	return sau
+}
+
+// AddReasonID adds i to the "reason_id" field.
+func (sau *StockAdjustmentUpdate) AddReasonID(i int) *StockAdjustmentUpdate {
+	sau.mutation.AddReasonID(i)
+	return sau
+}
+
+// SetNotes sets the "notes" field.
+func (sau *StockAdjustmentUpdate) SetNotes(s string) *StockAdjustmentUpdate {
+	sau.mutation.SetNotes(s)
+	return sau
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (sau *StockAdjustmentUpdate) SetNillableNotes(s *string) *StockAdjustmentUpdate {
+	if s != nil {
+		sau.SetNotes(*s)
+	}
+	return sau
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (sau *StockAdjustmentUpdate) ClearNotes() *StockAdjustmentUpdate {
+	sau.mutation.ClearNotes()
+	return sau
+}
+
+// SetStatus sets the "status" field.
+func (sau *StockAdjustmentUpdate) SetStatus(s string) *StockAdjustmentUpdate {
+	sau.mutation.SetStatus(s)
+	return sau
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sau *StockAdjustmentUpdate) SetNillableStatus(s *string) *StockAdjustmentUpdate {
+	if s != nil {
+		sau.SetStatus(*s)
+	}
+	return sau
+}
+
+// SetSource sets the "source" field.
+func (sau *StockAdjustmentUpdate) SetSource(s string) *StockAdjustmentUpdate {
+	sau.mutation.SetSource(s)
+	return sau
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (sau *StockAdjustmentUpdate) SetNillableSource(s *string) *StockAdjustmentUpdate {
+	if s != nil {
+		sau.SetSource(*s)
+	}
+	return sau
+}
+
+// SetTotalValue sets the "total_value" field.
+func (sau *StockAdjustmentUpdate) SetTotalValue(m money.Amount) *StockAdjustmentUpdate {
+	sau.mutation.ResetTotalValue()
+	sau.mutation.SetTotalValue(m)
+	return sau
+}
+
+// SetNillableTotalValue sets the "total_value" field if the given value is not nil.
+func (sau *StockAdjustmentUpdate) SetNillableTotalValue(m *money.Amount) *StockAdjustmentUpdate {
+	if m != nil {
+		sau.SetTotalValue(*m)
+	}
+	return sau
+}
+
+// AddTotalValue adds m to the "total_value" field.
+func (sau *StockAdjustmentUpdate) AddTotalValue(m money.Amount) *StockAdjustmentUpdate {
+	sau.mutation.AddTotalValue(m)
+	return sau
+}
+
+// SetUserID sets the "user_id" field.
+func (sau *StockAdjustmentUpdate) SetUserID(i int) *StockAdjustmentUpdate {
+	sau.mutation.ResetUserID()
+	sau.mutation.SetUserID(i)
+	return sau
+}
+
+// AddUserID adds i to the "user_id" field.
+func (sau *StockAdjustmentUpdate) AddUserID(i int) *StockAdjustmentUpdate {
+	sau.mutation.AddUserID(i)
+	return sau
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (sau *StockAdjustmentUpdate) SetReviewedBy(i int) *StockAdjustmentUpdate {
+	sau.mutation.ResetReviewedBy()
+	sau.mutation.SetReviewedBy(i)
+	return sau
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (sau *StockAdjustmentUpdate) SetNillableReviewedBy(i *int) *StockAdjustmentUpdate {
+	if i != nil {
+		sau.SetReviewedBy(*i)
+	}
+	return sau
+}
+
+// AddReviewedBy adds i to the "reviewed_by" field.
+func (sau *StockAdjustmentUpdate) AddReviewedBy(i int) *StockAdjustmentUpdate {
+	sau.mutation.AddReviewedBy(i)
+	return sau
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (sau *StockAdjustmentUpdate) ClearReviewedBy() *StockAdjustmentUpdate {
+	sau.mutation.ClearReviewedBy()
+	return sau
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (sau *StockAdjustmentUpdate) SetReviewedAt(t time.Time) *StockAdjustmentUpdate {
+	sau.mutation.SetReviewedAt(t)
+	return sau
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (sau *StockAdjustmentUpdate) SetNillableReviewedAt(t *time.Time) *StockAdjustmentUpdate {
+	if t != nil {
+		sau.SetReviewedAt(*t)
+	}
+	return sau
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (sau *StockAdjustmentUpdate) ClearReviewedAt() *StockAdjustmentUpdate {
+	sau.mutation.ClearReviewedAt()
+	return sau
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (sau *StockAdjustmentUpdate) SetAppliedAt(t time.Time) *StockAdjustmentUpdate {
+	sau.mutation.SetAppliedAt(t)
+	return sau
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (sau *StockAdjustmentUpdate) SetNillableAppliedAt(t *time.Time) *StockAdjustmentUpdate {
+	if t != nil {
+		sau.SetAppliedAt(*t)
+	}
+	return sau
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (sau *StockAdjustmentUpdate) ClearAppliedAt() *StockAdjustmentUpdate {
+	sau.mutation.ClearAppliedAt()
+	return sau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sau *StockAdjustmentUpdate) SetUpdatedAt(t time.Time) *StockAdjustmentUpdate {
+	sau.mutation.SetUpdatedAt(t)
+	return sau
+}
+
+// Mutation returns the StockAdjustmentMutation object of the builder.
+func (sau *StockAdjustmentUpdate) Mutation() *StockAdjustmentMutation {
+	return sau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *StockAdjustmentUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	sau.defaults()
+	if len(sau.hooks) == 0 {
+		affected, err = sau.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockAdjustmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sau.mutation = mutation
+			affected, err = sau.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sau.hooks) - 1; i >= 0; i-- {
+			if sau.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sau.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sau.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *StockAdjustmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *StockAdjustmentUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *StockAdjustmentUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sau *StockAdjustmentUpdate) defaults() {
+	if _, ok := sau.mutation.UpdatedAt(); !ok {
+		v := stockadjustment.UpdateDefaultUpdatedAt()
+		sau.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (sau *StockAdjustmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   stockadjustment.Table,
+			Columns: stockadjustment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockadjustment.FieldID,
+			},
+		},
+	}
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sau.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldTenantID,
+		})
+	}
+	if value, ok := sau.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldTenantID,
+		})
+	}
+	if value, ok := sau.mutation.ReasonID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldReasonID,
+		})
+	}
+	if value, ok := sau.mutation.AddedReasonID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldReasonID,
+		})
+	}
+	if value, ok := sau.mutation.Notes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockadjustment.FieldNotes,
+		})
+	}
+	if sau.mutation.NotesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: stockadjustment.FieldNotes,
+		})
+	}
+	if value, ok := sau.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockadjustment.FieldStatus,
+		})
+	}
+	if value, ok := sau.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockadjustment.FieldSource,
+		})
+	}
+	if value, ok := sau.mutation.TotalValue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: stockadjustment.FieldTotalValue,
+		})
+	}
+	if value, ok := sau.mutation.AddedTotalValue(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: stockadjustment.FieldTotalValue,
+		})
+	}
+	if value, ok := sau.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldUserID,
+		})
+	}
+	if value, ok := sau.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldUserID,
+		})
+	}
+	if value, ok := sau.mutation.ReviewedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldReviewedBy,
+		})
+	}
+	if value, ok := sau.mutation.AddedReviewedBy(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldReviewedBy,
+		})
+	}
+	if sau.mutation.ReviewedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: stockadjustment.FieldReviewedBy,
+		})
+	}
+	if value, ok := sau.mutation.ReviewedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: stockadjustment.FieldReviewedAt,
+		})
+	}
+	if sau.mutation.ReviewedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: stockadjustment.FieldReviewedAt,
+		})
+	}
+	if value, ok := sau.mutation.AppliedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: stockadjustment.FieldAppliedAt,
+		})
+	}
+	if sau.mutation.AppliedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: stockadjustment.FieldAppliedAt,
+		})
+	}
+	if value, ok := sau.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: stockadjustment.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockadjustment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// StockAdjustmentUpdateOne is the builder for updating a single StockAdjustment entity.
+type StockAdjustmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StockAdjustmentMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (sauo *StockAdjustmentUpdateOne) SetTenantID(i int) *StockAdjustmentUpdateOne {
+	sauo.mutation.ResetTenantID()
+	sauo.mutation.SetTenantID(i)
+	return sauo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (sauo *StockAdjustmentUpdateOne) AddTenantID(i int) *StockAdjustmentUpdateOne {
+	sauo.mutation.AddTenantID(i)
+	return sauo
+}
+
+// SetReasonID sets the "reason_id" field.
+func (sauo *StockAdjustmentUpdateOne) SetReasonID(i int) *StockAdjustmentUpdateOne {
+	sauo.mutation.ResetReasonID()
+	sauo.mutation.SetReasonID(i)
+	return sauo
+}
+
+// AddReasonID adds i to the "reason_id" field.
+func (sauo *StockAdjustmentUpdateOne) AddReasonID(i int) *StockAdjustmentUpdateOne {
+	sauo.mutation.AddReasonID(i)
+	return sauo
+}
+
+// SetNotes sets the "notes" field.
+func (sauo *StockAdjustmentUpdateOne) SetNotes(s string) *StockAdjustmentUpdateOne {
+	sauo.mutation.SetNotes(s)
+	return sauo
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (sauo *StockAdjustmentUpdateOne) SetNillableNotes(s *string) *StockAdjustmentUpdateOne {
+	if s != nil {
+		sauo.SetNotes(*s)
+	}
+	return sauo
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (sauo *StockAdjustmentUpdateOne) ClearNotes() *StockAdjustmentUpdateOne {
+	sauo.mutation.ClearNotes()
+	return sauo
+}
+
+// SetStatus sets the "status" field.
+func (sauo *StockAdjustmentUpdateOne) SetStatus(s string) *StockAdjustmentUpdateOne {
+	sauo.mutation.SetStatus(s)
+	return sauo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sauo *StockAdjustmentUpdateOne) SetNillableStatus(s *string) *StockAdjustmentUpdateOne {
+	if s != nil {
+		sauo.SetStatus(*s)
+	}
+	return sauo
+}
+
+// SetSource sets the "source" field.
+func (sauo *StockAdjustmentUpdateOne) SetSource(s string) *StockAdjustmentUpdateOne {
+	sauo.mutation.SetSource(s)
+	return sauo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (sauo *StockAdjustmentUpdateOne) SetNillableSource(s *string) *StockAdjustmentUpdateOne {
+	if s != nil {
+		sauo.SetSource(*s)
+	}
+	return sauo
+}
+
+// SetTotalValue sets the "total_value" field.
+func (sauo *StockAdjustmentUpdateOne) SetTotalValue(m money.Amount) *StockAdjustmentUpdateOne {
+	sauo.mutation.ResetTotalValue()
+	sauo.mutation.SetTotalValue(m)
+	return sauo
+}
+
+// SetNillableTotalValue sets the "total_value" field if the given value is not nil.
+func (sauo *StockAdjustmentUpdateOne) SetNillableTotalValue(m *money.Amount) *StockAdjustmentUpdateOne {
+	if m != nil {
+		sauo.SetTotalValue(*m)
+	}
+	return sauo
+}
+
+// AddTotalValue adds m to the "total_value" field.
+func (sauo *StockAdjustmentUpdateOne) AddTotalValue(m money.Amount) *StockAdjustmentUpdateOne {
+	sauo.mutation.AddTotalValue(m)
+	return sauo
+}
+
+// SetUserID sets the "user_id" field.
+func (sauo *StockAdjustmentUpdateOne) SetUserID(i int) *StockAdjustmentUpdateOne {
+	sauo.mutation.ResetUserID()
+	sauo.mutation.SetUserID(i)
+	return sauo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (sauo *StockAdjustmentUpdateOne) AddUserID(i int) *StockAdjustmentUpdateOne {
+	sauo.mutation.AddUserID(i)
+	return sauo
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (sauo *StockAdjustmentUpdateOne) SetReviewedBy(i int) *StockAdjustmentUpdateOne {
+	sauo.mutation.ResetReviewedBy()
+	sauo.mutation.SetReviewedBy(i)
+	return sauo
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (sauo *StockAdjustmentUpdateOne) SetNillableReviewedBy(i *int) *StockAdjustmentUpdateOne {
+	if i != nil {
+		sauo.SetReviewedBy(*i)
+	}
+	return sauo
+}
+
+// AddReviewedBy adds i to the "reviewed_by" field.
+func (sauo *StockAdjustmentUpdateOne) AddReviewedBy(i int) *StockAdjustmentUpdateOne {
+	sauo.mutation.AddReviewedBy(i)
+	return sauo
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (sauo *StockAdjustmentUpdateOne) ClearReviewedBy() *StockAdjustmentUpdateOne {
+	sauo.mutation.ClearReviewedBy()
+	return sauo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (sauo *StockAdjustmentUpdateOne) SetReviewedAt(t time.Time) *StockAdjustmentUpdateOne {
+	sauo.mutation.SetReviewedAt(t)
+	return sauo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (sauo *StockAdjustmentUpdateOne) SetNillableReviewedAt(t *time.Time) *StockAdjustmentUpdateOne {
+	if t != nil {
+		sauo.SetReviewedAt(*t)
+	}
+	return sauo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (sauo *StockAdjustmentUpdateOne) ClearReviewedAt() *StockAdjustmentUpdateOne {
+	sauo.mutation.ClearReviewedAt()
+	return sauo
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (sauo *StockAdjustmentUpdateOne) SetAppliedAt(t time.Time) *StockAdjustmentUpdateOne {
+	sauo.mutation.SetAppliedAt(t)
+	return sauo
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (sauo *StockAdjustmentUpdateOne) SetNillableAppliedAt(t *time.Time) *StockAdjustmentUpdateOne {
+	if t != nil {
+		sauo.SetAppliedAt(*t)
+	}
+	return sauo
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (sauo *StockAdjustmentUpdateOne) ClearAppliedAt() *StockAdjustmentUpdateOne {
+	sauo.mutation.ClearAppliedAt()
+	return sauo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sauo *StockAdjustmentUpdateOne) SetUpdatedAt(t time.Time) *StockAdjustmentUpdateOne {
+	sauo.mutation.SetUpdatedAt(t)
+	return sauo
+}
+
+// Mutation returns the StockAdjustmentMutation object of the builder.
+func (sauo *StockAdjustmentUpdateOne) Mutation() *StockAdjustmentMutation {
+	return sauo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *StockAdjustmentUpdateOne) Select(field string, fields ...string) *StockAdjustmentUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated StockAdjustment entity.
+func (sauo *StockAdjustmentUpdateOne) Save(ctx context.Context) (*StockAdjustment, error) {
+	var (
+		err  error
+		node *StockAdjustment
+	)
+	sauo.defaults()
+	if len(sauo.hooks) == 0 {
+		node, err = sauo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockAdjustmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sauo.mutation = mutation
+			node, err = sauo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sauo.hooks) - 1; i >= 0; i-- {
+			if sauo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sauo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, sauo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*StockAdjustment)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from StockAdjustmentMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *StockAdjustmentUpdateOne) SaveX(ctx context.Context) *StockAdjustment {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *StockAdjustmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *StockAdjustmentUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sauo *StockAdjustmentUpdateOne) defaults() {
+	if _, ok := sauo.mutation.UpdatedAt(); !ok {
+		v := stockadjustment.UpdateDefaultUpdatedAt()
+		sauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (sauo *StockAdjustmentUpdateOne) sqlSave(ctx context.Context) (_node *StockAdjustment, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   stockadjustment.Table,
+			Columns: stockadjustment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockadjustment.FieldID,
+			},
+		},
+	}
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StockAdjustment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stockadjustment.FieldID)
+		for _, f := range fields {
+			if !stockadjustment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != stockadjustment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sauo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldTenantID,
+		})
+	}
+	if value, ok := sauo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldTenantID,
+		})
+	}
+	if value, ok := sauo.mutation.ReasonID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldReasonID,
+		})
+	}
+	if value, ok := sauo.mutation.AddedReasonID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldReasonID,
+		})
+	}
+	if value, ok := sauo.mutation.Notes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockadjustment.FieldNotes,
+		})
+	}
+	if sauo.mutation.NotesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: stockadjustment.FieldNotes,
+		})
+	}
+	if value, ok := sauo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockadjustment.FieldStatus,
+		})
+	}
+	if value, ok := sauo.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: stockadjustment.FieldSource,
+		})
+	}
+	if value, ok := sauo.mutation.TotalValue(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: stockadjustment.FieldTotalValue,
+		})
+	}
+	if value, ok := sauo.mutation.AddedTotalValue(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: stockadjustment.FieldTotalValue,
+		})
+	}
+	if value, ok := sauo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldUserID,
+		})
+	}
+	if value, ok := sauo.mutation.AddedUserID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldUserID,
+		})
+	}
+	if value, ok := sauo.mutation.ReviewedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldReviewedBy,
+		})
+	}
+	if value, ok := sauo.mutation.AddedReviewedBy(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustment.FieldReviewedBy,
+		})
+	}
+	if sauo.mutation.ReviewedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: stockadjustment.FieldReviewedBy,
+		})
+	}
+	if value, ok := sauo.mutation.ReviewedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: stockadjustment.FieldReviewedAt,
+		})
+	}
+	if sauo.mutation.ReviewedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: stockadjustment.FieldReviewedAt,
+		})
+	}
+	if value, ok := sauo.mutation.AppliedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: stockadjustment.FieldAppliedAt,
+		})
+	}
+	if sauo.mutation.AppliedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: stockadjustment.FieldAppliedAt,
+		})
+	}
+	if value, ok := sauo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: stockadjustment.FieldUpdatedAt,
+		})
+	}
+	_node = &StockAdjustment{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockadjustment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
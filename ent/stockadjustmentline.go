@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/stockadjustmentline"
+	"Veritasbackend/pkg/money"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// StockAdjustmentLine is the model entity for the StockAdjustmentLine schema.
+type StockAdjustmentLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del ajuste
+	AdjustmentID int `json:"adjustment_id,omitempty"`
+	// ID del producto
+	ProductID int `json:"product_id,omitempty"`
+	// ID del lote del que salen las unidades, para productos con lotes
+	LotID int `json:"lot_id,omitempty"`
+	// Diferencia de stock: negativa para bajas, positiva para altas
+	Quantity float64 `json:"quantity,omitempty"`
+	// Costo unitario al registrar el ajuste
+	UnitCost money.Amount `json:"unit_cost,omitempty"`
+	// Diferencia valorizada al costo
+	TotalCost money.Amount `json:"total_cost,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StockAdjustmentLine) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stockadjustmentline.FieldUnitCost, stockadjustmentline.FieldTotalCost:
+			values[i] = new(money.Amount)
+		case stockadjustmentline.FieldQuantity:
+			values[i] = new(sql.NullFloat64)
+		case stockadjustmentline.FieldID, stockadjustmentline.FieldAdjustmentID, stockadjustmentline.FieldProductID, stockadjustmentline.FieldLotID:
+			values[i] = new(sql.NullInt64)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type StockAdjustmentLine", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StockAdjustmentLine fields.
+func (sal *StockAdjustmentLine) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stockadjustmentline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sal.ID = int(value.Int64)
+		case stockadjustmentline.FieldAdjustmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field adjustment_id", values[i])
+			} else if value.Valid {
+				sal.AdjustmentID = int(value.Int64)
+			}
+		case stockadjustmentline.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				sal.ProductID = int(value.Int64)
+			}
+		case stockadjustmentline.FieldLotID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lot_id", values[i])
+			} else if value.Valid {
+				sal.LotID = int(value.Int64)
+			}
+		case stockadjustmentline.FieldQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				sal.Quantity = value.Float64
+			}
+		case stockadjustmentline.FieldUnitCost:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_cost", values[i])
+			} else if value != nil {
+				sal.UnitCost = *value
+			}
+		case stockadjustmentline.FieldTotalCost:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field total_cost", values[i])
+			} else if value != nil {
+				sal.TotalCost = *value
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this StockAdjustmentLine.
+// Note that you need to call StockAdjustmentLine.Unwrap() before calling this method if this StockAdjustmentLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sal *StockAdjustmentLine) Update() *StockAdjustmentLineUpdateOne {
+	return (&StockAdjustmentLineClient{config: sal.config}).UpdateOne(sal)
+}
+
+// Unwrap unwraps the StockAdjustmentLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sal *StockAdjustmentLine) Unwrap() *StockAdjustmentLine {
+	_tx, ok := sal.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StockAdjustmentLine is not a transactional entity")
+	}
+	sal.config.driver = _tx.drv
+	return sal
+}
+
+// String implements the fmt.Stringer.
+func (sal *StockAdjustmentLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("StockAdjustmentLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sal.ID))
+	builder.WriteString("adjustment_id=")
+	builder.WriteString(fmt.Sprintf("%v", sal.AdjustmentID))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", sal.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("lot_id=")
+	builder.WriteString(fmt.Sprintf("%v", sal.LotID))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", sal.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("unit_cost=")
+	builder.WriteString(fmt.Sprintf("%v", sal.UnitCost))
+	builder.WriteString(", ")
+	builder.WriteString("total_cost=")
+	builder.WriteString(fmt.Sprintf("%v", sal.TotalCost))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StockAdjustmentLines is a parsable slice of StockAdjustmentLine.
+type StockAdjustmentLines []*StockAdjustmentLine
+
+func (sal StockAdjustmentLines) config(cfg config) {
+	for _i := range sal {
+		sal[_i].config = cfg
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stockadjustmentline
+
+import (
+	"Veritasbackend/pkg/money"
+)
+
+const (
+	// Label holds the string label denoting the stockadjustmentline type in the database.
+	Label = "stock_adjustment_line"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAdjustmentID holds the string denoting the adjustment_id field in the database.
+	FieldAdjustmentID = "adjustment_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldLotID holds the string denoting the lot_id field in the database.
+	FieldLotID = "lot_id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldUnitCost holds the string denoting the unit_cost field in the database.
+	FieldUnitCost = "unit_cost"
+	// FieldTotalCost holds the string denoting the total_cost field in the database.
+	FieldTotalCost = "total_cost"
+	// Table holds the table name of the stockadjustmentline in the database.
+	Table = "stock_adjustment_lines"
+)
+
+// Columns holds all SQL columns for stockadjustmentline fields.
+var Columns = []string{
+	FieldID,
+	FieldAdjustmentID,
+	FieldProductID,
+	FieldLotID,
+	FieldQuantity,
+	FieldUnitCost,
+	FieldTotalCost,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUnitCost holds the default value on creation for the "unit_cost" field.
+	DefaultUnitCost money.Amount
+	// UnitCostValidator is a validator for the "unit_cost" field. It is called by the builders before save.
+	UnitCostValidator func(int64) error
+	// DefaultTotalCost holds the default value on creation for the "total_cost" field.
+	DefaultTotalCost money.Amount
+)
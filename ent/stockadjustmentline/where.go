@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stockadjustmentline
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// AdjustmentID applies equality check predicate on the "adjustment_id" field. It's identical to AdjustmentIDEQ.
+func AdjustmentID(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAdjustmentID), v))
+	})
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// LotID applies equality check predicate on the "lot_id" field. It's identical to LotIDEQ.
+func LotID(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLotID), v))
+	})
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v float64) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// UnitCost applies equality check predicate on the "unit_cost" field. It's identical to UnitCostEQ.
+func UnitCost(v money.Amount) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// TotalCost applies equality check predicate on the "total_cost" field. It's identical to TotalCostEQ.
+func TotalCost(v money.Amount) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotalCost), v))
+	})
+}
+
+// AdjustmentIDEQ applies the EQ predicate on the "adjustment_id" field.
+func AdjustmentIDEQ(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAdjustmentID), v))
+	})
+}
+
+// AdjustmentIDNEQ applies the NEQ predicate on the "adjustment_id" field.
+func AdjustmentIDNEQ(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAdjustmentID), v))
+	})
+}
+
+// AdjustmentIDIn applies the In predicate on the "adjustment_id" field.
+func AdjustmentIDIn(vs ...int) predicate.StockAdjustmentLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAdjustmentID), v...))
+	})
+}
+
+// AdjustmentIDNotIn applies the NotIn predicate on the "adjustment_id" field.
+func AdjustmentIDNotIn(vs ...int) predicate.StockAdjustmentLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAdjustmentID), v...))
+	})
+}
+
+// AdjustmentIDGT applies the GT predicate on the "adjustment_id" field.
+func AdjustmentIDGT(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAdjustmentID), v))
+	})
+}
+
+// AdjustmentIDGTE applies the GTE predicate on the "adjustment_id" field.
+func AdjustmentIDGTE(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAdjustmentID), v))
+	})
+}
+
+// AdjustmentIDLT applies the LT predicate on the "adjustment_id" field.
+func AdjustmentIDLT(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAdjustmentID), v))
+	})
+}
+
+// AdjustmentIDLTE applies the LTE predicate on the "adjustment_id" field.
+func AdjustmentIDLTE(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAdjustmentID), v))
+	})
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.StockAdjustmentLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.StockAdjustmentLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProductID), v...))
+	})
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProductID), v))
+	})
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProductID), v))
+	})
+}
+
+// LotIDEQ applies the EQ predicate on the "lot_id" field.
+func LotIDEQ(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLotID), v))
+	})
+}
+
+// LotIDNEQ applies the NEQ predicate on the "lot_id" field.
+func LotIDNEQ(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLotID), v))
+	})
+}
+
+// LotIDIn applies the In predicate on the "lot_id" field.
+func LotIDIn(vs ...int) predicate.StockAdjustmentLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLotID), v...))
+	})
+}
+
+// LotIDNotIn applies the NotIn predicate on the "lot_id" field.
+func LotIDNotIn(vs ...int) predicate.StockAdjustmentLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLotID), v...))
+	})
+}
+
+// LotIDGT applies the GT predicate on the "lot_id" field.
+func LotIDGT(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLotID), v))
+	})
+}
+
+// LotIDGTE applies the GTE predicate on the "lot_id" field.
+func LotIDGTE(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLotID), v))
+	})
+}
+
+// LotIDLT applies the LT predicate on the "lot_id" field.
+func LotIDLT(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLotID), v))
+	})
+}
+
+// LotIDLTE applies the LTE predicate on the "lot_id" field.
+func LotIDLTE(v int) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLotID), v))
+	})
+}
+
+// LotIDIsNil applies the IsNil predicate on the "lot_id" field.
+func LotIDIsNil() predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLotID)))
+	})
+}
+
+// LotIDNotNil applies the NotNil predicate on the "lot_id" field.
+func LotIDNotNil() predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLotID)))
+	})
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v float64) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v float64) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...float64) predicate.StockAdjustmentLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...float64) predicate.StockAdjustmentLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v float64) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v float64) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v float64) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v float64) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldQuantity), v))
+	})
+}
+
+// UnitCostEQ applies the EQ predicate on the "unit_cost" field.
+func UnitCostEQ(v money.Amount) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostNEQ applies the NEQ predicate on the "unit_cost" field.
+func UnitCostNEQ(v money.Amount) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostIn applies the In predicate on the "unit_cost" field.
+func UnitCostIn(vs ...money.Amount) predicate.StockAdjustmentLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUnitCost), v...))
+	})
+}
+
+// UnitCostNotIn applies the NotIn predicate on the "unit_cost" field.
+func UnitCostNotIn(vs ...money.Amount) predicate.StockAdjustmentLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUnitCost), v...))
+	})
+}
+
+// UnitCostGT applies the GT predicate on the "unit_cost" field.
+func UnitCostGT(v money.Amount) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostGTE applies the GTE predicate on the "unit_cost" field.
+func UnitCostGTE(v money.Amount) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostLT applies the LT predicate on the "unit_cost" field.
+func UnitCostLT(v money.Amount) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUnitCost), v))
+	})
+}
+
+// UnitCostLTE applies the LTE predicate on the "unit_cost" field.
+func UnitCostLTE(v money.Amount) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUnitCost), v))
+	})
+}
+
+// TotalCostEQ applies the EQ predicate on the "total_cost" field.
+func TotalCostEQ(v money.Amount) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotalCost), v))
+	})
+}
+
+// TotalCostNEQ applies the NEQ predicate on the "total_cost" field.
+func TotalCostNEQ(v money.Amount) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTotalCost), v))
+	})
+}
+
+// TotalCostIn applies the In predicate on the "total_cost" field.
+func TotalCostIn(vs ...money.Amount) predicate.StockAdjustmentLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTotalCost), v...))
+	})
+}
+
+// TotalCostNotIn applies the NotIn predicate on the "total_cost" field.
+func TotalCostNotIn(vs ...money.Amount) predicate.StockAdjustmentLine {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTotalCost), v...))
+	})
+}
+
+// TotalCostGT applies the GT predicate on the "total_cost" field.
+func TotalCostGT(v money.Amount) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTotalCost), v))
+	})
+}
+
+// TotalCostGTE applies the GTE predicate on the "total_cost" field.
+func TotalCostGTE(v money.Amount) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTotalCost), v))
+	})
+}
+
+// TotalCostLT applies the LT predicate on the "total_cost" field.
+func TotalCostLT(v money.Amount) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTotalCost), v))
+	})
+}
+
+// TotalCostLTE applies the LTE predicate on the "total_cost" field.
+func TotalCostLTE(v money.Amount) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTotalCost), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StockAdjustmentLine) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StockAdjustmentLine) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StockAdjustmentLine) predicate.StockAdjustmentLine {
+	return predicate.StockAdjustmentLine(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/stockadjustmentline"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StockAdjustmentLineCreate is the builder for creating a StockAdjustmentLine entity.
+type StockAdjustmentLineCreate struct {
+	config
+	mutation *StockAdjustmentLineMutation
+	hooks    []Hook
+}
+
+// SetAdjustmentID sets the "adjustment_id" field.
+func (salc *StockAdjustmentLineCreate) SetAdjustmentID(i int) *StockAdjustmentLineCreate {
+	salc.mutation.SetAdjustmentID(i)
+	return salc
+}
+
+// SetProductID sets the "product_id" field.
+func (salc *StockAdjustmentLineCreate) SetProductID(i int) *StockAdjustmentLineCreate {
+	salc.mutation.SetProductID(i)
+	return salc
+}
+
+// SetLotID sets the "lot_id" field.
+func (salc *StockAdjustmentLineCreate) SetLotID(i int) *StockAdjustmentLineCreate {
+	salc.mutation.SetLotID(i)
+	return salc
+}
+
+// SetNillableLotID sets the "lot_id" field if the given value is not nil.
+func (salc *StockAdjustmentLineCreate) SetNillableLotID(i *int) *StockAdjustmentLineCreate {
+	if i != nil {
+		salc.SetLotID(*i)
+	}
+	return salc
+}
+
+// SetQuantity sets the "quantity" field.
+func (salc *StockAdjustmentLineCreate) SetQuantity(f float64) *StockAdjustmentLineCreate {
+	salc.mutation.SetQuantity(f)
+	return salc
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (salc *StockAdjustmentLineCreate) SetUnitCost(m money.Amount) *StockAdjustmentLineCreate {
+	salc.mutation.SetUnitCost(m)
+	return salc
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (salc *StockAdjustmentLineCreate) SetNillableUnitCost(m *money.Amount) *StockAdjustmentLineCreate {
+	if m != nil {
+		salc.SetUnitCost(*m)
+	}
+	return salc
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (salc *StockAdjustmentLineCreate) SetTotalCost(m money.Amount) *StockAdjustmentLineCreate {
+	salc.mutation.SetTotalCost(m)
+	return salc
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (salc *StockAdjustmentLineCreate) SetNillableTotalCost(m *money.Amount) *StockAdjustmentLineCreate {
+	if m != nil {
+		salc.SetTotalCost(*m)
+	}
+	return salc
+}
+
+// Mutation returns the StockAdjustmentLineMutation object of the builder.
+func (salc *StockAdjustmentLineCreate) Mutation() *StockAdjustmentLineMutation {
+	return salc.mutation
+}
+
+// Save creates the StockAdjustmentLine in the database.
+func (salc *StockAdjustmentLineCreate) Save(ctx context.Context) (*StockAdjustmentLine, error) {
+	var (
+		err  error
+		node *StockAdjustmentLine
+	)
+	salc.defaults()
+	if len(salc.hooks) == 0 {
+		if err = salc.check(); err != nil {
+			return nil, err
+		}
+		node, err = salc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockAdjustmentLineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = salc.check(); err != nil {
+				return nil, err
+			}
+			salc.mutation = mutation
+			if node, err = salc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(salc.hooks) - 1; i >= 0; i-- {
+			if salc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = salc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, salc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*StockAdjustmentLine)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from StockAdjustmentLineMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (salc *StockAdjustmentLineCreate) SaveX(ctx context.Context) *StockAdjustmentLine {
+	v, err := salc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (salc *StockAdjustmentLineCreate) Exec(ctx context.Context) error {
+	_, err := salc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (salc *StockAdjustmentLineCreate) ExecX(ctx context.Context) {
+	if err := salc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (salc *StockAdjustmentLineCreate) defaults() {
+	if _, ok := salc.mutation.UnitCost(); !ok {
+		v := stockadjustmentline.DefaultUnitCost
+		salc.mutation.SetUnitCost(v)
+	}
+	if _, ok := salc.mutation.TotalCost(); !ok {
+		v := stockadjustmentline.DefaultTotalCost
+		salc.mutation.SetTotalCost(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (salc *StockAdjustmentLineCreate) check() error {
+	if _, ok := salc.mutation.AdjustmentID(); !ok {
+		return &ValidationError{Name: "adjustment_id", err: errors.New(`ent: missing required field "StockAdjustmentLine.adjustment_id"`)}
+	}
+	if _, ok := salc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "StockAdjustmentLine.product_id"`)}
+	}
+	if _, ok := salc.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "StockAdjustmentLine.quantity"`)}
+	}
+	if _, ok := salc.mutation.UnitCost(); !ok {
+		return &ValidationError{Name: "unit_cost", err: errors.New(`ent: missing required field "StockAdjustmentLine.unit_cost"`)}
+	}
+	if v, ok := salc.mutation.UnitCost(); ok {
+		if err := stockadjustmentline.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "StockAdjustmentLine.unit_cost": %w`, err)}
+		}
+	}
+	if _, ok := salc.mutation.TotalCost(); !ok {
+		return &ValidationError{Name: "total_cost", err: errors.New(`ent: missing required field "StockAdjustmentLine.total_cost"`)}
+	}
+	return nil
+}
+
+func (salc *StockAdjustmentLineCreate) sqlSave(ctx context.Context) (*StockAdjustmentLine, error) {
+	_node, _spec := salc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, salc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (salc *StockAdjustmentLineCreate) createSpec() (*StockAdjustmentLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StockAdjustmentLine{config: salc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: stockadjustmentline.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockadjustmentline.FieldID,
+			},
+		}
+	)
+	if value, ok := salc.mutation.AdjustmentID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustmentline.FieldAdjustmentID,
+		})
+		_node.AdjustmentID = value
+	}
+	if value, ok := salc.mutation.ProductID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustmentline.FieldProductID,
+		})
+		_node.ProductID = value
+	}
+	if value, ok := salc.mutation.LotID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustmentline.FieldLotID,
+		})
+		_node.LotID = value
+	}
+	if value, ok := salc.mutation.Quantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: stockadjustmentline.FieldQuantity,
+		})
+		_node.Quantity = value
+	}
+	if value, ok := salc.mutation.UnitCost(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: stockadjustmentline.FieldUnitCost,
+		})
+		_node.UnitCost = value
+	}
+	if value, ok := salc.mutation.TotalCost(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: stockadjustmentline.FieldTotalCost,
+		})
+		_node.TotalCost = value
+	}
+	return _node, _spec
+}
+
+// StockAdjustmentLineCreateBulk is the builder for creating many StockAdjustmentLine entities in bulk.
+type StockAdjustmentLineCreateBulk struct {
+	config
+	builders []*StockAdjustmentLineCreate
+}
+
+// Save creates the StockAdjustmentLine entities in the database.
+func (salcb *StockAdjustmentLineCreateBulk) Save(ctx context.Context) ([]*StockAdjustmentLine, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(salcb.builders))
+	nodes := make([]*StockAdjustmentLine, len(salcb.builders))
+	mutators := make([]Mutator, len(salcb.builders))
+	for i := range salcb.builders {
+		func(i int, root context.Context) {
+			builder := salcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StockAdjustmentLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, salcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, salcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, salcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (salcb *StockAdjustmentLineCreateBulk) SaveX(ctx context.Context) []*StockAdjustmentLine {
+	v, err := salcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (salcb *StockAdjustmentLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := salcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (salcb *StockAdjustmentLineCreateBulk) ExecX(ctx context.Context) {
+	if err := salcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
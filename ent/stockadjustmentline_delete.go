@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/stockadjustmentline"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StockAdjustmentLineDelete is the builder for deleting a StockAdjustmentLine entity.
+type StockAdjustmentLineDelete struct {
+	config
+	hooks    []Hook
+	mutation *StockAdjustmentLineMutation
+}
+
+// Where appends a list predicates to the StockAdjustmentLineDelete builder.
+func (sald *StockAdjustmentLineDelete) Where(ps ...predicate.StockAdjustmentLine) *StockAdjustmentLineDelete {
+	sald.mutation.Where(ps...)
+	return sald
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sald *StockAdjustmentLineDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(sald.hooks) == 0 {
+		affected, err = sald.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockAdjustmentLineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sald.mutation = mutation
+			affected, err = sald.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sald.hooks) - 1; i >= 0; i-- {
+			if sald.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sald.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sald.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sald *StockAdjustmentLineDelete) ExecX(ctx context.Context) int {
+	n, err := sald.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sald *StockAdjustmentLineDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: stockadjustmentline.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockadjustmentline.FieldID,
+			},
+		},
+	}
+	if ps := sald.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sald.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// StockAdjustmentLineDeleteOne is the builder for deleting a single StockAdjustmentLine entity.
+type StockAdjustmentLineDeleteOne struct {
+	sald *StockAdjustmentLineDelete
+}
+
+// Exec executes the deletion query.
+func (saldo *StockAdjustmentLineDeleteOne) Exec(ctx context.Context) error {
+	n, err := saldo.sald.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{stockadjustmentline.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (saldo *StockAdjustmentLineDeleteOne) ExecX(ctx context.Context) {
+	saldo.sald.ExecX(ctx)
+}
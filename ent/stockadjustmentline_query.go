@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/stockadjustmentline"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StockAdjustmentLineQuery is the builder for querying StockAdjustmentLine entities.
+type StockAdjustmentLineQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.StockAdjustmentLine
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the StockAdjustmentLineQuery builder.
+func (salq *StockAdjustmentLineQuery) Where(ps ...predicate.StockAdjustmentLine) *StockAdjustmentLineQuery {
+	salq.predicates = append(salq.predicates, ps...)
+	return salq
+}
+
+// Limit adds a limit step to the query.
+func (salq *StockAdjustmentLineQuery) Limit(limit int) *StockAdjustmentLineQuery {
+	salq.limit = &limit
+	return salq
+}
+
+// Offset adds an offset step to the query.
+func (salq *StockAdjustmentLineQuery) Offset(offset int) *StockAdjustmentLineQuery {
+	salq.offset = &offset
+	return salq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (salq *StockAdjustmentLineQuery) Unique(unique bool) *StockAdjustmentLineQuery {
+	salq.unique = &unique
+	return salq
+}
+
+// Order adds an order step to the query.
+func (salq *StockAdjustmentLineQuery) Order(o ...OrderFunc) *StockAdjustmentLineQuery {
+	salq.order = append(salq.order, o...)
+	return salq
+}
+
+// First returns the first StockAdjustmentLine entity from the query.
+// Returns a *NotFoundError when no StockAdjustmentLine was found.
+func (salq *StockAdjustmentLineQuery) First(ctx context.Context) (*StockAdjustmentLine, error) {
+	nodes, err := salq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{stockadjustmentline.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (salq *StockAdjustmentLineQuery) FirstX(ctx context.Context) *StockAdjustmentLine {
+	node, err := salq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first StockAdjustmentLine ID from the query.
+// Returns a *NotFoundError when no StockAdjustmentLine ID was found.
+func (salq *StockAdjustmentLineQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = salq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{stockadjustmentline.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (salq *StockAdjustmentLineQuery) FirstIDX(ctx context.Context) int {
+	id, err := salq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single StockAdjustmentLine entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one StockAdjustmentLine entity is found.
+// Returns a *NotFoundError when no StockAdjustmentLine entities are found.
+func (salq *StockAdjustmentLineQuery) Only(ctx context.Context) (*StockAdjustmentLine, error) {
+	nodes, err := salq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{stockadjustmentline.Label}
+	default:
+		return nil, &NotSingularError{stockadjustmentline.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (salq *StockAdjustmentLineQuery) OnlyX(ctx context.Context) *StockAdjustmentLine {
+	node, err := salq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only StockAdjustmentLine ID in the query.
+// Returns a *NotSingularError when more than one StockAdjustmentLine ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (salq *StockAdjustmentLineQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = salq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{stockadjustmentline.Label}
+	default:
+		err = &NotSingularError{stockadjustmentline.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (salq *StockAdjustmentLineQuery) OnlyIDX(ctx context.Context) int {
+	id, err := salq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of StockAdjustmentLines.
+func (salq *StockAdjustmentLineQuery) All(ctx context.Context) ([]*StockAdjustmentLine, error) {
+	if err := salq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return salq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (salq *StockAdjustmentLineQuery) AllX(ctx context.Context) []*StockAdjustmentLine {
+	nodes, err := salq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of StockAdjustmentLine IDs.
+func (salq *StockAdjustmentLineQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := salq.Select(stockadjustmentline.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (salq *StockAdjustmentLineQuery) IDsX(ctx context.Context) []int {
+	ids, err := salq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (salq *StockAdjustmentLineQuery) Count(ctx context.Context) (int, error) {
+	if err := salq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return salq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (salq *StockAdjustmentLineQuery) CountX(ctx context.Context) int {
+	count, err := salq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (salq *StockAdjustmentLineQuery) Exist(ctx context.Context) (bool, error) {
+	if err := salq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return salq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (salq *StockAdjustmentLineQuery) ExistX(ctx context.Context) bool {
+	exist, err := salq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the StockAdjustmentLineQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (salq *StockAdjustmentLineQuery) Clone() *StockAdjustmentLineQuery {
+	if salq == nil {
+		return nil
+	}
+	return &StockAdjustmentLineQuery{
+		config:     salq.config,
+		limit:      salq.limit,
+		offset:     salq.offset,
+		order:      append([]OrderFunc{}, salq.order...),
+		predicates: append([]predicate.StockAdjustmentLine{}, salq.predicates...),
+		// clone intermediate query.
+		sql:    salq.sql.Clone(),
+		path:   salq.path,
+		unique: salq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AdjustmentID int `json:"adjustment_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.StockAdjustmentLine.Query().
+//		GroupBy(stockadjustmentline.FieldAdjustmentID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (salq *StockAdjustmentLineQuery) GroupBy(field string, fields ...string) *StockAdjustmentLineGroupBy {
+	grbuild := &StockAdjustmentLineGroupBy{config: salq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := salq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return salq.sqlQuery(ctx), nil
+	}
+	grbuild.label = stockadjustmentline.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AdjustmentID int `json:"adjustment_id,omitempty"`
+//	}
+//
+//	client.StockAdjustmentLine.Query().
+//		Select(stockadjustmentline.FieldAdjustmentID).
+//		Scan(ctx, &v)
+func (salq *StockAdjustmentLineQuery) Select(fields ...string) *StockAdjustmentLineSelect {
+	salq.fields = append(salq.fields, fields...)
+	selbuild := &StockAdjustmentLineSelect{StockAdjustmentLineQuery: salq}
+	selbuild.label = stockadjustmentline.Label
+	selbuild.flds, selbuild.scan = &salq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (salq *StockAdjustmentLineQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range salq.fields {
+		if !stockadjustmentline.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if salq.path != nil {
+		prev, err := salq.path(ctx)
+		if err != nil {
+			return err
+		}
+		salq.sql = prev
+	}
+	return nil
+}
+
+func (salq *StockAdjustmentLineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*StockAdjustmentLine, error) {
+	var (
+		nodes = []*StockAdjustmentLine{}
+		_spec = salq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*StockAdjustmentLine).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &StockAdjustmentLine{config: salq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(salq.modifiers) > 0 {
+		_spec.Modifiers = salq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, salq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (salq *StockAdjustmentLineQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := salq.querySpec()
+	if len(salq.modifiers) > 0 {
+		_spec.Modifiers = salq.modifiers
+	}
+	_spec.Node.Columns = salq.fields
+	if len(salq.fields) > 0 {
+		_spec.Unique = salq.unique != nil && *salq.unique
+	}
+	return sqlgraph.CountNodes(ctx, salq.driver, _spec)
+}
+
+func (salq *StockAdjustmentLineQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := salq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (salq *StockAdjustmentLineQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   stockadjustmentline.Table,
+			Columns: stockadjustmentline.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockadjustmentline.FieldID,
+			},
+		},
+		From:   salq.sql,
+		Unique: true,
+	}
+	if unique := salq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := salq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stockadjustmentline.FieldID)
+		for i := range fields {
+			if fields[i] != stockadjustmentline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := salq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := salq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := salq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := salq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (salq *StockAdjustmentLineQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(salq.driver.Dialect())
+	t1 := builder.Table(stockadjustmentline.Table)
+	columns := salq.fields
+	if len(columns) == 0 {
+		columns = stockadjustmentline.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if salq.sql != nil {
+		selector = salq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if salq.unique != nil && *salq.unique {
+		selector.Distinct()
+	}
+	for _, m := range salq.modifiers {
+		m(selector)
+	}
+	for _, p := range salq.predicates {
+		p(selector)
+	}
+	for _, p := range salq.order {
+		p(selector)
+	}
+	if offset := salq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := salq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (salq *StockAdjustmentLineQuery) ForUpdate(opts ...sql.LockOption) *StockAdjustmentLineQuery {
+	if salq.driver.Dialect() == dialect.Postgres {
+		salq.Unique(false)
+	}
+	salq.modifiers = append(salq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return salq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (salq *StockAdjustmentLineQuery) ForShare(opts ...sql.LockOption) *StockAdjustmentLineQuery {
+	if salq.driver.Dialect() == dialect.Postgres {
+		salq.Unique(false)
+	}
+	salq.modifiers = append(salq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return salq
+}
+
+// StockAdjustmentLineGroupBy is the group-by builder for StockAdjustmentLine entities.
+type StockAdjustmentLineGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (salgb *StockAdjustmentLineGroupBy) Aggregate(fns ...AggregateFunc) *StockAdjustmentLineGroupBy {
+	salgb.fns = append(salgb.fns, fns...)
+	return salgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (salgb *StockAdjustmentLineGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := salgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	salgb.sql = query
+	return salgb.sqlScan(ctx, v)
+}
+
+func (salgb *StockAdjustmentLineGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range salgb.fields {
+		if !stockadjustmentline.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := salgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := salgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (salgb *StockAdjustmentLineGroupBy) sqlQuery() *sql.Selector {
+	selector := salgb.sql.Select()
+	aggregation := make([]string, 0, len(salgb.fns))
+	for _, fn := range salgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(salgb.fields)+len(salgb.fns))
+		for _, f := range salgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(salgb.fields...)...)
+}
+
+// StockAdjustmentLineSelect is the builder for selecting fields of StockAdjustmentLine entities.
+type StockAdjustmentLineSelect struct {
+	*StockAdjustmentLineQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sals *StockAdjustmentLineSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := sals.prepareQuery(ctx); err != nil {
+		return err
+	}
+	sals.sql = sals.StockAdjustmentLineQuery.sqlQuery(ctx)
+	return sals.sqlScan(ctx, v)
+}
+
+func (sals *StockAdjustmentLineSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := sals.sql.Query()
+	if err := sals.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,674 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/stockadjustmentline"
+	"Veritasbackend/pkg/money"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StockAdjustmentLineUpdate is the builder for updating StockAdjustmentLine entities.
+type StockAdjustmentLineUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StockAdjustmentLineMutation
+}
+
+// Where appends a list predicates to the StockAdjustmentLineUpdate builder.
+func (salu *StockAdjustmentLineUpdate) Where(ps ...predicate.StockAdjustmentLine) *StockAdjustmentLineUpdate {
+	salu.mutation.Where(ps...)
+	return salu
+}
+
+// SetAdjustmentID sets the "adjustment_id" field.
+func (salu *StockAdjustmentLineUpdate) SetAdjustmentID(i int) *StockAdjustmentLineUpdate {
+	salu.mutation.ResetAdjustmentID()
+	salu.mutation.SetAdjustmentID(i)
+	return salu
+}
+
+// AddAdjustmentID adds i to the "adjustment_id" field.
+func (salu *StockAdjustmentLineUpdate) AddAdjustmentID(i int) *StockAdjustmentLineUpdate {
+	salu.mutation.AddAdjustmentID(i)
+	return salu
+}
+
+// SetProductID sets the "product_id" field.
+func (salu *StockAdjustmentLineUpdate) SetProductID(i int) *StockAdjustmentLineUpdate {
+	salu.mutation.ResetProductID()
+	salu.mutation.SetProductID(i)
+	return salu
+}
+
+// AddProductID adds i to the "product_id" field.
+func (salu *StockAdjustmentLineUpdate) AddProductID(i int) *StockAdjustmentLineUpdate {
+	salu.mutation.AddProductID(i)
+	return salu
+}
+
+// SetLotID sets the "lot_id" field.
+func (salu *StockAdjustmentLineUpdate) SetLotID(i int) *StockAdjustmentLineUpdate {
+	salu.mutation.ResetLotID()
+	salu.mutation.SetLotID(i)
+	return salu
+}
+
+// SetNillableLotID sets the "lot_id" field if the given value is not nil.
+func (salu *StockAdjustmentLineUpdate) SetNillableLotID(i *int) *StockAdjustmentLineUpdate {
+	if i != nil {
+		salu.SetLotID(*i)
+	}
+	return salu
+}
+
+// AddLotID adds i to the "lot_id" field.
+func (salu *StockAdjustmentLineUpdate) AddLotID(i int) *StockAdjustmentLineUpdate {
+	salu.mutation.AddLotID(i)
+	return salu
+}
+
+// ClearLotID clears the value of the "lot_id" field.
+func (salu *StockAdjustmentLineUpdate) ClearLotID() *StockAdjustmentLineUpdate {
+	salu.mutation.ClearLotID()
+	return salu
+}
+
+// SetQuantity sets the "quantity" field.
+func (salu *StockAdjustmentLineUpdate) SetQuantity(f float64) *StockAdjustmentLineUpdate {
+	salu.mutation.ResetQuantity()
+	salu.mutation.SetQuantity(f)
+	return salu
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (salu *StockAdjustmentLineUpdate) AddQuantity(f float64) *StockAdjustmentLineUpdate {
+	salu.mutation.AddQuantity(f)
+	return salu
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (salu *StockAdjustmentLineUpdate) SetUnitCost(m money.Amount) *StockAdjustmentLineUpdate {
+	salu.mutation.ResetUnitCost()
+	salu.mutation.SetUnitCost(m)
+	return salu
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (salu *StockAdjustmentLineUpdate) SetNillableUnitCost(m *money.Amount) *StockAdjustmentLineUpdate {
+	if m != nil {
+		salu.SetUnitCost(*m)
+	}
+	return salu
+}
+
+// AddUnitCost adds m to the "unit_cost" field.
+func (salu *StockAdjustmentLineUpdate) AddUnitCost(m money.Amount) *StockAdjustmentLineUpdate {
+	salu.mutation.AddUnitCost(m)
+	return salu
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (salu *StockAdjustmentLineUpdate) SetTotalCost(m money.Amount) *StockAdjustmentLineUpdate {
+	salu.mutation.ResetTotalCost()
+	salu.mutation.SetTotalCost(m)
+	return salu
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (salu *StockAdjustmentLineUpdate) SetNillableTotalCost(m *money.Amount) *StockAdjustmentLineUpdate {
+	if m != nil {
+		salu.SetTotalCost(*m)
+	}
+	return salu
+}
+
+// AddTotalCost adds m to the "total_cost" field.
+func (salu *StockAdjustmentLineUpdate) AddTotalCost(m money.Amount) *StockAdjustmentLineUpdate {
+	salu.mutation.AddTotalCost(m)
+	return salu
+}
+
+// Mutation returns the StockAdjustmentLineMutation object of the builder.
+func (salu *StockAdjustmentLineUpdate) Mutation() *StockAdjustmentLineMutation {
+	return salu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (salu *StockAdjustmentLineUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(salu.hooks) == 0 {
+		if err = salu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = salu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockAdjustmentLineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = salu.check(); err != nil {
+				return 0, err
+			}
+			salu.mutation = mutation
+			affected, err = salu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(salu.hooks) - 1; i >= 0; i-- {
+			if salu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = salu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, salu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (salu *StockAdjustmentLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := salu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (salu *StockAdjustmentLineUpdate) Exec(ctx context.Context) error {
+	_, err := salu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (salu *StockAdjustmentLineUpdate) ExecX(ctx context.Context) {
+	if err := salu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (salu *StockAdjustmentLineUpdate) check() error {
+	if v, ok := salu.mutation.UnitCost(); ok {
+		if err := stockadjustmentline.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "StockAdjustmentLine.unit_cost": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (salu *StockAdjustmentLineUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   stockadjustmentline.Table,
+			Columns: stockadjustmentline.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockadjustmentline.FieldID,
+			},
+		},
+	}
+	if ps := salu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := salu.mutation.AdjustmentID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustmentline.FieldAdjustmentID,
+		})
+	}
+	if value, ok := salu.mutation.AddedAdjustmentID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustmentline.FieldAdjustmentID,
+		})
+	}
+	if value, ok := salu.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustmentline.FieldProductID,
+		})
+	}
+	if value, ok := salu.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustmentline.FieldProductID,
+		})
+	}
+	if value, ok := salu.mutation.LotID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustmentline.FieldLotID,
+		})
+	}
+	if value, ok := salu.mutation.AddedLotID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustmentline.FieldLotID,
+		})
+	}
+	if salu.mutation.LotIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: stockadjustmentline.FieldLotID,
+		})
+	}
+	if value, ok := salu.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: stockadjustmentline.FieldQuantity,
+		})
+	}
+	if value, ok := salu.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: stockadjustmentline.FieldQuantity,
+		})
+	}
+	if value, ok := salu.mutation.UnitCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: stockadjustmentline.FieldUnitCost,
+		})
+	}
+	if value, ok := salu.mutation.AddedUnitCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: stockadjustmentline.FieldUnitCost,
+		})
+	}
+	if value, ok := salu.mutation.TotalCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: stockadjustmentline.FieldTotalCost,
+		})
+	}
+	if value, ok := salu.mutation.AddedTotalCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: stockadjustmentline.FieldTotalCost,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, salu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockadjustmentline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// StockAdjustmentLineUpdateOne is the builder for updating a single StockAdjustmentLine entity.
+type StockAdjustmentLineUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StockAdjustmentLineMutation
+}
+
+// SetAdjustmentID sets the "adjustment_id" field.
+func (saluo *StockAdjustmentLineUpdateOne) SetAdjustmentID(i int) *StockAdjustmentLineUpdateOne {
+	saluo.mutation.ResetAdjustmentID()
+	saluo.mutation.SetAdjustmentID(i)
+	return saluo
+}
+
+// AddAdjustmentID adds i to the "adjustment_id" field.
+func (saluo *StockAdjustmentLineUpdateOne) AddAdjustmentID(i int) *StockAdjustmentLineUpdateOne {
+	saluo.mutation.AddAdjustmentID(i)
+	return saluo
+}
+
+// SetProductID sets the "product_id" field.
+func (saluo *StockAdjustmentLineUpdateOne) SetProductID(i int) *StockAdjustmentLineUpdateOne {
+	saluo.mutation.ResetProductID()
+	saluo.mutation.SetProductID(i)
+	return saluo
+}
+
+// AddProductID adds i to the "product_id" field.
+func (saluo *StockAdjustmentLineUpdateOne) AddProductID(i int) *StockAdjustmentLineUpdateOne {
+	saluo.mutation.AddProductID(i)
+	return saluo
+}
+
+// SetLotID sets the "lot_id" field.
+func (saluo *StockAdjustmentLineUpdateOne) SetLotID(i int) *StockAdjustmentLineUpdateOne {
+	saluo.mutation.ResetLotID()
+	saluo.mutation.SetLotID(i)
+	return saluo
+}
+
+// SetNillableLotID sets the "lot_id" field if the given value is not nil.
+func (saluo *StockAdjustmentLineUpdateOne) SetNillableLotID(i *int) *StockAdjustmentLineUpdateOne {
+	if i != nil {
+		saluo.SetLotID(*i)
+	}
+	return saluo
+}
+
+// AddLotID adds i to the "lot_id" field.
+func (saluo *StockAdjustmentLineUpdateOne) AddLotID(i int) *StockAdjustmentLineUpdateOne {
+	saluo.mutation.AddLotID(i)
+	return saluo
+}
+
+// ClearLotID clears the value of the "lot_id" field.
+func (saluo *StockAdjustmentLineUpdateOne) ClearLotID() *StockAdjustmentLineUpdateOne {
+	saluo.mutation.ClearLotID()
+	return saluo
+}
+
+// SetQuantity sets the "quantity" field.
+func (saluo *StockAdjustmentLineUpdateOne) SetQuantity(f float64) *StockAdjustmentLineUpdateOne {
+	saluo.mutation.ResetQuantity()
+	saluo.mutation.SetQuantity(f)
+	return saluo
+}
+
+// AddQuantity adds f to the "quantity" field.
+func (saluo *StockAdjustmentLineUpdateOne) AddQuantity(f float64) *StockAdjustmentLineUpdateOne {
+	saluo.mutation.AddQuantity(f)
+	return saluo
+}
+
+// SetUnitCost sets the "unit_cost" field.
+func (saluo *StockAdjustmentLineUpdateOne) SetUnitCost(m money.Amount) *StockAdjustmentLineUpdateOne {
+	saluo.mutation.ResetUnitCost()
+	saluo.mutation.SetUnitCost(m)
+	return saluo
+}
+
+// SetNillableUnitCost sets the "unit_cost" field if the given value is not nil.
+func (saluo *StockAdjustmentLineUpdateOne) SetNillableUnitCost(m *money.Amount) *StockAdjustmentLineUpdateOne {
+	if m != nil {
+		saluo.SetUnitCost(*m)
+	}
+	return saluo
+}
+
+// AddUnitCost adds m to the "unit_cost" field.
+func (saluo *StockAdjustmentLineUpdateOne) AddUnitCost(m money.Amount) *StockAdjustmentLineUpdateOne {
+	saluo.mutation.AddUnitCost(m)
+	return saluo
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (saluo *StockAdjustmentLineUpdateOne) SetTotalCost(m money.Amount) *StockAdjustmentLineUpdateOne {
+	saluo.mutation.ResetTotalCost()
+	saluo.mutation.SetTotalCost(m)
+	return saluo
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (saluo *StockAdjustmentLineUpdateOne) SetNillableTotalCost(m *money.Amount) *StockAdjustmentLineUpdateOne {
+	if m != nil {
+		saluo.SetTotalCost(*m)
+	}
+	return saluo
+}
+
+// AddTotalCost adds m to the "total_cost" field.
+func (saluo *StockAdjustmentLineUpdateOne) AddTotalCost(m money.Amount) *StockAdjustmentLineUpdateOne {
+	saluo.mutation.AddTotalCost(m)
+	return saluo
+}
+
+// Mutation returns the StockAdjustmentLineMutation object of the builder.
+func (saluo *StockAdjustmentLineUpdateOne) Mutation() *StockAdjustmentLineMutation {
+	return saluo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (saluo *StockAdjustmentLineUpdateOne) Select(field string, fields ...string) *StockAdjustmentLineUpdateOne {
+	saluo.fields = append([]string{field}, fields...)
+	return saluo
+}
+
+// Save executes the query and returns the updated StockAdjustmentLine entity.
+func (saluo *StockAdjustmentLineUpdateOne) Save(ctx context.Context) (*StockAdjustmentLine, error) {
+	var (
+		err  error
+		node *StockAdjustmentLine
+	)
+	if len(saluo.hooks) == 0 {
+		if err = saluo.check(); err != nil {
+			return nil, err
+		}
+		node, err = saluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*StockAdjustmentLineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = saluo.check(); err != nil {
+				return nil, err
+			}
+			saluo.mutation = mutation
+			node, err = saluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(saluo.hooks) - 1; i >= 0; i-- {
+			if saluo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = saluo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, saluo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*StockAdjustmentLine)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from StockAdjustmentLineMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (saluo *StockAdjustmentLineUpdateOne) SaveX(ctx context.Context) *StockAdjustmentLine {
+	node, err := saluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (saluo *StockAdjustmentLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := saluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (saluo *StockAdjustmentLineUpdateOne) ExecX(ctx context.Context) {
+	if err := saluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (saluo *StockAdjustmentLineUpdateOne) check() error {
+	if v, ok := saluo.mutation.UnitCost(); ok {
+		if err := stockadjustmentline.UnitCostValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_cost", err: fmt.Errorf(`ent: validator failed for field "StockAdjustmentLine.unit_cost": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (saluo *StockAdjustmentLineUpdateOne) sqlSave(ctx context.Context) (_node *StockAdjustmentLine, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   stockadjustmentline.Table,
+			Columns: stockadjustmentline.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: stockadjustmentline.FieldID,
+			},
+		},
+	}
+	id, ok := saluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StockAdjustmentLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := saluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stockadjustmentline.FieldID)
+		for _, f := range fields {
+			if !stockadjustmentline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != stockadjustmentline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := saluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := saluo.mutation.AdjustmentID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustmentline.FieldAdjustmentID,
+		})
+	}
+	if value, ok := saluo.mutation.AddedAdjustmentID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustmentline.FieldAdjustmentID,
+		})
+	}
+	if value, ok := saluo.mutation.ProductID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustmentline.FieldProductID,
+		})
+	}
+	if value, ok := saluo.mutation.AddedProductID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustmentline.FieldProductID,
+		})
+	}
+	if value, ok := saluo.mutation.LotID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustmentline.FieldLotID,
+		})
+	}
+	if value, ok := saluo.mutation.AddedLotID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: stockadjustmentline.FieldLotID,
+		})
+	}
+	if saluo.mutation.LotIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: stockadjustmentline.FieldLotID,
+		})
+	}
+	if value, ok := saluo.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: stockadjustmentline.FieldQuantity,
+		})
+	}
+	if value, ok := saluo.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: stockadjustmentline.FieldQuantity,
+		})
+	}
+	if value, ok := saluo.mutation.UnitCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: stockadjustmentline.FieldUnitCost,
+		})
+	}
+	if value, ok := saluo.mutation.AddedUnitCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: stockadjustmentline.FieldUnitCost,
+		})
+	}
+	if value, ok := saluo.mutation.TotalCost(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: stockadjustmentline.FieldTotalCost,
+		})
+	}
+	if value, ok := saluo.mutation.AddedTotalCost(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: stockadjustmentline.FieldTotalCost,
+		})
+	}
+	_node = &StockAdjustmentLine{config: saluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, saluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockadjustmentline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
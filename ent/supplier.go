@@ -26,6 +26,12 @@ type Supplier struct {
 	Address string `json:"address,omitempty"`
 	// Número de identificación fiscal (RUC/NIT)
 	RucNit string `json:"ruc_nit,omitempty"`
+	// Tiempo de entrega del proveedor en días
+	LeadTimeDays int `json:"lead_time_days,omitempty"`
+	// Eliminado de forma lógica: no aparece en listados ni búsquedas pero sigue resolviéndose desde los documentos
+	Archived bool `json:"archived,omitempty"`
+	// Fecha de eliminación lógica
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// ID del tenant al que pertenece
 	TenantID int `json:"tenant_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -60,11 +66,13 @@ func (*Supplier) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case supplier.FieldID, supplier.FieldTenantID:
+		case supplier.FieldArchived:
+			values[i] = new(sql.NullBool)
+		case supplier.FieldID, supplier.FieldLeadTimeDays, supplier.FieldTenantID:
 			values[i] = new(sql.NullInt64)
 		case supplier.FieldName, supplier.FieldEmail, supplier.FieldPhone, supplier.FieldAddress, supplier.FieldRucNit:
 			values[i] = new(sql.NullString)
-		case supplier.FieldCreatedAt, supplier.FieldUpdatedAt:
+		case supplier.FieldDeletedAt, supplier.FieldCreatedAt, supplier.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Supplier", columns[i])
@@ -117,6 +125,25 @@ func (s *Supplier) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				s.RucNit = value.String
 			}
+		case supplier.FieldLeadTimeDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lead_time_days", values[i])
+			} else if value.Valid {
+				s.LeadTimeDays = int(value.Int64)
+			}
+		case supplier.FieldArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field archived", values[i])
+			} else if value.Valid {
+				s.Archived = value.Bool
+			}
+		case supplier.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				s.DeletedAt = new(time.Time)
+				*s.DeletedAt = value.Time
+			}
 		case supplier.FieldTenantID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
@@ -183,6 +210,17 @@ func (s *Supplier) String() string {
 	builder.WriteString("ruc_nit=")
 	builder.WriteString(s.RucNit)
 	builder.WriteString(", ")
+	builder.WriteString("lead_time_days=")
+	builder.WriteString(fmt.Sprintf("%v", s.LeadTimeDays))
+	builder.WriteString(", ")
+	builder.WriteString("archived=")
+	builder.WriteString(fmt.Sprintf("%v", s.Archived))
+	builder.WriteString(", ")
+	if v := s.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("tenant_id=")
 	builder.WriteString(fmt.Sprintf("%v", s.TenantID))
 	builder.WriteString(", ")
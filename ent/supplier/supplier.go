@@ -21,6 +21,12 @@ const (
 	FieldAddress = "address"
 	// FieldRucNit holds the string denoting the ruc_nit field in the database.
 	FieldRucNit = "ruc_nit"
+	// FieldLeadTimeDays holds the string denoting the lead_time_days field in the database.
+	FieldLeadTimeDays = "lead_time_days"
+	// FieldArchived holds the string denoting the archived field in the database.
+	FieldArchived = "archived"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldTenantID holds the string denoting the tenant_id field in the database.
 	FieldTenantID = "tenant_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -48,6 +54,9 @@ var Columns = []string{
 	FieldPhone,
 	FieldAddress,
 	FieldRucNit,
+	FieldLeadTimeDays,
+	FieldArchived,
+	FieldDeletedAt,
 	FieldTenantID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -66,6 +75,12 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultLeadTimeDays holds the default value on creation for the "lead_time_days" field.
+	DefaultLeadTimeDays int
+	// LeadTimeDaysValidator is a validator for the "lead_time_days" field. It is called by the builders before save.
+	LeadTimeDaysValidator func(int) error
+	// DefaultArchived holds the default value on creation for the "archived" field.
+	DefaultArchived bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
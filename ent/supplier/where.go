@@ -116,6 +116,27 @@ func RucNit(v string) predicate.Supplier {
 	})
 }
 
+// LeadTimeDays applies equality check predicate on the "lead_time_days" field. It's identical to LeadTimeDaysEQ.
+func LeadTimeDays(v int) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLeadTimeDays), v))
+	})
+}
+
+// Archived applies equality check predicate on the "archived" field. It's identical to ArchivedEQ.
+func Archived(v bool) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldArchived), v))
+	})
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
+	})
+}
+
 // TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
 func TenantID(v int) predicate.Supplier {
 	return predicate.Supplier(func(s *sql.Selector) {
@@ -748,6 +769,186 @@ func RucNitContainsFold(v string) predicate.Supplier {
 	})
 }
 
+// LeadTimeDaysEQ applies the EQ predicate on the "lead_time_days" field.
+func LeadTimeDaysEQ(v int) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLeadTimeDays), v))
+	})
+}
+
+// LeadTimeDaysNEQ applies the NEQ predicate on the "lead_time_days" field.
+func LeadTimeDaysNEQ(v int) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLeadTimeDays), v))
+	})
+}
+
+// LeadTimeDaysIn applies the In predicate on the "lead_time_days" field.
+func LeadTimeDaysIn(vs ...int) predicate.Supplier {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Supplier(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLeadTimeDays), v...))
+	})
+}
+
+// LeadTimeDaysNotIn applies the NotIn predicate on the "lead_time_days" field.
+func LeadTimeDaysNotIn(vs ...int) predicate.Supplier {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Supplier(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLeadTimeDays), v...))
+	})
+}
+
+// LeadTimeDaysGT applies the GT predicate on the "lead_time_days" field.
+func LeadTimeDaysGT(v int) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLeadTimeDays), v))
+	})
+}
+
+// LeadTimeDaysGTE applies the GTE predicate on the "lead_time_days" field.
+func LeadTimeDaysGTE(v int) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLeadTimeDays), v))
+	})
+}
+
+// LeadTimeDaysLT applies the LT predicate on the "lead_time_days" field.
+func LeadTimeDaysLT(v int) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLeadTimeDays), v))
+	})
+}
+
+// LeadTimeDaysLTE applies the LTE predicate on the "lead_time_days" field.
+func LeadTimeDaysLTE(v int) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLeadTimeDays), v))
+	})
+}
+
+// ArchivedEQ applies the EQ predicate on the "archived" field.
+func ArchivedEQ(v bool) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldArchived), v))
+	})
+}
+
+// ArchivedNEQ applies the NEQ predicate on the "archived" field.
+func ArchivedNEQ(v bool) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldArchived), v))
+	})
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Supplier {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Supplier(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Supplier {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Supplier(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDeletedAt)))
+	})
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Supplier {
+	return predicate.Supplier(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDeletedAt)))
+	})
+}
+
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
 func TenantIDEQ(v int) predicate.Supplier {
 	return predicate.Supplier(func(s *sql.Selector) {
@@ -83,6 +83,48 @@ func (sc *SupplierCreate) SetNillableRucNit(s *string) *SupplierCreate {
 	return sc
 }
 
+// SetLeadTimeDays sets the "lead_time_days" field.
+func (sc *SupplierCreate) SetLeadTimeDays(i int) *SupplierCreate {
+	sc.mutation.SetLeadTimeDays(i)
+	return sc
+}
+
+// SetNillableLeadTimeDays sets the "lead_time_days" field if the given value is not nil.
+func (sc *SupplierCreate) SetNillableLeadTimeDays(i *int) *SupplierCreate {
+	if i != nil {
+		sc.SetLeadTimeDays(*i)
+	}
+	return sc
+}
+
+// SetArchived sets the "archived" field.
+func (sc *SupplierCreate) SetArchived(b bool) *SupplierCreate {
+	sc.mutation.SetArchived(b)
+	return sc
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (sc *SupplierCreate) SetNillableArchived(b *bool) *SupplierCreate {
+	if b != nil {
+		sc.SetArchived(*b)
+	}
+	return sc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sc *SupplierCreate) SetDeletedAt(t time.Time) *SupplierCreate {
+	sc.mutation.SetDeletedAt(t)
+	return sc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sc *SupplierCreate) SetNillableDeletedAt(t *time.Time) *SupplierCreate {
+	if t != nil {
+		sc.SetDeletedAt(*t)
+	}
+	return sc
+}
+
 // SetTenantID sets the "tenant_id" field.
 func (sc *SupplierCreate) SetTenantID(i int) *SupplierCreate {
 	sc.mutation.SetTenantID(i)
@@ -209,6 +251,14 @@ func (sc *SupplierCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (sc *SupplierCreate) defaults() {
+	if _, ok := sc.mutation.LeadTimeDays(); !ok {
+		v := supplier.DefaultLeadTimeDays
+		sc.mutation.SetLeadTimeDays(v)
+	}
+	if _, ok := sc.mutation.Archived(); !ok {
+		v := supplier.DefaultArchived
+		sc.mutation.SetArchived(v)
+	}
 	if _, ok := sc.mutation.CreatedAt(); !ok {
 		v := supplier.DefaultCreatedAt()
 		sc.mutation.SetCreatedAt(v)
@@ -229,6 +279,17 @@ func (sc *SupplierCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Supplier.name": %w`, err)}
 		}
 	}
+	if _, ok := sc.mutation.LeadTimeDays(); !ok {
+		return &ValidationError{Name: "lead_time_days", err: errors.New(`ent: missing required field "Supplier.lead_time_days"`)}
+	}
+	if v, ok := sc.mutation.LeadTimeDays(); ok {
+		if err := supplier.LeadTimeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "lead_time_days", err: fmt.Errorf(`ent: validator failed for field "Supplier.lead_time_days": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Archived(); !ok {
+		return &ValidationError{Name: "archived", err: errors.New(`ent: missing required field "Supplier.archived"`)}
+	}
 	if _, ok := sc.mutation.TenantID(); !ok {
 		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Supplier.tenant_id"`)}
 	}
@@ -305,6 +366,30 @@ func (sc *SupplierCreate) createSpec() (*Supplier, *sqlgraph.CreateSpec) {
 		})
 		_node.RucNit = value
 	}
+	if value, ok := sc.mutation.LeadTimeDays(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: supplier.FieldLeadTimeDays,
+		})
+		_node.LeadTimeDays = value
+	}
+	if value, ok := sc.mutation.Archived(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: supplier.FieldArchived,
+		})
+		_node.Archived = value
+	}
+	if value, ok := sc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: supplier.FieldDeletedAt,
+		})
+		_node.DeletedAt = &value
+	}
 	if value, ok := sc.mutation.TenantID(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -27,6 +28,7 @@ type SupplierQuery struct {
 	predicates []predicate.Supplier
 	// eager-loading edges.
 	withPurchaseInvoices *PurchaseInvoiceQuery
+	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -299,7 +301,6 @@ func (sq *SupplierQuery) WithPurchaseInvoices(opts ...func(*PurchaseInvoiceQuery
 //		GroupBy(supplier.FieldName).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (sq *SupplierQuery) GroupBy(field string, fields ...string) *SupplierGroupBy {
 	grbuild := &SupplierGroupBy{config: sq.config}
 	grbuild.fields = append([]string{field}, fields...)
@@ -326,7 +327,6 @@ func (sq *SupplierQuery) GroupBy(field string, fields ...string) *SupplierGroupB
 //	client.Supplier.Query().
 //		Select(supplier.FieldName).
 //		Scan(ctx, &v)
-//
 func (sq *SupplierQuery) Select(fields ...string) *SupplierSelect {
 	sq.fields = append(sq.fields, fields...)
 	selbuild := &SupplierSelect{SupplierQuery: sq}
@@ -368,6 +368,9 @@ func (sq *SupplierQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sup
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(sq.modifiers) > 0 {
+		_spec.Modifiers = sq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -408,6 +411,9 @@ func (sq *SupplierQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sup
 
 func (sq *SupplierQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
+	if len(sq.modifiers) > 0 {
+		_spec.Modifiers = sq.modifiers
+	}
 	_spec.Node.Columns = sq.fields
 	if len(sq.fields) > 0 {
 		_spec.Unique = sq.unique != nil && *sq.unique
@@ -486,6 +492,9 @@ func (sq *SupplierQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if sq.unique != nil && *sq.unique {
 		selector.Distinct()
 	}
+	for _, m := range sq.modifiers {
+		m(selector)
+	}
 	for _, p := range sq.predicates {
 		p(selector)
 	}
@@ -503,6 +512,32 @@ func (sq *SupplierQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (sq *SupplierQuery) ForUpdate(opts ...sql.LockOption) *SupplierQuery {
+	if sq.driver.Dialect() == dialect.Postgres {
+		sq.Unique(false)
+	}
+	sq.modifiers = append(sq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return sq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (sq *SupplierQuery) ForShare(opts ...sql.LockOption) *SupplierQuery {
+	if sq.driver.Dialect() == dialect.Postgres {
+		sq.Unique(false)
+	}
+	sq.modifiers = append(sq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return sq
+}
+
 // SupplierGroupBy is the group-by builder for Supplier entities.
 type SupplierGroupBy struct {
 	config
@@ -115,6 +115,61 @@ func (su *SupplierUpdate) ClearRucNit() *SupplierUpdate {
 	return su
 }
 
+// SetLeadTimeDays sets the "lead_time_days" field.
+func (su *SupplierUpdate) SetLeadTimeDays(i int) *SupplierUpdate {
+	su.mutation.ResetLeadTimeDays()
+	su.mutation.SetLeadTimeDays(i)
+	return su
+}
+
+// SetNillableLeadTimeDays sets the "lead_time_days" field if the given value is not nil.
+func (su *SupplierUpdate) SetNillableLeadTimeDays(i *int) *SupplierUpdate {
+	if i != nil {
+		su.SetLeadTimeDays(*i)
+	}
+	return su
+}
+
+// AddLeadTimeDays adds i to the "lead_time_days" field.
+func (su *SupplierUpdate) AddLeadTimeDays(i int) *SupplierUpdate {
+	su.mutation.AddLeadTimeDays(i)
+	return su
+}
+
+// SetArchived sets the "archived" field.
+func (su *SupplierUpdate) SetArchived(b bool) *SupplierUpdate {
+	su.mutation.SetArchived(b)
+	return su
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (su *SupplierUpdate) SetNillableArchived(b *bool) *SupplierUpdate {
+	if b != nil {
+		su.SetArchived(*b)
+	}
+	return su
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (su *SupplierUpdate) SetDeletedAt(t time.Time) *SupplierUpdate {
+	su.mutation.SetDeletedAt(t)
+	return su
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (su *SupplierUpdate) SetNillableDeletedAt(t *time.Time) *SupplierUpdate {
+	if t != nil {
+		su.SetDeletedAt(*t)
+	}
+	return su
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (su *SupplierUpdate) ClearDeletedAt() *SupplierUpdate {
+	su.mutation.ClearDeletedAt()
+	return su
+}
+
 // SetTenantID sets the "tenant_id" field.
 func (su *SupplierUpdate) SetTenantID(i int) *SupplierUpdate {
 	su.mutation.ResetTenantID()
@@ -251,6 +306,11 @@ func (su *SupplierUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Supplier.name": %w`, err)}
 		}
 	}
+	if v, ok := su.mutation.LeadTimeDays(); ok {
+		if err := supplier.LeadTimeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "lead_time_days", err: fmt.Errorf(`ent: validator failed for field "Supplier.lead_time_days": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -331,6 +391,40 @@ func (su *SupplierUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: supplier.FieldRucNit,
 		})
 	}
+	if value, ok := su.mutation.LeadTimeDays(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: supplier.FieldLeadTimeDays,
+		})
+	}
+	if value, ok := su.mutation.AddedLeadTimeDays(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: supplier.FieldLeadTimeDays,
+		})
+	}
+	if value, ok := su.mutation.Archived(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: supplier.FieldArchived,
+		})
+	}
+	if value, ok := su.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: supplier.FieldDeletedAt,
+		})
+	}
+	if su.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: supplier.FieldDeletedAt,
+		})
+	}
 	if value, ok := su.mutation.TenantID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -511,6 +605,61 @@ func (suo *SupplierUpdateOne) ClearRucNit() *SupplierUpdateOne {
 	return suo
 }
 
+// SetLeadTimeDays sets the "lead_time_days" field.
+func (suo *SupplierUpdateOne) SetLeadTimeDays(i int) *SupplierUpdateOne {
+	suo.mutation.ResetLeadTimeDays()
+	suo.mutation.SetLeadTimeDays(i)
+	return suo
+}
+
+// SetNillableLeadTimeDays sets the "lead_time_days" field if the given value is not nil.
+func (suo *SupplierUpdateOne) SetNillableLeadTimeDays(i *int) *SupplierUpdateOne {
+	if i != nil {
+		suo.SetLeadTimeDays(*i)
+	}
+	return suo
+}
+
+// AddLeadTimeDays adds i to the "lead_time_days" field.
+func (suo *SupplierUpdateOne) AddLeadTimeDays(i int) *SupplierUpdateOne {
+	suo.mutation.AddLeadTimeDays(i)
+	return suo
+}
+
+// SetArchived sets the "archived" field.
+func (suo *SupplierUpdateOne) SetArchived(b bool) *SupplierUpdateOne {
+	suo.mutation.SetArchived(b)
+	return suo
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (suo *SupplierUpdateOne) SetNillableArchived(b *bool) *SupplierUpdateOne {
+	if b != nil {
+		suo.SetArchived(*b)
+	}
+	return suo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (suo *SupplierUpdateOne) SetDeletedAt(t time.Time) *SupplierUpdateOne {
+	suo.mutation.SetDeletedAt(t)
+	return suo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (suo *SupplierUpdateOne) SetNillableDeletedAt(t *time.Time) *SupplierUpdateOne {
+	if t != nil {
+		suo.SetDeletedAt(*t)
+	}
+	return suo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (suo *SupplierUpdateOne) ClearDeletedAt() *SupplierUpdateOne {
+	suo.mutation.ClearDeletedAt()
+	return suo
+}
+
 // SetTenantID sets the "tenant_id" field.
 func (suo *SupplierUpdateOne) SetTenantID(i int) *SupplierUpdateOne {
 	suo.mutation.ResetTenantID()
@@ -660,6 +809,11 @@ func (suo *SupplierUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Supplier.name": %w`, err)}
 		}
 	}
+	if v, ok := suo.mutation.LeadTimeDays(); ok {
+		if err := supplier.LeadTimeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "lead_time_days", err: fmt.Errorf(`ent: validator failed for field "Supplier.lead_time_days": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -757,6 +911,40 @@ func (suo *SupplierUpdateOne) sqlSave(ctx context.Context) (_node *Supplier, err
 			Column: supplier.FieldRucNit,
 		})
 	}
+	if value, ok := suo.mutation.LeadTimeDays(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: supplier.FieldLeadTimeDays,
+		})
+	}
+	if value, ok := suo.mutation.AddedLeadTimeDays(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: supplier.FieldLeadTimeDays,
+		})
+	}
+	if value, ok := suo.mutation.Archived(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: supplier.FieldArchived,
+		})
+	}
+	if value, ok := suo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: supplier.FieldDeletedAt,
+		})
+	}
+	if suo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: supplier.FieldDeletedAt,
+		})
+	}
 	if value, ok := suo.mutation.TenantID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
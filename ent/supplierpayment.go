@@ -4,6 +4,7 @@ package ent
 
 import (
 	"Veritasbackend/ent/supplierpayment"
+	"Veritasbackend/pkg/money"
 	"fmt"
 	"strings"
 	"time"
@@ -20,8 +21,16 @@ type SupplierPayment struct {
 	PurchaseInvoiceID int `json:"purchase_invoice_id,omitempty"`
 	// ID del proveedor
 	SupplierID int `json:"supplier_id,omitempty"`
-	// Monto del pago
-	Amount float64 `json:"amount,omitempty"`
+	// Monto del pago en la moneda de la factura
+	Amount money.Amount `json:"amount,omitempty"`
+	// Moneda del pago, la de la factura de compra
+	Currency string `json:"currency,omitempty"`
+	// Tasa de cambio del día del pago
+	ExchangeRate float64 `json:"exchange_rate,omitempty"`
+	// Monto del pago en la moneda base
+	BaseAmount money.Amount `json:"base_amount,omitempty"`
+	// Diferencia en cambio realizada con el pago, en la moneda base (positiva = ganancia)
+	FxGainLoss money.Amount `json:"fx_gain_loss,omitempty"`
 	// Fecha del pago
 	PaymentDate time.Time `json:"payment_date,omitempty"`
 	// Método de pago (cash, transfer, check, credit_card)
@@ -45,11 +54,13 @@ func (*SupplierPayment) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case supplierpayment.FieldAmount:
+		case supplierpayment.FieldAmount, supplierpayment.FieldBaseAmount, supplierpayment.FieldFxGainLoss:
+			values[i] = new(money.Amount)
+		case supplierpayment.FieldExchangeRate:
 			values[i] = new(sql.NullFloat64)
 		case supplierpayment.FieldID, supplierpayment.FieldPurchaseInvoiceID, supplierpayment.FieldSupplierID, supplierpayment.FieldTenantID, supplierpayment.FieldUserID:
 			values[i] = new(sql.NullInt64)
-		case supplierpayment.FieldPaymentMethod, supplierpayment.FieldReference, supplierpayment.FieldNotes:
+		case supplierpayment.FieldCurrency, supplierpayment.FieldPaymentMethod, supplierpayment.FieldReference, supplierpayment.FieldNotes:
 			values[i] = new(sql.NullString)
 		case supplierpayment.FieldPaymentDate, supplierpayment.FieldCreatedAt, supplierpayment.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -87,10 +98,34 @@ func (sp *SupplierPayment) assignValues(columns []string, values []interface{})
 				sp.SupplierID = int(value.Int64)
 			}
 		case supplierpayment.FieldAmount:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				sp.Amount = *value
+			}
+		case supplierpayment.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				sp.Currency = value.String
+			}
+		case supplierpayment.FieldExchangeRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate", values[i])
 			} else if value.Valid {
-				sp.Amount = value.Float64
+				sp.ExchangeRate = value.Float64
+			}
+		case supplierpayment.FieldBaseAmount:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field base_amount", values[i])
+			} else if value != nil {
+				sp.BaseAmount = *value
+			}
+		case supplierpayment.FieldFxGainLoss:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field fx_gain_loss", values[i])
+			} else if value != nil {
+				sp.FxGainLoss = *value
 			}
 		case supplierpayment.FieldPaymentDate:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -177,6 +212,18 @@ func (sp *SupplierPayment) String() string {
 	builder.WriteString("amount=")
 	builder.WriteString(fmt.Sprintf("%v", sp.Amount))
 	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(sp.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("exchange_rate=")
+	builder.WriteString(fmt.Sprintf("%v", sp.ExchangeRate))
+	builder.WriteString(", ")
+	builder.WriteString("base_amount=")
+	builder.WriteString(fmt.Sprintf("%v", sp.BaseAmount))
+	builder.WriteString(", ")
+	builder.WriteString("fx_gain_loss=")
+	builder.WriteString(fmt.Sprintf("%v", sp.FxGainLoss))
+	builder.WriteString(", ")
 	builder.WriteString("payment_date=")
 	builder.WriteString(sp.PaymentDate.Format(time.ANSIC))
 	builder.WriteString(", ")
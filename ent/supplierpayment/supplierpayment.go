@@ -3,6 +3,7 @@
 package supplierpayment
 
 import (
+	"Veritasbackend/pkg/money"
 	"time"
 )
 
@@ -17,6 +18,14 @@ const (
 	FieldSupplierID = "supplier_id"
 	// FieldAmount holds the string denoting the amount field in the database.
 	FieldAmount = "amount"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldExchangeRate holds the string denoting the exchange_rate field in the database.
+	FieldExchangeRate = "exchange_rate"
+	// FieldBaseAmount holds the string denoting the base_amount field in the database.
+	FieldBaseAmount = "base_amount"
+	// FieldFxGainLoss holds the string denoting the fx_gain_loss field in the database.
+	FieldFxGainLoss = "fx_gain_loss"
 	// FieldPaymentDate holds the string denoting the payment_date field in the database.
 	FieldPaymentDate = "payment_date"
 	// FieldPaymentMethod holds the string denoting the payment_method field in the database.
@@ -43,6 +52,10 @@ var Columns = []string{
 	FieldPurchaseInvoiceID,
 	FieldSupplierID,
 	FieldAmount,
+	FieldCurrency,
+	FieldExchangeRate,
+	FieldBaseAmount,
+	FieldFxGainLoss,
 	FieldPaymentDate,
 	FieldPaymentMethod,
 	FieldReference,
@@ -65,7 +78,17 @@ func ValidColumn(column string) bool {
 
 var (
 	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
-	AmountValidator func(float64) error
+	AmountValidator func(int64) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultExchangeRate holds the default value on creation for the "exchange_rate" field.
+	DefaultExchangeRate float64
+	// ExchangeRateValidator is a validator for the "exchange_rate" field. It is called by the builders before save.
+	ExchangeRateValidator func(float64) error
+	// DefaultBaseAmount holds the default value on creation for the "base_amount" field.
+	DefaultBaseAmount money.Amount
+	// DefaultFxGainLoss holds the default value on creation for the "fx_gain_loss" field.
+	DefaultFxGainLoss money.Amount
 	// DefaultPaymentDate holds the default value on creation for the "payment_date" field.
 	DefaultPaymentDate func() time.Time
 	// PaymentMethodValidator is a validator for the "payment_method" field. It is called by the builders before save.
@@ -4,6 +4,7 @@ package supplierpayment
 
 import (
 	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -95,12 +96,40 @@ func SupplierID(v int) predicate.SupplierPayment {
 }
 
 // Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
-func Amount(v float64) predicate.SupplierPayment {
+func Amount(v money.Amount) predicate.SupplierPayment {
 	return predicate.SupplierPayment(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldAmount), v))
 	})
 }
 
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// ExchangeRate applies equality check predicate on the "exchange_rate" field. It's identical to ExchangeRateEQ.
+func ExchangeRate(v float64) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExchangeRate), v))
+	})
+}
+
+// BaseAmount applies equality check predicate on the "base_amount" field. It's identical to BaseAmountEQ.
+func BaseAmount(v money.Amount) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseAmount), v))
+	})
+}
+
+// FxGainLoss applies equality check predicate on the "fx_gain_loss" field. It's identical to FxGainLossEQ.
+func FxGainLoss(v money.Amount) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFxGainLoss), v))
+	})
+}
+
 // PaymentDate applies equality check predicate on the "payment_date" field. It's identical to PaymentDateEQ.
 func PaymentDate(v time.Time) predicate.SupplierPayment {
 	return predicate.SupplierPayment(func(s *sql.Selector) {
@@ -310,21 +339,21 @@ func SupplierIDLTE(v int) predicate.SupplierPayment {
 }
 
 // AmountEQ applies the EQ predicate on the "amount" field.
-func AmountEQ(v float64) predicate.SupplierPayment {
+func AmountEQ(v money.Amount) predicate.SupplierPayment {
 	return predicate.SupplierPayment(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldAmount), v))
 	})
 }
 
 // AmountNEQ applies the NEQ predicate on the "amount" field.
-func AmountNEQ(v float64) predicate.SupplierPayment {
+func AmountNEQ(v money.Amount) predicate.SupplierPayment {
 	return predicate.SupplierPayment(func(s *sql.Selector) {
 		s.Where(sql.NEQ(s.C(FieldAmount), v))
 	})
 }
 
 // AmountIn applies the In predicate on the "amount" field.
-func AmountIn(vs ...float64) predicate.SupplierPayment {
+func AmountIn(vs ...money.Amount) predicate.SupplierPayment {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -341,7 +370,7 @@ func AmountIn(vs ...float64) predicate.SupplierPayment {
 }
 
 // AmountNotIn applies the NotIn predicate on the "amount" field.
-func AmountNotIn(vs ...float64) predicate.SupplierPayment {
+func AmountNotIn(vs ...money.Amount) predicate.SupplierPayment {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -358,33 +387,372 @@ func AmountNotIn(vs ...float64) predicate.SupplierPayment {
 }
 
 // AmountGT applies the GT predicate on the "amount" field.
-func AmountGT(v float64) predicate.SupplierPayment {
+func AmountGT(v money.Amount) predicate.SupplierPayment {
 	return predicate.SupplierPayment(func(s *sql.Selector) {
 		s.Where(sql.GT(s.C(FieldAmount), v))
 	})
 }
 
 // AmountGTE applies the GTE predicate on the "amount" field.
-func AmountGTE(v float64) predicate.SupplierPayment {
+func AmountGTE(v money.Amount) predicate.SupplierPayment {
 	return predicate.SupplierPayment(func(s *sql.Selector) {
 		s.Where(sql.GTE(s.C(FieldAmount), v))
 	})
 }
 
 // AmountLT applies the LT predicate on the "amount" field.
-func AmountLT(v float64) predicate.SupplierPayment {
+func AmountLT(v money.Amount) predicate.SupplierPayment {
 	return predicate.SupplierPayment(func(s *sql.Selector) {
 		s.Where(sql.LT(s.C(FieldAmount), v))
 	})
 }
 
 // AmountLTE applies the LTE predicate on the "amount" field.
-func AmountLTE(v float64) predicate.SupplierPayment {
+func AmountLTE(v money.Amount) predicate.SupplierPayment {
 	return predicate.SupplierPayment(func(s *sql.Selector) {
 		s.Where(sql.LTE(s.C(FieldAmount), v))
 	})
 }
 
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.SupplierPayment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.SupplierPayment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCurrency), v...))
+	})
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCurrency), v))
+	})
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCurrency), v))
+	})
+}
+
+// ExchangeRateEQ applies the EQ predicate on the "exchange_rate" field.
+func ExchangeRateEQ(v float64) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExchangeRate), v))
+	})
+}
+
+// ExchangeRateNEQ applies the NEQ predicate on the "exchange_rate" field.
+func ExchangeRateNEQ(v float64) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExchangeRate), v))
+	})
+}
+
+// ExchangeRateIn applies the In predicate on the "exchange_rate" field.
+func ExchangeRateIn(vs ...float64) predicate.SupplierPayment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExchangeRate), v...))
+	})
+}
+
+// ExchangeRateNotIn applies the NotIn predicate on the "exchange_rate" field.
+func ExchangeRateNotIn(vs ...float64) predicate.SupplierPayment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExchangeRate), v...))
+	})
+}
+
+// ExchangeRateGT applies the GT predicate on the "exchange_rate" field.
+func ExchangeRateGT(v float64) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExchangeRate), v))
+	})
+}
+
+// ExchangeRateGTE applies the GTE predicate on the "exchange_rate" field.
+func ExchangeRateGTE(v float64) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExchangeRate), v))
+	})
+}
+
+// ExchangeRateLT applies the LT predicate on the "exchange_rate" field.
+func ExchangeRateLT(v float64) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExchangeRate), v))
+	})
+}
+
+// ExchangeRateLTE applies the LTE predicate on the "exchange_rate" field.
+func ExchangeRateLTE(v float64) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExchangeRate), v))
+	})
+}
+
+// BaseAmountEQ applies the EQ predicate on the "base_amount" field.
+func BaseAmountEQ(v money.Amount) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseAmount), v))
+	})
+}
+
+// BaseAmountNEQ applies the NEQ predicate on the "base_amount" field.
+func BaseAmountNEQ(v money.Amount) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBaseAmount), v))
+	})
+}
+
+// BaseAmountIn applies the In predicate on the "base_amount" field.
+func BaseAmountIn(vs ...money.Amount) predicate.SupplierPayment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBaseAmount), v...))
+	})
+}
+
+// BaseAmountNotIn applies the NotIn predicate on the "base_amount" field.
+func BaseAmountNotIn(vs ...money.Amount) predicate.SupplierPayment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBaseAmount), v...))
+	})
+}
+
+// BaseAmountGT applies the GT predicate on the "base_amount" field.
+func BaseAmountGT(v money.Amount) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBaseAmount), v))
+	})
+}
+
+// BaseAmountGTE applies the GTE predicate on the "base_amount" field.
+func BaseAmountGTE(v money.Amount) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBaseAmount), v))
+	})
+}
+
+// BaseAmountLT applies the LT predicate on the "base_amount" field.
+func BaseAmountLT(v money.Amount) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBaseAmount), v))
+	})
+}
+
+// BaseAmountLTE applies the LTE predicate on the "base_amount" field.
+func BaseAmountLTE(v money.Amount) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBaseAmount), v))
+	})
+}
+
+// FxGainLossEQ applies the EQ predicate on the "fx_gain_loss" field.
+func FxGainLossEQ(v money.Amount) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFxGainLoss), v))
+	})
+}
+
+// FxGainLossNEQ applies the NEQ predicate on the "fx_gain_loss" field.
+func FxGainLossNEQ(v money.Amount) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFxGainLoss), v))
+	})
+}
+
+// FxGainLossIn applies the In predicate on the "fx_gain_loss" field.
+func FxGainLossIn(vs ...money.Amount) predicate.SupplierPayment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldFxGainLoss), v...))
+	})
+}
+
+// FxGainLossNotIn applies the NotIn predicate on the "fx_gain_loss" field.
+func FxGainLossNotIn(vs ...money.Amount) predicate.SupplierPayment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldFxGainLoss), v...))
+	})
+}
+
+// FxGainLossGT applies the GT predicate on the "fx_gain_loss" field.
+func FxGainLossGT(v money.Amount) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldFxGainLoss), v))
+	})
+}
+
+// FxGainLossGTE applies the GTE predicate on the "fx_gain_loss" field.
+func FxGainLossGTE(v money.Amount) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldFxGainLoss), v))
+	})
+}
+
+// FxGainLossLT applies the LT predicate on the "fx_gain_loss" field.
+func FxGainLossLT(v money.Amount) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldFxGainLoss), v))
+	})
+}
+
+// FxGainLossLTE applies the LTE predicate on the "fx_gain_loss" field.
+func FxGainLossLTE(v money.Amount) predicate.SupplierPayment {
+	return predicate.SupplierPayment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldFxGainLoss), v))
+	})
+}
+
 // PaymentDateEQ applies the EQ predicate on the "payment_date" field.
 func PaymentDateEQ(v time.Time) predicate.SupplierPayment {
 	return predicate.SupplierPayment(func(s *sql.Selector) {
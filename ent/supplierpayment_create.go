@@ -4,6 +4,7 @@ package ent
 
 import (
 	"Veritasbackend/ent/supplierpayment"
+	"Veritasbackend/pkg/money"
 	"context"
 	"errors"
 	"fmt"
@@ -33,8 +34,64 @@ func (spc *SupplierPaymentCreate) SetSupplierID(i int) *SupplierPaymentCreate {
 }
 
 // SetAmount sets the "amount" field.
-func (spc *SupplierPaymentCreate) SetAmount(f float64) *SupplierPaymentCreate {
-	spc.mutation.SetAmount(f)
+func (spc *SupplierPaymentCreate) SetAmount(m money.Amount) *SupplierPaymentCreate {
+	spc.mutation.SetAmount(m)
+	return spc
+}
+
+// SetCurrency sets the "currency" field.
+func (spc *SupplierPaymentCreate) SetCurrency(s string) *SupplierPaymentCreate {
+	spc.mutation.SetCurrency(s)
+	return spc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (spc *SupplierPaymentCreate) SetNillableCurrency(s *string) *SupplierPaymentCreate {
+	if s != nil {
+		spc.SetCurrency(*s)
+	}
+	return spc
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (spc *SupplierPaymentCreate) SetExchangeRate(f float64) *SupplierPaymentCreate {
+	spc.mutation.SetExchangeRate(f)
+	return spc
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (spc *SupplierPaymentCreate) SetNillableExchangeRate(f *float64) *SupplierPaymentCreate {
+	if f != nil {
+		spc.SetExchangeRate(*f)
+	}
+	return spc
+}
+
+// SetBaseAmount sets the "base_amount" field.
+func (spc *SupplierPaymentCreate) SetBaseAmount(m money.Amount) *SupplierPaymentCreate {
+	spc.mutation.SetBaseAmount(m)
+	return spc
+}
+
+// SetNillableBaseAmount sets the "base_amount" field if the given value is not nil.
+func (spc *SupplierPaymentCreate) SetNillableBaseAmount(m *money.Amount) *SupplierPaymentCreate {
+	if m != nil {
+		spc.SetBaseAmount(*m)
+	}
+	return spc
+}
+
+// SetFxGainLoss sets the "fx_gain_loss" field.
+func (spc *SupplierPaymentCreate) SetFxGainLoss(m money.Amount) *SupplierPaymentCreate {
+	spc.mutation.SetFxGainLoss(m)
+	return spc
+}
+
+// SetNillableFxGainLoss sets the "fx_gain_loss" field if the given value is not nil.
+func (spc *SupplierPaymentCreate) SetNillableFxGainLoss(m *money.Amount) *SupplierPaymentCreate {
+	if m != nil {
+		spc.SetFxGainLoss(*m)
+	}
 	return spc
 }
 
@@ -203,6 +260,22 @@ func (spc *SupplierPaymentCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (spc *SupplierPaymentCreate) defaults() {
+	if _, ok := spc.mutation.Currency(); !ok {
+		v := supplierpayment.DefaultCurrency
+		spc.mutation.SetCurrency(v)
+	}
+	if _, ok := spc.mutation.ExchangeRate(); !ok {
+		v := supplierpayment.DefaultExchangeRate
+		spc.mutation.SetExchangeRate(v)
+	}
+	if _, ok := spc.mutation.BaseAmount(); !ok {
+		v := supplierpayment.DefaultBaseAmount
+		spc.mutation.SetBaseAmount(v)
+	}
+	if _, ok := spc.mutation.FxGainLoss(); !ok {
+		v := supplierpayment.DefaultFxGainLoss
+		spc.mutation.SetFxGainLoss(v)
+	}
 	if _, ok := spc.mutation.PaymentDate(); !ok {
 		v := supplierpayment.DefaultPaymentDate()
 		spc.mutation.SetPaymentDate(v)
@@ -229,10 +302,27 @@ func (spc *SupplierPaymentCreate) check() error {
 		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "SupplierPayment.amount"`)}
 	}
 	if v, ok := spc.mutation.Amount(); ok {
-		if err := supplierpayment.AmountValidator(v); err != nil {
+		if err := supplierpayment.AmountValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "SupplierPayment.amount": %w`, err)}
 		}
 	}
+	if _, ok := spc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "SupplierPayment.currency"`)}
+	}
+	if _, ok := spc.mutation.ExchangeRate(); !ok {
+		return &ValidationError{Name: "exchange_rate", err: errors.New(`ent: missing required field "SupplierPayment.exchange_rate"`)}
+	}
+	if v, ok := spc.mutation.ExchangeRate(); ok {
+		if err := supplierpayment.ExchangeRateValidator(v); err != nil {
+			return &ValidationError{Name: "exchange_rate", err: fmt.Errorf(`ent: validator failed for field "SupplierPayment.exchange_rate": %w`, err)}
+		}
+	}
+	if _, ok := spc.mutation.BaseAmount(); !ok {
+		return &ValidationError{Name: "base_amount", err: errors.New(`ent: missing required field "SupplierPayment.base_amount"`)}
+	}
+	if _, ok := spc.mutation.FxGainLoss(); !ok {
+		return &ValidationError{Name: "fx_gain_loss", err: errors.New(`ent: missing required field "SupplierPayment.fx_gain_loss"`)}
+	}
 	if _, ok := spc.mutation.PaymentDate(); !ok {
 		return &ValidationError{Name: "payment_date", err: errors.New(`ent: missing required field "SupplierPayment.payment_date"`)}
 	}
@@ -301,12 +391,44 @@ func (spc *SupplierPaymentCreate) createSpec() (*SupplierPayment, *sqlgraph.Crea
 	}
 	if value, ok := spc.mutation.Amount(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: supplierpayment.FieldAmount,
 		})
 		_node.Amount = value
 	}
+	if value, ok := spc.mutation.Currency(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: supplierpayment.FieldCurrency,
+		})
+		_node.Currency = value
+	}
+	if value, ok := spc.mutation.ExchangeRate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: supplierpayment.FieldExchangeRate,
+		})
+		_node.ExchangeRate = value
+	}
+	if value, ok := spc.mutation.BaseAmount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: supplierpayment.FieldBaseAmount,
+		})
+		_node.BaseAmount = value
+	}
+	if value, ok := spc.mutation.FxGainLoss(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: supplierpayment.FieldFxGainLoss,
+		})
+		_node.FxGainLoss = value
+	}
 	if value, ok := spc.mutation.PaymentDate(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
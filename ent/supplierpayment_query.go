@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -23,6 +24,7 @@ type SupplierPaymentQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.SupplierPayment
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -261,7 +263,6 @@ func (spq *SupplierPaymentQuery) Clone() *SupplierPaymentQuery {
 //		GroupBy(supplierpayment.FieldPurchaseInvoiceID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (spq *SupplierPaymentQuery) GroupBy(field string, fields ...string) *SupplierPaymentGroupBy {
 	grbuild := &SupplierPaymentGroupBy{config: spq.config}
 	grbuild.fields = append([]string{field}, fields...)
@@ -288,7 +289,6 @@ func (spq *SupplierPaymentQuery) GroupBy(field string, fields ...string) *Suppli
 //	client.SupplierPayment.Query().
 //		Select(supplierpayment.FieldPurchaseInvoiceID).
 //		Scan(ctx, &v)
-//
 func (spq *SupplierPaymentQuery) Select(fields ...string) *SupplierPaymentSelect {
 	spq.fields = append(spq.fields, fields...)
 	selbuild := &SupplierPaymentSelect{SupplierPaymentQuery: spq}
@@ -326,6 +326,9 @@ func (spq *SupplierPaymentQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
+	if len(spq.modifiers) > 0 {
+		_spec.Modifiers = spq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -340,6 +343,9 @@ func (spq *SupplierPaymentQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 
 func (spq *SupplierPaymentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := spq.querySpec()
+	if len(spq.modifiers) > 0 {
+		_spec.Modifiers = spq.modifiers
+	}
 	_spec.Node.Columns = spq.fields
 	if len(spq.fields) > 0 {
 		_spec.Unique = spq.unique != nil && *spq.unique
@@ -418,6 +424,9 @@ func (spq *SupplierPaymentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if spq.unique != nil && *spq.unique {
 		selector.Distinct()
 	}
+	for _, m := range spq.modifiers {
+		m(selector)
+	}
 	for _, p := range spq.predicates {
 		p(selector)
 	}
@@ -435,6 +444,32 @@ func (spq *SupplierPaymentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (spq *SupplierPaymentQuery) ForUpdate(opts ...sql.LockOption) *SupplierPaymentQuery {
+	if spq.driver.Dialect() == dialect.Postgres {
+		spq.Unique(false)
+	}
+	spq.modifiers = append(spq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return spq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (spq *SupplierPaymentQuery) ForShare(opts ...sql.LockOption) *SupplierPaymentQuery {
+	if spq.driver.Dialect() == dialect.Postgres {
+		spq.Unique(false)
+	}
+	spq.modifiers = append(spq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return spq
+}
+
 // SupplierPaymentGroupBy is the group-by builder for SupplierPayment entities.
 type SupplierPaymentGroupBy struct {
 	config
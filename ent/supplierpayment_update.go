@@ -5,6 +5,7 @@ package ent
 import (
 	"Veritasbackend/ent/predicate"
 	"Veritasbackend/ent/supplierpayment"
+	"Veritasbackend/pkg/money"
 	"context"
 	"errors"
 	"fmt"
@@ -55,15 +56,92 @@ func (spu *SupplierPaymentUpdate) AddSupplierID(i int) *SupplierPaymentUpdate {
 }
 
 // SetAmount sets the "amount" field.
-func (spu *SupplierPaymentUpdate) SetAmount(f float64) *SupplierPaymentUpdate {
+func (spu *SupplierPaymentUpdate) SetAmount(m money.Amount) *SupplierPaymentUpdate {
 	spu.mutation.ResetAmount()
-	spu.mutation.SetAmount(f)
+	spu.mutation.SetAmount(m)
 	return spu
 }
 
-// AddAmount adds f to the "amount" field.
-func (spu *SupplierPaymentUpdate) AddAmount(f float64) *SupplierPaymentUpdate {
-	spu.mutation.AddAmount(f)
+// AddAmount adds m to the "amount" field.
+func (spu *SupplierPaymentUpdate) AddAmount(m money.Amount) *SupplierPaymentUpdate {
+	spu.mutation.AddAmount(m)
+	return spu
+}
+
+// SetCurrency sets the "currency" field.
+func (spu *SupplierPaymentUpdate) SetCurrency(s string) *SupplierPaymentUpdate {
+	spu.mutation.SetCurrency(s)
+	return spu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (spu *SupplierPaymentUpdate) SetNillableCurrency(s *string) *SupplierPaymentUpdate {
+	if s != nil {
+		spu.SetCurrency(*s)
+	}
+	return spu
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (spu *SupplierPaymentUpdate) SetExchangeRate(f float64) *SupplierPaymentUpdate {
+	spu.mutation.ResetExchangeRate()
+	spu.mutation.SetExchangeRate(f)
+	return spu
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (spu *SupplierPaymentUpdate) SetNillableExchangeRate(f *float64) *SupplierPaymentUpdate {
+	if f != nil {
+		spu.SetExchangeRate(*f)
+	}
+	return spu
+}
+
+// AddExchangeRate adds f to the "exchange_rate" field.
+func (spu *SupplierPaymentUpdate) AddExchangeRate(f float64) *SupplierPaymentUpdate {
+	spu.mutation.AddExchangeRate(f)
+	return spu
+}
+
+// SetBaseAmount sets the "base_amount" field.
+func (spu *SupplierPaymentUpdate) SetBaseAmount(m money.Amount) *SupplierPaymentUpdate {
+	spu.mutation.ResetBaseAmount()
+	spu.mutation.SetBaseAmount(m)
+	return spu
+}
+
+// SetNillableBaseAmount sets the "base_amount" field if the given value is not nil.
+func (spu *SupplierPaymentUpdate) SetNillableBaseAmount(m *money.Amount) *SupplierPaymentUpdate {
+	if m != nil {
+		spu.SetBaseAmount(*m)
+	}
+	return spu
+}
+
+// AddBaseAmount adds m to the "base_amount" field.
+func (spu *SupplierPaymentUpdate) AddBaseAmount(m money.Amount) *SupplierPaymentUpdate {
+	spu.mutation.AddBaseAmount(m)
+	return spu
+}
+
+// SetFxGainLoss sets the "fx_gain_loss" field.
+func (spu *SupplierPaymentUpdate) SetFxGainLoss(m money.Amount) *SupplierPaymentUpdate {
+	spu.mutation.ResetFxGainLoss()
+	spu.mutation.SetFxGainLoss(m)
+	return spu
+}
+
+// SetNillableFxGainLoss sets the "fx_gain_loss" field if the given value is not nil.
+func (spu *SupplierPaymentUpdate) SetNillableFxGainLoss(m *money.Amount) *SupplierPaymentUpdate {
+	if m != nil {
+		spu.SetFxGainLoss(*m)
+	}
+	return spu
+}
+
+// AddFxGainLoss adds m to the "fx_gain_loss" field.
+func (spu *SupplierPaymentUpdate) AddFxGainLoss(m money.Amount) *SupplierPaymentUpdate {
+	spu.mutation.AddFxGainLoss(m)
 	return spu
 }
 
@@ -236,10 +314,15 @@ func (spu *SupplierPaymentUpdate) defaults() {
 // check runs all checks and user-defined validators on the builder.
 func (spu *SupplierPaymentUpdate) check() error {
 	if v, ok := spu.mutation.Amount(); ok {
-		if err := supplierpayment.AmountValidator(v); err != nil {
+		if err := supplierpayment.AmountValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "SupplierPayment.amount": %w`, err)}
 		}
 	}
+	if v, ok := spu.mutation.ExchangeRate(); ok {
+		if err := supplierpayment.ExchangeRateValidator(v); err != nil {
+			return &ValidationError{Name: "exchange_rate", err: fmt.Errorf(`ent: validator failed for field "SupplierPayment.exchange_rate": %w`, err)}
+		}
+	}
 	if v, ok := spu.mutation.PaymentMethod(); ok {
 		if err := supplierpayment.PaymentMethodValidator(v); err != nil {
 			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "SupplierPayment.payment_method": %w`, err)}
@@ -296,18 +379,67 @@ func (spu *SupplierPaymentUpdate) sqlSave(ctx context.Context) (n int, err error
 	}
 	if value, ok := spu.mutation.Amount(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: supplierpayment.FieldAmount,
 		})
 	}
 	if value, ok := spu.mutation.AddedAmount(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: supplierpayment.FieldAmount,
 		})
 	}
+	if value, ok := spu.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: supplierpayment.FieldCurrency,
+		})
+	}
+	if value, ok := spu.mutation.ExchangeRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: supplierpayment.FieldExchangeRate,
+		})
+	}
+	if value, ok := spu.mutation.AddedExchangeRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: supplierpayment.FieldExchangeRate,
+		})
+	}
+	if value, ok := spu.mutation.BaseAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: supplierpayment.FieldBaseAmount,
+		})
+	}
+	if value, ok := spu.mutation.AddedBaseAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: supplierpayment.FieldBaseAmount,
+		})
+	}
+	if value, ok := spu.mutation.FxGainLoss(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: supplierpayment.FieldFxGainLoss,
+		})
+	}
+	if value, ok := spu.mutation.AddedFxGainLoss(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: supplierpayment.FieldFxGainLoss,
+		})
+	}
 	if value, ok := spu.mutation.PaymentDate(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -429,15 +561,92 @@ func (spuo *SupplierPaymentUpdateOne) AddSupplierID(i int) *SupplierPaymentUpdat
 }
 
 // SetAmount sets the "amount" field.
-func (spuo *SupplierPaymentUpdateOne) SetAmount(f float64) *SupplierPaymentUpdateOne {
+func (spuo *SupplierPaymentUpdateOne) SetAmount(m money.Amount) *SupplierPaymentUpdateOne {
 	spuo.mutation.ResetAmount()
-	spuo.mutation.SetAmount(f)
+	spuo.mutation.SetAmount(m)
 	return spuo
 }
 
-// AddAmount adds f to the "amount" field.
-func (spuo *SupplierPaymentUpdateOne) AddAmount(f float64) *SupplierPaymentUpdateOne {
-	spuo.mutation.AddAmount(f)
+// AddAmount adds m to the "amount" field.
+func (spuo *SupplierPaymentUpdateOne) AddAmount(m money.Amount) *SupplierPaymentUpdateOne {
+	spuo.mutation.AddAmount(m)
+	return spuo
+}
+
+// SetCurrency sets the "currency" field.
+func (spuo *SupplierPaymentUpdateOne) SetCurrency(s string) *SupplierPaymentUpdateOne {
+	spuo.mutation.SetCurrency(s)
+	return spuo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (spuo *SupplierPaymentUpdateOne) SetNillableCurrency(s *string) *SupplierPaymentUpdateOne {
+	if s != nil {
+		spuo.SetCurrency(*s)
+	}
+	return spuo
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (spuo *SupplierPaymentUpdateOne) SetExchangeRate(f float64) *SupplierPaymentUpdateOne {
+	spuo.mutation.ResetExchangeRate()
+	spuo.mutation.SetExchangeRate(f)
+	return spuo
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (spuo *SupplierPaymentUpdateOne) SetNillableExchangeRate(f *float64) *SupplierPaymentUpdateOne {
+	if f != nil {
+		spuo.SetExchangeRate(*f)
+	}
+	return spuo
+}
+
+// AddExchangeRate adds f to the "exchange_rate" field.
+func (spuo *SupplierPaymentUpdateOne) AddExchangeRate(f float64) *SupplierPaymentUpdateOne {
+	spuo.mutation.AddExchangeRate(f)
+	return spuo
+}
+
+// SetBaseAmount sets the "base_amount" field.
+func (spuo *SupplierPaymentUpdateOne) SetBaseAmount(m money.Amount) *SupplierPaymentUpdateOne {
+	spuo.mutation.ResetBaseAmount()
+	spuo.mutation.SetBaseAmount(m)
+	return spuo
+}
+
+// SetNillableBaseAmount sets the "base_amount" field if the given value is not nil.
+func (spuo *SupplierPaymentUpdateOne) SetNillableBaseAmount(m *money.Amount) *SupplierPaymentUpdateOne {
+	if m != nil {
+		spuo.SetBaseAmount(*m)
+	}
+	return spuo
+}
+
+// AddBaseAmount adds m to the "base_amount" field.
+func (spuo *SupplierPaymentUpdateOne) AddBaseAmount(m money.Amount) *SupplierPaymentUpdateOne {
+	spuo.mutation.AddBaseAmount(m)
+	return spuo
+}
+
+// SetFxGainLoss sets the "fx_gain_loss" field.
+func (spuo *SupplierPaymentUpdateOne) SetFxGainLoss(m money.Amount) *SupplierPaymentUpdateOne {
+	spuo.mutation.ResetFxGainLoss()
+	spuo.mutation.SetFxGainLoss(m)
+	return spuo
+}
+
+// SetNillableFxGainLoss sets the "fx_gain_loss" field if the given value is not nil.
+func (spuo *SupplierPaymentUpdateOne) SetNillableFxGainLoss(m *money.Amount) *SupplierPaymentUpdateOne {
+	if m != nil {
+		spuo.SetFxGainLoss(*m)
+	}
+	return spuo
+}
+
+// AddFxGainLoss adds m to the "fx_gain_loss" field.
+func (spuo *SupplierPaymentUpdateOne) AddFxGainLoss(m money.Amount) *SupplierPaymentUpdateOne {
+	spuo.mutation.AddFxGainLoss(m)
 	return spuo
 }
 
@@ -623,10 +832,15 @@ func (spuo *SupplierPaymentUpdateOne) defaults() {
 // check runs all checks and user-defined validators on the builder.
 func (spuo *SupplierPaymentUpdateOne) check() error {
 	if v, ok := spuo.mutation.Amount(); ok {
-		if err := supplierpayment.AmountValidator(v); err != nil {
+		if err := supplierpayment.AmountValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "SupplierPayment.amount": %w`, err)}
 		}
 	}
+	if v, ok := spuo.mutation.ExchangeRate(); ok {
+		if err := supplierpayment.ExchangeRateValidator(v); err != nil {
+			return &ValidationError{Name: "exchange_rate", err: fmt.Errorf(`ent: validator failed for field "SupplierPayment.exchange_rate": %w`, err)}
+		}
+	}
 	if v, ok := spuo.mutation.PaymentMethod(); ok {
 		if err := supplierpayment.PaymentMethodValidator(v); err != nil {
 			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "SupplierPayment.payment_method": %w`, err)}
@@ -700,18 +914,67 @@ func (spuo *SupplierPaymentUpdateOne) sqlSave(ctx context.Context) (_node *Suppl
 	}
 	if value, ok := spuo.mutation.Amount(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: supplierpayment.FieldAmount,
 		})
 	}
 	if value, ok := spuo.mutation.AddedAmount(); ok {
 		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeFloat64,
+			Type:   field.TypeInt64,
 			Value:  value,
 			Column: supplierpayment.FieldAmount,
 		})
 	}
+	if value, ok := spuo.mutation.Currency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: supplierpayment.FieldCurrency,
+		})
+	}
+	if value, ok := spuo.mutation.ExchangeRate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: supplierpayment.FieldExchangeRate,
+		})
+	}
+	if value, ok := spuo.mutation.AddedExchangeRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: supplierpayment.FieldExchangeRate,
+		})
+	}
+	if value, ok := spuo.mutation.BaseAmount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: supplierpayment.FieldBaseAmount,
+		})
+	}
+	if value, ok := spuo.mutation.AddedBaseAmount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: supplierpayment.FieldBaseAmount,
+		})
+	}
+	if value, ok := spuo.mutation.FxGainLoss(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: supplierpayment.FieldFxGainLoss,
+		})
+	}
+	if value, ok := spuo.mutation.AddedFxGainLoss(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: supplierpayment.FieldFxGainLoss,
+		})
+	}
 	if value, ok := spuo.mutation.PaymentDate(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/taxrate"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// TaxRate is the model entity for the TaxRate schema.
+type TaxRate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID del tenant
+	TenantID int `json:"tenant_id,omitempty"`
+	// Nombre de la tarifa (IVA 19%, IVA 5%, Exento, Excluido, ...)
+	Name string `json:"name,omitempty"`
+	// Porcentaje de la tarifa; solo se aplica a las tarifas gravadas
+	Rate float64 `json:"rate,omitempty"`
+	// Tipo de tarifa (taxable, exempt, excluded)
+	Kind string `json:"kind,omitempty"`
+	// Tarifa que se aplica a los productos sin tarifa propia; una por tenant
+	IsDefault bool `json:"is_default,omitempty"`
+	// Las tarifas inactivas no se pueden asignar a productos
+	Active bool `json:"active,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaxRate) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taxrate.FieldIsDefault, taxrate.FieldActive:
+			values[i] = new(sql.NullBool)
+		case taxrate.FieldRate:
+			values[i] = new(sql.NullFloat64)
+		case taxrate.FieldID, taxrate.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case taxrate.FieldName, taxrate.FieldKind:
+			values[i] = new(sql.NullString)
+		case taxrate.FieldCreatedAt, taxrate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type TaxRate", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaxRate fields.
+func (tr *TaxRate) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taxrate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tr.ID = int(value.Int64)
+		case taxrate.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				tr.TenantID = int(value.Int64)
+			}
+		case taxrate.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				tr.Name = value.String
+			}
+		case taxrate.FieldRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value.Valid {
+				tr.Rate = value.Float64
+			}
+		case taxrate.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				tr.Kind = value.String
+			}
+		case taxrate.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				tr.IsDefault = value.Bool
+			}
+		case taxrate.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				tr.Active = value.Bool
+			}
+		case taxrate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tr.CreatedAt = value.Time
+			}
+		case taxrate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tr.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this TaxRate.
+// Note that you need to call TaxRate.Unwrap() before calling this method if this TaxRate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tr *TaxRate) Update() *TaxRateUpdateOne {
+	return (&TaxRateClient{config: tr.config}).UpdateOne(tr)
+}
+
+// Unwrap unwraps the TaxRate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tr *TaxRate) Unwrap() *TaxRate {
+	_tx, ok := tr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaxRate is not a transactional entity")
+	}
+	tr.config.driver = _tx.drv
+	return tr
+}
+
+// String implements the fmt.Stringer.
+func (tr *TaxRate) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaxRate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tr.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", tr.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(tr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(tr.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", tr.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Active))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaxRates is a parsable slice of TaxRate.
+type TaxRates []*TaxRate
+
+func (tr TaxRates) config(cfg config) {
+	for _i := range tr {
+		tr[_i].config = cfg
+	}
+}
@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/taxrate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaxRateCreate is the builder for creating a TaxRate entity.
+type TaxRateCreate struct {
+	config
+	mutation *TaxRateMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (trc *TaxRateCreate) SetTenantID(i int) *TaxRateCreate {
+	trc.mutation.SetTenantID(i)
+	return trc
+}
+
+// SetName sets the "name" field.
+func (trc *TaxRateCreate) SetName(s string) *TaxRateCreate {
+	trc.mutation.SetName(s)
+	return trc
+}
+
+// SetRate sets the "rate" field.
+func (trc *TaxRateCreate) SetRate(f float64) *TaxRateCreate {
+	trc.mutation.SetRate(f)
+	return trc
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (trc *TaxRateCreate) SetNillableRate(f *float64) *TaxRateCreate {
+	if f != nil {
+		trc.SetRate(*f)
+	}
+	return trc
+}
+
+// SetKind sets the "kind" field.
+func (trc *TaxRateCreate) SetKind(s string) *TaxRateCreate {
+	trc.mutation.SetKind(s)
+	return trc
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (trc *TaxRateCreate) SetNillableKind(s *string) *TaxRateCreate {
+	if s != nil {
+		trc.SetKind(*s)
+	}
+	return trc
+}
+
+// SetIsDefault sets the "is_default" field.
+func (trc *TaxRateCreate) SetIsDefault(b bool) *TaxRateCreate {
+	trc.mutation.SetIsDefault(b)
+	return trc
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (trc *TaxRateCreate) SetNillableIsDefault(b *bool) *TaxRateCreate {
+	if b != nil {
+		trc.SetIsDefault(*b)
+	}
+	return trc
+}
+
+// SetActive sets the "active" field.
+func (trc *TaxRateCreate) SetActive(b bool) *TaxRateCreate {
+	trc.mutation.SetActive(b)
+	return trc
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (trc *TaxRateCreate) SetNillableActive(b *bool) *TaxRateCreate {
+	if b != nil {
+		trc.SetActive(*b)
+	}
+	return trc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (trc *TaxRateCreate) SetCreatedAt(t time.Time) *TaxRateCreate {
+	trc.mutation.SetCreatedAt(t)
+	return trc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (trc *TaxRateCreate) SetNillableCreatedAt(t *time.Time) *TaxRateCreate {
+	if t != nil {
+		trc.SetCreatedAt(*t)
+	}
+	return trc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (trc *TaxRateCreate) SetUpdatedAt(t time.Time) *TaxRateCreate {
+	trc.mutation.SetUpdatedAt(t)
+	return trc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (trc *TaxRateCreate) SetNillableUpdatedAt(t *time.Time) *TaxRateCreate {
+	if t != nil {
+		trc.SetUpdatedAt(*t)
+	}
+	return trc
+}
+
+// Mutation returns the TaxRateMutation object of the builder.
+func (trc *TaxRateCreate) Mutation() *TaxRateMutation {
+	return trc.mutation
+}
+
+// Save creates the TaxRate in the database.
+func (trc *TaxRateCreate) Save(ctx context.Context) (*TaxRate, error) {
+	var (
+		err  error
+		node *TaxRate
+	)
+	trc.defaults()
+	if len(trc.hooks) == 0 {
+		if err = trc.check(); err != nil {
+			return nil, err
+		}
+		node, err = trc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TaxRateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = trc.check(); err != nil {
+				return nil, err
+			}
+			trc.mutation = mutation
+			if node, err = trc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(trc.hooks) - 1; i >= 0; i-- {
+			if trc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = trc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, trc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*TaxRate)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from TaxRateMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (trc *TaxRateCreate) SaveX(ctx context.Context) *TaxRate {
+	v, err := trc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trc *TaxRateCreate) Exec(ctx context.Context) error {
+	_, err := trc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trc *TaxRateCreate) ExecX(ctx context.Context) {
+	if err := trc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trc *TaxRateCreate) defaults() {
+	if _, ok := trc.mutation.Rate(); !ok {
+		v := taxrate.DefaultRate
+		trc.mutation.SetRate(v)
+	}
+	if _, ok := trc.mutation.Kind(); !ok {
+		v := taxrate.DefaultKind
+		trc.mutation.SetKind(v)
+	}
+	if _, ok := trc.mutation.IsDefault(); !ok {
+		v := taxrate.DefaultIsDefault
+		trc.mutation.SetIsDefault(v)
+	}
+	if _, ok := trc.mutation.Active(); !ok {
+		v := taxrate.DefaultActive
+		trc.mutation.SetActive(v)
+	}
+	if _, ok := trc.mutation.CreatedAt(); !ok {
+		v := taxrate.DefaultCreatedAt()
+		trc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := trc.mutation.UpdatedAt(); !ok {
+		v := taxrate.DefaultUpdatedAt()
+		trc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (trc *TaxRateCreate) check() error {
+	if _, ok := trc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TaxRate.tenant_id"`)}
+	}
+	if _, ok := trc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TaxRate.name"`)}
+	}
+	if v, ok := trc.mutation.Name(); ok {
+		if err := taxrate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaxRate.name": %w`, err)}
+		}
+	}
+	if _, ok := trc.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "TaxRate.rate"`)}
+	}
+	if v, ok := trc.mutation.Rate(); ok {
+		if err := taxrate.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`ent: validator failed for field "TaxRate.rate": %w`, err)}
+		}
+	}
+	if _, ok := trc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "TaxRate.kind"`)}
+	}
+	if _, ok := trc.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "TaxRate.is_default"`)}
+	}
+	if _, ok := trc.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "TaxRate.active"`)}
+	}
+	if _, ok := trc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaxRate.created_at"`)}
+	}
+	if _, ok := trc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TaxRate.updated_at"`)}
+	}
+	return nil
+}
+
+func (trc *TaxRateCreate) sqlSave(ctx context.Context) (*TaxRate, error) {
+	_node, _spec := trc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, trc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (trc *TaxRateCreate) createSpec() (*TaxRate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaxRate{config: trc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: taxrate.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: taxrate.FieldID,
+			},
+		}
+	)
+	if value, ok := trc.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: taxrate.FieldTenantID,
+		})
+		_node.TenantID = value
+	}
+	if value, ok := trc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: taxrate.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := trc.mutation.Rate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: taxrate.FieldRate,
+		})
+		_node.Rate = value
+	}
+	if value, ok := trc.mutation.Kind(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: taxrate.FieldKind,
+		})
+		_node.Kind = value
+	}
+	if value, ok := trc.mutation.IsDefault(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: taxrate.FieldIsDefault,
+		})
+		_node.IsDefault = value
+	}
+	if value, ok := trc.mutation.Active(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: taxrate.FieldActive,
+		})
+		_node.Active = value
+	}
+	if value, ok := trc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: taxrate.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := trc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: taxrate.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// TaxRateCreateBulk is the builder for creating many TaxRate entities in bulk.
+type TaxRateCreateBulk struct {
+	config
+	builders []*TaxRateCreate
+}
+
+// Save creates the TaxRate entities in the database.
+func (trcb *TaxRateCreateBulk) Save(ctx context.Context) ([]*TaxRate, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(trcb.builders))
+	nodes := make([]*TaxRate, len(trcb.builders))
+	mutators := make([]Mutator, len(trcb.builders))
+	for i := range trcb.builders {
+		func(i int, root context.Context) {
+			builder := trcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaxRateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, trcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, trcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, trcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trcb *TaxRateCreateBulk) SaveX(ctx context.Context) []*TaxRate {
+	v, err := trcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trcb *TaxRateCreateBulk) Exec(ctx context.Context) error {
+	_, err := trcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcb *TaxRateCreateBulk) ExecX(ctx context.Context) {
+	if err := trcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/taxrate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaxRateQuery is the builder for querying TaxRate entities.
+type TaxRateQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.TaxRate
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaxRateQuery builder.
+func (trq *TaxRateQuery) Where(ps ...predicate.TaxRate) *TaxRateQuery {
+	trq.predicates = append(trq.predicates, ps...)
+	return trq
+}
+
+// Limit adds a limit step to the query.
+func (trq *TaxRateQuery) Limit(limit int) *TaxRateQuery {
+	trq.limit = &limit
+	return trq
+}
+
+// Offset adds an offset step to the query.
+func (trq *TaxRateQuery) Offset(offset int) *TaxRateQuery {
+	trq.offset = &offset
+	return trq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (trq *TaxRateQuery) Unique(unique bool) *TaxRateQuery {
+	trq.unique = &unique
+	return trq
+}
+
+// Order adds an order step to the query.
+func (trq *TaxRateQuery) Order(o ...OrderFunc) *TaxRateQuery {
+	trq.order = append(trq.order, o...)
+	return trq
+}
+
+// First returns the first TaxRate entity from the query.
+// Returns a *NotFoundError when no TaxRate was found.
+func (trq *TaxRateQuery) First(ctx context.Context) (*TaxRate, error) {
+	nodes, err := trq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{taxrate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (trq *TaxRateQuery) FirstX(ctx context.Context) *TaxRate {
+	node, err := trq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaxRate ID from the query.
+// Returns a *NotFoundError when no TaxRate ID was found.
+func (trq *TaxRateQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = trq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{taxrate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (trq *TaxRateQuery) FirstIDX(ctx context.Context) int {
+	id, err := trq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaxRate entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaxRate entity is found.
+// Returns a *NotFoundError when no TaxRate entities are found.
+func (trq *TaxRateQuery) Only(ctx context.Context) (*TaxRate, error) {
+	nodes, err := trq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{taxrate.Label}
+	default:
+		return nil, &NotSingularError{taxrate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (trq *TaxRateQuery) OnlyX(ctx context.Context) *TaxRate {
+	node, err := trq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaxRate ID in the query.
+// Returns a *NotSingularError when more than one TaxRate ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (trq *TaxRateQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = trq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{taxrate.Label}
+	default:
+		err = &NotSingularError{taxrate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (trq *TaxRateQuery) OnlyIDX(ctx context.Context) int {
+	id, err := trq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaxRates.
+func (trq *TaxRateQuery) All(ctx context.Context) ([]*TaxRate, error) {
+	if err := trq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return trq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (trq *TaxRateQuery) AllX(ctx context.Context) []*TaxRate {
+	nodes, err := trq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaxRate IDs.
+func (trq *TaxRateQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := trq.Select(taxrate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (trq *TaxRateQuery) IDsX(ctx context.Context) []int {
+	ids, err := trq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (trq *TaxRateQuery) Count(ctx context.Context) (int, error) {
+	if err := trq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return trq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (trq *TaxRateQuery) CountX(ctx context.Context) int {
+	count, err := trq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (trq *TaxRateQuery) Exist(ctx context.Context) (bool, error) {
+	if err := trq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return trq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (trq *TaxRateQuery) ExistX(ctx context.Context) bool {
+	exist, err := trq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaxRateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (trq *TaxRateQuery) Clone() *TaxRateQuery {
+	if trq == nil {
+		return nil
+	}
+	return &TaxRateQuery{
+		config:     trq.config,
+		limit:      trq.limit,
+		offset:     trq.offset,
+		order:      append([]OrderFunc{}, trq.order...),
+		predicates: append([]predicate.TaxRate{}, trq.predicates...),
+		// clone intermediate query.
+		sql:    trq.sql.Clone(),
+		path:   trq.path,
+		unique: trq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaxRate.Query().
+//		GroupBy(taxrate.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (trq *TaxRateQuery) GroupBy(field string, fields ...string) *TaxRateGroupBy {
+	grbuild := &TaxRateGroupBy{config: trq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := trq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return trq.sqlQuery(ctx), nil
+	}
+	grbuild.label = taxrate.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.TaxRate.Query().
+//		Select(taxrate.FieldTenantID).
+//		Scan(ctx, &v)
+func (trq *TaxRateQuery) Select(fields ...string) *TaxRateSelect {
+	trq.fields = append(trq.fields, fields...)
+	selbuild := &TaxRateSelect{TaxRateQuery: trq}
+	selbuild.label = taxrate.Label
+	selbuild.flds, selbuild.scan = &trq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (trq *TaxRateQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range trq.fields {
+		if !taxrate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if trq.path != nil {
+		prev, err := trq.path(ctx)
+		if err != nil {
+			return err
+		}
+		trq.sql = prev
+	}
+	return nil
+}
+
+func (trq *TaxRateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaxRate, error) {
+	var (
+		nodes = []*TaxRate{}
+		_spec = trq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*TaxRate).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &TaxRate{config: trq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(trq.modifiers) > 0 {
+		_spec.Modifiers = trq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, trq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (trq *TaxRateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := trq.querySpec()
+	if len(trq.modifiers) > 0 {
+		_spec.Modifiers = trq.modifiers
+	}
+	_spec.Node.Columns = trq.fields
+	if len(trq.fields) > 0 {
+		_spec.Unique = trq.unique != nil && *trq.unique
+	}
+	return sqlgraph.CountNodes(ctx, trq.driver, _spec)
+}
+
+func (trq *TaxRateQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := trq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (trq *TaxRateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   taxrate.Table,
+			Columns: taxrate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: taxrate.FieldID,
+			},
+		},
+		From:   trq.sql,
+		Unique: true,
+	}
+	if unique := trq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := trq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taxrate.FieldID)
+		for i := range fields {
+			if fields[i] != taxrate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := trq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := trq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := trq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := trq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (trq *TaxRateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(trq.driver.Dialect())
+	t1 := builder.Table(taxrate.Table)
+	columns := trq.fields
+	if len(columns) == 0 {
+		columns = taxrate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if trq.sql != nil {
+		selector = trq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if trq.unique != nil && *trq.unique {
+		selector.Distinct()
+	}
+	for _, m := range trq.modifiers {
+		m(selector)
+	}
+	for _, p := range trq.predicates {
+		p(selector)
+	}
+	for _, p := range trq.order {
+		p(selector)
+	}
+	if offset := trq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := trq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (trq *TaxRateQuery) ForUpdate(opts ...sql.LockOption) *TaxRateQuery {
+	if trq.driver.Dialect() == dialect.Postgres {
+		trq.Unique(false)
+	}
+	trq.modifiers = append(trq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return trq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (trq *TaxRateQuery) ForShare(opts ...sql.LockOption) *TaxRateQuery {
+	if trq.driver.Dialect() == dialect.Postgres {
+		trq.Unique(false)
+	}
+	trq.modifiers = append(trq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return trq
+}
+
+// TaxRateGroupBy is the group-by builder for TaxRate entities.
+type TaxRateGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (trgb *TaxRateGroupBy) Aggregate(fns ...AggregateFunc) *TaxRateGroupBy {
+	trgb.fns = append(trgb.fns, fns...)
+	return trgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (trgb *TaxRateGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := trgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	trgb.sql = query
+	return trgb.sqlScan(ctx, v)
+}
+
+func (trgb *TaxRateGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range trgb.fields {
+		if !taxrate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := trgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (trgb *TaxRateGroupBy) sqlQuery() *sql.Selector {
+	selector := trgb.sql.Select()
+	aggregation := make([]string, 0, len(trgb.fns))
+	for _, fn := range trgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(trgb.fields)+len(trgb.fns))
+		for _, f := range trgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(trgb.fields...)...)
+}
+
+// TaxRateSelect is the builder for selecting fields of TaxRate entities.
+type TaxRateSelect struct {
+	*TaxRateQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trs *TaxRateSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := trs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	trs.sql = trs.TaxRateQuery.sqlQuery(ctx)
+	return trs.sqlScan(ctx, v)
+}
+
+func (trs *TaxRateSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := trs.sql.Query()
+	if err := trs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
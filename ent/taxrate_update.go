@@ -0,0 +1,608 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"Veritasbackend/ent/predicate"
+	"Veritasbackend/ent/taxrate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaxRateUpdate is the builder for updating TaxRate entities.
+type TaxRateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaxRateMutation
+}
+
+// Where appends a list predicates to the TaxRateUpdate builder.
+func (tru *TaxRateUpdate) Where(ps ...predicate.TaxRate) *TaxRateUpdate {
+	tru.mutation.Where(ps...)
+	return tru
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (tru *TaxRateUpdate) SetTenantID(i int) *TaxRateUpdate {
+	tru.mutation.ResetTenantID()
+	tru.mutation.SetTenantID(i)
+	return tru
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (tru *TaxRateUpdate) AddTenantID(i int) *TaxRateUpdate {
+	tru.mutation.AddTenantID(i)
+	return tru
+}
+
+// SetName sets the "name" field.
+func (tru *TaxRateUpdate) SetName(s string) *TaxRateUpdate {
+	tru.mutation.SetName(s)
+	return tru
+}
+
+// SetRate sets the "rate" field.
+func (tru *TaxRateUpdate) SetRate(f float64) *TaxRateUpdate {
+	tru.mutation.ResetRate()
+	tru.mutation.SetRate(f)
+	return tru
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (tru *TaxRateUpdate) SetNillableRate(f *float64) *TaxRateUpdate {
+	if f != nil {
+		tru.SetRate(*f)
+	}
+	return tru
+}
+
+// AddRate adds f to the "rate" field.
+func (tru *TaxRateUpdate) AddRate(f float64) *TaxRateUpdate {
+	tru.mutation.AddRate(f)
+	return tru
+}
+
+// SetKind sets the "kind" field.
+func (tru *TaxRateUpdate) SetKind(s string) *TaxRateUpdate {
+	tru.mutation.SetKind(s)
+	return tru
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (tru *TaxRateUpdate) SetNillableKind(s *string) *TaxRateUpdate {
+	if s != nil {
+		tru.SetKind(*s)
+	}
+	return tru
+}
+
+// SetIsDefault sets the "is_default" field.
+func (tru *TaxRateUpdate) SetIsDefault(b bool) *TaxRateUpdate {
+	tru.mutation.SetIsDefault(b)
+	return tru
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (tru *TaxRateUpdate) SetNillableIsDefault(b *bool) *TaxRateUpdate {
+	if b != nil {
+		tru.SetIsDefault(*b)
+	}
+	return tru
+}
+
+// SetActive sets the "active" field.
+func (tru *TaxRateUpdate) SetActive(b bool) *TaxRateUpdate {
+	tru.mutation.SetActive(b)
+	return tru
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (tru *TaxRateUpdate) SetNillableActive(b *bool) *TaxRateUpdate {
+	if b != nil {
+		tru.SetActive(*b)
+	}
+	return tru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tru *TaxRateUpdate) SetUpdatedAt(t time.Time) *TaxRateUpdate {
+	tru.mutation.SetUpdatedAt(t)
+	return tru
+}
+
+// Mutation returns the TaxRateMutation object of the builder.
+func (tru *TaxRateUpdate) Mutation() *TaxRateMutation {
+	return tru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tru *TaxRateUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	tru.defaults()
+	if len(tru.hooks) == 0 {
+		if err = tru.check(); err != nil {
+			return 0, err
+		}
+		affected, err = tru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TaxRateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tru.check(); err != nil {
+				return 0, err
+			}
+			tru.mutation = mutation
+			affected, err = tru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(tru.hooks) - 1; i >= 0; i-- {
+			if tru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tru *TaxRateUpdate) SaveX(ctx context.Context) int {
+	affected, err := tru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tru *TaxRateUpdate) Exec(ctx context.Context) error {
+	_, err := tru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tru *TaxRateUpdate) ExecX(ctx context.Context) {
+	if err := tru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tru *TaxRateUpdate) defaults() {
+	if _, ok := tru.mutation.UpdatedAt(); !ok {
+		v := taxrate.UpdateDefaultUpdatedAt()
+		tru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tru *TaxRateUpdate) check() error {
+	if v, ok := tru.mutation.Name(); ok {
+		if err := taxrate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaxRate.name": %w`, err)}
+		}
+	}
+	if v, ok := tru.mutation.Rate(); ok {
+		if err := taxrate.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`ent: validator failed for field "TaxRate.rate": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tru *TaxRateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   taxrate.Table,
+			Columns: taxrate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: taxrate.FieldID,
+			},
+		},
+	}
+	if ps := tru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tru.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: taxrate.FieldTenantID,
+		})
+	}
+	if value, ok := tru.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: taxrate.FieldTenantID,
+		})
+	}
+	if value, ok := tru.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: taxrate.FieldName,
+		})
+	}
+	if value, ok := tru.mutation.Rate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: taxrate.FieldRate,
+		})
+	}
+	if value, ok := tru.mutation.AddedRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: taxrate.FieldRate,
+		})
+	}
+	if value, ok := tru.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: taxrate.FieldKind,
+		})
+	}
+	if value, ok := tru.mutation.IsDefault(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: taxrate.FieldIsDefault,
+		})
+	}
+	if value, ok := tru.mutation.Active(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: taxrate.FieldActive,
+		})
+	}
+	if value, ok := tru.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: taxrate.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taxrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TaxRateUpdateOne is the builder for updating a single TaxRate entity.
+type TaxRateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaxRateMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (truo *TaxRateUpdateOne) SetTenantID(i int) *TaxRateUpdateOne {
+	truo.mutation.ResetTenantID()
+	truo.mutation.SetTenantID(i)
+	return truo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (truo *TaxRateUpdateOne) AddTenantID(i int) *TaxRateUpdateOne {
+	truo.mutation.AddTenantID(i)
+	return truo
+}
+
+// SetName sets the "name" field.
+func (truo *TaxRateUpdateOne) SetName(s string) *TaxRateUpdateOne {
+	truo.mutation.SetName(s)
+	return truo
+}
+
+// SetRate sets the "rate" field.
+func (truo *TaxRateUpdateOne) SetRate(f float64) *TaxRateUpdateOne {
+	truo.mutation.ResetRate()
+	truo.mutation.SetRate(f)
+	return truo
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (truo *TaxRateUpdateOne) SetNillableRate(f *float64) *TaxRateUpdateOne {
+	if f != nil {
+		truo.SetRate(*f)
+	}
+	return truo
+}
+
+// AddRate adds f to the "rate" field.
+func (truo *TaxRateUpdateOne) AddRate(f float64) *TaxRateUpdateOne {
+	truo.mutation.AddRate(f)
+	return truo
+}
+
+// SetKind sets the "kind" field.
+func (truo *TaxRateUpdateOne) SetKind(s string) *TaxRateUpdateOne {
+	truo.mutation.SetKind(s)
+	return truo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (truo *TaxRateUpdateOne) SetNillableKind(s *string) *TaxRateUpdateOne {
+	if s != nil {
+		truo.SetKind(*s)
+	}
+	return truo
+}
+
+// SetIsDefault sets the "is_default" field.
+func (truo *TaxRateUpdateOne) SetIsDefault(b bool) *TaxRateUpdateOne {
+	truo.mutation.SetIsDefault(b)
+	return truo
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (truo *TaxRateUpdateOne) SetNillableIsDefault(b *bool) *TaxRateUpdateOne {
+	if b != nil {
+		truo.SetIsDefault(*b)
+	}
+	return truo
+}
+
+// SetActive sets the "active" field.
+func (truo *TaxRateUpdateOne) SetActive(b bool) *TaxRateUpdateOne {
+	truo.mutation.SetActive(b)
+	return truo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (truo *TaxRateUpdateOne) SetNillableActive(b *bool) *TaxRateUpdateOne {
+	if b != nil {
+		truo.SetActive(*b)
+	}
+	return truo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (truo *TaxRateUpdateOne) SetUpdatedAt(t time.Time) *TaxRateUpdateOne {
+	truo.mutation.SetUpdatedAt(t)
+	return truo
+}
+
+// Mutation returns the TaxRateMutation object of the builder.
+func (truo *TaxRateUpdateOne) Mutation() *TaxRateMutation {
+	return truo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (truo *TaxRateUpdateOne) Select(field string, fields ...string) *TaxRateUpdateOne {
+	truo.fields = append([]string{field}, fields...)
+	return truo
+}
+
+// Save executes the query and returns the updated TaxRate entity.
+func (truo *TaxRateUpdateOne) Save(ctx context.Context) (*TaxRate, error) {
+	var (
+		err  error
+		node *TaxRate
+	)
+	truo.defaults()
+	if len(truo.hooks) == 0 {
+		if err = truo.check(); err != nil {
+			return nil, err
+		}
+		node, err = truo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TaxRateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = truo.check(); err != nil {
+				return nil, err
+			}
+			truo.mutation = mutation
+			node, err = truo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(truo.hooks) - 1; i >= 0; i-- {
+			if truo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = truo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, truo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*TaxRate)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from TaxRateMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (truo *TaxRateUpdateOne) SaveX(ctx context.Context) *TaxRate {
+	node, err := truo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (truo *TaxRateUpdateOne) Exec(ctx context.Context) error {
+	_, err := truo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (truo *TaxRateUpdateOne) ExecX(ctx context.Context) {
+	if err := truo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (truo *TaxRateUpdateOne) defaults() {
+	if _, ok := truo.mutation.UpdatedAt(); !ok {
+		v := taxrate.UpdateDefaultUpdatedAt()
+		truo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (truo *TaxRateUpdateOne) check() error {
+	if v, ok := truo.mutation.Name(); ok {
+		if err := taxrate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaxRate.name": %w`, err)}
+		}
+	}
+	if v, ok := truo.mutation.Rate(); ok {
+		if err := taxrate.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`ent: validator failed for field "TaxRate.rate": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (truo *TaxRateUpdateOne) sqlSave(ctx context.Context) (_node *TaxRate, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   taxrate.Table,
+			Columns: taxrate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: taxrate.FieldID,
+			},
+		},
+	}
+	id, ok := truo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaxRate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := truo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taxrate.FieldID)
+		for _, f := range fields {
+			if !taxrate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taxrate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := truo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := truo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: taxrate.FieldTenantID,
+		})
+	}
+	if value, ok := truo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: taxrate.FieldTenantID,
+		})
+	}
+	if value, ok := truo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: taxrate.FieldName,
+		})
+	}
+	if value, ok := truo.mutation.Rate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: taxrate.FieldRate,
+		})
+	}
+	if value, ok := truo.mutation.AddedRate(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: taxrate.FieldRate,
+		})
+	}
+	if value, ok := truo.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: taxrate.FieldKind,
+		})
+	}
+	if value, ok := truo.mutation.IsDefault(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: taxrate.FieldIsDefault,
+		})
+	}
+	if value, ok := truo.mutation.Active(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: taxrate.FieldActive,
+		})
+	}
+	if value, ok := truo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: taxrate.FieldUpdatedAt,
+		})
+	}
+	_node = &TaxRate{config: truo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, truo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taxrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"Veritasbackend/ent/tenant"
+	"Veritasbackend/pkg/money"
 	"fmt"
 	"strings"
 	"time"
@@ -22,6 +23,46 @@ type Tenant struct {
 	Slug string `json:"slug,omitempty"`
 	// Dominio del tenant
 	Domain string `json:"domain,omitempty"`
+	// Método de costeo del inventario (average, fifo)
+	CostingMethod string `json:"costing_method,omitempty"`
+	// Valor al costo a partir del cual un ajuste de stock requiere aprobación (0 = sin aprobación)
+	AdjustmentApprovalThreshold money.Amount `json:"adjustment_approval_threshold,omitempty"`
+	// Descuento máximo en porcentaje que puede dar un usuario sin autorización
+	UserDiscountLimit float64 `json:"user_discount_limit,omitempty"`
+	// Descuento máximo en porcentaje que puede dar un manager sin autorización
+	ManagerDiscountLimit float64 `json:"manager_discount_limit,omitempty"`
+	// Los precios de venta de los productos ya incluyen impuestos
+	PricesIncludeTax bool `json:"prices_include_tax,omitempty"`
+	// Moneda base del tenant: la de los precios, los costos y los reportes
+	BaseCurrency string `json:"base_currency,omitempty"`
+	// Razón social que se imprime en las facturas
+	LegalName string `json:"legal_name,omitempty"`
+	// Número de identificación fiscal (NIT/RUC)
+	TaxID string `json:"tax_id,omitempty"`
+	// Dirección fiscal
+	Address string `json:"address,omitempty"`
+	// Teléfono de contacto
+	Phone string `json:"phone,omitempty"`
+	// Email de contacto
+	Email string `json:"email,omitempty"`
+	// Logo en PNG para el encabezado de facturas y recibos
+	Logo []byte `json:"logo,omitempty"`
+	// País de la factura electrónica (CO, PE); vacío si no emite facturas electrónicas
+	EinvoiceCountry string `json:"einvoice_country,omitempty"`
+	// Ambiente de la autoridad tributaria (test, production)
+	EinvoiceEnvironment string `json:"einvoice_environment,omitempty"`
+	// Identificador del software de facturación ante la autoridad
+	EinvoiceSoftwareID string `json:"einvoice_software_id,omitempty"`
+	// PIN del software de facturación; con él se calcula el CUDE de las notas crédito
+	EinvoiceSoftwarePin string `json:"-"`
+	// Clave técnica de la resolución de facturación; con ella se calcula el CUFE
+	EinvoiceTechnicalKey string `json:"-"`
+	// Certificado de firma digital en PEM
+	EinvoiceCertificate []byte `json:"-"`
+	// Llave privada del certificado de firma en PEM (PKCS#8), cifrada con EINVOICE_KEY_ENCRYPTION_KEY
+	EinvoicePrivateKey []byte `json:"-"`
+	// Vencimiento del certificado de firma
+	EinvoiceCertificateExpiresAt *time.Time `json:"einvoice_certificate_expires_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -33,11 +74,19 @@ func (*Tenant) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case tenant.FieldLogo, tenant.FieldEinvoiceCertificate, tenant.FieldEinvoicePrivateKey:
+			values[i] = new([]byte)
+		case tenant.FieldAdjustmentApprovalThreshold:
+			values[i] = new(money.Amount)
+		case tenant.FieldPricesIncludeTax:
+			values[i] = new(sql.NullBool)
+		case tenant.FieldUserDiscountLimit, tenant.FieldManagerDiscountLimit:
+			values[i] = new(sql.NullFloat64)
 		case tenant.FieldID:
 			values[i] = new(sql.NullInt64)
-		case tenant.FieldName, tenant.FieldSlug, tenant.FieldDomain:
+		case tenant.FieldName, tenant.FieldSlug, tenant.FieldDomain, tenant.FieldCostingMethod, tenant.FieldBaseCurrency, tenant.FieldLegalName, tenant.FieldTaxID, tenant.FieldAddress, tenant.FieldPhone, tenant.FieldEmail, tenant.FieldEinvoiceCountry, tenant.FieldEinvoiceEnvironment, tenant.FieldEinvoiceSoftwareID, tenant.FieldEinvoiceSoftwarePin, tenant.FieldEinvoiceTechnicalKey:
 			values[i] = new(sql.NullString)
-		case tenant.FieldCreatedAt, tenant.FieldUpdatedAt:
+		case tenant.FieldEinvoiceCertificateExpiresAt, tenant.FieldCreatedAt, tenant.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Tenant", columns[i])
@@ -78,6 +127,127 @@ func (t *Tenant) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				t.Domain = value.String
 			}
+		case tenant.FieldCostingMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field costing_method", values[i])
+			} else if value.Valid {
+				t.CostingMethod = value.String
+			}
+		case tenant.FieldAdjustmentApprovalThreshold:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field adjustment_approval_threshold", values[i])
+			} else if value != nil {
+				t.AdjustmentApprovalThreshold = *value
+			}
+		case tenant.FieldUserDiscountLimit:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_discount_limit", values[i])
+			} else if value.Valid {
+				t.UserDiscountLimit = value.Float64
+			}
+		case tenant.FieldManagerDiscountLimit:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field manager_discount_limit", values[i])
+			} else if value.Valid {
+				t.ManagerDiscountLimit = value.Float64
+			}
+		case tenant.FieldPricesIncludeTax:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field prices_include_tax", values[i])
+			} else if value.Valid {
+				t.PricesIncludeTax = value.Bool
+			}
+		case tenant.FieldBaseCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_currency", values[i])
+			} else if value.Valid {
+				t.BaseCurrency = value.String
+			}
+		case tenant.FieldLegalName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field legal_name", values[i])
+			} else if value.Valid {
+				t.LegalName = value.String
+			}
+		case tenant.FieldTaxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_id", values[i])
+			} else if value.Valid {
+				t.TaxID = value.String
+			}
+		case tenant.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				t.Address = value.String
+			}
+		case tenant.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				t.Phone = value.String
+			}
+		case tenant.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				t.Email = value.String
+			}
+		case tenant.FieldLogo:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field logo", values[i])
+			} else if value != nil {
+				t.Logo = *value
+			}
+		case tenant.FieldEinvoiceCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field einvoice_country", values[i])
+			} else if value.Valid {
+				t.EinvoiceCountry = value.String
+			}
+		case tenant.FieldEinvoiceEnvironment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field einvoice_environment", values[i])
+			} else if value.Valid {
+				t.EinvoiceEnvironment = value.String
+			}
+		case tenant.FieldEinvoiceSoftwareID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field einvoice_software_id", values[i])
+			} else if value.Valid {
+				t.EinvoiceSoftwareID = value.String
+			}
+		case tenant.FieldEinvoiceSoftwarePin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field einvoice_software_pin", values[i])
+			} else if value.Valid {
+				t.EinvoiceSoftwarePin = value.String
+			}
+		case tenant.FieldEinvoiceTechnicalKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field einvoice_technical_key", values[i])
+			} else if value.Valid {
+				t.EinvoiceTechnicalKey = value.String
+			}
+		case tenant.FieldEinvoiceCertificate:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field einvoice_certificate", values[i])
+			} else if value != nil {
+				t.EinvoiceCertificate = *value
+			}
+		case tenant.FieldEinvoicePrivateKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field einvoice_private_key", values[i])
+			} else if value != nil {
+				t.EinvoicePrivateKey = *value
+			}
+		case tenant.FieldEinvoiceCertificateExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field einvoice_certificate_expires_at", values[i])
+			} else if value.Valid {
+				t.EinvoiceCertificateExpiresAt = new(time.Time)
+				*t.EinvoiceCertificateExpiresAt = value.Time
+			}
 		case tenant.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -127,6 +297,64 @@ func (t *Tenant) String() string {
 	builder.WriteString("domain=")
 	builder.WriteString(t.Domain)
 	builder.WriteString(", ")
+	builder.WriteString("costing_method=")
+	builder.WriteString(t.CostingMethod)
+	builder.WriteString(", ")
+	builder.WriteString("adjustment_approval_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", t.AdjustmentApprovalThreshold))
+	builder.WriteString(", ")
+	builder.WriteString("user_discount_limit=")
+	builder.WriteString(fmt.Sprintf("%v", t.UserDiscountLimit))
+	builder.WriteString(", ")
+	builder.WriteString("manager_discount_limit=")
+	builder.WriteString(fmt.Sprintf("%v", t.ManagerDiscountLimit))
+	builder.WriteString(", ")
+	builder.WriteString("prices_include_tax=")
+	builder.WriteString(fmt.Sprintf("%v", t.PricesIncludeTax))
+	builder.WriteString(", ")
+	builder.WriteString("base_currency=")
+	builder.WriteString(t.BaseCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("legal_name=")
+	builder.WriteString(t.LegalName)
+	builder.WriteString(", ")
+	builder.WriteString("tax_id=")
+	builder.WriteString(t.TaxID)
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(t.Address)
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(t.Phone)
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(t.Email)
+	builder.WriteString(", ")
+	builder.WriteString("logo=")
+	builder.WriteString(fmt.Sprintf("%v", t.Logo))
+	builder.WriteString(", ")
+	builder.WriteString("einvoice_country=")
+	builder.WriteString(t.EinvoiceCountry)
+	builder.WriteString(", ")
+	builder.WriteString("einvoice_environment=")
+	builder.WriteString(t.EinvoiceEnvironment)
+	builder.WriteString(", ")
+	builder.WriteString("einvoice_software_id=")
+	builder.WriteString(t.EinvoiceSoftwareID)
+	builder.WriteString(", ")
+	builder.WriteString("einvoice_software_pin=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("einvoice_technical_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("einvoice_certificate=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("einvoice_private_key=<sensitive>")
+	builder.WriteString(", ")
+	if v := t.EinvoiceCertificateExpiresAt; v != nil {
+		builder.WriteString("einvoice_certificate_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
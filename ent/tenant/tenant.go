@@ -3,6 +3,7 @@
 package tenant
 
 import (
+	"Veritasbackend/pkg/money"
 	"time"
 )
 
@@ -17,6 +18,46 @@ const (
 	FieldSlug = "slug"
 	// FieldDomain holds the string denoting the domain field in the database.
 	FieldDomain = "domain"
+	// FieldCostingMethod holds the string denoting the costing_method field in the database.
+	FieldCostingMethod = "costing_method"
+	// FieldAdjustmentApprovalThreshold holds the string denoting the adjustment_approval_threshold field in the database.
+	FieldAdjustmentApprovalThreshold = "adjustment_approval_threshold"
+	// FieldUserDiscountLimit holds the string denoting the user_discount_limit field in the database.
+	FieldUserDiscountLimit = "user_discount_limit"
+	// FieldManagerDiscountLimit holds the string denoting the manager_discount_limit field in the database.
+	FieldManagerDiscountLimit = "manager_discount_limit"
+	// FieldPricesIncludeTax holds the string denoting the prices_include_tax field in the database.
+	FieldPricesIncludeTax = "prices_include_tax"
+	// FieldBaseCurrency holds the string denoting the base_currency field in the database.
+	FieldBaseCurrency = "base_currency"
+	// FieldLegalName holds the string denoting the legal_name field in the database.
+	FieldLegalName = "legal_name"
+	// FieldTaxID holds the string denoting the tax_id field in the database.
+	FieldTaxID = "tax_id"
+	// FieldAddress holds the string denoting the address field in the database.
+	FieldAddress = "address"
+	// FieldPhone holds the string denoting the phone field in the database.
+	FieldPhone = "phone"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldLogo holds the string denoting the logo field in the database.
+	FieldLogo = "logo"
+	// FieldEinvoiceCountry holds the string denoting the einvoice_country field in the database.
+	FieldEinvoiceCountry = "einvoice_country"
+	// FieldEinvoiceEnvironment holds the string denoting the einvoice_environment field in the database.
+	FieldEinvoiceEnvironment = "einvoice_environment"
+	// FieldEinvoiceSoftwareID holds the string denoting the einvoice_software_id field in the database.
+	FieldEinvoiceSoftwareID = "einvoice_software_id"
+	// FieldEinvoiceSoftwarePin holds the string denoting the einvoice_software_pin field in the database.
+	FieldEinvoiceSoftwarePin = "einvoice_software_pin"
+	// FieldEinvoiceTechnicalKey holds the string denoting the einvoice_technical_key field in the database.
+	FieldEinvoiceTechnicalKey = "einvoice_technical_key"
+	// FieldEinvoiceCertificate holds the string denoting the einvoice_certificate field in the database.
+	FieldEinvoiceCertificate = "einvoice_certificate"
+	// FieldEinvoicePrivateKey holds the string denoting the einvoice_private_key field in the database.
+	FieldEinvoicePrivateKey = "einvoice_private_key"
+	// FieldEinvoiceCertificateExpiresAt holds the string denoting the einvoice_certificate_expires_at field in the database.
+	FieldEinvoiceCertificateExpiresAt = "einvoice_certificate_expires_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -31,6 +72,26 @@ var Columns = []string{
 	FieldName,
 	FieldSlug,
 	FieldDomain,
+	FieldCostingMethod,
+	FieldAdjustmentApprovalThreshold,
+	FieldUserDiscountLimit,
+	FieldManagerDiscountLimit,
+	FieldPricesIncludeTax,
+	FieldBaseCurrency,
+	FieldLegalName,
+	FieldTaxID,
+	FieldAddress,
+	FieldPhone,
+	FieldEmail,
+	FieldLogo,
+	FieldEinvoiceCountry,
+	FieldEinvoiceEnvironment,
+	FieldEinvoiceSoftwareID,
+	FieldEinvoiceSoftwarePin,
+	FieldEinvoiceTechnicalKey,
+	FieldEinvoiceCertificate,
+	FieldEinvoicePrivateKey,
+	FieldEinvoiceCertificateExpiresAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -50,6 +111,26 @@ var (
 	NameValidator func(string) error
 	// SlugValidator is a validator for the "slug" field. It is called by the builders before save.
 	SlugValidator func(string) error
+	// DefaultCostingMethod holds the default value on creation for the "costing_method" field.
+	DefaultCostingMethod string
+	// DefaultAdjustmentApprovalThreshold holds the default value on creation for the "adjustment_approval_threshold" field.
+	DefaultAdjustmentApprovalThreshold money.Amount
+	// AdjustmentApprovalThresholdValidator is a validator for the "adjustment_approval_threshold" field. It is called by the builders before save.
+	AdjustmentApprovalThresholdValidator func(int64) error
+	// DefaultUserDiscountLimit holds the default value on creation for the "user_discount_limit" field.
+	DefaultUserDiscountLimit float64
+	// UserDiscountLimitValidator is a validator for the "user_discount_limit" field. It is called by the builders before save.
+	UserDiscountLimitValidator func(float64) error
+	// DefaultManagerDiscountLimit holds the default value on creation for the "manager_discount_limit" field.
+	DefaultManagerDiscountLimit float64
+	// ManagerDiscountLimitValidator is a validator for the "manager_discount_limit" field. It is called by the builders before save.
+	ManagerDiscountLimitValidator func(float64) error
+	// DefaultPricesIncludeTax holds the default value on creation for the "prices_include_tax" field.
+	DefaultPricesIncludeTax bool
+	// DefaultBaseCurrency holds the default value on creation for the "base_currency" field.
+	DefaultBaseCurrency string
+	// DefaultEinvoiceEnvironment holds the default value on creation for the "einvoice_environment" field.
+	DefaultEinvoiceEnvironment string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
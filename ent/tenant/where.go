@@ -4,6 +4,7 @@ package tenant
 
 import (
 	"Veritasbackend/ent/predicate"
+	"Veritasbackend/pkg/money"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -101,6 +102,146 @@ func Domain(v string) predicate.Tenant {
 	})
 }
 
+// CostingMethod applies equality check predicate on the "costing_method" field. It's identical to CostingMethodEQ.
+func CostingMethod(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCostingMethod), v))
+	})
+}
+
+// AdjustmentApprovalThreshold applies equality check predicate on the "adjustment_approval_threshold" field. It's identical to AdjustmentApprovalThresholdEQ.
+func AdjustmentApprovalThreshold(v money.Amount) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAdjustmentApprovalThreshold), v))
+	})
+}
+
+// UserDiscountLimit applies equality check predicate on the "user_discount_limit" field. It's identical to UserDiscountLimitEQ.
+func UserDiscountLimit(v float64) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserDiscountLimit), v))
+	})
+}
+
+// ManagerDiscountLimit applies equality check predicate on the "manager_discount_limit" field. It's identical to ManagerDiscountLimitEQ.
+func ManagerDiscountLimit(v float64) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldManagerDiscountLimit), v))
+	})
+}
+
+// PricesIncludeTax applies equality check predicate on the "prices_include_tax" field. It's identical to PricesIncludeTaxEQ.
+func PricesIncludeTax(v bool) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPricesIncludeTax), v))
+	})
+}
+
+// BaseCurrency applies equality check predicate on the "base_currency" field. It's identical to BaseCurrencyEQ.
+func BaseCurrency(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseCurrency), v))
+	})
+}
+
+// LegalName applies equality check predicate on the "legal_name" field. It's identical to LegalNameEQ.
+func LegalName(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLegalName), v))
+	})
+}
+
+// TaxID applies equality check predicate on the "tax_id" field. It's identical to TaxIDEQ.
+func TaxID(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxID), v))
+	})
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAddress), v))
+	})
+}
+
+// Phone applies equality check predicate on the "phone" field. It's identical to PhoneEQ.
+func Phone(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPhone), v))
+	})
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEmail), v))
+	})
+}
+
+// Logo applies equality check predicate on the "logo" field. It's identical to LogoEQ.
+func Logo(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLogo), v))
+	})
+}
+
+// EinvoiceCountry applies equality check predicate on the "einvoice_country" field. It's identical to EinvoiceCountryEQ.
+func EinvoiceCountry(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoiceCountry), v))
+	})
+}
+
+// EinvoiceEnvironment applies equality check predicate on the "einvoice_environment" field. It's identical to EinvoiceEnvironmentEQ.
+func EinvoiceEnvironment(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoiceEnvironment), v))
+	})
+}
+
+// EinvoiceSoftwareID applies equality check predicate on the "einvoice_software_id" field. It's identical to EinvoiceSoftwareIDEQ.
+func EinvoiceSoftwareID(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoiceSoftwareID), v))
+	})
+}
+
+// EinvoiceSoftwarePin applies equality check predicate on the "einvoice_software_pin" field. It's identical to EinvoiceSoftwarePinEQ.
+func EinvoiceSoftwarePin(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoiceSoftwarePin), v))
+	})
+}
+
+// EinvoiceTechnicalKey applies equality check predicate on the "einvoice_technical_key" field. It's identical to EinvoiceTechnicalKeyEQ.
+func EinvoiceTechnicalKey(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoiceTechnicalKey), v))
+	})
+}
+
+// EinvoiceCertificate applies equality check predicate on the "einvoice_certificate" field. It's identical to EinvoiceCertificateEQ.
+func EinvoiceCertificate(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoiceCertificate), v))
+	})
+}
+
+// EinvoicePrivateKey applies equality check predicate on the "einvoice_private_key" field. It's identical to EinvoicePrivateKeyEQ.
+func EinvoicePrivateKey(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoicePrivateKey), v))
+	})
+}
+
+// EinvoiceCertificateExpiresAt applies equality check predicate on the "einvoice_certificate_expires_at" field. It's identical to EinvoiceCertificateExpiresAtEQ.
+func EinvoiceCertificateExpiresAt(v time.Time) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoiceCertificateExpiresAt), v))
+	})
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Tenant {
 	return predicate.Tenant(func(s *sql.Selector) {
@@ -462,6 +603,2066 @@ func DomainContainsFold(v string) predicate.Tenant {
 	})
 }
 
+// CostingMethodEQ applies the EQ predicate on the "costing_method" field.
+func CostingMethodEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCostingMethod), v))
+	})
+}
+
+// CostingMethodNEQ applies the NEQ predicate on the "costing_method" field.
+func CostingMethodNEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCostingMethod), v))
+	})
+}
+
+// CostingMethodIn applies the In predicate on the "costing_method" field.
+func CostingMethodIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCostingMethod), v...))
+	})
+}
+
+// CostingMethodNotIn applies the NotIn predicate on the "costing_method" field.
+func CostingMethodNotIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCostingMethod), v...))
+	})
+}
+
+// CostingMethodGT applies the GT predicate on the "costing_method" field.
+func CostingMethodGT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCostingMethod), v))
+	})
+}
+
+// CostingMethodGTE applies the GTE predicate on the "costing_method" field.
+func CostingMethodGTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCostingMethod), v))
+	})
+}
+
+// CostingMethodLT applies the LT predicate on the "costing_method" field.
+func CostingMethodLT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCostingMethod), v))
+	})
+}
+
+// CostingMethodLTE applies the LTE predicate on the "costing_method" field.
+func CostingMethodLTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCostingMethod), v))
+	})
+}
+
+// CostingMethodContains applies the Contains predicate on the "costing_method" field.
+func CostingMethodContains(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCostingMethod), v))
+	})
+}
+
+// CostingMethodHasPrefix applies the HasPrefix predicate on the "costing_method" field.
+func CostingMethodHasPrefix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCostingMethod), v))
+	})
+}
+
+// CostingMethodHasSuffix applies the HasSuffix predicate on the "costing_method" field.
+func CostingMethodHasSuffix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCostingMethod), v))
+	})
+}
+
+// CostingMethodEqualFold applies the EqualFold predicate on the "costing_method" field.
+func CostingMethodEqualFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCostingMethod), v))
+	})
+}
+
+// CostingMethodContainsFold applies the ContainsFold predicate on the "costing_method" field.
+func CostingMethodContainsFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCostingMethod), v))
+	})
+}
+
+// AdjustmentApprovalThresholdEQ applies the EQ predicate on the "adjustment_approval_threshold" field.
+func AdjustmentApprovalThresholdEQ(v money.Amount) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAdjustmentApprovalThreshold), v))
+	})
+}
+
+// AdjustmentApprovalThresholdNEQ applies the NEQ predicate on the "adjustment_approval_threshold" field.
+func AdjustmentApprovalThresholdNEQ(v money.Amount) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAdjustmentApprovalThreshold), v))
+	})
+}
+
+// AdjustmentApprovalThresholdIn applies the In predicate on the "adjustment_approval_threshold" field.
+func AdjustmentApprovalThresholdIn(vs ...money.Amount) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAdjustmentApprovalThreshold), v...))
+	})
+}
+
+// AdjustmentApprovalThresholdNotIn applies the NotIn predicate on the "adjustment_approval_threshold" field.
+func AdjustmentApprovalThresholdNotIn(vs ...money.Amount) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAdjustmentApprovalThreshold), v...))
+	})
+}
+
+// AdjustmentApprovalThresholdGT applies the GT predicate on the "adjustment_approval_threshold" field.
+func AdjustmentApprovalThresholdGT(v money.Amount) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAdjustmentApprovalThreshold), v))
+	})
+}
+
+// AdjustmentApprovalThresholdGTE applies the GTE predicate on the "adjustment_approval_threshold" field.
+func AdjustmentApprovalThresholdGTE(v money.Amount) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAdjustmentApprovalThreshold), v))
+	})
+}
+
+// AdjustmentApprovalThresholdLT applies the LT predicate on the "adjustment_approval_threshold" field.
+func AdjustmentApprovalThresholdLT(v money.Amount) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAdjustmentApprovalThreshold), v))
+	})
+}
+
+// AdjustmentApprovalThresholdLTE applies the LTE predicate on the "adjustment_approval_threshold" field.
+func AdjustmentApprovalThresholdLTE(v money.Amount) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAdjustmentApprovalThreshold), v))
+	})
+}
+
+// UserDiscountLimitEQ applies the EQ predicate on the "user_discount_limit" field.
+func UserDiscountLimitEQ(v float64) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserDiscountLimit), v))
+	})
+}
+
+// UserDiscountLimitNEQ applies the NEQ predicate on the "user_discount_limit" field.
+func UserDiscountLimitNEQ(v float64) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserDiscountLimit), v))
+	})
+}
+
+// UserDiscountLimitIn applies the In predicate on the "user_discount_limit" field.
+func UserDiscountLimitIn(vs ...float64) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserDiscountLimit), v...))
+	})
+}
+
+// UserDiscountLimitNotIn applies the NotIn predicate on the "user_discount_limit" field.
+func UserDiscountLimitNotIn(vs ...float64) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserDiscountLimit), v...))
+	})
+}
+
+// UserDiscountLimitGT applies the GT predicate on the "user_discount_limit" field.
+func UserDiscountLimitGT(v float64) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserDiscountLimit), v))
+	})
+}
+
+// UserDiscountLimitGTE applies the GTE predicate on the "user_discount_limit" field.
+func UserDiscountLimitGTE(v float64) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserDiscountLimit), v))
+	})
+}
+
+// UserDiscountLimitLT applies the LT predicate on the "user_discount_limit" field.
+func UserDiscountLimitLT(v float64) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserDiscountLimit), v))
+	})
+}
+
+// UserDiscountLimitLTE applies the LTE predicate on the "user_discount_limit" field.
+func UserDiscountLimitLTE(v float64) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserDiscountLimit), v))
+	})
+}
+
+// ManagerDiscountLimitEQ applies the EQ predicate on the "manager_discount_limit" field.
+func ManagerDiscountLimitEQ(v float64) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldManagerDiscountLimit), v))
+	})
+}
+
+// ManagerDiscountLimitNEQ applies the NEQ predicate on the "manager_discount_limit" field.
+func ManagerDiscountLimitNEQ(v float64) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldManagerDiscountLimit), v))
+	})
+}
+
+// ManagerDiscountLimitIn applies the In predicate on the "manager_discount_limit" field.
+func ManagerDiscountLimitIn(vs ...float64) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldManagerDiscountLimit), v...))
+	})
+}
+
+// ManagerDiscountLimitNotIn applies the NotIn predicate on the "manager_discount_limit" field.
+func ManagerDiscountLimitNotIn(vs ...float64) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldManagerDiscountLimit), v...))
+	})
+}
+
+// ManagerDiscountLimitGT applies the GT predicate on the "manager_discount_limit" field.
+func ManagerDiscountLimitGT(v float64) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldManagerDiscountLimit), v))
+	})
+}
+
+// ManagerDiscountLimitGTE applies the GTE predicate on the "manager_discount_limit" field.
+func ManagerDiscountLimitGTE(v float64) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldManagerDiscountLimit), v))
+	})
+}
+
+// ManagerDiscountLimitLT applies the LT predicate on the "manager_discount_limit" field.
+func ManagerDiscountLimitLT(v float64) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldManagerDiscountLimit), v))
+	})
+}
+
+// ManagerDiscountLimitLTE applies the LTE predicate on the "manager_discount_limit" field.
+func ManagerDiscountLimitLTE(v float64) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldManagerDiscountLimit), v))
+	})
+}
+
+// PricesIncludeTaxEQ applies the EQ predicate on the "prices_include_tax" field.
+func PricesIncludeTaxEQ(v bool) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPricesIncludeTax), v))
+	})
+}
+
+// PricesIncludeTaxNEQ applies the NEQ predicate on the "prices_include_tax" field.
+func PricesIncludeTaxNEQ(v bool) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPricesIncludeTax), v))
+	})
+}
+
+// BaseCurrencyEQ applies the EQ predicate on the "base_currency" field.
+func BaseCurrencyEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaseCurrency), v))
+	})
+}
+
+// BaseCurrencyNEQ applies the NEQ predicate on the "base_currency" field.
+func BaseCurrencyNEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBaseCurrency), v))
+	})
+}
+
+// BaseCurrencyIn applies the In predicate on the "base_currency" field.
+func BaseCurrencyIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBaseCurrency), v...))
+	})
+}
+
+// BaseCurrencyNotIn applies the NotIn predicate on the "base_currency" field.
+func BaseCurrencyNotIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBaseCurrency), v...))
+	})
+}
+
+// BaseCurrencyGT applies the GT predicate on the "base_currency" field.
+func BaseCurrencyGT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBaseCurrency), v))
+	})
+}
+
+// BaseCurrencyGTE applies the GTE predicate on the "base_currency" field.
+func BaseCurrencyGTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBaseCurrency), v))
+	})
+}
+
+// BaseCurrencyLT applies the LT predicate on the "base_currency" field.
+func BaseCurrencyLT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBaseCurrency), v))
+	})
+}
+
+// BaseCurrencyLTE applies the LTE predicate on the "base_currency" field.
+func BaseCurrencyLTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBaseCurrency), v))
+	})
+}
+
+// BaseCurrencyContains applies the Contains predicate on the "base_currency" field.
+func BaseCurrencyContains(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldBaseCurrency), v))
+	})
+}
+
+// BaseCurrencyHasPrefix applies the HasPrefix predicate on the "base_currency" field.
+func BaseCurrencyHasPrefix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldBaseCurrency), v))
+	})
+}
+
+// BaseCurrencyHasSuffix applies the HasSuffix predicate on the "base_currency" field.
+func BaseCurrencyHasSuffix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldBaseCurrency), v))
+	})
+}
+
+// BaseCurrencyEqualFold applies the EqualFold predicate on the "base_currency" field.
+func BaseCurrencyEqualFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldBaseCurrency), v))
+	})
+}
+
+// BaseCurrencyContainsFold applies the ContainsFold predicate on the "base_currency" field.
+func BaseCurrencyContainsFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldBaseCurrency), v))
+	})
+}
+
+// LegalNameEQ applies the EQ predicate on the "legal_name" field.
+func LegalNameEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLegalName), v))
+	})
+}
+
+// LegalNameNEQ applies the NEQ predicate on the "legal_name" field.
+func LegalNameNEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLegalName), v))
+	})
+}
+
+// LegalNameIn applies the In predicate on the "legal_name" field.
+func LegalNameIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLegalName), v...))
+	})
+}
+
+// LegalNameNotIn applies the NotIn predicate on the "legal_name" field.
+func LegalNameNotIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLegalName), v...))
+	})
+}
+
+// LegalNameGT applies the GT predicate on the "legal_name" field.
+func LegalNameGT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLegalName), v))
+	})
+}
+
+// LegalNameGTE applies the GTE predicate on the "legal_name" field.
+func LegalNameGTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLegalName), v))
+	})
+}
+
+// LegalNameLT applies the LT predicate on the "legal_name" field.
+func LegalNameLT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLegalName), v))
+	})
+}
+
+// LegalNameLTE applies the LTE predicate on the "legal_name" field.
+func LegalNameLTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLegalName), v))
+	})
+}
+
+// LegalNameContains applies the Contains predicate on the "legal_name" field.
+func LegalNameContains(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLegalName), v))
+	})
+}
+
+// LegalNameHasPrefix applies the HasPrefix predicate on the "legal_name" field.
+func LegalNameHasPrefix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLegalName), v))
+	})
+}
+
+// LegalNameHasSuffix applies the HasSuffix predicate on the "legal_name" field.
+func LegalNameHasSuffix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLegalName), v))
+	})
+}
+
+// LegalNameIsNil applies the IsNil predicate on the "legal_name" field.
+func LegalNameIsNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLegalName)))
+	})
+}
+
+// LegalNameNotNil applies the NotNil predicate on the "legal_name" field.
+func LegalNameNotNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLegalName)))
+	})
+}
+
+// LegalNameEqualFold applies the EqualFold predicate on the "legal_name" field.
+func LegalNameEqualFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLegalName), v))
+	})
+}
+
+// LegalNameContainsFold applies the ContainsFold predicate on the "legal_name" field.
+func LegalNameContainsFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLegalName), v))
+	})
+}
+
+// TaxIDEQ applies the EQ predicate on the "tax_id" field.
+func TaxIDEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxID), v))
+	})
+}
+
+// TaxIDNEQ applies the NEQ predicate on the "tax_id" field.
+func TaxIDNEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxID), v))
+	})
+}
+
+// TaxIDIn applies the In predicate on the "tax_id" field.
+func TaxIDIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTaxID), v...))
+	})
+}
+
+// TaxIDNotIn applies the NotIn predicate on the "tax_id" field.
+func TaxIDNotIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxID), v...))
+	})
+}
+
+// TaxIDGT applies the GT predicate on the "tax_id" field.
+func TaxIDGT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTaxID), v))
+	})
+}
+
+// TaxIDGTE applies the GTE predicate on the "tax_id" field.
+func TaxIDGTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTaxID), v))
+	})
+}
+
+// TaxIDLT applies the LT predicate on the "tax_id" field.
+func TaxIDLT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTaxID), v))
+	})
+}
+
+// TaxIDLTE applies the LTE predicate on the "tax_id" field.
+func TaxIDLTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTaxID), v))
+	})
+}
+
+// TaxIDContains applies the Contains predicate on the "tax_id" field.
+func TaxIDContains(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTaxID), v))
+	})
+}
+
+// TaxIDHasPrefix applies the HasPrefix predicate on the "tax_id" field.
+func TaxIDHasPrefix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTaxID), v))
+	})
+}
+
+// TaxIDHasSuffix applies the HasSuffix predicate on the "tax_id" field.
+func TaxIDHasSuffix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTaxID), v))
+	})
+}
+
+// TaxIDIsNil applies the IsNil predicate on the "tax_id" field.
+func TaxIDIsNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTaxID)))
+	})
+}
+
+// TaxIDNotNil applies the NotNil predicate on the "tax_id" field.
+func TaxIDNotNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTaxID)))
+	})
+}
+
+// TaxIDEqualFold applies the EqualFold predicate on the "tax_id" field.
+func TaxIDEqualFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTaxID), v))
+	})
+}
+
+// TaxIDContainsFold applies the ContainsFold predicate on the "tax_id" field.
+func TaxIDContainsFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTaxID), v))
+	})
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAddress), v))
+	})
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAddress), v))
+	})
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAddress), v...))
+	})
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAddress), v...))
+	})
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAddress), v))
+	})
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAddress), v))
+	})
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAddress), v))
+	})
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAddress), v))
+	})
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAddress), v))
+	})
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAddress), v))
+	})
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAddress), v))
+	})
+}
+
+// AddressIsNil applies the IsNil predicate on the "address" field.
+func AddressIsNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAddress)))
+	})
+}
+
+// AddressNotNil applies the NotNil predicate on the "address" field.
+func AddressNotNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAddress)))
+	})
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAddress), v))
+	})
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAddress), v))
+	})
+}
+
+// PhoneEQ applies the EQ predicate on the "phone" field.
+func PhoneEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneNEQ applies the NEQ predicate on the "phone" field.
+func PhoneNEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneIn applies the In predicate on the "phone" field.
+func PhoneIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPhone), v...))
+	})
+}
+
+// PhoneNotIn applies the NotIn predicate on the "phone" field.
+func PhoneNotIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPhone), v...))
+	})
+}
+
+// PhoneGT applies the GT predicate on the "phone" field.
+func PhoneGT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneGTE applies the GTE predicate on the "phone" field.
+func PhoneGTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneLT applies the LT predicate on the "phone" field.
+func PhoneLT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneLTE applies the LTE predicate on the "phone" field.
+func PhoneLTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneContains applies the Contains predicate on the "phone" field.
+func PhoneContains(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
+func PhoneHasPrefix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
+func PhoneHasSuffix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneIsNil applies the IsNil predicate on the "phone" field.
+func PhoneIsNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPhone)))
+	})
+}
+
+// PhoneNotNil applies the NotNil predicate on the "phone" field.
+func PhoneNotNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPhone)))
+	})
+}
+
+// PhoneEqualFold applies the EqualFold predicate on the "phone" field.
+func PhoneEqualFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneContainsFold applies the ContainsFold predicate on the "phone" field.
+func PhoneContainsFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPhone), v))
+	})
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEmail), v))
+	})
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEmail), v))
+	})
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEmail), v...))
+	})
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEmail), v...))
+	})
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEmail), v))
+	})
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEmail), v))
+	})
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEmail), v))
+	})
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEmail), v))
+	})
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEmail), v))
+	})
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEmail), v))
+	})
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEmail), v))
+	})
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEmail)))
+	})
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEmail)))
+	})
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEmail), v))
+	})
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEmail), v))
+	})
+}
+
+// LogoEQ applies the EQ predicate on the "logo" field.
+func LogoEQ(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLogo), v))
+	})
+}
+
+// LogoNEQ applies the NEQ predicate on the "logo" field.
+func LogoNEQ(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLogo), v))
+	})
+}
+
+// LogoIn applies the In predicate on the "logo" field.
+func LogoIn(vs ...[]byte) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLogo), v...))
+	})
+}
+
+// LogoNotIn applies the NotIn predicate on the "logo" field.
+func LogoNotIn(vs ...[]byte) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLogo), v...))
+	})
+}
+
+// LogoGT applies the GT predicate on the "logo" field.
+func LogoGT(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLogo), v))
+	})
+}
+
+// LogoGTE applies the GTE predicate on the "logo" field.
+func LogoGTE(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLogo), v))
+	})
+}
+
+// LogoLT applies the LT predicate on the "logo" field.
+func LogoLT(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLogo), v))
+	})
+}
+
+// LogoLTE applies the LTE predicate on the "logo" field.
+func LogoLTE(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLogo), v))
+	})
+}
+
+// LogoIsNil applies the IsNil predicate on the "logo" field.
+func LogoIsNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLogo)))
+	})
+}
+
+// LogoNotNil applies the NotNil predicate on the "logo" field.
+func LogoNotNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLogo)))
+	})
+}
+
+// EinvoiceCountryEQ applies the EQ predicate on the "einvoice_country" field.
+func EinvoiceCountryEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoiceCountry), v))
+	})
+}
+
+// EinvoiceCountryNEQ applies the NEQ predicate on the "einvoice_country" field.
+func EinvoiceCountryNEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEinvoiceCountry), v))
+	})
+}
+
+// EinvoiceCountryIn applies the In predicate on the "einvoice_country" field.
+func EinvoiceCountryIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEinvoiceCountry), v...))
+	})
+}
+
+// EinvoiceCountryNotIn applies the NotIn predicate on the "einvoice_country" field.
+func EinvoiceCountryNotIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEinvoiceCountry), v...))
+	})
+}
+
+// EinvoiceCountryGT applies the GT predicate on the "einvoice_country" field.
+func EinvoiceCountryGT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEinvoiceCountry), v))
+	})
+}
+
+// EinvoiceCountryGTE applies the GTE predicate on the "einvoice_country" field.
+func EinvoiceCountryGTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEinvoiceCountry), v))
+	})
+}
+
+// EinvoiceCountryLT applies the LT predicate on the "einvoice_country" field.
+func EinvoiceCountryLT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEinvoiceCountry), v))
+	})
+}
+
+// EinvoiceCountryLTE applies the LTE predicate on the "einvoice_country" field.
+func EinvoiceCountryLTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEinvoiceCountry), v))
+	})
+}
+
+// EinvoiceCountryContains applies the Contains predicate on the "einvoice_country" field.
+func EinvoiceCountryContains(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEinvoiceCountry), v))
+	})
+}
+
+// EinvoiceCountryHasPrefix applies the HasPrefix predicate on the "einvoice_country" field.
+func EinvoiceCountryHasPrefix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEinvoiceCountry), v))
+	})
+}
+
+// EinvoiceCountryHasSuffix applies the HasSuffix predicate on the "einvoice_country" field.
+func EinvoiceCountryHasSuffix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEinvoiceCountry), v))
+	})
+}
+
+// EinvoiceCountryIsNil applies the IsNil predicate on the "einvoice_country" field.
+func EinvoiceCountryIsNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEinvoiceCountry)))
+	})
+}
+
+// EinvoiceCountryNotNil applies the NotNil predicate on the "einvoice_country" field.
+func EinvoiceCountryNotNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEinvoiceCountry)))
+	})
+}
+
+// EinvoiceCountryEqualFold applies the EqualFold predicate on the "einvoice_country" field.
+func EinvoiceCountryEqualFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEinvoiceCountry), v))
+	})
+}
+
+// EinvoiceCountryContainsFold applies the ContainsFold predicate on the "einvoice_country" field.
+func EinvoiceCountryContainsFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEinvoiceCountry), v))
+	})
+}
+
+// EinvoiceEnvironmentEQ applies the EQ predicate on the "einvoice_environment" field.
+func EinvoiceEnvironmentEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoiceEnvironment), v))
+	})
+}
+
+// EinvoiceEnvironmentNEQ applies the NEQ predicate on the "einvoice_environment" field.
+func EinvoiceEnvironmentNEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEinvoiceEnvironment), v))
+	})
+}
+
+// EinvoiceEnvironmentIn applies the In predicate on the "einvoice_environment" field.
+func EinvoiceEnvironmentIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEinvoiceEnvironment), v...))
+	})
+}
+
+// EinvoiceEnvironmentNotIn applies the NotIn predicate on the "einvoice_environment" field.
+func EinvoiceEnvironmentNotIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEinvoiceEnvironment), v...))
+	})
+}
+
+// EinvoiceEnvironmentGT applies the GT predicate on the "einvoice_environment" field.
+func EinvoiceEnvironmentGT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEinvoiceEnvironment), v))
+	})
+}
+
+// EinvoiceEnvironmentGTE applies the GTE predicate on the "einvoice_environment" field.
+func EinvoiceEnvironmentGTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEinvoiceEnvironment), v))
+	})
+}
+
+// EinvoiceEnvironmentLT applies the LT predicate on the "einvoice_environment" field.
+func EinvoiceEnvironmentLT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEinvoiceEnvironment), v))
+	})
+}
+
+// EinvoiceEnvironmentLTE applies the LTE predicate on the "einvoice_environment" field.
+func EinvoiceEnvironmentLTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEinvoiceEnvironment), v))
+	})
+}
+
+// EinvoiceEnvironmentContains applies the Contains predicate on the "einvoice_environment" field.
+func EinvoiceEnvironmentContains(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEinvoiceEnvironment), v))
+	})
+}
+
+// EinvoiceEnvironmentHasPrefix applies the HasPrefix predicate on the "einvoice_environment" field.
+func EinvoiceEnvironmentHasPrefix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEinvoiceEnvironment), v))
+	})
+}
+
+// EinvoiceEnvironmentHasSuffix applies the HasSuffix predicate on the "einvoice_environment" field.
+func EinvoiceEnvironmentHasSuffix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEinvoiceEnvironment), v))
+	})
+}
+
+// EinvoiceEnvironmentEqualFold applies the EqualFold predicate on the "einvoice_environment" field.
+func EinvoiceEnvironmentEqualFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEinvoiceEnvironment), v))
+	})
+}
+
+// EinvoiceEnvironmentContainsFold applies the ContainsFold predicate on the "einvoice_environment" field.
+func EinvoiceEnvironmentContainsFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEinvoiceEnvironment), v))
+	})
+}
+
+// EinvoiceSoftwareIDEQ applies the EQ predicate on the "einvoice_software_id" field.
+func EinvoiceSoftwareIDEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoiceSoftwareID), v))
+	})
+}
+
+// EinvoiceSoftwareIDNEQ applies the NEQ predicate on the "einvoice_software_id" field.
+func EinvoiceSoftwareIDNEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEinvoiceSoftwareID), v))
+	})
+}
+
+// EinvoiceSoftwareIDIn applies the In predicate on the "einvoice_software_id" field.
+func EinvoiceSoftwareIDIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEinvoiceSoftwareID), v...))
+	})
+}
+
+// EinvoiceSoftwareIDNotIn applies the NotIn predicate on the "einvoice_software_id" field.
+func EinvoiceSoftwareIDNotIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEinvoiceSoftwareID), v...))
+	})
+}
+
+// EinvoiceSoftwareIDGT applies the GT predicate on the "einvoice_software_id" field.
+func EinvoiceSoftwareIDGT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEinvoiceSoftwareID), v))
+	})
+}
+
+// EinvoiceSoftwareIDGTE applies the GTE predicate on the "einvoice_software_id" field.
+func EinvoiceSoftwareIDGTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEinvoiceSoftwareID), v))
+	})
+}
+
+// EinvoiceSoftwareIDLT applies the LT predicate on the "einvoice_software_id" field.
+func EinvoiceSoftwareIDLT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEinvoiceSoftwareID), v))
+	})
+}
+
+// EinvoiceSoftwareIDLTE applies the LTE predicate on the "einvoice_software_id" field.
+func EinvoiceSoftwareIDLTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEinvoiceSoftwareID), v))
+	})
+}
+
+// EinvoiceSoftwareIDContains applies the Contains predicate on the "einvoice_software_id" field.
+func EinvoiceSoftwareIDContains(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEinvoiceSoftwareID), v))
+	})
+}
+
+// EinvoiceSoftwareIDHasPrefix applies the HasPrefix predicate on the "einvoice_software_id" field.
+func EinvoiceSoftwareIDHasPrefix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEinvoiceSoftwareID), v))
+	})
+}
+
+// EinvoiceSoftwareIDHasSuffix applies the HasSuffix predicate on the "einvoice_software_id" field.
+func EinvoiceSoftwareIDHasSuffix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEinvoiceSoftwareID), v))
+	})
+}
+
+// EinvoiceSoftwareIDIsNil applies the IsNil predicate on the "einvoice_software_id" field.
+func EinvoiceSoftwareIDIsNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEinvoiceSoftwareID)))
+	})
+}
+
+// EinvoiceSoftwareIDNotNil applies the NotNil predicate on the "einvoice_software_id" field.
+func EinvoiceSoftwareIDNotNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEinvoiceSoftwareID)))
+	})
+}
+
+// EinvoiceSoftwareIDEqualFold applies the EqualFold predicate on the "einvoice_software_id" field.
+func EinvoiceSoftwareIDEqualFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEinvoiceSoftwareID), v))
+	})
+}
+
+// EinvoiceSoftwareIDContainsFold applies the ContainsFold predicate on the "einvoice_software_id" field.
+func EinvoiceSoftwareIDContainsFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEinvoiceSoftwareID), v))
+	})
+}
+
+// EinvoiceSoftwarePinEQ applies the EQ predicate on the "einvoice_software_pin" field.
+func EinvoiceSoftwarePinEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoiceSoftwarePin), v))
+	})
+}
+
+// EinvoiceSoftwarePinNEQ applies the NEQ predicate on the "einvoice_software_pin" field.
+func EinvoiceSoftwarePinNEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEinvoiceSoftwarePin), v))
+	})
+}
+
+// EinvoiceSoftwarePinIn applies the In predicate on the "einvoice_software_pin" field.
+func EinvoiceSoftwarePinIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEinvoiceSoftwarePin), v...))
+	})
+}
+
+// EinvoiceSoftwarePinNotIn applies the NotIn predicate on the "einvoice_software_pin" field.
+func EinvoiceSoftwarePinNotIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEinvoiceSoftwarePin), v...))
+	})
+}
+
+// EinvoiceSoftwarePinGT applies the GT predicate on the "einvoice_software_pin" field.
+func EinvoiceSoftwarePinGT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEinvoiceSoftwarePin), v))
+	})
+}
+
+// EinvoiceSoftwarePinGTE applies the GTE predicate on the "einvoice_software_pin" field.
+func EinvoiceSoftwarePinGTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEinvoiceSoftwarePin), v))
+	})
+}
+
+// EinvoiceSoftwarePinLT applies the LT predicate on the "einvoice_software_pin" field.
+func EinvoiceSoftwarePinLT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEinvoiceSoftwarePin), v))
+	})
+}
+
+// EinvoiceSoftwarePinLTE applies the LTE predicate on the "einvoice_software_pin" field.
+func EinvoiceSoftwarePinLTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEinvoiceSoftwarePin), v))
+	})
+}
+
+// EinvoiceSoftwarePinContains applies the Contains predicate on the "einvoice_software_pin" field.
+func EinvoiceSoftwarePinContains(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEinvoiceSoftwarePin), v))
+	})
+}
+
+// EinvoiceSoftwarePinHasPrefix applies the HasPrefix predicate on the "einvoice_software_pin" field.
+func EinvoiceSoftwarePinHasPrefix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEinvoiceSoftwarePin), v))
+	})
+}
+
+// EinvoiceSoftwarePinHasSuffix applies the HasSuffix predicate on the "einvoice_software_pin" field.
+func EinvoiceSoftwarePinHasSuffix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEinvoiceSoftwarePin), v))
+	})
+}
+
+// EinvoiceSoftwarePinIsNil applies the IsNil predicate on the "einvoice_software_pin" field.
+func EinvoiceSoftwarePinIsNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEinvoiceSoftwarePin)))
+	})
+}
+
+// EinvoiceSoftwarePinNotNil applies the NotNil predicate on the "einvoice_software_pin" field.
+func EinvoiceSoftwarePinNotNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEinvoiceSoftwarePin)))
+	})
+}
+
+// EinvoiceSoftwarePinEqualFold applies the EqualFold predicate on the "einvoice_software_pin" field.
+func EinvoiceSoftwarePinEqualFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEinvoiceSoftwarePin), v))
+	})
+}
+
+// EinvoiceSoftwarePinContainsFold applies the ContainsFold predicate on the "einvoice_software_pin" field.
+func EinvoiceSoftwarePinContainsFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEinvoiceSoftwarePin), v))
+	})
+}
+
+// EinvoiceTechnicalKeyEQ applies the EQ predicate on the "einvoice_technical_key" field.
+func EinvoiceTechnicalKeyEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoiceTechnicalKey), v))
+	})
+}
+
+// EinvoiceTechnicalKeyNEQ applies the NEQ predicate on the "einvoice_technical_key" field.
+func EinvoiceTechnicalKeyNEQ(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEinvoiceTechnicalKey), v))
+	})
+}
+
+// EinvoiceTechnicalKeyIn applies the In predicate on the "einvoice_technical_key" field.
+func EinvoiceTechnicalKeyIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEinvoiceTechnicalKey), v...))
+	})
+}
+
+// EinvoiceTechnicalKeyNotIn applies the NotIn predicate on the "einvoice_technical_key" field.
+func EinvoiceTechnicalKeyNotIn(vs ...string) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEinvoiceTechnicalKey), v...))
+	})
+}
+
+// EinvoiceTechnicalKeyGT applies the GT predicate on the "einvoice_technical_key" field.
+func EinvoiceTechnicalKeyGT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEinvoiceTechnicalKey), v))
+	})
+}
+
+// EinvoiceTechnicalKeyGTE applies the GTE predicate on the "einvoice_technical_key" field.
+func EinvoiceTechnicalKeyGTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEinvoiceTechnicalKey), v))
+	})
+}
+
+// EinvoiceTechnicalKeyLT applies the LT predicate on the "einvoice_technical_key" field.
+func EinvoiceTechnicalKeyLT(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEinvoiceTechnicalKey), v))
+	})
+}
+
+// EinvoiceTechnicalKeyLTE applies the LTE predicate on the "einvoice_technical_key" field.
+func EinvoiceTechnicalKeyLTE(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEinvoiceTechnicalKey), v))
+	})
+}
+
+// EinvoiceTechnicalKeyContains applies the Contains predicate on the "einvoice_technical_key" field.
+func EinvoiceTechnicalKeyContains(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEinvoiceTechnicalKey), v))
+	})
+}
+
+// EinvoiceTechnicalKeyHasPrefix applies the HasPrefix predicate on the "einvoice_technical_key" field.
+func EinvoiceTechnicalKeyHasPrefix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEinvoiceTechnicalKey), v))
+	})
+}
+
+// EinvoiceTechnicalKeyHasSuffix applies the HasSuffix predicate on the "einvoice_technical_key" field.
+func EinvoiceTechnicalKeyHasSuffix(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEinvoiceTechnicalKey), v))
+	})
+}
+
+// EinvoiceTechnicalKeyIsNil applies the IsNil predicate on the "einvoice_technical_key" field.
+func EinvoiceTechnicalKeyIsNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEinvoiceTechnicalKey)))
+	})
+}
+
+// EinvoiceTechnicalKeyNotNil applies the NotNil predicate on the "einvoice_technical_key" field.
+func EinvoiceTechnicalKeyNotNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEinvoiceTechnicalKey)))
+	})
+}
+
+// EinvoiceTechnicalKeyEqualFold applies the EqualFold predicate on the "einvoice_technical_key" field.
+func EinvoiceTechnicalKeyEqualFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEinvoiceTechnicalKey), v))
+	})
+}
+
+// EinvoiceTechnicalKeyContainsFold applies the ContainsFold predicate on the "einvoice_technical_key" field.
+func EinvoiceTechnicalKeyContainsFold(v string) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEinvoiceTechnicalKey), v))
+	})
+}
+
+// EinvoiceCertificateEQ applies the EQ predicate on the "einvoice_certificate" field.
+func EinvoiceCertificateEQ(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoiceCertificate), v))
+	})
+}
+
+// EinvoiceCertificateNEQ applies the NEQ predicate on the "einvoice_certificate" field.
+func EinvoiceCertificateNEQ(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEinvoiceCertificate), v))
+	})
+}
+
+// EinvoiceCertificateIn applies the In predicate on the "einvoice_certificate" field.
+func EinvoiceCertificateIn(vs ...[]byte) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEinvoiceCertificate), v...))
+	})
+}
+
+// EinvoiceCertificateNotIn applies the NotIn predicate on the "einvoice_certificate" field.
+func EinvoiceCertificateNotIn(vs ...[]byte) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEinvoiceCertificate), v...))
+	})
+}
+
+// EinvoiceCertificateGT applies the GT predicate on the "einvoice_certificate" field.
+func EinvoiceCertificateGT(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEinvoiceCertificate), v))
+	})
+}
+
+// EinvoiceCertificateGTE applies the GTE predicate on the "einvoice_certificate" field.
+func EinvoiceCertificateGTE(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEinvoiceCertificate), v))
+	})
+}
+
+// EinvoiceCertificateLT applies the LT predicate on the "einvoice_certificate" field.
+func EinvoiceCertificateLT(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEinvoiceCertificate), v))
+	})
+}
+
+// EinvoiceCertificateLTE applies the LTE predicate on the "einvoice_certificate" field.
+func EinvoiceCertificateLTE(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEinvoiceCertificate), v))
+	})
+}
+
+// EinvoiceCertificateIsNil applies the IsNil predicate on the "einvoice_certificate" field.
+func EinvoiceCertificateIsNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEinvoiceCertificate)))
+	})
+}
+
+// EinvoiceCertificateNotNil applies the NotNil predicate on the "einvoice_certificate" field.
+func EinvoiceCertificateNotNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEinvoiceCertificate)))
+	})
+}
+
+// EinvoicePrivateKeyEQ applies the EQ predicate on the "einvoice_private_key" field.
+func EinvoicePrivateKeyEQ(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoicePrivateKey), v))
+	})
+}
+
+// EinvoicePrivateKeyNEQ applies the NEQ predicate on the "einvoice_private_key" field.
+func EinvoicePrivateKeyNEQ(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEinvoicePrivateKey), v))
+	})
+}
+
+// EinvoicePrivateKeyIn applies the In predicate on the "einvoice_private_key" field.
+func EinvoicePrivateKeyIn(vs ...[]byte) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEinvoicePrivateKey), v...))
+	})
+}
+
+// EinvoicePrivateKeyNotIn applies the NotIn predicate on the "einvoice_private_key" field.
+func EinvoicePrivateKeyNotIn(vs ...[]byte) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEinvoicePrivateKey), v...))
+	})
+}
+
+// EinvoicePrivateKeyGT applies the GT predicate on the "einvoice_private_key" field.
+func EinvoicePrivateKeyGT(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEinvoicePrivateKey), v))
+	})
+}
+
+// EinvoicePrivateKeyGTE applies the GTE predicate on the "einvoice_private_key" field.
+func EinvoicePrivateKeyGTE(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEinvoicePrivateKey), v))
+	})
+}
+
+// EinvoicePrivateKeyLT applies the LT predicate on the "einvoice_private_key" field.
+func EinvoicePrivateKeyLT(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEinvoicePrivateKey), v))
+	})
+}
+
+// EinvoicePrivateKeyLTE applies the LTE predicate on the "einvoice_private_key" field.
+func EinvoicePrivateKeyLTE(v []byte) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEinvoicePrivateKey), v))
+	})
+}
+
+// EinvoicePrivateKeyIsNil applies the IsNil predicate on the "einvoice_private_key" field.
+func EinvoicePrivateKeyIsNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEinvoicePrivateKey)))
+	})
+}
+
+// EinvoicePrivateKeyNotNil applies the NotNil predicate on the "einvoice_private_key" field.
+func EinvoicePrivateKeyNotNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEinvoicePrivateKey)))
+	})
+}
+
+// EinvoiceCertificateExpiresAtEQ applies the EQ predicate on the "einvoice_certificate_expires_at" field.
+func EinvoiceCertificateExpiresAtEQ(v time.Time) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEinvoiceCertificateExpiresAt), v))
+	})
+}
+
+// EinvoiceCertificateExpiresAtNEQ applies the NEQ predicate on the "einvoice_certificate_expires_at" field.
+func EinvoiceCertificateExpiresAtNEQ(v time.Time) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEinvoiceCertificateExpiresAt), v))
+	})
+}
+
+// EinvoiceCertificateExpiresAtIn applies the In predicate on the "einvoice_certificate_expires_at" field.
+func EinvoiceCertificateExpiresAtIn(vs ...time.Time) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEinvoiceCertificateExpiresAt), v...))
+	})
+}
+
+// EinvoiceCertificateExpiresAtNotIn applies the NotIn predicate on the "einvoice_certificate_expires_at" field.
+func EinvoiceCertificateExpiresAtNotIn(vs ...time.Time) predicate.Tenant {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Tenant(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEinvoiceCertificateExpiresAt), v...))
+	})
+}
+
+// EinvoiceCertificateExpiresAtGT applies the GT predicate on the "einvoice_certificate_expires_at" field.
+func EinvoiceCertificateExpiresAtGT(v time.Time) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEinvoiceCertificateExpiresAt), v))
+	})
+}
+
+// EinvoiceCertificateExpiresAtGTE applies the GTE predicate on the "einvoice_certificate_expires_at" field.
+func EinvoiceCertificateExpiresAtGTE(v time.Time) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEinvoiceCertificateExpiresAt), v))
+	})
+}
+
+// EinvoiceCertificateExpiresAtLT applies the LT predicate on the "einvoice_certificate_expires_at" field.
+func EinvoiceCertificateExpiresAtLT(v time.Time) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEinvoiceCertificateExpiresAt), v))
+	})
+}
+
+// EinvoiceCertificateExpiresAtLTE applies the LTE predicate on the "einvoice_certificate_expires_at" field.
+func EinvoiceCertificateExpiresAtLTE(v time.Time) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEinvoiceCertificateExpiresAt), v))
+	})
+}
+
+// EinvoiceCertificateExpiresAtIsNil applies the IsNil predicate on the "einvoice_certificate_expires_at" field.
+func EinvoiceCertificateExpiresAtIsNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEinvoiceCertificateExpiresAt)))
+	})
+}
+
+// EinvoiceCertificateExpiresAtNotNil applies the NotNil predicate on the "einvoice_certificate_expires_at" field.
+func EinvoiceCertificateExpiresAtNotNil() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEinvoiceCertificateExpiresAt)))
+	})
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Tenant {
 	return predicate.Tenant(func(s *sql.Selector) {
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"Veritasbackend/ent/tenant"
+	"Veritasbackend/pkg/money"
 	"context"
 	"errors"
 	"fmt"
@@ -46,6 +47,262 @@ func (tc *TenantCreate) SetNillableDomain(s *string) *TenantCreate {
 	return tc
 }
 
+// SetCostingMethod sets the "costing_method" field.
+func (tc *TenantCreate) SetCostingMethod(s string) *TenantCreate {
+	tc.mutation.SetCostingMethod(s)
+	return tc
+}
+
+// SetNillableCostingMethod sets the "costing_method" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableCostingMethod(s *string) *TenantCreate {
+	if s != nil {
+		tc.SetCostingMethod(*s)
+	}
+	return tc
+}
+
+// SetAdjustmentApprovalThreshold sets the "adjustment_approval_threshold" field.
+func (tc *TenantCreate) SetAdjustmentApprovalThreshold(m money.Amount) *TenantCreate {
+	tc.mutation.SetAdjustmentApprovalThreshold(m)
+	return tc
+}
+
+// SetNillableAdjustmentApprovalThreshold sets the "adjustment_approval_threshold" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableAdjustmentApprovalThreshold(m *money.Amount) *TenantCreate {
+	if m != nil {
+		tc.SetAdjustmentApprovalThreshold(*m)
+	}
+	return tc
+}
+
+// SetUserDiscountLimit sets the "user_discount_limit" field.
+func (tc *TenantCreate) SetUserDiscountLimit(f float64) *TenantCreate {
+	tc.mutation.SetUserDiscountLimit(f)
+	return tc
+}
+
+// SetNillableUserDiscountLimit sets the "user_discount_limit" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableUserDiscountLimit(f *float64) *TenantCreate {
+	if f != nil {
+		tc.SetUserDiscountLimit(*f)
+	}
+	return tc
+}
+
+// SetManagerDiscountLimit sets the "manager_discount_limit" field.
+func (tc *TenantCreate) SetManagerDiscountLimit(f float64) *TenantCreate {
+	tc.mutation.SetManagerDiscountLimit(f)
+	return tc
+}
+
+// SetNillableManagerDiscountLimit sets the "manager_discount_limit" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableManagerDiscountLimit(f *float64) *TenantCreate {
+	if f != nil {
+		tc.SetManagerDiscountLimit(*f)
+	}
+	return tc
+}
+
+// SetPricesIncludeTax sets the "prices_include_tax" field.
+func (tc *TenantCreate) SetPricesIncludeTax(b bool) *TenantCreate {
+	tc.mutation.SetPricesIncludeTax(b)
+	return tc
+}
+
+// SetNillablePricesIncludeTax sets the "prices_include_tax" field if the given value is not nil.
+func (tc *TenantCreate) SetNillablePricesIncludeTax(b *bool) *TenantCreate {
+	if b != nil {
+		tc.SetPricesIncludeTax(*b)
+	}
+	return tc
+}
+
+// SetBaseCurrency sets the "base_currency" field.
+func (tc *TenantCreate) SetBaseCurrency(s string) *TenantCreate {
+	tc.mutation.SetBaseCurrency(s)
+	return tc
+}
+
+// SetNillableBaseCurrency sets the "base_currency" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableBaseCurrency(s *string) *TenantCreate {
+	if s != nil {
+		tc.SetBaseCurrency(*s)
+	}
+	return tc
+}
+
+// SetLegalName sets the "legal_name" field.
+func (tc *TenantCreate) SetLegalName(s string) *TenantCreate {
+	tc.mutation.SetLegalName(s)
+	return tc
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableLegalName(s *string) *TenantCreate {
+	if s != nil {
+		tc.SetLegalName(*s)
+	}
+	return tc
+}
+
+// SetTaxID sets the "tax_id" field.
+func (tc *TenantCreate) SetTaxID(s string) *TenantCreate {
+	tc.mutation.SetTaxID(s)
+	return tc
+}
+
+// SetNillableTaxID sets the "tax_id" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableTaxID(s *string) *TenantCreate {
+	if s != nil {
+		tc.SetTaxID(*s)
+	}
+	return tc
+}
+
+// SetAddress sets the "address" field.
+func (tc *TenantCreate) SetAddress(s string) *TenantCreate {
+	tc.mutation.SetAddress(s)
+	return tc
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableAddress(s *string) *TenantCreate {
+	if s != nil {
+		tc.SetAddress(*s)
+	}
+	return tc
+}
+
+// SetPhone sets the "phone" field.
+func (tc *TenantCreate) SetPhone(s string) *TenantCreate {
+	tc.mutation.SetPhone(s)
+	return tc
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (tc *TenantCreate) SetNillablePhone(s *string) *TenantCreate {
+	if s != nil {
+		tc.SetPhone(*s)
+	}
+	return tc
+}
+
+// SetEmail sets the "email" field.
+func (tc *TenantCreate) SetEmail(s string) *TenantCreate {
+	tc.mutation.SetEmail(s)
+	return tc
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableEmail(s *string) *TenantCreate {
+	if s != nil {
+		tc.SetEmail(*s)
+	}
+	return tc
+}
+
+// SetLogo sets the "logo" field.
+func (tc *TenantCreate) SetLogo(b []byte) *TenantCreate {
+	tc.mutation.SetLogo(b)
+	return tc
+}
+
+// SetEinvoiceCountry sets the "einvoice_country" field.
+func (tc *TenantCreate) SetEinvoiceCountry(s string) *TenantCreate {
+	tc.mutation.SetEinvoiceCountry(s)
+	return tc
+}
+
+// SetNillableEinvoiceCountry sets the "einvoice_country" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableEinvoiceCountry(s *string) *TenantCreate {
+	if s != nil {
+		tc.SetEinvoiceCountry(*s)
+	}
+	return tc
+}
+
+// SetEinvoiceEnvironment sets the "einvoice_environment" field.
+func (tc *TenantCreate) SetEinvoiceEnvironment(s string) *TenantCreate {
+	tc.mutation.SetEinvoiceEnvironment(s)
+	return tc
+}
+
+// SetNillableEinvoiceEnvironment sets the "einvoice_environment" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableEinvoiceEnvironment(s *string) *TenantCreate {
+	if s != nil {
+		tc.SetEinvoiceEnvironment(*s)
+	}
+	return tc
+}
+
+// SetEinvoiceSoftwareID sets the "einvoice_software_id" field.
+func (tc *TenantCreate) SetEinvoiceSoftwareID(s string) *TenantCreate {
+	tc.mutation.SetEinvoiceSoftwareID(s)
+	return tc
+}
+
+// SetNillableEinvoiceSoftwareID sets the "einvoice_software_id" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableEinvoiceSoftwareID(s *string) *TenantCreate {
+	if s != nil {
+		tc.SetEinvoiceSoftwareID(*s)
+	}
+	return tc
+}
+
+// SetEinvoiceSoftwarePin sets the "einvoice_software_pin" field.
+func (tc *TenantCreate) SetEinvoiceSoftwarePin(s string) *TenantCreate {
+	tc.mutation.SetEinvoiceSoftwarePin(s)
+	return tc
+}
+
+// SetNillableEinvoiceSoftwarePin sets the "einvoice_software_pin" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableEinvoiceSoftwarePin(s *string) *TenantCreate {
+	if s != nil {
+		tc.SetEinvoiceSoftwarePin(*s)
+	}
+	return tc
+}
+
+// SetEinvoiceTechnicalKey sets the "einvoice_technical_key" field.
+func (tc *TenantCreate) SetEinvoiceTechnicalKey(s string) *TenantCreate {
+	tc.mutation.SetEinvoiceTechnicalKey(s)
+	return tc
+}
+
+// SetNillableEinvoiceTechnicalKey sets the "einvoice_technical_key" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableEinvoiceTechnicalKey(s *string) *TenantCreate {
+	if s != nil {
+		tc.SetEinvoiceTechnicalKey(*s)
+	}
+	return tc
+}
+
+// SetEinvoiceCertificate sets the "einvoice_certificate" field.
+func (tc *TenantCreate) SetEinvoiceCertificate(b []byte) *TenantCreate {
+	tc.mutation.SetEinvoiceCertificate(b)
+	return tc
+}
+
+// SetEinvoicePrivateKey sets the "einvoice_private_key" field.
+func (tc *TenantCreate) SetEinvoicePrivateKey(b []byte) *TenantCreate {
+	tc.mutation.SetEinvoicePrivateKey(b)
+	return tc
+}
+
+// SetEinvoiceCertificateExpiresAt sets the "einvoice_certificate_expires_at" field.
+func (tc *TenantCreate) SetEinvoiceCertificateExpiresAt(t time.Time) *TenantCreate {
+	tc.mutation.SetEinvoiceCertificateExpiresAt(t)
+	return tc
+}
+
+// SetNillableEinvoiceCertificateExpiresAt sets the "einvoice_certificate_expires_at" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableEinvoiceCertificateExpiresAt(t *time.Time) *TenantCreate {
+	if t != nil {
+		tc.SetEinvoiceCertificateExpiresAt(*t)
+	}
+	return tc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (tc *TenantCreate) SetCreatedAt(t time.Time) *TenantCreate {
 	tc.mutation.SetCreatedAt(t)
@@ -151,6 +408,34 @@ func (tc *TenantCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (tc *TenantCreate) defaults() {
+	if _, ok := tc.mutation.CostingMethod(); !ok {
+		v := tenant.DefaultCostingMethod
+		tc.mutation.SetCostingMethod(v)
+	}
+	if _, ok := tc.mutation.AdjustmentApprovalThreshold(); !ok {
+		v := tenant.DefaultAdjustmentApprovalThreshold
+		tc.mutation.SetAdjustmentApprovalThreshold(v)
+	}
+	if _, ok := tc.mutation.UserDiscountLimit(); !ok {
+		v := tenant.DefaultUserDiscountLimit
+		tc.mutation.SetUserDiscountLimit(v)
+	}
+	if _, ok := tc.mutation.ManagerDiscountLimit(); !ok {
+		v := tenant.DefaultManagerDiscountLimit
+		tc.mutation.SetManagerDiscountLimit(v)
+	}
+	if _, ok := tc.mutation.PricesIncludeTax(); !ok {
+		v := tenant.DefaultPricesIncludeTax
+		tc.mutation.SetPricesIncludeTax(v)
+	}
+	if _, ok := tc.mutation.BaseCurrency(); !ok {
+		v := tenant.DefaultBaseCurrency
+		tc.mutation.SetBaseCurrency(v)
+	}
+	if _, ok := tc.mutation.EinvoiceEnvironment(); !ok {
+		v := tenant.DefaultEinvoiceEnvironment
+		tc.mutation.SetEinvoiceEnvironment(v)
+	}
 	if _, ok := tc.mutation.CreatedAt(); !ok {
 		v := tenant.DefaultCreatedAt()
 		tc.mutation.SetCreatedAt(v)
@@ -179,6 +464,42 @@ func (tc *TenantCreate) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Tenant.slug": %w`, err)}
 		}
 	}
+	if _, ok := tc.mutation.CostingMethod(); !ok {
+		return &ValidationError{Name: "costing_method", err: errors.New(`ent: missing required field "Tenant.costing_method"`)}
+	}
+	if _, ok := tc.mutation.AdjustmentApprovalThreshold(); !ok {
+		return &ValidationError{Name: "adjustment_approval_threshold", err: errors.New(`ent: missing required field "Tenant.adjustment_approval_threshold"`)}
+	}
+	if v, ok := tc.mutation.AdjustmentApprovalThreshold(); ok {
+		if err := tenant.AdjustmentApprovalThresholdValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "adjustment_approval_threshold", err: fmt.Errorf(`ent: validator failed for field "Tenant.adjustment_approval_threshold": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.UserDiscountLimit(); !ok {
+		return &ValidationError{Name: "user_discount_limit", err: errors.New(`ent: missing required field "Tenant.user_discount_limit"`)}
+	}
+	if v, ok := tc.mutation.UserDiscountLimit(); ok {
+		if err := tenant.UserDiscountLimitValidator(v); err != nil {
+			return &ValidationError{Name: "user_discount_limit", err: fmt.Errorf(`ent: validator failed for field "Tenant.user_discount_limit": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.ManagerDiscountLimit(); !ok {
+		return &ValidationError{Name: "manager_discount_limit", err: errors.New(`ent: missing required field "Tenant.manager_discount_limit"`)}
+	}
+	if v, ok := tc.mutation.ManagerDiscountLimit(); ok {
+		if err := tenant.ManagerDiscountLimitValidator(v); err != nil {
+			return &ValidationError{Name: "manager_discount_limit", err: fmt.Errorf(`ent: validator failed for field "Tenant.manager_discount_limit": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.PricesIncludeTax(); !ok {
+		return &ValidationError{Name: "prices_include_tax", err: errors.New(`ent: missing required field "Tenant.prices_include_tax"`)}
+	}
+	if _, ok := tc.mutation.BaseCurrency(); !ok {
+		return &ValidationError{Name: "base_currency", err: errors.New(`ent: missing required field "Tenant.base_currency"`)}
+	}
+	if _, ok := tc.mutation.EinvoiceEnvironment(); !ok {
+		return &ValidationError{Name: "einvoice_environment", err: errors.New(`ent: missing required field "Tenant.einvoice_environment"`)}
+	}
 	if _, ok := tc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Tenant.created_at"`)}
 	}
@@ -236,6 +557,166 @@ func (tc *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		})
 		_node.Domain = value
 	}
+	if value, ok := tc.mutation.CostingMethod(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldCostingMethod,
+		})
+		_node.CostingMethod = value
+	}
+	if value, ok := tc.mutation.AdjustmentApprovalThreshold(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: tenant.FieldAdjustmentApprovalThreshold,
+		})
+		_node.AdjustmentApprovalThreshold = value
+	}
+	if value, ok := tc.mutation.UserDiscountLimit(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: tenant.FieldUserDiscountLimit,
+		})
+		_node.UserDiscountLimit = value
+	}
+	if value, ok := tc.mutation.ManagerDiscountLimit(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: tenant.FieldManagerDiscountLimit,
+		})
+		_node.ManagerDiscountLimit = value
+	}
+	if value, ok := tc.mutation.PricesIncludeTax(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: tenant.FieldPricesIncludeTax,
+		})
+		_node.PricesIncludeTax = value
+	}
+	if value, ok := tc.mutation.BaseCurrency(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldBaseCurrency,
+		})
+		_node.BaseCurrency = value
+	}
+	if value, ok := tc.mutation.LegalName(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldLegalName,
+		})
+		_node.LegalName = value
+	}
+	if value, ok := tc.mutation.TaxID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldTaxID,
+		})
+		_node.TaxID = value
+	}
+	if value, ok := tc.mutation.Address(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldAddress,
+		})
+		_node.Address = value
+	}
+	if value, ok := tc.mutation.Phone(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldPhone,
+		})
+		_node.Phone = value
+	}
+	if value, ok := tc.mutation.Email(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEmail,
+		})
+		_node.Email = value
+	}
+	if value, ok := tc.mutation.Logo(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: tenant.FieldLogo,
+		})
+		_node.Logo = value
+	}
+	if value, ok := tc.mutation.EinvoiceCountry(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEinvoiceCountry,
+		})
+		_node.EinvoiceCountry = value
+	}
+	if value, ok := tc.mutation.EinvoiceEnvironment(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEinvoiceEnvironment,
+		})
+		_node.EinvoiceEnvironment = value
+	}
+	if value, ok := tc.mutation.EinvoiceSoftwareID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEinvoiceSoftwareID,
+		})
+		_node.EinvoiceSoftwareID = value
+	}
+	if value, ok := tc.mutation.EinvoiceSoftwarePin(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEinvoiceSoftwarePin,
+		})
+		_node.EinvoiceSoftwarePin = value
+	}
+	if value, ok := tc.mutation.EinvoiceTechnicalKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEinvoiceTechnicalKey,
+		})
+		_node.EinvoiceTechnicalKey = value
+	}
+	if value, ok := tc.mutation.EinvoiceCertificate(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: tenant.FieldEinvoiceCertificate,
+		})
+		_node.EinvoiceCertificate = value
+	}
+	if value, ok := tc.mutation.EinvoicePrivateKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: tenant.FieldEinvoicePrivateKey,
+		})
+		_node.EinvoicePrivateKey = value
+	}
+	if value, ok := tc.mutation.EinvoiceCertificateExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: tenant.FieldEinvoiceCertificateExpiresAt,
+		})
+		_node.EinvoiceCertificateExpiresAt = &value
+	}
 	if value, ok := tc.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
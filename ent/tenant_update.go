@@ -5,6 +5,7 @@ package ent
 import (
 	"Veritasbackend/ent/predicate"
 	"Veritasbackend/ent/tenant"
+	"Veritasbackend/pkg/money"
 	"context"
 	"errors"
 	"fmt"
@@ -60,6 +61,361 @@ func (tu *TenantUpdate) ClearDomain() *TenantUpdate {
 	return tu
 }
 
+// SetCostingMethod sets the "costing_method" field.
+func (tu *TenantUpdate) SetCostingMethod(s string) *TenantUpdate {
+	tu.mutation.SetCostingMethod(s)
+	return tu
+}
+
+// SetNillableCostingMethod sets the "costing_method" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableCostingMethod(s *string) *TenantUpdate {
+	if s != nil {
+		tu.SetCostingMethod(*s)
+	}
+	return tu
+}
+
+// SetAdjustmentApprovalThreshold sets the "adjustment_approval_threshold" field.
+func (tu *TenantUpdate) SetAdjustmentApprovalThreshold(m money.Amount) *TenantUpdate {
+	tu.mutation.ResetAdjustmentApprovalThreshold()
+	tu.mutation.SetAdjustmentApprovalThreshold(m)
+	return tu
+}
+
+// SetNillableAdjustmentApprovalThreshold sets the "adjustment_approval_threshold" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableAdjustmentApprovalThreshold(m *money.Amount) *TenantUpdate {
+	if m != nil {
+		tu.SetAdjustmentApprovalThreshold(*m)
+	}
+	return tu
+}
+
+// AddAdjustmentApprovalThreshold adds m to the "adjustment_approval_threshold" field.
+func (tu *TenantUpdate) AddAdjustmentApprovalThreshold(m money.Amount) *TenantUpdate {
+	tu.mutation.AddAdjustmentApprovalThreshold(m)
+	return tu
+}
+
+// SetUserDiscountLimit sets the "user_discount_limit" field.
+func (tu *TenantUpdate) SetUserDiscountLimit(f float64) *TenantUpdate {
+	tu.mutation.ResetUserDiscountLimit()
+	tu.mutation.SetUserDiscountLimit(f)
+	return tu
+}
+
+// SetNillableUserDiscountLimit sets the "user_discount_limit" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableUserDiscountLimit(f *float64) *TenantUpdate {
+	if f != nil {
+		tu.SetUserDiscountLimit(*f)
+	}
+	return tu
+}
+
+// AddUserDiscountLimit adds f to the "user_discount_limit" field.
+func (tu *TenantUpdate) AddUserDiscountLimit(f float64) *TenantUpdate {
+	tu.mutation.AddUserDiscountLimit(f)
+	return tu
+}
+
+// SetManagerDiscountLimit sets the "manager_discount_limit" field.
+func (tu *TenantUpdate) SetManagerDiscountLimit(f float64) *TenantUpdate {
+	tu.mutation.ResetManagerDiscountLimit()
+	tu.mutation.SetManagerDiscountLimit(f)
+	return tu
+}
+
+// SetNillableManagerDiscountLimit sets the "manager_discount_limit" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableManagerDiscountLimit(f *float64) *TenantUpdate {
+	if f != nil {
+		tu.SetManagerDiscountLimit(*f)
+	}
+	return tu
+}
+
+// AddManagerDiscountLimit adds f to the "manager_discount_limit" field.
+func (tu *TenantUpdate) AddManagerDiscountLimit(f float64) *TenantUpdate {
+	tu.mutation.AddManagerDiscountLimit(f)
+	return tu
+}
+
+// SetPricesIncludeTax sets the "prices_include_tax" field.
+func (tu *TenantUpdate) SetPricesIncludeTax(b bool) *TenantUpdate {
+	tu.mutation.SetPricesIncludeTax(b)
+	return tu
+}
+
+// SetNillablePricesIncludeTax sets the "prices_include_tax" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillablePricesIncludeTax(b *bool) *TenantUpdate {
+	if b != nil {
+		tu.SetPricesIncludeTax(*b)
+	}
+	return tu
+}
+
+// SetBaseCurrency sets the "base_currency" field.
+func (tu *TenantUpdate) SetBaseCurrency(s string) *TenantUpdate {
+	tu.mutation.SetBaseCurrency(s)
+	return tu
+}
+
+// SetNillableBaseCurrency sets the "base_currency" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableBaseCurrency(s *string) *TenantUpdate {
+	if s != nil {
+		tu.SetBaseCurrency(*s)
+	}
+	return tu
+}
+
+// SetLegalName sets the "legal_name" field.
+func (tu *TenantUpdate) SetLegalName(s string) *TenantUpdate {
+	tu.mutation.SetLegalName(s)
+	return tu
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableLegalName(s *string) *TenantUpdate {
+	if s != nil {
+		tu.SetLegalName(*s)
+	}
+	return tu
+}
+
+// ClearLegalName clears the value of the "legal_name" field.
+func (tu *TenantUpdate) ClearLegalName() *TenantUpdate {
+	tu.mutation.ClearLegalName()
+	return tu
+}
+
+// SetTaxID sets the "tax_id" field.
+func (tu *TenantUpdate) SetTaxID(s string) *TenantUpdate {
+	tu.mutation.SetTaxID(s)
+	return tu
+}
+
+// SetNillableTaxID sets the "tax_id" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableTaxID(s *string) *TenantUpdate {
+	if s != nil {
+		tu.SetTaxID(*s)
+	}
+	return tu
+}
+
+// ClearTaxID clears the value of the "tax_id" field.
+func (tu *TenantUpdate) ClearTaxID() *TenantUpdate {
+	tu.mutation.ClearTaxID()
+	return tu
+}
+
+// SetAddress sets the "address" field.
+func (tu *TenantUpdate) SetAddress(s string) *TenantUpdate {
+	tu.mutation.SetAddress(s)
+	return tu
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableAddress(s *string) *TenantUpdate {
+	if s != nil {
+		tu.SetAddress(*s)
+	}
+	return tu
+}
+
+// ClearAddress clears the value of the "address" field.
+func (tu *TenantUpdate) ClearAddress() *TenantUpdate {
+	tu.mutation.ClearAddress()
+	return tu
+}
+
+// SetPhone sets the "phone" field.
+func (tu *TenantUpdate) SetPhone(s string) *TenantUpdate {
+	tu.mutation.SetPhone(s)
+	return tu
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillablePhone(s *string) *TenantUpdate {
+	if s != nil {
+		tu.SetPhone(*s)
+	}
+	return tu
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (tu *TenantUpdate) ClearPhone() *TenantUpdate {
+	tu.mutation.ClearPhone()
+	return tu
+}
+
+// SetEmail sets the "email" field.
+func (tu *TenantUpdate) SetEmail(s string) *TenantUpdate {
+	tu.mutation.SetEmail(s)
+	return tu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableEmail(s *string) *TenantUpdate {
+	if s != nil {
+		tu.SetEmail(*s)
+	}
+	return tu
+}
+
+// ClearEmail clears the value of the "email" field.
+func (tu *TenantUpdate) ClearEmail() *TenantUpdate {
+	tu.mutation.ClearEmail()
+	return tu
+}
+
+// SetLogo sets the "logo" field.
+func (tu *TenantUpdate) SetLogo(b []byte) *TenantUpdate {
+	tu.mutation.SetLogo(b)
+	return tu
+}
+
+// ClearLogo clears the value of the "logo" field.
+func (tu *TenantUpdate) ClearLogo() *TenantUpdate {
+	tu.mutation.ClearLogo()
+	return tu
+}
+
+// SetEinvoiceCountry sets the "einvoice_country" field.
+func (tu *TenantUpdate) SetEinvoiceCountry(s string) *TenantUpdate {
+	tu.mutation.SetEinvoiceCountry(s)
+	return tu
+}
+
+// SetNillableEinvoiceCountry sets the "einvoice_country" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableEinvoiceCountry(s *string) *TenantUpdate {
+	if s != nil {
+		tu.SetEinvoiceCountry(*s)
+	}
+	return tu
+}
+
+// ClearEinvoiceCountry clears the value of the "einvoice_country" field.
+func (tu *TenantUpdate) ClearEinvoiceCountry() *TenantUpdate {
+	tu.mutation.ClearEinvoiceCountry()
+	return tu
+}
+
+// SetEinvoiceEnvironment sets the "einvoice_environment" field.
+func (tu *TenantUpdate) SetEinvoiceEnvironment(s string) *TenantUpdate {
+	tu.mutation.SetEinvoiceEnvironment(s)
+	return tu
+}
+
+// SetNillableEinvoiceEnvironment sets the "einvoice_environment" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableEinvoiceEnvironment(s *string) *TenantUpdate {
+	if s != nil {
+		tu.SetEinvoiceEnvironment(*s)
+	}
+	return tu
+}
+
+// SetEinvoiceSoftwareID sets the "einvoice_software_id" field.
+func (tu *TenantUpdate) SetEinvoiceSoftwareID(s string) *TenantUpdate {
+	tu.mutation.SetEinvoiceSoftwareID(s)
+	return tu
+}
+
+// SetNillableEinvoiceSoftwareID sets the "einvoice_software_id" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableEinvoiceSoftwareID(s *string) *TenantUpdate {
+	if s != nil {
+		tu.SetEinvoiceSoftwareID(*s)
+	}
+	return tu
+}
+
+// ClearEinvoiceSoftwareID clears the value of the "einvoice_software_id" field.
+func (tu *TenantUpdate) ClearEinvoiceSoftwareID() *TenantUpdate {
+	tu.mutation.ClearEinvoiceSoftwareID()
+	return tu
+}
+
+// SetEinvoiceSoftwarePin sets the "einvoice_software_pin" field.
+func (tu *TenantUpdate) SetEinvoiceSoftwarePin(s string) *TenantUpdate {
+	tu.mutation.SetEinvoiceSoftwarePin(s)
+	return tu
+}
+
+// SetNillableEinvoiceSoftwarePin sets the "einvoice_software_pin" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableEinvoiceSoftwarePin(s *string) *TenantUpdate {
+	if s != nil {
+		tu.SetEinvoiceSoftwarePin(*s)
+	}
+	return tu
+}
+
+// ClearEinvoiceSoftwarePin clears the value of the "einvoice_software_pin" field.
+func (tu *TenantUpdate) ClearEinvoiceSoftwarePin() *TenantUpdate {
+	tu.mutation.ClearEinvoiceSoftwarePin()
+	return tu
+}
+
+// SetEinvoiceTechnicalKey sets the "einvoice_technical_key" field.
+func (tu *TenantUpdate) SetEinvoiceTechnicalKey(s string) *TenantUpdate {
+	tu.mutation.SetEinvoiceTechnicalKey(s)
+	return tu
+}
+
+// SetNillableEinvoiceTechnicalKey sets the "einvoice_technical_key" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableEinvoiceTechnicalKey(s *string) *TenantUpdate {
+	if s != nil {
+		tu.SetEinvoiceTechnicalKey(*s)
+	}
+	return tu
+}
+
+// ClearEinvoiceTechnicalKey clears the value of the "einvoice_technical_key" field.
+func (tu *TenantUpdate) ClearEinvoiceTechnicalKey() *TenantUpdate {
+	tu.mutation.ClearEinvoiceTechnicalKey()
+	return tu
+}
+
+// SetEinvoiceCertificate sets the "einvoice_certificate" field.
+func (tu *TenantUpdate) SetEinvoiceCertificate(b []byte) *TenantUpdate {
+	tu.mutation.SetEinvoiceCertificate(b)
+	return tu
+}
+
+// ClearEinvoiceCertificate clears the value of the "einvoice_certificate" field.
+func (tu *TenantUpdate) ClearEinvoiceCertificate() *TenantUpdate {
+	tu.mutation.ClearEinvoiceCertificate()
+	return tu
+}
+
+// SetEinvoicePrivateKey sets the "einvoice_private_key" field.
+func (tu *TenantUpdate) SetEinvoicePrivateKey(b []byte) *TenantUpdate {
+	tu.mutation.SetEinvoicePrivateKey(b)
+	return tu
+}
+
+// ClearEinvoicePrivateKey clears the value of the "einvoice_private_key" field.
+func (tu *TenantUpdate) ClearEinvoicePrivateKey() *TenantUpdate {
+	tu.mutation.ClearEinvoicePrivateKey()
+	return tu
+}
+
+// SetEinvoiceCertificateExpiresAt sets the "einvoice_certificate_expires_at" field.
+func (tu *TenantUpdate) SetEinvoiceCertificateExpiresAt(t time.Time) *TenantUpdate {
+	tu.mutation.SetEinvoiceCertificateExpiresAt(t)
+	return tu
+}
+
+// SetNillableEinvoiceCertificateExpiresAt sets the "einvoice_certificate_expires_at" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableEinvoiceCertificateExpiresAt(t *time.Time) *TenantUpdate {
+	if t != nil {
+		tu.SetEinvoiceCertificateExpiresAt(*t)
+	}
+	return tu
+}
+
+// ClearEinvoiceCertificateExpiresAt clears the value of the "einvoice_certificate_expires_at" field.
+func (tu *TenantUpdate) ClearEinvoiceCertificateExpiresAt() *TenantUpdate {
+	tu.mutation.ClearEinvoiceCertificateExpiresAt()
+	return tu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (tu *TenantUpdate) SetUpdatedAt(t time.Time) *TenantUpdate {
 	tu.mutation.SetUpdatedAt(t)
@@ -152,6 +508,21 @@ func (tu *TenantUpdate) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Tenant.slug": %w`, err)}
 		}
 	}
+	if v, ok := tu.mutation.AdjustmentApprovalThreshold(); ok {
+		if err := tenant.AdjustmentApprovalThresholdValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "adjustment_approval_threshold", err: fmt.Errorf(`ent: validator failed for field "Tenant.adjustment_approval_threshold": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.UserDiscountLimit(); ok {
+		if err := tenant.UserDiscountLimitValidator(v); err != nil {
+			return &ValidationError{Name: "user_discount_limit", err: fmt.Errorf(`ent: validator failed for field "Tenant.user_discount_limit": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.ManagerDiscountLimit(); ok {
+		if err := tenant.ManagerDiscountLimitValidator(v); err != nil {
+			return &ValidationError{Name: "manager_discount_limit", err: fmt.Errorf(`ent: validator failed for field "Tenant.manager_discount_limit": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -187,74 +558,668 @@ func (tu *TenantUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: tenant.FieldSlug,
 		})
 	}
-	if value, ok := tu.mutation.Domain(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Value:  value,
-			Column: tenant.FieldDomain,
-		})
+	if value, ok := tu.mutation.Domain(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldDomain,
+		})
+	}
+	if tu.mutation.DomainCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldDomain,
+		})
+	}
+	if value, ok := tu.mutation.CostingMethod(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldCostingMethod,
+		})
+	}
+	if value, ok := tu.mutation.AdjustmentApprovalThreshold(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: tenant.FieldAdjustmentApprovalThreshold,
+		})
+	}
+	if value, ok := tu.mutation.AddedAdjustmentApprovalThreshold(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: tenant.FieldAdjustmentApprovalThreshold,
+		})
+	}
+	if value, ok := tu.mutation.UserDiscountLimit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: tenant.FieldUserDiscountLimit,
+		})
+	}
+	if value, ok := tu.mutation.AddedUserDiscountLimit(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: tenant.FieldUserDiscountLimit,
+		})
+	}
+	if value, ok := tu.mutation.ManagerDiscountLimit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: tenant.FieldManagerDiscountLimit,
+		})
+	}
+	if value, ok := tu.mutation.AddedManagerDiscountLimit(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: tenant.FieldManagerDiscountLimit,
+		})
+	}
+	if value, ok := tu.mutation.PricesIncludeTax(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: tenant.FieldPricesIncludeTax,
+		})
+	}
+	if value, ok := tu.mutation.BaseCurrency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldBaseCurrency,
+		})
+	}
+	if value, ok := tu.mutation.LegalName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldLegalName,
+		})
+	}
+	if tu.mutation.LegalNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldLegalName,
+		})
+	}
+	if value, ok := tu.mutation.TaxID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldTaxID,
+		})
+	}
+	if tu.mutation.TaxIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldTaxID,
+		})
+	}
+	if value, ok := tu.mutation.Address(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldAddress,
+		})
+	}
+	if tu.mutation.AddressCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldAddress,
+		})
+	}
+	if value, ok := tu.mutation.Phone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldPhone,
+		})
+	}
+	if tu.mutation.PhoneCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldPhone,
+		})
+	}
+	if value, ok := tu.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEmail,
+		})
+	}
+	if tu.mutation.EmailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldEmail,
+		})
+	}
+	if value, ok := tu.mutation.Logo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: tenant.FieldLogo,
+		})
+	}
+	if tu.mutation.LogoCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: tenant.FieldLogo,
+		})
+	}
+	if value, ok := tu.mutation.EinvoiceCountry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEinvoiceCountry,
+		})
+	}
+	if tu.mutation.EinvoiceCountryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldEinvoiceCountry,
+		})
+	}
+	if value, ok := tu.mutation.EinvoiceEnvironment(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEinvoiceEnvironment,
+		})
+	}
+	if value, ok := tu.mutation.EinvoiceSoftwareID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEinvoiceSoftwareID,
+		})
+	}
+	if tu.mutation.EinvoiceSoftwareIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldEinvoiceSoftwareID,
+		})
+	}
+	if value, ok := tu.mutation.EinvoiceSoftwarePin(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEinvoiceSoftwarePin,
+		})
+	}
+	if tu.mutation.EinvoiceSoftwarePinCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldEinvoiceSoftwarePin,
+		})
+	}
+	if value, ok := tu.mutation.EinvoiceTechnicalKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEinvoiceTechnicalKey,
+		})
+	}
+	if tu.mutation.EinvoiceTechnicalKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldEinvoiceTechnicalKey,
+		})
+	}
+	if value, ok := tu.mutation.EinvoiceCertificate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: tenant.FieldEinvoiceCertificate,
+		})
+	}
+	if tu.mutation.EinvoiceCertificateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: tenant.FieldEinvoiceCertificate,
+		})
+	}
+	if value, ok := tu.mutation.EinvoicePrivateKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: tenant.FieldEinvoicePrivateKey,
+		})
+	}
+	if tu.mutation.EinvoicePrivateKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: tenant.FieldEinvoicePrivateKey,
+		})
+	}
+	if value, ok := tu.mutation.EinvoiceCertificateExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: tenant.FieldEinvoiceCertificateExpiresAt,
+		})
+	}
+	if tu.mutation.EinvoiceCertificateExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: tenant.FieldEinvoiceCertificateExpiresAt,
+		})
+	}
+	if value, ok := tu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: tenant.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TenantUpdateOne is the builder for updating a single Tenant entity.
+type TenantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TenantMutation
+}
+
+// SetName sets the "name" field.
+func (tuo *TenantUpdateOne) SetName(s string) *TenantUpdateOne {
+	tuo.mutation.SetName(s)
+	return tuo
+}
+
+// SetSlug sets the "slug" field.
+func (tuo *TenantUpdateOne) SetSlug(s string) *TenantUpdateOne {
+	tuo.mutation.SetSlug(s)
+	return tuo
+}
+
+// SetDomain sets the "domain" field.
+func (tuo *TenantUpdateOne) SetDomain(s string) *TenantUpdateOne {
+	tuo.mutation.SetDomain(s)
+	return tuo
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableDomain(s *string) *TenantUpdateOne {
+	if s != nil {
+		tuo.SetDomain(*s)
+	}
+	return tuo
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (tuo *TenantUpdateOne) ClearDomain() *TenantUpdateOne {
+	tuo.mutation.ClearDomain()
+	return tuo
+}
+
+// SetCostingMethod sets the "costing_method" field.
+func (tuo *TenantUpdateOne) SetCostingMethod(s string) *TenantUpdateOne {
+	tuo.mutation.SetCostingMethod(s)
+	return tuo
+}
+
+// SetNillableCostingMethod sets the "costing_method" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableCostingMethod(s *string) *TenantUpdateOne {
+	if s != nil {
+		tuo.SetCostingMethod(*s)
+	}
+	return tuo
+}
+
+// SetAdjustmentApprovalThreshold sets the "adjustment_approval_threshold" field.
+func (tuo *TenantUpdateOne) SetAdjustmentApprovalThreshold(m money.Amount) *TenantUpdateOne {
+	tuo.mutation.ResetAdjustmentApprovalThreshold()
+	tuo.mutation.SetAdjustmentApprovalThreshold(m)
+	return tuo
+}
+
+// SetNillableAdjustmentApprovalThreshold sets the "adjustment_approval_threshold" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableAdjustmentApprovalThreshold(m *money.Amount) *TenantUpdateOne {
+	if m != nil {
+		tuo.SetAdjustmentApprovalThreshold(*m)
+	}
+	return tuo
+}
+
+// AddAdjustmentApprovalThreshold adds m to the "adjustment_approval_threshold" field.
+func (tuo *TenantUpdateOne) AddAdjustmentApprovalThreshold(m money.Amount) *TenantUpdateOne {
+	tuo.mutation.AddAdjustmentApprovalThreshold(m)
+	return tuo
+}
+
+// SetUserDiscountLimit sets the "user_discount_limit" field.
+func (tuo *TenantUpdateOne) SetUserDiscountLimit(f float64) *TenantUpdateOne {
+	tuo.mutation.ResetUserDiscountLimit()
+	tuo.mutation.SetUserDiscountLimit(f)
+	return tuo
+}
+
+// SetNillableUserDiscountLimit sets the "user_discount_limit" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableUserDiscountLimit(f *float64) *TenantUpdateOne {
+	if f != nil {
+		tuo.SetUserDiscountLimit(*f)
+	}
+	return tuo
+}
+
+// AddUserDiscountLimit adds f to the "user_discount_limit" field.
+func (tuo *TenantUpdateOne) AddUserDiscountLimit(f float64) *TenantUpdateOne {
+	tuo.mutation.AddUserDiscountLimit(f)
+	return tuo
+}
+
+// SetManagerDiscountLimit sets the "manager_discount_limit" field.
+func (tuo *TenantUpdateOne) SetManagerDiscountLimit(f float64) *TenantUpdateOne {
+	tuo.mutation.ResetManagerDiscountLimit()
+	tuo.mutation.SetManagerDiscountLimit(f)
+	return tuo
+}
+
+// SetNillableManagerDiscountLimit sets the "manager_discount_limit" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableManagerDiscountLimit(f *float64) *TenantUpdateOne {
+	if f != nil {
+		tuo.SetManagerDiscountLimit(*f)
+	}
+	return tuo
+}
+
+// AddManagerDiscountLimit adds f to the "manager_discount_limit" field.
+func (tuo *TenantUpdateOne) AddManagerDiscountLimit(f float64) *TenantUpdateOne {
+	tuo.mutation.AddManagerDiscountLimit(f)
+	return tuo
+}
+
+// SetPricesIncludeTax sets the "prices_include_tax" field.
+func (tuo *TenantUpdateOne) SetPricesIncludeTax(b bool) *TenantUpdateOne {
+	tuo.mutation.SetPricesIncludeTax(b)
+	return tuo
+}
+
+// SetNillablePricesIncludeTax sets the "prices_include_tax" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillablePricesIncludeTax(b *bool) *TenantUpdateOne {
+	if b != nil {
+		tuo.SetPricesIncludeTax(*b)
+	}
+	return tuo
+}
+
+// SetBaseCurrency sets the "base_currency" field.
+func (tuo *TenantUpdateOne) SetBaseCurrency(s string) *TenantUpdateOne {
+	tuo.mutation.SetBaseCurrency(s)
+	return tuo
+}
+
+// SetNillableBaseCurrency sets the "base_currency" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableBaseCurrency(s *string) *TenantUpdateOne {
+	if s != nil {
+		tuo.SetBaseCurrency(*s)
+	}
+	return tuo
+}
+
+// SetLegalName sets the "legal_name" field.
+func (tuo *TenantUpdateOne) SetLegalName(s string) *TenantUpdateOne {
+	tuo.mutation.SetLegalName(s)
+	return tuo
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableLegalName(s *string) *TenantUpdateOne {
+	if s != nil {
+		tuo.SetLegalName(*s)
+	}
+	return tuo
+}
+
+// ClearLegalName clears the value of the "legal_name" field.
+func (tuo *TenantUpdateOne) ClearLegalName() *TenantUpdateOne {
+	tuo.mutation.ClearLegalName()
+	return tuo
+}
+
+// SetTaxID sets the "tax_id" field.
+func (tuo *TenantUpdateOne) SetTaxID(s string) *TenantUpdateOne {
+	tuo.mutation.SetTaxID(s)
+	return tuo
+}
+
+// SetNillableTaxID sets the "tax_id" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableTaxID(s *string) *TenantUpdateOne {
+	if s != nil {
+		tuo.SetTaxID(*s)
+	}
+	return tuo
+}
+
+// ClearTaxID clears the value of the "tax_id" field.
+func (tuo *TenantUpdateOne) ClearTaxID() *TenantUpdateOne {
+	tuo.mutation.ClearTaxID()
+	return tuo
+}
+
+// SetAddress sets the "address" field.
+func (tuo *TenantUpdateOne) SetAddress(s string) *TenantUpdateOne {
+	tuo.mutation.SetAddress(s)
+	return tuo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableAddress(s *string) *TenantUpdateOne {
+	if s != nil {
+		tuo.SetAddress(*s)
+	}
+	return tuo
+}
+
+// ClearAddress clears the value of the "address" field.
+func (tuo *TenantUpdateOne) ClearAddress() *TenantUpdateOne {
+	tuo.mutation.ClearAddress()
+	return tuo
+}
+
+// SetPhone sets the "phone" field.
+func (tuo *TenantUpdateOne) SetPhone(s string) *TenantUpdateOne {
+	tuo.mutation.SetPhone(s)
+	return tuo
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillablePhone(s *string) *TenantUpdateOne {
+	if s != nil {
+		tuo.SetPhone(*s)
+	}
+	return tuo
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (tuo *TenantUpdateOne) ClearPhone() *TenantUpdateOne {
+	tuo.mutation.ClearPhone()
+	return tuo
+}
+
+// SetEmail sets the "email" field.
+func (tuo *TenantUpdateOne) SetEmail(s string) *TenantUpdateOne {
+	tuo.mutation.SetEmail(s)
+	return tuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableEmail(s *string) *TenantUpdateOne {
+	if s != nil {
+		tuo.SetEmail(*s)
+	}
+	return tuo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (tuo *TenantUpdateOne) ClearEmail() *TenantUpdateOne {
+	tuo.mutation.ClearEmail()
+	return tuo
+}
+
+// SetLogo sets the "logo" field.
+func (tuo *TenantUpdateOne) SetLogo(b []byte) *TenantUpdateOne {
+	tuo.mutation.SetLogo(b)
+	return tuo
+}
+
+// ClearLogo clears the value of the "logo" field.
+func (tuo *TenantUpdateOne) ClearLogo() *TenantUpdateOne {
+	tuo.mutation.ClearLogo()
+	return tuo
+}
+
+// SetEinvoiceCountry sets the "einvoice_country" field.
+func (tuo *TenantUpdateOne) SetEinvoiceCountry(s string) *TenantUpdateOne {
+	tuo.mutation.SetEinvoiceCountry(s)
+	return tuo
+}
+
+// SetNillableEinvoiceCountry sets the "einvoice_country" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableEinvoiceCountry(s *string) *TenantUpdateOne {
+	if s != nil {
+		tuo.SetEinvoiceCountry(*s)
+	}
+	return tuo
+}
+
+// ClearEinvoiceCountry clears the value of the "einvoice_country" field.
+func (tuo *TenantUpdateOne) ClearEinvoiceCountry() *TenantUpdateOne {
+	tuo.mutation.ClearEinvoiceCountry()
+	return tuo
+}
+
+// SetEinvoiceEnvironment sets the "einvoice_environment" field.
+func (tuo *TenantUpdateOne) SetEinvoiceEnvironment(s string) *TenantUpdateOne {
+	tuo.mutation.SetEinvoiceEnvironment(s)
+	return tuo
+}
+
+// SetNillableEinvoiceEnvironment sets the "einvoice_environment" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableEinvoiceEnvironment(s *string) *TenantUpdateOne {
+	if s != nil {
+		tuo.SetEinvoiceEnvironment(*s)
 	}
-	if tu.mutation.DomainCleared() {
-		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Column: tenant.FieldDomain,
-		})
+	return tuo
+}
+
+// SetEinvoiceSoftwareID sets the "einvoice_software_id" field.
+func (tuo *TenantUpdateOne) SetEinvoiceSoftwareID(s string) *TenantUpdateOne {
+	tuo.mutation.SetEinvoiceSoftwareID(s)
+	return tuo
+}
+
+// SetNillableEinvoiceSoftwareID sets the "einvoice_software_id" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableEinvoiceSoftwareID(s *string) *TenantUpdateOne {
+	if s != nil {
+		tuo.SetEinvoiceSoftwareID(*s)
 	}
-	if value, ok := tu.mutation.UpdatedAt(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeTime,
-			Value:  value,
-			Column: tenant.FieldUpdatedAt,
-		})
+	return tuo
+}
+
+// ClearEinvoiceSoftwareID clears the value of the "einvoice_software_id" field.
+func (tuo *TenantUpdateOne) ClearEinvoiceSoftwareID() *TenantUpdateOne {
+	tuo.mutation.ClearEinvoiceSoftwareID()
+	return tuo
+}
+
+// SetEinvoiceSoftwarePin sets the "einvoice_software_pin" field.
+func (tuo *TenantUpdateOne) SetEinvoiceSoftwarePin(s string) *TenantUpdateOne {
+	tuo.mutation.SetEinvoiceSoftwarePin(s)
+	return tuo
+}
+
+// SetNillableEinvoiceSoftwarePin sets the "einvoice_software_pin" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableEinvoiceSoftwarePin(s *string) *TenantUpdateOne {
+	if s != nil {
+		tuo.SetEinvoiceSoftwarePin(*s)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{tenant.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	return tuo
+}
+
+// ClearEinvoiceSoftwarePin clears the value of the "einvoice_software_pin" field.
+func (tuo *TenantUpdateOne) ClearEinvoiceSoftwarePin() *TenantUpdateOne {
+	tuo.mutation.ClearEinvoiceSoftwarePin()
+	return tuo
+}
+
+// SetEinvoiceTechnicalKey sets the "einvoice_technical_key" field.
+func (tuo *TenantUpdateOne) SetEinvoiceTechnicalKey(s string) *TenantUpdateOne {
+	tuo.mutation.SetEinvoiceTechnicalKey(s)
+	return tuo
+}
+
+// SetNillableEinvoiceTechnicalKey sets the "einvoice_technical_key" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableEinvoiceTechnicalKey(s *string) *TenantUpdateOne {
+	if s != nil {
+		tuo.SetEinvoiceTechnicalKey(*s)
 	}
-	return n, nil
+	return tuo
 }
 
-// TenantUpdateOne is the builder for updating a single Tenant entity.
-type TenantUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *TenantMutation
+// ClearEinvoiceTechnicalKey clears the value of the "einvoice_technical_key" field.
+func (tuo *TenantUpdateOne) ClearEinvoiceTechnicalKey() *TenantUpdateOne {
+	tuo.mutation.ClearEinvoiceTechnicalKey()
+	return tuo
 }
 
-// SetName sets the "name" field.
-func (tuo *TenantUpdateOne) SetName(s string) *TenantUpdateOne {
-	tuo.mutation.SetName(s)
+// SetEinvoiceCertificate sets the "einvoice_certificate" field.
+func (tuo *TenantUpdateOne) SetEinvoiceCertificate(b []byte) *TenantUpdateOne {
+	tuo.mutation.SetEinvoiceCertificate(b)
 	return tuo
 }
 
-// SetSlug sets the "slug" field.
-func (tuo *TenantUpdateOne) SetSlug(s string) *TenantUpdateOne {
-	tuo.mutation.SetSlug(s)
+// ClearEinvoiceCertificate clears the value of the "einvoice_certificate" field.
+func (tuo *TenantUpdateOne) ClearEinvoiceCertificate() *TenantUpdateOne {
+	tuo.mutation.ClearEinvoiceCertificate()
 	return tuo
 }
 
-// SetDomain sets the "domain" field.
-func (tuo *TenantUpdateOne) SetDomain(s string) *TenantUpdateOne {
-	tuo.mutation.SetDomain(s)
+// SetEinvoicePrivateKey sets the "einvoice_private_key" field.
+func (tuo *TenantUpdateOne) SetEinvoicePrivateKey(b []byte) *TenantUpdateOne {
+	tuo.mutation.SetEinvoicePrivateKey(b)
 	return tuo
 }
 
-// SetNillableDomain sets the "domain" field if the given value is not nil.
-func (tuo *TenantUpdateOne) SetNillableDomain(s *string) *TenantUpdateOne {
-	if s != nil {
-		tuo.SetDomain(*s)
+// ClearEinvoicePrivateKey clears the value of the "einvoice_private_key" field.
+func (tuo *TenantUpdateOne) ClearEinvoicePrivateKey() *TenantUpdateOne {
+	tuo.mutation.ClearEinvoicePrivateKey()
+	return tuo
+}
+
+// SetEinvoiceCertificateExpiresAt sets the "einvoice_certificate_expires_at" field.
+func (tuo *TenantUpdateOne) SetEinvoiceCertificateExpiresAt(t time.Time) *TenantUpdateOne {
+	tuo.mutation.SetEinvoiceCertificateExpiresAt(t)
+	return tuo
+}
+
+// SetNillableEinvoiceCertificateExpiresAt sets the "einvoice_certificate_expires_at" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableEinvoiceCertificateExpiresAt(t *time.Time) *TenantUpdateOne {
+	if t != nil {
+		tuo.SetEinvoiceCertificateExpiresAt(*t)
 	}
 	return tuo
 }
 
-// ClearDomain clears the value of the "domain" field.
-func (tuo *TenantUpdateOne) ClearDomain() *TenantUpdateOne {
-	tuo.mutation.ClearDomain()
+// ClearEinvoiceCertificateExpiresAt clears the value of the "einvoice_certificate_expires_at" field.
+func (tuo *TenantUpdateOne) ClearEinvoiceCertificateExpiresAt() *TenantUpdateOne {
+	tuo.mutation.ClearEinvoiceCertificateExpiresAt()
 	return tuo
 }
 
@@ -363,6 +1328,21 @@ func (tuo *TenantUpdateOne) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Tenant.slug": %w`, err)}
 		}
 	}
+	if v, ok := tuo.mutation.AdjustmentApprovalThreshold(); ok {
+		if err := tenant.AdjustmentApprovalThresholdValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "adjustment_approval_threshold", err: fmt.Errorf(`ent: validator failed for field "Tenant.adjustment_approval_threshold": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.UserDiscountLimit(); ok {
+		if err := tenant.UserDiscountLimitValidator(v); err != nil {
+			return &ValidationError{Name: "user_discount_limit", err: fmt.Errorf(`ent: validator failed for field "Tenant.user_discount_limit": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.ManagerDiscountLimit(); ok {
+		if err := tenant.ManagerDiscountLimitValidator(v); err != nil {
+			return &ValidationError{Name: "manager_discount_limit", err: fmt.Errorf(`ent: validator failed for field "Tenant.manager_discount_limit": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -428,6 +1408,245 @@ func (tuo *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err err
 			Column: tenant.FieldDomain,
 		})
 	}
+	if value, ok := tuo.mutation.CostingMethod(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldCostingMethod,
+		})
+	}
+	if value, ok := tuo.mutation.AdjustmentApprovalThreshold(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: tenant.FieldAdjustmentApprovalThreshold,
+		})
+	}
+	if value, ok := tuo.mutation.AddedAdjustmentApprovalThreshold(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: tenant.FieldAdjustmentApprovalThreshold,
+		})
+	}
+	if value, ok := tuo.mutation.UserDiscountLimit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: tenant.FieldUserDiscountLimit,
+		})
+	}
+	if value, ok := tuo.mutation.AddedUserDiscountLimit(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: tenant.FieldUserDiscountLimit,
+		})
+	}
+	if value, ok := tuo.mutation.ManagerDiscountLimit(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: tenant.FieldManagerDiscountLimit,
+		})
+	}
+	if value, ok := tuo.mutation.AddedManagerDiscountLimit(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: tenant.FieldManagerDiscountLimit,
+		})
+	}
+	if value, ok := tuo.mutation.PricesIncludeTax(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: tenant.FieldPricesIncludeTax,
+		})
+	}
+	if value, ok := tuo.mutation.BaseCurrency(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldBaseCurrency,
+		})
+	}
+	if value, ok := tuo.mutation.LegalName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldLegalName,
+		})
+	}
+	if tuo.mutation.LegalNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldLegalName,
+		})
+	}
+	if value, ok := tuo.mutation.TaxID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldTaxID,
+		})
+	}
+	if tuo.mutation.TaxIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldTaxID,
+		})
+	}
+	if value, ok := tuo.mutation.Address(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldAddress,
+		})
+	}
+	if tuo.mutation.AddressCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldAddress,
+		})
+	}
+	if value, ok := tuo.mutation.Phone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldPhone,
+		})
+	}
+	if tuo.mutation.PhoneCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldPhone,
+		})
+	}
+	if value, ok := tuo.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEmail,
+		})
+	}
+	if tuo.mutation.EmailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldEmail,
+		})
+	}
+	if value, ok := tuo.mutation.Logo(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: tenant.FieldLogo,
+		})
+	}
+	if tuo.mutation.LogoCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: tenant.FieldLogo,
+		})
+	}
+	if value, ok := tuo.mutation.EinvoiceCountry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEinvoiceCountry,
+		})
+	}
+	if tuo.mutation.EinvoiceCountryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldEinvoiceCountry,
+		})
+	}
+	if value, ok := tuo.mutation.EinvoiceEnvironment(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEinvoiceEnvironment,
+		})
+	}
+	if value, ok := tuo.mutation.EinvoiceSoftwareID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEinvoiceSoftwareID,
+		})
+	}
+	if tuo.mutation.EinvoiceSoftwareIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldEinvoiceSoftwareID,
+		})
+	}
+	if value, ok := tuo.mutation.EinvoiceSoftwarePin(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEinvoiceSoftwarePin,
+		})
+	}
+	if tuo.mutation.EinvoiceSoftwarePinCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldEinvoiceSoftwarePin,
+		})
+	}
+	if value, ok := tuo.mutation.EinvoiceTechnicalKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: tenant.FieldEinvoiceTechnicalKey,
+		})
+	}
+	if tuo.mutation.EinvoiceTechnicalKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: tenant.FieldEinvoiceTechnicalKey,
+		})
+	}
+	if value, ok := tuo.mutation.EinvoiceCertificate(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: tenant.FieldEinvoiceCertificate,
+		})
+	}
+	if tuo.mutation.EinvoiceCertificateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: tenant.FieldEinvoiceCertificate,
+		})
+	}
+	if value, ok := tuo.mutation.EinvoicePrivateKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: tenant.FieldEinvoicePrivateKey,
+		})
+	}
+	if tuo.mutation.EinvoicePrivateKeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: tenant.FieldEinvoicePrivateKey,
+		})
+	}
+	if value, ok := tuo.mutation.EinvoiceCertificateExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: tenant.FieldEinvoiceCertificateExpiresAt,
+		})
+	}
+	if tuo.mutation.EinvoiceCertificateExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: tenant.FieldEinvoiceCertificateExpiresAt,
+		})
+	}
 	if value, ok := tuo.mutation.UpdatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
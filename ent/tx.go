@@ -12,20 +12,82 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AdjustmentReason is the client for interacting with the AdjustmentReason builders.
+	AdjustmentReason *AdjustmentReasonClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// BundleAssembly is the client for interacting with the BundleAssembly builders.
+	BundleAssembly *BundleAssemblyClient
+	// BundleAssemblyLine is the client for interacting with the BundleAssemblyLine builders.
+	BundleAssemblyLine *BundleAssemblyLineClient
+	// BundleComponent is the client for interacting with the BundleComponent builders.
+	BundleComponent *BundleComponentClient
+	// Customer is the client for interacting with the Customer builders.
+	Customer *CustomerClient
+	// ExchangeRate is the client for interacting with the ExchangeRate builders.
+	ExchangeRate *ExchangeRateClient
+	// FiscalDocument is the client for interacting with the FiscalDocument builders.
+	FiscalDocument *FiscalDocumentClient
+	// InventoryCostLayer is the client for interacting with the InventoryCostLayer builders.
+	InventoryCostLayer *InventoryCostLayerClient
+	// InventoryCount is the client for interacting with the InventoryCount builders.
+	InventoryCount *InventoryCountClient
+	// InventoryCountEntry is the client for interacting with the InventoryCountEntry builders.
+	InventoryCountEntry *InventoryCountEntryClient
+	// InventoryCountLine is the client for interacting with the InventoryCountLine builders.
+	InventoryCountLine *InventoryCountLineClient
 	// Invoice is the client for interacting with the Invoice builders.
 	Invoice *InvoiceClient
 	// InvoiceItem is the client for interacting with the InvoiceItem builders.
 	InvoiceItem *InvoiceItemClient
+	// InvoiceItemComponent is the client for interacting with the InvoiceItemComponent builders.
+	InvoiceItemComponent *InvoiceItemComponentClient
+	// InvoiceLotAllocation is the client for interacting with the InvoiceLotAllocation builders.
+	InvoiceLotAllocation *InvoiceLotAllocationClient
+	// InvoiceStatusEvent is the client for interacting with the InvoiceStatusEvent builders.
+	InvoiceStatusEvent *InvoiceStatusEventClient
+	// InvoiceTax is the client for interacting with the InvoiceTax builders.
+	InvoiceTax *InvoiceTaxClient
+	// NumberingSeries is the client for interacting with the NumberingSeries builders.
+	NumberingSeries *NumberingSeriesClient
+	// PriceList is the client for interacting with the PriceList builders.
+	PriceList *PriceListClient
+	// PriceListItem is the client for interacting with the PriceListItem builders.
+	PriceListItem *PriceListItemClient
 	// Product is the client for interacting with the Product builders.
 	Product *ProductClient
+	// ProductBarcode is the client for interacting with the ProductBarcode builders.
+	ProductBarcode *ProductBarcodeClient
+	// ProductFile is the client for interacting with the ProductFile builders.
+	ProductFile *ProductFileClient
+	// ProductLot is the client for interacting with the ProductLot builders.
+	ProductLot *ProductLotClient
+	// ProductPrice is the client for interacting with the ProductPrice builders.
+	ProductPrice *ProductPriceClient
+	// ProductSerial is the client for interacting with the ProductSerial builders.
+	ProductSerial *ProductSerialClient
+	// ProductSerialEvent is the client for interacting with the ProductSerialEvent builders.
+	ProductSerialEvent *ProductSerialEventClient
+	// ProductUnit is the client for interacting with the ProductUnit builders.
+	ProductUnit *ProductUnitClient
+	// Promotion is the client for interacting with the Promotion builders.
+	Promotion *PromotionClient
+	// PromotionTarget is the client for interacting with the PromotionTarget builders.
+	PromotionTarget *PromotionTargetClient
 	// PurchaseInvoice is the client for interacting with the PurchaseInvoice builders.
 	PurchaseInvoice *PurchaseInvoiceClient
 	// PurchaseInvoiceItem is the client for interacting with the PurchaseInvoiceItem builders.
 	PurchaseInvoiceItem *PurchaseInvoiceItemClient
+	// StockAdjustment is the client for interacting with the StockAdjustment builders.
+	StockAdjustment *StockAdjustmentClient
+	// StockAdjustmentLine is the client for interacting with the StockAdjustmentLine builders.
+	StockAdjustmentLine *StockAdjustmentLineClient
 	// Supplier is the client for interacting with the Supplier builders.
 	Supplier *SupplierClient
 	// SupplierPayment is the client for interacting with the SupplierPayment builders.
 	SupplierPayment *SupplierPaymentClient
+	// TaxRate is the client for interacting with the TaxRate builders.
+	TaxRate *TaxRateClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
 	// User is the client for interacting with the User builders.
@@ -165,13 +227,44 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AdjustmentReason = NewAdjustmentReasonClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.BundleAssembly = NewBundleAssemblyClient(tx.config)
+	tx.BundleAssemblyLine = NewBundleAssemblyLineClient(tx.config)
+	tx.BundleComponent = NewBundleComponentClient(tx.config)
+	tx.Customer = NewCustomerClient(tx.config)
+	tx.ExchangeRate = NewExchangeRateClient(tx.config)
+	tx.FiscalDocument = NewFiscalDocumentClient(tx.config)
+	tx.InventoryCostLayer = NewInventoryCostLayerClient(tx.config)
+	tx.InventoryCount = NewInventoryCountClient(tx.config)
+	tx.InventoryCountEntry = NewInventoryCountEntryClient(tx.config)
+	tx.InventoryCountLine = NewInventoryCountLineClient(tx.config)
 	tx.Invoice = NewInvoiceClient(tx.config)
 	tx.InvoiceItem = NewInvoiceItemClient(tx.config)
+	tx.InvoiceItemComponent = NewInvoiceItemComponentClient(tx.config)
+	tx.InvoiceLotAllocation = NewInvoiceLotAllocationClient(tx.config)
+	tx.InvoiceStatusEvent = NewInvoiceStatusEventClient(tx.config)
+	tx.InvoiceTax = NewInvoiceTaxClient(tx.config)
+	tx.NumberingSeries = NewNumberingSeriesClient(tx.config)
+	tx.PriceList = NewPriceListClient(tx.config)
+	tx.PriceListItem = NewPriceListItemClient(tx.config)
 	tx.Product = NewProductClient(tx.config)
+	tx.ProductBarcode = NewProductBarcodeClient(tx.config)
+	tx.ProductFile = NewProductFileClient(tx.config)
+	tx.ProductLot = NewProductLotClient(tx.config)
+	tx.ProductPrice = NewProductPriceClient(tx.config)
+	tx.ProductSerial = NewProductSerialClient(tx.config)
+	tx.ProductSerialEvent = NewProductSerialEventClient(tx.config)
+	tx.ProductUnit = NewProductUnitClient(tx.config)
+	tx.Promotion = NewPromotionClient(tx.config)
+	tx.PromotionTarget = NewPromotionTargetClient(tx.config)
 	tx.PurchaseInvoice = NewPurchaseInvoiceClient(tx.config)
 	tx.PurchaseInvoiceItem = NewPurchaseInvoiceItemClient(tx.config)
+	tx.StockAdjustment = NewStockAdjustmentClient(tx.config)
+	tx.StockAdjustmentLine = NewStockAdjustmentLineClient(tx.config)
 	tx.Supplier = NewSupplierClient(tx.config)
 	tx.SupplierPayment = NewSupplierPaymentClient(tx.config)
+	tx.TaxRate = NewTaxRateClient(tx.config)
 	tx.Tenant = NewTenantClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
@@ -183,7 +276,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Invoice.QueryXXX(), the query will be executed
+// applies a query, for example: AdjustmentReason.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -24,6 +24,10 @@ type User struct {
 	Name string `json:"name,omitempty"`
 	// Rol del usuario (admin, manager, user)
 	Role string `json:"role,omitempty"`
+	// Eliminado de forma lógica: no puede iniciar sesión pero sigue resolviéndose desde los documentos
+	Archived bool `json:"archived,omitempty"`
+	// Fecha de eliminación lógica
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// ID del tenant al que pertenece
 	TenantID int `json:"tenant_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -37,11 +41,13 @@ func (*User) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case user.FieldArchived:
+			values[i] = new(sql.NullBool)
 		case user.FieldID, user.FieldTenantID:
 			values[i] = new(sql.NullInt64)
 		case user.FieldEmail, user.FieldPassword, user.FieldName, user.FieldRole:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldDeletedAt, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type User", columns[i])
@@ -88,6 +94,19 @@ func (u *User) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				u.Role = value.String
 			}
+		case user.FieldArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field archived", values[i])
+			} else if value.Valid {
+				u.Archived = value.Bool
+			}
+		case user.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				u.DeletedAt = new(time.Time)
+				*u.DeletedAt = value.Time
+			}
 		case user.FieldTenantID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
@@ -145,6 +164,14 @@ func (u *User) String() string {
 	builder.WriteString("role=")
 	builder.WriteString(u.Role)
 	builder.WriteString(", ")
+	builder.WriteString("archived=")
+	builder.WriteString(fmt.Sprintf("%v", u.Archived))
+	builder.WriteString(", ")
+	if v := u.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("tenant_id=")
 	builder.WriteString(fmt.Sprintf("%v", u.TenantID))
 	builder.WriteString(", ")
@@ -19,6 +19,10 @@ const (
 	FieldName = "name"
 	// FieldRole holds the string denoting the role field in the database.
 	FieldRole = "role"
+	// FieldArchived holds the string denoting the archived field in the database.
+	FieldArchived = "archived"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldTenantID holds the string denoting the tenant_id field in the database.
 	FieldTenantID = "tenant_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -36,6 +40,8 @@ var Columns = []string{
 	FieldPassword,
 	FieldName,
 	FieldRole,
+	FieldArchived,
+	FieldDeletedAt,
 	FieldTenantID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -60,6 +66,8 @@ var (
 	NameValidator func(string) error
 	// DefaultRole holds the default value on creation for the "role" field.
 	DefaultRole string
+	// DefaultArchived holds the default value on creation for the "archived" field.
+	DefaultArchived bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
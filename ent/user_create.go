@@ -52,6 +52,34 @@ func (uc *UserCreate) SetNillableRole(s *string) *UserCreate {
 	return uc
 }
 
+// SetArchived sets the "archived" field.
+func (uc *UserCreate) SetArchived(b bool) *UserCreate {
+	uc.mutation.SetArchived(b)
+	return uc
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (uc *UserCreate) SetNillableArchived(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetArchived(*b)
+	}
+	return uc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uc *UserCreate) SetDeletedAt(t time.Time) *UserCreate {
+	uc.mutation.SetDeletedAt(t)
+	return uc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDeletedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetDeletedAt(*t)
+	}
+	return uc
+}
+
 // SetTenantID sets the "tenant_id" field.
 func (uc *UserCreate) SetTenantID(i int) *UserCreate {
 	uc.mutation.SetTenantID(i)
@@ -167,6 +195,10 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultRole
 		uc.mutation.SetRole(v)
 	}
+	if _, ok := uc.mutation.Archived(); !ok {
+		v := user.DefaultArchived
+		uc.mutation.SetArchived(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		uc.mutation.SetCreatedAt(v)
@@ -206,6 +238,9 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Role(); !ok {
 		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
 	}
+	if _, ok := uc.mutation.Archived(); !ok {
+		return &ValidationError{Name: "archived", err: errors.New(`ent: missing required field "User.archived"`)}
+	}
 	if _, ok := uc.mutation.TenantID(); !ok {
 		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "User.tenant_id"`)}
 	}
@@ -274,6 +309,22 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		})
 		_node.Role = value
 	}
+	if value, ok := uc.mutation.Archived(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: user.FieldArchived,
+		})
+		_node.Archived = value
+	}
+	if value, ok := uc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: user.FieldDeletedAt,
+		})
+		_node.DeletedAt = &value
+	}
 	if value, ok := uc.mutation.TenantID(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
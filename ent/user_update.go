@@ -60,6 +60,40 @@ func (uu *UserUpdate) SetNillableRole(s *string) *UserUpdate {
 	return uu
 }
 
+// SetArchived sets the "archived" field.
+func (uu *UserUpdate) SetArchived(b bool) *UserUpdate {
+	uu.mutation.SetArchived(b)
+	return uu
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableArchived(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetArchived(*b)
+	}
+	return uu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uu *UserUpdate) SetDeletedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetDeletedAt(t)
+	return uu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDeletedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDeletedAt(*t)
+	}
+	return uu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uu *UserUpdate) ClearDeletedAt() *UserUpdate {
+	uu.mutation.ClearDeletedAt()
+	return uu
+}
+
 // SetTenantID sets the "tenant_id" field.
 func (uu *UserUpdate) SetTenantID(i int) *UserUpdate {
 	uu.mutation.ResetTenantID()
@@ -219,6 +253,26 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldRole,
 		})
 	}
+	if value, ok := uu.mutation.Archived(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: user.FieldArchived,
+		})
+	}
+	if value, ok := uu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: user.FieldDeletedAt,
+		})
+	}
+	if uu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: user.FieldDeletedAt,
+		})
+	}
 	if value, ok := uu.mutation.TenantID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -291,6 +345,40 @@ func (uuo *UserUpdateOne) SetNillableRole(s *string) *UserUpdateOne {
 	return uuo
 }
 
+// SetArchived sets the "archived" field.
+func (uuo *UserUpdateOne) SetArchived(b bool) *UserUpdateOne {
+	uuo.mutation.SetArchived(b)
+	return uuo
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableArchived(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetArchived(*b)
+	}
+	return uuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uuo *UserUpdateOne) SetDeletedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletedAt(t)
+	return uuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletedAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
+	uuo.mutation.ClearDeletedAt()
+	return uuo
+}
+
 // SetTenantID sets the "tenant_id" field.
 func (uuo *UserUpdateOne) SetTenantID(i int) *UserUpdateOne {
 	uuo.mutation.ResetTenantID()
@@ -480,6 +568,26 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldRole,
 		})
 	}
+	if value, ok := uuo.mutation.Archived(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: user.FieldArchived,
+		})
+	}
+	if value, ok := uuo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: user.FieldDeletedAt,
+		})
+	}
+	if uuo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: user.FieldDeletedAt,
+		})
+	}
 	if value, ok := uuo.mutation.TenantID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
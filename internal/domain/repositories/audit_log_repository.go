@@ -0,0 +1,112 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/auditlog"
+)
+
+// AuditEntry es un cambio a registrar en la bitácora. Details se guarda como JSON.
+type AuditEntry struct {
+	TenantID int
+	UserID   int
+	Action   string
+	Entity   string
+	EntityID int
+	Summary  string
+	Details  interface{}
+}
+
+// AuditLogFilter filtra la bitácora; los campos vacíos no filtran
+type AuditLogFilter struct {
+	Entity   string
+	EntityID int
+	Action   string
+}
+
+type AuditLogRepository interface {
+	Create(ctx context.Context, entry AuditEntry) (*ent.AuditLog, error)
+	FindAll(ctx context.Context, tenantID int, filter AuditLogFilter, limit, offset int) ([]*ent.AuditLog, int, error)
+}
+
+type auditLogRepository struct {
+	client *ent.Client
+}
+
+func NewAuditLogRepository(client *ent.Client) AuditLogRepository {
+	return &auditLogRepository{client: client}
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, entry AuditEntry) (*ent.AuditLog, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log, err := createAuditLogTx(ctx, tx, entry)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return log, nil
+}
+
+func (r *auditLogRepository) FindAll(ctx context.Context, tenantID int, filter AuditLogFilter, limit, offset int) ([]*ent.AuditLog, int, error) {
+	query := r.client.AuditLog.
+		Query().
+		Where(auditlog.TenantIDEQ(tenantID))
+
+	if filter.Entity != "" {
+		query = query.Where(auditlog.EntityEQ(filter.Entity))
+	}
+	if filter.EntityID > 0 {
+		query = query.Where(auditlog.EntityIDEQ(filter.EntityID))
+	}
+	if filter.Action != "" {
+		query = query.Where(auditlog.ActionEQ(filter.Action))
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	logs, err := query.
+		Limit(limit).
+		Offset(offset).
+		Order(ent.Desc(auditlog.FieldCreatedAt), ent.Desc(auditlog.FieldID)).
+		All(ctx)
+
+	return logs, total, err
+}
+
+// createAuditLogTx registra el cambio dentro de la transacción que lo aplica, para que la
+// bitácora no quede con cambios que se revirtieron
+func createAuditLogTx(ctx context.Context, tx *ent.Tx, entry AuditEntry) (*ent.AuditLog, error) {
+	builder := tx.AuditLog.
+		Create().
+		SetTenantID(entry.TenantID).
+		SetUserID(entry.UserID).
+		SetAction(entry.Action).
+		SetEntity(entry.Entity).
+		SetSummary(entry.Summary)
+
+	if entry.EntityID > 0 {
+		builder.SetEntityID(entry.EntityID)
+	}
+	if entry.Details != nil {
+		details, err := json.Marshal(entry.Details)
+		if err != nil {
+			return nil, err
+		}
+		builder.SetDetails(string(details))
+	}
+
+	return builder.Save(ctx)
+}
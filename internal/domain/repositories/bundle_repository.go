@@ -0,0 +1,320 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/bundleassembly"
+	"Veritasbackend/ent/bundleassemblyline"
+	"Veritasbackend/ent/bundlecomponent"
+	"Veritasbackend/ent/product"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+// BundleComponentInput es un componente de la definición de un combo
+type BundleComponentInput struct {
+	ComponentID int
+	Quantity    float64
+	// RevenueShare es el porcentaje del ingreso para el reparto manual; nil en los demás
+	RevenueShare *float64
+}
+
+type BundleRepository interface {
+	SetComponents(ctx context.Context, tenantID, bundleID int, revenueSplit string, components []BundleComponentInput) error
+	FindComponents(ctx context.Context, bundleID int) ([]*ent.BundleComponent, error)
+	FindComponentsByBundles(ctx context.Context, bundleIDs []int) (map[int][]*ent.BundleComponent, error)
+	IsComponent(ctx context.Context, productID int) (bool, error)
+	Buildable(ctx context.Context, bundleIDs []int) (map[int]float64, error)
+	Assemble(ctx context.Context, tenantID, userID, bundleID int, quantity float64, notes string) (*ent.BundleAssembly, error)
+	FindAssemblies(ctx context.Context, tenantID, bundleID int, limit, offset int) ([]*ent.BundleAssembly, int, error)
+	FindAssemblyLines(ctx context.Context, assemblyIDs []int) (map[int][]*ent.BundleAssemblyLine, error)
+}
+
+type bundleRepository struct {
+	client *ent.Client
+}
+
+func NewBundleRepository(client *ent.Client) BundleRepository {
+	return &bundleRepository{client: client}
+}
+
+// SetComponents reemplaza la definición del combo. Sin componentes el producto deja de ser combo.
+func (r *bundleRepository) SetComponents(ctx context.Context, tenantID, bundleID int, revenueSplit string, components []BundleComponentInput) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.BundleComponent.
+		Delete().
+		Where(bundlecomponent.BundleIDEQ(bundleID)).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	for _, c := range components {
+		err = tx.BundleComponent.
+			Create().
+			SetTenantID(tenantID).
+			SetBundleID(bundleID).
+			SetComponentID(c.ComponentID).
+			SetQuantity(c.Quantity).
+			SetNillableRevenueShare(c.RevenueShare).
+			Exec(ctx)
+		if err != nil {
+			return rollback(tx, err)
+		}
+	}
+
+	err = tx.Product.
+		UpdateOneID(bundleID).
+		SetBundle(len(components) > 0).
+		SetRevenueSplit(revenueSplit).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *bundleRepository) FindComponents(ctx context.Context, bundleID int) ([]*ent.BundleComponent, error) {
+	return r.client.BundleComponent.
+		Query().
+		Where(bundlecomponent.BundleIDEQ(bundleID)).
+		Order(ent.Asc(bundlecomponent.FieldID)).
+		All(ctx)
+}
+
+// FindComponentsByBundles devuelve los componentes agrupados por combo
+func (r *bundleRepository) FindComponentsByBundles(ctx context.Context, bundleIDs []int) (map[int][]*ent.BundleComponent, error) {
+	byBundle := make(map[int][]*ent.BundleComponent)
+	if len(bundleIDs) == 0 {
+		return byBundle, nil
+	}
+
+	components, err := r.client.BundleComponent.
+		Query().
+		Where(bundlecomponent.BundleIDIn(bundleIDs...)).
+		Order(ent.Asc(bundlecomponent.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range components {
+		byBundle[c.BundleID] = append(byBundle[c.BundleID], c)
+	}
+
+	return byBundle, nil
+}
+
+// IsComponent indica si el producto forma parte de algún combo
+func (r *bundleRepository) IsComponent(ctx context.Context, productID int) (bool, error) {
+	return r.client.BundleComponent.
+		Query().
+		Where(bundlecomponent.ComponentIDEQ(productID)).
+		Exist(ctx)
+}
+
+// Buildable devuelve cuántos combos completos se pueden armar con el stock actual de sus
+// componentes, sin contar los que ya están armados
+func (r *bundleRepository) Buildable(ctx context.Context, bundleIDs []int) (map[int]float64, error) {
+	byBundle, err := r.FindComponentsByBundles(ctx, bundleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var componentIDs []int
+	for _, components := range byBundle {
+		for _, c := range components {
+			componentIDs = append(componentIDs, c.ComponentID)
+		}
+	}
+
+	stock := make(map[int]float64)
+	if len(componentIDs) > 0 {
+		products, err := r.client.Product.
+			Query().
+			Where(product.IDIn(componentIDs...)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range products {
+			stock[p.ID] = p.Stock
+		}
+	}
+
+	buildable := make(map[int]float64, len(bundleIDs))
+	for _, bundleID := range bundleIDs {
+		components := byBundle[bundleID]
+		if len(components) == 0 {
+			buildable[bundleID] = 0
+			continue
+		}
+
+		limit := math.MaxFloat64
+		for _, c := range components {
+			if n := math.Floor(qty.Round(stock[c.ComponentID] / c.Quantity)); n < limit {
+				limit = n
+			}
+		}
+		buildable[bundleID] = limit
+	}
+
+	return buildable, nil
+}
+
+// Assemble arma unidades del combo en una sola transacción: descuenta los componentes (y sus
+// lotes vigentes, primero el que vence antes) y suma el combo al stock con el costo de lo
+// consumido, para que luego se venda desde su propio stock.
+func (r *bundleRepository) Assemble(ctx context.Context, tenantID, userID, bundleID int, quantity float64, notes string) (*ent.BundleAssembly, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	components, err := tx.BundleComponent.
+		Query().
+		Where(bundlecomponent.BundleIDEQ(bundleID)).
+		Order(ent.Asc(bundlecomponent.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if len(components) == 0 {
+		return nil, rollback(tx, fmt.Errorf("el producto no tiene componentes definidos"))
+	}
+
+	today := time.Now()
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+
+	type consumed struct {
+		productID int
+		quantity  float64
+		unitCost  money.Amount
+	}
+	lines := make([]consumed, 0, len(components))
+	totalCost := money.Zero
+
+	for _, c := range components {
+		need := qty.Round(c.Quantity * quantity)
+
+		p, err := tx.Product.
+			Query().
+			Where(product.IDEQ(c.ComponentID)).
+			Only(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		if p.Stock < need {
+			return nil, rollback(tx, fmt.Errorf("stock insuficiente de %s: disponible %s, requerido %s", p.Name, qty.Format(p.Stock), qty.Format(need)))
+		}
+
+		unitCost, err := issueStockTx(ctx, tx, p.ID, need)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		if p.TrackLots {
+			if err := consumeLotsTx(ctx, tx, p, need, today); err != nil {
+				return nil, rollback(tx, err)
+			}
+		}
+
+		lines = append(lines, consumed{productID: p.ID, quantity: need, unitCost: unitCost})
+		totalCost += unitCost.Mul(need)
+	}
+
+	builder := tx.BundleAssembly.
+		Create().
+		SetTenantID(tenantID).
+		SetBundleID(bundleID).
+		SetQuantity(quantity).
+		SetUnitCost(totalCost.Div(quantity)).
+		SetUserID(userID)
+
+	if notes != "" {
+		builder.SetNotes(notes)
+	}
+
+	assembly, err := builder.Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	for _, line := range lines {
+		err = tx.BundleAssemblyLine.
+			Create().
+			SetAssemblyID(assembly.ID).
+			SetProductID(line.productID).
+			SetQuantity(line.quantity).
+			SetUnitCost(line.unitCost).
+			Exec(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if err := receiveStockTx(ctx, tx, bundleID, quantity, assembly.UnitCost, "assembly", assembly.ID); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return assembly, nil
+}
+
+// FindAssemblies lista los armados del tenant; con bundleID distinto de 0 solo los de ese combo
+func (r *bundleRepository) FindAssemblies(ctx context.Context, tenantID, bundleID int, limit, offset int) ([]*ent.BundleAssembly, int, error) {
+	query := r.client.BundleAssembly.
+		Query().
+		Where(bundleassembly.TenantIDEQ(tenantID))
+
+	if bundleID != 0 {
+		query = query.Where(bundleassembly.BundleIDEQ(bundleID))
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	assemblies, err := query.
+		Limit(limit).
+		Offset(offset).
+		Order(ent.Desc(bundleassembly.FieldCreatedAt), ent.Desc(bundleassembly.FieldID)).
+		All(ctx)
+
+	return assemblies, total, err
+}
+
+// FindAssemblyLines devuelve los componentes consumidos agrupados por armado
+func (r *bundleRepository) FindAssemblyLines(ctx context.Context, assemblyIDs []int) (map[int][]*ent.BundleAssemblyLine, error) {
+	byAssembly := make(map[int][]*ent.BundleAssemblyLine)
+	if len(assemblyIDs) == 0 {
+		return byAssembly, nil
+	}
+
+	lines, err := r.client.BundleAssemblyLine.
+		Query().
+		Where(bundleassemblyline.AssemblyIDIn(assemblyIDs...)).
+		Order(ent.Asc(bundleassemblyline.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		byAssembly[line.AssemblyID] = append(byAssembly[line.AssemblyID], line)
+	}
+
+	return byAssembly, nil
+}
@@ -0,0 +1,227 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/customer"
+	"Veritasbackend/ent/invoice"
+	"Veritasbackend/ent/predicate"
+)
+
+// CustomerData son los datos editables de un cliente; los textos vacíos se guardan como nulos.
+// PriceListID es la lista de precios por defecto del cliente, nil si no tiene.
+type CustomerData struct {
+	Name           string
+	DocumentType   string
+	DocumentNumber string
+	Email          string
+	Phone          string
+	Address        string
+	TaxRegime      string
+	CustomerGroup  string
+	PriceListID    *int
+}
+
+// CustomerFilter acota el listado de clientes. Search busca en nombre, email y documento;
+// DocumentNumber es la búsqueda ya normalizada para encontrar documentos escritos con
+// puntos o guiones.
+type CustomerFilter struct {
+	Archived       bool
+	Search         string
+	DocumentNumber string
+}
+
+type CustomerRepository interface {
+	FindAll(ctx context.Context, tenantID int, filter CustomerFilter, limit, offset int) ([]*ent.Customer, int, error)
+	FindByID(ctx context.Context, id int) (*ent.Customer, error)
+	FindByDocument(ctx context.Context, tenantID int, documentNumber string) (*ent.Customer, error)
+	Create(ctx context.Context, tenantID int, data CustomerData) (*ent.Customer, error)
+	Update(ctx context.Context, id int, data CustomerData) (*ent.Customer, error)
+	Archive(ctx context.Context, id int) error
+	Restore(ctx context.Context, id int) error
+	CountReferences(ctx context.Context, id int) (References, error)
+	Purge(ctx context.Context, id int) error
+}
+
+type customerRepository struct {
+	client *ent.Client
+}
+
+func NewCustomerRepository(client *ent.Client) CustomerRepository {
+	return &customerRepository{client: client}
+}
+
+// FindAll lista los clientes activos, o los eliminados si el filtro lo pide, ordenados por nombre
+func (r *customerRepository) FindAll(ctx context.Context, tenantID int, filter CustomerFilter, limit, offset int) ([]*ent.Customer, int, error) {
+	predicates := []predicate.Customer{
+		customer.TenantIDEQ(tenantID),
+		customer.ArchivedEQ(filter.Archived),
+	}
+	if filter.Search != "" {
+		matches := []predicate.Customer{
+			customer.NameContainsFold(filter.Search),
+			customer.DocumentNumberContainsFold(filter.Search),
+			customer.EmailContainsFold(filter.Search),
+		}
+		if filter.DocumentNumber != "" {
+			matches = append(matches, customer.DocumentNumberContains(filter.DocumentNumber))
+		}
+		predicates = append(predicates, customer.Or(matches...))
+	}
+
+	query := r.client.Customer.
+		Query().
+		Where(predicates...)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	customers, err := query.
+		Limit(limit).
+		Offset(offset).
+		Order(ent.Asc(customer.FieldName)).
+		All(ctx)
+
+	return customers, total, err
+}
+
+func (r *customerRepository) FindByID(ctx context.Context, id int) (*ent.Customer, error) {
+	return r.client.Customer.
+		Query().
+		Where(customer.IDEQ(id)).
+		Only(ctx)
+}
+
+// FindByDocument busca el cliente del tenant con ese número de documento, incluidos los
+// eliminados, para no duplicarlo
+func (r *customerRepository) FindByDocument(ctx context.Context, tenantID int, documentNumber string) (*ent.Customer, error) {
+	return r.client.Customer.
+		Query().
+		Where(
+			customer.TenantIDEQ(tenantID),
+			customer.DocumentNumberEQ(documentNumber),
+		).
+		Only(ctx)
+}
+
+func (r *customerRepository) Create(ctx context.Context, tenantID int, data CustomerData) (*ent.Customer, error) {
+	builder := r.client.Customer.
+		Create().
+		SetTenantID(tenantID).
+		SetName(data.Name)
+
+	if data.DocumentType != "" {
+		builder.SetDocumentType(data.DocumentType)
+	}
+	if data.DocumentNumber != "" {
+		builder.SetDocumentNumber(data.DocumentNumber)
+	}
+	if data.Email != "" {
+		builder.SetEmail(data.Email)
+	}
+	if data.Phone != "" {
+		builder.SetPhone(data.Phone)
+	}
+	if data.Address != "" {
+		builder.SetAddress(data.Address)
+	}
+	if data.TaxRegime != "" {
+		builder.SetTaxRegime(data.TaxRegime)
+	}
+	if data.CustomerGroup != "" {
+		builder.SetCustomerGroup(data.CustomerGroup)
+	}
+	builder.SetNillablePriceListID(data.PriceListID)
+
+	return builder.Save(ctx)
+}
+
+func (r *customerRepository) Update(ctx context.Context, id int, data CustomerData) (*ent.Customer, error) {
+	builder := r.client.Customer.
+		UpdateOneID(id).
+		SetName(data.Name)
+
+	if data.DocumentType != "" {
+		builder.SetDocumentType(data.DocumentType)
+	} else {
+		builder.ClearDocumentType()
+	}
+	if data.DocumentNumber != "" {
+		builder.SetDocumentNumber(data.DocumentNumber)
+	} else {
+		builder.ClearDocumentNumber()
+	}
+	if data.Email != "" {
+		builder.SetEmail(data.Email)
+	} else {
+		builder.ClearEmail()
+	}
+	if data.Phone != "" {
+		builder.SetPhone(data.Phone)
+	} else {
+		builder.ClearPhone()
+	}
+	if data.Address != "" {
+		builder.SetAddress(data.Address)
+	} else {
+		builder.ClearAddress()
+	}
+	if data.TaxRegime != "" {
+		builder.SetTaxRegime(data.TaxRegime)
+	} else {
+		builder.ClearTaxRegime()
+	}
+	if data.CustomerGroup != "" {
+		builder.SetCustomerGroup(data.CustomerGroup)
+	} else {
+		builder.ClearCustomerGroup()
+	}
+	if data.PriceListID != nil {
+		builder.SetPriceListID(*data.PriceListID)
+	} else {
+		builder.ClearPriceListID()
+	}
+
+	return builder.Save(ctx)
+}
+
+// Archive elimina el cliente de forma lógica; sus facturas lo siguen resolviendo
+func (r *customerRepository) Archive(ctx context.Context, id int) error {
+	return r.client.Customer.
+		UpdateOneID(id).
+		SetArchived(true).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (r *customerRepository) Restore(ctx context.Context, id int) error {
+	return r.client.Customer.
+		UpdateOneID(id).
+		SetArchived(false).
+		ClearDeletedAt().
+		Exec(ctx)
+}
+
+// CountReferences cuenta las facturas emitidas al cliente
+func (r *customerRepository) CountReferences(ctx context.Context, id int) (References, error) {
+	refs := References{}
+
+	invoices, err := r.client.Invoice.Query().Where(invoice.CustomerIDEQ(id)).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refs.add("facturas", invoices)
+
+	return refs, nil
+}
+
+// Purge borra el cliente; quien llama debe revisar antes CountReferences
+func (r *customerRepository) Purge(ctx context.Context, id int) error {
+	return r.client.Customer.
+		DeleteOneID(id).
+		Exec(ctx)
+}
@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/exchangerate"
+)
+
+// ExchangeRateData es la tasa de una moneda para un día. Date debe venir truncada al día.
+type ExchangeRateData struct {
+	Currency string
+	Date     time.Time
+	Rate     float64
+	Source   string
+	UserID   int
+}
+
+type ExchangeRateRepository interface {
+	FindAll(ctx context.Context, tenantID int, currency string, from, to *time.Time) ([]*ent.ExchangeRate, error)
+	FindByID(ctx context.Context, id int) (*ent.ExchangeRate, error)
+	FindEffective(ctx context.Context, tenantID int, currency string, date time.Time) (*ent.ExchangeRate, error)
+	Save(ctx context.Context, tenantID int, data ExchangeRateData) (*ent.ExchangeRate, bool, error)
+	Delete(ctx context.Context, id int) error
+}
+
+type exchangeRateRepository struct {
+	client *ent.Client
+}
+
+func NewExchangeRateRepository(client *ent.Client) ExchangeRateRepository {
+	return &exchangeRateRepository{client: client}
+}
+
+// FindAll lista las tasas del tenant, de la más reciente a la más antigua; currency, from y to
+// son filtros opcionales
+func (r *exchangeRateRepository) FindAll(ctx context.Context, tenantID int, currency string, from, to *time.Time) ([]*ent.ExchangeRate, error) {
+	query := r.client.ExchangeRate.
+		Query().
+		Where(exchangerate.TenantIDEQ(tenantID))
+
+	if currency != "" {
+		query.Where(exchangerate.CurrencyEQ(currency))
+	}
+	if from != nil {
+		query.Where(exchangerate.DateGTE(*from))
+	}
+	if to != nil {
+		query.Where(exchangerate.DateLTE(*to))
+	}
+
+	return query.
+		Order(ent.Desc(exchangerate.FieldDate), ent.Asc(exchangerate.FieldCurrency)).
+		All(ctx)
+}
+
+func (r *exchangeRateRepository) FindByID(ctx context.Context, id int) (*ent.ExchangeRate, error) {
+	return r.client.ExchangeRate.
+		Query().
+		Where(exchangerate.IDEQ(id)).
+		Only(ctx)
+}
+
+// FindEffective devuelve la tasa que rige en la fecha: la del día o, si ese día no se cargó,
+// la última anterior. NotFound si la moneda no tiene tasas hasta esa fecha.
+func (r *exchangeRateRepository) FindEffective(ctx context.Context, tenantID int, currency string, date time.Time) (*ent.ExchangeRate, error) {
+	return r.client.ExchangeRate.
+		Query().
+		Where(
+			exchangerate.TenantIDEQ(tenantID),
+			exchangerate.CurrencyEQ(currency),
+			exchangerate.DateLTE(date),
+		).
+		Order(ent.Desc(exchangerate.FieldDate)).
+		First(ctx)
+}
+
+// Save registra la tasa del día; si la moneda ya tenía tasa ese día la reemplaza. Devuelve
+// true si la tasa es nueva.
+func (r *exchangeRateRepository) Save(ctx context.Context, tenantID int, data ExchangeRateData) (*ent.ExchangeRate, bool, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	existing, err := tx.ExchangeRate.
+		Query().
+		Where(
+			exchangerate.TenantIDEQ(tenantID),
+			exchangerate.CurrencyEQ(data.Currency),
+			exchangerate.DateEQ(data.Date),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, false, rollback(tx, err)
+	}
+
+	var rate *ent.ExchangeRate
+	if existing != nil {
+		update := tx.ExchangeRate.
+			UpdateOneID(existing.ID).
+			SetRate(data.Rate).
+			SetSource(data.Source)
+		if data.UserID != 0 {
+			update.SetUserID(data.UserID)
+		}
+		rate, err = update.Save(ctx)
+	} else {
+		create := tx.ExchangeRate.
+			Create().
+			SetTenantID(tenantID).
+			SetCurrency(data.Currency).
+			SetDate(data.Date).
+			SetRate(data.Rate).
+			SetSource(data.Source)
+		if data.UserID != 0 {
+			create.SetUserID(data.UserID)
+		}
+		rate, err = create.Save(ctx)
+	}
+	if err != nil {
+		return nil, false, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+
+	return rate, existing == nil, nil
+}
+
+func (r *exchangeRateRepository) Delete(ctx context.Context, id int) error {
+	return r.client.ExchangeRate.
+		DeleteOneID(id).
+		Exec(ctx)
+}
@@ -0,0 +1,241 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/fiscaldocument"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+)
+
+// FiscalDocumentData es un documento electrónico nuevo. Sin Number, el número se toma de la
+// serie de numeración vigente del tipo de documento.
+type FiscalDocumentData struct {
+	TenantID          int
+	InvoiceID         int
+	DocumentType      string
+	Number            string
+	ReferenceID       *int
+	DiscrepancyCode   string
+	DiscrepancyReason string
+	Currency          string
+	Total             money.Amount
+	IssuedAt          time.Time
+	UserID            int
+}
+
+// FiscalSubmission es el resultado de firmar, archivar y enviar un documento
+type FiscalSubmission struct {
+	CUFE        string
+	Digest      string
+	XMLKey      string
+	PDFKey      string
+	Provider    string
+	TrackID     string
+	Status      string
+	Reasons     []string
+	SubmittedAt *time.Time
+	AcceptedAt  *time.Time
+}
+
+type FiscalDocumentFilter struct {
+	Status       string
+	DocumentType string
+	InvoiceID    *int
+}
+
+type FiscalDocumentRepository interface {
+	FindAll(ctx context.Context, tenantID int, filter FiscalDocumentFilter, limit, offset int) ([]*ent.FiscalDocument, int, error)
+	FindByID(ctx context.Context, id int) (*ent.FiscalDocument, error)
+	FindByInvoice(ctx context.Context, invoiceID int) ([]*ent.FiscalDocument, error)
+	Create(ctx context.Context, data FiscalDocumentData) (*ent.FiscalDocument, error)
+	Reissue(ctx context.Context, id int, issuedAt time.Time, total money.Amount, userID int) (*ent.FiscalDocument, error)
+	SaveSubmission(ctx context.Context, id int, submission FiscalSubmission) (*ent.FiscalDocument, error)
+	SetStatus(ctx context.Context, id int, status string, reasons []string, acceptedAt *time.Time) (*ent.FiscalDocument, error)
+}
+
+type fiscalDocumentRepository struct {
+	client *ent.Client
+}
+
+func NewFiscalDocumentRepository(client *ent.Client) FiscalDocumentRepository {
+	return &fiscalDocumentRepository{client: client}
+}
+
+// FindAll lista los documentos del tenant, del más reciente al más antiguo
+func (r *fiscalDocumentRepository) FindAll(ctx context.Context, tenantID int, filter FiscalDocumentFilter, limit, offset int) ([]*ent.FiscalDocument, int, error) {
+	query := r.client.FiscalDocument.
+		Query().
+		Where(fiscaldocument.TenantIDEQ(tenantID))
+
+	if filter.Status != "" {
+		query.Where(fiscaldocument.StatusEQ(filter.Status))
+	}
+	if filter.DocumentType != "" {
+		query.Where(fiscaldocument.DocumentTypeEQ(filter.DocumentType))
+	}
+	if filter.InvoiceID != nil {
+		query.Where(fiscaldocument.InvoiceIDEQ(*filter.InvoiceID))
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	documents, err := query.
+		Limit(limit).
+		Offset(offset).
+		Order(ent.Desc(fiscaldocument.FieldIssuedAt), ent.Desc(fiscaldocument.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return documents, total, nil
+}
+
+func (r *fiscalDocumentRepository) FindByID(ctx context.Context, id int) (*ent.FiscalDocument, error) {
+	return r.client.FiscalDocument.
+		Query().
+		Where(fiscaldocument.IDEQ(id)).
+		Only(ctx)
+}
+
+// FindByInvoice devuelve la factura electrónica de una venta y sus notas crédito, en el orden
+// en que se emitieron
+func (r *fiscalDocumentRepository) FindByInvoice(ctx context.Context, invoiceID int) ([]*ent.FiscalDocument, error) {
+	return r.client.FiscalDocument.
+		Query().
+		Where(fiscaldocument.InvoiceIDEQ(invoiceID)).
+		Order(ent.Asc(fiscaldocument.FieldID)).
+		All(ctx)
+}
+
+// Create registra el documento en estado pending. El número es único por tenant y tipo de
+// documento: si ya existe devuelve ErrAlreadyExists.
+func (r *fiscalDocumentRepository) Create(ctx context.Context, data FiscalDocumentData) (*ent.FiscalDocument, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	number := data.Number
+	if number == "" {
+		if number, _, err = assignNumberTx(ctx, tx, data.TenantID, data.DocumentType, data.IssuedAt); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	builder := tx.FiscalDocument.
+		Create().
+		SetTenantID(data.TenantID).
+		SetInvoiceID(data.InvoiceID).
+		SetDocumentType(data.DocumentType).
+		SetNillableReferenceID(data.ReferenceID).
+		SetCurrency(data.Currency).
+		SetTotal(data.Total).
+		SetNumber(number).
+		SetIssuedAt(data.IssuedAt).
+		SetUserID(data.UserID)
+
+	if data.DiscrepancyCode != "" {
+		builder.SetDiscrepancyCode(data.DiscrepancyCode)
+	}
+	if data.DiscrepancyReason != "" {
+		builder.SetDiscrepancyReason(data.DiscrepancyReason)
+	}
+
+	doc, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			err = pkg_errors.ErrAlreadyExists
+		}
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// Reissue vuelve a pending un documento rechazado o con error de envío para generarlo y enviarlo
+// de nuevo con el mismo número. ErrInvalidState si el documento está en otro estado.
+func (r *fiscalDocumentRepository) Reissue(ctx context.Context, id int, issuedAt time.Time, total money.Amount, userID int) (*ent.FiscalDocument, error) {
+	updated, err := r.client.FiscalDocument.
+		Update().
+		Where(
+			fiscaldocument.IDEQ(id),
+			fiscaldocument.StatusIn("rejected", "failed"),
+		).
+		SetStatus("pending").
+		ClearRejectionReasons().
+		ClearTrackID().
+		SetIssuedAt(issuedAt).
+		SetTotal(total).
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, fmt.Errorf("%w: el documento ya fue enviado", pkg_errors.ErrInvalidState)
+	}
+
+	return r.FindByID(ctx, id)
+}
+
+// SaveSubmission guarda el código único, los archivos y la respuesta del proveedor
+func (r *fiscalDocumentRepository) SaveSubmission(ctx context.Context, id int, submission FiscalSubmission) (*ent.FiscalDocument, error) {
+	builder := r.client.FiscalDocument.
+		UpdateOneID(id).
+		SetCufe(submission.CUFE).
+		SetDigest(submission.Digest).
+		SetXMLKey(submission.XMLKey).
+		SetPdfKey(submission.PDFKey).
+		SetProvider(submission.Provider).
+		SetTrackID(submission.TrackID).
+		SetStatus(submission.Status).
+		SetNillableSubmittedAt(submission.SubmittedAt).
+		SetNillableAcceptedAt(submission.AcceptedAt)
+
+	if len(submission.Reasons) > 0 {
+		builder.SetRejectionReasons(submission.Reasons)
+	} else {
+		builder.ClearRejectionReasons()
+	}
+
+	return builder.Save(ctx)
+}
+
+// SetStatus registra la respuesta de la autoridad a un documento pendiente. ErrInvalidState si
+// el documento ya tenía una respuesta.
+func (r *fiscalDocumentRepository) SetStatus(ctx context.Context, id int, status string, reasons []string, acceptedAt *time.Time) (*ent.FiscalDocument, error) {
+	builder := r.client.FiscalDocument.
+		Update().
+		Where(
+			fiscaldocument.IDEQ(id),
+			fiscaldocument.StatusEQ("pending"),
+		).
+		SetStatus(status).
+		SetNillableAcceptedAt(acceptedAt)
+
+	if len(reasons) > 0 {
+		builder.SetRejectionReasons(reasons)
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, fmt.Errorf("%w: el documento ya tiene respuesta de la autoridad", pkg_errors.ErrInvalidState)
+	}
+
+	return r.FindByID(ctx, id)
+}
@@ -0,0 +1,249 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/inventorycostlayer"
+	"Veritasbackend/ent/product"
+	"Veritasbackend/ent/tenant"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+// InventoryCostRepository mueve el stock de un producto junto con su costo. Las capas de
+// costo se mantienen siempre; el método del tenant decide si el costo de salida es el
+// promedio ponderado (average) o el de las capas más antiguas (fifo).
+type InventoryCostRepository interface {
+	FindLayers(ctx context.Context, productID int) ([]*ent.InventoryCostLayer, error)
+}
+
+type inventoryCostRepository struct {
+	client *ent.Client
+}
+
+func NewInventoryCostRepository(client *ent.Client) InventoryCostRepository {
+	return &inventoryCostRepository{client: client}
+}
+
+func (r *inventoryCostRepository) FindLayers(ctx context.Context, productID int) ([]*ent.InventoryCostLayer, error) {
+	return r.client.InventoryCostLayer.
+		Query().
+		Where(
+			inventorycostlayer.ProductIDEQ(productID),
+			inventorycostlayer.RemainingGT(0),
+		).
+		Order(ent.Asc(inventorycostlayer.FieldCreatedAt), ent.Asc(inventorycostlayer.FieldID)).
+		All(ctx)
+}
+
+// costingMethodTx devuelve el método de costeo del tenant del producto
+func costingMethodTx(ctx context.Context, tx *ent.Tx, tenantID int) (string, error) {
+	t, err := tx.Tenant.
+		Query().
+		Where(tenant.IDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return t.CostingMethod, nil
+}
+
+// lockProductTx lee el producto bloqueando su fila hasta el fin de la transacción: los
+// movimientos de stock del mismo producto se aplican uno detrás de otro y ninguno pisa el stock
+// ni el costo que calculó otro
+func lockProductTx(ctx context.Context, tx *ent.Tx, productID int) (*ent.Product, error) {
+	return tx.Product.
+		Query().
+		Where(product.IDEQ(productID)).
+		ForUpdate().
+		Only(ctx)
+}
+
+// openLayersTx lee las capas abiertas del producto, de la más antigua a la más reciente, y las
+// bloquea hasta el fin de la transacción para que dos salidas no consuman la misma capa
+func openLayersTx(ctx context.Context, tx *ent.Tx, productID int) ([]*ent.InventoryCostLayer, error) {
+	return tx.InventoryCostLayer.
+		Query().
+		Where(
+			inventorycostlayer.ProductIDEQ(productID),
+			inventorycostlayer.RemainingGT(0),
+		).
+		Order(ent.Asc(inventorycostlayer.FieldCreatedAt), ent.Asc(inventorycostlayer.FieldID)).
+		ForUpdate().
+		All(ctx)
+}
+
+// receiveStockTx registra una capa de ingreso y actualiza stock y costo del producto dentro de
+// una transacción. El stock anterior a la primera capa (productos cargados a mano o por CSV)
+// se convierte en una capa de apertura al costo vigente para que FIFO lo consuma primero.
+func receiveStockTx(ctx context.Context, tx *ent.Tx, productID int, quantity float64, unitCost money.Amount, sourceType string, sourceID int) error {
+	quantity = qty.Round(quantity)
+	if quantity <= 0 {
+		return fmt.Errorf("la cantidad a ingresar debe ser mayor a 0")
+	}
+	if unitCost < 0 {
+		return fmt.Errorf("el costo unitario no puede ser negativo")
+	}
+
+	p, err := lockProductTx(ctx, tx, productID)
+	if err != nil {
+		return err
+	}
+
+	method, err := costingMethodTx(ctx, tx, p.TenantID)
+	if err != nil {
+		return err
+	}
+
+	layers, err := openLayersTx(ctx, tx, productID)
+	if err != nil {
+		return err
+	}
+
+	layered := 0.0
+	valuation := money.Zero
+	for _, layer := range layers {
+		layered += layer.Remaining
+		valuation += layer.UnitCost.Mul(layer.Remaining)
+	}
+
+	if unlayered := qty.Round(p.Stock - layered); unlayered > 0 {
+		err = tx.InventoryCostLayer.
+			Create().
+			SetTenantID(p.TenantID).
+			SetProductID(productID).
+			SetSourceType("opening").
+			SetQuantity(unlayered).
+			SetRemaining(unlayered).
+			SetUnitCost(p.PurchasePrice).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		valuation += p.PurchasePrice.Mul(unlayered)
+	}
+
+	builder := tx.InventoryCostLayer.
+		Create().
+		SetTenantID(p.TenantID).
+		SetProductID(productID).
+		SetSourceType(sourceType).
+		SetQuantity(quantity).
+		SetRemaining(quantity).
+		SetUnitCost(unitCost)
+
+	if sourceID != 0 {
+		builder.SetSourceID(sourceID)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		return err
+	}
+
+	newStock := qty.Round(p.Stock + quantity)
+	newCost := p.PurchasePrice
+	if method == "fifo" {
+		// Con FIFO el costo del producto es el valor de las capas abiertas sobre las unidades
+		newCost = (valuation + unitCost.Mul(quantity)).Div(newStock)
+	} else if p.Stock <= 0 {
+		newCost = unitCost
+	} else {
+		newCost = (p.PurchasePrice.Mul(p.Stock) + unitCost.Mul(quantity)).Div(newStock)
+	}
+
+	return tx.Product.
+		UpdateOneID(productID).
+		SetStock(newStock).
+		SetPurchasePrice(newCost).
+		Exec(ctx)
+}
+
+// issueStockTx descuenta stock consumiendo las capas más antiguas y devuelve el costo
+// unitario de salida según el método del tenant
+func issueStockTx(ctx context.Context, tx *ent.Tx, productID int, quantity float64) (money.Amount, error) {
+	quantity = qty.Round(quantity)
+	if quantity <= 0 {
+		return 0, fmt.Errorf("la cantidad a descontar debe ser mayor a 0")
+	}
+
+	p, err := lockProductTx(ctx, tx, productID)
+	if err != nil {
+		return 0, err
+	}
+
+	if p.Stock < quantity {
+		return 0, fmt.Errorf("stock insuficiente: disponible %s, solicitado %s", qty.Format(p.Stock), qty.Format(quantity))
+	}
+
+	method, err := costingMethodTx(ctx, tx, p.TenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	layers, err := openLayersTx(ctx, tx, productID)
+	if err != nil {
+		return 0, err
+	}
+
+	layered := 0.0
+	for _, layer := range layers {
+		layered += layer.Remaining
+	}
+
+	// Las unidades sin capa son anteriores a cualquier compra registrada y salen primero
+	unlayered := qty.Round(p.Stock - layered)
+	if unlayered < 0 {
+		unlayered = 0
+	}
+	takeUnlayered := unlayered
+	if takeUnlayered > quantity {
+		takeUnlayered = quantity
+	}
+
+	pending := qty.Round(quantity - takeUnlayered)
+	fifoCost := p.PurchasePrice.Mul(takeUnlayered)
+	remainingValuation := p.PurchasePrice.Mul(unlayered - takeUnlayered)
+
+	for _, layer := range layers {
+		take := layer.Remaining
+		if take > pending {
+			take = pending
+		}
+		if take > 0 {
+			fifoCost += layer.UnitCost.Mul(take)
+			pending = qty.Round(pending - take)
+			err = tx.InventoryCostLayer.
+				UpdateOneID(layer.ID).
+				SetRemaining(qty.Round(layer.Remaining - take)).
+				Exec(ctx)
+			if err != nil {
+				return 0, err
+			}
+		}
+		remainingValuation += layer.UnitCost.Mul(layer.Remaining - take)
+	}
+
+	newStock := qty.Round(p.Stock - quantity)
+	unitCost := p.PurchasePrice
+	newCost := p.PurchasePrice
+	if method == "fifo" {
+		unitCost = fifoCost.Div(quantity)
+		if newStock > 0 {
+			newCost = remainingValuation.Div(newStock)
+		}
+	}
+
+	err = tx.Product.
+		UpdateOneID(productID).
+		SetStock(newStock).
+		SetPurchasePrice(newCost).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return unitCost, nil
+}
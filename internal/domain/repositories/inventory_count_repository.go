@@ -0,0 +1,300 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/inventorycount"
+	"Veritasbackend/ent/inventorycountentry"
+	"Veritasbackend/ent/inventorycountline"
+	pkg_errors "Veritasbackend/pkg/errors"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+// CountEntry es una cantidad contada de un producto desde un dispositivo
+type CountEntry struct {
+	ProductID int
+	Quantity  float64
+}
+
+type InventoryCountRepository interface {
+	Create(ctx context.Context, tenantID, userID int, name, notes string, products []*ent.Product) (*ent.InventoryCount, error)
+	FindByID(ctx context.Context, id int) (*ent.InventoryCount, error)
+	FindAll(ctx context.Context, tenantID int, limit, offset int) ([]*ent.InventoryCount, int, error)
+	FindLines(ctx context.Context, countID int) ([]*ent.InventoryCountLine, error)
+	AddEntries(ctx context.Context, countID, userID int, deviceID string, entries []CountEntry) error
+	SumEntriesByLine(ctx context.Context, countID int) (map[int]float64, error)
+	Post(ctx context.Context, countID, userID int) error
+	Cancel(ctx context.Context, countID int) error
+}
+
+type inventoryCountRepository struct {
+	client *ent.Client
+}
+
+func NewInventoryCountRepository(client *ent.Client) InventoryCountRepository {
+	return &inventoryCountRepository{client: client}
+}
+
+// Create abre la toma y guarda una foto del stock y costo de cada producto
+func (r *inventoryCountRepository) Create(ctx context.Context, tenantID, userID int, name, notes string, products []*ent.Product) (*ent.InventoryCount, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := tx.InventoryCount.
+		Create().
+		SetTenantID(tenantID).
+		SetUserID(userID).
+		SetName(name).
+		SetStatus("open")
+
+	if notes != "" {
+		builder.SetNotes(notes)
+	}
+
+	count, err := builder.Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	for _, p := range products {
+		_, err = tx.InventoryCountLine.
+			Create().
+			SetCountID(count.ID).
+			SetProductID(p.ID).
+			SetExpectedQuantity(p.Stock).
+			SetUnitCost(p.PurchasePrice).
+			Save(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return count, nil
+}
+
+func (r *inventoryCountRepository) FindByID(ctx context.Context, id int) (*ent.InventoryCount, error) {
+	return r.client.InventoryCount.
+		Query().
+		Where(inventorycount.IDEQ(id)).
+		Only(ctx)
+}
+
+func (r *inventoryCountRepository) FindAll(ctx context.Context, tenantID int, limit, offset int) ([]*ent.InventoryCount, int, error) {
+	query := r.client.InventoryCount.
+		Query().
+		Where(inventorycount.TenantIDEQ(tenantID))
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	counts, err := query.
+		Limit(limit).
+		Offset(offset).
+		Order(ent.Desc(inventorycount.FieldCreatedAt)).
+		All(ctx)
+
+	return counts, total, err
+}
+
+func (r *inventoryCountRepository) FindLines(ctx context.Context, countID int) ([]*ent.InventoryCountLine, error) {
+	return r.client.InventoryCountLine.
+		Query().
+		Where(inventorycountline.CountIDEQ(countID)).
+		Order(ent.Asc(inventorycountline.FieldID)).
+		All(ctx)
+}
+
+// AddEntries guarda los conteos de un dispositivo. Cada conteo es un registro aparte, así
+// varios dispositivos pueden contar el mismo producto sin pisarse. La toma se bloquea y se
+// verifica que siga abierta dentro de la transacción: un conteo que llega mientras se
+// contabiliza espera al commit y recibe ErrInvalidState, en vez de quedar fuera del ajuste.
+func (r *inventoryCountRepository) AddEntries(ctx context.Context, countID, userID int, deviceID string, entries []CountEntry) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	count, err := tx.InventoryCount.
+		Query().
+		Where(inventorycount.IDEQ(countID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if count.Status != "open" {
+		return rollback(tx, fmt.Errorf("%w: la toma ya no está abierta", pkg_errors.ErrInvalidState))
+	}
+
+	for _, entry := range entries {
+		line, err := tx.InventoryCountLine.
+			Query().
+			Where(
+				inventorycountline.CountIDEQ(countID),
+				inventorycountline.ProductIDEQ(entry.ProductID),
+			).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				err = fmt.Errorf("el producto %d no forma parte de la toma", entry.ProductID)
+			}
+			return rollback(tx, err)
+		}
+
+		builder := tx.InventoryCountEntry.
+			Create().
+			SetCountID(countID).
+			SetLineID(line.ID).
+			SetQuantity(entry.Quantity).
+			SetUserID(userID)
+
+		if deviceID != "" {
+			builder.SetDeviceID(deviceID)
+		}
+
+		if _, err := builder.Save(ctx); err != nil {
+			return rollback(tx, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// SumEntriesByLine devuelve la cantidad contada por línea; las líneas sin conteos no aparecen
+func (r *inventoryCountRepository) SumEntriesByLine(ctx context.Context, countID int) (map[int]float64, error) {
+	return sumCountEntries(ctx, r.client.InventoryCountEntry, countID)
+}
+
+// sumCountEntries suma los conteos por línea con el cliente indicado, el del repositorio o
+// el de una transacción
+func sumCountEntries(ctx context.Context, client *ent.InventoryCountEntryClient, countID int) (map[int]float64, error) {
+	entries, err := client.
+		Query().
+		Where(inventorycountentry.CountIDEQ(countID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sums := make(map[int]float64)
+	for _, entry := range entries {
+		sums[entry.LineID] = qty.Round(sums[entry.LineID] + entry.Quantity)
+	}
+
+	return sums, nil
+}
+
+// Post aplica las diferencias de la toma sobre el stock actual. Se suma la variación
+// (contado - esperado) en vez de sobrescribir el stock, para respetar las ventas hechas
+// mientras se contaba. El paso a posted va primero y condicionado a que la toma siga abierta:
+// bloquea la fila hasta el commit, así una toma no se aplica dos veces. Si ya no está abierta
+// devuelve ErrInvalidState. Los conteos se suman después, en la misma transacción, para incluir
+// los que se registraron hasta el último momento (AddEntries espera el bloqueo de la toma).
+//
+// Los productos con lotes o números de serie no se ajustan aquí porque la toma no dice a qué
+// lote o serie corresponde la diferencia: si alguno tiene diferencia, la toma se rechaza.
+func (r *inventoryCountRepository) Post(ctx context.Context, countID, userID int) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated, err := tx.InventoryCount.
+		Update().
+		Where(
+			inventorycount.IDEQ(countID),
+			inventorycount.StatusEQ("open"),
+		).
+		SetStatus("posted").
+		SetPostedBy(userID).
+		SetPostedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if updated == 0 {
+		return rollback(tx, fmt.Errorf("%w: la toma ya no está abierta", pkg_errors.ErrInvalidState))
+	}
+
+	counted, err := sumCountEntries(ctx, tx.InventoryCountEntry, countID)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if len(counted) == 0 {
+		return rollback(tx, fmt.Errorf("la toma no tiene conteos registrados"))
+	}
+
+	lines, err := tx.InventoryCountLine.
+		Query().
+		Where(inventorycountline.CountIDEQ(countID)).
+		All(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	for _, line := range lines {
+		quantity, ok := counted[line.ID]
+		if !ok {
+			continue
+		}
+		if quantity < 0 {
+			return rollback(tx, fmt.Errorf("el producto %d tiene una cantidad contada negativa (%s)", line.ProductID, qty.Format(quantity)))
+		}
+
+		variance := qty.Round(quantity - line.ExpectedQuantity)
+		if variance != 0 {
+			p, err := lockProductTx(ctx, tx, line.ProductID)
+			if err != nil {
+				return rollback(tx, err)
+			}
+			if p.TrackLots || p.Serialized {
+				return rollback(tx, fmt.Errorf("el producto %s se controla por lote o número de serie y tiene una diferencia de %s: corríjala con un ajuste de stock que indique el lote o la serie", p.Name, qty.Format(variance)))
+			}
+		}
+
+		if variance > 0 {
+			err = receiveStockTx(ctx, tx, line.ProductID, variance, line.UnitCost, "inventory_count", countID)
+		} else if variance < 0 {
+			_, err = issueStockTx(ctx, tx, line.ProductID, -variance)
+		}
+		if err != nil {
+			return rollback(tx, fmt.Errorf("error al ajustar el producto %d: %w", line.ProductID, err))
+		}
+
+		if err := tx.InventoryCountLine.UpdateOneID(line.ID).SetCountedQuantity(quantity).Exec(ctx); err != nil {
+			return rollback(tx, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Cancel cancela una toma abierta; ErrInvalidState si ya se aplicó o canceló
+func (r *inventoryCountRepository) Cancel(ctx context.Context, countID int) error {
+	updated, err := r.client.InventoryCount.
+		Update().
+		Where(
+			inventorycount.IDEQ(countID),
+			inventorycount.StatusEQ("open"),
+		).
+		SetStatus("cancelled").
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return fmt.Errorf("%w: solo se puede cancelar una toma abierta", pkg_errors.ErrInvalidState)
+	}
+	return nil
+}
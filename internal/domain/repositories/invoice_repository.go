@@ -2,30 +2,125 @@ package repositories
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"time"
 
 	"Veritasbackend/ent"
 	"Veritasbackend/ent/invoice"
 	"Veritasbackend/ent/invoiceitem"
+	"Veritasbackend/ent/invoiceitemcomponent"
+	"Veritasbackend/ent/invoicelotallocation"
+	"Veritasbackend/ent/invoicestatusevent"
+	"Veritasbackend/ent/predicate"
 	"Veritasbackend/ent/product"
+	"Veritasbackend/ent/productbarcode"
+	"Veritasbackend/ent/productserial"
+	"Veritasbackend/pkg/barcode"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
 )
 
 type InvoiceItem struct {
 	ProductID int
-	Quantity  int
-	UnitPrice float64
-	Subtotal  float64
+	// Quantity y UnitPrice van en la unidad base del producto. FromStock es lo que sale del stock
+	// del producto: toda la cantidad, salvo en un combo, donde el resto se arma en el momento con
+	// los componentes.
+	Quantity  float64
+	FromStock float64
+	UnitPrice money.Amount
+	Subtotal  money.Amount
+	// PromotionID es 0 si no se aplicó una promoción; PromotionDiscount es lo que descontó
+	PromotionID       int
+	PromotionDiscount money.Amount
+	// DiscountAmount es el descuento de la línea e InvoiceDiscountAmount su parte del descuento
+	// de la factura; TaxBase se calcula después de la promoción y de ambos descuentos
+	DiscountAmount        money.Amount
+	InvoiceDiscountAmount money.Amount
+	// PriceRule es la regla que fijó el precio; PriceListID es 0 si no intervino una lista
+	PriceRule   string
+	PriceListID int
+	// UnitID es 0 cuando se vendió en la unidad base
+	UnitID       int
+	UnitName     string
+	UnitQuantity float64
+	// TaxRateID es 0 si la línea no tiene tarifa; TaxBase es el subtotal sin impuestos
+	TaxRateID int
+	TaxRate   float64
+	TaxBase   money.Amount
+	TaxAmount money.Amount
+	Lots      []LotAllocation
+	// SerialIDs son los números de serie que se venden con la línea
+	SerialIDs []int
+	// Components es el desglose de un combo; vacío para los demás productos
+	Components []InvoiceItemComponent
+}
+
+// InvoiceItemComponent es la parte de un componente en la venta de un combo. CostWeight es el
+// peso con que se le asigna una parte del costo del combo.
+type InvoiceItemComponent struct {
+	ProductID  int
+	Quantity   float64
+	Revenue    money.Amount
+	CostWeight money.Amount
+	// BuiltQuantity es lo que se armó en el momento y se descuenta del stock del componente
+	BuiltQuantity float64
+	// Lots son los lotes del componente consumidos al armar el combo en la venta
+	Lots []LotAllocation
+}
+
+// InvoiceData es la cabecera de una factura de venta nueva. PriceListID y CustomerID son 0 si no
+// aplican; Total es Subtotal más TaxTotal. DiscountApprovedBy es 0 si ningún descuento necesitó
+// autorización. PromotionTotal es lo descontado por promociones y CouponCode el cupón usado.
+// Los importes van en Currency; los Base* son los mismos totales en la moneda base del tenant
+// con la tasa ExchangeRate.
+type InvoiceData struct {
+	TenantID           int
+	UserID             int
+	PriceListID        int
+	CustomerID         int
+	Subtotal           money.Amount
+	TaxTotal           money.Amount
+	Total              money.Amount
+	DiscountAmount     money.Amount
+	DiscountTotal      money.Amount
+	DiscountApprovedBy int
+	PromotionTotal     money.Amount
+	CouponCode         string
+	PricesIncludeTax   bool
+	Currency           string
+	ExchangeRate       float64
+	BaseSubtotal       money.Amount
+	BaseTaxTotal       money.Amount
+	BaseTotal          money.Amount
+	Items              []InvoiceItem
+	Taxes              []TaxLine
+}
+
+// InvoicePayment es el cobro de una factura en moneda extranjera: la tasa del día del cobro y
+// la diferencia en cambio realizada, en la moneda base
+type InvoicePayment struct {
+	ExchangeRate float64
+	FXGainLoss   money.Amount
 }
 
 type InvoiceRepository interface {
 	CountByTenant(ctx context.Context, tenantID int) (int, error)
-	SumTotalByTenant(ctx context.Context, tenantID int, startDate, endDate time.Time) (float64, error)
+	SumTotalByTenant(ctx context.Context, tenantID int, startDate, endDate time.Time) (money.Amount, error)
 	CountByTenantAndDateRange(ctx context.Context, tenantID int, startDate, endDate time.Time) (int, error)
-	Create(ctx context.Context, tenantID, userID int, total float64, items []InvoiceItem) (*ent.Invoice, error)
+	Create(ctx context.Context, data InvoiceData) (*ent.Invoice, error)
 	FindByID(ctx context.Context, id int) (*ent.Invoice, []*ent.InvoiceItem, error)
 	FindAll(ctx context.Context, tenantID int, limit, offset int) ([]*ent.Invoice, int, error)
+	FindByCustomer(ctx context.Context, customerID int, limit, offset int) ([]*ent.Invoice, int, error)
 	SearchProducts(ctx context.Context, tenantID int, query string) ([]*ent.Product, error)
+	SumQuantitySoldByProduct(ctx context.Context, tenantID int, since time.Time) (map[int]float64, error)
+	FindItemsByDateRange(ctx context.Context, tenantID int, startDate, endDate time.Time) ([]*ent.InvoiceItem, error)
+	FindByDateRange(ctx context.Context, tenantID int, startDate, endDate time.Time) ([]*ent.Invoice, error)
+	FindComponentsByInvoice(ctx context.Context, invoiceID int) ([]*ent.InvoiceItemComponent, error)
+	FindComponentsByDateRange(ctx context.Context, tenantID int, startDate, endDate time.Time) ([]*ent.InvoiceItemComponent, error)
+	ChangeStatus(ctx context.Context, invoiceID, userID int, from, to, reason string, restock bool, payment *InvoicePayment) (*ent.Invoice, error)
+	FindStatusEvents(ctx context.Context, invoiceID int) ([]*ent.InvoiceStatusEvent, error)
 }
 
 type invoiceRepository struct {
@@ -43,13 +138,15 @@ func (r *invoiceRepository) CountByTenant(ctx context.Context, tenantID int) (in
 		Count(ctx)
 }
 
-func (r *invoiceRepository) SumTotalByTenant(ctx context.Context, tenantID int, startDate, endDate time.Time) (float64, error) {
+// SumTotalByTenant suma lo facturado en el rango en la moneda base, ignorando las facturas anuladas
+func (r *invoiceRepository) SumTotalByTenant(ctx context.Context, tenantID int, startDate, endDate time.Time) (money.Amount, error) {
 	invoices, err := r.client.Invoice.
 		Query().
 		Where(
 			invoice.TenantIDEQ(tenantID),
 			invoice.CreatedAtGTE(startDate),
 			invoice.CreatedAtLTE(endDate),
+			invoice.StatusNotIn("cancelled", "voided"),
 		).
 		All(ctx)
 
@@ -57,14 +154,15 @@ func (r *invoiceRepository) SumTotalByTenant(ctx context.Context, tenantID int,
 		return 0, err
 	}
 
-	var total float64
+	total := money.Zero
 	for _, inv := range invoices {
-		total += inv.Total
+		total += inv.BaseTotal
 	}
 
 	return total, nil
 }
 
+// CountByTenantAndDateRange cuenta las facturas del rango, ignorando las anuladas
 func (r *invoiceRepository) CountByTenantAndDateRange(ctx context.Context, tenantID int, startDate, endDate time.Time) (int, error) {
 	return r.client.Invoice.
 		Query().
@@ -72,44 +170,152 @@ func (r *invoiceRepository) CountByTenantAndDateRange(ctx context.Context, tenan
 			invoice.TenantIDEQ(tenantID),
 			invoice.CreatedAtGTE(startDate),
 			invoice.CreatedAtLTE(endDate),
+			invoice.StatusNotIn("cancelled", "voided"),
 		).
 		Count(ctx)
 }
 
-func (r *invoiceRepository) Create(ctx context.Context, tenantID, userID int, total float64, items []InvoiceItem) (*ent.Invoice, error) {
+// Create registra la factura y descuenta del inventario todo lo que sale con ella (stock, capas
+// de costo, lotes y números de serie) en una sola transacción: si una línea falla no queda nada
+// descontado. El costo de lo vendido se calcula aquí, al descontar el stock.
+func (r *invoiceRepository) Create(ctx context.Context, data InvoiceData) (*ent.Invoice, error) {
 	// Usar transacción para crear factura e items
 	tx, err := r.client.Tx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	// El número se asigna en la misma transacción: si la factura no se guarda no se consume
+	number, series, err := assignNumberTx(ctx, tx, data.TenantID, "invoice", time.Now())
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
 	// Crear la factura
-	inv, err := tx.Invoice.
+	builder := tx.Invoice.
 		Create().
-		SetTenantID(tenantID).
-		SetUserID(userID).
-		SetTotal(total).
-		SetStatus("pending").
-		Save(ctx)
+		SetTenantID(data.TenantID).
+		SetNumber(number).
+		SetSeriesID(series.ID).
+		SetUserID(data.UserID).
+		SetSubtotal(data.Subtotal).
+		SetTaxTotal(data.TaxTotal).
+		SetTotal(data.Total).
+		SetDiscountAmount(data.DiscountAmount).
+		SetDiscountTotal(data.DiscountTotal).
+		SetPromotionTotal(data.PromotionTotal).
+		SetPricesIncludeTax(data.PricesIncludeTax).
+		SetCurrency(data.Currency).
+		SetExchangeRate(data.ExchangeRate).
+		SetBaseSubtotal(data.BaseSubtotal).
+		SetBaseTaxTotal(data.BaseTaxTotal).
+		SetBaseTotal(data.BaseTotal).
+		SetStatus("pending")
+
+	if data.PriceListID != 0 {
+		builder.SetPriceListID(data.PriceListID)
+	}
+	if data.CustomerID != 0 {
+		builder.SetCustomerID(data.CustomerID)
+	}
+	if data.DiscountApprovedBy != 0 {
+		builder.SetDiscountApprovedBy(data.DiscountApprovedBy)
+	}
+	if data.CouponCode != "" {
+		builder.SetCouponCode(data.CouponCode)
+	}
+
+	inv, err := builder.Save(ctx)
 	if err != nil {
+		if ent.IsConstraintError(err) {
+			// Otra factura creó a la vez la serie por defecto del tenant y tomó el mismo número
+			err = fmt.Errorf("%w: el número %s ya fue asignado, intente de nuevo", pkg_errors.ErrInvalidState, number)
+		}
 		return nil, rollback(tx, err)
 	}
 
 	// Crear los items de la factura
 	// Nota: Después de regenerar Ent, InvoiceItem estará disponible
 	// Por ahora usamos una estructura temporal
-	for _, item := range items {
-		_, err = tx.InvoiceItem.
+	for _, item := range data.Items {
+		unitCost, componentCosts, builtCosts, err := issueInvoiceItemTx(ctx, tx, item)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		if err := markSerialsSoldTx(ctx, tx, item.SerialIDs, inv.ID, data.UserID); err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		itemBuilder := tx.InvoiceItem.
 			Create().
 			SetInvoiceID(inv.ID).
 			SetProductID(item.ProductID).
 			SetQuantity(item.Quantity).
 			SetUnitPrice(item.UnitPrice).
 			SetSubtotal(item.Subtotal).
-			Save(ctx)
+			SetPromotionDiscount(item.PromotionDiscount).
+			SetDiscountAmount(item.DiscountAmount).
+			SetInvoiceDiscountAmount(item.InvoiceDiscountAmount).
+			SetUnitCost(unitCost).
+			SetCostTotal(unitCost.Mul(item.Quantity)).
+			SetTaxRate(item.TaxRate).
+			SetTaxBase(item.TaxBase).
+			SetTaxAmount(item.TaxAmount)
+
+		if item.PriceRule != "" {
+			itemBuilder.SetPriceRule(item.PriceRule)
+		}
+		if item.PriceListID != 0 {
+			itemBuilder.SetPriceListID(item.PriceListID)
+		}
+		if item.TaxRateID != 0 {
+			itemBuilder.SetTaxRateID(item.TaxRateID)
+		}
+		if item.PromotionID != 0 {
+			itemBuilder.SetPromotionID(item.PromotionID)
+		}
+		if item.UnitID != 0 {
+			itemBuilder.
+				SetUnitID(item.UnitID).
+				SetUnitName(item.UnitName).
+				SetUnitQuantity(item.UnitQuantity)
+		}
+
+		saved, err := itemBuilder.Save(ctx)
 		if err != nil {
 			return nil, rollback(tx, err)
 		}
+
+		if err := createLotAllocationsTx(ctx, tx, inv.ID, item.ProductID, item.Lots); err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		for i, component := range item.Components {
+			err = tx.InvoiceItemComponent.
+				Create().
+				SetInvoiceID(inv.ID).
+				SetInvoiceItemID(saved.ID).
+				SetBundleID(item.ProductID).
+				SetProductID(component.ProductID).
+				SetQuantity(component.Quantity).
+				SetRevenue(component.Revenue).
+				SetCostTotal(componentCosts[i]).
+				SetBuiltQuantity(component.BuiltQuantity).
+				SetBuiltUnitCost(builtCosts[i]).
+				Exec(ctx)
+			if err != nil {
+				return nil, rollback(tx, err)
+			}
+
+			if err := createLotAllocationsTx(ctx, tx, inv.ID, component.ProductID, component.Lots); err != nil {
+				return nil, rollback(tx, err)
+			}
+		}
+	}
+
+	if err := createDocumentTaxesTx(ctx, tx, data.TenantID, "invoice", inv.ID, data.Taxes); err != nil {
+		return nil, rollback(tx, err)
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -119,6 +325,68 @@ func (r *invoiceRepository) Create(ctx context.Context, tenantID, userID int, to
 	return inv, nil
 }
 
+// issueInvoiceItemTx descuenta el stock y los lotes de una línea de venta. Devuelve el costo
+// unitario de lo vendido y, en un combo, el costo asignado a cada componente y el costo unitario
+// de lo que se armó de cada uno.
+func issueInvoiceItemTx(ctx context.Context, tx *ent.Tx, item InvoiceItem) (money.Amount, []money.Amount, []money.Amount, error) {
+	costTotal := money.Zero
+	unitCost := money.Zero
+	if item.FromStock > 0 {
+		var err error
+		unitCost, err = issueStockTx(ctx, tx, item.ProductID, item.FromStock)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		costTotal += unitCost.Mul(item.FromStock)
+	}
+	if err := consumeLotAllocationsTx(ctx, tx, item.Lots); err != nil {
+		return 0, nil, nil, err
+	}
+
+	if len(item.Components) == 0 {
+		return unitCost, nil, nil, nil
+	}
+
+	builtCosts := make([]money.Amount, len(item.Components))
+	weights := make([]money.Amount, len(item.Components))
+	for i, component := range item.Components {
+		weights[i] = component.CostWeight
+		if component.BuiltQuantity <= 0 {
+			continue
+		}
+		built, err := issueStockTx(ctx, tx, component.ProductID, component.BuiltQuantity)
+		if err != nil {
+			return 0, nil, nil, fmt.Errorf("error al armar el combo con el producto %d: %w", component.ProductID, err)
+		}
+		costTotal += built.Mul(component.BuiltQuantity)
+		builtCosts[i] = built
+
+		if err := consumeLotAllocationsTx(ctx, tx, component.Lots); err != nil {
+			return 0, nil, nil, err
+		}
+	}
+
+	componentCosts := money.Allocate(costTotal.Round(money.Default), weights, money.Default)
+	return costTotal.Div(item.Quantity), componentCosts, builtCosts, nil
+}
+
+// createLotAllocationsTx registra de qué lotes salió un producto vendido
+func createLotAllocationsTx(ctx context.Context, tx *ent.Tx, invoiceID, productID int, lots []LotAllocation) error {
+	for _, lot := range lots {
+		err := tx.InvoiceLotAllocation.
+			Create().
+			SetInvoiceID(invoiceID).
+			SetProductID(productID).
+			SetLotID(lot.LotID).
+			SetQuantity(lot.Quantity).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *invoiceRepository) FindByID(ctx context.Context, id int) (*ent.Invoice, []*ent.InvoiceItem, error) {
 	inv, err := r.client.Invoice.
 		Query().
@@ -158,32 +426,359 @@ func (r *invoiceRepository) FindAll(ctx context.Context, tenantID int, limit, of
 	return invoices, total, err
 }
 
+// FindByCustomer lista las facturas emitidas al cliente, de la más reciente a la más antigua
+func (r *invoiceRepository) FindByCustomer(ctx context.Context, customerID int, limit, offset int) ([]*ent.Invoice, int, error) {
+	query := r.client.Invoice.
+		Query().
+		Where(invoice.CustomerIDEQ(customerID))
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	invoices, err := query.
+		Limit(limit).
+		Offset(offset).
+		Order(ent.Desc(invoice.FieldCreatedAt)).
+		All(ctx)
+
+	return invoices, total, err
+}
+
 func (r *invoiceRepository) SearchProducts(ctx context.Context, tenantID int, searchQuery string) ([]*ent.Product, error) {
-	// Buscar por nombre o SKU que contenga el query
+	// Buscar por nombre o SKU que contenga el query, o por código de barras exacto
 	// También intentar buscar por ID si el query es numérico
-	query := r.client.Product.
+	barcodeProductIDs, err := r.client.ProductBarcode.
 		Query().
-		Where(product.TenantIDEQ(tenantID))
+		Where(
+			productbarcode.TenantIDEQ(tenantID),
+			productbarcode.CodeIn(barcode.Alternatives(searchQuery)...),
+		).
+		Select(productbarcode.FieldProductID).
+		Ints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	predicates := []predicate.Product{
+		product.NameContainsFold(searchQuery),
+		product.SkuContainsFold(searchQuery),
+	}
 
 	// Si el query es numérico, intentar buscar por ID también
 	if id, err := strconv.Atoi(searchQuery); err == nil {
-		query = query.Where(
-			product.Or(
-				product.IDEQ(id),
-				product.NameContainsFold(searchQuery),
-				product.SkuContainsFold(searchQuery),
-			),
-		)
-	} else {
-		query = query.Where(
-			product.Or(
-				product.NameContainsFold(searchQuery),
-				product.SkuContainsFold(searchQuery),
-			),
-		)
-	}
-
-	return query.Limit(20).All(ctx)
+		predicates = append(predicates, product.IDEQ(id))
+	}
+	if len(barcodeProductIDs) > 0 {
+		predicates = append(predicates, product.IDIn(barcodeProductIDs...))
+	}
+
+	return r.client.Product.
+		Query().
+		Where(
+			product.TenantIDEQ(tenantID),
+			product.ArchivedEQ(false),
+			product.Or(predicates...),
+		).
+		Limit(20).
+		All(ctx)
+}
+
+// SumQuantitySoldByProduct devuelve las unidades vendidas por producto desde la fecha indicada,
+// ignorando las facturas anuladas
+func (r *invoiceRepository) SumQuantitySoldByProduct(ctx context.Context, tenantID int, since time.Time) (map[int]float64, error) {
+	invoiceIDs, err := r.client.Invoice.
+		Query().
+		Where(
+			invoice.TenantIDEQ(tenantID),
+			invoice.CreatedAtGTE(since),
+			invoice.StatusNotIn("cancelled", "voided"),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sold := make(map[int]float64)
+	if len(invoiceIDs) == 0 {
+		return sold, nil
+	}
+
+	items, err := r.client.InvoiceItem.
+		Query().
+		Where(invoiceitem.InvoiceIDIn(invoiceIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		sold[item.ProductID] += item.Quantity
+	}
+
+	return sold, nil
+}
+
+// FindItemsByDateRange devuelve los items vendidos en el rango, ignorando las facturas anuladas
+func (r *invoiceRepository) FindItemsByDateRange(ctx context.Context, tenantID int, startDate, endDate time.Time) ([]*ent.InvoiceItem, error) {
+	invoiceIDs, err := r.client.Invoice.
+		Query().
+		Where(
+			invoice.TenantIDEQ(tenantID),
+			invoice.CreatedAtGTE(startDate),
+			invoice.CreatedAtLTE(endDate),
+			invoice.StatusNotIn("cancelled", "voided"),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(invoiceIDs) == 0 {
+		return []*ent.InvoiceItem{}, nil
+	}
+
+	return r.client.InvoiceItem.
+		Query().
+		Where(invoiceitem.InvoiceIDIn(invoiceIDs...)).
+		All(ctx)
+}
+
+// FindByDateRange devuelve las facturas del rango, ignorando las anuladas
+func (r *invoiceRepository) FindByDateRange(ctx context.Context, tenantID int, startDate, endDate time.Time) ([]*ent.Invoice, error) {
+	return r.client.Invoice.
+		Query().
+		Where(
+			invoice.TenantIDEQ(tenantID),
+			invoice.CreatedAtGTE(startDate),
+			invoice.CreatedAtLTE(endDate),
+			invoice.StatusNotIn("cancelled", "voided"),
+		).
+		Order(ent.Asc(invoice.FieldCreatedAt)).
+		All(ctx)
+}
+
+func (r *invoiceRepository) FindComponentsByInvoice(ctx context.Context, invoiceID int) ([]*ent.InvoiceItemComponent, error) {
+	return r.client.InvoiceItemComponent.
+		Query().
+		Where(invoiceitemcomponent.InvoiceIDEQ(invoiceID)).
+		Order(ent.Asc(invoiceitemcomponent.FieldID)).
+		All(ctx)
+}
+
+// FindComponentsByDateRange devuelve el desglose de los combos vendidos en el rango, ignorando
+// las facturas anuladas
+func (r *invoiceRepository) FindComponentsByDateRange(ctx context.Context, tenantID int, startDate, endDate time.Time) ([]*ent.InvoiceItemComponent, error) {
+	invoiceIDs, err := r.client.Invoice.
+		Query().
+		Where(
+			invoice.TenantIDEQ(tenantID),
+			invoice.CreatedAtGTE(startDate),
+			invoice.CreatedAtLTE(endDate),
+			invoice.StatusNotIn("cancelled", "voided"),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(invoiceIDs) == 0 {
+		return []*ent.InvoiceItemComponent{}, nil
+	}
+
+	return r.client.InvoiceItemComponent.
+		Query().
+		Where(invoiceitemcomponent.InvoiceIDIn(invoiceIDs...)).
+		All(ctx)
+}
+
+// ChangeStatus pasa la factura del estado from al estado to y registra quién y por qué lo hizo.
+// El cambio solo se aplica si la factura sigue en from, así dos pedidos simultáneos no pueden
+// aplicar la misma transición. Con restock se devuelve al inventario todo lo que salió con la
+// venta, en la misma transacción. payment, si no es nil, guarda la tasa y la diferencia en
+// cambio del cobro.
+func (r *invoiceRepository) ChangeStatus(ctx context.Context, invoiceID, userID int, from, to, reason string, restock bool, payment *InvoicePayment) (*ent.Invoice, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update := tx.Invoice.
+		Update().
+		Where(
+			invoice.IDEQ(invoiceID),
+			invoice.StatusEQ(from),
+		).
+		SetStatus(to)
+
+	if payment != nil {
+		update.
+			SetPaymentExchangeRate(payment.ExchangeRate).
+			SetFxGainLoss(payment.FXGainLoss)
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if updated == 0 {
+		return nil, rollback(tx, fmt.Errorf("%w: la factura ya no está en estado %s", pkg_errors.ErrInvalidState, from))
+	}
+
+	inv, err := tx.Invoice.
+		Query().
+		Where(invoice.IDEQ(invoiceID)).
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	builder := tx.InvoiceStatusEvent.
+		Create().
+		SetInvoiceID(invoiceID).
+		SetTenantID(inv.TenantID).
+		SetFromStatus(from).
+		SetToStatus(to).
+		SetUserID(userID)
+
+	if reason != "" {
+		builder.SetReason(reason)
+	}
+
+	if _, err := builder.Save(ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if restock {
+		if err := restockInvoiceTx(ctx, tx, invoiceID, userID); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+// restockInvoiceTx revierte las salidas de una venta: el stock vuelve al costo con que salió,
+// los lotes recuperan lo consumido y los números de serie quedan otra vez en existencia. De un
+// combo vuelve al stock del combo lo que salió armado y a cada componente lo que se armó en
+// el momento de la venta.
+func restockInvoiceTx(ctx context.Context, tx *ent.Tx, invoiceID, userID int) error {
+	items, err := tx.InvoiceItem.
+		Query().
+		Where(invoiceitem.InvoiceIDEQ(invoiceID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	components, err := tx.InvoiceItemComponent.
+		Query().
+		Where(invoiceitemcomponent.InvoiceIDEQ(invoiceID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	componentsByItem := make(map[int][]*ent.InvoiceItemComponent)
+	for _, component := range components {
+		componentsByItem[component.InvoiceItemID] = append(componentsByItem[component.InvoiceItemID], component)
+	}
+
+	for _, item := range items {
+		fromStock := item.Quantity
+		costTotal := item.CostTotal
+
+		for _, component := range componentsByItem[item.ID] {
+			if component.BuiltQuantity <= 0 {
+				continue
+			}
+			// Los combos armados en la venta se sacan de lo que vuelve al stock del combo
+			fromStock = qty.Round(item.Quantity - component.BuiltQuantity*item.Quantity/component.Quantity)
+			costTotal -= component.BuiltUnitCost.Mul(component.BuiltQuantity)
+
+			err := receiveStockTx(ctx, tx, component.ProductID, component.BuiltQuantity, component.BuiltUnitCost, "invoice_cancel", invoiceID)
+			if err != nil {
+				return fmt.Errorf("error al devolver stock del producto %d: %w", component.ProductID, err)
+			}
+		}
+
+		if fromStock <= 0 {
+			continue
+		}
+
+		unitCost := costTotal.Div(fromStock)
+		if unitCost < 0 {
+			unitCost = 0
+		}
+		if err := receiveStockTx(ctx, tx, item.ProductID, fromStock, unitCost, "invoice_cancel", invoiceID); err != nil {
+			return fmt.Errorf("error al devolver stock del producto %d: %w", item.ProductID, err)
+		}
+	}
+
+	allocations, err := tx.InvoiceLotAllocation.
+		Query().
+		Where(invoicelotallocation.InvoiceIDEQ(invoiceID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, allocation := range allocations {
+		err := tx.ProductLot.
+			UpdateOneID(allocation.LotID).
+			AddRemaining(allocation.Quantity).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	serials, err := tx.ProductSerial.
+		Query().
+		Where(productserial.InvoiceIDEQ(invoiceID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, serial := range serials {
+		err := tx.ProductSerial.
+			UpdateOneID(serial.ID).
+			SetStatus("in_stock").
+			ClearInvoiceID().
+			ClearSoldAt().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ProductSerialEvent.
+			Create().
+			SetSerialID(serial.ID).
+			SetEventType("returned").
+			SetDocumentType("invoice").
+			SetDocumentID(invoiceID).
+			SetUserID(userID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *invoiceRepository) FindStatusEvents(ctx context.Context, invoiceID int) ([]*ent.InvoiceStatusEvent, error) {
+	return r.client.InvoiceStatusEvent.
+		Query().
+		Where(invoicestatusevent.InvoiceIDEQ(invoiceID)).
+		Order(ent.Asc(invoicestatusevent.FieldCreatedAt)).
+		All(ctx)
 }
 
 // rollback es una función auxiliar para hacer rollback de transacciones
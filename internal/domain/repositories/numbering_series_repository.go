@@ -0,0 +1,306 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/fiscaldocument"
+	"Veritasbackend/ent/invoice"
+	"Veritasbackend/ent/numberingseries"
+	"Veritasbackend/ent/tenant"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+// defaultSeriesPrefixes son los tipos de documento numerados y el prefijo de la serie que se crea
+// sola si el tenant no configuró ninguna
+var defaultSeriesPrefixes = map[string]string{
+	"invoice":     "",
+	"credit_note": "NC",
+}
+
+// defaultSeriesRangeTo es el último número de la serie creada por defecto
+const defaultSeriesRangeTo = 999999999
+
+// NumberingSeriesData son los datos editables de una serie. ValidFrom y ValidUntil son opcionales;
+// ValidUntil es el último día de vigencia.
+type NumberingSeriesData struct {
+	DocumentType   string
+	Name           string
+	Prefix         string
+	RangeFrom      int64
+	RangeTo        int64
+	ValidFrom      *time.Time
+	ValidUntil     *time.Time
+	Resolution     string
+	AlertRemaining int64
+	Active         bool
+}
+
+type NumberingSeriesRepository interface {
+	FindAll(ctx context.Context, tenantID int, documentType string) ([]*ent.NumberingSeries, error)
+	FindByID(ctx context.Context, id int) (*ent.NumberingSeries, error)
+	Create(ctx context.Context, tenantID int, data NumberingSeriesData) (*ent.NumberingSeries, error)
+	Update(ctx context.Context, id int, currentNumber int64, data NumberingSeriesData) (*ent.NumberingSeries, error)
+	Delete(ctx context.Context, id int, currentNumber int64) error
+}
+
+type numberingSeriesRepository struct {
+	client *ent.Client
+}
+
+func NewNumberingSeriesRepository(client *ent.Client) NumberingSeriesRepository {
+	return &numberingSeriesRepository{client: client}
+}
+
+func (r *numberingSeriesRepository) FindAll(ctx context.Context, tenantID int, documentType string) ([]*ent.NumberingSeries, error) {
+	query := r.client.NumberingSeries.
+		Query().
+		Where(numberingseries.TenantIDEQ(tenantID))
+
+	if documentType != "" {
+		query.Where(numberingseries.DocumentTypeEQ(documentType))
+	}
+
+	return query.
+		Order(ent.Asc(numberingseries.FieldDocumentType), ent.Asc(numberingseries.FieldID)).
+		All(ctx)
+}
+
+func (r *numberingSeriesRepository) FindByID(ctx context.Context, id int) (*ent.NumberingSeries, error) {
+	return r.client.NumberingSeries.
+		Query().
+		Where(numberingseries.IDEQ(id)).
+		Only(ctx)
+}
+
+// Create registra la serie sin números usados: el primero que asigne será RangeFrom
+func (r *numberingSeriesRepository) Create(ctx context.Context, tenantID int, data NumberingSeriesData) (*ent.NumberingSeries, error) {
+	return r.client.NumberingSeries.
+		Create().
+		SetTenantID(tenantID).
+		SetDocumentType(data.DocumentType).
+		SetName(data.Name).
+		SetPrefix(data.Prefix).
+		SetRangeFrom(data.RangeFrom).
+		SetRangeTo(data.RangeTo).
+		SetCurrentNumber(data.RangeFrom - 1).
+		SetNillableValidFrom(data.ValidFrom).
+		SetNillableValidUntil(data.ValidUntil).
+		SetResolution(data.Resolution).
+		SetAlertRemaining(data.AlertRemaining).
+		SetActive(data.Active).
+		Save(ctx)
+}
+
+// Update reemplaza los datos de la serie siempre que no haya asignado números desde que se leyó
+// con currentNumber; si asignó alguno devuelve ErrInvalidState. Mientras la serie no se haya
+// usado, cambiar RangeFrom mueve también el consecutivo.
+func (r *numberingSeriesRepository) Update(ctx context.Context, id int, currentNumber int64, data NumberingSeriesData) (*ent.NumberingSeries, error) {
+	series, err := r.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := r.client.NumberingSeries.
+		Update().
+		Where(
+			numberingseries.IDEQ(id),
+			numberingseries.CurrentNumberEQ(currentNumber),
+		).
+		SetName(data.Name).
+		SetPrefix(data.Prefix).
+		SetRangeFrom(data.RangeFrom).
+		SetRangeTo(data.RangeTo).
+		SetResolution(data.Resolution).
+		SetAlertRemaining(data.AlertRemaining).
+		SetActive(data.Active)
+
+	if currentNumber < series.RangeFrom {
+		builder.SetCurrentNumber(data.RangeFrom - 1)
+	}
+	if data.ValidFrom != nil {
+		builder.SetValidFrom(*data.ValidFrom)
+	} else {
+		builder.ClearValidFrom()
+	}
+	if data.ValidUntil != nil {
+		builder.SetValidUntil(*data.ValidUntil)
+	} else {
+		builder.ClearValidUntil()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, fmt.Errorf("%w: la serie asignó números mientras se editaba, intente de nuevo", pkg_errors.ErrInvalidState)
+	}
+
+	return r.FindByID(ctx, id)
+}
+
+// Delete borra una serie que no ha asignado números desde que se leyó con currentNumber
+func (r *numberingSeriesRepository) Delete(ctx context.Context, id int, currentNumber int64) error {
+	deleted, err := r.client.NumberingSeries.
+		Delete().
+		Where(
+			numberingseries.IDEQ(id),
+			numberingseries.CurrentNumberEQ(currentNumber),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%w: la serie asignó números mientras se borraba", pkg_errors.ErrInUse)
+	}
+	return nil
+}
+
+// assignNumberTx toma el siguiente número de la serie vigente del tipo de documento dentro de la
+// transacción del documento, así que un documento que no se guarda no consume número. Las series
+// candidatas se leen sin bloqueo; la fila de la serie se bloquea recién en el incremento
+// condicionado y queda bloqueada hasta el commit. El número se lee después del incremento, así que
+// dos documentos simultáneos reciben números consecutivos y la numeración no tiene huecos. Si hay
+// varias series vigentes se agota primero la más antigua. Un tenant sin series del tipo recibe una
+// por defecto; si tiene series pero ninguna vigente con números disponibles devuelve
+// ErrInvalidState.
+func assignNumberTx(ctx context.Context, tx *ent.Tx, tenantID int, documentType string, at time.Time) (string, *ent.NumberingSeries, error) {
+	candidates, err := usableSeriesTx(ctx, tx, tenantID, documentType, at)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(candidates) == 0 {
+		// Dos primeros documentos simultáneos crearían cada uno su serie por defecto con el mismo
+		// rango. El bloqueo del tenant los ordena: el segundo espera el commit del primero y al
+		// volver a leer encuentra la serie que este creó.
+		_, err := tx.Tenant.
+			Query().
+			Where(tenant.IDEQ(tenantID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return "", nil, err
+		}
+
+		candidates, err = usableSeriesTx(ctx, tx, tenantID, documentType, at)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	if len(candidates) == 0 {
+		exists, err := tx.NumberingSeries.
+			Query().
+			Where(
+				numberingseries.TenantIDEQ(tenantID),
+				numberingseries.DocumentTypeEQ(documentType),
+			).
+			Exist(ctx)
+		if err != nil {
+			return "", nil, err
+		}
+		if !exists {
+			series, err := createDefaultSeriesTx(ctx, tx, tenantID, documentType)
+			if err != nil {
+				return "", nil, err
+			}
+			candidates = append(candidates, series)
+		}
+	}
+
+	for _, series := range candidates {
+		if series.CurrentNumber >= series.RangeTo {
+			continue
+		}
+
+		updated, err := tx.NumberingSeries.
+			Update().
+			Where(
+				numberingseries.IDEQ(series.ID),
+				numberingseries.CurrentNumberLT(series.RangeTo),
+			).
+			AddCurrentNumber(1).
+			Save(ctx)
+		if err != nil {
+			return "", nil, err
+		}
+		if updated == 0 {
+			// Otro documento tomó el último número de la serie
+			continue
+		}
+
+		series, err = tx.NumberingSeries.Get(ctx, series.ID)
+		if err != nil {
+			return "", nil, err
+		}
+		return series.Prefix + strconv.FormatInt(series.CurrentNumber, 10), series, nil
+	}
+
+	return "", nil, fmt.Errorf("%w: no hay una numeración vigente con números disponibles para %s", pkg_errors.ErrInvalidState, documentType)
+}
+
+// usableSeriesTx devuelve las series activas y vigentes en at, de la más antigua a la más nueva
+func usableSeriesTx(ctx context.Context, tx *ent.Tx, tenantID int, documentType string, at time.Time) ([]*ent.NumberingSeries, error) {
+	day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+
+	return tx.NumberingSeries.
+		Query().
+		Where(
+			numberingseries.TenantIDEQ(tenantID),
+			numberingseries.DocumentTypeEQ(documentType),
+			numberingseries.ActiveEQ(true),
+			numberingseries.Or(numberingseries.ValidFromIsNil(), numberingseries.ValidFromLTE(at)),
+			numberingseries.Or(numberingseries.ValidUntilIsNil(), numberingseries.ValidUntilGTE(day)),
+		).
+		Order(ent.Asc(numberingseries.FieldID)).
+		All(ctx)
+}
+
+func createDefaultSeriesTx(ctx context.Context, tx *ent.Tx, tenantID int, documentType string) (*ent.NumberingSeries, error) {
+	prefix, ok := defaultSeriesPrefixes[documentType]
+	if !ok {
+		return nil, fmt.Errorf("tipo de documento %q sin numeración", documentType)
+	}
+
+	// Antes de las series los documentos se numeraban con su ID: la serie por defecto continúa
+	// después del último para no repetir números ya impresos o enviados a la autoridad
+	var last int
+	var err error
+	if documentType == "invoice" {
+		last, err = tx.Invoice.
+			Query().
+			Where(invoice.TenantIDEQ(tenantID)).
+			Order(ent.Desc(invoice.FieldID)).
+			FirstID(ctx)
+	} else {
+		last, err = tx.FiscalDocument.
+			Query().
+			Where(
+				fiscaldocument.TenantIDEQ(tenantID),
+				fiscaldocument.DocumentTypeEQ(documentType),
+			).
+			Order(ent.Desc(fiscaldocument.FieldID)).
+			FirstID(ctx)
+	}
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	from := int64(last) + 1
+
+	return tx.NumberingSeries.
+		Create().
+		SetTenantID(tenantID).
+		SetDocumentType(documentType).
+		SetName("Serie por defecto").
+		SetPrefix(prefix).
+		SetRangeFrom(from).
+		SetRangeTo(defaultSeriesRangeTo).
+		SetCurrentNumber(from - 1).
+		Save(ctx)
+}
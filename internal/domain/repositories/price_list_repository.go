@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"context"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/pricelist"
+	"Veritasbackend/ent/pricelistitem"
+	"Veritasbackend/pkg/money"
+)
+
+type PriceListRepository interface {
+	Create(ctx context.Context, tenantID int, name, description string, discountPercent float64) (*ent.PriceList, error)
+	Update(ctx context.Context, id int, name, description string, discountPercent float64, active bool) (*ent.PriceList, error)
+	FindByID(ctx context.Context, id int) (*ent.PriceList, error)
+	FindAll(ctx context.Context, tenantID int) ([]*ent.PriceList, error)
+	FindItems(ctx context.Context, priceListID int) ([]*ent.PriceListItem, error)
+	FindItemByID(ctx context.Context, id int) (*ent.PriceListItem, error)
+	FindItemsByProduct(ctx context.Context, priceListID, productID int) ([]*ent.PriceListItem, error)
+	SetItem(ctx context.Context, priceListID, productID, minQuantity int, price *money.Amount, discountPercent *float64) (*ent.PriceListItem, error)
+	DeleteItem(ctx context.Context, id int) error
+}
+
+type priceListRepository struct {
+	client *ent.Client
+}
+
+func NewPriceListRepository(client *ent.Client) PriceListRepository {
+	return &priceListRepository{client: client}
+}
+
+func (r *priceListRepository) Create(ctx context.Context, tenantID int, name, description string, discountPercent float64) (*ent.PriceList, error) {
+	builder := r.client.PriceList.
+		Create().
+		SetTenantID(tenantID).
+		SetName(name).
+		SetDiscountPercent(discountPercent)
+
+	if description != "" {
+		builder.SetDescription(description)
+	}
+
+	return builder.Save(ctx)
+}
+
+func (r *priceListRepository) Update(ctx context.Context, id int, name, description string, discountPercent float64, active bool) (*ent.PriceList, error) {
+	return r.client.PriceList.
+		UpdateOneID(id).
+		SetName(name).
+		SetDescription(description).
+		SetDiscountPercent(discountPercent).
+		SetActive(active).
+		Save(ctx)
+}
+
+func (r *priceListRepository) FindByID(ctx context.Context, id int) (*ent.PriceList, error) {
+	return r.client.PriceList.
+		Query().
+		Where(pricelist.IDEQ(id)).
+		Only(ctx)
+}
+
+func (r *priceListRepository) FindAll(ctx context.Context, tenantID int) ([]*ent.PriceList, error) {
+	return r.client.PriceList.
+		Query().
+		Where(pricelist.TenantIDEQ(tenantID)).
+		Order(ent.Asc(pricelist.FieldName)).
+		All(ctx)
+}
+
+func (r *priceListRepository) FindItems(ctx context.Context, priceListID int) ([]*ent.PriceListItem, error) {
+	return r.client.PriceListItem.
+		Query().
+		Where(pricelistitem.PriceListIDEQ(priceListID)).
+		Order(ent.Asc(pricelistitem.FieldProductID), ent.Asc(pricelistitem.FieldMinQuantity)).
+		All(ctx)
+}
+
+func (r *priceListRepository) FindItemByID(ctx context.Context, id int) (*ent.PriceListItem, error) {
+	return r.client.PriceListItem.
+		Query().
+		Where(pricelistitem.IDEQ(id)).
+		Only(ctx)
+}
+
+// FindItemsByProduct devuelve los tramos del producto en la lista, del de mayor cantidad mínima al menor
+func (r *priceListRepository) FindItemsByProduct(ctx context.Context, priceListID, productID int) ([]*ent.PriceListItem, error) {
+	return r.client.PriceListItem.
+		Query().
+		Where(
+			pricelistitem.PriceListIDEQ(priceListID),
+			pricelistitem.ProductIDEQ(productID),
+		).
+		Order(ent.Desc(pricelistitem.FieldMinQuantity)).
+		All(ctx)
+}
+
+// SetItem crea o reemplaza el tramo del producto para la cantidad mínima indicada
+func (r *priceListRepository) SetItem(ctx context.Context, priceListID, productID, minQuantity int, price *money.Amount, discountPercent *float64) (*ent.PriceListItem, error) {
+	existing, err := r.client.PriceListItem.
+		Query().
+		Where(
+			pricelistitem.PriceListIDEQ(priceListID),
+			pricelistitem.ProductIDEQ(productID),
+			pricelistitem.MinQuantityEQ(minQuantity),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	if existing == nil {
+		return r.client.PriceListItem.
+			Create().
+			SetPriceListID(priceListID).
+			SetProductID(productID).
+			SetMinQuantity(minQuantity).
+			SetNillablePrice(price).
+			SetNillableDiscountPercent(discountPercent).
+			Save(ctx)
+	}
+
+	update := r.client.PriceListItem.UpdateOneID(existing.ID)
+	if price != nil {
+		update.SetPrice(*price)
+	} else {
+		update.ClearPrice()
+	}
+	if discountPercent != nil {
+		update.SetDiscountPercent(*discountPercent)
+	} else {
+		update.ClearDiscountPercent()
+	}
+
+	return update.Save(ctx)
+}
+
+func (r *priceListRepository) DeleteItem(ctx context.Context, id int) error {
+	return r.client.PriceListItem.
+		DeleteOneID(id).
+		Exec(ctx)
+}
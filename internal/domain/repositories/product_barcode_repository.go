@@ -0,0 +1,125 @@
+package repositories
+
+import (
+	"context"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/product"
+	"Veritasbackend/ent/productbarcode"
+)
+
+type ProductBarcodeRepository interface {
+	Create(ctx context.Context, tenantID, productID, unitID int, code, symbology string, internal bool) (*ent.ProductBarcode, error)
+	FindByID(ctx context.Context, id int) (*ent.ProductBarcode, error)
+	FindByCodes(ctx context.Context, tenantID int, codes []string) (*ent.ProductBarcode, error)
+	FindByProduct(ctx context.Context, productID int) ([]*ent.ProductBarcode, error)
+	FindByProducts(ctx context.Context, productIDs []int) (map[int][]*ent.ProductBarcode, error)
+	FindProductsWithout(ctx context.Context, tenantID int) ([]int, error)
+	Delete(ctx context.Context, id int) error
+}
+
+type productBarcodeRepository struct {
+	client *ent.Client
+}
+
+func NewProductBarcodeRepository(client *ent.Client) ProductBarcodeRepository {
+	return &productBarcodeRepository{client: client}
+}
+
+func (r *productBarcodeRepository) Create(ctx context.Context, tenantID, productID, unitID int, code, symbology string, internal bool) (*ent.ProductBarcode, error) {
+	return r.client.ProductBarcode.
+		Create().
+		SetTenantID(tenantID).
+		SetProductID(productID).
+		SetCode(code).
+		SetSymbology(symbology).
+		SetInternal(internal).
+		SetNillableUnitID(unitIDOrNil(unitID)).
+		Save(ctx)
+}
+
+// unitIDOrNil convierte el 0 de "unidad base" en nil para las columnas opcionales
+func unitIDOrNil(unitID int) *int {
+	if unitID == 0 {
+		return nil
+	}
+	return &unitID
+}
+
+func (r *productBarcodeRepository) FindByID(ctx context.Context, id int) (*ent.ProductBarcode, error) {
+	return r.client.ProductBarcode.
+		Query().
+		Where(productbarcode.IDEQ(id)).
+		Only(ctx)
+}
+
+// FindByCodes devuelve el primer código del tenant que coincida con alguna de las formas indicadas
+func (r *productBarcodeRepository) FindByCodes(ctx context.Context, tenantID int, codes []string) (*ent.ProductBarcode, error) {
+	return r.client.ProductBarcode.
+		Query().
+		Where(
+			productbarcode.TenantIDEQ(tenantID),
+			productbarcode.CodeIn(codes...),
+		).
+		Order(ent.Asc(productbarcode.FieldID)).
+		First(ctx)
+}
+
+func (r *productBarcodeRepository) FindByProduct(ctx context.Context, productID int) ([]*ent.ProductBarcode, error) {
+	return r.client.ProductBarcode.
+		Query().
+		Where(productbarcode.ProductIDEQ(productID)).
+		Order(ent.Asc(productbarcode.FieldID)).
+		All(ctx)
+}
+
+// FindByProducts devuelve los códigos agrupados por producto, en orden de alta
+func (r *productBarcodeRepository) FindByProducts(ctx context.Context, productIDs []int) (map[int][]*ent.ProductBarcode, error) {
+	barcodes := make(map[int][]*ent.ProductBarcode)
+	if len(productIDs) == 0 {
+		return barcodes, nil
+	}
+
+	found, err := r.client.ProductBarcode.
+		Query().
+		Where(productbarcode.ProductIDIn(productIDs...)).
+		Order(ent.Asc(productbarcode.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range found {
+		barcodes[b.ProductID] = append(barcodes[b.ProductID], b)
+	}
+
+	return barcodes, nil
+}
+
+// FindProductsWithout devuelve los IDs de los productos del tenant que no tienen ningún código
+func (r *productBarcodeRepository) FindProductsWithout(ctx context.Context, tenantID int) ([]int, error) {
+	withCode, err := r.client.ProductBarcode.
+		Query().
+		Where(productbarcode.TenantIDEQ(tenantID)).
+		Select(productbarcode.FieldProductID).
+		Ints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.client.Product.
+		Query().
+		Where(product.TenantIDEQ(tenantID))
+
+	if len(withCode) > 0 {
+		query = query.Where(product.IDNotIn(withCode...))
+	}
+
+	return query.Order(ent.Asc(product.FieldID)).IDs(ctx)
+}
+
+func (r *productBarcodeRepository) Delete(ctx context.Context, id int) error {
+	return r.client.ProductBarcode.
+		DeleteOneID(id).
+		Exec(ctx)
+}
@@ -0,0 +1,159 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/productfile"
+)
+
+// ProductFile son los datos de un archivo ya guardado en el almacenamiento
+type ProductFile struct {
+	TenantID     int
+	ProductID    int
+	UserID       int
+	Kind         string
+	FileName     string
+	ContentType  string
+	Size         int64
+	StorageKey   string
+	ThumbnailKey string
+	Width        int
+	Height       int
+}
+
+type ProductFileRepository interface {
+	Create(ctx context.Context, file ProductFile) (*ent.ProductFile, error)
+	FindByID(ctx context.Context, id int) (*ent.ProductFile, error)
+	FindByProduct(ctx context.Context, productID int, kind string) ([]*ent.ProductFile, error)
+	FindMainImages(ctx context.Context, productIDs []int) (map[int]*ent.ProductFile, error)
+	Reorder(ctx context.Context, productID int, ids []int) error
+	Delete(ctx context.Context, id int) error
+}
+
+type productFileRepository struct {
+	client *ent.Client
+}
+
+func NewProductFileRepository(client *ent.Client) ProductFileRepository {
+	return &productFileRepository{client: client}
+}
+
+// Create registra el archivo al final de los de su tipo
+func (r *productFileRepository) Create(ctx context.Context, file ProductFile) (*ent.ProductFile, error) {
+	position := 0
+	last, err := r.client.ProductFile.
+		Query().
+		Where(
+			productfile.ProductIDEQ(file.ProductID),
+			productfile.KindEQ(file.Kind),
+		).
+		Order(ent.Desc(productfile.FieldPosition)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if last != nil {
+		position = last.Position + 1
+	}
+
+	builder := r.client.ProductFile.
+		Create().
+		SetTenantID(file.TenantID).
+		SetProductID(file.ProductID).
+		SetUserID(file.UserID).
+		SetKind(file.Kind).
+		SetFileName(file.FileName).
+		SetContentType(file.ContentType).
+		SetSize(file.Size).
+		SetStorageKey(file.StorageKey).
+		SetWidth(file.Width).
+		SetHeight(file.Height).
+		SetPosition(position)
+
+	if file.ThumbnailKey != "" {
+		builder.SetThumbnailKey(file.ThumbnailKey)
+	}
+
+	return builder.Save(ctx)
+}
+
+func (r *productFileRepository) FindByID(ctx context.Context, id int) (*ent.ProductFile, error) {
+	return r.client.ProductFile.
+		Query().
+		Where(productfile.IDEQ(id)).
+		Only(ctx)
+}
+
+// FindByProduct devuelve los archivos del producto en orden; con kind vacío devuelve todos
+func (r *productFileRepository) FindByProduct(ctx context.Context, productID int, kind string) ([]*ent.ProductFile, error) {
+	query := r.client.ProductFile.
+		Query().
+		Where(productfile.ProductIDEQ(productID))
+
+	if kind != "" {
+		query = query.Where(productfile.KindEQ(kind))
+	}
+
+	return query.
+		Order(ent.Asc(productfile.FieldKind), ent.Asc(productfile.FieldPosition), ent.Asc(productfile.FieldID)).
+		All(ctx)
+}
+
+// FindMainImages devuelve la primera imagen de cada producto que tenga alguna
+func (r *productFileRepository) FindMainImages(ctx context.Context, productIDs []int) (map[int]*ent.ProductFile, error) {
+	images, err := r.client.ProductFile.
+		Query().
+		Where(
+			productfile.ProductIDIn(productIDs...),
+			productfile.KindEQ("image"),
+		).
+		Order(ent.Desc(productfile.FieldPosition), ent.Desc(productfile.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Recorridas de la última a la primera, la que queda en el mapa es la principal
+	main := make(map[int]*ent.ProductFile)
+	for _, image := range images {
+		main[image.ProductID] = image
+	}
+
+	return main, nil
+}
+
+// Reorder asigna a las imágenes el orden en que vienen los IDs; deben ser todas las del producto
+func (r *productFileRepository) Reorder(ctx context.Context, productID int, ids []int) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	for position, id := range ids {
+		affected, err := tx.ProductFile.
+			Update().
+			Where(
+				productfile.IDEQ(id),
+				productfile.ProductIDEQ(productID),
+				productfile.KindEQ("image"),
+			).
+			SetPosition(position).
+			Save(ctx)
+		if err != nil {
+			return rollback(tx, err)
+		}
+		if affected == 0 {
+			return rollback(tx, fmt.Errorf("la imagen %d no pertenece al producto", id))
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *productFileRepository) Delete(ctx context.Context, id int) error {
+	return r.client.ProductFile.
+		DeleteOneID(id).
+		Exec(ctx)
+}
@@ -0,0 +1,198 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/invoicelotallocation"
+	"Veritasbackend/ent/productlot"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+// LotAllocation indica cuántas unidades de una línea de venta salen de un lote
+type LotAllocation struct {
+	LotID    int
+	Quantity float64
+}
+
+type ProductLotRepository interface {
+	FindByID(ctx context.Context, id int) (*ent.ProductLot, error)
+	FindByProduct(ctx context.Context, productID int) ([]*ent.ProductLot, error)
+	FindAvailableByProduct(ctx context.Context, productID int, asOf time.Time) ([]*ent.ProductLot, error)
+	FindExpiring(ctx context.Context, tenantID int, until time.Time) ([]*ent.ProductLot, error)
+	FindAllocationsByInvoice(ctx context.Context, invoiceID int) ([]*ent.InvoiceLotAllocation, error)
+}
+
+type productLotRepository struct {
+	client *ent.Client
+}
+
+func NewProductLotRepository(client *ent.Client) ProductLotRepository {
+	return &productLotRepository{client: client}
+}
+
+// createLotTx registra un lote recibido con todo su saldo disponible
+func createLotTx(ctx context.Context, tx *ent.Tx, tenantID, productID int, lotNumber string, expiryDate *time.Time, quantity float64, purchaseInvoiceID int) (*ent.ProductLot, error) {
+	builder := tx.ProductLot.
+		Create().
+		SetTenantID(tenantID).
+		SetProductID(productID).
+		SetLotNumber(lotNumber).
+		SetNillableExpiryDate(expiryDate).
+		SetQuantity(quantity).
+		SetRemaining(quantity)
+
+	if purchaseInvoiceID != 0 {
+		builder.SetPurchaseInvoiceID(purchaseInvoiceID)
+	}
+
+	return builder.Save(ctx)
+}
+
+func (r *productLotRepository) FindByID(ctx context.Context, id int) (*ent.ProductLot, error) {
+	return r.client.ProductLot.
+		Query().
+		Where(productlot.IDEQ(id)).
+		Only(ctx)
+}
+
+func (r *productLotRepository) FindByProduct(ctx context.Context, productID int) ([]*ent.ProductLot, error) {
+	return r.client.ProductLot.
+		Query().
+		Where(productlot.ProductIDEQ(productID)).
+		Order(ent.Desc(productlot.FieldCreatedAt)).
+		All(ctx)
+}
+
+// FindAvailableByProduct devuelve los lotes con existencias que no han vencido a la fecha
+// indicada, ordenados primero-en-vencer-primero-en-salir (los lotes sin vencimiento van al final)
+func (r *productLotRepository) FindAvailableByProduct(ctx context.Context, productID int, asOf time.Time) ([]*ent.ProductLot, error) {
+	return r.client.ProductLot.
+		Query().
+		Where(
+			productlot.ProductIDEQ(productID),
+			productlot.RemainingGT(0),
+			productlot.Or(
+				productlot.ExpiryDateIsNil(),
+				productlot.ExpiryDateGTE(asOf),
+			),
+		).
+		Order(
+			func(s *sql.Selector) {
+				s.OrderBy(sql.Asc(s.C(productlot.FieldExpiryDate)) + " NULLS LAST")
+			},
+			ent.Asc(productlot.FieldCreatedAt),
+		).
+		All(ctx)
+}
+
+// FindExpiring devuelve los lotes con existencias que vencen antes de la fecha indicada,
+// incluidos los que ya están vencidos
+func (r *productLotRepository) FindExpiring(ctx context.Context, tenantID int, until time.Time) ([]*ent.ProductLot, error) {
+	return r.client.ProductLot.
+		Query().
+		Where(
+			productlot.TenantIDEQ(tenantID),
+			productlot.RemainingGT(0),
+			productlot.ExpiryDateNotNil(),
+			productlot.ExpiryDateLTE(until),
+		).
+		Order(ent.Asc(productlot.FieldExpiryDate)).
+		All(ctx)
+}
+
+func (r *productLotRepository) FindAllocationsByInvoice(ctx context.Context, invoiceID int) ([]*ent.InvoiceLotAllocation, error) {
+	return r.client.InvoiceLotAllocation.
+		Query().
+		Where(invoicelotallocation.InvoiceIDEQ(invoiceID)).
+		All(ctx)
+}
+
+// consumeLotAllocationsTx descuenta de cada lote lo asignado a una venta dentro de una
+// transacción. El descuento es relativo y condicionado a que el lote todavía tenga la cantidad,
+// así dos ventas simultáneas no pueden consumir más de lo que queda.
+func consumeLotAllocationsTx(ctx context.Context, tx *ent.Tx, allocations []LotAllocation) error {
+	for _, allocation := range allocations {
+		if err := consumeLotTx(ctx, tx, allocation.LotID, allocation.Quantity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// consumeLotTx descuenta quantity del lote si le queda al menos esa cantidad
+func consumeLotTx(ctx context.Context, tx *ent.Tx, lotID int, quantity float64) error {
+	updated, err := tx.ProductLot.
+		Update().
+		Where(
+			productlot.IDEQ(lotID),
+			productlot.RemainingGTE(quantity),
+		).
+		AddRemaining(-quantity).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if updated > 0 {
+		return nil
+	}
+
+	lot, err := tx.ProductLot.
+		Query().
+		Where(productlot.IDEQ(lotID)).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("stock insuficiente en lote %s: disponible %s, solicitado %s", lot.LotNumber, qty.Format(lot.Remaining), qty.Format(quantity))
+}
+
+// consumeLotsTx descuenta la cantidad de los lotes vigentes del producto dentro de una
+// transacción, primero el que vence antes
+func consumeLotsTx(ctx context.Context, tx *ent.Tx, p *ent.Product, quantity float64, asOf time.Time) error {
+	lots, err := tx.ProductLot.
+		Query().
+		Where(
+			productlot.ProductIDEQ(p.ID),
+			productlot.RemainingGT(0),
+			productlot.Or(
+				productlot.ExpiryDateIsNil(),
+				productlot.ExpiryDateGTE(asOf),
+			),
+		).
+		Order(
+			func(s *sql.Selector) {
+				s.OrderBy(sql.Asc(s.C(productlot.FieldExpiryDate)) + " NULLS LAST")
+			},
+			ent.Asc(productlot.FieldCreatedAt),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	pending := qty.Round(quantity)
+	for _, lot := range lots {
+		if pending <= 0 {
+			break
+		}
+		take := lot.Remaining
+		if take > pending {
+			take = pending
+		}
+		if err := consumeLotTx(ctx, tx, lot.ID, take); err != nil {
+			return err
+		}
+		pending = qty.Round(pending - take)
+	}
+
+	if pending > 0 {
+		return fmt.Errorf("stock vigente insuficiente para producto %s: faltan %s", p.Name, qty.Format(pending))
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/product"
+	"Veritasbackend/ent/productprice"
+	"Veritasbackend/pkg/money"
+)
+
+type ProductPriceRepository interface {
+	Schedule(ctx context.Context, tenantID, productID, userID int, retailPrice money.Amount, wholesalePrice *money.Amount, effectiveFrom time.Time, source string) (*ent.ProductPrice, error)
+	Apply(ctx context.Context, id int) (*ent.ProductPrice, error)
+	Cancel(ctx context.Context, id int) error
+	FindByID(ctx context.Context, id int) (*ent.ProductPrice, error)
+	FindByProduct(ctx context.Context, productID int) ([]*ent.ProductPrice, error)
+	FindEffective(ctx context.Context, productID int, at time.Time) (*ent.ProductPrice, error)
+	FindEffectiveByTenant(ctx context.Context, tenantID int, at time.Time) (map[int]*ent.ProductPrice, error)
+	FindDue(ctx context.Context, now time.Time) ([]*ent.ProductPrice, error)
+}
+
+type productPriceRepository struct {
+	client *ent.Client
+}
+
+func NewProductPriceRepository(client *ent.Client) ProductPriceRepository {
+	return &productPriceRepository{client: client}
+}
+
+// Schedule registra un cambio de precio que rige desde la fecha indicada; queda pendiente hasta Apply
+func (r *productPriceRepository) Schedule(ctx context.Context, tenantID, productID, userID int, retailPrice money.Amount, wholesalePrice *money.Amount, effectiveFrom time.Time, source string) (*ent.ProductPrice, error) {
+	return r.client.ProductPrice.
+		Create().
+		SetTenantID(tenantID).
+		SetProductID(productID).
+		SetUserID(userID).
+		SetRetailPrice(retailPrice).
+		SetNillableWholesalePrice(wholesalePrice).
+		SetEffectiveFrom(effectiveFrom).
+		SetStatus("scheduled").
+		SetSource(source).
+		Save(ctx)
+}
+
+// Apply copia los precios del cambio al producto y completa el registro con el precio al
+// mayor y el costo vigentes, para que el historial quede con los tres valores. Si ya se aplicó
+// un cambio con fecha posterior, el producto no se toca y el registro solo queda en el historial.
+func (r *productPriceRepository) Apply(ctx context.Context, id int) (*ent.ProductPrice, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := tx.ProductPrice.
+		Query().
+		Where(productprice.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if price.Status != "scheduled" {
+		return nil, rollback(tx, fmt.Errorf("el cambio de precio no está programado (estado: %s)", price.Status))
+	}
+
+	p, err := tx.Product.
+		Query().
+		Where(product.IDEQ(price.ProductID)).
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	wholesale := p.WholesalePrice
+	if price.WholesalePrice != nil {
+		wholesale = *price.WholesalePrice
+	}
+
+	superseded, err := tx.ProductPrice.
+		Query().
+		Where(
+			productprice.ProductIDEQ(p.ID),
+			productprice.StatusEQ("applied"),
+			productprice.EffectiveFromGT(price.EffectiveFrom),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if !superseded {
+		err = tx.Product.
+			UpdateOneID(p.ID).
+			SetRetailPrice(price.RetailPrice).
+			SetWholesalePrice(wholesale).
+			Exec(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	price, err = tx.ProductPrice.
+		UpdateOneID(id).
+		SetStatus("applied").
+		SetWholesalePrice(wholesale).
+		SetPurchasePrice(p.PurchasePrice).
+		SetAppliedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return price, nil
+}
+
+func (r *productPriceRepository) Cancel(ctx context.Context, id int) error {
+	affected, err := r.client.ProductPrice.
+		Update().
+		Where(
+			productprice.IDEQ(id),
+			productprice.StatusEQ("scheduled"),
+		).
+		SetStatus("cancelled").
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("solo se puede cancelar un cambio de precio programado")
+	}
+
+	return nil
+}
+
+func (r *productPriceRepository) FindByID(ctx context.Context, id int) (*ent.ProductPrice, error) {
+	return r.client.ProductPrice.
+		Query().
+		Where(productprice.IDEQ(id)).
+		Only(ctx)
+}
+
+func (r *productPriceRepository) FindByProduct(ctx context.Context, productID int) ([]*ent.ProductPrice, error) {
+	return r.client.ProductPrice.
+		Query().
+		Where(productprice.ProductIDEQ(productID)).
+		Order(ent.Desc(productprice.FieldEffectiveFrom), ent.Desc(productprice.FieldID)).
+		All(ctx)
+}
+
+// FindEffective devuelve el último cambio no cancelado que rige a la fecha indicada, aunque el
+// programador todavía no lo haya aplicado al producto
+func (r *productPriceRepository) FindEffective(ctx context.Context, productID int, at time.Time) (*ent.ProductPrice, error) {
+	return r.client.ProductPrice.
+		Query().
+		Where(
+			productprice.ProductIDEQ(productID),
+			productprice.StatusNEQ("cancelled"),
+			productprice.EffectiveFromLTE(at),
+		).
+		Order(ent.Desc(productprice.FieldEffectiveFrom), ent.Desc(productprice.FieldID)).
+		First(ctx)
+}
+
+// FindEffectiveByTenant devuelve, por producto, el cambio que rige a la fecha indicada
+func (r *productPriceRepository) FindEffectiveByTenant(ctx context.Context, tenantID int, at time.Time) (map[int]*ent.ProductPrice, error) {
+	prices, err := r.client.ProductPrice.
+		Query().
+		Where(
+			productprice.TenantIDEQ(tenantID),
+			productprice.StatusNEQ("cancelled"),
+			productprice.EffectiveFromLTE(at),
+		).
+		Order(ent.Asc(productprice.FieldEffectiveFrom), ent.Asc(productprice.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	effective := make(map[int]*ent.ProductPrice)
+	for _, price := range prices {
+		effective[price.ProductID] = price
+	}
+
+	return effective, nil
+}
+
+// FindDue devuelve los cambios programados de todos los tenants cuya fecha ya llegó
+func (r *productPriceRepository) FindDue(ctx context.Context, now time.Time) ([]*ent.ProductPrice, error) {
+	return r.client.ProductPrice.
+		Query().
+		Where(
+			productprice.StatusEQ("scheduled"),
+			productprice.EffectiveFromLTE(now),
+		).
+		Order(ent.Asc(productprice.FieldEffectiveFrom), ent.Asc(productprice.FieldID)).
+		All(ctx)
+}
@@ -3,19 +3,72 @@ package repositories
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"Veritasbackend/ent"
+	"Veritasbackend/ent/bundleassembly"
+	"Veritasbackend/ent/bundleassemblyline"
+	"Veritasbackend/ent/bundlecomponent"
+	"Veritasbackend/ent/inventorycostlayer"
+	"Veritasbackend/ent/inventorycountline"
+	"Veritasbackend/ent/invoiceitem"
+	"Veritasbackend/ent/invoiceitemcomponent"
+	"Veritasbackend/ent/pricelistitem"
 	"Veritasbackend/ent/product"
+	"Veritasbackend/ent/productbarcode"
+	"Veritasbackend/ent/productfile"
+	"Veritasbackend/ent/productlot"
+	"Veritasbackend/ent/productprice"
+	"Veritasbackend/ent/productserial"
+	"Veritasbackend/ent/productunit"
+	"Veritasbackend/ent/purchaseinvoiceitem"
+	"Veritasbackend/ent/stockadjustmentline"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
 )
 
+// ProductFilter selecciona productos activos; los campos vacíos no filtran
+type ProductFilter struct {
+	IDs      []int
+	Category string
+	// Search busca en el nombre y el SKU
+	Search string
+}
+
+// ProductChange es el cambio de un producto dentro de una operación masiva. Los campos nil no
+// cambian; Category vacía quita la categoría y ClearTaxRate quita la tarifa de impuesto, con lo
+// que el producto pasa a usar la del tenant.
+type ProductChange struct {
+	ProductID      int
+	RetailPrice    *money.Amount
+	WholesalePrice *money.Amount
+	Category       *string
+	TaxRateID      *int
+	ClearTaxRate   bool
+	Archive        bool
+}
+
 type ProductRepository interface {
-	FindAll(ctx context.Context, tenantID int, limit, offset int) ([]*ent.Product, int, error)
+	FindAll(ctx context.Context, tenantID int, archived bool, limit, offset int) ([]*ent.Product, int, error)
 	FindByID(ctx context.Context, id int) (*ent.Product, error)
-	Create(ctx context.Context, tenantID int, name, description, sku string, price float64, stock int) (*ent.Product, error)
-	Update(ctx context.Context, id int, name, description, sku string, price float64, stock int) (*ent.Product, error)
-	UpdateStock(ctx context.Context, id int, quantity int) error
-	AddStock(ctx context.Context, id int, quantity int) error
-	Delete(ctx context.Context, id int) error
+	FindAllByTenant(ctx context.Context, tenantID int) ([]*ent.Product, error)
+	FindByIDsOrCategories(ctx context.Context, tenantID int, ids []int, categories []string) ([]*ent.Product, error)
+	FindByFilter(ctx context.Context, tenantID int, filter ProductFilter) ([]*ent.Product, error)
+	Create(ctx context.Context, tenantID int, name, description, sku string, retailPrice, purchasePrice money.Amount, stock float64) (*ent.Product, error)
+	Update(ctx context.Context, id int, name, description, sku string, retailPrice money.Amount) (*ent.Product, error)
+	SetWholesalePricing(ctx context.Context, id int, wholesalePrice money.Amount, minQuantity int) error
+	UpdateStock(ctx context.Context, id int, quantity float64) error
+	SetTrackLots(ctx context.Context, id int, trackLots bool) error
+	SetSerialized(ctx context.Context, id int, serialized bool, warrantyMonths int) error
+	SetCategory(ctx context.Context, id int, category string) error
+	SetBaseUnit(ctx context.Context, id int, baseUnit string, fractional bool) error
+	SetTaxRate(ctx context.Context, id int, taxRateID *int) error
+	ApplyChanges(ctx context.Context, tenantID, userID int, changes []ProductChange, audit AuditEntry) error
+	AddStock(ctx context.Context, id int, quantity float64) error
+	Archive(ctx context.Context, id int) error
+	Restore(ctx context.Context, id int) error
+	CountReferences(ctx context.Context, id int) (References, error)
+	Purge(ctx context.Context, id int) error
 	CountByTenant(ctx context.Context, tenantID int) (int, error)
 }
 
@@ -27,10 +80,14 @@ func NewProductRepository(client *ent.Client) ProductRepository {
 	return &productRepository{client: client}
 }
 
-func (r *productRepository) FindAll(ctx context.Context, tenantID int, limit, offset int) ([]*ent.Product, int, error) {
+// FindAll lista los productos activos, o los eliminados si archived es true
+func (r *productRepository) FindAll(ctx context.Context, tenantID int, archived bool, limit, offset int) ([]*ent.Product, int, error) {
 	query := r.client.Product.
 		Query().
-		Where(product.TenantIDEQ(tenantID))
+		Where(
+			product.TenantIDEQ(tenantID),
+			product.ArchivedEQ(archived),
+		)
 
 	total, err := query.Count(ctx)
 	if err != nil {
@@ -46,6 +103,8 @@ func (r *productRepository) FindAll(ctx context.Context, tenantID int, limit, of
 	return products, total, err
 }
 
+// FindByID también resuelve productos eliminados, para que los documentos históricos
+// sigan mostrando su nombre
 func (r *productRepository) FindByID(ctx context.Context, id int) (*ent.Product, error) {
 	return r.client.Product.
 		Query().
@@ -53,12 +112,68 @@ func (r *productRepository) FindByID(ctx context.Context, id int) (*ent.Product,
 		Only(ctx)
 }
 
-func (r *productRepository) Create(ctx context.Context, tenantID int, name, description, sku string, price float64, stock int) (*ent.Product, error) {
+// FindAllByTenant devuelve los productos activos del tenant
+func (r *productRepository) FindAllByTenant(ctx context.Context, tenantID int) ([]*ent.Product, error) {
+	return r.client.Product.
+		Query().
+		Where(
+			product.TenantIDEQ(tenantID),
+			product.ArchivedEQ(false),
+		).
+		Order(ent.Asc(product.FieldName)).
+		All(ctx)
+}
+
+// FindByIDsOrCategories devuelve los productos cuyo ID o categoría esté en las listas indicadas
+func (r *productRepository) FindByIDsOrCategories(ctx context.Context, tenantID int, ids []int, categories []string) ([]*ent.Product, error) {
+	return r.client.Product.
+		Query().
+		Where(
+			product.TenantIDEQ(tenantID),
+			product.Or(
+				product.IDIn(ids...),
+				product.CategoryIn(categories...),
+			),
+		).
+		Order(ent.Asc(product.FieldName)).
+		All(ctx)
+}
+
+// FindByFilter devuelve los productos activos que cumplen todos los criterios del filtro
+func (r *productRepository) FindByFilter(ctx context.Context, tenantID int, filter ProductFilter) ([]*ent.Product, error) {
+	query := r.client.Product.
+		Query().
+		Where(
+			product.TenantIDEQ(tenantID),
+			product.ArchivedEQ(false),
+		)
+
+	if len(filter.IDs) > 0 {
+		query = query.Where(product.IDIn(filter.IDs...))
+	}
+	if filter.Category != "" {
+		query = query.Where(product.CategoryEQ(filter.Category))
+	}
+	if filter.Search != "" {
+		query = query.Where(product.Or(
+			product.NameContainsFold(filter.Search),
+			product.SkuContainsFold(filter.Search),
+		))
+	}
+
+	return query.
+		Order(ent.Asc(product.FieldName)).
+		All(ctx)
+}
+
+// Create registra el producto; purchasePrice es el costo inicial del stock con el que se crea
+func (r *productRepository) Create(ctx context.Context, tenantID int, name, description, sku string, retailPrice, purchasePrice money.Amount, stock float64) (*ent.Product, error) {
 	builder := r.client.Product.
 		Create().
 		SetTenantID(tenantID).
 		SetName(name).
-		SetPrice(price).
+		SetRetailPrice(retailPrice).
+		SetPurchasePrice(purchasePrice).
 		SetStock(stock)
 
 	if description != "" {
@@ -71,12 +186,13 @@ func (r *productRepository) Create(ctx context.Context, tenantID int, name, desc
 	return builder.Save(ctx)
 }
 
-func (r *productRepository) Update(ctx context.Context, id int, name, description, sku string, price float64, stock int) (*ent.Product, error) {
+// Update modifica los datos del producto. El stock solo cambia por ventas, compras y ajustes, y
+// el costo lo mantiene el método de costeo.
+func (r *productRepository) Update(ctx context.Context, id int, name, description, sku string, retailPrice money.Amount) (*ent.Product, error) {
 	builder := r.client.Product.
 		UpdateOneID(id).
 		SetName(name).
-		SetPrice(price).
-		SetStock(stock)
+		SetRetailPrice(retailPrice)
 
 	if description != "" {
 		builder.SetDescription(description)
@@ -88,7 +204,25 @@ func (r *productRepository) Update(ctx context.Context, id int, name, descriptio
 	return builder.Save(ctx)
 }
 
-func (r *productRepository) UpdateStock(ctx context.Context, id int, quantity int) error {
+// SetWholesalePricing fija el precio al mayor y la cantidad mínima para aplicarlo; en 0 lo desactiva
+func (r *productRepository) SetWholesalePricing(ctx context.Context, id int, wholesalePrice money.Amount, minQuantity int) error {
+	builder := r.client.Product.UpdateOneID(id)
+
+	if wholesalePrice > 0 {
+		builder.SetWholesalePrice(wholesalePrice)
+	} else {
+		builder.ClearWholesalePrice()
+	}
+	if minQuantity > 0 {
+		builder.SetMinWholesaleQuantity(minQuantity)
+	} else {
+		builder.ClearMinWholesaleQuantity()
+	}
+
+	return builder.Exec(ctx)
+}
+
+func (r *productRepository) UpdateStock(ctx context.Context, id int, quantity float64) error {
 	product, err := r.client.Product.
 		Query().
 		Where(product.IDEQ(id)).
@@ -97,9 +231,9 @@ func (r *productRepository) UpdateStock(ctx context.Context, id int, quantity in
 		return err
 	}
 
-	newStock := product.Stock - quantity
+	newStock := qty.Round(product.Stock - quantity)
 	if newStock < 0 {
-		return fmt.Errorf("stock insuficiente: disponible %d, solicitado %d", product.Stock, quantity)
+		return fmt.Errorf("stock insuficiente: disponible %s, solicitado %s", qty.Format(product.Stock), qty.Format(quantity))
 	}
 
 	_, err = r.client.Product.
@@ -110,7 +244,133 @@ func (r *productRepository) UpdateStock(ctx context.Context, id int, quantity in
 	return err
 }
 
-func (r *productRepository) AddStock(ctx context.Context, id int, quantity int) error {
+func (r *productRepository) SetTrackLots(ctx context.Context, id int, trackLots bool) error {
+	return r.client.Product.
+		UpdateOneID(id).
+		SetTrackLots(trackLots).
+		Exec(ctx)
+}
+
+func (r *productRepository) SetSerialized(ctx context.Context, id int, serialized bool, warrantyMonths int) error {
+	return r.client.Product.
+		UpdateOneID(id).
+		SetSerialized(serialized).
+		SetWarrantyMonths(warrantyMonths).
+		Exec(ctx)
+}
+
+func (r *productRepository) SetCategory(ctx context.Context, id int, category string) error {
+	builder := r.client.Product.UpdateOneID(id)
+	if category == "" {
+		builder.ClearCategory()
+	} else {
+		builder.SetCategory(category)
+	}
+
+	return builder.Exec(ctx)
+}
+
+// SetBaseUnit cambia la unidad en la que se lleva el stock y si admite fracciones
+func (r *productRepository) SetBaseUnit(ctx context.Context, id int, baseUnit string, fractional bool) error {
+	return r.client.Product.
+		UpdateOneID(id).
+		SetBaseUnit(baseUnit).
+		SetFractional(fractional).
+		Exec(ctx)
+}
+
+// SetTaxRate fija la tarifa de impuesto del producto; nil la quita y el producto usa la
+// tarifa por defecto del tenant
+func (r *productRepository) SetTaxRate(ctx context.Context, id int, taxRateID *int) error {
+	builder := r.client.Product.UpdateOneID(id)
+	if taxRateID == nil {
+		builder.ClearTaxRateID()
+	} else {
+		builder.SetTaxRateID(*taxRateID)
+	}
+
+	return builder.Exec(ctx)
+}
+
+// ApplyChanges aplica una operación masiva en una sola transacción: si un producto falla no
+// cambia ninguno. Los cambios de precio quedan en el historial con origen "bulk" y la operación
+// completa en la bitácora.
+func (r *productRepository) ApplyChanges(ctx context.Context, tenantID, userID int, changes []ProductChange, audit AuditEntry) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, change := range changes {
+		p, err := tx.Product.
+			Query().
+			Where(
+				product.IDEQ(change.ProductID),
+				product.TenantIDEQ(tenantID),
+			).
+			Only(ctx)
+		if err != nil {
+			return rollback(tx, fmt.Errorf("producto %d: %w", change.ProductID, err))
+		}
+
+		builder := tx.Product.UpdateOneID(p.ID)
+		if change.RetailPrice != nil {
+			builder.SetRetailPrice(*change.RetailPrice)
+		}
+		if change.WholesalePrice != nil {
+			builder.SetWholesalePrice(*change.WholesalePrice)
+		}
+		if change.Category != nil {
+			if *change.Category == "" {
+				builder.ClearCategory()
+			} else {
+				builder.SetCategory(*change.Category)
+			}
+		}
+		if change.ClearTaxRate {
+			builder.ClearTaxRateID()
+		} else if change.TaxRateID != nil {
+			builder.SetTaxRateID(*change.TaxRateID)
+		}
+		if change.Archive {
+			builder.SetArchived(true).SetDeletedAt(now)
+		}
+
+		p, err = builder.Save(ctx)
+		if err != nil {
+			return rollback(tx, fmt.Errorf("producto %d: %w", change.ProductID, err))
+		}
+
+		if change.RetailPrice != nil || change.WholesalePrice != nil {
+			price := tx.ProductPrice.
+				Create().
+				SetTenantID(tenantID).
+				SetProductID(p.ID).
+				SetUserID(userID).
+				SetRetailPrice(p.RetailPrice).
+				SetPurchasePrice(p.PurchasePrice).
+				SetEffectiveFrom(now).
+				SetStatus("applied").
+				SetSource("bulk").
+				SetAppliedAt(now)
+			if p.WholesalePrice > 0 {
+				price.SetWholesalePrice(p.WholesalePrice)
+			}
+			if _, err := price.Save(ctx); err != nil {
+				return rollback(tx, err)
+			}
+		}
+	}
+
+	if _, err := createAuditLogTx(ctx, tx, audit); err != nil {
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *productRepository) AddStock(ctx context.Context, id int, quantity float64) error {
 	product, err := r.client.Product.
 		Query().
 		Where(product.IDEQ(id)).
@@ -119,7 +379,7 @@ func (r *productRepository) AddStock(ctx context.Context, id int, quantity int)
 		return err
 	}
 
-	newStock := product.Stock + quantity
+	newStock := qty.Round(product.Stock + quantity)
 
 	_, err = r.client.Product.
 		UpdateOneID(id).
@@ -129,16 +389,126 @@ func (r *productRepository) AddStock(ctx context.Context, id int, quantity int)
 	return err
 }
 
-func (r *productRepository) Delete(ctx context.Context, id int) error {
+// Archive elimina el producto de forma lógica: deja de aparecer en listados y búsquedas
+func (r *productRepository) Archive(ctx context.Context, id int) error {
+	return r.client.Product.
+		UpdateOneID(id).
+		SetArchived(true).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (r *productRepository) Restore(ctx context.Context, id int) error {
 	return r.client.Product.
-		DeleteOneID(id).
+		UpdateOneID(id).
+		SetArchived(false).
+		ClearDeletedAt().
 		Exec(ctx)
 }
 
+// CountReferences cuenta los documentos y movimientos que apuntan al producto
+func (r *productRepository) CountReferences(ctx context.Context, id int) (References, error) {
+	counters := []struct {
+		kind  string
+		count func() (int, error)
+	}{
+		{"ventas", func() (int, error) {
+			return r.client.InvoiceItem.Query().Where(invoiceitem.ProductIDEQ(id)).Count(ctx)
+		}},
+		{"ventas en combo", func() (int, error) {
+			return r.client.InvoiceItemComponent.Query().Where(invoiceitemcomponent.ProductIDEQ(id)).Count(ctx)
+		}},
+		{"compras", func() (int, error) {
+			return r.client.PurchaseInvoiceItem.Query().Where(purchaseinvoiceitem.ProductIDEQ(id)).Count(ctx)
+		}},
+		{"ajustes de stock", func() (int, error) {
+			return r.client.StockAdjustmentLine.Query().Where(stockadjustmentline.ProductIDEQ(id)).Count(ctx)
+		}},
+		{"tomas de inventario", func() (int, error) {
+			return r.client.InventoryCountLine.Query().Where(inventorycountline.ProductIDEQ(id)).Count(ctx)
+		}},
+		{"armados de combo", func() (int, error) {
+			return r.client.BundleAssembly.Query().Where(bundleassembly.BundleIDEQ(id)).Count(ctx)
+		}},
+		{"consumos en armados", func() (int, error) {
+			return r.client.BundleAssemblyLine.Query().Where(bundleassemblyline.ProductIDEQ(id)).Count(ctx)
+		}},
+		{"combos", func() (int, error) {
+			return r.client.BundleComponent.Query().Where(bundlecomponent.ComponentIDEQ(id)).Count(ctx)
+		}},
+		{"lotes", func() (int, error) {
+			return r.client.ProductLot.Query().Where(productlot.ProductIDEQ(id)).Count(ctx)
+		}},
+		{"números de serie", func() (int, error) {
+			return r.client.ProductSerial.Query().Where(productserial.ProductIDEQ(id)).Count(ctx)
+		}},
+	}
+
+	refs := References{}
+	for _, counter := range counters {
+		count, err := counter.count()
+		if err != nil {
+			return nil, err
+		}
+		refs.add(counter.kind, count)
+	}
+
+	return refs, nil
+}
+
+// Purge borra el producto junto con los datos que le pertenecen (códigos, unidades, precios,
+// archivos, capas de costo y componentes si es combo). No revisa referencias: eso queda a
+// cargo de quien llama, con CountReferences.
+func (r *productRepository) Purge(ctx context.Context, id int) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	deletes := []func() (int, error){
+		func() (int, error) {
+			return tx.ProductBarcode.Delete().Where(productbarcode.ProductIDEQ(id)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.ProductUnit.Delete().Where(productunit.ProductIDEQ(id)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.ProductPrice.Delete().Where(productprice.ProductIDEQ(id)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.PriceListItem.Delete().Where(pricelistitem.ProductIDEQ(id)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.ProductFile.Delete().Where(productfile.ProductIDEQ(id)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.InventoryCostLayer.Delete().Where(inventorycostlayer.ProductIDEQ(id)).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.BundleComponent.Delete().Where(bundlecomponent.BundleIDEQ(id)).Exec(ctx)
+		},
+	}
+
+	for _, del := range deletes {
+		if _, err := del(); err != nil {
+			return rollback(tx, err)
+		}
+	}
+
+	if err := tx.Product.DeleteOneID(id).Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// CountByTenant cuenta los productos activos del tenant
 func (r *productRepository) CountByTenant(ctx context.Context, tenantID int) (int, error) {
 	return r.client.Product.
 		Query().
-		Where(product.TenantIDEQ(tenantID)).
+		Where(
+			product.TenantIDEQ(tenantID),
+			product.ArchivedEQ(false),
+		).
 		Count(ctx)
 }
-
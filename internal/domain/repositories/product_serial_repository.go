@@ -0,0 +1,154 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/productserial"
+	"Veritasbackend/ent/productserialevent"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type ProductSerialRepository interface {
+	FindBySerialNumber(ctx context.Context, tenantID int, serialNumber string) ([]*ent.ProductSerial, error)
+	FindByProductAndNumbers(ctx context.Context, productID int, serialNumbers []string) ([]*ent.ProductSerial, error)
+	FindByProduct(ctx context.Context, productID int, status string) ([]*ent.ProductSerial, error)
+	FindByInvoice(ctx context.Context, invoiceID int) ([]*ent.ProductSerial, error)
+	FindEvents(ctx context.Context, serialID int) ([]*ent.ProductSerialEvent, error)
+}
+
+type productSerialRepository struct {
+	client *ent.Client
+}
+
+func NewProductSerialRepository(client *ent.Client) ProductSerialRepository {
+	return &productSerialRepository{client: client}
+}
+
+// receiveSerialsTx registra en stock las unidades recibidas en una compra, con su evento de
+// recepción, dentro de la transacción de la compra
+func receiveSerialsTx(ctx context.Context, tx *ent.Tx, tenantID, productID, supplierID, purchaseInvoiceID, userID int, serialNumbers []string) error {
+	for _, number := range serialNumbers {
+		serial, err := tx.ProductSerial.
+			Create().
+			SetTenantID(tenantID).
+			SetProductID(productID).
+			SetSerialNumber(number).
+			SetStatus("in_stock").
+			SetSupplierID(supplierID).
+			SetPurchaseInvoiceID(purchaseInvoiceID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ProductSerialEvent.
+			Create().
+			SetSerialID(serial.ID).
+			SetEventType("received").
+			SetDocumentType("purchase_invoice").
+			SetDocumentID(purchaseInvoiceID).
+			SetSupplierID(supplierID).
+			SetUserID(userID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// markSerialsSoldTx marca las unidades como vendidas en la factura indicada y registra el evento,
+// dentro de la transacción de la factura. Solo cambia unidades que siguen en stock: si otra venta
+// tomó una unidad primero devuelve ErrInvalidState y la factura no se guarda.
+func markSerialsSoldTx(ctx context.Context, tx *ent.Tx, ids []int, invoiceID, userID int) error {
+	now := time.Now()
+	for _, id := range ids {
+		updated, err := tx.ProductSerial.
+			Update().
+			Where(
+				productserial.IDEQ(id),
+				productserial.StatusEQ("in_stock"),
+			).
+			SetStatus("sold").
+			SetInvoiceID(invoiceID).
+			SetSoldAt(now).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			serial, err := tx.ProductSerial.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("%w: el número de serie %s ya no está disponible", pkg_errors.ErrInvalidState, serial.SerialNumber)
+		}
+
+		_, err = tx.ProductSerialEvent.
+			Create().
+			SetSerialID(id).
+			SetEventType("sold").
+			SetDocumentType("invoice").
+			SetDocumentID(invoiceID).
+			SetUserID(userID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *productSerialRepository) FindBySerialNumber(ctx context.Context, tenantID int, serialNumber string) ([]*ent.ProductSerial, error) {
+	return r.client.ProductSerial.
+		Query().
+		Where(
+			productserial.TenantIDEQ(tenantID),
+			productserial.SerialNumberEQ(serialNumber),
+		).
+		All(ctx)
+}
+
+func (r *productSerialRepository) FindByProductAndNumbers(ctx context.Context, productID int, serialNumbers []string) ([]*ent.ProductSerial, error) {
+	return r.client.ProductSerial.
+		Query().
+		Where(
+			productserial.ProductIDEQ(productID),
+			productserial.SerialNumberIn(serialNumbers...),
+		).
+		All(ctx)
+}
+
+func (r *productSerialRepository) FindByProduct(ctx context.Context, productID int, status string) ([]*ent.ProductSerial, error) {
+	query := r.client.ProductSerial.
+		Query().
+		Where(productserial.ProductIDEQ(productID))
+
+	if status != "" {
+		query = query.Where(productserial.StatusEQ(status))
+	}
+
+	return query.
+		Order(ent.Asc(productserial.FieldReceivedAt)).
+		All(ctx)
+}
+
+func (r *productSerialRepository) FindByInvoice(ctx context.Context, invoiceID int) ([]*ent.ProductSerial, error) {
+	return r.client.ProductSerial.
+		Query().
+		Where(productserial.InvoiceIDEQ(invoiceID)).
+		All(ctx)
+}
+
+func (r *productSerialRepository) FindEvents(ctx context.Context, serialID int) ([]*ent.ProductSerialEvent, error) {
+	return r.client.ProductSerialEvent.
+		Query().
+		Where(productserialevent.SerialIDEQ(serialID)).
+		Order(ent.Asc(productserialevent.FieldCreatedAt)).
+		All(ctx)
+}
@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"context"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/productbarcode"
+	"Veritasbackend/ent/productunit"
+	"Veritasbackend/pkg/money"
+)
+
+type ProductUnitRepository interface {
+	Create(ctx context.Context, tenantID, productID int, name string, factor float64, retailPrice *money.Amount) (*ent.ProductUnit, error)
+	Update(ctx context.Context, id int, name string, factor float64, retailPrice *money.Amount) (*ent.ProductUnit, error)
+	FindByID(ctx context.Context, id int) (*ent.ProductUnit, error)
+	FindByProduct(ctx context.Context, productID int) ([]*ent.ProductUnit, error)
+	Delete(ctx context.Context, id int) error
+}
+
+type productUnitRepository struct {
+	client *ent.Client
+}
+
+func NewProductUnitRepository(client *ent.Client) ProductUnitRepository {
+	return &productUnitRepository{client: client}
+}
+
+func (r *productUnitRepository) Create(ctx context.Context, tenantID, productID int, name string, factor float64, retailPrice *money.Amount) (*ent.ProductUnit, error) {
+	return r.client.ProductUnit.
+		Create().
+		SetTenantID(tenantID).
+		SetProductID(productID).
+		SetName(name).
+		SetFactor(factor).
+		SetNillableRetailPrice(retailPrice).
+		Save(ctx)
+}
+
+// Update reemplaza los datos de la unidad; un precio nil vuelve al precio base por el factor
+func (r *productUnitRepository) Update(ctx context.Context, id int, name string, factor float64, retailPrice *money.Amount) (*ent.ProductUnit, error) {
+	builder := r.client.ProductUnit.
+		UpdateOneID(id).
+		SetName(name).
+		SetFactor(factor)
+
+	if retailPrice != nil {
+		builder.SetRetailPrice(*retailPrice)
+	} else {
+		builder.ClearRetailPrice()
+	}
+
+	return builder.Save(ctx)
+}
+
+func (r *productUnitRepository) FindByID(ctx context.Context, id int) (*ent.ProductUnit, error) {
+	return r.client.ProductUnit.
+		Query().
+		Where(productunit.IDEQ(id)).
+		Only(ctx)
+}
+
+func (r *productUnitRepository) FindByProduct(ctx context.Context, productID int) ([]*ent.ProductUnit, error) {
+	return r.client.ProductUnit.
+		Query().
+		Where(productunit.ProductIDEQ(productID)).
+		Order(ent.Asc(productunit.FieldFactor), ent.Asc(productunit.FieldID)).
+		All(ctx)
+}
+
+// Delete elimina la unidad junto con los códigos de barras del empaque; las facturas y compras
+// conservan el nombre y la cantidad de la unidad con que se registraron
+func (r *productUnitRepository) Delete(ctx context.Context, id int) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ProductBarcode.
+		Delete().
+		Where(productbarcode.UnitIDEQ(id)).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	if err := tx.ProductUnit.DeleteOneID(id).Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,269 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/invoiceitem"
+	"Veritasbackend/ent/promotion"
+	"Veritasbackend/ent/promotiontarget"
+	"Veritasbackend/pkg/money"
+)
+
+// PromotionData son los datos editables de una promoción. Sin Targets la promoción aplica a
+// todos los productos.
+type PromotionData struct {
+	Name          string
+	Description   string
+	CouponCode    string
+	Effect        string
+	Percent       float64
+	Amount        money.Amount
+	BuyQuantity   float64
+	FreeQuantity  float64
+	MinQuantity   float64
+	MinAmount     money.Amount
+	StartsAt      *time.Time
+	EndsAt        *time.Time
+	Weekdays      string
+	StartTime     string
+	EndTime       string
+	CustomerGroup string
+	Priority      int
+	Active        bool
+	Targets       []PromotionTarget
+}
+
+// PromotionTarget es un producto o una categoría a la que aplica la promoción
+type PromotionTarget struct {
+	ProductID int
+	Category  string
+}
+
+type PromotionRepository interface {
+	FindAll(ctx context.Context, tenantID int) ([]*ent.Promotion, error)
+	FindByID(ctx context.Context, id int) (*ent.Promotion, error)
+	FindActive(ctx context.Context, tenantID int) ([]*ent.Promotion, error)
+	FindByCoupon(ctx context.Context, tenantID int, code string) (*ent.Promotion, error)
+	FindTargets(ctx context.Context, promotionIDs ...int) (map[int][]*ent.PromotionTarget, error)
+	Create(ctx context.Context, tenantID int, data PromotionData) (*ent.Promotion, error)
+	Update(ctx context.Context, id int, data PromotionData) (*ent.Promotion, error)
+	CountReferences(ctx context.Context, id int) (References, error)
+	Delete(ctx context.Context, id int) error
+}
+
+type promotionRepository struct {
+	client *ent.Client
+}
+
+func NewPromotionRepository(client *ent.Client) PromotionRepository {
+	return &promotionRepository{client: client}
+}
+
+func (r *promotionRepository) FindAll(ctx context.Context, tenantID int) ([]*ent.Promotion, error) {
+	return r.client.Promotion.
+		Query().
+		Where(promotion.TenantIDEQ(tenantID)).
+		Order(ent.Desc(promotion.FieldPriority), ent.Asc(promotion.FieldName)).
+		All(ctx)
+}
+
+func (r *promotionRepository) FindByID(ctx context.Context, id int) (*ent.Promotion, error) {
+	return r.client.Promotion.
+		Query().
+		Where(promotion.IDEQ(id)).
+		Only(ctx)
+}
+
+// FindActive devuelve las promociones activas del tenant; la vigencia por fecha y hora la
+// evalúa quien factura
+func (r *promotionRepository) FindActive(ctx context.Context, tenantID int) ([]*ent.Promotion, error) {
+	return r.client.Promotion.
+		Query().
+		Where(
+			promotion.TenantIDEQ(tenantID),
+			promotion.ActiveEQ(true),
+		).
+		Order(ent.Desc(promotion.FieldPriority), ent.Asc(promotion.FieldID)).
+		All(ctx)
+}
+
+// FindByCoupon busca la promoción con el código de cupón, que se guarda en mayúsculas
+func (r *promotionRepository) FindByCoupon(ctx context.Context, tenantID int, code string) (*ent.Promotion, error) {
+	return r.client.Promotion.
+		Query().
+		Where(
+			promotion.TenantIDEQ(tenantID),
+			promotion.CouponCodeEQ(code),
+		).
+		First(ctx)
+}
+
+// FindTargets devuelve los productos y categorías de cada promoción, agrupados por promoción
+func (r *promotionRepository) FindTargets(ctx context.Context, promotionIDs ...int) (map[int][]*ent.PromotionTarget, error) {
+	targets, err := r.client.PromotionTarget.
+		Query().
+		Where(promotiontarget.PromotionIDIn(promotionIDs...)).
+		Order(ent.Asc(promotiontarget.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byPromotion := make(map[int][]*ent.PromotionTarget, len(promotionIDs))
+	for _, target := range targets {
+		byPromotion[target.PromotionID] = append(byPromotion[target.PromotionID], target)
+	}
+
+	return byPromotion, nil
+}
+
+func (r *promotionRepository) Create(ctx context.Context, tenantID int, data PromotionData) (*ent.Promotion, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := tx.Promotion.
+		Create().
+		SetTenantID(tenantID).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetCouponCode(data.CouponCode).
+		SetEffect(data.Effect).
+		SetPercent(data.Percent).
+		SetAmount(data.Amount).
+		SetBuyQuantity(data.BuyQuantity).
+		SetFreeQuantity(data.FreeQuantity).
+		SetMinQuantity(data.MinQuantity).
+		SetMinAmount(data.MinAmount).
+		SetNillableStartsAt(data.StartsAt).
+		SetNillableEndsAt(data.EndsAt).
+		SetWeekdays(data.Weekdays).
+		SetStartTime(data.StartTime).
+		SetEndTime(data.EndTime).
+		SetCustomerGroup(data.CustomerGroup).
+		SetPriority(data.Priority).
+		SetActive(data.Active)
+
+	promo, err := builder.Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := createPromotionTargetsTx(ctx, tx, promo.ID, data.Targets); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return promo, nil
+}
+
+// Update reemplaza los datos de la promoción y todos sus productos y categorías
+func (r *promotionRepository) Update(ctx context.Context, id int, data PromotionData) (*ent.Promotion, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := tx.Promotion.
+		UpdateOneID(id).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetCouponCode(data.CouponCode).
+		SetEffect(data.Effect).
+		SetPercent(data.Percent).
+		SetAmount(data.Amount).
+		SetBuyQuantity(data.BuyQuantity).
+		SetFreeQuantity(data.FreeQuantity).
+		SetMinQuantity(data.MinQuantity).
+		SetMinAmount(data.MinAmount).
+		SetWeekdays(data.Weekdays).
+		SetStartTime(data.StartTime).
+		SetEndTime(data.EndTime).
+		SetCustomerGroup(data.CustomerGroup).
+		SetPriority(data.Priority).
+		SetActive(data.Active)
+
+	if data.StartsAt != nil {
+		builder.SetStartsAt(*data.StartsAt)
+	} else {
+		builder.ClearStartsAt()
+	}
+	if data.EndsAt != nil {
+		builder.SetEndsAt(*data.EndsAt)
+	} else {
+		builder.ClearEndsAt()
+	}
+
+	promo, err := builder.Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if _, err := tx.PromotionTarget.Delete().Where(promotiontarget.PromotionIDEQ(id)).Exec(ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := createPromotionTargetsTx(ctx, tx, id, data.Targets); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return promo, nil
+}
+
+func createPromotionTargetsTx(ctx context.Context, tx *ent.Tx, promotionID int, targets []PromotionTarget) error {
+	for _, target := range targets {
+		builder := tx.PromotionTarget.
+			Create().
+			SetPromotionID(promotionID)
+		if target.ProductID != 0 {
+			builder.SetProductID(target.ProductID)
+		}
+		if target.Category != "" {
+			builder.SetCategory(target.Category)
+		}
+		if err := builder.Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CountReferences cuenta las líneas de factura a las que se aplicó la promoción
+func (r *promotionRepository) CountReferences(ctx context.Context, id int) (References, error) {
+	refs := References{}
+
+	sales, err := r.client.InvoiceItem.Query().Where(invoiceitem.PromotionIDEQ(id)).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refs.add("líneas de venta", sales)
+
+	return refs, nil
+}
+
+// Delete borra la promoción con sus productos y categorías; quien llama debe revisar antes
+// CountReferences
+func (r *promotionRepository) Delete(ctx context.Context, id int) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.PromotionTarget.Delete().Where(promotiontarget.PromotionIDEQ(id)).Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	if err := tx.Promotion.DeleteOneID(id).Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
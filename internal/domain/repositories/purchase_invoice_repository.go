@@ -2,25 +2,122 @@ package repositories
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"Veritasbackend/ent"
 	"Veritasbackend/ent/purchaseinvoice"
 	"Veritasbackend/ent/purchaseinvoiceitem"
+	"Veritasbackend/ent/supplierpayment"
+	"Veritasbackend/pkg/money"
 )
 
+// LastPurchase describe la compra más reciente de un producto
+type LastPurchase struct {
+	SupplierID        int
+	PurchaseInvoiceID int
+	UnitCost          money.Amount
+	PurchasedAt       time.Time
+}
+
+// DocumentCurrency es la moneda de una factura de compra, la tasa con que se registró y sus
+// totales en la moneda base del tenant
+type DocumentCurrency struct {
+	Currency     string
+	ExchangeRate float64
+	BaseSubtotal money.Amount
+	BaseTaxTotal money.Amount
+	BaseTotal    money.Amount
+}
+
+// PurchaseData es una factura de compra recibida con sus ítems. Los importes van en la moneda de
+// la factura; Currency lleva la tasa y los totales en la moneda base.
+type PurchaseData struct {
+	TenantID         int
+	SupplierID       int
+	UserID           int
+	InvoiceNumber    string
+	PaymentMethod    *string
+	DueDate          *time.Time
+	Subtotal         money.Amount
+	TaxTotal         money.Amount
+	Total            money.Amount
+	PricesIncludeTax bool
+	Taxes            []TaxLine
+	Currency         DocumentCurrency
+	Items            []PurchaseItem
+}
+
+// PurchaseItem es un ítem de una compra recibida. NewProduct es el producto que llega por primera
+// vez y se crea con la compra; BaseUnitCost es el costo unitario en moneda base con que entra al
+// inventario. Las cantidades de Lots van en la unidad base.
+type PurchaseItem struct {
+	Item         *ent.PurchaseInvoiceItem
+	NewProduct   *ent.Product
+	BaseUnitCost money.Amount
+	Lots         []PurchaseLot
+	Serials      []string
+}
+
+// PurchaseLot es un lote recibido en una compra
+type PurchaseLot struct {
+	LotNumber  string
+	ExpiryDate *time.Time
+	Quantity   float64
+}
+
+// ReceivedPurchaseItem es un ítem guardado con los lotes que se registraron para él
+type ReceivedPurchaseItem struct {
+	Item *ent.PurchaseInvoiceItem
+	Lots []*ent.ProductLot
+}
+
+// DraftPurchaseData es una orden de compra en borrador con sus ítems. Total y Currency van en la
+// moneda del borrador.
+type DraftPurchaseData struct {
+	TenantID      int
+	SupplierID    int
+	UserID        int
+	InvoiceNumber string
+	Total         money.Amount
+	Currency      DocumentCurrency
+	Items         []*ent.PurchaseInvoiceItem
+}
+
+// SupplierPaymentData es un pago a una factura de compra. Amount va en la moneda de la factura
+// y BaseAmount en la moneda base con la tasa del día del pago; FXGainLoss es la diferencia
+// contra lo registrado con la tasa de la factura.
+type SupplierPaymentData struct {
+	PurchaseInvoiceID int
+	TenantID          int
+	UserID            int
+	Amount            money.Amount
+	PaymentDate       time.Time
+	PaymentMethod     string
+	Reference         string
+	Notes             string
+	Currency          string
+	ExchangeRate      float64
+	BaseAmount        money.Amount
+	FXGainLoss        money.Amount
+}
+
 type PurchaseInvoiceRepository interface {
 	FindAll(ctx context.Context, tenantID int, limit, offset int) ([]*ent.PurchaseInvoice, int, error)
 	FindByID(ctx context.Context, id int) (*ent.PurchaseInvoice, error)
-	Create(ctx context.Context, tenantID, supplierID, userID int, invoiceNumber string, total float64, paymentMethod *string, dueDate *time.Time) (*ent.PurchaseInvoice, error)
-	Update(ctx context.Context, id int, status string, paidAmount float64) (*ent.PurchaseInvoice, error)
+	Create(ctx context.Context, data PurchaseData) (*ent.PurchaseInvoice, []ReceivedPurchaseItem, error)
+	CreateDraft(ctx context.Context, data DraftPurchaseData) (*ent.PurchaseInvoice, []*ent.PurchaseInvoiceItem, error)
+	Update(ctx context.Context, id int, status string, paidAmount money.Amount) (*ent.PurchaseInvoice, error)
 	Delete(ctx context.Context, id int) error
 	FindBySupplierID(ctx context.Context, supplierID int) ([]*ent.PurchaseInvoice, error)
+	FindLastPurchaseByProduct(ctx context.Context, tenantID int) (map[int]LastPurchase, error)
+	RegisterPayment(ctx context.Context, data SupplierPaymentData) (*ent.SupplierPayment, *ent.PurchaseInvoice, error)
+	FindPayments(ctx context.Context, purchaseInvoiceID int) ([]*ent.SupplierPayment, error)
 }
 
 type PurchaseInvoiceItemRepository interface {
 	FindByPurchaseInvoiceID(ctx context.Context, purchaseInvoiceID int) ([]*ent.PurchaseInvoiceItem, error)
-	Create(ctx context.Context, purchaseInvoiceID, productID, quantity int, unitCost, subtotal float64) (*ent.PurchaseInvoiceItem, error)
+	Create(ctx context.Context, item *ent.PurchaseInvoiceItem) (*ent.PurchaseInvoiceItem, error)
 	CreateBulk(ctx context.Context, items []*ent.PurchaseInvoiceItem) error
 	DeleteByPurchaseInvoiceID(ctx context.Context, purchaseInvoiceID int) error
 }
@@ -59,29 +156,160 @@ func (r *purchaseInvoiceRepository) FindByID(ctx context.Context, id int) (*ent.
 		Only(ctx)
 }
 
-func (r *purchaseInvoiceRepository) Create(ctx context.Context, tenantID, supplierID, userID int, invoiceNumber string, total float64, paymentMethod *string, dueDate *time.Time) (*ent.PurchaseInvoice, error) {
-	builder := r.client.PurchaseInvoice.
+// Create guarda la compra con sus impuestos, moneda e ítems y recibe la mercancía en una sola
+// transacción: crea los productos nuevos, suma el stock al costo en moneda base y registra lotes
+// y números de serie. Si algo falla no queda nada de la compra.
+func (r *purchaseInvoiceRepository) Create(ctx context.Context, data PurchaseData) (*ent.PurchaseInvoice, []ReceivedPurchaseItem, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	builder := tx.PurchaseInvoice.
 		Create().
-		SetTenantID(tenantID).
-		SetSupplierID(supplierID).
-		SetUserID(userID).
-		SetInvoiceNumber(invoiceNumber).
-		SetTotal(total).
+		SetTenantID(data.TenantID).
+		SetSupplierID(data.SupplierID).
+		SetUserID(data.UserID).
+		SetInvoiceNumber(data.InvoiceNumber).
+		SetSubtotal(data.Subtotal).
+		SetTaxTotal(data.TaxTotal).
+		SetTotal(data.Total).
+		SetPricesIncludeTax(data.PricesIncludeTax).
 		SetStatus("pending").
-		SetPaidAmount(0)
+		SetPaidAmount(0).
+		SetCurrency(data.Currency.Currency).
+		SetExchangeRate(data.Currency.ExchangeRate).
+		SetBaseSubtotal(data.Currency.BaseSubtotal).
+		SetBaseTaxTotal(data.Currency.BaseTaxTotal).
+		SetBaseTotal(data.Currency.BaseTotal)
+
+	if data.PaymentMethod != nil {
+		builder.SetPaymentMethod(*data.PaymentMethod)
+	}
+	if data.DueDate != nil {
+		builder.SetDueDate(*data.DueDate)
+	}
+
+	inv, err := builder.Save(ctx)
+	if err != nil {
+		return nil, nil, rollback(tx, err)
+	}
+
+	if err := createDocumentTaxesTx(ctx, tx, data.TenantID, "purchase_invoice", inv.ID, data.Taxes); err != nil {
+		return nil, nil, rollback(tx, err)
+	}
+
+	received := make([]ReceivedPurchaseItem, 0, len(data.Items))
+	for _, item := range data.Items {
+		if item.NewProduct != nil {
+			product, err := createPurchasedProductTx(ctx, tx, data.TenantID, item.NewProduct)
+			if err != nil {
+				return nil, nil, rollback(tx, fmt.Errorf("error al crear nuevo producto '%s': %w", item.NewProduct.Name, err))
+			}
+			item.Item.ProductID = product.ID
+		}
+		productID := item.Item.ProductID
+
+		item.Item.PurchaseInvoiceID = inv.ID
+		created, err := purchaseItemCreate(tx.PurchaseInvoiceItem, item.Item).Save(ctx)
+		if err != nil {
+			return nil, nil, rollback(tx, fmt.Errorf("error al crear ítem de factura para producto %d: %w", productID, err))
+		}
+
+		if err := receiveStockTx(ctx, tx, productID, created.Quantity, item.BaseUnitCost, "purchase", inv.ID); err != nil {
+			return nil, nil, rollback(tx, fmt.Errorf("error al actualizar stock del producto %d: %w", productID, err))
+		}
+
+		lots := make([]*ent.ProductLot, 0, len(item.Lots))
+		for _, lot := range item.Lots {
+			createdLot, err := createLotTx(ctx, tx, data.TenantID, productID, lot.LotNumber, lot.ExpiryDate, lot.Quantity, inv.ID)
+			if err != nil {
+				return nil, nil, rollback(tx, fmt.Errorf("error al registrar lote %s del producto %d: %w", lot.LotNumber, productID, err))
+			}
+			lots = append(lots, createdLot)
+		}
+
+		if len(item.Serials) > 0 {
+			if err := receiveSerialsTx(ctx, tx, data.TenantID, productID, data.SupplierID, inv.ID, data.UserID, item.Serials); err != nil {
+				return nil, nil, rollback(tx, fmt.Errorf("error al registrar números de serie del producto %d: %w", productID, err))
+			}
+		}
 
-	if paymentMethod != nil {
-		builder.SetPaymentMethod(*paymentMethod)
+		received = append(received, ReceivedPurchaseItem{Item: created, Lots: lots})
 	}
 
-	if dueDate != nil {
-		builder.SetDueDate(*dueDate)
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return inv, received, nil
+}
+
+// createPurchasedProductTx crea un producto que llega por primera vez en una compra, sin stock:
+// el stock entra con la recepción
+func createPurchasedProductTx(ctx context.Context, tx *ent.Tx, tenantID int, product *ent.Product) (*ent.Product, error) {
+	builder := tx.Product.
+		Create().
+		SetTenantID(tenantID).
+		SetName(product.Name).
+		SetRetailPrice(product.RetailPrice).
+		SetPurchasePrice(product.PurchasePrice).
+		SetStock(0).
+		SetTrackLots(product.TrackLots).
+		SetSerialized(product.Serialized)
+
+	if product.Sku != "" {
+		builder.SetSku(product.Sku)
 	}
 
 	return builder.Save(ctx)
 }
 
-func (r *purchaseInvoiceRepository) Update(ctx context.Context, id int, status string, paidAmount float64) (*ent.PurchaseInvoice, error) {
+// CreateDraft crea una orden de compra en borrador con su moneda y sus ítems en una sola
+// transacción; no afecta el stock hasta ser recibida
+func (r *purchaseInvoiceRepository) CreateDraft(ctx context.Context, data DraftPurchaseData) (*ent.PurchaseInvoice, []*ent.PurchaseInvoiceItem, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	inv, err := tx.PurchaseInvoice.
+		Create().
+		SetTenantID(data.TenantID).
+		SetSupplierID(data.SupplierID).
+		SetUserID(data.UserID).
+		SetInvoiceNumber(data.InvoiceNumber).
+		SetTotal(data.Total).
+		SetStatus("draft").
+		SetPaidAmount(0).
+		SetCurrency(data.Currency.Currency).
+		SetExchangeRate(data.Currency.ExchangeRate).
+		SetBaseSubtotal(data.Currency.BaseSubtotal).
+		SetBaseTaxTotal(data.Currency.BaseTaxTotal).
+		SetBaseTotal(data.Currency.BaseTotal).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, rollback(tx, err)
+	}
+
+	items := make([]*ent.PurchaseInvoiceItem, 0, len(data.Items))
+	for _, item := range data.Items {
+		item.PurchaseInvoiceID = inv.ID
+		created, err := purchaseItemCreate(tx.PurchaseInvoiceItem, item).Save(ctx)
+		if err != nil {
+			return nil, nil, rollback(tx, fmt.Errorf("error al crear ítem de borrador para producto %d: %w", item.ProductID, err))
+		}
+		items = append(items, created)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return inv, items, nil
+}
+
+func (r *purchaseInvoiceRepository) Update(ctx context.Context, id int, status string, paidAmount money.Amount) (*ent.PurchaseInvoice, error) {
 	return r.client.PurchaseInvoice.
 		UpdateOneID(id).
 		SetStatus(status).
@@ -96,6 +324,92 @@ func (r *purchaseInvoiceRepository) Delete(ctx context.Context, id int) error {
 		Exec(ctx)
 }
 
+// RegisterPayment guarda el pago y actualiza en la misma transacción lo pagado, el estado
+// (partial o paid) y la diferencia en cambio acumulada de la factura. El monto se suma solo si
+// la factura sigue con el saldo que vio quien llama, así dos pagos simultáneos no la sobrepagan.
+func (r *purchaseInvoiceRepository) RegisterPayment(ctx context.Context, data SupplierPaymentData) (*ent.SupplierPayment, *ent.PurchaseInvoice, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	inv, err := tx.PurchaseInvoice.Get(ctx, data.PurchaseInvoiceID)
+	if err != nil {
+		return nil, nil, rollback(tx, err)
+	}
+
+	paid := inv.PaidAmount + data.Amount
+	if paid > inv.Total {
+		return nil, nil, rollback(tx, fmt.Errorf("el pago de %s supera el saldo de %s", data.Amount, inv.Total-inv.PaidAmount))
+	}
+	status := "partial"
+	if paid == inv.Total {
+		status = "paid"
+	}
+
+	updated, err := tx.PurchaseInvoice.
+		Update().
+		Where(
+			purchaseinvoice.IDEQ(inv.ID),
+			purchaseinvoice.PaidAmountEQ(inv.PaidAmount),
+		).
+		SetPaidAmount(paid).
+		SetStatus(status).
+		AddFxGainLoss(data.FXGainLoss).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, rollback(tx, err)
+	}
+	if updated == 0 {
+		return nil, nil, rollback(tx, fmt.Errorf("la factura recibió otro pago mientras se registraba este; intente de nuevo"))
+	}
+
+	builder := tx.SupplierPayment.
+		Create().
+		SetPurchaseInvoiceID(inv.ID).
+		SetSupplierID(inv.SupplierID).
+		SetTenantID(data.TenantID).
+		SetUserID(data.UserID).
+		SetAmount(data.Amount).
+		SetPaymentDate(data.PaymentDate).
+		SetPaymentMethod(data.PaymentMethod).
+		SetCurrency(data.Currency).
+		SetExchangeRate(data.ExchangeRate).
+		SetBaseAmount(data.BaseAmount).
+		SetFxGainLoss(data.FXGainLoss)
+
+	if data.Reference != "" {
+		builder.SetReference(data.Reference)
+	}
+	if data.Notes != "" {
+		builder.SetNotes(data.Notes)
+	}
+
+	payment, err := builder.Save(ctx)
+	if err != nil {
+		return nil, nil, rollback(tx, err)
+	}
+
+	inv, err = tx.PurchaseInvoice.Get(ctx, inv.ID)
+	if err != nil {
+		return nil, nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return payment, inv, nil
+}
+
+func (r *purchaseInvoiceRepository) FindPayments(ctx context.Context, purchaseInvoiceID int) ([]*ent.SupplierPayment, error) {
+	return r.client.SupplierPayment.
+		Query().
+		Where(supplierpayment.PurchaseInvoiceIDEQ(purchaseInvoiceID)).
+		Order(ent.Asc(supplierpayment.FieldPaymentDate), ent.Asc(supplierpayment.FieldID)).
+		All(ctx)
+}
+
 func (r *purchaseInvoiceRepository) FindBySupplierID(ctx context.Context, supplierID int) ([]*ent.PurchaseInvoice, error) {
 	return r.client.PurchaseInvoice.
 		Query().
@@ -104,6 +418,57 @@ func (r *purchaseInvoiceRepository) FindBySupplierID(ctx context.Context, suppli
 		All(ctx)
 }
 
+// FindLastPurchaseByProduct devuelve, para cada producto, el proveedor y costo de la última
+// compra recibida (se excluyen borradores y compras anuladas). El costo va en la moneda base.
+func (r *purchaseInvoiceRepository) FindLastPurchaseByProduct(ctx context.Context, tenantID int) (map[int]LastPurchase, error) {
+	invoices, err := r.client.PurchaseInvoice.
+		Query().
+		Where(
+			purchaseinvoice.TenantIDEQ(tenantID),
+			purchaseinvoice.StatusNotIn("draft", "cancelled"),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	last := make(map[int]LastPurchase)
+	if len(invoices) == 0 {
+		return last, nil
+	}
+
+	invoicesByID := make(map[int]*ent.PurchaseInvoice, len(invoices))
+	ids := make([]int, 0, len(invoices))
+	for _, inv := range invoices {
+		invoicesByID[inv.ID] = inv
+		ids = append(ids, inv.ID)
+	}
+
+	items, err := r.client.PurchaseInvoiceItem.
+		Query().
+		Where(purchaseinvoiceitem.PurchaseInvoiceIDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		inv := invoicesByID[item.PurchaseInvoiceID]
+		current, ok := last[item.ProductID]
+		if ok && !inv.CreatedAt.After(current.PurchasedAt) {
+			continue
+		}
+		last[item.ProductID] = LastPurchase{
+			SupplierID:        inv.SupplierID,
+			PurchaseInvoiceID: inv.ID,
+			UnitCost:          item.UnitCost.Mul(inv.ExchangeRate),
+			PurchasedAt:       inv.CreatedAt,
+		}
+	}
+
+	return last, nil
+}
+
 type purchaseInvoiceItemRepository struct {
 	client *ent.Client
 }
@@ -119,15 +484,34 @@ func (r *purchaseInvoiceItemRepository) FindByPurchaseInvoiceID(ctx context.Cont
 		All(ctx)
 }
 
-func (r *purchaseInvoiceItemRepository) Create(ctx context.Context, purchaseInvoiceID, productID, quantity int, unitCost, subtotal float64) (*ent.PurchaseInvoiceItem, error) {
-	return r.client.PurchaseInvoiceItem.
+// Create guarda el ítem; UnitID, UnitName y UnitQuantity solo se indican si se compró en una
+// unidad distinta de la base y TaxRateID si la línea tiene tarifa de impuesto
+func (r *purchaseInvoiceItemRepository) Create(ctx context.Context, item *ent.PurchaseInvoiceItem) (*ent.PurchaseInvoiceItem, error) {
+	return purchaseItemCreate(r.client.PurchaseInvoiceItem, item).Save(ctx)
+}
+
+// purchaseItemCreate arma la creación del ítem con el cliente o la transacción que se indique
+func purchaseItemCreate(client *ent.PurchaseInvoiceItemClient, item *ent.PurchaseInvoiceItem) *ent.PurchaseInvoiceItemCreate {
+	builder := client.
 		Create().
-		SetPurchaseInvoiceID(purchaseInvoiceID).
-		SetProductID(productID).
-		SetQuantity(quantity).
-		SetUnitCost(unitCost).
-		SetSubtotal(subtotal).
-		Save(ctx)
+		SetPurchaseInvoiceID(item.PurchaseInvoiceID).
+		SetProductID(item.ProductID).
+		SetQuantity(item.Quantity).
+		SetUnitCost(item.UnitCost).
+		SetSubtotal(item.Subtotal).
+		SetNillableTaxRateID(item.TaxRateID).
+		SetTaxRate(item.TaxRate).
+		SetTaxBase(item.TaxBase).
+		SetTaxAmount(item.TaxAmount)
+
+	if item.UnitID != nil {
+		builder.
+			SetUnitID(*item.UnitID).
+			SetUnitName(item.UnitName).
+			SetNillableUnitQuantity(item.UnitQuantity)
+	}
+
+	return builder
 }
 
 func (r *purchaseInvoiceItemRepository) CreateBulk(ctx context.Context, items []*ent.PurchaseInvoiceItem) error {
@@ -0,0 +1,41 @@
+package repositories
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// References cuenta, por tipo de documento, los registros que apuntan a otro. Solo incluye
+// los tipos con al menos una referencia.
+type References map[string]int
+
+func (r References) add(kind string, count int) {
+	if count > 0 {
+		r[kind] = count
+	}
+}
+
+func (r References) Total() int {
+	total := 0
+	for _, count := range r {
+		total += count
+	}
+	return total
+}
+
+// String lista las referencias en orden alfabético, por ejemplo "compras (2), ventas (5)"
+func (r References) String() string {
+	kinds := make([]string, 0, len(r))
+	for kind := range r {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	parts := make([]string, len(kinds))
+	for i, kind := range kinds {
+		parts[i] = fmt.Sprintf("%s (%d)", kind, r[kind])
+	}
+
+	return strings.Join(parts, ", ")
+}
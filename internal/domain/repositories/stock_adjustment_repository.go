@@ -0,0 +1,316 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/adjustmentreason"
+	"Veritasbackend/ent/productlot"
+	"Veritasbackend/ent/stockadjustment"
+	"Veritasbackend/ent/stockadjustmentline"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+// ErrAdjustmentNotPending indica que el ajuste ya fue aplicado o rechazado
+var ErrAdjustmentNotPending = errors.New("el ajuste ya fue revisado")
+
+// AdjustmentLine es una diferencia de stock de un producto dentro de un ajuste
+type AdjustmentLine struct {
+	ProductID int
+	LotID     int
+	Quantity  float64
+	UnitCost  money.Amount
+}
+
+type StockAdjustmentRepository interface {
+	FindReasons(ctx context.Context, tenantID int) ([]*ent.AdjustmentReason, error)
+	FindReasonByID(ctx context.Context, id int) (*ent.AdjustmentReason, error)
+	FindReasonByCode(ctx context.Context, tenantID int, code string) (*ent.AdjustmentReason, error)
+	CreateReason(ctx context.Context, tenantID int, code, name string, writeOff bool) (*ent.AdjustmentReason, error)
+	UpdateReason(ctx context.Context, id int, name string, writeOff, active bool) (*ent.AdjustmentReason, error)
+	Create(ctx context.Context, tenantID, userID, reasonID int, notes, source string, lines []AdjustmentLine) (*ent.StockAdjustment, error)
+	FindByID(ctx context.Context, id int) (*ent.StockAdjustment, error)
+	FindAll(ctx context.Context, tenantID int, status string, limit, offset int) ([]*ent.StockAdjustment, int, error)
+	FindApplied(ctx context.Context, tenantID int, from, to time.Time) ([]*ent.StockAdjustment, error)
+	FindLines(ctx context.Context, adjustmentIDs []int) ([]*ent.StockAdjustmentLine, error)
+	Apply(ctx context.Context, id, reviewedBy int) error
+	Reject(ctx context.Context, id, reviewedBy int) error
+}
+
+type stockAdjustmentRepository struct {
+	client *ent.Client
+}
+
+func NewStockAdjustmentRepository(client *ent.Client) StockAdjustmentRepository {
+	return &stockAdjustmentRepository{client: client}
+}
+
+func (r *stockAdjustmentRepository) FindReasons(ctx context.Context, tenantID int) ([]*ent.AdjustmentReason, error) {
+	return r.client.AdjustmentReason.
+		Query().
+		Where(adjustmentreason.TenantIDEQ(tenantID)).
+		Order(ent.Asc(adjustmentreason.FieldName)).
+		All(ctx)
+}
+
+func (r *stockAdjustmentRepository) FindReasonByID(ctx context.Context, id int) (*ent.AdjustmentReason, error) {
+	return r.client.AdjustmentReason.
+		Query().
+		Where(adjustmentreason.IDEQ(id)).
+		Only(ctx)
+}
+
+func (r *stockAdjustmentRepository) FindReasonByCode(ctx context.Context, tenantID int, code string) (*ent.AdjustmentReason, error) {
+	return r.client.AdjustmentReason.
+		Query().
+		Where(
+			adjustmentreason.TenantIDEQ(tenantID),
+			adjustmentreason.CodeEQ(code),
+		).
+		Only(ctx)
+}
+
+func (r *stockAdjustmentRepository) CreateReason(ctx context.Context, tenantID int, code, name string, writeOff bool) (*ent.AdjustmentReason, error) {
+	return r.client.AdjustmentReason.
+		Create().
+		SetTenantID(tenantID).
+		SetCode(code).
+		SetName(name).
+		SetWriteOff(writeOff).
+		Save(ctx)
+}
+
+func (r *stockAdjustmentRepository) UpdateReason(ctx context.Context, id int, name string, writeOff, active bool) (*ent.AdjustmentReason, error) {
+	return r.client.AdjustmentReason.
+		UpdateOneID(id).
+		SetName(name).
+		SetWriteOff(writeOff).
+		SetActive(active).
+		Save(ctx)
+}
+
+// Create registra el ajuste pendiente con sus líneas valorizadas; el stock no cambia hasta Apply
+func (r *stockAdjustmentRepository) Create(ctx context.Context, tenantID, userID, reasonID int, notes, source string, lines []AdjustmentLine) (*ent.StockAdjustment, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totalValue := money.Zero
+	for _, line := range lines {
+		totalValue += line.UnitCost.Mul(line.Quantity)
+	}
+
+	builder := tx.StockAdjustment.
+		Create().
+		SetTenantID(tenantID).
+		SetUserID(userID).
+		SetReasonID(reasonID).
+		SetStatus("pending").
+		SetSource(source).
+		SetTotalValue(totalValue)
+
+	if notes != "" {
+		builder.SetNotes(notes)
+	}
+
+	adjustment, err := builder.Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	for _, line := range lines {
+		lineBuilder := tx.StockAdjustmentLine.
+			Create().
+			SetAdjustmentID(adjustment.ID).
+			SetProductID(line.ProductID).
+			SetQuantity(line.Quantity).
+			SetUnitCost(line.UnitCost).
+			SetTotalCost(line.UnitCost.Mul(line.Quantity))
+
+		if line.LotID != 0 {
+			lineBuilder.SetLotID(line.LotID)
+		}
+
+		if _, err := lineBuilder.Save(ctx); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return adjustment, nil
+}
+
+func (r *stockAdjustmentRepository) FindByID(ctx context.Context, id int) (*ent.StockAdjustment, error) {
+	return r.client.StockAdjustment.
+		Query().
+		Where(stockadjustment.IDEQ(id)).
+		Only(ctx)
+}
+
+func (r *stockAdjustmentRepository) FindAll(ctx context.Context, tenantID int, status string, limit, offset int) ([]*ent.StockAdjustment, int, error) {
+	query := r.client.StockAdjustment.
+		Query().
+		Where(stockadjustment.TenantIDEQ(tenantID))
+
+	if status != "" {
+		query = query.Where(stockadjustment.StatusEQ(status))
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	adjustments, err := query.
+		Limit(limit).
+		Offset(offset).
+		Order(ent.Desc(stockadjustment.FieldCreatedAt)).
+		All(ctx)
+
+	return adjustments, total, err
+}
+
+func (r *stockAdjustmentRepository) FindApplied(ctx context.Context, tenantID int, from, to time.Time) ([]*ent.StockAdjustment, error) {
+	return r.client.StockAdjustment.
+		Query().
+		Where(
+			stockadjustment.TenantIDEQ(tenantID),
+			stockadjustment.StatusEQ("applied"),
+			stockadjustment.AppliedAtGTE(from),
+			stockadjustment.AppliedAtLTE(to),
+		).
+		Order(ent.Asc(stockadjustment.FieldAppliedAt)).
+		All(ctx)
+}
+
+func (r *stockAdjustmentRepository) FindLines(ctx context.Context, adjustmentIDs []int) ([]*ent.StockAdjustmentLine, error) {
+	return r.client.StockAdjustmentLine.
+		Query().
+		Where(stockadjustmentline.AdjustmentIDIn(adjustmentIDs...)).
+		Order(ent.Asc(stockadjustmentline.FieldID)).
+		All(ctx)
+}
+
+// Apply mueve el stock y el costo de cada línea (y el saldo del lote, si lo indica) y marca
+// el ajuste como aplicado. Si alguna línea dejaría el stock en negativo no se aplica ninguna.
+func (r *stockAdjustmentRepository) Apply(ctx context.Context, id, reviewedBy int) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Se marca primero como aplicado para que dos aprobaciones simultáneas no muevan el stock dos veces
+	now := time.Now()
+	builder := tx.StockAdjustment.
+		Update().
+		Where(
+			stockadjustment.IDEQ(id),
+			stockadjustment.StatusEQ("pending"),
+		).
+		SetStatus("applied").
+		SetAppliedAt(now)
+
+	if reviewedBy != 0 {
+		builder.SetReviewedBy(reviewedBy).SetReviewedAt(now)
+	}
+
+	affected, err := builder.Save(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if affected == 0 {
+		return rollback(tx, ErrAdjustmentNotPending)
+	}
+
+	lines, err := tx.StockAdjustmentLine.
+		Query().
+		Where(stockadjustmentline.AdjustmentIDEQ(id)).
+		All(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	totalValue := money.Zero
+	for _, line := range lines {
+		// Las altas entran como capa al costo del ajuste; las bajas salen al costo del método
+		// del tenant y la línea queda valorizada con ese costo real
+		unitCost := line.UnitCost
+		if line.Quantity > 0 {
+			err = receiveStockTx(ctx, tx, line.ProductID, line.Quantity, line.UnitCost, "adjustment", id)
+		} else {
+			unitCost, err = issueStockTx(ctx, tx, line.ProductID, -line.Quantity)
+		}
+		if err != nil {
+			return rollback(tx, err)
+		}
+
+		totalCost := unitCost.Mul(line.Quantity)
+		totalValue += totalCost
+		if unitCost != line.UnitCost {
+			err = tx.StockAdjustmentLine.
+				UpdateOneID(line.ID).
+				SetUnitCost(unitCost).
+				SetTotalCost(totalCost).
+				Exec(ctx)
+			if err != nil {
+				return rollback(tx, err)
+			}
+		}
+
+		if line.LotID == 0 {
+			continue
+		}
+
+		lot, err := tx.ProductLot.
+			Query().
+			Where(productlot.IDEQ(line.LotID)).
+			Only(ctx)
+		if err != nil {
+			return rollback(tx, err)
+		}
+
+		remaining := qty.Round(lot.Remaining + line.Quantity)
+		if remaining < 0 {
+			return rollback(tx, fmt.Errorf("stock insuficiente en lote %s: disponible %s, ajuste %s", lot.LotNumber, qty.Format(lot.Remaining), qty.Format(line.Quantity)))
+		}
+
+		if err := tx.ProductLot.UpdateOneID(lot.ID).SetRemaining(remaining).Exec(ctx); err != nil {
+			return rollback(tx, err)
+		}
+	}
+
+	if err := tx.StockAdjustment.UpdateOneID(id).SetTotalValue(totalValue).Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *stockAdjustmentRepository) Reject(ctx context.Context, id, reviewedBy int) error {
+	affected, err := r.client.StockAdjustment.
+		Update().
+		Where(
+			stockadjustment.IDEQ(id),
+			stockadjustment.StatusEQ("pending"),
+		).
+		SetStatus("rejected").
+		SetReviewedBy(reviewedBy).
+		SetReviewedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrAdjustmentNotPending
+	}
+
+	return nil
+}
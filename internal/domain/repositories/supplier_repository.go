@@ -2,17 +2,25 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"Veritasbackend/ent"
+	"Veritasbackend/ent/productserial"
+	"Veritasbackend/ent/productserialevent"
+	"Veritasbackend/ent/purchaseinvoice"
 	"Veritasbackend/ent/supplier"
+	"Veritasbackend/ent/supplierpayment"
 )
 
 type SupplierRepository interface {
-	FindAll(ctx context.Context, tenantID int, limit, offset int) ([]*ent.Supplier, int, error)
+	FindAll(ctx context.Context, tenantID int, archived bool, limit, offset int) ([]*ent.Supplier, int, error)
 	FindByID(ctx context.Context, id int) (*ent.Supplier, error)
-	Create(ctx context.Context, tenantID int, name, email, phone, address, rucNit string) (*ent.Supplier, error)
-	Update(ctx context.Context, id int, name, email, phone, address, rucNit string) (*ent.Supplier, error)
-	Delete(ctx context.Context, id int) error
+	Create(ctx context.Context, tenantID int, name, email, phone, address, rucNit string, leadTimeDays int) (*ent.Supplier, error)
+	Update(ctx context.Context, id int, name, email, phone, address, rucNit string, leadTimeDays int) (*ent.Supplier, error)
+	Archive(ctx context.Context, id int) error
+	Restore(ctx context.Context, id int) error
+	CountReferences(ctx context.Context, id int) (References, error)
+	Purge(ctx context.Context, id int) error
 	CountByTenant(ctx context.Context, tenantID int) (int, error)
 }
 
@@ -24,10 +32,14 @@ func NewSupplierRepository(client *ent.Client) SupplierRepository {
 	return &supplierRepository{client: client}
 }
 
-func (r *supplierRepository) FindAll(ctx context.Context, tenantID int, limit, offset int) ([]*ent.Supplier, int, error) {
+// FindAll lista los proveedores activos, o los eliminados si archived es true
+func (r *supplierRepository) FindAll(ctx context.Context, tenantID int, archived bool, limit, offset int) ([]*ent.Supplier, int, error) {
 	query := r.client.Supplier.
 		Query().
-		Where(supplier.TenantIDEQ(tenantID))
+		Where(
+			supplier.TenantIDEQ(tenantID),
+			supplier.ArchivedEQ(archived),
+		)
 
 	total, err := query.Count(ctx)
 	if err != nil {
@@ -50,11 +62,12 @@ func (r *supplierRepository) FindByID(ctx context.Context, id int) (*ent.Supplie
 		Only(ctx)
 }
 
-func (r *supplierRepository) Create(ctx context.Context, tenantID int, name, email, phone, address, rucNit string) (*ent.Supplier, error) {
+func (r *supplierRepository) Create(ctx context.Context, tenantID int, name, email, phone, address, rucNit string, leadTimeDays int) (*ent.Supplier, error) {
 	builder := r.client.Supplier.
 		Create().
 		SetTenantID(tenantID).
-		SetName(name)
+		SetName(name).
+		SetLeadTimeDays(leadTimeDays)
 
 	if email != "" {
 		builder.SetEmail(email)
@@ -72,10 +85,11 @@ func (r *supplierRepository) Create(ctx context.Context, tenantID int, name, ema
 	return builder.Save(ctx)
 }
 
-func (r *supplierRepository) Update(ctx context.Context, id int, name, email, phone, address, rucNit string) (*ent.Supplier, error) {
+func (r *supplierRepository) Update(ctx context.Context, id int, name, email, phone, address, rucNit string, leadTimeDays int) (*ent.Supplier, error) {
 	builder := r.client.Supplier.
 		UpdateOneID(id).
-		SetName(name)
+		SetName(name).
+		SetLeadTimeDays(leadTimeDays)
 
 	if email != "" {
 		builder.SetEmail(email)
@@ -101,15 +115,68 @@ func (r *supplierRepository) Update(ctx context.Context, id int, name, email, ph
 	return builder.Save(ctx)
 }
 
-func (r *supplierRepository) Delete(ctx context.Context, id int) error {
+// Archive elimina el proveedor de forma lógica; sus compras y pagos lo siguen resolviendo
+func (r *supplierRepository) Archive(ctx context.Context, id int) error {
+	return r.client.Supplier.
+		UpdateOneID(id).
+		SetArchived(true).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (r *supplierRepository) Restore(ctx context.Context, id int) error {
+	return r.client.Supplier.
+		UpdateOneID(id).
+		SetArchived(false).
+		ClearDeletedAt().
+		Exec(ctx)
+}
+
+// CountReferences cuenta las compras, pagos y números de serie que apuntan al proveedor
+func (r *supplierRepository) CountReferences(ctx context.Context, id int) (References, error) {
+	refs := References{}
+
+	purchases, err := r.client.PurchaseInvoice.Query().Where(purchaseinvoice.SupplierIDEQ(id)).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refs.add("compras", purchases)
+
+	payments, err := r.client.SupplierPayment.Query().Where(supplierpayment.SupplierIDEQ(id)).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refs.add("pagos", payments)
+
+	serials, err := r.client.ProductSerial.Query().Where(productserial.SupplierIDEQ(id)).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refs.add("números de serie", serials)
+
+	events, err := r.client.ProductSerialEvent.Query().Where(productserialevent.SupplierIDEQ(id)).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refs.add("movimientos de series", events)
+
+	return refs, nil
+}
+
+// Purge borra el proveedor; quien llama debe revisar antes CountReferences
+func (r *supplierRepository) Purge(ctx context.Context, id int) error {
 	return r.client.Supplier.
 		DeleteOneID(id).
 		Exec(ctx)
 }
 
+// CountByTenant cuenta los proveedores activos del tenant
 func (r *supplierRepository) CountByTenant(ctx context.Context, tenantID int) (int, error) {
 	return r.client.Supplier.
 		Query().
-		Where(supplier.TenantIDEQ(tenantID)).
+		Where(
+			supplier.TenantIDEQ(tenantID),
+			supplier.ArchivedEQ(false),
+		).
 		Count(ctx)
-}
\ No newline at end of file
+}
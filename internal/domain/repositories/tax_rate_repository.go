@@ -0,0 +1,223 @@
+package repositories
+
+import (
+	"context"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/ent/invoiceitem"
+	"Veritasbackend/ent/invoicetax"
+	"Veritasbackend/ent/product"
+	"Veritasbackend/ent/purchaseinvoiceitem"
+	"Veritasbackend/ent/taxrate"
+	"Veritasbackend/pkg/money"
+)
+
+// TaxRateData son los datos editables de una tarifa de impuesto
+type TaxRateData struct {
+	Name      string
+	Rate      float64
+	Kind      string
+	IsDefault bool
+	Active    bool
+}
+
+// TaxLine es el resumen de una tarifa dentro de una factura: base gravable e impuesto
+type TaxLine struct {
+	TaxRateID int
+	Name      string
+	Kind      string
+	Rate      float64
+	Base      money.Amount
+	Amount    money.Amount
+}
+
+type TaxRateRepository interface {
+	FindAll(ctx context.Context, tenantID int) ([]*ent.TaxRate, error)
+	FindByID(ctx context.Context, id int) (*ent.TaxRate, error)
+	FindDefault(ctx context.Context, tenantID int) (*ent.TaxRate, error)
+	Create(ctx context.Context, tenantID int, data TaxRateData) (*ent.TaxRate, error)
+	Update(ctx context.Context, id int, data TaxRateData) (*ent.TaxRate, error)
+	CountReferences(ctx context.Context, id int) (References, error)
+	Delete(ctx context.Context, id int) error
+	FindDocumentTaxes(ctx context.Context, documentType string, documentID int) ([]*ent.InvoiceTax, error)
+}
+
+type taxRateRepository struct {
+	client *ent.Client
+}
+
+func NewTaxRateRepository(client *ent.Client) TaxRateRepository {
+	return &taxRateRepository{client: client}
+}
+
+func (r *taxRateRepository) FindAll(ctx context.Context, tenantID int) ([]*ent.TaxRate, error) {
+	return r.client.TaxRate.
+		Query().
+		Where(taxrate.TenantIDEQ(tenantID)).
+		Order(ent.Desc(taxrate.FieldRate), ent.Asc(taxrate.FieldName)).
+		All(ctx)
+}
+
+func (r *taxRateRepository) FindByID(ctx context.Context, id int) (*ent.TaxRate, error) {
+	return r.client.TaxRate.
+		Query().
+		Where(taxrate.IDEQ(id)).
+		Only(ctx)
+}
+
+// FindDefault devuelve la tarifa por defecto del tenant; NotFound si no definió ninguna
+func (r *taxRateRepository) FindDefault(ctx context.Context, tenantID int) (*ent.TaxRate, error) {
+	return r.client.TaxRate.
+		Query().
+		Where(
+			taxrate.TenantIDEQ(tenantID),
+			taxrate.IsDefaultEQ(true),
+			taxrate.ActiveEQ(true),
+		).
+		First(ctx)
+}
+
+// Create guarda la tarifa; si es la nueva tarifa por defecto, las demás del tenant dejan de serlo
+func (r *taxRateRepository) Create(ctx context.Context, tenantID int, data TaxRateData) (*ent.TaxRate, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.IsDefault {
+		if err := clearDefaultTaxRateTx(ctx, tx, tenantID); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	rate, err := tx.TaxRate.
+		Create().
+		SetTenantID(tenantID).
+		SetName(data.Name).
+		SetRate(data.Rate).
+		SetKind(data.Kind).
+		SetIsDefault(data.IsDefault).
+		SetActive(data.Active).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+func (r *taxRateRepository) Update(ctx context.Context, id int, data TaxRateData) (*ent.TaxRate, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := tx.TaxRate.Get(ctx, id)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if data.IsDefault && !current.IsDefault {
+		if err := clearDefaultTaxRateTx(ctx, tx, current.TenantID); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	rate, err := tx.TaxRate.
+		UpdateOneID(id).
+		SetName(data.Name).
+		SetRate(data.Rate).
+		SetKind(data.Kind).
+		SetIsDefault(data.IsDefault).
+		SetActive(data.Active).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+func clearDefaultTaxRateTx(ctx context.Context, tx *ent.Tx, tenantID int) error {
+	return tx.TaxRate.
+		Update().
+		Where(
+			taxrate.TenantIDEQ(tenantID),
+			taxrate.IsDefaultEQ(true),
+		).
+		SetIsDefault(false).
+		Exec(ctx)
+}
+
+// CountReferences cuenta los productos y líneas de factura que usan la tarifa
+func (r *taxRateRepository) CountReferences(ctx context.Context, id int) (References, error) {
+	refs := References{}
+
+	products, err := r.client.Product.Query().Where(product.TaxRateIDEQ(id)).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refs.add("productos", products)
+
+	sales, err := r.client.InvoiceItem.Query().Where(invoiceitem.TaxRateIDEQ(id)).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refs.add("líneas de venta", sales)
+
+	purchases, err := r.client.PurchaseInvoiceItem.Query().Where(purchaseinvoiceitem.TaxRateIDEQ(id)).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refs.add("líneas de compra", purchases)
+
+	return refs, nil
+}
+
+// Delete borra la tarifa; quien llama debe revisar antes CountReferences
+func (r *taxRateRepository) Delete(ctx context.Context, id int) error {
+	return r.client.TaxRate.
+		DeleteOneID(id).
+		Exec(ctx)
+}
+
+func (r *taxRateRepository) FindDocumentTaxes(ctx context.Context, documentType string, documentID int) ([]*ent.InvoiceTax, error) {
+	return r.client.InvoiceTax.
+		Query().
+		Where(
+			invoicetax.DocumentTypeEQ(documentType),
+			invoicetax.DocumentIDEQ(documentID),
+		).
+		Order(ent.Asc(invoicetax.FieldID)).
+		All(ctx)
+}
+
+// createDocumentTaxesTx guarda el resumen de impuestos por tarifa de una factura de venta o compra
+func createDocumentTaxesTx(ctx context.Context, tx *ent.Tx, tenantID int, documentType string, documentID int, taxes []TaxLine) error {
+	for _, line := range taxes {
+		err := tx.InvoiceTax.
+			Create().
+			SetDocumentType(documentType).
+			SetDocumentID(documentID).
+			SetTenantID(tenantID).
+			SetTaxRateID(line.TaxRateID).
+			SetName(line.Name).
+			SetKind(line.Kind).
+			SetRate(line.Rate).
+			SetBase(line.Base).
+			SetAmount(line.Amount).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
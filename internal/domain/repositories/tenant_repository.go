@@ -2,15 +2,45 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"Veritasbackend/ent"
 	"Veritasbackend/ent/tenant"
+	"Veritasbackend/pkg/money"
 )
 
+// TenantProfile son los datos fiscales y de contacto que se imprimen en los documentos
+type TenantProfile struct {
+	LegalName string
+	TaxID     string
+	Address   string
+	Phone     string
+	Email     string
+}
+
+// EInvoiceSettings es la habilitación del tenant ante la autoridad tributaria para emitir
+// facturas electrónicas
+type EInvoiceSettings struct {
+	Country      string
+	Environment  string
+	SoftwareID   string
+	SoftwarePIN  string
+	TechnicalKey string
+}
+
 type TenantRepository interface {
 	FindByID(ctx context.Context, id int) (*ent.Tenant, error)
 	FindBySlug(ctx context.Context, slug string) (*ent.Tenant, error)
 	Create(ctx context.Context, name, slug, domain string) (*ent.Tenant, error)
+	SetAdjustmentApprovalThreshold(ctx context.Context, id int, threshold money.Amount) error
+	SetCostingMethod(ctx context.Context, id int, method string) error
+	SetPricesIncludeTax(ctx context.Context, id int, included bool) error
+	SetDiscountLimits(ctx context.Context, id int, userLimit, managerLimit float64) error
+	SetBaseCurrency(ctx context.Context, id int, currency string) error
+	SetProfile(ctx context.Context, id int, profile TenantProfile) error
+	SetLogo(ctx context.Context, id int, logo []byte) error
+	SetEInvoiceSettings(ctx context.Context, id int, settings EInvoiceSettings) error
+	SetEInvoiceCertificate(ctx context.Context, id int, certPEM, keyPEM []byte, expiresAt time.Time) error
 }
 
 type tenantRepository struct {
@@ -44,3 +74,84 @@ func (r *tenantRepository) Create(ctx context.Context, name, slug, domain string
 		Save(ctx)
 }
 
+func (r *tenantRepository) SetAdjustmentApprovalThreshold(ctx context.Context, id int, threshold money.Amount) error {
+	return r.client.Tenant.
+		UpdateOneID(id).
+		SetAdjustmentApprovalThreshold(threshold).
+		Exec(ctx)
+}
+
+func (r *tenantRepository) SetCostingMethod(ctx context.Context, id int, method string) error {
+	return r.client.Tenant.
+		UpdateOneID(id).
+		SetCostingMethod(method).
+		Exec(ctx)
+}
+
+func (r *tenantRepository) SetPricesIncludeTax(ctx context.Context, id int, included bool) error {
+	return r.client.Tenant.
+		UpdateOneID(id).
+		SetPricesIncludeTax(included).
+		Exec(ctx)
+}
+
+// SetDiscountLimits fija el descuento máximo, en porcentaje, que dan sin autorización los
+// usuarios y los managers
+func (r *tenantRepository) SetDiscountLimits(ctx context.Context, id int, userLimit, managerLimit float64) error {
+	return r.client.Tenant.
+		UpdateOneID(id).
+		SetUserDiscountLimit(userLimit).
+		SetManagerDiscountLimit(managerLimit).
+		Exec(ctx)
+}
+
+// SetBaseCurrency cambia la moneda en que el tenant lleva precios, costos y reportes
+func (r *tenantRepository) SetBaseCurrency(ctx context.Context, id int, currency string) error {
+	return r.client.Tenant.
+		UpdateOneID(id).
+		SetBaseCurrency(currency).
+		Exec(ctx)
+}
+
+func (r *tenantRepository) SetProfile(ctx context.Context, id int, profile TenantProfile) error {
+	return r.client.Tenant.
+		UpdateOneID(id).
+		SetLegalName(profile.LegalName).
+		SetTaxID(profile.TaxID).
+		SetAddress(profile.Address).
+		SetPhone(profile.Phone).
+		SetEmail(profile.Email).
+		Exec(ctx)
+}
+
+// SetLogo guarda el logo del tenant; nil lo quita
+func (r *tenantRepository) SetLogo(ctx context.Context, id int, logo []byte) error {
+	update := r.client.Tenant.UpdateOneID(id)
+	if logo == nil {
+		update.ClearLogo()
+	} else {
+		update.SetLogo(logo)
+	}
+	return update.Exec(ctx)
+}
+
+func (r *tenantRepository) SetEInvoiceSettings(ctx context.Context, id int, settings EInvoiceSettings) error {
+	return r.client.Tenant.
+		UpdateOneID(id).
+		SetEinvoiceCountry(settings.Country).
+		SetEinvoiceEnvironment(settings.Environment).
+		SetEinvoiceSoftwareID(settings.SoftwareID).
+		SetEinvoiceSoftwarePin(settings.SoftwarePIN).
+		SetEinvoiceTechnicalKey(settings.TechnicalKey).
+		Exec(ctx)
+}
+
+// SetEInvoiceCertificate guarda el certificado de firma en PEM y su llave privada ya cifrada
+func (r *tenantRepository) SetEInvoiceCertificate(ctx context.Context, id int, certPEM, keyPEM []byte, expiresAt time.Time) error {
+	return r.client.Tenant.
+		UpdateOneID(id).
+		SetEinvoiceCertificate(certPEM).
+		SetEinvoicePrivateKey(keyPEM).
+		SetEinvoiceCertificateExpiresAt(expiresAt).
+		Exec(ctx)
+}
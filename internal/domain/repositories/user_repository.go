@@ -2,15 +2,31 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"Veritasbackend/ent"
+	"Veritasbackend/ent/bundleassembly"
+	"Veritasbackend/ent/inventorycount"
+	"Veritasbackend/ent/inventorycountentry"
+	"Veritasbackend/ent/invoice"
+	"Veritasbackend/ent/productfile"
+	"Veritasbackend/ent/productprice"
+	"Veritasbackend/ent/productserialevent"
+	"Veritasbackend/ent/purchaseinvoice"
+	"Veritasbackend/ent/stockadjustment"
+	"Veritasbackend/ent/supplierpayment"
 	"Veritasbackend/ent/user"
 )
 
 type UserRepository interface {
 	FindByEmail(ctx context.Context, email string) (*ent.User, error)
 	FindByID(ctx context.Context, id int) (*ent.User, error)
+	FindAll(ctx context.Context, tenantID int, archived bool, limit, offset int) ([]*ent.User, int, error)
 	Create(ctx context.Context, email, password, name, role string, tenantID int) (*ent.User, error)
+	Archive(ctx context.Context, id int) error
+	Restore(ctx context.Context, id int) error
+	CountReferences(ctx context.Context, id int) (References, error)
+	Purge(ctx context.Context, id int) error
 }
 
 type userRepository struct {
@@ -21,6 +37,7 @@ func NewUserRepository(client *ent.Client) UserRepository {
 	return &userRepository{client: client}
 }
 
+// FindByEmail también devuelve usuarios eliminados, para que el correo no se pueda reutilizar
 func (r *userRepository) FindByEmail(ctx context.Context, email string) (*ent.User, error) {
 	return r.client.User.
 		Query().
@@ -35,6 +52,29 @@ func (r *userRepository) FindByID(ctx context.Context, id int) (*ent.User, error
 		Only(ctx)
 }
 
+// FindAll lista los usuarios activos del tenant, o los eliminados si archived es true
+func (r *userRepository) FindAll(ctx context.Context, tenantID int, archived bool, limit, offset int) ([]*ent.User, int, error) {
+	query := r.client.User.
+		Query().
+		Where(
+			user.TenantIDEQ(tenantID),
+			user.ArchivedEQ(archived),
+		)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	users, err := query.
+		Limit(limit).
+		Offset(offset).
+		Order(ent.Asc(user.FieldName)).
+		All(ctx)
+
+	return users, total, err
+}
+
 func (r *userRepository) Create(ctx context.Context, email, password, name, role string, tenantID int) (*ent.User, error) {
 	return r.client.User.
 		Create().
@@ -46,3 +86,83 @@ func (r *userRepository) Create(ctx context.Context, email, password, name, role
 		Save(ctx)
 }
 
+// Archive elimina el usuario de forma lógica: ya no puede iniciar sesión, pero los documentos
+// que registró lo siguen mostrando
+func (r *userRepository) Archive(ctx context.Context, id int) error {
+	return r.client.User.
+		UpdateOneID(id).
+		SetArchived(true).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (r *userRepository) Restore(ctx context.Context, id int) error {
+	return r.client.User.
+		UpdateOneID(id).
+		SetArchived(false).
+		ClearDeletedAt().
+		Exec(ctx)
+}
+
+// CountReferences cuenta los documentos registrados, revisados o aprobados por el usuario
+func (r *userRepository) CountReferences(ctx context.Context, id int) (References, error) {
+	counters := []struct {
+		kind  string
+		count func() (int, error)
+	}{
+		{"ventas", func() (int, error) {
+			return r.client.Invoice.Query().Where(invoice.UserIDEQ(id)).Count(ctx)
+		}},
+		{"compras", func() (int, error) {
+			return r.client.PurchaseInvoice.Query().Where(purchaseinvoice.UserIDEQ(id)).Count(ctx)
+		}},
+		{"ajustes de stock", func() (int, error) {
+			return r.client.StockAdjustment.Query().Where(stockadjustment.Or(
+				stockadjustment.UserIDEQ(id),
+				stockadjustment.ReviewedByEQ(id),
+			)).Count(ctx)
+		}},
+		{"tomas de inventario", func() (int, error) {
+			return r.client.InventoryCount.Query().Where(inventorycount.Or(
+				inventorycount.UserIDEQ(id),
+				inventorycount.PostedByEQ(id),
+			)).Count(ctx)
+		}},
+		{"conteos", func() (int, error) {
+			return r.client.InventoryCountEntry.Query().Where(inventorycountentry.UserIDEQ(id)).Count(ctx)
+		}},
+		{"cambios de precio", func() (int, error) {
+			return r.client.ProductPrice.Query().Where(productprice.UserIDEQ(id)).Count(ctx)
+		}},
+		{"archivos de producto", func() (int, error) {
+			return r.client.ProductFile.Query().Where(productfile.UserIDEQ(id)).Count(ctx)
+		}},
+		{"armados de combo", func() (int, error) {
+			return r.client.BundleAssembly.Query().Where(bundleassembly.UserIDEQ(id)).Count(ctx)
+		}},
+		{"movimientos de series", func() (int, error) {
+			return r.client.ProductSerialEvent.Query().Where(productserialevent.UserIDEQ(id)).Count(ctx)
+		}},
+		{"pagos a proveedores", func() (int, error) {
+			return r.client.SupplierPayment.Query().Where(supplierpayment.UserIDEQ(id)).Count(ctx)
+		}},
+	}
+
+	refs := References{}
+	for _, counter := range counters {
+		count, err := counter.count()
+		if err != nil {
+			return nil, err
+		}
+		refs.add(counter.kind, count)
+	}
+
+	return refs, nil
+}
+
+// Purge borra el usuario; quien llama debe revisar antes CountReferences
+func (r *userRepository) Purge(ctx context.Context, id int) error {
+	return r.client.User.
+		DeleteOneID(id).
+		Exec(ctx)
+}
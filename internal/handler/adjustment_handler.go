@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"Veritasbackend/internal/usecase/stock"
+	pkg_errors "Veritasbackend/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdjustmentHandler struct {
+	listReasonsUseCase      *stock.ListAdjustmentReasonsUseCase
+	createReasonUseCase     *stock.CreateAdjustmentReasonUseCase
+	updateReasonUseCase     *stock.UpdateAdjustmentReasonUseCase
+	createAdjustmentUseCase *stock.CreateAdjustmentUseCase
+	listAdjustmentsUseCase  *stock.ListAdjustmentsUseCase
+	reviewAdjustmentUseCase *stock.ReviewAdjustmentUseCase
+	updateSettingsUseCase   *stock.UpdateAdjustmentSettingsUseCase
+	shrinkageReportUseCase  *stock.GetShrinkageReportUseCase
+}
+
+func NewAdjustmentHandler(
+	listReasonsUseCase *stock.ListAdjustmentReasonsUseCase,
+	createReasonUseCase *stock.CreateAdjustmentReasonUseCase,
+	updateReasonUseCase *stock.UpdateAdjustmentReasonUseCase,
+	createAdjustmentUseCase *stock.CreateAdjustmentUseCase,
+	listAdjustmentsUseCase *stock.ListAdjustmentsUseCase,
+	reviewAdjustmentUseCase *stock.ReviewAdjustmentUseCase,
+	updateSettingsUseCase *stock.UpdateAdjustmentSettingsUseCase,
+	shrinkageReportUseCase *stock.GetShrinkageReportUseCase,
+) *AdjustmentHandler {
+	return &AdjustmentHandler{
+		listReasonsUseCase:      listReasonsUseCase,
+		createReasonUseCase:     createReasonUseCase,
+		updateReasonUseCase:     updateReasonUseCase,
+		createAdjustmentUseCase: createAdjustmentUseCase,
+		listAdjustmentsUseCase:  listAdjustmentsUseCase,
+		reviewAdjustmentUseCase: reviewAdjustmentUseCase,
+		updateSettingsUseCase:   updateSettingsUseCase,
+		shrinkageReportUseCase:  shrinkageReportUseCase,
+	}
+}
+
+func (h *AdjustmentHandler) ListReasons(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	response, err := h.listReasonsUseCase.Execute(c.Request.Context(), tenantID.(int))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdjustmentHandler) CreateReason(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	var req stock.CreateAdjustmentReasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reason, err := h.createReasonUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		if errors.Is(err, pkg_errors.ErrAlreadyExists) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Reason code already exists"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"reason": reason})
+}
+
+func (h *AdjustmentHandler) UpdateReason(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid reason ID"})
+		return
+	}
+
+	var req stock.UpdateAdjustmentReasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reason, err := h.updateReasonUseCase.Execute(c.Request.Context(), tenantID.(int), id, req)
+	if err != nil {
+		if errors.Is(err, pkg_errors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Reason not found"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"reason": reason})
+}
+
+func (h *AdjustmentHandler) CreateAdjustment(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	var req stock.CreateAdjustmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	adjustment, err := h.createAdjustmentUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), isAdmin(c), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"adjustment": adjustment})
+}
+
+func (h *AdjustmentHandler) ListAdjustments(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	req := stock.ListAdjustmentsRequest{
+		Status: c.Query("status"),
+		Page:   1,
+		Limit:  20,
+	}
+
+	if page := c.Query("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil {
+			req.Page = p
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil {
+			req.Limit = l
+		}
+	}
+
+	response, err := h.listAdjustmentsUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdjustmentHandler) ApproveAdjustment(c *gin.Context) {
+	h.reviewAdjustment(c, true)
+}
+
+func (h *AdjustmentHandler) RejectAdjustment(c *gin.Context) {
+	h.reviewAdjustment(c, false)
+}
+
+func (h *AdjustmentHandler) reviewAdjustment(c *gin.Context, approve bool) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid adjustment ID"})
+		return
+	}
+
+	adjustment, err := h.reviewAdjustmentUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), id, approve)
+	if err != nil {
+		if errors.Is(err, pkg_errors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Adjustment not found"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"adjustment": adjustment})
+}
+
+func (h *AdjustmentHandler) UpdateSettings(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	var req stock.AdjustmentSettingsDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	settings, err := h.updateSettingsUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"settings": settings})
+}
+
+func (h *AdjustmentHandler) GetShrinkageReport(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	req := stock.ShrinkageReportRequest{
+		StartDate: c.Query("startDate"),
+		EndDate:   c.Query("endDate"),
+	}
+
+	response, err := h.shrinkageReportUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// isAdmin indica si el usuario autenticado puede aprobar operaciones que requieren autorización
+func isAdmin(c *gin.Context) bool {
+	role, _ := c.Get("userRole")
+	return role == "admin"
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"Veritasbackend/internal/usecase/audit"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuditHandler struct {
+	listAuditLogUseCase *audit.ListAuditLogUseCase
+}
+
+func NewAuditHandler(listAuditLogUseCase *audit.ListAuditLogUseCase) *AuditHandler {
+	return &AuditHandler{
+		listAuditLogUseCase: listAuditLogUseCase,
+	}
+}
+
+func (h *AuditHandler) ListAuditLog(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	req := audit.ListAuditLogRequest{
+		Page:   1,
+		Limit:  20,
+		Entity: c.Query("entity"),
+		Action: c.Query("action"),
+	}
+
+	if page := c.Query("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil {
+			req.Page = p
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil {
+			req.Limit = l
+		}
+	}
+	if entityID := c.Query("entityId"); entityID != "" {
+		if id, err := strconv.Atoi(entityID); err == nil {
+			req.EntityID = id
+		}
+	}
+
+	response, err := h.listAuditLogUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"Veritasbackend/internal/usecase/stock"
+	pkg_errors "Veritasbackend/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BarcodeHandler struct {
+	lookupBarcodeUseCase            *stock.LookupBarcodeUseCase
+	listProductBarcodesUseCase      *stock.ListProductBarcodesUseCase
+	addProductBarcodeUseCase        *stock.AddProductBarcodeUseCase
+	deleteProductBarcodeUseCase     *stock.DeleteProductBarcodeUseCase
+	generateInternalBarcodesUseCase *stock.GenerateInternalBarcodesUseCase
+	printLabelsUseCase              *stock.PrintLabelsUseCase
+}
+
+func NewBarcodeHandler(
+	lookupBarcodeUseCase *stock.LookupBarcodeUseCase,
+	listProductBarcodesUseCase *stock.ListProductBarcodesUseCase,
+	addProductBarcodeUseCase *stock.AddProductBarcodeUseCase,
+	deleteProductBarcodeUseCase *stock.DeleteProductBarcodeUseCase,
+	generateInternalBarcodesUseCase *stock.GenerateInternalBarcodesUseCase,
+	printLabelsUseCase *stock.PrintLabelsUseCase,
+) *BarcodeHandler {
+	return &BarcodeHandler{
+		lookupBarcodeUseCase:            lookupBarcodeUseCase,
+		listProductBarcodesUseCase:      listProductBarcodesUseCase,
+		addProductBarcodeUseCase:        addProductBarcodeUseCase,
+		deleteProductBarcodeUseCase:     deleteProductBarcodeUseCase,
+		generateInternalBarcodesUseCase: generateInternalBarcodesUseCase,
+		printLabelsUseCase:              printLabelsUseCase,
+	}
+}
+
+func (h *BarcodeHandler) LookupBarcode(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	response, err := h.lookupBarcodeUseCase.Execute(c.Request.Context(), tenantID.(int), c.Param("code"))
+	if err != nil {
+		if errors.Is(err, pkg_errors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "No product with this barcode"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *BarcodeHandler) ListBarcodes(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	barcodes, err := h.listProductBarcodesUseCase.Execute(c.Request.Context(), tenantID.(int), id)
+	if err != nil {
+		respondBarcodeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"barcodes": barcodes})
+}
+
+func (h *BarcodeHandler) AddBarcode(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	var req stock.AddProductBarcodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	barcode, err := h.addProductBarcodeUseCase.Execute(c.Request.Context(), tenantID.(int), id, req)
+	if err != nil {
+		respondBarcodeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"barcode": barcode})
+}
+
+func (h *BarcodeHandler) DeleteBarcode(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+	barcodeID, err := strconv.Atoi(c.Param("barcodeId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid barcode ID"})
+		return
+	}
+
+	if err := h.deleteProductBarcodeUseCase.Execute(c.Request.Context(), tenantID.(int), id, barcodeID); err != nil {
+		respondBarcodeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Barcode deleted"})
+}
+
+func (h *BarcodeHandler) GenerateBarcodes(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	response, err := h.generateInternalBarcodesUseCase.Execute(c.Request.Context(), tenantID.(int))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *BarcodeHandler) PrintLabels(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	var req stock.PrintLabelsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	file, err := h.printLabelsUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		respondBarcodeError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="`+file.FileName+`"`)
+	c.Data(http.StatusOK, file.ContentType, file.Data)
+}
+
+func respondBarcodeError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, pkg_errors.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Product or barcode not found"})
+	case errors.Is(err, pkg_errors.ErrAlreadyExists):
+		c.JSON(http.StatusConflict, gin.H{"error": "Barcode already assigned to a product"})
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
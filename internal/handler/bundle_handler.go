@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"Veritasbackend/internal/usecase/stock"
+	pkg_errors "Veritasbackend/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BundleHandler struct {
+	getBundleUseCase           *stock.GetBundleUseCase
+	setBundleComponentsUseCase *stock.SetBundleComponentsUseCase
+	assembleBundleUseCase      *stock.AssembleBundleUseCase
+	listAssembliesUseCase      *stock.ListAssembliesUseCase
+}
+
+func NewBundleHandler(
+	getBundleUseCase *stock.GetBundleUseCase,
+	setBundleComponentsUseCase *stock.SetBundleComponentsUseCase,
+	assembleBundleUseCase *stock.AssembleBundleUseCase,
+	listAssembliesUseCase *stock.ListAssembliesUseCase,
+) *BundleHandler {
+	return &BundleHandler{
+		getBundleUseCase:           getBundleUseCase,
+		setBundleComponentsUseCase: setBundleComponentsUseCase,
+		assembleBundleUseCase:      assembleBundleUseCase,
+		listAssembliesUseCase:      listAssembliesUseCase,
+	}
+}
+
+func (h *BundleHandler) GetBundle(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	bundle, err := h.getBundleUseCase.Execute(c.Request.Context(), tenantID.(int), id)
+	if err != nil {
+		respondBundleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"bundle": bundle})
+}
+
+func (h *BundleHandler) SetComponents(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	var req stock.SetBundleComponentsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	bundle, err := h.setBundleComponentsUseCase.Execute(c.Request.Context(), tenantID.(int), id, req)
+	if err != nil {
+		respondBundleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"bundle": bundle})
+}
+
+func (h *BundleHandler) Assemble(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	var req stock.AssembleBundleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	assembly, err := h.assembleBundleUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), id, req)
+	if err != nil {
+		respondBundleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"assembly": assembly})
+}
+
+func (h *BundleHandler) ListAssemblies(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	req := stock.ListAssembliesRequest{
+		Page:  1,
+		Limit: 20,
+	}
+
+	if bundleID := c.Query("bundleId"); bundleID != "" {
+		if id, err := strconv.Atoi(bundleID); err == nil {
+			req.BundleID = id
+		}
+	}
+	if page := c.Query("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil {
+			req.Page = p
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil {
+			req.Limit = l
+		}
+	}
+
+	response, err := h.listAssembliesUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func respondBundleError(c *gin.Context, err error) {
+	if errors.Is(err, pkg_errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
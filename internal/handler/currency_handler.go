@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"Veritasbackend/internal/usecase/currency"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+type CurrencyHandler struct {
+	listExchangeRatesUseCase      *currency.ListExchangeRatesUseCase
+	setExchangeRateUseCase        *currency.SetExchangeRateUseCase
+	importExchangeRatesUseCase    *currency.ImportExchangeRatesUseCase
+	deleteExchangeRateUseCase     *currency.DeleteExchangeRateUseCase
+	updateCurrencySettingsUseCase *currency.UpdateCurrencySettingsUseCase
+}
+
+func NewCurrencyHandler(
+	listExchangeRatesUseCase *currency.ListExchangeRatesUseCase,
+	setExchangeRateUseCase *currency.SetExchangeRateUseCase,
+	importExchangeRatesUseCase *currency.ImportExchangeRatesUseCase,
+	deleteExchangeRateUseCase *currency.DeleteExchangeRateUseCase,
+	updateCurrencySettingsUseCase *currency.UpdateCurrencySettingsUseCase,
+) *CurrencyHandler {
+	return &CurrencyHandler{
+		listExchangeRatesUseCase:      listExchangeRatesUseCase,
+		setExchangeRateUseCase:        setExchangeRateUseCase,
+		importExchangeRatesUseCase:    importExchangeRatesUseCase,
+		deleteExchangeRateUseCase:     deleteExchangeRateUseCase,
+		updateCurrencySettingsUseCase: updateCurrencySettingsUseCase,
+	}
+}
+
+func (h *CurrencyHandler) ListExchangeRates(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	req := currency.ListExchangeRatesRequest{
+		Currency:  c.Query("currency"),
+		StartDate: c.Query("startDate"),
+		EndDate:   c.Query("endDate"),
+	}
+
+	result, err := h.listExchangeRatesUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		respondCurrencyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *CurrencyHandler) SetExchangeRate(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	var req currency.ExchangeRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.setExchangeRateUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), req)
+	if err != nil {
+		respondCurrencyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *CurrencyHandler) ImportExchangeRates(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "File is required"})
+		return
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to open file"})
+		return
+	}
+	defer f.Close()
+
+	result, err := h.importExchangeRatesUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), f)
+	if err != nil {
+		respondCurrencyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *CurrencyHandler) DeleteExchangeRate(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid exchange rate ID"})
+		return
+	}
+
+	if err := h.deleteExchangeRateUseCase.Execute(c.Request.Context(), tenantID.(int), id); err != nil {
+		respondCurrencyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Exchange rate deleted"})
+}
+
+func (h *CurrencyHandler) UpdateSettings(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	var req currency.CurrencySettingsDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	settings, err := h.updateCurrencySettingsUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		respondCurrencyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"settings": settings})
+}
+
+func respondCurrencyError(c *gin.Context, err error) {
+	if errors.Is(err, pkg_errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Exchange rate not found"})
+		return
+	}
+	if errors.Is(err, pkg_errors.ErrInvalidState) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
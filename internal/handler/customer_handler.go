@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"Veritasbackend/internal/usecase/customer"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+type CustomerHandler struct {
+	createCustomerUseCase       *customer.CreateCustomerUseCase
+	listCustomersUseCase        *customer.ListCustomersUseCase
+	getCustomerUseCase          *customer.GetCustomerUseCase
+	updateCustomerUseCase       *customer.UpdateCustomerUseCase
+	deleteCustomerUseCase       *customer.DeleteCustomerUseCase
+	restoreCustomerUseCase      *customer.RestoreCustomerUseCase
+	purgeCustomerUseCase        *customer.PurgeCustomerUseCase
+	listCustomerInvoicesUseCase *customer.ListCustomerInvoicesUseCase
+}
+
+func NewCustomerHandler(
+	createCustomerUseCase *customer.CreateCustomerUseCase,
+	listCustomersUseCase *customer.ListCustomersUseCase,
+	getCustomerUseCase *customer.GetCustomerUseCase,
+	updateCustomerUseCase *customer.UpdateCustomerUseCase,
+	deleteCustomerUseCase *customer.DeleteCustomerUseCase,
+	restoreCustomerUseCase *customer.RestoreCustomerUseCase,
+	purgeCustomerUseCase *customer.PurgeCustomerUseCase,
+	listCustomerInvoicesUseCase *customer.ListCustomerInvoicesUseCase,
+) *CustomerHandler {
+	return &CustomerHandler{
+		createCustomerUseCase:       createCustomerUseCase,
+		listCustomersUseCase:        listCustomersUseCase,
+		getCustomerUseCase:          getCustomerUseCase,
+		updateCustomerUseCase:       updateCustomerUseCase,
+		deleteCustomerUseCase:       deleteCustomerUseCase,
+		restoreCustomerUseCase:      restoreCustomerUseCase,
+		purgeCustomerUseCase:        purgeCustomerUseCase,
+		listCustomerInvoicesUseCase: listCustomerInvoicesUseCase,
+	}
+}
+
+func (h *CustomerHandler) CreateCustomer(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	var req customer.CreateCustomerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.createCustomerUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		respondCustomerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+func (h *CustomerHandler) ListCustomers(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	req := customer.ListCustomersRequest{
+		Page:     1,
+		Limit:    20,
+		Search:   c.Query("search"),
+		Archived: c.Query("archived") == "true",
+	}
+
+	if page := c.Query("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil {
+			req.Page = p
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil {
+			req.Limit = l
+		}
+	}
+
+	result, err := h.listCustomersUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *CustomerHandler) GetCustomer(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid customer ID"})
+		return
+	}
+
+	result, err := h.getCustomerUseCase.Execute(c.Request.Context(), tenantID.(int), id)
+	if err != nil {
+		respondCustomerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *CustomerHandler) UpdateCustomer(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid customer ID"})
+		return
+	}
+
+	var req customer.UpdateCustomerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.updateCustomerUseCase.Execute(c.Request.Context(), tenantID.(int), id, req)
+	if err != nil {
+		respondCustomerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DeleteCustomer elimina el cliente de forma lógica; se recupera con RestoreCustomer
+func (h *CustomerHandler) DeleteCustomer(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid customer ID"})
+		return
+	}
+
+	if err := h.deleteCustomerUseCase.Execute(c.Request.Context(), tenantID.(int), id); err != nil {
+		respondCustomerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Customer deleted"})
+}
+
+func (h *CustomerHandler) RestoreCustomer(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid customer ID"})
+		return
+	}
+
+	result, err := h.restoreCustomerUseCase.Execute(c.Request.Context(), tenantID.(int), id)
+	if err != nil {
+		respondCustomerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// PurgeCustomer borra definitivamente un cliente eliminado sin facturas
+func (h *CustomerHandler) PurgeCustomer(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid customer ID"})
+		return
+	}
+
+	if err := h.purgeCustomerUseCase.Execute(c.Request.Context(), tenantID.(int), id); err != nil {
+		respondCustomerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Customer purged"})
+}
+
+func (h *CustomerHandler) ListCustomerInvoices(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid customer ID"})
+		return
+	}
+
+	req := customer.ListCustomerInvoicesRequest{
+		Page:  1,
+		Limit: 20,
+	}
+
+	if page := c.Query("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil {
+			req.Page = p
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil {
+			req.Limit = l
+		}
+	}
+
+	result, err := h.listCustomerInvoicesUseCase.Execute(c.Request.Context(), tenantID.(int), id, req)
+	if err != nil {
+		respondCustomerError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func respondCustomerError(c *gin.Context, err error) {
+	if errors.Is(err, pkg_errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Customer not found"})
+		return
+	}
+	if errors.Is(err, pkg_errors.ErrAlreadyExists) || errors.Is(err, pkg_errors.ErrInUse) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
@@ -8,14 +8,18 @@ import (
 )
 
 type DashboardHandler struct {
-	getMetricsUseCase *dashboard.GetMetricsUseCase
-	getReportsUseCase *dashboard.GetReportsUseCase
+	getMetricsUseCase   *dashboard.GetMetricsUseCase
+	getReportsUseCase   *dashboard.GetReportsUseCase
+	getMarginsUseCase   *dashboard.GetMarginsUseCase
+	getDiscountsUseCase *dashboard.GetDiscountsUseCase
 }
 
-func NewDashboardHandler(getMetricsUseCase *dashboard.GetMetricsUseCase, getReportsUseCase *dashboard.GetReportsUseCase) *DashboardHandler {
+func NewDashboardHandler(getMetricsUseCase *dashboard.GetMetricsUseCase, getReportsUseCase *dashboard.GetReportsUseCase, getMarginsUseCase *dashboard.GetMarginsUseCase, getDiscountsUseCase *dashboard.GetDiscountsUseCase) *DashboardHandler {
 	return &DashboardHandler{
-		getMetricsUseCase: getMetricsUseCase,
-		getReportsUseCase: getReportsUseCase,
+		getMetricsUseCase:   getMetricsUseCase,
+		getReportsUseCase:   getReportsUseCase,
+		getMarginsUseCase:   getMarginsUseCase,
+		getDiscountsUseCase: getDiscountsUseCase,
 	}
 }
 
@@ -49,3 +53,37 @@ func (h *DashboardHandler) GetReports(c *gin.Context) {
 	c.JSON(http.StatusOK, reports)
 }
 
+func (h *DashboardHandler) GetMargins(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	req := dashboard.MarginRequest{
+		StartDate:    c.Query("startDate"),
+		EndDate:      c.Query("endDate"),
+		SplitBundles: c.Query("splitBundles") == "true",
+	}
+
+	margins, err := h.getMarginsUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, margins)
+}
+
+func (h *DashboardHandler) GetDiscounts(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	req := dashboard.DiscountRequest{
+		StartDate: c.Query("startDate"),
+		EndDate:   c.Query("endDate"),
+	}
+
+	discounts, err := h.getDiscountsUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, discounts)
+}
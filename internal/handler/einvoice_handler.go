@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"Veritasbackend/internal/usecase/einvoice"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+type EInvoiceHandler struct {
+	getSettingsUseCase       *einvoice.GetSettingsUseCase
+	updateSettingsUseCase    *einvoice.UpdateSettingsUseCase
+	uploadCertificateUseCase *einvoice.UploadCertificateUseCase
+	issueInvoiceUseCase      *einvoice.IssueInvoiceUseCase
+	issueCreditNoteUseCase   *einvoice.IssueCreditNoteUseCase
+	listDocumentsUseCase     *einvoice.ListDocumentsUseCase
+	getDocumentUseCase       *einvoice.GetDocumentUseCase
+	refreshStatusUseCase     *einvoice.RefreshStatusUseCase
+}
+
+func NewEInvoiceHandler(
+	getSettingsUseCase *einvoice.GetSettingsUseCase,
+	updateSettingsUseCase *einvoice.UpdateSettingsUseCase,
+	uploadCertificateUseCase *einvoice.UploadCertificateUseCase,
+	issueInvoiceUseCase *einvoice.IssueInvoiceUseCase,
+	issueCreditNoteUseCase *einvoice.IssueCreditNoteUseCase,
+	listDocumentsUseCase *einvoice.ListDocumentsUseCase,
+	getDocumentUseCase *einvoice.GetDocumentUseCase,
+	refreshStatusUseCase *einvoice.RefreshStatusUseCase,
+) *EInvoiceHandler {
+	return &EInvoiceHandler{
+		getSettingsUseCase:       getSettingsUseCase,
+		updateSettingsUseCase:    updateSettingsUseCase,
+		uploadCertificateUseCase: uploadCertificateUseCase,
+		issueInvoiceUseCase:      issueInvoiceUseCase,
+		issueCreditNoteUseCase:   issueCreditNoteUseCase,
+		listDocumentsUseCase:     listDocumentsUseCase,
+		getDocumentUseCase:       getDocumentUseCase,
+		refreshStatusUseCase:     refreshStatusUseCase,
+	}
+}
+
+func (h *EInvoiceHandler) GetSettings(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	settings, err := h.getSettingsUseCase.Execute(c.Request.Context(), tenantID.(int))
+	if err != nil {
+		respondEInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"settings": settings})
+}
+
+func (h *EInvoiceHandler) UpdateSettings(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	var req einvoice.SettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	settings, err := h.updateSettingsUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		respondEInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"settings": settings})
+}
+
+func (h *EInvoiceHandler) UploadCertificate(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "File is required"})
+		return
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to open file"})
+		return
+	}
+	defer f.Close()
+
+	// Se lee un byte más del máximo para detectar archivos demasiado grandes
+	data, err := io.ReadAll(io.LimitReader(f, einvoice.MaxCertificateSize+1))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read file"})
+		return
+	}
+
+	certificate, err := h.uploadCertificateUseCase.Execute(c.Request.Context(), tenantID.(int), data, c.PostForm("password"))
+	if err != nil {
+		respondEInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"certificate": certificate})
+}
+
+func (h *EInvoiceHandler) IssueInvoice(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	document, err := h.issueInvoiceUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), id)
+	if err != nil {
+		respondEInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"document": document})
+}
+
+func (h *EInvoiceHandler) IssueCreditNote(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	var req einvoice.CreditNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	document, err := h.issueCreditNoteUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), id, req)
+	if err != nil {
+		respondEInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"document": document})
+}
+
+func (h *EInvoiceHandler) ListDocuments(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	req := einvoice.ListDocumentsRequest{
+		Status:       c.Query("status"),
+		DocumentType: c.Query("documentType"),
+		Page:         1,
+		Limit:        20,
+	}
+
+	if invoiceID := c.Query("invoiceId"); invoiceID != "" {
+		id, err := strconv.Atoi(invoiceID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+			return
+		}
+		req.InvoiceID = &id
+	}
+	if page := c.Query("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil {
+			req.Page = p
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil {
+			req.Limit = l
+		}
+	}
+
+	response, err := h.listDocumentsUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *EInvoiceHandler) GetDocument(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid document ID"})
+		return
+	}
+
+	document, err := h.getDocumentUseCase.Execute(c.Request.Context(), tenantID.(int), id)
+	if err != nil {
+		respondEInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"document": document})
+}
+
+func (h *EInvoiceHandler) RefreshStatus(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid document ID"})
+		return
+	}
+
+	document, err := h.refreshStatusUseCase.Execute(c.Request.Context(), tenantID.(int), id)
+	if err != nil {
+		respondEInvoiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"document": document})
+}
+
+func respondEInvoiceError(c *gin.Context, err error) {
+	if errors.Is(err, pkg_errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+		return
+	}
+	if errors.Is(err, pkg_errors.ErrInvalidState) || errors.Is(err, pkg_errors.ErrAlreadyExists) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
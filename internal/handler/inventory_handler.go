@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"Veritasbackend/internal/usecase/inventory"
+	pkg_errors "Veritasbackend/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InventoryHandler struct {
+	openCountUseCase     *inventory.OpenCountUseCase
+	listCountsUseCase    *inventory.ListCountsUseCase
+	getCountUseCase      *inventory.GetCountUseCase
+	recordEntriesUseCase *inventory.RecordEntriesUseCase
+	postCountUseCase     *inventory.PostCountUseCase
+	cancelCountUseCase   *inventory.CancelCountUseCase
+}
+
+func NewInventoryHandler(
+	openCountUseCase *inventory.OpenCountUseCase,
+	listCountsUseCase *inventory.ListCountsUseCase,
+	getCountUseCase *inventory.GetCountUseCase,
+	recordEntriesUseCase *inventory.RecordEntriesUseCase,
+	postCountUseCase *inventory.PostCountUseCase,
+	cancelCountUseCase *inventory.CancelCountUseCase,
+) *InventoryHandler {
+	return &InventoryHandler{
+		openCountUseCase:     openCountUseCase,
+		listCountsUseCase:    listCountsUseCase,
+		getCountUseCase:      getCountUseCase,
+		recordEntriesUseCase: recordEntriesUseCase,
+		postCountUseCase:     postCountUseCase,
+		cancelCountUseCase:   cancelCountUseCase,
+	}
+}
+
+func (h *InventoryHandler) OpenCount(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	var req inventory.OpenCountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	count, err := h.openCountUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"count": count})
+}
+
+func (h *InventoryHandler) ListCounts(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	req := inventory.ListCountsRequest{
+		Page:  1,
+		Limit: 20,
+	}
+
+	if page := c.Query("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil {
+			req.Page = p
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil {
+			req.Limit = l
+		}
+	}
+
+	response, err := h.listCountsUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *InventoryHandler) GetCount(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid count ID"})
+		return
+	}
+
+	count, err := h.getCountUseCase.Execute(c.Request.Context(), tenantID.(int), id)
+	if err != nil {
+		respondCountError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"count": count})
+}
+
+func (h *InventoryHandler) RecordEntries(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid count ID"})
+		return
+	}
+
+	var req inventory.RecordEntriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	count, err := h.recordEntriesUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), id, req)
+	if err != nil {
+		respondCountError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"count": count})
+}
+
+func (h *InventoryHandler) PostCount(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid count ID"})
+		return
+	}
+
+	count, err := h.postCountUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), id)
+	if err != nil {
+		respondCountError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"count": count})
+}
+
+func (h *InventoryHandler) CancelCount(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid count ID"})
+		return
+	}
+
+	if err := h.cancelCountUseCase.Execute(c.Request.Context(), tenantID.(int), id); err != nil {
+		respondCountError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Count cancelled"})
+}
+
+func respondCountError(c *gin.Context, err error) {
+	if errors.Is(err, pkg_errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Count not found"})
+		return
+	}
+	if errors.Is(err, pkg_errors.ErrInvalidState) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
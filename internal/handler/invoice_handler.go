@@ -1,18 +1,27 @@
 package handler
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"strconv"
 
 	"Veritasbackend/internal/usecase/invoice"
+	pkg_errors "Veritasbackend/pkg/errors"
 	"github.com/gin-gonic/gin"
 )
 
 type InvoiceHandler struct {
-	createInvoiceUseCase  *invoice.CreateInvoiceUseCase
-	listInvoicesUseCase   *invoice.ListInvoicesUseCase
-	getInvoiceUseCase     *invoice.GetInvoiceUseCase
-	searchProductsUseCase *invoice.SearchProductsUseCase
+	createInvoiceUseCase          *invoice.CreateInvoiceUseCase
+	listInvoicesUseCase           *invoice.ListInvoicesUseCase
+	getInvoiceUseCase             *invoice.GetInvoiceUseCase
+	searchProductsUseCase         *invoice.SearchProductsUseCase
+	payInvoiceUseCase             *invoice.PayInvoiceUseCase
+	cancelInvoiceUseCase          *invoice.CancelInvoiceUseCase
+	voidInvoiceUseCase            *invoice.VoidInvoiceUseCase
+	updateDiscountSettingsUseCase *invoice.UpdateDiscountSettingsUseCase
+	previewInvoiceUseCase         *invoice.PreviewInvoiceUseCase
+	printInvoiceUseCase           *invoice.PrintInvoiceUseCase
 }
 
 func NewInvoiceHandler(
@@ -20,12 +29,24 @@ func NewInvoiceHandler(
 	listInvoicesUseCase *invoice.ListInvoicesUseCase,
 	getInvoiceUseCase *invoice.GetInvoiceUseCase,
 	searchProductsUseCase *invoice.SearchProductsUseCase,
+	payInvoiceUseCase *invoice.PayInvoiceUseCase,
+	cancelInvoiceUseCase *invoice.CancelInvoiceUseCase,
+	voidInvoiceUseCase *invoice.VoidInvoiceUseCase,
+	updateDiscountSettingsUseCase *invoice.UpdateDiscountSettingsUseCase,
+	previewInvoiceUseCase *invoice.PreviewInvoiceUseCase,
+	printInvoiceUseCase *invoice.PrintInvoiceUseCase,
 ) *InvoiceHandler {
 	return &InvoiceHandler{
-		createInvoiceUseCase:  createInvoiceUseCase,
-		listInvoicesUseCase:   listInvoicesUseCase,
-		getInvoiceUseCase:     getInvoiceUseCase,
-		searchProductsUseCase: searchProductsUseCase,
+		createInvoiceUseCase:          createInvoiceUseCase,
+		listInvoicesUseCase:           listInvoicesUseCase,
+		getInvoiceUseCase:             getInvoiceUseCase,
+		searchProductsUseCase:         searchProductsUseCase,
+		payInvoiceUseCase:             payInvoiceUseCase,
+		cancelInvoiceUseCase:          cancelInvoiceUseCase,
+		voidInvoiceUseCase:            voidInvoiceUseCase,
+		updateDiscountSettingsUseCase: updateDiscountSettingsUseCase,
+		previewInvoiceUseCase:         previewInvoiceUseCase,
+		printInvoiceUseCase:           printInvoiceUseCase,
 	}
 }
 
@@ -41,6 +62,17 @@ func (h *InvoiceHandler) CreateInvoice(c *gin.Context) {
 
 	invoice, err := h.createInvoiceUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), req)
 	if err != nil {
+		// Un descuento por encima del límite sin autorización válida responde 403 para que la
+		// caja pida las credenciales de un supervisor
+		if errors.Is(err, pkg_errors.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		// Sin una numeración vigente con números disponibles la factura no se puede crear
+		if errors.Is(err, pkg_errors.ErrInvalidState) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
@@ -48,6 +80,26 @@ func (h *InvoiceHandler) CreateInvoice(c *gin.Context) {
 	c.JSON(http.StatusCreated, gin.H{"invoice": invoice})
 }
 
+// PreviewInvoice calcula precios, promociones, descuentos e impuestos sin crear la factura
+func (h *InvoiceHandler) PreviewInvoice(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	var req invoice.CreateInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	preview, err := h.previewInvoiceUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"preview": preview})
+}
+
 func (h *InvoiceHandler) ListInvoices(c *gin.Context) {
 	tenantID, _ := c.Get("tenantID")
 
@@ -93,6 +145,26 @@ func (h *InvoiceHandler) GetInvoice(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"invoice": invoice})
 }
 
+// PrintInvoice devuelve la factura en PDF; ?layout=receipt la imprime en rollo de 80 mm
+func (h *InvoiceHandler) PrintInvoice(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	file, err := h.printInvoiceUseCase.Execute(c.Request.Context(), tenantID.(int), id, c.Query("layout"))
+	if err != nil {
+		respondInvoiceError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="`+file.FileName+`"`)
+	c.Data(http.StatusOK, file.ContentType, file.Data)
+}
+
 func (h *InvoiceHandler) SearchProducts(c *gin.Context) {
 	tenantID, _ := c.Get("tenantID")
 	query := c.Query("q")
@@ -111,3 +183,77 @@ func (h *InvoiceHandler) SearchProducts(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+func (h *InvoiceHandler) PayInvoice(c *gin.Context) {
+	h.changeStatus(c, h.payInvoiceUseCase.Execute)
+}
+
+func (h *InvoiceHandler) CancelInvoice(c *gin.Context) {
+	h.changeStatus(c, h.cancelInvoiceUseCase.Execute)
+}
+
+func (h *InvoiceHandler) VoidInvoice(c *gin.Context) {
+	h.changeStatus(c, h.voidInvoiceUseCase.Execute)
+}
+
+// changeStatus aplica una transición de estado y responde con la factura actualizada
+func (h *InvoiceHandler) changeStatus(c *gin.Context, execute func(ctx context.Context, tenantID, userID, invoiceID int, req invoice.InvoiceStatusRequest) error) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid invoice ID"})
+		return
+	}
+
+	var req invoice.InvoiceStatusRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if err := execute(c.Request.Context(), tenantID.(int), userID.(int), id, req); err != nil {
+		respondInvoiceError(c, err)
+		return
+	}
+
+	updated, err := h.getInvoiceUseCase.Execute(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"invoice": updated})
+}
+
+func (h *InvoiceHandler) UpdateDiscountSettings(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	var req invoice.DiscountSettingsDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	settings, err := h.updateDiscountSettingsUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"settings": settings})
+}
+
+func respondInvoiceError(c *gin.Context, err error) {
+	if errors.Is(err, pkg_errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Invoice not found"})
+		return
+	}
+	if errors.Is(err, pkg_errors.ErrInvalidState) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"Veritasbackend/internal/usecase/numbering"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+type NumberingHandler struct {
+	listSeriesUseCase   *numbering.ListSeriesUseCase
+	createSeriesUseCase *numbering.CreateSeriesUseCase
+	updateSeriesUseCase *numbering.UpdateSeriesUseCase
+	deleteSeriesUseCase *numbering.DeleteSeriesUseCase
+}
+
+func NewNumberingHandler(
+	listSeriesUseCase *numbering.ListSeriesUseCase,
+	createSeriesUseCase *numbering.CreateSeriesUseCase,
+	updateSeriesUseCase *numbering.UpdateSeriesUseCase,
+	deleteSeriesUseCase *numbering.DeleteSeriesUseCase,
+) *NumberingHandler {
+	return &NumberingHandler{
+		listSeriesUseCase:   listSeriesUseCase,
+		createSeriesUseCase: createSeriesUseCase,
+		updateSeriesUseCase: updateSeriesUseCase,
+		deleteSeriesUseCase: deleteSeriesUseCase,
+	}
+}
+
+func (h *NumberingHandler) ListSeries(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	series, err := h.listSeriesUseCase.Execute(c.Request.Context(), tenantID.(int), c.Query("documentType"))
+	if err != nil {
+		respondNumberingError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"series": series})
+}
+
+func (h *NumberingHandler) CreateSeries(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	var req numbering.SeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	series, err := h.createSeriesUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		respondNumberingError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"series": series})
+}
+
+func (h *NumberingHandler) UpdateSeries(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid series ID"})
+		return
+	}
+
+	var req numbering.SeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	series, err := h.updateSeriesUseCase.Execute(c.Request.Context(), tenantID.(int), id, req)
+	if err != nil {
+		respondNumberingError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"series": series})
+}
+
+func (h *NumberingHandler) DeleteSeries(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid series ID"})
+		return
+	}
+
+	if err := h.deleteSeriesUseCase.Execute(c.Request.Context(), tenantID.(int), id); err != nil {
+		respondNumberingError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Numbering series deleted"})
+}
+
+func respondNumberingError(c *gin.Context, err error) {
+	if errors.Is(err, pkg_errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Numbering series not found"})
+		return
+	}
+	if errors.Is(err, pkg_errors.ErrInvalidState) || errors.Is(err, pkg_errors.ErrAlreadyExists) || errors.Is(err, pkg_errors.ErrInUse) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
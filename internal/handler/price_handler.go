@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"Veritasbackend/internal/usecase/stock"
+	pkg_errors "Veritasbackend/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PriceHandler struct {
+	changeProductPriceUseCase   *stock.ChangeProductPriceUseCase
+	listProductPricesUseCase    *stock.ListProductPricesUseCase
+	cancelScheduledPriceUseCase *stock.CancelScheduledPriceUseCase
+	getPricesAsOfUseCase        *stock.GetPricesAsOfUseCase
+}
+
+func NewPriceHandler(
+	changeProductPriceUseCase *stock.ChangeProductPriceUseCase,
+	listProductPricesUseCase *stock.ListProductPricesUseCase,
+	cancelScheduledPriceUseCase *stock.CancelScheduledPriceUseCase,
+	getPricesAsOfUseCase *stock.GetPricesAsOfUseCase,
+) *PriceHandler {
+	return &PriceHandler{
+		changeProductPriceUseCase:   changeProductPriceUseCase,
+		listProductPricesUseCase:    listProductPricesUseCase,
+		cancelScheduledPriceUseCase: cancelScheduledPriceUseCase,
+		getPricesAsOfUseCase:        getPricesAsOfUseCase,
+	}
+}
+
+func (h *PriceHandler) ChangeProductPrice(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	var req stock.ChangeProductPriceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	price, err := h.changeProductPriceUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), id, req)
+	if err != nil {
+		if errors.Is(err, pkg_errors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"price": price})
+}
+
+func (h *PriceHandler) ListProductPrices(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	response, err := h.listProductPricesUseCase.Execute(c.Request.Context(), tenantID.(int), id)
+	if err != nil {
+		if errors.Is(err, pkg_errors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *PriceHandler) CancelScheduledPrice(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("priceId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid price ID"})
+		return
+	}
+
+	if err := h.cancelScheduledPriceUseCase.Execute(c.Request.Context(), tenantID.(int), id); err != nil {
+		if errors.Is(err, pkg_errors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Price change not found"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Price change cancelled"})
+}
+
+func (h *PriceHandler) GetPricesAsOf(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	response, err := h.getPricesAsOfUseCase.Execute(c.Request.Context(), tenantID.(int), c.Query("date"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"Veritasbackend/internal/usecase/pricelist"
+	pkg_errors "Veritasbackend/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PriceListHandler struct {
+	listPriceListsUseCase      *pricelist.ListPriceListsUseCase
+	createPriceListUseCase     *pricelist.CreatePriceListUseCase
+	updatePriceListUseCase     *pricelist.UpdatePriceListUseCase
+	getPriceListUseCase        *pricelist.GetPriceListUseCase
+	setPriceListItemUseCase    *pricelist.SetPriceListItemUseCase
+	deletePriceListItemUseCase *pricelist.DeletePriceListItemUseCase
+}
+
+func NewPriceListHandler(
+	listPriceListsUseCase *pricelist.ListPriceListsUseCase,
+	createPriceListUseCase *pricelist.CreatePriceListUseCase,
+	updatePriceListUseCase *pricelist.UpdatePriceListUseCase,
+	getPriceListUseCase *pricelist.GetPriceListUseCase,
+	setPriceListItemUseCase *pricelist.SetPriceListItemUseCase,
+	deletePriceListItemUseCase *pricelist.DeletePriceListItemUseCase,
+) *PriceListHandler {
+	return &PriceListHandler{
+		listPriceListsUseCase:      listPriceListsUseCase,
+		createPriceListUseCase:     createPriceListUseCase,
+		updatePriceListUseCase:     updatePriceListUseCase,
+		getPriceListUseCase:        getPriceListUseCase,
+		setPriceListItemUseCase:    setPriceListItemUseCase,
+		deletePriceListItemUseCase: deletePriceListItemUseCase,
+	}
+}
+
+func (h *PriceListHandler) ListPriceLists(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	response, err := h.listPriceListsUseCase.Execute(c.Request.Context(), tenantID.(int))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *PriceListHandler) CreatePriceList(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	var req pricelist.CreatePriceListRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	list, err := h.createPriceListUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		if errors.Is(err, pkg_errors.ErrAlreadyExists) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Price list name already exists"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"priceList": list})
+}
+
+func (h *PriceListHandler) UpdatePriceList(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid price list ID"})
+		return
+	}
+
+	var req pricelist.UpdatePriceListRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	list, err := h.updatePriceListUseCase.Execute(c.Request.Context(), tenantID.(int), id, req)
+	if err != nil {
+		respondPriceListError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"priceList": list})
+}
+
+func (h *PriceListHandler) GetPriceList(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid price list ID"})
+		return
+	}
+
+	list, err := h.getPriceListUseCase.Execute(c.Request.Context(), tenantID.(int), id)
+	if err != nil {
+		respondPriceListError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"priceList": list})
+}
+
+func (h *PriceListHandler) SetItem(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid price list ID"})
+		return
+	}
+
+	var req pricelist.SetPriceListItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	item, err := h.setPriceListItemUseCase.Execute(c.Request.Context(), tenantID.(int), id, req)
+	if err != nil {
+		respondPriceListError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"item": item})
+}
+
+func (h *PriceListHandler) DeleteItem(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid price list ID"})
+		return
+	}
+	itemID, err := strconv.Atoi(c.Param("itemId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid item ID"})
+		return
+	}
+
+	if err := h.deletePriceListItemUseCase.Execute(c.Request.Context(), tenantID.(int), id, itemID); err != nil {
+		respondPriceListError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Price list item deleted"})
+}
+
+func respondPriceListError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, pkg_errors.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Price list not found"})
+	case errors.Is(err, pkg_errors.ErrAlreadyExists):
+		c.JSON(http.StatusConflict, gin.H{"error": "Price list name already exists"})
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"net/http"
+
+	"Veritasbackend/internal/usecase/stock"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProductBulkHandler struct {
+	previewBulkProductsUseCase *stock.PreviewBulkProductsUseCase
+	applyBulkProductsUseCase   *stock.ApplyBulkProductsUseCase
+}
+
+func NewProductBulkHandler(
+	previewBulkProductsUseCase *stock.PreviewBulkProductsUseCase,
+	applyBulkProductsUseCase *stock.ApplyBulkProductsUseCase,
+) *ProductBulkHandler {
+	return &ProductBulkHandler{
+		previewBulkProductsUseCase: previewBulkProductsUseCase,
+		applyBulkProductsUseCase:   applyBulkProductsUseCase,
+	}
+}
+
+// Preview muestra el resultado de la operación masiva sin aplicarla
+func (h *ProductBulkHandler) Preview(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	var req stock.BulkProductsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.previewBulkProductsUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ProductBulkHandler) Apply(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	var req stock.BulkProductsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.applyBulkProductsUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"Veritasbackend/internal/infrastructure/storage"
+	"Veritasbackend/internal/usecase/stock"
+	pkg_errors "Veritasbackend/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProductFileHandler struct {
+	uploadProductFileUseCase    *stock.UploadProductFileUseCase
+	listProductFilesUseCase     *stock.ListProductFilesUseCase
+	reorderProductImagesUseCase *stock.ReorderProductImagesUseCase
+	deleteProductFileUseCase    *stock.DeleteProductFileUseCase
+	localStorage                *storage.LocalStorage
+}
+
+func NewProductFileHandler(
+	uploadProductFileUseCase *stock.UploadProductFileUseCase,
+	listProductFilesUseCase *stock.ListProductFilesUseCase,
+	reorderProductImagesUseCase *stock.ReorderProductImagesUseCase,
+	deleteProductFileUseCase *stock.DeleteProductFileUseCase,
+	localStorage *storage.LocalStorage,
+) *ProductFileHandler {
+	return &ProductFileHandler{
+		uploadProductFileUseCase:    uploadProductFileUseCase,
+		listProductFilesUseCase:     listProductFilesUseCase,
+		reorderProductImagesUseCase: reorderProductImagesUseCase,
+		deleteProductFileUseCase:    deleteProductFileUseCase,
+		localStorage:                localStorage,
+	}
+}
+
+func (h *ProductFileHandler) UploadFile(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "File is required"})
+		return
+	}
+	if file.Size > stock.MaxProductFileSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "File is too large"})
+		return
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to open file"})
+		return
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, stock.MaxProductFileSize+1))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read file"})
+		return
+	}
+
+	productFile, err := h.uploadProductFileUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), id, stock.UploadProductFileRequest{
+		Kind:     c.PostForm("kind"),
+		FileName: file.Filename,
+		Data:     data,
+	})
+	if err != nil {
+		respondProductFileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"file": productFile})
+}
+
+func (h *ProductFileHandler) ListFiles(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	response, err := h.listProductFilesUseCase.Execute(c.Request.Context(), tenantID.(int), id, c.Query("kind"))
+	if err != nil {
+		respondProductFileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ProductFileHandler) ReorderImages(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	var req stock.ReorderProductImagesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.reorderProductImagesUseCase.Execute(c.Request.Context(), tenantID.(int), id, req); err != nil {
+		respondProductFileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Images reordered"})
+}
+
+func (h *ProductFileHandler) DeleteFile(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+	fileID, err := strconv.Atoi(c.Param("fileId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid file ID"})
+		return
+	}
+
+	if err := h.deleteProductFileUseCase.Execute(c.Request.Context(), tenantID.(int), id, fileID); err != nil {
+		respondProductFileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "File deleted"})
+}
+
+// ServeFile entrega un archivo del almacenamiento local a partir de una URL firmada. Es una ruta
+// pública: el acceso lo controla la firma, que vence.
+func (h *ProductFileHandler) ServeFile(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+
+	f, err := h.localStorage.Open(key, c.Query("expires"), c.Query("signature"))
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrInvalidSignature):
+			c.JSON(http.StatusForbidden, gin.H{"error": "Invalid or expired link"})
+		case errors.Is(err, os.ErrNotExist):
+			c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to open file"})
+		}
+		return
+	}
+	defer f.Close()
+
+	c.Header("Cache-Control", "private, max-age=300")
+	http.ServeContent(c.Writer, c.Request, filepath.Base(key), time.Time{}, f)
+}
+
+func respondProductFileError(c *gin.Context, err error) {
+	if errors.Is(err, pkg_errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Product or file not found"})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"Veritasbackend/internal/usecase/stock"
+	pkg_errors "Veritasbackend/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProductUnitHandler struct {
+	listProductUnitsUseCase  *stock.ListProductUnitsUseCase
+	createProductUnitUseCase *stock.CreateProductUnitUseCase
+	updateProductUnitUseCase *stock.UpdateProductUnitUseCase
+	deleteProductUnitUseCase *stock.DeleteProductUnitUseCase
+}
+
+func NewProductUnitHandler(
+	listProductUnitsUseCase *stock.ListProductUnitsUseCase,
+	createProductUnitUseCase *stock.CreateProductUnitUseCase,
+	updateProductUnitUseCase *stock.UpdateProductUnitUseCase,
+	deleteProductUnitUseCase *stock.DeleteProductUnitUseCase,
+) *ProductUnitHandler {
+	return &ProductUnitHandler{
+		listProductUnitsUseCase:  listProductUnitsUseCase,
+		createProductUnitUseCase: createProductUnitUseCase,
+		updateProductUnitUseCase: updateProductUnitUseCase,
+		deleteProductUnitUseCase: deleteProductUnitUseCase,
+	}
+}
+
+func (h *ProductUnitHandler) ListUnits(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	units, err := h.listProductUnitsUseCase.Execute(c.Request.Context(), tenantID.(int), id)
+	if err != nil {
+		respondUnitError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"units": units})
+}
+
+func (h *ProductUnitHandler) CreateUnit(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	var req stock.UnitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	unit, err := h.createProductUnitUseCase.Execute(c.Request.Context(), tenantID.(int), id, req)
+	if err != nil {
+		respondUnitError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"unit": unit})
+}
+
+func (h *ProductUnitHandler) UpdateUnit(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+	unitID, err := strconv.Atoi(c.Param("unitId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid unit ID"})
+		return
+	}
+
+	var req stock.UnitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	unit, err := h.updateProductUnitUseCase.Execute(c.Request.Context(), tenantID.(int), id, unitID, req)
+	if err != nil {
+		respondUnitError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"unit": unit})
+}
+
+func (h *ProductUnitHandler) DeleteUnit(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+	unitID, err := strconv.Atoi(c.Param("unitId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid unit ID"})
+		return
+	}
+
+	if err := h.deleteProductUnitUseCase.Execute(c.Request.Context(), tenantID.(int), id, unitID); err != nil {
+		respondUnitError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Unit deleted"})
+}
+
+func respondUnitError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, pkg_errors.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Product or unit not found"})
+	case errors.Is(err, pkg_errors.ErrAlreadyExists):
+		c.JSON(http.StatusConflict, gin.H{"error": "The product already has a unit with this name"})
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"Veritasbackend/internal/usecase/promotion"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+type PromotionHandler struct {
+	listPromotionsUseCase  *promotion.ListPromotionsUseCase
+	createPromotionUseCase *promotion.CreatePromotionUseCase
+	updatePromotionUseCase *promotion.UpdatePromotionUseCase
+	deletePromotionUseCase *promotion.DeletePromotionUseCase
+}
+
+func NewPromotionHandler(
+	listPromotionsUseCase *promotion.ListPromotionsUseCase,
+	createPromotionUseCase *promotion.CreatePromotionUseCase,
+	updatePromotionUseCase *promotion.UpdatePromotionUseCase,
+	deletePromotionUseCase *promotion.DeletePromotionUseCase,
+) *PromotionHandler {
+	return &PromotionHandler{
+		listPromotionsUseCase:  listPromotionsUseCase,
+		createPromotionUseCase: createPromotionUseCase,
+		updatePromotionUseCase: updatePromotionUseCase,
+		deletePromotionUseCase: deletePromotionUseCase,
+	}
+}
+
+func (h *PromotionHandler) ListPromotions(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	promotions, err := h.listPromotionsUseCase.Execute(c.Request.Context(), tenantID.(int))
+	if err != nil {
+		respondPromotionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"promotions": promotions})
+}
+
+func (h *PromotionHandler) CreatePromotion(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	var req promotion.PromotionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.createPromotionUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		respondPromotionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+func (h *PromotionHandler) UpdatePromotion(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid promotion ID"})
+		return
+	}
+
+	var req promotion.PromotionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.updatePromotionUseCase.Execute(c.Request.Context(), tenantID.(int), id, req)
+	if err != nil {
+		respondPromotionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *PromotionHandler) DeletePromotion(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid promotion ID"})
+		return
+	}
+
+	if err := h.deletePromotionUseCase.Execute(c.Request.Context(), tenantID.(int), id); err != nil {
+		respondPromotionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Promotion deleted"})
+}
+
+func respondPromotionError(c *gin.Context, err error) {
+	if errors.Is(err, pkg_errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Promotion not found"})
+		return
+	}
+	if errors.Is(err, pkg_errors.ErrAlreadyExists) || errors.Is(err, pkg_errors.ErrInUse) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
@@ -1,40 +1,69 @@
 package handler
 
 import (
+	"errors"
 	"log"
 	"net/http"
+	"strconv"
 	"time"
 
 	"Veritasbackend/internal/usecase/purchase"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
 
 	"github.com/gin-gonic/gin"
 )
 
 type PurchaseHandler struct {
-	createPurchaseUseCase *purchase.CreatePurchaseUseCase
+	createPurchaseUseCase        *purchase.CreatePurchaseUseCase
+	getReorderSuggestionsUseCase *purchase.GetReorderSuggestionsUseCase
+	createDraftPurchaseUseCase   *purchase.CreateDraftPurchaseUseCase
+	registerPaymentUseCase       *purchase.RegisterPaymentUseCase
+	printPurchaseUseCase         *purchase.PrintPurchaseUseCase
 }
 
-func NewPurchaseHandler(createPurchaseUseCase *purchase.CreatePurchaseUseCase) *PurchaseHandler {
+func NewPurchaseHandler(
+	createPurchaseUseCase *purchase.CreatePurchaseUseCase,
+	getReorderSuggestionsUseCase *purchase.GetReorderSuggestionsUseCase,
+	createDraftPurchaseUseCase *purchase.CreateDraftPurchaseUseCase,
+	registerPaymentUseCase *purchase.RegisterPaymentUseCase,
+	printPurchaseUseCase *purchase.PrintPurchaseUseCase,
+) *PurchaseHandler {
 	return &PurchaseHandler{
-		createPurchaseUseCase: createPurchaseUseCase,
+		createPurchaseUseCase:        createPurchaseUseCase,
+		getReorderSuggestionsUseCase: getReorderSuggestionsUseCase,
+		createDraftPurchaseUseCase:   createDraftPurchaseUseCase,
+		registerPaymentUseCase:       registerPaymentUseCase,
+		printPurchaseUseCase:         printPurchaseUseCase,
 	}
 }
 
 type CreatePurchaseRequest struct {
-	SupplierID    int                             `json:"supplierId" binding:"required"`
-	InvoiceNumber string                          `json:"invoiceNumber" binding:"required"`
-	PaymentMethod *string                         `json:"paymentMethod,omitempty"`
-	DueDate       *string                         `json:"dueDate,omitempty"`
-	Items         []CreatePurchaseItemRequest     `json:"items" binding:"required,min=1"`
+	SupplierID    int                         `json:"supplierId" binding:"required"`
+	InvoiceNumber string                      `json:"invoiceNumber" binding:"required"`
+	PaymentMethod *string                     `json:"paymentMethod,omitempty"`
+	DueDate       *string                     `json:"dueDate,omitempty"`
+	Items         []CreatePurchaseItemRequest `json:"items" binding:"required,min=1"`
+	Currency      string                      `json:"currency,omitempty"`
+	ExchangeRate  *float64                    `json:"exchangeRate,omitempty"`
 }
 
 type CreatePurchaseItemRequest struct {
-	ProductID    int     `json:"productId" binding:"required"`
-	Quantity     int     `json:"quantity" binding:"required,min=1"`
-	UnitCost     float64 `json:"unitCost" binding:"required,min=0"`
-	ProductName  string  `json:"productName"`
-	ProductSku   string  `json:"productSku"`
-	ProductPrice float64 `json:"productPrice"`
+	ProductID    int                        `json:"productId" binding:"required"`
+	Quantity     float64                    `json:"quantity" binding:"required,gt=0"`
+	UnitID       *int                       `json:"unitId,omitempty"`
+	UnitCost     money.Amount               `json:"unitCost" binding:"required,min=0"`
+	ProductName  string                     `json:"productName"`
+	ProductSku   string                     `json:"productSku"`
+	ProductPrice money.Amount               `json:"productPrice"`
+	Lots         []CreatePurchaseLotRequest `json:"lots,omitempty" binding:"omitempty,dive"`
+	Serials      []string                   `json:"serials,omitempty"`
+}
+
+type CreatePurchaseLotRequest struct {
+	LotNumber  string  `json:"lotNumber" binding:"required"`
+	ExpiryDate *string `json:"expiryDate,omitempty"`
+	Quantity   float64 `json:"quantity" binding:"required,gt=0"`
 }
 
 func (h *PurchaseHandler) CreatePurchase(c *gin.Context) {
@@ -65,7 +94,7 @@ func (h *PurchaseHandler) CreatePurchase(c *gin.Context) {
 
 	log.Printf("Request parsed successfully: supplierId=%d, invoiceNumber=%s, items=%d", req.SupplierID, req.InvoiceNumber, len(req.Items))
 	for i, item := range req.Items {
-		log.Printf("Parsed item %d: ProductID=%d, Quantity=%d, UnitCost=%f, ProductName='%s'", i, item.ProductID, item.Quantity, item.UnitCost, item.ProductName)
+		log.Printf("Parsed item %d: ProductID=%d, Quantity=%g, UnitCost=%s, ProductName='%s'", i, item.ProductID, item.Quantity, item.UnitCost, item.ProductName)
 	}
 
 	// Parse due date if provided
@@ -82,13 +111,32 @@ func (h *PurchaseHandler) CreatePurchase(c *gin.Context) {
 	// Convert request to use case format
 	items := make([]purchase.PurchaseItemRequest, len(req.Items))
 	for i, item := range req.Items {
+		lots := make([]purchase.PurchaseLotRequest, len(item.Lots))
+		for j, lot := range item.Lots {
+			lots[j] = purchase.PurchaseLotRequest{
+				LotNumber: lot.LotNumber,
+				Quantity:  lot.Quantity,
+			}
+			if lot.ExpiryDate != nil && *lot.ExpiryDate != "" {
+				parsed, err := time.Parse("2006-01-02", *lot.ExpiryDate)
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid lot expiry date format. Use YYYY-MM-DD"})
+					return
+				}
+				lots[j].ExpiryDate = &parsed
+			}
+		}
+
 		items[i] = purchase.PurchaseItemRequest{
 			ProductID:    item.ProductID,
 			Quantity:     item.Quantity,
+			UnitID:       item.UnitID,
 			UnitCost:     item.UnitCost,
 			ProductName:  item.ProductName,
 			ProductSku:   item.ProductSku,
 			ProductPrice: item.ProductPrice,
+			Lots:         lots,
+			Serials:      item.Serials,
 		}
 	}
 
@@ -98,6 +146,8 @@ func (h *PurchaseHandler) CreatePurchase(c *gin.Context) {
 		PaymentMethod: req.PaymentMethod,
 		DueDate:       dueDate,
 		Items:         items,
+		Currency:      req.Currency,
+		ExchangeRate:  req.ExchangeRate,
 	}
 
 	result, err := h.createPurchaseUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), useCaseReq)
@@ -107,4 +157,114 @@ func (h *PurchaseHandler) CreatePurchase(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusCreated, result)
-}
\ No newline at end of file
+}
+
+func (h *PurchaseHandler) GetReorderSuggestions(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	req := purchase.ReorderSuggestionsRequest{}
+	if windowDays := c.Query("windowDays"); windowDays != "" {
+		if w, err := strconv.Atoi(windowDays); err == nil {
+			req.WindowDays = w
+		}
+	}
+	if coverDays := c.Query("coverDays"); coverDays != "" {
+		if d, err := strconv.Atoi(coverDays); err == nil {
+			req.CoverDays = d
+		}
+	}
+	if leadTimeDays := c.Query("leadTimeDays"); leadTimeDays != "" {
+		if l, err := strconv.Atoi(leadTimeDays); err == nil {
+			req.LeadTimeDays = &l
+		}
+	}
+	if supplierID := c.Query("supplierId"); supplierID != "" {
+		if s, err := strconv.Atoi(supplierID); err == nil {
+			req.SupplierID = s
+		}
+	}
+
+	result, err := h.getReorderSuggestionsUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *PurchaseHandler) CreateDraftFromSuggestion(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	var req purchase.CreateDraftPurchaseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.createDraftPurchaseUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+func (h *PurchaseHandler) RegisterPayment(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid purchase ID"})
+		return
+	}
+
+	var req purchase.RegisterPaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.registerPaymentUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), id, req)
+	if err != nil {
+		if errors.Is(err, pkg_errors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Purchase not found"})
+			return
+		}
+		if errors.Is(err, pkg_errors.ErrInvalidState) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// PrintPurchase devuelve la factura de compra en PDF; ?layout=receipt la imprime en rollo de 80 mm
+func (h *PurchaseHandler) PrintPurchase(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid purchase ID"})
+		return
+	}
+
+	file, err := h.printPurchaseUseCase.Execute(c.Request.Context(), tenantID.(int), id, c.Query("layout"))
+	if err != nil {
+		if errors.Is(err, pkg_errors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Purchase not found"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="`+file.FileName+`"`)
+	c.Data(http.StatusOK, file.ContentType, file.Data)
+}
@@ -1,19 +1,29 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
 	"Veritasbackend/internal/usecase/stock"
+	pkg_errors "Veritasbackend/pkg/errors"
 	"github.com/gin-gonic/gin"
 )
 
 type StockHandler struct {
-	listProductsUseCase   *stock.ListProductsUseCase
-	createProductUseCase  *stock.CreateProductUseCase
-	updateProductUseCase  *stock.UpdateProductUseCase
-	deleteProductUseCase  *stock.DeleteProductUseCase
-	uploadProductsUseCase *stock.UploadProductsUseCase
+	listProductsUseCase       *stock.ListProductsUseCase
+	createProductUseCase      *stock.CreateProductUseCase
+	updateProductUseCase      *stock.UpdateProductUseCase
+	deleteProductUseCase      *stock.DeleteProductUseCase
+	uploadProductsUseCase     *stock.UploadProductsUseCase
+	listProductLotsUseCase    *stock.ListProductLotsUseCase
+	listExpiringLotsUseCase   *stock.ListExpiringLotsUseCase
+	listProductSerialsUseCase *stock.ListProductSerialsUseCase
+	lookupSerialUseCase       *stock.LookupSerialUseCase
+	valuationUseCase          *stock.GetInventoryValuationUseCase
+	costingMethodUseCase      *stock.UpdateCostingMethodUseCase
+	restoreProductUseCase     *stock.RestoreProductUseCase
+	purgeProductUseCase       *stock.PurgeProductUseCase
 }
 
 func NewStockHandler(
@@ -22,13 +32,29 @@ func NewStockHandler(
 	updateProductUseCase *stock.UpdateProductUseCase,
 	deleteProductUseCase *stock.DeleteProductUseCase,
 	uploadProductsUseCase *stock.UploadProductsUseCase,
+	listProductLotsUseCase *stock.ListProductLotsUseCase,
+	listExpiringLotsUseCase *stock.ListExpiringLotsUseCase,
+	listProductSerialsUseCase *stock.ListProductSerialsUseCase,
+	lookupSerialUseCase *stock.LookupSerialUseCase,
+	valuationUseCase *stock.GetInventoryValuationUseCase,
+	costingMethodUseCase *stock.UpdateCostingMethodUseCase,
+	restoreProductUseCase *stock.RestoreProductUseCase,
+	purgeProductUseCase *stock.PurgeProductUseCase,
 ) *StockHandler {
 	return &StockHandler{
-		listProductsUseCase:   listProductsUseCase,
-		createProductUseCase:  createProductUseCase,
-		updateProductUseCase:  updateProductUseCase,
-		deleteProductUseCase:  deleteProductUseCase,
-		uploadProductsUseCase: uploadProductsUseCase,
+		listProductsUseCase:       listProductsUseCase,
+		createProductUseCase:      createProductUseCase,
+		updateProductUseCase:      updateProductUseCase,
+		deleteProductUseCase:      deleteProductUseCase,
+		uploadProductsUseCase:     uploadProductsUseCase,
+		listProductLotsUseCase:    listProductLotsUseCase,
+		listExpiringLotsUseCase:   listExpiringLotsUseCase,
+		listProductSerialsUseCase: listProductSerialsUseCase,
+		lookupSerialUseCase:       lookupSerialUseCase,
+		valuationUseCase:          valuationUseCase,
+		costingMethodUseCase:      costingMethodUseCase,
+		restoreProductUseCase:     restoreProductUseCase,
+		purgeProductUseCase:       purgeProductUseCase,
 	}
 }
 
@@ -36,8 +62,9 @@ func (h *StockHandler) ListProducts(c *gin.Context) {
 	tenantID, _ := c.Get("tenantID")
 
 	req := stock.ListProductsRequest{
-		Page:  1,
-		Limit: 20,
+		Page:     1,
+		Limit:    20,
+		Archived: c.Query("archived") == "true",
 	}
 
 	if page := c.Query("page"); page != "" {
@@ -62,6 +89,7 @@ func (h *StockHandler) ListProducts(c *gin.Context) {
 
 func (h *StockHandler) CreateProduct(c *gin.Context) {
 	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
 
 	var req stock.CreateProductRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -69,8 +97,12 @@ func (h *StockHandler) CreateProduct(c *gin.Context) {
 		return
 	}
 
-	product, err := h.createProductUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	product, err := h.createProductUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), req)
 	if err != nil {
+		if errors.Is(err, pkg_errors.ErrAlreadyExists) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Barcode already assigned to a product"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -79,6 +111,9 @@ func (h *StockHandler) CreateProduct(c *gin.Context) {
 }
 
 func (h *StockHandler) UpdateProduct(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
 	idStr := c.Param("id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
@@ -92,16 +127,23 @@ func (h *StockHandler) UpdateProduct(c *gin.Context) {
 		return
 	}
 
-	product, err := h.updateProductUseCase.Execute(c.Request.Context(), id, req)
+	response, err := h.updateProductUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), id, req)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+		if errors.Is(err, pkg_errors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"product": product})
+	c.JSON(http.StatusOK, response)
 }
 
+// DeleteProduct elimina el producto de forma lógica; se recupera con RestoreProduct
 func (h *StockHandler) DeleteProduct(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
 	idStr := c.Param("id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
@@ -109,15 +151,51 @@ func (h *StockHandler) DeleteProduct(c *gin.Context) {
 		return
 	}
 
-	err = h.deleteProductUseCase.Execute(c.Request.Context(), id)
+	err = h.deleteProductUseCase.Execute(c.Request.Context(), tenantID.(int), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+		respondProductError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{"message": "Product deleted"})
 }
 
+func (h *StockHandler) RestoreProduct(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	product, err := h.restoreProductUseCase.Execute(c.Request.Context(), tenantID.(int), id)
+	if err != nil {
+		respondProductError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, product)
+}
+
+// PurgeProduct borra definitivamente un producto eliminado que ningún documento referencia
+func (h *StockHandler) PurgeProduct(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	if err := h.purgeProductUseCase.Execute(c.Request.Context(), tenantID.(int), id); err != nil {
+		respondProductError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Product purged"})
+}
+
 func (h *StockHandler) UploadProducts(c *gin.Context) {
 	tenantID, _ := c.Get("tenantID")
 
@@ -143,3 +221,113 @@ func (h *StockHandler) UploadProducts(c *gin.Context) {
 	c.JSON(http.StatusOK, result)
 }
 
+func (h *StockHandler) ListProductLots(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	idStr := c.Param("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	response, err := h.listProductLotsUseCase.Execute(c.Request.Context(), tenantID.(int), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *StockHandler) ListExpiringLots(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	days := 0
+	if d := c.Query("days"); d != "" {
+		if parsed, err := strconv.Atoi(d); err == nil {
+			days = parsed
+		}
+	}
+
+	response, err := h.listExpiringLotsUseCase.Execute(c.Request.Context(), tenantID.(int), days)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *StockHandler) ListProductSerials(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	idStr := c.Param("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product ID"})
+		return
+	}
+
+	response, err := h.listProductSerialsUseCase.Execute(c.Request.Context(), tenantID.(int), id, c.Query("status"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *StockHandler) LookupSerial(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	response, err := h.lookupSerialUseCase.Execute(c.Request.Context(), tenantID.(int), c.Param("serial"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *StockHandler) GetValuation(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	response, err := h.valuationUseCase.Execute(c.Request.Context(), tenantID.(int))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *StockHandler) UpdateCostingMethod(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	var req stock.CostingMethodDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.costingMethodUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"costing": response})
+}
+
+func respondProductError(c *gin.Context, err error) {
+	if errors.Is(err, pkg_errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Product not found"})
+		return
+	}
+	if errors.Is(err, pkg_errors.ErrInUse) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
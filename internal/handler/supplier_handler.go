@@ -1,28 +1,39 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
 	"Veritasbackend/internal/usecase/supplier"
+	pkg_errors "Veritasbackend/pkg/errors"
 	"github.com/gin-gonic/gin"
 )
 
 type SupplierHandler struct {
-	createSupplierUseCase *supplier.CreateSupplierUseCase
-	listSuppliersUseCase  *supplier.ListSuppliersUseCase
-	updateSupplierUseCase *supplier.UpdateSupplierUseCase
+	createSupplierUseCase  *supplier.CreateSupplierUseCase
+	listSuppliersUseCase   *supplier.ListSuppliersUseCase
+	updateSupplierUseCase  *supplier.UpdateSupplierUseCase
+	deleteSupplierUseCase  *supplier.DeleteSupplierUseCase
+	restoreSupplierUseCase *supplier.RestoreSupplierUseCase
+	purgeSupplierUseCase   *supplier.PurgeSupplierUseCase
 }
 
 func NewSupplierHandler(
 	createSupplierUseCase *supplier.CreateSupplierUseCase,
 	listSuppliersUseCase *supplier.ListSuppliersUseCase,
 	updateSupplierUseCase *supplier.UpdateSupplierUseCase,
+	deleteSupplierUseCase *supplier.DeleteSupplierUseCase,
+	restoreSupplierUseCase *supplier.RestoreSupplierUseCase,
+	purgeSupplierUseCase *supplier.PurgeSupplierUseCase,
 ) *SupplierHandler {
 	return &SupplierHandler{
-		createSupplierUseCase: createSupplierUseCase,
-		listSuppliersUseCase:  listSuppliersUseCase,
-		updateSupplierUseCase: updateSupplierUseCase,
+		createSupplierUseCase:  createSupplierUseCase,
+		listSuppliersUseCase:   listSuppliersUseCase,
+		updateSupplierUseCase:  updateSupplierUseCase,
+		deleteSupplierUseCase:  deleteSupplierUseCase,
+		restoreSupplierUseCase: restoreSupplierUseCase,
+		purgeSupplierUseCase:   purgeSupplierUseCase,
 	}
 }
 
@@ -77,8 +88,9 @@ func (h *SupplierHandler) ListSuppliers(c *gin.Context) {
 	}
 
 	req := supplier.ListSuppliersRequest{
-		Page:  page,
-		Limit: limit,
+		Page:     page,
+		Limit:    limit,
+		Archived: c.Query("archived") == "true",
 	}
 
 	result, err := h.listSuppliersUseCase.Execute(c.Request.Context(), tenantID.(int), req)
@@ -125,4 +137,70 @@ func (h *SupplierHandler) UpdateSupplier(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, result)
-}
\ No newline at end of file
+}
+
+// DeleteSupplier elimina el proveedor de forma lógica; se recupera con RestoreSupplier
+func (h *SupplierHandler) DeleteSupplier(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid supplier ID"})
+		return
+	}
+
+	if err := h.deleteSupplierUseCase.Execute(c.Request.Context(), tenantID.(int), id); err != nil {
+		respondSupplierError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Supplier deleted"})
+}
+
+func (h *SupplierHandler) RestoreSupplier(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid supplier ID"})
+		return
+	}
+
+	result, err := h.restoreSupplierUseCase.Execute(c.Request.Context(), tenantID.(int), id)
+	if err != nil {
+		respondSupplierError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// PurgeSupplier borra definitivamente un proveedor eliminado sin compras, pagos ni series
+func (h *SupplierHandler) PurgeSupplier(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid supplier ID"})
+		return
+	}
+
+	if err := h.purgeSupplierUseCase.Execute(c.Request.Context(), tenantID.(int), id); err != nil {
+		respondSupplierError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Supplier purged"})
+}
+
+func respondSupplierError(c *gin.Context, err error) {
+	if errors.Is(err, pkg_errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Supplier not found"})
+		return
+	}
+	if errors.Is(err, pkg_errors.ErrInUse) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"Veritasbackend/internal/usecase/taxes"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+type TaxHandler struct {
+	listTaxRatesUseCase      *taxes.ListTaxRatesUseCase
+	createTaxRateUseCase     *taxes.CreateTaxRateUseCase
+	updateTaxRateUseCase     *taxes.UpdateTaxRateUseCase
+	deleteTaxRateUseCase     *taxes.DeleteTaxRateUseCase
+	updateTaxSettingsUseCase *taxes.UpdateTaxSettingsUseCase
+}
+
+func NewTaxHandler(
+	listTaxRatesUseCase *taxes.ListTaxRatesUseCase,
+	createTaxRateUseCase *taxes.CreateTaxRateUseCase,
+	updateTaxRateUseCase *taxes.UpdateTaxRateUseCase,
+	deleteTaxRateUseCase *taxes.DeleteTaxRateUseCase,
+	updateTaxSettingsUseCase *taxes.UpdateTaxSettingsUseCase,
+) *TaxHandler {
+	return &TaxHandler{
+		listTaxRatesUseCase:      listTaxRatesUseCase,
+		createTaxRateUseCase:     createTaxRateUseCase,
+		updateTaxRateUseCase:     updateTaxRateUseCase,
+		deleteTaxRateUseCase:     deleteTaxRateUseCase,
+		updateTaxSettingsUseCase: updateTaxSettingsUseCase,
+	}
+}
+
+func (h *TaxHandler) ListTaxRates(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	result, err := h.listTaxRatesUseCase.Execute(c.Request.Context(), tenantID.(int))
+	if err != nil {
+		respondTaxError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *TaxHandler) CreateTaxRate(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	var req taxes.TaxRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.createTaxRateUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		respondTaxError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+func (h *TaxHandler) UpdateTaxRate(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tax rate ID"})
+		return
+	}
+
+	var req taxes.TaxRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.updateTaxRateUseCase.Execute(c.Request.Context(), tenantID.(int), id, req)
+	if err != nil {
+		respondTaxError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *TaxHandler) DeleteTaxRate(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tax rate ID"})
+		return
+	}
+
+	if err := h.deleteTaxRateUseCase.Execute(c.Request.Context(), tenantID.(int), id); err != nil {
+		respondTaxError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Tax rate deleted"})
+}
+
+func (h *TaxHandler) UpdateSettings(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	var req taxes.TaxSettingsDTO
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	settings, err := h.updateTaxSettingsUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"settings": settings})
+}
+
+func respondTaxError(c *gin.Context, err error) {
+	if errors.Is(err, pkg_errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tax rate not found"})
+		return
+	}
+	if errors.Is(err, pkg_errors.ErrAlreadyExists) || errors.Is(err, pkg_errors.ErrInUse) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"Veritasbackend/internal/usecase/tenant"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+type TenantHandler struct {
+	getProfileUseCase    *tenant.GetProfileUseCase
+	updateProfileUseCase *tenant.UpdateProfileUseCase
+	uploadLogoUseCase    *tenant.UploadLogoUseCase
+	deleteLogoUseCase    *tenant.DeleteLogoUseCase
+}
+
+func NewTenantHandler(
+	getProfileUseCase *tenant.GetProfileUseCase,
+	updateProfileUseCase *tenant.UpdateProfileUseCase,
+	uploadLogoUseCase *tenant.UploadLogoUseCase,
+	deleteLogoUseCase *tenant.DeleteLogoUseCase,
+) *TenantHandler {
+	return &TenantHandler{
+		getProfileUseCase:    getProfileUseCase,
+		updateProfileUseCase: updateProfileUseCase,
+		uploadLogoUseCase:    uploadLogoUseCase,
+		deleteLogoUseCase:    deleteLogoUseCase,
+	}
+}
+
+func (h *TenantHandler) GetProfile(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	profile, err := h.getProfileUseCase.Execute(c.Request.Context(), tenantID.(int))
+	if err != nil {
+		respondTenantError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"profile": profile})
+}
+
+func (h *TenantHandler) UpdateProfile(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	var req tenant.ProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	profile, err := h.updateProfileUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		respondTenantError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"profile": profile})
+}
+
+func (h *TenantHandler) UploadLogo(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "File is required"})
+		return
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to open file"})
+		return
+	}
+	defer f.Close()
+
+	// Se lee un byte más del máximo para detectar archivos demasiado grandes
+	data, err := io.ReadAll(io.LimitReader(f, tenant.MaxLogoSize+1))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read file"})
+		return
+	}
+
+	if err := h.uploadLogoUseCase.Execute(c.Request.Context(), tenantID.(int), data); err != nil {
+		respondTenantError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Logo updated"})
+}
+
+func (h *TenantHandler) DeleteLogo(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	if err := h.deleteLogoUseCase.Execute(c.Request.Context(), tenantID.(int)); err != nil {
+		respondTenantError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Logo deleted"})
+}
+
+func respondTenantError(c *gin.Context, err error) {
+	if errors.Is(err, pkg_errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tenant not found"})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"Veritasbackend/internal/usecase/auth"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+type UserHandler struct {
+	listUsersUseCase   *auth.ListUsersUseCase
+	deleteUserUseCase  *auth.DeleteUserUseCase
+	restoreUserUseCase *auth.RestoreUserUseCase
+	purgeUserUseCase   *auth.PurgeUserUseCase
+}
+
+func NewUserHandler(
+	listUsersUseCase *auth.ListUsersUseCase,
+	deleteUserUseCase *auth.DeleteUserUseCase,
+	restoreUserUseCase *auth.RestoreUserUseCase,
+	purgeUserUseCase *auth.PurgeUserUseCase,
+) *UserHandler {
+	return &UserHandler{
+		listUsersUseCase:   listUsersUseCase,
+		deleteUserUseCase:  deleteUserUseCase,
+		restoreUserUseCase: restoreUserUseCase,
+		purgeUserUseCase:   purgeUserUseCase,
+	}
+}
+
+func (h *UserHandler) ListUsers(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	req := auth.ListUsersRequest{
+		Page:     1,
+		Limit:    20,
+		Archived: c.Query("archived") == "true",
+	}
+
+	if page := c.Query("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil {
+			req.Page = p
+		}
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil {
+			req.Limit = l
+		}
+	}
+
+	response, err := h.listUsersUseCase.Execute(c.Request.Context(), tenantID.(int), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteUser elimina el usuario de forma lógica: ya no puede iniciar sesión
+func (h *UserHandler) DeleteUser(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+	userID, _ := c.Get("userID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	if err := h.deleteUserUseCase.Execute(c.Request.Context(), tenantID.(int), userID.(int), id); err != nil {
+		respondUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "User deleted"})
+}
+
+func (h *UserHandler) RestoreUser(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	user, err := h.restoreUserUseCase.Execute(c.Request.Context(), tenantID.(int), id)
+	if err != nil {
+		respondUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"user": user})
+}
+
+// PurgeUser borra definitivamente un usuario eliminado que no registró ningún documento
+func (h *UserHandler) PurgeUser(c *gin.Context) {
+	tenantID, _ := c.Get("tenantID")
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	if err := h.purgeUserUseCase.Execute(c.Request.Context(), tenantID.(int), id); err != nil {
+		respondUserError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "User purged"})
+}
+
+func respondUserError(c *gin.Context, err error) {
+	if errors.Is(err, pkg_errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+	if errors.Is(err, pkg_errors.ErrInUse) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
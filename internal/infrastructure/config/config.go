@@ -9,6 +9,8 @@ type Config struct {
 	Database DatabaseConfig
 	JWT      JWTConfig
 	CORS     CORSConfig
+	Storage  StorageConfig
+	Fiscal   FiscalConfig
 }
 
 type ServerConfig struct {
@@ -34,6 +36,25 @@ type CORSConfig struct {
 	AllowedOrigins string
 }
 
+type StorageConfig struct {
+	Driver      string
+	URLTTL      string
+	SigningKey  string
+	LocalPath   string
+	PublicURL   string
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
+	S3PathStyle bool
+}
+
+type FiscalConfig struct {
+	Provider         string
+	KeyEncryptionKey string
+}
+
 func Load() *Config {
 	return &Config{
 		Server: ServerConfig{
@@ -55,6 +76,23 @@ func Load() *Config {
 		CORS: CORSConfig{
 			AllowedOrigins: getEnv("CORS_ALLOWED_ORIGINS", "http://localhost:3000"),
 		},
+		Storage: StorageConfig{
+			Driver:      getEnv("STORAGE_DRIVER", "local"),
+			URLTTL:      getEnv("STORAGE_URL_TTL", "15m"),
+			SigningKey:  getEnv("STORAGE_SIGNING_KEY", ""),
+			LocalPath:   getEnv("STORAGE_LOCAL_PATH", "./uploads"),
+			PublicURL:   getEnv("STORAGE_PUBLIC_URL", "http://localhost:"+getEnv("PORT", "8080")),
+			S3Endpoint:  getEnv("S3_ENDPOINT", "https://s3.amazonaws.com"),
+			S3Region:    getEnv("S3_REGION", "us-east-1"),
+			S3Bucket:    getEnv("S3_BUCKET", ""),
+			S3AccessKey: getEnv("S3_ACCESS_KEY", ""),
+			S3SecretKey: getEnv("S3_SECRET_KEY", ""),
+			S3PathStyle: getEnv("S3_PATH_STYLE", "true") == "true",
+		},
+		Fiscal: FiscalConfig{
+			Provider:         getEnv("FISCAL_PROVIDER", "mock"),
+			KeyEncryptionKey: getEnv("EINVOICE_KEY_ENCRYPTION_KEY", ""),
+		},
 	}
 }
 
@@ -8,6 +8,8 @@ import (
 	"Veritasbackend/ent"
 	"Veritasbackend/internal/infrastructure/config"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/lib/pq"
 )
 
@@ -22,15 +24,20 @@ func NewClient(cfg *config.Config) (*ent.Client, error) {
 		cfg.Database.SSLMode,
 	)
 
-	client, err := ent.Open("postgres", dsn)
+	drv, err := entsql.Open(dialect.Postgres, dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed opening connection to postgres: %w", err)
 	}
+	client := ent.NewClient(ent.Driver(drv))
 
 	// Ejecutar migraciones automáticamente
 	if err := client.Schema.Create(context.Background()); err != nil {
 		log.Printf("Warning: failed creating schema resources: %v", err)
 	}
 
+	if err := runMigrations(context.Background(), drv.DB(), cfg); err != nil {
+		log.Printf("Warning: failed running migrations: %v", err)
+	}
+
 	return client, nil
 }
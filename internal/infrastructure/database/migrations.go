@@ -0,0 +1,294 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"Veritasbackend/internal/infrastructure/config"
+	"Veritasbackend/pkg/money"
+	"Veritasbackend/pkg/secret"
+)
+
+// migration es un cambio de datos o de columnas que el auto-migrate de Ent no hace (por
+// ejemplo, borrar columnas). Cada una debe poder ejecutarse varias veces sin efecto.
+type migration struct {
+	name string
+	run  func(ctx context.Context, db *sql.DB, cfg *config.Config) error
+}
+
+var migrations = []migration{
+	{name: "retire_product_price", run: retireProductPrice},
+	{name: "money_columns_numeric", run: moneyColumnsNumeric},
+	{name: "document_base_amounts", run: documentBaseAmounts},
+	{name: "promotion_value_split", run: promotionValueSplit},
+	{name: "einvoice_private_key_encryption", run: encryptEInvoiceKeys},
+}
+
+// runMigrations ejecuta las migraciones manuales después de que Ent crea las tablas y columnas nuevas
+func runMigrations(ctx context.Context, db *sql.DB, cfg *config.Config) error {
+	for _, m := range migrations {
+		if err := m.run(ctx, db, cfg); err != nil {
+			return fmt.Errorf("migration %s: %w", m.name, err)
+		}
+	}
+	return nil
+}
+
+// retireProductPrice pasa el precio legacy (products.price) a retail_price en los productos que
+// todavía no lo tienen y elimina la columna, que quedó NOT NULL sin valor por defecto
+func retireProductPrice(ctx context.Context, db *sql.DB, _ *config.Config) error {
+	exists, err := columnExists(ctx, db, "products", "price")
+	if err != nil || !exists {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `UPDATE products SET retail_price = price WHERE retail_price = 0 AND price > 0`)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `ALTER TABLE products DROP COLUMN price`); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	backfilled, _ := result.RowsAffected()
+	log.Printf("Migration retire_product_price: %d products moved to retail_price, column products.price dropped", backfilled)
+
+	return nil
+}
+
+// moneyColumns son las columnas con importes, que pasaron de double precision a numeric
+var moneyColumns = map[string][]string{
+	"bundle_assemblies":       {"unit_cost"},
+	"bundle_assembly_lines":   {"unit_cost"},
+	"inventory_cost_layers":   {"unit_cost"},
+	"inventory_count_lines":   {"unit_cost"},
+	"invoices":                {"total", "subtotal", "tax_total", "discount_amount", "discount_total", "promotion_total"},
+	"invoice_items":           {"unit_price", "subtotal", "promotion_discount", "discount_amount", "invoice_discount_amount", "unit_cost", "cost_total", "tax_base", "tax_amount"},
+	"invoice_item_components": {"revenue", "cost_total", "built_unit_cost"},
+	"invoice_taxes":           {"base", "amount"},
+	"price_list_items":        {"price"},
+	"products":                {"purchase_price", "retail_price", "wholesale_price"},
+	"product_prices":          {"retail_price", "wholesale_price", "purchase_price"},
+	"product_units":           {"retail_price"},
+	"promotions":              {"min_amount"},
+	"purchase_invoices":       {"total", "subtotal", "tax_total", "paid_amount"},
+	"purchase_invoice_items":  {"unit_cost", "subtotal", "tax_base", "tax_amount"},
+	"stock_adjustments":       {"total_value"},
+	"stock_adjustment_lines":  {"unit_cost", "total_cost"},
+	"supplier_payments":       {"amount"},
+	"tenants":                 {"adjustment_approval_threshold"},
+}
+
+// centColumns son los importes de documentos que siempre se guardaron redondeados a centavos;
+// los costos y precios unitarios conservan los cuatro decimales
+var centColumns = map[string][]string{
+	"invoices":                {"total", "subtotal", "tax_total", "discount_amount", "discount_total", "promotion_total"},
+	"invoice_items":           {"subtotal", "promotion_discount", "discount_amount", "invoice_discount_amount", "tax_base", "tax_amount"},
+	"invoice_item_components": {"revenue"},
+	"invoice_taxes":           {"base", "amount"},
+	"purchase_invoices":       {"total", "subtotal", "tax_total", "paid_amount"},
+	"supplier_payments":       {"amount"},
+}
+
+// moneyColumnsNumeric termina el paso de los importes a numeric. El auto-migrate cambia el tipo
+// de las columnas; si no pudo hacerlo, se cambia aquí. Después quita el ruido de punto flotante
+// (0.30000000000000004) de los totales ya guardados, redondeándolos a centavos.
+func moneyColumnsNumeric(ctx context.Context, db *sql.DB, _ *config.Config) error {
+	converted := 0
+	for table, columns := range moneyColumns {
+		for _, column := range columns {
+			dataType, err := columnType(ctx, db, table, column)
+			if err != nil {
+				return err
+			}
+			if dataType != "double precision" {
+				continue
+			}
+
+			_, err = db.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE %s ALTER COLUMN %s TYPE %s USING round(%s::numeric, %d)`, table, column, money.SchemaType, column, money.Decimals))
+			if err != nil {
+				return err
+			}
+			converted++
+		}
+	}
+
+	rounded := int64(0)
+	for table, columns := range centColumns {
+		for _, column := range columns {
+			result, err := db.ExecContext(ctx, fmt.Sprintf(`UPDATE %s SET %s = round(%s, %d) WHERE %s <> round(%s, %d)`, table, column, column, money.Default.Decimals, column, column, money.Default.Decimals))
+			if err != nil {
+				return err
+			}
+			affected, _ := result.RowsAffected()
+			rounded += affected
+		}
+	}
+
+	if converted > 0 || rounded > 0 {
+		log.Printf("Migration money_columns_numeric: %d columns converted to numeric, %d amounts rounded to cents", converted, rounded)
+	}
+
+	return nil
+}
+
+// baseAmountBackfills copia a los importes en moneda base los de los documentos anteriores a la
+// multimoneda, que se registraron en la moneda base con tasa 1
+var baseAmountBackfills = []string{
+	`UPDATE invoices SET base_subtotal = subtotal, base_tax_total = tax_total, base_total = total WHERE base_total = 0 AND total <> 0`,
+	`UPDATE purchase_invoices SET base_subtotal = subtotal, base_tax_total = tax_total, base_total = total WHERE base_total = 0 AND total <> 0`,
+	`UPDATE supplier_payments SET base_amount = amount WHERE base_amount = 0 AND amount <> 0`,
+}
+
+// documentBaseAmounts llena los importes en moneda base de facturas, compras y pagos existentes,
+// que los reportes suman desde que hay documentos en otras monedas
+func documentBaseAmounts(ctx context.Context, db *sql.DB, _ *config.Config) error {
+	backfilled := int64(0)
+	for _, query := range baseAmountBackfills {
+		result, err := db.ExecContext(ctx, query)
+		if err != nil {
+			return err
+		}
+		affected, _ := result.RowsAffected()
+		backfilled += affected
+	}
+
+	if backfilled > 0 {
+		log.Printf("Migration document_base_amounts: %d documents backfilled with base currency amounts", backfilled)
+	}
+
+	return nil
+}
+
+// promotionValueSplit reparte promotions.value, un double que guardaba el porcentaje o el monto
+// según el efecto, entre percent y amount (numeric), y elimina la columna
+func promotionValueSplit(ctx context.Context, db *sql.DB, _ *config.Config) error {
+	exists, err := columnExists(ctx, db, "promotions", "value")
+	if err != nil || !exists {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	percents, err := tx.ExecContext(ctx, `UPDATE promotions SET percent = value WHERE effect = 'percent' AND percent = 0`)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	amounts, err := tx.ExecContext(ctx, fmt.Sprintf(`UPDATE promotions SET amount = round(value::numeric, %d) WHERE effect IN ('fixed', 'bundle_price') AND amount = 0`, money.Decimals))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `ALTER TABLE promotions DROP COLUMN value`); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	movedPercents, _ := percents.RowsAffected()
+	movedAmounts, _ := amounts.RowsAffected()
+	log.Printf("Migration promotion_value_split: %d percentages and %d amounts moved, column promotions.value dropped", movedPercents, movedAmounts)
+
+	return nil
+}
+
+// encryptEInvoiceKeys cifra con EINVOICE_KEY_ENCRYPTION_KEY las llaves privadas de firma que se
+// guardaron en claro antes de cifrarlas; las ya cifradas se dejan como están
+func encryptEInvoiceKeys(ctx context.Context, db *sql.DB, cfg *config.Config) error {
+	rows, err := db.QueryContext(ctx, `SELECT id, einvoice_private_key FROM tenants WHERE length(einvoice_private_key) > 0`)
+	if err != nil {
+		return err
+	}
+	plaintext := make(map[int][]byte)
+	for rows.Next() {
+		var id int
+		var key []byte
+		if err := rows.Scan(&id, &key); err != nil {
+			rows.Close()
+			return err
+		}
+		if !secret.IsSealed(key) {
+			plaintext[id] = key
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(plaintext) == 0 {
+		return nil
+	}
+
+	box, err := secret.NewBox(cfg.Fiscal.KeyEncryptionKey)
+	if err != nil {
+		return fmt.Errorf("hay %d llaves de firma sin cifrar y EINVOICE_KEY_ENCRYPTION_KEY no es válida: %w", len(plaintext), err)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for id, key := range plaintext {
+		sealed, err := box.Seal(key)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE tenants SET einvoice_private_key = $1 WHERE id = $2`, sealed, id); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	log.Printf("Migration einvoice_private_key_encryption: %d private keys encrypted", len(plaintext))
+
+	return nil
+}
+
+// columnType devuelve el tipo de la columna; vacío si la columna no existe
+func columnType(ctx context.Context, db *sql.DB, table, column string) (string, error) {
+	var dataType string
+	err := db.QueryRowContext(ctx,
+		`SELECT data_type FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = $1 AND column_name = $2`,
+		table, column,
+	).Scan(&dataType)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return dataType, err
+}
+
+func columnExists(ctx context.Context, db *sql.DB, table, column string) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = $1 AND column_name = $2)`,
+		table, column,
+	).Scan(&exists)
+	return exists, err
+}
@@ -0,0 +1,54 @@
+package fiscal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Veritasbackend/internal/infrastructure/config"
+)
+
+// Estados de la respuesta de la autoridad tributaria
+const (
+	StatusAccepted = "accepted"
+	StatusRejected = "rejected"
+	StatusPending  = "pending"
+)
+
+// Submission es un documento firmado listo para enviar a la autoridad
+type Submission struct {
+	TenantID     int
+	Country      string
+	Environment  string
+	DocumentType string
+	Number       string
+	CUFE         string
+	XML          []byte
+}
+
+// Result es la respuesta de la autoridad. Un documento pending se consulta después con Status.
+type Result struct {
+	TrackID    string
+	Status     string
+	Reasons    []string
+	ReceivedAt time.Time
+}
+
+// Provider envía los documentos electrónicos a la autoridad tributaria (directamente o a través
+// de un proveedor tecnológico) y consulta su estado
+type Provider interface {
+	Name() string
+	Submit(ctx context.Context, submission Submission) (*Result, error)
+	Status(ctx context.Context, trackID string) (*Result, error)
+}
+
+// New crea el proveedor configurado en FISCAL_PROVIDER. Por ahora solo existe mock, que valida
+// el documento localmente sin enviarlo.
+func New(cfg config.FiscalConfig) (Provider, error) {
+	switch cfg.Provider {
+	case "mock":
+		return NewMockProvider(), nil
+	default:
+		return nil, fmt.Errorf("proveedor de facturación electrónica desconocido %q", cfg.Provider)
+	}
+}
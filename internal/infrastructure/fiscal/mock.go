@@ -0,0 +1,111 @@
+package fiscal
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"Veritasbackend/pkg/xades"
+)
+
+// MockProvider simula a la autoridad tributaria: verifica la firma y las reglas básicas del
+// documento y lo acepta o lo rechaza con los motivos. Las respuestas se guardan en memoria.
+type MockProvider struct {
+	mu      sync.Mutex
+	results map[string]Result
+}
+
+func NewMockProvider() *MockProvider {
+	return &MockProvider{results: make(map[string]Result)}
+}
+
+func (p *MockProvider) Name() string {
+	return "mock"
+}
+
+func (p *MockProvider) Submit(ctx context.Context, submission Submission) (*Result, error) {
+	trackID, err := newTrackID()
+	if err != nil {
+		return nil, err
+	}
+
+	result := Result{
+		TrackID:    trackID,
+		Status:     StatusAccepted,
+		Reasons:    validate(submission),
+		ReceivedAt: time.Now(),
+	}
+	if len(result.Reasons) > 0 {
+		result.Status = StatusRejected
+	}
+
+	p.mu.Lock()
+	p.results[trackID] = result
+	p.mu.Unlock()
+
+	return &result, nil
+}
+
+func (p *MockProvider) Status(ctx context.Context, trackID string) (*Result, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	result, ok := p.results[trackID]
+	if !ok {
+		return nil, fmt.Errorf("el proveedor no conoce el envío %s", trackID)
+	}
+	return &result, nil
+}
+
+// received son los campos del documento que revisa el mock. encoding/xml compara solo el nombre
+// local, así que sirve para facturas y notas crédito.
+type received struct {
+	ID       string `xml:"ID"`
+	UUID     string `xml:"UUID"`
+	Payable  string `xml:"LegalMonetaryTotal>PayableAmount"`
+	Customer string `xml:"AccountingCustomerParty>Party>PartyTaxScheme>CompanyID"`
+}
+
+func validate(submission Submission) []string {
+	var reasons []string
+
+	if _, err := xades.Verify(submission.XML); err != nil {
+		reasons = append(reasons, "Firma inválida: "+err.Error())
+	}
+
+	var doc received
+	if err := xml.Unmarshal(submission.XML, &doc); err != nil {
+		return append(reasons, "XML mal formado: "+err.Error())
+	}
+
+	if strings.TrimSpace(doc.ID) == "" {
+		reasons = append(reasons, "El documento no tiene número")
+	} else if doc.ID != submission.Number {
+		reasons = append(reasons, fmt.Sprintf("El número del XML (%s) no coincide con el enviado (%s)", doc.ID, submission.Number))
+	}
+	if submission.CUFE != "" && doc.UUID != submission.CUFE {
+		reasons = append(reasons, "El código único del XML no coincide con el enviado")
+	}
+	if payable, err := strconv.ParseFloat(doc.Payable, 64); err != nil || payable < 0 {
+		reasons = append(reasons, "El total a pagar es inválido")
+	}
+	if strings.TrimSpace(doc.Customer) == "" {
+		reasons = append(reasons, "El adquiriente no tiene identificación")
+	}
+
+	return reasons
+}
+
+func newTrackID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,30 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Job es una tarea periódica; un error se registra y la tarea se vuelve a ejecutar en el siguiente ciclo
+type Job func(ctx context.Context) error
+
+// Start ejecuta el job al arrancar y luego cada intervalo, hasta que se cancele el contexto
+func Start(ctx context.Context, name string, interval time.Duration, job Job) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := job(ctx); err != nil {
+				log.Printf("Scheduler job %s failed: %v", name, err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
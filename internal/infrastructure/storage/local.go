@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidSignature indica que la URL firmada fue alterada o ya venció
+var ErrInvalidSignature = errors.New("firma inválida o vencida")
+
+// LocalStorage guarda los archivos en disco. Las URLs apuntan a la ruta /api/files del propio
+// servidor y llevan una firma HMAC con su vencimiento.
+type LocalStorage struct {
+	baseDir    string
+	publicURL  string
+	signingKey []byte
+}
+
+func NewLocalStorage(baseDir, publicURL, signingKey string) *LocalStorage {
+	return &LocalStorage{
+		baseDir:    baseDir,
+		publicURL:  strings.TrimRight(publicURL, "/"),
+		signingKey: []byte(signingKey),
+	}
+}
+
+func (s *LocalStorage) Put(ctx context.Context, key, contentType string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStorage) SignedURL(ctx context.Context, key string, expiresIn time.Duration) (string, error) {
+	expires := strconv.FormatInt(time.Now().Add(expiresIn).Unix(), 10)
+
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", s.sign(key, expires))
+
+	return fmt.Sprintf("%s/api/files/%s?%s", s.publicURL, key, query.Encode()), nil
+}
+
+// Open verifica la firma de una URL generada por SignedURL y abre el archivo
+func (s *LocalStorage) Open(key, expires, signature string) (io.ReadSeekCloser, error) {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return nil, ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(signature), []byte(s.sign(key, expires))) {
+		return nil, ErrInvalidSignature
+	}
+
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.Open(path)
+}
+
+func (s *LocalStorage) sign(key, expires string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// path traduce la clave a una ruta dentro del directorio base, sin permitir salir de él
+func (s *LocalStorage) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if clean == "/" {
+		return "", fmt.Errorf("clave de almacenamiento inválida %q", key)
+	}
+	return filepath.Join(s.baseDir, clean), nil
+}
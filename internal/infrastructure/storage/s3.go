@@ -0,0 +1,238 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	s3Algorithm       = "AWS4-HMAC-SHA256"
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	s3MaxPresignTTL   = 7 * 24 * time.Hour
+)
+
+// S3Storage guarda los archivos en un bucket compatible con S3 (AWS, MinIO, R2, ...). Las
+// peticiones se firman con AWS Signature V4 y las descargas usan URLs prefirmadas.
+type S3Storage struct {
+	endpoint  *url.URL
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	pathStyle bool
+	client    *http.Client
+}
+
+func NewS3Storage(endpoint, region, bucket, accessKey, secretKey string, pathStyle bool) (*S3Storage, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("endpoint de S3 inválido %q", endpoint)
+	}
+
+	return &S3Storage{
+		endpoint:  u,
+		region:    region,
+		bucket:    bucket,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		pathStyle: pathStyle,
+		client:    &http.Client{Timeout: 60 * time.Second},
+	}, nil
+}
+
+func (s *S3Storage) Put(ctx context.Context, key, contentType string, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key).String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	return s.do(req, data)
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key).String(), nil)
+	if err != nil {
+		return err
+	}
+
+	return s.do(req, nil)
+}
+
+func (s *S3Storage) SignedURL(ctx context.Context, key string, expiresIn time.Duration) (string, error) {
+	if expiresIn > s3MaxPresignTTL {
+		expiresIn = s3MaxPresignTTL
+	}
+
+	return s.presign(key, expiresIn, time.Now().UTC()), nil
+}
+
+func (s *S3Storage) presign(key string, expiresIn time.Duration, now time.Time) string {
+	amzDate := now.Format("20060102T150405Z")
+	scope := s.scope(now)
+
+	u := s.objectURL(key)
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", s3Algorithm)
+	query.Set("X-Amz-Credential", s.accessKey+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expiresIn.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		u.EscapedPath(),
+		canonicalQuery(query),
+		"host:" + u.Host + "\n",
+		"host",
+		s3UnsignedPayload,
+	}, "\n")
+
+	query.Set("X-Amz-Signature", s.signature(now, canonicalRequest))
+	u.RawQuery = canonicalQuery(query)
+
+	return u.String()
+}
+
+// do firma la petición con las cabeceras de Signature V4 y la envía
+func (s *S3Storage) do(req *http.Request, payload []byte) error {
+	now := time.Now().UTC()
+	payloadHash := sha256Hex(payload)
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", now.Format("20060102T150405Z"))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, strings.ToLower(name))
+	}
+	sort.Strings(names)
+
+	var headers strings.Builder
+	for _, name := range names {
+		headers.WriteString(name + ":" + strings.TrimSpace(req.Header.Get(name)) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		headers.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.accessKey, s.scope(now), signedHeaders, s.signature(now, canonicalRequest)))
+	req.Header.Del("Host")
+	req.Host = req.URL.Host
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("S3 %s %s falló: %s %s", req.Method, req.URL.Path, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
+
+func (s *S3Storage) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	escapedKey := escapePath(strings.TrimLeft(key, "/"))
+	if s.pathStyle {
+		u.Path = "/" + s.bucket + "/" + key
+		u.RawPath = "/" + s.bucket + "/" + escapedKey
+	} else {
+		u.Host = s.bucket + "." + u.Host
+		u.Path = "/" + key
+		u.RawPath = "/" + escapedKey
+	}
+	return &u
+}
+
+func (s *S3Storage) scope(t time.Time) string {
+	return t.Format("20060102") + "/" + s.region + "/s3/aws4_request"
+}
+
+func (s *S3Storage) signature(t time.Time, canonicalRequest string) string {
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		t.Format("20060102T150405Z"),
+		s.scope(t),
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), t.Format("20060102"))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// canonicalQuery ordena y codifica los parámetros como exige Signature V4
+func canonicalQuery(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range values[k] {
+			parts = append(parts, awsEscape(k, true)+"="+awsEscape(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+func escapePath(path string) string {
+	return awsEscape(path, false)
+}
+
+// awsEscape codifica todo salvo los caracteres no reservados (RFC 3986); la barra se conserva en rutas
+func awsEscape(value string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
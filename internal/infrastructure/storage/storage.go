@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Veritasbackend/internal/infrastructure/config"
+)
+
+// Storage guarda archivos por clave y entrega URLs firmadas con vencimiento para descargarlos
+type Storage interface {
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	Delete(ctx context.Context, key string) error
+	SignedURL(ctx context.Context, key string, expiresIn time.Duration) (string, error)
+}
+
+// New crea el almacenamiento configurado en STORAGE_DRIVER (local o s3)
+func New(cfg config.StorageConfig) (Storage, error) {
+	switch cfg.Driver {
+	case "local":
+		// Las URLs firmadas no comparten clave con los tokens: quien obtenga una no puede firmar la otra
+		if cfg.SigningKey == "" {
+			return nil, fmt.Errorf("el almacenamiento local requiere STORAGE_SIGNING_KEY para firmar las URLs de descarga")
+		}
+		return NewLocalStorage(cfg.LocalPath, cfg.PublicURL, cfg.SigningKey), nil
+	case "s3":
+		if cfg.S3Bucket == "" || cfg.S3AccessKey == "" || cfg.S3SecretKey == "" {
+			return nil, fmt.Errorf("el almacenamiento S3 requiere S3_BUCKET, S3_ACCESS_KEY y S3_SECRET_KEY")
+		}
+		return NewS3Storage(cfg.S3Endpoint, cfg.S3Region, cfg.S3Bucket, cfg.S3AccessKey, cfg.S3SecretKey, cfg.S3PathStyle)
+	default:
+		return nil, fmt.Errorf("driver de almacenamiento desconocido %q", cfg.Driver)
+	}
+}
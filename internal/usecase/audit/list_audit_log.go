@@ -0,0 +1,95 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type ListAuditLogUseCase struct {
+	auditRepo repositories.AuditLogRepository
+}
+
+func NewListAuditLogUseCase(auditRepo repositories.AuditLogRepository) *ListAuditLogUseCase {
+	return &ListAuditLogUseCase{
+		auditRepo: auditRepo,
+	}
+}
+
+type ListAuditLogRequest struct {
+	Page     int    `json:"page"`
+	Limit    int    `json:"limit"`
+	Entity   string `json:"entity"`
+	EntityID int    `json:"entityId"`
+	Action   string `json:"action"`
+}
+
+type AuditLogDTO struct {
+	ID       int    `json:"id"`
+	UserID   int    `json:"userId"`
+	Action   string `json:"action"`
+	Entity   string `json:"entity"`
+	EntityID int    `json:"entityId,omitempty"`
+	Summary  string `json:"summary"`
+	// Details es el detalle tal como se registró (operación, valores antes y después)
+	Details   json.RawMessage `json:"details,omitempty"`
+	CreatedAt string          `json:"createdAt"`
+}
+
+type ListAuditLogResponse struct {
+	Entries []AuditLogDTO `json:"entries"`
+	Total   int           `json:"total"`
+	Page    int           `json:"page"`
+	Limit   int           `json:"limit"`
+}
+
+func (uc *ListAuditLogUseCase) Execute(ctx context.Context, tenantID int, req ListAuditLogRequest) (*ListAuditLogResponse, error) {
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 || req.Limit > 100 {
+		req.Limit = 20
+	}
+
+	offset := (req.Page - 1) * req.Limit
+
+	logs, total, err := uc.auditRepo.FindAll(ctx, tenantID, repositories.AuditLogFilter{
+		Entity:   req.Entity,
+		EntityID: req.EntityID,
+		Action:   req.Action,
+	}, req.Limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]AuditLogDTO, len(logs))
+	for i, log := range logs {
+		entries[i] = convertAuditLogToDTO(log)
+	}
+
+	return &ListAuditLogResponse{
+		Entries: entries,
+		Total:   total,
+		Page:    req.Page,
+		Limit:   req.Limit,
+	}, nil
+}
+
+func convertAuditLogToDTO(log *ent.AuditLog) AuditLogDTO {
+	dto := AuditLogDTO{
+		ID:        log.ID,
+		UserID:    log.UserID,
+		Action:    log.Action,
+		Entity:    log.Entity,
+		EntityID:  log.EntityID,
+		Summary:   log.Summary,
+		CreatedAt: log.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if log.Details != "" {
+		dto.Details = json.RawMessage(log.Details)
+	}
+
+	return dto
+}
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type DeleteUserUseCase struct {
+	userRepo repositories.UserRepository
+}
+
+func NewDeleteUserUseCase(userRepo repositories.UserRepository) *DeleteUserUseCase {
+	return &DeleteUserUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute elimina el usuario de forma lógica: ya no puede iniciar sesión, pero las ventas,
+// compras y movimientos que registró lo siguen mostrando. currentUserID es quien lo elimina.
+func (uc *DeleteUserUseCase) Execute(ctx context.Context, tenantID, currentUserID, userID int) error {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil || user.TenantID != tenantID || user.Archived {
+		return pkg_errors.ErrNotFound
+	}
+
+	if user.ID == currentUserID {
+		return fmt.Errorf("no puedes eliminar tu propio usuario")
+	}
+
+	return uc.userRepo.Archive(ctx, userID)
+}
@@ -19,7 +19,7 @@ func NewGetCurrentUserUseCase(userRepo repositories.UserRepository) *GetCurrentU
 
 func (uc *GetCurrentUserUseCase) Execute(ctx context.Context, userID int) (*UserDTO, error) {
 	user, err := uc.userRepo.FindByID(ctx, userID)
-	if err != nil {
+	if err != nil || user.Archived {
 		return nil, pkg_errors.ErrNotFound
 	}
 
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type ListUsersUseCase struct {
+	userRepo repositories.UserRepository
+}
+
+func NewListUsersUseCase(userRepo repositories.UserRepository) *ListUsersUseCase {
+	return &ListUsersUseCase{
+		userRepo: userRepo,
+	}
+}
+
+type ListUsersRequest struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+	// Archived lista los usuarios eliminados en vez de los activos
+	Archived bool `json:"archived"`
+}
+
+type ListUsersResponse struct {
+	Users []UserDTO `json:"users"`
+	Total int       `json:"total"`
+	Page  int       `json:"page"`
+	Limit int       `json:"limit"`
+}
+
+func (uc *ListUsersUseCase) Execute(ctx context.Context, tenantID int, req ListUsersRequest) (*ListUsersResponse, error) {
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 || req.Limit > 100 {
+		req.Limit = 20
+	}
+
+	offset := (req.Page - 1) * req.Limit
+
+	users, total, err := uc.userRepo.FindAll(ctx, tenantID, req.Archived, req.Limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	userDTOs := make([]UserDTO, len(users))
+	for i, user := range users {
+		userDTOs[i] = convertUserToDTO(user)
+	}
+
+	return &ListUsersResponse{
+		Users: userDTOs,
+		Total: total,
+		Page:  req.Page,
+		Limit: req.Limit,
+	}, nil
+}
+
+func convertUserToDTO(user *ent.User) UserDTO {
+	dto := UserDTO{
+		ID:       user.ID,
+		Email:    user.Email,
+		Name:     user.Name,
+		Role:     user.Role,
+		Archived: user.Archived,
+	}
+	if user.DeletedAt != nil {
+		dto.DeletedAt = user.DeletedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return dto
+}
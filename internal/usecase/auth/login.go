@@ -33,10 +33,13 @@ type LoginResponse struct {
 }
 
 type UserDTO struct {
-	ID    int    `json:"id"`
-	Email string `json:"email"`
-	Name  string `json:"name"`
-	Role  string `json:"role"`
+	ID       int    `json:"id"`
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Role     string `json:"role"`
+	Archived bool   `json:"archived"`
+	// DeletedAt es la fecha de eliminación; vacío si el usuario está activo
+	DeletedAt string `json:"deletedAt,omitempty"`
 }
 
 func (uc *LoginUseCase) Execute(ctx context.Context, req LoginRequest) (*LoginResponse, error) {
@@ -57,6 +60,11 @@ func (uc *LoginUseCase) Execute(ctx context.Context, req LoginRequest) (*LoginRe
 		return nil, pkg_errors.ErrUnauthorized
 	}
 
+	// Los usuarios eliminados no pueden iniciar sesión
+	if user.Archived {
+		return nil, pkg_errors.ErrUnauthorized
+	}
+
 	// Obtener tenant
 	tenant, err := uc.tenantRepo.FindByID(ctx, user.TenantID)
 	if err != nil {
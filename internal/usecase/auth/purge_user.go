@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type PurgeUserUseCase struct {
+	userRepo repositories.UserRepository
+}
+
+func NewPurgeUserUseCase(userRepo repositories.UserRepository) *PurgeUserUseCase {
+	return &PurgeUserUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute borra definitivamente un usuario ya eliminado; se rechaza con ErrInUse si registró,
+// revisó o aprobó algún documento
+func (uc *PurgeUserUseCase) Execute(ctx context.Context, tenantID, userID int) error {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil || user.TenantID != tenantID {
+		return pkg_errors.ErrNotFound
+	}
+
+	if !user.Archived {
+		return fmt.Errorf("solo se pueden purgar usuarios eliminados")
+	}
+
+	refs, err := uc.userRepo.CountReferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if refs.Total() > 0 {
+		return fmt.Errorf("%w: el usuario %s tiene %s", pkg_errors.ErrInUse, user.Email, refs)
+	}
+
+	return uc.userRepo.Purge(ctx, userID)
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type RestoreUserUseCase struct {
+	userRepo repositories.UserRepository
+}
+
+func NewRestoreUserUseCase(userRepo repositories.UserRepository) *RestoreUserUseCase {
+	return &RestoreUserUseCase{
+		userRepo: userRepo,
+	}
+}
+
+func (uc *RestoreUserUseCase) Execute(ctx context.Context, tenantID, userID int) (*UserDTO, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil || user.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	if !user.Archived {
+		return nil, fmt.Errorf("el usuario no está eliminado")
+	}
+
+	if err := uc.userRepo.Restore(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	user, err = uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := convertUserToDTO(user)
+	return &dto, nil
+}
@@ -0,0 +1,29 @@
+package currency
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type DeleteExchangeRateUseCase struct {
+	exchangeRateRepo repositories.ExchangeRateRepository
+}
+
+func NewDeleteExchangeRateUseCase(exchangeRateRepo repositories.ExchangeRateRepository) *DeleteExchangeRateUseCase {
+	return &DeleteExchangeRateUseCase{
+		exchangeRateRepo: exchangeRateRepo,
+	}
+}
+
+// Execute borra una tasa cargada por error. Los documentos guardan su propia tasa, así que
+// borrarla no cambia facturas ya registradas.
+func (uc *DeleteExchangeRateUseCase) Execute(ctx context.Context, tenantID, rateID int) error {
+	rate, err := uc.exchangeRateRepo.FindByID(ctx, rateID)
+	if err != nil || rate.TenantID != tenantID {
+		return pkg_errors.ErrNotFound
+	}
+
+	return uc.exchangeRateRepo.Delete(ctx, rateID)
+}
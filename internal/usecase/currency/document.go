@@ -0,0 +1,125 @@
+package currency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
+)
+
+// Document es la moneda de una factura o una compra y la tasa (unidades de la moneda base por
+// unidad de la moneda del documento) con que se convierten precios, costos y totales
+type Document struct {
+	Currency money.Currency
+	Base     money.Currency
+	Rate     float64
+}
+
+// Foreign indica que el documento no está en la moneda base del tenant
+func (d Document) Foreign() bool {
+	return d.Currency.Code != d.Base.Code
+}
+
+// ToDocument pasa un importe en moneda base, como un precio del catálogo, a la moneda del
+// documento; conserva los cuatro decimales para que lo redondee quien lo use
+func (d Document) ToDocument(amount money.Amount) money.Amount {
+	if !d.Foreign() {
+		return amount
+	}
+	return amount.Div(d.Rate)
+}
+
+// ToBase pasa un importe del documento a la moneda base, redondeado a sus centavos
+func (d Document) ToBase(amount money.Amount) money.Amount {
+	if !d.Foreign() {
+		return amount
+	}
+	return amount.Exchange(d.Rate, d.Base)
+}
+
+// ToBaseCost pasa un costo unitario a la moneda base conservando los cuatro decimales, como el
+// costo promedio del inventario
+func (d Document) ToBaseCost(cost money.Amount) money.Amount {
+	if !d.Foreign() {
+		return cost
+	}
+	return cost.Mul(d.Rate)
+}
+
+// Totals arma la moneda del documento con sus totales en moneda base
+func (d Document) Totals(subtotal, taxTotal, total money.Amount) repositories.DocumentCurrency {
+	result := repositories.DocumentCurrency{
+		Currency:     d.Currency.Code,
+		ExchangeRate: d.Rate,
+		BaseSubtotal: subtotal,
+		BaseTaxTotal: taxTotal,
+		BaseTotal:    total,
+	}
+	if d.Foreign() {
+		result.BaseSubtotal = d.ToBase(subtotal)
+		result.BaseTaxTotal = d.ToBase(taxTotal)
+		result.BaseTotal = result.BaseSubtotal + result.BaseTaxTotal
+	}
+	return result
+}
+
+// TenantCurrency es la moneda base del tenant; los tenants con un código desconocido usan la
+// moneda por defecto
+func TenantCurrency(tenant *ent.Tenant) money.Currency {
+	if currency, ok := money.Lookup(tenant.BaseCurrency); ok {
+		return currency
+	}
+	return money.Default
+}
+
+// Resolve elige la moneda de un documento: la base si no se indica otra. Una moneda extranjera
+// usa la tasa enviada o, si no se envía, la vigente en la fecha según la tabla de tasas del
+// tenant.
+func Resolve(ctx context.Context, exchangeRateRepo repositories.ExchangeRateRepository, tenant *ent.Tenant, code string, override *float64, date time.Time) (Document, error) {
+	base := TenantCurrency(tenant)
+	result := Document{Currency: base, Base: base, Rate: 1}
+	if code == "" {
+		return result, nil
+	}
+
+	currency, ok := money.Lookup(code)
+	if !ok {
+		return result, fmt.Errorf("moneda desconocida %q", code)
+	}
+	result.Currency = currency
+	if !result.Foreign() {
+		return result, nil
+	}
+
+	rate, err := ExchangeRateFor(ctx, exchangeRateRepo, tenant.ID, currency.Code, override, date)
+	if err != nil {
+		return result, err
+	}
+	result.Rate = rate
+
+	return result, nil
+}
+
+// ExchangeRateFor devuelve la tasa enviada en la petición o la vigente en la fecha
+func ExchangeRateFor(ctx context.Context, exchangeRateRepo repositories.ExchangeRateRepository, tenantID int, currency string, override *float64, date time.Time) (float64, error) {
+	if override != nil {
+		if *override <= 0 {
+			return 0, fmt.Errorf("la tasa de cambio debe ser mayor a 0")
+		}
+		return *override, nil
+	}
+
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	rate, err := exchangeRateRepo.FindEffective(ctx, tenantID, currency, day)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, fmt.Errorf("no hay tasa de cambio de %s al %s: regístrela o envíe exchangeRate", currency, day.Format("2006-01-02"))
+		}
+		return 0, fmt.Errorf("error al consultar la tasa de cambio: %v", err)
+	}
+
+	return rate.Rate, nil
+}
@@ -0,0 +1,112 @@
+package currency
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type ImportExchangeRatesUseCase struct {
+	exchangeRateRepo repositories.ExchangeRateRepository
+	tenantRepo       repositories.TenantRepository
+}
+
+func NewImportExchangeRatesUseCase(exchangeRateRepo repositories.ExchangeRateRepository, tenantRepo repositories.TenantRepository) *ImportExchangeRatesUseCase {
+	return &ImportExchangeRatesUseCase{
+		exchangeRateRepo: exchangeRateRepo,
+		tenantRepo:       tenantRepo,
+	}
+}
+
+type ImportExchangeRatesResult struct {
+	Created int      `json:"created"`
+	Updated int      `json:"updated"`
+	Errors  []string `json:"errors"`
+}
+
+// Execute carga tasas desde un CSV con encabezado date,currency,rate (una fila por día y
+// moneda). Las filas con error se informan y no detienen la importación; una tasa que ya
+// existía para ese día se reemplaza.
+func (uc *ImportExchangeRatesUseCase) Execute(ctx context.Context, tenantID, userID int, reader io.Reader) (*ImportExchangeRatesResult, error) {
+	tenant, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	csvReader := csv.NewReader(reader)
+	csvReader.Comment = '#'
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("el archivo no tiene encabezado: %v", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"date", "currency", "rate"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("falta la columna %s: el encabezado debe ser date,currency,rate", required)
+		}
+	}
+
+	result := &ImportExchangeRatesResult{Errors: []string{}}
+	line := 1
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("línea %d: %v", line, err))
+			continue
+		}
+
+		field := func(name string) string {
+			if i := columns[name]; i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		rate, err := strconv.ParseFloat(field("rate"), 64)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("línea %d: tasa inválida %q", line, field("rate")))
+			continue
+		}
+
+		data, err := validateExchangeRate(tenant.BaseCurrency, ExchangeRateRequest{
+			Currency: field("currency"),
+			Date:     field("date"),
+			Rate:     rate,
+		})
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("línea %d: %v", line, err))
+			continue
+		}
+		data.Source = sourceCSV
+		data.UserID = userID
+
+		_, created, err := uc.exchangeRateRepo.Save(ctx, tenantID, data)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("línea %d: %v", line, err))
+			continue
+		}
+		if created {
+			result.Created++
+		} else {
+			result.Updated++
+		}
+	}
+
+	return result, nil
+}
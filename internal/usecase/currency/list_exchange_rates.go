@@ -0,0 +1,122 @@
+package currency
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+)
+
+type ListExchangeRatesUseCase struct {
+	exchangeRateRepo repositories.ExchangeRateRepository
+	tenantRepo       repositories.TenantRepository
+}
+
+func NewListExchangeRatesUseCase(exchangeRateRepo repositories.ExchangeRateRepository, tenantRepo repositories.TenantRepository) *ListExchangeRatesUseCase {
+	return &ListExchangeRatesUseCase{
+		exchangeRateRepo: exchangeRateRepo,
+		tenantRepo:       tenantRepo,
+	}
+}
+
+type ListExchangeRatesRequest struct {
+	Currency  string `json:"currency"`
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+}
+
+type ExchangeRateDTO struct {
+	ID       int    `json:"id"`
+	Currency string `json:"currency"`
+	Date     string `json:"date"`
+	// Rate son las unidades de la moneda base por una unidad de Currency
+	Rate      float64 `json:"rate"`
+	Source    string  `json:"source"`
+	UserID    *int    `json:"userId,omitempty"`
+	CreatedAt string  `json:"createdAt"`
+	UpdatedAt string  `json:"updatedAt"`
+}
+
+type CurrencySettingsDTO struct {
+	// BaseCurrency es la moneda de los precios, los costos y los reportes del tenant
+	BaseCurrency string `json:"baseCurrency"`
+}
+
+type ListExchangeRatesResponse struct {
+	Rates    []ExchangeRateDTO   `json:"rates"`
+	Settings CurrencySettingsDTO `json:"settings"`
+}
+
+func (uc *ListExchangeRatesUseCase) Execute(ctx context.Context, tenantID int, req ListExchangeRatesRequest) (*ListExchangeRatesResponse, error) {
+	tenant, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	code := ""
+	if req.Currency != "" {
+		currency, ok := money.Lookup(req.Currency)
+		if !ok {
+			return nil, fmt.Errorf("moneda desconocida %q", req.Currency)
+		}
+		code = currency.Code
+	}
+
+	var from, to *time.Time
+	if req.StartDate != "" {
+		date, err := parseDate(req.StartDate)
+		if err != nil {
+			return nil, err
+		}
+		from = &date
+	}
+	if req.EndDate != "" {
+		date, err := parseDate(req.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		to = &date
+	}
+
+	rates, err := uc.exchangeRateRepo.FindAll(ctx, tenantID, code, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListExchangeRatesResponse{
+		Rates:    make([]ExchangeRateDTO, len(rates)),
+		Settings: CurrencySettingsDTO{BaseCurrency: tenant.BaseCurrency},
+	}
+	for i, rate := range rates {
+		response.Rates[i] = convertExchangeRateToDTO(rate)
+	}
+
+	return response, nil
+}
+
+func convertExchangeRateToDTO(rate *ent.ExchangeRate) ExchangeRateDTO {
+	return ExchangeRateDTO{
+		ID:        rate.ID,
+		Currency:  rate.Currency,
+		Date:      rate.Date.Format("2006-01-02"),
+		Rate:      rate.Rate,
+		Source:    rate.Source,
+		UserID:    rate.UserID,
+		CreatedAt: rate.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: rate.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// parseDate lee una fecha AAAA-MM-DD; las tasas se guardan por día, a medianoche UTC
+func parseDate(value string) (time.Time, error) {
+	date, err := time.Parse("2006-01-02", strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("fecha inválida %q: use el formato AAAA-MM-DD", value)
+	}
+	return date, nil
+}
@@ -0,0 +1,84 @@
+package currency
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+)
+
+const (
+	sourceManual = "manual"
+	sourceCSV    = "csv"
+)
+
+type SetExchangeRateUseCase struct {
+	exchangeRateRepo repositories.ExchangeRateRepository
+	tenantRepo       repositories.TenantRepository
+}
+
+func NewSetExchangeRateUseCase(exchangeRateRepo repositories.ExchangeRateRepository, tenantRepo repositories.TenantRepository) *SetExchangeRateUseCase {
+	return &SetExchangeRateUseCase{
+		exchangeRateRepo: exchangeRateRepo,
+		tenantRepo:       tenantRepo,
+	}
+}
+
+type ExchangeRateRequest struct {
+	Currency string `json:"currency"`
+	// Date es el día desde el que rige la tasa, AAAA-MM-DD
+	Date string  `json:"date"`
+	Rate float64 `json:"rate"`
+}
+
+// Execute registra la tasa del día; si la moneda ya tenía tasa ese día la reemplaza. Los
+// documentos ya registrados conservan la tasa con que se crearon.
+func (uc *SetExchangeRateUseCase) Execute(ctx context.Context, tenantID, userID int, req ExchangeRateRequest) (*ExchangeRateDTO, error) {
+	tenant, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	data, err := validateExchangeRate(tenant.BaseCurrency, req)
+	if err != nil {
+		return nil, err
+	}
+	data.Source = sourceManual
+	data.UserID = userID
+
+	rate, _, err := uc.exchangeRateRepo.Save(ctx, tenantID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := convertExchangeRateToDTO(rate)
+	return &dto, nil
+}
+
+// validateExchangeRate normaliza la moneda y la fecha y rechaza tasas de la moneda base
+func validateExchangeRate(baseCurrency string, req ExchangeRateRequest) (repositories.ExchangeRateData, error) {
+	currency, ok := money.Lookup(req.Currency)
+	if !ok {
+		return repositories.ExchangeRateData{}, fmt.Errorf("moneda desconocida %q", req.Currency)
+	}
+	if currency.Code == baseCurrency {
+		return repositories.ExchangeRateData{}, fmt.Errorf("%s es la moneda base del tenant y no lleva tasa de cambio", currency.Code)
+	}
+
+	date, err := parseDate(req.Date)
+	if err != nil {
+		return repositories.ExchangeRateData{}, err
+	}
+
+	if req.Rate <= 0 {
+		return repositories.ExchangeRateData{}, fmt.Errorf("la tasa de cambio debe ser mayor a 0")
+	}
+
+	return repositories.ExchangeRateData{
+		Currency: currency.Code,
+		Date:     date,
+		Rate:     req.Rate,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package currency
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+)
+
+type UpdateCurrencySettingsUseCase struct {
+	tenantRepo          repositories.TenantRepository
+	invoiceRepo         repositories.InvoiceRepository
+	purchaseInvoiceRepo repositories.PurchaseInvoiceRepository
+}
+
+func NewUpdateCurrencySettingsUseCase(
+	tenantRepo repositories.TenantRepository,
+	invoiceRepo repositories.InvoiceRepository,
+	purchaseInvoiceRepo repositories.PurchaseInvoiceRepository,
+) *UpdateCurrencySettingsUseCase {
+	return &UpdateCurrencySettingsUseCase{
+		tenantRepo:          tenantRepo,
+		invoiceRepo:         invoiceRepo,
+		purchaseInvoiceRepo: purchaseInvoiceRepo,
+	}
+}
+
+// Execute cambia la moneda base del tenant. Solo se permite antes de registrar ventas o
+// compras: los precios, costos y totales en moneda base ya guardados no se convierten.
+func (uc *UpdateCurrencySettingsUseCase) Execute(ctx context.Context, tenantID int, req CurrencySettingsDTO) (*CurrencySettingsDTO, error) {
+	currency, ok := money.Lookup(req.BaseCurrency)
+	if !ok {
+		return nil, fmt.Errorf("moneda desconocida %q", req.BaseCurrency)
+	}
+
+	tenant, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+	if tenant.BaseCurrency == currency.Code {
+		return &CurrencySettingsDTO{BaseCurrency: currency.Code}, nil
+	}
+
+	invoices, err := uc.invoiceRepo.CountByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	_, purchases, err := uc.purchaseInvoiceRepo.FindAll(ctx, tenantID, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	if invoices > 0 || purchases > 0 {
+		return nil, fmt.Errorf("%w: el tenant ya tiene facturas en %s y su moneda base no se puede cambiar", pkg_errors.ErrInvalidState, tenant.BaseCurrency)
+	}
+
+	if err := uc.tenantRepo.SetBaseCurrency(ctx, tenantID, currency.Code); err != nil {
+		return nil, err
+	}
+
+	return &CurrencySettingsDTO{BaseCurrency: currency.Code}, nil
+}
@@ -0,0 +1,191 @@
+package customer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/validator"
+)
+
+type CreateCustomerUseCase struct {
+	customerRepo  repositories.CustomerRepository
+	priceListRepo repositories.PriceListRepository
+}
+
+func NewCreateCustomerUseCase(customerRepo repositories.CustomerRepository, priceListRepo repositories.PriceListRepository) *CreateCustomerUseCase {
+	return &CreateCustomerUseCase{
+		customerRepo:  customerRepo,
+		priceListRepo: priceListRepo,
+	}
+}
+
+type CreateCustomerRequest struct {
+	Name           string  `json:"name"`
+	DocumentType   *string `json:"documentType,omitempty"`
+	DocumentNumber *string `json:"documentNumber,omitempty"`
+	Email          *string `json:"email,omitempty"`
+	Phone          *string `json:"phone,omitempty"`
+	Address        *string `json:"address,omitempty"`
+	TaxRegime      *string `json:"taxRegime,omitempty"`
+	// CustomerGroup agrupa clientes para las promociones (mayorista, VIP, ...)
+	CustomerGroup *string `json:"customerGroup,omitempty"`
+	// PriceListID es la lista de precios que se aplica a sus facturas si no se elige otra
+	PriceListID *int `json:"priceListId,omitempty"`
+}
+
+type CustomerDTO struct {
+	ID             int     `json:"id"`
+	Name           string  `json:"name"`
+	DocumentType   *string `json:"documentType,omitempty"`
+	DocumentNumber *string `json:"documentNumber,omitempty"`
+	Email          *string `json:"email,omitempty"`
+	Phone          *string `json:"phone,omitempty"`
+	Address        *string `json:"address,omitempty"`
+	TaxRegime      *string `json:"taxRegime,omitempty"`
+	CustomerGroup  *string `json:"customerGroup,omitempty"`
+	PriceListID    *int    `json:"priceListId,omitempty"`
+	TenantID       int     `json:"tenantId"`
+	Archived       bool    `json:"archived"`
+	DeletedAt      *string `json:"deletedAt,omitempty"`
+	CreatedAt      string  `json:"createdAt"`
+	UpdatedAt      string  `json:"updatedAt"`
+}
+
+func (uc *CreateCustomerUseCase) Execute(ctx context.Context, tenantID int, req CreateCustomerRequest) (*CustomerDTO, error) {
+	data := repositories.CustomerData{
+		Name:           req.Name,
+		DocumentType:   valueOf(req.DocumentType),
+		DocumentNumber: valueOf(req.DocumentNumber),
+		Email:          valueOf(req.Email),
+		Phone:          valueOf(req.Phone),
+		Address:        valueOf(req.Address),
+		TaxRegime:      valueOf(req.TaxRegime),
+		CustomerGroup:  valueOf(req.CustomerGroup),
+		PriceListID:    req.PriceListID,
+	}
+
+	data, err := validateCustomer(ctx, uc.customerRepo, tenantID, 0, data)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePriceList(ctx, uc.priceListRepo, tenantID, data.PriceListID); err != nil {
+		return nil, err
+	}
+
+	customer, err := uc.customerRepo.Create(ctx, tenantID, data)
+	if err != nil {
+		return nil, fmt.Errorf("error al crear cliente: %v", err)
+	}
+
+	return convertCustomerToDTO(customer), nil
+}
+
+// validateCustomer limpia y valida los datos del cliente. El número de documento se normaliza
+// para que "900.123.456-7" y "9001234567" se reconozcan como el mismo cliente, y se rechaza si
+// ya lo tiene otro cliente del tenant, aunque esté eliminado.
+func validateCustomer(ctx context.Context, customerRepo repositories.CustomerRepository, tenantID, customerID int, data repositories.CustomerData) (repositories.CustomerData, error) {
+	data.Name = validator.SanitizeString(data.Name)
+	data.DocumentType = strings.ToUpper(validator.SanitizeString(data.DocumentType))
+	data.DocumentNumber = normalizeDocument(data.DocumentNumber)
+	data.Email = validator.SanitizeString(data.Email)
+	data.Phone = validator.SanitizeString(data.Phone)
+	data.Address = validator.SanitizeString(data.Address)
+	data.TaxRegime = validator.SanitizeString(data.TaxRegime)
+	data.CustomerGroup = strings.ToLower(validator.SanitizeString(data.CustomerGroup))
+
+	if data.Name == "" {
+		return data, fmt.Errorf("el nombre del cliente es obligatorio")
+	}
+	if data.Email != "" && !validator.ValidateEmail(data.Email) {
+		return data, fmt.Errorf("el email %s no es válido", data.Email)
+	}
+	if data.DocumentType != "" && data.DocumentNumber == "" {
+		return data, fmt.Errorf("el número de documento es obligatorio cuando se indica el tipo")
+	}
+
+	if data.DocumentNumber != "" {
+		existing, err := customerRepo.FindByDocument(ctx, tenantID, data.DocumentNumber)
+		if err != nil && !ent.IsNotFound(err) {
+			return data, fmt.Errorf("error al consultar clientes: %v", err)
+		}
+		if existing != nil && existing.ID != customerID {
+			if existing.Archived {
+				return data, fmt.Errorf("%w: el documento %s pertenece al cliente eliminado %s (ID %d); restáurelo en vez de crearlo de nuevo", pkg_errors.ErrAlreadyExists, data.DocumentNumber, existing.Name, existing.ID)
+			}
+			return data, fmt.Errorf("%w: el documento %s ya está registrado para el cliente %s (ID %d)", pkg_errors.ErrAlreadyExists, data.DocumentNumber, existing.Name, existing.ID)
+		}
+	}
+
+	return data, nil
+}
+
+// validatePriceList comprueba que la lista de precios asignada al cliente sea del tenant y esté activa
+func validatePriceList(ctx context.Context, priceListRepo repositories.PriceListRepository, tenantID int, priceListID *int) error {
+	if priceListID == nil {
+		return nil
+	}
+
+	list, err := priceListRepo.FindByID(ctx, *priceListID)
+	if err != nil || list.TenantID != tenantID {
+		return fmt.Errorf("lista de precios con ID %d no encontrada", *priceListID)
+	}
+	if !list.Active {
+		return fmt.Errorf("la lista de precios %s está inactiva", list.Name)
+	}
+	return nil
+}
+
+// normalizeDocument deja solo letras y dígitos en mayúscula
+func normalizeDocument(document string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(document) {
+		if (r >= '0' && r <= '9') || (r >= 'A' && r <= 'Z') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func valueOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func convertCustomerToDTO(customer *ent.Customer) *CustomerDTO {
+	var deletedAt *string
+	if customer.DeletedAt != nil {
+		formatted := customer.DeletedAt.Format("2006-01-02T15:04:05Z07:00")
+		deletedAt = &formatted
+	}
+
+	return &CustomerDTO{
+		ID:             customer.ID,
+		Name:           customer.Name,
+		DocumentType:   optionalString(customer.DocumentType),
+		DocumentNumber: optionalString(customer.DocumentNumber),
+		Email:          optionalString(customer.Email),
+		Phone:          optionalString(customer.Phone),
+		Address:        optionalString(customer.Address),
+		TaxRegime:      optionalString(customer.TaxRegime),
+		CustomerGroup:  optionalString(customer.CustomerGroup),
+		PriceListID:    customer.PriceListID,
+		TenantID:       customer.TenantID,
+		Archived:       customer.Archived,
+		DeletedAt:      deletedAt,
+		CreatedAt:      customer.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      customer.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
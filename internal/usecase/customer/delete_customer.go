@@ -0,0 +1,29 @@
+package customer
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type DeleteCustomerUseCase struct {
+	customerRepo repositories.CustomerRepository
+}
+
+func NewDeleteCustomerUseCase(customerRepo repositories.CustomerRepository) *DeleteCustomerUseCase {
+	return &DeleteCustomerUseCase{
+		customerRepo: customerRepo,
+	}
+}
+
+// Execute elimina el cliente de forma lógica: deja de aparecer en el listado y no se le puede
+// facturar, pero sus facturas lo siguen mostrando
+func (uc *DeleteCustomerUseCase) Execute(ctx context.Context, tenantID, customerID int) error {
+	customer, err := uc.customerRepo.FindByID(ctx, customerID)
+	if err != nil || customer.TenantID != tenantID || customer.Archived {
+		return pkg_errors.ErrNotFound
+	}
+
+	return uc.customerRepo.Archive(ctx, customerID)
+}
@@ -0,0 +1,27 @@
+package customer
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type GetCustomerUseCase struct {
+	customerRepo repositories.CustomerRepository
+}
+
+func NewGetCustomerUseCase(customerRepo repositories.CustomerRepository) *GetCustomerUseCase {
+	return &GetCustomerUseCase{
+		customerRepo: customerRepo,
+	}
+}
+
+func (uc *GetCustomerUseCase) Execute(ctx context.Context, tenantID, customerID int) (*CustomerDTO, error) {
+	customer, err := uc.customerRepo.FindByID(ctx, customerID)
+	if err != nil || customer.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	return convertCustomerToDTO(customer), nil
+}
@@ -0,0 +1,92 @@
+package customer
+
+import (
+	"context"
+	"strconv"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+)
+
+type ListCustomerInvoicesUseCase struct {
+	customerRepo repositories.CustomerRepository
+	invoiceRepo  repositories.InvoiceRepository
+}
+
+func NewListCustomerInvoicesUseCase(customerRepo repositories.CustomerRepository, invoiceRepo repositories.InvoiceRepository) *ListCustomerInvoicesUseCase {
+	return &ListCustomerInvoicesUseCase{
+		customerRepo: customerRepo,
+		invoiceRepo:  invoiceRepo,
+	}
+}
+
+type ListCustomerInvoicesRequest struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+}
+
+type CustomerInvoiceDTO struct {
+	ID        int          `json:"id"`
+	Number    string       `json:"number"`
+	Total     money.Amount `json:"total"`
+	Status    string       `json:"status"`
+	UserID    int          `json:"userId"`
+	CreatedAt string       `json:"createdAt"`
+}
+
+type CustomerInvoicesResponse struct {
+	Customer CustomerDTO          `json:"customer"`
+	Invoices []CustomerInvoiceDTO `json:"invoices"`
+	Total    int                  `json:"total"`
+	Page     int                  `json:"page"`
+	Limit    int                  `json:"limit"`
+}
+
+// Execute lista las compras del cliente, incluidas las canceladas y anuladas; también
+// funciona con clientes eliminados
+func (uc *ListCustomerInvoicesUseCase) Execute(ctx context.Context, tenantID, customerID int, req ListCustomerInvoicesRequest) (*CustomerInvoicesResponse, error) {
+	customer, err := uc.customerRepo.FindByID(ctx, customerID)
+	if err != nil || customer.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 || req.Limit > 100 {
+		req.Limit = 20
+	}
+
+	offset := (req.Page - 1) * req.Limit
+
+	invoices, total, err := uc.invoiceRepo.FindByCustomer(ctx, customerID, req.Limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceDTOs := make([]CustomerInvoiceDTO, len(invoices))
+	for i, inv := range invoices {
+		// Las facturas anteriores a las series de numeración se identificaban con su ID
+		number := strconv.Itoa(inv.ID)
+		if inv.Number != nil {
+			number = *inv.Number
+		}
+		invoiceDTOs[i] = CustomerInvoiceDTO{
+			ID:        inv.ID,
+			Number:    number,
+			Total:     inv.Total,
+			Status:    inv.Status,
+			UserID:    inv.UserID,
+			CreatedAt: inv.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
+	return &CustomerInvoicesResponse{
+		Customer: *convertCustomerToDTO(customer),
+		Invoices: invoiceDTOs,
+		Total:    total,
+		Page:     req.Page,
+		Limit:    req.Limit,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package customer
+
+import (
+	"context"
+	"strings"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type ListCustomersUseCase struct {
+	customerRepo repositories.CustomerRepository
+}
+
+func NewListCustomersUseCase(customerRepo repositories.CustomerRepository) *ListCustomersUseCase {
+	return &ListCustomersUseCase{
+		customerRepo: customerRepo,
+	}
+}
+
+type ListCustomersRequest struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+	// Search busca por nombre, número de documento o email
+	Search string `json:"search"`
+	// Archived lista los clientes eliminados en vez de los activos
+	Archived bool `json:"archived"`
+}
+
+type CustomersListResponse struct {
+	Customers []CustomerDTO `json:"customers"`
+	Total     int           `json:"total"`
+	Page      int           `json:"page"`
+	Limit     int           `json:"limit"`
+}
+
+func (uc *ListCustomersUseCase) Execute(ctx context.Context, tenantID int, req ListCustomersRequest) (*CustomersListResponse, error) {
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 || req.Limit > 100 {
+		req.Limit = 20
+	}
+
+	offset := (req.Page - 1) * req.Limit
+
+	// Un documento escrito con puntos o guiones también encuentra al cliente
+	search := strings.TrimSpace(req.Search)
+	filter := repositories.CustomerFilter{
+		Archived:       req.Archived,
+		Search:         search,
+		DocumentNumber: normalizeDocument(search),
+	}
+
+	customers, total, err := uc.customerRepo.FindAll(ctx, tenantID, filter, req.Limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	customerDTOs := make([]CustomerDTO, len(customers))
+	for i, customer := range customers {
+		customerDTOs[i] = *convertCustomerToDTO(customer)
+	}
+
+	return &CustomersListResponse{
+		Customers: customerDTOs,
+		Total:     total,
+		Page:      req.Page,
+		Limit:     req.Limit,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package customer
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type PurgeCustomerUseCase struct {
+	customerRepo repositories.CustomerRepository
+}
+
+func NewPurgeCustomerUseCase(customerRepo repositories.CustomerRepository) *PurgeCustomerUseCase {
+	return &PurgeCustomerUseCase{
+		customerRepo: customerRepo,
+	}
+}
+
+// Execute borra definitivamente un cliente ya eliminado; se rechaza con ErrInUse si tiene
+// facturas emitidas
+func (uc *PurgeCustomerUseCase) Execute(ctx context.Context, tenantID, customerID int) error {
+	customer, err := uc.customerRepo.FindByID(ctx, customerID)
+	if err != nil || customer.TenantID != tenantID {
+		return pkg_errors.ErrNotFound
+	}
+
+	if !customer.Archived {
+		return fmt.Errorf("solo se pueden purgar clientes eliminados")
+	}
+
+	refs, err := uc.customerRepo.CountReferences(ctx, customerID)
+	if err != nil {
+		return err
+	}
+	if refs.Total() > 0 {
+		return fmt.Errorf("%w: el cliente %s tiene %s", pkg_errors.ErrInUse, customer.Name, refs)
+	}
+
+	return uc.customerRepo.Purge(ctx, customerID)
+}
@@ -0,0 +1,41 @@
+package customer
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type RestoreCustomerUseCase struct {
+	customerRepo repositories.CustomerRepository
+}
+
+func NewRestoreCustomerUseCase(customerRepo repositories.CustomerRepository) *RestoreCustomerUseCase {
+	return &RestoreCustomerUseCase{
+		customerRepo: customerRepo,
+	}
+}
+
+func (uc *RestoreCustomerUseCase) Execute(ctx context.Context, tenantID, customerID int) (*CustomerDTO, error) {
+	customer, err := uc.customerRepo.FindByID(ctx, customerID)
+	if err != nil || customer.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	if !customer.Archived {
+		return nil, fmt.Errorf("el cliente no está eliminado")
+	}
+
+	if err := uc.customerRepo.Restore(ctx, customerID); err != nil {
+		return nil, err
+	}
+
+	customer, err = uc.customerRepo.FindByID(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertCustomerToDTO(customer), nil
+}
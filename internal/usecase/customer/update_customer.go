@@ -0,0 +1,101 @@
+package customer
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type UpdateCustomerUseCase struct {
+	customerRepo  repositories.CustomerRepository
+	priceListRepo repositories.PriceListRepository
+}
+
+func NewUpdateCustomerUseCase(customerRepo repositories.CustomerRepository, priceListRepo repositories.PriceListRepository) *UpdateCustomerUseCase {
+	return &UpdateCustomerUseCase{
+		customerRepo:  customerRepo,
+		priceListRepo: priceListRepo,
+	}
+}
+
+// UpdateCustomerRequest cambia solo los campos enviados; un texto vacío borra el valor y
+// PriceListID 0 quita la lista de precios del cliente
+type UpdateCustomerRequest struct {
+	Name           *string `json:"name,omitempty"`
+	DocumentType   *string `json:"documentType,omitempty"`
+	DocumentNumber *string `json:"documentNumber,omitempty"`
+	Email          *string `json:"email,omitempty"`
+	Phone          *string `json:"phone,omitempty"`
+	Address        *string `json:"address,omitempty"`
+	TaxRegime      *string `json:"taxRegime,omitempty"`
+	CustomerGroup  *string `json:"customerGroup,omitempty"`
+	PriceListID    *int    `json:"priceListId,omitempty"`
+}
+
+func (uc *UpdateCustomerUseCase) Execute(ctx context.Context, tenantID, customerID int, req UpdateCustomerRequest) (*CustomerDTO, error) {
+	existing, err := uc.customerRepo.FindByID(ctx, customerID)
+	if err != nil || existing.TenantID != tenantID || existing.Archived {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	data := repositories.CustomerData{
+		Name:           existing.Name,
+		DocumentType:   existing.DocumentType,
+		DocumentNumber: existing.DocumentNumber,
+		Email:          existing.Email,
+		Phone:          existing.Phone,
+		Address:        existing.Address,
+		TaxRegime:      existing.TaxRegime,
+		CustomerGroup:  existing.CustomerGroup,
+		PriceListID:    existing.PriceListID,
+	}
+
+	if req.Name != nil {
+		data.Name = *req.Name
+	}
+	if req.DocumentType != nil {
+		data.DocumentType = *req.DocumentType
+	}
+	if req.DocumentNumber != nil {
+		data.DocumentNumber = *req.DocumentNumber
+	}
+	if req.Email != nil {
+		data.Email = *req.Email
+	}
+	if req.Phone != nil {
+		data.Phone = *req.Phone
+	}
+	if req.Address != nil {
+		data.Address = *req.Address
+	}
+	if req.TaxRegime != nil {
+		data.TaxRegime = *req.TaxRegime
+	}
+	if req.CustomerGroup != nil {
+		data.CustomerGroup = *req.CustomerGroup
+	}
+	if req.PriceListID != nil {
+		if *req.PriceListID == 0 {
+			data.PriceListID = nil
+		} else {
+			data.PriceListID = req.PriceListID
+			if err := validatePriceList(ctx, uc.priceListRepo, tenantID, data.PriceListID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	data, err = validateCustomer(ctx, uc.customerRepo, tenantID, customerID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	customer, err := uc.customerRepo.Update(ctx, customerID, data)
+	if err != nil {
+		return nil, fmt.Errorf("error al actualizar cliente: %v", err)
+	}
+
+	return convertCustomerToDTO(customer), nil
+}
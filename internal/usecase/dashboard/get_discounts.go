@@ -0,0 +1,113 @@
+package dashboard
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
+)
+
+type GetDiscountsUseCase struct {
+	invoiceRepo repositories.InvoiceRepository
+	userRepo    repositories.UserRepository
+}
+
+func NewGetDiscountsUseCase(invoiceRepo repositories.InvoiceRepository, userRepo repositories.UserRepository) *GetDiscountsUseCase {
+	return &GetDiscountsUseCase{
+		invoiceRepo: invoiceRepo,
+		userRepo:    userRepo,
+	}
+}
+
+type DiscountRequest struct {
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+}
+
+type UserDiscountDTO struct {
+	UserID   int    `json:"userId"`
+	UserName string `json:"userName"`
+	// Invoices son las facturas del vendedor y DiscountedInvoices las que llevan descuento
+	Invoices           int          `json:"invoices"`
+	DiscountedInvoices int          `json:"discountedInvoices"`
+	Sales              money.Amount `json:"sales"`
+	DiscountTotal      money.Amount `json:"discountTotal"`
+	// Overrides son las facturas cuyo descuento superó el límite del vendedor y fue autorizado
+	Overrides int `json:"overrides"`
+}
+
+type DiscountResponse struct {
+	StartDate     string            `json:"startDate"`
+	EndDate       string            `json:"endDate"`
+	DiscountTotal money.Amount      `json:"discountTotal"`
+	Users         []UserDiscountDTO `json:"users"`
+}
+
+// Execute suma los descuentos concedidos por cada vendedor en el período, sin las facturas
+// canceladas ni anuladas
+func (uc *GetDiscountsUseCase) Execute(ctx context.Context, tenantID int, req DiscountRequest) (*DiscountResponse, error) {
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		startDate = time.Now().AddDate(0, -1, 0)
+	}
+
+	endDate, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		endDate = time.Now()
+	} else {
+		endDate = endDate.AddDate(0, 0, 1)
+	}
+
+	invoices, err := uc.invoiceRepo.FindByDateRange(ctx, tenantID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DiscountResponse{
+		StartDate: startDate.Format("2006-01-02"),
+		EndDate:   req.EndDate,
+		Users:     []UserDiscountDTO{},
+	}
+	if response.EndDate == "" {
+		response.EndDate = endDate.Format("2006-01-02")
+	}
+
+	byUser := make(map[int]*UserDiscountDTO)
+	for _, inv := range invoices {
+		summary := byUser[inv.UserID]
+		if summary == nil {
+			summary = &UserDiscountDTO{UserID: inv.UserID}
+			byUser[inv.UserID] = summary
+		}
+
+		summary.Invoices++
+		// Los totales del reporte van en moneda base
+		summary.Sales += inv.BaseTotal
+		if inv.DiscountTotal > 0 {
+			discount := inv.DiscountTotal.Mul(inv.ExchangeRate)
+			summary.DiscountedInvoices++
+			summary.DiscountTotal += discount
+			response.DiscountTotal += discount
+		}
+		if inv.DiscountApprovedBy != nil {
+			summary.Overrides++
+		}
+	}
+
+	for userID, summary := range byUser {
+		if user, err := uc.userRepo.FindByID(ctx, userID); err == nil {
+			summary.UserName = user.Name
+		}
+		summary.Sales = roundMoney(summary.Sales)
+		summary.DiscountTotal = roundMoney(summary.DiscountTotal)
+		response.Users = append(response.Users, *summary)
+	}
+	sort.Slice(response.Users, func(i, j int) bool {
+		return response.Users[i].DiscountTotal > response.Users[j].DiscountTotal
+	})
+	response.DiscountTotal = roundMoney(response.DiscountTotal)
+
+	return response, nil
+}
@@ -0,0 +1,191 @@
+package dashboard
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+type GetMarginsUseCase struct {
+	invoiceRepo repositories.InvoiceRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewGetMarginsUseCase(invoiceRepo repositories.InvoiceRepository, productRepo repositories.ProductRepository) *GetMarginsUseCase {
+	return &GetMarginsUseCase{
+		invoiceRepo: invoiceRepo,
+		productRepo: productRepo,
+	}
+}
+
+type MarginRequest struct {
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+	// SplitBundles reparte las ventas de combos entre sus componentes según el reparto de cada combo
+	SplitBundles bool `json:"splitBundles"`
+}
+
+type ProductMarginDTO struct {
+	ProductID   int          `json:"productId"`
+	ProductName string       `json:"productName"`
+	SKU         string       `json:"sku"`
+	Quantity    float64      `json:"quantity"`
+	Revenue     money.Amount `json:"revenue"`
+	Cost        money.Amount `json:"cost"`
+	GrossMargin money.Amount `json:"grossMargin"`
+	MarginPct   float64      `json:"marginPct"`
+}
+
+type MarginResponse struct {
+	StartDate   string             `json:"startDate"`
+	EndDate     string             `json:"endDate"`
+	Revenue     money.Amount       `json:"revenue"`
+	Cost        money.Amount       `json:"cost"`
+	GrossMargin money.Amount       `json:"grossMargin"`
+	MarginPct   float64            `json:"marginPct"`
+	Products    []ProductMarginDTO `json:"products"`
+}
+
+// Execute calcula el margen bruto del período con el costo de lo vendido guardado en cada item
+func (uc *GetMarginsUseCase) Execute(ctx context.Context, tenantID int, req MarginRequest) (*MarginResponse, error) {
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		startDate = time.Now().AddDate(0, -1, 0)
+	}
+
+	endDate, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		endDate = time.Now()
+	} else {
+		endDate = endDate.AddDate(0, 0, 1)
+	}
+
+	items, err := uc.invoiceRepo.FindItemsByDateRange(ctx, tenantID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Las ventas en moneda extranjera se llevan a moneda base con la tasa de su factura; el
+	// costo y el ingreso de los componentes de combos ya se guardan en moneda base
+	invoices, err := uc.invoiceRepo.FindByDateRange(ctx, tenantID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	rates := make(map[int]float64, len(invoices))
+	for _, inv := range invoices {
+		rates[inv.ID] = inv.ExchangeRate
+	}
+
+	response := &MarginResponse{
+		StartDate: startDate.Format("2006-01-02"),
+		EndDate:   req.EndDate,
+		Products:  []ProductMarginDTO{},
+	}
+	if response.EndDate == "" {
+		response.EndDate = endDate.Format("2006-01-02")
+	}
+
+	components := make(map[int][]*ent.InvoiceItemComponent)
+	if req.SplitBundles {
+		sold, err := uc.invoiceRepo.FindComponentsByDateRange(ctx, tenantID, startDate, endDate)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range sold {
+			components[c.InvoiceItemID] = append(components[c.InvoiceItemID], c)
+		}
+	}
+
+	byProduct := make(map[int]*ProductMarginDTO)
+	add := func(productID int, quantity float64, revenue, cost money.Amount) {
+		margin := byProduct[productID]
+		if margin == nil {
+			margin = &ProductMarginDTO{ProductID: productID}
+			byProduct[productID] = margin
+		}
+		margin.Quantity = qty.Round(margin.Quantity + quantity)
+		margin.Revenue += revenue
+		margin.Cost += cost
+	}
+
+	for _, item := range items {
+		if parts, ok := components[item.ID]; ok {
+			for _, c := range parts {
+				add(c.ProductID, c.Quantity, c.Revenue, c.CostTotal)
+			}
+		} else {
+			add(item.ProductID, item.Quantity, baseRevenue(item, rates), item.CostTotal)
+		}
+
+		response.Revenue += baseRevenue(item, rates)
+		response.Cost += item.CostTotal
+	}
+
+	productIDs := make([]int, 0, len(byProduct))
+	for id := range byProduct {
+		productIDs = append(productIDs, id)
+	}
+	products, err := uc.productRepo.FindByIDsOrCategories(ctx, tenantID, productIDs, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range products {
+		byProduct[p.ID].ProductName = p.Name
+		byProduct[p.ID].SKU = p.Sku
+	}
+
+	for _, margin := range byProduct {
+		margin.GrossMargin, margin.MarginPct = grossMargin(margin.Revenue, margin.Cost)
+		margin.Revenue = roundMoney(margin.Revenue)
+		margin.Cost = roundMoney(margin.Cost)
+		response.Products = append(response.Products, *margin)
+	}
+	sort.Slice(response.Products, func(i, j int) bool {
+		return response.Products[i].GrossMargin > response.Products[j].GrossMargin
+	})
+
+	response.GrossMargin, response.MarginPct = grossMargin(response.Revenue, response.Cost)
+	response.Revenue = roundMoney(response.Revenue)
+	response.Cost = roundMoney(response.Cost)
+
+	return response, nil
+}
+
+// lineRevenue es el ingreso de la línea sin impuestos y después de descuentos. Las líneas de
+// antes de registrar impuestos no tienen base y su subtotal ya es el ingreso.
+func lineRevenue(item *ent.InvoiceItem) money.Amount {
+	if item.TaxBase == 0 && item.TaxAmount == 0 && item.PromotionDiscount == 0 && item.DiscountAmount == 0 && item.InvoiceDiscountAmount == 0 {
+		return item.Subtotal
+	}
+	return item.TaxBase
+}
+
+// baseRevenue es el ingreso de la línea en moneda base
+func baseRevenue(item *ent.InvoiceItem, rates map[int]float64) money.Amount {
+	revenue := lineRevenue(item)
+	if rate, ok := rates[item.InvoiceID]; ok && rate != 1 {
+		return revenue.Mul(rate)
+	}
+	return revenue
+}
+
+// grossMargin devuelve el margen bruto y su porcentaje sobre la venta
+func grossMargin(revenue, cost money.Amount) (money.Amount, float64) {
+	margin := revenue - cost
+	pct := 0.0
+	if revenue > 0 {
+		pct = math.Round(margin.Ratio(revenue)*10000) / 100
+	}
+	return roundMoney(margin), pct
+}
+
+// roundMoney redondea un importe a los centavos de la moneda
+func roundMoney(amount money.Amount) money.Amount {
+	return amount.Round(money.Default)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
 )
 
 type GetReportsUseCase struct {
@@ -24,9 +25,9 @@ type ReportRequest struct {
 }
 
 type ReportData struct {
-	Date  string  `json:"date"`
-	Value float64 `json:"value"`
-	Count int     `json:"count"`
+	Date  string       `json:"date"`
+	Value money.Amount `json:"value"`
+	Count int          `json:"count"`
 }
 
 type ReportResponse struct {
@@ -0,0 +1,91 @@
+package einvoice
+
+import (
+	"context"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/infrastructure/storage"
+	"Veritasbackend/pkg/money"
+)
+
+// DocumentDTO es una factura o nota crédito electrónica. CUFE es el código único del documento
+// (CUFE o CUDE en Colombia, el hash de la firma en Perú).
+type DocumentDTO struct {
+	ID                int          `json:"id"`
+	InvoiceID         int          `json:"invoiceId"`
+	DocumentType      string       `json:"documentType"`
+	Number            string       `json:"number"`
+	ReferenceID       *int         `json:"referenceId,omitempty"`
+	DiscrepancyCode   string       `json:"discrepancyCode,omitempty"`
+	DiscrepancyReason string       `json:"discrepancyReason,omitempty"`
+	Currency          string       `json:"currency"`
+	Total             money.Amount `json:"total"`
+	CUFE              string       `json:"cufe,omitempty"`
+	Status            string       `json:"status"`
+	Provider          string       `json:"provider,omitempty"`
+	TrackID           string       `json:"trackId,omitempty"`
+	RejectionReasons  []string     `json:"rejectionReasons,omitempty"`
+	// XMLURL y PDFURL son URLs firmadas que vencen en URLExpiresAt; solo van en el detalle
+	XMLURL       string `json:"xmlUrl,omitempty"`
+	PDFURL       string `json:"pdfUrl,omitempty"`
+	URLExpiresAt string `json:"urlExpiresAt,omitempty"`
+	IssuedAt     string `json:"issuedAt"`
+	SubmittedAt  string `json:"submittedAt,omitempty"`
+	AcceptedAt   string `json:"acceptedAt,omitempty"`
+	UserID       int    `json:"userId"`
+	CreatedAt    string `json:"createdAt"`
+}
+
+func convertDocumentToDTO(doc *ent.FiscalDocument) DocumentDTO {
+	dto := DocumentDTO{
+		ID:                doc.ID,
+		InvoiceID:         doc.InvoiceID,
+		DocumentType:      doc.DocumentType,
+		ReferenceID:       doc.ReferenceID,
+		DiscrepancyCode:   doc.DiscrepancyCode,
+		DiscrepancyReason: doc.DiscrepancyReason,
+		Currency:          doc.Currency,
+		Total:             doc.Total,
+		CUFE:              doc.Cufe,
+		Status:            doc.Status,
+		Provider:          doc.Provider,
+		TrackID:           doc.TrackID,
+		RejectionReasons:  doc.RejectionReasons,
+		IssuedAt:          doc.IssuedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UserID:            doc.UserID,
+		CreatedAt:         doc.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if doc.Number != nil {
+		dto.Number = *doc.Number
+	}
+	if doc.SubmittedAt != nil {
+		dto.SubmittedAt = doc.SubmittedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if doc.AcceptedAt != nil {
+		dto.AcceptedAt = doc.AcceptedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return dto
+}
+
+// convertDocumentWithFiles agrega al DTO las URLs firmadas del XML y del PDF archivados
+func convertDocumentWithFiles(ctx context.Context, fileStorage storage.Storage, urlTTL time.Duration, doc *ent.FiscalDocument) (*DocumentDTO, error) {
+	dto := convertDocumentToDTO(doc)
+
+	var err error
+	if doc.XMLKey != "" {
+		if dto.XMLURL, err = fileStorage.SignedURL(ctx, doc.XMLKey, urlTTL); err != nil {
+			return nil, err
+		}
+	}
+	if doc.PdfKey != "" {
+		if dto.PDFURL, err = fileStorage.SignedURL(ctx, doc.PdfKey, urlTTL); err != nil {
+			return nil, err
+		}
+	}
+	if dto.XMLURL != "" || dto.PDFURL != "" {
+		dto.URLExpiresAt = time.Now().Add(urlTTL).Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return &dto, nil
+}
@@ -0,0 +1,407 @@
+package einvoice
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"strings"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/infrastructure/fiscal"
+	"Veritasbackend/internal/infrastructure/storage"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/imaging"
+	"Veritasbackend/pkg/invoicepdf"
+	"Veritasbackend/pkg/money"
+	"Veritasbackend/pkg/quantity"
+	"Veritasbackend/pkg/secret"
+	"Veritasbackend/pkg/ubl"
+	"Veritasbackend/pkg/xades"
+)
+
+// dianPolicy es la política de firma de la factura electrónica de la DIAN
+var dianPolicy = &xades.Policy{
+	Identifier:  "https://facturaelectronica.dian.gov.co/politicadefirma/v2/politicadefirmav2.pdf",
+	Description: "Política de firma para facturas electrónicas de la República de Colombia",
+	Digest:      "dMoMvtcG5aIzgYo0tIsSQeVJBDnUnfSOfBpxXrmor0Y=",
+}
+
+// emitter firma, archiva y envía los documentos electrónicos; lo comparten la emisión de
+// facturas y de notas crédito
+type emitter struct {
+	fiscalDocRepo repositories.FiscalDocumentRepository
+	storage       storage.Storage
+	provider      fiscal.Provider
+	keys          *secret.Box
+}
+
+// emit firma el documento, archiva el XML y su representación gráfica en PDF y lo envía a la
+// autoridad. Si falla la firma o el archivo el documento queda failed y se devuelve el error; si
+// falla el envío queda failed con el motivo y se puede volver a emitir.
+func (e *emitter) emit(ctx context.Context, t *ent.Tenant, doc *ent.FiscalDocument, content ubl.Document, printable invoicepdf.Document) (*ent.FiscalDocument, error) {
+	submission := repositories.FiscalSubmission{
+		CUFE:     content.UUID,
+		Provider: e.provider.Name(),
+		Status:   "failed",
+	}
+	fail := func(err error) (*ent.FiscalDocument, error) {
+		submission.Reasons = []string{err.Error()}
+		if _, saveErr := e.fiscalDocRepo.SaveSubmission(ctx, doc.ID, submission); saveErr != nil {
+			return nil, saveErr
+		}
+		return nil, err
+	}
+
+	// La llave privada se guarda cifrada y solo se descifra aquí, para firmar
+	keyPEM, err := e.keys.Open(t.EinvoicePrivateKey)
+	if err != nil {
+		return fail(fmt.Errorf("no se pudo descifrar la llave privada del certificado de firma: %v", err))
+	}
+	cert, err := xades.ParsePEM(t.EinvoiceCertificate, keyPEM)
+	if err != nil {
+		return fail(fmt.Errorf("el certificado de firma guardado es inválido: %v", err))
+	}
+
+	data, err := ubl.Marshal(content)
+	if err != nil {
+		return fail(err)
+	}
+
+	opts := xades.Options{SigningTime: time.Now()}
+	if t.EinvoiceCountry == countryColombia {
+		opts.Policy = dianPolicy
+		opts.Role = "supplier"
+	}
+	signed, err := xades.Sign(data, cert, opts)
+	if err != nil {
+		return fail(err)
+	}
+	submission.Digest = signed.DigestValue
+
+	// En Perú el código que se imprime es el resumen de la firma
+	if submission.CUFE == "" {
+		submission.CUFE = signed.DigestValue
+	}
+	printable.Notes = append(printable.Notes, codeLabel(t.EinvoiceCountry, content.Type)+": "+submission.CUFE)
+	if content.Extensions != nil {
+		printable.QR = content.Extensions.QRCode
+	} else {
+		printable.QR = sunatQR(content, submission.CUFE)
+	}
+
+	pdf, err := invoicepdf.Render(printable, invoicepdf.LayoutA4)
+	if err != nil {
+		return fail(err)
+	}
+
+	prefix := fmt.Sprintf("tenants/%d/einvoice/%d/%s", t.ID, doc.ID, content.Number)
+	submission.XMLKey = prefix + ".xml"
+	submission.PDFKey = prefix + ".pdf"
+	if err := e.storage.Put(ctx, submission.XMLKey, "application/xml", signed.XML); err != nil {
+		return fail(fmt.Errorf("error al archivar el XML: %v", err))
+	}
+	if err := e.storage.Put(ctx, submission.PDFKey, "application/pdf", pdf); err != nil {
+		return fail(fmt.Errorf("error al archivar el PDF: %v", err))
+	}
+
+	now := time.Now()
+	submission.SubmittedAt = &now
+	result, err := e.provider.Submit(ctx, fiscal.Submission{
+		TenantID:     t.ID,
+		Country:      t.EinvoiceCountry,
+		Environment:  t.EinvoiceEnvironment,
+		DocumentType: content.Type,
+		Number:       content.Number,
+		CUFE:         content.UUID,
+		XML:          signed.XML,
+	})
+	if err != nil {
+		submission.Reasons = []string{"Error al enviar a la autoridad: " + err.Error()}
+		return e.fiscalDocRepo.SaveSubmission(ctx, doc.ID, submission)
+	}
+
+	submission.TrackID = result.TrackID
+	submission.Status = result.Status
+	submission.Reasons = result.Reasons
+	if result.Status == fiscal.StatusAccepted {
+		submission.AcceptedAt = &result.ReceivedAt
+	}
+
+	return e.fiscalDocRepo.SaveSubmission(ctx, doc.ID, submission)
+}
+
+// readyTenant carga el tenant y comprueba que pueda emitir documentos electrónicos
+func readyTenant(ctx context.Context, tenantRepo repositories.TenantRepository, tenantID int) (*ent.Tenant, error) {
+	t, err := tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	if t.EinvoiceCountry == "" {
+		return nil, fmt.Errorf("%w: la facturación electrónica no está habilitada", pkg_errors.ErrInvalidState)
+	}
+	if strings.TrimSpace(t.TaxID) == "" {
+		return nil, fmt.Errorf("%w: registre la identificación fiscal del tenant en el perfil", pkg_errors.ErrInvalidState)
+	}
+	if len(t.EinvoiceCertificate) == 0 || len(t.EinvoicePrivateKey) == 0 {
+		return nil, fmt.Errorf("%w: cargue el certificado de firma", pkg_errors.ErrInvalidState)
+	}
+	if expires := t.EinvoiceCertificateExpiresAt; expires != nil && time.Now().After(*expires) {
+		return nil, fmt.Errorf("%w: el certificado de firma venció el %s", pkg_errors.ErrInvalidState, expires.Format("2006-01-02"))
+	}
+
+	return t, nil
+}
+
+func profileFor(country string) ubl.Profile {
+	if country == countryPeru {
+		return ubl.ProfileSUNAT
+	}
+	return ubl.ProfileDIAN
+}
+
+// environmentCode es el código del ambiente ante la autoridad: 1 producción, 2 pruebas
+func environmentCode(environment string) string {
+	if environment == environmentProduction {
+		return "1"
+	}
+	return "2"
+}
+
+func codeLabel(country, documentType string) string {
+	switch {
+	case country == countryPeru:
+		return "Código hash"
+	case documentType == ubl.TypeCreditNote:
+		return "CUDE"
+	default:
+		return "CUFE"
+	}
+}
+
+func supplierParty(t *ent.Tenant) ubl.Party {
+	party := ubl.Party{
+		Name:    t.Name,
+		IDType:  "NIT",
+		TaxID:   t.TaxID,
+		Address: t.Address,
+		Country: t.EinvoiceCountry,
+		Phone:   t.Phone,
+		Email:   t.Email,
+	}
+	if t.LegalName != "" {
+		party.Name = t.LegalName
+	}
+	if t.EinvoiceCountry == countryPeru {
+		party.IDType = "RUC"
+	}
+	return party
+}
+
+// customerParty es el adquiriente; las ventas sin cliente se emiten al consumidor final
+func customerParty(customer *ent.Customer) ubl.Party {
+	if customer == nil {
+		return ubl.Party{Name: "Consumidor final"}
+	}
+	return ubl.Party{
+		Name:    customer.Name,
+		IDType:  customer.DocumentType,
+		TaxID:   customer.DocumentNumber,
+		Address: customer.Address,
+		Phone:   customer.Phone,
+		Email:   customer.Email,
+	}
+}
+
+// seriesAuthorization es la resolución de numeración que la DIAN exige en las facturas. Sin
+// resolución o sin fechas de vigencia la serie no la informa.
+func seriesAuthorization(series *ent.NumberingSeries) *ubl.Authorization {
+	if series.Resolution == "" || series.ValidFrom == nil || series.ValidUntil == nil {
+		return nil
+	}
+	return &ubl.Authorization{
+		Number:    series.Resolution,
+		StartDate: *series.ValidFrom,
+		EndDate:   *series.ValidUntil,
+		Prefix:    series.Prefix,
+		From:      series.RangeFrom,
+		To:        series.RangeTo,
+	}
+}
+
+// dianExtensions son los datos de control de la DIAN, con el QR que consulta el documento en el
+// catálogo de la DIAN
+func dianExtensions(t *ent.Tenant, content ubl.Document) *ubl.DianExtensions {
+	supplierID, _ := ubl.SplitTaxID(content.Supplier.TaxID)
+	customerID, _ := ubl.SplitTaxID(content.Customer.TaxID)
+	if customerID == "" {
+		customerID = content.Profile.FinalConsumerID
+	}
+
+	host := "catalogo-vpfe-hab.dian.gov.co"
+	if t.EinvoiceEnvironment == environmentProduction {
+		host = "catalogo-vpfe.dian.gov.co"
+	}
+
+	qr := strings.Join([]string{
+		"NumFac: " + content.Number,
+		"FecFac: " + content.IssuedAt.Format("2006-01-02"),
+		"HorFac: " + content.IssuedAt.Format("15:04:05-07:00"),
+		"NitFac: " + supplierID,
+		"DocAdq: " + customerID,
+		"ValFac: " + ubl.FormatAmount(content.LineExtension),
+		"ValIva: " + ubl.FormatAmount(content.TaxInclusive.Sub(content.TaxExclusive)),
+		"ValOtroIm: 0.00",
+		"ValTolFac: " + ubl.FormatAmount(content.Payable),
+		"CUFE: " + content.UUID,
+		"QRCode: https://" + host + "/document/searchqr?documentkey=" + content.UUID,
+	}, "\n")
+
+	return &ubl.DianExtensions{
+		SoftwareProviderID:   supplierID,
+		SoftwareID:           t.EinvoiceSoftwareID,
+		SoftwareSecurityCode: ubl.SoftwareSecurityCode(t.EinvoiceSoftwareID, t.EinvoiceSoftwarePin, content.Number),
+		QRCode:               qr,
+	}
+}
+
+// sunatQR es el texto del QR de la representación impresa de SUNAT, separado por barras
+func sunatQR(content ubl.Document, hash string) string {
+	supplierID, _ := ubl.SplitTaxID(content.Supplier.TaxID)
+	customerID, _ := ubl.SplitTaxID(content.Customer.TaxID)
+	if customerID == "" {
+		customerID = content.Profile.FinalConsumerID
+	}
+	typeCode := content.Profile.InvoiceTypeCode
+	if content.Type == ubl.TypeCreditNote {
+		typeCode = content.Profile.CreditNoteTypeCode
+	}
+
+	return strings.Join([]string{
+		supplierID,
+		typeCode,
+		content.Number,
+		ubl.FormatAmount(content.TaxInclusive.Sub(content.TaxExclusive)),
+		ubl.FormatAmount(content.Payable),
+		content.IssuedAt.Format("2006-01-02"),
+		content.Profile.IDScheme(content.Customer.IDType),
+		customerID,
+		hash,
+	}, "|")
+}
+
+// summarize agrupa los impuestos de las líneas por tarifa y calcula los totales del documento.
+// Todos los impuestos se informan como IVA o IGV.
+func summarize(content *ubl.Document) {
+	content.Taxes = nil
+	content.LineExtension = money.Zero
+	taxTotal := money.Zero
+
+	index := make(map[float64]int)
+	for _, line := range content.Lines {
+		content.LineExtension = content.LineExtension.Add(line.LineExtension)
+		taxTotal = taxTotal.Add(line.TaxAmount)
+
+		i, ok := index[line.TaxPercent]
+		if !ok {
+			i = len(content.Taxes)
+			index[line.TaxPercent] = i
+			content.Taxes = append(content.Taxes, ubl.TaxSubtotal{Percent: line.TaxPercent})
+		}
+		content.Taxes[i].Base = content.Taxes[i].Base.Add(line.LineExtension)
+		content.Taxes[i].Amount = content.Taxes[i].Amount.Add(line.TaxAmount)
+	}
+
+	content.TaxExclusive = content.LineExtension
+	content.TaxInclusive = content.LineExtension.Add(taxTotal)
+	content.Payable = content.TaxInclusive
+}
+
+// printableDocument es la representación gráfica del documento electrónico
+func printableDocument(t *ent.Tenant, content ubl.Document, title string) invoicepdf.Document {
+	currency, ok := money.Lookup(content.Currency)
+	if !ok {
+		currency = money.Default
+	}
+	format := func(a money.Amount) string {
+		return a.Format(currency)
+	}
+
+	issuer := content.Supplier
+	doc := invoicepdf.Document{
+		Title:  title,
+		Number: content.Number,
+		Date:   content.IssuedAt.Format("2006-01-02 15:04"),
+		Issuer: invoicepdf.Party{
+			Name:    issuer.Name,
+			TaxID:   strings.TrimSpace(issuer.IDType + " " + issuer.TaxID),
+			Address: issuer.Address,
+			Phone:   issuer.Phone,
+			Email:   issuer.Email,
+		},
+		Logo:       tenantLogo(t),
+		PartyLabel: "Adquiriente",
+		Party: invoicepdf.Party{
+			Name:    content.Customer.Name,
+			TaxID:   strings.TrimSpace(content.Customer.IDType + " " + content.Customer.TaxID),
+			Address: content.Customer.Address,
+			Phone:   content.Customer.Phone,
+			Email:   content.Customer.Email,
+		},
+	}
+
+	allowance := money.Zero
+	for _, line := range content.Lines {
+		l := invoicepdf.Line{
+			Description: line.Description,
+			Quantity:    quantity.Format(line.Quantity),
+			UnitPrice:   format(line.UnitPrice),
+			Total:       format(line.LineExtension),
+		}
+		if !line.Discount.IsZero() {
+			l.Discount = format(line.Discount)
+			allowance = allowance.Add(line.Discount)
+		}
+		doc.Lines = append(doc.Lines, l)
+	}
+
+	for _, tax := range content.Taxes {
+		doc.Taxes = append(doc.Taxes, invoicepdf.Tax{
+			Name:   fmt.Sprintf("%s %s%%", content.Profile.TaxSchemeName, quantity.Format(tax.Percent)),
+			Base:   format(tax.Base),
+			Amount: format(tax.Amount),
+		})
+	}
+
+	if !allowance.IsZero() {
+		doc.Totals = append(doc.Totals, invoicepdf.Total{Label: "Descuentos", Value: "-" + format(allowance)})
+	}
+	doc.Totals = append(doc.Totals,
+		invoicepdf.Total{Label: "Base sin impuestos", Value: format(content.TaxExclusive)},
+		invoicepdf.Total{Label: "Impuestos", Value: format(content.TaxInclusive.Sub(content.TaxExclusive))},
+		invoicepdf.Total{Label: "Total " + currency.Code, Value: format(content.Payable), Bold: true},
+	)
+
+	if ref := content.Reference; ref != nil {
+		doc.Notes = append(doc.Notes, fmt.Sprintf("Factura afectada: %s del %s", ref.Number, ref.IssueDate.Format("2006-01-02")))
+		doc.Notes = append(doc.Notes, "Motivo: "+content.DiscrepancyReason)
+	}
+	doc.Notes = append(doc.Notes, "Representación gráfica de "+strings.ToLower(title))
+
+	return doc
+}
+
+// tenantLogo decodifica el logo guardado; un logo ilegible se omite en vez de impedir la emisión
+func tenantLogo(t *ent.Tenant) image.Image {
+	if len(t.Logo) == 0 {
+		return nil
+	}
+	img, _, err := imaging.Decode(bytes.NewReader(t.Logo))
+	if err != nil {
+		return nil
+	}
+	return img
+}
@@ -0,0 +1,34 @@
+package einvoice
+
+import (
+	"context"
+	"time"
+
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/infrastructure/storage"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type GetDocumentUseCase struct {
+	fiscalDocRepo repositories.FiscalDocumentRepository
+	storage       storage.Storage
+	urlTTL        time.Duration
+}
+
+func NewGetDocumentUseCase(fiscalDocRepo repositories.FiscalDocumentRepository, fileStorage storage.Storage, urlTTL time.Duration) *GetDocumentUseCase {
+	return &GetDocumentUseCase{
+		fiscalDocRepo: fiscalDocRepo,
+		storage:       fileStorage,
+		urlTTL:        urlTTL,
+	}
+}
+
+// Execute devuelve el documento con URLs firmadas para descargar el XML y el PDF archivados
+func (uc *GetDocumentUseCase) Execute(ctx context.Context, tenantID, documentID int) (*DocumentDTO, error) {
+	doc, err := uc.fiscalDocRepo.FindByID(ctx, documentID)
+	if err != nil || doc.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	return convertDocumentWithFiles(ctx, uc.storage, uc.urlTTL, doc)
+}
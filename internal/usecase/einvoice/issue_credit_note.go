@@ -0,0 +1,214 @@
+package einvoice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/infrastructure/fiscal"
+	"Veritasbackend/internal/infrastructure/storage"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+	"Veritasbackend/pkg/quantity"
+	"Veritasbackend/pkg/secret"
+	"Veritasbackend/pkg/ubl"
+)
+
+type IssueCreditNoteUseCase struct {
+	invoiceRepo  repositories.InvoiceRepository
+	productRepo  repositories.ProductRepository
+	customerRepo repositories.CustomerRepository
+	tenantRepo   repositories.TenantRepository
+	emitter      *emitter
+	urlTTL       time.Duration
+}
+
+func NewIssueCreditNoteUseCase(
+	invoiceRepo repositories.InvoiceRepository,
+	productRepo repositories.ProductRepository,
+	customerRepo repositories.CustomerRepository,
+	tenantRepo repositories.TenantRepository,
+	fiscalDocRepo repositories.FiscalDocumentRepository,
+	fileStorage storage.Storage,
+	provider fiscal.Provider,
+	keys *secret.Box,
+	urlTTL time.Duration,
+) *IssueCreditNoteUseCase {
+	return &IssueCreditNoteUseCase{
+		invoiceRepo:  invoiceRepo,
+		productRepo:  productRepo,
+		customerRepo: customerRepo,
+		tenantRepo:   tenantRepo,
+		emitter:      &emitter{fiscalDocRepo: fiscalDocRepo, storage: fileStorage, provider: provider, keys: keys},
+		urlTTL:       urlTTL,
+	}
+}
+
+// CreditNoteItemRequest acredita parte de una línea de la factura. Line es la posición de la
+// línea (desde 1) y Quantity la cantidad en la unidad en que se vendió.
+type CreditNoteItemRequest struct {
+	Line     int     `json:"line" binding:"required,min=1"`
+	Quantity float64 `json:"quantity" binding:"required,gt=0"`
+}
+
+// CreditNoteRequest es una nota crédito sobre una factura electrónica aceptada. ReasonCode es el
+// concepto de corrección de la autoridad; sin Items se acredita la factura completa.
+type CreditNoteRequest struct {
+	ReasonCode string                  `json:"reasonCode" binding:"required"`
+	Reason     string                  `json:"reason" binding:"required"`
+	Items      []CreditNoteItemRequest `json:"items"`
+}
+
+// Execute emite una nota crédito electrónica. Los importes de cada línea se acreditan en
+// proporción a la cantidad y el total de las notas no puede superar el de la factura. La nota es
+// solo fiscal: no devuelve inventario ni cambia el estado de la venta.
+func (uc *IssueCreditNoteUseCase) Execute(ctx context.Context, tenantID, userID, invoiceID int, req CreditNoteRequest) (*DocumentDTO, error) {
+	inv, items, err := uc.invoiceRepo.FindByID(ctx, invoiceID)
+	if err != nil || inv.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	t, err := readyTenant(ctx, uc.tenantRepo, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	fiscalDocRepo := uc.emitter.fiscalDocRepo
+	existing, err := fiscalDocRepo.FindByInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var reference *ent.FiscalDocument
+	credited := money.Zero
+	for _, doc := range existing {
+		switch {
+		case doc.DocumentType == ubl.TypeInvoice:
+			reference = doc
+		case doc.Status == "pending" || doc.Status == "accepted":
+			credited = credited.Add(doc.Total)
+		}
+	}
+	if reference == nil || reference.Status != "accepted" {
+		return nil, fmt.Errorf("%w: la factura no tiene una factura electrónica aceptada", pkg_errors.ErrInvalidState)
+	}
+
+	sold, err := invoiceLines(ctx, uc.productRepo, inv, items)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := creditedLines(sold, req.Items, inv.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	var customer *ent.Customer
+	if inv.CustomerID != nil {
+		if customer, err = uc.customerRepo.FindByID(ctx, *inv.CustomerID); err != nil {
+			customer = nil
+		}
+	}
+
+	content := ubl.Document{
+		Type:        ubl.TypeCreditNote,
+		Profile:     profileFor(t.EinvoiceCountry),
+		Environment: environmentCode(t.EinvoiceEnvironment),
+		IssuedAt:    time.Now(),
+		Currency:    reference.Currency,
+		Supplier:    supplierParty(t),
+		Customer:    customerParty(customer),
+		Lines:       lines,
+		Reference: &ubl.Reference{
+			Number:    *reference.Number,
+			UUID:      reference.Cufe,
+			IssueDate: reference.IssuedAt,
+		},
+		DiscrepancyCode:   strings.TrimSpace(req.ReasonCode),
+		DiscrepancyReason: strings.TrimSpace(req.Reason),
+	}
+	summarize(&content)
+
+	if content.Payable.IsZero() {
+		return nil, fmt.Errorf("la nota crédito no tiene valor")
+	}
+	if remaining := reference.Total.Sub(credited); content.Payable > remaining {
+		currency, ok := money.Lookup(reference.Currency)
+		if !ok {
+			currency = money.Default
+		}
+		return nil, fmt.Errorf("la nota crédito (%s) supera el saldo de la factura (%s)", content.Payable.Format(currency), remaining.Format(currency))
+	}
+
+	doc, err := fiscalDocRepo.Create(ctx, repositories.FiscalDocumentData{
+		TenantID:          tenantID,
+		InvoiceID:         invoiceID,
+		DocumentType:      ubl.TypeCreditNote,
+		ReferenceID:       &reference.ID,
+		DiscrepancyCode:   content.DiscrepancyCode,
+		DiscrepancyReason: content.DiscrepancyReason,
+		Currency:          content.Currency,
+		Total:             content.Payable,
+		IssuedAt:          content.IssuedAt,
+		UserID:            userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	content.Number = *doc.Number
+	content.IssuedAt = doc.IssuedAt
+
+	if t.EinvoiceCountry == countryColombia {
+		// El CUDE de las notas crédito se calcula con el PIN del software
+		content.UUID = ubl.CUFE(content, t.EinvoiceSoftwarePin)
+		content.Extensions = dianExtensions(t, content)
+	}
+
+	doc, err = uc.emitter.emit(ctx, t, doc, content, printableDocument(t, content, "Nota crédito electrónica"))
+	if err != nil {
+		return nil, err
+	}
+
+	return convertDocumentWithFiles(ctx, uc.emitter.storage, uc.urlTTL, doc)
+}
+
+// creditedLines toma las líneas de la factura que se acreditan, con sus importes proporcionales
+// a la cantidad; sin items se acreditan todas completas
+func creditedLines(lines []ubl.Line, items []CreditNoteItemRequest, currencyCode string) ([]ubl.Line, error) {
+	if len(items) == 0 {
+		return lines, nil
+	}
+
+	currency, ok := money.Lookup(currencyCode)
+	if !ok {
+		currency = money.Default
+	}
+
+	seen := make(map[int]bool)
+	credited := make([]ubl.Line, 0, len(items))
+	for _, item := range items {
+		if item.Line < 1 || item.Line > len(lines) {
+			return nil, fmt.Errorf("la factura no tiene la línea %d", item.Line)
+		}
+		if seen[item.Line] {
+			return nil, fmt.Errorf("la línea %d está repetida", item.Line)
+		}
+		seen[item.Line] = true
+
+		line := lines[item.Line-1]
+		if item.Quantity > line.Quantity {
+			return nil, fmt.Errorf("la línea %d solo tiene %s unidades", item.Line, quantity.Format(line.Quantity))
+		}
+
+		ratio := item.Quantity / line.Quantity
+		line.Quantity = item.Quantity
+		line.LineExtension = line.LineExtension.Mul(ratio).Round(currency)
+		line.Discount = line.Discount.Mul(ratio).Round(currency)
+		line.TaxAmount = line.TaxAmount.Mul(ratio).Round(currency)
+		credited = append(credited, line)
+	}
+
+	return credited, nil
+}
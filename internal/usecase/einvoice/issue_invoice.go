@@ -0,0 +1,205 @@
+package einvoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/infrastructure/fiscal"
+	"Veritasbackend/internal/infrastructure/storage"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+	"Veritasbackend/pkg/secret"
+	"Veritasbackend/pkg/ubl"
+)
+
+type IssueInvoiceUseCase struct {
+	invoiceRepo  repositories.InvoiceRepository
+	productRepo  repositories.ProductRepository
+	customerRepo repositories.CustomerRepository
+	tenantRepo   repositories.TenantRepository
+	seriesRepo   repositories.NumberingSeriesRepository
+	emitter      *emitter
+	urlTTL       time.Duration
+}
+
+func NewIssueInvoiceUseCase(
+	invoiceRepo repositories.InvoiceRepository,
+	productRepo repositories.ProductRepository,
+	customerRepo repositories.CustomerRepository,
+	tenantRepo repositories.TenantRepository,
+	seriesRepo repositories.NumberingSeriesRepository,
+	fiscalDocRepo repositories.FiscalDocumentRepository,
+	fileStorage storage.Storage,
+	provider fiscal.Provider,
+	keys *secret.Box,
+	urlTTL time.Duration,
+) *IssueInvoiceUseCase {
+	return &IssueInvoiceUseCase{
+		invoiceRepo:  invoiceRepo,
+		productRepo:  productRepo,
+		customerRepo: customerRepo,
+		tenantRepo:   tenantRepo,
+		seriesRepo:   seriesRepo,
+		emitter:      &emitter{fiscalDocRepo: fiscalDocRepo, storage: fileStorage, provider: provider, keys: keys},
+		urlTTL:       urlTTL,
+	}
+}
+
+// Execute emite la factura electrónica de una venta: genera el XML UBL 2.1, calcula el CUFE, lo
+// firma, archiva el XML y el PDF y lo envía a la autoridad. Una factura rechazada o con error de
+// envío se vuelve a emitir con el mismo número; una pendiente o aceptada no.
+func (uc *IssueInvoiceUseCase) Execute(ctx context.Context, tenantID, userID, invoiceID int) (*DocumentDTO, error) {
+	inv, items, err := uc.invoiceRepo.FindByID(ctx, invoiceID)
+	if err != nil || inv.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+	if inv.Status == "cancelled" || inv.Status == "voided" {
+		return nil, fmt.Errorf("%w: la factura está %s", pkg_errors.ErrInvalidState, inv.Status)
+	}
+
+	t, err := readyTenant(ctx, uc.tenantRepo, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var customer *ent.Customer
+	if inv.CustomerID != nil {
+		if customer, err = uc.customerRepo.FindByID(ctx, *inv.CustomerID); err != nil {
+			customer = nil
+		}
+	}
+
+	lines, err := invoiceLines(ctx, uc.productRepo, inv, items)
+	if err != nil {
+		return nil, err
+	}
+
+	content := ubl.Document{
+		Type:        ubl.TypeInvoice,
+		Profile:     profileFor(t.EinvoiceCountry),
+		Environment: environmentCode(t.EinvoiceEnvironment),
+		IssuedAt:    time.Now(),
+		Currency:    inv.Currency,
+		Supplier:    supplierParty(t),
+		Customer:    customerParty(customer),
+		Lines:       lines,
+	}
+	summarize(&content)
+
+	doc, err := uc.prepare(ctx, tenantID, userID, inv, content)
+	if err != nil {
+		return nil, err
+	}
+	content.Number = *doc.Number
+	content.IssuedAt = doc.IssuedAt
+
+	if t.EinvoiceCountry == countryColombia {
+		content.UUID = ubl.CUFE(content, t.EinvoiceTechnicalKey)
+		content.Extensions = dianExtensions(t, content)
+		if inv.SeriesID != nil {
+			if series, err := uc.seriesRepo.FindByID(ctx, *inv.SeriesID); err == nil {
+				content.Extensions.Authorization = seriesAuthorization(series)
+			}
+		}
+	}
+
+	doc, err = uc.emitter.emit(ctx, t, doc, content, printableDocument(t, content, "Factura electrónica de venta"))
+	if err != nil {
+		return nil, err
+	}
+
+	return convertDocumentWithFiles(ctx, uc.emitter.storage, uc.urlTTL, doc)
+}
+
+// prepare registra el documento de la factura o, si ya existía rechazado o con error, lo vuelve
+// a pending para emitirlo otra vez
+func (uc *IssueInvoiceUseCase) prepare(ctx context.Context, tenantID, userID int, inv *ent.Invoice, content ubl.Document) (*ent.FiscalDocument, error) {
+	fiscalDocRepo := uc.emitter.fiscalDocRepo
+
+	existing, err := fiscalDocRepo.FindByInvoice(ctx, inv.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, doc := range existing {
+		if doc.DocumentType == ubl.TypeInvoice {
+			return fiscalDocRepo.Reissue(ctx, doc.ID, content.IssuedAt, content.Payable, userID)
+		}
+	}
+
+	// La factura electrónica lleva el número de la venta; las anteriores a las series de
+	// numeración se emiten con su ID
+	number := strconv.Itoa(inv.ID)
+	if inv.Number != nil {
+		number = *inv.Number
+	}
+
+	doc, err := fiscalDocRepo.Create(ctx, repositories.FiscalDocumentData{
+		TenantID:     tenantID,
+		InvoiceID:    inv.ID,
+		DocumentType: ubl.TypeInvoice,
+		Number:       number,
+		Currency:     inv.Currency,
+		Total:        content.Payable,
+		IssuedAt:     content.IssuedAt,
+		UserID:       userID,
+	})
+	if errors.Is(err, pkg_errors.ErrAlreadyExists) {
+		return nil, fmt.Errorf("%w: la factura ya tiene un documento electrónico", pkg_errors.ErrInvalidState)
+	}
+	return doc, err
+}
+
+// invoiceLines arma las líneas del documento. Los importes se informan sin impuestos: cuando los
+// precios los incluían, el descuento se pasa a la base con la tarifa de la línea. La cantidad es
+// la de la unidad en que se vendió.
+func invoiceLines(ctx context.Context, productRepo repositories.ProductRepository, inv *ent.Invoice, items []*ent.InvoiceItem) ([]ubl.Line, error) {
+	currency, ok := money.Lookup(inv.Currency)
+	if !ok {
+		currency = money.Default
+	}
+
+	lines := make([]ubl.Line, len(items))
+	for i, item := range items {
+		line := ubl.Line{
+			Quantity:      item.Quantity,
+			LineExtension: item.TaxBase,
+			TaxPercent:    item.TaxRate,
+			TaxAmount:     item.TaxAmount,
+		}
+		if item.UnitQuantity != nil {
+			line.Quantity = *item.UnitQuantity
+		}
+
+		if product, err := productRepo.FindByID(ctx, item.ProductID); err == nil {
+			line.Description = product.Name
+			line.Code = product.Sku
+		}
+		if line.Description == "" {
+			line.Description = fmt.Sprintf("Producto %d", item.ProductID)
+		}
+
+		discount := item.PromotionDiscount.Add(item.DiscountAmount).Add(item.InvoiceDiscountAmount)
+		// Las facturas anteriores a los impuestos no tienen base: la base es el subtotal neto
+		if line.LineExtension.IsZero() && line.TaxAmount.IsZero() {
+			line.LineExtension = item.Subtotal.Sub(discount)
+		}
+		if inv.PricesIncludeTax && item.TaxRate > 0 {
+			discount = discount.Div(1 + item.TaxRate/100).Round(currency)
+		}
+		line.Discount = discount
+
+		if line.Quantity <= 0 {
+			return nil, fmt.Errorf("la línea %d tiene cantidad inválida", i+1)
+		}
+		line.UnitPrice = line.LineExtension.Add(discount).Div(line.Quantity)
+
+		lines[i] = line
+	}
+
+	return lines, nil
+}
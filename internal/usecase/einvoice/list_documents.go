@@ -0,0 +1,65 @@
+package einvoice
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type ListDocumentsUseCase struct {
+	fiscalDocRepo repositories.FiscalDocumentRepository
+}
+
+func NewListDocumentsUseCase(fiscalDocRepo repositories.FiscalDocumentRepository) *ListDocumentsUseCase {
+	return &ListDocumentsUseCase{
+		fiscalDocRepo: fiscalDocRepo,
+	}
+}
+
+type ListDocumentsRequest struct {
+	Status       string `json:"status"`
+	DocumentType string `json:"documentType"`
+	InvoiceID    *int   `json:"invoiceId"`
+	Page         int    `json:"page"`
+	Limit        int    `json:"limit"`
+}
+
+type ListDocumentsResponse struct {
+	Documents []DocumentDTO `json:"documents"`
+	Total     int           `json:"total"`
+	Page      int           `json:"page"`
+	Limit     int           `json:"limit"`
+}
+
+func (uc *ListDocumentsUseCase) Execute(ctx context.Context, tenantID int, req ListDocumentsRequest) (*ListDocumentsResponse, error) {
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	offset := (req.Page - 1) * req.Limit
+
+	filter := repositories.FiscalDocumentFilter{
+		Status:       req.Status,
+		DocumentType: req.DocumentType,
+		InvoiceID:    req.InvoiceID,
+	}
+	documents, total, err := uc.fiscalDocRepo.FindAll(ctx, tenantID, filter, req.Limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	documentDTOs := make([]DocumentDTO, len(documents))
+	for i, doc := range documents {
+		documentDTOs[i] = convertDocumentToDTO(doc)
+	}
+
+	return &ListDocumentsResponse{
+		Documents: documentDTOs,
+		Total:     total,
+		Page:      req.Page,
+		Limit:     req.Limit,
+	}, nil
+}
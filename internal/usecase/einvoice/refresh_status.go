@@ -0,0 +1,66 @@
+package einvoice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/infrastructure/fiscal"
+	"Veritasbackend/internal/infrastructure/storage"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type RefreshStatusUseCase struct {
+	fiscalDocRepo repositories.FiscalDocumentRepository
+	provider      fiscal.Provider
+	storage       storage.Storage
+	urlTTL        time.Duration
+}
+
+func NewRefreshStatusUseCase(fiscalDocRepo repositories.FiscalDocumentRepository, provider fiscal.Provider, fileStorage storage.Storage, urlTTL time.Duration) *RefreshStatusUseCase {
+	return &RefreshStatusUseCase{
+		fiscalDocRepo: fiscalDocRepo,
+		provider:      provider,
+		storage:       fileStorage,
+		urlTTL:        urlTTL,
+	}
+}
+
+// Execute consulta a la autoridad el estado de un documento pendiente y guarda la respuesta. Un
+// documento pendiente que nunca llegó a enviarse queda failed para poder emitirlo de nuevo.
+func (uc *RefreshStatusUseCase) Execute(ctx context.Context, tenantID, documentID int) (*DocumentDTO, error) {
+	doc, err := uc.fiscalDocRepo.FindByID(ctx, documentID)
+	if err != nil || doc.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+	if doc.Status != "pending" {
+		return nil, fmt.Errorf("%w: el documento ya tiene respuesta de la autoridad", pkg_errors.ErrInvalidState)
+	}
+
+	if doc.TrackID == "" {
+		doc, err = uc.fiscalDocRepo.SetStatus(ctx, doc.ID, "failed", []string{"El documento no se envió a la autoridad"}, nil)
+		if err != nil {
+			return nil, err
+		}
+		return convertDocumentWithFiles(ctx, uc.storage, uc.urlTTL, doc)
+	}
+
+	result, err := uc.provider.Status(ctx, doc.TrackID)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar el estado del documento: %v", err)
+	}
+
+	if result.Status != fiscal.StatusPending {
+		var acceptedAt *time.Time
+		if result.Status == fiscal.StatusAccepted {
+			acceptedAt = &result.ReceivedAt
+		}
+		doc, err = uc.fiscalDocRepo.SetStatus(ctx, doc.ID, result.Status, result.Reasons, acceptedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return convertDocumentWithFiles(ctx, uc.storage, uc.urlTTL, doc)
+}
@@ -0,0 +1,140 @@
+package einvoice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+// Países con factura electrónica: Colombia (DIAN) y Perú (SUNAT)
+const (
+	countryColombia = "CO"
+	countryPeru     = "PE"
+)
+
+const (
+	environmentTest       = "test"
+	environmentProduction = "production"
+)
+
+type GetSettingsUseCase struct {
+	tenantRepo repositories.TenantRepository
+}
+
+func NewGetSettingsUseCase(tenantRepo repositories.TenantRepository) *GetSettingsUseCase {
+	return &GetSettingsUseCase{
+		tenantRepo: tenantRepo,
+	}
+}
+
+// SettingsDTO es la configuración de facturación electrónica. El PIN, la clave técnica y la
+// llave privada nunca se devuelven; solo se indica si están cargados.
+type SettingsDTO struct {
+	Enabled              bool   `json:"enabled"`
+	Country              string `json:"country"`
+	Environment          string `json:"environment"`
+	SoftwareID           string `json:"softwareId"`
+	HasSoftwarePIN       bool   `json:"hasSoftwarePin"`
+	HasTechnicalKey      bool   `json:"hasTechnicalKey"`
+	HasCertificate       bool   `json:"hasCertificate"`
+	CertificateExpiresAt string `json:"certificateExpiresAt,omitempty"`
+}
+
+func (uc *GetSettingsUseCase) Execute(ctx context.Context, tenantID int) (*SettingsDTO, error) {
+	t, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	return convertSettingsToDTO(t), nil
+}
+
+type UpdateSettingsUseCase struct {
+	tenantRepo repositories.TenantRepository
+}
+
+func NewUpdateSettingsUseCase(tenantRepo repositories.TenantRepository) *UpdateSettingsUseCase {
+	return &UpdateSettingsUseCase{
+		tenantRepo: tenantRepo,
+	}
+}
+
+// SettingsRequest habilita o deshabilita la facturación electrónica. SoftwarePIN y TechnicalKey
+// se conservan si no se envían.
+type SettingsRequest struct {
+	Country      string  `json:"country"`
+	Environment  string  `json:"environment"`
+	SoftwareID   string  `json:"softwareId"`
+	SoftwarePIN  *string `json:"softwarePin"`
+	TechnicalKey *string `json:"technicalKey"`
+}
+
+// Execute guarda la configuración. Un país vacío deshabilita la facturación electrónica; en
+// Colombia se exigen el identificador y el PIN del software y la clave técnica de la resolución.
+func (uc *UpdateSettingsUseCase) Execute(ctx context.Context, tenantID int, req SettingsRequest) (*SettingsDTO, error) {
+	t, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	settings := repositories.EInvoiceSettings{
+		Country:      strings.ToUpper(strings.TrimSpace(req.Country)),
+		Environment:  req.Environment,
+		SoftwareID:   strings.TrimSpace(req.SoftwareID),
+		SoftwarePIN:  t.EinvoiceSoftwarePin,
+		TechnicalKey: t.EinvoiceTechnicalKey,
+	}
+	if req.SoftwarePIN != nil {
+		settings.SoftwarePIN = strings.TrimSpace(*req.SoftwarePIN)
+	}
+	if req.TechnicalKey != nil {
+		settings.TechnicalKey = strings.TrimSpace(*req.TechnicalKey)
+	}
+	if settings.Environment == "" {
+		settings.Environment = environmentTest
+	}
+
+	switch settings.Country {
+	case "", countryPeru:
+	case countryColombia:
+		if settings.SoftwareID == "" || settings.SoftwarePIN == "" || settings.TechnicalKey == "" {
+			return nil, fmt.Errorf("la DIAN exige softwareId, softwarePin y technicalKey")
+		}
+	default:
+		return nil, fmt.Errorf("país de facturación electrónica inválido %q (use CO o PE)", req.Country)
+	}
+	if settings.Environment != environmentTest && settings.Environment != environmentProduction {
+		return nil, fmt.Errorf("ambiente inválido %q (use test o production)", settings.Environment)
+	}
+
+	if err := uc.tenantRepo.SetEInvoiceSettings(ctx, tenantID, settings); err != nil {
+		return nil, err
+	}
+
+	t, err = uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	return convertSettingsToDTO(t), nil
+}
+
+func convertSettingsToDTO(t *ent.Tenant) *SettingsDTO {
+	dto := &SettingsDTO{
+		Enabled:         t.EinvoiceCountry != "",
+		Country:         t.EinvoiceCountry,
+		Environment:     t.EinvoiceEnvironment,
+		SoftwareID:      t.EinvoiceSoftwareID,
+		HasSoftwarePIN:  t.EinvoiceSoftwarePin != "",
+		HasTechnicalKey: t.EinvoiceTechnicalKey != "",
+		HasCertificate:  len(t.EinvoiceCertificate) > 0,
+	}
+	if t.EinvoiceCertificateExpiresAt != nil {
+		dto.CertificateExpiresAt = t.EinvoiceCertificateExpiresAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return dto
+}
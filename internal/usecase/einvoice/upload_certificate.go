@@ -0,0 +1,81 @@
+package einvoice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/secret"
+	"Veritasbackend/pkg/xades"
+)
+
+// MaxCertificateSize es el tamaño máximo del archivo del certificado de firma
+const MaxCertificateSize = 1 << 20
+
+type UploadCertificateUseCase struct {
+	tenantRepo repositories.TenantRepository
+	keys       *secret.Box
+}
+
+func NewUploadCertificateUseCase(tenantRepo repositories.TenantRepository, keys *secret.Box) *UploadCertificateUseCase {
+	return &UploadCertificateUseCase{
+		tenantRepo: tenantRepo,
+		keys:       keys,
+	}
+}
+
+type CertificateDTO struct {
+	Subject      string `json:"subject"`
+	Issuer       string `json:"issuer"`
+	SerialNumber string `json:"serialNumber"`
+	ValidFrom    string `json:"validFrom"`
+	ExpiresAt    string `json:"expiresAt"`
+}
+
+// Execute reemplaza el certificado de firma del tenant. Acepta PKCS#12 (.p12 o .pfx) con su
+// contraseña o PEM; se guarda en PEM, con la llave privada cifrada, y la contraseña no se
+// conserva.
+func (uc *UploadCertificateUseCase) Execute(ctx context.Context, tenantID int, data []byte, password string) (*CertificateDTO, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("el archivo está vacío")
+	}
+	if len(data) > MaxCertificateSize {
+		return nil, fmt.Errorf("el certificado supera el máximo de %d MB", MaxCertificateSize>>20)
+	}
+
+	if _, err := uc.tenantRepo.FindByID(ctx, tenantID); err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	cert, err := xades.LoadCertificate(data, password)
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().After(cert.Leaf.NotAfter) {
+		return nil, fmt.Errorf("el certificado venció el %s", cert.Leaf.NotAfter.Format("2006-01-02"))
+	}
+
+	certPEM, keyPEM, err := cert.PEM()
+	if err != nil {
+		return nil, err
+	}
+
+	sealedKey, err := uc.keys.Seal(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.tenantRepo.SetEInvoiceCertificate(ctx, tenantID, certPEM, sealedKey, cert.Leaf.NotAfter); err != nil {
+		return nil, err
+	}
+
+	return &CertificateDTO{
+		Subject:      cert.Leaf.Subject.String(),
+		Issuer:       cert.Leaf.Issuer.String(),
+		SerialNumber: cert.Leaf.SerialNumber.String(),
+		ValidFrom:    cert.Leaf.NotBefore.Format("2006-01-02T15:04:05Z07:00"),
+		ExpiresAt:    cert.Leaf.NotAfter.Format("2006-01-02T15:04:05Z07:00"),
+	}, nil
+}
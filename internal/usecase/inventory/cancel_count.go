@@ -0,0 +1,32 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type CancelCountUseCase struct {
+	countRepo repositories.InventoryCountRepository
+}
+
+func NewCancelCountUseCase(countRepo repositories.InventoryCountRepository) *CancelCountUseCase {
+	return &CancelCountUseCase{
+		countRepo: countRepo,
+	}
+}
+
+func (uc *CancelCountUseCase) Execute(ctx context.Context, tenantID, countID int) error {
+	count, err := uc.countRepo.FindByID(ctx, countID)
+	if err != nil || count.TenantID != tenantID {
+		return pkg_errors.ErrNotFound
+	}
+
+	if count.Status != "open" {
+		return fmt.Errorf("solo se puede cancelar una toma abierta (estado: %s)", count.Status)
+	}
+
+	return uc.countRepo.Cancel(ctx, countID)
+}
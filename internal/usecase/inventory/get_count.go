@@ -0,0 +1,173 @@
+package inventory
+
+import (
+	"context"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+type GetCountUseCase struct {
+	countRepo   repositories.InventoryCountRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewGetCountUseCase(countRepo repositories.InventoryCountRepository, productRepo repositories.ProductRepository) *GetCountUseCase {
+	return &GetCountUseCase{
+		countRepo:   countRepo,
+		productRepo: productRepo,
+	}
+}
+
+type CountLineDTO struct {
+	ProductID        int           `json:"productId"`
+	ProductName      string        `json:"productName"`
+	SKU              string        `json:"sku"`
+	Category         string        `json:"category"`
+	ExpectedQuantity float64       `json:"expectedQuantity"`
+	CountedQuantity  *float64      `json:"countedQuantity"`
+	Variance         *float64      `json:"variance"`
+	UnitCost         money.Amount  `json:"unitCost"`
+	VarianceValue    *money.Amount `json:"varianceValue"`
+}
+
+// VarianceSummaryDTO valoriza las diferencias de la toma al costo registrado al abrirla
+type VarianceSummaryDTO struct {
+	LinesTotal        int          `json:"linesTotal"`
+	LinesCounted      int          `json:"linesCounted"`
+	LinesUncounted    int          `json:"linesUncounted"`
+	LinesWithVariance int          `json:"linesWithVariance"`
+	ShortageUnits     float64      `json:"shortageUnits"`
+	SurplusUnits      float64      `json:"surplusUnits"`
+	ShortageValue     money.Amount `json:"shortageValue"`
+	SurplusValue      money.Amount `json:"surplusValue"`
+	NetValue          money.Amount `json:"netValue"`
+}
+
+type CountDTO struct {
+	ID        int                `json:"id"`
+	Name      string             `json:"name"`
+	Notes     string             `json:"notes"`
+	Status    string             `json:"status"`
+	UserID    int                `json:"userId"`
+	PostedBy  int                `json:"postedBy,omitempty"`
+	PostedAt  string             `json:"postedAt,omitempty"`
+	CreatedAt string             `json:"createdAt"`
+	UpdatedAt string             `json:"updatedAt"`
+	Lines     []CountLineDTO     `json:"lines"`
+	Summary   VarianceSummaryDTO `json:"summary"`
+}
+
+func (uc *GetCountUseCase) Execute(ctx context.Context, tenantID, id int) (*CountDTO, error) {
+	count, err := uc.countRepo.FindByID(ctx, id)
+	if err != nil || count.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	return loadCountDTO(ctx, uc.countRepo, uc.productRepo, count)
+}
+
+// loadCountDTO arma la toma con sus líneas. Mientras está abierta, la cantidad contada es la
+// suma de los conteos registrados; una vez contabilizada, es la que quedó guardada en la línea.
+func loadCountDTO(ctx context.Context, countRepo repositories.InventoryCountRepository, productRepo repositories.ProductRepository, count *ent.InventoryCount) (*CountDTO, error) {
+	lines, err := countRepo.FindLines(ctx, count.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	counted := make(map[int]float64)
+	if count.Status == "posted" {
+		for _, line := range lines {
+			if line.CountedQuantity != nil {
+				counted[line.ID] = *line.CountedQuantity
+			}
+		}
+	} else {
+		counted, err = countRepo.SumEntriesByLine(ctx, count.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	productIDs := make([]int, len(lines))
+	for i, line := range lines {
+		productIDs[i] = line.ProductID
+	}
+	products, err := productRepo.FindByIDsOrCategories(ctx, count.TenantID, productIDs, nil)
+	if err != nil {
+		return nil, err
+	}
+	productsByID := make(map[int]*ent.Product, len(products))
+	for _, p := range products {
+		productsByID[p.ID] = p
+	}
+
+	dto := &CountDTO{
+		ID:        count.ID,
+		Name:      count.Name,
+		Notes:     count.Notes,
+		Status:    count.Status,
+		UserID:    count.UserID,
+		PostedBy:  count.PostedBy,
+		CreatedAt: count.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: count.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Lines:     make([]CountLineDTO, len(lines)),
+	}
+	if count.PostedAt != nil {
+		dto.PostedAt = count.PostedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	summary := &dto.Summary
+	summary.LinesTotal = len(lines)
+	for i, line := range lines {
+		lineDTO := CountLineDTO{
+			ProductID:        line.ProductID,
+			ExpectedQuantity: line.ExpectedQuantity,
+			UnitCost:         line.UnitCost,
+		}
+		if p, ok := productsByID[line.ProductID]; ok {
+			lineDTO.ProductName = p.Name
+			lineDTO.SKU = p.Sku
+			lineDTO.Category = p.Category
+		}
+
+		quantity, ok := counted[line.ID]
+		if !ok {
+			summary.LinesUncounted++
+			dto.Lines[i] = lineDTO
+			continue
+		}
+
+		variance := qty.Round(quantity - line.ExpectedQuantity)
+		value := roundMoney(line.UnitCost.Mul(variance))
+		lineDTO.CountedQuantity = &quantity
+		lineDTO.Variance = &variance
+		lineDTO.VarianceValue = &value
+
+		summary.LinesCounted++
+		if variance < 0 {
+			summary.LinesWithVariance++
+			summary.ShortageUnits = qty.Round(summary.ShortageUnits - variance)
+			summary.ShortageValue += -value
+		} else if variance > 0 {
+			summary.LinesWithVariance++
+			summary.SurplusUnits = qty.Round(summary.SurplusUnits + variance)
+			summary.SurplusValue += value
+		}
+
+		dto.Lines[i] = lineDTO
+	}
+
+	summary.ShortageValue = roundMoney(summary.ShortageValue)
+	summary.SurplusValue = roundMoney(summary.SurplusValue)
+	summary.NetValue = roundMoney(summary.SurplusValue - summary.ShortageValue)
+
+	return dto, nil
+}
+
+func roundMoney(amount money.Amount) money.Amount {
+	return amount.Round(money.Default)
+}
@@ -0,0 +1,75 @@
+package inventory
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type ListCountsUseCase struct {
+	countRepo repositories.InventoryCountRepository
+}
+
+func NewListCountsUseCase(countRepo repositories.InventoryCountRepository) *ListCountsUseCase {
+	return &ListCountsUseCase{
+		countRepo: countRepo,
+	}
+}
+
+type ListCountsRequest struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+}
+
+type CountSummaryDTO struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	UserID    int    `json:"userId"`
+	PostedAt  string `json:"postedAt,omitempty"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type ListCountsResponse struct {
+	Counts []CountSummaryDTO `json:"counts"`
+	Total  int               `json:"total"`
+	Page   int               `json:"page"`
+	Limit  int               `json:"limit"`
+}
+
+func (uc *ListCountsUseCase) Execute(ctx context.Context, tenantID int, req ListCountsRequest) (*ListCountsResponse, error) {
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	offset := (req.Page - 1) * req.Limit
+
+	counts, total, err := uc.countRepo.FindAll(ctx, tenantID, req.Limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	countDTOs := make([]CountSummaryDTO, len(counts))
+	for i, count := range counts {
+		countDTOs[i] = CountSummaryDTO{
+			ID:        count.ID,
+			Name:      count.Name,
+			Status:    count.Status,
+			UserID:    count.UserID,
+			CreatedAt: count.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+		if count.PostedAt != nil {
+			countDTOs[i].PostedAt = count.PostedAt.Format("2006-01-02T15:04:05Z07:00")
+		}
+	}
+
+	return &ListCountsResponse{
+		Counts: countDTOs,
+		Total:  total,
+		Page:   req.Page,
+		Limit:  req.Limit,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type OpenCountUseCase struct {
+	countRepo   repositories.InventoryCountRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewOpenCountUseCase(countRepo repositories.InventoryCountRepository, productRepo repositories.ProductRepository) *OpenCountUseCase {
+	return &OpenCountUseCase{
+		countRepo:   countRepo,
+		productRepo: productRepo,
+	}
+}
+
+type OpenCountRequest struct {
+	Name  string `json:"name" binding:"required"`
+	Notes string `json:"notes"`
+	// ProductIDs y Categories definen el alcance de la toma; si ambos están vacíos se cuentan todos los productos
+	ProductIDs []int    `json:"productIds"`
+	Categories []string `json:"categories"`
+}
+
+func (uc *OpenCountUseCase) Execute(ctx context.Context, tenantID, userID int, req OpenCountRequest) (*CountDTO, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("el nombre de la toma es requerido")
+	}
+
+	var products []*ent.Product
+	var err error
+	if len(req.ProductIDs) == 0 && len(req.Categories) == 0 {
+		products, err = uc.productRepo.FindAllByTenant(ctx, tenantID)
+	} else {
+		products, err = uc.productRepo.FindByIDsOrCategories(ctx, tenantID, req.ProductIDs, req.Categories)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar productos: %w", err)
+	}
+
+	// Los productos eliminados no se cuentan aunque se pidan por ID o categoría. Tampoco los
+	// que llevan lotes o números de serie: la toma cuenta unidades sin lote ni serie, y esos
+	// productos se corrigen con un ajuste de stock que los indica.
+	active := products[:0]
+	for _, p := range products {
+		if !p.Archived && !p.TrackLots && !p.Serialized {
+			active = append(active, p)
+		}
+	}
+	products = active
+
+	if len(products) == 0 {
+		return nil, fmt.Errorf("no hay productos para contar con los filtros indicados")
+	}
+
+	count, err := uc.countRepo.Create(ctx, tenantID, userID, name, req.Notes, products)
+	if err != nil {
+		return nil, fmt.Errorf("error al abrir la toma: %w", err)
+	}
+
+	return loadCountDTO(ctx, uc.countRepo, uc.productRepo, count)
+}
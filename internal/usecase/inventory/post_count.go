@@ -0,0 +1,46 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type PostCountUseCase struct {
+	countRepo   repositories.InventoryCountRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewPostCountUseCase(countRepo repositories.InventoryCountRepository, productRepo repositories.ProductRepository) *PostCountUseCase {
+	return &PostCountUseCase{
+		countRepo:   countRepo,
+		productRepo: productRepo,
+	}
+}
+
+// Execute contabiliza la toma: ajusta el stock por la diferencia de cada línea contada y
+// devuelve la toma con la valorización de las diferencias. Las líneas sin contar no se ajustan.
+// Los conteos se suman y validan dentro de la transacción del repositorio.
+func (uc *PostCountUseCase) Execute(ctx context.Context, tenantID, userID, countID int) (*CountDTO, error) {
+	count, err := uc.countRepo.FindByID(ctx, countID)
+	if err != nil || count.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	if count.Status != "open" {
+		return nil, fmt.Errorf("la toma no está abierta (estado: %s)", count.Status)
+	}
+
+	if err := uc.countRepo.Post(ctx, countID, userID); err != nil {
+		return nil, err
+	}
+
+	count, err = uc.countRepo.FindByID(ctx, countID)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadCountDTO(ctx, uc.countRepo, uc.productRepo, count)
+}
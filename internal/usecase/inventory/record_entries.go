@@ -0,0 +1,67 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+type RecordEntriesUseCase struct {
+	countRepo   repositories.InventoryCountRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewRecordEntriesUseCase(countRepo repositories.InventoryCountRepository, productRepo repositories.ProductRepository) *RecordEntriesUseCase {
+	return &RecordEntriesUseCase{
+		countRepo:   countRepo,
+		productRepo: productRepo,
+	}
+}
+
+type CountEntryRequest struct {
+	ProductID int `json:"productId" binding:"required"`
+	// Quantity se suma a lo ya contado; un valor negativo corrige un conteo anterior
+	// en la unidad base del producto
+	Quantity float64 `json:"quantity"`
+}
+
+type RecordEntriesRequest struct {
+	DeviceID string              `json:"deviceId"`
+	Entries  []CountEntryRequest `json:"entries" binding:"required,min=1,dive"`
+}
+
+func (uc *RecordEntriesUseCase) Execute(ctx context.Context, tenantID, userID, countID int, req RecordEntriesRequest) (*CountDTO, error) {
+	count, err := uc.countRepo.FindByID(ctx, countID)
+	if err != nil || count.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	if count.Status != "open" {
+		return nil, fmt.Errorf("la toma no está abierta (estado: %s)", count.Status)
+	}
+
+	entries := make([]repositories.CountEntry, len(req.Entries))
+	for i, entry := range req.Entries {
+		product, err := uc.productRepo.FindByID(ctx, entry.ProductID)
+		if err != nil || product.TenantID != tenantID {
+			return nil, fmt.Errorf("producto con ID %d no encontrado", entry.ProductID)
+		}
+		if !product.Fractional && !qty.IsWhole(entry.Quantity) {
+			return nil, fmt.Errorf("el producto %s no admite cantidades fraccionarias", product.Name)
+		}
+
+		entries[i] = repositories.CountEntry{
+			ProductID: entry.ProductID,
+			Quantity:  entry.Quantity,
+		}
+	}
+
+	if err := uc.countRepo.AddEntries(ctx, countID, userID, req.DeviceID, entries); err != nil {
+		return nil, err
+	}
+
+	return loadCountDTO(ctx, uc.countRepo, uc.productRepo, count)
+}
@@ -0,0 +1,147 @@
+package invoice
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+type InvoiceComponentDTO struct {
+	ProductID   int             `json:"productId"`
+	ProductName string          `json:"productName"`
+	Quantity    float64         `json:"quantity"`
+	Revenue     money.Amount    `json:"revenue"`
+	Lots        []InvoiceLotDTO `json:"lots,omitempty"`
+}
+
+// bundleComponentSale es un componente de un combo dentro de una venta
+type bundleComponentSale struct {
+	product *ent.Product
+	share   *float64
+	// perBundle es la cantidad del componente por combo; build es lo que se descuenta ahora
+	perBundle   float64
+	build       float64
+	lots        []*ent.ProductLot
+	allocations []repositories.LotAllocation
+}
+
+// bundleSale es el plan de salida de un combo: primero su stock ya armado y el resto se arma
+// en el momento con los componentes
+type bundleSale struct {
+	product    *ent.Product
+	quantity   float64
+	fromStock  float64
+	components []*bundleComponentSale
+}
+
+// prepareBundle valida que haya combos armados o componentes suficientes para la cantidad
+// vendida y reserva el stock y los lotes vigentes de los componentes, sin tocar el inventario
+func (uc *CreateInvoiceUseCase) prepareBundle(ctx context.Context, product *ent.Product, item InvoiceItemRequest, quantity float64, reserved *reservations) (*bundleSale, error) {
+	if item.LotID != nil || len(item.Serials) > 0 {
+		return nil, fmt.Errorf("el combo %s no admite lotes ni números de serie; se toman de sus componentes", product.Name)
+	}
+
+	components, err := uc.bundleRepo.FindComponents(ctx, product.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar componentes: %v", err)
+	}
+	if len(components) == 0 {
+		return nil, fmt.Errorf("el combo %s no tiene componentes definidos", product.Name)
+	}
+
+	sale := &bundleSale{product: product, quantity: quantity, fromStock: quantity}
+	if available := reserved.stock(product); available < quantity {
+		sale.fromStock = available
+		if sale.fromStock < 0 {
+			sale.fromStock = 0
+		}
+	}
+	toBuild := qty.Round(quantity - sale.fromStock)
+
+	for _, c := range components {
+		component, err := uc.productRepo.FindByID(ctx, c.ComponentID)
+		if err != nil {
+			return nil, fmt.Errorf("componente con ID %d del combo %s no encontrado", c.ComponentID, product.Name)
+		}
+
+		entry := &bundleComponentSale{
+			product:   component,
+			share:     c.RevenueShare,
+			perBundle: c.Quantity,
+			build:     qty.Round(c.Quantity * toBuild),
+		}
+
+		if entry.build > 0 {
+			if component.Serialized {
+				return nil, fmt.Errorf("el componente %s es serializado; arme el combo antes de venderlo", component.Name)
+			}
+			if available := reserved.stock(component); available < entry.build {
+				return nil, fmt.Errorf("stock insuficiente para combo %s: el componente %s tiene %s, se requieren %s", product.Name, component.Name, qty.Format(available), qty.Format(entry.build))
+			}
+			reserved.takeStock(component.ID, entry.build)
+			if component.TrackLots {
+				entry.lots, entry.allocations, err = uc.allocateLots(ctx, component, nil, entry.build, reserved)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		sale.components = append(sale.components, entry)
+	}
+
+	return sale, nil
+}
+
+// splitBundle arma el desglose por componente de un combo vendido, con el ingreso repartido
+// según la configuración del combo y el peso de cada uno en su costo, que se calcula al
+// descontar el inventario. El ingreso va en currency, la moneda base del tenant.
+func splitBundle(sale *bundleSale, subtotal money.Amount, currency money.Currency) ([]repositories.InvoiceItemComponent, []InvoiceComponentDTO) {
+	revenueWeights := make([]money.Amount, len(sale.components))
+	for i, c := range sale.components {
+		switch sale.product.RevenueSplit {
+		case "cost":
+			revenueWeights[i] = c.product.PurchasePrice.Mul(c.perBundle)
+		case "manual":
+			if c.share != nil {
+				revenueWeights[i] = money.FromFloat(*c.share)
+			}
+		default:
+			revenueWeights[i] = c.product.RetailPrice.Mul(c.perBundle)
+		}
+	}
+
+	revenues := money.Allocate(subtotal, revenueWeights, currency)
+
+	components := make([]repositories.InvoiceItemComponent, len(sale.components))
+	dtos := make([]InvoiceComponentDTO, len(sale.components))
+	for i, c := range sale.components {
+		quantity := qty.Round(c.perBundle * sale.quantity)
+		components[i] = repositories.InvoiceItemComponent{
+			ProductID:     c.product.ID,
+			Quantity:      quantity,
+			Revenue:       revenues[i],
+			CostWeight:    c.product.PurchasePrice.Mul(c.perBundle),
+			Lots:          c.allocations,
+			BuiltQuantity: c.build,
+		}
+
+		lotDTOs := make([]InvoiceLotDTO, 0, len(c.allocations))
+		for j, allocation := range c.allocations {
+			lotDTOs = append(lotDTOs, convertLotAllocationToDTO(c.lots[j], allocation.Quantity))
+		}
+		dtos[i] = InvoiceComponentDTO{
+			ProductID:   c.product.ID,
+			ProductName: c.product.Name,
+			Quantity:    quantity,
+			Revenue:     revenues[i],
+			Lots:        lotDTOs,
+		}
+	}
+
+	return components, dtos
+}
@@ -0,0 +1,22 @@
+package invoice
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+// CancelInvoiceUseCase cancela una factura pendiente y devuelve la mercadería al inventario
+type CancelInvoiceUseCase struct {
+	invoiceRepo repositories.InvoiceRepository
+}
+
+func NewCancelInvoiceUseCase(invoiceRepo repositories.InvoiceRepository) *CancelInvoiceUseCase {
+	return &CancelInvoiceUseCase{
+		invoiceRepo: invoiceRepo,
+	}
+}
+
+func (uc *CancelInvoiceUseCase) Execute(ctx context.Context, tenantID, userID, invoiceID int, req InvoiceStatusRequest) error {
+	return changeInvoiceStatus(ctx, uc.invoiceRepo, tenantID, userID, invoiceID, statusCancelled, req, nil)
+}
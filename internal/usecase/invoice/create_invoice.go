@@ -3,124 +3,703 @@ package invoice
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"Veritasbackend/ent"
 	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/usecase/currency"
+	"Veritasbackend/internal/usecase/taxes"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+	"Veritasbackend/pkg/tax"
 )
 
 type CreateInvoiceUseCase struct {
-	invoiceRepo repositories.InvoiceRepository
-	productRepo  repositories.ProductRepository
+	invoiceRepo   repositories.InvoiceRepository
+	productRepo   repositories.ProductRepository
+	lotRepo       repositories.ProductLotRepository
+	serialRepo    repositories.ProductSerialRepository
+	priceRepo     repositories.ProductPriceRepository
+	priceListRepo repositories.PriceListRepository
+	unitRepo      repositories.ProductUnitRepository
+	bundleRepo    repositories.BundleRepository
+	customerRepo  repositories.CustomerRepository
+	taxRateRepo   repositories.TaxRateRepository
+	tenantRepo    repositories.TenantRepository
+	userRepo      repositories.UserRepository
+	promotionRepo repositories.PromotionRepository
+	rateRepo      repositories.ExchangeRateRepository
+	numberingRepo repositories.NumberingSeriesRepository
 }
 
-func NewCreateInvoiceUseCase(invoiceRepo repositories.InvoiceRepository, productRepo repositories.ProductRepository) *CreateInvoiceUseCase {
+func NewCreateInvoiceUseCase(
+	invoiceRepo repositories.InvoiceRepository,
+	productRepo repositories.ProductRepository,
+	lotRepo repositories.ProductLotRepository,
+	serialRepo repositories.ProductSerialRepository,
+	priceRepo repositories.ProductPriceRepository,
+	priceListRepo repositories.PriceListRepository,
+	unitRepo repositories.ProductUnitRepository,
+	bundleRepo repositories.BundleRepository,
+	customerRepo repositories.CustomerRepository,
+	taxRateRepo repositories.TaxRateRepository,
+	tenantRepo repositories.TenantRepository,
+	userRepo repositories.UserRepository,
+	promotionRepo repositories.PromotionRepository,
+	rateRepo repositories.ExchangeRateRepository,
+	numberingRepo repositories.NumberingSeriesRepository,
+) *CreateInvoiceUseCase {
 	return &CreateInvoiceUseCase{
-		invoiceRepo: invoiceRepo,
-		productRepo: productRepo,
+		invoiceRepo:   invoiceRepo,
+		productRepo:   productRepo,
+		lotRepo:       lotRepo,
+		serialRepo:    serialRepo,
+		priceRepo:     priceRepo,
+		priceListRepo: priceListRepo,
+		unitRepo:      unitRepo,
+		bundleRepo:    bundleRepo,
+		customerRepo:  customerRepo,
+		taxRateRepo:   taxRateRepo,
+		tenantRepo:    tenantRepo,
+		userRepo:      userRepo,
+		promotionRepo: promotionRepo,
+		rateRepo:      rateRepo,
+		numberingRepo: numberingRepo,
 	}
 }
 
 type InvoiceItemRequest struct {
 	ProductID int `json:"productId"`
-	Quantity  int `json:"quantity"`
+	// Quantity va en la unidad de venta: la base del producto o la indicada en UnitID
+	Quantity float64 `json:"quantity"`
+	UnitID   *int    `json:"unitId,omitempty"`
+	// LotID permite elegir el lote manualmente; si se omite se consume por FEFO
+	LotID *int `json:"lotId,omitempty"`
+	// Serials son los números de serie vendidos; obligatorios para productos serializados
+	Serials []string `json:"serials,omitempty"`
+	// Discount es el descuento de la línea; el precio unitario no cambia
+	Discount *DiscountRequest `json:"discount,omitempty"`
 }
 
 type CreateInvoiceRequest struct {
 	Items []InvoiceItemRequest `json:"items"`
+	// PriceListID aplica una lista de precios a toda la factura; sin ella se usa la del cliente
+	PriceListID *int `json:"priceListId,omitempty"`
+	// CustomerID identifica al cliente; sin él la factura es a consumidor final
+	CustomerID *int `json:"customerId,omitempty"`
+	// Discount es el descuento sobre toda la factura, después de los descuentos de línea
+	Discount *DiscountRequest `json:"discount,omitempty"`
+	// Override autoriza los descuentos que superan el límite del rol del vendedor
+	Override *DiscountOverrideRequest `json:"override,omitempty"`
+	// CouponCode activa la promoción con ese cupón; las promociones sin cupón se aplican solas
+	CouponCode string `json:"couponCode,omitempty"`
+	// Currency es la moneda de la factura; sin ella se factura en la moneda base del tenant. Los
+	// precios del catálogo se convierten con ExchangeRate o, si no se envía, con la tasa del día.
+	// Los descuentos en valor fijo van en la moneda de la factura.
+	Currency     string   `json:"currency,omitempty"`
+	ExchangeRate *float64 `json:"exchangeRate,omitempty"`
+}
+
+type InvoiceLotDTO struct {
+	LotID      int     `json:"lotId"`
+	LotNumber  string  `json:"lotNumber"`
+	ExpiryDate *string `json:"expiryDate,omitempty"`
+	Quantity   float64 `json:"quantity"`
 }
 
 type InvoiceItemDTO struct {
-	ProductID int     `json:"productId"`
-	Quantity  int     `json:"quantity"`
-	UnitPrice float64 `json:"unitPrice"`
-	Subtotal  float64 `json:"subtotal"`
-	ProductName string `json:"productName"`
+	ProductID int `json:"productId"`
+	// Quantity y UnitPrice van en la unidad base; UnitQuantity es lo vendido en la unidad de venta
+	Quantity  float64      `json:"quantity"`
+	UnitPrice money.Amount `json:"unitPrice"`
+	// Subtotal es cantidad por precio, antes de promociones y descuentos; incluye el impuesto si
+	// los precios lo incluyen. TaxBase se calcula después de PromotionDiscount, DiscountAmount e
+	// InvoiceDiscountAmount.
+	Subtotal              money.Amount    `json:"subtotal"`
+	PromotionID           *int            `json:"promotionId,omitempty"`
+	PromotionName         string          `json:"promotionName,omitempty"`
+	PromotionDiscount     money.Amount    `json:"promotionDiscount"`
+	DiscountAmount        money.Amount    `json:"discountAmount"`
+	InvoiceDiscountAmount money.Amount    `json:"invoiceDiscountAmount"`
+	TaxRateID             *int            `json:"taxRateId,omitempty"`
+	TaxRate               float64         `json:"taxRate"`
+	TaxBase               money.Amount    `json:"taxBase"`
+	TaxAmount             money.Amount    `json:"taxAmount"`
+	ProductName           string          `json:"productName"`
+	PriceRule             string          `json:"priceRule"`
+	PriceListID           *int            `json:"priceListId,omitempty"`
+	UnitID                *int            `json:"unitId,omitempty"`
+	UnitName              string          `json:"unitName,omitempty"`
+	UnitQuantity          *float64        `json:"unitQuantity,omitempty"`
+	Lots                  []InvoiceLotDTO `json:"lots,omitempty"`
+	Serials               []string        `json:"serials,omitempty"`
+	// Components es el desglose de un combo con el ingreso asignado a cada componente
+	Components []InvoiceComponentDTO `json:"components,omitempty"`
+}
+
+type InvoiceCustomerDTO struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	DocumentType   string `json:"documentType,omitempty"`
+	DocumentNumber string `json:"documentNumber,omitempty"`
+	TaxRegime      string `json:"taxRegime,omitempty"`
+	CustomerGroup  string `json:"customerGroup,omitempty"`
 }
 
 type InvoiceDTO struct {
-	ID        int             `json:"id"`
-	Total     float64         `json:"total"`
-	Status    string          `json:"status"`
-	UserID    int             `json:"userId"`
-	Items     []InvoiceItemDTO `json:"items"`
-	CreatedAt string          `json:"createdAt"`
-	UpdatedAt string          `json:"updatedAt"`
+	ID int `json:"id"`
+	// Number es el consecutivo de la serie de numeración del tenant (el ID en las facturas
+	// anteriores a las series); NumberingWarning avisa al crear la factura que la serie está por
+	// agotar su rango autorizado
+	Number           string `json:"number"`
+	NumberingWarning string `json:"numberingWarning,omitempty"`
+	// Subtotal es la suma de las bases sin impuestos y Total incluye TaxTotal
+	Subtotal money.Amount `json:"subtotal"`
+	TaxTotal money.Amount `json:"taxTotal"`
+	Total    money.Amount `json:"total"`
+	// DiscountAmount es el descuento de factura y DiscountTotal incluye los de línea;
+	// DiscountApprovedBy es quien autorizó un descuento por encima del límite del vendedor
+	DiscountAmount     money.Amount `json:"discountAmount"`
+	DiscountTotal      money.Amount `json:"discountTotal"`
+	DiscountApprovedBy *int         `json:"discountApprovedBy,omitempty"`
+	// PromotionTotal es lo descontado por promociones, aparte de DiscountTotal
+	PromotionTotal   money.Amount           `json:"promotionTotal"`
+	CouponCode       string                 `json:"couponCode,omitempty"`
+	Promotions       []AppliedPromotionDTO  `json:"promotions,omitempty"`
+	PricesIncludeTax bool                   `json:"pricesIncludeTax"`
+	Taxes            []taxes.DocumentTaxDTO `json:"taxes"`
+	Status           string                 `json:"status"`
+	UserID           int                    `json:"userId"`
+	PriceListID      *int                   `json:"priceListId,omitempty"`
+	Customer         *InvoiceCustomerDTO    `json:"customer,omitempty"`
+	Items            []InvoiceItemDTO       `json:"items"`
+	// Los importes van en Currency; los Base* son los totales en la moneda base del tenant con
+	// ExchangeRate. Al cobrar una factura en moneda extranjera se guarda la tasa del cobro en
+	// PaymentExchangeRate y la diferencia en cambio, en moneda base, en FXGainLoss.
+	Currency            string       `json:"currency"`
+	ExchangeRate        float64      `json:"exchangeRate"`
+	BaseSubtotal        money.Amount `json:"baseSubtotal"`
+	BaseTaxTotal        money.Amount `json:"baseTaxTotal"`
+	BaseTotal           money.Amount `json:"baseTotal"`
+	PaymentExchangeRate *float64     `json:"paymentExchangeRate,omitempty"`
+	FXGainLoss          money.Amount `json:"fxGainLoss"`
+	// History son los cambios de estado de la factura, del más antiguo al más reciente
+	History   []InvoiceStatusEventDTO `json:"history,omitempty"`
+	CreatedAt string                  `json:"createdAt"`
+	UpdatedAt string                  `json:"updatedAt"`
+}
+
+// invoiceQuote es una factura con precios, promociones, descuentos e impuestos ya calculados,
+// antes de validar el stock y descontar el inventario
+type invoiceQuote struct {
+	tenant          *ent.Tenant
+	priceList       *ent.PriceList
+	customer        *ent.Customer
+	lines           []saleLine
+	promotions      []AppliedPromotionDTO
+	couponCode      string
+	invoiceDiscount money.Amount
+	subtotal        money.Amount
+	taxTotal        money.Amount
+	total           money.Amount
+	discountTotal   money.Amount
+	promotionTotal  money.Amount
+	taxLines        []tax.Line
+	// currency es la moneda de la factura; los importes de arriba van en ella y los base* son
+	// los totales en la moneda base
+	currency     currency.Document
+	baseSubtotal money.Amount
+	baseTaxTotal money.Amount
+	baseTotal    money.Amount
 }
 
 func (uc *CreateInvoiceUseCase) Execute(ctx context.Context, tenantID, userID int, req CreateInvoiceRequest) (*InvoiceDTO, error) {
-	if len(req.Items) == 0 {
-		return nil, fmt.Errorf("la factura debe tener al menos un item")
+	quote, err := uc.quote(ctx, tenantID, req)
+	if err != nil {
+		return nil, err
 	}
 
-	// Validar productos y calcular totales
-	var total float64
-	repoItems := make([]repositories.InvoiceItem, 0, len(req.Items))
-	itemDTOs := make([]InvoiceItemDTO, 0, len(req.Items))
+	approvedBy, err := uc.authorizeDiscount(ctx, quote.tenant, userID, maxDiscountPercent(quote.lines), req.Override)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validar stock, lotes y números de serie. El inventario se descuenta al guardar la factura,
+	// en su misma transacción; reserved lleva lo que ya tomaron las líneas anteriores.
+	repoItems := make([]repositories.InvoiceItem, 0, len(quote.lines))
+	itemDTOs := make([]InvoiceItemDTO, 0, len(quote.lines))
+	reserved := newReservations()
+
+	for _, line := range quote.lines {
+		item := line.item
+		quantity := line.quantity
 
-	for _, item := range req.Items {
-		// Obtener producto
 		product, err := uc.productRepo.FindByID(ctx, item.ProductID)
 		if err != nil {
 			return nil, fmt.Errorf("producto con ID %d no encontrado", item.ProductID)
 		}
 
-		// Validar que el producto pertenece al tenant
-		if product.TenantID != tenantID {
-			return nil, fmt.Errorf("producto con ID %d no pertenece a tu tenant", item.ProductID)
+		// Validar stock; lo que falte de un combo se arma en el momento con sus componentes
+		var bundle *bundleSale
+		fromStock := quantity
+		if product.Bundle {
+			bundle, err = uc.prepareBundle(ctx, product, item, quantity, reserved)
+			if err != nil {
+				return nil, err
+			}
+			fromStock = bundle.fromStock
+		} else if available := reserved.stock(product); available < quantity {
+			return nil, fmt.Errorf("stock insuficiente para producto %s: disponible %s, solicitado %s", product.Name, qty.Format(available), qty.Format(quantity))
 		}
+		reserved.takeStock(product.ID, fromStock)
 
-		// Validar stock
-		if product.Stock < item.Quantity {
-			return nil, fmt.Errorf("stock insuficiente para producto %s: disponible %d, solicitado %d", product.Name, product.Stock, item.Quantity)
+		// Asignar lotes antes de tocar el stock para no vender unidades vencidas
+		var lots []*ent.ProductLot
+		var allocations []repositories.LotAllocation
+		if product.TrackLots {
+			lots, allocations, err = uc.allocateLots(ctx, product, item.LotID, quantity, reserved)
+			if err != nil {
+				return nil, err
+			}
+		} else if item.LotID != nil {
+			return nil, fmt.Errorf("el producto %s no tiene control de lotes", product.Name)
 		}
 
-		// Validar cantidad
-		if item.Quantity <= 0 {
-			return nil, fmt.Errorf("la cantidad debe ser mayor a 0")
+		serialIDs, err := uc.validateSaleSerials(ctx, product, item.Serials, quantity)
+		if err != nil {
+			return nil, err
 		}
 
-		// Calcular subtotal
-		unitPrice := product.Price
-		subtotal := unitPrice * float64(item.Quantity)
-		total += subtotal
-
-		// Actualizar stock del producto
-		err = uc.productRepo.UpdateStock(ctx, item.ProductID, item.Quantity)
-		if err != nil {
-			return nil, fmt.Errorf("error al actualizar stock: %v", err)
+		lotDTOs := make([]InvoiceLotDTO, 0, len(allocations))
+		for i, allocation := range allocations {
+			lotDTOs = append(lotDTOs, convertLotAllocationToDTO(lots[i], allocation.Quantity))
 		}
 
 		// Preparar item para el repositorio
-		repoItems = append(repoItems, repositories.InvoiceItem{
-			ProductID: item.ProductID,
-			Quantity:  item.Quantity,
-			UnitPrice: unitPrice,
-			Subtotal:  subtotal,
-		})
+		repoItem := repositories.InvoiceItem{
+			ProductID:             item.ProductID,
+			Quantity:              quantity,
+			FromStock:             fromStock,
+			UnitPrice:             line.unitPrice,
+			Subtotal:              line.subtotal,
+			PromotionID:           line.promotionID,
+			PromotionDiscount:     line.promotionDiscount,
+			DiscountAmount:        line.discount,
+			InvoiceDiscountAmount: line.invoiceDiscount,
+			PriceRule:             line.price.Rule,
+			PriceListID:           line.price.PriceListID,
+			TaxRateID:             line.rate.ID,
+			TaxRate:               line.rate.Percent,
+			TaxBase:               line.tax.Base,
+			TaxAmount:             line.tax.Amount,
+			Lots:                  allocations,
+			SerialIDs:             serialIDs,
+		}
+		if line.unit != nil {
+			repoItem.UnitID = line.unit.ID
+			repoItem.UnitName = line.unit.Name
+			repoItem.UnitQuantity = item.Quantity
+		}
 
 		// Preparar DTO
-		itemDTOs = append(itemDTOs, InvoiceItemDTO{
-			ProductID:  item.ProductID,
-			Quantity:   item.Quantity,
-			UnitPrice:  unitPrice,
-			Subtotal:   subtotal,
-			ProductName: product.Name,
-		})
+		itemDTO := convertSaleLineToDTO(line)
+		itemDTO.Lots = lotDTOs
+		itemDTO.Serials = item.Serials
+
+		if bundle != nil {
+			// El ingreso de los componentes se reparte en moneda base, como su costo
+			repoItem.Components, itemDTO.Components = splitBundle(bundle, quote.currency.ToBase(line.tax.Base), quote.currency.Base)
+		}
+
+		repoItems = append(repoItems, repoItem)
+		itemDTOs = append(itemDTOs, itemDTO)
 	}
 
 	// Crear factura
-	inv, err := uc.invoiceRepo.Create(ctx, tenantID, userID, total, repoItems)
+	priceListID := 0
+	if quote.priceList != nil {
+		priceListID = quote.priceList.ID
+	}
+
+	customerID := 0
+	if quote.customer != nil {
+		customerID = quote.customer.ID
+	}
+
+	taxSummary, taxDTOs := taxes.Summarize(quote.taxLines)
+
+	inv, err := uc.invoiceRepo.Create(ctx, repositories.InvoiceData{
+		TenantID:           tenantID,
+		UserID:             userID,
+		PriceListID:        priceListID,
+		CustomerID:         customerID,
+		Subtotal:           quote.subtotal,
+		TaxTotal:           quote.taxTotal,
+		Total:              quote.total,
+		DiscountAmount:     quote.invoiceDiscount,
+		DiscountTotal:      quote.discountTotal,
+		DiscountApprovedBy: approvedBy,
+		PromotionTotal:     quote.promotionTotal,
+		CouponCode:         quote.couponCode,
+		PricesIncludeTax:   quote.tenant.PricesIncludeTax,
+		Currency:           quote.currency.Currency.Code,
+		ExchangeRate:       quote.currency.Rate,
+		BaseSubtotal:       quote.baseSubtotal,
+		BaseTaxTotal:       quote.baseTaxTotal,
+		BaseTotal:          quote.baseTotal,
+		Items:              repoItems,
+		Taxes:              taxSummary,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("error al crear factura: %v", err)
+		return nil, fmt.Errorf("error al crear factura: %w", err)
+	}
+
+	var numberingWarning string
+	if inv.SeriesID != nil {
+		if series, err := uc.numberingRepo.FindByID(ctx, *inv.SeriesID); err == nil {
+			numberingWarning = seriesWarning(series)
+		}
 	}
 
 	return &InvoiceDTO{
-		ID:        inv.ID,
-		Total:     inv.Total,
-		Status:    inv.Status,
-		UserID:    inv.UserID,
-		Items:     itemDTOs,
-		CreatedAt: inv.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt: inv.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:                 inv.ID,
+		Number:             invoiceNumber(inv),
+		NumberingWarning:   numberingWarning,
+		Subtotal:           inv.Subtotal,
+		TaxTotal:           inv.TaxTotal,
+		Total:              inv.Total,
+		DiscountAmount:     inv.DiscountAmount,
+		DiscountTotal:      inv.DiscountTotal,
+		DiscountApprovedBy: inv.DiscountApprovedBy,
+		PromotionTotal:     inv.PromotionTotal,
+		CouponCode:         inv.CouponCode,
+		Promotions:         quote.promotions,
+		PricesIncludeTax:   inv.PricesIncludeTax,
+		Taxes:              taxDTOs,
+		Status:             inv.Status,
+		UserID:             inv.UserID,
+		PriceListID:        inv.PriceListID,
+		Customer:           convertCustomerToDTO(quote.customer),
+		Items:              itemDTOs,
+		Currency:           inv.Currency,
+		ExchangeRate:       inv.ExchangeRate,
+		BaseSubtotal:       inv.BaseSubtotal,
+		BaseTaxTotal:       inv.BaseTaxTotal,
+		BaseTotal:          inv.BaseTotal,
+		FXGainLoss:         inv.FxGainLoss,
+		CreatedAt:          inv.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:          inv.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}, nil
 }
 
+// quote valida la factura y calcula precios, promociones, descuentos e impuestos sin tocar el
+// inventario. La usan la facturación y la vista previa de precios.
+func (uc *CreateInvoiceUseCase) quote(ctx context.Context, tenantID int, req CreateInvoiceRequest) (*invoiceQuote, error) {
+	if len(req.Items) == 0 {
+		return nil, fmt.Errorf("la factura debe tener al menos un item")
+	}
+
+	quote := &invoiceQuote{couponCode: strings.ToUpper(strings.TrimSpace(req.CouponCode))}
+
+	if req.CustomerID != nil {
+		found, err := uc.customerRepo.FindByID(ctx, *req.CustomerID)
+		if err != nil || found.TenantID != tenantID {
+			return nil, fmt.Errorf("cliente con ID %d no encontrado", *req.CustomerID)
+		}
+		if found.Archived {
+			return nil, fmt.Errorf("el cliente %s fue eliminado y no se le puede facturar", found.Name)
+		}
+		quote.customer = found
+	}
+
+	if req.PriceListID != nil {
+		list, err := uc.priceListRepo.FindByID(ctx, *req.PriceListID)
+		if err != nil || list.TenantID != tenantID {
+			return nil, fmt.Errorf("lista de precios con ID %d no encontrada", *req.PriceListID)
+		}
+		if !list.Active {
+			return nil, fmt.Errorf("la lista de precios %s está inactiva", list.Name)
+		}
+		quote.priceList = list
+	} else if quote.customer != nil && quote.customer.PriceListID != nil {
+		// Sin lista en la factura se usa la del cliente; si se desactivó se factura a precio normal
+		list, err := uc.priceListRepo.FindByID(ctx, *quote.customer.PriceListID)
+		if err == nil && list.TenantID == tenantID && list.Active {
+			quote.priceList = list
+		}
+	}
+
+	tenant, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar el tenant: %v", err)
+	}
+	quote.tenant = tenant
+	quote.currency, err = currency.Resolve(ctx, uc.rateRepo, tenant, req.Currency, req.ExchangeRate, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	rates, err := taxes.NewResolver(ctx, uc.taxRateRepo, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validar productos y fijar el precio de cada línea
+	quote.lines = make([]saleLine, 0, len(req.Items))
+	for _, item := range req.Items {
+		line, err := uc.priceLine(ctx, tenantID, item, quote.priceList, quote.currency.Base)
+		if err != nil {
+			return nil, err
+		}
+		convertLine(quote.currency, &line)
+		quote.lines = append(quote.lines, line)
+	}
+
+	// Las promociones van antes de los descuentos manuales, que se calculan sobre lo que queda;
+	// el descuento de la factura se reparte según el valor de todas las líneas
+	rules, err := uc.loadPromotions(ctx, tenantID, quote.couponCode)
+	if err != nil {
+		return nil, err
+	}
+	rules = convertPromotions(quote.currency, rules)
+	quote.promotions, err = applyPromotions(quote.lines, rules, quote.customer, quote.couponCode, time.Now(), quote.currency.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyLineDiscounts(quote.lines, quote.currency.Currency); err != nil {
+		return nil, err
+	}
+	quote.invoiceDiscount, err = applyInvoiceDiscount(quote.lines, req.Discount, quote.currency.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// Separar o sumar el impuesto según la tarifa del producto, sobre el valor después de
+	// promociones y descuentos
+	for i := range quote.lines {
+		line := &quote.lines[i]
+		line.rate, err = rates.RateFor(ctx, line.product, nil)
+		if err != nil {
+			return nil, err
+		}
+		line.tax = tax.Compute(line.net(), line.rate, tenant.PricesIncludeTax, quote.currency.Currency)
+
+		quote.taxLines = append(quote.taxLines, line.tax)
+		quote.subtotal += line.tax.Base
+		quote.taxTotal += line.tax.Amount
+		quote.total += line.tax.Total
+		quote.discountTotal += line.discount + line.invoiceDiscount
+		quote.promotionTotal += line.promotionDiscount
+	}
+	quote.subtotal = quote.subtotal.Round(quote.currency.Currency)
+	quote.taxTotal = quote.taxTotal.Round(quote.currency.Currency)
+	quote.total = quote.total.Round(quote.currency.Currency)
+	quote.discountTotal = quote.discountTotal.Round(quote.currency.Currency)
+	quote.promotionTotal = quote.promotionTotal.Round(quote.currency.Currency)
+
+	quote.baseSubtotal = quote.currency.ToBase(quote.subtotal)
+	quote.baseTaxTotal = quote.currency.ToBase(quote.taxTotal)
+	quote.baseTotal = quote.baseSubtotal + quote.baseTaxTotal
+	if !quote.currency.Foreign() {
+		quote.baseTotal = quote.total
+	}
+
+	return quote, nil
+}
+
+// convertSaleLineToDTO arma el DTO de una línea con precio; los lotes, seriales y componentes
+// los agrega quien descuenta el inventario
+func convertSaleLineToDTO(line saleLine) InvoiceItemDTO {
+	dto := InvoiceItemDTO{
+		ProductID:             line.item.ProductID,
+		Quantity:              line.quantity,
+		UnitPrice:             line.unitPrice,
+		Subtotal:              line.subtotal,
+		PromotionID:           optionalID(line.promotionID),
+		PromotionName:         line.promotionName,
+		PromotionDiscount:     line.promotionDiscount,
+		DiscountAmount:        line.discount,
+		InvoiceDiscountAmount: line.invoiceDiscount,
+		ProductName:           line.product.Name,
+		PriceRule:             line.price.Rule,
+		PriceListID:           optionalID(line.price.PriceListID),
+		TaxRateID:             taxes.RateID(line.rate),
+		TaxRate:               line.rate.Percent,
+		TaxBase:               line.tax.Base,
+		TaxAmount:             line.tax.Amount,
+	}
+
+	if line.unit != nil {
+		quantity := line.item.Quantity
+		dto.UnitID = &line.unit.ID
+		dto.UnitName = line.unit.Name
+		dto.UnitQuantity = &quantity
+	}
+
+	return dto
+}
+
+// allocateLots reparte la cantidad vendida (en unidad base) entre los lotes del producto y la
+// reserva. Con un lote elegido a mano se valida que esté vigente y alcance; si no, se consume
+// primero el que vence antes.
+func (uc *CreateInvoiceUseCase) allocateLots(ctx context.Context, product *ent.Product, lotID *int, quantity float64, reserved *reservations) ([]*ent.ProductLot, []repositories.LotAllocation, error) {
+	today := time.Now()
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+
+	if lotID != nil {
+		lot, err := uc.lotRepo.FindByID(ctx, *lotID)
+		if err != nil || lot.ProductID != product.ID {
+			return nil, nil, fmt.Errorf("lote con ID %d no encontrado para el producto %s", *lotID, product.Name)
+		}
+		if lot.ExpiryDate != nil && lot.ExpiryDate.Before(today) {
+			return nil, nil, fmt.Errorf("el lote %s del producto %s está vencido", lot.LotNumber, product.Name)
+		}
+		if available := reserved.lot(lot); available < quantity {
+			return nil, nil, fmt.Errorf("stock insuficiente en lote %s: disponible %s, solicitado %s", lot.LotNumber, qty.Format(available), qty.Format(quantity))
+		}
+		reserved.takeLot(lot.ID, quantity)
+		return []*ent.ProductLot{lot}, []repositories.LotAllocation{{LotID: lot.ID, Quantity: quantity}}, nil
+	}
+
+	available, err := uc.lotRepo.FindAvailableByProduct(ctx, product.ID, today)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error al consultar lotes: %v", err)
+	}
+
+	var lots []*ent.ProductLot
+	var allocations []repositories.LotAllocation
+	pending := quantity
+	for _, lot := range available {
+		if pending <= 0 {
+			break
+		}
+		take := reserved.lot(lot)
+		if take <= 0 {
+			continue
+		}
+		if take > pending {
+			take = pending
+		}
+		reserved.takeLot(lot.ID, take)
+		lots = append(lots, lot)
+		allocations = append(allocations, repositories.LotAllocation{LotID: lot.ID, Quantity: take})
+		pending = qty.Round(pending - take)
+	}
+
+	if pending > 0 {
+		return nil, nil, fmt.Errorf("stock vigente insuficiente para producto %s: disponible %s, solicitado %s", product.Name, qty.Format(quantity-pending), qty.Format(quantity))
+	}
+
+	return lots, allocations, nil
+}
+
+// validateSaleSerials verifica que cada unidad vendida de un producto serializado tenga un
+// número de serie en existencia y devuelve los IDs a marcar como vendidos
+func (uc *CreateInvoiceUseCase) validateSaleSerials(ctx context.Context, product *ent.Product, numbers []string, quantity float64) ([]int, error) {
+	if !product.Serialized {
+		if len(numbers) > 0 {
+			return nil, fmt.Errorf("el producto %s no controla números de serie", product.Name)
+		}
+		return nil, nil
+	}
+
+	if float64(len(numbers)) != quantity {
+		return nil, fmt.Errorf("el producto %s requiere elegir %s números de serie, se recibieron %d", product.Name, qty.Format(quantity), len(numbers))
+	}
+
+	serials, err := uc.serialRepo.FindByProductAndNumbers(ctx, product.ID, numbers)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar números de serie: %v", err)
+	}
+
+	byNumber := make(map[string]*ent.ProductSerial, len(serials))
+	for _, serial := range serials {
+		byNumber[serial.SerialNumber] = serial
+	}
+
+	ids := make([]int, 0, len(numbers))
+	seen := make(map[string]bool, len(numbers))
+	for _, number := range numbers {
+		if seen[number] {
+			return nil, fmt.Errorf("número de serie %s repetido", number)
+		}
+		seen[number] = true
+
+		serial, ok := byNumber[number]
+		if !ok {
+			return nil, fmt.Errorf("el número de serie %s no está registrado para %s", number, product.Name)
+		}
+		if serial.Status != "in_stock" {
+			return nil, fmt.Errorf("el número de serie %s no está disponible", number)
+		}
+		ids = append(ids, serial.ID)
+	}
+
+	return ids, nil
+}
+
+// reservations lleva lo que las líneas anteriores de la venta ya tomaron de cada producto y
+// lote, porque el inventario recién se descuenta al guardar la factura
+type reservations struct {
+	products map[int]float64
+	lots     map[int]float64
+}
+
+func newReservations() *reservations {
+	return &reservations{products: make(map[int]float64), lots: make(map[int]float64)}
+}
+
+// stock es el stock del producto que queda para esta línea
+func (r *reservations) stock(product *ent.Product) float64 {
+	return qty.Round(product.Stock - r.products[product.ID])
+}
+
+func (r *reservations) takeStock(productID int, quantity float64) {
+	r.products[productID] = qty.Round(r.products[productID] + quantity)
+}
+
+// lot es lo que queda del lote para esta línea
+func (r *reservations) lot(lot *ent.ProductLot) float64 {
+	return qty.Round(lot.Remaining - r.lots[lot.ID])
+}
+
+func (r *reservations) takeLot(lotID int, quantity float64) {
+	r.lots[lotID] = qty.Round(r.lots[lotID] + quantity)
+}
+
+func convertCustomerToDTO(customer *ent.Customer) *InvoiceCustomerDTO {
+	if customer == nil {
+		return nil
+	}
+
+	return &InvoiceCustomerDTO{
+		ID:             customer.ID,
+		Name:           customer.Name,
+		DocumentType:   customer.DocumentType,
+		DocumentNumber: customer.DocumentNumber,
+		TaxRegime:      customer.TaxRegime,
+		CustomerGroup:  customer.CustomerGroup,
+	}
+}
+
+func optionalID(id int) *int {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+func convertLotAllocationToDTO(lot *ent.ProductLot, quantity float64) InvoiceLotDTO {
+	var expiryDate *string
+	if lot.ExpiryDate != nil {
+		formatted := lot.ExpiryDate.Format("2006-01-02")
+		expiryDate = &formatted
+	}
+
+	return InvoiceLotDTO{
+		LotID:      lot.ID,
+		LotNumber:  lot.LotNumber,
+		ExpiryDate: expiryDate,
+		Quantity:   quantity,
+	}
+}
@@ -0,0 +1,34 @@
+package invoice
+
+import (
+	"Veritasbackend/internal/usecase/currency"
+)
+
+// convertLine pasa a la moneda de la factura el precio de una línea recién cotizada
+func convertLine(d currency.Document, line *saleLine) {
+	if !d.Foreign() {
+		return
+	}
+	line.unitPrice = d.ToDocument(line.unitPrice)
+	line.subtotal = d.ToDocument(line.subtotal).Round(d.Currency)
+}
+
+// convertPromotions pasa a la moneda de la factura los montos fijos de las promociones, que se
+// configuran en moneda base. Trabaja sobre copias para no alterar las promociones cargadas.
+func convertPromotions(d currency.Document, rules []promotionRule) []promotionRule {
+	if !d.Foreign() {
+		return rules
+	}
+
+	converted := make([]promotionRule, len(rules))
+	for i, rule := range rules {
+		promo := *rule.promotion
+		promo.MinAmount = d.ToDocument(promo.MinAmount)
+		if promo.Effect == promotionFixed || promo.Effect == promotionBundlePrice {
+			promo.Amount = d.ToDocument(promo.Amount)
+		}
+		converted[i] = promotionRule{promotion: &promo, targets: rule.targets}
+	}
+
+	return converted
+}
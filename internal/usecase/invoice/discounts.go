@@ -0,0 +1,201 @@
+package invoice
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"Veritasbackend/ent"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+	"Veritasbackend/pkg/tax"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Tipos de descuento de una línea o de la factura
+const (
+	discountPercent = "percent"
+	discountAmount  = "amount"
+)
+
+// DiscountRequest es un descuento en porcentaje (percent) o en valor fijo (amount)
+type DiscountRequest struct {
+	Type  string  `json:"type"`
+	Value float64 `json:"value"`
+}
+
+// DiscountOverrideRequest son las credenciales de quien autoriza un descuento por encima del
+// límite del vendedor
+type DiscountOverrideRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// saleLine es una línea de la factura ya validada y con precio, antes de descontar inventario
+type saleLine struct {
+	item      InvoiceItemRequest
+	product   *ent.Product
+	unit      *ent.ProductUnit
+	quantity  float64
+	price     linePrice
+	unitPrice money.Amount
+	subtotal  money.Amount
+	// promotionDiscount es lo que descontó la promoción promotionID, antes de los descuentos
+	promotionID       int
+	promotionName     string
+	promotionDiscount money.Amount
+	// discount es el descuento de la línea e invoiceDiscount su parte del descuento de la factura
+	discount        money.Amount
+	invoiceDiscount money.Amount
+	rate            tax.Rate
+	tax             tax.Line
+}
+
+// promoted es el valor de la línea después de la promoción, sobre el que se aplican los
+// descuentos. Todos los importes de la línea ya van redondeados a la moneda de la factura.
+func (l saleLine) promoted() money.Amount {
+	return l.subtotal - l.promotionDiscount
+}
+
+// net es el valor de la línea después de promociones y descuentos, sobre el que se calcula el
+// impuesto
+func (l saleLine) net() money.Amount {
+	return l.subtotal - l.promotionDiscount - l.discount - l.invoiceDiscount
+}
+
+// discountFor calcula el monto de un descuento sobre amount, redondeado a la moneda de la
+// factura; sin descuento devuelve 0
+func discountFor(discount *DiscountRequest, amount money.Amount, currency money.Currency) (money.Amount, error) {
+	if discount == nil || discount.Value == 0 {
+		return 0, nil
+	}
+	if discount.Value < 0 {
+		return 0, fmt.Errorf("el descuento no puede ser negativo")
+	}
+
+	switch discount.Type {
+	case discountPercent:
+		if discount.Value > 100 {
+			return 0, fmt.Errorf("el descuento no puede superar el 100%%")
+		}
+		return amount.Percent(discount.Value).Round(currency), nil
+	case discountAmount:
+		value := money.FromFloat(discount.Value).Round(currency)
+		if value > amount {
+			return 0, fmt.Errorf("el descuento de %s supera el valor de %s", value, amount)
+		}
+		return value, nil
+	default:
+		return 0, fmt.Errorf("tipo de descuento inválido %q: use %s o %s", discount.Type, discountPercent, discountAmount)
+	}
+}
+
+// applyLineDiscounts aplica el descuento de cada línea sobre su valor después de la promoción
+func applyLineDiscounts(lines []saleLine, currency money.Currency) error {
+	for i := range lines {
+		discount, err := discountFor(lines[i].item.Discount, lines[i].promoted(), currency)
+		if err != nil {
+			return fmt.Errorf("%s: %w", lines[i].product.Name, err)
+		}
+		lines[i].discount = discount
+	}
+
+	return nil
+}
+
+// applyInvoiceDiscount reparte el descuento de la factura entre las líneas en proporción a su
+// valor después del descuento de línea y devuelve el monto total repartido
+func applyInvoiceDiscount(lines []saleLine, discount *DiscountRequest, currency money.Currency) (money.Amount, error) {
+	weights := make([]money.Amount, len(lines))
+	for i, line := range lines {
+		weights[i] = line.promoted() - line.discount
+	}
+
+	amount, err := discountFor(discount, money.Sum(weights...), currency)
+	if err != nil {
+		return 0, err
+	}
+	if amount == 0 {
+		return 0, nil
+	}
+
+	for i, share := range money.Allocate(amount, weights, currency) {
+		lines[i].invoiceDiscount = share
+	}
+
+	return amount, nil
+}
+
+// maxDiscountPercent es el mayor descuento efectivo de las líneas, sumando el de línea y la
+// parte del de factura, sobre el valor después de la promoción. Se mide por línea para que un
+// descuento grande en un solo producto no se diluya en el total; las promociones no cuentan
+// porque ya las aprobó quien las configuró.
+func maxDiscountPercent(lines []saleLine) float64 {
+	max := 0.0
+	for _, line := range lines {
+		base := line.promoted()
+		if base <= 0 {
+			continue
+		}
+		percent := (line.discount + line.invoiceDiscount).Ratio(base) * 100
+		max = math.Max(max, math.Round(percent*100)/100)
+	}
+
+	return max
+}
+
+// discountLimit es el descuento máximo que da un rol sin autorización; los admin no tienen límite
+func discountLimit(tenant *ent.Tenant, role string) float64 {
+	switch role {
+	case "admin":
+		return 100
+	case "manager":
+		return tenant.ManagerDiscountLimit
+	default:
+		return tenant.UserDiscountLimit
+	}
+}
+
+// sellerLimit es el descuento máximo que puede dar el vendedor sin autorización
+func (uc *CreateInvoiceUseCase) sellerLimit(ctx context.Context, tenant *ent.Tenant, userID int) (float64, error) {
+	seller, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("error al consultar el vendedor: %v", err)
+	}
+	return discountLimit(tenant, seller.Role), nil
+}
+
+// authorizeDiscount valida el descuento contra el límite del vendedor. Si lo supera, exige
+// las credenciales de un usuario del tenant cuyo límite sí lo cubra y devuelve su ID; devuelve
+// 0 cuando no hizo falta autorización.
+func (uc *CreateInvoiceUseCase) authorizeDiscount(ctx context.Context, tenant *ent.Tenant, userID int, percent float64, override *DiscountOverrideRequest) (int, error) {
+	if percent == 0 {
+		return 0, nil
+	}
+
+	limit, err := uc.sellerLimit(ctx, tenant, userID)
+	if err != nil {
+		return 0, err
+	}
+	if percent <= limit {
+		return 0, nil
+	}
+
+	if override == nil || override.Email == "" {
+		return 0, fmt.Errorf("%w: el descuento de %g%% supera tu límite de %g%% y requiere autorización", pkg_errors.ErrForbidden, percent, limit)
+	}
+
+	approver, err := uc.userRepo.FindByEmail(ctx, override.Email)
+	if err != nil || approver.TenantID != tenant.ID || approver.Archived {
+		return 0, fmt.Errorf("%w: credenciales de autorización inválidas", pkg_errors.ErrForbidden)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(approver.Password), []byte(override.Password)); err != nil {
+		return 0, fmt.Errorf("%w: credenciales de autorización inválidas", pkg_errors.ErrForbidden)
+	}
+
+	if approverLimit := discountLimit(tenant, approver.Role); percent > approverLimit {
+		return 0, fmt.Errorf("%w: el descuento de %g%% supera también el límite de %s (%g%%)", pkg_errors.ErrForbidden, percent, approver.Name, approverLimit)
+	}
+
+	return approver.ID, nil
+}
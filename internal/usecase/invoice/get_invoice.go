@@ -4,18 +4,35 @@ import (
 	"context"
 	"fmt"
 
+	"Veritasbackend/ent"
 	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/usecase/taxes"
 )
 
 type GetInvoiceUseCase struct {
-	invoiceRepo repositories.InvoiceRepository
-	productRepo repositories.ProductRepository
+	invoiceRepo  repositories.InvoiceRepository
+	productRepo  repositories.ProductRepository
+	lotRepo      repositories.ProductLotRepository
+	serialRepo   repositories.ProductSerialRepository
+	customerRepo repositories.CustomerRepository
+	taxRateRepo  repositories.TaxRateRepository
 }
 
-func NewGetInvoiceUseCase(invoiceRepo repositories.InvoiceRepository, productRepo repositories.ProductRepository) *GetInvoiceUseCase {
+func NewGetInvoiceUseCase(
+	invoiceRepo repositories.InvoiceRepository,
+	productRepo repositories.ProductRepository,
+	lotRepo repositories.ProductLotRepository,
+	serialRepo repositories.ProductSerialRepository,
+	customerRepo repositories.CustomerRepository,
+	taxRateRepo repositories.TaxRateRepository,
+) *GetInvoiceUseCase {
 	return &GetInvoiceUseCase{
-		invoiceRepo: invoiceRepo,
-		productRepo: productRepo,
+		invoiceRepo:  invoiceRepo,
+		productRepo:  productRepo,
+		lotRepo:      lotRepo,
+		serialRepo:   serialRepo,
+		customerRepo: customerRepo,
+		taxRateRepo:  taxRateRepo,
 	}
 }
 
@@ -25,6 +42,78 @@ func (uc *GetInvoiceUseCase) Execute(ctx context.Context, invoiceID int) (*Invoi
 		return nil, fmt.Errorf("factura no encontrada: %v", err)
 	}
 
+	allocations, err := uc.lotRepo.FindAllocationsByInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar lotes de la factura: %v", err)
+	}
+
+	lotsByProduct := make(map[int][]InvoiceLotDTO)
+	for _, allocation := range allocations {
+		lot, err := uc.lotRepo.FindByID(ctx, allocation.LotID)
+		if err != nil {
+			continue
+		}
+		lotsByProduct[allocation.ProductID] = append(lotsByProduct[allocation.ProductID], convertLotAllocationToDTO(lot, allocation.Quantity))
+	}
+
+	components, err := uc.invoiceRepo.FindComponentsByInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar componentes de la factura: %v", err)
+	}
+
+	componentsByItem := make(map[int][]InvoiceComponentDTO)
+	for _, component := range components {
+		name := ""
+		if p, err := uc.productRepo.FindByID(ctx, component.ProductID); err == nil {
+			name = p.Name
+		}
+		componentsByItem[component.InvoiceItemID] = append(componentsByItem[component.InvoiceItemID], InvoiceComponentDTO{
+			ProductID:   component.ProductID,
+			ProductName: name,
+			Quantity:    component.Quantity,
+			Revenue:     component.Revenue,
+			Lots:        lotsByProduct[component.ProductID],
+		})
+	}
+
+	serials, err := uc.serialRepo.FindByInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar números de serie de la factura: %v", err)
+	}
+
+	serialsByProduct := make(map[int][]string)
+	for _, serial := range serials {
+		serialsByProduct[serial.ProductID] = append(serialsByProduct[serial.ProductID], serial.SerialNumber)
+	}
+
+	events, err := uc.invoiceRepo.FindStatusEvents(ctx, invoiceID)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar el historial de la factura: %v", err)
+	}
+
+	history := make([]InvoiceStatusEventDTO, len(events))
+	for i, event := range events {
+		history[i] = convertStatusEventToDTO(event)
+	}
+
+	var customer *ent.Customer
+	if inv.CustomerID != nil {
+		customer, err = uc.customerRepo.FindByID(ctx, *inv.CustomerID)
+		if err != nil {
+			customer = nil
+		}
+	}
+
+	taxLines, err := uc.taxRateRepo.FindDocumentTaxes(ctx, "invoice", invoiceID)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar los impuestos de la factura: %v", err)
+	}
+
+	taxDTOs := make([]taxes.DocumentTaxDTO, len(taxLines))
+	for i, line := range taxLines {
+		taxDTOs[i] = taxes.ConvertDocumentTaxToDTO(line)
+	}
+
 	itemDTOs := make([]InvoiceItemDTO, len(items))
 	for i, item := range items {
 		// Obtener información del producto
@@ -40,22 +129,57 @@ func (uc *GetInvoiceUseCase) Execute(ctx context.Context, invoiceID int) (*Invoi
 		}
 
 		itemDTOs[i] = InvoiceItemDTO{
-			ProductID:  item.ProductID,
-			Quantity:   item.Quantity,
-			UnitPrice:  item.UnitPrice,
-			Subtotal:   item.Subtotal,
-			ProductName: productName,
+			ProductID:             item.ProductID,
+			Quantity:              item.Quantity,
+			UnitPrice:             item.UnitPrice,
+			Subtotal:              item.Subtotal,
+			PromotionID:           item.PromotionID,
+			PromotionDiscount:     item.PromotionDiscount,
+			DiscountAmount:        item.DiscountAmount,
+			InvoiceDiscountAmount: item.InvoiceDiscountAmount,
+			ProductName:           productName,
+			PriceRule:             item.PriceRule,
+			PriceListID:           item.PriceListID,
+			TaxRateID:             item.TaxRateID,
+			TaxRate:               item.TaxRate,
+			TaxBase:               item.TaxBase,
+			TaxAmount:             item.TaxAmount,
+			UnitID:                item.UnitID,
+			UnitName:              item.UnitName,
+			UnitQuantity:          item.UnitQuantity,
+			Lots:                  lotsByProduct[item.ProductID],
+			Serials:               serialsByProduct[item.ProductID],
+			Components:            componentsByItem[item.ID],
 		}
 	}
 
 	return &InvoiceDTO{
-		ID:        inv.ID,
-		Total:     inv.Total,
-		Status:    inv.Status,
-		UserID:    inv.UserID,
-		Items:     itemDTOs,
-		CreatedAt: inv.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt: inv.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:                  inv.ID,
+		Number:              invoiceNumber(inv),
+		Subtotal:            inv.Subtotal,
+		TaxTotal:            inv.TaxTotal,
+		Total:               inv.Total,
+		DiscountAmount:      inv.DiscountAmount,
+		DiscountTotal:       inv.DiscountTotal,
+		DiscountApprovedBy:  inv.DiscountApprovedBy,
+		PromotionTotal:      inv.PromotionTotal,
+		CouponCode:          inv.CouponCode,
+		PricesIncludeTax:    inv.PricesIncludeTax,
+		Taxes:               taxDTOs,
+		Status:              inv.Status,
+		UserID:              inv.UserID,
+		PriceListID:         inv.PriceListID,
+		Customer:            convertCustomerToDTO(customer),
+		Items:               itemDTOs,
+		Currency:            inv.Currency,
+		ExchangeRate:        inv.ExchangeRate,
+		BaseSubtotal:        inv.BaseSubtotal,
+		BaseTaxTotal:        inv.BaseTaxTotal,
+		BaseTotal:           inv.BaseTotal,
+		PaymentExchangeRate: inv.PaymentExchangeRate,
+		FXGainLoss:          inv.FxGainLoss,
+		History:             history,
+		CreatedAt:           inv.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:           inv.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}, nil
 }
-
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
 )
 
 type ListInvoicesUseCase struct {
@@ -22,12 +23,16 @@ type ListInvoicesRequest struct {
 }
 
 type InvoiceSummaryDTO struct {
-	ID        int     `json:"id"`
-	Total     float64 `json:"total"`
-	Status    string  `json:"status"`
-	UserID    int     `json:"userId"`
-	CreatedAt string  `json:"createdAt"`
-	UpdatedAt string  `json:"updatedAt"`
+	ID         int          `json:"id"`
+	Number     string       `json:"number"`
+	Total      money.Amount `json:"total"`
+	Currency   string       `json:"currency"`
+	BaseTotal  money.Amount `json:"baseTotal"`
+	Status     string       `json:"status"`
+	UserID     int          `json:"userId"`
+	CustomerID *int         `json:"customerId,omitempty"`
+	CreatedAt  string       `json:"createdAt"`
+	UpdatedAt  string       `json:"updatedAt"`
 }
 
 type ListInvoicesResponse struct {
@@ -55,12 +60,16 @@ func (uc *ListInvoicesUseCase) Execute(ctx context.Context, tenantID int, req Li
 	invoiceDTOs := make([]InvoiceSummaryDTO, len(invoices))
 	for i, inv := range invoices {
 		invoiceDTOs[i] = InvoiceSummaryDTO{
-			ID:        inv.ID,
-			Total:     inv.Total,
-			Status:    inv.Status,
-			UserID:    inv.UserID,
-			CreatedAt: inv.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt: inv.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			ID:         inv.ID,
+			Number:     invoiceNumber(inv),
+			Total:      inv.Total,
+			Currency:   inv.Currency,
+			BaseTotal:  inv.BaseTotal,
+			Status:     inv.Status,
+			UserID:     inv.UserID,
+			CustomerID: inv.CustomerID,
+			CreatedAt:  inv.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			UpdatedAt:  inv.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
 	}
 
@@ -71,4 +80,3 @@ func (uc *ListInvoicesUseCase) Execute(ctx context.Context, tenantID int, req Li
 		Limit:    req.Limit,
 	}, nil
 }
-
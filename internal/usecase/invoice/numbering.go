@@ -0,0 +1,34 @@
+package invoice
+
+import (
+	"fmt"
+	"strconv"
+
+	"Veritasbackend/ent"
+)
+
+// invoiceNumber es el número de la factura en su serie. Las facturas anteriores a las series de
+// numeración se identificaban con su ID.
+func invoiceNumber(inv *ent.Invoice) string {
+	if inv.Number != nil {
+		return *inv.Number
+	}
+	return strconv.Itoa(inv.ID)
+}
+
+// seriesWarning avisa cuando a la serie le quedan alert_remaining números o menos
+func seriesWarning(series *ent.NumberingSeries) string {
+	remaining := series.RangeTo - series.CurrentNumber
+	if remaining > series.AlertRemaining {
+		return ""
+	}
+
+	name := series.Name
+	if name == "" {
+		name = series.Prefix
+	}
+	if remaining == 0 {
+		return fmt.Sprintf("la numeración %s agotó su rango autorizado (hasta %d)", name, series.RangeTo)
+	}
+	return fmt.Sprintf("a la numeración %s le quedan %d números de su rango autorizado (hasta %d)", name, remaining, series.RangeTo)
+}
@@ -0,0 +1,67 @@
+package invoice
+
+import (
+	"context"
+	"time"
+
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/usecase/currency"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+// PayInvoiceUseCase registra el cobro de una factura pendiente
+type PayInvoiceUseCase struct {
+	invoiceRepo repositories.InvoiceRepository
+	tenantRepo  repositories.TenantRepository
+	rateRepo    repositories.ExchangeRateRepository
+}
+
+func NewPayInvoiceUseCase(
+	invoiceRepo repositories.InvoiceRepository,
+	tenantRepo repositories.TenantRepository,
+	rateRepo repositories.ExchangeRateRepository,
+) *PayInvoiceUseCase {
+	return &PayInvoiceUseCase{
+		invoiceRepo: invoiceRepo,
+		tenantRepo:  tenantRepo,
+		rateRepo:    rateRepo,
+	}
+}
+
+func (uc *PayInvoiceUseCase) Execute(ctx context.Context, tenantID, userID, invoiceID int, req InvoiceStatusRequest) error {
+	payment, err := uc.payment(ctx, tenantID, invoiceID, req)
+	if err != nil {
+		return err
+	}
+
+	return changeInvoiceStatus(ctx, uc.invoiceRepo, tenantID, userID, invoiceID, statusPaid, req, payment)
+}
+
+// payment calcula la diferencia en cambio del cobro de una factura en moneda extranjera: lo
+// cobrado convertido con la tasa del cobro menos lo facturado en moneda base. Positiva es
+// ganancia. Las facturas en moneda base no llevan datos de cobro.
+func (uc *PayInvoiceUseCase) payment(ctx context.Context, tenantID, invoiceID int, req InvoiceStatusRequest) (*repositories.InvoicePayment, error) {
+	inv, _, err := uc.invoiceRepo.FindByID(ctx, invoiceID)
+	if err != nil || inv.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	tenant, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+	base := currency.TenantCurrency(tenant)
+	if inv.Status != statusPending || inv.Currency == base.Code {
+		return nil, nil
+	}
+
+	rate, err := currency.ExchangeRateFor(ctx, uc.rateRepo, tenantID, inv.Currency, req.ExchangeRate, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &repositories.InvoicePayment{
+		ExchangeRate: rate,
+		FXGainLoss:   inv.Total.Exchange(rate, base) - inv.BaseTotal,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package invoice
+
+import (
+	"context"
+
+	"Veritasbackend/internal/usecase/taxes"
+	"Veritasbackend/pkg/money"
+)
+
+// PreviewInvoiceUseCase calcula los precios de una factura sin crearla ni tocar el inventario
+type PreviewInvoiceUseCase struct {
+	createInvoiceUseCase *CreateInvoiceUseCase
+}
+
+func NewPreviewInvoiceUseCase(createInvoiceUseCase *CreateInvoiceUseCase) *PreviewInvoiceUseCase {
+	return &PreviewInvoiceUseCase{createInvoiceUseCase: createInvoiceUseCase}
+}
+
+type InvoicePreviewDTO struct {
+	Subtotal         money.Amount           `json:"subtotal"`
+	TaxTotal         money.Amount           `json:"taxTotal"`
+	Total            money.Amount           `json:"total"`
+	DiscountAmount   money.Amount           `json:"discountAmount"`
+	DiscountTotal    money.Amount           `json:"discountTotal"`
+	PromotionTotal   money.Amount           `json:"promotionTotal"`
+	CouponCode       string                 `json:"couponCode,omitempty"`
+	Promotions       []AppliedPromotionDTO  `json:"promotions"`
+	PricesIncludeTax bool                   `json:"pricesIncludeTax"`
+	Taxes            []taxes.DocumentTaxDTO `json:"taxes"`
+	PriceListID      *int                   `json:"priceListId,omitempty"`
+	Customer         *InvoiceCustomerDTO    `json:"customer,omitempty"`
+	Items            []InvoiceItemDTO       `json:"items"`
+	Currency         string                 `json:"currency"`
+	ExchangeRate     float64                `json:"exchangeRate"`
+	BaseTotal        money.Amount           `json:"baseTotal"`
+	// MaxDiscountPercent es el mayor descuento manual de las líneas; si supera DiscountLimit,
+	// el del vendedor, la factura necesitará autorización (RequiresApproval)
+	MaxDiscountPercent float64 `json:"maxDiscountPercent"`
+	DiscountLimit      float64 `json:"discountLimit"`
+	RequiresApproval   bool    `json:"requiresApproval"`
+}
+
+// Execute acepta la misma petición que la creación de facturas. No valida stock, lotes ni
+// seriales, que pueden cambiar antes de facturar.
+func (uc *PreviewInvoiceUseCase) Execute(ctx context.Context, tenantID, userID int, req CreateInvoiceRequest) (*InvoicePreviewDTO, error) {
+	quote, err := uc.createInvoiceUseCase.quote(ctx, tenantID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := uc.createInvoiceUseCase.sellerLimit(ctx, quote.tenant, userID)
+	if err != nil {
+		return nil, err
+	}
+	maxPercent := maxDiscountPercent(quote.lines)
+
+	items := make([]InvoiceItemDTO, len(quote.lines))
+	for i, line := range quote.lines {
+		items[i] = convertSaleLineToDTO(line)
+	}
+
+	var priceListID *int
+	if quote.priceList != nil {
+		priceListID = &quote.priceList.ID
+	}
+
+	promotions := quote.promotions
+	if promotions == nil {
+		promotions = []AppliedPromotionDTO{}
+	}
+
+	_, taxDTOs := taxes.Summarize(quote.taxLines)
+
+	return &InvoicePreviewDTO{
+		Subtotal:           quote.subtotal,
+		TaxTotal:           quote.taxTotal,
+		Total:              quote.total,
+		DiscountAmount:     quote.invoiceDiscount,
+		DiscountTotal:      quote.discountTotal,
+		PromotionTotal:     quote.promotionTotal,
+		CouponCode:         quote.couponCode,
+		Promotions:         promotions,
+		PricesIncludeTax:   quote.tenant.PricesIncludeTax,
+		Taxes:              taxDTOs,
+		PriceListID:        priceListID,
+		Customer:           convertCustomerToDTO(quote.customer),
+		Items:              items,
+		Currency:           quote.currency.Currency.Code,
+		ExchangeRate:       quote.currency.Rate,
+		BaseTotal:          quote.baseTotal,
+		MaxDiscountPercent: maxPercent,
+		DiscountLimit:      limit,
+		RequiresApproval:   maxPercent > limit,
+	}, nil
+}
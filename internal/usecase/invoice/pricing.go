@@ -0,0 +1,146 @@
+package invoice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/pkg/money"
+)
+
+// Reglas de precio que se guardan en cada línea de la factura
+const (
+	priceRuleRetail      = "retail"
+	priceRuleWholesale   = "wholesale"
+	priceRuleListFixed   = "price_list_fixed"
+	priceRuleListPercent = "price_list_percent"
+	priceRuleUnit        = "unit"
+)
+
+// linePrice es el precio unitario de una línea y la regla que lo fijó
+type linePrice struct {
+	UnitPrice   money.Amount
+	Rule        string
+	PriceListID int
+}
+
+// priceLine valida el producto y la cantidad de una línea y le fija el precio vigente, sin tocar
+// el inventario. Los precios del catálogo van en currency, la moneda base del tenant.
+func (uc *CreateInvoiceUseCase) priceLine(ctx context.Context, tenantID int, item InvoiceItemRequest, priceList *ent.PriceList, currency money.Currency) (saleLine, error) {
+	// Obtener producto
+	product, err := uc.productRepo.FindByID(ctx, item.ProductID)
+	if err != nil {
+		return saleLine{}, fmt.Errorf("producto con ID %d no encontrado", item.ProductID)
+	}
+
+	// Validar que el producto pertenece al tenant
+	if product.TenantID != tenantID {
+		return saleLine{}, fmt.Errorf("producto con ID %d no pertenece a tu tenant", item.ProductID)
+	}
+	if product.Archived {
+		return saleLine{}, fmt.Errorf("el producto %s fue eliminado y no se puede vender", product.Name)
+	}
+
+	// Validar cantidad
+	if item.Quantity <= 0 {
+		return saleLine{}, fmt.Errorf("la cantidad debe ser mayor a 0")
+	}
+
+	// Pasar la cantidad a la unidad base, que es en la que se lleva el stock
+	unit, quantity, err := uc.toBaseQuantity(ctx, product, item)
+	if err != nil {
+		return saleLine{}, err
+	}
+
+	// Calcular subtotal con el precio vigente al momento de la venta. Una unidad con precio
+	// propio (por ejemplo la caja) lo usa mientras no rija un precio mayorista o de lista.
+	price, err := uc.resolvePrice(ctx, product, quantity, priceList, currency)
+	if err != nil {
+		return saleLine{}, err
+	}
+	unitPrice := price.UnitPrice
+	subtotal := unitPrice.Mul(quantity).Round(currency)
+	if unit != nil && unit.RetailPrice != nil && price.Rule == priceRuleRetail {
+		subtotal = unit.RetailPrice.Mul(item.Quantity).Round(currency)
+		unitPrice = subtotal.Div(quantity)
+		price.Rule = priceRuleUnit
+	}
+
+	return saleLine{
+		item:      item,
+		product:   product,
+		unit:      unit,
+		quantity:  quantity,
+		price:     price,
+		unitPrice: unitPrice,
+		subtotal:  subtotal,
+	}, nil
+}
+
+// resolvePrice elige el precio de la línea. Sin lista se usa el precio detal, o el mayor si la
+// cantidad alcanza el mínimo del producto. Con lista, el tramo del producto que corresponda a
+// la cantidad tiene prioridad; si no hay tramo, se aplica el descuento general de la lista.
+// La cantidad y el precio van en la unidad base del producto.
+func (uc *CreateInvoiceUseCase) resolvePrice(ctx context.Context, product *ent.Product, quantity float64, priceList *ent.PriceList, currency money.Currency) (linePrice, error) {
+	retail, wholesale, err := uc.effectivePrices(ctx, product)
+	if err != nil {
+		return linePrice{}, err
+	}
+
+	result := linePrice{UnitPrice: retail, Rule: priceRuleRetail}
+	if wholesale > 0 && product.MinWholesaleQuantity > 0 && quantity >= float64(product.MinWholesaleQuantity) {
+		result = linePrice{UnitPrice: wholesale, Rule: priceRuleWholesale}
+	}
+
+	if priceList == nil {
+		return result, nil
+	}
+
+	items, err := uc.priceListRepo.FindItemsByProduct(ctx, priceList.ID, product.ID)
+	if err != nil {
+		return linePrice{}, fmt.Errorf("error al consultar la lista de precios: %v", err)
+	}
+
+	for _, item := range items {
+		if float64(item.MinQuantity) > quantity {
+			continue
+		}
+		if item.Price != nil {
+			return linePrice{UnitPrice: *item.Price, Rule: priceRuleListFixed, PriceListID: priceList.ID}, nil
+		}
+		if item.DiscountPercent != nil {
+			return linePrice{UnitPrice: applyPercent(retail, *item.DiscountPercent, currency), Rule: priceRuleListPercent, PriceListID: priceList.ID}, nil
+		}
+	}
+
+	if priceList.DiscountPercent != 0 {
+		result = linePrice{UnitPrice: applyPercent(result.UnitPrice, priceList.DiscountPercent, currency), Rule: priceRuleListPercent, PriceListID: priceList.ID}
+	}
+
+	return result, nil
+}
+
+// effectivePrices devuelve los precios detal y mayor que rigen ahora. Un cambio programado cuya
+// fecha ya llegó se respeta aunque el programador todavía no lo haya copiado al producto.
+func (uc *CreateInvoiceUseCase) effectivePrices(ctx context.Context, product *ent.Product) (money.Amount, money.Amount, error) {
+	retail := product.RetailPrice
+	wholesale := product.WholesalePrice
+
+	price, err := uc.priceRepo.FindEffective(ctx, product.ID, time.Now())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return retail, wholesale, nil
+		}
+		return 0, 0, fmt.Errorf("error al consultar el precio vigente: %v", err)
+	}
+
+	if price.WholesalePrice != nil {
+		wholesale = *price.WholesalePrice
+	}
+	return price.RetailPrice, wholesale, nil
+}
+
+func applyPercent(price money.Amount, discountPercent float64, currency money.Currency) money.Amount {
+	return price.Mul(1 - discountPercent/100).Round(currency)
+}
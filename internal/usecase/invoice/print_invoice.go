@@ -0,0 +1,219 @@
+package invoice
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/usecase/currency"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/imaging"
+	"Veritasbackend/pkg/invoicepdf"
+	"Veritasbackend/pkg/money"
+	"Veritasbackend/pkg/quantity"
+)
+
+type PrintInvoiceUseCase struct {
+	invoiceRepo       repositories.InvoiceRepository
+	tenantRepo        repositories.TenantRepository
+	customerRepo      repositories.CustomerRepository
+	getInvoiceUseCase *GetInvoiceUseCase
+}
+
+func NewPrintInvoiceUseCase(
+	invoiceRepo repositories.InvoiceRepository,
+	tenantRepo repositories.TenantRepository,
+	customerRepo repositories.CustomerRepository,
+	getInvoiceUseCase *GetInvoiceUseCase,
+) *PrintInvoiceUseCase {
+	return &PrintInvoiceUseCase{
+		invoiceRepo:       invoiceRepo,
+		tenantRepo:        tenantRepo,
+		customerRepo:      customerRepo,
+		getInvoiceUseCase: getInvoiceUseCase,
+	}
+}
+
+type InvoiceFile struct {
+	ContentType string
+	FileName    string
+	Data        []byte
+}
+
+var invoiceStatusLabels = map[string]string{
+	"pending":   "Pendiente de pago",
+	"paid":      "Pagada",
+	"cancelled": "Cancelada",
+	"voided":    "Anulada",
+}
+
+// Execute imprime la factura en PDF, en hoja A4 o en recibo de 80 mm según layout, con los datos
+// del tenant en el encabezado
+func (uc *PrintInvoiceUseCase) Execute(ctx context.Context, tenantID, invoiceID int, layout string) (*InvoiceFile, error) {
+	inv, _, err := uc.invoiceRepo.FindByID(ctx, invoiceID)
+	if err != nil || inv.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	t, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	dto, err := uc.getInvoiceUseCase.Execute(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	base := currency.TenantCurrency(t)
+	docCurrency, ok := money.Lookup(dto.Currency)
+	if !ok {
+		docCurrency = base
+	}
+	format := func(a money.Amount) string {
+		return a.Format(docCurrency)
+	}
+
+	doc := invoicepdf.Document{
+		Title:      "Factura de venta",
+		Number:     "N.º " + invoiceNumber(inv),
+		Date:       inv.CreatedAt.Format("2006-01-02 15:04"),
+		Status:     invoiceStatusLabels[inv.Status],
+		Issuer:     issuerParty(t),
+		Logo:       tenantLogo(t),
+		PartyLabel: "Cliente",
+	}
+
+	if inv.CustomerID != nil {
+		if customer, err := uc.customerRepo.FindByID(ctx, *inv.CustomerID); err == nil {
+			doc.Party = customerParty(customer)
+		}
+	}
+	if doc.Party.Name == "" {
+		doc.Party.Name = "Consumidor final"
+	}
+
+	for _, item := range dto.Items {
+		qty := quantity.Format(item.Quantity)
+		displayQty := item.Quantity
+		if item.UnitQuantity != nil {
+			displayQty = *item.UnitQuantity
+			qty = strings.TrimSpace(quantity.Format(displayQty) + " " + item.UnitName)
+		}
+
+		unitPrice := item.UnitPrice
+		if displayQty != 0 {
+			unitPrice = item.Subtotal.Div(displayQty)
+		}
+
+		discount := item.PromotionDiscount.Add(item.DiscountAmount)
+		line := invoicepdf.Line{
+			Description: item.ProductName,
+			Quantity:    qty,
+			UnitPrice:   format(unitPrice),
+			Total:       format(item.Subtotal.Sub(discount)),
+		}
+		if !discount.IsZero() {
+			line.Discount = format(discount)
+		}
+		doc.Lines = append(doc.Lines, line)
+	}
+
+	for _, tax := range dto.Taxes {
+		doc.Taxes = append(doc.Taxes, invoicepdf.Tax{
+			Name:   fmt.Sprintf("%s %s%%", tax.Name, quantity.Format(tax.Rate)),
+			Base:   format(tax.Base),
+			Amount: format(tax.Amount),
+		})
+	}
+
+	discounts := dto.DiscountTotal.Add(dto.PromotionTotal)
+	gross := dto.Subtotal.Add(discounts)
+	doc.Totals = append(doc.Totals, invoicepdf.Total{Label: "Valor bruto", Value: format(gross)})
+	if !discounts.IsZero() {
+		doc.Totals = append(doc.Totals, invoicepdf.Total{Label: "Descuentos", Value: "-" + format(discounts)})
+	}
+	doc.Totals = append(doc.Totals,
+		invoicepdf.Total{Label: "Base sin impuestos", Value: format(dto.Subtotal)},
+		invoicepdf.Total{Label: "Impuestos", Value: format(dto.TaxTotal)},
+		invoicepdf.Total{Label: "Total " + docCurrency.Code, Value: format(dto.Total), Bold: true},
+	)
+
+	if docCurrency.Code != base.Code {
+		doc.Totals = append(doc.Totals, invoicepdf.Total{
+			Label: fmt.Sprintf("Total %s (tasa %s)", base.Code, quantity.Format(dto.ExchangeRate)),
+			Value: dto.BaseTotal.Format(base),
+		})
+	}
+
+	if dto.CouponCode != "" {
+		doc.Notes = append(doc.Notes, "Cupón aplicado: "+dto.CouponCode)
+	}
+	if dto.PricesIncludeTax {
+		doc.Notes = append(doc.Notes, "Los precios incluyen impuestos")
+	}
+	doc.Notes = append(doc.Notes, "Gracias por su compra")
+
+	doc.QR = strings.Join([]string{
+		"Factura: " + doc.Number,
+		"Emisor: " + strings.TrimSpace(doc.Issuer.Name+" "+doc.Issuer.TaxID),
+		"Fecha: " + doc.Date,
+		"Total: " + format(dto.Total) + " " + docCurrency.Code,
+		"Estado: " + doc.Status,
+	}, "\n")
+
+	data, err := invoicepdf.Render(doc, layout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InvoiceFile{
+		ContentType: "application/pdf",
+		FileName:    fmt.Sprintf("factura-%s.pdf", invoiceNumber(inv)),
+		Data:        data,
+	}, nil
+}
+
+// issuerParty son los datos del tenant que se imprimen en el encabezado; sin razón social se usa
+// el nombre del tenant
+func issuerParty(t *ent.Tenant) invoicepdf.Party {
+	party := invoicepdf.Party{
+		Name:    t.Name,
+		Address: t.Address,
+		Phone:   t.Phone,
+		Email:   t.Email,
+	}
+	if t.LegalName != "" {
+		party.Name = t.LegalName
+	}
+	if t.TaxID != "" {
+		party.TaxID = "NIT " + t.TaxID
+	}
+	return party
+}
+
+// tenantLogo decodifica el logo guardado; un logo ilegible se omite en vez de impedir la impresión
+func tenantLogo(t *ent.Tenant) image.Image {
+	if len(t.Logo) == 0 {
+		return nil
+	}
+	img, _, err := imaging.Decode(bytes.NewReader(t.Logo))
+	if err != nil {
+		return nil
+	}
+	return img
+}
+
+func customerParty(customer *ent.Customer) invoicepdf.Party {
+	return invoicepdf.Party{
+		Name:    customer.Name,
+		TaxID:   strings.TrimSpace(customer.DocumentType + " " + customer.DocumentNumber),
+		Address: customer.Address,
+		Phone:   customer.Phone,
+		Email:   customer.Email,
+	}
+}
@@ -0,0 +1,311 @@
+package invoice
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+// Efectos de una promoción sobre las líneas que cumplen sus condiciones
+const (
+	promotionPercent     = "percent"
+	promotionFixed       = "fixed"
+	promotionFreeItem    = "free_item"
+	promotionBundlePrice = "bundle_price"
+)
+
+// AppliedPromotionDTO es una promoción aplicada a la factura y el total que descontó
+type AppliedPromotionDTO struct {
+	PromotionID int          `json:"promotionId"`
+	Name        string       `json:"name"`
+	Effect      string       `json:"effect"`
+	CouponCode  string       `json:"couponCode,omitempty"`
+	Amount      money.Amount `json:"amount"`
+}
+
+// promotionRule es una promoción con los productos y categorías a los que aplica
+type promotionRule struct {
+	promotion *ent.Promotion
+	targets   []*ent.PromotionTarget
+}
+
+// loadPromotions trae las promociones activas del tenant. Las que tienen cupón solo se
+// consideran si la factura trae ese código; un cupón que no existe es un error.
+func (uc *CreateInvoiceUseCase) loadPromotions(ctx context.Context, tenantID int, couponCode string) ([]promotionRule, error) {
+	active, err := uc.promotionRepo.FindActive(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar promociones: %v", err)
+	}
+
+	promotions := make([]*ent.Promotion, 0, len(active))
+	ids := make([]int, 0, len(active))
+	couponFound := false
+	for _, promo := range active {
+		if promo.CouponCode != "" && promo.CouponCode != couponCode {
+			continue
+		}
+		if promo.CouponCode != "" {
+			couponFound = true
+		}
+		promotions = append(promotions, promo)
+		ids = append(ids, promo.ID)
+	}
+	if couponCode != "" && !couponFound {
+		return nil, fmt.Errorf("el cupón %s no existe o no está activo", couponCode)
+	}
+	if len(promotions) == 0 {
+		return nil, nil
+	}
+
+	targets, err := uc.promotionRepo.FindTargets(ctx, ids...)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar promociones: %v", err)
+	}
+
+	rules := make([]promotionRule, len(promotions))
+	for i, promo := range promotions {
+		rules[i] = promotionRule{promotion: promo, targets: targets[promo.ID]}
+	}
+
+	return rules, nil
+}
+
+// applyPromotions asigna a cada línea como máximo una promoción. En cada ronda gana, entre las
+// promociones vigentes que todavía no se aplicaron, la de mayor prioridad y a igual prioridad
+// la que más descuenta sobre las líneas libres; se repite hasta que ninguna descuente nada.
+// Los descuentos se redondean a currency, la moneda de la factura.
+func applyPromotions(lines []saleLine, rules []promotionRule, customer *ent.Customer, couponCode string, now time.Time, currency money.Currency) ([]AppliedPromotionDTO, error) {
+	var applied []AppliedPromotionDTO
+	used := make(map[int]bool, len(rules))
+
+	for {
+		bestIndex := -1
+		var bestLines []int
+		var bestShares []money.Amount
+		bestAmount := money.Zero
+
+		for i, rule := range rules {
+			if used[rule.promotion.ID] || !promotionOpen(rule.promotion, customer, now) {
+				continue
+			}
+
+			matching := make([]int, 0, len(lines))
+			for j, line := range lines {
+				if line.promotionID == 0 && rule.matches(line.product) {
+					matching = append(matching, j)
+				}
+			}
+
+			shares := promotionShares(rule.promotion, lines, matching, currency)
+			amount := money.Sum(shares...)
+			if amount <= 0 {
+				continue
+			}
+
+			if bestIndex == -1 || rule.promotion.Priority > rules[bestIndex].promotion.Priority ||
+				(rule.promotion.Priority == rules[bestIndex].promotion.Priority && amount > bestAmount) {
+				bestIndex, bestLines, bestShares, bestAmount = i, matching, shares, amount
+			}
+		}
+
+		if bestIndex == -1 {
+			break
+		}
+
+		promo := rules[bestIndex].promotion
+		used[promo.ID] = true
+		for k, j := range bestLines {
+			if bestShares[k] == 0 {
+				continue
+			}
+			lines[j].promotionID = promo.ID
+			lines[j].promotionName = promo.Name
+			lines[j].promotionDiscount = bestShares[k]
+		}
+		applied = append(applied, AppliedPromotionDTO{
+			PromotionID: promo.ID,
+			Name:        promo.Name,
+			Effect:      promo.Effect,
+			CouponCode:  promo.CouponCode,
+			Amount:      bestAmount,
+		})
+	}
+
+	if couponCode != "" {
+		for _, promo := range applied {
+			if promo.CouponCode == couponCode {
+				return applied, nil
+			}
+		}
+		return nil, fmt.Errorf("el cupón %s no aplica a esta factura", couponCode)
+	}
+
+	return applied, nil
+}
+
+// matches indica si la promoción aplica al producto; sin productos ni categorías aplica a todos
+func (r promotionRule) matches(product *ent.Product) bool {
+	if len(r.targets) == 0 {
+		return true
+	}
+
+	for _, target := range r.targets {
+		if target.ProductID != nil && *target.ProductID == product.ID {
+			return true
+		}
+		if target.Category != "" && strings.EqualFold(target.Category, product.Category) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// promotionOpen revisa la vigencia de la promoción en el momento de la venta: fechas, días de
+// la semana, franja horaria y grupo del cliente
+func promotionOpen(promo *ent.Promotion, customer *ent.Customer, now time.Time) bool {
+	if promo.StartsAt != nil && now.Before(*promo.StartsAt) {
+		return false
+	}
+	if promo.EndsAt != nil && now.After(*promo.EndsAt) {
+		return false
+	}
+
+	if promo.Weekdays != "" {
+		today := int(now.Weekday())
+		if today == 0 {
+			today = 7
+		}
+		found := false
+		for _, day := range strings.Split(promo.Weekdays, ",") {
+			if n, err := strconv.Atoi(strings.TrimSpace(day)); err == nil && n == today {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if promo.StartTime != "" && promo.EndTime != "" {
+		clock := now.Format("15:04")
+		if promo.StartTime <= promo.EndTime {
+			if clock < promo.StartTime || clock >= promo.EndTime {
+				return false
+			}
+		} else if clock < promo.StartTime && clock >= promo.EndTime {
+			// La franja cruza la medianoche, por ejemplo de 22:00 a 02:00
+			return false
+		}
+	}
+
+	if promo.CustomerGroup != "" {
+		if customer == nil || !strings.EqualFold(customer.CustomerGroup, promo.CustomerGroup) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// promotionShares calcula lo que la promoción descuenta en cada una de las líneas indicadas.
+// Devuelve ceros si las líneas no alcanzan la cantidad o el valor mínimo.
+func promotionShares(promo *ent.Promotion, lines []saleLine, matching []int, currency money.Currency) []money.Amount {
+	shares := make([]money.Amount, len(matching))
+	if len(matching) == 0 {
+		return shares
+	}
+
+	quantity := 0.0
+	weights := make([]money.Amount, len(matching))
+	for k, j := range matching {
+		quantity += lines[j].quantity
+		weights[k] = lines[j].subtotal
+	}
+	quantity = qty.Round(quantity)
+	amount := money.Sum(weights...)
+	if quantity < promo.MinQuantity || amount < promo.MinAmount {
+		return shares
+	}
+
+	switch promo.Effect {
+	case promotionPercent:
+		for k, j := range matching {
+			shares[k] = lines[j].subtotal.Percent(promo.Percent).Round(currency)
+		}
+	case promotionFixed:
+		value := promo.Amount.Round(currency)
+		shares = money.Allocate(money.Min(value, amount), weights, currency)
+	case promotionFreeItem:
+		if promo.BuyQuantity <= 0 || promo.FreeQuantity <= 0 {
+			return shares
+		}
+		// Las unidades de regalo son las más baratas entre las que cumplen
+		groups := math.Floor(qty.Round(quantity / (promo.BuyQuantity + promo.FreeQuantity)))
+		free := groups * promo.FreeQuantity
+		for _, k := range unitsByPrice(lines, matching, false) {
+			if free <= 0 {
+				break
+			}
+			units := math.Min(free, lines[matching[k]].quantity)
+			shares[k] = lines[matching[k]].unitPrice.Mul(units).Round(currency)
+			free = qty.Round(free - units)
+		}
+	case promotionBundlePrice:
+		if promo.BuyQuantity <= 0 {
+			return shares
+		}
+		// Los grupos se arman con las unidades más caras y cada grupo cuesta Amount
+		groups := math.Floor(qty.Round(quantity / promo.BuyQuantity))
+		grouped := groups * promo.BuyQuantity
+		regular := make([]money.Amount, len(matching))
+		regularTotal := money.Zero
+		for _, k := range unitsByPrice(lines, matching, true) {
+			if grouped <= 0 {
+				break
+			}
+			units := math.Min(grouped, lines[matching[k]].quantity)
+			regular[k] = lines[matching[k]].unitPrice.Mul(units).Round(currency)
+			regularTotal += regular[k]
+			grouped = qty.Round(grouped - units)
+		}
+		discount := regularTotal - promo.Amount.Mul(groups).Round(currency)
+		if discount <= 0 {
+			return shares
+		}
+		shares = money.Allocate(discount, regular, currency)
+	}
+
+	// Ninguna promoción deja una línea en negativo
+	for k, j := range matching {
+		shares[k] = money.Max(0, money.Min(shares[k], lines[j].subtotal))
+	}
+
+	return shares
+}
+
+// unitsByPrice ordena las posiciones de matching por precio unitario de la línea
+func unitsByPrice(lines []saleLine, matching []int, descending bool) []int {
+	order := make([]int, len(matching))
+	for k := range order {
+		order[k] = k
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		pa, pb := lines[matching[order[a]]].unitPrice, lines[matching[order[b]]].unitPrice
+		if descending {
+			return pa > pb
+		}
+		return pa < pb
+	})
+
+	return order
+}
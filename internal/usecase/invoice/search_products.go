@@ -4,27 +4,36 @@ import (
 	"context"
 
 	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
 )
 
 type SearchProductsUseCase struct {
 	invoiceRepo repositories.InvoiceRepository
+	bundleRepo  repositories.BundleRepository
 }
 
-func NewSearchProductsUseCase(invoiceRepo repositories.InvoiceRepository) *SearchProductsUseCase {
+func NewSearchProductsUseCase(invoiceRepo repositories.InvoiceRepository, bundleRepo repositories.BundleRepository) *SearchProductsUseCase {
 	return &SearchProductsUseCase{
 		invoiceRepo: invoiceRepo,
+		bundleRepo:  bundleRepo,
 	}
 }
 
 type ProductDTO struct {
-	ID          int     `json:"id"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price"`
-	Stock       int     `json:"stock"`
-	SKU         string  `json:"sku"`
-	CreatedAt   string  `json:"createdAt"`
-	UpdatedAt   string  `json:"updatedAt"`
+	ID                   int          `json:"id"`
+	Name                 string       `json:"name"`
+	Description          string       `json:"description"`
+	RetailPrice          money.Amount `json:"retailPrice"`
+	WholesalePrice       money.Amount `json:"wholesalePrice"`
+	MinWholesaleQuantity int          `json:"minWholesaleQuantity"`
+	Stock                float64      `json:"stock"`
+	Available            float64      `json:"available"`
+	Bundle               bool         `json:"bundle"`
+	BaseUnit             string       `json:"baseUnit"`
+	Fractional           bool         `json:"fractional"`
+	SKU                  string       `json:"sku"`
+	CreatedAt            string       `json:"createdAt"`
+	UpdatedAt            string       `json:"updatedAt"`
 }
 
 type SearchProductsResponse struct {
@@ -41,17 +50,34 @@ func (uc *SearchProductsUseCase) Execute(ctx context.Context, tenantID int, quer
 		return nil, err
 	}
 
+	var bundleIDs []int
+	for _, p := range products {
+		if p.Bundle {
+			bundleIDs = append(bundleIDs, p.ID)
+		}
+	}
+	buildable, err := uc.bundleRepo.Buildable(ctx, bundleIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	productDTOs := make([]ProductDTO, len(products))
 	for i, p := range products {
 		productDTOs[i] = ProductDTO{
-			ID:          p.ID,
-			Name:        p.Name,
-			Description: p.Description,
-			Price:       p.Price,
-			Stock:       p.Stock,
-			SKU:         p.Sku,
-			CreatedAt:   p.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt:   p.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			ID:                   p.ID,
+			Name:                 p.Name,
+			Description:          p.Description,
+			RetailPrice:          p.RetailPrice,
+			WholesalePrice:       p.WholesalePrice,
+			MinWholesaleQuantity: p.MinWholesaleQuantity,
+			Stock:                p.Stock,
+			Available:            p.Stock + buildable[p.ID],
+			Bundle:               p.Bundle,
+			BaseUnit:             p.BaseUnit,
+			Fractional:           p.Fractional,
+			SKU:                  p.Sku,
+			CreatedAt:            p.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			UpdatedAt:            p.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
 	}
 
@@ -59,4 +85,3 @@ func (uc *SearchProductsUseCase) Execute(ctx context.Context, tenantID int, quer
 		Products: productDTOs,
 	}, nil
 }
-
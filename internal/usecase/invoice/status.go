@@ -0,0 +1,86 @@
+package invoice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+const (
+	statusPending   = "pending"
+	statusPaid      = "paid"
+	statusCancelled = "cancelled"
+	statusVoided    = "voided"
+)
+
+// invoiceTransition describe desde qué estado se llega a otro y si el cambio devuelve la
+// mercadería al inventario
+type invoiceTransition struct {
+	from          string
+	restock       bool
+	requireReason bool
+}
+
+// invoiceTransitions es la máquina de estados de la factura de venta: una factura pendiente se
+// cobra o se cancela, y una cobrada solo se puede anular. Cancelada y anulada son finales.
+var invoiceTransitions = map[string]invoiceTransition{
+	statusPaid:      {from: statusPending},
+	statusCancelled: {from: statusPending, restock: true, requireReason: true},
+	statusVoided:    {from: statusPaid, restock: true, requireReason: true},
+}
+
+type InvoiceStatusRequest struct {
+	Reason string `json:"reason"`
+	// ExchangeRate es la tasa del cobro de una factura en moneda extranjera; sin ella se usa la
+	// tasa del día
+	ExchangeRate *float64 `json:"exchangeRate,omitempty"`
+}
+
+type InvoiceStatusEventDTO struct {
+	FromStatus string `json:"fromStatus"`
+	ToStatus   string `json:"toStatus"`
+	Reason     string `json:"reason,omitempty"`
+	UserID     int    `json:"userId"`
+	CreatedAt  string `json:"createdAt"`
+}
+
+// changeInvoiceStatus valida la transición contra el estado actual de la factura y la aplica.
+// payment solo se envía al cobrar.
+func changeInvoiceStatus(ctx context.Context, invoiceRepo repositories.InvoiceRepository, tenantID, userID, invoiceID int, to string, req InvoiceStatusRequest, payment *repositories.InvoicePayment) error {
+	transition := invoiceTransitions[to]
+
+	inv, _, err := invoiceRepo.FindByID(ctx, invoiceID)
+	if err != nil || inv.TenantID != tenantID {
+		return pkg_errors.ErrNotFound
+	}
+
+	if inv.Status != transition.from {
+		return fmt.Errorf("%w: la factura está en estado %s y no puede pasar a %s", pkg_errors.ErrInvalidState, inv.Status, to)
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if transition.requireReason && reason == "" {
+		return fmt.Errorf("el motivo es obligatorio")
+	}
+
+	_, err = invoiceRepo.ChangeStatus(ctx, invoiceID, userID, transition.from, to, reason, transition.restock, payment)
+	if err != nil {
+		return fmt.Errorf("error al cambiar el estado de la factura: %w", err)
+	}
+
+	return nil
+}
+
+func convertStatusEventToDTO(event *ent.InvoiceStatusEvent) InvoiceStatusEventDTO {
+	return InvoiceStatusEventDTO{
+		FromStatus: event.FromStatus,
+		ToStatus:   event.ToStatus,
+		Reason:     event.Reason,
+		UserID:     event.UserID,
+		CreatedAt:  event.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -0,0 +1,35 @@
+package invoice
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/ent"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+// toBaseQuantity convierte la cantidad de la línea a la unidad base del producto y devuelve la
+// unidad de venta usada, o nil si se vendió en la unidad base. El resultado debe ser entero
+// salvo en los productos que admiten fracciones.
+func (uc *CreateInvoiceUseCase) toBaseQuantity(ctx context.Context, product *ent.Product, item InvoiceItemRequest) (*ent.ProductUnit, float64, error) {
+	var unit *ent.ProductUnit
+	quantity := qty.Round(item.Quantity)
+
+	if item.UnitID != nil {
+		found, err := uc.unitRepo.FindByID(ctx, *item.UnitID)
+		if err != nil || found.ProductID != product.ID {
+			return nil, 0, fmt.Errorf("unidad con ID %d no encontrada para el producto %s", *item.UnitID, product.Name)
+		}
+		unit = found
+		quantity = qty.Round(item.Quantity * unit.Factor)
+	}
+
+	if quantity <= 0 {
+		return nil, 0, fmt.Errorf("la cantidad de %s es demasiado pequeña", product.Name)
+	}
+	if !product.Fractional && !qty.IsWhole(quantity) {
+		return nil, 0, fmt.Errorf("el producto %s no admite cantidades fraccionarias de %s", product.Name, product.BaseUnit)
+	}
+
+	return unit, quantity, nil
+}
@@ -0,0 +1,38 @@
+package invoice
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type UpdateDiscountSettingsUseCase struct {
+	tenantRepo repositories.TenantRepository
+}
+
+func NewUpdateDiscountSettingsUseCase(tenantRepo repositories.TenantRepository) *UpdateDiscountSettingsUseCase {
+	return &UpdateDiscountSettingsUseCase{
+		tenantRepo: tenantRepo,
+	}
+}
+
+type DiscountSettingsDTO struct {
+	// UserLimit y ManagerLimit son el descuento máximo en porcentaje que da cada rol sin
+	// autorización; 100 no limita y 0 exige autorización para cualquier descuento. Los admin
+	// no tienen límite.
+	UserLimit    float64 `json:"userLimit"`
+	ManagerLimit float64 `json:"managerLimit"`
+}
+
+func (uc *UpdateDiscountSettingsUseCase) Execute(ctx context.Context, tenantID int, req DiscountSettingsDTO) (*DiscountSettingsDTO, error) {
+	if req.UserLimit < 0 || req.UserLimit > 100 || req.ManagerLimit < 0 || req.ManagerLimit > 100 {
+		return nil, fmt.Errorf("los límites de descuento deben estar entre 0 y 100")
+	}
+
+	if err := uc.tenantRepo.SetDiscountLimits(ctx, tenantID, req.UserLimit, req.ManagerLimit); err != nil {
+		return nil, err
+	}
+
+	return &DiscountSettingsDTO{UserLimit: req.UserLimit, ManagerLimit: req.ManagerLimit}, nil
+}
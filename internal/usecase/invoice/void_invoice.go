@@ -0,0 +1,22 @@
+package invoice
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+// VoidInvoiceUseCase anula una factura ya cobrada y devuelve la mercadería al inventario
+type VoidInvoiceUseCase struct {
+	invoiceRepo repositories.InvoiceRepository
+}
+
+func NewVoidInvoiceUseCase(invoiceRepo repositories.InvoiceRepository) *VoidInvoiceUseCase {
+	return &VoidInvoiceUseCase{
+		invoiceRepo: invoiceRepo,
+	}
+}
+
+func (uc *VoidInvoiceUseCase) Execute(ctx context.Context, tenantID, userID, invoiceID int, req InvoiceStatusRequest) error {
+	return changeInvoiceStatus(ctx, uc.invoiceRepo, tenantID, userID, invoiceID, statusVoided, req, nil)
+}
@@ -0,0 +1,159 @@
+package numbering
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+// Tipos de documento que se numeran con series
+const (
+	DocumentInvoice    = "invoice"
+	DocumentCreditNote = "credit_note"
+)
+
+// defaultAlertRemaining son los números restantes a partir de los cuales se avisa si la petición
+// no indica otro valor
+const defaultAlertRemaining = 100
+
+type CreateSeriesUseCase struct {
+	seriesRepo repositories.NumberingSeriesRepository
+}
+
+func NewCreateSeriesUseCase(seriesRepo repositories.NumberingSeriesRepository) *CreateSeriesUseCase {
+	return &CreateSeriesUseCase{
+		seriesRepo: seriesRepo,
+	}
+}
+
+// SeriesRequest es una serie de numeración. DocumentType es invoice o credit_note; RangeFrom y
+// RangeTo son el rango autorizado y las fechas (YYYY-MM-DD) su vigencia, con ValidUntil como
+// último día. Resolution es el número de la resolución de la autoridad tributaria.
+type SeriesRequest struct {
+	DocumentType string `json:"documentType" binding:"required"`
+	Name         string `json:"name"`
+	Prefix       string `json:"prefix"`
+	RangeFrom    int64  `json:"rangeFrom" binding:"required"`
+	RangeTo      int64  `json:"rangeTo" binding:"required"`
+	ValidFrom    string `json:"validFrom"`
+	ValidUntil   string `json:"validUntil"`
+	Resolution   string `json:"resolution"`
+	// AlertRemaining es 100 si no se envía
+	AlertRemaining *int64 `json:"alertRemaining,omitempty"`
+	// Active es true si no se envía
+	Active *bool `json:"active,omitempty"`
+}
+
+func (uc *CreateSeriesUseCase) Execute(ctx context.Context, tenantID int, req SeriesRequest) (*SeriesDTO, error) {
+	data, err := validateSeries(ctx, uc.seriesRepo, tenantID, nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := uc.seriesRepo.Create(ctx, tenantID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := convertSeriesToDTO(series, time.Now())
+	return &dto, nil
+}
+
+// validateSeries normaliza la petición. current es la serie que se edita, nil al crear: si ya
+// asignó números no puede cambiar de tipo, prefijo ni inicio, ni terminar antes del último
+// número asignado. Dos series del mismo tipo y prefijo no pueden compartir números.
+func validateSeries(ctx context.Context, seriesRepo repositories.NumberingSeriesRepository, tenantID int, current *ent.NumberingSeries, req SeriesRequest) (repositories.NumberingSeriesData, error) {
+	data := repositories.NumberingSeriesData{
+		DocumentType:   req.DocumentType,
+		Name:           strings.TrimSpace(req.Name),
+		Prefix:         strings.ToUpper(strings.TrimSpace(req.Prefix)),
+		RangeFrom:      req.RangeFrom,
+		RangeTo:        req.RangeTo,
+		Resolution:     strings.TrimSpace(req.Resolution),
+		AlertRemaining: defaultAlertRemaining,
+		Active:         req.Active == nil || *req.Active,
+	}
+	if req.AlertRemaining != nil {
+		data.AlertRemaining = *req.AlertRemaining
+	}
+
+	if !validDocumentType(data.DocumentType) {
+		return data, fmt.Errorf("tipo de documento inválido %q: use %s o %s", data.DocumentType, DocumentInvoice, DocumentCreditNote)
+	}
+	if strings.ContainsAny(data.Prefix, " \t") {
+		return data, fmt.Errorf("el prefijo no puede tener espacios")
+	}
+	if data.RangeFrom < 1 {
+		return data, fmt.Errorf("el rango debe empezar en 1 o más")
+	}
+	if data.RangeTo < data.RangeFrom {
+		return data, fmt.Errorf("el rango termina (%d) antes de empezar (%d)", data.RangeTo, data.RangeFrom)
+	}
+	if data.AlertRemaining < 0 {
+		return data, fmt.Errorf("el aviso de números restantes no puede ser negativo")
+	}
+
+	var err error
+	if data.ValidFrom, err = parseDate(req.ValidFrom, "validFrom"); err != nil {
+		return data, err
+	}
+	if data.ValidUntil, err = parseDate(req.ValidUntil, "validUntil"); err != nil {
+		return data, err
+	}
+	if data.ValidFrom != nil && data.ValidUntil != nil && data.ValidUntil.Before(*data.ValidFrom) {
+		return data, fmt.Errorf("la vigencia termina antes de empezar")
+	}
+
+	if current != nil && current.CurrentNumber >= current.RangeFrom {
+		if data.DocumentType != current.DocumentType || data.Prefix != current.Prefix || data.RangeFrom != current.RangeFrom {
+			return data, fmt.Errorf("%w: la serie ya asignó números: no se puede cambiar el tipo, el prefijo ni el inicio del rango", pkg_errors.ErrInvalidState)
+		}
+		if data.RangeTo < current.CurrentNumber {
+			return data, fmt.Errorf("%w: la serie ya asignó hasta el número %d", pkg_errors.ErrInvalidState, current.CurrentNumber)
+		}
+	}
+
+	existing, err := seriesRepo.FindAll(ctx, tenantID, data.DocumentType)
+	if err != nil {
+		return data, err
+	}
+	for _, other := range existing {
+		if current != nil && other.ID == current.ID {
+			continue
+		}
+		if other.Prefix == data.Prefix && other.RangeFrom <= data.RangeTo && data.RangeFrom <= other.RangeTo {
+			return data, fmt.Errorf("%w: el rango se cruza con la serie %s%d-%s%d", pkg_errors.ErrAlreadyExists, other.Prefix, other.RangeFrom, other.Prefix, other.RangeTo)
+		}
+	}
+
+	return data, nil
+}
+
+func validDocumentType(documentType string) bool {
+	return documentType == DocumentInvoice || documentType == DocumentCreditNote
+}
+
+func parseDate(value, field string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, fmt.Errorf("%s debe tener el formato YYYY-MM-DD", field)
+	}
+	return &date, nil
+}
+
+func findSeries(ctx context.Context, seriesRepo repositories.NumberingSeriesRepository, tenantID, seriesID int) (*ent.NumberingSeries, error) {
+	series, err := seriesRepo.FindByID(ctx, seriesID)
+	if err != nil || series.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	return series, nil
+}
@@ -0,0 +1,34 @@
+package numbering
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type DeleteSeriesUseCase struct {
+	seriesRepo repositories.NumberingSeriesRepository
+}
+
+func NewDeleteSeriesUseCase(seriesRepo repositories.NumberingSeriesRepository) *DeleteSeriesUseCase {
+	return &DeleteSeriesUseCase{
+		seriesRepo: seriesRepo,
+	}
+}
+
+// Execute borra una serie que no ha asignado números; las que ya numeraron documentos se
+// desactivan en lugar de borrarse
+func (uc *DeleteSeriesUseCase) Execute(ctx context.Context, tenantID, seriesID int) error {
+	series, err := findSeries(ctx, uc.seriesRepo, tenantID, seriesID)
+	if err != nil {
+		return err
+	}
+
+	if series.CurrentNumber >= series.RangeFrom {
+		return fmt.Errorf("%w: la serie ya asignó números; desactívala en su lugar", pkg_errors.ErrInUse)
+	}
+
+	return uc.seriesRepo.Delete(ctx, seriesID, series.CurrentNumber)
+}
@@ -0,0 +1,119 @@
+package numbering
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type ListSeriesUseCase struct {
+	seriesRepo repositories.NumberingSeriesRepository
+}
+
+func NewListSeriesUseCase(seriesRepo repositories.NumberingSeriesRepository) *ListSeriesUseCase {
+	return &ListSeriesUseCase{
+		seriesRepo: seriesRepo,
+	}
+}
+
+type SeriesDTO struct {
+	ID           int    `json:"id"`
+	DocumentType string `json:"documentType"`
+	Name         string `json:"name,omitempty"`
+	Prefix       string `json:"prefix"`
+	// RangeFrom y RangeTo son el rango autorizado; CurrentNumber es el último número asignado
+	// (RangeFrom - 1 si la serie no se ha usado) y NextNumber el próximo, vacío si se agotó
+	RangeFrom     int64  `json:"rangeFrom"`
+	RangeTo       int64  `json:"rangeTo"`
+	CurrentNumber int64  `json:"currentNumber"`
+	NextNumber    string `json:"nextNumber,omitempty"`
+	// Remaining son los números sin asignar; NearExhaustion se activa cuando no superan
+	// AlertRemaining y Warning lo explica
+	Remaining      int64  `json:"remaining"`
+	AlertRemaining int64  `json:"alertRemaining"`
+	NearExhaustion bool   `json:"nearExhaustion"`
+	Warning        string `json:"warning,omitempty"`
+	// ValidUntil es el último día de vigencia; Usable indica que hoy la serie puede asignar números
+	ValidFrom  string `json:"validFrom,omitempty"`
+	ValidUntil string `json:"validUntil,omitempty"`
+	Resolution string `json:"resolution,omitempty"`
+	Active     bool   `json:"active"`
+	Usable     bool   `json:"usable"`
+	CreatedAt  string `json:"createdAt"`
+	UpdatedAt  string `json:"updatedAt"`
+}
+
+func (uc *ListSeriesUseCase) Execute(ctx context.Context, tenantID int, documentType string) ([]SeriesDTO, error) {
+	if documentType != "" && !validDocumentType(documentType) {
+		return nil, fmt.Errorf("tipo de documento inválido %q: use %s o %s", documentType, DocumentInvoice, DocumentCreditNote)
+	}
+
+	series, err := uc.seriesRepo.FindAll(ctx, tenantID, documentType)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	dtos := make([]SeriesDTO, len(series))
+	for i, s := range series {
+		dtos[i] = convertSeriesToDTO(s, now)
+	}
+
+	return dtos, nil
+}
+
+func convertSeriesToDTO(series *ent.NumberingSeries, now time.Time) SeriesDTO {
+	remaining := series.RangeTo - series.CurrentNumber
+	dto := SeriesDTO{
+		ID:             series.ID,
+		DocumentType:   series.DocumentType,
+		Name:           series.Name,
+		Prefix:         series.Prefix,
+		RangeFrom:      series.RangeFrom,
+		RangeTo:        series.RangeTo,
+		CurrentNumber:  series.CurrentNumber,
+		Remaining:      remaining,
+		AlertRemaining: series.AlertRemaining,
+		NearExhaustion: remaining <= series.AlertRemaining,
+		Resolution:     series.Resolution,
+		Active:         series.Active,
+		CreatedAt:      series.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      series.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if remaining > 0 {
+		dto.NextNumber = series.Prefix + strconv.FormatInt(series.CurrentNumber+1, 10)
+	}
+	if dto.NearExhaustion {
+		dto.Warning = seriesWarning(series, remaining)
+	}
+	if series.ValidFrom != nil {
+		dto.ValidFrom = series.ValidFrom.Format("2006-01-02")
+	}
+	if series.ValidUntil != nil {
+		dto.ValidUntil = series.ValidUntil.Format("2006-01-02")
+	}
+
+	// Misma regla de vigencia con que la factura elige la serie
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	dto.Usable = series.Active && remaining > 0 &&
+		(series.ValidFrom == nil || !series.ValidFrom.After(now)) &&
+		(series.ValidUntil == nil || !series.ValidUntil.Before(today))
+
+	return dto
+}
+
+func seriesWarning(series *ent.NumberingSeries, remaining int64) string {
+	name := series.Name
+	if name == "" {
+		name = series.Prefix
+	}
+	if remaining == 0 {
+		return fmt.Sprintf("la numeración %s agotó su rango autorizado (hasta %d)", name, series.RangeTo)
+	}
+	return fmt.Sprintf("a la numeración %s le quedan %d números de su rango autorizado (hasta %d)", name, remaining, series.RangeTo)
+}
@@ -0,0 +1,41 @@
+package numbering
+
+import (
+	"context"
+	"time"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type UpdateSeriesUseCase struct {
+	seriesRepo repositories.NumberingSeriesRepository
+}
+
+func NewUpdateSeriesUseCase(seriesRepo repositories.NumberingSeriesRepository) *UpdateSeriesUseCase {
+	return &UpdateSeriesUseCase{
+		seriesRepo: seriesRepo,
+	}
+}
+
+// Execute reemplaza los datos de la serie. Una serie en uso solo puede ampliar su rango,
+// cambiar la vigencia, la resolución, el aviso o desactivarse; las facturas ya numeradas
+// conservan su número.
+func (uc *UpdateSeriesUseCase) Execute(ctx context.Context, tenantID, seriesID int, req SeriesRequest) (*SeriesDTO, error) {
+	current, err := findSeries(ctx, uc.seriesRepo, tenantID, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := validateSeries(ctx, uc.seriesRepo, tenantID, current, req)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := uc.seriesRepo.Update(ctx, seriesID, current.CurrentNumber, data)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := convertSeriesToDTO(series, time.Now())
+	return &dto, nil
+}
@@ -0,0 +1,57 @@
+package pricelist
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type CreatePriceListUseCase struct {
+	priceListRepo repositories.PriceListRepository
+}
+
+func NewCreatePriceListUseCase(priceListRepo repositories.PriceListRepository) *CreatePriceListUseCase {
+	return &CreatePriceListUseCase{
+		priceListRepo: priceListRepo,
+	}
+}
+
+type CreatePriceListRequest struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+	// DiscountPercent se aplica a los productos que no tienen precio propio en la lista
+	DiscountPercent float64 `json:"discountPercent"`
+}
+
+func (uc *CreatePriceListUseCase) Execute(ctx context.Context, tenantID int, req CreatePriceListRequest) (*PriceListDTO, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("el nombre de la lista es requerido")
+	}
+	if err := validatePercent(req.DiscountPercent); err != nil {
+		return nil, err
+	}
+
+	list, err := uc.priceListRepo.Create(ctx, tenantID, name, strings.TrimSpace(req.Description), req.DiscountPercent)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, pkg_errors.ErrAlreadyExists
+		}
+		return nil, err
+	}
+
+	dto := convertPriceListToDTO(list)
+	return &dto, nil
+}
+
+// validatePercent admite descuentos de hasta el 100% y recargos (valores negativos)
+func validatePercent(percent float64) error {
+	if percent > 100 {
+		return fmt.Errorf("el descuento no puede superar el 100%%")
+	}
+	return nil
+}
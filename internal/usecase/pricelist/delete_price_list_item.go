@@ -0,0 +1,32 @@
+package pricelist
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type DeletePriceListItemUseCase struct {
+	priceListRepo repositories.PriceListRepository
+}
+
+func NewDeletePriceListItemUseCase(priceListRepo repositories.PriceListRepository) *DeletePriceListItemUseCase {
+	return &DeletePriceListItemUseCase{
+		priceListRepo: priceListRepo,
+	}
+}
+
+func (uc *DeletePriceListItemUseCase) Execute(ctx context.Context, tenantID, priceListID, itemID int) error {
+	list, err := uc.priceListRepo.FindByID(ctx, priceListID)
+	if err != nil || list.TenantID != tenantID {
+		return pkg_errors.ErrNotFound
+	}
+
+	item, err := uc.priceListRepo.FindItemByID(ctx, itemID)
+	if err != nil || item.PriceListID != priceListID {
+		return pkg_errors.ErrNotFound
+	}
+
+	return uc.priceListRepo.DeleteItem(ctx, itemID)
+}
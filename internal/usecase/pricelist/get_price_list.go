@@ -0,0 +1,67 @@
+package pricelist
+
+import (
+	"context"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+)
+
+type GetPriceListUseCase struct {
+	priceListRepo repositories.PriceListRepository
+	productRepo   repositories.ProductRepository
+}
+
+func NewGetPriceListUseCase(priceListRepo repositories.PriceListRepository, productRepo repositories.ProductRepository) *GetPriceListUseCase {
+	return &GetPriceListUseCase{
+		priceListRepo: priceListRepo,
+		productRepo:   productRepo,
+	}
+}
+
+type PriceListItemDTO struct {
+	ID              int           `json:"id"`
+	ProductID       int           `json:"productId"`
+	ProductName     string        `json:"productName"`
+	MinQuantity     int           `json:"minQuantity"`
+	Price           *money.Amount `json:"price"`
+	DiscountPercent *float64      `json:"discountPercent"`
+}
+
+// Execute devuelve la lista con sus precios por producto y tramo de cantidad
+func (uc *GetPriceListUseCase) Execute(ctx context.Context, tenantID, id int) (*PriceListDTO, error) {
+	list, err := uc.priceListRepo.FindByID(ctx, id)
+	if err != nil || list.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	items, err := uc.priceListRepo.FindItems(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := convertPriceListToDTO(list)
+	dto.Items = make([]PriceListItemDTO, len(items))
+	for i, item := range items {
+		productName := ""
+		if product, err := uc.productRepo.FindByID(ctx, item.ProductID); err == nil {
+			productName = product.Name
+		}
+		dto.Items[i] = convertPriceListItemToDTO(item, productName)
+	}
+
+	return &dto, nil
+}
+
+func convertPriceListItemToDTO(item *ent.PriceListItem, productName string) PriceListItemDTO {
+	return PriceListItemDTO{
+		ID:              item.ID,
+		ProductID:       item.ProductID,
+		ProductName:     productName,
+		MinQuantity:     item.MinQuantity,
+		Price:           item.Price,
+		DiscountPercent: item.DiscountPercent,
+	}
+}
@@ -0,0 +1,59 @@
+package pricelist
+
+import (
+	"context"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type ListPriceListsUseCase struct {
+	priceListRepo repositories.PriceListRepository
+}
+
+func NewListPriceListsUseCase(priceListRepo repositories.PriceListRepository) *ListPriceListsUseCase {
+	return &ListPriceListsUseCase{
+		priceListRepo: priceListRepo,
+	}
+}
+
+type PriceListDTO struct {
+	ID              int                `json:"id"`
+	Name            string             `json:"name"`
+	Description     string             `json:"description"`
+	DiscountPercent float64            `json:"discountPercent"`
+	Active          bool               `json:"active"`
+	Items           []PriceListItemDTO `json:"items,omitempty"`
+	CreatedAt       string             `json:"createdAt"`
+	UpdatedAt       string             `json:"updatedAt"`
+}
+
+type ListPriceListsResponse struct {
+	PriceLists []PriceListDTO `json:"priceLists"`
+}
+
+func (uc *ListPriceListsUseCase) Execute(ctx context.Context, tenantID int) (*ListPriceListsResponse, error) {
+	lists, err := uc.priceListRepo.FindAll(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	listDTOs := make([]PriceListDTO, len(lists))
+	for i, list := range lists {
+		listDTOs[i] = convertPriceListToDTO(list)
+	}
+
+	return &ListPriceListsResponse{PriceLists: listDTOs}, nil
+}
+
+func convertPriceListToDTO(list *ent.PriceList) PriceListDTO {
+	return PriceListDTO{
+		ID:              list.ID,
+		Name:            list.Name,
+		Description:     list.Description,
+		DiscountPercent: list.DiscountPercent,
+		Active:          list.Active,
+		CreatedAt:       list.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:       list.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
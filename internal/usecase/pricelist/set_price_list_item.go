@@ -0,0 +1,73 @@
+package pricelist
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+)
+
+type SetPriceListItemUseCase struct {
+	priceListRepo repositories.PriceListRepository
+	productRepo   repositories.ProductRepository
+}
+
+func NewSetPriceListItemUseCase(priceListRepo repositories.PriceListRepository, productRepo repositories.ProductRepository) *SetPriceListItemUseCase {
+	return &SetPriceListItemUseCase{
+		priceListRepo: priceListRepo,
+		productRepo:   productRepo,
+	}
+}
+
+type SetPriceListItemRequest struct {
+	ProductID int `json:"productId" binding:"required"`
+	// MinQuantity define el tramo; por defecto 1
+	MinQuantity int `json:"minQuantity"`
+	// Se indica un precio fijo o un descuento sobre el precio detal, no ambos
+	Price           *money.Amount `json:"price,omitempty"`
+	DiscountPercent *float64      `json:"discountPercent,omitempty"`
+}
+
+// Execute fija el precio de un producto en la lista para un tramo de cantidad. Si el tramo ya
+// existe se reemplaza.
+func (uc *SetPriceListItemUseCase) Execute(ctx context.Context, tenantID, priceListID int, req SetPriceListItemRequest) (*PriceListItemDTO, error) {
+	list, err := uc.priceListRepo.FindByID(ctx, priceListID)
+	if err != nil || list.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	product, err := uc.productRepo.FindByID(ctx, req.ProductID)
+	if err != nil || product.TenantID != tenantID {
+		return nil, fmt.Errorf("producto con ID %d no encontrado", req.ProductID)
+	}
+
+	if (req.Price == nil) == (req.DiscountPercent == nil) {
+		return nil, fmt.Errorf("indique un precio fijo o un porcentaje de descuento")
+	}
+	if req.Price != nil && *req.Price < 0 {
+		return nil, fmt.Errorf("el precio no puede ser negativo")
+	}
+	if req.DiscountPercent != nil {
+		if err := validatePercent(*req.DiscountPercent); err != nil {
+			return nil, err
+		}
+	}
+
+	minQuantity := req.MinQuantity
+	if minQuantity == 0 {
+		minQuantity = 1
+	}
+	if minQuantity < 0 {
+		return nil, fmt.Errorf("la cantidad mínima debe ser mayor a 0")
+	}
+
+	item, err := uc.priceListRepo.SetItem(ctx, priceListID, req.ProductID, minQuantity, req.Price, req.DiscountPercent)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := convertPriceListItemToDTO(item, product.Name)
+	return &dto, nil
+}
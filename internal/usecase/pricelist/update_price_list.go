@@ -0,0 +1,69 @@
+package pricelist
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type UpdatePriceListUseCase struct {
+	priceListRepo repositories.PriceListRepository
+}
+
+func NewUpdatePriceListUseCase(priceListRepo repositories.PriceListRepository) *UpdatePriceListUseCase {
+	return &UpdatePriceListUseCase{
+		priceListRepo: priceListRepo,
+	}
+}
+
+type UpdatePriceListRequest struct {
+	Name            *string  `json:"name,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	DiscountPercent *float64 `json:"discountPercent,omitempty"`
+	Active          *bool    `json:"active,omitempty"`
+}
+
+func (uc *UpdatePriceListUseCase) Execute(ctx context.Context, tenantID, id int, req UpdatePriceListRequest) (*PriceListDTO, error) {
+	list, err := uc.priceListRepo.FindByID(ctx, id)
+	if err != nil || list.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	name := list.Name
+	if req.Name != nil {
+		name = strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, fmt.Errorf("el nombre de la lista es requerido")
+		}
+	}
+	description := list.Description
+	if req.Description != nil {
+		description = strings.TrimSpace(*req.Description)
+	}
+	discountPercent := list.DiscountPercent
+	if req.DiscountPercent != nil {
+		if err := validatePercent(*req.DiscountPercent); err != nil {
+			return nil, err
+		}
+		discountPercent = *req.DiscountPercent
+	}
+	active := list.Active
+	if req.Active != nil {
+		active = *req.Active
+	}
+
+	list, err = uc.priceListRepo.Update(ctx, id, name, description, discountPercent, active)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, pkg_errors.ErrAlreadyExists
+		}
+		return nil, err
+	}
+
+	dto := convertPriceListToDTO(list)
+	return &dto, nil
+}
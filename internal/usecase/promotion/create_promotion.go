@@ -0,0 +1,271 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+)
+
+// Efectos de una promoción
+const (
+	effectPercent     = "percent"
+	effectFixed       = "fixed"
+	effectFreeItem    = "free_item"
+	effectBundlePrice = "bundle_price"
+)
+
+type CreatePromotionUseCase struct {
+	promotionRepo repositories.PromotionRepository
+	productRepo   repositories.ProductRepository
+}
+
+func NewCreatePromotionUseCase(promotionRepo repositories.PromotionRepository, productRepo repositories.ProductRepository) *CreatePromotionUseCase {
+	return &CreatePromotionUseCase{
+		promotionRepo: promotionRepo,
+		productRepo:   productRepo,
+	}
+}
+
+type PromotionRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// CouponCode hace que la promoción solo se aplique cuando la factura trae el código
+	CouponCode string `json:"couponCode"`
+	// Effect es percent (Percent %), fixed (Amount de descuento), free_item (por cada
+	// BuyQuantity se regalan FreeQuantity) o bundle_price (BuyQuantity unidades por Amount)
+	Effect       string       `json:"effect"`
+	Percent      float64      `json:"percent"`
+	Amount       money.Amount `json:"amount"`
+	BuyQuantity  float64      `json:"buyQuantity"`
+	FreeQuantity float64      `json:"freeQuantity"`
+	MinQuantity  float64      `json:"minQuantity"`
+	MinAmount    money.Amount `json:"minAmount"`
+	// StartsAt y EndsAt aceptan YYYY-MM-DD o RFC3339; una fecha sola en EndsAt incluye ese día
+	StartsAt *string `json:"startsAt,omitempty"`
+	EndsAt   *string `json:"endsAt,omitempty"`
+	Weekdays []int   `json:"weekdays"`
+	// StartTime y EndTime van en HH:MM; si EndTime es menor la franja cruza la medianoche
+	StartTime     string   `json:"startTime"`
+	EndTime       string   `json:"endTime"`
+	CustomerGroup string   `json:"customerGroup"`
+	Priority      int      `json:"priority"`
+	Active        *bool    `json:"active,omitempty"`
+	ProductIDs    []int    `json:"productIds"`
+	Categories    []string `json:"categories"`
+}
+
+func (uc *CreatePromotionUseCase) Execute(ctx context.Context, tenantID int, req PromotionRequest) (*PromotionDTO, error) {
+	data, err := validatePromotion(ctx, uc.promotionRepo, uc.productRepo, tenantID, 0, req)
+	if err != nil {
+		return nil, err
+	}
+
+	promo, err := uc.promotionRepo.Create(ctx, tenantID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	targets, err := uc.promotionRepo.FindTargets(ctx, promo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := convertPromotionToDTO(promo, targets[promo.ID])
+	return &dto, nil
+}
+
+// validatePromotion normaliza la petición y valida el efecto, la vigencia y los productos;
+// currentID es la promoción que se edita, 0 al crear
+func validatePromotion(ctx context.Context, promotionRepo repositories.PromotionRepository, productRepo repositories.ProductRepository, tenantID, currentID int, req PromotionRequest) (repositories.PromotionData, error) {
+	data := repositories.PromotionData{
+		Name:          strings.TrimSpace(req.Name),
+		Description:   strings.TrimSpace(req.Description),
+		CouponCode:    strings.ToUpper(strings.TrimSpace(req.CouponCode)),
+		Effect:        req.Effect,
+		Percent:       req.Percent,
+		Amount:        req.Amount,
+		BuyQuantity:   req.BuyQuantity,
+		FreeQuantity:  req.FreeQuantity,
+		MinQuantity:   req.MinQuantity,
+		MinAmount:     req.MinAmount,
+		StartTime:     strings.TrimSpace(req.StartTime),
+		EndTime:       strings.TrimSpace(req.EndTime),
+		CustomerGroup: strings.ToLower(strings.TrimSpace(req.CustomerGroup)),
+		Priority:      req.Priority,
+		Active:        req.Active == nil || *req.Active,
+	}
+
+	if data.Name == "" {
+		return data, fmt.Errorf("el nombre de la promoción es requerido")
+	}
+	if strings.ContainsAny(data.CouponCode, " \t") {
+		return data, fmt.Errorf("el código de cupón no puede tener espacios")
+	}
+	if data.Percent < 0 || data.Amount < 0 || data.BuyQuantity < 0 || data.FreeQuantity < 0 || data.MinQuantity < 0 || data.MinAmount < 0 {
+		return data, fmt.Errorf("los valores y cantidades de la promoción no pueden ser negativos")
+	}
+
+	switch data.Effect {
+	case effectPercent:
+		if data.Percent <= 0 || data.Percent > 100 {
+			return data, fmt.Errorf("el porcentaje de la promoción debe estar entre 0 y 100")
+		}
+	case effectFixed:
+		if data.Amount <= 0 {
+			return data, fmt.Errorf("el monto de la promoción debe ser mayor a 0")
+		}
+	case effectFreeItem:
+		if data.BuyQuantity <= 0 || data.FreeQuantity <= 0 {
+			return data, fmt.Errorf("indica cuántas unidades se pagan (buyQuantity) y cuántas se regalan (freeQuantity)")
+		}
+	case effectBundlePrice:
+		if data.BuyQuantity <= 0 || data.Amount <= 0 {
+			return data, fmt.Errorf("indica cuántas unidades forman el grupo (buyQuantity) y su precio (amount)")
+		}
+	default:
+		return data, fmt.Errorf("efecto de promoción inválido %q: use %s, %s, %s o %s", data.Effect, effectPercent, effectFixed, effectFreeItem, effectBundlePrice)
+	}
+
+	var err error
+	if req.StartsAt != nil && *req.StartsAt != "" {
+		if data.StartsAt, err = parsePromotionDate(*req.StartsAt, false); err != nil {
+			return data, err
+		}
+	}
+	if req.EndsAt != nil && *req.EndsAt != "" {
+		if data.EndsAt, err = parsePromotionDate(*req.EndsAt, true); err != nil {
+			return data, err
+		}
+	}
+	if data.StartsAt != nil && data.EndsAt != nil && data.EndsAt.Before(*data.StartsAt) {
+		return data, fmt.Errorf("la promoción no puede terminar antes de empezar")
+	}
+
+	if data.Weekdays, err = normalizeWeekdays(req.Weekdays); err != nil {
+		return data, err
+	}
+
+	if (data.StartTime == "") != (data.EndTime == "") {
+		return data, fmt.Errorf("indica la hora de inicio y la de fin, o ninguna")
+	}
+	for _, clock := range []string{data.StartTime, data.EndTime} {
+		if clock == "" {
+			continue
+		}
+		if _, err := time.Parse("15:04", clock); err != nil {
+			return data, fmt.Errorf("hora inválida %q: use HH:MM", clock)
+		}
+	}
+	if data.StartTime != "" && data.StartTime == data.EndTime {
+		return data, fmt.Errorf("la hora de inicio y la de fin no pueden ser iguales")
+	}
+
+	if data.Targets, err = promotionTargets(ctx, productRepo, tenantID, req.ProductIDs, req.Categories); err != nil {
+		return data, err
+	}
+
+	promotions, err := promotionRepo.FindAll(ctx, tenantID)
+	if err != nil {
+		return data, err
+	}
+	for _, promo := range promotions {
+		if promo.ID == currentID {
+			continue
+		}
+		if strings.EqualFold(promo.Name, data.Name) {
+			return data, fmt.Errorf("%w: ya existe la promoción %s", pkg_errors.ErrAlreadyExists, promo.Name)
+		}
+		if data.CouponCode != "" && promo.CouponCode == data.CouponCode {
+			return data, fmt.Errorf("%w: el cupón %s ya lo usa la promoción %s", pkg_errors.ErrAlreadyExists, data.CouponCode, promo.Name)
+		}
+	}
+
+	return data, nil
+}
+
+// parsePromotionDate acepta una fecha (YYYY-MM-DD) o un RFC3339. Una fecha sola empieza al
+// inicio del día, o termina al final del día si endOfDay es true.
+func parsePromotionDate(value string, endOfDay bool) (*time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		if endOfDay {
+			t = t.Add(24*time.Hour - time.Second)
+		}
+		return &t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+	return nil, fmt.Errorf("fecha inválida %q: use YYYY-MM-DD o RFC3339", value)
+}
+
+// normalizeWeekdays valida los días (1 = lunes ... 7 = domingo) y los guarda ordenados y sin
+// repetir, separados por coma
+func normalizeWeekdays(days []int) (string, error) {
+	seen := make(map[int]bool, len(days))
+	unique := make([]int, 0, len(days))
+	for _, day := range days {
+		if day < 1 || day > 7 {
+			return "", fmt.Errorf("día de la semana inválido %d: use 1 (lunes) a 7 (domingo)", day)
+		}
+		if !seen[day] {
+			seen[day] = true
+			unique = append(unique, day)
+		}
+	}
+	sort.Ints(unique)
+
+	parts := make([]string, len(unique))
+	for i, day := range unique {
+		parts[i] = strconv.Itoa(day)
+	}
+
+	return strings.Join(parts, ","), nil
+}
+
+// promotionTargets valida que los productos sean del tenant y limpia las categorías repetidas
+func promotionTargets(ctx context.Context, productRepo repositories.ProductRepository, tenantID int, productIDs []int, categories []string) ([]repositories.PromotionTarget, error) {
+	var targets []repositories.PromotionTarget
+
+	seen := make(map[int]bool, len(productIDs))
+	for _, id := range productIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		product, err := productRepo.FindByID(ctx, id)
+		if err != nil || product.TenantID != tenantID {
+			return nil, fmt.Errorf("producto con ID %d no encontrado", id)
+		}
+		targets = append(targets, repositories.PromotionTarget{ProductID: id})
+	}
+
+	seenCategory := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		category = strings.TrimSpace(category)
+		if category == "" || seenCategory[strings.ToLower(category)] {
+			continue
+		}
+		seenCategory[strings.ToLower(category)] = true
+		targets = append(targets, repositories.PromotionTarget{Category: category})
+	}
+
+	return targets, nil
+}
+
+func findPromotion(ctx context.Context, promotionRepo repositories.PromotionRepository, tenantID, promotionID int) (*ent.Promotion, error) {
+	promo, err := promotionRepo.FindByID(ctx, promotionID)
+	if err != nil || promo.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	return promo, nil
+}
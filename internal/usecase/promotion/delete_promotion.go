@@ -0,0 +1,38 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type DeletePromotionUseCase struct {
+	promotionRepo repositories.PromotionRepository
+}
+
+func NewDeletePromotionUseCase(promotionRepo repositories.PromotionRepository) *DeletePromotionUseCase {
+	return &DeletePromotionUseCase{
+		promotionRepo: promotionRepo,
+	}
+}
+
+// Execute borra una promoción que nunca se aplicó; las que ya están en facturas se desactivan
+// en lugar de borrarse
+func (uc *DeletePromotionUseCase) Execute(ctx context.Context, tenantID, promotionID int) error {
+	promo, err := findPromotion(ctx, uc.promotionRepo, tenantID, promotionID)
+	if err != nil {
+		return err
+	}
+
+	refs, err := uc.promotionRepo.CountReferences(ctx, promotionID)
+	if err != nil {
+		return err
+	}
+	if refs.Total() > 0 {
+		return fmt.Errorf("%w: la promoción %s tiene %s; desactívala en su lugar", pkg_errors.ErrInUse, promo.Name, refs)
+	}
+
+	return uc.promotionRepo.Delete(ctx, promotionID)
+}
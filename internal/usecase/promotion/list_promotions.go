@@ -0,0 +1,126 @@
+package promotion
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
+)
+
+type ListPromotionsUseCase struct {
+	promotionRepo repositories.PromotionRepository
+}
+
+func NewListPromotionsUseCase(promotionRepo repositories.PromotionRepository) *ListPromotionsUseCase {
+	return &ListPromotionsUseCase{
+		promotionRepo: promotionRepo,
+	}
+}
+
+type PromotionDTO struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	CouponCode  string `json:"couponCode,omitempty"`
+	// Effect es percent, fixed, free_item o bundle_price; Percent es el porcentaje de percent y
+	// Amount el monto de fixed o el precio del grupo de bundle_price
+	Effect       string       `json:"effect"`
+	Percent      float64      `json:"percent"`
+	Amount       money.Amount `json:"amount"`
+	BuyQuantity  float64      `json:"buyQuantity"`
+	FreeQuantity float64      `json:"freeQuantity"`
+	MinQuantity  float64      `json:"minQuantity"`
+	MinAmount    money.Amount `json:"minAmount"`
+	StartsAt     *string      `json:"startsAt,omitempty"`
+	EndsAt       *string      `json:"endsAt,omitempty"`
+	// Weekdays van de 1 (lunes) a 7 (domingo); vacío para todos los días
+	Weekdays      []int  `json:"weekdays"`
+	StartTime     string `json:"startTime,omitempty"`
+	EndTime       string `json:"endTime,omitempty"`
+	CustomerGroup string `json:"customerGroup,omitempty"`
+	Priority      int    `json:"priority"`
+	Active        bool   `json:"active"`
+	// ProductIDs y Categories son a lo que aplica la promoción; vacíos para todos los productos
+	ProductIDs []int    `json:"productIds"`
+	Categories []string `json:"categories"`
+	CreatedAt  string   `json:"createdAt"`
+	UpdatedAt  string   `json:"updatedAt"`
+}
+
+func (uc *ListPromotionsUseCase) Execute(ctx context.Context, tenantID int) ([]PromotionDTO, error) {
+	promotions, err := uc.promotionRepo.FindAll(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(promotions))
+	for i, promo := range promotions {
+		ids[i] = promo.ID
+	}
+	targets, err := uc.promotionRepo.FindTargets(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]PromotionDTO, len(promotions))
+	for i, promo := range promotions {
+		dtos[i] = convertPromotionToDTO(promo, targets[promo.ID])
+	}
+
+	return dtos, nil
+}
+
+func convertPromotionToDTO(promo *ent.Promotion, targets []*ent.PromotionTarget) PromotionDTO {
+	dto := PromotionDTO{
+		ID:            promo.ID,
+		Name:          promo.Name,
+		Description:   promo.Description,
+		CouponCode:    promo.CouponCode,
+		Effect:        promo.Effect,
+		Percent:       promo.Percent,
+		Amount:        promo.Amount,
+		BuyQuantity:   promo.BuyQuantity,
+		FreeQuantity:  promo.FreeQuantity,
+		MinQuantity:   promo.MinQuantity,
+		MinAmount:     promo.MinAmount,
+		Weekdays:      []int{},
+		StartTime:     promo.StartTime,
+		EndTime:       promo.EndTime,
+		CustomerGroup: promo.CustomerGroup,
+		Priority:      promo.Priority,
+		Active:        promo.Active,
+		ProductIDs:    []int{},
+		Categories:    []string{},
+		CreatedAt:     promo.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:     promo.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if promo.StartsAt != nil {
+		startsAt := promo.StartsAt.Format("2006-01-02T15:04:05Z07:00")
+		dto.StartsAt = &startsAt
+	}
+	if promo.EndsAt != nil {
+		endsAt := promo.EndsAt.Format("2006-01-02T15:04:05Z07:00")
+		dto.EndsAt = &endsAt
+	}
+
+	for _, day := range strings.Split(promo.Weekdays, ",") {
+		if n, err := strconv.Atoi(day); err == nil {
+			dto.Weekdays = append(dto.Weekdays, n)
+		}
+	}
+
+	for _, target := range targets {
+		if target.ProductID != nil {
+			dto.ProductIDs = append(dto.ProductIDs, *target.ProductID)
+		}
+		if target.Category != "" {
+			dto.Categories = append(dto.Categories, target.Category)
+		}
+	}
+
+	return dto
+}
@@ -0,0 +1,45 @@
+package promotion
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type UpdatePromotionUseCase struct {
+	promotionRepo repositories.PromotionRepository
+	productRepo   repositories.ProductRepository
+}
+
+func NewUpdatePromotionUseCase(promotionRepo repositories.PromotionRepository, productRepo repositories.ProductRepository) *UpdatePromotionUseCase {
+	return &UpdatePromotionUseCase{
+		promotionRepo: promotionRepo,
+		productRepo:   productRepo,
+	}
+}
+
+// Execute reemplaza la promoción con sus productos y categorías. Las facturas ya emitidas
+// guardan lo que descontó, así que el cambio solo afecta a las nuevas.
+func (uc *UpdatePromotionUseCase) Execute(ctx context.Context, tenantID, promotionID int, req PromotionRequest) (*PromotionDTO, error) {
+	if _, err := findPromotion(ctx, uc.promotionRepo, tenantID, promotionID); err != nil {
+		return nil, err
+	}
+
+	data, err := validatePromotion(ctx, uc.promotionRepo, uc.productRepo, tenantID, promotionID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	promo, err := uc.promotionRepo.Update(ctx, promotionID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	targets, err := uc.promotionRepo.FindTargets(ctx, promo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := convertPromotionToDTO(promo, targets[promo.ID])
+	return &dto, nil
+}
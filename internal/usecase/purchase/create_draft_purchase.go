@@ -0,0 +1,117 @@
+package purchase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/usecase/currency"
+	"Veritasbackend/pkg/money"
+)
+
+type CreateDraftPurchaseUseCase struct {
+	suggestionsUseCase  *GetReorderSuggestionsUseCase
+	purchaseInvoiceRepo repositories.PurchaseInvoiceRepository
+	tenantRepo          repositories.TenantRepository
+}
+
+func NewCreateDraftPurchaseUseCase(
+	suggestionsUseCase *GetReorderSuggestionsUseCase,
+	purchaseInvoiceRepo repositories.PurchaseInvoiceRepository,
+	tenantRepo repositories.TenantRepository,
+) *CreateDraftPurchaseUseCase {
+	return &CreateDraftPurchaseUseCase{
+		suggestionsUseCase:  suggestionsUseCase,
+		purchaseInvoiceRepo: purchaseInvoiceRepo,
+		tenantRepo:          tenantRepo,
+	}
+}
+
+type CreateDraftPurchaseRequest struct {
+	SupplierID   int  `json:"supplierId"`
+	WindowDays   int  `json:"windowDays"`
+	CoverDays    int  `json:"coverDays"`
+	LeadTimeDays *int `json:"leadTimeDays,omitempty"`
+	// ProductIDs limita el borrador a algunos productos de la sugerencia
+	ProductIDs []int `json:"productIds,omitempty"`
+}
+
+// Execute recalcula la sugerencia del proveedor y la guarda como una compra en estado
+// "draft". El stock no cambia hasta que la mercancía se reciba con CreatePurchaseUseCase.
+func (uc *CreateDraftPurchaseUseCase) Execute(ctx context.Context, tenantID, userID int, req CreateDraftPurchaseRequest) (*PurchaseInvoiceDTO, error) {
+	if req.SupplierID <= 0 {
+		return nil, fmt.Errorf("proveedor requerido")
+	}
+
+	suggestions, err := uc.suggestionsUseCase.Execute(ctx, tenantID, ReorderSuggestionsRequest{
+		WindowDays:   req.WindowDays,
+		CoverDays:    req.CoverDays,
+		LeadTimeDays: req.LeadTimeDays,
+		SupplierID:   req.SupplierID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(suggestions.Suppliers) == 0 {
+		return nil, fmt.Errorf("no hay productos por reponer para el proveedor %d", req.SupplierID)
+	}
+
+	selected := make(map[int]bool, len(req.ProductIDs))
+	for _, id := range req.ProductIDs {
+		selected[id] = true
+	}
+
+	var total money.Amount
+	var items []ReorderItemDTO
+	for _, item := range suggestions.Suppliers[0].Items {
+		if len(selected) > 0 && !selected[item.ProductID] {
+			continue
+		}
+		items = append(items, item)
+		total += item.EstimatedCost
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("ninguno de los productos seleccionados necesita reposición")
+	}
+
+	// Los costos de la sugerencia están en moneda base, así que el borrador también
+	tenant, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("tenant no encontrado")
+	}
+	base := currency.TenantCurrency(tenant)
+	doc := currency.Document{Currency: base, Base: base, Rate: 1}
+
+	draftItems := make([]*ent.PurchaseInvoiceItem, 0, len(items))
+	for _, item := range items {
+		draftItems = append(draftItems, &ent.PurchaseInvoiceItem{
+			ProductID: item.ProductID,
+			Quantity:  item.SuggestedQuantity,
+			UnitCost:  item.LastUnitCost,
+			Subtotal:  item.EstimatedCost,
+		})
+	}
+
+	invoice, createdItems, err := uc.purchaseInvoiceRepo.CreateDraft(ctx, repositories.DraftPurchaseData{
+		TenantID:      tenantID,
+		SupplierID:    req.SupplierID,
+		UserID:        userID,
+		InvoiceNumber: fmt.Sprintf("DRAFT-%d-%d-%s", tenantID, req.SupplierID, time.Now().Format("20060102150405")),
+		Total:         total,
+		Currency:      doc.Totals(0, 0, total),
+		Items:         draftItems,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error al crear borrador de compra: %w", err)
+	}
+
+	dto := convertPurchaseInvoiceToDTO(invoice)
+	dto.Items = make([]PurchaseItemDTO, 0, len(createdItems))
+	for _, item := range createdItems {
+		dto.Items = append(dto.Items, convertPurchaseInvoiceItemToDTO(item))
+	}
+
+	return dto, nil
+}
@@ -8,66 +8,137 @@ import (
 
 	"Veritasbackend/ent"
 	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/usecase/currency"
+	"Veritasbackend/internal/usecase/taxes"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+	"Veritasbackend/pkg/tax"
 )
 
 type CreatePurchaseUseCase struct {
-	purchaseInvoiceRepo     repositories.PurchaseInvoiceRepository
-	purchaseInvoiceItemRepo repositories.PurchaseInvoiceItemRepository
-	productRepo             repositories.ProductRepository
+	purchaseInvoiceRepo repositories.PurchaseInvoiceRepository
+	productRepo         repositories.ProductRepository
+	serialRepo          repositories.ProductSerialRepository
+	unitRepo            repositories.ProductUnitRepository
+	supplierRepo        repositories.SupplierRepository
+	taxRateRepo         repositories.TaxRateRepository
+	tenantRepo          repositories.TenantRepository
+	rateRepo            repositories.ExchangeRateRepository
 }
 
 func NewCreatePurchaseUseCase(
 	purchaseInvoiceRepo repositories.PurchaseInvoiceRepository,
-	purchaseInvoiceItemRepo repositories.PurchaseInvoiceItemRepository,
 	productRepo repositories.ProductRepository,
+	serialRepo repositories.ProductSerialRepository,
+	unitRepo repositories.ProductUnitRepository,
+	supplierRepo repositories.SupplierRepository,
+	taxRateRepo repositories.TaxRateRepository,
+	tenantRepo repositories.TenantRepository,
+	rateRepo repositories.ExchangeRateRepository,
 ) *CreatePurchaseUseCase {
 	return &CreatePurchaseUseCase{
-		purchaseInvoiceRepo:     purchaseInvoiceRepo,
-		purchaseInvoiceItemRepo: purchaseInvoiceItemRepo,
-		productRepo:             productRepo,
+		purchaseInvoiceRepo: purchaseInvoiceRepo,
+		productRepo:         productRepo,
+		serialRepo:          serialRepo,
+		unitRepo:            unitRepo,
+		supplierRepo:        supplierRepo,
+		taxRateRepo:         taxRateRepo,
+		tenantRepo:          tenantRepo,
+		rateRepo:            rateRepo,
 	}
 }
 
+type PurchaseLotRequest struct {
+	LotNumber  string     `json:"lotNumber"`
+	ExpiryDate *time.Time `json:"expiryDate,omitempty"`
+	// Quantity va en la misma unidad que el ítem
+	Quantity float64 `json:"quantity"`
+}
+
 type PurchaseItemRequest struct {
-	ProductID    int     `json:"productId"`
-	Quantity     int     `json:"quantity"`
-	UnitCost     float64 `json:"unitCost"`
-	ProductName  string  `json:"productName"`
-	ProductSku   string  `json:"productSku"`
-	ProductPrice float64 `json:"productPrice"`
+	ProductID int `json:"productId"`
+	// Quantity y UnitCost van en la unidad de compra: la base del producto o la indicada en UnitID
+	Quantity     float64              `json:"quantity"`
+	UnitID       *int                 `json:"unitId,omitempty"`
+	UnitCost     money.Amount         `json:"unitCost"`
+	ProductName  string               `json:"productName"`
+	ProductSku   string               `json:"productSku"`
+	ProductPrice money.Amount         `json:"productPrice"`
+	Lots         []PurchaseLotRequest `json:"lots,omitempty"`
+	Serials      []string             `json:"serials,omitempty"`
+	// TaxRateID reemplaza la tarifa del producto cuando el proveedor factura con otra
+	TaxRateID *int `json:"taxRateId,omitempty"`
 }
 
 type CreatePurchaseRequest struct {
-	SupplierID    int                   `json:"supplierId"`
-	InvoiceNumber string                `json:"invoiceNumber"`
-	PaymentMethod *string               `json:"paymentMethod,omitempty"`
-	DueDate       *time.Time            `json:"dueDate,omitempty"`
-	Items         []PurchaseItemRequest `json:"items"`
+	SupplierID    int        `json:"supplierId"`
+	InvoiceNumber string     `json:"invoiceNumber"`
+	PaymentMethod *string    `json:"paymentMethod,omitempty"`
+	DueDate       *time.Time `json:"dueDate,omitempty"`
+	// PricesIncludeTax indica que los costos de la factura del proveedor ya traen el impuesto
+	PricesIncludeTax bool                  `json:"pricesIncludeTax"`
+	Items            []PurchaseItemRequest `json:"items"`
+	// Currency es la moneda de la factura del proveedor; sin ella se usa la moneda base. Los
+	// costos van en esa moneda y el inventario entra convertido con ExchangeRate o, si no se
+	// envía, con la tasa del día.
+	Currency     string   `json:"currency,omitempty"`
+	ExchangeRate *float64 `json:"exchangeRate,omitempty"`
+}
+
+type PurchaseLotDTO struct {
+	ID         int     `json:"id"`
+	LotNumber  string  `json:"lotNumber"`
+	ExpiryDate *string `json:"expiryDate,omitempty"`
+	Quantity   float64 `json:"quantity"`
 }
 
 type PurchaseItemDTO struct {
-	ID                int     `json:"id"`
-	PurchaseInvoiceID int     `json:"purchaseInvoiceId"`
-	ProductID         int     `json:"productId"`
-	Quantity          int     `json:"quantity"`
-	UnitCost          float64 `json:"unitCost"`
-	Subtotal          float64 `json:"subtotal"`
+	ID                int `json:"id"`
+	PurchaseInvoiceID int `json:"purchaseInvoiceId"`
+	ProductID         int `json:"productId"`
+	// Quantity y UnitCost van en la unidad base; UnitQuantity es lo comprado en la unidad de compra.
+	// UnitCost es el costo sin el impuesto descontable y Subtotal el importe facturado.
+	Quantity     float64          `json:"quantity"`
+	UnitCost     money.Amount     `json:"unitCost"`
+	Subtotal     money.Amount     `json:"subtotal"`
+	TaxRateID    *int             `json:"taxRateId,omitempty"`
+	TaxRate      float64          `json:"taxRate"`
+	TaxBase      money.Amount     `json:"taxBase"`
+	TaxAmount    money.Amount     `json:"taxAmount"`
+	UnitID       *int             `json:"unitId,omitempty"`
+	UnitName     string           `json:"unitName,omitempty"`
+	UnitQuantity *float64         `json:"unitQuantity,omitempty"`
+	Lots         []PurchaseLotDTO `json:"lots,omitempty"`
+	Serials      []string         `json:"serials,omitempty"`
 }
 
 type PurchaseInvoiceDTO struct {
-	ID            int                `json:"id"`
-	InvoiceNumber string             `json:"invoiceNumber"`
-	Total         float64            `json:"total"`
-	Status        string             `json:"status"`
-	PaymentMethod *string            `json:"paymentMethod,omitempty"`
-	DueDate       *string            `json:"dueDate,omitempty"`
-	PaidAmount    float64            `json:"paidAmount"`
-	SupplierID    int                `json:"supplierId"`
-	TenantID      int                `json:"tenantId"`
-	UserID        int                `json:"userId"`
-	Items         []PurchaseItemDTO  `json:"items"`
-	CreatedAt     string             `json:"createdAt"`
-	UpdatedAt     string             `json:"updatedAt"`
+	ID            int    `json:"id"`
+	InvoiceNumber string `json:"invoiceNumber"`
+	// Subtotal es la suma de las bases y TaxTotal el impuesto descontable incluido en Total
+	Subtotal         money.Amount           `json:"subtotal"`
+	TaxTotal         money.Amount           `json:"taxTotal"`
+	Total            money.Amount           `json:"total"`
+	PricesIncludeTax bool                   `json:"pricesIncludeTax"`
+	Taxes            []taxes.DocumentTaxDTO `json:"taxes"`
+	Status           string                 `json:"status"`
+	PaymentMethod    *string                `json:"paymentMethod,omitempty"`
+	DueDate          *string                `json:"dueDate,omitempty"`
+	PaidAmount       money.Amount           `json:"paidAmount"`
+	// Los importes van en Currency; los Base* son los totales en la moneda base con
+	// ExchangeRate y FXGainLoss la diferencia en cambio acumulada de los pagos
+	Currency     string            `json:"currency"`
+	ExchangeRate float64           `json:"exchangeRate"`
+	BaseSubtotal money.Amount      `json:"baseSubtotal"`
+	BaseTaxTotal money.Amount      `json:"baseTaxTotal"`
+	BaseTotal    money.Amount      `json:"baseTotal"`
+	FXGainLoss   money.Amount      `json:"fxGainLoss"`
+	SupplierID   int               `json:"supplierId"`
+	TenantID     int               `json:"tenantId"`
+	UserID       int               `json:"userId"`
+	Items        []PurchaseItemDTO `json:"items"`
+	CreatedAt    string            `json:"createdAt"`
+	UpdatedAt    string            `json:"updatedAt"`
 }
 
 func convertPurchaseInvoiceToDTO(invoice *ent.PurchaseInvoice) *PurchaseInvoiceDTO {
@@ -86,18 +157,28 @@ func convertPurchaseInvoiceToDTO(invoice *ent.PurchaseInvoice) *PurchaseInvoiceD
 	}
 
 	return &PurchaseInvoiceDTO{
-		ID:            invoice.ID,
-		InvoiceNumber: invoice.InvoiceNumber,
-		Total:         invoice.Total,
-		Status:        invoice.Status,
-		PaymentMethod: paymentMethod,
-		DueDate:       dueDateStr,
-		PaidAmount:    invoice.PaidAmount,
-		SupplierID:    invoice.SupplierID,
-		TenantID:      invoice.TenantID,
-		UserID:        invoice.UserID,
-		CreatedAt:     invoice.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:     invoice.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:               invoice.ID,
+		InvoiceNumber:    invoice.InvoiceNumber,
+		Subtotal:         invoice.Subtotal,
+		TaxTotal:         invoice.TaxTotal,
+		Total:            invoice.Total,
+		PricesIncludeTax: invoice.PricesIncludeTax,
+		Taxes:            []taxes.DocumentTaxDTO{},
+		Status:           invoice.Status,
+		PaymentMethod:    paymentMethod,
+		DueDate:          dueDateStr,
+		PaidAmount:       invoice.PaidAmount,
+		Currency:         invoice.Currency,
+		ExchangeRate:     invoice.ExchangeRate,
+		BaseSubtotal:     invoice.BaseSubtotal,
+		BaseTaxTotal:     invoice.BaseTaxTotal,
+		BaseTotal:        invoice.BaseTotal,
+		FXGainLoss:       invoice.FxGainLoss,
+		SupplierID:       invoice.SupplierID,
+		TenantID:         invoice.TenantID,
+		UserID:           invoice.UserID,
+		CreatedAt:        invoice.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:        invoice.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
 
@@ -109,6 +190,13 @@ func convertPurchaseInvoiceItemToDTO(item *ent.PurchaseInvoiceItem) PurchaseItem
 		Quantity:          item.Quantity,
 		UnitCost:          item.UnitCost,
 		Subtotal:          item.Subtotal,
+		TaxRateID:         item.TaxRateID,
+		TaxRate:           item.TaxRate,
+		TaxBase:           item.TaxBase,
+		TaxAmount:         item.TaxAmount,
+		UnitID:            item.UnitID,
+		UnitName:          item.UnitName,
+		UnitQuantity:      item.UnitQuantity,
 	}
 }
 
@@ -118,119 +206,277 @@ func (uc *CreatePurchaseUseCase) Execute(ctx context.Context, tenantID, userID i
 	log.Printf("Items in request: %d", len(req.Items))
 
 	for i, item := range req.Items {
-		log.Printf("Item %d: ProductID=%d, Quantity=%d, UnitCost=%f, ProductName='%s'", i, item.ProductID, item.Quantity, item.UnitCost, item.ProductName)
+		log.Printf("Item %d: ProductID=%d, Quantity=%s, UnitCost=%s, ProductName='%s'", i, item.ProductID, qty.Format(item.Quantity), item.UnitCost, item.ProductName)
+	}
+
+	supplier, err := uc.supplierRepo.FindByID(ctx, req.SupplierID)
+	if err != nil || supplier.TenantID != tenantID {
+		return nil, fmt.Errorf("proveedor con ID %d no encontrado", req.SupplierID)
+	}
+	if supplier.Archived {
+		return nil, fmt.Errorf("el proveedor %s fue eliminado y no admite compras nuevas", supplier.Name)
+	}
+
+	tenant, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("tenant no encontrado")
+	}
+	doc, err := currency.Resolve(ctx, uc.rateRepo, tenant, req.Currency, req.ExchangeRate, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := taxes.NewResolver(ctx, uc.taxRateRepo, tenantID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Calculate total
-	var total float64
-	var purchaseItems []*ent.PurchaseInvoiceItem
+	var subtotalNet, taxTotal, total money.Amount
+	var taxLines []tax.Line
+	var purchaseItems []repositories.PurchaseItem
 
 	for _, item := range req.Items {
-		var productID = item.ProductID
+		var product *ent.Product
 
-		// If ProductID is negative, it means we need to create a new product
+		// Un ProductID negativo es un producto nuevo: se crea junto con la compra
 		if item.ProductID < 0 {
-			log.Printf("Creating new product for item with ProductID %d", item.ProductID)
-
 			if item.ProductName == "" {
-				log.Printf("ProductName is empty")
 				return nil, fmt.Errorf("nombre del producto requerido para productos nuevos")
 			}
 
-			productName := item.ProductName
-			log.Printf("Creating product with name: %s", productName)
-
-			// Create new product
-			description := ""
 			sku := item.ProductSku
-			price := item.UnitCost // Default price is the cost
-
 			if sku == "" {
 				sku = fmt.Sprintf("SKU-%d", item.ProductID*-1) // Generate SKU from negative ID
 			}
+			// El catálogo se lleva en moneda base; sin precio de venta se usa el costo
+			price := doc.ToBase(item.UnitCost)
 			if item.ProductPrice > 0 {
-				price = item.ProductPrice
+				price = doc.ToBase(item.ProductPrice)
 			}
 
-			log.Printf("Product SKU: %s, Price: %f", sku, price)
-
-			log.Printf("Calling productRepo.Create with tenantID=%d, name=%s, sku=%s, price=%f", tenantID, productName, sku, price)
-
-			newProduct, err := uc.productRepo.Create(ctx, tenantID, productName, description, sku, price, 0)
-			if err != nil {
-				log.Printf("Error creating product: %v", err)
-				return nil, fmt.Errorf("error al crear nuevo producto '%s': %w", productName, err)
+			log.Printf("New product for item %d: name=%s, sku=%s, price=%s", item.ProductID, item.ProductName, sku, price)
+
+			// Los productos nuevos que llegan con lotes o números de serie quedan con ese control
+			product = &ent.Product{
+				TenantID:      tenantID,
+				Name:          item.ProductName,
+				Sku:           sku,
+				RetailPrice:   price,
+				PurchasePrice: doc.ToBase(item.UnitCost),
+				BaseUnit:      "unidad",
+				TrackLots:     len(item.Lots) > 0,
+				Serialized:    len(item.Serials) > 0,
+			}
+		} else {
+			// Validate product before receiving stock
+			product, err = uc.productRepo.FindByID(ctx, item.ProductID)
+			if err != nil || product.TenantID != tenantID {
+				log.Printf("Error finding product %d: %v", item.ProductID, err)
+				return nil, fmt.Errorf("producto con ID %d no encontrado", item.ProductID)
+			}
+			if product.Archived {
+				return nil, fmt.Errorf("el producto %s fue eliminado y no se puede comprar", product.Name)
+			}
+			if product.Bundle {
+				return nil, fmt.Errorf("%s es un combo: se compran sus componentes y se arma en el inventario", product.Name)
 			}
-			log.Printf("New product created with ID: %d", newProduct.ID)
-			productID = newProduct.ID
 		}
 
-		subtotal := float64(item.Quantity) * item.UnitCost
-		total += subtotal
+		// El stock y el costo se llevan en la unidad base: una caja de 24 a 12.00 entra
+		// como 24 unidades a 0.50
+		unit, quantity, err := toBaseQuantity(ctx, uc.unitRepo, product, item.Quantity, item.UnitID)
+		if err != nil {
+			return nil, err
+		}
 
-		purchaseItem := &ent.PurchaseInvoiceItem{
-			PurchaseInvoiceID: 0, // Will be set after invoice creation
-			ProductID:         productID,
-			Quantity:          item.Quantity,
-			UnitCost:          item.UnitCost,
-			Subtotal:          subtotal,
+		if err := validatePurchaseLots(product, item); err != nil {
+			return nil, err
+		}
+		if err := uc.validatePurchaseSerials(ctx, product, item.Serials, quantity); err != nil {
+			return nil, err
 		}
-		purchaseItems = append(purchaseItems, purchaseItem)
 
-			// Update product stock
-		log.Printf("Updating stock for product %d, quantity %d", productID, item.Quantity)
-		product, err := uc.productRepo.FindByID(ctx, productID)
+		// El impuesto de la compra es descontable: el inventario entra al costo de la base
+		rate, err := rates.RateFor(ctx, product, item.TaxRateID)
 		if err != nil {
-			log.Printf("Error finding product %d: %v", productID, err)
-			return nil, fmt.Errorf("error al buscar producto con ID %d: %w", productID, err)
+			return nil, err
 		}
-		if product == nil {
-			log.Printf("Product %d not found", productID)
-			return nil, fmt.Errorf("producto con ID %d no encontrado", productID)
+
+		// El subtotal se guarda y se suma redondeado a los centavos de la moneda de la compra
+		subtotal := item.UnitCost.Mul(item.Quantity).Round(doc.Currency)
+		taxLine := tax.Compute(subtotal, rate, req.PricesIncludeTax, doc.Currency)
+		taxLines = append(taxLines, taxLine)
+		subtotalNet += taxLine.Base
+		taxTotal += taxLine.Amount
+		total += taxLine.Total
+
+		purchaseItem := repositories.PurchaseItem{
+			Item: &ent.PurchaseInvoiceItem{
+				ProductID: product.ID,
+				Quantity:  quantity,
+				UnitCost:  taxLine.Base.Div(quantity),
+				Subtotal:  subtotal,
+				TaxRateID: taxes.RateID(rate),
+				TaxRate:   rate.Percent,
+				TaxBase:   taxLine.Base,
+				TaxAmount: taxLine.Amount,
+			},
+			Serials: item.Serials,
+		}
+		if product.ID == 0 {
+			purchaseItem.NewProduct = product
 		}
+		// Stock y costo entran en moneda base
+		purchaseItem.BaseUnitCost = doc.ToBaseCost(purchaseItem.Item.UnitCost)
 
-		// Update stock: stock += quantity purchased
-		err = uc.productRepo.AddStock(ctx, productID, item.Quantity)
-		if err != nil {
-			log.Printf("Error updating stock for product %d: %v", productID, err)
-			return nil, fmt.Errorf("error al actualizar stock del producto %d: %w", productID, err)
+		for _, lot := range item.Lots {
+			lotQuantity := lot.Quantity
+			if unit != nil {
+				lotQuantity = qty.Round(lot.Quantity * unit.Factor)
+			}
+			purchaseItem.Lots = append(purchaseItem.Lots, repositories.PurchaseLot{
+				LotNumber:  lot.LotNumber,
+				ExpiryDate: lot.ExpiryDate,
+				Quantity:   lotQuantity,
+			})
+		}
+		if unit != nil {
+			purchaseItem.Item.UnitID = &unit.ID
+			purchaseItem.Item.UnitName = unit.Name
+			purchaseItem.Item.UnitQuantity = &item.Quantity
 		}
-		log.Printf("Stock updated successfully for product %d", productID)
+
+		purchaseItems = append(purchaseItems, purchaseItem)
 	}
 
-	// Create purchase invoice
-	log.Printf("Creating purchase invoice with total %f", total)
-	invoice, err := uc.purchaseInvoiceRepo.Create(ctx, tenantID, req.SupplierID, userID, req.InvoiceNumber, total, req.PaymentMethod, req.DueDate)
+	total = total.Round(doc.Currency)
+	taxSummary, taxDTOs := taxes.Summarize(taxLines)
+	documentTotals := doc.Totals(subtotalNet.Round(doc.Currency), taxTotal.Round(doc.Currency), total)
+
+	log.Printf("Creating purchase invoice with total %s", total)
+	invoice, received, err := uc.purchaseInvoiceRepo.Create(ctx, repositories.PurchaseData{
+		TenantID:         tenantID,
+		SupplierID:       req.SupplierID,
+		UserID:           userID,
+		InvoiceNumber:    req.InvoiceNumber,
+		PaymentMethod:    req.PaymentMethod,
+		DueDate:          req.DueDate,
+		Subtotal:         subtotalNet.Round(doc.Currency),
+		TaxTotal:         taxTotal.Round(doc.Currency),
+		Total:            total,
+		PricesIncludeTax: req.PricesIncludeTax,
+		Taxes:            taxSummary,
+		Currency:         documentTotals,
+		Items:            purchaseItems,
+	})
 	if err != nil {
 		log.Printf("Error creating purchase invoice: %v", err)
 		return nil, fmt.Errorf("error al crear factura de compra: %w", err)
 	}
-	log.Printf("Purchase invoice created with ID %d", invoice.ID)
+	log.Printf("Purchase invoice %d created with %d items", invoice.ID, len(received))
 
-	// Create purchase invoice items
-	var itemsDTO []PurchaseItemDTO
-	for _, item := range purchaseItems {
-		item.PurchaseInvoiceID = invoice.ID
-		log.Printf("Creating purchase invoice item for product %d", item.ProductID)
-		createdItem, err := uc.purchaseInvoiceItemRepo.Create(ctx, item.PurchaseInvoiceID, item.ProductID, item.Quantity, item.UnitCost, item.Subtotal)
-		if err != nil {
-			log.Printf("Error creating purchase invoice item for product %d: %v", item.ProductID, err)
-			return nil, fmt.Errorf("error al crear ítem de factura para producto %d: %w", item.ProductID, err)
+	itemsDTO := make([]PurchaseItemDTO, 0, len(received))
+	for i, item := range received {
+		itemDTO := convertPurchaseInvoiceItemToDTO(item.Item)
+		for _, lot := range item.Lots {
+			itemDTO.Lots = append(itemDTO.Lots, convertLotToDTO(lot))
 		}
-
-		itemsDTO = append(itemsDTO, PurchaseItemDTO{
-			ID:                createdItem.ID,
-			PurchaseInvoiceID: createdItem.PurchaseInvoiceID,
-			ProductID:         createdItem.ProductID,
-			Quantity:          createdItem.Quantity,
-			UnitCost:          createdItem.UnitCost,
-			Subtotal:          createdItem.Subtotal,
-		})
+		if len(purchaseItems[i].Serials) > 0 {
+			itemDTO.Serials = purchaseItems[i].Serials
+		}
+		itemsDTO = append(itemsDTO, itemDTO)
 	}
-	log.Printf("Purchase completed successfully with %d items", len(itemsDTO))
 
 	dto := convertPurchaseInvoiceToDTO(invoice)
+	dto.Taxes = taxDTOs
 	dto.Items = itemsDTO
 
 	return dto, nil
-}
\ No newline at end of file
+}
+
+func convertLotToDTO(lot *ent.ProductLot) PurchaseLotDTO {
+	var expiryDate *string
+	if lot.ExpiryDate != nil {
+		formatted := lot.ExpiryDate.Format("2006-01-02")
+		expiryDate = &formatted
+	}
+
+	return PurchaseLotDTO{
+		ID:         lot.ID,
+		LotNumber:  lot.LotNumber,
+		ExpiryDate: expiryDate,
+		Quantity:   lot.Quantity,
+	}
+}
+
+// validatePurchaseLots exige lotes para los productos con control de lotes y verifica que
+// las cantidades por lote sumen la cantidad comprada
+func validatePurchaseLots(product *ent.Product, item PurchaseItemRequest) error {
+	if len(item.Lots) == 0 {
+		if product.TrackLots {
+			return fmt.Errorf("el producto %s requiere lote y fecha de vencimiento", product.Name)
+		}
+		return nil
+	}
+
+	if !product.TrackLots {
+		return fmt.Errorf("el producto %s no tiene control de lotes", product.Name)
+	}
+
+	lotTotal := 0.0
+	for _, lot := range item.Lots {
+		if lot.LotNumber == "" {
+			return fmt.Errorf("número de lote requerido para el producto %s", product.Name)
+		}
+		if lot.Quantity <= 0 {
+			return fmt.Errorf("la cantidad del lote %s debe ser mayor a 0", lot.LotNumber)
+		}
+		lotTotal = qty.Round(lotTotal + lot.Quantity)
+	}
+
+	if lotTotal != qty.Round(item.Quantity) {
+		return fmt.Errorf("los lotes del producto %s suman %s pero se compraron %s", product.Name, qty.Format(lotTotal), qty.Format(item.Quantity))
+	}
+
+	return nil
+}
+
+// validatePurchaseSerials exige un número de serie único por unidad para los productos
+// serializados; quantity va en la unidad base
+func (uc *CreatePurchaseUseCase) validatePurchaseSerials(ctx context.Context, product *ent.Product, serials []string, quantity float64) error {
+	if len(serials) == 0 {
+		if product.Serialized {
+			return fmt.Errorf("el producto %s requiere un número de serie por unidad", product.Name)
+		}
+		return nil
+	}
+
+	if !product.Serialized {
+		return fmt.Errorf("el producto %s no controla números de serie", product.Name)
+	}
+	if float64(len(serials)) != quantity {
+		return fmt.Errorf("se recibieron %d números de serie para %s unidades de %s", len(serials), qty.Format(quantity), product.Name)
+	}
+
+	seen := make(map[string]bool, len(serials))
+	for _, serial := range serials {
+		if serial == "" {
+			return fmt.Errorf("número de serie vacío para el producto %s", product.Name)
+		}
+		if seen[serial] {
+			return fmt.Errorf("número de serie %s repetido", serial)
+		}
+		seen[serial] = true
+	}
+
+	existing, err := uc.serialRepo.FindByProductAndNumbers(ctx, product.ID, serials)
+	if err != nil {
+		return fmt.Errorf("error al validar números de serie: %w", err)
+	}
+	if len(existing) > 0 {
+		return fmt.Errorf("el número de serie %s ya está registrado para %s", existing[0].SerialNumber, product.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package purchase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+const (
+	defaultSalesWindowDays = 30
+	defaultCoverDays       = 15
+)
+
+type GetReorderSuggestionsUseCase struct {
+	productRepo         repositories.ProductRepository
+	invoiceRepo         repositories.InvoiceRepository
+	purchaseInvoiceRepo repositories.PurchaseInvoiceRepository
+	supplierRepo        repositories.SupplierRepository
+}
+
+func NewGetReorderSuggestionsUseCase(
+	productRepo repositories.ProductRepository,
+	invoiceRepo repositories.InvoiceRepository,
+	purchaseInvoiceRepo repositories.PurchaseInvoiceRepository,
+	supplierRepo repositories.SupplierRepository,
+) *GetReorderSuggestionsUseCase {
+	return &GetReorderSuggestionsUseCase{
+		productRepo:         productRepo,
+		invoiceRepo:         invoiceRepo,
+		purchaseInvoiceRepo: purchaseInvoiceRepo,
+		supplierRepo:        supplierRepo,
+	}
+}
+
+type ReorderSuggestionsRequest struct {
+	// WindowDays es la ventana de ventas usada para calcular el promedio diario
+	WindowDays int `json:"windowDays"`
+	// CoverDays son los días de venta que debe cubrir el pedido después de recibirlo
+	CoverDays int `json:"coverDays"`
+	// LeadTimeDays reemplaza el tiempo de entrega configurado en cada proveedor
+	LeadTimeDays *int `json:"leadTimeDays,omitempty"`
+	// SupplierID limita las sugerencias a un proveedor
+	SupplierID int `json:"supplierId,omitempty"`
+}
+
+type ReorderItemDTO struct {
+	ProductID         int          `json:"productId"`
+	ProductName       string       `json:"productName"`
+	SKU               string       `json:"sku"`
+	Stock             float64      `json:"stock"`
+	SoldInWindow      float64      `json:"soldInWindow"`
+	AverageDailySales float64      `json:"averageDailySales"`
+	DaysOfCover       *float64     `json:"daysOfCover"`
+	SuggestedQuantity float64      `json:"suggestedQuantity"`
+	LastUnitCost      money.Amount `json:"lastUnitCost"`
+	EstimatedCost     money.Amount `json:"estimatedCost"`
+}
+
+type SupplierSuggestionDTO struct {
+	SupplierID     int              `json:"supplierId"`
+	SupplierName   string           `json:"supplierName"`
+	LeadTimeDays   int              `json:"leadTimeDays"`
+	Items          []ReorderItemDTO `json:"items"`
+	EstimatedTotal money.Amount     `json:"estimatedTotal"`
+}
+
+type ReorderSuggestionsResponse struct {
+	WindowDays int                     `json:"windowDays"`
+	CoverDays  int                     `json:"coverDays"`
+	Suppliers  []SupplierSuggestionDTO `json:"suppliers"`
+	// Unassigned contiene productos que necesitan reposición pero nunca se han comprado
+	Unassigned []ReorderItemDTO `json:"unassigned"`
+}
+
+func (uc *GetReorderSuggestionsUseCase) Execute(ctx context.Context, tenantID int, req ReorderSuggestionsRequest) (*ReorderSuggestionsResponse, error) {
+	if req.WindowDays <= 0 {
+		req.WindowDays = defaultSalesWindowDays
+	}
+	if req.CoverDays <= 0 {
+		req.CoverDays = defaultCoverDays
+	}
+	if req.LeadTimeDays != nil && *req.LeadTimeDays < 0 {
+		return nil, fmt.Errorf("el tiempo de entrega no puede ser negativo")
+	}
+
+	since := time.Now().AddDate(0, 0, -req.WindowDays)
+	sold, err := uc.invoiceRepo.SumQuantitySoldByProduct(ctx, tenantID, since)
+	if err != nil {
+		return nil, fmt.Errorf("error al calcular ventas: %w", err)
+	}
+
+	lastPurchases, err := uc.purchaseInvoiceRepo.FindLastPurchaseByProduct(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar compras: %w", err)
+	}
+
+	products, err := uc.productRepo.FindAllByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar productos: %w", err)
+	}
+
+	groups := make(map[int]*SupplierSuggestionDTO)
+	unassigned := []ReorderItemDTO{}
+
+	for _, p := range products {
+		last, hasSupplier := lastPurchases[p.ID]
+		if req.SupplierID != 0 && (!hasSupplier || last.SupplierID != req.SupplierID) {
+			continue
+		}
+
+		leadTime := 0
+		var group *SupplierSuggestionDTO
+		if hasSupplier {
+			group = groups[last.SupplierID]
+			if group == nil {
+				group, err = uc.newSupplierGroup(ctx, tenantID, last.SupplierID, req.LeadTimeDays)
+				if err != nil {
+					return nil, err
+				}
+				groups[last.SupplierID] = group
+			}
+			leadTime = group.LeadTimeDays
+		} else if req.LeadTimeDays != nil {
+			leadTime = *req.LeadTimeDays
+		}
+
+		item, ok := suggestReorder(p.Stock, sold[p.ID], req.WindowDays, leadTime, req.CoverDays)
+		if !ok {
+			continue
+		}
+		item.ProductID = p.ID
+		item.ProductName = p.Name
+		item.SKU = p.Sku
+
+		if !hasSupplier {
+			unassigned = append(unassigned, item)
+			continue
+		}
+
+		item.LastUnitCost = last.UnitCost
+		item.EstimatedCost = last.UnitCost.Mul(item.SuggestedQuantity)
+		group.Items = append(group.Items, item)
+		group.EstimatedTotal += item.EstimatedCost
+	}
+
+	suppliers := make([]SupplierSuggestionDTO, 0, len(groups))
+	for _, group := range groups {
+		if len(group.Items) == 0 {
+			continue
+		}
+		suppliers = append(suppliers, *group)
+	}
+	sort.Slice(suppliers, func(i, j int) bool {
+		return suppliers[i].SupplierName < suppliers[j].SupplierName
+	})
+
+	return &ReorderSuggestionsResponse{
+		WindowDays: req.WindowDays,
+		CoverDays:  req.CoverDays,
+		Suppliers:  suppliers,
+		Unassigned: unassigned,
+	}, nil
+}
+
+func (uc *GetReorderSuggestionsUseCase) newSupplierGroup(ctx context.Context, tenantID, supplierID int, leadTimeOverride *int) (*SupplierSuggestionDTO, error) {
+	supplier, err := uc.supplierRepo.FindByID(ctx, supplierID)
+	if err != nil {
+		return nil, fmt.Errorf("proveedor con ID %d no encontrado", supplierID)
+	}
+	if supplier.TenantID != tenantID {
+		return nil, fmt.Errorf("proveedor con ID %d no pertenece a tu tenant", supplierID)
+	}
+
+	leadTime := supplier.LeadTimeDays
+	if leadTimeOverride != nil {
+		leadTime = *leadTimeOverride
+	}
+
+	return &SupplierSuggestionDTO{
+		SupplierID:   supplier.ID,
+		SupplierName: supplier.Name,
+		LeadTimeDays: leadTime,
+		Items:        []ReorderItemDTO{},
+	}, nil
+}
+
+// suggestReorder calcula la cantidad a pedir para cubrir el tiempo de entrega más los días
+// de cobertura al ritmo de venta promedio. Devuelve false si el stock actual es suficiente.
+func suggestReorder(stock, soldInWindow float64, windowDays, leadTimeDays, coverDays int) (ReorderItemDTO, bool) {
+	avgDaily := soldInWindow / float64(windowDays)
+	if avgDaily <= 0 {
+		return ReorderItemDTO{}, false
+	}
+
+	daysOfCover := math.Round(stock/avgDaily*10) / 10
+	target := math.Ceil(avgDaily * float64(leadTimeDays+coverDays))
+	suggested := qty.Round(target - stock)
+	if suggested <= 0 {
+		return ReorderItemDTO{}, false
+	}
+
+	return ReorderItemDTO{
+		Stock:             stock,
+		SoldInWindow:      soldInWindow,
+		AverageDailySales: math.Round(avgDaily*100) / 100,
+		DaysOfCover:       &daysOfCover,
+		SuggestedQuantity: suggested,
+	}, true
+}
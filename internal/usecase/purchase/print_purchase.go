@@ -0,0 +1,211 @@
+package purchase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/usecase/currency"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/imaging"
+	"Veritasbackend/pkg/invoicepdf"
+	"Veritasbackend/pkg/money"
+	"Veritasbackend/pkg/quantity"
+)
+
+type PrintPurchaseUseCase struct {
+	purchaseInvoiceRepo     repositories.PurchaseInvoiceRepository
+	purchaseInvoiceItemRepo repositories.PurchaseInvoiceItemRepository
+	productRepo             repositories.ProductRepository
+	supplierRepo            repositories.SupplierRepository
+	tenantRepo              repositories.TenantRepository
+	taxRateRepo             repositories.TaxRateRepository
+}
+
+func NewPrintPurchaseUseCase(
+	purchaseInvoiceRepo repositories.PurchaseInvoiceRepository,
+	purchaseInvoiceItemRepo repositories.PurchaseInvoiceItemRepository,
+	productRepo repositories.ProductRepository,
+	supplierRepo repositories.SupplierRepository,
+	tenantRepo repositories.TenantRepository,
+	taxRateRepo repositories.TaxRateRepository,
+) *PrintPurchaseUseCase {
+	return &PrintPurchaseUseCase{
+		purchaseInvoiceRepo:     purchaseInvoiceRepo,
+		purchaseInvoiceItemRepo: purchaseInvoiceItemRepo,
+		productRepo:             productRepo,
+		supplierRepo:            supplierRepo,
+		tenantRepo:              tenantRepo,
+		taxRateRepo:             taxRateRepo,
+	}
+}
+
+type PurchaseFile struct {
+	ContentType string
+	FileName    string
+	Data        []byte
+}
+
+var purchaseStatusLabels = map[string]string{
+	"draft":     "Borrador",
+	"pending":   "Pendiente de pago",
+	"partial":   "Pago parcial",
+	"paid":      "Pagada",
+	"cancelled": "Cancelada",
+}
+
+// Execute imprime la factura de compra en PDF, en hoja A4 o en recibo de 80 mm según layout
+func (uc *PrintPurchaseUseCase) Execute(ctx context.Context, tenantID, purchaseID int, layout string) (*PurchaseFile, error) {
+	inv, err := uc.purchaseInvoiceRepo.FindByID(ctx, purchaseID)
+	if err != nil || inv.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	t, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	items, err := uc.purchaseInvoiceItemRepo.FindByPurchaseInvoiceID(ctx, purchaseID)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar las líneas de la compra: %v", err)
+	}
+
+	taxLines, err := uc.taxRateRepo.FindDocumentTaxes(ctx, "purchase_invoice", purchaseID)
+	if err != nil {
+		return nil, fmt.Errorf("error al consultar los impuestos de la compra: %v", err)
+	}
+
+	base := currency.TenantCurrency(t)
+	docCurrency, ok := money.Lookup(inv.Currency)
+	if !ok {
+		docCurrency = base
+	}
+	format := func(a money.Amount) string {
+		return a.Format(docCurrency)
+	}
+
+	doc := invoicepdf.Document{
+		Title:      "Factura de compra",
+		Number:     inv.InvoiceNumber,
+		Date:       inv.CreatedAt.Format("2006-01-02 15:04"),
+		Status:     purchaseStatusLabels[inv.Status],
+		Issuer:     issuerParty(t),
+		Logo:       tenantLogo(t),
+		PartyLabel: "Proveedor",
+	}
+
+	if supplier, err := uc.supplierRepo.FindByID(ctx, inv.SupplierID); err == nil {
+		doc.Party = invoicepdf.Party{
+			Name:    supplier.Name,
+			TaxID:   supplier.RucNit,
+			Address: supplier.Address,
+			Phone:   supplier.Phone,
+			Email:   supplier.Email,
+		}
+	}
+
+	for _, item := range items {
+		name := ""
+		if product, err := uc.productRepo.FindByID(ctx, item.ProductID); err == nil {
+			name = product.Name
+		}
+
+		qty := quantity.Format(item.Quantity)
+		unitCost := item.UnitCost
+		if item.UnitQuantity != nil && *item.UnitQuantity != 0 {
+			qty = strings.TrimSpace(quantity.Format(*item.UnitQuantity) + " " + item.UnitName)
+			unitCost = item.Subtotal.Div(*item.UnitQuantity)
+		}
+
+		doc.Lines = append(doc.Lines, invoicepdf.Line{
+			Description: name,
+			Quantity:    qty,
+			UnitPrice:   format(unitCost),
+			Total:       format(item.Subtotal),
+		})
+	}
+
+	for _, tax := range taxLines {
+		doc.Taxes = append(doc.Taxes, invoicepdf.Tax{
+			Name:   fmt.Sprintf("%s %s%%", tax.Name, quantity.Format(tax.Rate)),
+			Base:   format(tax.Base),
+			Amount: format(tax.Amount),
+		})
+	}
+
+	doc.Totals = []invoicepdf.Total{
+		{Label: "Subtotal", Value: format(inv.Subtotal)},
+		{Label: "Impuestos", Value: format(inv.TaxTotal)},
+		{Label: "Total " + docCurrency.Code, Value: format(inv.Total), Bold: true},
+		{Label: "Pagado", Value: format(inv.PaidAmount)},
+		{Label: "Saldo", Value: format(inv.Total.Sub(inv.PaidAmount))},
+	}
+
+	if docCurrency.Code != base.Code {
+		doc.Totals = append(doc.Totals, invoicepdf.Total{
+			Label: fmt.Sprintf("Total %s (tasa %s)", base.Code, quantity.Format(inv.ExchangeRate)),
+			Value: inv.BaseTotal.Format(base),
+		})
+	}
+
+	if !inv.DueDate.IsZero() {
+		doc.Notes = append(doc.Notes, "Vence: "+inv.DueDate.Format("2006-01-02"))
+	}
+	if inv.PricesIncludeTax {
+		doc.Notes = append(doc.Notes, "Los costos incluyen impuestos")
+	}
+
+	doc.QR = strings.Join([]string{
+		"Compra: " + doc.Number,
+		"Proveedor: " + strings.TrimSpace(doc.Party.Name+" "+doc.Party.TaxID),
+		"Fecha: " + doc.Date,
+		"Total: " + format(inv.Total) + " " + docCurrency.Code,
+		"Estado: " + doc.Status,
+	}, "\n")
+
+	data, err := invoicepdf.Render(doc, layout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PurchaseFile{
+		ContentType: "application/pdf",
+		FileName:    fmt.Sprintf("compra-%d.pdf", inv.ID),
+		Data:        data,
+	}, nil
+}
+
+// issuerParty son los datos del tenant que se imprimen en el encabezado; sin razón social se usa
+// el nombre del tenant
+func issuerParty(t *ent.Tenant) invoicepdf.Party {
+	party := invoicepdf.Party{
+		Name:    t.Name,
+		Address: t.Address,
+		Phone:   t.Phone,
+		Email:   t.Email,
+	}
+	if t.LegalName != "" {
+		party.Name = t.LegalName
+	}
+	if t.TaxID != "" {
+		party.TaxID = "NIT " + t.TaxID
+	}
+	return party
+}
+
+// tenantLogo decodifica el logo guardado; un logo ilegible se omite en vez de impedir la impresión
+func tenantLogo(t *ent.Tenant) image.Image {
+	if len(t.Logo) == 0 {
+		return nil
+	}
+	img, _, err := imaging.Decode(bytes.NewReader(t.Logo))
+	if err != nil {
+		return nil
+	}
+	return img
+}
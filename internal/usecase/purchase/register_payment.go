@@ -0,0 +1,155 @@
+package purchase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/usecase/currency"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+)
+
+// RegisterPaymentUseCase registra un pago a una factura de compra. En las compras en moneda
+// extranjera el pago se convierte con la tasa del día del pago y la diferencia contra la tasa de
+// la factura queda como diferencia en cambio realizada.
+type RegisterPaymentUseCase struct {
+	purchaseInvoiceRepo repositories.PurchaseInvoiceRepository
+	tenantRepo          repositories.TenantRepository
+	rateRepo            repositories.ExchangeRateRepository
+}
+
+func NewRegisterPaymentUseCase(
+	purchaseInvoiceRepo repositories.PurchaseInvoiceRepository,
+	tenantRepo repositories.TenantRepository,
+	rateRepo repositories.ExchangeRateRepository,
+) *RegisterPaymentUseCase {
+	return &RegisterPaymentUseCase{
+		purchaseInvoiceRepo: purchaseInvoiceRepo,
+		tenantRepo:          tenantRepo,
+		rateRepo:            rateRepo,
+	}
+}
+
+// RegisterPaymentRequest lleva el monto en la moneda de la factura. PaymentDate es opcional
+// (YYYY-MM-DD, hoy por defecto) y ExchangeRate reemplaza la tasa vigente ese día.
+type RegisterPaymentRequest struct {
+	Amount        money.Amount `json:"amount"`
+	PaymentMethod string       `json:"paymentMethod"`
+	Reference     string       `json:"reference,omitempty"`
+	Notes         string       `json:"notes,omitempty"`
+	PaymentDate   string       `json:"paymentDate,omitempty"`
+	ExchangeRate  *float64     `json:"exchangeRate,omitempty"`
+}
+
+type SupplierPaymentDTO struct {
+	ID                int          `json:"id"`
+	PurchaseInvoiceID int          `json:"purchaseInvoiceId"`
+	Amount            money.Amount `json:"amount"`
+	Currency          string       `json:"currency"`
+	ExchangeRate      float64      `json:"exchangeRate"`
+	BaseAmount        money.Amount `json:"baseAmount"`
+	FXGainLoss        money.Amount `json:"fxGainLoss"`
+	PaymentDate       string       `json:"paymentDate"`
+	PaymentMethod     string       `json:"paymentMethod"`
+	Reference         string       `json:"reference,omitempty"`
+	Notes             string       `json:"notes,omitempty"`
+	UserID            int          `json:"userId"`
+	CreatedAt         string       `json:"createdAt"`
+}
+
+type RegisterPaymentResponse struct {
+	Payment SupplierPaymentDTO  `json:"payment"`
+	Invoice *PurchaseInvoiceDTO `json:"invoice"`
+}
+
+func (uc *RegisterPaymentUseCase) Execute(ctx context.Context, tenantID, userID, purchaseInvoiceID int, req RegisterPaymentRequest) (*RegisterPaymentResponse, error) {
+	if req.Amount <= 0 {
+		return nil, fmt.Errorf("el monto del pago debe ser mayor a 0")
+	}
+	method := strings.TrimSpace(req.PaymentMethod)
+	if method == "" {
+		return nil, fmt.Errorf("el método de pago es obligatorio")
+	}
+
+	paymentDate := time.Now()
+	if req.PaymentDate != "" {
+		parsed, err := time.Parse("2006-01-02", req.PaymentDate)
+		if err != nil {
+			return nil, fmt.Errorf("fecha de pago inválida %q, use YYYY-MM-DD", req.PaymentDate)
+		}
+		paymentDate = parsed
+	}
+
+	inv, err := uc.purchaseInvoiceRepo.FindByID(ctx, purchaseInvoiceID)
+	if err != nil || inv.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+	if inv.Status != "pending" && inv.Status != "partial" {
+		return nil, fmt.Errorf("%w: la compra está en estado %s y no admite pagos", pkg_errors.ErrInvalidState, inv.Status)
+	}
+
+	tenant, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("tenant no encontrado")
+	}
+	base := currency.TenantCurrency(tenant)
+
+	// Pagar una cuenta por pagar con una tasa menor a la de la factura cuesta menos en moneda
+	// base: la diferencia positiva es ganancia
+	rate := 1.0
+	baseAmount := req.Amount
+	var fxGainLoss money.Amount
+	if inv.Currency != base.Code {
+		rate, err = currency.ExchangeRateFor(ctx, uc.rateRepo, tenantID, inv.Currency, req.ExchangeRate, paymentDate)
+		if err != nil {
+			return nil, err
+		}
+		baseAmount = req.Amount.Exchange(rate, base)
+		fxGainLoss = req.Amount.Exchange(inv.ExchangeRate, base) - baseAmount
+	}
+
+	payment, inv, err := uc.purchaseInvoiceRepo.RegisterPayment(ctx, repositories.SupplierPaymentData{
+		PurchaseInvoiceID: purchaseInvoiceID,
+		TenantID:          tenantID,
+		UserID:            userID,
+		Amount:            req.Amount,
+		PaymentDate:       paymentDate,
+		PaymentMethod:     method,
+		Reference:         strings.TrimSpace(req.Reference),
+		Notes:             strings.TrimSpace(req.Notes),
+		Currency:          inv.Currency,
+		ExchangeRate:      rate,
+		BaseAmount:        baseAmount,
+		FXGainLoss:        fxGainLoss,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error al registrar el pago: %v", err)
+	}
+
+	return &RegisterPaymentResponse{
+		Payment: convertSupplierPaymentToDTO(payment),
+		Invoice: convertPurchaseInvoiceToDTO(inv),
+	}, nil
+}
+
+func convertSupplierPaymentToDTO(payment *ent.SupplierPayment) SupplierPaymentDTO {
+	return SupplierPaymentDTO{
+		ID:                payment.ID,
+		PurchaseInvoiceID: payment.PurchaseInvoiceID,
+		Amount:            payment.Amount,
+		Currency:          payment.Currency,
+		ExchangeRate:      payment.ExchangeRate,
+		BaseAmount:        payment.BaseAmount,
+		FXGainLoss:        payment.FxGainLoss,
+		PaymentDate:       payment.PaymentDate.Format("2006-01-02"),
+		PaymentMethod:     payment.PaymentMethod,
+		Reference:         payment.Reference,
+		Notes:             payment.Notes,
+		UserID:            payment.UserID,
+		CreatedAt:         payment.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -0,0 +1,39 @@
+package purchase
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+// toBaseQuantity convierte la cantidad comprada a la unidad base del producto y devuelve la
+// unidad de compra usada, o nil si se compró en la unidad base
+func toBaseQuantity(ctx context.Context, unitRepo repositories.ProductUnitRepository, product *ent.Product, quantity float64, unitID *int) (*ent.ProductUnit, float64, error) {
+	if quantity <= 0 {
+		return nil, 0, fmt.Errorf("la cantidad de %s debe ser mayor a 0", product.Name)
+	}
+
+	var unit *ent.ProductUnit
+	base := qty.Round(quantity)
+
+	if unitID != nil {
+		found, err := unitRepo.FindByID(ctx, *unitID)
+		if err != nil || found.ProductID != product.ID {
+			return nil, 0, fmt.Errorf("unidad con ID %d no encontrada para el producto %s", *unitID, product.Name)
+		}
+		unit = found
+		base = qty.Round(quantity * unit.Factor)
+	}
+
+	if base <= 0 {
+		return nil, 0, fmt.Errorf("la cantidad de %s es demasiado pequeña", product.Name)
+	}
+	if !product.Fractional && !qty.IsWhole(base) {
+		return nil, 0, fmt.Errorf("el producto %s no admite cantidades fraccionarias de %s", product.Name, product.BaseUnit)
+	}
+
+	return unit, base, nil
+}
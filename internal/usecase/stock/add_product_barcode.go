@@ -0,0 +1,60 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type AddProductBarcodeUseCase struct {
+	barcodeRepo repositories.ProductBarcodeRepository
+	productRepo repositories.ProductRepository
+	unitRepo    repositories.ProductUnitRepository
+}
+
+func NewAddProductBarcodeUseCase(barcodeRepo repositories.ProductBarcodeRepository, productRepo repositories.ProductRepository, unitRepo repositories.ProductUnitRepository) *AddProductBarcodeUseCase {
+	return &AddProductBarcodeUseCase{
+		barcodeRepo: barcodeRepo,
+		productRepo: productRepo,
+		unitRepo:    unitRepo,
+	}
+}
+
+type AddProductBarcodeRequest struct {
+	Code string `json:"code" binding:"required"`
+	// Symbology es opcional: ean13, ean8, upca o code128; sin ella se deduce del código
+	Symbology string `json:"symbology"`
+	// UnitID asocia el código a una unidad de empaque (caja, bulto) del producto
+	UnitID *int `json:"unitId,omitempty"`
+}
+
+func (uc *AddProductBarcodeUseCase) Execute(ctx context.Context, tenantID, productID int, req AddProductBarcodeRequest) (*BarcodeDTO, error) {
+	product, err := uc.productRepo.FindByID(ctx, productID)
+	if err != nil || product.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	code, symbology, err := normalizeBarcode(req.Code, req.Symbology)
+	if err != nil {
+		return nil, err
+	}
+
+	unitID := 0
+	if req.UnitID != nil {
+		unit, err := uc.unitRepo.FindByID(ctx, *req.UnitID)
+		if err != nil || unit.ProductID != productID {
+			return nil, fmt.Errorf("la unidad %d no pertenece al producto", *req.UnitID)
+		}
+		unitID = unit.ID
+	}
+
+	b, err := addBarcode(ctx, uc.barcodeRepo, tenantID, productID, unitID, code, symbology, false)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := convertBarcodeToDTO(b)
+	return &dto, nil
+}
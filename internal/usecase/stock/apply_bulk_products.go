@@ -0,0 +1,51 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type ApplyBulkProductsUseCase struct {
+	productRepo repositories.ProductRepository
+	taxRateRepo repositories.TaxRateRepository
+}
+
+func NewApplyBulkProductsUseCase(productRepo repositories.ProductRepository, taxRateRepo repositories.TaxRateRepository) *ApplyBulkProductsUseCase {
+	return &ApplyBulkProductsUseCase{
+		productRepo: productRepo,
+		taxRateRepo: taxRateRepo,
+	}
+}
+
+// Execute aplica la operación en una sola transacción y la registra en la bitácora con los
+// valores antes y después de cada producto
+func (uc *ApplyBulkProductsUseCase) Execute(ctx context.Context, tenantID, userID int, req BulkProductsRequest) (*BulkProductsResponse, error) {
+	response, changes, err := planBulk(ctx, uc.productRepo, uc.taxRateRepo, tenantID, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return nil, fmt.Errorf("la operación no cambia ningún producto")
+	}
+
+	err = uc.productRepo.ApplyChanges(ctx, tenantID, userID, changes, repositories.AuditEntry{
+		TenantID: tenantID,
+		UserID:   userID,
+		Action:   "product.bulk_" + req.Action,
+		Entity:   "product",
+		Summary:  response.Summary,
+		Details: map[string]interface{}{
+			"request": req,
+			"changes": response.Changes,
+			"skipped": response.Skipped,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response.Applied = true
+	return response, nil
+}
@@ -0,0 +1,43 @@
+package stock
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type ApplyScheduledPricesUseCase struct {
+	priceRepo repositories.ProductPriceRepository
+}
+
+func NewApplyScheduledPricesUseCase(priceRepo repositories.ProductPriceRepository) *ApplyScheduledPricesUseCase {
+	return &ApplyScheduledPricesUseCase{
+		priceRepo: priceRepo,
+	}
+}
+
+// Execute aplica los cambios de precio programados de todos los tenants cuya fecha ya llegó.
+// Un cambio que falla se registra en el log y se reintenta en la siguiente ejecución.
+func (uc *ApplyScheduledPricesUseCase) Execute(ctx context.Context) error {
+	due, err := uc.priceRepo.FindDue(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	applied := 0
+	for _, price := range due {
+		if _, err := uc.priceRepo.Apply(ctx, price.ID); err != nil {
+			log.Printf("Error applying scheduled price %d for product %d: %v", price.ID, price.ProductID, err)
+			continue
+		}
+		applied++
+	}
+
+	if applied > 0 {
+		log.Printf("Applied %d scheduled price changes", applied)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+type AssembleBundleUseCase struct {
+	bundleRepo  repositories.BundleRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewAssembleBundleUseCase(bundleRepo repositories.BundleRepository, productRepo repositories.ProductRepository) *AssembleBundleUseCase {
+	return &AssembleBundleUseCase{
+		bundleRepo:  bundleRepo,
+		productRepo: productRepo,
+	}
+}
+
+type AssembleBundleRequest struct {
+	Quantity float64 `json:"quantity" binding:"required"`
+	Notes    string  `json:"notes"`
+}
+
+type AssemblyLineDTO struct {
+	ProductID   int          `json:"productId"`
+	ProductName string       `json:"productName"`
+	Quantity    float64      `json:"quantity"`
+	UnitCost    money.Amount `json:"unitCost"`
+}
+
+type AssemblyDTO struct {
+	ID         int               `json:"id"`
+	BundleID   int               `json:"bundleId"`
+	BundleName string            `json:"bundleName"`
+	Quantity   float64           `json:"quantity"`
+	UnitCost   money.Amount      `json:"unitCost"`
+	Notes      string            `json:"notes"`
+	UserID     int               `json:"userId"`
+	Lines      []AssemblyLineDTO `json:"lines"`
+	CreatedAt  string            `json:"createdAt"`
+}
+
+// Execute arma combos por adelantado: descuenta los componentes y suma los combos a su stock
+// con el costo de lo consumido
+func (uc *AssembleBundleUseCase) Execute(ctx context.Context, tenantID, userID, productID int, req AssembleBundleRequest) (*AssemblyDTO, error) {
+	bundle, err := uc.productRepo.FindByID(ctx, productID)
+	if err != nil || bundle.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+	if !bundle.Bundle {
+		return nil, fmt.Errorf("%s no es un combo", bundle.Name)
+	}
+
+	quantity := qty.Round(req.Quantity)
+	if quantity <= 0 || !qty.IsWhole(quantity) {
+		return nil, fmt.Errorf("la cantidad a armar debe ser un número entero mayor a 0")
+	}
+
+	assembly, err := uc.bundleRepo.Assemble(ctx, tenantID, userID, productID, quantity, req.Notes)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := uc.bundleRepo.FindAssemblyLines(ctx, []int{assembly.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := assemblyProductNames(ctx, uc.productRepo, tenantID, []*ent.BundleAssembly{assembly}, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := convertAssemblyToDTO(assembly, lines[assembly.ID], names)
+	return &dto, nil
+}
+
+// assemblyProductNames devuelve el nombre de los combos y componentes de los armados
+func assemblyProductNames(ctx context.Context, productRepo repositories.ProductRepository, tenantID int, assemblies []*ent.BundleAssembly, lines map[int][]*ent.BundleAssemblyLine) (map[int]string, error) {
+	names := make(map[int]string)
+	if len(assemblies) == 0 {
+		return names, nil
+	}
+
+	var ids []int
+	for _, assembly := range assemblies {
+		ids = append(ids, assembly.BundleID)
+		for _, line := range lines[assembly.ID] {
+			ids = append(ids, line.ProductID)
+		}
+	}
+
+	products, err := productRepo.FindByIDsOrCategories(ctx, tenantID, ids, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range products {
+		names[p.ID] = p.Name
+	}
+
+	return names, nil
+}
+
+func convertAssemblyToDTO(assembly *ent.BundleAssembly, lines []*ent.BundleAssemblyLine, names map[int]string) AssemblyDTO {
+	dto := AssemblyDTO{
+		ID:         assembly.ID,
+		BundleID:   assembly.BundleID,
+		BundleName: names[assembly.BundleID],
+		Quantity:   assembly.Quantity,
+		UnitCost:   assembly.UnitCost,
+		Notes:      assembly.Notes,
+		UserID:     assembly.UserID,
+		Lines:      make([]AssemblyLineDTO, len(lines)),
+		CreatedAt:  assembly.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	for i, line := range lines {
+		dto.Lines[i] = AssemblyLineDTO{
+			ProductID:   line.ProductID,
+			ProductName: names[line.ProductID],
+			Quantity:    line.Quantity,
+			UnitCost:    line.UnitCost,
+		}
+	}
+
+	return dto
+}
@@ -0,0 +1,27 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type CancelScheduledPriceUseCase struct {
+	priceRepo repositories.ProductPriceRepository
+}
+
+func NewCancelScheduledPriceUseCase(priceRepo repositories.ProductPriceRepository) *CancelScheduledPriceUseCase {
+	return &CancelScheduledPriceUseCase{
+		priceRepo: priceRepo,
+	}
+}
+
+func (uc *CancelScheduledPriceUseCase) Execute(ctx context.Context, tenantID, priceID int) error {
+	price, err := uc.priceRepo.FindByID(ctx, priceID)
+	if err != nil || price.TenantID != tenantID {
+		return pkg_errors.ErrNotFound
+	}
+
+	return uc.priceRepo.Cancel(ctx, priceID)
+}
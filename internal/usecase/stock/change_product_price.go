@@ -0,0 +1,126 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+)
+
+type ChangeProductPriceUseCase struct {
+	priceRepo   repositories.ProductPriceRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewChangeProductPriceUseCase(priceRepo repositories.ProductPriceRepository, productRepo repositories.ProductRepository) *ChangeProductPriceUseCase {
+	return &ChangeProductPriceUseCase{
+		priceRepo:   priceRepo,
+		productRepo: productRepo,
+	}
+}
+
+type ChangeProductPriceRequest struct {
+	RetailPrice    money.Amount  `json:"retailPrice" binding:"min=0"`
+	WholesalePrice *money.Amount `json:"wholesalePrice,omitempty"`
+	// EffectiveFrom acepta YYYY-MM-DD o RFC3339; vacío o en el pasado aplica el cambio de inmediato
+	EffectiveFrom string `json:"effectiveFrom,omitempty"`
+}
+
+type PriceDTO struct {
+	ID             int           `json:"id"`
+	ProductID      int           `json:"productId"`
+	RetailPrice    money.Amount  `json:"retailPrice"`
+	WholesalePrice *money.Amount `json:"wholesalePrice"`
+	PurchasePrice  *money.Amount `json:"purchasePrice"`
+	EffectiveFrom  string        `json:"effectiveFrom"`
+	Status         string        `json:"status"`
+	Source         string        `json:"source"`
+	UserID         int           `json:"userId,omitempty"`
+	AppliedAt      string        `json:"appliedAt,omitempty"`
+	CreatedAt      string        `json:"createdAt"`
+}
+
+// Execute registra un cambio de precio. Si rige desde ahora se aplica en el momento; si rige
+// en el futuro queda programado para que lo aplique el programador de precios.
+func (uc *ChangeProductPriceUseCase) Execute(ctx context.Context, tenantID, userID, productID int, req ChangeProductPriceRequest) (*PriceDTO, error) {
+	product, err := uc.productRepo.FindByID(ctx, productID)
+	if err != nil || product.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	if req.WholesalePrice != nil && *req.WholesalePrice < 0 {
+		return nil, fmt.Errorf("el precio al mayor no puede ser negativo")
+	}
+
+	now := time.Now()
+	effectiveFrom := now
+	if req.EffectiveFrom != "" {
+		effectiveFrom, err = parseEffectiveDate(req.EffectiveFrom)
+		if err != nil {
+			return nil, err
+		}
+		if effectiveFrom.Before(now) {
+			effectiveFrom = now
+		}
+	}
+
+	price, err := uc.priceRepo.Schedule(ctx, tenantID, productID, userID, req.RetailPrice, req.WholesalePrice, effectiveFrom, "manual")
+	if err != nil {
+		return nil, err
+	}
+
+	if !effectiveFrom.After(now) {
+		price, err = uc.priceRepo.Apply(ctx, price.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dto := convertPriceToDTO(price)
+	return &dto, nil
+}
+
+// recordPriceChange deja en el historial un cambio de precio hecho desde la ficha del producto
+func recordPriceChange(ctx context.Context, priceRepo repositories.ProductPriceRepository, tenantID, userID, productID int, retailPrice, wholesalePrice money.Amount) error {
+	price, err := priceRepo.Schedule(ctx, tenantID, productID, userID, retailPrice, &wholesalePrice, time.Now(), "product")
+	if err != nil {
+		return err
+	}
+
+	_, err = priceRepo.Apply(ctx, price.ID)
+	return err
+}
+
+// parseEffectiveDate acepta una fecha (YYYY-MM-DD, desde el inicio del día) o un RFC3339
+func parseEffectiveDate(value string) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("fecha inválida %q: use YYYY-MM-DD o RFC3339", value)
+}
+
+func convertPriceToDTO(price *ent.ProductPrice) PriceDTO {
+	dto := PriceDTO{
+		ID:             price.ID,
+		ProductID:      price.ProductID,
+		RetailPrice:    price.RetailPrice,
+		WholesalePrice: price.WholesalePrice,
+		PurchasePrice:  price.PurchasePrice,
+		EffectiveFrom:  price.EffectiveFrom.Format("2006-01-02T15:04:05Z07:00"),
+		Status:         price.Status,
+		Source:         price.Source,
+		UserID:         price.UserID,
+		CreatedAt:      price.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if price.AppliedAt != nil {
+		dto.AppliedAt = price.AppliedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return dto
+}
@@ -0,0 +1,268 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+type CreateAdjustmentUseCase struct {
+	adjustmentRepo repositories.StockAdjustmentRepository
+	productRepo    repositories.ProductRepository
+	lotRepo        repositories.ProductLotRepository
+	tenantRepo     repositories.TenantRepository
+}
+
+func NewCreateAdjustmentUseCase(
+	adjustmentRepo repositories.StockAdjustmentRepository,
+	productRepo repositories.ProductRepository,
+	lotRepo repositories.ProductLotRepository,
+	tenantRepo repositories.TenantRepository,
+) *CreateAdjustmentUseCase {
+	return &CreateAdjustmentUseCase{
+		adjustmentRepo: adjustmentRepo,
+		productRepo:    productRepo,
+		lotRepo:        lotRepo,
+		tenantRepo:     tenantRepo,
+	}
+}
+
+type AdjustmentLineRequest struct {
+	ProductID int `json:"productId" binding:"required"`
+	// LotID es obligatorio para productos con control de lotes
+	LotID int `json:"lotId,omitempty"`
+	// Quantity es la diferencia de stock en la unidad base: negativa para bajas, positiva para altas
+	Quantity float64 `json:"quantity" binding:"required"`
+}
+
+type CreateAdjustmentRequest struct {
+	ReasonCode string                  `json:"reasonCode" binding:"required"`
+	Notes      string                  `json:"notes"`
+	Lines      []AdjustmentLineRequest `json:"lines" binding:"required,min=1,dive"`
+}
+
+type AdjustmentLineDTO struct {
+	ProductID   int          `json:"productId"`
+	ProductName string       `json:"productName"`
+	SKU         string       `json:"sku"`
+	LotID       int          `json:"lotId,omitempty"`
+	Quantity    float64      `json:"quantity"`
+	UnitCost    money.Amount `json:"unitCost"`
+	TotalCost   money.Amount `json:"totalCost"`
+}
+
+type AdjustmentDTO struct {
+	ID         int                 `json:"id"`
+	ReasonCode string              `json:"reasonCode"`
+	ReasonName string              `json:"reasonName"`
+	WriteOff   bool                `json:"writeOff"`
+	Notes      string              `json:"notes"`
+	Status     string              `json:"status"`
+	Source     string              `json:"source"`
+	TotalValue money.Amount        `json:"totalValue"`
+	UserID     int                 `json:"userId"`
+	ReviewedBy int                 `json:"reviewedBy,omitempty"`
+	ReviewedAt string              `json:"reviewedAt,omitempty"`
+	AppliedAt  string              `json:"appliedAt,omitempty"`
+	CreatedAt  string              `json:"createdAt"`
+	Lines      []AdjustmentLineDTO `json:"lines"`
+}
+
+// Execute registra un ajuste de stock. Si su valor al costo supera el umbral del tenant y
+// quien lo registra no puede aprobarlo, queda pendiente; si no, se aplica en el momento.
+func (uc *CreateAdjustmentUseCase) Execute(ctx context.Context, tenantID, userID int, canApprove bool, req CreateAdjustmentRequest) (*AdjustmentDTO, error) {
+	if _, err := ensureDefaultReasons(ctx, uc.adjustmentRepo, tenantID); err != nil {
+		return nil, err
+	}
+
+	reason, err := uc.adjustmentRepo.FindReasonByCode(ctx, tenantID, req.ReasonCode)
+	if err != nil {
+		return nil, fmt.Errorf("motivo de ajuste %s no encontrado", req.ReasonCode)
+	}
+	if !reason.Active {
+		return nil, fmt.Errorf("el motivo de ajuste %s está desactivado", req.ReasonCode)
+	}
+
+	lines := make([]repositories.AdjustmentLine, 0, len(req.Lines))
+	absValue := money.Zero
+	for _, lineReq := range req.Lines {
+		line, err := uc.validateLine(ctx, tenantID, lineReq)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+		absValue += line.UnitCost.Mul(math.Abs(line.Quantity))
+	}
+
+	tenant, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	threshold := tenant.AdjustmentApprovalThreshold
+	needsApproval := threshold > 0 && absValue > threshold
+
+	adjustment, err := uc.adjustmentRepo.Create(ctx, tenantID, userID, reason.ID, req.Notes, "manual", lines)
+	if err != nil {
+		return nil, fmt.Errorf("error al registrar el ajuste: %w", err)
+	}
+
+	if !needsApproval || canApprove {
+		reviewedBy := 0
+		if needsApproval {
+			reviewedBy = userID
+		}
+		if err := uc.adjustmentRepo.Apply(ctx, adjustment.ID, reviewedBy); err != nil {
+			return nil, err
+		}
+	}
+
+	adjustment, err = uc.adjustmentRepo.FindByID(ctx, adjustment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos, err := convertAdjustmentsToDTO(ctx, uc.adjustmentRepo, uc.productRepo, tenantID, []*ent.StockAdjustment{adjustment})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos[0], nil
+}
+
+func (uc *CreateAdjustmentUseCase) validateLine(ctx context.Context, tenantID int, req AdjustmentLineRequest) (repositories.AdjustmentLine, error) {
+	req.Quantity = qty.Round(req.Quantity)
+	if req.Quantity == 0 {
+		return repositories.AdjustmentLine{}, fmt.Errorf("la cantidad del ajuste del producto %d no puede ser cero", req.ProductID)
+	}
+
+	product, err := uc.productRepo.FindByID(ctx, req.ProductID)
+	if err != nil || product.TenantID != tenantID {
+		return repositories.AdjustmentLine{}, fmt.Errorf("producto con ID %d no encontrado", req.ProductID)
+	}
+	if product.Archived {
+		return repositories.AdjustmentLine{}, fmt.Errorf("el producto %s fue eliminado y no se puede ajustar", product.Name)
+	}
+
+	if product.Serialized {
+		return repositories.AdjustmentLine{}, fmt.Errorf("el stock de %s se controla por número de serie y no se puede ajustar por cantidad", product.Name)
+	}
+
+	if !product.Fractional && !qty.IsWhole(req.Quantity) {
+		return repositories.AdjustmentLine{}, fmt.Errorf("el producto %s no admite cantidades fraccionarias de %s", product.Name, product.BaseUnit)
+	}
+
+	if qty.Round(product.Stock+req.Quantity) < 0 {
+		return repositories.AdjustmentLine{}, fmt.Errorf("stock insuficiente de %s: disponible %s, ajuste %s", product.Name, qty.Format(product.Stock), qty.Format(req.Quantity))
+	}
+
+	if product.TrackLots {
+		if req.LotID == 0 {
+			return repositories.AdjustmentLine{}, fmt.Errorf("el producto %s controla lotes: indica el lote a ajustar", product.Name)
+		}
+		lot, err := uc.lotRepo.FindByID(ctx, req.LotID)
+		if err != nil || lot.ProductID != product.ID {
+			return repositories.AdjustmentLine{}, fmt.Errorf("lote %d no encontrado para el producto %s", req.LotID, product.Name)
+		}
+		if qty.Round(lot.Remaining+req.Quantity) < 0 {
+			return repositories.AdjustmentLine{}, fmt.Errorf("stock insuficiente en lote %s: disponible %s, ajuste %s", lot.LotNumber, qty.Format(lot.Remaining), qty.Format(req.Quantity))
+		}
+	} else if req.LotID != 0 {
+		return repositories.AdjustmentLine{}, fmt.Errorf("el producto %s no controla lotes", product.Name)
+	}
+
+	return repositories.AdjustmentLine{
+		ProductID: product.ID,
+		LotID:     req.LotID,
+		Quantity:  req.Quantity,
+		UnitCost:  product.PurchasePrice,
+	}, nil
+}
+
+// convertAdjustmentsToDTO arma los ajustes con su motivo y sus líneas
+func convertAdjustmentsToDTO(ctx context.Context, adjustmentRepo repositories.StockAdjustmentRepository, productRepo repositories.ProductRepository, tenantID int, adjustments []*ent.StockAdjustment) ([]AdjustmentDTO, error) {
+	dtos := make([]AdjustmentDTO, len(adjustments))
+	if len(adjustments) == 0 {
+		return dtos, nil
+	}
+
+	reasons, err := adjustmentRepo.FindReasons(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	reasonsByID := make(map[int]*ent.AdjustmentReason, len(reasons))
+	for _, reason := range reasons {
+		reasonsByID[reason.ID] = reason
+	}
+
+	ids := make([]int, len(adjustments))
+	indexByID := make(map[int]int, len(adjustments))
+	for i, adjustment := range adjustments {
+		ids[i] = adjustment.ID
+		indexByID[adjustment.ID] = i
+
+		dtos[i] = AdjustmentDTO{
+			ID:         adjustment.ID,
+			Notes:      adjustment.Notes,
+			Status:     adjustment.Status,
+			Source:     adjustment.Source,
+			TotalValue: adjustment.TotalValue.Round(money.Default),
+			UserID:     adjustment.UserID,
+			ReviewedBy: adjustment.ReviewedBy,
+			CreatedAt:  adjustment.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			Lines:      []AdjustmentLineDTO{},
+		}
+		if reason, ok := reasonsByID[adjustment.ReasonID]; ok {
+			dtos[i].ReasonCode = reason.Code
+			dtos[i].ReasonName = reason.Name
+			dtos[i].WriteOff = reason.WriteOff
+		}
+		if adjustment.ReviewedAt != nil {
+			dtos[i].ReviewedAt = adjustment.ReviewedAt.Format("2006-01-02T15:04:05Z07:00")
+		}
+		if adjustment.AppliedAt != nil {
+			dtos[i].AppliedAt = adjustment.AppliedAt.Format("2006-01-02T15:04:05Z07:00")
+		}
+	}
+
+	lines, err := adjustmentRepo.FindLines(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	productIDs := make([]int, len(lines))
+	for i, line := range lines {
+		productIDs[i] = line.ProductID
+	}
+	products, err := productRepo.FindByIDsOrCategories(ctx, tenantID, productIDs, nil)
+	if err != nil {
+		return nil, err
+	}
+	productsByID := make(map[int]*ent.Product, len(products))
+	for _, p := range products {
+		productsByID[p.ID] = p
+	}
+
+	for _, line := range lines {
+		lineDTO := AdjustmentLineDTO{
+			ProductID: line.ProductID,
+			LotID:     line.LotID,
+			Quantity:  line.Quantity,
+			UnitCost:  line.UnitCost,
+			TotalCost: line.TotalCost.Round(money.Default),
+		}
+		if p, ok := productsByID[line.ProductID]; ok {
+			lineDTO.ProductName = p.Name
+			lineDTO.SKU = p.Sku
+		}
+
+		i := indexByID[line.AdjustmentID]
+		dtos[i].Lines = append(dtos[i].Lines, lineDTO)
+	}
+
+	return dtos, nil
+}
@@ -0,0 +1,55 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type CreateAdjustmentReasonUseCase struct {
+	adjustmentRepo repositories.StockAdjustmentRepository
+}
+
+func NewCreateAdjustmentReasonUseCase(adjustmentRepo repositories.StockAdjustmentRepository) *CreateAdjustmentReasonUseCase {
+	return &CreateAdjustmentReasonUseCase{
+		adjustmentRepo: adjustmentRepo,
+	}
+}
+
+type CreateAdjustmentReasonRequest struct {
+	Code     string `json:"code" binding:"required"`
+	Name     string `json:"name" binding:"required"`
+	WriteOff *bool  `json:"writeOff,omitempty"`
+}
+
+func (uc *CreateAdjustmentReasonUseCase) Execute(ctx context.Context, tenantID int, req CreateAdjustmentReasonRequest) (*AdjustmentReasonDTO, error) {
+	code := strings.ToLower(strings.TrimSpace(req.Code))
+	name := strings.TrimSpace(req.Name)
+	if code == "" || name == "" {
+		return nil, fmt.Errorf("el código y el nombre del motivo son requeridos")
+	}
+
+	if _, err := ensureDefaultReasons(ctx, uc.adjustmentRepo, tenantID); err != nil {
+		return nil, err
+	}
+
+	writeOff := true
+	if req.WriteOff != nil {
+		writeOff = *req.WriteOff
+	}
+
+	reason, err := uc.adjustmentRepo.CreateReason(ctx, tenantID, code, name, writeOff)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, pkg_errors.ErrAlreadyExists
+		}
+		return nil, err
+	}
+
+	dto := convertReasonToDTO(reason)
+	return &dto, nil
+}
@@ -2,43 +2,121 @@ package stock
 
 import (
 	"context"
+	"fmt"
 
 	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
 )
 
 type CreateProductUseCase struct {
 	productRepo repositories.ProductRepository
+	priceRepo   repositories.ProductPriceRepository
+	barcodeRepo repositories.ProductBarcodeRepository
+	taxRateRepo repositories.TaxRateRepository
 }
 
-func NewCreateProductUseCase(productRepo repositories.ProductRepository) *CreateProductUseCase {
+func NewCreateProductUseCase(productRepo repositories.ProductRepository, priceRepo repositories.ProductPriceRepository, barcodeRepo repositories.ProductBarcodeRepository, taxRateRepo repositories.TaxRateRepository) *CreateProductUseCase {
 	return &CreateProductUseCase{
 		productRepo: productRepo,
+		priceRepo:   priceRepo,
+		barcodeRepo: barcodeRepo,
+		taxRateRepo: taxRateRepo,
 	}
 }
 
 type CreateProductRequest struct {
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price"`
-	Stock       int     `json:"stock"`
-	SKU         string  `json:"sku"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	RetailPrice money.Amount `json:"retailPrice"`
+	// PurchasePrice es el costo del stock inicial; luego lo mantiene el método de costeo
+	PurchasePrice        money.Amount  `json:"purchasePrice"`
+	WholesalePrice       *money.Amount `json:"wholesalePrice,omitempty"`
+	MinWholesaleQuantity *int          `json:"minWholesaleQuantity,omitempty"`
+	Stock                float64       `json:"stock"`
+	SKU                  string        `json:"sku"`
+	TrackLots            *bool         `json:"trackLots,omitempty"`
+	Serialized           *bool         `json:"serialized,omitempty"`
+	WarrantyMonths       *int          `json:"warrantyMonths,omitempty"`
+	Category             *string       `json:"category,omitempty"`
+	// TaxRateID es la tarifa de impuesto del producto; 0 la quita y se usa la del tenant
+	TaxRateID *int `json:"taxRateId,omitempty"`
+	// BaseUnit es la unidad en la que se lleva el stock (por defecto "unidad"); Fractional
+	// permite vender y comprar fracciones de ella, como en los productos pesados
+	BaseUnit   *string `json:"baseUnit,omitempty"`
+	Fractional *bool   `json:"fractional,omitempty"`
+	// Barcodes son los códigos del fabricante; si no viene ninguno se asigna uno interno
+	Barcodes []string `json:"barcodes,omitempty"`
 }
 
-func (uc *CreateProductUseCase) Execute(ctx context.Context, tenantID int, req CreateProductRequest) (*ProductDTO, error) {
-	product, err := uc.productRepo.Create(ctx, tenantID, req.Name, req.Description, req.SKU, req.Price, req.Stock)
+func (uc *CreateProductUseCase) Execute(ctx context.Context, tenantID, userID int, req CreateProductRequest) (*ProductDTO, error) {
+	if req.Serialized != nil && *req.Serialized && req.Stock > 0 {
+		return nil, fmt.Errorf("el stock de un producto serializado se registra con compras que incluyan los números de serie")
+	}
+	if (req.Fractional == nil || !*req.Fractional) && !qty.IsWhole(req.Stock) {
+		return nil, fmt.Errorf("el stock inicial solo puede ser fraccionario en productos que admiten fracciones")
+	}
+	if req.RetailPrice < 0 || req.PurchasePrice < 0 {
+		return nil, fmt.Errorf("los precios no pueden ser negativos")
+	}
+
+	codes := make([]string, len(req.Barcodes))
+	symbologies := make([]string, len(req.Barcodes))
+	for i, raw := range req.Barcodes {
+		code, symbology, err := normalizeBarcode(raw, "")
+		if err != nil {
+			return nil, fmt.Errorf("código %s: %w", raw, err)
+		}
+		codes[i], symbologies[i] = code, symbology
+	}
+	if len(codes) > 0 {
+		if _, err := uc.barcodeRepo.FindByCodes(ctx, tenantID, codes); err == nil {
+			return nil, pkg_errors.ErrAlreadyExists
+		}
+	}
+
+	product, err := uc.productRepo.Create(ctx, tenantID, req.Name, req.Description, req.SKU, req.RetailPrice, req.PurchasePrice, qty.Round(req.Stock))
 	if err != nil {
 		return nil, err
 	}
 
-	return &ProductDTO{
-		ID:          product.ID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		Stock:       product.Stock,
-		SKU:         product.Sku,
-		CreatedAt:   product.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   product.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}, nil
-}
+	if _, err := applyWholesale(ctx, uc.productRepo, product, req.WholesalePrice, req.MinWholesaleQuantity); err != nil {
+		return nil, err
+	}
 
+	// Los precios iniciales abren el historial de precios del producto
+	if err := recordPriceChange(ctx, uc.priceRepo, tenantID, userID, product.ID, product.RetailPrice, product.WholesalePrice); err != nil {
+		return nil, err
+	}
+
+	if err := applyTracking(ctx, uc.productRepo, product, req.TrackLots, req.Serialized, req.WarrantyMonths); err != nil {
+		return nil, err
+	}
+
+	if err := applyCategory(ctx, uc.productRepo, product, req.Category); err != nil {
+		return nil, err
+	}
+
+	if err := applyTaxRate(ctx, uc.productRepo, uc.taxRateRepo, tenantID, product, req.TaxRateID); err != nil {
+		return nil, err
+	}
+
+	if err := applyBaseUnit(ctx, uc.productRepo, product, req.BaseUnit, req.Fractional); err != nil {
+		return nil, err
+	}
+
+	for i, code := range codes {
+		if _, err := addBarcode(ctx, uc.barcodeRepo, tenantID, product.ID, 0, code, symbologies[i], false); err != nil {
+			return nil, err
+		}
+	}
+	if len(codes) == 0 {
+		if _, err := assignInternalBarcode(ctx, uc.barcodeRepo, tenantID, product.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	dto := convertProductToDTO(product)
+	return &dto, nil
+}
@@ -0,0 +1,40 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type CreateProductUnitUseCase struct {
+	unitRepo    repositories.ProductUnitRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewCreateProductUnitUseCase(unitRepo repositories.ProductUnitRepository, productRepo repositories.ProductRepository) *CreateProductUnitUseCase {
+	return &CreateProductUnitUseCase{
+		unitRepo:    unitRepo,
+		productRepo: productRepo,
+	}
+}
+
+func (uc *CreateProductUnitUseCase) Execute(ctx context.Context, tenantID, productID int, req UnitRequest) (*UnitDTO, error) {
+	product, err := uc.productRepo.FindByID(ctx, productID)
+	if err != nil || product.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	name, factor, err := validateUnit(product, req)
+	if err != nil {
+		return nil, err
+	}
+
+	unit, err := uc.unitRepo.Create(ctx, tenantID, productID, name, factor, req.RetailPrice)
+	if err != nil {
+		return nil, saveUnitError(err)
+	}
+
+	dto := convertUnitToDTO(unit, product)
+	return &dto, nil
+}
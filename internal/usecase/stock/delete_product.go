@@ -2,9 +2,11 @@ package stock
 
 import (
 	"context"
+	"fmt"
 
 	"Veritasbackend/internal/domain/repositories"
 	pkg_errors "Veritasbackend/pkg/errors"
+	qty "Veritasbackend/pkg/quantity"
 )
 
 type DeleteProductUseCase struct {
@@ -17,11 +19,18 @@ func NewDeleteProductUseCase(productRepo repositories.ProductRepository) *Delete
 	}
 }
 
-func (uc *DeleteProductUseCase) Execute(ctx context.Context, id int) error {
-	err := uc.productRepo.Delete(ctx, id)
-	if err != nil {
+// Execute elimina el producto de forma lógica: deja de aparecer en el listado y en la búsqueda,
+// pero las ventas, compras y movimientos lo siguen mostrando. Con stock no se permite, para que
+// el inventario valorizado no tenga existencias de productos ocultos.
+func (uc *DeleteProductUseCase) Execute(ctx context.Context, tenantID, id int) error {
+	p, err := uc.productRepo.FindByID(ctx, id)
+	if err != nil || p.TenantID != tenantID || p.Archived {
 		return pkg_errors.ErrNotFound
 	}
-	return nil
-}
 
+	if p.Stock != 0 {
+		return fmt.Errorf("el producto todavía tiene stock (%s %s); ajústalo a cero antes de eliminarlo", qty.Format(p.Stock), p.BaseUnit)
+	}
+
+	return uc.productRepo.Archive(ctx, id)
+}
@@ -0,0 +1,27 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type DeleteProductBarcodeUseCase struct {
+	barcodeRepo repositories.ProductBarcodeRepository
+}
+
+func NewDeleteProductBarcodeUseCase(barcodeRepo repositories.ProductBarcodeRepository) *DeleteProductBarcodeUseCase {
+	return &DeleteProductBarcodeUseCase{
+		barcodeRepo: barcodeRepo,
+	}
+}
+
+func (uc *DeleteProductBarcodeUseCase) Execute(ctx context.Context, tenantID, productID, barcodeID int) error {
+	b, err := uc.barcodeRepo.FindByID(ctx, barcodeID)
+	if err != nil || b.TenantID != tenantID || b.ProductID != productID {
+		return pkg_errors.ErrNotFound
+	}
+
+	return uc.barcodeRepo.Delete(ctx, barcodeID)
+}
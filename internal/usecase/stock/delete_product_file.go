@@ -0,0 +1,46 @@
+package stock
+
+import (
+	"context"
+	"log"
+
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/infrastructure/storage"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type DeleteProductFileUseCase struct {
+	fileRepo repositories.ProductFileRepository
+	storage  storage.Storage
+}
+
+func NewDeleteProductFileUseCase(fileRepo repositories.ProductFileRepository, fileStorage storage.Storage) *DeleteProductFileUseCase {
+	return &DeleteProductFileUseCase{
+		fileRepo: fileRepo,
+		storage:  fileStorage,
+	}
+}
+
+// Execute elimina el registro y luego el archivo y su miniatura. Si el almacenamiento falla el
+// archivo queda huérfano pero ya no es accesible desde la API.
+func (uc *DeleteProductFileUseCase) Execute(ctx context.Context, tenantID, productID, fileID int) error {
+	file, err := uc.fileRepo.FindByID(ctx, fileID)
+	if err != nil || file.TenantID != tenantID || file.ProductID != productID {
+		return pkg_errors.ErrNotFound
+	}
+
+	if err := uc.fileRepo.Delete(ctx, fileID); err != nil {
+		return err
+	}
+
+	for _, key := range []string{file.StorageKey, file.ThumbnailKey} {
+		if key == "" {
+			continue
+		}
+		if err := uc.storage.Delete(ctx, key); err != nil {
+			log.Printf("Error deleting stored file %s: %v", key, err)
+		}
+	}
+
+	return nil
+}
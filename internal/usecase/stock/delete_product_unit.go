@@ -0,0 +1,27 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type DeleteProductUnitUseCase struct {
+	unitRepo    repositories.ProductUnitRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewDeleteProductUnitUseCase(unitRepo repositories.ProductUnitRepository, productRepo repositories.ProductRepository) *DeleteProductUnitUseCase {
+	return &DeleteProductUnitUseCase{
+		unitRepo:    unitRepo,
+		productRepo: productRepo,
+	}
+}
+
+func (uc *DeleteProductUnitUseCase) Execute(ctx context.Context, tenantID, productID, unitID int) error {
+	if _, _, err := findProductUnit(ctx, uc.productRepo, uc.unitRepo, tenantID, productID, unitID); err != nil {
+		return err
+	}
+
+	return uc.unitRepo.Delete(ctx, unitID)
+}
@@ -0,0 +1,38 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type GenerateInternalBarcodesUseCase struct {
+	barcodeRepo repositories.ProductBarcodeRepository
+}
+
+func NewGenerateInternalBarcodesUseCase(barcodeRepo repositories.ProductBarcodeRepository) *GenerateInternalBarcodesUseCase {
+	return &GenerateInternalBarcodesUseCase{
+		barcodeRepo: barcodeRepo,
+	}
+}
+
+type GenerateBarcodesResponse struct {
+	Generated int `json:"generated"`
+}
+
+// Execute asigna el código interno a los productos que no tienen ninguno, por ejemplo los
+// creados antes de que existieran los códigos de barras
+func (uc *GenerateInternalBarcodesUseCase) Execute(ctx context.Context, tenantID int) (*GenerateBarcodesResponse, error) {
+	productIDs, err := uc.barcodeRepo.FindProductsWithout(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, productID := range productIDs {
+		if _, err := assignInternalBarcode(ctx, uc.barcodeRepo, tenantID, productID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &GenerateBarcodesResponse{Generated: len(productIDs)}, nil
+}
@@ -0,0 +1,29 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type GetBundleUseCase struct {
+	bundleRepo  repositories.BundleRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewGetBundleUseCase(bundleRepo repositories.BundleRepository, productRepo repositories.ProductRepository) *GetBundleUseCase {
+	return &GetBundleUseCase{
+		bundleRepo:  bundleRepo,
+		productRepo: productRepo,
+	}
+}
+
+func (uc *GetBundleUseCase) Execute(ctx context.Context, tenantID, productID int) (*BundleDTO, error) {
+	product, err := uc.productRepo.FindByID(ctx, productID)
+	if err != nil || product.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	return loadBundle(ctx, uc.productRepo, uc.bundleRepo, product)
+}
@@ -0,0 +1,75 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+type GetInventoryValuationUseCase struct {
+	productRepo repositories.ProductRepository
+	tenantRepo  repositories.TenantRepository
+}
+
+func NewGetInventoryValuationUseCase(productRepo repositories.ProductRepository, tenantRepo repositories.TenantRepository) *GetInventoryValuationUseCase {
+	return &GetInventoryValuationUseCase{
+		productRepo: productRepo,
+		tenantRepo:  tenantRepo,
+	}
+}
+
+type ProductValuationDTO struct {
+	ProductID   int          `json:"productId"`
+	ProductName string       `json:"productName"`
+	SKU         string       `json:"sku"`
+	Stock       float64      `json:"stock"`
+	UnitCost    money.Amount `json:"unitCost"`
+	Value       money.Amount `json:"value"`
+}
+
+type InventoryValuationResponse struct {
+	CostingMethod string                `json:"costingMethod"`
+	TotalUnits    float64               `json:"totalUnits"`
+	TotalValue    money.Amount          `json:"totalValue"`
+	Products      []ProductValuationDTO `json:"products"`
+}
+
+// Execute valoriza el stock actual al costo unitario mantenido por el método de costeo del tenant
+func (uc *GetInventoryValuationUseCase) Execute(ctx context.Context, tenantID int) (*InventoryValuationResponse, error) {
+	tenant, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := uc.productRepo.FindAllByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InventoryValuationResponse{
+		CostingMethod: tenant.CostingMethod,
+		Products:      []ProductValuationDTO{},
+	}
+	for _, p := range products {
+		if p.Stock <= 0 {
+			continue
+		}
+
+		value := p.PurchasePrice.Mul(p.Stock)
+		response.Products = append(response.Products, ProductValuationDTO{
+			ProductID:   p.ID,
+			ProductName: p.Name,
+			SKU:         p.Sku,
+			Stock:       p.Stock,
+			UnitCost:    p.PurchasePrice,
+			Value:       value.Round(money.Default),
+		})
+		response.TotalUnits = qty.Round(response.TotalUnits + p.Stock)
+		response.TotalValue += value
+	}
+	response.TotalValue = response.TotalValue.Round(money.Default)
+
+	return response, nil
+}
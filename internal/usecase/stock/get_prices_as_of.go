@@ -0,0 +1,87 @@
+package stock
+
+import (
+	"context"
+	"time"
+
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
+)
+
+type GetPricesAsOfUseCase struct {
+	priceRepo   repositories.ProductPriceRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewGetPricesAsOfUseCase(priceRepo repositories.ProductPriceRepository, productRepo repositories.ProductRepository) *GetPricesAsOfUseCase {
+	return &GetPricesAsOfUseCase{
+		priceRepo:   priceRepo,
+		productRepo: productRepo,
+	}
+}
+
+type ProductPriceAsOfDTO struct {
+	ProductID      int          `json:"productId"`
+	ProductName    string       `json:"productName"`
+	SKU            string       `json:"sku"`
+	RetailPrice    money.Amount `json:"retailPrice"`
+	WholesalePrice money.Amount `json:"wholesalePrice"`
+	// PriceID es el cambio del historial que rige; 0 si el producto no tiene historial a esa fecha
+	PriceID int `json:"priceId,omitempty"`
+}
+
+type PricesAsOfResponse struct {
+	Date   string                `json:"date"`
+	Prices []ProductPriceAsOfDTO `json:"prices"`
+}
+
+// Execute devuelve la lista de precios que rige en la fecha indicada (por defecto, ahora),
+// incluidos los cambios programados. Los productos sin historial usan su precio actual.
+func (uc *GetPricesAsOfUseCase) Execute(ctx context.Context, tenantID int, date string) (*PricesAsOfResponse, error) {
+	at := time.Now()
+	if date != "" {
+		parsed, err := parseEffectiveDate(date)
+		if err != nil {
+			return nil, err
+		}
+		// Una fecha sin hora incluye los cambios que rigen durante ese día
+		if len(date) == len("2006-01-02") {
+			parsed = parsed.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+		at = parsed
+	}
+
+	effective, err := uc.priceRepo.FindEffectiveByTenant(ctx, tenantID, at)
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := uc.productRepo.FindAllByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make([]ProductPriceAsOfDTO, len(products))
+	for i, p := range products {
+		dto := ProductPriceAsOfDTO{
+			ProductID:      p.ID,
+			ProductName:    p.Name,
+			SKU:            p.Sku,
+			RetailPrice:    p.RetailPrice,
+			WholesalePrice: p.WholesalePrice,
+		}
+		if price, ok := effective[p.ID]; ok {
+			dto.PriceID = price.ID
+			dto.RetailPrice = price.RetailPrice
+			if price.WholesalePrice != nil {
+				dto.WholesalePrice = *price.WholesalePrice
+			}
+		}
+		prices[i] = dto
+	}
+
+	return &PricesAsOfResponse{
+		Date:   at.Format("2006-01-02T15:04:05Z07:00"),
+		Prices: prices,
+	}, nil
+}
@@ -0,0 +1,171 @@
+package stock
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+type GetShrinkageReportUseCase struct {
+	adjustmentRepo repositories.StockAdjustmentRepository
+	productRepo    repositories.ProductRepository
+}
+
+func NewGetShrinkageReportUseCase(adjustmentRepo repositories.StockAdjustmentRepository, productRepo repositories.ProductRepository) *GetShrinkageReportUseCase {
+	return &GetShrinkageReportUseCase{
+		adjustmentRepo: adjustmentRepo,
+		productRepo:    productRepo,
+	}
+}
+
+type ShrinkageReportRequest struct {
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+}
+
+type ShrinkageByReasonDTO struct {
+	ReasonCode string       `json:"reasonCode"`
+	ReasonName string       `json:"reasonName"`
+	Units      float64      `json:"units"`
+	Value      money.Amount `json:"value"`
+}
+
+type ShrinkageByProductDTO struct {
+	ProductID   int          `json:"productId"`
+	ProductName string       `json:"productName"`
+	SKU         string       `json:"sku"`
+	Units       float64      `json:"units"`
+	Value       money.Amount `json:"value"`
+}
+
+type ShrinkageReportResponse struct {
+	StartDate  string                  `json:"startDate"`
+	EndDate    string                  `json:"endDate"`
+	TotalUnits float64                 `json:"totalUnits"`
+	TotalValue money.Amount            `json:"totalValue"`
+	ByReason   []ShrinkageByReasonDTO  `json:"byReason"`
+	ByProduct  []ShrinkageByProductDTO `json:"byProduct"`
+}
+
+// Execute resume las bajas aplicadas en el período, valorizadas al costo registrado en
+// cada ajuste. Solo cuentan las salidas con motivos marcados como baja.
+func (uc *GetShrinkageReportUseCase) Execute(ctx context.Context, tenantID int, req ShrinkageReportRequest) (*ShrinkageReportResponse, error) {
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		startDate = startOfDay(time.Now().AddDate(0, -1, 0))
+	}
+
+	endDate, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		endDate = startOfDay(time.Now())
+	}
+
+	adjustments, err := uc.adjustmentRepo.FindApplied(ctx, tenantID, startDate, endDate.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ShrinkageReportResponse{
+		StartDate: startDate.Format("2006-01-02"),
+		EndDate:   endDate.Format("2006-01-02"),
+		ByReason:  []ShrinkageByReasonDTO{},
+		ByProduct: []ShrinkageByProductDTO{},
+	}
+	if len(adjustments) == 0 {
+		return response, nil
+	}
+
+	reasons, err := uc.adjustmentRepo.FindReasons(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	reasonsByID := make(map[int]*ent.AdjustmentReason, len(reasons))
+	for _, reason := range reasons {
+		reasonsByID[reason.ID] = reason
+	}
+
+	writeOffs := make(map[int]*ent.AdjustmentReason)
+	ids := []int{}
+	for _, adjustment := range adjustments {
+		reason, ok := reasonsByID[adjustment.ReasonID]
+		if !ok || !reason.WriteOff {
+			continue
+		}
+		writeOffs[adjustment.ID] = reason
+		ids = append(ids, adjustment.ID)
+	}
+	if len(ids) == 0 {
+		return response, nil
+	}
+
+	lines, err := uc.adjustmentRepo.FindLines(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byReason := make(map[string]*ShrinkageByReasonDTO)
+	byProduct := make(map[int]*ShrinkageByProductDTO)
+	for _, line := range lines {
+		if line.Quantity >= 0 {
+			continue
+		}
+		units := -line.Quantity
+		value := -line.TotalCost
+
+		reason := writeOffs[line.AdjustmentID]
+		reasonTotal := byReason[reason.Code]
+		if reasonTotal == nil {
+			reasonTotal = &ShrinkageByReasonDTO{ReasonCode: reason.Code, ReasonName: reason.Name}
+			byReason[reason.Code] = reasonTotal
+		}
+		reasonTotal.Units = qty.Round(reasonTotal.Units + units)
+		reasonTotal.Value += value
+
+		productTotal := byProduct[line.ProductID]
+		if productTotal == nil {
+			productTotal = &ShrinkageByProductDTO{ProductID: line.ProductID}
+			byProduct[line.ProductID] = productTotal
+		}
+		productTotal.Units = qty.Round(productTotal.Units + units)
+		productTotal.Value += value
+
+		response.TotalUnits = qty.Round(response.TotalUnits + units)
+		response.TotalValue += value
+	}
+
+	productIDs := make([]int, 0, len(byProduct))
+	for id := range byProduct {
+		productIDs = append(productIDs, id)
+	}
+	products, err := uc.productRepo.FindByIDsOrCategories(ctx, tenantID, productIDs, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range products {
+		byProduct[p.ID].ProductName = p.Name
+		byProduct[p.ID].SKU = p.Sku
+	}
+
+	for _, reasonTotal := range byReason {
+		reasonTotal.Value = reasonTotal.Value.Round(money.Default)
+		response.ByReason = append(response.ByReason, *reasonTotal)
+	}
+	for _, productTotal := range byProduct {
+		productTotal.Value = productTotal.Value.Round(money.Default)
+		response.ByProduct = append(response.ByProduct, *productTotal)
+	}
+	sort.Slice(response.ByReason, func(i, j int) bool {
+		return response.ByReason[i].Value > response.ByReason[j].Value
+	})
+	sort.Slice(response.ByProduct, func(i, j int) bool {
+		return response.ByProduct[i].Value > response.ByProduct[j].Value
+	})
+	response.TotalValue = response.TotalValue.Round(money.Default)
+
+	return response, nil
+}
@@ -0,0 +1,84 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+)
+
+// defaultAdjustmentReasons son los motivos con los que arranca cada tenant
+var defaultAdjustmentReasons = []struct {
+	Code     string
+	Name     string
+	WriteOff bool
+}{
+	{"damaged", "Producto dañado", true},
+	{"theft", "Robo o pérdida", true},
+	{"expired", "Producto vencido", true},
+	{"internal_use", "Consumo interno", true},
+	{"count_correction", "Corrección de conteo", false},
+	{"manual_correction", "Corrección manual", false},
+}
+
+type ListAdjustmentReasonsUseCase struct {
+	adjustmentRepo repositories.StockAdjustmentRepository
+}
+
+func NewListAdjustmentReasonsUseCase(adjustmentRepo repositories.StockAdjustmentRepository) *ListAdjustmentReasonsUseCase {
+	return &ListAdjustmentReasonsUseCase{
+		adjustmentRepo: adjustmentRepo,
+	}
+}
+
+type AdjustmentReasonDTO struct {
+	ID       int    `json:"id"`
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+	WriteOff bool   `json:"writeOff"`
+	Active   bool   `json:"active"`
+}
+
+type ListAdjustmentReasonsResponse struct {
+	Reasons []AdjustmentReasonDTO `json:"reasons"`
+}
+
+func (uc *ListAdjustmentReasonsUseCase) Execute(ctx context.Context, tenantID int) (*ListAdjustmentReasonsResponse, error) {
+	reasons, err := ensureDefaultReasons(ctx, uc.adjustmentRepo, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	reasonDTOs := make([]AdjustmentReasonDTO, len(reasons))
+	for i, reason := range reasons {
+		reasonDTOs[i] = convertReasonToDTO(reason)
+	}
+
+	return &ListAdjustmentReasonsResponse{Reasons: reasonDTOs}, nil
+}
+
+// ensureDefaultReasons crea los motivos por defecto la primera vez que un tenant los consulta
+func ensureDefaultReasons(ctx context.Context, adjustmentRepo repositories.StockAdjustmentRepository, tenantID int) ([]*ent.AdjustmentReason, error) {
+	reasons, err := adjustmentRepo.FindReasons(ctx, tenantID)
+	if err != nil || len(reasons) > 0 {
+		return reasons, err
+	}
+
+	for _, def := range defaultAdjustmentReasons {
+		if _, err := adjustmentRepo.CreateReason(ctx, tenantID, def.Code, def.Name, def.WriteOff); err != nil && !ent.IsConstraintError(err) {
+			return nil, err
+		}
+	}
+
+	return adjustmentRepo.FindReasons(ctx, tenantID)
+}
+
+func convertReasonToDTO(reason *ent.AdjustmentReason) AdjustmentReasonDTO {
+	return AdjustmentReasonDTO{
+		ID:       reason.ID,
+		Code:     reason.Code,
+		Name:     reason.Name,
+		WriteOff: reason.WriteOff,
+		Active:   reason.Active,
+	}
+}
@@ -0,0 +1,60 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type ListAdjustmentsUseCase struct {
+	adjustmentRepo repositories.StockAdjustmentRepository
+	productRepo    repositories.ProductRepository
+}
+
+func NewListAdjustmentsUseCase(adjustmentRepo repositories.StockAdjustmentRepository, productRepo repositories.ProductRepository) *ListAdjustmentsUseCase {
+	return &ListAdjustmentsUseCase{
+		adjustmentRepo: adjustmentRepo,
+		productRepo:    productRepo,
+	}
+}
+
+type ListAdjustmentsRequest struct {
+	Status string `json:"status"`
+	Page   int    `json:"page"`
+	Limit  int    `json:"limit"`
+}
+
+type ListAdjustmentsResponse struct {
+	Adjustments []AdjustmentDTO `json:"adjustments"`
+	Total       int             `json:"total"`
+	Page        int             `json:"page"`
+	Limit       int             `json:"limit"`
+}
+
+func (uc *ListAdjustmentsUseCase) Execute(ctx context.Context, tenantID int, req ListAdjustmentsRequest) (*ListAdjustmentsResponse, error) {
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	offset := (req.Page - 1) * req.Limit
+
+	adjustments, total, err := uc.adjustmentRepo.FindAll(ctx, tenantID, req.Status, req.Limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	adjustmentDTOs, err := convertAdjustmentsToDTO(ctx, uc.adjustmentRepo, uc.productRepo, tenantID, adjustments)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListAdjustmentsResponse{
+		Adjustments: adjustmentDTOs,
+		Total:       total,
+		Page:        req.Page,
+		Limit:       req.Limit,
+	}, nil
+}
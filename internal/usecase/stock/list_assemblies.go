@@ -0,0 +1,75 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type ListAssembliesUseCase struct {
+	bundleRepo  repositories.BundleRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewListAssembliesUseCase(bundleRepo repositories.BundleRepository, productRepo repositories.ProductRepository) *ListAssembliesUseCase {
+	return &ListAssembliesUseCase{
+		bundleRepo:  bundleRepo,
+		productRepo: productRepo,
+	}
+}
+
+type ListAssembliesRequest struct {
+	// BundleID filtra los armados de un combo; 0 lista todos
+	BundleID int `json:"bundleId"`
+	Page     int `json:"page"`
+	Limit    int `json:"limit"`
+}
+
+type ListAssembliesResponse struct {
+	Assemblies []AssemblyDTO `json:"assemblies"`
+	Total      int           `json:"total"`
+	Page       int           `json:"page"`
+	Limit      int           `json:"limit"`
+}
+
+func (uc *ListAssembliesUseCase) Execute(ctx context.Context, tenantID int, req ListAssembliesRequest) (*ListAssembliesResponse, error) {
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	offset := (req.Page - 1) * req.Limit
+
+	assemblies, total, err := uc.bundleRepo.FindAssemblies(ctx, tenantID, req.BundleID, req.Limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(assemblies))
+	for i, assembly := range assemblies {
+		ids[i] = assembly.ID
+	}
+	lines, err := uc.bundleRepo.FindAssemblyLines(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := assemblyProductNames(ctx, uc.productRepo, tenantID, assemblies, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]AssemblyDTO, len(assemblies))
+	for i, assembly := range assemblies {
+		dtos[i] = convertAssemblyToDTO(assembly, lines[assembly.ID], names)
+	}
+
+	return &ListAssembliesResponse{
+		Assemblies: dtos,
+		Total:      total,
+		Page:       req.Page,
+		Limit:      req.Limit,
+	}, nil
+}
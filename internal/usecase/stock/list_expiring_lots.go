@@ -0,0 +1,61 @@
+package stock
+
+import (
+	"context"
+	"time"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+const defaultExpiringDays = 30
+
+type ListExpiringLotsUseCase struct {
+	productRepo repositories.ProductRepository
+	lotRepo     repositories.ProductLotRepository
+}
+
+func NewListExpiringLotsUseCase(productRepo repositories.ProductRepository, lotRepo repositories.ProductLotRepository) *ListExpiringLotsUseCase {
+	return &ListExpiringLotsUseCase{
+		productRepo: productRepo,
+		lotRepo:     lotRepo,
+	}
+}
+
+type ExpiringLotsResponse struct {
+	Days int      `json:"days"`
+	Lots []LotDTO `json:"lots"`
+}
+
+// Execute lista los lotes con existencias que vencen dentro de los próximos días,
+// incluidos los ya vencidos que aún no se han dado de baja
+func (uc *ListExpiringLotsUseCase) Execute(ctx context.Context, tenantID, days int) (*ExpiringLotsResponse, error) {
+	if days <= 0 {
+		days = defaultExpiringDays
+	}
+
+	now := time.Now()
+	lots, err := uc.lotRepo.FindExpiring(ctx, tenantID, startOfDay(now).AddDate(0, 0, days))
+	if err != nil {
+		return nil, err
+	}
+
+	productNames := make(map[int]string)
+	lotDTOs := make([]LotDTO, len(lots))
+	for i, lot := range lots {
+		name, ok := productNames[lot.ProductID]
+		if !ok {
+			if product, err := uc.productRepo.FindByID(ctx, lot.ProductID); err == nil {
+				name = product.Name
+			}
+			productNames[lot.ProductID] = name
+		}
+
+		lotDTOs[i] = convertLotToDTO(lot, now)
+		lotDTOs[i].ProductName = name
+	}
+
+	return &ExpiringLotsResponse{
+		Days: days,
+		Lots: lotDTOs,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type ListProductBarcodesUseCase struct {
+	barcodeRepo repositories.ProductBarcodeRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewListProductBarcodesUseCase(barcodeRepo repositories.ProductBarcodeRepository, productRepo repositories.ProductRepository) *ListProductBarcodesUseCase {
+	return &ListProductBarcodesUseCase{
+		barcodeRepo: barcodeRepo,
+		productRepo: productRepo,
+	}
+}
+
+func (uc *ListProductBarcodesUseCase) Execute(ctx context.Context, tenantID, productID int) ([]BarcodeDTO, error) {
+	product, err := uc.productRepo.FindByID(ctx, productID)
+	if err != nil || product.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	barcodes, err := uc.barcodeRepo.FindByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]BarcodeDTO, len(barcodes))
+	for i, b := range barcodes {
+		dtos[i] = convertBarcodeToDTO(b)
+	}
+
+	return dtos, nil
+}
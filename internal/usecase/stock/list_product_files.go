@@ -0,0 +1,103 @@
+package stock
+
+import (
+	"context"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/infrastructure/storage"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type ListProductFilesUseCase struct {
+	fileRepo    repositories.ProductFileRepository
+	productRepo repositories.ProductRepository
+	storage     storage.Storage
+	urlTTL      time.Duration
+}
+
+func NewListProductFilesUseCase(fileRepo repositories.ProductFileRepository, productRepo repositories.ProductRepository, fileStorage storage.Storage, urlTTL time.Duration) *ListProductFilesUseCase {
+	return &ListProductFilesUseCase{
+		fileRepo:    fileRepo,
+		productRepo: productRepo,
+		storage:     fileStorage,
+		urlTTL:      urlTTL,
+	}
+}
+
+type ProductFileDTO struct {
+	ID           int    `json:"id"`
+	ProductID    int    `json:"productId"`
+	Kind         string `json:"kind"`
+	FileName     string `json:"fileName"`
+	ContentType  string `json:"contentType"`
+	Size         int64  `json:"size"`
+	Width        int    `json:"width,omitempty"`
+	Height       int    `json:"height,omitempty"`
+	Position     int    `json:"position"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnailUrl,omitempty"`
+	// URLExpiresAt es el vencimiento de las URLs firmadas; después hay que volver a pedirlas
+	URLExpiresAt string `json:"urlExpiresAt"`
+	CreatedAt    string `json:"createdAt"`
+}
+
+type ListProductFilesResponse struct {
+	Files []ProductFileDTO `json:"files"`
+}
+
+// Execute devuelve los archivos del producto con URLs firmadas; kind filtra por image o attachment
+func (uc *ListProductFilesUseCase) Execute(ctx context.Context, tenantID, productID int, kind string) (*ListProductFilesResponse, error) {
+	product, err := uc.productRepo.FindByID(ctx, productID)
+	if err != nil || product.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	files, err := uc.fileRepo.FindByProduct(ctx, productID, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	fileDTOs := make([]ProductFileDTO, len(files))
+	for i, file := range files {
+		dto, err := convertFileToDTO(ctx, uc.storage, uc.urlTTL, file)
+		if err != nil {
+			return nil, err
+		}
+		fileDTOs[i] = *dto
+	}
+
+	return &ListProductFilesResponse{Files: fileDTOs}, nil
+}
+
+func convertFileToDTO(ctx context.Context, fileStorage storage.Storage, urlTTL time.Duration, file *ent.ProductFile) (*ProductFileDTO, error) {
+	url, err := fileStorage.SignedURL(ctx, file.StorageKey, urlTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &ProductFileDTO{
+		ID:           file.ID,
+		ProductID:    file.ProductID,
+		Kind:         file.Kind,
+		FileName:     file.FileName,
+		ContentType:  file.ContentType,
+		Size:         file.Size,
+		Width:        file.Width,
+		Height:       file.Height,
+		Position:     file.Position,
+		URL:          url,
+		URLExpiresAt: time.Now().Add(urlTTL).Format("2006-01-02T15:04:05Z07:00"),
+		CreatedAt:    file.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if file.ThumbnailKey != "" {
+		dto.ThumbnailURL, err = fileStorage.SignedURL(ctx, file.ThumbnailKey, urlTTL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return dto, nil
+}
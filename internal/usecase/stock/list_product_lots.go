@@ -0,0 +1,89 @@
+package stock
+
+import (
+	"context"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type ListProductLotsUseCase struct {
+	productRepo repositories.ProductRepository
+	lotRepo     repositories.ProductLotRepository
+}
+
+func NewListProductLotsUseCase(productRepo repositories.ProductRepository, lotRepo repositories.ProductLotRepository) *ListProductLotsUseCase {
+	return &ListProductLotsUseCase{
+		productRepo: productRepo,
+		lotRepo:     lotRepo,
+	}
+}
+
+type LotDTO struct {
+	ID                int     `json:"id"`
+	ProductID         int     `json:"productId"`
+	ProductName       string  `json:"productName,omitempty"`
+	LotNumber         string  `json:"lotNumber"`
+	ExpiryDate        *string `json:"expiryDate"`
+	Quantity          float64 `json:"quantity"`
+	Remaining         float64 `json:"remaining"`
+	PurchaseInvoiceID int     `json:"purchaseInvoiceId,omitempty"`
+	Expired           bool    `json:"expired"`
+	DaysToExpiry      *int    `json:"daysToExpiry"`
+	CreatedAt         string  `json:"createdAt"`
+}
+
+type ListLotsResponse struct {
+	Lots []LotDTO `json:"lots"`
+}
+
+func convertLotToDTO(lot *ent.ProductLot, now time.Time) LotDTO {
+	dto := LotDTO{
+		ID:                lot.ID,
+		ProductID:         lot.ProductID,
+		LotNumber:         lot.LotNumber,
+		Quantity:          lot.Quantity,
+		Remaining:         lot.Remaining,
+		PurchaseInvoiceID: lot.PurchaseInvoiceID,
+		CreatedAt:         lot.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if lot.ExpiryDate != nil {
+		expiry := lot.ExpiryDate.Format("2006-01-02")
+		days := int(lot.ExpiryDate.Sub(startOfDay(now)).Hours() / 24)
+		dto.ExpiryDate = &expiry
+		dto.DaysToExpiry = &days
+		dto.Expired = days < 0
+	}
+
+	return dto
+}
+
+// startOfDay trunca la fecha a la medianoche local; un lote vence al terminar su fecha de vencimiento
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// Execute lista todos los lotes de un producto, incluidos los agotados
+func (uc *ListProductLotsUseCase) Execute(ctx context.Context, tenantID, productID int) (*ListLotsResponse, error) {
+	product, err := uc.productRepo.FindByID(ctx, productID)
+	if err != nil || product.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	lots, err := uc.lotRepo.FindByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	lotDTOs := make([]LotDTO, len(lots))
+	for i, lot := range lots {
+		lotDTOs[i] = convertLotToDTO(lot, now)
+		lotDTOs[i].ProductName = product.Name
+	}
+
+	return &ListLotsResponse{Lots: lotDTOs}, nil
+}
@@ -0,0 +1,45 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type ListProductPricesUseCase struct {
+	priceRepo   repositories.ProductPriceRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewListProductPricesUseCase(priceRepo repositories.ProductPriceRepository, productRepo repositories.ProductRepository) *ListProductPricesUseCase {
+	return &ListProductPricesUseCase{
+		priceRepo:   priceRepo,
+		productRepo: productRepo,
+	}
+}
+
+type ListProductPricesResponse struct {
+	Prices []PriceDTO `json:"prices"`
+}
+
+// Execute devuelve el historial de precios del producto, incluidos los cambios programados,
+// del más reciente al más antiguo
+func (uc *ListProductPricesUseCase) Execute(ctx context.Context, tenantID, productID int) (*ListProductPricesResponse, error) {
+	product, err := uc.productRepo.FindByID(ctx, productID)
+	if err != nil || product.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	prices, err := uc.priceRepo.FindByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	priceDTOs := make([]PriceDTO, len(prices))
+	for i, price := range prices {
+		priceDTOs[i] = convertPriceToDTO(price)
+	}
+
+	return &ListProductPricesResponse{Prices: priceDTOs}, nil
+}
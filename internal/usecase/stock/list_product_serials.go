@@ -0,0 +1,47 @@
+package stock
+
+import (
+	"context"
+	"time"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type ListProductSerialsUseCase struct {
+	productRepo repositories.ProductRepository
+	serialRepo  repositories.ProductSerialRepository
+}
+
+func NewListProductSerialsUseCase(productRepo repositories.ProductRepository, serialRepo repositories.ProductSerialRepository) *ListProductSerialsUseCase {
+	return &ListProductSerialsUseCase{
+		productRepo: productRepo,
+		serialRepo:  serialRepo,
+	}
+}
+
+type ListProductSerialsResponse struct {
+	Serials []SerialUnitDTO `json:"serials"`
+}
+
+// Execute lista los números de serie de un producto, opcionalmente filtrados por estado,
+// para que el cajero elija las unidades a vender
+func (uc *ListProductSerialsUseCase) Execute(ctx context.Context, tenantID, productID int, status string) (*ListProductSerialsResponse, error) {
+	product, err := uc.productRepo.FindByID(ctx, productID)
+	if err != nil || product.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	serials, err := uc.serialRepo.FindByProduct(ctx, productID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	serialDTOs := make([]SerialUnitDTO, len(serials))
+	for i, serial := range serials {
+		serialDTOs[i] = convertSerialToDTO(serial, product, now)
+	}
+
+	return &ListProductSerialsResponse{Serials: serialDTOs}, nil
+}
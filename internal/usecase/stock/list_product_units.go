@@ -0,0 +1,39 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type ListProductUnitsUseCase struct {
+	unitRepo    repositories.ProductUnitRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewListProductUnitsUseCase(unitRepo repositories.ProductUnitRepository, productRepo repositories.ProductRepository) *ListProductUnitsUseCase {
+	return &ListProductUnitsUseCase{
+		unitRepo:    unitRepo,
+		productRepo: productRepo,
+	}
+}
+
+func (uc *ListProductUnitsUseCase) Execute(ctx context.Context, tenantID, productID int) ([]UnitDTO, error) {
+	product, err := uc.productRepo.FindByID(ctx, productID)
+	if err != nil || product.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	units, err := uc.unitRepo.FindByProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]UnitDTO, len(units))
+	for i, unit := range units {
+		dtos[i] = convertUnitToDTO(unit, product)
+	}
+
+	return dtos, nil
+}
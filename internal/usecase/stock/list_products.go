@@ -2,34 +2,68 @@ package stock
 
 import (
 	"context"
+	"math"
+	"time"
 
+	"Veritasbackend/ent"
 	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/infrastructure/storage"
+	"Veritasbackend/pkg/money"
 )
 
 type ListProductsUseCase struct {
 	productRepo repositories.ProductRepository
+	fileRepo    repositories.ProductFileRepository
+	storage     storage.Storage
+	urlTTL      time.Duration
 }
 
-func NewListProductsUseCase(productRepo repositories.ProductRepository) *ListProductsUseCase {
+func NewListProductsUseCase(productRepo repositories.ProductRepository, fileRepo repositories.ProductFileRepository, fileStorage storage.Storage, urlTTL time.Duration) *ListProductsUseCase {
 	return &ListProductsUseCase{
 		productRepo: productRepo,
+		fileRepo:    fileRepo,
+		storage:     fileStorage,
+		urlTTL:      urlTTL,
 	}
 }
 
 type ListProductsRequest struct {
 	Page  int `json:"page"`
 	Limit int `json:"limit"`
+	// Archived lista los productos eliminados en vez de los activos
+	Archived bool `json:"archived"`
 }
 
 type ProductDTO struct {
-	ID          int     `json:"id"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price"`
-	Stock       int     `json:"stock"`
-	SKU         string  `json:"sku"`
-	CreatedAt   string  `json:"createdAt"`
-	UpdatedAt   string  `json:"updatedAt"`
+	ID                   int          `json:"id"`
+	Name                 string       `json:"name"`
+	Description          string       `json:"description"`
+	PurchasePrice        money.Amount `json:"purchasePrice"`
+	RetailPrice          money.Amount `json:"retailPrice"`
+	WholesalePrice       money.Amount `json:"wholesalePrice"`
+	MinWholesaleQuantity int          `json:"minWholesaleQuantity"`
+	// Margin es la ganancia unitaria al detal; MarginPercent es sobre el precio y MarkupPercent sobre el costo
+	Margin         money.Amount `json:"margin"`
+	MarginPercent  float64      `json:"marginPercent"`
+	MarkupPercent  float64      `json:"markupPercent"`
+	Stock          float64      `json:"stock"`
+	BaseUnit       string       `json:"baseUnit"`
+	Fractional     bool         `json:"fractional"`
+	Bundle         bool         `json:"bundle"`
+	SKU            string       `json:"sku"`
+	TrackLots      bool         `json:"trackLots"`
+	Serialized     bool         `json:"serialized"`
+	WarrantyMonths int          `json:"warrantyMonths"`
+	Category       string       `json:"category"`
+	// TaxRateID es la tarifa de impuesto propia; vacía si usa la del tenant
+	TaxRateID *int `json:"taxRateId"`
+	Archived  bool `json:"archived"`
+	// DeletedAt es la fecha de eliminación; vacío si el producto está activo
+	DeletedAt string `json:"deletedAt,omitempty"`
+	// ThumbnailURL es la miniatura firmada de la imagen principal; solo viene en el listado
+	ThumbnailURL string `json:"thumbnailUrl,omitempty"`
+	CreatedAt    string `json:"createdAt"`
+	UpdatedAt    string `json:"updatedAt"`
 }
 
 type ListProductsResponse struct {
@@ -49,22 +83,29 @@ func (uc *ListProductsUseCase) Execute(ctx context.Context, tenantID int, req Li
 
 	offset := (req.Page - 1) * req.Limit
 
-	products, total, err := uc.productRepo.FindAll(ctx, tenantID, req.Limit, offset)
+	products, total, err := uc.productRepo.FindAll(ctx, tenantID, req.Archived, req.Limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	productIDs := make([]int, len(products))
+	for i, p := range products {
+		productIDs[i] = p.ID
+	}
+
+	images, err := uc.fileRepo.FindMainImages(ctx, productIDs)
 	if err != nil {
 		return nil, err
 	}
 
 	productDTOs := make([]ProductDTO, len(products))
 	for i, p := range products {
-		productDTOs[i] = ProductDTO{
-			ID:          p.ID,
-			Name:        p.Name,
-			Description: p.Description,
-			Price:       p.Price,
-			Stock:       p.Stock,
-			SKU:         p.Sku,
-			CreatedAt:   p.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt:   p.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		productDTOs[i] = convertProductToDTO(p)
+		if image, ok := images[p.ID]; ok {
+			productDTOs[i].ThumbnailURL, err = uc.storage.SignedURL(ctx, image.ThumbnailKey, uc.urlTTL)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -76,3 +117,45 @@ func (uc *ListProductsUseCase) Execute(ctx context.Context, tenantID int, req Li
 	}, nil
 }
 
+func convertProductToDTO(p *ent.Product) ProductDTO {
+	margin := p.RetailPrice - p.PurchasePrice
+	marginPercent := 0.0
+	if p.RetailPrice > 0 {
+		marginPercent = math.Round(margin.Ratio(p.RetailPrice)*10000) / 100
+	}
+	markupPercent := 0.0
+	if p.PurchasePrice > 0 {
+		markupPercent = math.Round(margin.Ratio(p.PurchasePrice)*10000) / 100
+	}
+
+	dto := ProductDTO{
+		ID:                   p.ID,
+		Name:                 p.Name,
+		Description:          p.Description,
+		PurchasePrice:        p.PurchasePrice,
+		RetailPrice:          p.RetailPrice,
+		WholesalePrice:       p.WholesalePrice,
+		MinWholesaleQuantity: p.MinWholesaleQuantity,
+		Margin:               margin.Round(money.Default),
+		MarginPercent:        marginPercent,
+		MarkupPercent:        markupPercent,
+		Stock:                p.Stock,
+		BaseUnit:             p.BaseUnit,
+		Fractional:           p.Fractional,
+		Bundle:               p.Bundle,
+		SKU:                  p.Sku,
+		TrackLots:            p.TrackLots,
+		Serialized:           p.Serialized,
+		WarrantyMonths:       p.WarrantyMonths,
+		Category:             p.Category,
+		TaxRateID:            p.TaxRateID,
+		Archived:             p.Archived,
+		CreatedAt:            p.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:            p.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if p.DeletedAt != nil {
+		dto.DeletedAt = p.DeletedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return dto
+}
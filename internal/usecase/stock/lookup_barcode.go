@@ -0,0 +1,66 @@
+package stock
+
+import (
+	"context"
+	"strings"
+
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/barcode"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type LookupBarcodeUseCase struct {
+	barcodeRepo repositories.ProductBarcodeRepository
+	productRepo repositories.ProductRepository
+	unitRepo    repositories.ProductUnitRepository
+}
+
+func NewLookupBarcodeUseCase(barcodeRepo repositories.ProductBarcodeRepository, productRepo repositories.ProductRepository, unitRepo repositories.ProductUnitRepository) *LookupBarcodeUseCase {
+	return &LookupBarcodeUseCase{
+		barcodeRepo: barcodeRepo,
+		productRepo: productRepo,
+		unitRepo:    unitRepo,
+	}
+}
+
+type BarcodeLookupResponse struct {
+	Barcode BarcodeDTO `json:"barcode"`
+	Product ProductDTO `json:"product"`
+	// Unit es el empaque escaneado; no viene cuando el código es de la unidad base
+	Unit *UnitDTO `json:"unit,omitempty"`
+}
+
+// Execute busca el producto de un código escaneado. Un UPC-A se encuentra tanto con 12 dígitos
+// como con el 0 inicial de su forma EAN-13, porque cada lector lo entrega distinto.
+func (uc *LookupBarcodeUseCase) Execute(ctx context.Context, tenantID int, code string) (*BarcodeLookupResponse, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	b, err := uc.barcodeRepo.FindByCodes(ctx, tenantID, barcode.Alternatives(code))
+	if err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	product, err := uc.productRepo.FindByID(ctx, b.ProductID)
+	if err != nil || product.TenantID != tenantID || product.Archived {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	response := &BarcodeLookupResponse{
+		Barcode: convertBarcodeToDTO(b),
+		Product: convertProductToDTO(product),
+	}
+
+	if b.UnitID != nil {
+		unit, err := uc.unitRepo.FindByID(ctx, *b.UnitID)
+		if err != nil {
+			return nil, err
+		}
+		dto := convertUnitToDTO(unit, product)
+		response.Unit = &dto
+	}
+
+	return response, nil
+}
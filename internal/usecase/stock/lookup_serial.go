@@ -0,0 +1,163 @@
+package stock
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type LookupSerialUseCase struct {
+	serialRepo   repositories.ProductSerialRepository
+	productRepo  repositories.ProductRepository
+	supplierRepo repositories.SupplierRepository
+	invoiceRepo  repositories.InvoiceRepository
+	customerRepo repositories.CustomerRepository
+}
+
+func NewLookupSerialUseCase(
+	serialRepo repositories.ProductSerialRepository,
+	productRepo repositories.ProductRepository,
+	supplierRepo repositories.SupplierRepository,
+	invoiceRepo repositories.InvoiceRepository,
+	customerRepo repositories.CustomerRepository,
+) *LookupSerialUseCase {
+	return &LookupSerialUseCase{
+		serialRepo:   serialRepo,
+		productRepo:  productRepo,
+		supplierRepo: supplierRepo,
+		invoiceRepo:  invoiceRepo,
+		customerRepo: customerRepo,
+	}
+}
+
+type SerialEventDTO struct {
+	EventType    string `json:"eventType"`
+	DocumentType string `json:"documentType"`
+	DocumentID   int    `json:"documentId"`
+	SupplierID   int    `json:"supplierId,omitempty"`
+	UserID       int    `json:"userId"`
+	CreatedAt    string `json:"createdAt"`
+}
+
+type SerialUnitDTO struct {
+	ID                int              `json:"id"`
+	SerialNumber      string           `json:"serialNumber"`
+	ProductID         int              `json:"productId"`
+	ProductName       string           `json:"productName"`
+	Status            string           `json:"status"`
+	SupplierID        int              `json:"supplierId,omitempty"`
+	SupplierName      string           `json:"supplierName,omitempty"`
+	PurchaseInvoiceID int              `json:"purchaseInvoiceId,omitempty"`
+	ReceivedAt        string           `json:"receivedAt"`
+	InvoiceID         int              `json:"invoiceId,omitempty"`
+	CustomerID        int              `json:"customerId,omitempty"`
+	CustomerName      string           `json:"customerName,omitempty"`
+	SoldAt            *string          `json:"soldAt,omitempty"`
+	WarrantyMonths    int              `json:"warrantyMonths"`
+	WarrantyUntil     *string          `json:"warrantyUntil,omitempty"`
+	UnderWarranty     bool             `json:"underWarranty"`
+	History           []SerialEventDTO `json:"history,omitempty"`
+}
+
+type SerialLookupResponse struct {
+	SerialNumber string          `json:"serialNumber"`
+	Ours         bool            `json:"ours"`
+	Units        []SerialUnitDTO `json:"units"`
+}
+
+// Execute responde si un número de serie pertenece al tenant, cuándo y a qué cliente se vendió
+// y si sigue en garantía. Un mismo número puede existir en productos distintos, por eso se devuelven
+// todas las unidades que coinciden.
+func (uc *LookupSerialUseCase) Execute(ctx context.Context, tenantID int, serialNumber string) (*SerialLookupResponse, error) {
+	serialNumber = strings.TrimSpace(serialNumber)
+
+	serials, err := uc.serialRepo.FindBySerialNumber(ctx, tenantID, serialNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	units := make([]SerialUnitDTO, 0, len(serials))
+	for _, serial := range serials {
+		product, err := uc.productRepo.FindByID(ctx, serial.ProductID)
+		if err != nil {
+			product = nil
+		}
+
+		unit := convertSerialToDTO(serial, product, now)
+
+		if serial.SupplierID != 0 {
+			if supplier, err := uc.supplierRepo.FindByID(ctx, serial.SupplierID); err == nil {
+				unit.SupplierName = supplier.Name
+			}
+		}
+
+		if serial.InvoiceID != 0 {
+			if inv, _, err := uc.invoiceRepo.FindByID(ctx, serial.InvoiceID); err == nil && inv.CustomerID != nil {
+				unit.CustomerID = *inv.CustomerID
+				if customer, err := uc.customerRepo.FindByID(ctx, *inv.CustomerID); err == nil {
+					unit.CustomerName = customer.Name
+				}
+			}
+		}
+
+		events, err := uc.serialRepo.FindEvents(ctx, serial.ID)
+		if err != nil {
+			return nil, err
+		}
+		unit.History = make([]SerialEventDTO, len(events))
+		for i, event := range events {
+			unit.History[i] = SerialEventDTO{
+				EventType:    event.EventType,
+				DocumentType: event.DocumentType,
+				DocumentID:   event.DocumentID,
+				SupplierID:   event.SupplierID,
+				UserID:       event.UserID,
+				CreatedAt:    event.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			}
+		}
+
+		units = append(units, unit)
+	}
+
+	return &SerialLookupResponse{
+		SerialNumber: serialNumber,
+		Ours:         len(units) > 0,
+		Units:        units,
+	}, nil
+}
+
+func convertSerialToDTO(serial *ent.ProductSerial, product *ent.Product, now time.Time) SerialUnitDTO {
+	dto := SerialUnitDTO{
+		ID:                serial.ID,
+		SerialNumber:      serial.SerialNumber,
+		ProductID:         serial.ProductID,
+		Status:            serial.Status,
+		SupplierID:        serial.SupplierID,
+		PurchaseInvoiceID: serial.PurchaseInvoiceID,
+		ReceivedAt:        serial.ReceivedAt.Format("2006-01-02T15:04:05Z07:00"),
+		InvoiceID:         serial.InvoiceID,
+	}
+
+	if product != nil {
+		dto.ProductName = product.Name
+		dto.WarrantyMonths = product.WarrantyMonths
+	}
+
+	if serial.SoldAt != nil {
+		soldAt := serial.SoldAt.Format("2006-01-02T15:04:05Z07:00")
+		dto.SoldAt = &soldAt
+
+		if dto.WarrantyMonths > 0 {
+			until := serial.SoldAt.AddDate(0, dto.WarrantyMonths, 0)
+			formatted := until.Format("2006-01-02")
+			dto.WarrantyUntil = &formatted
+			dto.UnderWarranty = now.Before(until)
+		}
+	}
+
+	return dto
+}
@@ -0,0 +1,25 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type PreviewBulkProductsUseCase struct {
+	productRepo repositories.ProductRepository
+	taxRateRepo repositories.TaxRateRepository
+}
+
+func NewPreviewBulkProductsUseCase(productRepo repositories.ProductRepository, taxRateRepo repositories.TaxRateRepository) *PreviewBulkProductsUseCase {
+	return &PreviewBulkProductsUseCase{
+		productRepo: productRepo,
+		taxRateRepo: taxRateRepo,
+	}
+}
+
+// Execute muestra los productos que cambiarían y sus valores antes y después, sin aplicar nada
+func (uc *PreviewBulkProductsUseCase) Execute(ctx context.Context, tenantID int, req BulkProductsRequest) (*BulkProductsResponse, error) {
+	response, _, err := planBulk(ctx, uc.productRepo, uc.taxRateRepo, tenantID, req)
+	return response, err
+}
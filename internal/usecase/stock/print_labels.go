@@ -0,0 +1,123 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/labels"
+	"Veritasbackend/pkg/money"
+)
+
+// MaxLabelsPerRequest limita el tamaño de un pedido de etiquetas
+const MaxLabelsPerRequest = 2400
+
+type PrintLabelsUseCase struct {
+	barcodeRepo repositories.ProductBarcodeRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewPrintLabelsUseCase(barcodeRepo repositories.ProductBarcodeRepository, productRepo repositories.ProductRepository) *PrintLabelsUseCase {
+	return &PrintLabelsUseCase{
+		barcodeRepo: barcodeRepo,
+		productRepo: productRepo,
+	}
+}
+
+type LabelItemRequest struct {
+	ProductID int `json:"productId" binding:"required"`
+	Copies    int `json:"copies"`
+	// Code elige cuál de los códigos del producto imprimir; por defecto el primero
+	Code string `json:"code"`
+}
+
+type PrintLabelsRequest struct {
+	Items []LabelItemRequest `json:"items" binding:"required,min=1,dive"`
+	// Format es pdf (hojas A4 de 3 x 8 etiquetas) o svg
+	Format string `json:"format"`
+}
+
+type LabelsFile struct {
+	ContentType string
+	FileName    string
+	Data        []byte
+}
+
+// Execute arma las etiquetas con nombre, precio al detal y código de barras. A los productos sin
+// código se les asigna el interno antes de imprimir, así la etiqueta se puede escanear.
+func (uc *PrintLabelsUseCase) Execute(ctx context.Context, tenantID int, req PrintLabelsRequest) (*LabelsFile, error) {
+	format := strings.ToLower(req.Format)
+	if format == "" {
+		format = labels.FormatPDF
+	}
+
+	var items []labels.Label
+	for _, item := range req.Items {
+		copies := item.Copies
+		if copies == 0 {
+			copies = 1
+		}
+		if copies < 0 {
+			return nil, fmt.Errorf("la cantidad de copias no puede ser negativa")
+		}
+		if len(items)+copies > MaxLabelsPerRequest {
+			return nil, fmt.Errorf("no se pueden imprimir más de %d etiquetas por pedido", MaxLabelsPerRequest)
+		}
+
+		product, err := uc.productRepo.FindByID(ctx, item.ProductID)
+		if err != nil || product.TenantID != tenantID {
+			return nil, pkg_errors.ErrNotFound
+		}
+
+		code, err := uc.labelBarcode(ctx, tenantID, product, item.Code)
+		if err != nil {
+			return nil, err
+		}
+
+		label := labels.Label{
+			Name:      product.Name,
+			Price:     product.RetailPrice.Round(money.Default).String(),
+			Code:      code.Code,
+			Symbology: code.Symbology,
+		}
+		for i := 0; i < copies; i++ {
+			items = append(items, label)
+		}
+	}
+
+	data, contentType, err := labels.Render(items, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LabelsFile{
+		ContentType: contentType,
+		FileName:    "labels." + format,
+		Data:        data,
+	}, nil
+}
+
+func (uc *PrintLabelsUseCase) labelBarcode(ctx context.Context, tenantID int, product *ent.Product, code string) (*ent.ProductBarcode, error) {
+	barcodes, err := uc.barcodeRepo.FindByProduct(ctx, product.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if code != "" {
+		for _, b := range barcodes {
+			if b.Code == code {
+				return b, nil
+			}
+		}
+		return nil, fmt.Errorf("el código %s no pertenece al producto %s", code, product.Name)
+	}
+
+	if len(barcodes) > 0 {
+		return barcodes[0], nil
+	}
+
+	return assignInternalBarcode(ctx, uc.barcodeRepo, tenantID, product.ID)
+}
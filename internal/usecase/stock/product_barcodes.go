@@ -0,0 +1,68 @@
+package stock
+
+import (
+	"context"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/barcode"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type BarcodeDTO struct {
+	ID        int `json:"id"`
+	ProductID int `json:"productId"`
+	// UnitID es la unidad de empaque que identifica el código; nil es la unidad base
+	UnitID    *int   `json:"unitId"`
+	Code      string `json:"code"`
+	Symbology string `json:"symbology"`
+	Internal  bool   `json:"internal"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// normalizeBarcode limpia el código y lo valida. Sin simbología se deduce por la forma del
+// código; "code128" permite guardar como Code128 un código numérico que no es EAN/UPC.
+func normalizeBarcode(code, symbology string) (string, string, error) {
+	code = strings.TrimSpace(code)
+	symbology = strings.ToLower(strings.TrimSpace(symbology))
+	if symbology == "" {
+		symbology = barcode.Detect(code)
+	}
+
+	if err := barcode.Validate(code, symbology); err != nil {
+		return "", "", err
+	}
+
+	return code, symbology, nil
+}
+
+// addBarcode guarda un código ya normalizado; un código repetido en el tenant es ErrAlreadyExists.
+// unitID es 0 cuando el código identifica la unidad base.
+func addBarcode(ctx context.Context, barcodeRepo repositories.ProductBarcodeRepository, tenantID, productID, unitID int, code, symbology string, internal bool) (*ent.ProductBarcode, error) {
+	b, err := barcodeRepo.Create(ctx, tenantID, productID, unitID, code, symbology, internal)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, pkg_errors.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return b, nil
+}
+
+// assignInternalBarcode le da al producto su código EAN-13 interno
+func assignInternalBarcode(ctx context.Context, barcodeRepo repositories.ProductBarcodeRepository, tenantID, productID int) (*ent.ProductBarcode, error) {
+	return addBarcode(ctx, barcodeRepo, tenantID, productID, 0, barcode.Internal(productID), barcode.EAN13, true)
+}
+
+func convertBarcodeToDTO(b *ent.ProductBarcode) BarcodeDTO {
+	return BarcodeDTO{
+		ID:        b.ID,
+		ProductID: b.ProductID,
+		UnitID:    b.UnitID,
+		Code:      b.Code,
+		Symbology: b.Symbology,
+		Internal:  b.Internal,
+		CreatedAt: b.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -0,0 +1,371 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
+)
+
+// Operaciones masivas sobre productos
+const (
+	BulkActionReprice     = "reprice"
+	BulkActionSetCategory = "set_category"
+	BulkActionSetTaxRate  = "set_tax_rate"
+	BulkActionArchive     = "archive"
+)
+
+// maxBulkProducts limita los productos de una operación para que la transacción no quede
+// abierta demasiado tiempo
+const maxBulkProducts = 5000
+
+// BulkFilter selecciona los productos activos de la operación. Los criterios se combinan; para
+// abarcar todo el catálogo hay que pedirlo con All.
+type BulkFilter struct {
+	ProductIDs []int  `json:"productIds,omitempty"`
+	Category   string `json:"category,omitempty"`
+	Search     string `json:"search,omitempty"`
+	All        bool   `json:"all,omitempty"`
+}
+
+// PriceRounding redondea el precio a múltiplos de Increment. Con Ending el precio termina en esa
+// fracción: con incremento 1 y terminación 0.99, 12.30 hacia arriba queda en 12.99.
+type PriceRounding struct {
+	// Mode es nearest (por defecto), up o down
+	Mode      string       `json:"mode"`
+	Increment money.Amount `json:"increment"`
+	Ending    money.Amount `json:"ending,omitempty"`
+}
+
+type BulkPriceChange struct {
+	// Target es retail (por defecto), wholesale o both
+	Target string `json:"target"`
+	// Mode es percent (Value en porcentaje, negativo para bajar), amount (suma Value) o set (fija Value)
+	Mode     string         `json:"mode"`
+	Value    float64        `json:"value"`
+	Rounding *PriceRounding `json:"rounding,omitempty"`
+}
+
+type BulkProductsRequest struct {
+	Filter BulkFilter `json:"filter"`
+	Action string     `json:"action"`
+	// Price es obligatorio en reprice
+	Price *BulkPriceChange `json:"price,omitempty"`
+	// Category es la categoría de set_category; vacía la quita
+	Category *string `json:"category,omitempty"`
+	// TaxRateID es la tarifa de set_tax_rate; vacía o 0 la quita y se usa la del tenant
+	TaxRateID *int `json:"taxRateId,omitempty"`
+}
+
+type BulkProductValues struct {
+	RetailPrice    money.Amount `json:"retailPrice"`
+	WholesalePrice money.Amount `json:"wholesalePrice"`
+	Category       string       `json:"category"`
+	TaxRateID      *int         `json:"taxRateId"`
+	Archived       bool         `json:"archived"`
+}
+
+type BulkProductChangeDTO struct {
+	ProductID int               `json:"productId"`
+	Name      string            `json:"name"`
+	SKU       string            `json:"sku"`
+	Before    BulkProductValues `json:"before"`
+	After     BulkProductValues `json:"after"`
+}
+
+type BulkSkippedDTO struct {
+	ProductID int    `json:"productId"`
+	Name      string `json:"name,omitempty"`
+	Reason    string `json:"reason"`
+}
+
+type BulkProductsResponse struct {
+	Action string `json:"action"`
+	// Matched son los productos que cumplen el filtro; Changes solo los que cambian
+	Matched int                    `json:"matched"`
+	Changes []BulkProductChangeDTO `json:"changes"`
+	Skipped []BulkSkippedDTO       `json:"skipped"`
+	Applied bool                   `json:"applied"`
+	Summary string                 `json:"summary"`
+}
+
+// planBulk calcula el resultado de la operación sin aplicarlo. Los productos que no se pueden
+// cambiar quedan en Skipped con el motivo; los que ya tienen el valor pedido no aparecen.
+func planBulk(ctx context.Context, productRepo repositories.ProductRepository, taxRateRepo repositories.TaxRateRepository, tenantID int, req BulkProductsRequest) (*BulkProductsResponse, []repositories.ProductChange, error) {
+	if err := validateBulkRequest(&req); err != nil {
+		return nil, nil, err
+	}
+
+	var taxRateName string
+	if req.Action == BulkActionSetTaxRate && req.TaxRateID != nil {
+		rate, err := findTaxRate(ctx, taxRateRepo, tenantID, *req.TaxRateID)
+		if err != nil {
+			return nil, nil, err
+		}
+		taxRateName = rate.Name
+	}
+
+	products, err := productRepo.FindByFilter(ctx, tenantID, repositories.ProductFilter{
+		IDs:      req.Filter.ProductIDs,
+		Category: req.Filter.Category,
+		Search:   req.Filter.Search,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(products) > maxBulkProducts {
+		return nil, nil, fmt.Errorf("la operación abarca %d productos; el máximo es %d", len(products), maxBulkProducts)
+	}
+
+	response := &BulkProductsResponse{
+		Action:  req.Action,
+		Matched: len(products),
+		Changes: []BulkProductChangeDTO{},
+		Skipped: []BulkSkippedDTO{},
+	}
+
+	found := make(map[int]bool, len(products))
+	for _, p := range products {
+		found[p.ID] = true
+	}
+	for _, id := range req.Filter.ProductIDs {
+		if !found[id] {
+			response.Skipped = append(response.Skipped, BulkSkippedDTO{ProductID: id, Reason: "producto no encontrado o eliminado"})
+			found[id] = true
+		}
+	}
+
+	var changes []repositories.ProductChange
+	for _, p := range products {
+		before := bulkValues(p)
+		after := before
+		change := repositories.ProductChange{ProductID: p.ID}
+
+		var reason string
+		switch req.Action {
+		case BulkActionReprice:
+			reason = planReprice(p, req.Price, &after, &change)
+		case BulkActionSetCategory:
+			after.Category = *req.Category
+			change.Category = req.Category
+		case BulkActionSetTaxRate:
+			after.TaxRateID = req.TaxRateID
+			change.TaxRateID = req.TaxRateID
+			change.ClearTaxRate = req.TaxRateID == nil
+		case BulkActionArchive:
+			if p.Stock != 0 {
+				reason = "todavía tiene stock; ajústalo a cero antes de eliminarlo"
+			}
+			after.Archived = true
+			change.Archive = true
+		}
+
+		if reason != "" {
+			response.Skipped = append(response.Skipped, BulkSkippedDTO{ProductID: p.ID, Name: p.Name, Reason: reason})
+			continue
+		}
+		if sameBulkValues(before, after) {
+			continue
+		}
+
+		response.Changes = append(response.Changes, BulkProductChangeDTO{
+			ProductID: p.ID,
+			Name:      p.Name,
+			SKU:       p.Sku,
+			Before:    before,
+			After:     after,
+		})
+		changes = append(changes, change)
+	}
+
+	response.Summary = bulkSummary(req, len(changes), taxRateName)
+
+	return response, changes, nil
+}
+
+func validateBulkRequest(req *BulkProductsRequest) error {
+	filter := req.Filter
+	if !filter.All && len(filter.ProductIDs) == 0 && filter.Category == "" && filter.Search == "" {
+		return fmt.Errorf("indica los productos, un filtro o all para abarcar todo el catálogo")
+	}
+
+	switch req.Action {
+	case BulkActionReprice:
+		return validateBulkPrice(req.Price)
+	case BulkActionSetCategory:
+		if req.Category == nil {
+			return fmt.Errorf("indica la categoría (vacía para quitarla)")
+		}
+		category := strings.TrimSpace(*req.Category)
+		req.Category = &category
+	case BulkActionSetTaxRate:
+		if req.TaxRateID != nil && *req.TaxRateID == 0 {
+			req.TaxRateID = nil
+		}
+	case BulkActionArchive:
+	default:
+		return fmt.Errorf("operación inválida %q: use %s, %s, %s o %s", req.Action, BulkActionReprice, BulkActionSetCategory, BulkActionSetTaxRate, BulkActionArchive)
+	}
+
+	return nil
+}
+
+func validateBulkPrice(price *BulkPriceChange) error {
+	if price == nil {
+		return fmt.Errorf("indica el cambio de precio")
+	}
+
+	if price.Target == "" {
+		price.Target = "retail"
+	}
+	if price.Target != "retail" && price.Target != "wholesale" && price.Target != "both" {
+		return fmt.Errorf("precio inválido %q: use retail, wholesale o both", price.Target)
+	}
+
+	switch price.Mode {
+	case "percent":
+		if price.Value <= -100 {
+			return fmt.Errorf("el porcentaje debe ser mayor a -100")
+		}
+	case "amount":
+	case "set":
+		if price.Value < 0 {
+			return fmt.Errorf("el precio no puede ser negativo")
+		}
+	default:
+		return fmt.Errorf("modo inválido %q: use percent, amount o set", price.Mode)
+	}
+
+	if r := price.Rounding; r != nil {
+		if r.Mode == "" {
+			r.Mode = "nearest"
+		}
+		if r.Mode != "nearest" && r.Mode != "up" && r.Mode != "down" {
+			return fmt.Errorf("redondeo inválido %q: use nearest, up o down", r.Mode)
+		}
+		if r.Increment <= 0 {
+			return fmt.Errorf("el incremento de redondeo debe ser mayor a 0")
+		}
+		if r.Ending < 0 || r.Ending >= r.Increment {
+			return fmt.Errorf("la terminación debe estar entre 0 y el incremento")
+		}
+	}
+
+	return nil
+}
+
+// planReprice calcula los precios nuevos; devuelve el motivo si el producto no se puede cambiar
+func planReprice(p *ent.Product, price *BulkPriceChange, after *BulkProductValues, change *repositories.ProductChange) string {
+	if price.Target == "retail" || price.Target == "both" {
+		retail := repriceValue(p.RetailPrice, price)
+		if retail < 0 {
+			return "el precio detal quedaría negativo"
+		}
+		after.RetailPrice = retail
+		change.RetailPrice = &retail
+	}
+
+	if price.Target == "wholesale" || price.Target == "both" {
+		if p.WholesalePrice <= 0 && price.Mode != "set" {
+			if price.Target == "wholesale" {
+				return "no tiene precio al mayor"
+			}
+			return ""
+		}
+		wholesale := repriceValue(p.WholesalePrice, price)
+		if wholesale < 0 {
+			return "el precio al mayor quedaría negativo"
+		}
+		after.WholesalePrice = wholesale
+		change.WholesalePrice = &wholesale
+	}
+
+	return ""
+}
+
+func repriceValue(current money.Amount, price *BulkPriceChange) money.Amount {
+	value := current
+	switch price.Mode {
+	case "percent":
+		value = current.Mul(1 + price.Value/100)
+	case "amount":
+		value = current + money.FromFloat(price.Value)
+	case "set":
+		value = money.FromFloat(price.Value)
+	}
+
+	return roundPrice(value, price.Rounding)
+}
+
+// roundPrice lleva el precio al múltiplo del incremento (desplazado por la terminación) según
+// el modo; sin regla de redondeo lo deja en centavos
+func roundPrice(value money.Amount, rounding *PriceRounding) money.Amount {
+	if rounding == nil {
+		return value.Round(money.Default)
+	}
+
+	steps := (value - rounding.Ending).Ratio(rounding.Increment)
+	steps = math.Round(steps*1e6) / 1e6
+	switch rounding.Mode {
+	case "up":
+		steps = math.Ceil(steps)
+	case "down":
+		steps = math.Floor(steps)
+	default:
+		steps = math.Round(steps)
+	}
+
+	return (rounding.Increment.Mul(steps) + rounding.Ending).Round(money.Default)
+}
+
+func bulkValues(p *ent.Product) BulkProductValues {
+	return BulkProductValues{
+		RetailPrice:    p.RetailPrice,
+		WholesalePrice: p.WholesalePrice,
+		Category:       p.Category,
+		TaxRateID:      p.TaxRateID,
+		Archived:       p.Archived,
+	}
+}
+
+func sameBulkValues(a, b BulkProductValues) bool {
+	return sameTaxRate(a.TaxRateID, b.TaxRateID) &&
+		a.RetailPrice == b.RetailPrice &&
+		a.WholesalePrice == b.WholesalePrice &&
+		a.Category == b.Category &&
+		a.Archived == b.Archived
+}
+
+// bulkSummary describe la operación para la bitácora
+func bulkSummary(req BulkProductsRequest, count int, taxRateName string) string {
+	switch req.Action {
+	case BulkActionReprice:
+		targets := map[string]string{"retail": "detal", "wholesale": "al mayor", "both": "detal y al mayor"}
+		var change string
+		switch req.Price.Mode {
+		case "percent":
+			change = fmt.Sprintf("%+g%%", req.Price.Value)
+		case "amount":
+			change = fmt.Sprintf("%+g", req.Price.Value)
+		case "set":
+			change = fmt.Sprintf("fijado en %g", req.Price.Value)
+		}
+		return fmt.Sprintf("Precio %s %s en %d productos", targets[req.Price.Target], change, count)
+	case BulkActionSetCategory:
+		if *req.Category == "" {
+			return fmt.Sprintf("Categoría quitada en %d productos", count)
+		}
+		return fmt.Sprintf("Categoría %q en %d productos", *req.Category, count)
+	case BulkActionSetTaxRate:
+		if req.TaxRateID == nil {
+			return fmt.Sprintf("Tarifa de impuesto quitada en %d productos", count)
+		}
+		return fmt.Sprintf("Tarifa de impuesto %s en %d productos", taxRateName, count)
+	default:
+		return fmt.Sprintf("%d productos eliminados", count)
+	}
+}
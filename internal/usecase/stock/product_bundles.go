@@ -0,0 +1,95 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+)
+
+// Repartos del ingreso de un combo entre sus componentes para los reportes
+const (
+	RevenueSplitRetailPrice = "retail_price"
+	RevenueSplitCost        = "cost"
+	RevenueSplitManual      = "manual"
+)
+
+type BundleComponentDTO struct {
+	ProductID   int     `json:"productId"`
+	ProductName string  `json:"productName"`
+	SKU         string  `json:"sku"`
+	BaseUnit    string  `json:"baseUnit"`
+	Quantity    float64 `json:"quantity"`
+	// RevenueShare es el porcentaje del ingreso con reparto manual
+	RevenueShare *float64 `json:"revenueShare,omitempty"`
+	Stock        float64  `json:"stock"`
+}
+
+type BundleDTO struct {
+	ProductID    int    `json:"productId"`
+	RevenueSplit string `json:"revenueSplit"`
+	// Stock son los combos ya armados; Buildable los que se pueden armar con los componentes
+	Stock      float64              `json:"stock"`
+	Buildable  float64              `json:"buildable"`
+	Available  float64              `json:"available"`
+	Components []BundleComponentDTO `json:"components"`
+}
+
+// loadBundle arma la vista del combo con sus componentes y lo que se puede armar con ellos
+func loadBundle(ctx context.Context, productRepo repositories.ProductRepository, bundleRepo repositories.BundleRepository, bundle *ent.Product) (*BundleDTO, error) {
+	components, err := bundleRepo.FindComponents(ctx, bundle.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(components))
+	for i, c := range components {
+		ids[i] = c.ComponentID
+	}
+	products := make(map[int]*ent.Product, len(ids))
+	if len(ids) > 0 {
+		found, err := productRepo.FindByIDsOrCategories(ctx, bundle.TenantID, ids, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range found {
+			products[p.ID] = p
+		}
+	}
+
+	buildable, err := bundleRepo.Buildable(ctx, []int{bundle.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	dto := convertBundleToDTO(bundle, components, products, buildable[bundle.ID])
+	return &dto, nil
+}
+
+func convertBundleToDTO(bundle *ent.Product, components []*ent.BundleComponent, products map[int]*ent.Product, buildable float64) BundleDTO {
+	dto := BundleDTO{
+		ProductID:    bundle.ID,
+		RevenueSplit: bundle.RevenueSplit,
+		Stock:        bundle.Stock,
+		Buildable:    buildable,
+		Available:    bundle.Stock + buildable,
+		Components:   make([]BundleComponentDTO, 0, len(components)),
+	}
+
+	for _, c := range components {
+		component := BundleComponentDTO{
+			ProductID:    c.ComponentID,
+			Quantity:     c.Quantity,
+			RevenueShare: c.RevenueShare,
+		}
+		if p, ok := products[c.ComponentID]; ok {
+			component.ProductName = p.Name
+			component.SKU = p.Sku
+			component.BaseUnit = p.BaseUnit
+			component.Stock = p.Stock
+		}
+		dto.Components = append(dto.Components, component)
+	}
+
+	return dto
+}
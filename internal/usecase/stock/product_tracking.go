@@ -0,0 +1,193 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+// applyTracking actualiza el control de lotes, de números de serie y la garantía del producto
+// solo cuando la petición los incluye
+func applyTracking(ctx context.Context, productRepo repositories.ProductRepository, product *ent.Product, trackLots, serialized *bool, warrantyMonths *int) error {
+	newTrackLots := product.TrackLots
+	if trackLots != nil {
+		newTrackLots = *trackLots
+	}
+	newSerialized := product.Serialized
+	if serialized != nil {
+		newSerialized = *serialized
+	}
+	newWarranty := product.WarrantyMonths
+	if warrantyMonths != nil {
+		if *warrantyMonths < 0 {
+			return fmt.Errorf("los meses de garantía no pueden ser negativos")
+		}
+		newWarranty = *warrantyMonths
+	}
+
+	if newTrackLots && newSerialized {
+		return fmt.Errorf("un producto no puede controlar lotes y números de serie a la vez")
+	}
+	if product.Bundle && (newTrackLots || newSerialized) {
+		return fmt.Errorf("un combo no controla lotes ni números de serie; los llevan sus componentes")
+	}
+
+	if newTrackLots != product.TrackLots {
+		if err := productRepo.SetTrackLots(ctx, product.ID, newTrackLots); err != nil {
+			return err
+		}
+		product.TrackLots = newTrackLots
+	}
+
+	if newSerialized != product.Serialized || newWarranty != product.WarrantyMonths {
+		if err := productRepo.SetSerialized(ctx, product.ID, newSerialized, newWarranty); err != nil {
+			return err
+		}
+		product.Serialized = newSerialized
+		product.WarrantyMonths = newWarranty
+	}
+
+	return nil
+}
+
+// applyCategory asigna la categoría del producto solo cuando la petición la incluye;
+// una cadena vacía la elimina
+func applyCategory(ctx context.Context, productRepo repositories.ProductRepository, product *ent.Product, category *string) error {
+	if category == nil {
+		return nil
+	}
+
+	newCategory := strings.TrimSpace(*category)
+	if newCategory == product.Category {
+		return nil
+	}
+
+	if err := productRepo.SetCategory(ctx, product.ID, newCategory); err != nil {
+		return err
+	}
+	product.Category = newCategory
+
+	return nil
+}
+
+// applyTaxRate asigna la tarifa de impuesto del producto solo cuando la petición la incluye;
+// 0 la quita y el producto pasa a usar la tarifa por defecto del tenant
+func applyTaxRate(ctx context.Context, productRepo repositories.ProductRepository, taxRateRepo repositories.TaxRateRepository, tenantID int, product *ent.Product, taxRateID *int) error {
+	if taxRateID == nil {
+		return nil
+	}
+
+	var newTaxRateID *int
+	if *taxRateID != 0 {
+		if _, err := findTaxRate(ctx, taxRateRepo, tenantID, *taxRateID); err != nil {
+			return err
+		}
+		newTaxRateID = taxRateID
+	}
+	if sameTaxRate(product.TaxRateID, newTaxRateID) {
+		return nil
+	}
+
+	if err := productRepo.SetTaxRate(ctx, product.ID, newTaxRateID); err != nil {
+		return err
+	}
+	product.TaxRateID = newTaxRateID
+
+	return nil
+}
+
+// findTaxRate busca una tarifa activa del tenant
+func findTaxRate(ctx context.Context, taxRateRepo repositories.TaxRateRepository, tenantID, taxRateID int) (*ent.TaxRate, error) {
+	rate, err := taxRateRepo.FindByID(ctx, taxRateID)
+	if err != nil || rate.TenantID != tenantID {
+		return nil, fmt.Errorf("%w: tarifa de impuesto %d", pkg_errors.ErrNotFound, taxRateID)
+	}
+	if !rate.Active {
+		return nil, fmt.Errorf("la tarifa de impuesto %s está inactiva", rate.Name)
+	}
+
+	return rate, nil
+}
+
+func sameTaxRate(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return *a == *b
+}
+
+// applyWholesale actualiza el precio al mayor y su cantidad mínima solo cuando la petición los
+// incluye; devuelve si hubo cambios
+func applyWholesale(ctx context.Context, productRepo repositories.ProductRepository, product *ent.Product, wholesalePrice *money.Amount, minQuantity *int) (bool, error) {
+	newPrice := product.WholesalePrice
+	if wholesalePrice != nil {
+		if *wholesalePrice < 0 {
+			return false, fmt.Errorf("el precio al mayor no puede ser negativo")
+		}
+		newPrice = *wholesalePrice
+	}
+	newMinQuantity := product.MinWholesaleQuantity
+	if minQuantity != nil {
+		if *minQuantity < 0 {
+			return false, fmt.Errorf("la cantidad mínima para precio al mayor no puede ser negativa")
+		}
+		newMinQuantity = *minQuantity
+	}
+
+	if newPrice == product.WholesalePrice && newMinQuantity == product.MinWholesaleQuantity {
+		return false, nil
+	}
+
+	if err := productRepo.SetWholesalePricing(ctx, product.ID, newPrice, newMinQuantity); err != nil {
+		return false, err
+	}
+	product.WholesalePrice = newPrice
+	product.MinWholesaleQuantity = newMinQuantity
+
+	return true, nil
+}
+
+// applyBaseUnit actualiza la unidad base y si el producto admite cantidades fraccionarias
+// solo cuando la petición los incluye
+func applyBaseUnit(ctx context.Context, productRepo repositories.ProductRepository, product *ent.Product, baseUnit *string, fractional *bool) error {
+	newBaseUnit := product.BaseUnit
+	if baseUnit != nil {
+		newBaseUnit = strings.TrimSpace(*baseUnit)
+		if newBaseUnit == "" {
+			return fmt.Errorf("la unidad base no puede estar vacía")
+		}
+	}
+	newFractional := product.Fractional
+	if fractional != nil {
+		newFractional = *fractional
+	}
+
+	if newBaseUnit == product.BaseUnit && newFractional == product.Fractional {
+		return nil
+	}
+
+	if newFractional && product.Serialized {
+		return fmt.Errorf("un producto serializado no admite cantidades fraccionarias")
+	}
+	if newFractional && product.Bundle {
+		return fmt.Errorf("un combo se vende por unidades enteras")
+	}
+	if !newFractional && !qty.IsWhole(product.Stock) {
+		return fmt.Errorf("el stock de %s es fraccionario (%s); ajústelo antes de quitar las fracciones", product.Name, qty.Format(product.Stock))
+	}
+
+	if err := productRepo.SetBaseUnit(ctx, product.ID, newBaseUnit, newFractional); err != nil {
+		return err
+	}
+	product.BaseUnit = newBaseUnit
+	product.Fractional = newFractional
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+type UnitDTO struct {
+	ID        int    `json:"id"`
+	ProductID int    `json:"productId"`
+	Name      string `json:"name"`
+	// Factor es cuántas unidades base contiene la unidad
+	Factor   float64 `json:"factor"`
+	BaseUnit string  `json:"baseUnit"`
+	// RetailPrice es el precio propio del empaque; sin él se vende al precio base por el factor
+	RetailPrice *money.Amount `json:"retailPrice"`
+	// UnitPrice es el precio detal efectivo de una unidad del empaque
+	UnitPrice money.Amount `json:"unitPrice"`
+	CreatedAt string       `json:"createdAt"`
+	UpdatedAt string       `json:"updatedAt"`
+}
+
+type UnitRequest struct {
+	Name        string        `json:"name" binding:"required"`
+	Factor      float64       `json:"factor" binding:"required"`
+	RetailPrice *money.Amount `json:"retailPrice,omitempty"`
+}
+
+// validateUnit normaliza el nombre y revisa que el factor deje cantidades válidas en la
+// unidad base: un producto sin fracciones solo admite empaques de unidades enteras
+func validateUnit(product *ent.Product, req UnitRequest) (string, float64, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return "", 0, fmt.Errorf("el nombre de la unidad es requerido")
+	}
+	if strings.EqualFold(name, product.BaseUnit) {
+		return "", 0, fmt.Errorf("%s ya es la unidad base del producto", name)
+	}
+
+	factor := qty.Round(req.Factor)
+	if factor <= 0 {
+		return "", 0, fmt.Errorf("el factor de conversión debe ser mayor que cero")
+	}
+	if !product.Fractional && !qty.IsWhole(factor) {
+		return "", 0, fmt.Errorf("el producto %s no admite fracciones: el factor debe ser entero", product.Name)
+	}
+
+	if req.RetailPrice != nil && *req.RetailPrice < 0 {
+		return "", 0, fmt.Errorf("el precio de la unidad no puede ser negativo")
+	}
+
+	return name, factor, nil
+}
+
+// saveUnitError traduce el nombre repetido en el producto a ErrAlreadyExists
+func saveUnitError(err error) error {
+	if ent.IsConstraintError(err) {
+		return pkg_errors.ErrAlreadyExists
+	}
+	return err
+}
+
+// findProductUnit devuelve la unidad solo si pertenece al producto y al tenant
+func findProductUnit(ctx context.Context, productRepo repositories.ProductRepository, unitRepo repositories.ProductUnitRepository, tenantID, productID, unitID int) (*ent.Product, *ent.ProductUnit, error) {
+	product, err := productRepo.FindByID(ctx, productID)
+	if err != nil || product.TenantID != tenantID {
+		return nil, nil, pkg_errors.ErrNotFound
+	}
+
+	unit, err := unitRepo.FindByID(ctx, unitID)
+	if err != nil || unit.ProductID != productID {
+		return nil, nil, pkg_errors.ErrNotFound
+	}
+
+	return product, unit, nil
+}
+
+func convertUnitToDTO(unit *ent.ProductUnit, product *ent.Product) UnitDTO {
+	unitPrice := product.RetailPrice.Mul(unit.Factor).Round(money.Default)
+	if unit.RetailPrice != nil {
+		unitPrice = *unit.RetailPrice
+	}
+
+	return UnitDTO{
+		ID:          unit.ID,
+		ProductID:   unit.ProductID,
+		Name:        unit.Name,
+		Factor:      unit.Factor,
+		BaseUnit:    product.BaseUnit,
+		RetailPrice: unit.RetailPrice,
+		UnitPrice:   unitPrice,
+		CreatedAt:   unit.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   unit.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
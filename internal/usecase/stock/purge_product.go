@@ -0,0 +1,68 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/infrastructure/storage"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type PurgeProductUseCase struct {
+	productRepo repositories.ProductRepository
+	fileRepo    repositories.ProductFileRepository
+	storage     storage.Storage
+}
+
+func NewPurgeProductUseCase(productRepo repositories.ProductRepository, fileRepo repositories.ProductFileRepository, fileStorage storage.Storage) *PurgeProductUseCase {
+	return &PurgeProductUseCase{
+		productRepo: productRepo,
+		fileRepo:    fileRepo,
+		storage:     fileStorage,
+	}
+}
+
+// Execute borra definitivamente un producto ya eliminado. Si algún documento o movimiento lo
+// referencia se rechaza con ErrInUse, porque el historial dejaría de mostrar el producto.
+func (uc *PurgeProductUseCase) Execute(ctx context.Context, tenantID, id int) error {
+	p, err := uc.productRepo.FindByID(ctx, id)
+	if err != nil || p.TenantID != tenantID {
+		return pkg_errors.ErrNotFound
+	}
+
+	if !p.Archived {
+		return fmt.Errorf("solo se pueden purgar productos eliminados")
+	}
+
+	refs, err := uc.productRepo.CountReferences(ctx, id)
+	if err != nil {
+		return err
+	}
+	if refs.Total() > 0 {
+		return fmt.Errorf("%w: el producto %s tiene %s", pkg_errors.ErrInUse, p.Name, refs)
+	}
+
+	files, err := uc.fileRepo.FindByProduct(ctx, id, "")
+	if err != nil {
+		return err
+	}
+
+	if err := uc.productRepo.Purge(ctx, id); err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		for _, key := range []string{file.StorageKey, file.ThumbnailKey} {
+			if key == "" {
+				continue
+			}
+			if err := uc.storage.Delete(ctx, key); err != nil {
+				log.Printf("Error deleting stored file %s: %v", key, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type ReorderProductImagesUseCase struct {
+	fileRepo    repositories.ProductFileRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewReorderProductImagesUseCase(fileRepo repositories.ProductFileRepository, productRepo repositories.ProductRepository) *ReorderProductImagesUseCase {
+	return &ReorderProductImagesUseCase{
+		fileRepo:    fileRepo,
+		productRepo: productRepo,
+	}
+}
+
+type ReorderProductImagesRequest struct {
+	// ImageIDs son todas las imágenes del producto en el orden deseado; la primera es la principal
+	ImageIDs []int `json:"imageIds" binding:"required"`
+}
+
+func (uc *ReorderProductImagesUseCase) Execute(ctx context.Context, tenantID, productID int, req ReorderProductImagesRequest) error {
+	product, err := uc.productRepo.FindByID(ctx, productID)
+	if err != nil || product.TenantID != tenantID {
+		return pkg_errors.ErrNotFound
+	}
+
+	images, err := uc.fileRepo.FindByProduct(ctx, productID, "image")
+	if err != nil {
+		return err
+	}
+
+	if len(req.ImageIDs) != len(images) {
+		return fmt.Errorf("se deben indicar las %d imágenes del producto", len(images))
+	}
+
+	seen := make(map[int]bool, len(req.ImageIDs))
+	for _, id := range req.ImageIDs {
+		if seen[id] {
+			return fmt.Errorf("la imagen %d está repetida", id)
+		}
+		seen[id] = true
+	}
+
+	return uc.fileRepo.Reorder(ctx, productID, req.ImageIDs)
+}
@@ -0,0 +1,42 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type RestoreProductUseCase struct {
+	productRepo repositories.ProductRepository
+}
+
+func NewRestoreProductUseCase(productRepo repositories.ProductRepository) *RestoreProductUseCase {
+	return &RestoreProductUseCase{
+		productRepo: productRepo,
+	}
+}
+
+func (uc *RestoreProductUseCase) Execute(ctx context.Context, tenantID, id int) (*ProductDTO, error) {
+	p, err := uc.productRepo.FindByID(ctx, id)
+	if err != nil || p.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	if !p.Archived {
+		return nil, fmt.Errorf("el producto no está eliminado")
+	}
+
+	if err := uc.productRepo.Restore(ctx, id); err != nil {
+		return nil, err
+	}
+
+	p, err = uc.productRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := convertProductToDTO(p)
+	return &dto, nil
+}
@@ -0,0 +1,55 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type ReviewAdjustmentUseCase struct {
+	adjustmentRepo repositories.StockAdjustmentRepository
+	productRepo    repositories.ProductRepository
+}
+
+func NewReviewAdjustmentUseCase(adjustmentRepo repositories.StockAdjustmentRepository, productRepo repositories.ProductRepository) *ReviewAdjustmentUseCase {
+	return &ReviewAdjustmentUseCase{
+		adjustmentRepo: adjustmentRepo,
+		productRepo:    productRepo,
+	}
+}
+
+// Execute aprueba (aplicando el ajuste al stock) o rechaza un ajuste pendiente
+func (uc *ReviewAdjustmentUseCase) Execute(ctx context.Context, tenantID, userID, id int, approve bool) (*AdjustmentDTO, error) {
+	adjustment, err := uc.adjustmentRepo.FindByID(ctx, id)
+	if err != nil || adjustment.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	if approve {
+		err = uc.adjustmentRepo.Apply(ctx, id, userID)
+	} else {
+		err = uc.adjustmentRepo.Reject(ctx, id, userID)
+	}
+	if errors.Is(err, repositories.ErrAdjustmentNotPending) {
+		return nil, fmt.Errorf("el ajuste no está pendiente (estado: %s)", adjustment.Status)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	adjustment, err = uc.adjustmentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos, err := convertAdjustmentsToDTO(ctx, uc.adjustmentRepo, uc.productRepo, tenantID, []*ent.StockAdjustment{adjustment})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos[0], nil
+}
@@ -0,0 +1,129 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	qty "Veritasbackend/pkg/quantity"
+)
+
+type SetBundleComponentsUseCase struct {
+	bundleRepo  repositories.BundleRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewSetBundleComponentsUseCase(bundleRepo repositories.BundleRepository, productRepo repositories.ProductRepository) *SetBundleComponentsUseCase {
+	return &SetBundleComponentsUseCase{
+		bundleRepo:  bundleRepo,
+		productRepo: productRepo,
+	}
+}
+
+type BundleComponentRequest struct {
+	ProductID int     `json:"productId" binding:"required"`
+	Quantity  float64 `json:"quantity" binding:"required"`
+	// RevenueShare es obligatorio con reparto manual: los porcentajes deben sumar 100
+	RevenueShare *float64 `json:"revenueShare,omitempty"`
+}
+
+type SetBundleComponentsRequest struct {
+	// RevenueSplit es retail_price (por defecto), cost o manual
+	RevenueSplit string                   `json:"revenueSplit"`
+	Components   []BundleComponentRequest `json:"components" binding:"dive"`
+}
+
+// Execute reemplaza los componentes del combo. Una lista vacía convierte el combo en un
+// producto común; los combos ya armados quedan como su stock.
+func (uc *SetBundleComponentsUseCase) Execute(ctx context.Context, tenantID, productID int, req SetBundleComponentsRequest) (*BundleDTO, error) {
+	bundle, err := uc.productRepo.FindByID(ctx, productID)
+	if err != nil || bundle.TenantID != tenantID || bundle.Archived {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	split := req.RevenueSplit
+	if split == "" {
+		split = RevenueSplitRetailPrice
+	}
+	if split != RevenueSplitRetailPrice && split != RevenueSplitCost && split != RevenueSplitManual {
+		return nil, fmt.Errorf("reparto de ingresos inválido: use retail_price, cost o manual")
+	}
+
+	if len(req.Components) > 0 {
+		if bundle.TrackLots || bundle.Serialized {
+			return nil, fmt.Errorf("un combo no controla lotes ni números de serie; los llevan sus componentes")
+		}
+		if bundle.Fractional {
+			return nil, fmt.Errorf("un combo se vende por unidades enteras")
+		}
+		isComponent, err := uc.bundleRepo.IsComponent(ctx, productID)
+		if err != nil {
+			return nil, err
+		}
+		if isComponent {
+			return nil, fmt.Errorf("%s forma parte de otro combo y no puede tener componentes", bundle.Name)
+		}
+	}
+
+	components := make([]repositories.BundleComponentInput, 0, len(req.Components))
+	seen := make(map[int]bool, len(req.Components))
+	shares := 0.0
+	for _, c := range req.Components {
+		if c.ProductID == productID {
+			return nil, fmt.Errorf("un combo no puede contenerse a sí mismo")
+		}
+		if seen[c.ProductID] {
+			return nil, fmt.Errorf("el producto %d está repetido en el combo", c.ProductID)
+		}
+		seen[c.ProductID] = true
+
+		component, err := uc.productRepo.FindByID(ctx, c.ProductID)
+		if err != nil || component.TenantID != tenantID {
+			return nil, fmt.Errorf("producto con ID %d no encontrado", c.ProductID)
+		}
+		if component.Archived {
+			return nil, fmt.Errorf("%s fue eliminado y no puede ser componente de un combo", component.Name)
+		}
+		if component.Bundle {
+			return nil, fmt.Errorf("%s es un combo y no puede ser componente de otro", component.Name)
+		}
+		if component.Serialized {
+			return nil, fmt.Errorf("%s es serializado y no puede ser componente de un combo", component.Name)
+		}
+
+		quantity := qty.Round(c.Quantity)
+		if quantity <= 0 {
+			return nil, fmt.Errorf("la cantidad de %s en el combo debe ser mayor a 0", component.Name)
+		}
+		if !component.Fractional && !qty.IsWhole(quantity) {
+			return nil, fmt.Errorf("el producto %s no admite cantidades fraccionarias de %s", component.Name, component.BaseUnit)
+		}
+
+		input := repositories.BundleComponentInput{ComponentID: c.ProductID, Quantity: quantity}
+		if split == RevenueSplitManual {
+			if c.RevenueShare == nil || *c.RevenueShare < 0 {
+				return nil, fmt.Errorf("el reparto manual requiere el porcentaje de ingreso de %s", component.Name)
+			}
+			input.RevenueShare = c.RevenueShare
+			shares += *c.RevenueShare
+		}
+		components = append(components, input)
+	}
+
+	if split == RevenueSplitManual && len(components) > 0 && math.Abs(shares-100) > 0.01 {
+		return nil, fmt.Errorf("los porcentajes del reparto manual deben sumar 100 (suman %.2f)", shares)
+	}
+
+	if err := uc.bundleRepo.SetComponents(ctx, tenantID, productID, split, components); err != nil {
+		return nil, err
+	}
+
+	bundle, err = uc.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadBundle(ctx, uc.productRepo, uc.bundleRepo, bundle)
+}
@@ -0,0 +1,36 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
+)
+
+type UpdateAdjustmentSettingsUseCase struct {
+	tenantRepo repositories.TenantRepository
+}
+
+func NewUpdateAdjustmentSettingsUseCase(tenantRepo repositories.TenantRepository) *UpdateAdjustmentSettingsUseCase {
+	return &UpdateAdjustmentSettingsUseCase{
+		tenantRepo: tenantRepo,
+	}
+}
+
+type AdjustmentSettingsDTO struct {
+	// ApprovalThreshold es el valor al costo a partir del cual un ajuste requiere aprobación; 0 la desactiva
+	ApprovalThreshold money.Amount `json:"approvalThreshold"`
+}
+
+func (uc *UpdateAdjustmentSettingsUseCase) Execute(ctx context.Context, tenantID int, req AdjustmentSettingsDTO) (*AdjustmentSettingsDTO, error) {
+	if req.ApprovalThreshold < 0 {
+		return nil, fmt.Errorf("el umbral de aprobación no puede ser negativo")
+	}
+
+	if err := uc.tenantRepo.SetAdjustmentApprovalThreshold(ctx, tenantID, req.ApprovalThreshold); err != nil {
+		return nil, err
+	}
+
+	return &AdjustmentSettingsDTO{ApprovalThreshold: req.ApprovalThreshold}, nil
+}
@@ -0,0 +1,37 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type UpdateCostingMethodUseCase struct {
+	tenantRepo repositories.TenantRepository
+}
+
+func NewUpdateCostingMethodUseCase(tenantRepo repositories.TenantRepository) *UpdateCostingMethodUseCase {
+	return &UpdateCostingMethodUseCase{
+		tenantRepo: tenantRepo,
+	}
+}
+
+type CostingMethodDTO struct {
+	// Method es el método de costeo: average (promedio ponderado móvil) o fifo
+	Method string `json:"method" binding:"required"`
+}
+
+// Execute cambia el método de costeo. Las capas de costo se mantienen con ambos métodos, así
+// que el cambio rige desde el siguiente movimiento de stock sin recalcular el pasado.
+func (uc *UpdateCostingMethodUseCase) Execute(ctx context.Context, tenantID int, req CostingMethodDTO) (*CostingMethodDTO, error) {
+	if req.Method != "average" && req.Method != "fifo" {
+		return nil, fmt.Errorf("método de costeo inválido: %s (use average o fifo)", req.Method)
+	}
+
+	if err := uc.tenantRepo.SetCostingMethod(ctx, tenantID, req.Method); err != nil {
+		return nil, err
+	}
+
+	return &CostingMethodDTO{Method: req.Method}, nil
+}
@@ -2,44 +2,93 @@ package stock
 
 import (
 	"context"
+	"fmt"
 
 	"Veritasbackend/internal/domain/repositories"
 	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/money"
 )
 
 type UpdateProductUseCase struct {
 	productRepo repositories.ProductRepository
+	priceRepo   repositories.ProductPriceRepository
+	taxRateRepo repositories.TaxRateRepository
 }
 
-func NewUpdateProductUseCase(productRepo repositories.ProductRepository) *UpdateProductUseCase {
+func NewUpdateProductUseCase(productRepo repositories.ProductRepository, priceRepo repositories.ProductPriceRepository, taxRateRepo repositories.TaxRateRepository) *UpdateProductUseCase {
 	return &UpdateProductUseCase{
 		productRepo: productRepo,
+		priceRepo:   priceRepo,
+		taxRateRepo: taxRateRepo,
 	}
 }
 
 type UpdateProductRequest struct {
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price"`
-	Stock       int     `json:"stock"`
-	SKU         string  `json:"sku"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	RetailPrice money.Amount `json:"retailPrice"`
+	// El costo (purchasePrice) no se edita: lo mantienen las compras y el método de costeo. El
+	// stock tampoco: se corrige con un ajuste (POST /api/stock/adjustments).
+	WholesalePrice       *money.Amount `json:"wholesalePrice,omitempty"`
+	MinWholesaleQuantity *int          `json:"minWholesaleQuantity,omitempty"`
+	SKU                  string        `json:"sku"`
+	TrackLots            *bool         `json:"trackLots,omitempty"`
+	Serialized           *bool         `json:"serialized,omitempty"`
+	WarrantyMonths       *int          `json:"warrantyMonths,omitempty"`
+	Category             *string       `json:"category,omitempty"`
+	// TaxRateID es la tarifa de impuesto del producto; 0 la quita y se usa la del tenant
+	TaxRateID  *int    `json:"taxRateId,omitempty"`
+	BaseUnit   *string `json:"baseUnit,omitempty"`
+	Fractional *bool   `json:"fractional,omitempty"`
 }
 
-func (uc *UpdateProductUseCase) Execute(ctx context.Context, id int, req UpdateProductRequest) (*ProductDTO, error) {
-	product, err := uc.productRepo.Update(ctx, id, req.Name, req.Description, req.SKU, req.Price, req.Stock)
-	if err != nil {
+type UpdateProductResponse struct {
+	Product *ProductDTO `json:"product"`
+}
+
+// Execute actualiza los datos del producto; un cambio de precio queda en el historial de precios
+func (uc *UpdateProductUseCase) Execute(ctx context.Context, tenantID, userID int, id int, req UpdateProductRequest) (*UpdateProductResponse, error) {
+	current, err := uc.productRepo.FindByID(ctx, id)
+	if err != nil || current.TenantID != tenantID {
 		return nil, pkg_errors.ErrNotFound
 	}
 
-	return &ProductDTO{
-		ID:          product.ID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		Stock:       product.Stock,
-		SKU:         product.Sku,
-		CreatedAt:   product.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   product.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}, nil
-}
+	if req.RetailPrice < 0 {
+		return nil, fmt.Errorf("el precio detal no puede ser negativo")
+	}
+
+	product, err := uc.productRepo.Update(ctx, id, req.Name, req.Description, req.SKU, req.RetailPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	wholesaleChanged, err := applyWholesale(ctx, uc.productRepo, product, req.WholesalePrice, req.MinWholesaleQuantity)
+	if err != nil {
+		return nil, err
+	}
 
+	if req.RetailPrice != current.RetailPrice || wholesaleChanged {
+		if err := recordPriceChange(ctx, uc.priceRepo, tenantID, userID, id, product.RetailPrice, product.WholesalePrice); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := applyTracking(ctx, uc.productRepo, product, req.TrackLots, req.Serialized, req.WarrantyMonths); err != nil {
+		return nil, err
+	}
+
+	if err := applyCategory(ctx, uc.productRepo, product, req.Category); err != nil {
+		return nil, err
+	}
+
+	if err := applyTaxRate(ctx, uc.productRepo, uc.taxRateRepo, tenantID, product, req.TaxRateID); err != nil {
+		return nil, err
+	}
+
+	if err := applyBaseUnit(ctx, uc.productRepo, product, req.BaseUnit, req.Fractional); err != nil {
+		return nil, err
+	}
+
+	dto := convertProductToDTO(product)
+	return &UpdateProductResponse{Product: &dto}, nil
+}
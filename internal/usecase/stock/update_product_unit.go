@@ -0,0 +1,41 @@
+package stock
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type UpdateProductUnitUseCase struct {
+	unitRepo    repositories.ProductUnitRepository
+	productRepo repositories.ProductRepository
+}
+
+func NewUpdateProductUnitUseCase(unitRepo repositories.ProductUnitRepository, productRepo repositories.ProductRepository) *UpdateProductUnitUseCase {
+	return &UpdateProductUnitUseCase{
+		unitRepo:    unitRepo,
+		productRepo: productRepo,
+	}
+}
+
+// Execute reemplaza nombre, factor y precio de la unidad. Las ventas y compras ya registradas
+// no cambian: guardan la cantidad en unidad base y el nombre del empaque usado.
+func (uc *UpdateProductUnitUseCase) Execute(ctx context.Context, tenantID, productID, unitID int, req UnitRequest) (*UnitDTO, error) {
+	product, _, err := findProductUnit(ctx, uc.productRepo, uc.unitRepo, tenantID, productID, unitID)
+	if err != nil {
+		return nil, err
+	}
+
+	name, factor, err := validateUnit(product, req)
+	if err != nil {
+		return nil, err
+	}
+
+	unit, err := uc.unitRepo.Update(ctx, unitID, name, factor, req.RetailPrice)
+	if err != nil {
+		return nil, saveUnitError(err)
+	}
+
+	dto := convertUnitToDTO(unit, product)
+	return &dto, nil
+}
@@ -0,0 +1,148 @@
+package stock
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/internal/infrastructure/storage"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/imaging"
+)
+
+const (
+	// MaxProductFileSize es el tamaño máximo de un archivo subido a un producto
+	MaxProductFileSize = 10 << 20
+	thumbnailSize      = 320
+	thumbnailQuality   = 80
+)
+
+type UploadProductFileUseCase struct {
+	fileRepo    repositories.ProductFileRepository
+	productRepo repositories.ProductRepository
+	storage     storage.Storage
+	urlTTL      time.Duration
+}
+
+func NewUploadProductFileUseCase(fileRepo repositories.ProductFileRepository, productRepo repositories.ProductRepository, fileStorage storage.Storage, urlTTL time.Duration) *UploadProductFileUseCase {
+	return &UploadProductFileUseCase{
+		fileRepo:    fileRepo,
+		productRepo: productRepo,
+		storage:     fileStorage,
+		urlTTL:      urlTTL,
+	}
+}
+
+type UploadProductFileRequest struct {
+	// Kind es image (por defecto) o attachment
+	Kind     string
+	FileName string
+	Data     []byte
+}
+
+// Execute guarda el archivo en el almacenamiento y lo asocia al producto. Las imágenes deben ser
+// JPEG, PNG o GIF y se les genera una miniatura; los adjuntos se guardan tal cual.
+func (uc *UploadProductFileUseCase) Execute(ctx context.Context, tenantID, userID, productID int, req UploadProductFileRequest) (*ProductFileDTO, error) {
+	product, err := uc.productRepo.FindByID(ctx, productID)
+	if err != nil || product.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	kind := req.Kind
+	if kind == "" {
+		kind = "image"
+	}
+	if kind != "image" && kind != "attachment" {
+		return nil, fmt.Errorf("tipo de archivo inválido %q: use image o attachment", kind)
+	}
+	if len(req.Data) == 0 {
+		return nil, fmt.Errorf("el archivo está vacío")
+	}
+	if len(req.Data) > MaxProductFileSize {
+		return nil, fmt.Errorf("el archivo supera el máximo de %d MB", MaxProductFileSize>>20)
+	}
+
+	fileName := filepath.Base(strings.TrimSpace(req.FileName))
+	if fileName == "." || fileName == "/" {
+		fileName = "archivo"
+	}
+
+	baseKey, err := newStorageKey(tenantID, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	file := repositories.ProductFile{
+		TenantID:    tenantID,
+		ProductID:   productID,
+		UserID:      userID,
+		Kind:        kind,
+		FileName:    fileName,
+		ContentType: http.DetectContentType(req.Data),
+		Size:        int64(len(req.Data)),
+		StorageKey:  baseKey + strings.ToLower(filepath.Ext(fileName)),
+	}
+
+	var thumbnail []byte
+	if kind == "image" {
+		img, _, err := imaging.Decode(bytes.NewReader(req.Data))
+		if err != nil {
+			return nil, fmt.Errorf("formato de imagen no soportado: use JPEG, PNG o GIF")
+		}
+		file.Width = img.Bounds().Dx()
+		file.Height = img.Bounds().Dy()
+
+		thumbnail, err = imaging.EncodeJPEG(imaging.Thumbnail(img, thumbnailSize), thumbnailQuality)
+		if err != nil {
+			return nil, fmt.Errorf("error al generar la miniatura: %v", err)
+		}
+		file.ThumbnailKey = baseKey + "_thumb.jpg"
+	}
+
+	if err := uc.storage.Put(ctx, file.StorageKey, file.ContentType, req.Data); err != nil {
+		return nil, fmt.Errorf("error al guardar el archivo: %v", err)
+	}
+	if thumbnail != nil {
+		if err := uc.storage.Put(ctx, file.ThumbnailKey, "image/jpeg", thumbnail); err != nil {
+			uc.discard(ctx, file.StorageKey)
+			return nil, fmt.Errorf("error al guardar la miniatura: %v", err)
+		}
+	}
+
+	saved, err := uc.fileRepo.Create(ctx, file)
+	if err != nil {
+		uc.discard(ctx, file.StorageKey, file.ThumbnailKey)
+		return nil, err
+	}
+
+	return convertFileToDTO(ctx, uc.storage, uc.urlTTL, saved)
+}
+
+// discard borra archivos ya subidos cuando no se pudo completar el registro
+func (uc *UploadProductFileUseCase) discard(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if err := uc.storage.Delete(ctx, key); err != nil {
+			log.Printf("Error deleting orphan file %s: %v", key, err)
+		}
+	}
+}
+
+// newStorageKey arma una clave única por tenant y producto; la extensión se agrega aparte
+func newStorageKey(tenantID, productID int) (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("tenants/%d/products/%d/%s", tenantID, productID, hex.EncodeToString(random)), nil
+}
@@ -8,15 +8,19 @@ import (
 	"strings"
 
 	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
+	qty "Veritasbackend/pkg/quantity"
 )
 
 type UploadProductsUseCase struct {
 	productRepo repositories.ProductRepository
+	barcodeRepo repositories.ProductBarcodeRepository
 }
 
-func NewUploadProductsUseCase(productRepo repositories.ProductRepository) *UploadProductsUseCase {
+func NewUploadProductsUseCase(productRepo repositories.ProductRepository, barcodeRepo repositories.ProductBarcodeRepository) *UploadProductsUseCase {
 	return &UploadProductsUseCase{
 		productRepo: productRepo,
+		barcodeRepo: barcodeRepo,
 	}
 }
 
@@ -36,7 +40,7 @@ func (uc *UploadProductsUseCase) Execute(ctx context.Context, tenantID int, read
 		return nil, err
 	}
 
-	// Validar header esperado: name,description,price,stock,sku
+	// Validar header esperado: name,description,price,stock,sku,barcode (price es el precio detal)
 	if len(header) < 3 {
 		return &UploadResult{Errors: []string{"Invalid CSV format"}}, nil
 	}
@@ -67,32 +71,59 @@ func (uc *UploadProductsUseCase) Execute(ctx context.Context, tenantID int, read
 			description = strings.TrimSpace(record[1])
 		}
 
-		price, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		price, err := money.Parse(record[2])
 		if err != nil {
 			errors = append(errors, "Invalid price: "+record[2])
 			continue
 		}
 
-		stock := 0
+		stock := 0.0
 		if len(record) > 3 {
-			stock, err = strconv.Atoi(strings.TrimSpace(record[3]))
+			stock, err = strconv.ParseFloat(strings.TrimSpace(record[3]), 64)
 			if err != nil {
 				stock = 0
 			}
 		}
+		// Los productos cargados por archivo se crean en unidades enteras
+		if !qty.IsWhole(stock) {
+			errors = append(errors, "Invalid stock: "+record[3])
+			continue
+		}
 
 		sku := ""
 		if len(record) > 4 {
 			sku = strings.TrimSpace(record[4])
 		}
 
+		code, symbology := "", ""
+		if len(record) > 5 && strings.TrimSpace(record[5]) != "" {
+			code, symbology, err = normalizeBarcode(record[5], "")
+			if err != nil {
+				errors = append(errors, "Invalid barcode: "+record[5])
+				continue
+			}
+		}
+
 		// Crear producto
-		_, err = uc.productRepo.Create(ctx, tenantID, name, description, sku, price, stock)
+		product, err := uc.productRepo.Create(ctx, tenantID, name, description, sku, price, 0, stock)
 		if err != nil {
 			errors = append(errors, "Failed to create product: "+name)
 			continue
 		}
 
+		// Sin código en el archivo, o si ya lo usa otro producto, queda el interno
+		if code != "" {
+			_, err = addBarcode(ctx, uc.barcodeRepo, tenantID, product.ID, 0, code, symbology, false)
+			if err != nil {
+				errors = append(errors, "Barcode already in use: "+code)
+			}
+		}
+		if code == "" || err != nil {
+			if _, err := assignInternalBarcode(ctx, uc.barcodeRepo, tenantID, product.ID); err != nil {
+				errors = append(errors, "Failed to assign barcode: "+name)
+			}
+		}
+
 		imported++
 	}
 
@@ -101,4 +132,3 @@ func (uc *UploadProductsUseCase) Execute(ctx context.Context, tenantID int, read
 		Errors:   errors,
 	}, nil
 }
-
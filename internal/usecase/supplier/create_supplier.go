@@ -18,27 +18,31 @@ func NewCreateSupplierUseCase(supplierRepo repositories.SupplierRepository) *Cre
 }
 
 type CreateSupplierRequest struct {
-	Name    string  `json:"name"`
-	Email   *string `json:"email,omitempty"`
-	Phone   *string `json:"phone,omitempty"`
-	Address *string `json:"address,omitempty"`
-	RucNit  *string `json:"rucNit,omitempty"`
+	Name         string  `json:"name"`
+	Email        *string `json:"email,omitempty"`
+	Phone        *string `json:"phone,omitempty"`
+	Address      *string `json:"address,omitempty"`
+	RucNit       *string `json:"rucNit,omitempty"`
+	LeadTimeDays *int    `json:"leadTimeDays,omitempty"`
 }
 
 type SupplierDTO struct {
-	ID        int     `json:"id"`
-	Name      string  `json:"name"`
-	Email     *string `json:"email,omitempty"`
-	Phone     *string `json:"phone,omitempty"`
-	Address   *string `json:"address,omitempty"`
-	RucNit    *string `json:"rucNit,omitempty"`
-	TenantID  int     `json:"tenantId"`
-	CreatedAt string  `json:"createdAt"`
-	UpdatedAt string  `json:"updatedAt"`
+	ID           int     `json:"id"`
+	Name         string  `json:"name"`
+	Email        *string `json:"email,omitempty"`
+	Phone        *string `json:"phone,omitempty"`
+	Address      *string `json:"address,omitempty"`
+	RucNit       *string `json:"rucNit,omitempty"`
+	LeadTimeDays int     `json:"leadTimeDays"`
+	TenantID     int     `json:"tenantId"`
+	Archived     bool    `json:"archived"`
+	DeletedAt    *string `json:"deletedAt,omitempty"`
+	CreatedAt    string  `json:"createdAt"`
+	UpdatedAt    string  `json:"updatedAt"`
 }
 
 func convertSupplierToDTO(supplier *ent.Supplier) *SupplierDTO {
-	var email, phone, address, rucNit *string
+	var email, phone, address, rucNit, deletedAt *string
 
 	if supplier.Email != "" {
 		email = &supplier.Email
@@ -52,17 +56,24 @@ func convertSupplierToDTO(supplier *ent.Supplier) *SupplierDTO {
 	if supplier.RucNit != "" {
 		rucNit = &supplier.RucNit
 	}
+	if supplier.DeletedAt != nil {
+		formatted := supplier.DeletedAt.Format("2006-01-02T15:04:05Z07:00")
+		deletedAt = &formatted
+	}
 
 	return &SupplierDTO{
-		ID:        supplier.ID,
-		Name:      supplier.Name,
-		Email:     email,
-		Phone:     phone,
-		Address:   address,
-		RucNit:    rucNit,
-		TenantID:  supplier.TenantID,
-		CreatedAt: supplier.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt: supplier.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:           supplier.ID,
+		Name:         supplier.Name,
+		Email:        email,
+		Phone:        phone,
+		Address:      address,
+		RucNit:       rucNit,
+		LeadTimeDays: supplier.LeadTimeDays,
+		TenantID:     supplier.TenantID,
+		Archived:     supplier.Archived,
+		DeletedAt:    deletedAt,
+		CreatedAt:    supplier.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:    supplier.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
 
@@ -85,10 +96,15 @@ func (uc *CreateSupplierUseCase) Execute(ctx context.Context, tenantID int, req
 		rucNit = *req.RucNit
 	}
 
-	supplier, err := uc.supplierRepo.Create(ctx, tenantID, name, email, phone, address, rucNit)
+	leadTimeDays := 0
+	if req.LeadTimeDays != nil && *req.LeadTimeDays > 0 {
+		leadTimeDays = *req.LeadTimeDays
+	}
+
+	supplier, err := uc.supplierRepo.Create(ctx, tenantID, name, email, phone, address, rucNit, leadTimeDays)
 	if err != nil {
 		return nil, err
 	}
 
 	return convertSupplierToDTO(supplier), nil
-}
\ No newline at end of file
+}
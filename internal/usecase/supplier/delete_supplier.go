@@ -0,0 +1,29 @@
+package supplier
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type DeleteSupplierUseCase struct {
+	supplierRepo repositories.SupplierRepository
+}
+
+func NewDeleteSupplierUseCase(supplierRepo repositories.SupplierRepository) *DeleteSupplierUseCase {
+	return &DeleteSupplierUseCase{
+		supplierRepo: supplierRepo,
+	}
+}
+
+// Execute elimina el proveedor de forma lógica: deja de aparecer en el listado y no admite
+// compras nuevas, pero sus compras y pagos lo siguen mostrando
+func (uc *DeleteSupplierUseCase) Execute(ctx context.Context, tenantID, supplierID int) error {
+	supplier, err := uc.supplierRepo.FindByID(ctx, supplierID)
+	if err != nil || supplier.TenantID != tenantID || supplier.Archived {
+		return pkg_errors.ErrNotFound
+	}
+
+	return uc.supplierRepo.Archive(ctx, supplierID)
+}
@@ -19,6 +19,8 @@ func NewListSuppliersUseCase(supplierRepo repositories.SupplierRepository) *List
 type ListSuppliersRequest struct {
 	Page  int `json:"page"`
 	Limit int `json:"limit"`
+	// Archived lista los proveedores eliminados en vez de los activos
+	Archived bool `json:"archived"`
 }
 
 type SuppliersListResponse struct {
@@ -38,7 +40,7 @@ func (uc *ListSuppliersUseCase) Execute(ctx context.Context, tenantID int, req L
 
 	offset := (req.Page - 1) * req.Limit
 
-	suppliers, total, err := uc.supplierRepo.FindAll(ctx, tenantID, req.Limit, offset)
+	suppliers, total, err := uc.supplierRepo.FindAll(ctx, tenantID, req.Archived, req.Limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,42 @@
+package supplier
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type PurgeSupplierUseCase struct {
+	supplierRepo repositories.SupplierRepository
+}
+
+func NewPurgeSupplierUseCase(supplierRepo repositories.SupplierRepository) *PurgeSupplierUseCase {
+	return &PurgeSupplierUseCase{
+		supplierRepo: supplierRepo,
+	}
+}
+
+// Execute borra definitivamente un proveedor ya eliminado; se rechaza con ErrInUse si tiene
+// compras, pagos o números de serie registrados
+func (uc *PurgeSupplierUseCase) Execute(ctx context.Context, tenantID, supplierID int) error {
+	supplier, err := uc.supplierRepo.FindByID(ctx, supplierID)
+	if err != nil || supplier.TenantID != tenantID {
+		return pkg_errors.ErrNotFound
+	}
+
+	if !supplier.Archived {
+		return fmt.Errorf("solo se pueden purgar proveedores eliminados")
+	}
+
+	refs, err := uc.supplierRepo.CountReferences(ctx, supplierID)
+	if err != nil {
+		return err
+	}
+	if refs.Total() > 0 {
+		return fmt.Errorf("%w: el proveedor %s tiene %s", pkg_errors.ErrInUse, supplier.Name, refs)
+	}
+
+	return uc.supplierRepo.Purge(ctx, supplierID)
+}
@@ -0,0 +1,41 @@
+package supplier
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type RestoreSupplierUseCase struct {
+	supplierRepo repositories.SupplierRepository
+}
+
+func NewRestoreSupplierUseCase(supplierRepo repositories.SupplierRepository) *RestoreSupplierUseCase {
+	return &RestoreSupplierUseCase{
+		supplierRepo: supplierRepo,
+	}
+}
+
+func (uc *RestoreSupplierUseCase) Execute(ctx context.Context, tenantID, supplierID int) (*SupplierDTO, error) {
+	supplier, err := uc.supplierRepo.FindByID(ctx, supplierID)
+	if err != nil || supplier.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	if !supplier.Archived {
+		return nil, fmt.Errorf("el proveedor no está eliminado")
+	}
+
+	if err := uc.supplierRepo.Restore(ctx, supplierID); err != nil {
+		return nil, err
+	}
+
+	supplier, err = uc.supplierRepo.FindByID(ctx, supplierID)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertSupplierToDTO(supplier), nil
+}
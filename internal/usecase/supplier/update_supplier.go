@@ -17,11 +17,12 @@ func NewUpdateSupplierUseCase(supplierRepo repositories.SupplierRepository) *Upd
 }
 
 type UpdateSupplierRequest struct {
-	Name    *string `json:"name,omitempty"`
-	Email   *string `json:"email,omitempty"`
-	Phone   *string `json:"phone,omitempty"`
-	Address *string `json:"address,omitempty"`
-	RucNit  *string `json:"rucNit,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	Email        *string `json:"email,omitempty"`
+	Phone        *string `json:"phone,omitempty"`
+	Address      *string `json:"address,omitempty"`
+	RucNit       *string `json:"rucNit,omitempty"`
+	LeadTimeDays *int    `json:"leadTimeDays,omitempty"`
 }
 
 func (uc *UpdateSupplierUseCase) Execute(ctx context.Context, tenantID, supplierID int, req UpdateSupplierRequest) (*SupplierDTO, error) {
@@ -91,8 +92,13 @@ func (uc *UpdateSupplierUseCase) Execute(ctx context.Context, tenantID, supplier
 		}
 	}
 
+	leadTimeDays := existingSupplier.LeadTimeDays
+	if req.LeadTimeDays != nil && *req.LeadTimeDays >= 0 {
+		leadTimeDays = *req.LeadTimeDays
+	}
+
 	// Update the supplier
-	updatedSupplier, err := uc.supplierRepo.Update(ctx, supplierID, name, email, phone, address, rucNit)
+	updatedSupplier, err := uc.supplierRepo.Update(ctx, supplierID, name, email, phone, address, rucNit, leadTimeDays)
 	if err != nil {
 		return nil, err
 	}
@@ -116,4 +122,4 @@ type ForbiddenError struct {
 
 func (e *ForbiddenError) Error() string {
 	return e.Message
-}
\ No newline at end of file
+}
@@ -0,0 +1,99 @@
+package taxes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+	"Veritasbackend/pkg/tax"
+)
+
+type CreateTaxRateUseCase struct {
+	taxRateRepo repositories.TaxRateRepository
+}
+
+func NewCreateTaxRateUseCase(taxRateRepo repositories.TaxRateRepository) *CreateTaxRateUseCase {
+	return &CreateTaxRateUseCase{
+		taxRateRepo: taxRateRepo,
+	}
+}
+
+type TaxRateRequest struct {
+	Name string  `json:"name"`
+	Rate float64 `json:"rate"`
+	// Kind es taxable (por defecto), exempt o excluded; las dos últimas no generan impuesto
+	Kind      string `json:"kind"`
+	IsDefault bool   `json:"isDefault"`
+	// Active es true si no se envía
+	Active *bool `json:"active,omitempty"`
+}
+
+func (uc *CreateTaxRateUseCase) Execute(ctx context.Context, tenantID int, req TaxRateRequest) (*TaxRateDTO, error) {
+	data, err := validateTaxRate(ctx, uc.taxRateRepo, tenantID, 0, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := uc.taxRateRepo.Create(ctx, tenantID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := convertTaxRateToDTO(rate)
+	return &dto, nil
+}
+
+// validateTaxRate normaliza la petición y rechaza nombres repetidos dentro del tenant;
+// currentID es la tarifa que se edita, 0 al crear
+func validateTaxRate(ctx context.Context, taxRateRepo repositories.TaxRateRepository, tenantID, currentID int, req TaxRateRequest) (repositories.TaxRateData, error) {
+	data := repositories.TaxRateData{
+		Name:      strings.TrimSpace(req.Name),
+		Rate:      req.Rate,
+		Kind:      req.Kind,
+		IsDefault: req.IsDefault,
+		Active:    req.Active == nil || *req.Active,
+	}
+
+	if data.Name == "" {
+		return data, fmt.Errorf("el nombre de la tarifa es requerido")
+	}
+	if data.Kind == "" {
+		data.Kind = tax.KindTaxable
+	}
+	if !tax.ValidKind(data.Kind) {
+		return data, fmt.Errorf("tipo de tarifa inválido %q: use %s, %s o %s", data.Kind, tax.KindTaxable, tax.KindExempt, tax.KindExcluded)
+	}
+	if data.Rate < 0 || data.Rate > 100 {
+		return data, fmt.Errorf("la tarifa debe estar entre 0 y 100")
+	}
+	if data.Kind != tax.KindTaxable {
+		data.Rate = 0
+	}
+	if data.IsDefault && !data.Active {
+		return data, fmt.Errorf("la tarifa por defecto debe estar activa")
+	}
+
+	rates, err := taxRateRepo.FindAll(ctx, tenantID)
+	if err != nil {
+		return data, err
+	}
+	for _, rate := range rates {
+		if rate.ID != currentID && strings.EqualFold(rate.Name, data.Name) {
+			return data, fmt.Errorf("%w: ya existe la tarifa %s", pkg_errors.ErrAlreadyExists, rate.Name)
+		}
+	}
+
+	return data, nil
+}
+
+func findTaxRate(ctx context.Context, taxRateRepo repositories.TaxRateRepository, tenantID, taxRateID int) (*ent.TaxRate, error) {
+	rate, err := taxRateRepo.FindByID(ctx, taxRateID)
+	if err != nil || rate.TenantID != tenantID {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	return rate, nil
+}
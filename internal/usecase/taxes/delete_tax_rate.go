@@ -0,0 +1,38 @@
+package taxes
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type DeleteTaxRateUseCase struct {
+	taxRateRepo repositories.TaxRateRepository
+}
+
+func NewDeleteTaxRateUseCase(taxRateRepo repositories.TaxRateRepository) *DeleteTaxRateUseCase {
+	return &DeleteTaxRateUseCase{
+		taxRateRepo: taxRateRepo,
+	}
+}
+
+// Execute borra una tarifa sin uso; las que ya tienen productos o facturas se desactivan
+// en lugar de borrarse
+func (uc *DeleteTaxRateUseCase) Execute(ctx context.Context, tenantID, taxRateID int) error {
+	rate, err := findTaxRate(ctx, uc.taxRateRepo, tenantID, taxRateID)
+	if err != nil {
+		return err
+	}
+
+	refs, err := uc.taxRateRepo.CountReferences(ctx, taxRateID)
+	if err != nil {
+		return err
+	}
+	if refs.Total() > 0 {
+		return fmt.Errorf("%w: la tarifa %s tiene %s; desactívala en su lugar", pkg_errors.ErrInUse, rate.Name, refs)
+	}
+
+	return uc.taxRateRepo.Delete(ctx, taxRateID)
+}
@@ -0,0 +1,78 @@
+package taxes
+
+import (
+	"context"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type ListTaxRatesUseCase struct {
+	taxRateRepo repositories.TaxRateRepository
+	tenantRepo  repositories.TenantRepository
+}
+
+func NewListTaxRatesUseCase(taxRateRepo repositories.TaxRateRepository, tenantRepo repositories.TenantRepository) *ListTaxRatesUseCase {
+	return &ListTaxRatesUseCase{
+		taxRateRepo: taxRateRepo,
+		tenantRepo:  tenantRepo,
+	}
+}
+
+type TaxRateDTO struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	// Rate es el porcentaje; Kind es taxable, exempt (exento) o excluded (excluido)
+	Rate      float64 `json:"rate"`
+	Kind      string  `json:"kind"`
+	IsDefault bool    `json:"isDefault"`
+	Active    bool    `json:"active"`
+	CreatedAt string  `json:"createdAt"`
+	UpdatedAt string  `json:"updatedAt"`
+}
+
+type TaxSettingsDTO struct {
+	// PricesIncludeTax indica que los precios de venta ya traen el impuesto
+	PricesIncludeTax bool `json:"pricesIncludeTax"`
+}
+
+type ListTaxRatesResponse struct {
+	Rates    []TaxRateDTO   `json:"rates"`
+	Settings TaxSettingsDTO `json:"settings"`
+}
+
+func (uc *ListTaxRatesUseCase) Execute(ctx context.Context, tenantID int) (*ListTaxRatesResponse, error) {
+	tenant, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	rates, err := uc.taxRateRepo.FindAll(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTaxRatesResponse{
+		Rates:    make([]TaxRateDTO, len(rates)),
+		Settings: TaxSettingsDTO{PricesIncludeTax: tenant.PricesIncludeTax},
+	}
+	for i, rate := range rates {
+		response.Rates[i] = convertTaxRateToDTO(rate)
+	}
+
+	return response, nil
+}
+
+func convertTaxRateToDTO(rate *ent.TaxRate) TaxRateDTO {
+	return TaxRateDTO{
+		ID:        rate.ID,
+		Name:      rate.Name,
+		Rate:      rate.Rate,
+		Kind:      rate.Kind,
+		IsDefault: rate.IsDefault,
+		Active:    rate.Active,
+		CreatedAt: rate.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: rate.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
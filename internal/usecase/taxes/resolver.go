@@ -0,0 +1,133 @@
+package taxes
+
+import (
+	"context"
+	"fmt"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/money"
+	"Veritasbackend/pkg/tax"
+)
+
+// DocumentTaxDTO es el impuesto de una factura o una compra agrupado por tarifa
+type DocumentTaxDTO struct {
+	TaxRateID int          `json:"taxRateId"`
+	Name      string       `json:"name"`
+	Kind      string       `json:"kind"`
+	Rate      float64      `json:"rate"`
+	Base      money.Amount `json:"base"`
+	Amount    money.Amount `json:"amount"`
+}
+
+// Resolver resuelve la tarifa de cada línea de una factura o compra: la indicada en la línea,
+// la del producto o la tarifa por defecto del tenant, en ese orden. Sin ninguna, la línea
+// queda sin impuesto. Las tarifas consultadas se guardan para no repetir la consulta.
+type Resolver struct {
+	taxRateRepo repositories.TaxRateRepository
+	tenantID    int
+	fallback    tax.Rate
+	rates       map[int]tax.Rate
+}
+
+func NewResolver(ctx context.Context, taxRateRepo repositories.TaxRateRepository, tenantID int) (*Resolver, error) {
+	resolver := &Resolver{
+		taxRateRepo: taxRateRepo,
+		tenantID:    tenantID,
+		rates:       make(map[int]tax.Rate),
+	}
+
+	fallback, err := taxRateRepo.FindDefault(ctx, tenantID)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("error al consultar la tarifa de impuesto por defecto: %v", err)
+	}
+	if fallback != nil {
+		resolver.fallback = toRate(fallback)
+	}
+
+	return resolver, nil
+}
+
+// RateFor devuelve la tarifa de la línea; override es la tarifa indicada en la línea, nil si
+// se usa la del producto
+func (r *Resolver) RateFor(ctx context.Context, product *ent.Product, override *int) (tax.Rate, error) {
+	taxRateID := product.TaxRateID
+	if override != nil {
+		taxRateID = override
+	}
+	if taxRateID == nil {
+		return r.fallback, nil
+	}
+
+	if rate, ok := r.rates[*taxRateID]; ok {
+		return rate, nil
+	}
+
+	found, err := r.taxRateRepo.FindByID(ctx, *taxRateID)
+	if err != nil || found.TenantID != r.tenantID {
+		if override != nil {
+			return tax.Rate{}, fmt.Errorf("tarifa de impuesto con ID %d no encontrada", *taxRateID)
+		}
+		return tax.Rate{}, fmt.Errorf("la tarifa de impuesto del producto %s no existe", product.Name)
+	}
+
+	rate := toRate(found)
+	r.rates[found.ID] = rate
+	return rate, nil
+}
+
+func toRate(rate *ent.TaxRate) tax.Rate {
+	return tax.Rate{
+		ID:      rate.ID,
+		Name:    rate.Name,
+		Percent: rate.Rate,
+		Kind:    rate.Kind,
+	}
+}
+
+// RateID es el ID de la tarifa que se guarda en la línea; nil si la línea no tiene tarifa
+func RateID(rate tax.Rate) *int {
+	if rate.ID == 0 {
+		return nil
+	}
+	return &rate.ID
+}
+
+// Summarize agrupa el impuesto de las líneas por tarifa para guardarlo con el documento
+func Summarize(lines []tax.Line) ([]repositories.TaxLine, []DocumentTaxDTO) {
+	summary := tax.Summarize(lines)
+
+	taxLines := make([]repositories.TaxLine, len(summary))
+	dtos := make([]DocumentTaxDTO, len(summary))
+	for i, line := range summary {
+		taxLines[i] = repositories.TaxLine{
+			TaxRateID: line.Rate.ID,
+			Name:      line.Rate.Name,
+			Kind:      line.Rate.Kind,
+			Rate:      line.Rate.Percent,
+			Base:      line.Base,
+			Amount:    line.Amount,
+		}
+		dtos[i] = DocumentTaxDTO{
+			TaxRateID: line.Rate.ID,
+			Name:      line.Rate.Name,
+			Kind:      line.Rate.Kind,
+			Rate:      line.Rate.Percent,
+			Base:      line.Base,
+			Amount:    line.Amount,
+		}
+	}
+
+	return taxLines, dtos
+}
+
+func ConvertDocumentTaxToDTO(line *ent.InvoiceTax) DocumentTaxDTO {
+	return DocumentTaxDTO{
+		TaxRateID: line.TaxRateID,
+		Name:      line.Name,
+		Kind:      line.Kind,
+		Rate:      line.Rate,
+		Base:      line.Base,
+		Amount:    line.Amount,
+	}
+}
@@ -0,0 +1,38 @@
+package taxes
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type UpdateTaxRateUseCase struct {
+	taxRateRepo repositories.TaxRateRepository
+}
+
+func NewUpdateTaxRateUseCase(taxRateRepo repositories.TaxRateRepository) *UpdateTaxRateUseCase {
+	return &UpdateTaxRateUseCase{
+		taxRateRepo: taxRateRepo,
+	}
+}
+
+// Execute reemplaza los datos de la tarifa. Las facturas ya emitidas guardan su propia copia
+// de la tarifa, así que el cambio solo afecta a las nuevas.
+func (uc *UpdateTaxRateUseCase) Execute(ctx context.Context, tenantID, taxRateID int, req TaxRateRequest) (*TaxRateDTO, error) {
+	if _, err := findTaxRate(ctx, uc.taxRateRepo, tenantID, taxRateID); err != nil {
+		return nil, err
+	}
+
+	data, err := validateTaxRate(ctx, uc.taxRateRepo, tenantID, taxRateID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := uc.taxRateRepo.Update(ctx, taxRateID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := convertTaxRateToDTO(rate)
+	return &dto, nil
+}
@@ -0,0 +1,26 @@
+package taxes
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type UpdateTaxSettingsUseCase struct {
+	tenantRepo repositories.TenantRepository
+}
+
+func NewUpdateTaxSettingsUseCase(tenantRepo repositories.TenantRepository) *UpdateTaxSettingsUseCase {
+	return &UpdateTaxSettingsUseCase{
+		tenantRepo: tenantRepo,
+	}
+}
+
+// Execute cambia si los precios de venta incluyen el impuesto; aplica a las facturas nuevas
+func (uc *UpdateTaxSettingsUseCase) Execute(ctx context.Context, tenantID int, req TaxSettingsDTO) (*TaxSettingsDTO, error) {
+	if err := uc.tenantRepo.SetPricesIncludeTax(ctx, tenantID, req.PricesIncludeTax); err != nil {
+		return nil, err
+	}
+
+	return &TaxSettingsDTO{PricesIncludeTax: req.PricesIncludeTax}, nil
+}
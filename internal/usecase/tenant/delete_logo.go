@@ -0,0 +1,22 @@
+package tenant
+
+import (
+	"context"
+
+	"Veritasbackend/internal/domain/repositories"
+)
+
+type DeleteLogoUseCase struct {
+	tenantRepo repositories.TenantRepository
+}
+
+func NewDeleteLogoUseCase(tenantRepo repositories.TenantRepository) *DeleteLogoUseCase {
+	return &DeleteLogoUseCase{
+		tenantRepo: tenantRepo,
+	}
+}
+
+// Execute quita el logo; las facturas se imprimen sin él
+func (uc *DeleteLogoUseCase) Execute(ctx context.Context, tenantID int) error {
+	return uc.tenantRepo.SetLogo(ctx, tenantID, nil)
+}
@@ -0,0 +1,51 @@
+package tenant
+
+import (
+	"context"
+
+	"Veritasbackend/ent"
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type GetProfileUseCase struct {
+	tenantRepo repositories.TenantRepository
+}
+
+func NewGetProfileUseCase(tenantRepo repositories.TenantRepository) *GetProfileUseCase {
+	return &GetProfileUseCase{
+		tenantRepo: tenantRepo,
+	}
+}
+
+// ProfileDTO son los datos del tenant que encabezan las facturas y recibos impresos
+type ProfileDTO struct {
+	Name      string `json:"name"`
+	LegalName string `json:"legalName"`
+	TaxID     string `json:"taxId"`
+	Address   string `json:"address"`
+	Phone     string `json:"phone"`
+	Email     string `json:"email"`
+	HasLogo   bool   `json:"hasLogo"`
+}
+
+func (uc *GetProfileUseCase) Execute(ctx context.Context, tenantID int) (*ProfileDTO, error) {
+	t, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	return convertProfileToDTO(t), nil
+}
+
+func convertProfileToDTO(t *ent.Tenant) *ProfileDTO {
+	return &ProfileDTO{
+		Name:      t.Name,
+		LegalName: t.LegalName,
+		TaxID:     t.TaxID,
+		Address:   t.Address,
+		Phone:     t.Phone,
+		Email:     t.Email,
+		HasLogo:   len(t.Logo) > 0,
+	}
+}
@@ -0,0 +1,53 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Veritasbackend/internal/domain/repositories"
+	pkg_errors "Veritasbackend/pkg/errors"
+)
+
+type UpdateProfileUseCase struct {
+	tenantRepo repositories.TenantRepository
+}
+
+func NewUpdateProfileUseCase(tenantRepo repositories.TenantRepository) *UpdateProfileUseCase {
+	return &UpdateProfileUseCase{
+		tenantRepo: tenantRepo,
+	}
+}
+
+type ProfileRequest struct {
+	LegalName string `json:"legalName"`
+	TaxID     string `json:"taxId"`
+	Address   string `json:"address"`
+	Phone     string `json:"phone"`
+	Email     string `json:"email"`
+}
+
+// Execute reemplaza los datos fiscales y de contacto del tenant; los campos vacíos no se imprimen
+func (uc *UpdateProfileUseCase) Execute(ctx context.Context, tenantID int, req ProfileRequest) (*ProfileDTO, error) {
+	profile := repositories.TenantProfile{
+		LegalName: strings.TrimSpace(req.LegalName),
+		TaxID:     strings.TrimSpace(req.TaxID),
+		Address:   strings.TrimSpace(req.Address),
+		Phone:     strings.TrimSpace(req.Phone),
+		Email:     strings.TrimSpace(req.Email),
+	}
+	if profile.Email != "" && !strings.Contains(profile.Email, "@") {
+		return nil, fmt.Errorf("email inválido %q", profile.Email)
+	}
+
+	if err := uc.tenantRepo.SetProfile(ctx, tenantID, profile); err != nil {
+		return nil, err
+	}
+
+	t, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return nil, pkg_errors.ErrNotFound
+	}
+
+	return convertProfileToDTO(t), nil
+}
@@ -0,0 +1,50 @@
+package tenant
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image/png"
+
+	"Veritasbackend/internal/domain/repositories"
+	"Veritasbackend/pkg/imaging"
+)
+
+const (
+	// MaxLogoSize es el tamaño máximo del archivo del logo
+	MaxLogoSize = 2 << 20
+	logoSize    = 400
+)
+
+type UploadLogoUseCase struct {
+	tenantRepo repositories.TenantRepository
+}
+
+func NewUploadLogoUseCase(tenantRepo repositories.TenantRepository) *UploadLogoUseCase {
+	return &UploadLogoUseCase{
+		tenantRepo: tenantRepo,
+	}
+}
+
+// Execute guarda el logo del tenant. Acepta JPEG, PNG o GIF; se reduce a 400 px por lado y se
+// guarda en PNG, que conserva la transparencia.
+func (uc *UploadLogoUseCase) Execute(ctx context.Context, tenantID int, data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("el archivo está vacío")
+	}
+	if len(data) > MaxLogoSize {
+		return fmt.Errorf("el logo supera el máximo de %d MB", MaxLogoSize>>20)
+	}
+
+	img, _, err := imaging.Decode(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("el logo debe ser una imagen JPEG, PNG o GIF")
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, imaging.Thumbnail(img, logoSize)); err != nil {
+		return fmt.Errorf("error al procesar el logo: %v", err)
+	}
+
+	return uc.tenantRepo.SetLogo(ctx, tenantID, buf.Bytes())
+}
@@ -1,19 +1,35 @@
 package main
 
 import (
+	"context"
 	"log"
+	"time"
 
 	"Veritasbackend/internal/domain/repositories"
 	"Veritasbackend/internal/handler"
 	"Veritasbackend/internal/infrastructure/config"
 	"Veritasbackend/internal/infrastructure/database"
+	"Veritasbackend/internal/infrastructure/fiscal"
 	"Veritasbackend/internal/infrastructure/middleware"
+	"Veritasbackend/internal/infrastructure/scheduler"
+	"Veritasbackend/internal/infrastructure/storage"
+	"Veritasbackend/internal/usecase/audit"
 	"Veritasbackend/internal/usecase/auth"
+	"Veritasbackend/internal/usecase/currency"
+	"Veritasbackend/internal/usecase/customer"
 	"Veritasbackend/internal/usecase/dashboard"
+	"Veritasbackend/internal/usecase/einvoice"
+	"Veritasbackend/internal/usecase/inventory"
 	"Veritasbackend/internal/usecase/invoice"
+	"Veritasbackend/internal/usecase/numbering"
+	"Veritasbackend/internal/usecase/pricelist"
+	"Veritasbackend/internal/usecase/promotion"
 	"Veritasbackend/internal/usecase/purchase"
 	"Veritasbackend/internal/usecase/stock"
 	"Veritasbackend/internal/usecase/supplier"
+	"Veritasbackend/internal/usecase/taxes"
+	"Veritasbackend/internal/usecase/tenant"
+	"Veritasbackend/pkg/secret"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
@@ -34,60 +50,397 @@ func main() {
 	}
 	defer dbClient.Close()
 
+	// Almacenamiento de archivos (imágenes y adjuntos de productos)
+	fileStorage, err := storage.New(cfg.Storage)
+	if err != nil {
+		log.Fatalf("Failed to configure storage: %v", err)
+	}
+	fileURLTTL, err := time.ParseDuration(cfg.Storage.URLTTL)
+	if err != nil {
+		log.Fatalf("Invalid STORAGE_URL_TTL: %v", err)
+	}
+
+	// Proveedor de facturación electrónica ante la autoridad tributaria
+	fiscalProvider, err := fiscal.New(cfg.Fiscal)
+	if err != nil {
+		log.Fatalf("Failed to configure fiscal provider: %v", err)
+	}
+
+	// Clave con que se cifran las llaves privadas de los certificados de firma
+	einvoiceKeys, err := secret.NewBox(cfg.Fiscal.KeyEncryptionKey)
+	if err != nil {
+		log.Fatalf("Invalid EINVOICE_KEY_ENCRYPTION_KEY: %v", err)
+	}
+
 	// Inicializar repositorios
 	userRepo := repositories.NewUserRepository(dbClient)
 	tenantRepo := repositories.NewTenantRepository(dbClient)
 	productRepo := repositories.NewProductRepository(dbClient)
 	invoiceRepo := repositories.NewInvoiceRepository(dbClient)
 	supplierRepo := repositories.NewSupplierRepository(dbClient)
+	customerRepo := repositories.NewCustomerRepository(dbClient)
 	purchaseInvoiceRepo := repositories.NewPurchaseInvoiceRepository(dbClient)
 	purchaseInvoiceItemRepo := repositories.NewPurchaseInvoiceItemRepository(dbClient)
+	productLotRepo := repositories.NewProductLotRepository(dbClient)
+	productSerialRepo := repositories.NewProductSerialRepository(dbClient)
+	inventoryCountRepo := repositories.NewInventoryCountRepository(dbClient)
+	stockAdjustmentRepo := repositories.NewStockAdjustmentRepository(dbClient)
+	productPriceRepo := repositories.NewProductPriceRepository(dbClient)
+	priceListRepo := repositories.NewPriceListRepository(dbClient)
+	productFileRepo := repositories.NewProductFileRepository(dbClient)
+	productBarcodeRepo := repositories.NewProductBarcodeRepository(dbClient)
+	productUnitRepo := repositories.NewProductUnitRepository(dbClient)
+	bundleRepo := repositories.NewBundleRepository(dbClient)
+	auditLogRepo := repositories.NewAuditLogRepository(dbClient)
+	taxRateRepo := repositories.NewTaxRateRepository(dbClient)
+	promotionRepo := repositories.NewPromotionRepository(dbClient)
+	exchangeRateRepo := repositories.NewExchangeRateRepository(dbClient)
+	fiscalDocumentRepo := repositories.NewFiscalDocumentRepository(dbClient)
+	numberingSeriesRepo := repositories.NewNumberingSeriesRepository(dbClient)
 
 	// Inicializar casos de uso
 	loginUseCase := auth.NewLoginUseCase(userRepo, tenantRepo)
 	getCurrentUserUseCase := auth.NewGetCurrentUserUseCase(userRepo)
 	createUserUseCase := auth.NewCreateUserUseCase(userRepo, tenantRepo)
+	listUsersUseCase := auth.NewListUsersUseCase(userRepo)
+	deleteUserUseCase := auth.NewDeleteUserUseCase(userRepo)
+	restoreUserUseCase := auth.NewRestoreUserUseCase(userRepo)
+	purgeUserUseCase := auth.NewPurgeUserUseCase(userRepo)
 	getMetricsUseCase := dashboard.NewGetMetricsUseCase(productRepo, invoiceRepo)
 	getReportsUseCase := dashboard.NewGetReportsUseCase(invoiceRepo)
-	listProductsUseCase := stock.NewListProductsUseCase(productRepo)
-	createProductUseCase := stock.NewCreateProductUseCase(productRepo)
-	updateProductUseCase := stock.NewUpdateProductUseCase(productRepo)
+	getMarginsUseCase := dashboard.NewGetMarginsUseCase(invoiceRepo, productRepo)
+	getDiscountsUseCase := dashboard.NewGetDiscountsUseCase(invoiceRepo, userRepo)
+	listProductsUseCase := stock.NewListProductsUseCase(productRepo, productFileRepo, fileStorage, fileURLTTL)
+	createProductUseCase := stock.NewCreateProductUseCase(productRepo, productPriceRepo, productBarcodeRepo, taxRateRepo)
+	updateProductUseCase := stock.NewUpdateProductUseCase(productRepo, productPriceRepo, taxRateRepo)
 	deleteProductUseCase := stock.NewDeleteProductUseCase(productRepo)
-	uploadProductsUseCase := stock.NewUploadProductsUseCase(productRepo)
-	createInvoiceUseCase := invoice.NewCreateInvoiceUseCase(invoiceRepo, productRepo)
+	restoreProductUseCase := stock.NewRestoreProductUseCase(productRepo)
+	purgeProductUseCase := stock.NewPurgeProductUseCase(productRepo, productFileRepo, fileStorage)
+	uploadProductsUseCase := stock.NewUploadProductsUseCase(productRepo, productBarcodeRepo)
+	listProductLotsUseCase := stock.NewListProductLotsUseCase(productRepo, productLotRepo)
+	listExpiringLotsUseCase := stock.NewListExpiringLotsUseCase(productRepo, productLotRepo)
+	listProductSerialsUseCase := stock.NewListProductSerialsUseCase(productRepo, productSerialRepo)
+	lookupSerialUseCase := stock.NewLookupSerialUseCase(productSerialRepo, productRepo, supplierRepo, invoiceRepo, customerRepo)
+	getInventoryValuationUseCase := stock.NewGetInventoryValuationUseCase(productRepo, tenantRepo)
+	updateCostingMethodUseCase := stock.NewUpdateCostingMethodUseCase(tenantRepo)
+	createInvoiceUseCase := invoice.NewCreateInvoiceUseCase(invoiceRepo, productRepo, productLotRepo, productSerialRepo, productPriceRepo, priceListRepo, productUnitRepo, bundleRepo, customerRepo, taxRateRepo, tenantRepo, userRepo, promotionRepo, exchangeRateRepo, numberingSeriesRepo)
 	listInvoicesUseCase := invoice.NewListInvoicesUseCase(invoiceRepo)
-	getInvoiceUseCase := invoice.NewGetInvoiceUseCase(invoiceRepo, productRepo)
-	searchProductsUseCase := invoice.NewSearchProductsUseCase(invoiceRepo)
+	getInvoiceUseCase := invoice.NewGetInvoiceUseCase(invoiceRepo, productRepo, productLotRepo, productSerialRepo, customerRepo, taxRateRepo)
+	searchProductsUseCase := invoice.NewSearchProductsUseCase(invoiceRepo, bundleRepo)
+	payInvoiceUseCase := invoice.NewPayInvoiceUseCase(invoiceRepo, tenantRepo, exchangeRateRepo)
+	cancelInvoiceUseCase := invoice.NewCancelInvoiceUseCase(invoiceRepo)
+	voidInvoiceUseCase := invoice.NewVoidInvoiceUseCase(invoiceRepo)
+	updateDiscountSettingsUseCase := invoice.NewUpdateDiscountSettingsUseCase(tenantRepo)
+	previewInvoiceUseCase := invoice.NewPreviewInvoiceUseCase(createInvoiceUseCase)
+	printInvoiceUseCase := invoice.NewPrintInvoiceUseCase(invoiceRepo, tenantRepo, customerRepo, getInvoiceUseCase)
 
 	// Supplier use cases
 	createSupplierUseCase := supplier.NewCreateSupplierUseCase(supplierRepo)
 	listSuppliersUseCase := supplier.NewListSuppliersUseCase(supplierRepo)
 	updateSupplierUseCase := supplier.NewUpdateSupplierUseCase(supplierRepo)
+	deleteSupplierUseCase := supplier.NewDeleteSupplierUseCase(supplierRepo)
+	restoreSupplierUseCase := supplier.NewRestoreSupplierUseCase(supplierRepo)
+	purgeSupplierUseCase := supplier.NewPurgeSupplierUseCase(supplierRepo)
+
+	// Customer use cases
+	createCustomerUseCase := customer.NewCreateCustomerUseCase(customerRepo, priceListRepo)
+	listCustomersUseCase := customer.NewListCustomersUseCase(customerRepo)
+	getCustomerUseCase := customer.NewGetCustomerUseCase(customerRepo)
+	updateCustomerUseCase := customer.NewUpdateCustomerUseCase(customerRepo, priceListRepo)
+	deleteCustomerUseCase := customer.NewDeleteCustomerUseCase(customerRepo)
+	restoreCustomerUseCase := customer.NewRestoreCustomerUseCase(customerRepo)
+	purgeCustomerUseCase := customer.NewPurgeCustomerUseCase(customerRepo)
+	listCustomerInvoicesUseCase := customer.NewListCustomerInvoicesUseCase(customerRepo, invoiceRepo)
+
+	// Tax use cases
+	listTaxRatesUseCase := taxes.NewListTaxRatesUseCase(taxRateRepo, tenantRepo)
+	createTaxRateUseCase := taxes.NewCreateTaxRateUseCase(taxRateRepo)
+	updateTaxRateUseCase := taxes.NewUpdateTaxRateUseCase(taxRateRepo)
+	deleteTaxRateUseCase := taxes.NewDeleteTaxRateUseCase(taxRateRepo)
+	updateTaxSettingsUseCase := taxes.NewUpdateTaxSettingsUseCase(tenantRepo)
+
+	// Tenant profile use cases
+	getTenantProfileUseCase := tenant.NewGetProfileUseCase(tenantRepo)
+	updateTenantProfileUseCase := tenant.NewUpdateProfileUseCase(tenantRepo)
+	uploadTenantLogoUseCase := tenant.NewUploadLogoUseCase(tenantRepo)
+	deleteTenantLogoUseCase := tenant.NewDeleteLogoUseCase(tenantRepo)
+
+	// Electronic invoicing use cases
+	getEInvoiceSettingsUseCase := einvoice.NewGetSettingsUseCase(tenantRepo)
+	updateEInvoiceSettingsUseCase := einvoice.NewUpdateSettingsUseCase(tenantRepo)
+	uploadEInvoiceCertificateUseCase := einvoice.NewUploadCertificateUseCase(tenantRepo, einvoiceKeys)
+	issueEInvoiceUseCase := einvoice.NewIssueInvoiceUseCase(invoiceRepo, productRepo, customerRepo, tenantRepo, numberingSeriesRepo, fiscalDocumentRepo, fileStorage, fiscalProvider, einvoiceKeys, fileURLTTL)
+	issueCreditNoteUseCase := einvoice.NewIssueCreditNoteUseCase(invoiceRepo, productRepo, customerRepo, tenantRepo, fiscalDocumentRepo, fileStorage, fiscalProvider, einvoiceKeys, fileURLTTL)
+	listFiscalDocumentsUseCase := einvoice.NewListDocumentsUseCase(fiscalDocumentRepo)
+	getFiscalDocumentUseCase := einvoice.NewGetDocumentUseCase(fiscalDocumentRepo, fileStorage, fileURLTTL)
+	refreshFiscalStatusUseCase := einvoice.NewRefreshStatusUseCase(fiscalDocumentRepo, fiscalProvider, fileStorage, fileURLTTL)
+
+	// Numbering series use cases
+	listNumberingSeriesUseCase := numbering.NewListSeriesUseCase(numberingSeriesRepo)
+	createNumberingSeriesUseCase := numbering.NewCreateSeriesUseCase(numberingSeriesRepo)
+	updateNumberingSeriesUseCase := numbering.NewUpdateSeriesUseCase(numberingSeriesRepo)
+	deleteNumberingSeriesUseCase := numbering.NewDeleteSeriesUseCase(numberingSeriesRepo)
+
+	// Currency use cases
+	listExchangeRatesUseCase := currency.NewListExchangeRatesUseCase(exchangeRateRepo, tenantRepo)
+	setExchangeRateUseCase := currency.NewSetExchangeRateUseCase(exchangeRateRepo, tenantRepo)
+	importExchangeRatesUseCase := currency.NewImportExchangeRatesUseCase(exchangeRateRepo, tenantRepo)
+	deleteExchangeRateUseCase := currency.NewDeleteExchangeRateUseCase(exchangeRateRepo)
+	updateCurrencySettingsUseCase := currency.NewUpdateCurrencySettingsUseCase(tenantRepo, invoiceRepo, purchaseInvoiceRepo)
+
+	// Promotion use cases
+	listPromotionsUseCase := promotion.NewListPromotionsUseCase(promotionRepo)
+	createPromotionUseCase := promotion.NewCreatePromotionUseCase(promotionRepo, productRepo)
+	updatePromotionUseCase := promotion.NewUpdatePromotionUseCase(promotionRepo, productRepo)
+	deletePromotionUseCase := promotion.NewDeletePromotionUseCase(promotionRepo)
 
 	// Purchase use cases
-	createPurchaseUseCase := purchase.NewCreatePurchaseUseCase(purchaseInvoiceRepo, purchaseInvoiceItemRepo, productRepo)
+	createPurchaseUseCase := purchase.NewCreatePurchaseUseCase(purchaseInvoiceRepo, productRepo, productSerialRepo, productUnitRepo, supplierRepo, taxRateRepo, tenantRepo, exchangeRateRepo)
+	getReorderSuggestionsUseCase := purchase.NewGetReorderSuggestionsUseCase(productRepo, invoiceRepo, purchaseInvoiceRepo, supplierRepo)
+	createDraftPurchaseUseCase := purchase.NewCreateDraftPurchaseUseCase(getReorderSuggestionsUseCase, purchaseInvoiceRepo, tenantRepo)
+	registerSupplierPaymentUseCase := purchase.NewRegisterPaymentUseCase(purchaseInvoiceRepo, tenantRepo, exchangeRateRepo)
+	printPurchaseUseCase := purchase.NewPrintPurchaseUseCase(purchaseInvoiceRepo, purchaseInvoiceItemRepo, productRepo, supplierRepo, tenantRepo, taxRateRepo)
+
+	// Stock adjustment use cases
+	listAdjustmentReasonsUseCase := stock.NewListAdjustmentReasonsUseCase(stockAdjustmentRepo)
+	createAdjustmentReasonUseCase := stock.NewCreateAdjustmentReasonUseCase(stockAdjustmentRepo)
+	createAdjustmentUseCase := stock.NewCreateAdjustmentUseCase(stockAdjustmentRepo, productRepo, productLotRepo, tenantRepo)
+	updateAdjustmentReasonUseCase := stock.NewUpdateAdjustmentReasonUseCase(stockAdjustmentRepo)
+	listAdjustmentsUseCase := stock.NewListAdjustmentsUseCase(stockAdjustmentRepo, productRepo)
+	reviewAdjustmentUseCase := stock.NewReviewAdjustmentUseCase(stockAdjustmentRepo, productRepo)
+	updateAdjustmentSettingsUseCase := stock.NewUpdateAdjustmentSettingsUseCase(tenantRepo)
+	getShrinkageReportUseCase := stock.NewGetShrinkageReportUseCase(stockAdjustmentRepo, productRepo)
+
+	// Price use cases
+	changeProductPriceUseCase := stock.NewChangeProductPriceUseCase(productPriceRepo, productRepo)
+	listProductPricesUseCase := stock.NewListProductPricesUseCase(productPriceRepo, productRepo)
+	cancelScheduledPriceUseCase := stock.NewCancelScheduledPriceUseCase(productPriceRepo)
+	getPricesAsOfUseCase := stock.NewGetPricesAsOfUseCase(productPriceRepo, productRepo)
+	applyScheduledPricesUseCase := stock.NewApplyScheduledPricesUseCase(productPriceRepo)
+
+	// Product file use cases
+	uploadProductFileUseCase := stock.NewUploadProductFileUseCase(productFileRepo, productRepo, fileStorage, fileURLTTL)
+	listProductFilesUseCase := stock.NewListProductFilesUseCase(productFileRepo, productRepo, fileStorage, fileURLTTL)
+	reorderProductImagesUseCase := stock.NewReorderProductImagesUseCase(productFileRepo, productRepo)
+	deleteProductFileUseCase := stock.NewDeleteProductFileUseCase(productFileRepo, fileStorage)
+
+	// Barcode use cases
+	lookupBarcodeUseCase := stock.NewLookupBarcodeUseCase(productBarcodeRepo, productRepo, productUnitRepo)
+	listProductBarcodesUseCase := stock.NewListProductBarcodesUseCase(productBarcodeRepo, productRepo)
+	addProductBarcodeUseCase := stock.NewAddProductBarcodeUseCase(productBarcodeRepo, productRepo, productUnitRepo)
+	deleteProductBarcodeUseCase := stock.NewDeleteProductBarcodeUseCase(productBarcodeRepo)
+	generateInternalBarcodesUseCase := stock.NewGenerateInternalBarcodesUseCase(productBarcodeRepo)
+	printLabelsUseCase := stock.NewPrintLabelsUseCase(productBarcodeRepo, productRepo)
+
+	// Product unit use cases
+	listProductUnitsUseCase := stock.NewListProductUnitsUseCase(productUnitRepo, productRepo)
+	createProductUnitUseCase := stock.NewCreateProductUnitUseCase(productUnitRepo, productRepo)
+	updateProductUnitUseCase := stock.NewUpdateProductUnitUseCase(productUnitRepo, productRepo)
+	deleteProductUnitUseCase := stock.NewDeleteProductUnitUseCase(productUnitRepo, productRepo)
+
+	// Bundle use cases
+	getBundleUseCase := stock.NewGetBundleUseCase(bundleRepo, productRepo)
+	setBundleComponentsUseCase := stock.NewSetBundleComponentsUseCase(bundleRepo, productRepo)
+	assembleBundleUseCase := stock.NewAssembleBundleUseCase(bundleRepo, productRepo)
+	listAssembliesUseCase := stock.NewListAssembliesUseCase(bundleRepo, productRepo)
+
+	// Bulk product use cases
+	previewBulkProductsUseCase := stock.NewPreviewBulkProductsUseCase(productRepo, taxRateRepo)
+	applyBulkProductsUseCase := stock.NewApplyBulkProductsUseCase(productRepo, taxRateRepo)
+	listAuditLogUseCase := audit.NewListAuditLogUseCase(auditLogRepo)
+
+	// Price list use cases
+	listPriceListsUseCase := pricelist.NewListPriceListsUseCase(priceListRepo)
+	createPriceListUseCase := pricelist.NewCreatePriceListUseCase(priceListRepo)
+	updatePriceListUseCase := pricelist.NewUpdatePriceListUseCase(priceListRepo)
+	getPriceListUseCase := pricelist.NewGetPriceListUseCase(priceListRepo, productRepo)
+	setPriceListItemUseCase := pricelist.NewSetPriceListItemUseCase(priceListRepo, productRepo)
+	deletePriceListItemUseCase := pricelist.NewDeletePriceListItemUseCase(priceListRepo)
+
+	// Inventory count use cases
+	openCountUseCase := inventory.NewOpenCountUseCase(inventoryCountRepo, productRepo)
+	listCountsUseCase := inventory.NewListCountsUseCase(inventoryCountRepo)
+	getCountUseCase := inventory.NewGetCountUseCase(inventoryCountRepo, productRepo)
+	recordEntriesUseCase := inventory.NewRecordEntriesUseCase(inventoryCountRepo, productRepo)
+	postCountUseCase := inventory.NewPostCountUseCase(inventoryCountRepo, productRepo)
+	cancelCountUseCase := inventory.NewCancelCountUseCase(inventoryCountRepo)
 
 	// Inicializar handlers
 	authHandler := handler.NewAuthHandler(loginUseCase, getCurrentUserUseCase, createUserUseCase)
-	dashboardHandler := handler.NewDashboardHandler(getMetricsUseCase, getReportsUseCase)
+	userHandler := handler.NewUserHandler(listUsersUseCase, deleteUserUseCase, restoreUserUseCase, purgeUserUseCase)
+	dashboardHandler := handler.NewDashboardHandler(getMetricsUseCase, getReportsUseCase, getMarginsUseCase, getDiscountsUseCase)
 	stockHandler := handler.NewStockHandler(
 		listProductsUseCase,
 		createProductUseCase,
 		updateProductUseCase,
 		deleteProductUseCase,
 		uploadProductsUseCase,
+		listProductLotsUseCase,
+		listExpiringLotsUseCase,
+		listProductSerialsUseCase,
+		lookupSerialUseCase,
+		getInventoryValuationUseCase,
+		updateCostingMethodUseCase,
+		restoreProductUseCase,
+		purgeProductUseCase,
 	)
 	invoiceHandler := handler.NewInvoiceHandler(
 		createInvoiceUseCase,
 		listInvoicesUseCase,
 		getInvoiceUseCase,
 		searchProductsUseCase,
+		payInvoiceUseCase,
+		cancelInvoiceUseCase,
+		voidInvoiceUseCase,
+		updateDiscountSettingsUseCase,
+		previewInvoiceUseCase,
+		printInvoiceUseCase,
 	)
 	log.Println("🔧 Inicializando handler de supplier...")
-	supplierHandler := handler.NewSupplierHandler(createSupplierUseCase, listSuppliersUseCase, updateSupplierUseCase)
+	supplierHandler := handler.NewSupplierHandler(
+		createSupplierUseCase,
+		listSuppliersUseCase,
+		updateSupplierUseCase,
+		deleteSupplierUseCase,
+		restoreSupplierUseCase,
+		purgeSupplierUseCase,
+	)
+	log.Println("🔧 Inicializando handler de customer...")
+	customerHandler := handler.NewCustomerHandler(
+		createCustomerUseCase,
+		listCustomersUseCase,
+		getCustomerUseCase,
+		updateCustomerUseCase,
+		deleteCustomerUseCase,
+		restoreCustomerUseCase,
+		purgeCustomerUseCase,
+		listCustomerInvoicesUseCase,
+	)
+
+	log.Println("🔧 Inicializando handler de tax...")
+	taxHandler := handler.NewTaxHandler(
+		listTaxRatesUseCase,
+		createTaxRateUseCase,
+		updateTaxRateUseCase,
+		deleteTaxRateUseCase,
+		updateTaxSettingsUseCase,
+	)
+
+	log.Println("🔧 Inicializando handler de tenant...")
+	tenantHandler := handler.NewTenantHandler(
+		getTenantProfileUseCase,
+		updateTenantProfileUseCase,
+		uploadTenantLogoUseCase,
+		deleteTenantLogoUseCase,
+	)
+
+	log.Println("🔧 Inicializando handler de facturación electrónica...")
+	einvoiceHandler := handler.NewEInvoiceHandler(
+		getEInvoiceSettingsUseCase,
+		updateEInvoiceSettingsUseCase,
+		uploadEInvoiceCertificateUseCase,
+		issueEInvoiceUseCase,
+		issueCreditNoteUseCase,
+		listFiscalDocumentsUseCase,
+		getFiscalDocumentUseCase,
+		refreshFiscalStatusUseCase,
+	)
+
+	log.Println("🔧 Inicializando handler de numeración...")
+	numberingHandler := handler.NewNumberingHandler(
+		listNumberingSeriesUseCase,
+		createNumberingSeriesUseCase,
+		updateNumberingSeriesUseCase,
+		deleteNumberingSeriesUseCase,
+	)
+
+	log.Println("🔧 Inicializando handler de currency...")
+	currencyHandler := handler.NewCurrencyHandler(
+		listExchangeRatesUseCase,
+		setExchangeRateUseCase,
+		importExchangeRatesUseCase,
+		deleteExchangeRateUseCase,
+		updateCurrencySettingsUseCase,
+	)
+
+	log.Println("🔧 Inicializando handler de promotion...")
+	promotionHandler := handler.NewPromotionHandler(
+		listPromotionsUseCase,
+		createPromotionUseCase,
+		updatePromotionUseCase,
+		deletePromotionUseCase,
+	)
 
 	log.Println("🔧 Inicializando handler de purchase...")
-	purchaseHandler := handler.NewPurchaseHandler(createPurchaseUseCase)
+	purchaseHandler := handler.NewPurchaseHandler(createPurchaseUseCase, getReorderSuggestionsUseCase, createDraftPurchaseUseCase, registerSupplierPaymentUseCase, printPurchaseUseCase)
+
+	adjustmentHandler := handler.NewAdjustmentHandler(
+		listAdjustmentReasonsUseCase,
+		createAdjustmentReasonUseCase,
+		updateAdjustmentReasonUseCase,
+		createAdjustmentUseCase,
+		listAdjustmentsUseCase,
+		reviewAdjustmentUseCase,
+		updateAdjustmentSettingsUseCase,
+		getShrinkageReportUseCase,
+	)
+	priceHandler := handler.NewPriceHandler(
+		changeProductPriceUseCase,
+		listProductPricesUseCase,
+		cancelScheduledPriceUseCase,
+		getPricesAsOfUseCase,
+	)
+	localStorage, _ := fileStorage.(*storage.LocalStorage)
+	productFileHandler := handler.NewProductFileHandler(
+		uploadProductFileUseCase,
+		listProductFilesUseCase,
+		reorderProductImagesUseCase,
+		deleteProductFileUseCase,
+		localStorage,
+	)
+	barcodeHandler := handler.NewBarcodeHandler(
+		lookupBarcodeUseCase,
+		listProductBarcodesUseCase,
+		addProductBarcodeUseCase,
+		deleteProductBarcodeUseCase,
+		generateInternalBarcodesUseCase,
+		printLabelsUseCase,
+	)
+	productUnitHandler := handler.NewProductUnitHandler(
+		listProductUnitsUseCase,
+		createProductUnitUseCase,
+		updateProductUnitUseCase,
+		deleteProductUnitUseCase,
+	)
+	bundleHandler := handler.NewBundleHandler(
+		getBundleUseCase,
+		setBundleComponentsUseCase,
+		assembleBundleUseCase,
+		listAssembliesUseCase,
+	)
+	productBulkHandler := handler.NewProductBulkHandler(previewBulkProductsUseCase, applyBulkProductsUseCase)
+	auditHandler := handler.NewAuditHandler(listAuditLogUseCase)
+	priceListHandler := handler.NewPriceListHandler(
+		listPriceListsUseCase,
+		createPriceListUseCase,
+		updatePriceListUseCase,
+		getPriceListUseCase,
+		setPriceListItemUseCase,
+		deletePriceListItemUseCase,
+	)
+	inventoryHandler := handler.NewInventoryHandler(
+		openCountUseCase,
+		listCountsUseCase,
+		getCountUseCase,
+		recordEntriesUseCase,
+		postCountUseCase,
+		cancelCountUseCase,
+	)
+
+	// Tareas en segundo plano
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	defer stopScheduler()
+	scheduler.Start(schedulerCtx, "apply-scheduled-prices", time.Minute, applyScheduledPricesUseCase.Execute)
 
 	// Configurar Gin
 	if cfg.Server.GinMode == "release" {
@@ -107,6 +460,11 @@ func main() {
 	api := r.Group("/api")
 	{
 		api.POST("/auth/login", authHandler.Login)
+
+		// Con almacenamiento local los archivos se descargan desde aquí con URLs firmadas
+		if localStorage != nil {
+			api.GET("/files/*key", productFileHandler.ServeFile)
+		}
 	}
 
 	// Rutas protegidas
@@ -123,33 +481,174 @@ func main() {
 		admin.Use(middleware.AdminMiddleware())
 		{
 			admin.POST("/users", authHandler.CreateUser)
+			admin.GET("/users", userHandler.ListUsers)
+			admin.DELETE("/users/:id", userHandler.DeleteUser)
+			admin.POST("/users/:id/restore", userHandler.RestoreUser)
+			admin.DELETE("/users/:id/purge", userHandler.PurgeUser)
+			admin.DELETE("/stock/:id/purge", stockHandler.PurgeProduct)
+			admin.DELETE("/suppliers/:id/purge", supplierHandler.PurgeSupplier)
+			admin.DELETE("/customers/:id/purge", customerHandler.PurgeCustomer)
+			admin.POST("/taxes", taxHandler.CreateTaxRate)
+			admin.PUT("/taxes/settings", taxHandler.UpdateSettings)
+			admin.PUT("/taxes/:id", taxHandler.UpdateTaxRate)
+			admin.DELETE("/taxes/:id", taxHandler.DeleteTaxRate)
+			admin.PUT("/tenant/profile", tenantHandler.UpdateProfile)
+			admin.PUT("/tenant/logo", tenantHandler.UploadLogo)
+			admin.DELETE("/tenant/logo", tenantHandler.DeleteLogo)
+			admin.GET("/einvoice/settings", einvoiceHandler.GetSettings)
+			admin.PUT("/einvoice/settings", einvoiceHandler.UpdateSettings)
+			admin.PUT("/einvoice/certificate", einvoiceHandler.UploadCertificate)
+			admin.GET("/numbering-series", numberingHandler.ListSeries)
+			admin.POST("/numbering-series", numberingHandler.CreateSeries)
+			admin.PUT("/numbering-series/:id", numberingHandler.UpdateSeries)
+			admin.DELETE("/numbering-series/:id", numberingHandler.DeleteSeries)
+			admin.POST("/exchange-rates", currencyHandler.SetExchangeRate)
+			admin.POST("/exchange-rates/import", currencyHandler.ImportExchangeRates)
+			admin.PUT("/exchange-rates/settings", currencyHandler.UpdateSettings)
+			admin.DELETE("/exchange-rates/:id", currencyHandler.DeleteExchangeRate)
+			admin.POST("/promotions", promotionHandler.CreatePromotion)
+			admin.PUT("/promotions/:id", promotionHandler.UpdatePromotion)
+			admin.DELETE("/promotions/:id", promotionHandler.DeletePromotion)
+			admin.POST("/stock/bulk", productBulkHandler.Apply)
+			admin.GET("/audit-log", auditHandler.ListAuditLog)
+			admin.POST("/stock/adjustment-reasons", adjustmentHandler.CreateReason)
+			admin.PUT("/stock/adjustment-reasons/:id", adjustmentHandler.UpdateReason)
+			admin.PUT("/stock/adjustments/settings", adjustmentHandler.UpdateSettings)
+			admin.PUT("/invoices/discounts/settings", invoiceHandler.UpdateDiscountSettings)
+			admin.PUT("/stock/costing", stockHandler.UpdateCostingMethod)
+			admin.POST("/stock/adjustments/:id/approve", adjustmentHandler.ApproveAdjustment)
+			admin.POST("/stock/adjustments/:id/reject", adjustmentHandler.RejectAdjustment)
+			admin.POST("/stock/barcodes/generate", barcodeHandler.GenerateBarcodes)
+			admin.POST("/price-lists", priceListHandler.CreatePriceList)
+			admin.PUT("/price-lists/:id", priceListHandler.UpdatePriceList)
+			admin.PUT("/price-lists/:id/items", priceListHandler.SetItem)
+			admin.DELETE("/price-lists/:id/items/:itemId", priceListHandler.DeleteItem)
 			log.Println("✅ Ruta admin POST /api/users registrada correctamente")
 		}
 
 		// Dashboard
 		protected.GET("/dashboard/metrics", dashboardHandler.GetMetrics)
 		protected.GET("/dashboard/reports", dashboardHandler.GetReports)
+		protected.GET("/dashboard/margins", dashboardHandler.GetMargins)
+		protected.GET("/dashboard/discounts", dashboardHandler.GetDiscounts)
 
 		// Stock
 		protected.GET("/stock", stockHandler.ListProducts)
 		protected.POST("/stock", stockHandler.CreateProduct)
 		protected.PUT("/stock/:id", stockHandler.UpdateProduct)
 		protected.DELETE("/stock/:id", stockHandler.DeleteProduct)
+		protected.POST("/stock/:id/restore", stockHandler.RestoreProduct)
 		protected.POST("/stock/upload", stockHandler.UploadProducts)
+		protected.GET("/stock/lots/expiring", stockHandler.ListExpiringLots)
+		protected.GET("/stock/:id/lots", stockHandler.ListProductLots)
+		protected.GET("/stock/serials/:serial", stockHandler.LookupSerial)
+		protected.GET("/stock/:id/serials", stockHandler.ListProductSerials)
+		protected.GET("/stock/valuation", stockHandler.GetValuation)
+
+		// Product images and attachments
+		protected.GET("/stock/:id/files", productFileHandler.ListFiles)
+		protected.POST("/stock/:id/files", productFileHandler.UploadFile)
+		protected.PUT("/stock/:id/images/order", productFileHandler.ReorderImages)
+		protected.DELETE("/stock/:id/files/:fileId", productFileHandler.DeleteFile)
+
+		// Barcodes and labels
+		protected.GET("/stock/barcode/:code", barcodeHandler.LookupBarcode)
+		protected.GET("/stock/:id/barcodes", barcodeHandler.ListBarcodes)
+		protected.POST("/stock/:id/barcodes", barcodeHandler.AddBarcode)
+		protected.DELETE("/stock/:id/barcodes/:barcodeId", barcodeHandler.DeleteBarcode)
+		protected.POST("/stock/labels", barcodeHandler.PrintLabels)
+
+		// Units of measure and packs
+		protected.GET("/stock/:id/units", productUnitHandler.ListUnits)
+		protected.POST("/stock/:id/units", productUnitHandler.CreateUnit)
+		protected.PUT("/stock/:id/units/:unitId", productUnitHandler.UpdateUnit)
+		protected.DELETE("/stock/:id/units/:unitId", productUnitHandler.DeleteUnit)
+
+		// Bundles and kit assembly
+		protected.GET("/stock/assemblies", bundleHandler.ListAssemblies)
+		protected.GET("/stock/:id/bundle", bundleHandler.GetBundle)
+		protected.PUT("/stock/:id/bundle", bundleHandler.SetComponents)
+		protected.POST("/stock/:id/assemblies", bundleHandler.Assemble)
+
+		// Bulk product operations
+		protected.POST("/stock/bulk/preview", productBulkHandler.Preview)
+
+		// Product prices
+		protected.GET("/stock/prices", priceHandler.GetPricesAsOf)
+		protected.DELETE("/stock/prices/:priceId", priceHandler.CancelScheduledPrice)
+		protected.GET("/stock/:id/prices", priceHandler.ListProductPrices)
+		protected.POST("/stock/:id/prices", priceHandler.ChangeProductPrice)
+
+		// Stock adjustments
+		protected.GET("/stock/adjustment-reasons", adjustmentHandler.ListReasons)
+		protected.GET("/stock/adjustments", adjustmentHandler.ListAdjustments)
+		protected.POST("/stock/adjustments", adjustmentHandler.CreateAdjustment)
+		protected.GET("/stock/adjustments/shrinkage", adjustmentHandler.GetShrinkageReport)
 
 		// Invoices
 		protected.POST("/invoices", invoiceHandler.CreateInvoice)
+		protected.POST("/invoices/preview", invoiceHandler.PreviewInvoice)
 		protected.GET("/invoices", invoiceHandler.ListInvoices)
 		protected.GET("/invoices/:id", invoiceHandler.GetInvoice)
+		protected.GET("/invoices/:id/pdf", invoiceHandler.PrintInvoice)
+		protected.POST("/invoices/:id/pay", invoiceHandler.PayInvoice)
+		protected.POST("/invoices/:id/cancel", invoiceHandler.CancelInvoice)
+		protected.POST("/invoices/:id/void", invoiceHandler.VoidInvoice)
+		protected.POST("/invoices/:id/einvoice", einvoiceHandler.IssueInvoice)
+		protected.POST("/invoices/:id/credit-notes", einvoiceHandler.IssueCreditNote)
 		protected.GET("/invoices/products/search", invoiceHandler.SearchProducts)
 
+		// Price lists
+		protected.GET("/price-lists", priceListHandler.ListPriceLists)
+		protected.GET("/price-lists/:id", priceListHandler.GetPriceList)
+
 		// Suppliers
 		protected.POST("/suppliers", supplierHandler.CreateSupplier)
 		protected.GET("/suppliers", supplierHandler.ListSuppliers)
 		protected.PUT("/suppliers/:id", supplierHandler.UpdateSupplier)
+		protected.DELETE("/suppliers/:id", supplierHandler.DeleteSupplier)
+		protected.POST("/suppliers/:id/restore", supplierHandler.RestoreSupplier)
+
+		// Customers
+		protected.POST("/customers", customerHandler.CreateCustomer)
+		protected.GET("/customers", customerHandler.ListCustomers)
+		protected.GET("/customers/:id", customerHandler.GetCustomer)
+		protected.PUT("/customers/:id", customerHandler.UpdateCustomer)
+		protected.DELETE("/customers/:id", customerHandler.DeleteCustomer)
+		protected.POST("/customers/:id/restore", customerHandler.RestoreCustomer)
+		protected.GET("/customers/:id/invoices", customerHandler.ListCustomerInvoices)
+
+		// Taxes
+		protected.GET("/taxes", taxHandler.ListTaxRates)
+
+		// Tenant profile
+		protected.GET("/tenant/profile", tenantHandler.GetProfile)
+
+		// Electronic invoicing
+		protected.GET("/einvoice/documents", einvoiceHandler.ListDocuments)
+		protected.GET("/einvoice/documents/:id", einvoiceHandler.GetDocument)
+		protected.POST("/einvoice/documents/:id/refresh", einvoiceHandler.RefreshStatus)
+
+		// Exchange rates
+		protected.GET("/exchange-rates", currencyHandler.ListExchangeRates)
+
+		// Promotions
+		protected.GET("/promotions", promotionHandler.ListPromotions)
 
 		// Purchases
 		protected.POST("/purchases", purchaseHandler.CreatePurchase)
+		protected.GET("/purchases/suggestions", purchaseHandler.GetReorderSuggestions)
+		protected.POST("/purchases/suggestions/draft", purchaseHandler.CreateDraftFromSuggestion)
+		protected.POST("/purchases/:id/payments", purchaseHandler.RegisterPayment)
+		protected.GET("/purchases/:id/pdf", purchaseHandler.PrintPurchase)
+
+		// Inventory counts
+		protected.POST("/inventory-counts", inventoryHandler.OpenCount)
+		protected.GET("/inventory-counts", inventoryHandler.ListCounts)
+		protected.GET("/inventory-counts/:id", inventoryHandler.GetCount)
+		protected.POST("/inventory-counts/:id/entries", inventoryHandler.RecordEntries)
+		protected.POST("/inventory-counts/:id/post", inventoryHandler.PostCount)
+		protected.POST("/inventory-counts/:id/cancel", inventoryHandler.CancelCount)
 	}
 
 	// Log de todas las rutas registradas para debugging
@@ -157,18 +656,131 @@ func main() {
 	log.Println("  - POST /api/auth/login (pública)")
 	log.Println("  - GET /api/auth/me (protegida)")
 	log.Println("  - POST /api/users (admin)")
+	log.Println("  - GET /api/users (admin)")
+	log.Println("  - DELETE /api/users/:id (admin)")
+	log.Println("  - POST /api/users/:id/restore (admin)")
+	log.Println("  - DELETE /api/users/:id/purge (admin)")
 	log.Println("  - GET /api/dashboard/metrics (protegida)")
 	log.Println("  - GET /api/dashboard/reports (protegida)")
+	log.Println("  - GET /api/dashboard/margins (protegida)")
+	log.Println("  - GET /api/dashboard/discounts (protegida)")
 	log.Println("  - GET /api/stock (protegida)")
 	log.Println("  - POST /api/stock (protegida)")
+	log.Println("  - DELETE /api/stock/:id (protegida)")
+	log.Println("  - POST /api/stock/:id/restore (protegida)")
+	log.Println("  - DELETE /api/stock/:id/purge (admin)")
+	log.Println("  - GET /api/stock/lots/expiring (protegida)")
+	log.Println("  - GET /api/stock/:id/lots (protegida)")
+	log.Println("  - GET /api/stock/serials/:serial (protegida)")
+	log.Println("  - GET /api/stock/:id/serials (protegida)")
+	log.Println("  - GET /api/stock/valuation (protegida)")
+	log.Println("  - PUT /api/stock/costing (admin)")
+	log.Println("  - GET /api/stock/:id/files (protegida)")
+	log.Println("  - POST /api/stock/:id/files (protegida)")
+	log.Println("  - PUT /api/stock/:id/images/order (protegida)")
+	log.Println("  - DELETE /api/stock/:id/files/:fileId (protegida)")
+	log.Println("  - GET /api/files/*key (pública, URL firmada)")
+	log.Println("  - GET /api/stock/barcode/:code (protegida)")
+	log.Println("  - GET /api/stock/:id/barcodes (protegida)")
+	log.Println("  - POST /api/stock/:id/barcodes (protegida)")
+	log.Println("  - DELETE /api/stock/:id/barcodes/:barcodeId (protegida)")
+	log.Println("  - POST /api/stock/barcodes/generate (admin)")
+	log.Println("  - POST /api/stock/labels (protegida)")
+	log.Println("  - GET /api/stock/:id/units (protegida)")
+	log.Println("  - POST /api/stock/:id/units (protegida)")
+	log.Println("  - PUT /api/stock/:id/units/:unitId (protegida)")
+	log.Println("  - DELETE /api/stock/:id/units/:unitId (protegida)")
+	log.Println("  - GET /api/stock/assemblies (protegida)")
+	log.Println("  - GET /api/stock/:id/bundle (protegida)")
+	log.Println("  - PUT /api/stock/:id/bundle (protegida)")
+	log.Println("  - POST /api/stock/:id/assemblies (protegida)")
+	log.Println("  - POST /api/stock/bulk/preview (protegida)")
+	log.Println("  - POST /api/stock/bulk (admin)")
+	log.Println("  - GET /api/audit-log (admin)")
+	log.Println("  - GET /api/stock/prices (protegida)")
+	log.Println("  - DELETE /api/stock/prices/:priceId (protegida)")
+	log.Println("  - GET /api/stock/:id/prices (protegida)")
+	log.Println("  - POST /api/stock/:id/prices (protegida)")
+	log.Println("  - GET /api/stock/adjustment-reasons (protegida)")
+	log.Println("  - POST /api/stock/adjustment-reasons (admin)")
+	log.Println("  - PUT /api/stock/adjustment-reasons/:id (admin)")
+	log.Println("  - GET /api/stock/adjustments (protegida)")
+	log.Println("  - POST /api/stock/adjustments (protegida)")
+	log.Println("  - GET /api/stock/adjustments/shrinkage (protegida)")
+	log.Println("  - PUT /api/stock/adjustments/settings (admin)")
+	log.Println("  - POST /api/stock/adjustments/:id/approve (admin)")
+	log.Println("  - POST /api/stock/adjustments/:id/reject (admin)")
 	log.Println("  - POST /api/invoices (protegida)")
+	log.Println("  - POST /api/invoices/preview (protegida)")
 	log.Println("  - GET /api/invoices (protegida)")
 	log.Println("  - GET /api/invoices/:id (protegida)")
+	log.Println("  - GET /api/invoices/:id/pdf (protegida)")
+	log.Println("  - POST /api/invoices/:id/pay (protegida)")
+	log.Println("  - POST /api/invoices/:id/cancel (protegida)")
+	log.Println("  - POST /api/invoices/:id/void (protegida)")
+	log.Println("  - PUT /api/invoices/discounts/settings (admin)")
 	log.Println("  - GET /api/invoices/products/search (protegida)")
+	log.Println("  - GET /api/price-lists (protegida)")
+	log.Println("  - GET /api/price-lists/:id (protegida)")
+	log.Println("  - POST /api/price-lists (admin)")
+	log.Println("  - PUT /api/price-lists/:id (admin)")
+	log.Println("  - PUT /api/price-lists/:id/items (admin)")
+	log.Println("  - DELETE /api/price-lists/:id/items/:itemId (admin)")
 	log.Println("  - POST /api/suppliers (protegida)")
 	log.Println("  - GET /api/suppliers (protegida)")
 	log.Println("  - PUT /api/suppliers/:id (protegida)")
+	log.Println("  - DELETE /api/suppliers/:id (protegida)")
+	log.Println("  - POST /api/suppliers/:id/restore (protegida)")
+	log.Println("  - DELETE /api/suppliers/:id/purge (admin)")
+	log.Println("  - POST /api/customers (protegida)")
+	log.Println("  - GET /api/customers (protegida)")
+	log.Println("  - GET /api/customers/:id (protegida)")
+	log.Println("  - PUT /api/customers/:id (protegida)")
+	log.Println("  - DELETE /api/customers/:id (protegida)")
+	log.Println("  - POST /api/customers/:id/restore (protegida)")
+	log.Println("  - GET /api/customers/:id/invoices (protegida)")
+	log.Println("  - DELETE /api/customers/:id/purge (admin)")
+	log.Println("  - GET /api/taxes (protegida)")
+	log.Println("  - POST /api/taxes (admin)")
+	log.Println("  - PUT /api/taxes/:id (admin)")
+	log.Println("  - DELETE /api/taxes/:id (admin)")
+	log.Println("  - PUT /api/taxes/settings (admin)")
+	log.Println("  - GET /api/tenant/profile (protegida)")
+	log.Println("  - PUT /api/tenant/profile (admin)")
+	log.Println("  - PUT /api/tenant/logo (admin)")
+	log.Println("  - DELETE /api/tenant/logo (admin)")
+	log.Println("  - GET /api/einvoice/settings (admin)")
+	log.Println("  - PUT /api/einvoice/settings (admin)")
+	log.Println("  - PUT /api/einvoice/certificate (admin)")
+	log.Println("  - POST /api/invoices/:id/einvoice (protegida)")
+	log.Println("  - POST /api/invoices/:id/credit-notes (protegida)")
+	log.Println("  - GET /api/einvoice/documents (protegida)")
+	log.Println("  - GET /api/einvoice/documents/:id (protegida)")
+	log.Println("  - POST /api/einvoice/documents/:id/refresh (protegida)")
+	log.Println("  - GET /api/numbering-series (admin)")
+	log.Println("  - POST /api/numbering-series (admin)")
+	log.Println("  - PUT /api/numbering-series/:id (admin)")
+	log.Println("  - DELETE /api/numbering-series/:id (admin)")
+	log.Println("  - GET /api/exchange-rates (protegida)")
+	log.Println("  - POST /api/exchange-rates (admin)")
+	log.Println("  - POST /api/exchange-rates/import (admin)")
+	log.Println("  - PUT /api/exchange-rates/settings (admin)")
+	log.Println("  - DELETE /api/exchange-rates/:id (admin)")
+	log.Println("  - GET /api/promotions (protegida)")
+	log.Println("  - POST /api/promotions (admin)")
+	log.Println("  - PUT /api/promotions/:id (admin)")
+	log.Println("  - DELETE /api/promotions/:id (admin)")
 	log.Println("  - POST /api/purchases (protegida)")
+	log.Println("  - GET /api/purchases/suggestions (protegida)")
+	log.Println("  - POST /api/purchases/suggestions/draft (protegida)")
+	log.Println("  - POST /api/purchases/:id/payments (protegida)")
+	log.Println("  - GET /api/purchases/:id/pdf (protegida)")
+	log.Println("  - POST /api/inventory-counts (protegida)")
+	log.Println("  - GET /api/inventory-counts (protegida)")
+	log.Println("  - GET /api/inventory-counts/:id (protegida)")
+	log.Println("  - POST /api/inventory-counts/:id/entries (protegida)")
+	log.Println("  - POST /api/inventory-counts/:id/post (protegida)")
+	log.Println("  - POST /api/inventory-counts/:id/cancel (protegida)")
 	
 	log.Printf("🚀 Server starting on port %s", cfg.Server.Port)
 	if err := r.Run(":" + cfg.Server.Port); err != nil {
@@ -0,0 +1,131 @@
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Simbologías soportadas
+const (
+	EAN13   = "ean13"
+	EAN8    = "ean8"
+	UPCA    = "upca"
+	Code128 = "code128"
+)
+
+var ErrInvalidCheckDigit = errors.New("dígito verificador inválido")
+
+// Detect deduce la simbología de un código: los numéricos de 8, 12 y 13 dígitos son EAN-8,
+// UPC-A y EAN-13; el resto se imprime como Code128
+func Detect(code string) string {
+	if isDigits(code) {
+		switch len(code) {
+		case 8:
+			return EAN8
+		case 12:
+			return UPCA
+		case 13:
+			return EAN13
+		}
+	}
+	return Code128
+}
+
+// Validate verifica el código para la simbología indicada, incluido el dígito verificador
+func Validate(code, symbology string) error {
+	switch symbology {
+	case EAN13, EAN8, UPCA:
+		length := map[string]int{EAN13: 13, EAN8: 8, UPCA: 12}[symbology]
+		if len(code) != length || !isDigits(code) {
+			return fmt.Errorf("un código %s debe tener %d dígitos", strings.ToUpper(symbology), length)
+		}
+		if CheckDigit(code[:length-1]) != code[length-1] {
+			return ErrInvalidCheckDigit
+		}
+		return nil
+	case Code128:
+		if code == "" || len(code) > 48 {
+			return fmt.Errorf("un código Code128 debe tener entre 1 y 48 caracteres")
+		}
+		for i := 0; i < len(code); i++ {
+			if code[i] < 32 || code[i] > 126 {
+				return fmt.Errorf("el código contiene caracteres no imprimibles")
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("simbología %q no soportada", symbology)
+	}
+}
+
+// CheckDigit calcula el dígito verificador GS1 (EAN/UPC) de los dígitos de datos
+func CheckDigit(data string) byte {
+	sum := 0
+	weight := 3
+	for i := len(data) - 1; i >= 0; i-- {
+		sum += int(data[i]-'0') * weight
+		weight = 4 - weight
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// Internal arma el código EAN-13 interno de un producto. Usa el prefijo 2, que GS1 reserva para
+// uso dentro de la tienda, así nunca choca con un código de fabricante.
+func Internal(productID int) string {
+	data := fmt.Sprintf("2%011d", productID)
+	return data + string(CheckDigit(data))
+}
+
+// Alternatives devuelve las formas equivalentes de un código escaneado: los lectores pueden
+// entregar un UPC-A con o sin el 0 inicial de su forma EAN-13
+func Alternatives(code string) []string {
+	codes := []string{code}
+	if isDigits(code) {
+		if len(code) == 12 {
+			codes = append(codes, "0"+code)
+		}
+		if len(code) == 13 && code[0] == '0' {
+			codes = append(codes, code[1:])
+		}
+	}
+	return codes
+}
+
+// Encode devuelve los módulos del código (true = barra), sin zonas de silencio
+func Encode(code, symbology string) ([]bool, error) {
+	if err := Validate(code, symbology); err != nil {
+		return nil, err
+	}
+
+	switch symbology {
+	case EAN13:
+		return encodeEAN13(code), nil
+	case UPCA:
+		return encodeEAN13("0" + code), nil
+	case EAN8:
+		return encodeEAN8(code), nil
+	default:
+		return encodeCode128(code), nil
+	}
+}
+
+func isDigits(code string) bool {
+	if code == "" {
+		return false
+	}
+	for i := 0; i < len(code); i++ {
+		if code[i] < '0' || code[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// appendPattern agrega un patrón escrito como "0101..." a los módulos
+func appendPattern(modules []bool, pattern string) []bool {
+	for i := 0; i < len(pattern); i++ {
+		modules = append(modules, pattern[i] == '1')
+	}
+	return modules
+}
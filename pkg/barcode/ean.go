@@ -0,0 +1,61 @@
+package barcode
+
+var (
+	eanLeft = [10]string{
+		"0001101", "0011001", "0010011", "0111101", "0100011",
+		"0110001", "0101111", "0111011", "0110111", "0001011",
+	}
+	eanLeftEven = [10]string{
+		"0100111", "0110011", "0011011", "0100001", "0011101",
+		"0111001", "0000101", "0010001", "0001001", "0010111",
+	}
+	eanRight = [10]string{
+		"1110010", "1100110", "1101100", "1000010", "1011100",
+		"1001110", "1010000", "1000100", "1001000", "1110100",
+	}
+	// ean13Parity indica, según el primer dígito, qué dígitos de la mitad izquierda usan paridad par
+	ean13Parity = [10]string{
+		"LLLLLL", "LLGLGG", "LLGGLG", "LLGGGL", "LGLLGG",
+		"LGGLLG", "LGGGLL", "LGLGLG", "LGLGGL", "LGGLGL",
+	}
+)
+
+const (
+	eanGuard  = "101"
+	eanCenter = "01010"
+)
+
+func encodeEAN13(code string) []bool {
+	modules := make([]bool, 0, 95)
+	modules = appendPattern(modules, eanGuard)
+
+	parity := ean13Parity[code[0]-'0']
+	for i := 1; i <= 6; i++ {
+		digit := code[i] - '0'
+		if parity[i-1] == 'G' {
+			modules = appendPattern(modules, eanLeftEven[digit])
+		} else {
+			modules = appendPattern(modules, eanLeft[digit])
+		}
+	}
+
+	modules = appendPattern(modules, eanCenter)
+	for i := 7; i <= 12; i++ {
+		modules = appendPattern(modules, eanRight[code[i]-'0'])
+	}
+
+	return appendPattern(modules, eanGuard)
+}
+
+func encodeEAN8(code string) []bool {
+	modules := make([]bool, 0, 67)
+	modules = appendPattern(modules, eanGuard)
+	for i := 0; i < 4; i++ {
+		modules = appendPattern(modules, eanLeft[code[i]-'0'])
+	}
+	modules = appendPattern(modules, eanCenter)
+	for i := 4; i < 8; i++ {
+		modules = appendPattern(modules, eanRight[code[i]-'0'])
+	}
+	return appendPattern(modules, eanGuard)
+}
@@ -8,6 +8,7 @@ var (
 	ErrForbidden     = errors.New("forbidden")
 	ErrInvalidInput  = errors.New("invalid input")
 	ErrAlreadyExists = errors.New("resource already exists")
+	ErrInUse         = errors.New("resource is referenced by other records")
+	ErrInvalidState  = errors.New("invalid state transition")
 	ErrInternal      = errors.New("internal server error")
 )
-
@@ -0,0 +1,104 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+
+	// Formatos que se aceptan al subir imágenes
+	_ "image/gif"
+	_ "image/png"
+)
+
+// Decode lee una imagen JPEG, PNG o GIF y devuelve también su formato
+func Decode(r io.Reader) (image.Image, string, error) {
+	return image.Decode(r)
+}
+
+// Thumbnail reduce la imagen para que su lado mayor no supere maxSize, promediando los píxeles
+// de origen que caen en cada píxel de destino. Las imágenes más chicas se devuelven tal cual.
+func Thumbnail(src image.Image, maxSize int) image.Image {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxSize && height <= maxSize {
+		return src
+	}
+
+	dstWidth, dstHeight := maxSize, maxSize
+	if width > height {
+		dstHeight = height * maxSize / width
+	} else {
+		dstWidth = width * maxSize / height
+	}
+	if dstWidth < 1 {
+		dstWidth = 1
+	}
+	if dstHeight < 1 {
+		dstHeight = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < dstHeight; y++ {
+		y0 := bounds.Min.Y + y*height/dstHeight
+		y1 := bounds.Min.Y + (y+1)*height/dstHeight
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < dstWidth; x++ {
+			x0 := bounds.Min.X + x*width/dstWidth
+			x1 := bounds.Min.X + (x+1)*width/dstWidth
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r += uint64(cr)
+					g += uint64(cg)
+					b += uint64(cb)
+					a += uint64(ca)
+					n++
+				}
+			}
+
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(b / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+
+	return dst
+}
+
+// EncodeJPEG codifica la imagen como JPEG sobre fondo blanco, para que las transparencias de
+// PNG y GIF no queden negras
+func EncodeJPEG(img image.Image, quality int) ([]byte, error) {
+	bounds := img.Bounds()
+	flattened := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			r, g, b, a := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			// Los valores vienen premultiplicados por alfa: se suma el blanco que falta
+			white := 0xffff - a
+			flattened.Set(x, y, color.RGBA64{
+				R: uint16(r + white),
+				G: uint16(g + white),
+				B: uint16(b + white),
+				A: 0xffff,
+			})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, flattened, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
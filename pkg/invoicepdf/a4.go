@@ -0,0 +1,240 @@
+package invoicepdf
+
+import (
+	"fmt"
+	"strings"
+
+	"Veritasbackend/pkg/pdf"
+)
+
+// Hoja A4; las medidas están en milímetros
+const (
+	a4Width      = 210.0
+	a4Height     = 297.0
+	a4Margin     = 15.0
+	a4Right      = a4Width - a4Margin
+	a4Bottom     = a4Height - 20
+	a4BodySize   = 9.0
+	a4SmallSize  = 8.0
+	a4RowHeight  = 5.5
+	a4QRSize     = 32.0
+	a4LogoWidth  = 40.0
+	a4LogoHeight = 22.0
+)
+
+// Columnas de la tabla de líneas: borde derecho de cada importe
+const (
+	colQuantity = 118.0
+	colPrice    = 143.0
+	colDiscount = 166.0
+	colTotal    = a4Right - 2
+)
+
+type a4Writer struct {
+	doc     *pdf.Document
+	content Document
+	modules [][]bool
+	logo    *pdf.Image
+	pages   []*sheet
+	sheet   *sheet
+	y       float64
+}
+
+func renderA4(content Document, modules [][]bool) []byte {
+	w := &a4Writer{doc: pdf.New(), content: content, modules: modules}
+	if content.Logo != nil {
+		w.logo = w.doc.AddImage(content.Logo)
+	}
+
+	w.newPage()
+	w.header()
+	w.party()
+	w.tableHeader()
+	for _, line := range content.Lines {
+		if w.y+a4RowHeight > a4Bottom {
+			w.newPage()
+			w.tableHeader()
+		}
+		w.row(line)
+	}
+	w.sheet.line(a4Margin, w.y, a4Right, w.y)
+	w.y += 4
+
+	w.summary()
+	w.footer()
+
+	return w.doc.Bytes()
+}
+
+func (w *a4Writer) newPage() {
+	w.sheet = &sheet{page: w.doc.AddPage(pdf.A4Width, pdf.A4Height), height: a4Height}
+	w.pages = append(w.pages, w.sheet)
+	w.y = a4Margin
+
+	// Las páginas siguientes repiten el título y el número del documento
+	if len(w.pages) > 1 {
+		w.sheet.text(a4Margin, w.y+4, a4BodySize, true, strings.TrimSpace(w.content.Title+" "+w.content.Number))
+		w.y += 10
+	}
+}
+
+// header dibuja el logo y los datos del emisor a la izquierda, y el título, número, fecha y
+// estado a la derecha
+func (w *a4Writer) header() {
+	c := w.content
+	textX := a4Margin
+	bottom := w.y
+
+	if w.logo != nil {
+		width, height := fitImage(w.logo, a4LogoWidth, a4LogoHeight)
+		w.sheet.image(w.logo, a4Margin, w.y, width, height)
+		textX += width + 5
+		bottom = w.y + height
+	}
+
+	y := w.y + 5
+	w.sheet.text(textX, y, 12, true, truncate(c.Issuer.Name, 95, 12, true))
+	for _, line := range partyLines(c.Issuer) {
+		y += lineHeight(a4SmallSize)
+		w.sheet.text(textX, y, a4SmallSize, false, truncate(line, 95, a4SmallSize, false))
+	}
+	if y > bottom {
+		bottom = y
+	}
+
+	y = w.y + 6
+	w.sheet.textRight(a4Right, y, 14, true, c.Title)
+	if c.Number != "" {
+		y += lineHeight(11) + 1
+		w.sheet.textRight(a4Right, y, 11, true, c.Number)
+	}
+	if c.Date != "" {
+		y += lineHeight(a4BodySize)
+		w.sheet.textRight(a4Right, y, a4BodySize, false, c.Date)
+	}
+	if c.Status != "" {
+		y += lineHeight(a4BodySize)
+		w.sheet.textRight(a4Right, y, a4BodySize, true, c.Status)
+	}
+	if y > bottom {
+		bottom = y
+	}
+
+	w.y = bottom + 6
+}
+
+// party dibuja el recuadro con los datos del cliente o proveedor
+func (w *a4Writer) party() {
+	c := w.content
+	if c.Party.Name == "" {
+		return
+	}
+
+	details := strings.Join(partyLines(c.Party), "  ·  ")
+	height := 11.0
+	if details != "" {
+		height += lineHeight(a4SmallSize)
+	}
+
+	w.sheet.rect(a4Margin, w.y, a4Right-a4Margin, height, 0.94)
+	w.sheet.text(a4Margin+3, w.y+5, a4SmallSize, true, c.PartyLabel)
+	w.sheet.text(a4Margin+3+textWidth(c.PartyLabel, a4SmallSize, true)+3, w.y+5, a4BodySize, true, truncate(c.Party.Name, 140, a4BodySize, true))
+	if details != "" {
+		w.sheet.text(a4Margin+3, w.y+5+lineHeight(a4SmallSize)+1, a4SmallSize, false, truncate(details, a4Right-a4Margin-6, a4SmallSize, false))
+	}
+
+	w.y += height + 6
+}
+
+func (w *a4Writer) tableHeader() {
+	w.sheet.rect(a4Margin, w.y, a4Right-a4Margin, 7, 0.88)
+	y := w.y + 4.8
+	w.sheet.text(a4Margin+2, y, a4SmallSize, true, "Descripción")
+	w.sheet.textRight(colQuantity, y, a4SmallSize, true, "Cant.")
+	w.sheet.textRight(colPrice, y, a4SmallSize, true, "Precio")
+	w.sheet.textRight(colDiscount, y, a4SmallSize, true, "Desc.")
+	w.sheet.textRight(colTotal, y, a4SmallSize, true, "Total")
+	w.y += 7 + 1
+}
+
+func (w *a4Writer) row(line Line) {
+	y := w.y + 4
+	descriptionWidth := colQuantity - 22 - a4Margin
+	w.sheet.text(a4Margin+2, y, a4BodySize, false, truncate(line.Description, descriptionWidth, a4BodySize, false))
+	w.sheet.textRight(colQuantity, y, a4BodySize, false, line.Quantity)
+	w.sheet.textRight(colPrice, y, a4BodySize, false, line.UnitPrice)
+	w.sheet.textRight(colDiscount, y, a4BodySize, false, line.Discount)
+	w.sheet.textRight(colTotal, y, a4BodySize, false, line.Total)
+	w.y += a4RowHeight
+}
+
+// summary dibuja los impuestos a la izquierda, los totales a la derecha y debajo el código QR
+// con las notas; si no caben en la página pasa a una nueva
+func (w *a4Writer) summary() {
+	c := w.content
+	taxesHeight := 0.0
+	if len(c.Taxes) > 0 {
+		taxesHeight = float64(len(c.Taxes)+1) * a4RowHeight
+	}
+	totalsHeight := float64(len(c.Totals)) * a4RowHeight
+	blockHeight := taxesHeight
+	if totalsHeight > blockHeight {
+		blockHeight = totalsHeight
+	}
+	qrHeight := 0.0
+	if len(w.modules) > 0 || len(c.Notes) > 0 {
+		qrHeight = a4QRSize + 4
+	}
+	if w.y+blockHeight+qrHeight > a4Bottom {
+		w.newPage()
+	}
+
+	top := w.y
+	if len(c.Taxes) > 0 {
+		y := top + 4
+		w.sheet.text(a4Margin, y, a4SmallSize, true, "Impuesto")
+		w.sheet.textRight(a4Margin+62, y, a4SmallSize, true, "Base")
+		w.sheet.textRight(a4Margin+90, y, a4SmallSize, true, "Valor")
+		for _, tax := range c.Taxes {
+			y += a4RowHeight
+			w.sheet.text(a4Margin, y, a4SmallSize, false, truncate(tax.Name, 36, a4SmallSize, false))
+			w.sheet.textRight(a4Margin+62, y, a4SmallSize, false, tax.Base)
+			w.sheet.textRight(a4Margin+90, y, a4SmallSize, false, tax.Amount)
+		}
+	}
+
+	y := top + 4
+	for _, total := range c.Totals {
+		size := a4BodySize
+		if total.Bold {
+			size = 11
+		}
+		w.sheet.textRight(colDiscount, y, size, total.Bold, total.Label)
+		w.sheet.textRight(colTotal, y, size, total.Bold, total.Value)
+		y += a4RowHeight
+	}
+
+	w.y = top + blockHeight + 4
+	if qrHeight == 0 {
+		return
+	}
+
+	notesX := a4Margin
+	if len(w.modules) > 0 {
+		w.sheet.qr(w.modules, a4Margin-2, w.y, a4QRSize)
+		notesX += a4QRSize + 2
+	}
+	y = w.y + 6
+	for _, note := range c.Notes {
+		w.sheet.text(notesX, y, a4SmallSize, false, truncate(note, a4Right-notesX, a4SmallSize, false))
+		y += lineHeight(a4SmallSize)
+	}
+	w.y += qrHeight
+}
+
+// footer numera las páginas una vez que se conoce el total
+func (w *a4Writer) footer() {
+	for i, page := range w.pages {
+		page.textRight(a4Right, a4Height-10, 7, false, fmt.Sprintf("Página %d de %d", i+1, len(w.pages)))
+	}
+}
@@ -0,0 +1,195 @@
+package invoicepdf
+
+import (
+	"fmt"
+	"image"
+
+	"Veritasbackend/pkg/pdf"
+	"Veritasbackend/pkg/qrcode"
+)
+
+// Formatos de impresión
+const (
+	LayoutA4      = "a4"
+	LayoutReceipt = "receipt"
+)
+
+// Party es el emisor o el receptor del documento; los campos vacíos no se imprimen
+type Party struct {
+	Name    string
+	TaxID   string
+	Address string
+	Phone   string
+	Email   string
+}
+
+// Line es una línea del documento con los importes ya formateados
+type Line struct {
+	Description string
+	Quantity    string
+	UnitPrice   string
+	Discount    string
+	Total       string
+}
+
+type Tax struct {
+	Name   string
+	Base   string
+	Amount string
+}
+
+type Total struct {
+	Label string
+	Value string
+	Bold  bool
+}
+
+// Document es el contenido de una factura o un recibo listo para imprimir
+type Document struct {
+	Title  string
+	Number string
+	Date   string
+	Status string
+	Issuer Party
+	// Logo es opcional; se escala para caber en el encabezado
+	Logo       image.Image
+	PartyLabel string
+	Party      Party
+	Lines      []Line
+	Taxes      []Tax
+	Totals     []Total
+	Notes      []string
+	// QR es el texto del código QR; vacío para no imprimirlo
+	QR string
+}
+
+// Render arma el PDF en hoja A4 o en rollo de 80 mm
+func Render(doc Document, layout string) ([]byte, error) {
+	var modules [][]bool
+	if doc.QR != "" {
+		var err error
+		modules, err = qrcode.Encode(doc.QR)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch layout {
+	case LayoutA4, "":
+		return renderA4(doc, modules), nil
+	case LayoutReceipt:
+		return renderReceipt(doc, modules), nil
+	default:
+		return nil, fmt.Errorf("formato %q no soportado: use a4 o receipt", layout)
+	}
+}
+
+// sheet dibuja en milímetros con el origen arriba a la izquierda; los tamaños de letra van en
+// puntos. Sin página solo mide, para calcular el largo del recibo antes de dibujarlo.
+type sheet struct {
+	page   *pdf.Page
+	height float64
+}
+
+func font(bold bool) pdf.Font {
+	if bold {
+		return pdf.HelveticaBold
+	}
+	return pdf.Helvetica
+}
+
+func textWidth(text string, size float64, bold bool) float64 {
+	return pdf.TextWidth(text, size, font(bold)) / pdf.MM
+}
+
+func truncate(text string, width, size float64, bold bool) string {
+	return pdf.Truncate(text, width*pdf.MM, size, font(bold))
+}
+
+// lineHeight es el alto de una línea de texto en milímetros
+func lineHeight(size float64) float64 {
+	return size * 1.35 / pdf.MM
+}
+
+func (s *sheet) text(x, y, size float64, bold bool, text string) {
+	if s.page != nil && text != "" {
+		s.page.Text(x*pdf.MM, (s.height-y)*pdf.MM, size, font(bold), text)
+	}
+}
+
+func (s *sheet) textRight(x, y, size float64, bold bool, text string) {
+	s.text(x-textWidth(text, size, bold), y, size, bold, text)
+}
+
+func (s *sheet) textCenter(x, y, size float64, bold bool, text string) {
+	s.text(x-textWidth(text, size, bold)/2, y, size, bold, text)
+}
+
+func (s *sheet) rect(x, y, width, height, gray float64) {
+	if s.page != nil {
+		s.page.SetGray(gray)
+		s.page.Rect(x*pdf.MM, (s.height-y-height)*pdf.MM, width*pdf.MM, height*pdf.MM)
+		s.page.SetGray(0)
+	}
+}
+
+func (s *sheet) line(x1, y1, x2, y2 float64) {
+	if s.page != nil {
+		s.page.Line(x1*pdf.MM, (s.height-y1)*pdf.MM, x2*pdf.MM, (s.height-y2)*pdf.MM, 0.5)
+	}
+}
+
+func (s *sheet) image(img *pdf.Image, x, y, width, height float64) {
+	if s.page != nil && img != nil {
+		s.page.DrawImage(img, x*pdf.MM, (s.height-y-height)*pdf.MM, width*pdf.MM, height*pdf.MM)
+	}
+}
+
+// qr dibuja el código en un cuadrado de size milímetros que incluye la zona de silencio; los
+// módulos oscuros contiguos de una fila se dibujan como un solo rectángulo
+func (s *sheet) qr(modules [][]bool, x, y, size float64) {
+	if s.page == nil || len(modules) == 0 {
+		return
+	}
+	module := size / float64(len(modules)+2*qrcode.QuietZone)
+	origin := float64(qrcode.QuietZone) * module
+	for row, dark := range modules {
+		for col := 0; col < len(dark); {
+			if !dark[col] {
+				col++
+				continue
+			}
+			start := col
+			for col < len(dark) && dark[col] {
+				col++
+			}
+			s.rect(x+origin+float64(start)*module, y+origin+float64(row)*module, float64(col-start)*module, module, 0)
+		}
+	}
+}
+
+// fitImage escala la imagen para que quepa en el rectángulo conservando su proporción
+func fitImage(img *pdf.Image, maxWidth, maxHeight float64) (float64, float64) {
+	w, h := img.Size()
+	if w == 0 || h == 0 {
+		return 0, 0
+	}
+	width := maxWidth
+	height := width * float64(h) / float64(w)
+	if height > maxHeight {
+		height = maxHeight
+		width = height * float64(w) / float64(h)
+	}
+	return width, height
+}
+
+// partyLines son los datos de contacto no vacíos de un emisor o receptor, después del nombre
+func partyLines(p Party) []string {
+	var lines []string
+	for _, value := range []string{p.TaxID, p.Address, p.Phone, p.Email} {
+		if value != "" {
+			lines = append(lines, value)
+		}
+	}
+	return lines
+}
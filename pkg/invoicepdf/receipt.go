@@ -0,0 +1,125 @@
+package invoicepdf
+
+import (
+	"strings"
+
+	"Veritasbackend/pkg/pdf"
+)
+
+// Rollo de 80 mm; el largo de la página se ajusta al contenido
+const (
+	receiptWidth      = 80.0
+	receiptMargin     = 4.0
+	receiptRight      = receiptWidth - receiptMargin
+	receiptCenter     = receiptWidth / 2
+	receiptSize       = 7.5
+	receiptQRSize     = 34.0
+	receiptLogoWidth  = 40.0
+	receiptLogoHeight = 20.0
+)
+
+func renderReceipt(content Document, modules [][]bool) []byte {
+	doc := pdf.New()
+	var logo *pdf.Image
+	if content.Logo != nil {
+		logo = doc.AddImage(content.Logo)
+	}
+
+	// La primera pasada solo mide el largo del recibo
+	height := drawReceipt(&sheet{}, content, modules, logo)
+	s := &sheet{page: doc.AddPage(receiptWidth*pdf.MM, height*pdf.MM), height: height}
+	drawReceipt(s, content, modules, logo)
+
+	return doc.Bytes()
+}
+
+// drawReceipt dibuja el recibo de arriba hacia abajo y devuelve el largo usado
+func drawReceipt(s *sheet, c Document, modules [][]bool, logo *pdf.Image) float64 {
+	width := receiptRight - receiptMargin
+	step := lineHeight(receiptSize)
+	y := receiptMargin
+
+	if logo != nil {
+		w, h := fitImage(logo, receiptLogoWidth, receiptLogoHeight)
+		s.image(logo, receiptCenter-w/2, y, w, h)
+		y += h + 2
+	}
+
+	y += lineHeight(10)
+	s.textCenter(receiptCenter, y, 10, true, truncate(c.Issuer.Name, width, 10, true))
+	for _, line := range partyLines(c.Issuer) {
+		y += step
+		s.textCenter(receiptCenter, y, receiptSize, false, truncate(line, width, receiptSize, false))
+	}
+
+	y += step + 2
+	s.textCenter(receiptCenter, y, 9, true, truncate(strings.TrimSpace(c.Title+" "+c.Number), width, 9, true))
+	for _, line := range []string{c.Date, c.Status} {
+		if line != "" {
+			y += step
+			s.textCenter(receiptCenter, y, receiptSize, false, line)
+		}
+	}
+
+	if c.Party.Name != "" {
+		y += step + 1
+		s.text(receiptMargin, y, receiptSize, true, truncate(c.PartyLabel+": "+c.Party.Name, width, receiptSize, true))
+		if c.Party.TaxID != "" {
+			y += step
+			s.text(receiptMargin, y, receiptSize, false, truncate(c.Party.TaxID, width, receiptSize, false))
+		}
+	}
+
+	y += 2
+	s.line(receiptMargin, y, receiptRight, y)
+	y += 1
+
+	for _, line := range c.Lines {
+		y += step
+		s.text(receiptMargin, y, receiptSize, false, truncate(line.Description, width, receiptSize, false))
+		y += step
+		s.text(receiptMargin+2, y, receiptSize, false, line.Quantity+" x "+line.UnitPrice)
+		s.textRight(receiptRight, y, receiptSize, false, line.Total)
+		if line.Discount != "" {
+			y += step
+			s.text(receiptMargin+2, y, receiptSize, false, "Descuento -"+line.Discount)
+		}
+	}
+
+	y += 2
+	s.line(receiptMargin, y, receiptRight, y)
+	y += 1
+
+	for _, tax := range c.Taxes {
+		y += step
+		s.text(receiptMargin, y, receiptSize, false, truncate(tax.Name, 26, receiptSize, false))
+		s.textRight(receiptMargin+50, y, receiptSize, false, tax.Base)
+		s.textRight(receiptRight, y, receiptSize, false, tax.Amount)
+	}
+	if len(c.Taxes) > 0 {
+		y += 1
+	}
+
+	for _, total := range c.Totals {
+		size := receiptSize
+		if total.Bold {
+			size = 9
+		}
+		y += lineHeight(size)
+		s.text(receiptMargin, y, size, total.Bold, total.Label)
+		s.textRight(receiptRight, y, size, total.Bold, total.Value)
+	}
+
+	if len(modules) > 0 {
+		y += 3
+		s.qr(modules, receiptCenter-receiptQRSize/2, y, receiptQRSize)
+		y += receiptQRSize
+	}
+
+	for _, note := range c.Notes {
+		y += step
+		s.textCenter(receiptCenter, y, receiptSize, false, truncate(note, width, receiptSize, false))
+	}
+
+	return y + receiptMargin + 2
+}
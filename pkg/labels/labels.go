@@ -0,0 +1,152 @@
+package labels
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+
+	"Veritasbackend/pkg/barcode"
+	"Veritasbackend/pkg/pdf"
+)
+
+// Formatos de salida
+const (
+	FormatPDF = "pdf"
+	FormatSVG = "svg"
+)
+
+// Hoja A4 de 3 x 8 etiquetas de 70 x 37 mm; las medidas están en milímetros
+const (
+	columns      = 3
+	rows         = 8
+	labelWidth   = 70.0
+	labelHeight  = 37.0
+	sheetWidth   = 210.0
+	sheetHeight  = 297.0
+	topMargin    = (sheetHeight - rows*labelHeight) / 2
+	padding      = 3.0
+	nameSize     = 3.0
+	priceSize    = 4.5
+	codeSize     = 2.5
+	barTop       = 14.0
+	barHeight    = 14.0
+	maxModule    = 0.33
+	quietModules = 10
+)
+
+// Label es el contenido de una etiqueta
+type Label struct {
+	Name      string
+	Price     string
+	Code      string
+	Symbology string
+}
+
+// canvas dibuja en coordenadas de hoja, en milímetros y con el origen arriba a la izquierda
+type canvas interface {
+	rect(x, y, width, height float64)
+	text(x, y, size float64, bold bool, text string)
+}
+
+// Render arma las hojas de etiquetas en PDF (una página por hoja) o en un único SVG continuo
+func Render(labels []Label, format string) ([]byte, string, error) {
+	encoded := make([][]bool, len(labels))
+	for i, label := range labels {
+		modules, err := barcode.Encode(label.Code, label.Symbology)
+		if err != nil {
+			return nil, "", fmt.Errorf("código %s: %w", label.Code, err)
+		}
+		encoded[i] = modules
+	}
+
+	switch format {
+	case FormatPDF:
+		doc := pdf.New()
+		var page *pdfCanvas
+		for i, label := range labels {
+			if i%(columns*rows) == 0 {
+				page = &pdfCanvas{page: doc.AddPage(pdf.A4Width, pdf.A4Height)}
+			}
+			slot := i % (columns * rows)
+			drawLabel(page, float64(slot%columns)*labelWidth, topMargin+float64(slot/columns)*labelHeight, label, encoded[i])
+		}
+		return doc.Bytes(), "application/pdf", nil
+	case FormatSVG:
+		totalRows := (len(labels) + columns - 1) / columns
+		height := float64(totalRows) * labelHeight
+		svg := &svgCanvas{}
+		fmt.Fprintf(&svg.buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%gmm" height="%gmm" viewBox="0 0 %g %g">`+"\n", sheetWidth, height, sheetWidth, height)
+		fmt.Fprintf(&svg.buf, `<rect width="%g" height="%g" fill="#fff"/>`+"\n", sheetWidth, height)
+		for i, label := range labels {
+			drawLabel(svg, float64(i%columns)*labelWidth, float64(i/columns)*labelHeight, label, encoded[i])
+		}
+		svg.buf.WriteString("</svg>\n")
+		return svg.buf.Bytes(), "image/svg+xml", nil
+	default:
+		return nil, "", fmt.Errorf("formato %q no soportado: use pdf o svg", format)
+	}
+}
+
+func drawLabel(c canvas, x, y float64, label Label, modules []bool) {
+	contentWidth := labelWidth - 2*padding
+
+	name := pdf.Truncate(label.Name, contentWidth, nameSize, pdf.HelveticaBold)
+	c.text(x+padding, y+padding+nameSize, nameSize, true, name)
+	c.text(x+padding, y+padding+nameSize+priceSize+1.5, priceSize, true, label.Price)
+
+	module := contentWidth / float64(len(modules)+2*quietModules)
+	if module > maxModule {
+		module = maxModule
+	}
+	barsWidth := module * float64(len(modules))
+	barX := x + (labelWidth-barsWidth)/2
+
+	// Las barras contiguas se dibujan como un solo rectángulo
+	for i := 0; i < len(modules); {
+		if !modules[i] {
+			i++
+			continue
+		}
+		start := i
+		for i < len(modules) && modules[i] {
+			i++
+		}
+		c.rect(barX+float64(start)*module, y+barTop, float64(i-start)*module, barHeight)
+	}
+
+	codeWidth := pdf.TextWidth(label.Code, codeSize, pdf.Helvetica)
+	c.text(x+(labelWidth-codeWidth)/2, y+barTop+barHeight+codeSize+0.5, codeSize, false, label.Code)
+}
+
+type pdfCanvas struct {
+	page *pdf.Page
+}
+
+func (c *pdfCanvas) rect(x, y, width, height float64) {
+	c.page.Rect(x*pdf.MM, pdf.A4Height-(y+height)*pdf.MM, width*pdf.MM, height*pdf.MM)
+}
+
+func (c *pdfCanvas) text(x, y, size float64, bold bool, text string) {
+	font := pdf.Helvetica
+	if bold {
+		font = pdf.HelveticaBold
+	}
+	c.page.Text(x*pdf.MM, pdf.A4Height-y*pdf.MM, size*pdf.MM, font, text)
+}
+
+type svgCanvas struct {
+	buf bytes.Buffer
+}
+
+func (c *svgCanvas) rect(x, y, width, height float64) {
+	fmt.Fprintf(&c.buf, `<rect x="%.3f" y="%.3f" width="%.3f" height="%.3f"/>`+"\n", x, y, width, height)
+}
+
+func (c *svgCanvas) text(x, y, size float64, bold bool, text string) {
+	weight := "normal"
+	if bold {
+		weight = "bold"
+	}
+	fmt.Fprintf(&c.buf, `<text x="%.3f" y="%.3f" font-family="Helvetica, Arial, sans-serif" font-size="%g" font-weight="%s">%s</text>`+"\n",
+		x, y, size, weight, html.EscapeString(text))
+}
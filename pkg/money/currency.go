@@ -0,0 +1,66 @@
+package money
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Currency es una moneda ISO 4217 y los decimales a los que se redondean sus totales
+type Currency struct {
+	Code     string
+	Decimals int
+}
+
+// Monedas conocidas. Las de cero decimales (CLP, JPY...) redondean los totales a la unidad.
+var currencies = map[string]Currency{
+	"COP": {Code: "COP", Decimals: 2},
+	"USD": {Code: "USD", Decimals: 2},
+	"EUR": {Code: "EUR", Decimals: 2},
+	"MXN": {Code: "MXN", Decimals: 2},
+	"PEN": {Code: "PEN", Decimals: 2},
+	"ARS": {Code: "ARS", Decimals: 2},
+	"BRL": {Code: "BRL", Decimals: 2},
+	"CLP": {Code: "CLP", Decimals: 0},
+	"PYG": {Code: "PYG", Decimals: 0},
+	"JPY": {Code: "JPY", Decimals: 0},
+}
+
+// Default es la moneda de los tenants que no configuraron otra
+var Default = currencies["COP"]
+
+// Lookup devuelve la moneda con el código indicado, sin distinguir mayúsculas
+func Lookup(code string) (Currency, bool) {
+	currency, ok := currencies[strings.ToUpper(strings.TrimSpace(code))]
+	return currency, ok
+}
+
+// Exchange convierte el importe a otra moneda con la tasa indicada (unidades de la moneda de
+// destino por cada unidad del importe) y lo redondea a los decimales de esa moneda
+func (a Amount) Exchange(rate float64, to Currency) Amount {
+	return a.Mul(rate).Round(to)
+}
+
+// Format escribe el importe redondeado a los decimales de la moneda, con separador de miles y
+// punto decimal: 1,234,567.50
+func (a Amount) Format(currency Currency) string {
+	text := strconv.FormatFloat(a.Round(currency).Float64(), 'f', currency.Decimals, 64)
+
+	sign := ""
+	if strings.HasPrefix(text, "-") {
+		sign, text = "-", text[1:]
+	}
+	whole, fraction := text, ""
+	if i := strings.IndexByte(text, '.'); i >= 0 {
+		whole, fraction = text[:i], text[i:]
+	}
+
+	var b strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(digit)
+	}
+
+	return sign + b.String() + fraction
+}
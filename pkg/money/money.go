@@ -0,0 +1,355 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimals es la precisión con la que se guardan los importes. Los totales se redondean a los
+// decimales de la moneda; los cuatro decimales dejan lugar a costos y precios unitarios
+// fraccionarios (un costo promedio, el precio de un gramo) sin perder centavos al multiplicar.
+const Decimals = 4
+
+const scale = 10000
+
+// SchemaType es el tipo de columna de los importes en la base de datos
+const SchemaType = "numeric(19,4)"
+
+// Amount es un importe en punto fijo: el entero son diezmilésimas de la unidad de la moneda.
+// Sumas y restas son exactas; multiplicar o dividir por cantidades y porcentajes se hace con
+// Mul, Div y Percent, que redondean a la precisión de Amount.
+type Amount int64
+
+// Zero es el importe cero
+const Zero Amount = 0
+
+// FromFloat convierte un float64 al importe más cercano. Se usa en los bordes (valores
+// calculados por reglas en porcentaje, datos importados); los importes que llegan por JSON o
+// desde la base de datos se leen sin pasar por float64.
+func FromFloat(f float64) Amount {
+	return Amount(math.Round(f * scale))
+}
+
+// FromInt convierte un número entero de unidades de la moneda
+func FromInt(units int64) Amount {
+	return Amount(units * scale)
+}
+
+// Parse lee un importe decimal como "1234.5" o "-0.25" de forma exacta. Más de cuatro
+// decimales se redondean, alejándose de cero en la mitad.
+func Parse(value string) (Amount, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, fmt.Errorf("importe vacío")
+	}
+
+	r, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return 0, fmt.Errorf("importe inválido %q", value)
+	}
+
+	return fromRat(r)
+}
+
+// Float64 devuelve el importe como float64, para cálculos que no son monetarios (márgenes,
+// porcentajes)
+func (a Amount) Float64() float64 {
+	return float64(a) / scale
+}
+
+// String escribe el importe con al menos dos decimales y sin ceros de más: 12.50, 0.1234
+func (a Amount) String() string {
+	sign := ""
+	v := int64(a)
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+
+	fraction := strings.TrimRight(fmt.Sprintf("%04d", v%scale), "0")
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+
+	return sign + strconv.FormatInt(v/scale, 10) + "." + fraction
+}
+
+// Add suma importes; se puede usar también el operador +
+func (a Amount) Add(b Amount) Amount {
+	return a + b
+}
+
+// Sub resta importes; se puede usar también el operador -
+func (a Amount) Sub(b Amount) Amount {
+	return a - b
+}
+
+// Neg cambia el signo del importe
+func (a Amount) Neg() Amount {
+	return -a
+}
+
+// IsZero indica si el importe es cero
+func (a Amount) IsZero() bool {
+	return a == 0
+}
+
+// Min devuelve el menor de dos importes
+func Min(a, b Amount) Amount {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Max devuelve el mayor de dos importes
+func Max(a, b Amount) Amount {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Sum suma los importes
+func Sum(amounts ...Amount) Amount {
+	total := Zero
+	for _, amount := range amounts {
+		total += amount
+	}
+	return total
+}
+
+// Mul multiplica el importe por una cantidad, por ejemplo precio unitario por unidades
+func (a Amount) Mul(quantity float64) Amount {
+	r := new(big.Rat).SetInt64(int64(a))
+	r.Mul(r, ratFromFloat(quantity))
+	return mustRat(r)
+}
+
+// Div divide el importe por una cantidad, por ejemplo un costo total entre las unidades. Dividir
+// por cero devuelve cero.
+func (a Amount) Div(quantity float64) Amount {
+	if quantity == 0 {
+		return 0
+	}
+	r := new(big.Rat).SetInt64(int64(a))
+	r.Quo(r, ratFromFloat(quantity))
+	return mustRat(r)
+}
+
+// Percent devuelve el percent % del importe
+func (a Amount) Percent(percent float64) Amount {
+	return a.Mul(percent / 100)
+}
+
+// Ratio devuelve la proporción a/b como float64; 0 si b es cero. Es para márgenes y
+// porcentajes, nunca para calcular otro importe.
+func (a Amount) Ratio(b Amount) float64 {
+	if b == 0 {
+		return 0
+	}
+	return float64(a) / float64(b)
+}
+
+// Round redondea el importe a los decimales de la moneda, alejándose de cero en la mitad
+// (0.005 COP es 0.01; -0.005 es -0.01)
+func (a Amount) Round(currency Currency) Amount {
+	return a.roundTo(currency.Decimals)
+}
+
+func (a Amount) roundTo(decimals int) Amount {
+	if decimals >= Decimals {
+		return a
+	}
+	step := int64(math.Pow10(Decimals - decimals))
+	v := int64(a)
+	half := step / 2
+	if v >= 0 {
+		return Amount((v + half) / step * step)
+	}
+	return Amount(-((-v + half) / step * step))
+}
+
+// Allocate reparte total en proporción a los pesos, en partes iguales si todos son cero. Cada
+// parte se redondea a la moneda y los centavos que sobran del redondeo se asignan a las partes
+// con mayor residuo, de modo que la suma de las partes siempre es exactamente total. Con pesos
+// no negativos ninguna parte tiene signo contrario al total.
+func Allocate(total Amount, weights []Amount, currency Currency) []Amount {
+	parts := make([]Amount, len(weights))
+	if len(weights) == 0 {
+		return parts
+	}
+
+	step := int64(math.Pow10(Decimals - currency.Decimals))
+	rounded := total.Round(currency)
+	units := int64(rounded) / step
+	// Lo que la moneda no puede representar (subcentavos) queda en la parte más grande, que
+	// puede absorberlo sin cambiar de signo
+	leftover := total - rounded
+
+	weightTotal := Zero
+	for _, w := range weights {
+		weightTotal += w
+	}
+
+	type remainder struct {
+		index int
+		rest  *big.Rat
+	}
+	remainders := make([]remainder, len(weights))
+	assigned := int64(0)
+	for i, w := range weights {
+		share := new(big.Rat)
+		if weightTotal != 0 {
+			share.SetFrac64(int64(w), int64(weightTotal))
+		} else {
+			share.SetFrac64(1, int64(len(weights)))
+		}
+		exact := new(big.Rat).Mul(share, new(big.Rat).SetInt64(units))
+
+		// Parte entera hacia cero; el residuo decide quién recibe las unidades sobrantes
+		whole := new(big.Int).Quo(exact.Num(), exact.Denom())
+		parts[i] = Amount(whole.Int64() * step)
+		assigned += whole.Int64()
+		remainders[i] = remainder{index: i, rest: new(big.Rat).Sub(exact, new(big.Rat).SetInt(whole))}
+	}
+
+	// Repartir las unidades pendientes (positivas o negativas) de a una, empezando por el mayor
+	// residuo en valor absoluto; a igual residuo gana la primera parte
+	pending := units - assigned
+	unit := int64(1)
+	if pending < 0 {
+		unit = -1
+	}
+	for pending != 0 {
+		best := -1
+		for i, r := range remainders {
+			if best == -1 || new(big.Rat).Abs(r.rest).Cmp(new(big.Rat).Abs(remainders[best].rest)) > 0 {
+				best = i
+			}
+		}
+		parts[remainders[best].index] += Amount(unit * step)
+		remainders[best].rest = new(big.Rat)
+		pending -= unit
+	}
+
+	largest := 0
+	for i, part := range parts {
+		if abs(part) > abs(parts[largest]) {
+			largest = i
+		}
+	}
+	parts[largest] += leftover
+	return parts
+}
+
+func abs(a Amount) Amount {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// Value guarda el importe como numeric con cuatro decimales
+func (a Amount) Value() (driver.Value, error) {
+	v := int64(a)
+	sign := ""
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%04d", sign, v/scale, v%scale), nil
+}
+
+// Scan lee el importe de una columna numeric, o de una double precision todavía sin migrar
+func (a *Amount) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*a = 0
+	case []byte:
+		parsed, err := Parse(string(v))
+		if err != nil {
+			return err
+		}
+		*a = parsed
+	case string:
+		parsed, err := Parse(v)
+		if err != nil {
+			return err
+		}
+		*a = parsed
+	case float64:
+		*a = FromFloat(v)
+	case int64:
+		*a = FromInt(v)
+	default:
+		return fmt.Errorf("money: no se puede leer %T como importe", src)
+	}
+	return nil
+}
+
+// MarshalJSON escribe el importe como número JSON con su representación decimal exacta
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON acepta un número o un texto con el importe, sin pasar por float64
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	value := strings.Trim(string(data), `"`)
+	if value == "null" {
+		return nil
+	}
+
+	parsed, err := Parse(value)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+func fromRat(r *big.Rat) (Amount, error) {
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt64(scale))
+	rounded := roundRat(scaled)
+	if !rounded.IsInt64() {
+		return 0, fmt.Errorf("importe fuera de rango")
+	}
+	return Amount(rounded.Int64()), nil
+}
+
+func mustRat(r *big.Rat) Amount {
+	rounded := roundRat(r)
+	if !rounded.IsInt64() {
+		if rounded.Sign() < 0 {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	return Amount(rounded.Int64())
+}
+
+// roundRat redondea al entero más cercano, alejándose de cero en la mitad
+func roundRat(r *big.Rat) *big.Int {
+	num := new(big.Int).Abs(r.Num())
+	den := r.Denom()
+	doubled := new(big.Int).Add(new(big.Int).Mul(num, big.NewInt(2)), den)
+	result := new(big.Int).Quo(doubled, new(big.Int).Mul(den, big.NewInt(2)))
+	if r.Sign() < 0 {
+		result.Neg(result)
+	}
+	return result
+}
+
+// ratFromFloat toma el decimal más corto que representa el float64 (0.1 y no
+// 0.1000000000000000055), así una cantidad de 0.1 multiplica exactamente por un décimo
+func ratFromFloat(f float64) *big.Rat {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+	if !ok {
+		return new(big.Rat)
+	}
+	return r
+}
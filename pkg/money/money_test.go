@@ -0,0 +1,307 @@
+package money
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// iterations es cuántos casos aleatorios prueba cada propiedad
+const iterations = 5000
+
+var testCurrencies = []Currency{currencies["COP"], currencies["CLP"], {Code: "XTS", Decimals: 4}}
+
+// randomAmount devuelve un importe entre -max y max con los cuatro decimales ocupados
+func randomAmount(r *rand.Rand, max int64) Amount {
+	return Amount(r.Int63n(2*max*scale+1) - max*scale)
+}
+
+func randomWeights(r *rand.Rand) []Amount {
+	weights := make([]Amount, 1+r.Intn(8))
+	for i := range weights {
+		// Un peso de cada cuatro es cero para probar las partes que no reciben nada
+		if r.Intn(4) > 0 {
+			weights[i] = Amount(r.Int63n(1000 * scale))
+		}
+	}
+	return weights
+}
+
+func TestAllocateSumsToTotal(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < iterations; i++ {
+		currency := testCurrencies[r.Intn(len(testCurrencies))]
+		total := randomAmount(r, 100000)
+		weights := randomWeights(r)
+
+		parts := Allocate(total, weights, currency)
+		if len(parts) != len(weights) {
+			t.Fatalf("Allocate(%s, %v) devolvió %d partes, se esperaban %d", total, weights, len(parts), len(weights))
+		}
+		if sum := Sum(parts...); sum != total {
+			t.Fatalf("Allocate(%s, %v, %s) = %v suma %s", total, weights, currency.Code, parts, sum)
+		}
+	}
+}
+
+func TestAllocateHasNoNegativeShares(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < iterations; i++ {
+		currency := testCurrencies[r.Intn(len(testCurrencies))]
+		total := Amount(r.Int63n(100000 * scale))
+		// Los importes con subcentavos son los que dejan un residuo que la moneda no representa
+		if i%2 == 0 {
+			total = Amount(r.Int63n(2 * scale))
+		}
+		weights := randomWeights(r)
+
+		for _, part := range Allocate(total, weights, currency) {
+			if part < 0 {
+				t.Fatalf("Allocate(%s, %v, %s) tiene una parte negativa: %s", total, weights, currency.Code, part)
+			}
+		}
+	}
+}
+
+func TestAllocateRoundsToCurrency(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for i := 0; i < iterations; i++ {
+		currency := testCurrencies[r.Intn(len(testCurrencies))]
+		total := randomAmount(r, 100000).Round(currency)
+		weights := randomWeights(r)
+
+		for _, part := range Allocate(total, weights, currency) {
+			if part.Round(currency) != part {
+				t.Fatalf("Allocate(%s, %v, %s): la parte %s no está redondeada a la moneda", total, weights, currency.Code, part)
+			}
+		}
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    Amount
+		weights  []Amount
+		currency Currency
+		want     []Amount
+	}{
+		{"partes iguales", FromInt(100), []Amount{1, 1, 1}, currencies["COP"], []Amount{333400, 333300, 333300}},
+		{"pesos en cero", FromInt(10), []Amount{0, 0}, currencies["COP"], []Amount{50000, 50000}},
+		{"proporcional", FromInt(90), []Amount{FromInt(1), FromInt(2)}, currencies["COP"], []Amount{FromInt(30), FromInt(60)}},
+		{"sin decimales", FromInt(10), []Amount{1, 1, 1}, currencies["CLP"], []Amount{FromInt(4), FromInt(3), FromInt(3)}},
+		{"negativo", FromInt(-10), []Amount{1, 1, 1}, currencies["COP"], []Amount{-33400, -33300, -33300}},
+		{"subcentavos", 99, []Amount{1, 1}, currencies["COP"], []Amount{99, 0}},
+		{"sin pesos", FromInt(10), nil, currencies["COP"], []Amount{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Allocate(tt.total, tt.weights, tt.currency)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Allocate() = %v, se esperaba %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("Allocate() = %v, se esperaba %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		amount   Amount
+		currency Currency
+		want     Amount
+	}{
+		{12345, currencies["COP"], 12300},
+		{12350, currencies["COP"], 12400},
+		{-12350, currencies["COP"], -12400},
+		{-12349, currencies["COP"], -12300},
+		{15000, currencies["CLP"], 20000},
+		{14999, currencies["CLP"], 10000},
+		{-15000, currencies["JPY"], -20000},
+		{12345, Currency{Code: "XTS", Decimals: 4}, 12345},
+	}
+
+	for _, tt := range tests {
+		if got := tt.amount.Round(tt.currency); got != tt.want {
+			t.Errorf("%d.Round(%s) = %d, se esperaba %d", tt.amount, tt.currency.Code, got, tt.want)
+		}
+	}
+}
+
+func TestRoundProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	for i := 0; i < iterations; i++ {
+		currency := testCurrencies[r.Intn(len(testCurrencies))]
+		amount := randomAmount(r, 1000000)
+		step := Amount(math.Pow10(Decimals - currency.Decimals))
+
+		rounded := amount.Round(currency)
+		if rounded%step != 0 {
+			t.Fatalf("%s.Round(%s) = %s no es múltiplo de %d", amount, currency.Code, rounded, step)
+		}
+		if diff := rounded - amount; 2*diff > step || 2*diff < -step {
+			t.Fatalf("%s.Round(%s) = %s se aleja más de medio paso", amount, currency.Code, rounded)
+		}
+		if rounded.Round(currency) != rounded {
+			t.Fatalf("Round no es idempotente para %s en %s", amount, currency.Code)
+		}
+		if (-amount).Round(currency) != -rounded {
+			t.Fatalf("Round no es simétrico para %s en %s", amount, currency.Code)
+		}
+	}
+}
+
+func TestExchange(t *testing.T) {
+	tests := []struct {
+		amount Amount
+		rate   float64
+		to     Currency
+		want   Amount
+	}{
+		{FromInt(100), 4000.5, currencies["COP"], FromInt(400050)},
+		{FromInt(1), 0.00025, currencies["USD"], 0},
+		{FromInt(10), 0.00025, currencies["USD"], 0},
+		{FromInt(100), 0.00025, currencies["USD"], 300},
+		{125000, 950.5, currencies["CLP"], FromInt(11881)},
+		{FromInt(3), 1.0 / 3, currencies["USD"], FromInt(1)},
+	}
+
+	for _, tt := range tests {
+		if got := tt.amount.Exchange(tt.rate, tt.to); got != tt.want {
+			t.Errorf("%s.Exchange(%v, %s) = %s, se esperaba %s", tt.amount, tt.rate, tt.to.Code, got, tt.want)
+		}
+	}
+}
+
+func TestExchangeRoundsToTargetCurrency(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	for i := 0; i < iterations; i++ {
+		currency := testCurrencies[r.Intn(len(testCurrencies))]
+		amount := randomAmount(r, 100000)
+		rate := math.Round(r.Float64()*5000*10000) / 10000
+
+		got := amount.Exchange(rate, currency)
+		if got.Round(currency) != got {
+			t.Fatalf("%s.Exchange(%v, %s) = %s no está redondeado a la moneda", amount, rate, currency.Code, got)
+		}
+		if want := amount.Mul(rate).Round(currency); got != want {
+			t.Fatalf("%s.Exchange(%v, %s) = %s, se esperaba %s", amount, rate, currency.Code, got, want)
+		}
+	}
+}
+
+// TestNumericRoundTrip comprueba que un importe sale a numeric(19,4) y vuelve igual, también
+// cuando la columna llega como texto o bytes
+func TestNumericRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(6))
+	for i := 0; i < iterations; i++ {
+		amount := randomAmount(r, 1000000000)
+
+		value, err := amount.Value()
+		if err != nil {
+			t.Fatalf("Value(%d): %v", amount, err)
+		}
+
+		var fromString, fromBytes Amount
+		if err := fromString.Scan(value); err != nil {
+			t.Fatalf("Scan(%v): %v", value, err)
+		}
+		if err := fromBytes.Scan([]byte(value.(string))); err != nil {
+			t.Fatalf("Scan(%v): %v", value, err)
+		}
+		if fromString != amount || fromBytes != amount {
+			t.Fatalf("%d se guardó como %v y se leyó como %d y %d", amount, value, fromString, fromBytes)
+		}
+
+		parsed, err := Parse(amount.String())
+		if err != nil || parsed != amount {
+			t.Fatalf("Parse(%q) = %d, %v; se esperaba %d", amount.String(), parsed, err, amount)
+		}
+	}
+}
+
+func TestFromFloatRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	for i := 0; i < iterations; i++ {
+		// Hasta 10^11 unidades con cuatro decimales caben en la precisión de un float64
+		amount := randomAmount(r, 100000000000)
+		if got := FromFloat(amount.Float64()); got != amount {
+			t.Fatalf("FromFloat(%v) = %d, se esperaba %d", amount.Float64(), got, amount)
+		}
+	}
+
+	tests := []struct {
+		value float64
+		want  Amount
+	}{
+		{0.1, 1000},
+		{1234.56789, 12345679},
+		{-0.00005, -1},
+		{0.00004, 0},
+		{19.99, 199900},
+	}
+	for _, tt := range tests {
+		if got := FromFloat(tt.value); got != tt.want {
+			t.Errorf("FromFloat(%v) = %d, se esperaba %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestScanLegacyDoublePrecision(t *testing.T) {
+	var amount Amount
+	if err := amount.Scan(float64(1234.5678)); err != nil {
+		t.Fatal(err)
+	}
+	if amount != 12345678 {
+		t.Fatalf("Scan(1234.5678) = %d, se esperaba 12345678", amount)
+	}
+}
+
+// TestInvoiceTotals arma facturas como la facturación: descuento global repartido entre las
+// líneas, impuesto por línea sobre la base ya descontada. La suma de las líneas más el impuesto
+// menos los descuentos debe dar el total de la factura sin perder ni sobrar centavos.
+func TestInvoiceTotals(t *testing.T) {
+	r := rand.New(rand.NewSource(8))
+	rates := []float64{0, 5, 8, 19}
+
+	for i := 0; i < iterations; i++ {
+		lines := make([]Amount, 1+r.Intn(10))
+		subtotal := Zero
+		for j := range lines {
+			unitPrice := Amount(r.Int63n(500000 * scale))
+			quantity := float64(1+r.Intn(1000)) / float64(1+r.Intn(4))
+			lines[j] = unitPrice.Mul(quantity).Round(Default)
+			subtotal += lines[j]
+		}
+
+		discount := Amount(r.Int63n(int64(subtotal) + 1)).Round(Default)
+		if discount > subtotal {
+			discount = subtotal
+		}
+		shares := Allocate(discount, lines, Default)
+
+		taxTotal := Zero
+		total := Zero
+		for j, line := range lines {
+			if shares[j] < 0 || shares[j] > line {
+				t.Fatalf("el descuento %s de la línea %s queda fuera de rango", shares[j], line)
+			}
+			base := line - shares[j]
+			tax := base.Percent(rates[r.Intn(len(rates))]).Round(Default)
+			taxTotal += tax
+			total += base + tax
+		}
+
+		if got := subtotal + taxTotal - discount; got != total {
+			t.Fatalf("líneas %s + impuesto %s - descuento %s = %s, pero el total es %s", subtotal, taxTotal, discount, got, total)
+		}
+		if total.Round(Default) != total {
+			t.Fatalf("el total %s no está redondeado a la moneda", total)
+		}
+	}
+}
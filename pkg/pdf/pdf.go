@@ -0,0 +1,256 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"strings"
+)
+
+// Font es una de las fuentes estándar de PDF, que los visores traen y no hace falta incrustar
+type Font string
+
+const (
+	Helvetica     Font = "Helvetica"
+	HelveticaBold Font = "Helvetica-Bold"
+)
+
+// Tamaños de página en puntos (1 pt = 1/72 pulgada)
+const (
+	A4Width  = 595.28
+	A4Height = 841.89
+	MM       = 72 / 25.4
+)
+
+var fontResources = map[Font]string{
+	Helvetica:     "F1",
+	HelveticaBold: "F2",
+}
+
+// Document es un PDF mínimo con texto, formas vectoriales e imágenes; el origen de coordenadas
+// de cada página es la esquina inferior izquierda
+type Document struct {
+	pages  []*Page
+	images []*Image
+}
+
+type Page struct {
+	width   float64
+	height  float64
+	content bytes.Buffer
+}
+
+func New() *Document {
+	return &Document{}
+}
+
+func (d *Document) AddPage(width, height float64) *Page {
+	page := &Page{width: width, height: height}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+// Image es una imagen incrustada una sola vez en el documento, que las páginas pueden dibujar
+type Image struct {
+	name   string
+	width  int
+	height int
+	data   []byte
+}
+
+// AddImage incrusta la imagen en RGB comprimido; la transparencia se mezcla sobre fondo blanco
+func (d *Document) AddImage(img image.Image) *Image {
+	bounds := img.Bounds()
+	raw := make([]byte, 0, bounds.Dx()*bounds.Dy()*3)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, a := img.At(x, y).RGBA()
+			white := 0xffff - a
+			raw = append(raw, byte((r+white)>>8), byte((g+white)>>8), byte((b+white)>>8))
+		}
+	}
+
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+	w.Write(raw)
+	w.Close()
+
+	embedded := &Image{
+		name:   fmt.Sprintf("Im%d", len(d.images)+1),
+		width:  bounds.Dx(),
+		height: bounds.Dy(),
+		data:   compressed.Bytes(),
+	}
+	d.images = append(d.images, embedded)
+	return embedded
+}
+
+// Size devuelve el ancho y el alto de la imagen en píxeles
+func (i *Image) Size() (int, int) {
+	return i.width, i.height
+}
+
+// Rect dibuja un rectángulo relleno con el color de relleno actual
+func (p *Page) Rect(x, y, width, height float64) {
+	fmt.Fprintf(&p.content, "%s %s %s %s re f\n", num(x), num(y), num(width), num(height))
+}
+
+// StrokeRect dibuja el borde de un rectángulo
+func (p *Page) StrokeRect(x, y, width, height, lineWidth float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s %s %s re S\n", num(lineWidth), num(x), num(y), num(width), num(height))
+}
+
+func (p *Page) Line(x1, y1, x2, y2, lineWidth float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s m %s %s l S\n", num(lineWidth), num(x1), num(y1), num(x2), num(y2))
+}
+
+// SetGray fija el color de relleno y de trazo (0 negro, 1 blanco)
+func (p *Page) SetGray(gray float64) {
+	fmt.Fprintf(&p.content, "%s g %s G\n", num(gray), num(gray))
+}
+
+// DrawImage dibuja la imagen con su esquina inferior izquierda en (x, y)
+func (p *Page) DrawImage(img *Image, x, y, width, height float64) {
+	fmt.Fprintf(&p.content, "q %s 0 0 %s %s %s cm /%s Do Q\n", num(width), num(height), num(x), num(y), img.name)
+}
+
+// Text escribe una línea con la base del texto en (x, y)
+func (p *Page) Text(x, y, size float64, font Font, text string) {
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td (%s) Tj ET\n", fontResources[font], num(size), num(x), num(y), escape(text))
+}
+
+// TextRight escribe una línea alineada a la derecha en x
+func (p *Page) TextRight(x, y, size float64, font Font, text string) {
+	p.Text(x-TextWidth(text, size, font), y, size, font, text)
+}
+
+// Bytes arma el archivo PDF
+func (d *Document) Bytes() []byte {
+	var buf bytes.Buffer
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// 1: catálogo, 2: árbol de páginas, 3 y 4: fuentes, luego las imágenes y después página y
+	// contenido por cada página
+	firstPage := 5 + len(d.images)
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+i*2)
+	}
+
+	xObjects := ""
+	if len(d.images) > 0 {
+		refs := make([]string, len(d.images))
+		for i, img := range d.images {
+			refs[i] = fmt.Sprintf("/%s %d 0 R", img.name, 5+i)
+		}
+		xObjects = fmt.Sprintf(" /XObject << %s >>", strings.Join(refs, " "))
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", Helvetica))
+	object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", HelveticaBold))
+
+	for _, img := range d.images {
+		object(fmt.Sprintf("<< /Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /FlateDecode /Length %d >>\nstream\n%s\nendstream",
+			img.width, img.height, len(img.data), img.data))
+	}
+
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R /F2 4 0 R >>%s >> /Contents %d 0 R >>",
+			num(page.width), num(page.height), xObjects, firstPage+1+i*2))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.content.Len(), page.content.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.Bytes()
+}
+
+// TextWidth estima el ancho del texto en puntos con las métricas de Helvetica
+func TextWidth(text string, size float64, font Font) float64 {
+	units := 0
+	for _, r := range text {
+		units += charWidth(r, font == HelveticaBold)
+	}
+	return float64(units) * size / 1000
+}
+
+// Truncate recorta el texto con "..." para que no supere el ancho indicado
+func Truncate(text string, maxWidth, size float64, font Font) string {
+	if TextWidth(text, size, font) <= maxWidth {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && TextWidth(string(runes)+"...", size, font) > maxWidth {
+		runes = runes[:len(runes)-1]
+	}
+	return strings.TrimSpace(string(runes)) + "..."
+}
+
+// charWidth devuelve el ancho aproximado de un carácter en milésimas del tamaño de la fuente
+func charWidth(r rune, bold bool) int {
+	switch {
+	case strings.ContainsRune(" .,:;!|'ijlIíì", r):
+		return 278
+	case strings.ContainsRune("ftr()[]-/\"", r):
+		if bold {
+			return 389
+		}
+		return 333
+	case strings.ContainsRune("mM", r):
+		return 833
+	case strings.ContainsRune("wW", r):
+		return 944
+	case r >= 'A' && r <= 'Z', strings.ContainsRune("ÁÉÍÓÚÑÜ", r):
+		return 667
+	default:
+		return 556
+	}
+}
+
+func num(value float64) string {
+	s := fmt.Sprintf("%.2f", value)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "-0" || s == "" {
+		return "0"
+	}
+	return s
+}
+
+// escape convierte el texto a WinAnsi (los caracteres latinos coinciden con Latin-1) y escapa
+// los delimitadores de cadena de PDF
+func escape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '€':
+			b.WriteString("\\200")
+		case r < 32:
+			b.WriteByte(' ')
+		case r < 128:
+			b.WriteRune(r)
+		case r >= 160 && r < 256:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,468 @@
+package qrcode
+
+import "fmt"
+
+// QuietZone es el margen claro, en módulos, que debe rodear al código al imprimirlo
+const QuietZone = 4
+
+// blockLayout es la división en bloques de Reed-Solomon de una versión con corrección M
+type blockLayout struct {
+	ecPerBlock int
+	shortCount int
+	shortData  int
+	longCount  int
+}
+
+// layouts son las versiones 1 a 20 con nivel de corrección M (recupera ~15% del código)
+var layouts = []blockLayout{
+	{10, 1, 16, 0}, {16, 1, 28, 0}, {26, 1, 44, 0}, {18, 2, 32, 0}, {24, 2, 43, 0},
+	{16, 4, 27, 0}, {18, 4, 31, 0}, {22, 2, 38, 2}, {22, 3, 36, 2}, {26, 4, 43, 1},
+	{30, 1, 50, 4}, {22, 6, 36, 2}, {22, 8, 37, 1}, {24, 4, 40, 5}, {24, 5, 41, 5},
+	{28, 7, 45, 3}, {28, 10, 46, 1}, {26, 9, 43, 4}, {26, 3, 44, 11}, {26, 3, 41, 13},
+}
+
+func (l blockLayout) dataCodewords() int {
+	return l.shortCount*l.shortData + l.longCount*(l.shortData+1)
+}
+
+// Encode codifica el texto en modo byte y devuelve la matriz del código, fila por fila, con true
+// en los módulos oscuros. Usa la versión más chica en que cabe el texto, sin la zona de silencio.
+func Encode(text string) ([][]bool, error) {
+	data := []byte(text)
+
+	version := 0
+	for v := 1; v <= len(layouts); v++ {
+		countBits := 8
+		if v >= 10 {
+			countBits = 16
+		}
+		if 4+countBits+8*len(data) <= 8*layouts[v-1].dataCodewords() {
+			version = v
+			break
+		}
+	}
+	if version == 0 {
+		return nil, fmt.Errorf("el texto del código QR es demasiado largo (%d bytes)", len(data))
+	}
+
+	q := newSymbol(version)
+	q.drawFunctionPatterns()
+	q.drawCodewords(q.addErrorCorrection(q.dataCodewords(data)))
+
+	best, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		q.applyMask(mask)
+		q.drawFormatBits(mask)
+		if penalty := q.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			best, bestPenalty = mask, penalty
+		}
+		q.applyMask(mask)
+	}
+	q.applyMask(best)
+	q.drawFormatBits(best)
+
+	return q.modules, nil
+}
+
+type symbol struct {
+	version    int
+	size       int
+	modules    [][]bool
+	isFunction [][]bool
+}
+
+func newSymbol(version int) *symbol {
+	size := version*4 + 17
+	q := &symbol{version: version, size: size}
+	q.modules = make([][]bool, size)
+	q.isFunction = make([][]bool, size)
+	for i := range q.modules {
+		q.modules[i] = make([]bool, size)
+		q.isFunction[i] = make([]bool, size)
+	}
+	return q
+}
+
+func (q *symbol) setFunction(x, y int, dark bool) {
+	q.modules[y][x] = dark
+	q.isFunction[y][x] = true
+}
+
+func (q *symbol) drawFunctionPatterns() {
+	for i := 0; i < q.size; i++ {
+		q.setFunction(6, i, i%2 == 0)
+		q.setFunction(i, 6, i%2 == 0)
+	}
+
+	q.drawFinder(3, 3)
+	q.drawFinder(q.size-4, 3)
+	q.drawFinder(3, q.size-4)
+
+	positions := q.alignmentPositions()
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			// Las esquinas con patrón de posición no llevan patrón de alineación
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			q.drawAlignment(x, y)
+		}
+	}
+
+	// Reserva el área de formato; los bits reales se escriben al elegir la máscara
+	q.drawFormatBits(0)
+	q.drawVersion()
+}
+
+func (q *symbol) drawFinder(cx, cy int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			x, y := cx+dx, cy+dy
+			if x < 0 || x >= q.size || y < 0 || y >= q.size {
+				continue
+			}
+			dist := chebyshev(dx, dy)
+			q.setFunction(x, y, dist != 2 && dist != 4)
+		}
+	}
+}
+
+func (q *symbol) drawAlignment(cx, cy int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			q.setFunction(cx+dx, cy+dy, chebyshev(dx, dy) != 1)
+		}
+	}
+}
+
+// alignmentPositions devuelve las coordenadas de los centros de los patrones de alineación
+func (q *symbol) alignmentPositions() []int {
+	if q.version == 1 {
+		return nil
+	}
+	count := q.version/7 + 2
+	step := (q.version*8 + count*3 + 5) / (count*4 - 4) * 2
+
+	positions := make([]int, count)
+	positions[0] = 6
+	for i, pos := count-1, q.size-7; i >= 1; i, pos = i-1, pos-step {
+		positions[i] = pos
+	}
+	return positions
+}
+
+// drawFormatBits escribe el nivel de corrección (M) y la máscara, con su código BCH, en las dos
+// copias del área de formato
+func (q *symbol) drawFormatBits(mask int) {
+	data := mask // el nivel M se codifica como 00
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+
+	for i := 0; i <= 5; i++ {
+		q.setFunction(8, i, bit(bits, i))
+	}
+	q.setFunction(8, 7, bit(bits, 6))
+	q.setFunction(8, 8, bit(bits, 7))
+	q.setFunction(7, 8, bit(bits, 8))
+	for i := 9; i < 15; i++ {
+		q.setFunction(14-i, 8, bit(bits, i))
+	}
+
+	for i := 0; i < 8; i++ {
+		q.setFunction(q.size-1-i, 8, bit(bits, i))
+	}
+	for i := 8; i < 15; i++ {
+		q.setFunction(8, q.size-15+i, bit(bits, i))
+	}
+	q.setFunction(8, q.size-8, true)
+}
+
+// drawVersion escribe el número de versión en las versiones 7 y superiores
+func (q *symbol) drawVersion() {
+	if q.version < 7 {
+		return
+	}
+	rem := q.version
+	for i := 0; i < 12; i++ {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+	}
+	bits := q.version<<12 | rem
+
+	for i := 0; i < 18; i++ {
+		a, b := q.size-11+i%3, i/3
+		q.setFunction(a, b, bit(bits, i))
+		q.setFunction(b, a, bit(bits, i))
+	}
+}
+
+// dataCodewords arma los codewords de datos: modo byte, longitud, datos, terminador y relleno
+func (q *symbol) dataCodewords(data []byte) []byte {
+	capacity := layouts[q.version-1].dataCodewords()
+
+	var buf bitBuffer
+	buf.append(0x4, 4)
+	if q.version >= 10 {
+		buf.append(len(data), 16)
+	} else {
+		buf.append(len(data), 8)
+	}
+	for _, b := range data {
+		buf.append(int(b), 8)
+	}
+
+	terminator := capacity*8 - len(buf)
+	if terminator > 4 {
+		terminator = 4
+	}
+	buf.append(0, terminator)
+	buf.append(0, (8-len(buf)%8)%8)
+
+	codewords := buf.bytes()
+	for pad := 0xEC; len(codewords) < capacity; pad ^= 0xEC ^ 0x11 {
+		codewords = append(codewords, byte(pad))
+	}
+	return codewords
+}
+
+// addErrorCorrection divide los datos en bloques, calcula la corrección de cada uno y los
+// intercala como pide la norma
+func (q *symbol) addErrorCorrection(data []byte) []byte {
+	layout := layouts[q.version-1]
+	divisor := reedSolomonDivisor(layout.ecPerBlock)
+
+	blocks := layout.shortCount + layout.longCount
+	dataBlocks := make([][]byte, blocks)
+	ecBlocks := make([][]byte, blocks)
+	offset := 0
+	for i := 0; i < blocks; i++ {
+		length := layout.shortData
+		if i >= layout.shortCount {
+			length++
+		}
+		dataBlocks[i] = data[offset : offset+length]
+		ecBlocks[i] = reedSolomonRemainder(dataBlocks[i], divisor)
+		offset += length
+	}
+
+	var result []byte
+	for i := 0; i <= layout.shortData; i++ {
+		for _, block := range dataBlocks {
+			if i < len(block) {
+				result = append(result, block[i])
+			}
+		}
+	}
+	for i := 0; i < layout.ecPerBlock; i++ {
+		for _, block := range ecBlocks {
+			result = append(result, block[i])
+		}
+	}
+	return result
+}
+
+// drawCodewords recorre la matriz en zigzag de a dos columnas, de abajo hacia arriba y de
+// derecha a izquierda, y coloca los bits en los módulos que no son de función
+func (q *symbol) drawCodewords(data []byte) {
+	i := 0
+	for right := q.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < q.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = q.size - 1 - vert
+				}
+				if !q.isFunction[y][x] && i < len(data)*8 {
+					q.modules[y][x] = bit(int(data[i>>3]), 7-(i&7))
+					i++
+				}
+			}
+		}
+	}
+}
+
+// applyMask invierte los módulos de datos según el patrón de la máscara; aplicarla dos veces
+// la deshace
+func (q *symbol) applyMask(mask int) {
+	for y := 0; y < q.size; y++ {
+		for x := 0; x < q.size; x++ {
+			if q.isFunction[y][x] {
+				continue
+			}
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			if invert {
+				q.modules[y][x] = !q.modules[y][x]
+			}
+		}
+	}
+}
+
+// penalty puntúa la matriz con las cuatro reglas de la norma; la máscara con menos puntos es
+// la más fácil de leer
+func (q *symbol) penalty() int {
+	score := 0
+	finderLike := [][]bool{
+		{true, false, true, true, true, false, true, false, false, false, false},
+		{false, false, false, false, true, false, true, true, true, false, true},
+	}
+
+	for _, vertical := range []bool{false, true} {
+		at := func(a, b int) bool {
+			if vertical {
+				return q.modules[b][a]
+			}
+			return q.modules[a][b]
+		}
+
+		for a := 0; a < q.size; a++ {
+			run := 1
+			for b := 1; b <= q.size; b++ {
+				if b < q.size && at(a, b) == at(a, b-1) {
+					run++
+					continue
+				}
+				if run >= 5 {
+					score += run - 2
+				}
+				run = 1
+			}
+
+			for b := 0; b+11 <= q.size; b++ {
+				for _, pattern := range finderLike {
+					match := true
+					for k, dark := range pattern {
+						if at(a, b+k) != dark {
+							match = false
+							break
+						}
+					}
+					if match {
+						score += 40
+					}
+				}
+			}
+		}
+	}
+
+	dark := 0
+	for y := 0; y < q.size; y++ {
+		for x := 0; x < q.size; x++ {
+			if q.modules[y][x] {
+				dark++
+			}
+			if x+1 < q.size && y+1 < q.size {
+				c := q.modules[y][x]
+				if c == q.modules[y][x+1] && c == q.modules[y+1][x] && c == q.modules[y+1][x+1] {
+					score += 3
+				}
+			}
+		}
+	}
+	total := q.size * q.size
+	score += abs(dark*20-total*10) / total * 10
+
+	return score
+}
+
+// reedSolomonDivisor devuelve el polinomio generador del grado indicado, sin el coeficiente
+// principal
+func reedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+	return result
+}
+
+func reedSolomonRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coefficient := range divisor {
+			result[i] ^= gfMultiply(coefficient, factor)
+		}
+	}
+	return result
+}
+
+// gfMultiply multiplica en GF(2^8) con el polinomio 0x11D
+func gfMultiply(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>i)&1) * int(x)
+	}
+	return byte(z)
+}
+
+type bitBuffer []bool
+
+func (b *bitBuffer) append(value, length int) {
+	for i := length - 1; i >= 0; i-- {
+		*b = append(*b, bit(value, i))
+	}
+}
+
+func (b bitBuffer) bytes() []byte {
+	result := make([]byte, len(b)/8)
+	for i, set := range b {
+		if set {
+			result[i/8] |= 1 << (7 - i%8)
+		}
+	}
+	return result
+}
+
+func bit(value, i int) bool {
+	return (value>>i)&1 != 0
+}
+
+// chebyshev es la distancia al centro de un patrón cuadrado
+func chebyshev(dx, dy int) int {
+	if abs(dx) > abs(dy) {
+		return abs(dx)
+	}
+	return abs(dy)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,27 @@
+package quantity
+
+import (
+	"math"
+	"strconv"
+)
+
+// Decimals es la precisión con la que se guardan las cantidades (gramos sobre kilos)
+const Decimals = 3
+
+const scale = 1000
+
+// Round redondea una cantidad a la precisión de inventario, para que sumas y restas de
+// fracciones no dejen restos como 0.30000000000000004
+func Round(q float64) float64 {
+	return math.Round(q*scale) / scale
+}
+
+// IsWhole indica si la cantidad no tiene parte fraccionaria
+func IsWhole(q float64) bool {
+	return Round(q) == math.Trunc(Round(q))
+}
+
+// Format escribe la cantidad sin ceros decimales de más: 3, 2.5, 0.125
+func Format(q float64) string {
+	return strconv.FormatFloat(Round(q), 'f', -1, 64)
+}
@@ -0,0 +1,80 @@
+package secret
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+)
+
+// KeySize es el largo de la clave: AES-256
+const KeySize = 32
+
+// prefix marca los valores cifrados; así se distinguen de los guardados antes en claro
+var prefix = []byte("enc:v1:")
+
+// Box cifra secretos con AES-256-GCM para guardarlos en la base de datos. Cada valor lleva el
+// prefijo de versión y un nonce aleatorio antes del texto cifrado.
+type Box struct {
+	aead cipher.AEAD
+}
+
+// NewBox crea la caja con una clave de 32 bytes codificada en base64 (openssl rand -base64 32)
+func NewBox(encodedKey string) (*Box, error) {
+	if encodedKey == "" {
+		return nil, fmt.Errorf("la clave de cifrado es requerida")
+	}
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil || len(key) != KeySize {
+		return nil, fmt.Errorf("la clave de cifrado debe ser de %d bytes codificados en base64", KeySize)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Box{aead: aead}, nil
+}
+
+// Seal cifra el valor
+func (b *Box) Seal(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	sealed := append(append([]byte{}, prefix...), nonce...)
+	return b.aead.Seal(sealed, nonce, plaintext, nil), nil
+}
+
+// Open descifra un valor cifrado con Seal; falla si el valor no está cifrado, se alteró o se
+// cifró con otra clave
+func (b *Box) Open(sealed []byte) ([]byte, error) {
+	if !IsSealed(sealed) {
+		return nil, fmt.Errorf("el valor no está cifrado")
+	}
+	data := sealed[len(prefix):]
+	if len(data) < b.aead.NonceSize() {
+		return nil, fmt.Errorf("el valor cifrado está incompleto")
+	}
+
+	nonce, ciphertext := data[:b.aead.NonceSize()], data[b.aead.NonceSize():]
+	plaintext, err := b.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo descifrar el valor: la clave no corresponde o el valor se alteró")
+	}
+
+	return plaintext, nil
+}
+
+// IsSealed indica si el valor fue cifrado con Seal
+func IsSealed(value []byte) bool {
+	return bytes.HasPrefix(value, prefix)
+}
@@ -0,0 +1,78 @@
+package tax
+
+import "Veritasbackend/pkg/money"
+
+// Tipos de tarifa. Exenta y excluida no generan impuesto, pero la exenta forma parte de las
+// operaciones gravadas y la excluida no, por eso se reportan por separado.
+const (
+	KindTaxable  = "taxable"
+	KindExempt   = "exempt"
+	KindExcluded = "excluded"
+)
+
+// Rate es una tarifa de impuesto; el ID cero indica que la línea no tiene tarifa
+type Rate struct {
+	ID      int
+	Name    string
+	Percent float64
+	Kind    string
+}
+
+// ValidKind indica si el tipo de tarifa es uno de los conocidos
+func ValidKind(kind string) bool {
+	return kind == KindTaxable || kind == KindExempt || kind == KindExcluded
+}
+
+// Line es el impuesto de una línea: Base sin impuestos, Amount el impuesto y Total la suma
+type Line struct {
+	Rate   Rate
+	Base   money.Amount
+	Amount money.Amount
+	Total  money.Amount
+}
+
+// Compute calcula el impuesto de un importe, redondeado a los centavos de la moneda del
+// documento. Con inclusive el importe ya trae el impuesto y se separa la base; si no, el
+// impuesto se suma sobre el importe.
+func Compute(amount money.Amount, rate Rate, inclusive bool, currency money.Currency) Line {
+	amount = amount.Round(currency)
+	line := Line{Rate: rate, Base: amount, Total: amount}
+
+	if rate.Kind != KindTaxable || rate.Percent <= 0 {
+		return line
+	}
+
+	if inclusive {
+		line.Base = amount.Div(1 + rate.Percent/100).Round(currency)
+		line.Amount = amount - line.Base
+	} else {
+		line.Amount = amount.Percent(rate.Percent).Round(currency)
+		line.Total = amount + line.Amount
+	}
+
+	return line
+}
+
+// Summarize agrupa las líneas por tarifa, en el orden en que aparece cada una. Las líneas sin
+// tarifa no se incluyen.
+func Summarize(lines []Line) []Line {
+	var summary []Line
+	index := make(map[int]int)
+
+	for _, line := range lines {
+		if line.Rate.ID == 0 {
+			continue
+		}
+		i, ok := index[line.Rate.ID]
+		if !ok {
+			i = len(summary)
+			index[line.Rate.ID] = i
+			summary = append(summary, Line{Rate: line.Rate})
+		}
+		summary[i].Base += line.Base
+		summary[i].Amount += line.Amount
+		summary[i].Total += line.Total
+	}
+
+	return summary
+}
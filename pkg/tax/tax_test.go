@@ -0,0 +1,42 @@
+package tax
+
+import (
+	"testing"
+
+	"Veritasbackend/pkg/money"
+)
+
+func TestComputeRoundsToDocumentCurrency(t *testing.T) {
+	cop, _ := money.Lookup("COP")
+	clp, _ := money.Lookup("CLP")
+	iva := Rate{ID: 1, Name: "IVA 19%", Percent: 19, Kind: KindTaxable}
+	exempt := Rate{ID: 2, Name: "Exento", Kind: KindExempt}
+
+	tests := []struct {
+		name      string
+		amount    money.Amount
+		rate      Rate
+		inclusive bool
+		currency  money.Currency
+		want      Line
+	}{
+		{"COP sobre la base", 1000050, iva, false, cop, Line{Base: 1000100, Amount: 190000, Total: 1190100}},
+		{"COP incluido", money.FromInt(119), iva, true, cop, Line{Base: money.FromInt(100), Amount: money.FromInt(19), Total: money.FromInt(119)}},
+		{"CLP sobre la base", 10006000, iva, false, clp, Line{Base: money.FromInt(1001), Amount: money.FromInt(190), Total: money.FromInt(1191)}},
+		{"CLP incluido", money.FromInt(1000), iva, true, clp, Line{Base: money.FromInt(840), Amount: money.FromInt(160), Total: money.FromInt(1000)}},
+		{"exento", 10006000, exempt, false, clp, Line{Base: money.FromInt(1001), Total: money.FromInt(1001)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Compute(tt.amount, tt.rate, tt.inclusive, tt.currency)
+			if got.Base != tt.want.Base || got.Amount != tt.want.Amount || got.Total != tt.want.Total {
+				t.Fatalf("Compute(%s) = base %s, impuesto %s, total %s; se esperaba base %s, impuesto %s, total %s",
+					tt.amount, got.Base, got.Amount, got.Total, tt.want.Base, tt.want.Amount, tt.want.Total)
+			}
+			if got.Base+got.Amount != got.Total {
+				t.Fatalf("base %s + impuesto %s no da el total %s", got.Base, got.Amount, got.Total)
+			}
+		})
+	}
+}
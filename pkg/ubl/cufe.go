@@ -0,0 +1,48 @@
+package ubl
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+)
+
+// Códigos de impuesto que entran en el CUFE: IVA, impuesto al consumo e ICA
+const (
+	taxIVA = "01"
+	taxINC = "04"
+	taxICA = "03"
+)
+
+// CUFE calcula el código único de una factura de la DIAN (SHA-384 en hexadecimal). Para las
+// notas crédito el mismo cálculo da el CUDE si key es el PIN del software en lugar de la clave
+// técnica de la resolución. Todos los impuestos del documento se informan como IVA.
+func CUFE(doc Document, key string) string {
+	supplierID, _ := SplitTaxID(doc.Supplier.TaxID)
+	customerID, _ := SplitTaxID(doc.Customer.TaxID)
+	if customerID == "" {
+		customerID = doc.Profile.FinalConsumerID
+	}
+
+	taxes := doc.TaxInclusive.Sub(doc.TaxExclusive)
+	input := doc.Number +
+		doc.IssuedAt.Format("2006-01-02") +
+		doc.IssuedAt.Format("15:04:05-07:00") +
+		FormatAmount(doc.LineExtension) +
+		taxIVA + FormatAmount(taxes) +
+		taxINC + "0.00" +
+		taxICA + "0.00" +
+		FormatAmount(doc.Payable) +
+		supplierID +
+		customerID +
+		key +
+		doc.Environment
+
+	sum := sha512.Sum384([]byte(input))
+	return hex.EncodeToString(sum[:])
+}
+
+// SoftwareSecurityCode es el código de seguridad del software que la DIAN pide en las
+// extensiones: SHA-384 del identificador del software, su PIN y el número del documento
+func SoftwareSecurityCode(softwareID, pin, number string) string {
+	sum := sha512.Sum384([]byte(softwareID + pin + number))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,391 @@
+package ubl
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"Veritasbackend/pkg/money"
+)
+
+// Tipos de documento
+const (
+	TypeInvoice    = "invoice"
+	TypeCreditNote = "credit_note"
+)
+
+const (
+	nsInvoice    = "urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"
+	nsCreditNote = "urn:oasis:names:specification:ubl:schema:xsd:CreditNote-2"
+	nsCAC        = "urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	nsCBC        = "urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"
+	nsEXT        = "urn:oasis:names:specification:ubl:schema:xsd:CommonExtensionComponents-2"
+	nsSTS        = "dian:gov:co:facturaelectronica:Structures-2-1"
+)
+
+// Profile son los códigos que cambian según la autoridad tributaria
+type Profile struct {
+	CustomizationID     string
+	InvoiceProfileID    string
+	CreditNoteProfileID string
+	InvoiceTypeCode     string
+	CreditNoteTypeCode  string
+	// TaxSchemeID y TaxSchemeName identifican el impuesto al valor agregado (IVA o IGV)
+	TaxSchemeID   string
+	TaxSchemeName string
+	UnitCode      string
+	// InvoiceUUIDScheme y CreditNoteUUIDScheme nombran el código único del documento; vacíos
+	// para no incluir cbc:UUID
+	InvoiceUUIDScheme    string
+	CreditNoteUUIDScheme string
+	// IDSchemes traduce el tipo de documento de identidad (NIT, CC, RUC, DNI, ...) al código de
+	// la autoridad; DefaultIDScheme se usa para los tipos desconocidos
+	IDSchemes       map[string]string
+	DefaultIDScheme string
+	// FinalConsumerID es la identificación genérica del consumidor final
+	FinalConsumerID string
+}
+
+// ProfileDIAN es el perfil de la factura electrónica de venta de Colombia
+var ProfileDIAN = Profile{
+	CustomizationID:      "10",
+	InvoiceProfileID:     "DIAN 2.1: Factura Electrónica de Venta",
+	CreditNoteProfileID:  "DIAN 2.1: Nota Crédito de Factura Electrónica de Venta",
+	InvoiceTypeCode:      "01",
+	CreditNoteTypeCode:   "91",
+	TaxSchemeID:          "01",
+	TaxSchemeName:        "IVA",
+	UnitCode:             "94",
+	InvoiceUUIDScheme:    "CUFE-SHA384",
+	CreditNoteUUIDScheme: "CUDE-SHA384",
+	IDSchemes: map[string]string{
+		"RC": "11", "TI": "12", "CC": "13", "TE": "21", "CE": "22", "NIT": "31",
+		"PASSPORT": "41", "PASAPORTE": "41", "DIE": "42", "PEP": "47", "NIT_EXT": "50",
+	},
+	DefaultIDScheme: "13",
+	FinalConsumerID: "222222222222",
+}
+
+// ProfileSUNAT es el perfil de la factura electrónica de Perú
+var ProfileSUNAT = Profile{
+	CustomizationID:     "2.0",
+	InvoiceProfileID:    "0101",
+	CreditNoteProfileID: "0101",
+	InvoiceTypeCode:     "01",
+	CreditNoteTypeCode:  "07",
+	TaxSchemeID:         "1000",
+	TaxSchemeName:       "IGV",
+	UnitCode:            "NIU",
+	IDSchemes: map[string]string{
+		"DNI": "1", "CE": "4", "RUC": "6", "PASSPORT": "7", "PASAPORTE": "7",
+	},
+	DefaultIDScheme: "0",
+	FinalConsumerID: "00000000",
+}
+
+// Party es el emisor o el adquiriente. TaxID puede traer el dígito de verificación después de un
+// guion (900123456-7).
+type Party struct {
+	Name    string
+	IDType  string
+	TaxID   string
+	Address string
+	Country string
+	Phone   string
+	Email   string
+}
+
+// Line es una línea del documento. LineExtension es la base sin impuestos después de los
+// descuentos, que se informan en Discount.
+type Line struct {
+	Description   string
+	Code          string
+	Quantity      float64
+	UnitPrice     money.Amount
+	Discount      money.Amount
+	LineExtension money.Amount
+	TaxPercent    float64
+	TaxAmount     money.Amount
+}
+
+// TaxSubtotal es el impuesto de una tarifa
+type TaxSubtotal struct {
+	Percent float64
+	Base    money.Amount
+	Amount  money.Amount
+}
+
+// Reference es la factura a la que se refiere una nota crédito
+type Reference struct {
+	Number    string
+	UUID      string
+	IssueDate time.Time
+}
+
+// Authorization es la resolución de numeración autorizada por la DIAN
+type Authorization struct {
+	Number    string
+	StartDate time.Time
+	EndDate   time.Time
+	Prefix    string
+	From      int64
+	To        int64
+}
+
+// DianExtensions son los datos de control que la DIAN pide en la primera extensión
+type DianExtensions struct {
+	Authorization        *Authorization
+	SoftwareProviderID   string
+	SoftwareID           string
+	SoftwareSecurityCode string
+	QRCode               string
+}
+
+// Document es una factura o una nota crédito. Environment es el ambiente de la autoridad (1
+// producción, 2 pruebas) y UUID el código único (CUFE o CUDE).
+type Document struct {
+	Type        string
+	Profile     Profile
+	Environment string
+	Number      string
+	UUID        string
+	IssuedAt    time.Time
+	Currency    string
+	Notes       []string
+	Supplier    Party
+	Customer    Party
+	Lines       []Line
+	Taxes       []TaxSubtotal
+	// Totales: LineExtension es la suma de las bases, TaxInclusive le suma los impuestos,
+	// Allowance son los descuentos ya restados de las bases y Payable el total a pagar
+	LineExtension money.Amount
+	TaxExclusive  money.Amount
+	TaxInclusive  money.Amount
+	Allowance     money.Amount
+	Payable       money.Amount
+	// Reference, DiscrepancyCode y DiscrepancyReason solo aplican a las notas crédito
+	Reference         *Reference
+	DiscrepancyCode   string
+	DiscrepancyReason string
+	Extensions        *DianExtensions
+}
+
+// Marshal genera el XML UBL 2.1 del documento. La última extensión queda vacía para la firma.
+func Marshal(doc Document) ([]byte, error) {
+	if doc.Type != TypeInvoice && doc.Type != TypeCreditNote {
+		return nil, fmt.Errorf("tipo de documento %q no soportado", doc.Type)
+	}
+	if doc.Type == TypeCreditNote && doc.Reference == nil {
+		return nil, fmt.Errorf("la nota crédito debe referir una factura")
+	}
+
+	p := doc.Profile
+	root := documentXML{
+		XMLNameCAC:         nsCAC,
+		XMLNameCBC:         nsCBC,
+		XMLNameEXT:         nsEXT,
+		UBLVersionID:       "UBL 2.1",
+		CustomizationID:    p.CustomizationID,
+		ProfileExecutionID: doc.Environment,
+		ID:                 doc.Number,
+		IssueDate:          doc.IssuedAt.Format("2006-01-02"),
+		IssueTime:          doc.IssuedAt.Format("15:04:05-07:00"),
+		Notes:              doc.Notes,
+		Currency:           doc.Currency,
+		LineCount:          len(doc.Lines),
+		Supplier:           partyXML{AccountID: "1", Party: p.party(doc.Supplier)},
+		Customer:           partyXML{Party: p.party(doc.Customer)},
+		PaymentMeans:       &paymentMeansXML{ID: "1", Code: "10"},
+	}
+
+	uuidScheme := p.InvoiceUUIDScheme
+	if doc.Type == TypeInvoice {
+		root.XMLName = xml.Name{Local: "Invoice"}
+		root.Xmlns = nsInvoice
+		root.ProfileID = p.InvoiceProfileID
+		root.InvoiceTypeCode = p.InvoiceTypeCode
+	} else {
+		uuidScheme = p.CreditNoteUUIDScheme
+		root.XMLName = xml.Name{Local: "CreditNote"}
+		root.Xmlns = nsCreditNote
+		root.ProfileID = p.CreditNoteProfileID
+		root.CreditNoteTypeCode = p.CreditNoteTypeCode
+		root.Discrepancy = &discrepancyXML{
+			ReferenceID:  doc.Reference.Number,
+			ResponseCode: doc.DiscrepancyCode,
+			Description:  doc.DiscrepancyReason,
+		}
+		reference := &documentReferenceXML{
+			ID:        doc.Reference.Number,
+			IssueDate: doc.Reference.IssueDate.Format("2006-01-02"),
+		}
+		if doc.Reference.UUID != "" {
+			reference.UUID = &uuidXML{SchemeName: p.InvoiceUUIDScheme, Value: doc.Reference.UUID}
+		}
+		root.BillingReference = &billingReferenceXML{Invoice: reference}
+	}
+	if uuidScheme != "" {
+		root.UUID = &uuidXML{SchemeID: doc.Environment, SchemeName: uuidScheme, Value: doc.UUID}
+	}
+
+	if doc.Extensions != nil {
+		root.XMLNameSTS = nsSTS
+		root.Extensions.Extensions = append(root.Extensions.Extensions, extensionXML{Content: extensionContentXML{Dian: dianExtensions(doc)}})
+	}
+	root.Extensions.Extensions = append(root.Extensions.Extensions, extensionXML{})
+
+	if len(doc.Taxes) > 0 {
+		total := taxTotalXML{}
+		for _, tax := range doc.Taxes {
+			total.Amount = total.Amount.Add(tax.Amount)
+			total.Subtotals = append(total.Subtotals, p.taxSubtotal(doc.Currency, tax))
+		}
+		root.TaxTotals = []taxTotalXML{total.withCurrency(doc.Currency)}
+	}
+
+	root.Totals = monetaryTotalXML{
+		LineExtension: amountOf(doc.Currency, doc.LineExtension),
+		TaxExclusive:  amountOf(doc.Currency, doc.TaxExclusive),
+		TaxInclusive:  amountOf(doc.Currency, doc.TaxInclusive),
+		Allowance:     amountOf(doc.Currency, doc.Allowance),
+		Payable:       amountOf(doc.Currency, doc.Payable),
+	}
+
+	for i, line := range doc.Lines {
+		l := lineXML{
+			ID:            strconv.Itoa(i + 1),
+			LineExtension: amountOf(doc.Currency, line.LineExtension),
+			Item:          itemXML{Description: line.Description},
+			Price: priceXML{
+				Amount:       amountOf(doc.Currency, line.UnitPrice),
+				BaseQuantity: quantityXML{UnitCode: p.UnitCode, Value: decimal(1)},
+			},
+		}
+		quantity := &quantityXML{UnitCode: p.UnitCode, Value: decimal(line.Quantity)}
+		if doc.Type == TypeInvoice {
+			l.InvoicedQuantity = quantity
+		} else {
+			l.CreditedQuantity = quantity
+		}
+		if line.Code != "" {
+			l.Item.Identification = &identificationXML{ID: line.Code}
+		}
+		if !line.Discount.IsZero() {
+			gross := line.LineExtension.Add(line.Discount)
+			l.AllowanceCharges = []allowanceChargeXML{{
+				ID:              "1",
+				ChargeIndicator: false,
+				Percent:         decimal(line.Discount.Ratio(gross) * 100),
+				Amount:          amountOf(doc.Currency, line.Discount),
+				BaseAmount:      amountOf(doc.Currency, gross),
+			}}
+		}
+		tax := TaxSubtotal{Percent: line.TaxPercent, Base: line.LineExtension, Amount: line.TaxAmount}
+		total := taxTotalXML{Amount: line.TaxAmount, Subtotals: []taxSubtotalXML{p.taxSubtotal(doc.Currency, tax)}}
+		l.TaxTotals = []taxTotalXML{total.withCurrency(doc.Currency)}
+
+		if doc.Type == TypeInvoice {
+			root.InvoiceLines = append(root.InvoiceLines, l)
+		} else {
+			root.CreditNoteLines = append(root.CreditNoteLines, l)
+		}
+	}
+
+	out, err := xml.Marshal(root)
+	if err != nil {
+		return nil, fmt.Errorf("error al generar el XML: %v", err)
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+// IDScheme es el código de la autoridad para un tipo de documento de identidad
+func (p Profile) IDScheme(idType string) string {
+	if code, ok := p.IDSchemes[strings.ToUpper(idType)]; ok {
+		return code
+	}
+	return p.DefaultIDScheme
+}
+
+func (p Profile) party(party Party) *partyDetailXML {
+	id, checkDigit := SplitTaxID(party.TaxID)
+	if id == "" {
+		id = p.FinalConsumerID
+	}
+	scheme := p.IDScheme(party.IDType)
+	company := companyIDXML{SchemeID: checkDigit, SchemeName: scheme, Value: id}
+
+	detail := &partyDetailXML{
+		Name: party.Name,
+		TaxScheme: partyTaxSchemeXML{
+			RegistrationName: party.Name,
+			CompanyID:        company,
+			TaxScheme:        taxSchemeXML{ID: p.TaxSchemeID, Name: p.TaxSchemeName},
+		},
+		LegalEntity: partyLegalEntityXML{RegistrationName: party.Name, CompanyID: company},
+	}
+	if party.Address != "" || party.Country != "" {
+		detail.Location = &locationXML{Address: addressXML{Line: party.Address, Country: party.Country}}
+	}
+	if party.Phone != "" || party.Email != "" {
+		detail.Contact = &contactXML{Telephone: party.Phone, Email: party.Email}
+	}
+	return detail
+}
+
+func (p Profile) taxSubtotal(currency string, tax TaxSubtotal) taxSubtotalXML {
+	return taxSubtotalXML{
+		Base:   amountOf(currency, tax.Base),
+		Amount: amountOf(currency, tax.Amount),
+		Category: taxCategoryXML{
+			Percent:   decimal(tax.Percent),
+			TaxScheme: taxSchemeXML{ID: p.TaxSchemeID, Name: p.TaxSchemeName},
+		},
+	}
+}
+
+func dianExtensions(doc Document) *dianExtensionsXML {
+	ext := doc.Extensions
+	result := &dianExtensionsXML{
+		Source: invoiceSourceXML{Country: countryCodeXML{ListAgencyID: "6", ListID: "ISO 3166-1", Value: "CO"}},
+		SoftwareProvider: softwareProviderXML{
+			ProviderID: providerIDXML{SchemeAgencyID: "195", Value: ext.SoftwareProviderID},
+			SoftwareID: softwareIDXML{SchemeAgencyID: "195", Value: ext.SoftwareID},
+		},
+		SecurityCode:          softwareIDXML{SchemeAgencyID: "195", Value: ext.SoftwareSecurityCode},
+		AuthorizationProvider: authorizationProviderXML{ID: providerIDXML{SchemeAgencyID: "195", Value: "800197268"}},
+		QRCode:                ext.QRCode,
+	}
+	if a := ext.Authorization; a != nil && doc.Type == TypeInvoice {
+		result.Control = &invoiceControlXML{
+			Authorization: a.Number,
+			Period:        periodXML{StartDate: a.StartDate.Format("2006-01-02"), EndDate: a.EndDate.Format("2006-01-02")},
+			Authorized:    authorizedXML{Prefix: a.Prefix, From: a.From, To: a.To},
+		}
+	}
+	return result
+}
+
+// SplitTaxID separa el dígito de verificación de un NIT escrito como 900123456-7
+func SplitTaxID(taxID string) (string, string) {
+	taxID = strings.TrimSpace(taxID)
+	if i := strings.LastIndex(taxID, "-"); i > 0 {
+		return strings.ReplaceAll(taxID[:i], ".", ""), taxID[i+1:]
+	}
+	return strings.ReplaceAll(taxID, ".", ""), ""
+}
+
+// FormatAmount escribe un importe con dos decimales y punto decimal, como lo piden el XML y el
+// CUFE
+func FormatAmount(a money.Amount) string {
+	return strconv.FormatFloat(a.Round(money.Currency{Decimals: 2}).Float64(), 'f', 2, 64)
+}
+
+func decimal(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
+
+func amountOf(currency string, a money.Amount) amountXML {
+	return amountXML{CurrencyID: currency, Value: FormatAmount(a)}
+}
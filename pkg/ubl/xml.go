@@ -0,0 +1,258 @@
+package ubl
+
+import (
+	"encoding/xml"
+
+	"Veritasbackend/pkg/money"
+)
+
+// Estructuras del XML. Los nombres llevan el prefijo del espacio de nombres, declarado en la
+// raíz, porque encoding/xml no permite elegir prefijos.
+
+type documentXML struct {
+	XMLName            xml.Name
+	Xmlns              string               `xml:"xmlns,attr"`
+	XMLNameCAC         string               `xml:"xmlns:cac,attr"`
+	XMLNameCBC         string               `xml:"xmlns:cbc,attr"`
+	XMLNameEXT         string               `xml:"xmlns:ext,attr"`
+	XMLNameSTS         string               `xml:"xmlns:sts,attr,omitempty"`
+	Extensions         extensionsXML        `xml:"ext:UBLExtensions"`
+	UBLVersionID       string               `xml:"cbc:UBLVersionID"`
+	CustomizationID    string               `xml:"cbc:CustomizationID"`
+	ProfileID          string               `xml:"cbc:ProfileID"`
+	ProfileExecutionID string               `xml:"cbc:ProfileExecutionID,omitempty"`
+	ID                 string               `xml:"cbc:ID"`
+	UUID               *uuidXML             `xml:"cbc:UUID,omitempty"`
+	IssueDate          string               `xml:"cbc:IssueDate"`
+	IssueTime          string               `xml:"cbc:IssueTime"`
+	InvoiceTypeCode    string               `xml:"cbc:InvoiceTypeCode,omitempty"`
+	CreditNoteTypeCode string               `xml:"cbc:CreditNoteTypeCode,omitempty"`
+	Notes              []string             `xml:"cbc:Note"`
+	Currency           string               `xml:"cbc:DocumentCurrencyCode"`
+	LineCount          int                  `xml:"cbc:LineCountNumeric"`
+	Discrepancy        *discrepancyXML      `xml:"cac:DiscrepancyResponse,omitempty"`
+	BillingReference   *billingReferenceXML `xml:"cac:BillingReference,omitempty"`
+	Supplier           partyXML             `xml:"cac:AccountingSupplierParty"`
+	Customer           partyXML             `xml:"cac:AccountingCustomerParty"`
+	PaymentMeans       *paymentMeansXML     `xml:"cac:PaymentMeans,omitempty"`
+	TaxTotals          []taxTotalXML        `xml:"cac:TaxTotal"`
+	Totals             monetaryTotalXML     `xml:"cac:LegalMonetaryTotal"`
+	InvoiceLines       []lineXML            `xml:"cac:InvoiceLine"`
+	CreditNoteLines    []lineXML            `xml:"cac:CreditNoteLine"`
+}
+
+type extensionsXML struct {
+	Extensions []extensionXML `xml:"ext:UBLExtension"`
+}
+
+type extensionXML struct {
+	Content extensionContentXML `xml:"ext:ExtensionContent"`
+}
+
+type extensionContentXML struct {
+	Dian *dianExtensionsXML `xml:"sts:DianExtensions,omitempty"`
+}
+
+type dianExtensionsXML struct {
+	Control               *invoiceControlXML       `xml:"sts:InvoiceControl,omitempty"`
+	Source                invoiceSourceXML         `xml:"sts:InvoiceSource"`
+	SoftwareProvider      softwareProviderXML      `xml:"sts:SoftwareProvider"`
+	SecurityCode          softwareIDXML            `xml:"sts:SoftwareSecurityCode"`
+	AuthorizationProvider authorizationProviderXML `xml:"sts:AuthorizationProvider"`
+	QRCode                string                   `xml:"sts:QRCode,omitempty"`
+}
+
+type invoiceControlXML struct {
+	Authorization string        `xml:"sts:InvoiceAuthorization"`
+	Period        periodXML     `xml:"sts:AuthorizationPeriod"`
+	Authorized    authorizedXML `xml:"sts:AuthorizedInvoices"`
+}
+
+type periodXML struct {
+	StartDate string `xml:"cbc:StartDate"`
+	EndDate   string `xml:"cbc:EndDate"`
+}
+
+type authorizedXML struct {
+	Prefix string `xml:"sts:Prefix,omitempty"`
+	From   int64  `xml:"sts:From"`
+	To     int64  `xml:"sts:To"`
+}
+
+type invoiceSourceXML struct {
+	Country countryCodeXML `xml:"cbc:IdentificationCode"`
+}
+
+type countryCodeXML struct {
+	ListAgencyID string `xml:"listAgencyID,attr"`
+	ListID       string `xml:"listID,attr"`
+	Value        string `xml:",chardata"`
+}
+
+type softwareProviderXML struct {
+	ProviderID providerIDXML `xml:"sts:ProviderID"`
+	SoftwareID softwareIDXML `xml:"sts:SoftwareID"`
+}
+
+type providerIDXML struct {
+	SchemeAgencyID string `xml:"schemeAgencyID,attr"`
+	Value          string `xml:",chardata"`
+}
+
+type softwareIDXML struct {
+	SchemeAgencyID string `xml:"schemeAgencyID,attr"`
+	Value          string `xml:",chardata"`
+}
+
+type authorizationProviderXML struct {
+	ID providerIDXML `xml:"sts:AuthorizationProviderID"`
+}
+
+type uuidXML struct {
+	SchemeID   string `xml:"schemeID,attr,omitempty"`
+	SchemeName string `xml:"schemeName,attr,omitempty"`
+	Value      string `xml:",chardata"`
+}
+
+type discrepancyXML struct {
+	ReferenceID  string `xml:"cbc:ReferenceID"`
+	ResponseCode string `xml:"cbc:ResponseCode"`
+	Description  string `xml:"cbc:Description"`
+}
+
+type billingReferenceXML struct {
+	Invoice *documentReferenceXML `xml:"cac:InvoiceDocumentReference"`
+}
+
+type documentReferenceXML struct {
+	ID        string   `xml:"cbc:ID"`
+	UUID      *uuidXML `xml:"cbc:UUID,omitempty"`
+	IssueDate string   `xml:"cbc:IssueDate"`
+}
+
+type partyXML struct {
+	AccountID string          `xml:"cbc:AdditionalAccountID,omitempty"`
+	Party     *partyDetailXML `xml:"cac:Party"`
+}
+
+type partyDetailXML struct {
+	Name        string              `xml:"cac:PartyName>cbc:Name"`
+	Location    *locationXML        `xml:"cac:PhysicalLocation,omitempty"`
+	TaxScheme   partyTaxSchemeXML   `xml:"cac:PartyTaxScheme"`
+	LegalEntity partyLegalEntityXML `xml:"cac:PartyLegalEntity"`
+	Contact     *contactXML         `xml:"cac:Contact,omitempty"`
+}
+
+type locationXML struct {
+	Address addressXML `xml:"cac:Address"`
+}
+
+type addressXML struct {
+	Line    string `xml:"cac:AddressLine>cbc:Line,omitempty"`
+	Country string `xml:"cac:Country>cbc:IdentificationCode,omitempty"`
+}
+
+type partyTaxSchemeXML struct {
+	RegistrationName string       `xml:"cbc:RegistrationName"`
+	CompanyID        companyIDXML `xml:"cbc:CompanyID"`
+	TaxScheme        taxSchemeXML `xml:"cac:TaxScheme"`
+}
+
+type partyLegalEntityXML struct {
+	RegistrationName string       `xml:"cbc:RegistrationName"`
+	CompanyID        companyIDXML `xml:"cbc:CompanyID"`
+}
+
+type companyIDXML struct {
+	SchemeID   string `xml:"schemeID,attr,omitempty"`
+	SchemeName string `xml:"schemeName,attr,omitempty"`
+	Value      string `xml:",chardata"`
+}
+
+type contactXML struct {
+	Telephone string `xml:"cbc:Telephone,omitempty"`
+	Email     string `xml:"cbc:ElectronicMail,omitempty"`
+}
+
+type taxSchemeXML struct {
+	ID   string `xml:"cbc:ID"`
+	Name string `xml:"cbc:Name"`
+}
+
+type paymentMeansXML struct {
+	ID   string `xml:"cbc:ID"`
+	Code string `xml:"cbc:PaymentMeansCode"`
+}
+
+type taxTotalXML struct {
+	Amount    money.Amount     `xml:"-"`
+	TaxAmount amountXML        `xml:"cbc:TaxAmount"`
+	Subtotals []taxSubtotalXML `xml:"cac:TaxSubtotal"`
+}
+
+func (t taxTotalXML) withCurrency(currency string) taxTotalXML {
+	t.TaxAmount = amountOf(currency, t.Amount)
+	return t
+}
+
+type taxSubtotalXML struct {
+	Base     amountXML      `xml:"cbc:TaxableAmount"`
+	Amount   amountXML      `xml:"cbc:TaxAmount"`
+	Category taxCategoryXML `xml:"cac:TaxCategory"`
+}
+
+type taxCategoryXML struct {
+	Percent   string       `xml:"cbc:Percent"`
+	TaxScheme taxSchemeXML `xml:"cac:TaxScheme"`
+}
+
+type monetaryTotalXML struct {
+	LineExtension amountXML `xml:"cbc:LineExtensionAmount"`
+	TaxExclusive  amountXML `xml:"cbc:TaxExclusiveAmount"`
+	TaxInclusive  amountXML `xml:"cbc:TaxInclusiveAmount"`
+	Allowance     amountXML `xml:"cbc:AllowanceTotalAmount"`
+	Payable       amountXML `xml:"cbc:PayableAmount"`
+}
+
+type amountXML struct {
+	CurrencyID string `xml:"currencyID,attr"`
+	Value      string `xml:",chardata"`
+}
+
+type quantityXML struct {
+	UnitCode string `xml:"unitCode,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type lineXML struct {
+	ID               string               `xml:"cbc:ID"`
+	InvoicedQuantity *quantityXML         `xml:"cbc:InvoicedQuantity,omitempty"`
+	CreditedQuantity *quantityXML         `xml:"cbc:CreditedQuantity,omitempty"`
+	LineExtension    amountXML            `xml:"cbc:LineExtensionAmount"`
+	AllowanceCharges []allowanceChargeXML `xml:"cac:AllowanceCharge"`
+	TaxTotals        []taxTotalXML        `xml:"cac:TaxTotal"`
+	Item             itemXML              `xml:"cac:Item"`
+	Price            priceXML             `xml:"cac:Price"`
+}
+
+type allowanceChargeXML struct {
+	ID              string    `xml:"cbc:ID"`
+	ChargeIndicator bool      `xml:"cbc:ChargeIndicator"`
+	Percent         string    `xml:"cbc:MultiplierFactorNumeric"`
+	Amount          amountXML `xml:"cbc:Amount"`
+	BaseAmount      amountXML `xml:"cbc:BaseAmount"`
+}
+
+type itemXML struct {
+	Description    string             `xml:"cbc:Description"`
+	Identification *identificationXML `xml:"cac:StandardItemIdentification,omitempty"`
+}
+
+type identificationXML struct {
+	ID string `xml:"cbc:ID"`
+}
+
+type priceXML struct {
+	Amount       amountXML   `xml:"cbc:PriceAmount"`
+	BaseQuantity quantityXML `xml:"cbc:BaseQuantity"`
+}
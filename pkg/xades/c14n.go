@@ -0,0 +1,375 @@
+package xades
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// matcher elige un elemento por su nombre con prefijo y sus atributos
+type matcher func(name string, attrs []xml.Attr) bool
+
+// byName elige los elementos con ese nombre con prefijo (ds:Signature)
+func byName(name string) matcher {
+	return func(n string, _ []xml.Attr) bool {
+		return n == name
+	}
+}
+
+// byID elige el elemento con ese atributo Id
+func byID(id string) matcher {
+	return func(_ string, attrs []xml.Attr) bool {
+		for _, attr := range attrs {
+			if attr.Name.Space == "" && attr.Name.Local == "Id" && attr.Value == id {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// canonicalize devuelve la forma canónica (C14N 1.0 inclusiva, sin comentarios) del primer
+// elemento que cumple apex, o del documento completo si apex es nil. Los elementos que cumplen
+// exclude se omiten con todo su contenido, como pide la transformación enveloped-signature.
+// No se procesa el DTD: los atributos por defecto y las entidades que declare no se aplican.
+func canonicalize(doc []byte, apex, exclude matcher) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(normalizeAttributeWhitespace(doc)))
+	decoder.CharsetReader = charsetReader
+
+	var out bytes.Buffer
+	// scopes son los espacios de nombres declarados en cada elemento abierto; rendered, los que
+	// ya escribió el ancestro de la salida más cercano. xmlAttrs son los atributos xml:* de cada
+	// elemento abierto, que el elemento apex hereda de sus ancestros.
+	var scopes []map[string]string
+	var rendered []map[string]string
+	var xmlAttrs [][]xml.Attr
+	depth := 0
+	outputDepth := -1
+	skipDepth := -1
+	// Del documento completo se escriben también las instrucciones de procesamiento de fuera
+	// del elemento raíz, separadas de él por un salto de línea
+	afterRoot := false
+
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("XML inválido: %v", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			declared := make(map[string]string)
+			var attrs, inherited []xml.Attr
+			for _, attr := range t.Attr {
+				switch {
+				case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+					declared[""] = attr.Value
+				case attr.Name.Space == "xmlns":
+					declared[attr.Name.Local] = attr.Value
+				default:
+					attrs = append(attrs, attr)
+					if attr.Name.Space == "xml" {
+						inherited = append(inherited, attr)
+					}
+				}
+			}
+			scopes = append(scopes, declared)
+			xmlAttrs = append(xmlAttrs, inherited)
+
+			if skipDepth >= 0 {
+				continue
+			}
+			name := qualified(t.Name)
+			if outputDepth < 0 && (apex == nil || apex(name, t.Attr)) {
+				outputDepth = depth
+				rendered = append(rendered, map[string]string{})
+				attrs = inheritXMLAttrs(attrs, xmlAttrs[:len(xmlAttrs)-1])
+			}
+			if outputDepth < 0 {
+				continue
+			}
+			if exclude != nil && exclude(name, t.Attr) {
+				skipDepth = depth
+				continue
+			}
+
+			inScope := resolveScope(scopes)
+			parent := rendered[len(rendered)-1]
+			current := make(map[string]string, len(parent))
+			for prefix, uri := range parent {
+				current[prefix] = uri
+			}
+
+			var prefixes []string
+			for prefix, uri := range inScope {
+				if value, ok := parent[prefix]; ok && value == uri {
+					continue
+				}
+				if _, ok := parent[prefix]; !ok && prefix == "" && uri == "" {
+					continue
+				}
+				prefixes = append(prefixes, prefix)
+				current[prefix] = uri
+			}
+			sort.Strings(prefixes)
+			rendered = append(rendered, current)
+
+			sort.SliceStable(attrs, func(i, j int) bool {
+				si, sj := attrNamespace(attrs[i], inScope), attrNamespace(attrs[j], inScope)
+				if si != sj {
+					return si < sj
+				}
+				return attrs[i].Name.Local < attrs[j].Name.Local
+			})
+
+			out.WriteString("<" + name)
+			for _, prefix := range prefixes {
+				if prefix == "" {
+					out.WriteString(` xmlns="` + escapeAttr(inScope[prefix]) + `"`)
+				} else {
+					out.WriteString(" xmlns:" + prefix + `="` + escapeAttr(inScope[prefix]) + `"`)
+				}
+			}
+			for _, attr := range attrs {
+				out.WriteString(" " + qualified(attr.Name) + `="` + escapeAttr(attr.Value) + `"`)
+			}
+			out.WriteString(">")
+
+		case xml.EndElement:
+			if skipDepth >= 0 || outputDepth < 0 {
+				if skipDepth == depth {
+					skipDepth = -1
+				}
+				scopes = scopes[:len(scopes)-1]
+				xmlAttrs = xmlAttrs[:len(xmlAttrs)-1]
+				depth--
+				continue
+			}
+			out.WriteString("</" + qualified(t.Name) + ">")
+			rendered = rendered[:len(rendered)-1]
+			scopes = scopes[:len(scopes)-1]
+			xmlAttrs = xmlAttrs[:len(xmlAttrs)-1]
+			if depth == outputDepth {
+				if apex != nil {
+					return out.Bytes(), nil
+				}
+				afterRoot = true
+				outputDepth = -1
+			}
+			depth--
+
+		case xml.CharData:
+			if outputDepth >= 0 && skipDepth < 0 {
+				out.WriteString(escapeText(string(t)))
+			}
+
+		case xml.ProcInst:
+			// La declaración XML no es una instrucción de procesamiento
+			if t.Target == "xml" || skipDepth >= 0 {
+				continue
+			}
+			pi := "<?" + t.Target
+			if len(t.Inst) > 0 {
+				pi += " " + string(t.Inst)
+			}
+			pi += "?>"
+
+			switch {
+			case outputDepth >= 0:
+				out.WriteString(pi)
+			case apex == nil && afterRoot:
+				out.WriteString("\n" + pi)
+			case apex == nil && depth == 0:
+				out.WriteString(pi + "\n")
+			}
+		}
+	}
+
+	if afterRoot {
+		return out.Bytes(), nil
+	}
+	if outputDepth < 0 {
+		return nil, fmt.Errorf("elemento a canonicalizar no encontrado")
+	}
+	return nil, fmt.Errorf("XML incompleto")
+}
+
+// charsetReader convierte a UTF-8 los documentos declarados en ISO-8859-1; la forma canónica
+// siempre va en UTF-8
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "iso-8859-1", "latin1":
+		data, err := io.ReadAll(input)
+		if err != nil {
+			return nil, err
+		}
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+		}
+		return strings.NewReader(string(runes)), nil
+	}
+	return nil, fmt.Errorf("codificación %s no soportada", charset)
+}
+
+// inheritXMLAttrs agrega al elemento apex los atributos xml:* (xml:lang, xml:space...) de sus
+// ancestros que no declara él mismo; ancestors va del más externo al más interno
+func inheritXMLAttrs(attrs []xml.Attr, ancestors [][]xml.Attr) []xml.Attr {
+	present := make(map[string]bool)
+	for _, attr := range attrs {
+		if attr.Name.Space == "xml" {
+			present[attr.Name.Local] = true
+		}
+	}
+
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		for _, attr := range ancestors[i] {
+			if !present[attr.Name.Local] {
+				present[attr.Name.Local] = true
+				attrs = append(attrs, attr)
+			}
+		}
+	}
+	return attrs
+}
+
+// normalizeAttributeWhitespace hace la normalización de valores de atributo que exige XML y que
+// encoding/xml no hace: los tabuladores y saltos de línea escritos literalmente en un atributo
+// son espacios. Los escritos como referencia (&#x9;, &#xA;) se conservan.
+func normalizeAttributeWhitespace(doc []byte) []byte {
+	out := make([]byte, 0, len(doc))
+	for i := 0; i < len(doc); {
+		if doc[i] != '<' {
+			out = append(out, doc[i])
+			i++
+			continue
+		}
+
+		// Comentarios, CDATA, instrucciones de procesamiento y el DTD se copian sin cambios
+		rest := doc[i:]
+		end := -1
+		switch {
+		case bytes.HasPrefix(rest, []byte("<!--")):
+			end = indexAfter(rest, "-->")
+		case bytes.HasPrefix(rest, []byte("<![CDATA[")):
+			end = indexAfter(rest, "]]>")
+		case bytes.HasPrefix(rest, []byte("<?")):
+			end = indexAfter(rest, "?>")
+		case bytes.HasPrefix(rest, []byte("<!")):
+			end = declarationEnd(rest)
+		}
+		if end >= 0 {
+			out = append(out, rest[:end]...)
+			i += end
+			continue
+		}
+
+		// Etiqueta: se normalizan los valores entre comillas hasta el > que la cierra
+		var quote byte
+		for i < len(doc) {
+			c := doc[i]
+			i++
+			switch {
+			case quote != 0 && c == quote:
+				quote = 0
+			case quote != 0 && c == '\r':
+				if i < len(doc) && doc[i] == '\n' {
+					i++
+				}
+				c = ' '
+			case quote != 0 && (c == '\n' || c == '\t'):
+				c = ' '
+			case quote == 0 && (c == '"' || c == '\''):
+				quote = c
+			}
+			out = append(out, c)
+			if quote == 0 && c == '>' {
+				break
+			}
+		}
+	}
+	return out
+}
+
+// indexAfter es la posición siguiente al final de la primera aparición de marker, o el largo de
+// data si no aparece
+func indexAfter(data []byte, marker string) int {
+	at := bytes.Index(data, []byte(marker))
+	if at < 0 {
+		return len(data)
+	}
+	return at + len(marker)
+}
+
+// declarationEnd es la posición siguiente al > que cierra una declaración como <!DOCTYPE ...>,
+// saltando el subconjunto interno entre corchetes
+func declarationEnd(data []byte) int {
+	depth := 0
+	var quote byte
+	for i, c := range data {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		case c == '>' && depth == 0:
+			return i + 1
+		}
+	}
+	return len(data)
+}
+
+// resolveScope une las declaraciones de espacios de nombres vigentes; las más internas ganan
+func resolveScope(scopes []map[string]string) map[string]string {
+	result := make(map[string]string)
+	for _, scope := range scopes {
+		for prefix, uri := range scope {
+			result[prefix] = uri
+		}
+	}
+	return result
+}
+
+// attrNamespace es el URI del espacio de nombres de un atributo; los atributos sin prefijo no
+// tienen espacio de nombres
+func attrNamespace(attr xml.Attr, scope map[string]string) string {
+	if attr.Name.Space == "" {
+		return ""
+	}
+	if attr.Name.Space == "xml" {
+		return "http://www.w3.org/XML/1998/namespace"
+	}
+	return scope[attr.Name.Space]
+}
+
+func qualified(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+
+var attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", `"`, "&quot;", "\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;")
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func escapeAttr(s string) string {
+	return attrEscaper.Replace(s)
+}
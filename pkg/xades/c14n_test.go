@@ -0,0 +1,215 @@
+package xades
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// Ejemplos de la recomendación C14N 1.0 (https://www.w3.org/TR/xml-c14n#Examples), en su forma
+// sin comentarios. canonicalize no procesa el DTD, así que de los ejemplos 3.3 y 3.4 se quitaron
+// el DTD y lo que depende de él (el atributo por defecto de e9 y los atributos de tipo ID y
+// NMTOKENS); el resto de la salida es la del documento.
+var w3cExamples = []struct {
+	name  string
+	input string
+	want  string
+}{
+	{
+		name: "3.1 PIs, comentarios y fuera del elemento raíz",
+		input: `<?xml version="1.0"?>
+
+<?xml-stylesheet   href="doc.xsl"
+   type="text/xsl"   ?>
+
+<!DOCTYPE doc SYSTEM "doc.dtd">
+
+<doc>Hello, world!<!-- Comment 1 --></doc>
+
+<?pi-without-data     ?>
+
+<!-- Comment 2 -->
+
+<!-- Comment 3 -->`,
+		want: `<?xml-stylesheet href="doc.xsl"
+   type="text/xsl"   ?>
+<doc>Hello, world!</doc>
+<?pi-without-data?>`,
+	},
+	{
+		name: "3.2 espacios en el contenido",
+		input: `<doc>
+   <clean>   </clean>
+   <dirty>   A   B   </dirty>
+   <mixed>
+      A
+      <clean>   </clean>
+      B
+      <dirty>   A   B   </dirty>
+      C
+   </mixed>
+</doc>`,
+		want: `<doc>
+   <clean>   </clean>
+   <dirty>   A   B   </dirty>
+   <mixed>
+      A
+      <clean>   </clean>
+      B
+      <dirty>   A   B   </dirty>
+      C
+   </mixed>
+</doc>`,
+	},
+	{
+		name: "3.3 etiquetas de apertura y cierre",
+		input: `<doc>
+   <e1   />
+   <e2   ></e2>
+   <e3   name = "elem3"   id="elem3"   />
+   <e4   name="elem4"   id="elem4"   ></e4>
+   <e5 a:attr="out" b:attr="sorted" attr2="all" attr="I'm"
+      xmlns:b="http://www.ietf.org"
+      xmlns:a="http://www.w3.org"
+      xmlns="http://example.org"/>
+   <e6 xmlns="" xmlns:a="http://www.w3.org">
+      <e7 xmlns="http://www.ietf.org">
+         <e8 xmlns="" xmlns:a="http://www.w3.org">
+            <e9 xmlns="" xmlns:a="http://www.ietf.org"/>
+         </e8>
+      </e7>
+   </e6>
+</doc>`,
+		want: `<doc>
+   <e1></e1>
+   <e2></e2>
+   <e3 id="elem3" name="elem3"></e3>
+   <e4 id="elem4" name="elem4"></e4>
+   <e5 xmlns="http://example.org" xmlns:a="http://www.w3.org" xmlns:b="http://www.ietf.org" attr="I'm" attr2="all" b:attr="sorted" a:attr="out"></e5>
+   <e6 xmlns:a="http://www.w3.org">
+      <e7 xmlns="http://www.ietf.org">
+         <e8 xmlns="">
+            <e9 xmlns:a="http://www.ietf.org"></e9>
+         </e8>
+      </e7>
+   </e6>
+</doc>`,
+	},
+	{
+		name: "3.4 caracteres, CDATA y normalización de atributos",
+		input: `<doc>
+   <text>First line&#x0d;&#10;Second line</text>
+   <value>&#x32;</value>
+   <compute><![CDATA[value>"0" && value<"10" ?"valid":"error"]]></compute>
+   <compute expr='value>"0" &amp;&amp; value&lt;"10" ?"valid":"error"'>valid</compute>
+   <norm attr=' &apos;   &#x20;&#13;&#xa;&#9;   &apos; '/>
+</doc>`,
+		want: `<doc>
+   <text>First line&#xD;
+Second line</text>
+   <value>2</value>
+   <compute>value&gt;"0" &amp;&amp; value&lt;"10" ?"valid":"error"</compute>
+   <compute expr="value>&quot;0&quot; &amp;&amp; value&lt;&quot;10&quot; ?&quot;valid&quot;:&quot;error&quot;">valid</compute>
+   <norm attr=" '    &#xD;&#xA;&#x9;   ' "></norm>
+</doc>`,
+	},
+	{
+		name:  "3.6 codificación UTF-8",
+		input: "<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?>\n<doc>&#169;</doc>",
+		want:  "<doc>©</doc>",
+	},
+}
+
+func TestCanonicalizeW3CExamples(t *testing.T) {
+	for _, tt := range w3cExamples {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := canonicalize([]byte(tt.input), nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Fatalf("forma canónica distinta\nobtenida:\n%s\nesperada:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalizeLiteralWhitespaceInAttributes(t *testing.T) {
+	input := "<doc><e a=\"x\ty\r\nz\nw\" b='&#9;&#10;'/><!-- <e c=\"\t\"/> --></doc>"
+	want := `<doc><e a="x y z w" b="&#x9;&#xA;"></e></doc>`
+
+	got, err := canonicalize([]byte(input), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != want {
+		t.Fatalf("obtenida %s, esperada %s", got, want)
+	}
+}
+
+// Un subconjunto del documento lleva en su elemento raíz todos los espacios de nombres vigentes
+// y los atributos xml:* heredados de sus ancestros
+func TestCanonicalizeSubset(t *testing.T) {
+	input := `<a:root xmlns:a="urn:a" xmlns="urn:default" xml:lang="es" xml:space="preserve">
+  <b:parent xmlns:b="urn:b" xmlns:unused="urn:unused" xml:lang="en">
+    <b:child Id="target" z="1" a:attr="2"><a:leaf xmlns:a="urn:a"/><inner xmlns="urn:default"/></b:child>
+  </b:parent>
+</a:root>`
+	want := `<b:child xmlns="urn:default" xmlns:a="urn:a" xmlns:b="urn:b" xmlns:unused="urn:unused" Id="target" z="1" xml:lang="en" xml:space="preserve" a:attr="2"><a:leaf></a:leaf><inner></inner></b:child>`
+
+	got, err := canonicalize([]byte(input), byID("target"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != want {
+		t.Fatalf("obtenida:\n%s\nesperada:\n%s", got, want)
+	}
+
+	if _, err := canonicalize([]byte(input), byID("missing"), nil); err == nil {
+		t.Fatal("se esperaba error para un elemento que no existe")
+	}
+}
+
+func TestCanonicalizeExcludesEnvelopedSignature(t *testing.T) {
+	input := `<doc xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><a>1</a><ds:Signature><ds:SignedInfo/><?pi inside?></ds:Signature><b>2</b></doc>`
+	want := `<doc xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><a>1</a><b>2</b></doc>`
+
+	got, err := canonicalize([]byte(input), nil, byName("ds:Signature"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != want {
+		t.Fatalf("obtenida %s, esperada %s", got, want)
+	}
+}
+
+// La forma canónica esperada de testdata/invoice.xml se generó con libxml2 (xmllint --c14n)
+func TestCanonicalizeUBLInvoice(t *testing.T) {
+	input := readTestdata(t, "invoice.xml")
+	want := readTestdata(t, "invoice.c14n.xml")
+
+	got, err := canonicalize(input, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("forma canónica distinta\nobtenida:\n%s\nesperada:\n%s", got, want)
+	}
+
+	again, err := canonicalize(got, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(again, got) {
+		t.Fatal("canonicalizar la forma canónica la cambió")
+	}
+}
+
+func readTestdata(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
@@ -0,0 +1,103 @@
+package xades
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	"golang.org/x/crypto/pkcs12"
+)
+
+// Certificate es el certificado de firma con su llave privada RSA
+type Certificate struct {
+	Leaf *x509.Certificate
+	Key  *rsa.PrivateKey
+}
+
+// LoadCertificate lee un archivo PKCS#12 (.p12 o .pfx) protegido con contraseña, o un PEM con el
+// certificado y la llave privada sin cifrar. Si el archivo trae la cadena de certificación se
+// usa el certificado que corresponde a la llave.
+func LoadCertificate(data []byte, password string) (*Certificate, error) {
+	var blocks []*pem.Block
+	if bytes.Contains(data, []byte("-----BEGIN")) {
+		for rest := data; ; {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			blocks = append(blocks, block)
+		}
+	} else {
+		var err error
+		blocks, err = pkcs12.ToPEM(data, password)
+		if err == pkcs12.ErrIncorrectPassword {
+			return nil, fmt.Errorf("la contraseña del certificado es incorrecta")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("el certificado debe ser un archivo PKCS#12 o PEM: %v", err)
+		}
+	}
+
+	var certs []*x509.Certificate
+	var key *rsa.PrivateKey
+	for _, block := range blocks {
+		switch block.Type {
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("certificado inválido: %v", err)
+			}
+			certs = append(certs, cert)
+		case "PRIVATE KEY", "RSA PRIVATE KEY":
+			parsed, err := parsePrivateKey(block.Bytes)
+			if err != nil {
+				return nil, err
+			}
+			key = parsed
+		}
+	}
+
+	if key == nil {
+		return nil, fmt.Errorf("el archivo no contiene la llave privada")
+	}
+	for _, cert := range certs {
+		if public, ok := cert.PublicKey.(*rsa.PublicKey); ok && public.Equal(&key.PublicKey) {
+			return &Certificate{Leaf: cert, Key: key}, nil
+		}
+	}
+	return nil, fmt.Errorf("el archivo no contiene el certificado de la llave privada")
+}
+
+// ParsePEM lee un certificado guardado con PEM
+func ParsePEM(certPEM, keyPEM []byte) (*Certificate, error) {
+	return LoadCertificate(append(append([]byte{}, certPEM...), keyPEM...), "")
+}
+
+// PEM devuelve el certificado y la llave en PEM (la llave en PKCS#8) para guardarlos
+func (c *Certificate) PEM() ([]byte, []byte, error) {
+	key, err := x509.MarshalPKCS8PrivateKey(c.Key)
+	if err != nil {
+		return nil, nil, err
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Leaf.Raw})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key})
+	return certPEM, keyPEM, nil
+}
+
+func parsePrivateKey(der []byte) (*rsa.PrivateKey, error) {
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("llave privada inválida: %v", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("la llave privada debe ser RSA")
+	}
+	return rsaKey, nil
+}
@@ -0,0 +1,82 @@
+package xades
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+type signatureXML struct {
+	SignedInfo struct {
+		References []struct {
+			URI         string `xml:"URI,attr"`
+			DigestValue string `xml:"DigestValue"`
+		} `xml:"Reference"`
+	} `xml:"SignedInfo"`
+	SignatureValue string `xml:"SignatureValue"`
+	Certificate    string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+
+// Verify comprueba una firma hecha con Sign: los resúmenes de cada referencia y el valor de la
+// firma con el certificado incluido. Devuelve el certificado del firmante; no valida su cadena.
+func Verify(doc []byte) (*x509.Certificate, error) {
+	part, err := canonicalize(doc, byName("ds:Signature"), nil)
+	if err != nil {
+		return nil, fmt.Errorf("el documento no está firmado")
+	}
+	var sig signatureXML
+	if err := xml.Unmarshal(part, &sig); err != nil {
+		return nil, fmt.Errorf("firma inválida: %v", err)
+	}
+	if len(sig.SignedInfo.References) == 0 {
+		return nil, fmt.Errorf("la firma no tiene referencias")
+	}
+
+	for _, ref := range sig.SignedInfo.References {
+		var content []byte
+		if ref.URI == "" {
+			content, err = canonicalize(doc, nil, byName("ds:Signature"))
+		} else {
+			content, err = canonicalize(doc, byID(strings.TrimPrefix(ref.URI, "#")), nil)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("referencia %q: %v", ref.URI, err)
+		}
+		if digest(content) != strings.TrimSpace(ref.DigestValue) {
+			return nil, fmt.Errorf("el resumen de la referencia %q no coincide", ref.URI)
+		}
+	}
+
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(sig.Certificate), ""))
+	if err != nil {
+		return nil, fmt.Errorf("certificado de la firma inválido: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("certificado de la firma inválido: %v", err)
+	}
+	public, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("el certificado de la firma debe ser RSA")
+	}
+
+	signedInfo, err := canonicalize(doc, byName("ds:SignedInfo"), nil)
+	if err != nil {
+		return nil, err
+	}
+	value, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(sig.SignatureValue), ""))
+	if err != nil {
+		return nil, fmt.Errorf("valor de la firma inválido: %v", err)
+	}
+	hashed := sha256.Sum256(signedInfo)
+	if err := rsa.VerifyPKCS1v15(public, crypto.SHA256, hashed[:], value); err != nil {
+		return nil, fmt.Errorf("la firma no corresponde al documento")
+	}
+
+	return cert, nil
+}
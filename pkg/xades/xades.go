@@ -0,0 +1,204 @@
+package xades
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Algoritmos de la firma: C14N 1.0 inclusiva, RSA con SHA-256 y resúmenes SHA-256
+const (
+	algC14N              = "http://www.w3.org/TR/2001/REC-xml-c14n-20010315"
+	algRSASHA256         = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	algSHA256            = "http://www.w3.org/2001/04/xmlenc#sha256"
+	algEnveloped         = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+	nsDS                 = "http://www.w3.org/2000/09/xmldsig#"
+	nsXAdES              = "http://uri.etsi.org/01903/v1.3.2#"
+	typeSignedProperties = "http://uri.etsi.org/01903#SignedProperties"
+)
+
+// Placeholder es el elemento vacío del documento donde se inserta la firma; en UBL es la última
+// extensión de ext:UBLExtensions
+const Placeholder = "<ext:ExtensionContent></ext:ExtensionContent>"
+
+// Policy es la política de firma que exige la autoridad tributaria; Digest es el SHA-256 del
+// documento de la política en base64
+type Policy struct {
+	Identifier  string
+	Description string
+	Digest      string
+}
+
+type Options struct {
+	SigningTime time.Time
+	// Policy es opcional; sin ella la firma es XAdES-BES sin política explícita
+	Policy *Policy
+	// Role es el rol del firmante, por ejemplo supplier
+	Role string
+}
+
+// Signed es el documento firmado. DigestValue es el resumen del documento en base64, el "hash"
+// que algunas autoridades piden imprimir en la representación gráfica.
+type Signed struct {
+	XML            []byte
+	DigestValue    string
+	SignatureValue string
+}
+
+// Sign firma el documento con una firma XAdES-BES envuelta (enveloped). La firma se inserta en
+// el último Placeholder del documento y cubre el documento completo, el certificado (KeyInfo) y
+// las propiedades firmadas.
+func Sign(doc []byte, cert *Certificate, opts Options) (*Signed, error) {
+	canonical, err := canonicalize(doc, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	at := bytes.LastIndex(canonical, []byte(Placeholder))
+	if at < 0 {
+		return nil, fmt.Errorf("el documento no tiene dónde insertar la firma")
+	}
+	at += len("<ext:ExtensionContent>")
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	sig := signature{
+		id:      "xmldsig-" + id,
+		cert:    cert,
+		opts:    opts,
+		digests: make([]string, 3),
+	}
+	sig.digests[0] = digest(canonical)
+
+	insert := func() []byte {
+		var out bytes.Buffer
+		out.Write(canonical[:at])
+		out.WriteString(sig.render())
+		out.Write(canonical[at:])
+		return out.Bytes()
+	}
+
+	// El certificado y las propiedades firmadas se resumen en el contexto del documento, con los
+	// espacios de nombres que heredan
+	draft := insert()
+	for i, id := range []string{sig.keyInfoID(), sig.signedPropertiesID()} {
+		part, err := canonicalize(draft, byID(id), nil)
+		if err != nil {
+			return nil, err
+		}
+		sig.digests[i+1] = digest(part)
+	}
+
+	signedInfo, err := canonicalize(insert(), byName("ds:SignedInfo"), nil)
+	if err != nil {
+		return nil, err
+	}
+	hashed := sha256.Sum256(signedInfo)
+	value, err := rsa.SignPKCS1v15(rand.Reader, cert.Key, crypto.SHA256, hashed[:])
+	if err != nil {
+		return nil, fmt.Errorf("error al firmar: %v", err)
+	}
+	sig.value = base64.StdEncoding.EncodeToString(value)
+
+	signed := append([]byte(`<?xml version="1.0" encoding="UTF-8"?>`+"\n"), insert()...)
+	return &Signed{XML: signed, DigestValue: sig.digests[0], SignatureValue: sig.value}, nil
+}
+
+type signature struct {
+	id   string
+	cert *Certificate
+	opts Options
+	// digests son los resúmenes del documento, del KeyInfo y de las propiedades firmadas
+	digests []string
+	value   string
+}
+
+func (s *signature) keyInfoID() string {
+	return s.id + "-keyinfo"
+}
+
+func (s *signature) signedPropertiesID() string {
+	return s.id + "-signedprops"
+}
+
+func (s *signature) render() string {
+	var b strings.Builder
+	leaf := s.cert.Leaf
+
+	b.WriteString(`<ds:Signature xmlns:ds="` + nsDS + `" Id="` + s.id + `">`)
+	b.WriteString(`<ds:SignedInfo>`)
+	b.WriteString(`<ds:CanonicalizationMethod Algorithm="` + algC14N + `"></ds:CanonicalizationMethod>`)
+	b.WriteString(`<ds:SignatureMethod Algorithm="` + algRSASHA256 + `"></ds:SignatureMethod>`)
+	b.WriteString(`<ds:Reference Id="` + s.id + `-ref0" URI="">`)
+	b.WriteString(`<ds:Transforms><ds:Transform Algorithm="` + algEnveloped + `"></ds:Transform></ds:Transforms>`)
+	writeDigest(&b, s.digests[0])
+	b.WriteString(`</ds:Reference>`)
+	b.WriteString(`<ds:Reference URI="#` + s.keyInfoID() + `">`)
+	writeDigest(&b, s.digests[1])
+	b.WriteString(`</ds:Reference>`)
+	b.WriteString(`<ds:Reference Type="` + typeSignedProperties + `" URI="#` + s.signedPropertiesID() + `">`)
+	writeDigest(&b, s.digests[2])
+	b.WriteString(`</ds:Reference>`)
+	b.WriteString(`</ds:SignedInfo>`)
+
+	b.WriteString(`<ds:SignatureValue Id="` + s.id + `-sigvalue">` + s.value + `</ds:SignatureValue>`)
+
+	b.WriteString(`<ds:KeyInfo Id="` + s.keyInfoID() + `"><ds:X509Data><ds:X509Certificate>`)
+	b.WriteString(base64.StdEncoding.EncodeToString(leaf.Raw))
+	b.WriteString(`</ds:X509Certificate></ds:X509Data></ds:KeyInfo>`)
+
+	certDigest := sha256.Sum256(leaf.Raw)
+	b.WriteString(`<ds:Object><xades:QualifyingProperties xmlns:xades="` + nsXAdES + `" Target="#` + s.id + `">`)
+	b.WriteString(`<xades:SignedProperties Id="` + s.signedPropertiesID() + `"><xades:SignedSignatureProperties>`)
+	b.WriteString(`<xades:SigningTime>` + s.opts.SigningTime.Format("2006-01-02T15:04:05-07:00") + `</xades:SigningTime>`)
+	b.WriteString(`<xades:SigningCertificate><xades:Cert><xades:CertDigest>`)
+	writeDigest(&b, base64.StdEncoding.EncodeToString(certDigest[:]))
+	b.WriteString(`</xades:CertDigest><xades:IssuerSerial>`)
+	b.WriteString(`<ds:X509IssuerName>` + escapeText(leaf.Issuer.String()) + `</ds:X509IssuerName>`)
+	b.WriteString(`<ds:X509SerialNumber>` + leaf.SerialNumber.String() + `</ds:X509SerialNumber>`)
+	b.WriteString(`</xades:IssuerSerial></xades:Cert></xades:SigningCertificate>`)
+	if policy := s.opts.Policy; policy != nil {
+		b.WriteString(`<xades:SignaturePolicyIdentifier><xades:SignaturePolicyId><xades:SigPolicyId>`)
+		b.WriteString(`<xades:Identifier>` + escapeText(policy.Identifier) + `</xades:Identifier>`)
+		b.WriteString(`<xades:Description>` + escapeText(policy.Description) + `</xades:Description>`)
+		b.WriteString(`</xades:SigPolicyId><xades:SigPolicyHash>`)
+		writeDigest(&b, policy.Digest)
+		b.WriteString(`</xades:SigPolicyHash></xades:SignaturePolicyId></xades:SignaturePolicyIdentifier>`)
+	}
+	if s.opts.Role != "" {
+		b.WriteString(`<xades:SignerRole><xades:ClaimedRoles><xades:ClaimedRole>` + escapeText(s.opts.Role) + `</xades:ClaimedRole></xades:ClaimedRoles></xades:SignerRole>`)
+	}
+	b.WriteString(`</xades:SignedSignatureProperties></xades:SignedProperties>`)
+	b.WriteString(`</xades:QualifyingProperties></ds:Object>`)
+	b.WriteString(`</ds:Signature>`)
+
+	return b.String()
+}
+
+func writeDigest(b *strings.Builder, value string) {
+	b.WriteString(`<ds:DigestMethod Algorithm="` + algSHA256 + `"></ds:DigestMethod>`)
+	b.WriteString(`<ds:DigestValue>` + value + `</ds:DigestValue>`)
+}
+
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// newID es un identificador aleatorio con forma de UUID para los atributos Id de la firma
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	h := hex.EncodeToString(b)
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:], nil
+}
@@ -0,0 +1,124 @@
+package xades
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func testCertificate(t *testing.T) *Certificate {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1001),
+		Subject:      pkix.Name{CommonName: "Firma de prueba", Organization: []string{"Comercial La Esquina S.A.S."}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Certificate{Leaf: leaf, Key: key}
+}
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	cert := testCertificate(t)
+	doc := readTestdata(t, "invoice.xml")
+
+	signed, err := Sign(doc, cert, Options{
+		SigningTime: time.Date(2024, 3, 15, 10, 30, 0, 0, time.FixedZone("COT", -5*3600)),
+		Policy:      &Policy{Identifier: "https://example.org/politica.pdf", Description: "Política de firma", Digest: digest([]byte("politica"))},
+		Role:        "supplier",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signer, err := Verify(signed.XML)
+	if err != nil {
+		t.Fatalf("la firma recién hecha no verifica: %v", err)
+	}
+	if !signer.Equal(cert.Leaf) {
+		t.Fatal("Verify devolvió otro certificado")
+	}
+
+	// La referencia al documento cubre la forma canónica de libxml2 sin la firma
+	golden := readTestdata(t, "invoice.c14n.xml")
+	if signed.DigestValue != digest(golden) {
+		t.Fatal("el resumen del documento no es el de su forma canónica")
+	}
+	content, err := canonicalize(signed.XML, nil, byName("ds:Signature"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(content, golden) {
+		t.Fatalf("el documento firmado sin la firma no es el original:\n%s", content)
+	}
+}
+
+func TestVerifyRejectsTampering(t *testing.T) {
+	cert := testCertificate(t)
+	signed, err := Sign(readTestdata(t, "invoice.xml"), cert, Options{SigningTime: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		old, new string
+	}{
+		{"contenido del documento", "<cbc:PayableAmount currencyID=\"COP\">119000.00", "<cbc:PayableAmount currencyID=\"COP\">1190.00"},
+		{"atributo del documento", `currencyID="COP">50000.00`, `currencyID="USD">50000.00`},
+		{"propiedades firmadas", "<xades:SigningTime>", "<xades:SigningTime>1"},
+		{"resumen de una referencia", "<ds:DigestValue>", "<ds:DigestValue>A"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !bytes.Contains(signed.XML, []byte(tt.old)) {
+				t.Fatalf("el documento firmado no contiene %q", tt.old)
+			}
+			tampered := bytes.Replace(signed.XML, []byte(tt.old), []byte(tt.new), 1)
+			if _, err := Verify(tampered); err == nil {
+				t.Fatal("Verify aceptó un documento alterado")
+			}
+		})
+	}
+
+	if _, err := Verify(readTestdata(t, "invoice.xml")); err == nil {
+		t.Fatal("Verify aceptó un documento sin firma")
+	}
+}
+
+// testdata/invoice.signed.xml se firmó sin este paquete: cada forma canónica la calculó libxml2
+// (xmllint --c14n) y la firma RSA-SHA256 openssl. La firma está indentada, con elementos vacíos
+// abreviados y la firma en base64 partida en líneas, como la dejan otras implementaciones.
+func TestVerifyReferenceSignature(t *testing.T) {
+	doc := readTestdata(t, "invoice.signed.xml")
+
+	signer, err := Verify(doc)
+	if err != nil {
+		t.Fatalf("la firma de referencia no verifica: %v", err)
+	}
+	if signer.SerialNumber.Int64() != 4242 {
+		t.Fatalf("Verify devolvió el certificado %s", signer.SerialNumber)
+	}
+
+	tampered := bytes.Replace(doc, []byte("Café tostado"), []byte("Café molido"), 1)
+	if _, err := Verify(tampered); err == nil {
+		t.Fatal("Verify aceptó un documento alterado")
+	}
+}